@@ -18,6 +18,9 @@ materialId="${MATERIALID:-00000000-0000-4000-8000-000000000001}"
 blockPackId="${BLOCKPACKID:-00000000-0000-4000-8000-000000000001}"
 blockId="${BLOCKID:-00000000-0000-4000-8000-000000000001}"
 itemId="${ITEMID:-00000000-0000-4000-8000-000000000001}"
+archiveJobId="${ARCHIVEJOBID:-00000000-0000-4000-8000-000000000001}"
+snapshotId="${SNAPSHOTID:-00000000-0000-4000-8000-000000000001}"
+uploadId="${UPLOADID:-00000000-0000-4000-8000-000000000001}"
 
 # Run individual functions deliberately. DELETE/reset functions are not invoked automatically.
 
@@ -98,6 +101,15 @@ createBlockPack() {
     "$api_gateway_base_url/block-packs/sub-shelf/${parentSubShelfId}"
 }
 
+createBlockPackFromMarkdown() {
+  curl --fail-with-body --silent --show-error -X POST \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-API-Key: $api_key" \
+    --data '{"headerBackgroundURL":"https://example.com","icon":"😀","id":"00000000-0000-4000-8000-000000000001","markdown":"example","name":"example","parentSubShelfId":"00000000-0000-4000-8000-000000000001"}' \
+    "$api_gateway_base_url/block-packs/sub-shelf/${parentSubShelfId}/markdown"
+}
+
 deleteMyBlockPackById() {
   curl --fail-with-body --silent --show-error -X DELETE \
     -H "User-Agent: $user_agent" \
@@ -122,6 +134,13 @@ updateMyBlockPackById() {
     "$api_gateway_base_url/block-packs/${blockPackId}"
 }
 
+getMyBlockPackMarkdownById() {
+  curl --fail-with-body --silent --show-error -X GET \
+    -H "User-Agent: $user_agent" \
+    -H "X-API-Key: $api_key" \
+    "$api_gateway_base_url/block-packs/${blockPackId}/markdown"
+}
+
 getMyBlockPackAndItsParentById() {
   curl --fail-with-body --silent --show-error -X GET \
     -H "User-Agent: $user_agent" \
@@ -146,6 +165,37 @@ restoreMyBlockPackById() {
     "$api_gateway_base_url/block-packs/${blockPackId}/restore"
 }
 
+getMyBlockPackSnapshotsById() {
+  curl --fail-with-body --silent --show-error -X GET \
+    -H "User-Agent: $user_agent" \
+    -H "X-API-Key: $api_key" \
+    "$api_gateway_base_url/block-packs/${blockPackId}/snapshots"
+}
+
+createMyBlockPackSnapshotById() {
+  curl --fail-with-body --silent --show-error -X POST \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-API-Key: $api_key" \
+    --data '{"name":"example"}' \
+    "$api_gateway_base_url/block-packs/${blockPackId}/snapshots"
+}
+
+getMyBlockPackSnapshotDiffById() {
+  curl --fail-with-body --silent --show-error -X GET \
+    -H "User-Agent: $user_agent" \
+    -H "X-API-Key: $api_key" \
+    "$api_gateway_base_url/block-packs/${blockPackId}/snapshots/diff?fromSnapshotId=00000000-0000-4000-8000-000000000001&toSnapshotId=00000000-0000-4000-8000-000000000001"
+}
+
+restoreMyBlockPackSnapshotById() {
+  curl --fail-with-body --silent --show-error -X PATCH \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-API-Key: $api_key" \
+    "$api_gateway_base_url/block-packs/${blockPackId}/snapshots/${snapshotId}/restore"
+}
+
 getMyBlocksByIds() {
   curl --fail-with-body --silent --show-error -X GET \
     -H "User-Agent: $user_agent" \
@@ -217,6 +267,15 @@ createMyMaterial() {
     "$api_gateway_base_url/materials/sub-shelf/${parentSubShelfId}"
 }
 
+uploadMyMaterialPart() {
+  curl --fail-with-body --silent --show-error -X PUT \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-API-Key: $api_key" \
+    --data '{"content":[1]}' \
+    "$api_gateway_base_url/materials/uploads/${storageUploadId}/parts/${partNumber}"
+}
+
 deleteMyMaterialById() {
   curl --fail-with-body --silent --show-error -X DELETE \
     -H "User-Agent: $user_agent" \
@@ -274,6 +333,32 @@ restoreMyMaterialById() {
     "$api_gateway_base_url/materials/${materialId}/restore"
 }
 
+initiateMyMaterialUpload() {
+  curl --fail-with-body --silent --show-error -X POST \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-API-Key: $api_key" \
+    --data '{"contentType":"none","size":1}' \
+    "$api_gateway_base_url/materials/${materialId}/uploads"
+}
+
+abortMyMaterialUpload() {
+  curl --fail-with-body --silent --show-error -X DELETE \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-API-Key: $api_key" \
+    "$api_gateway_base_url/materials/${materialId}/uploads/${uploadId}"
+}
+
+completeMyMaterialUpload() {
+  curl --fail-with-body --silent --show-error -X POST \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-API-Key: $api_key" \
+    --data '{"parts":[{"eTag":"example","partNumber":1}]}' \
+    "$api_gateway_base_url/materials/${materialId}/uploads/${uploadId}/complete"
+}
+
 createRootShelf() {
   curl --fail-with-body --silent --show-error -X POST \
     -H "User-Agent: $user_agent" \
@@ -283,6 +368,22 @@ createRootShelf() {
     "$api_gateway_base_url/root-shelves"
 }
 
+createRootShelfFromArchive() {
+  curl --fail-with-body --silent --show-error -X POST \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-API-Key: $api_key" \
+    --data '{"archiveFile":[1],"name":"example","targetRootShelfId":"00000000-0000-4000-8000-000000000001"}' \
+    "$api_gateway_base_url/root-shelves/archive"
+}
+
+getMyRootShelfArchiveJobById() {
+  curl --fail-with-body --silent --show-error -X GET \
+    -H "User-Agent: $user_agent" \
+    -H "X-API-Key: $api_key" \
+    "$api_gateway_base_url/root-shelves/archive-jobs/${archiveJobId}"
+}
+
 deleteMyRootShelvesByIds() {
   curl --fail-with-body --silent --show-error -X DELETE \
     -H "User-Agent: $user_agent" \
@@ -353,6 +454,14 @@ updateMyRootShelfById() {
     "$api_gateway_base_url/root-shelves/${rootShelfId}"
 }
 
+exportMyRootShelfArchiveById() {
+  curl --fail-with-body --silent --show-error -X POST \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-API-Key: $api_key" \
+    "$api_gateway_base_url/root-shelves/${rootShelfId}/archive"
+}
+
 leaveMyRootShelf() {
   curl --fail-with-body --silent --show-error -X DELETE \
     -H "User-Agent: $user_agent" \
@@ -525,7 +634,7 @@ createRoutineTaskByRoutineId() {
     -H "User-Agent: $user_agent" \
     -H "Content-Type: application/json" \
     -H "X-API-Key: $api_key" \
-    --data '{"maxAttempts":1,"nextScheduledAt":"2026-01-01T00:00:00Z","payload":{},"period":"Daily","priority":1,"purpose":"CreateRootShelf","routineId":"00000000-0000-4000-8000-000000000001","title":"example"}' \
+    --data '{"maxAttempts":1,"nextScheduledAt":"2026-01-01T00:00:00Z","payload":{},"period":"Daily","priority":1,"purpose":"CreateRootShelf","recurrenceRule":"FREQ=WEEKLY;BYDAY=MO,WE,FR;BYHOUR=9;BYMINUTE=0","routineId":"00000000-0000-4000-8000-000000000001","title":"example"}' \
     "$api_gateway_base_url/routine-tasks/routine/${routineId}"
 }
 
@@ -583,7 +692,7 @@ updateMyRoutineTaskById() {
     -H "User-Agent: $user_agent" \
     -H "Content-Type: application/json" \
     -H "X-API-Key: $api_key" \
-    --data '{"routineTaskId":"00000000-0000-4000-8000-000000000001","setNull":{},"values":{"maxAttempts":1,"nextScheduledAt":"2026-01-01T00:00:00Z","payload":{},"period":"Daily","priority":1,"purpose":"CreateRootShelf","routineId":"00000000-0000-4000-8000-000000000001","title":"example"}}' \
+    --data '{"routineTaskId":"00000000-0000-4000-8000-000000000001","setNull":{},"values":{"maxAttempts":1,"nextScheduledAt":"2026-01-01T00:00:00Z","payload":{},"period":"Daily","priority":1,"purpose":"CreateRootShelf","recurrenceRule":"FREQ=WEEKLY;BYDAY=MO,WE,FR;BYHOUR=9;BYMINUTE=0","routineId":"00000000-0000-4000-8000-000000000001","title":"example"}}' \
     "$api_gateway_base_url/routine-tasks/${routineTaskId}"
 }
 
@@ -635,7 +744,7 @@ createRoutinesByStationIds() {
     -H "User-Agent: $user_agent" \
     -H "Content-Type: application/json" \
     -H "X-API-Key: $api_key" \
-    --data '{"createdRoutines":[{"description":"example","id":"00000000-0000-4000-8000-000000000001","isPinned":true,"period":"Daily","scheduledEndAt":"2026-01-01T00:00:00Z","scheduledStartAt":"2026-01-01T00:00:00Z","stationId":"00000000-0000-4000-8000-000000000001","status":"Scheduled","timezone":"example","title":"example"}]}' \
+    --data '{"createdRoutines":[{"description":"example","id":"00000000-0000-4000-8000-000000000001","isPinned":true,"period":"Daily","recurrenceRule":"FREQ=WEEKLY;BYDAY=MO,WE,FR;BYHOUR=9;BYMINUTE=0","scheduledEndAt":"2026-01-01T00:00:00Z","scheduledStartAt":"2026-01-01T00:00:00Z","stationId":"00000000-0000-4000-8000-000000000001","status":"Scheduled","timezone":"example","title":"example"}]}' \
     "$api_gateway_base_url/routines/batch"
 }
 
@@ -644,7 +753,7 @@ updateMyRoutinesByIds() {
     -H "User-Agent: $user_agent" \
     -H "Content-Type: application/json" \
     -H "X-API-Key: $api_key" \
-    --data '{"updatedRoutines":[{"routineId":"00000000-0000-4000-8000-000000000001","setNull":{},"values":{"description":"example","isPinned":true,"period":"Daily","scheduledEndAt":"2026-01-01T00:00:00Z","scheduledStartAt":"2026-01-01T00:00:00Z","stationId":"00000000-0000-4000-8000-000000000001","status":"Scheduled","timezone":"example","title":"example"}}]}' \
+    --data '{"updatedRoutines":[{"routineId":"00000000-0000-4000-8000-000000000001","setNull":{},"values":{"description":"example","isPinned":true,"period":"Daily","recurrenceRule":"FREQ=WEEKLY;BYDAY=MO,WE,FR;BYHOUR=9;BYMINUTE=0","scheduledEndAt":"2026-01-01T00:00:00Z","scheduledStartAt":"2026-01-01T00:00:00Z","stationId":"00000000-0000-4000-8000-000000000001","status":"Scheduled","timezone":"example","title":"example"}}]}' \
     "$api_gateway_base_url/routines/batch"
 }
 
@@ -686,7 +795,7 @@ createRoutineByStationId() {
     -H "User-Agent: $user_agent" \
     -H "Content-Type: application/json" \
     -H "X-API-Key: $api_key" \
-    --data '{"description":"example","id":"00000000-0000-4000-8000-000000000001","isPinned":true,"period":"Daily","scheduledEndAt":"2026-01-01T00:00:00Z","scheduledStartAt":"2026-01-01T00:00:00Z","stationId":"00000000-0000-4000-8000-000000000001","status":"Scheduled","timezone":"example","title":"example"}' \
+    --data '{"description":"example","id":"00000000-0000-4000-8000-000000000001","isPinned":true,"period":"Daily","recurrenceRule":"FREQ=WEEKLY;BYDAY=MO,WE,FR;BYHOUR=9;BYMINUTE=0","scheduledEndAt":"2026-01-01T00:00:00Z","scheduledStartAt":"2026-01-01T00:00:00Z","stationId":"00000000-0000-4000-8000-000000000001","status":"Scheduled","timezone":"example","title":"example"}' \
     "$api_gateway_base_url/routines/station/${stationId}"
 }
 
@@ -748,7 +857,7 @@ updateMyRoutineById() {
     -H "User-Agent: $user_agent" \
     -H "Content-Type: application/json" \
     -H "X-API-Key: $api_key" \
-    --data '{"routineId":"00000000-0000-4000-8000-000000000001","setNull":{},"values":{"description":"example","isPinned":true,"period":"Daily","scheduledEndAt":"2026-01-01T00:00:00Z","scheduledStartAt":"2026-01-01T00:00:00Z","stationId":"00000000-0000-4000-8000-000000000001","status":"Scheduled","timezone":"example","title":"example"}}' \
+    --data '{"routineId":"00000000-0000-4000-8000-000000000001","setNull":{},"values":{"description":"example","isPinned":true,"period":"Daily","recurrenceRule":"FREQ=WEEKLY;BYDAY=MO,WE,FR;BYHOUR=9;BYMINUTE=0","scheduledEndAt":"2026-01-01T00:00:00Z","scheduledStartAt":"2026-01-01T00:00:00Z","stationId":"00000000-0000-4000-8000-000000000001","status":"Scheduled","timezone":"example","title":"example"}}' \
     "$api_gateway_base_url/routines/${routineId}"
 }
 
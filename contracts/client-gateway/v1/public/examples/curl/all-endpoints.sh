@@ -22,6 +22,14 @@ materialId="${MATERIALID:-00000000-0000-4000-8000-000000000001}"
 blockPackId="${BLOCKPACKID:-00000000-0000-4000-8000-000000000001}"
 blockId="${BLOCKID:-00000000-0000-4000-8000-000000000001}"
 itemId="${ITEMID:-00000000-0000-4000-8000-000000000001}"
+archiveJobId="${ARCHIVEJOBID:-00000000-0000-4000-8000-000000000001}"
+snapshotId="${SNAPSHOTID:-00000000-0000-4000-8000-000000000001}"
+uploadId="${UPLOADID:-00000000-0000-4000-8000-000000000001}"
+shareLinkId="${SHARELINKID:-00000000-0000-4000-8000-000000000001}"
+sessionId="${SESSIONID:-00000000-0000-4000-8000-000000000001}"
+twoFactorChallengeToken="${TWOFACTORCHALLENGETOKEN:-}"
+oauthState="${OAUTHSTATE:-}"
+notificationDigestUnsubscribeToken="${NOTIFICATIONDIGESTUNSUBSCRIBETOKEN:-}"
 
 # Run individual functions deliberately. DELETE/reset functions are not invoked automatically.
 
@@ -49,7 +57,7 @@ login() {
     -b "$cookie_jar" -c "$cookie_jar" \
     -H "User-Agent: $user_agent" \
     -H "Content-Type: application/json" \
-    --data "{\"account\":\"$account\",\"password\":\"$password\"}" \
+    --data "{\"account\":\"$account\",\"password\":\"$password\",\"deviceLabel\":\"Curl example\"}" \
     "$gateway_base_url/auth/login"
 }
 
@@ -62,6 +70,42 @@ loginViaGoogle() {
     "$gateway_base_url/auth/login-via-google"
 }
 
+loginViaGithub() {
+  curl --fail-with-body --silent --show-error -X POST \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    --data "{\"authorizationCode\":\"example\",\"state\":\"$oauthState\"}" \
+    "$gateway_base_url/auth/login-via-github"
+}
+
+loginViaMeta() {
+  curl --fail-with-body --silent --show-error -X POST \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    --data "{\"authorizationCode\":\"example\",\"state\":\"$oauthState\"}" \
+    "$gateway_base_url/auth/login-via-meta"
+}
+
+loginViaOIDC() {
+  curl --fail-with-body --silent --show-error -X POST \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    --data "{\"authorizationCode\":\"example\",\"state\":\"$oauthState\"}" \
+    "$gateway_base_url/auth/login-via-oidc"
+}
+
+verifyLoginTwoFactor() {
+  curl --fail-with-body --silent --show-error -X POST \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    --data "{\"challengeToken\":\"$twoFactorChallengeToken\",\"code\":\"123456\"}" \
+    "$gateway_base_url/auth/login/two-factor"
+}
+
 logout() {
   curl --fail-with-body --silent --show-error -X POST \
     -b "$cookie_jar" -c "$cookie_jar" \
@@ -71,6 +115,15 @@ logout() {
     "$gateway_base_url/auth/logout"
 }
 
+getOAuthAuthorizationURL() {
+  curl --fail-with-body --silent --show-error -X POST \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    --data '{"provider":"GitHub"}' \
+    "$gateway_base_url/auth/oauth/authorization-url"
+}
+
 register() {
   curl --fail-with-body --silent --show-error -X POST \
     -b "$cookie_jar" -c "$cookie_jar" \
@@ -89,6 +142,33 @@ registerViaGoogle() {
     "$gateway_base_url/auth/register-via-google"
 }
 
+registerViaGithub() {
+  curl --fail-with-body --silent --show-error -X POST \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    --data "{\"authorizationCode\":\"example\",\"state\":\"$oauthState\"}" \
+    "$gateway_base_url/auth/register-via-github"
+}
+
+registerViaMeta() {
+  curl --fail-with-body --silent --show-error -X POST \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    --data "{\"authorizationCode\":\"example\",\"state\":\"$oauthState\"}" \
+    "$gateway_base_url/auth/register-via-meta"
+}
+
+registerViaOIDC() {
+  curl --fail-with-body --silent --show-error -X POST \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    --data "{\"authorizationCode\":\"example\",\"state\":\"$oauthState\"}" \
+    "$gateway_base_url/auth/register-via-oidc"
+}
+
 resetEmail() {
   curl --fail-with-body --silent --show-error -X PUT \
     -b "$cookie_jar" -c "$cookie_jar" \
@@ -118,6 +198,77 @@ sendAuthCode() {
     "$gateway_base_url/auth/send-auth-code"
 }
 
+getMySessions() {
+  curl --fail-with-body --silent --show-error -X GET \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    "$gateway_base_url/auth/sessions"
+}
+
+revokeMyOtherSessions() {
+  curl --fail-with-body --silent --show-error -X DELETE \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-CSRF-Token: $csrf_token" \
+    "$gateway_base_url/auth/sessions"
+}
+
+revokeMySession() {
+  curl --fail-with-body --silent --show-error -X DELETE \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-CSRF-Token: $csrf_token" \
+    "$gateway_base_url/auth/sessions/${sessionId}"
+}
+
+getMyTwoFactor() {
+  curl --fail-with-body --silent --show-error -X GET \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    "$gateway_base_url/auth/two-factor"
+}
+
+disableMyTwoFactor() {
+  curl --fail-with-body --silent --show-error -X DELETE \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-CSRF-Token: $csrf_token" \
+    --data '{"authCode":"123456","code":"123456"}' \
+    "$gateway_base_url/auth/two-factor"
+}
+
+enableMyTwoFactor() {
+  curl --fail-with-body --silent --show-error -X POST \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-CSRF-Token: $csrf_token" \
+    --data '{"code":"123456"}' \
+    "$gateway_base_url/auth/two-factor/enable"
+}
+
+enrollMyTwoFactor() {
+  curl --fail-with-body --silent --show-error -X POST \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-CSRF-Token: $csrf_token" \
+    "$gateway_base_url/auth/two-factor/enroll"
+}
+
+regenerateMyTwoFactorRecoveryCodes() {
+  curl --fail-with-body --silent --show-error -X POST \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-CSRF-Token: $csrf_token" \
+    --data '{"code":"123456"}' \
+    "$gateway_base_url/auth/two-factor/recovery-codes"
+}
+
 validateEmail() {
   curl --fail-with-body --silent --show-error -X PUT \
     -b "$cookie_jar" -c "$cookie_jar" \
@@ -128,6 +279,27 @@ validateEmail() {
     "$gateway_base_url/auth/validate-email"
 }
 
+getBillingPlans() {
+  curl --fail-with-body --silent --show-error -X GET \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    "$gateway_base_url/billing/plans"
+}
+
+handlePayPalWebhook() {
+  curl --fail-with-body --silent --show-error -X POST \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "PAYPAL-AUTH-ALGO: SHA256withRSA" \
+    -H "PAYPAL-CERT-URL: https://api.paypal.com/v1/notifications/certs/CERT-360caa42-fca2a594-example" \
+    -H "PAYPAL-TRANSMISSION-ID: 00000000-0000-4000-8000-000000000001" \
+    -H "PAYPAL-TRANSMISSION-SIG: example-signature" \
+    -H "PAYPAL-TRANSMISSION-TIME: 2030-01-01T00:00:00Z" \
+    -H "Content-Type: application/json" \
+    --data '{"event_type":"BILLING.SUBSCRIPTION.ACTIVATED","id":"WH-00000000000000000-0000000000000000","resource":{"id":"I-EXAMPLE0000000","status":"ACTIVE"},"resource_type":"subscription"}' \
+    "$gateway_base_url/billing/paypal/webhook"
+}
+
 deleteMyBlockPacksByIds() {
   curl --fail-with-body --silent --show-error -X DELETE \
     -b "$cookie_jar" -c "$cookie_jar" \
@@ -212,6 +384,16 @@ createBlockPack() {
     "$gateway_base_url/block-packs/sub-shelf/${parentSubShelfId}"
 }
 
+createBlockPackFromMarkdown() {
+  curl --fail-with-body --silent --show-error -X POST \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-CSRF-Token: $csrf_token" \
+    --data '{"headerBackgroundURL":"https://example.com","icon":"😀","id":"00000000-0000-4000-8000-000000000001","markdown":"example","name":"example","parentSubShelfId":"00000000-0000-4000-8000-000000000001"}' \
+    "$gateway_base_url/block-packs/sub-shelf/${parentSubShelfId}/markdown"
+}
+
 deleteMyBlockPackById() {
   curl --fail-with-body --silent --show-error -X DELETE \
     -b "$cookie_jar" -c "$cookie_jar" \
@@ -238,6 +420,13 @@ updateMyBlockPackById() {
     "$gateway_base_url/block-packs/${blockPackId}"
 }
 
+getMyBlockPackMarkdownById() {
+  curl --fail-with-body --silent --show-error -X GET \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    "$gateway_base_url/block-packs/${blockPackId}/markdown"
+}
+
 getMyBlockPackAndItsParentById() {
   curl --fail-with-body --silent --show-error -X GET \
     -b "$cookie_jar" -c "$cookie_jar" \
@@ -264,6 +453,39 @@ restoreMyBlockPackById() {
     "$gateway_base_url/block-packs/${blockPackId}/restore"
 }
 
+getMyBlockPackSnapshotsById() {
+  curl --fail-with-body --silent --show-error -X GET \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    "$gateway_base_url/block-packs/${blockPackId}/snapshots"
+}
+
+createMyBlockPackSnapshotById() {
+  curl --fail-with-body --silent --show-error -X POST \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-CSRF-Token: $csrf_token" \
+    --data '{"name":"example"}' \
+    "$gateway_base_url/block-packs/${blockPackId}/snapshots"
+}
+
+getMyBlockPackSnapshotDiffById() {
+  curl --fail-with-body --silent --show-error -X GET \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    "$gateway_base_url/block-packs/${blockPackId}/snapshots/diff?fromSnapshotId=00000000-0000-4000-8000-000000000001&toSnapshotId=00000000-0000-4000-8000-000000000001"
+}
+
+restoreMyBlockPackSnapshotById() {
+  curl --fail-with-body --silent --show-error -X PATCH \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-CSRF-Token: $csrf_token" \
+    "$gateway_base_url/block-packs/${blockPackId}/snapshots/${snapshotId}/restore"
+}
+
 getMyBlocksByIds() {
   curl --fail-with-body --silent --show-error -X GET \
     -b "$cookie_jar" -c "$cookie_jar" \
@@ -418,6 +640,35 @@ restoreMyMaterialById() {
     "$gateway_base_url/materials/${materialId}/restore"
 }
 
+initiateMyMaterialUpload() {
+  curl --fail-with-body --silent --show-error -X POST \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-CSRF-Token: $csrf_token" \
+    --data '{"contentType":"none","size":1}' \
+    "$gateway_base_url/materials/${materialId}/uploads"
+}
+
+abortMyMaterialUpload() {
+  curl --fail-with-body --silent --show-error -X DELETE \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-CSRF-Token: $csrf_token" \
+    "$gateway_base_url/materials/${materialId}/uploads/${uploadId}"
+}
+
+completeMyMaterialUpload() {
+  curl --fail-with-body --silent --show-error -X POST \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-CSRF-Token: $csrf_token" \
+    --data '{"parts":[{"eTag":"example","partNumber":1}]}' \
+    "$gateway_base_url/materials/${materialId}/uploads/${uploadId}/complete"
+}
+
 getMyAccount() {
   curl --fail-with-body --silent --show-error -X GET \
     -b "$cookie_jar" -c "$cookie_jar" \
@@ -455,6 +706,117 @@ bindGoogleAccount() {
     "$gateway_base_url/me/account/google"
 }
 
+unbindGithubAccount() {
+  curl --fail-with-body --silent --show-error -X DELETE \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-CSRF-Token: $csrf_token" \
+    --data '{"authCode":"123456"}' \
+    "$gateway_base_url/me/account/github"
+}
+
+bindGithubAccount() {
+  curl --fail-with-body --silent --show-error -X PUT \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-CSRF-Token: $csrf_token" \
+    --data "{\"authorizationCode\":\"example\",\"state\":\"$oauthState\"}" \
+    "$gateway_base_url/me/account/github"
+}
+
+unbindMetaAccount() {
+  curl --fail-with-body --silent --show-error -X DELETE \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-CSRF-Token: $csrf_token" \
+    --data '{"authCode":"123456"}' \
+    "$gateway_base_url/me/account/meta"
+}
+
+bindMetaAccount() {
+  curl --fail-with-body --silent --show-error -X PUT \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-CSRF-Token: $csrf_token" \
+    --data "{\"authorizationCode\":\"example\",\"state\":\"$oauthState\"}" \
+    "$gateway_base_url/me/account/meta"
+}
+
+unbindOIDCAccount() {
+  curl --fail-with-body --silent --show-error -X DELETE \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-CSRF-Token: $csrf_token" \
+    --data '{"authCode":"123456"}' \
+    "$gateway_base_url/me/account/oidc"
+}
+
+bindOIDCAccount() {
+  curl --fail-with-body --silent --show-error -X PUT \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-CSRF-Token: $csrf_token" \
+    --data "{\"authorizationCode\":\"example\",\"state\":\"$oauthState\"}" \
+    "$gateway_base_url/me/account/oidc"
+}
+
+getMyUsage() {
+  curl --fail-with-body --silent --show-error -X GET \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    "$gateway_base_url/me/account/usage?days=30"
+}
+
+getMyBillingInvoices() {
+  curl --fail-with-body --silent --show-error -X GET \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    "$gateway_base_url/me/billing/invoices?limit=20&offset=0"
+}
+
+getMySubscription() {
+  curl --fail-with-body --silent --show-error -X GET \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    "$gateway_base_url/me/billing/subscription"
+}
+
+createMySubscription() {
+  curl --fail-with-body --silent --show-error -X POST \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-CSRF-Token: $csrf_token" \
+    --data '{"billingPlanId":"P-EXAMPLE-PRO-MONTHLY"}' \
+    "$gateway_base_url/me/billing/subscription"
+}
+
+cancelMySubscription() {
+  curl --fail-with-body --silent --show-error -X DELETE \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-CSRF-Token: $csrf_token" \
+    --data '{"reason":"No longer needed"}' \
+    "$gateway_base_url/me/billing/subscription"
+}
+
+changeMySubscriptionPlan() {
+  curl --fail-with-body --silent --show-error -X PUT \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-CSRF-Token: $csrf_token" \
+    --data '{"billingPlanId":"P-EXAMPLE-PREMIUM-MONTHLY"}' \
+    "$gateway_base_url/me/billing/subscription/plan"
+}
+
 getMyInfo() {
   curl --fail-with-body --silent --show-error -X GET \
     -b "$cookie_jar" -c "$cookie_jar" \
@@ -505,6 +867,65 @@ search() {
     "$gateway_base_url/notifications"
 }
 
+getPreferences() {
+  curl --fail-with-body --silent --show-error -X GET \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    "$gateway_base_url/notifications/preferences"
+}
+
+updatePreferences() {
+  curl --fail-with-body --silent --show-error -X PUT \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-CSRF-Token: $csrf_token" \
+    --data '{"preferences":[{"email":true,"emailDigest":true,"inApp":true,"templateKey":"","type":"news","webPush":false}],"timezone":"Asia/Taipei","digest":{"frequency":"weekly","hour":8,"weekday":1}}' \
+    "$gateway_base_url/notifications/preferences"
+}
+
+getPushPublicKey() {
+  curl --fail-with-body --silent --show-error -X GET \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    "$gateway_base_url/notifications/push/public-key"
+}
+
+registerPushSubscription() {
+  curl --fail-with-body --silent --show-error -X POST \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-CSRF-Token: $csrf_token" \
+    --data '{"endpoint":"https://push.example.com/send/subscription-id","expirationTime":null,"keys":{"auth":"tBHItJI5svbpez7KI4CCXg","p256dh":"BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4"}}' \
+    "$gateway_base_url/notifications/push/subscriptions"
+}
+
+unregisterPushSubscription() {
+  curl --fail-with-body --silent --show-error -X DELETE \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-CSRF-Token: $csrf_token" \
+    --data '{"endpoint":"https://push.example.com/send/subscription-id"}' \
+    "$gateway_base_url/notifications/push/subscriptions"
+}
+
+confirmUnsubscribeDigest() {
+  curl --fail-with-body --silent --show-error -X GET \
+    -H "User-Agent: $user_agent" \
+    -H "Accept: text/html" \
+    --get --data-urlencode "token=$notificationDigestUnsubscribeToken" \
+    "$gateway_base_url/notifications/digest/unsubscribe"
+}
+
+unsubscribeDigest() {
+  curl --fail-with-body --silent --show-error -X POST \
+    -H "User-Agent: $user_agent" \
+    --get --data-urlencode "token=$notificationDigestUnsubscribeToken" \
+    "$gateway_base_url/notifications/digest/unsubscribe"
+}
+
 markRead() {
   curl --fail-with-body --silent --show-error -X PATCH \
     -b "$cookie_jar" -c "$cookie_jar" \
@@ -550,6 +971,23 @@ createRootShelf() {
     "$gateway_base_url/root-shelves"
 }
 
+createRootShelfFromArchive() {
+  curl --fail-with-body --silent --show-error -X POST \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-CSRF-Token: $csrf_token" \
+    --data '{"archiveFile":[1],"name":"example","targetRootShelfId":"00000000-0000-4000-8000-000000000001"}' \
+    "$gateway_base_url/root-shelves/archive"
+}
+
+getMyRootShelfArchiveJobById() {
+  curl --fail-with-body --silent --show-error -X GET \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    "$gateway_base_url/root-shelves/archive-jobs/${archiveJobId}"
+}
+
 deleteMyRootShelvesByIds() {
   curl --fail-with-body --silent --show-error -X DELETE \
     -b "$cookie_jar" -c "$cookie_jar" \
@@ -627,6 +1065,15 @@ updateMyRootShelfById() {
     "$gateway_base_url/root-shelves/${rootShelfId}"
 }
 
+exportMyRootShelfArchiveById() {
+  curl --fail-with-body --silent --show-error -X POST \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-CSRF-Token: $csrf_token" \
+    "$gateway_base_url/root-shelves/${rootShelfId}/archive"
+}
+
 leaveMyRootShelf() {
   curl --fail-with-body --silent --show-error -X DELETE \
     -b "$cookie_jar" -c "$cookie_jar" \
@@ -1138,6 +1585,41 @@ linkRoutineTagById() {
     "$gateway_base_url/routines/${routineId}/tags/${routineTagId}"
 }
 
+getMyShareLinksByRootShelfId() {
+  curl --fail-with-body --silent --show-error -X GET \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    "$gateway_base_url/root-shelves/${rootShelfId}/share-links"
+}
+
+createMyShareLink() {
+  curl --fail-with-body --silent --show-error -X POST \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-CSRF-Token: $csrf_token" \
+    --data '{"expiresAt":"2030-01-01T00:00:00Z","maxUseCount":10,"permission":"Read"}' \
+    "$gateway_base_url/root-shelves/${rootShelfId}/share-links"
+}
+
+revokeMyShareLinkById() {
+  curl --fail-with-body --silent --show-error -X DELETE \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    -H "X-CSRF-Token: $csrf_token" \
+    "$gateway_base_url/root-shelves/${rootShelfId}/share-links/${shareLinkId}"
+}
+
+resolveShareLink() {
+  curl --fail-with-body --silent --show-error -X POST \
+    -b "$cookie_jar" -c "$cookie_jar" \
+    -H "User-Agent: $user_agent" \
+    -H "Content-Type: application/json" \
+    --data '{"token":"nzs_example-share-link-token-0000000000000000"}' \
+    "$gateway_base_url/share-links/resolve"
+}
+
 getGlobalAvatar() {
   curl --fail-with-body --silent --show-error -X GET \
     -b "$cookie_jar" -c "$cookie_jar" \
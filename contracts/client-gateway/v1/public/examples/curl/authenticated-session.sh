@@ -16,6 +16,20 @@ curl --fail-with-body --silent --show-error \
   --data "$login_payload" \
   "$gateway_base_url/auth/login" > "$response_file"
 
+if jq -e '.data.twoFactorRequired == true' "$response_file" > /dev/null; then
+  two_factor_payload="$(jq -cn \
+    --arg challengeToken "$(jq -er '.data.twoFactorChallengeToken' "$response_file")" \
+    --arg code "${TWO_FACTOR_CODE:?set TWO_FACTOR_CODE to a TOTP or recovery code}" \
+    '{challengeToken:$challengeToken,code:$code}')"
+
+  curl --fail-with-body --silent --show-error \
+    -c "$cookie_jar" \
+    -H 'Content-Type: application/json' \
+    -H 'User-Agent: NotegicCurlSession/1.0' \
+    --data "$two_factor_payload" \
+    "$gateway_base_url/auth/login/two-factor" > "$response_file"
+fi
+
 csrf_token="$(jq -er '.data.csrfToken' "$response_file")"
 
 curl --fail-with-body --silent --show-error \
@@ -3,6 +3,8 @@ package apicontract
 import (
 	"time"
 
+	"github.com/google/uuid"
+
 	coreapicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api"
 )
 
@@ -12,8 +14,11 @@ type CreateMyAPIKeyRequestDto struct {
 			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
 		},
 		struct {
-			Name      string     `json:"name" validate:"required,max=64"`
-			ExpiresAt *time.Time `json:"expiresAt"`
+			Name         string      `json:"name" validate:"required,max=64"`
+			ExpiresAt    *time.Time  `json:"expiresAt"`
+			Scopes       []string    `json:"scopes" validate:"omitempty,max=64,unique,dive,required,max=64"`
+			RootShelfIds []uuid.UUID `json:"rootShelfIds" validate:"omitempty,max=32,unique"`
+			StationIds   []uuid.UUID `json:"stationIds" validate:"omitempty,max=32,unique"`
 		},
 		struct{},
 		struct{},
@@ -21,10 +26,13 @@ type CreateMyAPIKeyRequestDto struct {
 }
 
 type CreateMyAPIKeyResponseDto struct {
-	PublicId  string     `json:"publicId"`
-	Name      string     `json:"name"`
-	KeyPrefix string     `json:"keyPrefix"`
-	Secret    string     `json:"secret"`
-	ExpiresAt *time.Time `json:"expiresAt"`
-	CreatedAt time.Time  `json:"createdAt"`
+	PublicId     string      `json:"publicId"`
+	Name         string      `json:"name"`
+	KeyPrefix    string      `json:"keyPrefix"`
+	Secret       string      `json:"secret"`
+	Scopes       []string    `json:"scopes"`
+	RootShelfIds []uuid.UUID `json:"rootShelfIds"`
+	StationIds   []uuid.UUID `json:"stationIds"`
+	ExpiresAt    *time.Time  `json:"expiresAt"`
+	CreatedAt    time.Time   `json:"createdAt"`
 }
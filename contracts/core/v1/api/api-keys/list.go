@@ -3,6 +3,8 @@ package apicontract
 import (
 	"time"
 
+	"github.com/google/uuid"
+
 	coreapicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api"
 )
 
@@ -22,11 +24,14 @@ type ListMyAPIKeysResponseDto struct {
 }
 
 type APIKeySummary struct {
-	PublicId   string     `json:"publicId"`
-	Name       string     `json:"name"`
-	KeyPrefix  string     `json:"keyPrefix"`
-	LastUsedAt *time.Time `json:"lastUsedAt"`
-	ExpiresAt  *time.Time `json:"expiresAt"`
-	RevokedAt  *time.Time `json:"revokedAt"`
-	CreatedAt  time.Time  `json:"createdAt"`
+	PublicId     string      `json:"publicId"`
+	Name         string      `json:"name"`
+	KeyPrefix    string      `json:"keyPrefix"`
+	Scopes       []string    `json:"scopes"`
+	RootShelfIds []uuid.UUID `json:"rootShelfIds"`
+	StationIds   []uuid.UUID `json:"stationIds"`
+	LastUsedAt   *time.Time  `json:"lastUsedAt"`
+	ExpiresAt    *time.Time  `json:"expiresAt"`
+	RevokedAt    *time.Time  `json:"revokedAt"`
+	CreatedAt    time.Time   `json:"createdAt"`
 }
@@ -12,10 +12,12 @@ type LoginRequestDto struct {
 	coreapicontract.RequestDto[
 		struct {
 			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+			IpAddress string `json:"ipAddress" validate:"omitempty,ip"`
 		},
 		struct {
-			Account  string `json:"account" validate:"required,isaccount"`
-			Password string `json:"password" validate:"required"`
+			Account     string  `json:"account" validate:"required,isaccount"`
+			Password    string  `json:"password" validate:"required"`
+			DeviceLabel *string `json:"deviceLabel" validate:"omitnil,min=1,max=64"`
 		},
 		struct{},
 		struct{},
@@ -32,15 +34,25 @@ type LoginResponseDto struct {
 	CSRFToken    string    `json:"csrfToken"`
 	UpdatedAt    time.Time `json:"updatedAt"`
 	CreatedAt    time.Time `json:"createdAt"`
+
+	// only set when the user has enabled the two-factor authentication, the tokens above
+	// are then left empty until the challenge is verified with a TOTP or recovery code
+	TwoFactorRequired           bool       `json:"twoFactorRequired"`
+	TwoFactorChallengeToken     string     `json:"twoFactorChallengeToken,omitempty"`
+	TwoFactorChallengeExpiresAt *time.Time `json:"twoFactorChallengeExpiresAt,omitempty"`
 }
 
 type LoginViaGoogleRequestDto struct {
 	coreapicontract.RequestDto[
 		struct {
-			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+			UserAgent       string `json:"userAgent" validate:"required,isuseragent"`
+			IpAddress       string `json:"ipAddress" validate:"omitempty,ip"`
+			OAuthStateToken string `json:"oauthStateToken" validate:"required"` // from the HttpOnly cookie set by GetOAuthAuthorizationURL
 		},
 		struct {
-			AuthorizationCode string `json:"authorizationCode" validate:"required"`
+			AuthorizationCode string  `json:"authorizationCode" validate:"required"`
+			State             string  `json:"state" validate:"required"`
+			DeviceLabel       *string `json:"deviceLabel" validate:"omitnil,min=1,max=64"`
 		},
 		struct{},
 		struct{},
@@ -57,4 +69,10 @@ type LoginViaGoogleResponseDto struct {
 	CSRFToken    string    `json:"csrfToken"`
 	UpdatedAt    time.Time `json:"updatedAt"`
 	CreatedAt    time.Time `json:"createdAt"`
+
+	// only set when the user has enabled the two-factor authentication, the tokens above
+	// are then left empty until the challenge is verified with a TOTP or recovery code
+	TwoFactorRequired           bool       `json:"twoFactorRequired"`
+	TwoFactorChallengeToken     string     `json:"twoFactorChallengeToken,omitempty"`
+	TwoFactorChallengeExpiresAt *time.Time `json:"twoFactorChallengeExpiresAt,omitempty"`
 }
@@ -0,0 +1,149 @@
+package apicontract
+
+import (
+	"time"
+
+	coreapicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api"
+	enumcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
+)
+
+type GetOAuthAuthorizationURLRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct {
+			Provider enumcontract.OAuthProvider `json:"provider" validate:"required,oneof=Google GitHub Meta OIDC"`
+		},
+		struct{},
+		struct{},
+	]
+}
+
+// The state must be sent back together with the authorization code from the same
+// user agent before it expires, while the state token carries the PKCE code verifier
+// of the authorization and is only kept in an HttpOnly cookie by the gateway.
+type GetOAuthAuthorizationURLResponseDto struct {
+	Provider         enumcontract.OAuthProvider `json:"provider"`
+	AuthorizationURL string                     `json:"authorizationUrl"`
+	State            string                     `json:"state"`
+	StateToken       string                     `json:"stateToken,omitempty"`
+	ExpiresAt        time.Time                  `json:"expiresAt"`
+}
+
+/* ============================== GitHub ============================== */
+
+type RegisterViaGithubRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent       string `json:"userAgent" validate:"required,isuseragent"`
+			IpAddress       string `json:"ipAddress" validate:"omitempty,ip"`
+			OAuthStateToken string `json:"oauthStateToken" validate:"required"` // from the HttpOnly cookie set by GetOAuthAuthorizationURL
+		},
+		struct {
+			AuthorizationCode string `json:"authorizationCode" validate:"required"`
+			State             string `json:"state" validate:"required"`
+		},
+		struct{},
+		struct{},
+	]
+}
+
+type RegisterViaGithubResponseDto RegisterViaGoogleResponseDto
+
+type LoginViaGithubRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent       string `json:"userAgent" validate:"required,isuseragent"`
+			IpAddress       string `json:"ipAddress" validate:"omitempty,ip"`
+			OAuthStateToken string `json:"oauthStateToken" validate:"required"` // from the HttpOnly cookie set by GetOAuthAuthorizationURL
+		},
+		struct {
+			AuthorizationCode string  `json:"authorizationCode" validate:"required"`
+			State             string  `json:"state" validate:"required"`
+			DeviceLabel       *string `json:"deviceLabel" validate:"omitnil,min=1,max=64"`
+		},
+		struct{},
+		struct{},
+	]
+}
+
+type LoginViaGithubResponseDto LoginViaGoogleResponseDto
+
+/* ============================== Meta ============================== */
+
+type RegisterViaMetaRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent       string `json:"userAgent" validate:"required,isuseragent"`
+			IpAddress       string `json:"ipAddress" validate:"omitempty,ip"`
+			OAuthStateToken string `json:"oauthStateToken" validate:"required"` // from the HttpOnly cookie set by GetOAuthAuthorizationURL
+		},
+		struct {
+			AuthorizationCode string `json:"authorizationCode" validate:"required"`
+			State             string `json:"state" validate:"required"`
+		},
+		struct{},
+		struct{},
+	]
+}
+
+type RegisterViaMetaResponseDto RegisterViaGoogleResponseDto
+
+type LoginViaMetaRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent       string `json:"userAgent" validate:"required,isuseragent"`
+			IpAddress       string `json:"ipAddress" validate:"omitempty,ip"`
+			OAuthStateToken string `json:"oauthStateToken" validate:"required"` // from the HttpOnly cookie set by GetOAuthAuthorizationURL
+		},
+		struct {
+			AuthorizationCode string  `json:"authorizationCode" validate:"required"`
+			State             string  `json:"state" validate:"required"`
+			DeviceLabel       *string `json:"deviceLabel" validate:"omitnil,min=1,max=64"`
+		},
+		struct{},
+		struct{},
+	]
+}
+
+type LoginViaMetaResponseDto LoginViaGoogleResponseDto
+
+/* ============================== OpenID Connect ============================== */
+
+type RegisterViaOIDCRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent       string `json:"userAgent" validate:"required,isuseragent"`
+			IpAddress       string `json:"ipAddress" validate:"omitempty,ip"`
+			OAuthStateToken string `json:"oauthStateToken" validate:"required"` // from the HttpOnly cookie set by GetOAuthAuthorizationURL
+		},
+		struct {
+			AuthorizationCode string `json:"authorizationCode" validate:"required"`
+			State             string `json:"state" validate:"required"`
+		},
+		struct{},
+		struct{},
+	]
+}
+
+type RegisterViaOIDCResponseDto RegisterViaGoogleResponseDto
+
+type LoginViaOIDCRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent       string `json:"userAgent" validate:"required,isuseragent"`
+			IpAddress       string `json:"ipAddress" validate:"omitempty,ip"`
+			OAuthStateToken string `json:"oauthStateToken" validate:"required"` // from the HttpOnly cookie set by GetOAuthAuthorizationURL
+		},
+		struct {
+			AuthorizationCode string  `json:"authorizationCode" validate:"required"`
+			State             string  `json:"state" validate:"required"`
+			DeviceLabel       *string `json:"deviceLabel" validate:"omitnil,min=1,max=64"`
+		},
+		struct{},
+		struct{},
+	]
+}
+
+type LoginViaOIDCResponseDto LoginViaGoogleResponseDto
@@ -1,15 +1,31 @@
 package apicontract
 
 const (
-	RegisterOperation          = "auth.register"
-	RegisterViaGoogleOperation = "auth.register-via-google"
-	LoginOperation             = "auth.login"
-	LoginViaGoogleOperation    = "auth.login-via-google"
-	LogoutOperation            = "auth.logout"
-	SendAuthCodeOperation      = "auth.send-auth-code"
-	ValidateEmailOperation     = "auth.validate-email"
-	ResetEmailOperation        = "auth.reset-email"
-	ForgetPasswordOperation    = "auth.forget-password"
-	ResetMeOperation           = "auth.reset-me"
-	DeleteMeOperation          = "auth.delete-me"
+	RegisterOperation                           = "auth.register"
+	RegisterViaGoogleOperation                  = "auth.register-via-google"
+	LoginOperation                              = "auth.login"
+	LoginViaGoogleOperation                     = "auth.login-via-google"
+	LogoutOperation                             = "auth.logout"
+	SendAuthCodeOperation                       = "auth.send-auth-code"
+	ValidateEmailOperation                      = "auth.validate-email"
+	ResetEmailOperation                         = "auth.reset-email"
+	ForgetPasswordOperation                     = "auth.forget-password"
+	ResetMeOperation                            = "auth.reset-me"
+	DeleteMeOperation                           = "auth.delete-me"
+	GetMySessionsOperation                      = "auth.get-my-sessions"
+	RevokeMySessionOperation                    = "auth.revoke-my-session"
+	RevokeMyOtherSessionsOperation              = "auth.revoke-my-other-sessions"
+	GetMyTwoFactorOperation                     = "auth.get-my-two-factor"
+	EnrollMyTwoFactorOperation                  = "auth.enroll-my-two-factor"
+	EnableMyTwoFactorOperation                  = "auth.enable-my-two-factor"
+	DisableMyTwoFactorOperation                 = "auth.disable-my-two-factor"
+	RegenerateMyTwoFactorRecoveryCodesOperation = "auth.regenerate-my-two-factor-recovery-codes"
+	VerifyLoginTwoFactorOperation               = "auth.verify-login-two-factor"
+	GetOAuthAuthorizationURLOperation           = "auth.get-oauth-authorization-url"
+	RegisterViaGithubOperation                  = "auth.register-via-github"
+	LoginViaGithubOperation                     = "auth.login-via-github"
+	RegisterViaMetaOperation                    = "auth.register-via-meta"
+	LoginViaMetaOperation                       = "auth.login-via-meta"
+	RegisterViaOIDCOperation                    = "auth.register-via-oidc"
+	LoginViaOIDCOperation                       = "auth.login-via-oidc"
 )
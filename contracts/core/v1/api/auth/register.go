@@ -12,6 +12,7 @@ type RegisterRequestDto struct {
 	coreapicontract.RequestDto[
 		struct {
 			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+			IpAddress string `json:"ipAddress" validate:"omitempty,ip"`
 		},
 		struct {
 			Name     string `json:"name" validate:"required,min=6,max=32,alphaandnum"`
@@ -37,10 +38,13 @@ type RegisterResponseDto struct {
 type RegisterViaGoogleRequestDto struct {
 	coreapicontract.RequestDto[
 		struct {
-			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+			UserAgent       string `json:"userAgent" validate:"required,isuseragent"`
+			IpAddress       string `json:"ipAddress" validate:"omitempty,ip"`
+			OAuthStateToken string `json:"oauthStateToken" validate:"required"` // from the HttpOnly cookie set by GetOAuthAuthorizationURL
 		},
 		struct {
 			AuthorizationCode string `json:"authorizationCode" validate:"required"`
+			State             string `json:"state" validate:"required"`
 		},
 		struct{},
 		struct{},
@@ -56,4 +60,10 @@ type RegisterViaGoogleResponseDto struct {
 	RefreshToken string    `json:"refreshToken"`
 	CSRFToken    string    `json:"csrfToken"`
 	CreatedAt    time.Time `json:"createdAt"`
+
+	// only set when the Google account belongs to an existing user who has enabled the
+	// two-factor authentication, see LoginViaGoogleResponseDto
+	TwoFactorRequired           bool       `json:"twoFactorRequired"`
+	TwoFactorChallengeToken     string     `json:"twoFactorChallengeToken,omitempty"`
+	TwoFactorChallengeExpiresAt *time.Time `json:"twoFactorChallengeExpiresAt,omitempty"`
 }
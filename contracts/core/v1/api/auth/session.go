@@ -0,0 +1,65 @@
+package apicontract
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	coreapicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api"
+)
+
+type SessionResponseDto struct {
+	Id          uuid.UUID  `json:"id"`
+	DeviceLabel string     `json:"deviceLabel"`
+	UserAgent   string     `json:"userAgent"`
+	IpAddress   string     `json:"ipAddress"`
+	IsCurrent   bool       `json:"isCurrent"`
+	LastSeenAt  time.Time  `json:"lastSeenAt"`
+	ExpiresAt   time.Time  `json:"expiresAt"`
+	RevokedAt   *time.Time `json:"revokedAt"`
+	CreatedAt   time.Time  `json:"createdAt"`
+}
+
+type GetMySessionsRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct{},
+		struct{},
+		struct{},
+	]
+}
+
+type GetMySessionsResponseDto []SessionResponseDto
+
+type RevokeMySessionRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct{},
+		struct {
+			SessionId uuid.UUID `json:"sessionId" validate:"required"`
+		},
+		struct{},
+	]
+}
+
+type RevokeMySessionResponseDto = SessionResponseDto
+
+type RevokeMyOtherSessionsRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct{},
+		struct{},
+		struct{},
+	]
+}
+
+type RevokeMyOtherSessionsResponseDto struct {
+	RevokedCount int64     `json:"revokedCount"`
+	RevokedAt    time.Time `json:"revokedAt"`
+}
@@ -0,0 +1,117 @@
+package apicontract
+
+import (
+	"time"
+
+	coreapicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api"
+)
+
+type GetMyTwoFactorRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct{},
+		struct{},
+		struct{},
+	]
+}
+
+type GetMyTwoFactorResponseDto struct {
+	IsEnabled                  bool       `json:"isEnabled"`
+	IsPending                  bool       `json:"isPending"`
+	RemainingRecoveryCodeCount int        `json:"remainingRecoveryCodeCount"`
+	EnabledAt                  *time.Time `json:"enabledAt"`
+}
+
+type EnrollMyTwoFactorRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct{},
+		struct{},
+		struct{},
+	]
+}
+
+// The secret is only returned by the enrollment, clients render the provisioning URI
+// as a QR code and may show the secret for the authenticator apps without a camera.
+type EnrollMyTwoFactorResponseDto struct {
+	Secret          string    `json:"secret"`
+	ProvisioningURI string    `json:"provisioningUri"`
+	CreatedAt       time.Time `json:"createdAt"`
+}
+
+type EnableMyTwoFactorRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct {
+			Code string `json:"code" validate:"required,isnumberstring,len=6"`
+		},
+		struct{},
+		struct{},
+	]
+}
+
+// The recovery codes are only returned once, and each of them can be used once
+// in place of a TOTP code.
+type EnableMyTwoFactorResponseDto struct {
+	RecoveryCodes []string  `json:"recoveryCodes"`
+	EnabledAt     time.Time `json:"enabledAt"`
+}
+
+type DisableMyTwoFactorRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct {
+			AuthCode string `json:"authCode" validate:"required,isnumberstring,len=6"`
+			Code     string `json:"code" validate:"required,min=6,max=16"`
+		},
+		struct{},
+		struct{},
+	]
+}
+
+type DisableMyTwoFactorResponseDto struct {
+	DisabledAt time.Time `json:"disabledAt"`
+}
+
+type RegenerateMyTwoFactorRecoveryCodesRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct {
+			Code string `json:"code" validate:"required,isnumberstring,len=6"`
+		},
+		struct{},
+		struct{},
+	]
+}
+
+type RegenerateMyTwoFactorRecoveryCodesResponseDto struct {
+	RecoveryCodes []string  `json:"recoveryCodes"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
+
+type VerifyLoginTwoFactorRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+			IpAddress string `json:"ipAddress" validate:"omitempty,ip"`
+		},
+		struct {
+			ChallengeToken string `json:"challengeToken" validate:"required"`
+			Code           string `json:"code" validate:"required,min=6,max=16"` // either a TOTP code or a recovery code
+		},
+		struct{},
+		struct{},
+	]
+}
+
+type VerifyLoginTwoFactorResponseDto = LoginResponseDto
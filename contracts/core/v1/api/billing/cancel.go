@@ -0,0 +1,22 @@
+package apicontract
+
+import (
+	coreapicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api"
+)
+
+type CancelMySubscriptionRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct {
+			Reason *string `json:"reason" validate:"omitnil,min=1,max=127"`
+		},
+		struct{},
+		struct{},
+	]
+}
+
+type CancelMySubscriptionResponseDto struct {
+	Subscription SubscriptionResponseDto `json:"subscription"`
+}
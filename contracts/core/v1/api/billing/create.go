@@ -0,0 +1,25 @@
+package apicontract
+
+import (
+	coreapicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api"
+)
+
+type CreateMySubscriptionRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct {
+			BillingPlanId string `json:"billingPlanId" validate:"required,max=64"`
+		},
+		struct{},
+		struct{},
+	]
+}
+
+// CreateMySubscriptionResponseDto carries the URL where the user approves the subscription
+// at the billing provider, the plan of the user is only upgraded after the approval.
+type CreateMySubscriptionResponseDto struct {
+	Subscription SubscriptionResponseDto `json:"subscription"`
+	ApprovalURL  string                  `json:"approvalUrl"`
+}
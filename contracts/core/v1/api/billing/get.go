@@ -0,0 +1,90 @@
+package apicontract
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	coreapicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api"
+	enumcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
+)
+
+type BillingPlanResponseDto struct {
+	Id           string                             `json:"id"`
+	Name         enumcontract.BillingPlanName       `json:"name"`
+	Plan         enumcontract.UserPlan              `json:"plan"`
+	IntervalUnit enumcontract.BillingIntervalUnit   `json:"intervalUnit"`
+	Price        float64                            `json:"price"`
+	CurrencyCode enumcontract.SupportedCurrencyCode `json:"currencyCode"`
+}
+
+type SubscriptionResponseDto struct {
+	Id                uuid.UUID                              `json:"id"`
+	BillingPlan       BillingPlanResponseDto                 `json:"billingPlan"`
+	Status            enumcontract.UsersToBillingPlansStatus `json:"status"`
+	StartDate         time.Time                              `json:"startDate"`
+	EndDate           *time.Time                             `json:"endDate"`
+	NextBillingDate   *time.Time                             `json:"nextBillingDate"`
+	GracePeriodEndsAt *time.Time                             `json:"gracePeriodEndsAt"`
+	FailureCount      int32                                  `json:"failureCount"`
+	CreatedAt         time.Time                              `json:"createdAt"`
+	UpdatedAt         time.Time                              `json:"updatedAt"`
+}
+
+type BillingInvoiceResponseDto struct {
+	Id             uuid.UUID                          `json:"id"`
+	SubscriptionId uuid.UUID                          `json:"subscriptionId"`
+	BillingPlanId  string                             `json:"billingPlanId"`
+	Amount         string                             `json:"amount"` // a decimal string in the major unit of the currency, e.g. "9.99"
+	CurrencyCode   enumcontract.SupportedCurrencyCode `json:"currencyCode"`
+	Status         enumcontract.BillingInvoiceStatus  `json:"status"`
+	PaidAt         time.Time                          `json:"paidAt"`
+	RefundedAt     *time.Time                         `json:"refundedAt"`
+}
+
+type GetBillingPlansRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct{},
+		struct{},
+		struct{},
+	]
+}
+
+type GetBillingPlansResponseDto []BillingPlanResponseDto
+
+type GetMySubscriptionRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct{},
+		struct{},
+		struct{},
+	]
+}
+
+// GetMySubscriptionResponseDto carries the plan the user is currently entitled to, which may
+// still be a paid plan after the subscription is cancelled or while its payment is failing.
+type GetMySubscriptionResponseDto struct {
+	Plan         enumcontract.UserPlan    `json:"plan"`
+	Subscription *SubscriptionResponseDto `json:"subscription"`
+}
+
+type GetMyBillingInvoicesRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct{},
+		struct {
+			Limit  int `json:"limit" form:"limit" validate:"omitempty,min=1,max=100"`
+			Offset int `json:"offset" form:"offset" validate:"omitempty,min=0"`
+		},
+		struct{},
+	]
+}
+
+type GetMyBillingInvoicesResponseDto []BillingInvoiceResponseDto
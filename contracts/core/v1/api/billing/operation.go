@@ -0,0 +1,11 @@
+package apicontract
+
+const (
+	GetBillingPlansOperation          = "billing.get-billing-plans"
+	GetMySubscriptionOperation        = "billing.get-my-subscription"
+	CreateMySubscriptionOperation     = "billing.create-my-subscription"
+	ChangeMySubscriptionPlanOperation = "billing.change-my-subscription-plan"
+	CancelMySubscriptionOperation     = "billing.cancel-my-subscription"
+	GetMyBillingInvoicesOperation     = "billing.get-my-billing-invoices"
+	HandlePayPalWebhookOperation      = "billing.handle-paypal-webhook"
+)
@@ -0,0 +1,25 @@
+package apicontract
+
+import (
+	coreapicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api"
+)
+
+type ChangeMySubscriptionPlanRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct {
+			BillingPlanId string `json:"billingPlanId" validate:"required,max=64"`
+		},
+		struct{},
+		struct{},
+	]
+}
+
+// ChangeMySubscriptionPlanResponseDto carries the URL where the user approves the plan change,
+// the subscription keeps its current billing plan until the provider confirms the change.
+type ChangeMySubscriptionPlanResponseDto struct {
+	Subscription SubscriptionResponseDto `json:"subscription"`
+	ApprovalURL  string                  `json:"approvalUrl"`
+}
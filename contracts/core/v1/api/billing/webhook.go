@@ -0,0 +1,32 @@
+package apicontract
+
+import (
+	coreapicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api"
+)
+
+// HandlePayPalWebhookRequestDto forwards a webhook delivery of PayPal as it was received,
+// the payload is kept as the raw body since the signature is verified against it.
+type HandlePayPalWebhookRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			AuthAlgo         string `json:"authAlgo" validate:"required,max=64"`
+			CertURL          string `json:"certUrl" validate:"required,url,max=2048"`
+			TransmissionId   string `json:"transmissionId" validate:"required,max=128"`
+			TransmissionSig  string `json:"transmissionSig" validate:"required,max=2048"`
+			TransmissionTime string `json:"transmissionTime" validate:"required,max=64"`
+		},
+		struct {
+			Payload string `json:"payload" validate:"required,max=1048576"`
+		},
+		struct{},
+		struct{},
+	]
+}
+
+// HandlePayPalWebhookResponseDto reports whether the event changed anything, a redelivered
+// or an unsupported event is acknowledged without being processed again.
+type HandlePayPalWebhookResponseDto struct {
+	EventId   string `json:"eventId"`
+	EventType string `json:"eventType"`
+	Processed bool   `json:"processed"`
+}
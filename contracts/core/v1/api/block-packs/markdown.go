@@ -0,0 +1,51 @@
+package apicontract
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	coreapicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api"
+	coretypes "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/types/block-packs"
+)
+
+type GetMyBlockPackMarkdownByIdRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct{},
+		struct {
+			BlockPackId uuid.UUID `json:"blockPackId" validate:"required"`
+		},
+		struct{},
+	]
+}
+
+type GetMyBlockPackMarkdownByIdResponseDto struct {
+	Id         uuid.UUID `json:"id"`
+	Name       string    `json:"name"`
+	Markdown   string    `json:"markdown"`
+	BlockCount int64     `json:"blockCount"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+type CreateBlockPackFromMarkdownRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct {
+			coretypes.CreatableBlockPack
+			Markdown string `json:"markdown" validate:"max=1048576"` // 1 MiB
+		},
+		struct{},
+		struct{},
+	]
+}
+
+type CreateBlockPackFromMarkdownResponseDto struct {
+	Id         uuid.UUID `json:"id"`
+	BlockCount int64     `json:"blockCount"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
@@ -5,8 +5,10 @@ const (
 	GetMyBlockPackAndItsParentByIdOperation      = "block-pack.get-and-parent-by-id"
 	GetMyBlockPacksByParentSubShelfIdOperation   = "block-pack.get-by-parent-sub-shelf-id"
 	GetAllMyBlockPacksByRootShelfIdOperation     = "block-pack.get-all-by-root-shelf-id"
+	GetMyBlockPackMarkdownByIdOperation          = "block-pack.get-markdown-by-id"
 	CreateBlockPackOperation                     = "block-pack.create"
 	CreateBlockPacksOperation                    = "block-pack.create-many"
+	CreateBlockPackFromMarkdownOperation         = "block-pack.create-from-markdown"
 	UpdateMyBlockPackByIdOperation               = "block-pack.update"
 	UpdateMyBlockPacksByIdsOperation             = "block-pack.update-many"
 	MoveMyBlockPackByParentSubShelfIdOperation   = "block-pack.move"
@@ -16,5 +18,9 @@ const (
 	RestoreMyBlockPacksByIdsOperation            = "block-pack.restore-many"
 	DeleteMyBlockPackByIdOperation               = "block-pack.delete"
 	DeleteMyBlockPacksByIdsOperation             = "block-pack.delete-many"
+	CreateMyBlockPackSnapshotByIdOperation       = "block-pack.create-snapshot"
+	GetMyBlockPackSnapshotsByIdOperation         = "block-pack.get-snapshots"
+	GetMyBlockPackSnapshotDiffByIdOperation      = "block-pack.get-snapshot-diff"
+	RestoreMyBlockPackSnapshotByIdOperation      = "block-pack.restore-snapshot"
 	SearchBlockPacksOperation                    = "graphql.search-block-packs"
 )
@@ -0,0 +1,118 @@
+package apicontract
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	coreapicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api"
+	enumcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
+)
+
+type BlockPackSnapshotResponseDto struct {
+	Id             uuid.UUID                          `json:"id"`
+	BlockPackId    uuid.UUID                          `json:"blockPackId"`
+	Kind           enumcontract.BlockPackSnapshotKind `json:"kind"`
+	Name           *string                            `json:"name"`
+	UpdateSequence int64                              `json:"updateSequence"`
+	CreatorId      *uuid.UUID                         `json:"creatorId"`
+	CreatedAt      time.Time                          `json:"createdAt"`
+}
+
+type CreateMyBlockPackSnapshotByIdRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct {
+			Name string `json:"name" validate:"required,min=1,max=128"`
+		},
+		struct {
+			BlockPackId uuid.UUID `json:"blockPackId" validate:"required"`
+		},
+		struct{},
+	]
+}
+
+type CreateMyBlockPackSnapshotByIdResponseDto = BlockPackSnapshotResponseDto
+
+type GetMyBlockPackSnapshotsByIdRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct{},
+		struct {
+			BlockPackId uuid.UUID `json:"blockPackId" validate:"required"`
+		},
+		struct{},
+	]
+}
+
+type GetMyBlockPackSnapshotsByIdResponseDto []BlockPackSnapshotResponseDto
+
+type GetMyBlockPackSnapshotDiffByIdRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct{},
+		struct {
+			BlockPackId    uuid.UUID  `json:"blockPackId" validate:"required"`
+			FromSnapshotId uuid.UUID  `json:"fromSnapshotId" validate:"required"`
+			ToSnapshotId   *uuid.UUID `json:"toSnapshotId" validate:"omitnil"` // compare with the current document if it is nil
+		},
+		struct{},
+	]
+}
+
+type BlockPackSnapshotDiffBlockDto struct {
+	Id            uuid.UUID              `json:"id"`
+	ParentBlockId *uuid.UUID             `json:"parentBlockId"`
+	PrevBlockId   *uuid.UUID             `json:"prevBlockId"`
+	Type          enumcontract.BlockType `json:"type"`
+	Props         json.RawMessage        `json:"props"`
+	Content       json.RawMessage        `json:"content"`
+}
+
+type BlockPackSnapshotChangedBlockDto struct {
+	Id                uuid.UUID                     `json:"id"`
+	IsContentChanged  bool                          `json:"isContentChanged"`
+	IsPositionChanged bool                          `json:"isPositionChanged"`
+	Before            BlockPackSnapshotDiffBlockDto `json:"before"`
+	After             BlockPackSnapshotDiffBlockDto `json:"after"`
+}
+
+type GetMyBlockPackSnapshotDiffByIdResponseDto struct {
+	BlockPackId        uuid.UUID                          `json:"blockPackId"`
+	FromSnapshotId     uuid.UUID                          `json:"fromSnapshotId"`
+	ToSnapshotId       *uuid.UUID                         `json:"toSnapshotId"`
+	FromUpdateSequence int64                              `json:"fromUpdateSequence"`
+	ToUpdateSequence   int64                              `json:"toUpdateSequence"`
+	AddedBlocks        []BlockPackSnapshotDiffBlockDto    `json:"addedBlocks"`
+	RemovedBlocks      []BlockPackSnapshotDiffBlockDto    `json:"removedBlocks"`
+	ChangedBlocks      []BlockPackSnapshotChangedBlockDto `json:"changedBlocks"`
+}
+
+type RestoreMyBlockPackSnapshotByIdRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct{},
+		struct {
+			BlockPackId uuid.UUID `json:"blockPackId" validate:"required"`
+			SnapshotId  uuid.UUID `json:"snapshotId" validate:"required"`
+		},
+		struct{},
+	]
+}
+
+type RestoreMyBlockPackSnapshotByIdResponseDto struct {
+	BlockPackId          uuid.UUID `json:"blockPackId"`
+	SnapshotId           uuid.UUID `json:"snapshotId"`
+	PreRestoreSnapshotId uuid.UUID `json:"preRestoreSnapshotId"` // the snapshot of the document right before the restore
+	UpdateSequence       int64     `json:"updateSequence"`
+	RestoredAt           time.Time `json:"restoredAt"`
+}
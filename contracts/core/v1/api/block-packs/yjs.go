@@ -10,3 +10,21 @@ type InitializeBlockPackYjsDocumentResDto struct {
 	Snapshot    []byte `json:"snapshot"`
 	StateVector []byte `json:"stateVector"`
 }
+
+type ProjectBlockPackYjsDocumentReqDto struct {
+	State []byte `json:"state"` // the marshaled YjsDocumentState
+}
+
+type ProjectBlockPackYjsDocumentResDto struct {
+	Blocks []blocknote.ArborizedEditableBlock `json:"blocks"`
+}
+
+type RestoreBlockPackYjsDocumentReqDto struct {
+	CurrentState []byte `json:"currentState"`
+	TargetState  []byte `json:"targetState"`
+}
+
+type RestoreBlockPackYjsDocumentResDto struct {
+	Update     []byte `json:"update"` // the Yjs update which turns the current document into the target one
+	BlockCount int64  `json:"blockCount"`
+}
@@ -1,3 +1,6 @@
 package apicontract
 
-const SearchItemsOperation = "graphql.search-items"
+const (
+	SearchItemsOperation = "graphql.search-items"
+	GetMyTrashOperation  = "graphql.get-my-trash"
+)
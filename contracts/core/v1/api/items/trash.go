@@ -0,0 +1,6 @@
+package apicontract
+
+import gqlmodels "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/graphql/models"
+
+type GetMyTrashRequestDto = gqlmodels.GetMyTrashInput
+type GetMyTrashResponseDto = gqlmodels.MyTrash
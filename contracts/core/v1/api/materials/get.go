@@ -10,16 +10,18 @@ import (
 )
 
 type MaterialResponseDto struct {
-	Id               uuid.UUID                        `json:"id"`
-	ParentSubShelfId uuid.UUID                        `json:"parentSubShelfId"`
-	Name             string                           `json:"name"`
-	Size             int64                            `json:"size"`
-	ContentType      enumcontract.MaterialContentType `json:"contentType"`
-	ParseMediaType   string                           `json:"parseMediaType"`
-	DownloadURL      string                           `json:"downloadURL"`
-	DeletedAt        *time.Time                       `json:"deletedAt"`
-	UpdatedAt        time.Time                        `json:"updatedAt"`
-	CreatedAt        time.Time                        `json:"createdAt"`
+	Id               uuid.UUID                             `json:"id"`
+	ParentSubShelfId uuid.UUID                             `json:"parentSubShelfId"`
+	Name             string                                `json:"name"`
+	Size             int64                                 `json:"size"`
+	ContentType      enumcontract.MaterialContentType      `json:"contentType"`
+	ParseMediaType   string                                `json:"parseMediaType"`
+	DownloadURL      string                                `json:"downloadURL"`
+	ProcessingStatus enumcontract.MaterialProcessingStatus `json:"processingStatus"`
+	PreviewURL       *string                               `json:"previewURL"` // only presigned once a preview has been generated
+	DeletedAt        *time.Time                            `json:"deletedAt"`
+	UpdatedAt        time.Time                             `json:"updatedAt"`
+	CreatedAt        time.Time                             `json:"createdAt"`
 }
 
 type GetMyMaterialByIdRequestDto struct {
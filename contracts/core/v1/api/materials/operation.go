@@ -8,6 +8,10 @@ const (
 	CreateMyMaterialOperation                 = "material.create"
 	UpdateMyMaterialByIdOperation             = "material.update"
 	SaveMyMaterialByIdOperation               = "material.save"
+	InitiateMyMaterialUploadOperation         = "material.initiate-upload"
+	UploadMyMaterialPartOperation             = "material.upload-part"
+	CompleteMyMaterialUploadOperation         = "material.complete-upload"
+	AbortMyMaterialUploadOperation            = "material.abort-upload"
 	MoveMyMaterialByIdOperation               = "material.move"
 	MoveMyMaterialsByIdsOperation             = "material.move-many"
 	RestoreMyMaterialByIdOperation            = "material.restore"
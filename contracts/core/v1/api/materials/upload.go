@@ -0,0 +1,104 @@
+package apicontract
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	coreapicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api"
+	enumcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
+)
+
+type InitiateMyMaterialUploadRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct {
+			Size        int64                            `json:"size" validate:"required,min=1"`
+			ContentType enumcontract.MaterialContentType `json:"contentType" validate:"required"`
+		},
+		struct {
+			MaterialId uuid.UUID `json:"materialId" validate:"required"`
+		},
+		struct{},
+	]
+}
+
+type MaterialUploadPartResponseDto struct {
+	PartNumber int32  `json:"partNumber"`
+	UploadURL  string `json:"uploadURL"`
+}
+
+type InitiateMyMaterialUploadResponseDto struct {
+	UploadId  uuid.UUID                       `json:"uploadId"`
+	PartSize  int64                           `json:"partSize"`
+	Parts     []MaterialUploadPartResponseDto `json:"parts"`
+	ExpiresAt time.Time                       `json:"expiresAt"`
+}
+
+// the parts are only uploaded through here by the storages which can not presign the part uploads
+type UploadMyMaterialPartRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct {
+			Content []byte `json:"content" validate:"required"`
+		},
+		struct {
+			StorageUploadId string `json:"storageUploadId" validate:"required"`
+			PartNumber      int32  `json:"partNumber" validate:"required,min=1,max=10000"`
+		},
+		struct{},
+	]
+}
+
+type UploadMyMaterialPartResponseDto struct {
+	PartNumber int32  `json:"partNumber"`
+	ETag       string `json:"eTag"`
+}
+
+type CompleteMyMaterialUploadRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct {
+			Parts []struct {
+				PartNumber int32  `json:"partNumber" validate:"required,min=1"`
+				ETag       string `json:"eTag" validate:"required"`
+			} `json:"parts" validate:"required,min=1,max=10000,dive"`
+		},
+		struct {
+			MaterialId uuid.UUID `json:"materialId" validate:"required"`
+			UploadId   uuid.UUID `json:"uploadId" validate:"required"`
+		},
+		struct{},
+	]
+}
+
+type CompleteMyMaterialUploadResponseDto struct {
+	Size           int64                            `json:"size"`
+	ContentType    enumcontract.MaterialContentType `json:"contentType"`
+	ParseMediaType string                           `json:"parseMediaType"`
+	UpdatedAt      time.Time                        `json:"updatedAt"`
+}
+
+type AbortMyMaterialUploadRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct{},
+		struct {
+			MaterialId uuid.UUID `json:"materialId" validate:"required"`
+			UploadId   uuid.UUID `json:"uploadId" validate:"required"`
+		},
+		struct{},
+	]
+}
+
+type AbortMyMaterialUploadResponseDto struct {
+	AbortedAt time.Time `json:"abortedAt"`
+}
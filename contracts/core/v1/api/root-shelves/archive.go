@@ -0,0 +1,79 @@
+package apicontract
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	coreapicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api"
+	enums "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
+)
+
+type ExportMyRootShelfArchiveByIdRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct{},
+		struct {
+			RootShelfId uuid.UUID `json:"rootShelfId" validate:"required"`
+		},
+		struct{},
+	]
+}
+
+type ExportMyRootShelfArchiveByIdResponseDto struct {
+	ArchiveJobId uuid.UUID                       `json:"archiveJobId"`
+	Status       enums.RootShelfArchiveJobStatus `json:"status"`
+	CreatedAt    time.Time                       `json:"createdAt"`
+}
+
+type CreateRootShelfFromArchiveRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct {
+			ArchiveFile       []byte     `json:"archiveFile" validate:"required"`
+			TargetRootShelfId *uuid.UUID `json:"targetRootShelfId" validate:"omitnil"`
+			Name              *string    `json:"name" validate:"omitnil,min=1,max=128,isshelfname"`
+		},
+		struct{},
+		struct{},
+	]
+}
+
+type CreateRootShelfFromArchiveResponseDto struct {
+	ArchiveJobId uuid.UUID                       `json:"archiveJobId"`
+	Status       enums.RootShelfArchiveJobStatus `json:"status"`
+	CreatedAt    time.Time                       `json:"createdAt"`
+}
+
+type GetMyRootShelfArchiveJobByIdRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct{},
+		struct {
+			ArchiveJobId uuid.UUID `json:"archiveJobId" validate:"required"`
+		},
+		struct{},
+	]
+}
+
+type GetMyRootShelfArchiveJobByIdResponseDto struct {
+	Id                 uuid.UUID                       `json:"id"`
+	Type               enums.RootShelfArchiveJobType   `json:"type"`
+	Status             enums.RootShelfArchiveJobStatus `json:"status"`
+	RootShelfId        *uuid.UUID                      `json:"rootShelfId"` // the exported root shelf, or the root shelf receiving the imported tree
+	ProcessedItemCount int64                           `json:"processedItemCount"`
+	TotalItemCount     int64                           `json:"totalItemCount"`
+	ProgressPercent    int32                           `json:"progressPercent"`
+	DownloadURL        *string                         `json:"downloadURL"` // only available once an export job is succeeded
+	ErrorMessage       *string                         `json:"errorMessage"`
+	StartedAt          *time.Time                      `json:"startedAt"`
+	CompletedAt        *time.Time                      `json:"completedAt"`
+	UpdatedAt          time.Time                       `json:"updatedAt"`
+	CreatedAt          time.Time                       `json:"createdAt"`
+}
@@ -20,5 +20,8 @@ const (
 	DeleteMyRootShelfPermissionsOperation = "root-shelf.permission.delete-many"
 	LeaveMyRootShelfOperation             = "root-shelf.membership.leave"
 	LeaveMyRootShelvesOperation           = "root-shelf.membership.leave-many"
+	ExportMyRootShelfArchiveByIdOperation = "root-shelf.get-archive-by-id"
+	CreateRootShelfFromArchiveOperation   = "root-shelf.create-from-archive"
+	GetMyRootShelfArchiveJobByIdOperation = "root-shelf.get-archive-job-by-id"
 	SearchRootShelvesOperation            = "graphql.search-root-shelves"
 )
@@ -21,6 +21,7 @@ type RoutineTaskResponseDto struct {
 	Attempts        int32                           `json:"attempts"`
 	MaxAttempts     int32                           `json:"maxAttempts"`
 	Period          *enumcontract.RoutinePeriod     `json:"period"`
+	RecurrenceRule  *string                         `json:"recurrenceRule"`
 	NextScheduledAt time.Time                       `json:"nextScheduledAt"`
 	ScheduledAt     time.Time                       `json:"scheduledAt"`
 	ActualStartedAt *time.Time                      `json:"actualStartedAt"`
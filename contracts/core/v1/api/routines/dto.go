@@ -18,6 +18,7 @@ type RoutineResponseDto struct {
 	ScheduledStartAt time.Time                   `json:"scheduledStartAt"`
 	ScheduledEndAt   time.Time                   `json:"scheduledEndAt"`
 	Period           *enumcontract.RoutinePeriod `json:"period"`
+	RecurrenceRule   *string                     `json:"recurrenceRule"`
 	Timezone         string                      `json:"timezone"`
 	DeletedAt        *time.Time                  `json:"deletedAt"`
 	UpdatedAt        time.Time                   `json:"updatedAt"`
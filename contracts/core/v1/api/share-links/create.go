@@ -0,0 +1,34 @@
+package apicontract
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	coreapicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api"
+)
+
+type CreateMyShareLinkRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct {
+			RootShelfId uuid.UUID  `json:"rootShelfId" validate:"required"`
+			BlockPackId *uuid.UUID `json:"blockPackId" validate:"omitnil"`
+			Permission  string     `json:"permission" validate:"required,oneof=Read"` // a guest session never edits the shared resources
+			Password    *string    `json:"password" validate:"omitnil,min=4,max=72"`
+			ExpiresAt   *time.Time `json:"expiresAt" validate:"omitnil"`
+			MaxUseCount *int32     `json:"maxUseCount" validate:"omitnil,min=1,max=100000"`
+		},
+		struct{},
+		struct{},
+	]
+}
+
+// CreateMyShareLinkResponseDto is the only response carrying the token of the share link,
+// only the digest of the token is stored, so a lost token can only be replaced by a new share link.
+type CreateMyShareLinkResponseDto struct {
+	ShareLinkResponseDto
+	Token string `json:"token"`
+}
@@ -0,0 +1,39 @@
+package apicontract
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	coreapicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api"
+)
+
+type ShareLinkResponseDto struct {
+	Id                 uuid.UUID  `json:"id"`
+	RootShelfId        uuid.UUID  `json:"rootShelfId"`
+	BlockPackId        *uuid.UUID `json:"blockPackId"`
+	Permission         string     `json:"permission"`
+	IsPasswordRequired bool       `json:"isPasswordRequired"`
+	MaxUseCount        *int32     `json:"maxUseCount"`
+	UseCount           int32      `json:"useCount"`
+	ExpiresAt          *time.Time `json:"expiresAt"`
+	RevokedAt          *time.Time `json:"revokedAt"`
+	LastUsedAt         *time.Time `json:"lastUsedAt"`
+	CreatorId          uuid.UUID  `json:"creatorId"`
+	CreatedAt          time.Time  `json:"createdAt"`
+}
+
+type GetMyShareLinksByRootShelfIdRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct{},
+		struct {
+			RootShelfId uuid.UUID `json:"rootShelfId" validate:"required"`
+		},
+		struct{},
+	]
+}
+
+type GetMyShareLinksByRootShelfIdResponseDto []ShareLinkResponseDto
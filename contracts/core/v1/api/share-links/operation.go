@@ -0,0 +1,8 @@
+package apicontract
+
+const (
+	CreateMyShareLinkOperation            = "share-link.create"
+	GetMyShareLinksByRootShelfIdOperation = "share-link.get-many-by-root-shelf-id"
+	RevokeMyShareLinkByIdOperation        = "share-link.revoke"
+	ResolveShareLinkOperation             = "share-link.resolve"
+)
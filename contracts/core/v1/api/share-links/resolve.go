@@ -0,0 +1,34 @@
+package apicontract
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	coreapicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api"
+)
+
+type ResolveShareLinkRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct {
+			Token    string  `json:"token" validate:"required,startswith=nzs_,max=128"`
+			Password *string `json:"password" validate:"omitnil,max=72"`
+		},
+		struct{},
+		struct{},
+	]
+}
+
+// ResolveShareLinkResponseDto describes the guest session of the share link, the session
+// acts with the permission of the share link and ends no later than the share link expires.
+type ResolveShareLinkResponseDto struct {
+	ShareSessionToken string     `json:"shareSessionToken"`
+	ExpiresAt         time.Time  `json:"expiresAt"`
+	ShareLinkId       uuid.UUID  `json:"shareLinkId"`
+	RootShelfId       uuid.UUID  `json:"rootShelfId"`
+	BlockPackId       *uuid.UUID `json:"blockPackId"`
+	Permission        string     `json:"permission"`
+}
@@ -0,0 +1,23 @@
+package apicontract
+
+import (
+	"github.com/google/uuid"
+
+	coreapicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api"
+)
+
+type RevokeMyShareLinkByIdRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct{},
+		struct {
+			RootShelfId uuid.UUID `json:"rootShelfId" validate:"required"`
+			ShareLinkId uuid.UUID `json:"shareLinkId" validate:"required"`
+		},
+		struct{},
+	]
+}
+
+type RevokeMyShareLinkByIdResponseDto = ShareLinkResponseDto
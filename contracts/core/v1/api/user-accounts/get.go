@@ -22,6 +22,9 @@ type GetMyAccountResponseDto struct {
 	PhoneNumber              *string   `json:"phoneNumber"`
 	GoogleCredential         *string   `json:"googleCrendential"`
 	DiscordCredential        *string   `json:"discordCrendential"`
+	GithubCredential         *string   `json:"githubCredential"`
+	MetaCredential           *string   `json:"metaCredential"`
+	OIDCCredential           *string   `json:"oidcCredential"`
 	RootShelfCount           int64     `json:"rootShelfCount"`
 	BlockPackCount           int64     `json:"blockPackCount"`
 	BlockCount               int64     `json:"blockCount"`
@@ -9,10 +9,12 @@ import (
 type BindGoogleAccountRequestDto struct {
 	coreapicontract.RequestDto[
 		struct {
-			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+			UserAgent       string `json:"userAgent" validate:"required,isuseragent"`
+			OAuthStateToken string `json:"oauthStateToken" validate:"required"` // from the HttpOnly cookie set by GetOAuthAuthorizationURL
 		},
 		struct {
 			AuthorizationCode string `json:"authorizationCode" validate:"required"`
+			State             string `json:"state" validate:"required"`
 		},
 		struct{},
 		struct{},
@@ -0,0 +1,43 @@
+package apicontract
+
+import (
+	"time"
+
+	coreapicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api"
+)
+
+type BindMetaAccountRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent       string `json:"userAgent" validate:"required,isuseragent"`
+			OAuthStateToken string `json:"oauthStateToken" validate:"required"` // from the HttpOnly cookie set by GetOAuthAuthorizationURL
+		},
+		struct {
+			AuthorizationCode string `json:"authorizationCode" validate:"required"`
+			State             string `json:"state" validate:"required"`
+		},
+		struct{},
+		struct{},
+	]
+}
+
+type BindMetaAccountResponseDto struct {
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type UnbindMetaAccountRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct {
+			AuthCode string `json:"authCode" validate:"required"`
+		},
+		struct{},
+		struct{},
+	]
+}
+
+type UnbindMetaAccountResponseDto struct {
+	UpdatedAt time.Time `json:"updatedAt"`
+}
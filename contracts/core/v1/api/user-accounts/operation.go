@@ -5,4 +5,12 @@ const (
 	UpdateMyAccountOperation     = "user-account.update-my-account"
 	BindGoogleAccountOperation   = "user-account.bind-google-account"
 	UnbindGoogleAccountOperation = "user-account.unbind-google-account"
+	BindGithubAccountOperation   = "user-account.bind-github-account"
+	UnbindGithubAccountOperation = "user-account.unbind-github-account"
+	BindMetaAccountOperation     = "user-account.bind-meta-account"
+	UnbindMetaAccountOperation   = "user-account.unbind-meta-account"
+	BindOIDCAccountOperation     = "user-account.bind-oidc-account"
+	UnbindOIDCAccountOperation   = "user-account.unbind-oidc-account"
+	GetMyUsageOperation          = "user-account.get-my-usage"
+	GraphQLGetMyUsageOperation   = "graphql.get-my-usage"
 )
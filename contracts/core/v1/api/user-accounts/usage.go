@@ -0,0 +1,24 @@
+package apicontract
+
+import (
+	coreapicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api"
+	gqlmodels "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/graphql/models"
+)
+
+type GetMyUsageRequestDto struct {
+	coreapicontract.RequestDto[
+		struct {
+			UserAgent string `json:"userAgent" validate:"required,isuseragent"`
+		},
+		struct{},
+		struct{},
+		struct {
+			Days *int32 `json:"days" form:"days" validate:"omitnil,min=1,max=365"`
+		},
+	]
+}
+
+type GetMyUsageResponseDto = gqlmodels.MyUsage
+
+type GraphQLGetMyUsageRequestDto = gqlmodels.GetMyUsageInput
+type GraphQLGetMyUsageResponseDto = gqlmodels.MyUsage
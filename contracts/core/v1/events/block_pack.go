@@ -21,3 +21,8 @@ type BlockPackRoomPolicyChangedData struct {
 	RoomAdmissionEnforcementStrategy string `json:"roomAdmissionEnforcementStrategy"`
 	MaximumSubscribers               int32  `json:"maximumSubscribers"`
 }
+
+type BlockPackDocumentRestoredData struct {
+	SnapshotId     uuid.UUID `json:"snapshotId"`
+	UpdateSequence int64     `json:"updateSequence"` // the sequence of the update which restores the snapshot
+}
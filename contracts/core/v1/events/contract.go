@@ -6,12 +6,16 @@ const CoreLifecycleTopic eventcontract.Topic = "notegic.core.lifecycle.v1"
 
 const CoreDurableJobYjsMaintenanceHintTopic eventcontract.Topic = "notegic.core.durablejob.yjs-maintenance-hint.v1"
 
+const CoreDurableJobMaterialProcessingHintTopic eventcontract.Topic = "notegic.core.durablejob.material-processing-hint.v1"
+
 const (
 	AggregateType_RootShelf   eventcontract.AggregateType = "RootShelf"
 	AggregateType_SubShelf    eventcontract.AggregateType = "SubShelf"
 	AggregateType_BlockPack   eventcontract.AggregateType = "BlockPack"
+	AggregateType_Material    eventcontract.AggregateType = "Material"
 	AggregateType_RoutineTask eventcontract.AggregateType = "RoutineTask"
 	AggregateType_User        eventcontract.AggregateType = "User"
+	AggregateType_ShareLink   eventcontract.AggregateType = "ShareLink"
 )
 
 const (
@@ -22,8 +26,12 @@ const (
 	EventType_RootShelfDeleted           eventcontract.EventType = "RootShelfDeleted"
 	EventType_BlockPackChanged           eventcontract.EventType = "BlockPackChanged"
 	EventType_BlockPackDeleted           eventcontract.EventType = "BlockPackDeleted"
+	EventType_BlockPackDocumentRestored  eventcontract.EventType = "BlockPackDocumentRestored"
 	EventType_UserSessionsRevoked        eventcontract.EventType = "UserSessionsRevoked"
+	EventType_ShareLinkRevoked           eventcontract.EventType = "ShareLinkRevoked"
 	EventType_UserDeleted                eventcontract.EventType = "UserDeleted"
 	EventType_YjsMaintenanceHint         eventcontract.EventType = "YjsMaintenanceHint"
+	EventType_MaterialProcessingHint     eventcontract.EventType = "MaterialProcessingHint"
 	EventType_RoutineTaskCompleted       eventcontract.EventType = "RoutineTaskCompleted"
+	EventType_RoutineTaskFailed          eventcontract.EventType = "RoutineTaskFailed"
 )
@@ -0,0 +1,14 @@
+package eventscontract
+
+import (
+	"github.com/google/uuid"
+
+	enumcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
+)
+
+type MaterialProcessingHintData struct {
+	MaterialId  uuid.UUID                        `json:"materialId"`
+	ContentType enumcontract.MaterialContentType `json:"contentType"`
+	Size        int64                            `json:"size"`
+	Reason      string                           `json:"reason"`
+}
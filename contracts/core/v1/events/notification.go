@@ -34,12 +34,23 @@ const (
 )
 
 type NotificationRequestedData struct {
-	RecipientUserPublicId uuid.UUID            `json:"recipientUserPublicId"`
-	Type                  NotificationType     `json:"type"`
-	Priority              NotificationPriority `json:"priority"`
-	TemplateKey           string               `json:"templateKey"`
-	TemplateVersion       int                  `json:"templateVersion"`
-	Payload               json.RawMessage      `json:"payload"`
-	DedupeKey             string               `json:"dedupeKey"`
-	ExpiresAt             *time.Time           `json:"expiresAt,omitempty"`
+	RecipientUserPublicId uuid.UUID              `json:"recipientUserPublicId"`
+	Type                  NotificationType       `json:"type"`
+	Priority              NotificationPriority   `json:"priority"`
+	TemplateKey           string                 `json:"templateKey"`
+	TemplateVersion       int                    `json:"templateVersion"`
+	Payload               json.RawMessage        `json:"payload"`
+	DedupeKey             string                 `json:"dedupeKey"`
+	ExpiresAt             *time.Time             `json:"expiresAt,omitempty"`
+	Recipient             *NotificationRecipient `json:"recipient,omitempty"`
+}
+
+// NotificationRecipient snapshots the email address and quiet mode settings of the recipient when Core requests the
+// notification, since Notification owns no user data and plans the email deliveries from this snapshot
+type NotificationRecipient struct {
+	Email                string `json:"email"`
+	Name                 string `json:"name"`
+	QuietMode            bool   `json:"quietMode"`
+	QuietModeStartMinute int64  `json:"quietModeStartMinute"`
+	QuietModeEndMinute   int64  `json:"quietModeEndMinute"`
 }
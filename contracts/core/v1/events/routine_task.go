@@ -18,3 +18,19 @@ type RoutineTaskCompletedData struct {
 	Attempt             int32                    `json:"attempt"`
 	CompletedAt         time.Time                `json:"completedAt"`
 }
+
+// RoutineTaskFailedData is published on the notification topic for the owner of the station once a routine task
+// record fails, Notification keeps it until the next email digest of the owner, and the recipient snapshots the
+// email address of the owner like a notification request does
+type RoutineTaskFailedData struct {
+	RecipientUserPublicId uuid.UUID                        `json:"recipientUserPublicId"`
+	RoutineId             uuid.UUID                        `json:"routineId"`
+	RoutineTitle          string                           `json:"routineTitle"`
+	RoutineTaskId         uuid.UUID                        `json:"routineTaskId"`
+	RoutineTaskTitle      string                           `json:"routineTaskTitle"`
+	RoutineTaskRecordId   uuid.UUID                        `json:"routineTaskRecordId"`
+	Purpose               enums.RoutineTaskPurpose         `json:"purpose"`
+	ErrorCode             enums.RoutineTaskRecordErrorCode `json:"errorCode"`
+	FailedAt              time.Time                        `json:"failedAt"`
+	Recipient             *NotificationRecipient           `json:"recipient,omitempty"`
+}
@@ -0,0 +1,7 @@
+package eventscontract
+
+import "github.com/google/uuid"
+
+type ShareLinkRevokedData struct {
+	RootShelfId uuid.UUID `json:"rootShelfId"`
+}
@@ -33,14 +33,334 @@ type Config struct {
 }
 
 type ResolverRoot interface {
+	Mutation() MutationResolver
 	PublicUser() PublicUserResolver
 	Query() QueryResolver
+	Subscription() SubscriptionResolver
 }
 
 type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	BlockPackPayload struct {
+		BlockCount             func(childComplexity int) int
+		CompactedUntilSequence func(childComplexity int) int
+		CreatedAt              func(childComplexity int) int
+		DeletedAt              func(childComplexity int) int
+		HeaderBackgroundURL    func(childComplexity int) int
+		Icon                   func(childComplexity int) int
+		Id                     func(childComplexity int) int
+		IsProjectionCurrent    func(childComplexity int) int
+		LastUpdateSequence     func(childComplexity int) int
+		Name                   func(childComplexity int) int
+		ParentSubShelfId       func(childComplexity int) int
+		ProjectedUntilSequence func(childComplexity int) int
+		UpdatedAt              func(childComplexity int) int
+	}
+
+	CreateBlockPackPayload struct {
+		CreatedAt func(childComplexity int) int
+		Id        func(childComplexity int) int
+	}
+
+	CreateBlockPacksPayload struct {
+		CreatedAt func(childComplexity int) int
+		Ids       func(childComplexity int) int
+	}
+
+	CreateMaterialPayload struct {
+		CreatedAt func(childComplexity int) int
+		Id        func(childComplexity int) int
+	}
+
+	CreateRootShelfPayload struct {
+		CreatedAt      func(childComplexity int) int
+		Id             func(childComplexity int) int
+		LastAnalyzedAt func(childComplexity int) int
+	}
+
+	CreateRootShelvesPayload struct {
+		CreatedAt      func(childComplexity int) int
+		Ids            func(childComplexity int) int
+		LastAnalyzedAt func(childComplexity int) int
+	}
+
+	CreateRoutineByStationIdPayload struct {
+		CreatedAt func(childComplexity int) int
+		Id        func(childComplexity int) int
+	}
+
+	CreateRoutineTaskByRoutineIdPayload struct {
+		CreatedAt func(childComplexity int) int
+		Id        func(childComplexity int) int
+	}
+
+	CreateRoutinesByStationIdsPayload struct {
+		CreatedAt func(childComplexity int) int
+		Ids       func(childComplexity int) int
+	}
+
+	CreateStationPayload struct {
+		CreatedAt func(childComplexity int) int
+		Id        func(childComplexity int) int
+	}
+
+	CreateStationsPayload struct {
+		CreatedAt func(childComplexity int) int
+		Ids       func(childComplexity int) int
+	}
+
+	CreateSubShelfByRootShelfIdPayload struct {
+		CreatedAt func(childComplexity int) int
+		Id        func(childComplexity int) int
+	}
+
+	CreateSubShelvesByRootShelfIdsPayload struct {
+		CreatedAt func(childComplexity int) int
+		Ids       func(childComplexity int) int
+	}
+
+	DeleteBlockPackPayload struct {
+		DeletedAt func(childComplexity int) int
+	}
+
+	DeleteBlockPacksPayload struct {
+		DeletedAt func(childComplexity int) int
+	}
+
+	DeleteMaterialPayload struct {
+		DeletedAt func(childComplexity int) int
+	}
+
+	DeleteMaterialsPayload struct {
+		DeletedAt func(childComplexity int) int
+	}
+
+	DeleteRootShelfPayload struct {
+		DeletedAt func(childComplexity int) int
+	}
+
+	DeleteRootShelvesPayload struct {
+		DeletedAt func(childComplexity int) int
+	}
+
+	DeleteRoutinePayload struct {
+		DeletedAt func(childComplexity int) int
+	}
+
+	DeleteRoutinesPayload struct {
+		DeletedAt func(childComplexity int) int
+	}
+
+	DeleteStationPayload struct {
+		DeletedAt func(childComplexity int) int
+	}
+
+	DeleteStationsPayload struct {
+		DeletedAt func(childComplexity int) int
+	}
+
+	DeleteSubShelfPayload struct {
+		DeletedAt func(childComplexity int) int
+	}
+
+	DeleteSubShelvesPayload struct {
+		DeletedAt func(childComplexity int) int
+	}
+
+	HardDeleteRoutinePayload struct {
+		DeletedAt func(childComplexity int) int
+	}
+
+	HardDeleteRoutineTaskPayload struct {
+		DeletedAt func(childComplexity int) int
+	}
+
+	HardDeleteRoutineTasksPayload struct {
+		DeletedAt func(childComplexity int) int
+	}
+
+	HardDeleteRoutinesPayload struct {
+		DeletedAt func(childComplexity int) int
+	}
+
+	HardDeleteStationPayload struct {
+		DeletedAt func(childComplexity int) int
+	}
+
+	HardDeleteStationsPayload struct {
+		DeletedAt func(childComplexity int) int
+	}
+
+	LinkRoutineItemPayload struct {
+		UpdatedAt func(childComplexity int) int
+	}
+
+	LinkRoutineItemsPayload struct {
+		UpdatedAt func(childComplexity int) int
+	}
+
+	LinkRoutineTagPayload struct {
+		UpdatedAt func(childComplexity int) int
+	}
+
+	LinkRoutineTagsPayload struct {
+		UpdatedAt func(childComplexity int) int
+	}
+
+	MaterialPayload struct {
+		ContentType      func(childComplexity int) int
+		CreatedAt        func(childComplexity int) int
+		DeletedAt        func(childComplexity int) int
+		DownloadURL      func(childComplexity int) int
+		Id               func(childComplexity int) int
+		Name             func(childComplexity int) int
+		ParentSubShelfId func(childComplexity int) int
+		ParseMediaType   func(childComplexity int) int
+		Size             func(childComplexity int) int
+		UpdatedAt        func(childComplexity int) int
+	}
+
+	MoveBlockPackByParentSubShelfIdPayload struct {
+		UpdatedAt func(childComplexity int) int
+	}
+
+	MoveBlockPacksByParentSubShelfIdPayload struct {
+		UpdatedAt func(childComplexity int) int
+	}
+
+	MoveBlockPacksByParentSubShelfIdsPayload struct {
+		UpdatedAt func(childComplexity int) int
+	}
+
+	MoveMaterialPayload struct {
+		UpdatedAt func(childComplexity int) int
+	}
+
+	MoveMaterialsPayload struct {
+		UpdatedAt func(childComplexity int) int
+	}
+
+	MoveSubShelfByRootShelfIdPayload struct {
+		UpdatedAt func(childComplexity int) int
+	}
+
+	MoveSubShelvesByRootShelfIdPayload struct {
+		UpdatedAt func(childComplexity int) int
+	}
+
+	MoveSubShelvesByRootShelfIdsPayload struct {
+		UpdatedAt func(childComplexity int) int
+	}
+
+	Mutation struct {
+		CreateBlockPack                   func(childComplexity int, input gqlmodels.CreatableBlockPackInput) int
+		CreateBlockPacks                  func(childComplexity int, input gqlmodels.CreateBlockPacksInput) int
+		CreateMaterial                    func(childComplexity int, input gqlmodels.CreatableMaterialInput) int
+		CreateRootShelf                   func(childComplexity int, input gqlmodels.CreatableRootShelfInput) int
+		CreateRootShelfPermission         func(childComplexity int, input gqlmodels.CreateRootShelfPermissionInput) int
+		CreateRootShelves                 func(childComplexity int, input gqlmodels.CreateRootShelvesInput) int
+		CreateRoutineByStationID          func(childComplexity int, input gqlmodels.CreatableRoutineInput) int
+		CreateRoutineTaskByRoutineID      func(childComplexity int, input gqlmodels.CreatableRoutineTaskInput) int
+		CreateRoutinesByStationIds        func(childComplexity int, input gqlmodels.CreateRoutinesByStationIdsInput) int
+		CreateStation                     func(childComplexity int, input gqlmodels.CreatableStationInput) int
+		CreateStationPermission           func(childComplexity int, input gqlmodels.CreateStationPermissionInput) int
+		CreateStations                    func(childComplexity int, input gqlmodels.CreateStationsInput) int
+		CreateSubShelfByRootShelfID       func(childComplexity int, input gqlmodels.CreatableSubShelfInput) int
+		CreateSubShelvesByRootShelfIds    func(childComplexity int, input gqlmodels.CreateSubShelvesByRootShelfIdsInput) int
+		DeleteBlockPack                   func(childComplexity int, input gqlmodels.DeleteBlockPackInput) int
+		DeleteBlockPacks                  func(childComplexity int, input gqlmodels.DeleteBlockPacksInput) int
+		DeleteMaterial                    func(childComplexity int, input gqlmodels.DeleteMaterialInput) int
+		DeleteMaterials                   func(childComplexity int, input gqlmodels.DeleteMaterialsInput) int
+		DeleteRootShelf                   func(childComplexity int, input gqlmodels.DeleteRootShelfInput) int
+		DeleteRootShelfPermission         func(childComplexity int, input gqlmodels.DeleteRootShelfPermissionInput) int
+		DeleteRootShelfPermissions        func(childComplexity int, input gqlmodels.DeleteRootShelfPermissionsInput) int
+		DeleteRootShelves                 func(childComplexity int, input gqlmodels.DeleteRootShelvesInput) int
+		DeleteRoutine                     func(childComplexity int, input gqlmodels.DeleteRoutineInput) int
+		DeleteRoutines                    func(childComplexity int, input gqlmodels.DeleteRoutinesInput) int
+		DeleteStation                     func(childComplexity int, input gqlmodels.DeleteStationInput) int
+		DeleteStationPermission           func(childComplexity int, input gqlmodels.DeleteStationPermissionInput) int
+		DeleteStationPermissions          func(childComplexity int, input gqlmodels.DeleteStationPermissionsInput) int
+		DeleteStations                    func(childComplexity int, input gqlmodels.DeleteStationsInput) int
+		DeleteSubShelf                    func(childComplexity int, input gqlmodels.DeleteSubShelfInput) int
+		DeleteSubShelves                  func(childComplexity int, input gqlmodels.DeleteSubShelvesInput) int
+		HardDeleteRoutine                 func(childComplexity int, input gqlmodels.HardDeleteRoutineInput) int
+		HardDeleteRoutineTask             func(childComplexity int, input gqlmodels.HardDeleteRoutineTaskInput) int
+		HardDeleteRoutineTasks            func(childComplexity int, input gqlmodels.HardDeleteRoutineTasksInput) int
+		HardDeleteRoutines                func(childComplexity int, input gqlmodels.HardDeleteRoutinesInput) int
+		HardDeleteStation                 func(childComplexity int, input gqlmodels.HardDeleteStationInput) int
+		HardDeleteStations                func(childComplexity int, input gqlmodels.HardDeleteStationsInput) int
+		LeaveRootShelf                    func(childComplexity int, input gqlmodels.LeaveRootShelfInput) int
+		LeaveRootShelves                  func(childComplexity int, input gqlmodels.LeaveRootShelvesInput) int
+		LeaveStation                      func(childComplexity int, input gqlmodels.LeaveStationInput) int
+		LeaveStations                     func(childComplexity int, input gqlmodels.LeaveStationsInput) int
+		LinkRoutineItem                   func(childComplexity int, input gqlmodels.LinkRoutineItemInput) int
+		LinkRoutineItems                  func(childComplexity int, input gqlmodels.LinkRoutineItemsInput) int
+		LinkRoutineTag                    func(childComplexity int, input gqlmodels.LinkRoutineTagInput) int
+		LinkRoutineTags                   func(childComplexity int, input gqlmodels.LinkRoutineTagsInput) int
+		MoveBlockPackByParentSubShelfID   func(childComplexity int, input gqlmodels.MoveBlockPackByParentSubShelfIDInput) int
+		MoveBlockPacksByParentSubShelfID  func(childComplexity int, input gqlmodels.MovableBlockPackInput) int
+		MoveBlockPacksByParentSubShelfIds func(childComplexity int, input gqlmodels.MoveBlockPacksByParentSubShelfIdsInput) int
+		MoveMaterial                      func(childComplexity int, input gqlmodels.MoveMaterialInput) int
+		MoveMaterials                     func(childComplexity int, input gqlmodels.MoveMaterialsInput) int
+		MoveSubShelfByRootShelfID         func(childComplexity int, input gqlmodels.MoveSubShelfByRootShelfIDInput) int
+		MoveSubShelvesByRootShelfID       func(childComplexity int, input gqlmodels.MovableSubShelfInput) int
+		MoveSubShelvesByRootShelfIds      func(childComplexity int, input gqlmodels.MoveSubShelvesByRootShelfIdsInput) int
+		PauseRoutineTask                  func(childComplexity int, input gqlmodels.PauseRoutineTaskInput) int
+		RestoreBlockPack                  func(childComplexity int, input gqlmodels.RestoreBlockPackInput) int
+		RestoreBlockPacks                 func(childComplexity int, input gqlmodels.RestoreBlockPacksInput) int
+		RestoreMaterial                   func(childComplexity int, input gqlmodels.RestoreMaterialInput) int
+		RestoreMaterials                  func(childComplexity int, input gqlmodels.RestoreMaterialsInput) int
+		RestoreRootShelf                  func(childComplexity int, input gqlmodels.RestoreRootShelfInput) int
+		RestoreRootShelves                func(childComplexity int, input gqlmodels.RestoreRootShelvesInput) int
+		RestoreRoutine                    func(childComplexity int, input gqlmodels.RestoreRoutineInput) int
+		RestoreRoutines                   func(childComplexity int, input gqlmodels.RestoreRoutinesInput) int
+		RestoreStation                    func(childComplexity int, input gqlmodels.RestoreStationInput) int
+		RestoreStations                   func(childComplexity int, input gqlmodels.RestoreStationsInput) int
+		RestoreSubShelf                   func(childComplexity int, input gqlmodels.RestoreSubShelfInput) int
+		RestoreSubShelves                 func(childComplexity int, input gqlmodels.RestoreSubShelvesInput) int
+		ResumeRoutineTask                 func(childComplexity int, input gqlmodels.ResumeRoutineTaskInput) int
+		SaveMaterial                      func(childComplexity int, input gqlmodels.SaveMaterialInput) int
+		TransferRootShelfOwnership        func(childComplexity int, input gqlmodels.TransferRootShelfOwnershipInput) int
+		TransferStationOwnership          func(childComplexity int, input gqlmodels.TransferStationOwnershipInput) int
+		UpdateBlockPack                   func(childComplexity int, input gqlmodels.UpdateBlockPackInput) int
+		UpdateBlockPacks                  func(childComplexity int, input gqlmodels.UpdateBlockPacksInput) int
+		UpdateMaterial                    func(childComplexity int, input gqlmodels.UpdateMaterialInput) int
+		UpdateRootShelf                   func(childComplexity int, input gqlmodels.UpdateRootShelfInput) int
+		UpdateRootShelfPermission         func(childComplexity int, input gqlmodels.UpdateRootShelfPermissionInput) int
+		UpdateRootShelves                 func(childComplexity int, input gqlmodels.UpdateRootShelvesInput) int
+		UpdateRoutine                     func(childComplexity int, input gqlmodels.UpdatableRoutineInput) int
+		UpdateRoutineTask                 func(childComplexity int, input gqlmodels.UpdatableRoutineTaskInput) int
+		UpdateRoutines                    func(childComplexity int, input gqlmodels.UpdateRoutinesInput) int
+		UpdateStation                     func(childComplexity int, input gqlmodels.UpdateStationInput) int
+		UpdateStationPermission           func(childComplexity int, input gqlmodels.UpdateStationPermissionInput) int
+		UpdateStations                    func(childComplexity int, input gqlmodels.UpdateStationsInput) int
+		UpdateSubShelf                    func(childComplexity int, input gqlmodels.UpdateSubShelfInput) int
+		UpdateSubShelves                  func(childComplexity int, input gqlmodels.UpdateSubShelvesInput) int
+		UpsertRootShelfPermission         func(childComplexity int, input gqlmodels.UpsertRootShelfPermissionInput) int
+		UpsertRootShelfPermissions        func(childComplexity int, input gqlmodels.UpsertRootShelfPermissionsInput) int
+		UpsertStationPermission           func(childComplexity int, input gqlmodels.UpsertStationPermissionInput) int
+		UpsertStationPermissions          func(childComplexity int, input gqlmodels.UpsertStationPermissionsInput) int
+	}
+
+	MyTrash struct {
+		Items         func(childComplexity int) int
+		RetentionDays func(childComplexity int) int
+		TotalCount    func(childComplexity int) int
+	}
+
+	MyUsage struct {
+		GeneratedAt func(childComplexity int) int
+		Limits      func(childComplexity int) int
+		Plan        func(childComplexity int) int
+		Snapshots   func(childComplexity int) int
+	}
+
+	PauseRoutineTaskPayload struct {
+		UpdatedAt func(childComplexity int) int
+	}
+
 	PrivateBlock struct {
 		BlockPackID   func(childComplexity int) int
 		ChildrenIds   func(childComplexity int) int
@@ -114,6 +434,7 @@ type ComplexityRoot struct {
 		IsPinned         func(childComplexity int) int
 		ItemIds          func(childComplexity int) int
 		Period           func(childComplexity int) int
+		RecurrenceRule   func(childComplexity int) int
 		ScheduledEndAt   func(childComplexity int) int
 		ScheduledStartAt func(childComplexity int) int
 		StationID        func(childComplexity int) int
@@ -147,6 +468,7 @@ type ComplexityRoot struct {
 		Period          func(childComplexity int) int
 		Priority        func(childComplexity int) int
 		Purpose         func(childComplexity int) int
+		RecurrenceRule  func(childComplexity int) int
 		RoutineID       func(childComplexity int) int
 		ScheduledAt     func(childComplexity int) int
 		Status          func(childComplexity int) int
@@ -177,6 +499,7 @@ type ComplexityRoot struct {
 		IsPinned         func(childComplexity int) int
 		ItemIds          func(childComplexity int) int
 		Period           func(childComplexity int) int
+		RecurrenceRule   func(childComplexity int) int
 		ScheduledEndAt   func(childComplexity int) int
 		ScheduledStartAt func(childComplexity int) int
 		StationID        func(childComplexity int) int
@@ -270,6 +593,8 @@ type ComplexityRoot struct {
 	}
 
 	Query struct {
+		GetMyTrash               func(childComplexity int, input *gqlmodels.GetMyTrashInput) int
+		GetMyUsage               func(childComplexity int, input *gqlmodels.GetMyUsageInput) int
 		SearchBlockPacks         func(childComplexity int, input gqlmodels.SearchBlockPackInput) int
 		SearchBlocks             func(childComplexity int, input gqlmodels.SearchBlockInput) int
 		SearchItems              func(childComplexity int, input gqlmodels.SearchItemInput) int
@@ -285,6 +610,84 @@ type ComplexityRoot struct {
 		SearchUsers              func(childComplexity int, input gqlmodels.SearchUserInput) int
 	}
 
+	ResourceEvent struct {
+		Change             func(childComplexity int) int
+		EventID            func(childComplexity int) int
+		EventType          func(childComplexity int) int
+		Permission         func(childComplexity int) int
+		ResourceID         func(childComplexity int) int
+		TargetUserPublicID func(childComplexity int) int
+	}
+
+	RestoreRootShelfPayload struct {
+		CreatedAt      func(childComplexity int) int
+		DeletedAt      func(childComplexity int) int
+		Id             func(childComplexity int) int
+		ItemCount      func(childComplexity int) int
+		LastAnalyzedAt func(childComplexity int) int
+		Name           func(childComplexity int) int
+		SubShelfCount  func(childComplexity int) int
+		UpdatedAt      func(childComplexity int) int
+	}
+
+	RestoreStationPayload struct {
+		CreatedAt           func(childComplexity int) int
+		DeletedAt           func(childComplexity int) int
+		Description         func(childComplexity int) int
+		HeaderBackgroundURL func(childComplexity int) int
+		Icon                func(childComplexity int) int
+		Id                  func(childComplexity int) int
+		Name                func(childComplexity int) int
+		RoutineCount        func(childComplexity int) int
+		UpdatedAt           func(childComplexity int) int
+	}
+
+	ResumeRoutineTaskPayload struct {
+		UpdatedAt func(childComplexity int) int
+	}
+
+	RootShelfPermissionPayload struct {
+		CreatedAt    func(childComplexity int) int
+		Permission   func(childComplexity int) int
+		UpdatedAt    func(childComplexity int) int
+		UserPublicId func(childComplexity int) int
+	}
+
+	RoutinePayload struct {
+		CreatedAt        func(childComplexity int) int
+		DeletedAt        func(childComplexity int) int
+		Description      func(childComplexity int) int
+		Id               func(childComplexity int) int
+		IsPinned         func(childComplexity int) int
+		ItemIds          func(childComplexity int) int
+		Period           func(childComplexity int) int
+		RecurrenceRule   func(childComplexity int) int
+		ScheduledEndAt   func(childComplexity int) int
+		ScheduledStartAt func(childComplexity int) int
+		StationId        func(childComplexity int) int
+		Status           func(childComplexity int) int
+		TagIds           func(childComplexity int) int
+		TaskIds          func(childComplexity int) int
+		Timezone         func(childComplexity int) int
+		Title            func(childComplexity int) int
+		UpdatedAt        func(childComplexity int) int
+	}
+
+	RoutineTaskLifecycleEvent struct {
+		Attempt             func(childComplexity int) int
+		EventID             func(childComplexity int) int
+		OccurredAt          func(childComplexity int) int
+		Purpose             func(childComplexity int) int
+		RoutineID           func(childComplexity int) int
+		RoutineTaskID       func(childComplexity int) int
+		RoutineTaskRecordID func(childComplexity int) int
+		Status              func(childComplexity int) int
+	}
+
+	SaveMaterialPayload struct {
+		UpdatedAt func(childComplexity int) int
+	}
+
 	SearchBadgeConnection struct {
 		SearchEdges    func(childComplexity int) int
 		SearchPageInfo func(childComplexity int) int
@@ -306,7 +709,9 @@ type ComplexityRoot struct {
 
 	SearchBlockEdge struct {
 		EncodedSearchCursor func(childComplexity int) int
+		Highlight           func(childComplexity int) int
 		Node                func(childComplexity int) int
+		Rank                func(childComplexity int) int
 	}
 
 	SearchBlockPackConnection struct {
@@ -318,7 +723,9 @@ type ComplexityRoot struct {
 
 	SearchBlockPackEdge struct {
 		EncodedSearchCursor func(childComplexity int) int
+		Highlight           func(childComplexity int) int
 		Node                func(childComplexity int) int
+		Rank                func(childComplexity int) int
 	}
 
 	SearchItemConnection struct {
@@ -342,7 +749,9 @@ type ComplexityRoot struct {
 
 	SearchMaterialEdge struct {
 		EncodedSearchCursor func(childComplexity int) int
+		Highlight           func(childComplexity int) int
 		Node                func(childComplexity int) int
+		Rank                func(childComplexity int) int
 	}
 
 	SearchPageInfo struct {
@@ -459,6 +868,132 @@ type ComplexityRoot struct {
 		EncodedSearchCursor func(childComplexity int) int
 		Node                func(childComplexity int) int
 	}
+
+	StationPermissionPayload struct {
+		CreatedAt    func(childComplexity int) int
+		Permission   func(childComplexity int) int
+		UpdatedAt    func(childComplexity int) int
+		UserPublicId func(childComplexity int) int
+	}
+
+	SubShelfPayload struct {
+		CreatedAt      func(childComplexity int) int
+		DeletedAt      func(childComplexity int) int
+		Id             func(childComplexity int) int
+		Name           func(childComplexity int) int
+		Path           func(childComplexity int) int
+		PrevSubShelfId func(childComplexity int) int
+		RootShelfId    func(childComplexity int) int
+		UpdatedAt      func(childComplexity int) int
+	}
+
+	Subscription struct {
+		ResourceEvents             func(childComplexity int, input *gqlmodels.SubscribeResourceEventsInput) int
+		RoutineTaskLifecycleEvents func(childComplexity int, input *gqlmodels.SubscribeRoutineTaskLifecycleEventsInput) int
+	}
+
+	TransferRootShelfOwnershipPayload struct {
+		NewOwnerUserPublicId      func(childComplexity int) int
+		PreviousOwnerUserPublicId func(childComplexity int) int
+		RootShelfId               func(childComplexity int) int
+		UpdatedAt                 func(childComplexity int) int
+	}
+
+	TransferStationOwnershipPayload struct {
+		NewOwnerUserPublicId      func(childComplexity int) int
+		PreviousOwnerUserPublicId func(childComplexity int) int
+		StationId                 func(childComplexity int) int
+		UpdatedAt                 func(childComplexity int) int
+	}
+
+	TrashItem struct {
+		DeletedAt        func(childComplexity int) int
+		ID               func(childComplexity int) int
+		Name             func(childComplexity int) int
+		ParentSubShelfID func(childComplexity int) int
+		PurgeAt          func(childComplexity int) int
+		RootShelfID      func(childComplexity int) int
+		Size             func(childComplexity int) int
+		Type             func(childComplexity int) int
+	}
+
+	UpdateBlockPackPayload struct {
+		UpdatedAt func(childComplexity int) int
+	}
+
+	UpdateBlockPacksPayload struct {
+		UpdatedAt func(childComplexity int) int
+	}
+
+	UpdateMaterialPayload struct {
+		UpdatedAt func(childComplexity int) int
+	}
+
+	UpdateRootShelfPayload struct {
+		UpdatedAt func(childComplexity int) int
+	}
+
+	UpdateRootShelvesPayload struct {
+		UpdatedAt func(childComplexity int) int
+	}
+
+	UpdateRoutinePayload struct {
+		UpdatedAt func(childComplexity int) int
+	}
+
+	UpdateRoutineTaskPayload struct {
+		UpdatedAt func(childComplexity int) int
+	}
+
+	UpdateRoutinesPayload struct {
+		UpdatedAt func(childComplexity int) int
+	}
+
+	UpdateStationPayload struct {
+		UpdatedAt func(childComplexity int) int
+	}
+
+	UpdateStationsPayload struct {
+		UpdatedAt func(childComplexity int) int
+	}
+
+	UpdateSubShelfPayload struct {
+		UpdatedAt func(childComplexity int) int
+	}
+
+	UpdateSubShelvesPayload struct {
+		UpdatedAt func(childComplexity int) int
+	}
+
+	UpsertRootShelfPermissionsPayload struct {
+		Permissions func(childComplexity int) int
+	}
+
+	UpsertStationPermissionsPayload struct {
+		Permissions func(childComplexity int) int
+	}
+
+	UsageLimit struct {
+		Key        func(childComplexity int) int
+		Limit      func(childComplexity int) int
+		Percentage func(childComplexity int) int
+		ResetAt    func(childComplexity int) int
+		Used       func(childComplexity int) int
+	}
+
+	UsageSnapshot struct {
+		AdditionalItemCount     func(childComplexity int) int
+		BlockCount              func(childComplexity int) int
+		BlockPackCount          func(childComplexity int) int
+		Date                    func(childComplexity int) int
+		MaterialCount           func(childComplexity int) int
+		RootShelfCount          func(childComplexity int) int
+		RoutineCount            func(childComplexity int) int
+		RoutineTagCount         func(childComplexity int) int
+		RoutineTaskCostUnitUsed func(childComplexity int) int
+		StationCount            func(childComplexity int) int
+		WorkflowCount           func(childComplexity int) int
+	}
 }
 
 type executableSchema struct {
@@ -480,2439 +1015,6278 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 	_ = ec
 	switch typeName + "." + field {
 
-	case "PrivateBlock.blockPackId":
-		if e.complexity.PrivateBlock.BlockPackID == nil {
+	case "BlockPackPayload.blockCount":
+		if e.complexity.BlockPackPayload.BlockCount == nil {
 			break
 		}
 
-		return e.complexity.PrivateBlock.BlockPackID(childComplexity), true
+		return e.complexity.BlockPackPayload.BlockCount(childComplexity), true
 
-	case "PrivateBlock.childrenIds":
-		if e.complexity.PrivateBlock.ChildrenIds == nil {
+	case "BlockPackPayload.compactedUntilSequence":
+		if e.complexity.BlockPackPayload.CompactedUntilSequence == nil {
 			break
 		}
 
-		return e.complexity.PrivateBlock.ChildrenIds(childComplexity), true
+		return e.complexity.BlockPackPayload.CompactedUntilSequence(childComplexity), true
 
-	case "PrivateBlock.content":
-		if e.complexity.PrivateBlock.Content == nil {
+	case "BlockPackPayload.createdAt":
+		if e.complexity.BlockPackPayload.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateBlock.Content(childComplexity), true
+		return e.complexity.BlockPackPayload.CreatedAt(childComplexity), true
 
-	case "PrivateBlock.createdAt":
-		if e.complexity.PrivateBlock.CreatedAt == nil {
+	case "BlockPackPayload.deletedAt":
+		if e.complexity.BlockPackPayload.DeletedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateBlock.CreatedAt(childComplexity), true
+		return e.complexity.BlockPackPayload.DeletedAt(childComplexity), true
 
-	case "PrivateBlock.id":
-		if e.complexity.PrivateBlock.ID == nil {
+	case "BlockPackPayload.headerBackgroundURL":
+		if e.complexity.BlockPackPayload.HeaderBackgroundURL == nil {
 			break
 		}
 
-		return e.complexity.PrivateBlock.ID(childComplexity), true
+		return e.complexity.BlockPackPayload.HeaderBackgroundURL(childComplexity), true
 
-	case "PrivateBlock.nextBlockId":
-		if e.complexity.PrivateBlock.NextBlockID == nil {
+	case "BlockPackPayload.icon":
+		if e.complexity.BlockPackPayload.Icon == nil {
 			break
 		}
 
-		return e.complexity.PrivateBlock.NextBlockID(childComplexity), true
+		return e.complexity.BlockPackPayload.Icon(childComplexity), true
 
-	case "PrivateBlock.parentBlockId":
-		if e.complexity.PrivateBlock.ParentBlockID == nil {
+	case "BlockPackPayload.id":
+		if e.complexity.BlockPackPayload.Id == nil {
 			break
 		}
 
-		return e.complexity.PrivateBlock.ParentBlockID(childComplexity), true
+		return e.complexity.BlockPackPayload.Id(childComplexity), true
 
-	case "PrivateBlock.prevBlockId":
-		if e.complexity.PrivateBlock.PrevBlockID == nil {
+	case "BlockPackPayload.isProjectionCurrent":
+		if e.complexity.BlockPackPayload.IsProjectionCurrent == nil {
 			break
 		}
 
-		return e.complexity.PrivateBlock.PrevBlockID(childComplexity), true
+		return e.complexity.BlockPackPayload.IsProjectionCurrent(childComplexity), true
 
-	case "PrivateBlock.props":
-		if e.complexity.PrivateBlock.Props == nil {
+	case "BlockPackPayload.lastUpdateSequence":
+		if e.complexity.BlockPackPayload.LastUpdateSequence == nil {
 			break
 		}
 
-		return e.complexity.PrivateBlock.Props(childComplexity), true
+		return e.complexity.BlockPackPayload.LastUpdateSequence(childComplexity), true
 
-	case "PrivateBlock.type":
-		if e.complexity.PrivateBlock.Type == nil {
+	case "BlockPackPayload.name":
+		if e.complexity.BlockPackPayload.Name == nil {
 			break
 		}
 
-		return e.complexity.PrivateBlock.Type(childComplexity), true
+		return e.complexity.BlockPackPayload.Name(childComplexity), true
 
-	case "PrivateBlock.updatedAt":
-		if e.complexity.PrivateBlock.UpdatedAt == nil {
+	case "BlockPackPayload.parentSubShelfId":
+		if e.complexity.BlockPackPayload.ParentSubShelfId == nil {
 			break
 		}
 
-		return e.complexity.PrivateBlock.UpdatedAt(childComplexity), true
+		return e.complexity.BlockPackPayload.ParentSubShelfId(childComplexity), true
 
-	case "PrivateBlockPack.blockCount":
-		if e.complexity.PrivateBlockPack.BlockCount == nil {
+	case "BlockPackPayload.projectedUntilSequence":
+		if e.complexity.BlockPackPayload.ProjectedUntilSequence == nil {
 			break
 		}
 
-		return e.complexity.PrivateBlockPack.BlockCount(childComplexity), true
+		return e.complexity.BlockPackPayload.ProjectedUntilSequence(childComplexity), true
 
-	case "PrivateBlockPack.blockIds":
-		if e.complexity.PrivateBlockPack.BlockIds == nil {
+	case "BlockPackPayload.updatedAt":
+		if e.complexity.BlockPackPayload.UpdatedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateBlockPack.BlockIds(childComplexity), true
+		return e.complexity.BlockPackPayload.UpdatedAt(childComplexity), true
 
-	case "PrivateBlockPack.createdAt":
-		if e.complexity.PrivateBlockPack.CreatedAt == nil {
+	case "CreateBlockPackPayload.createdAt":
+		if e.complexity.CreateBlockPackPayload.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateBlockPack.CreatedAt(childComplexity), true
+		return e.complexity.CreateBlockPackPayload.CreatedAt(childComplexity), true
 
-	case "PrivateBlockPack.deletedAt":
-		if e.complexity.PrivateBlockPack.DeletedAt == nil {
+	case "CreateBlockPackPayload.id":
+		if e.complexity.CreateBlockPackPayload.Id == nil {
 			break
 		}
 
-		return e.complexity.PrivateBlockPack.DeletedAt(childComplexity), true
+		return e.complexity.CreateBlockPackPayload.Id(childComplexity), true
 
-	case "PrivateBlockPack.headerBackgroundURL":
-		if e.complexity.PrivateBlockPack.HeaderBackgroundURL == nil {
+	case "CreateBlockPacksPayload.createdAt":
+		if e.complexity.CreateBlockPacksPayload.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateBlockPack.HeaderBackgroundURL(childComplexity), true
+		return e.complexity.CreateBlockPacksPayload.CreatedAt(childComplexity), true
 
-	case "PrivateBlockPack.id":
-		if e.complexity.PrivateBlockPack.ID == nil {
+	case "CreateBlockPacksPayload.ids":
+		if e.complexity.CreateBlockPacksPayload.Ids == nil {
 			break
 		}
 
-		return e.complexity.PrivateBlockPack.ID(childComplexity), true
+		return e.complexity.CreateBlockPacksPayload.Ids(childComplexity), true
 
-	case "PrivateBlockPack.icon":
-		if e.complexity.PrivateBlockPack.Icon == nil {
+	case "CreateMaterialPayload.createdAt":
+		if e.complexity.CreateMaterialPayload.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateBlockPack.Icon(childComplexity), true
+		return e.complexity.CreateMaterialPayload.CreatedAt(childComplexity), true
 
-	case "PrivateBlockPack.name":
-		if e.complexity.PrivateBlockPack.Name == nil {
+	case "CreateMaterialPayload.id":
+		if e.complexity.CreateMaterialPayload.Id == nil {
 			break
 		}
 
-		return e.complexity.PrivateBlockPack.Name(childComplexity), true
+		return e.complexity.CreateMaterialPayload.Id(childComplexity), true
 
-	case "PrivateBlockPack.parentSubShelfId":
-		if e.complexity.PrivateBlockPack.ParentSubShelfID == nil {
+	case "CreateRootShelfPayload.createdAt":
+		if e.complexity.CreateRootShelfPayload.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateBlockPack.ParentSubShelfID(childComplexity), true
+		return e.complexity.CreateRootShelfPayload.CreatedAt(childComplexity), true
 
-	case "PrivateBlockPack.updatedAt":
-		if e.complexity.PrivateBlockPack.UpdatedAt == nil {
+	case "CreateRootShelfPayload.id":
+		if e.complexity.CreateRootShelfPayload.Id == nil {
 			break
 		}
 
-		return e.complexity.PrivateBlockPack.UpdatedAt(childComplexity), true
+		return e.complexity.CreateRootShelfPayload.Id(childComplexity), true
 
-	case "PrivateItem.createdAt":
-		if e.complexity.PrivateItem.CreatedAt == nil {
+	case "CreateRootShelfPayload.lastAnalyzedAt":
+		if e.complexity.CreateRootShelfPayload.LastAnalyzedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateItem.CreatedAt(childComplexity), true
+		return e.complexity.CreateRootShelfPayload.LastAnalyzedAt(childComplexity), true
 
-	case "PrivateItem.deletedAt":
-		if e.complexity.PrivateItem.DeletedAt == nil {
+	case "CreateRootShelvesPayload.createdAt":
+		if e.complexity.CreateRootShelvesPayload.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateItem.DeletedAt(childComplexity), true
+		return e.complexity.CreateRootShelvesPayload.CreatedAt(childComplexity), true
 
-	case "PrivateItem.id":
-		if e.complexity.PrivateItem.ID == nil {
+	case "CreateRootShelvesPayload.ids":
+		if e.complexity.CreateRootShelvesPayload.Ids == nil {
 			break
 		}
 
-		return e.complexity.PrivateItem.ID(childComplexity), true
+		return e.complexity.CreateRootShelvesPayload.Ids(childComplexity), true
 
-	case "PrivateItem.parentSubShelfId":
-		if e.complexity.PrivateItem.ParentSubShelfID == nil {
+	case "CreateRootShelvesPayload.lastAnalyzedAt":
+		if e.complexity.CreateRootShelvesPayload.LastAnalyzedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateItem.ParentSubShelfID(childComplexity), true
+		return e.complexity.CreateRootShelvesPayload.LastAnalyzedAt(childComplexity), true
 
-	case "PrivateItem.rootShelfId":
-		if e.complexity.PrivateItem.RootShelfID == nil {
+	case "CreateRoutineByStationIdPayload.createdAt":
+		if e.complexity.CreateRoutineByStationIdPayload.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateItem.RootShelfID(childComplexity), true
+		return e.complexity.CreateRoutineByStationIdPayload.CreatedAt(childComplexity), true
 
-	case "PrivateItem.routineIds":
-		if e.complexity.PrivateItem.RoutineIds == nil {
+	case "CreateRoutineByStationIdPayload.id":
+		if e.complexity.CreateRoutineByStationIdPayload.Id == nil {
 			break
 		}
 
-		return e.complexity.PrivateItem.RoutineIds(childComplexity), true
+		return e.complexity.CreateRoutineByStationIdPayload.Id(childComplexity), true
 
-	case "PrivateItem.type":
-		if e.complexity.PrivateItem.Type == nil {
+	case "CreateRoutineTaskByRoutineIdPayload.createdAt":
+		if e.complexity.CreateRoutineTaskByRoutineIdPayload.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateItem.Type(childComplexity), true
+		return e.complexity.CreateRoutineTaskByRoutineIdPayload.CreatedAt(childComplexity), true
 
-	case "PrivateItem.updatedAt":
-		if e.complexity.PrivateItem.UpdatedAt == nil {
+	case "CreateRoutineTaskByRoutineIdPayload.id":
+		if e.complexity.CreateRoutineTaskByRoutineIdPayload.Id == nil {
 			break
 		}
 
-		return e.complexity.PrivateItem.UpdatedAt(childComplexity), true
+		return e.complexity.CreateRoutineTaskByRoutineIdPayload.Id(childComplexity), true
 
-	case "PrivateMaterial.contentType":
-		if e.complexity.PrivateMaterial.ContentType == nil {
+	case "CreateRoutinesByStationIdsPayload.createdAt":
+		if e.complexity.CreateRoutinesByStationIdsPayload.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateMaterial.ContentType(childComplexity), true
+		return e.complexity.CreateRoutinesByStationIdsPayload.CreatedAt(childComplexity), true
 
-	case "PrivateMaterial.createdAt":
-		if e.complexity.PrivateMaterial.CreatedAt == nil {
+	case "CreateRoutinesByStationIdsPayload.ids":
+		if e.complexity.CreateRoutinesByStationIdsPayload.Ids == nil {
 			break
 		}
 
-		return e.complexity.PrivateMaterial.CreatedAt(childComplexity), true
+		return e.complexity.CreateRoutinesByStationIdsPayload.Ids(childComplexity), true
 
-	case "PrivateMaterial.deletedAt":
-		if e.complexity.PrivateMaterial.DeletedAt == nil {
+	case "CreateStationPayload.createdAt":
+		if e.complexity.CreateStationPayload.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateMaterial.DeletedAt(childComplexity), true
+		return e.complexity.CreateStationPayload.CreatedAt(childComplexity), true
 
-	case "PrivateMaterial.id":
-		if e.complexity.PrivateMaterial.ID == nil {
+	case "CreateStationPayload.id":
+		if e.complexity.CreateStationPayload.Id == nil {
 			break
 		}
 
-		return e.complexity.PrivateMaterial.ID(childComplexity), true
+		return e.complexity.CreateStationPayload.Id(childComplexity), true
 
-	case "PrivateMaterial.name":
-		if e.complexity.PrivateMaterial.Name == nil {
+	case "CreateStationsPayload.createdAt":
+		if e.complexity.CreateStationsPayload.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateMaterial.Name(childComplexity), true
+		return e.complexity.CreateStationsPayload.CreatedAt(childComplexity), true
 
-	case "PrivateMaterial.parentSubShelfId":
-		if e.complexity.PrivateMaterial.ParentSubShelfID == nil {
+	case "CreateStationsPayload.ids":
+		if e.complexity.CreateStationsPayload.Ids == nil {
 			break
 		}
 
-		return e.complexity.PrivateMaterial.ParentSubShelfID(childComplexity), true
+		return e.complexity.CreateStationsPayload.Ids(childComplexity), true
 
-	case "PrivateMaterial.parseMediaType":
-		if e.complexity.PrivateMaterial.ParseMediaType == nil {
+	case "CreateSubShelfByRootShelfIdPayload.createdAt":
+		if e.complexity.CreateSubShelfByRootShelfIdPayload.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateMaterial.ParseMediaType(childComplexity), true
+		return e.complexity.CreateSubShelfByRootShelfIdPayload.CreatedAt(childComplexity), true
 
-	case "PrivateMaterial.size":
-		if e.complexity.PrivateMaterial.Size == nil {
+	case "CreateSubShelfByRootShelfIdPayload.id":
+		if e.complexity.CreateSubShelfByRootShelfIdPayload.Id == nil {
 			break
 		}
 
-		return e.complexity.PrivateMaterial.Size(childComplexity), true
+		return e.complexity.CreateSubShelfByRootShelfIdPayload.Id(childComplexity), true
 
-	case "PrivateMaterial.updatedAt":
-		if e.complexity.PrivateMaterial.UpdatedAt == nil {
+	case "CreateSubShelvesByRootShelfIdsPayload.createdAt":
+		if e.complexity.CreateSubShelvesByRootShelfIdsPayload.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateMaterial.UpdatedAt(childComplexity), true
+		return e.complexity.CreateSubShelvesByRootShelfIdsPayload.CreatedAt(childComplexity), true
 
-	case "PrivateRootShelf.createdAt":
-		if e.complexity.PrivateRootShelf.CreatedAt == nil {
+	case "CreateSubShelvesByRootShelfIdsPayload.ids":
+		if e.complexity.CreateSubShelvesByRootShelfIdsPayload.Ids == nil {
 			break
 		}
 
-		return e.complexity.PrivateRootShelf.CreatedAt(childComplexity), true
+		return e.complexity.CreateSubShelvesByRootShelfIdsPayload.Ids(childComplexity), true
 
-	case "PrivateRootShelf.deletedAt":
-		if e.complexity.PrivateRootShelf.DeletedAt == nil {
+	case "DeleteBlockPackPayload.deletedAt":
+		if e.complexity.DeleteBlockPackPayload.DeletedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateRootShelf.DeletedAt(childComplexity), true
+		return e.complexity.DeleteBlockPackPayload.DeletedAt(childComplexity), true
 
-	case "PrivateRootShelf.id":
-		if e.complexity.PrivateRootShelf.ID == nil {
+	case "DeleteBlockPacksPayload.deletedAt":
+		if e.complexity.DeleteBlockPacksPayload.DeletedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateRootShelf.ID(childComplexity), true
+		return e.complexity.DeleteBlockPacksPayload.DeletedAt(childComplexity), true
 
-	case "PrivateRootShelf.itemCount":
-		if e.complexity.PrivateRootShelf.ItemCount == nil {
+	case "DeleteMaterialPayload.deletedAt":
+		if e.complexity.DeleteMaterialPayload.DeletedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateRootShelf.ItemCount(childComplexity), true
+		return e.complexity.DeleteMaterialPayload.DeletedAt(childComplexity), true
 
-	case "PrivateRootShelf.itemIds":
-		if e.complexity.PrivateRootShelf.ItemIds == nil {
+	case "DeleteMaterialsPayload.deletedAt":
+		if e.complexity.DeleteMaterialsPayload.DeletedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateRootShelf.ItemIds(childComplexity), true
+		return e.complexity.DeleteMaterialsPayload.DeletedAt(childComplexity), true
 
-	case "PrivateRootShelf.lastAnalyzedAt":
-		if e.complexity.PrivateRootShelf.LastAnalyzedAt == nil {
+	case "DeleteRootShelfPayload.deletedAt":
+		if e.complexity.DeleteRootShelfPayload.DeletedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateRootShelf.LastAnalyzedAt(childComplexity), true
+		return e.complexity.DeleteRootShelfPayload.DeletedAt(childComplexity), true
 
-	case "PrivateRootShelf.name":
-		if e.complexity.PrivateRootShelf.Name == nil {
+	case "DeleteRootShelvesPayload.deletedAt":
+		if e.complexity.DeleteRootShelvesPayload.DeletedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateRootShelf.Name(childComplexity), true
+		return e.complexity.DeleteRootShelvesPayload.DeletedAt(childComplexity), true
 
-	case "PrivateRootShelf.owner":
-		if e.complexity.PrivateRootShelf.Owner == nil {
+	case "DeleteRoutinePayload.deletedAt":
+		if e.complexity.DeleteRoutinePayload.DeletedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateRootShelf.Owner(childComplexity), true
+		return e.complexity.DeleteRoutinePayload.DeletedAt(childComplexity), true
 
-	case "PrivateRootShelf.permission":
-		if e.complexity.PrivateRootShelf.Permission == nil {
+	case "DeleteRoutinesPayload.deletedAt":
+		if e.complexity.DeleteRoutinesPayload.DeletedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateRootShelf.Permission(childComplexity), true
+		return e.complexity.DeleteRoutinesPayload.DeletedAt(childComplexity), true
 
-	case "PrivateRootShelf.sharers":
-		if e.complexity.PrivateRootShelf.Sharers == nil {
+	case "DeleteStationPayload.deletedAt":
+		if e.complexity.DeleteStationPayload.DeletedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateRootShelf.Sharers(childComplexity), true
+		return e.complexity.DeleteStationPayload.DeletedAt(childComplexity), true
 
-	case "PrivateRootShelf.subShelfCount":
-		if e.complexity.PrivateRootShelf.SubShelfCount == nil {
+	case "DeleteStationsPayload.deletedAt":
+		if e.complexity.DeleteStationsPayload.DeletedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateRootShelf.SubShelfCount(childComplexity), true
+		return e.complexity.DeleteStationsPayload.DeletedAt(childComplexity), true
 
-	case "PrivateRootShelf.updatedAt":
-		if e.complexity.PrivateRootShelf.UpdatedAt == nil {
+	case "DeleteSubShelfPayload.deletedAt":
+		if e.complexity.DeleteSubShelfPayload.DeletedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateRootShelf.UpdatedAt(childComplexity), true
+		return e.complexity.DeleteSubShelfPayload.DeletedAt(childComplexity), true
 
-	case "PrivateRoutine.createdAt":
-		if e.complexity.PrivateRoutine.CreatedAt == nil {
+	case "DeleteSubShelvesPayload.deletedAt":
+		if e.complexity.DeleteSubShelvesPayload.DeletedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutine.CreatedAt(childComplexity), true
+		return e.complexity.DeleteSubShelvesPayload.DeletedAt(childComplexity), true
 
-	case "PrivateRoutine.deletedAt":
-		if e.complexity.PrivateRoutine.DeletedAt == nil {
+	case "HardDeleteRoutinePayload.deletedAt":
+		if e.complexity.HardDeleteRoutinePayload.DeletedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutine.DeletedAt(childComplexity), true
+		return e.complexity.HardDeleteRoutinePayload.DeletedAt(childComplexity), true
 
-	case "PrivateRoutine.description":
-		if e.complexity.PrivateRoutine.Description == nil {
+	case "HardDeleteRoutineTaskPayload.deletedAt":
+		if e.complexity.HardDeleteRoutineTaskPayload.DeletedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutine.Description(childComplexity), true
+		return e.complexity.HardDeleteRoutineTaskPayload.DeletedAt(childComplexity), true
 
-	case "PrivateRoutine.id":
-		if e.complexity.PrivateRoutine.ID == nil {
+	case "HardDeleteRoutineTasksPayload.deletedAt":
+		if e.complexity.HardDeleteRoutineTasksPayload.DeletedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutine.ID(childComplexity), true
+		return e.complexity.HardDeleteRoutineTasksPayload.DeletedAt(childComplexity), true
 
-	case "PrivateRoutine.isPinned":
-		if e.complexity.PrivateRoutine.IsPinned == nil {
+	case "HardDeleteRoutinesPayload.deletedAt":
+		if e.complexity.HardDeleteRoutinesPayload.DeletedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutine.IsPinned(childComplexity), true
+		return e.complexity.HardDeleteRoutinesPayload.DeletedAt(childComplexity), true
 
-	case "PrivateRoutine.itemIds":
-		if e.complexity.PrivateRoutine.ItemIds == nil {
+	case "HardDeleteStationPayload.deletedAt":
+		if e.complexity.HardDeleteStationPayload.DeletedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutine.ItemIds(childComplexity), true
+		return e.complexity.HardDeleteStationPayload.DeletedAt(childComplexity), true
 
-	case "PrivateRoutine.period":
-		if e.complexity.PrivateRoutine.Period == nil {
+	case "HardDeleteStationsPayload.deletedAt":
+		if e.complexity.HardDeleteStationsPayload.DeletedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutine.Period(childComplexity), true
+		return e.complexity.HardDeleteStationsPayload.DeletedAt(childComplexity), true
 
-	case "PrivateRoutine.scheduledEndAt":
-		if e.complexity.PrivateRoutine.ScheduledEndAt == nil {
+	case "LinkRoutineItemPayload.updatedAt":
+		if e.complexity.LinkRoutineItemPayload.UpdatedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutine.ScheduledEndAt(childComplexity), true
+		return e.complexity.LinkRoutineItemPayload.UpdatedAt(childComplexity), true
 
-	case "PrivateRoutine.scheduledStartAt":
-		if e.complexity.PrivateRoutine.ScheduledStartAt == nil {
+	case "LinkRoutineItemsPayload.updatedAt":
+		if e.complexity.LinkRoutineItemsPayload.UpdatedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutine.ScheduledStartAt(childComplexity), true
+		return e.complexity.LinkRoutineItemsPayload.UpdatedAt(childComplexity), true
 
-	case "PrivateRoutine.stationId":
-		if e.complexity.PrivateRoutine.StationID == nil {
+	case "LinkRoutineTagPayload.updatedAt":
+		if e.complexity.LinkRoutineTagPayload.UpdatedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutine.StationID(childComplexity), true
+		return e.complexity.LinkRoutineTagPayload.UpdatedAt(childComplexity), true
 
-	case "PrivateRoutine.status":
-		if e.complexity.PrivateRoutine.Status == nil {
+	case "LinkRoutineTagsPayload.updatedAt":
+		if e.complexity.LinkRoutineTagsPayload.UpdatedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutine.Status(childComplexity), true
+		return e.complexity.LinkRoutineTagsPayload.UpdatedAt(childComplexity), true
 
-	case "PrivateRoutine.tagIds":
-		if e.complexity.PrivateRoutine.TagIds == nil {
+	case "MaterialPayload.contentType":
+		if e.complexity.MaterialPayload.ContentType == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutine.TagIds(childComplexity), true
+		return e.complexity.MaterialPayload.ContentType(childComplexity), true
 
-	case "PrivateRoutine.taskIds":
-		if e.complexity.PrivateRoutine.TaskIds == nil {
+	case "MaterialPayload.createdAt":
+		if e.complexity.MaterialPayload.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutine.TaskIds(childComplexity), true
+		return e.complexity.MaterialPayload.CreatedAt(childComplexity), true
 
-	case "PrivateRoutine.timezone":
-		if e.complexity.PrivateRoutine.Timezone == nil {
+	case "MaterialPayload.deletedAt":
+		if e.complexity.MaterialPayload.DeletedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutine.Timezone(childComplexity), true
+		return e.complexity.MaterialPayload.DeletedAt(childComplexity), true
 
-	case "PrivateRoutine.title":
-		if e.complexity.PrivateRoutine.Title == nil {
+	case "MaterialPayload.downloadURL":
+		if e.complexity.MaterialPayload.DownloadURL == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutine.Title(childComplexity), true
+		return e.complexity.MaterialPayload.DownloadURL(childComplexity), true
 
-	case "PrivateRoutine.updatedAt":
-		if e.complexity.PrivateRoutine.UpdatedAt == nil {
+	case "MaterialPayload.id":
+		if e.complexity.MaterialPayload.Id == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutine.UpdatedAt(childComplexity), true
+		return e.complexity.MaterialPayload.Id(childComplexity), true
 
-	case "PrivateRoutineTag.color":
-		if e.complexity.PrivateRoutineTag.Color == nil {
+	case "MaterialPayload.name":
+		if e.complexity.MaterialPayload.Name == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutineTag.Color(childComplexity), true
+		return e.complexity.MaterialPayload.Name(childComplexity), true
 
-	case "PrivateRoutineTag.createdAt":
-		if e.complexity.PrivateRoutineTag.CreatedAt == nil {
+	case "MaterialPayload.parentSubShelfId":
+		if e.complexity.MaterialPayload.ParentSubShelfId == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutineTag.CreatedAt(childComplexity), true
+		return e.complexity.MaterialPayload.ParentSubShelfId(childComplexity), true
 
-	case "PrivateRoutineTag.id":
-		if e.complexity.PrivateRoutineTag.ID == nil {
+	case "MaterialPayload.parseMediaType":
+		if e.complexity.MaterialPayload.ParseMediaType == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutineTag.ID(childComplexity), true
+		return e.complexity.MaterialPayload.ParseMediaType(childComplexity), true
 
-	case "PrivateRoutineTag.icon":
-		if e.complexity.PrivateRoutineTag.Icon == nil {
+	case "MaterialPayload.size":
+		if e.complexity.MaterialPayload.Size == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutineTag.Icon(childComplexity), true
+		return e.complexity.MaterialPayload.Size(childComplexity), true
 
-	case "PrivateRoutineTag.name":
-		if e.complexity.PrivateRoutineTag.Name == nil {
+	case "MaterialPayload.updatedAt":
+		if e.complexity.MaterialPayload.UpdatedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutineTag.Name(childComplexity), true
+		return e.complexity.MaterialPayload.UpdatedAt(childComplexity), true
 
-	case "PrivateRoutineTag.updatedAt":
-		if e.complexity.PrivateRoutineTag.UpdatedAt == nil {
+	case "MoveBlockPackByParentSubShelfIdPayload.updatedAt":
+		if e.complexity.MoveBlockPackByParentSubShelfIdPayload.UpdatedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutineTag.UpdatedAt(childComplexity), true
+		return e.complexity.MoveBlockPackByParentSubShelfIdPayload.UpdatedAt(childComplexity), true
 
-	case "PrivateRoutineTask.actualEndedAt":
-		if e.complexity.PrivateRoutineTask.ActualEndedAt == nil {
+	case "MoveBlockPacksByParentSubShelfIdPayload.updatedAt":
+		if e.complexity.MoveBlockPacksByParentSubShelfIdPayload.UpdatedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutineTask.ActualEndedAt(childComplexity), true
+		return e.complexity.MoveBlockPacksByParentSubShelfIdPayload.UpdatedAt(childComplexity), true
 
-	case "PrivateRoutineTask.actualStartedAt":
-		if e.complexity.PrivateRoutineTask.ActualStartedAt == nil {
+	case "MoveBlockPacksByParentSubShelfIdsPayload.updatedAt":
+		if e.complexity.MoveBlockPacksByParentSubShelfIdsPayload.UpdatedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutineTask.ActualStartedAt(childComplexity), true
+		return e.complexity.MoveBlockPacksByParentSubShelfIdsPayload.UpdatedAt(childComplexity), true
 
-	case "PrivateRoutineTask.attempts":
-		if e.complexity.PrivateRoutineTask.Attempts == nil {
+	case "MoveMaterialPayload.updatedAt":
+		if e.complexity.MoveMaterialPayload.UpdatedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutineTask.Attempts(childComplexity), true
+		return e.complexity.MoveMaterialPayload.UpdatedAt(childComplexity), true
 
-	case "PrivateRoutineTask.costUnit":
-		if e.complexity.PrivateRoutineTask.CostUnit == nil {
+	case "MoveMaterialsPayload.updatedAt":
+		if e.complexity.MoveMaterialsPayload.UpdatedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutineTask.CostUnit(childComplexity), true
+		return e.complexity.MoveMaterialsPayload.UpdatedAt(childComplexity), true
 
-	case "PrivateRoutineTask.createdAt":
-		if e.complexity.PrivateRoutineTask.CreatedAt == nil {
+	case "MoveSubShelfByRootShelfIdPayload.updatedAt":
+		if e.complexity.MoveSubShelfByRootShelfIdPayload.UpdatedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutineTask.CreatedAt(childComplexity), true
+		return e.complexity.MoveSubShelfByRootShelfIdPayload.UpdatedAt(childComplexity), true
 
-	case "PrivateRoutineTask.id":
-		if e.complexity.PrivateRoutineTask.ID == nil {
+	case "MoveSubShelvesByRootShelfIdPayload.updatedAt":
+		if e.complexity.MoveSubShelvesByRootShelfIdPayload.UpdatedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutineTask.ID(childComplexity), true
+		return e.complexity.MoveSubShelvesByRootShelfIdPayload.UpdatedAt(childComplexity), true
 
-	case "PrivateRoutineTask.maxAttempts":
-		if e.complexity.PrivateRoutineTask.MaxAttempts == nil {
+	case "MoveSubShelvesByRootShelfIdsPayload.updatedAt":
+		if e.complexity.MoveSubShelvesByRootShelfIdsPayload.UpdatedAt == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutineTask.MaxAttempts(childComplexity), true
+		return e.complexity.MoveSubShelvesByRootShelfIdsPayload.UpdatedAt(childComplexity), true
 
-	case "PrivateRoutineTask.nextScheduledAt":
-		if e.complexity.PrivateRoutineTask.NextScheduledAt == nil {
+	case "Mutation.createBlockPack":
+		if e.complexity.Mutation.CreateBlockPack == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutineTask.NextScheduledAt(childComplexity), true
-
-	case "PrivateRoutineTask.payload":
-		if e.complexity.PrivateRoutineTask.Payload == nil {
-			break
+		args, err := ec.field_Mutation_createBlockPack_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PrivateRoutineTask.Payload(childComplexity), true
+		return e.complexity.Mutation.CreateBlockPack(childComplexity, args["input"].(gqlmodels.CreatableBlockPackInput)), true
 
-	case "PrivateRoutineTask.period":
-		if e.complexity.PrivateRoutineTask.Period == nil {
+	case "Mutation.createBlockPacks":
+		if e.complexity.Mutation.CreateBlockPacks == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutineTask.Period(childComplexity), true
-
-	case "PrivateRoutineTask.priority":
-		if e.complexity.PrivateRoutineTask.Priority == nil {
-			break
+		args, err := ec.field_Mutation_createBlockPacks_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PrivateRoutineTask.Priority(childComplexity), true
+		return e.complexity.Mutation.CreateBlockPacks(childComplexity, args["input"].(gqlmodels.CreateBlockPacksInput)), true
 
-	case "PrivateRoutineTask.purpose":
-		if e.complexity.PrivateRoutineTask.Purpose == nil {
+	case "Mutation.createMaterial":
+		if e.complexity.Mutation.CreateMaterial == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutineTask.Purpose(childComplexity), true
+		args, err := ec.field_Mutation_createMaterial_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "PrivateRoutineTask.routineId":
-		if e.complexity.PrivateRoutineTask.RoutineID == nil {
+		return e.complexity.Mutation.CreateMaterial(childComplexity, args["input"].(gqlmodels.CreatableMaterialInput)), true
+
+	case "Mutation.createRootShelf":
+		if e.complexity.Mutation.CreateRootShelf == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutineTask.RoutineID(childComplexity), true
-
-	case "PrivateRoutineTask.scheduledAt":
-		if e.complexity.PrivateRoutineTask.ScheduledAt == nil {
-			break
+		args, err := ec.field_Mutation_createRootShelf_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PrivateRoutineTask.ScheduledAt(childComplexity), true
+		return e.complexity.Mutation.CreateRootShelf(childComplexity, args["input"].(gqlmodels.CreatableRootShelfInput)), true
 
-	case "PrivateRoutineTask.status":
-		if e.complexity.PrivateRoutineTask.Status == nil {
+	case "Mutation.createRootShelfPermission":
+		if e.complexity.Mutation.CreateRootShelfPermission == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutineTask.Status(childComplexity), true
-
-	case "PrivateRoutineTask.title":
-		if e.complexity.PrivateRoutineTask.Title == nil {
-			break
+		args, err := ec.field_Mutation_createRootShelfPermission_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PrivateRoutineTask.Title(childComplexity), true
+		return e.complexity.Mutation.CreateRootShelfPermission(childComplexity, args["input"].(gqlmodels.CreateRootShelfPermissionInput)), true
 
-	case "PrivateRoutineTask.updatedAt":
-		if e.complexity.PrivateRoutineTask.UpdatedAt == nil {
+	case "Mutation.createRootShelves":
+		if e.complexity.Mutation.CreateRootShelves == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutineTask.UpdatedAt(childComplexity), true
-
-	case "PrivateRoutineTaskRecord.actualEndedAt":
-		if e.complexity.PrivateRoutineTaskRecord.ActualEndedAt == nil {
-			break
+		args, err := ec.field_Mutation_createRootShelves_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PrivateRoutineTaskRecord.ActualEndedAt(childComplexity), true
+		return e.complexity.Mutation.CreateRootShelves(childComplexity, args["input"].(gqlmodels.CreateRootShelvesInput)), true
 
-	case "PrivateRoutineTaskRecord.actualStartedAt":
-		if e.complexity.PrivateRoutineTaskRecord.ActualStartedAt == nil {
+	case "Mutation.createRoutineByStationId":
+		if e.complexity.Mutation.CreateRoutineByStationID == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutineTaskRecord.ActualStartedAt(childComplexity), true
-
-	case "PrivateRoutineTaskRecord.costUnit":
-		if e.complexity.PrivateRoutineTaskRecord.CostUnit == nil {
-			break
+		args, err := ec.field_Mutation_createRoutineByStationId_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PrivateRoutineTaskRecord.CostUnit(childComplexity), true
+		return e.complexity.Mutation.CreateRoutineByStationID(childComplexity, args["input"].(gqlmodels.CreatableRoutineInput)), true
 
-	case "PrivateRoutineTaskRecord.createdAt":
-		if e.complexity.PrivateRoutineTaskRecord.CreatedAt == nil {
+	case "Mutation.createRoutineTaskByRoutineId":
+		if e.complexity.Mutation.CreateRoutineTaskByRoutineID == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutineTaskRecord.CreatedAt(childComplexity), true
-
-	case "PrivateRoutineTaskRecord.errorCode":
-		if e.complexity.PrivateRoutineTaskRecord.ErrorCode == nil {
-			break
+		args, err := ec.field_Mutation_createRoutineTaskByRoutineId_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PrivateRoutineTaskRecord.ErrorCode(childComplexity), true
+		return e.complexity.Mutation.CreateRoutineTaskByRoutineID(childComplexity, args["input"].(gqlmodels.CreatableRoutineTaskInput)), true
 
-	case "PrivateRoutineTaskRecord.errorReason":
-		if e.complexity.PrivateRoutineTaskRecord.ErrorReason == nil {
+	case "Mutation.createRoutinesByStationIds":
+		if e.complexity.Mutation.CreateRoutinesByStationIds == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutineTaskRecord.ErrorReason(childComplexity), true
-
-	case "PrivateRoutineTaskRecord.id":
-		if e.complexity.PrivateRoutineTaskRecord.ID == nil {
-			break
+		args, err := ec.field_Mutation_createRoutinesByStationIds_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PrivateRoutineTaskRecord.ID(childComplexity), true
+		return e.complexity.Mutation.CreateRoutinesByStationIds(childComplexity, args["input"].(gqlmodels.CreateRoutinesByStationIdsInput)), true
 
-	case "PrivateRoutineTaskRecord.purpose":
-		if e.complexity.PrivateRoutineTaskRecord.Purpose == nil {
+	case "Mutation.createStation":
+		if e.complexity.Mutation.CreateStation == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutineTaskRecord.Purpose(childComplexity), true
-
-	case "PrivateRoutineTaskRecord.routineTaskId":
-		if e.complexity.PrivateRoutineTaskRecord.RoutineTaskID == nil {
-			break
+		args, err := ec.field_Mutation_createStation_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PrivateRoutineTaskRecord.RoutineTaskID(childComplexity), true
+		return e.complexity.Mutation.CreateStation(childComplexity, args["input"].(gqlmodels.CreatableStationInput)), true
 
-	case "PrivateRoutineTaskRecord.scheduledAt":
-		if e.complexity.PrivateRoutineTaskRecord.ScheduledAt == nil {
+	case "Mutation.createStationPermission":
+		if e.complexity.Mutation.CreateStationPermission == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutineTaskRecord.ScheduledAt(childComplexity), true
-
-	case "PrivateRoutineTaskRecord.status":
-		if e.complexity.PrivateRoutineTaskRecord.Status == nil {
-			break
+		args, err := ec.field_Mutation_createStationPermission_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PrivateRoutineTaskRecord.Status(childComplexity), true
+		return e.complexity.Mutation.CreateStationPermission(childComplexity, args["input"].(gqlmodels.CreateStationPermissionInput)), true
 
-	case "PrivateRoutineTaskRecord.totalAttempts":
-		if e.complexity.PrivateRoutineTaskRecord.TotalAttempts == nil {
+	case "Mutation.createStations":
+		if e.complexity.Mutation.CreateStations == nil {
 			break
 		}
 
-		return e.complexity.PrivateRoutineTaskRecord.TotalAttempts(childComplexity), true
-
-	case "PrivateRoutineTaskRecord.updatedAt":
-		if e.complexity.PrivateRoutineTaskRecord.UpdatedAt == nil {
-			break
+		args, err := ec.field_Mutation_createStations_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PrivateRoutineTaskRecord.UpdatedAt(childComplexity), true
+		return e.complexity.Mutation.CreateStations(childComplexity, args["input"].(gqlmodels.CreateStationsInput)), true
 
-	case "PrivateSearchableRoutine.createdAt":
-		if e.complexity.PrivateSearchableRoutine.CreatedAt == nil {
+	case "Mutation.createSubShelfByRootShelfId":
+		if e.complexity.Mutation.CreateSubShelfByRootShelfID == nil {
 			break
 		}
 
-		return e.complexity.PrivateSearchableRoutine.CreatedAt(childComplexity), true
-
-	case "PrivateSearchableRoutine.deletedAt":
-		if e.complexity.PrivateSearchableRoutine.DeletedAt == nil {
-			break
+		args, err := ec.field_Mutation_createSubShelfByRootShelfId_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PrivateSearchableRoutine.DeletedAt(childComplexity), true
+		return e.complexity.Mutation.CreateSubShelfByRootShelfID(childComplexity, args["input"].(gqlmodels.CreatableSubShelfInput)), true
 
-	case "PrivateSearchableRoutine.id":
-		if e.complexity.PrivateSearchableRoutine.ID == nil {
+	case "Mutation.createSubShelvesByRootShelfIds":
+		if e.complexity.Mutation.CreateSubShelvesByRootShelfIds == nil {
 			break
 		}
 
-		return e.complexity.PrivateSearchableRoutine.ID(childComplexity), true
-
-	case "PrivateSearchableRoutine.isPinned":
-		if e.complexity.PrivateSearchableRoutine.IsPinned == nil {
-			break
+		args, err := ec.field_Mutation_createSubShelvesByRootShelfIds_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PrivateSearchableRoutine.IsPinned(childComplexity), true
+		return e.complexity.Mutation.CreateSubShelvesByRootShelfIds(childComplexity, args["input"].(gqlmodels.CreateSubShelvesByRootShelfIdsInput)), true
 
-	case "PrivateSearchableRoutine.itemIds":
-		if e.complexity.PrivateSearchableRoutine.ItemIds == nil {
+	case "Mutation.deleteBlockPack":
+		if e.complexity.Mutation.DeleteBlockPack == nil {
 			break
 		}
 
-		return e.complexity.PrivateSearchableRoutine.ItemIds(childComplexity), true
-
-	case "PrivateSearchableRoutine.period":
-		if e.complexity.PrivateSearchableRoutine.Period == nil {
-			break
+		args, err := ec.field_Mutation_deleteBlockPack_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PrivateSearchableRoutine.Period(childComplexity), true
+		return e.complexity.Mutation.DeleteBlockPack(childComplexity, args["input"].(gqlmodels.DeleteBlockPackInput)), true
 
-	case "PrivateSearchableRoutine.scheduledEndAt":
-		if e.complexity.PrivateSearchableRoutine.ScheduledEndAt == nil {
+	case "Mutation.deleteBlockPacks":
+		if e.complexity.Mutation.DeleteBlockPacks == nil {
 			break
 		}
 
-		return e.complexity.PrivateSearchableRoutine.ScheduledEndAt(childComplexity), true
-
-	case "PrivateSearchableRoutine.scheduledStartAt":
-		if e.complexity.PrivateSearchableRoutine.ScheduledStartAt == nil {
-			break
+		args, err := ec.field_Mutation_deleteBlockPacks_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PrivateSearchableRoutine.ScheduledStartAt(childComplexity), true
+		return e.complexity.Mutation.DeleteBlockPacks(childComplexity, args["input"].(gqlmodels.DeleteBlockPacksInput)), true
 
-	case "PrivateSearchableRoutine.stationId":
-		if e.complexity.PrivateSearchableRoutine.StationID == nil {
+	case "Mutation.deleteMaterial":
+		if e.complexity.Mutation.DeleteMaterial == nil {
 			break
 		}
 
-		return e.complexity.PrivateSearchableRoutine.StationID(childComplexity), true
-
-	case "PrivateSearchableRoutine.status":
-		if e.complexity.PrivateSearchableRoutine.Status == nil {
-			break
+		args, err := ec.field_Mutation_deleteMaterial_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PrivateSearchableRoutine.Status(childComplexity), true
+		return e.complexity.Mutation.DeleteMaterial(childComplexity, args["input"].(gqlmodels.DeleteMaterialInput)), true
 
-	case "PrivateSearchableRoutine.tagIds":
-		if e.complexity.PrivateSearchableRoutine.TagIds == nil {
+	case "Mutation.deleteMaterials":
+		if e.complexity.Mutation.DeleteMaterials == nil {
 			break
 		}
 
-		return e.complexity.PrivateSearchableRoutine.TagIds(childComplexity), true
-
-	case "PrivateSearchableRoutine.taskIds":
-		if e.complexity.PrivateSearchableRoutine.TaskIds == nil {
-			break
+		args, err := ec.field_Mutation_deleteMaterials_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PrivateSearchableRoutine.TaskIds(childComplexity), true
+		return e.complexity.Mutation.DeleteMaterials(childComplexity, args["input"].(gqlmodels.DeleteMaterialsInput)), true
 
-	case "PrivateSearchableRoutine.timezone":
-		if e.complexity.PrivateSearchableRoutine.Timezone == nil {
+	case "Mutation.deleteRootShelf":
+		if e.complexity.Mutation.DeleteRootShelf == nil {
 			break
 		}
 
-		return e.complexity.PrivateSearchableRoutine.Timezone(childComplexity), true
-
-	case "PrivateSearchableRoutine.title":
-		if e.complexity.PrivateSearchableRoutine.Title == nil {
-			break
+		args, err := ec.field_Mutation_deleteRootShelf_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PrivateSearchableRoutine.Title(childComplexity), true
+		return e.complexity.Mutation.DeleteRootShelf(childComplexity, args["input"].(gqlmodels.DeleteRootShelfInput)), true
 
-	case "PrivateSearchableRoutine.updatedAt":
-		if e.complexity.PrivateSearchableRoutine.UpdatedAt == nil {
+	case "Mutation.deleteRootShelfPermission":
+		if e.complexity.Mutation.DeleteRootShelfPermission == nil {
 			break
 		}
 
-		return e.complexity.PrivateSearchableRoutine.UpdatedAt(childComplexity), true
-
-	case "PrivateSearchableStation.createdAt":
-		if e.complexity.PrivateSearchableStation.CreatedAt == nil {
-			break
+		args, err := ec.field_Mutation_deleteRootShelfPermission_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PrivateSearchableStation.CreatedAt(childComplexity), true
+		return e.complexity.Mutation.DeleteRootShelfPermission(childComplexity, args["input"].(gqlmodels.DeleteRootShelfPermissionInput)), true
 
-	case "PrivateSearchableStation.deletedAt":
-		if e.complexity.PrivateSearchableStation.DeletedAt == nil {
+	case "Mutation.deleteRootShelfPermissions":
+		if e.complexity.Mutation.DeleteRootShelfPermissions == nil {
 			break
 		}
 
-		return e.complexity.PrivateSearchableStation.DeletedAt(childComplexity), true
-
-	case "PrivateSearchableStation.headerBackgroundURL":
-		if e.complexity.PrivateSearchableStation.HeaderBackgroundURL == nil {
-			break
+		args, err := ec.field_Mutation_deleteRootShelfPermissions_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PrivateSearchableStation.HeaderBackgroundURL(childComplexity), true
+		return e.complexity.Mutation.DeleteRootShelfPermissions(childComplexity, args["input"].(gqlmodels.DeleteRootShelfPermissionsInput)), true
 
-	case "PrivateSearchableStation.id":
-		if e.complexity.PrivateSearchableStation.ID == nil {
+	case "Mutation.deleteRootShelves":
+		if e.complexity.Mutation.DeleteRootShelves == nil {
 			break
 		}
 
-		return e.complexity.PrivateSearchableStation.ID(childComplexity), true
-
-	case "PrivateSearchableStation.icon":
-		if e.complexity.PrivateSearchableStation.Icon == nil {
-			break
+		args, err := ec.field_Mutation_deleteRootShelves_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PrivateSearchableStation.Icon(childComplexity), true
+		return e.complexity.Mutation.DeleteRootShelves(childComplexity, args["input"].(gqlmodels.DeleteRootShelvesInput)), true
 
-	case "PrivateSearchableStation.name":
-		if e.complexity.PrivateSearchableStation.Name == nil {
+	case "Mutation.deleteRoutine":
+		if e.complexity.Mutation.DeleteRoutine == nil {
 			break
 		}
 
-		return e.complexity.PrivateSearchableStation.Name(childComplexity), true
-
-	case "PrivateSearchableStation.permission":
-		if e.complexity.PrivateSearchableStation.Permission == nil {
-			break
+		args, err := ec.field_Mutation_deleteRoutine_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PrivateSearchableStation.Permission(childComplexity), true
+		return e.complexity.Mutation.DeleteRoutine(childComplexity, args["input"].(gqlmodels.DeleteRoutineInput)), true
 
-	case "PrivateSearchableStation.routineCount":
-		if e.complexity.PrivateSearchableStation.RoutineCount == nil {
+	case "Mutation.deleteRoutines":
+		if e.complexity.Mutation.DeleteRoutines == nil {
 			break
 		}
 
-		return e.complexity.PrivateSearchableStation.RoutineCount(childComplexity), true
-
-	case "PrivateSearchableStation.updatedAt":
-		if e.complexity.PrivateSearchableStation.UpdatedAt == nil {
-			break
+		args, err := ec.field_Mutation_deleteRoutines_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PrivateSearchableStation.UpdatedAt(childComplexity), true
+		return e.complexity.Mutation.DeleteRoutines(childComplexity, args["input"].(gqlmodels.DeleteRoutinesInput)), true
 
-	case "PrivateStation.createdAt":
-		if e.complexity.PrivateStation.CreatedAt == nil {
+	case "Mutation.deleteStation":
+		if e.complexity.Mutation.DeleteStation == nil {
 			break
 		}
 
-		return e.complexity.PrivateStation.CreatedAt(childComplexity), true
-
-	case "PrivateStation.deletedAt":
-		if e.complexity.PrivateStation.DeletedAt == nil {
-			break
+		args, err := ec.field_Mutation_deleteStation_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PrivateStation.DeletedAt(childComplexity), true
+		return e.complexity.Mutation.DeleteStation(childComplexity, args["input"].(gqlmodels.DeleteStationInput)), true
 
-	case "PrivateStation.description":
-		if e.complexity.PrivateStation.Description == nil {
+	case "Mutation.deleteStationPermission":
+		if e.complexity.Mutation.DeleteStationPermission == nil {
 			break
 		}
 
-		return e.complexity.PrivateStation.Description(childComplexity), true
-
-	case "PrivateStation.headerBackgroundURL":
-		if e.complexity.PrivateStation.HeaderBackgroundURL == nil {
-			break
+		args, err := ec.field_Mutation_deleteStationPermission_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PrivateStation.HeaderBackgroundURL(childComplexity), true
+		return e.complexity.Mutation.DeleteStationPermission(childComplexity, args["input"].(gqlmodels.DeleteStationPermissionInput)), true
 
-	case "PrivateStation.id":
-		if e.complexity.PrivateStation.ID == nil {
+	case "Mutation.deleteStationPermissions":
+		if e.complexity.Mutation.DeleteStationPermissions == nil {
 			break
 		}
 
-		return e.complexity.PrivateStation.ID(childComplexity), true
-
-	case "PrivateStation.icon":
-		if e.complexity.PrivateStation.Icon == nil {
-			break
+		args, err := ec.field_Mutation_deleteStationPermissions_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PrivateStation.Icon(childComplexity), true
+		return e.complexity.Mutation.DeleteStationPermissions(childComplexity, args["input"].(gqlmodels.DeleteStationPermissionsInput)), true
 
-	case "PrivateStation.name":
-		if e.complexity.PrivateStation.Name == nil {
+	case "Mutation.deleteStations":
+		if e.complexity.Mutation.DeleteStations == nil {
 			break
 		}
 
-		return e.complexity.PrivateStation.Name(childComplexity), true
-
-	case "PrivateStation.permission":
-		if e.complexity.PrivateStation.Permission == nil {
-			break
+		args, err := ec.field_Mutation_deleteStations_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PrivateStation.Permission(childComplexity), true
+		return e.complexity.Mutation.DeleteStations(childComplexity, args["input"].(gqlmodels.DeleteStationsInput)), true
 
-	case "PrivateStation.routineCount":
-		if e.complexity.PrivateStation.RoutineCount == nil {
+	case "Mutation.deleteSubShelf":
+		if e.complexity.Mutation.DeleteSubShelf == nil {
 			break
 		}
 
-		return e.complexity.PrivateStation.RoutineCount(childComplexity), true
+		args, err := ec.field_Mutation_deleteSubShelf_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "PrivateStation.updatedAt":
-		if e.complexity.PrivateStation.UpdatedAt == nil {
+		return e.complexity.Mutation.DeleteSubShelf(childComplexity, args["input"].(gqlmodels.DeleteSubShelfInput)), true
+
+	case "Mutation.deleteSubShelves":
+		if e.complexity.Mutation.DeleteSubShelves == nil {
 			break
 		}
 
-		return e.complexity.PrivateStation.UpdatedAt(childComplexity), true
-
-	case "PrivateSubShelf.createdAt":
-		if e.complexity.PrivateSubShelf.CreatedAt == nil {
-			break
+		args, err := ec.field_Mutation_deleteSubShelves_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PrivateSubShelf.CreatedAt(childComplexity), true
+		return e.complexity.Mutation.DeleteSubShelves(childComplexity, args["input"].(gqlmodels.DeleteSubShelvesInput)), true
 
-	case "PrivateSubShelf.deletedAt":
-		if e.complexity.PrivateSubShelf.DeletedAt == nil {
+	case "Mutation.hardDeleteRoutine":
+		if e.complexity.Mutation.HardDeleteRoutine == nil {
 			break
 		}
 
-		return e.complexity.PrivateSubShelf.DeletedAt(childComplexity), true
-
-	case "PrivateSubShelf.id":
-		if e.complexity.PrivateSubShelf.ID == nil {
-			break
+		args, err := ec.field_Mutation_hardDeleteRoutine_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PrivateSubShelf.ID(childComplexity), true
+		return e.complexity.Mutation.HardDeleteRoutine(childComplexity, args["input"].(gqlmodels.HardDeleteRoutineInput)), true
 
-	case "PrivateSubShelf.itemIds":
-		if e.complexity.PrivateSubShelf.ItemIds == nil {
+	case "Mutation.hardDeleteRoutineTask":
+		if e.complexity.Mutation.HardDeleteRoutineTask == nil {
 			break
 		}
 
-		return e.complexity.PrivateSubShelf.ItemIds(childComplexity), true
-
-	case "PrivateSubShelf.name":
-		if e.complexity.PrivateSubShelf.Name == nil {
-			break
+		args, err := ec.field_Mutation_hardDeleteRoutineTask_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PrivateSubShelf.Name(childComplexity), true
+		return e.complexity.Mutation.HardDeleteRoutineTask(childComplexity, args["input"].(gqlmodels.HardDeleteRoutineTaskInput)), true
 
-	case "PrivateSubShelf.nextSubShelfIds":
-		if e.complexity.PrivateSubShelf.NextSubShelfIds == nil {
+	case "Mutation.hardDeleteRoutineTasks":
+		if e.complexity.Mutation.HardDeleteRoutineTasks == nil {
 			break
 		}
 
-		return e.complexity.PrivateSubShelf.NextSubShelfIds(childComplexity), true
-
-	case "PrivateSubShelf.path":
-		if e.complexity.PrivateSubShelf.Path == nil {
-			break
+		args, err := ec.field_Mutation_hardDeleteRoutineTasks_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PrivateSubShelf.Path(childComplexity), true
+		return e.complexity.Mutation.HardDeleteRoutineTasks(childComplexity, args["input"].(gqlmodels.HardDeleteRoutineTasksInput)), true
 
-	case "PrivateSubShelf.prevSubShelfId":
-		if e.complexity.PrivateSubShelf.PrevSubShelfID == nil {
+	case "Mutation.hardDeleteRoutines":
+		if e.complexity.Mutation.HardDeleteRoutines == nil {
 			break
 		}
 
-		return e.complexity.PrivateSubShelf.PrevSubShelfID(childComplexity), true
-
-	case "PrivateSubShelf.rootShelfId":
-		if e.complexity.PrivateSubShelf.RootShelfID == nil {
-			break
+		args, err := ec.field_Mutation_hardDeleteRoutines_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PrivateSubShelf.RootShelfID(childComplexity), true
+		return e.complexity.Mutation.HardDeleteRoutines(childComplexity, args["input"].(gqlmodels.HardDeleteRoutinesInput)), true
 
-	case "PrivateSubShelf.updatedAt":
-		if e.complexity.PrivateSubShelf.UpdatedAt == nil {
+	case "Mutation.hardDeleteStation":
+		if e.complexity.Mutation.HardDeleteStation == nil {
 			break
 		}
 
-		return e.complexity.PrivateSubShelf.UpdatedAt(childComplexity), true
-
-	case "PublicBadge.createdAt":
-		if e.complexity.PublicBadge.CreatedAt == nil {
-			break
+		args, err := ec.field_Mutation_hardDeleteStation_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PublicBadge.CreatedAt(childComplexity), true
+		return e.complexity.Mutation.HardDeleteStation(childComplexity, args["input"].(gqlmodels.HardDeleteStationInput)), true
 
-	case "PublicBadge.description":
-		if e.complexity.PublicBadge.Description == nil {
+	case "Mutation.hardDeleteStations":
+		if e.complexity.Mutation.HardDeleteStations == nil {
 			break
 		}
 
-		return e.complexity.PublicBadge.Description(childComplexity), true
-
-	case "PublicBadge.imageURL":
-		if e.complexity.PublicBadge.ImageURL == nil {
-			break
+		args, err := ec.field_Mutation_hardDeleteStations_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PublicBadge.ImageURL(childComplexity), true
+		return e.complexity.Mutation.HardDeleteStations(childComplexity, args["input"].(gqlmodels.HardDeleteStationsInput)), true
 
-	case "PublicBadge.publicId":
-		if e.complexity.PublicBadge.PublicID == nil {
+	case "Mutation.leaveRootShelf":
+		if e.complexity.Mutation.LeaveRootShelf == nil {
 			break
 		}
 
-		return e.complexity.PublicBadge.PublicID(childComplexity), true
-
-	case "PublicBadge.title":
-		if e.complexity.PublicBadge.Title == nil {
-			break
+		args, err := ec.field_Mutation_leaveRootShelf_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PublicBadge.Title(childComplexity), true
+		return e.complexity.Mutation.LeaveRootShelf(childComplexity, args["input"].(gqlmodels.LeaveRootShelfInput)), true
 
-	case "PublicBadge.type":
-		if e.complexity.PublicBadge.Type == nil {
+	case "Mutation.leaveRootShelves":
+		if e.complexity.Mutation.LeaveRootShelves == nil {
 			break
 		}
 
-		return e.complexity.PublicBadge.Type(childComplexity), true
-
-	case "PublicTheme.authorId":
-		if e.complexity.PublicTheme.AuthorID == nil {
-			break
+		args, err := ec.field_Mutation_leaveRootShelves_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PublicTheme.AuthorID(childComplexity), true
+		return e.complexity.Mutation.LeaveRootShelves(childComplexity, args["input"].(gqlmodels.LeaveRootShelvesInput)), true
 
-	case "PublicTheme.createdAt":
-		if e.complexity.PublicTheme.CreatedAt == nil {
+	case "Mutation.leaveStation":
+		if e.complexity.Mutation.LeaveStation == nil {
 			break
 		}
 
-		return e.complexity.PublicTheme.CreatedAt(childComplexity), true
-
-	case "PublicTheme.downloadCount":
-		if e.complexity.PublicTheme.DownloadCount == nil {
-			break
+		args, err := ec.field_Mutation_leaveStation_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PublicTheme.DownloadCount(childComplexity), true
+		return e.complexity.Mutation.LeaveStation(childComplexity, args["input"].(gqlmodels.LeaveStationInput)), true
 
-	case "PublicTheme.downloadURL":
-		if e.complexity.PublicTheme.DownloadURL == nil {
+	case "Mutation.leaveStations":
+		if e.complexity.Mutation.LeaveStations == nil {
 			break
 		}
 
-		return e.complexity.PublicTheme.DownloadURL(childComplexity), true
-
-	case "PublicTheme.isDark":
-		if e.complexity.PublicTheme.IsDark == nil {
-			break
+		args, err := ec.field_Mutation_leaveStations_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PublicTheme.IsDark(childComplexity), true
+		return e.complexity.Mutation.LeaveStations(childComplexity, args["input"].(gqlmodels.LeaveStationsInput)), true
 
-	case "PublicTheme.isDefault":
-		if e.complexity.PublicTheme.IsDefault == nil {
+	case "Mutation.linkRoutineItem":
+		if e.complexity.Mutation.LinkRoutineItem == nil {
 			break
 		}
 
-		return e.complexity.PublicTheme.IsDefault(childComplexity), true
-
-	case "PublicTheme.name":
-		if e.complexity.PublicTheme.Name == nil {
-			break
+		args, err := ec.field_Mutation_linkRoutineItem_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PublicTheme.Name(childComplexity), true
+		return e.complexity.Mutation.LinkRoutineItem(childComplexity, args["input"].(gqlmodels.LinkRoutineItemInput)), true
 
-	case "PublicTheme.publicId":
-		if e.complexity.PublicTheme.PublicID == nil {
+	case "Mutation.linkRoutineItems":
+		if e.complexity.Mutation.LinkRoutineItems == nil {
 			break
 		}
 
-		return e.complexity.PublicTheme.PublicID(childComplexity), true
-
-	case "PublicTheme.updatedAt":
-		if e.complexity.PublicTheme.UpdatedAt == nil {
-			break
+		args, err := ec.field_Mutation_linkRoutineItems_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PublicTheme.UpdatedAt(childComplexity), true
+		return e.complexity.Mutation.LinkRoutineItems(childComplexity, args["input"].(gqlmodels.LinkRoutineItemsInput)), true
 
-	case "PublicTheme.version":
-		if e.complexity.PublicTheme.Version == nil {
+	case "Mutation.linkRoutineTag":
+		if e.complexity.Mutation.LinkRoutineTag == nil {
 			break
 		}
 
-		return e.complexity.PublicTheme.Version(childComplexity), true
-
-	case "PublicUser.createdAt":
-		if e.complexity.PublicUser.CreatedAt == nil {
-			break
+		args, err := ec.field_Mutation_linkRoutineTag_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PublicUser.CreatedAt(childComplexity), true
+		return e.complexity.Mutation.LinkRoutineTag(childComplexity, args["input"].(gqlmodels.LinkRoutineTagInput)), true
 
-	case "PublicUser.displayName":
-		if e.complexity.PublicUser.DisplayName == nil {
+	case "Mutation.linkRoutineTags":
+		if e.complexity.Mutation.LinkRoutineTags == nil {
 			break
 		}
 
-		return e.complexity.PublicUser.DisplayName(childComplexity), true
-
-	case "PublicUser.info":
-		if e.complexity.PublicUser.Info == nil {
-			break
+		args, err := ec.field_Mutation_linkRoutineTags_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PublicUser.Info(childComplexity), true
+		return e.complexity.Mutation.LinkRoutineTags(childComplexity, args["input"].(gqlmodels.LinkRoutineTagsInput)), true
 
-	case "PublicUser.name":
-		if e.complexity.PublicUser.Name == nil {
+	case "Mutation.moveBlockPackByParentSubShelfId":
+		if e.complexity.Mutation.MoveBlockPackByParentSubShelfID == nil {
 			break
 		}
 
-		return e.complexity.PublicUser.Name(childComplexity), true
-
-	case "PublicUser.plan":
-		if e.complexity.PublicUser.Plan == nil {
-			break
+		args, err := ec.field_Mutation_moveBlockPackByParentSubShelfId_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PublicUser.Plan(childComplexity), true
+		return e.complexity.Mutation.MoveBlockPackByParentSubShelfID(childComplexity, args["input"].(gqlmodels.MoveBlockPackByParentSubShelfIDInput)), true
 
-	case "PublicUser.publicId":
-		if e.complexity.PublicUser.PublicID == nil {
+	case "Mutation.moveBlockPacksByParentSubShelfId":
+		if e.complexity.Mutation.MoveBlockPacksByParentSubShelfID == nil {
 			break
 		}
 
-		return e.complexity.PublicUser.PublicID(childComplexity), true
-
-	case "PublicUser.role":
-		if e.complexity.PublicUser.Role == nil {
-			break
+		args, err := ec.field_Mutation_moveBlockPacksByParentSubShelfId_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PublicUser.Role(childComplexity), true
+		return e.complexity.Mutation.MoveBlockPacksByParentSubShelfID(childComplexity, args["input"].(gqlmodels.MovableBlockPackInput)), true
 
-	case "PublicUser.status":
-		if e.complexity.PublicUser.Status == nil {
+	case "Mutation.moveBlockPacksByParentSubShelfIds":
+		if e.complexity.Mutation.MoveBlockPacksByParentSubShelfIds == nil {
 			break
 		}
 
-		return e.complexity.PublicUser.Status(childComplexity), true
-
-	case "PublicUserInfo.avatarURL":
-		if e.complexity.PublicUserInfo.AvatarURL == nil {
-			break
+		args, err := ec.field_Mutation_moveBlockPacksByParentSubShelfIds_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PublicUserInfo.AvatarURL(childComplexity), true
+		return e.complexity.Mutation.MoveBlockPacksByParentSubShelfIds(childComplexity, args["input"].(gqlmodels.MoveBlockPacksByParentSubShelfIdsInput)), true
 
-	case "PublicUserInfo.birthDate":
-		if e.complexity.PublicUserInfo.BirthDate == nil {
+	case "Mutation.moveMaterial":
+		if e.complexity.Mutation.MoveMaterial == nil {
 			break
 		}
 
-		return e.complexity.PublicUserInfo.BirthDate(childComplexity), true
-
-	case "PublicUserInfo.country":
-		if e.complexity.PublicUserInfo.Country == nil {
-			break
+		args, err := ec.field_Mutation_moveMaterial_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PublicUserInfo.Country(childComplexity), true
+		return e.complexity.Mutation.MoveMaterial(childComplexity, args["input"].(gqlmodels.MoveMaterialInput)), true
 
-	case "PublicUserInfo.coverBackgroundURL":
-		if e.complexity.PublicUserInfo.CoverBackgroundURL == nil {
+	case "Mutation.moveMaterials":
+		if e.complexity.Mutation.MoveMaterials == nil {
 			break
 		}
 
-		return e.complexity.PublicUserInfo.CoverBackgroundURL(childComplexity), true
-
-	case "PublicUserInfo.gender":
-		if e.complexity.PublicUserInfo.Gender == nil {
-			break
+		args, err := ec.field_Mutation_moveMaterials_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PublicUserInfo.Gender(childComplexity), true
+		return e.complexity.Mutation.MoveMaterials(childComplexity, args["input"].(gqlmodels.MoveMaterialsInput)), true
 
-	case "PublicUserInfo.header":
-		if e.complexity.PublicUserInfo.Header == nil {
+	case "Mutation.moveSubShelfByRootShelfId":
+		if e.complexity.Mutation.MoveSubShelfByRootShelfID == nil {
 			break
 		}
 
-		return e.complexity.PublicUserInfo.Header(childComplexity), true
-
-	case "PublicUserInfo.introduction":
-		if e.complexity.PublicUserInfo.Introduction == nil {
-			break
+		args, err := ec.field_Mutation_moveSubShelfByRootShelfId_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PublicUserInfo.Introduction(childComplexity), true
+		return e.complexity.Mutation.MoveSubShelfByRootShelfID(childComplexity, args["input"].(gqlmodels.MoveSubShelfByRootShelfIDInput)), true
 
-	case "Query.searchBlockPacks":
-		if e.complexity.Query.SearchBlockPacks == nil {
+	case "Mutation.moveSubShelvesByRootShelfId":
+		if e.complexity.Mutation.MoveSubShelvesByRootShelfID == nil {
 			break
 		}
 
-		args, err := ec.field_Query_searchBlockPacks_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_moveSubShelvesByRootShelfId_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.SearchBlockPacks(childComplexity, args["input"].(gqlmodels.SearchBlockPackInput)), true
+		return e.complexity.Mutation.MoveSubShelvesByRootShelfID(childComplexity, args["input"].(gqlmodels.MovableSubShelfInput)), true
 
-	case "Query.searchBlocks":
-		if e.complexity.Query.SearchBlocks == nil {
+	case "Mutation.moveSubShelvesByRootShelfIds":
+		if e.complexity.Mutation.MoveSubShelvesByRootShelfIds == nil {
 			break
 		}
 
-		args, err := ec.field_Query_searchBlocks_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_moveSubShelvesByRootShelfIds_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.SearchBlocks(childComplexity, args["input"].(gqlmodels.SearchBlockInput)), true
+		return e.complexity.Mutation.MoveSubShelvesByRootShelfIds(childComplexity, args["input"].(gqlmodels.MoveSubShelvesByRootShelfIdsInput)), true
 
-	case "Query.searchItems":
-		if e.complexity.Query.SearchItems == nil {
+	case "Mutation.pauseRoutineTask":
+		if e.complexity.Mutation.PauseRoutineTask == nil {
 			break
 		}
 
-		args, err := ec.field_Query_searchItems_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_pauseRoutineTask_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.SearchItems(childComplexity, args["input"].(gqlmodels.SearchItemInput)), true
+		return e.complexity.Mutation.PauseRoutineTask(childComplexity, args["input"].(gqlmodels.PauseRoutineTaskInput)), true
 
-	case "Query.searchMaterials":
-		if e.complexity.Query.SearchMaterials == nil {
+	case "Mutation.restoreBlockPack":
+		if e.complexity.Mutation.RestoreBlockPack == nil {
 			break
 		}
 
-		args, err := ec.field_Query_searchMaterials_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_restoreBlockPack_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.SearchMaterials(childComplexity, args["input"].(gqlmodels.SearchMaterialInput)), true
+		return e.complexity.Mutation.RestoreBlockPack(childComplexity, args["input"].(gqlmodels.RestoreBlockPackInput)), true
 
-	case "Query.searchRootShelves":
-		if e.complexity.Query.SearchRootShelves == nil {
+	case "Mutation.restoreBlockPacks":
+		if e.complexity.Mutation.RestoreBlockPacks == nil {
 			break
 		}
 
-		args, err := ec.field_Query_searchRootShelves_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_restoreBlockPacks_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.SearchRootShelves(childComplexity, args["input"].(gqlmodels.SearchRootShelfInput)), true
+		return e.complexity.Mutation.RestoreBlockPacks(childComplexity, args["input"].(gqlmodels.RestoreBlockPacksInput)), true
 
-	case "Query.searchRoutineTags":
-		if e.complexity.Query.SearchRoutineTags == nil {
+	case "Mutation.restoreMaterial":
+		if e.complexity.Mutation.RestoreMaterial == nil {
 			break
 		}
 
-		args, err := ec.field_Query_searchRoutineTags_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_restoreMaterial_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.SearchRoutineTags(childComplexity, args["input"].(gqlmodels.SearchRoutineTagInput)), true
+		return e.complexity.Mutation.RestoreMaterial(childComplexity, args["input"].(gqlmodels.RestoreMaterialInput)), true
 
-	case "Query.searchRoutineTaskRecords":
-		if e.complexity.Query.SearchRoutineTaskRecords == nil {
+	case "Mutation.restoreMaterials":
+		if e.complexity.Mutation.RestoreMaterials == nil {
 			break
 		}
 
-		args, err := ec.field_Query_searchRoutineTaskRecords_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_restoreMaterials_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.SearchRoutineTaskRecords(childComplexity, args["input"].(gqlmodels.SearchRoutineTaskRecordInput)), true
+		return e.complexity.Mutation.RestoreMaterials(childComplexity, args["input"].(gqlmodels.RestoreMaterialsInput)), true
 
-	case "Query.searchRoutineTasks":
-		if e.complexity.Query.SearchRoutineTasks == nil {
+	case "Mutation.restoreRootShelf":
+		if e.complexity.Mutation.RestoreRootShelf == nil {
 			break
 		}
 
-		args, err := ec.field_Query_searchRoutineTasks_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_restoreRootShelf_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.SearchRoutineTasks(childComplexity, args["input"].(gqlmodels.SearchRoutineTaskInput)), true
+		return e.complexity.Mutation.RestoreRootShelf(childComplexity, args["input"].(gqlmodels.RestoreRootShelfInput)), true
 
-	case "Query.searchRoutines":
-		if e.complexity.Query.SearchRoutines == nil {
+	case "Mutation.restoreRootShelves":
+		if e.complexity.Mutation.RestoreRootShelves == nil {
 			break
 		}
 
-		args, err := ec.field_Query_searchRoutines_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_restoreRootShelves_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.SearchRoutines(childComplexity, args["input"].(gqlmodels.SearchRoutineInput)), true
+		return e.complexity.Mutation.RestoreRootShelves(childComplexity, args["input"].(gqlmodels.RestoreRootShelvesInput)), true
 
-	case "Query.searchStations":
-		if e.complexity.Query.SearchStations == nil {
+	case "Mutation.restoreRoutine":
+		if e.complexity.Mutation.RestoreRoutine == nil {
 			break
 		}
 
-		args, err := ec.field_Query_searchStations_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_restoreRoutine_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.SearchStations(childComplexity, args["input"].(gqlmodels.SearchStationInput)), true
+		return e.complexity.Mutation.RestoreRoutine(childComplexity, args["input"].(gqlmodels.RestoreRoutineInput)), true
 
-	case "Query.searchSubShelves":
-		if e.complexity.Query.SearchSubShelves == nil {
+	case "Mutation.restoreRoutines":
+		if e.complexity.Mutation.RestoreRoutines == nil {
 			break
 		}
 
-		args, err := ec.field_Query_searchSubShelves_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_restoreRoutines_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.SearchSubShelves(childComplexity, args["input"].(gqlmodels.SearchSubShelfInput)), true
+		return e.complexity.Mutation.RestoreRoutines(childComplexity, args["input"].(gqlmodels.RestoreRoutinesInput)), true
 
-	case "Query.searchThemes":
-		if e.complexity.Query.SearchThemes == nil {
+	case "Mutation.restoreStation":
+		if e.complexity.Mutation.RestoreStation == nil {
 			break
 		}
 
-		args, err := ec.field_Query_searchThemes_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_restoreStation_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.SearchThemes(childComplexity, args["input"].(gqlmodels.SearchThemeInput)), true
+		return e.complexity.Mutation.RestoreStation(childComplexity, args["input"].(gqlmodels.RestoreStationInput)), true
 
-	case "Query.searchUsers":
-		if e.complexity.Query.SearchUsers == nil {
+	case "Mutation.restoreStations":
+		if e.complexity.Mutation.RestoreStations == nil {
 			break
 		}
 
-		args, err := ec.field_Query_searchUsers_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_restoreStations_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.SearchUsers(childComplexity, args["input"].(gqlmodels.SearchUserInput)), true
+		return e.complexity.Mutation.RestoreStations(childComplexity, args["input"].(gqlmodels.RestoreStationsInput)), true
 
-	case "SearchBadgeConnection.searchEdges":
-		if e.complexity.SearchBadgeConnection.SearchEdges == nil {
+	case "Mutation.restoreSubShelf":
+		if e.complexity.Mutation.RestoreSubShelf == nil {
 			break
 		}
 
-		return e.complexity.SearchBadgeConnection.SearchEdges(childComplexity), true
-
-	case "SearchBadgeConnection.searchPageInfo":
-		if e.complexity.SearchBadgeConnection.SearchPageInfo == nil {
-			break
+		args, err := ec.field_Mutation_restoreSubShelf_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.SearchBadgeConnection.SearchPageInfo(childComplexity), true
+		return e.complexity.Mutation.RestoreSubShelf(childComplexity, args["input"].(gqlmodels.RestoreSubShelfInput)), true
 
-	case "SearchBadgeConnection.searchTime":
-		if e.complexity.SearchBadgeConnection.SearchTime == nil {
+	case "Mutation.restoreSubShelves":
+		if e.complexity.Mutation.RestoreSubShelves == nil {
 			break
 		}
 
-		return e.complexity.SearchBadgeConnection.SearchTime(childComplexity), true
-
-	case "SearchBadgeConnection.totalCount":
-		if e.complexity.SearchBadgeConnection.TotalCount == nil {
-			break
+		args, err := ec.field_Mutation_restoreSubShelves_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.SearchBadgeConnection.TotalCount(childComplexity), true
+		return e.complexity.Mutation.RestoreSubShelves(childComplexity, args["input"].(gqlmodels.RestoreSubShelvesInput)), true
 
-	case "SearchBadgeEdge.encodedSearchCursor":
-		if e.complexity.SearchBadgeEdge.EncodedSearchCursor == nil {
+	case "Mutation.resumeRoutineTask":
+		if e.complexity.Mutation.ResumeRoutineTask == nil {
 			break
 		}
 
-		return e.complexity.SearchBadgeEdge.EncodedSearchCursor(childComplexity), true
-
-	case "SearchBadgeEdge.node":
-		if e.complexity.SearchBadgeEdge.Node == nil {
-			break
+		args, err := ec.field_Mutation_resumeRoutineTask_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.SearchBadgeEdge.Node(childComplexity), true
+		return e.complexity.Mutation.ResumeRoutineTask(childComplexity, args["input"].(gqlmodels.ResumeRoutineTaskInput)), true
 
-	case "SearchBlockConnection.searchEdges":
-		if e.complexity.SearchBlockConnection.SearchEdges == nil {
+	case "Mutation.saveMaterial":
+		if e.complexity.Mutation.SaveMaterial == nil {
 			break
 		}
 
-		return e.complexity.SearchBlockConnection.SearchEdges(childComplexity), true
-
-	case "SearchBlockConnection.searchPageInfo":
-		if e.complexity.SearchBlockConnection.SearchPageInfo == nil {
-			break
+		args, err := ec.field_Mutation_saveMaterial_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.SearchBlockConnection.SearchPageInfo(childComplexity), true
+		return e.complexity.Mutation.SaveMaterial(childComplexity, args["input"].(gqlmodels.SaveMaterialInput)), true
 
-	case "SearchBlockConnection.searchTime":
-		if e.complexity.SearchBlockConnection.SearchTime == nil {
+	case "Mutation.transferRootShelfOwnership":
+		if e.complexity.Mutation.TransferRootShelfOwnership == nil {
 			break
 		}
 
-		return e.complexity.SearchBlockConnection.SearchTime(childComplexity), true
-
-	case "SearchBlockConnection.totalCount":
-		if e.complexity.SearchBlockConnection.TotalCount == nil {
-			break
+		args, err := ec.field_Mutation_transferRootShelfOwnership_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.SearchBlockConnection.TotalCount(childComplexity), true
+		return e.complexity.Mutation.TransferRootShelfOwnership(childComplexity, args["input"].(gqlmodels.TransferRootShelfOwnershipInput)), true
 
-	case "SearchBlockEdge.encodedSearchCursor":
-		if e.complexity.SearchBlockEdge.EncodedSearchCursor == nil {
+	case "Mutation.transferStationOwnership":
+		if e.complexity.Mutation.TransferStationOwnership == nil {
 			break
 		}
 
-		return e.complexity.SearchBlockEdge.EncodedSearchCursor(childComplexity), true
-
-	case "SearchBlockEdge.node":
-		if e.complexity.SearchBlockEdge.Node == nil {
-			break
+		args, err := ec.field_Mutation_transferStationOwnership_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.SearchBlockEdge.Node(childComplexity), true
+		return e.complexity.Mutation.TransferStationOwnership(childComplexity, args["input"].(gqlmodels.TransferStationOwnershipInput)), true
 
-	case "SearchBlockPackConnection.searchEdges":
-		if e.complexity.SearchBlockPackConnection.SearchEdges == nil {
+	case "Mutation.updateBlockPack":
+		if e.complexity.Mutation.UpdateBlockPack == nil {
 			break
 		}
 
-		return e.complexity.SearchBlockPackConnection.SearchEdges(childComplexity), true
-
-	case "SearchBlockPackConnection.searchPageInfo":
-		if e.complexity.SearchBlockPackConnection.SearchPageInfo == nil {
-			break
+		args, err := ec.field_Mutation_updateBlockPack_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.SearchBlockPackConnection.SearchPageInfo(childComplexity), true
+		return e.complexity.Mutation.UpdateBlockPack(childComplexity, args["input"].(gqlmodels.UpdateBlockPackInput)), true
 
-	case "SearchBlockPackConnection.searchTime":
-		if e.complexity.SearchBlockPackConnection.SearchTime == nil {
+	case "Mutation.updateBlockPacks":
+		if e.complexity.Mutation.UpdateBlockPacks == nil {
 			break
 		}
 
-		return e.complexity.SearchBlockPackConnection.SearchTime(childComplexity), true
-
-	case "SearchBlockPackConnection.totalCount":
-		if e.complexity.SearchBlockPackConnection.TotalCount == nil {
-			break
+		args, err := ec.field_Mutation_updateBlockPacks_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.SearchBlockPackConnection.TotalCount(childComplexity), true
+		return e.complexity.Mutation.UpdateBlockPacks(childComplexity, args["input"].(gqlmodels.UpdateBlockPacksInput)), true
 
-	case "SearchBlockPackEdge.encodedSearchCursor":
-		if e.complexity.SearchBlockPackEdge.EncodedSearchCursor == nil {
+	case "Mutation.updateMaterial":
+		if e.complexity.Mutation.UpdateMaterial == nil {
 			break
 		}
 
-		return e.complexity.SearchBlockPackEdge.EncodedSearchCursor(childComplexity), true
-
-	case "SearchBlockPackEdge.node":
-		if e.complexity.SearchBlockPackEdge.Node == nil {
-			break
+		args, err := ec.field_Mutation_updateMaterial_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.SearchBlockPackEdge.Node(childComplexity), true
+		return e.complexity.Mutation.UpdateMaterial(childComplexity, args["input"].(gqlmodels.UpdateMaterialInput)), true
 
-	case "SearchItemConnection.searchEdges":
-		if e.complexity.SearchItemConnection.SearchEdges == nil {
+	case "Mutation.updateRootShelf":
+		if e.complexity.Mutation.UpdateRootShelf == nil {
 			break
 		}
 
-		return e.complexity.SearchItemConnection.SearchEdges(childComplexity), true
-
-	case "SearchItemConnection.searchPageInfo":
-		if e.complexity.SearchItemConnection.SearchPageInfo == nil {
-			break
+		args, err := ec.field_Mutation_updateRootShelf_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.SearchItemConnection.SearchPageInfo(childComplexity), true
+		return e.complexity.Mutation.UpdateRootShelf(childComplexity, args["input"].(gqlmodels.UpdateRootShelfInput)), true
 
-	case "SearchItemConnection.searchTime":
-		if e.complexity.SearchItemConnection.SearchTime == nil {
+	case "Mutation.updateRootShelfPermission":
+		if e.complexity.Mutation.UpdateRootShelfPermission == nil {
 			break
 		}
 
-		return e.complexity.SearchItemConnection.SearchTime(childComplexity), true
-
-	case "SearchItemConnection.totalCount":
-		if e.complexity.SearchItemConnection.TotalCount == nil {
-			break
+		args, err := ec.field_Mutation_updateRootShelfPermission_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.SearchItemConnection.TotalCount(childComplexity), true
+		return e.complexity.Mutation.UpdateRootShelfPermission(childComplexity, args["input"].(gqlmodels.UpdateRootShelfPermissionInput)), true
 
-	case "SearchItemEdge.encodedSearchCursor":
-		if e.complexity.SearchItemEdge.EncodedSearchCursor == nil {
+	case "Mutation.updateRootShelves":
+		if e.complexity.Mutation.UpdateRootShelves == nil {
 			break
 		}
 
-		return e.complexity.SearchItemEdge.EncodedSearchCursor(childComplexity), true
-
-	case "SearchItemEdge.node":
-		if e.complexity.SearchItemEdge.Node == nil {
-			break
+		args, err := ec.field_Mutation_updateRootShelves_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.SearchItemEdge.Node(childComplexity), true
+		return e.complexity.Mutation.UpdateRootShelves(childComplexity, args["input"].(gqlmodels.UpdateRootShelvesInput)), true
 
-	case "SearchMaterialConnection.searchEdges":
-		if e.complexity.SearchMaterialConnection.SearchEdges == nil {
+	case "Mutation.updateRoutine":
+		if e.complexity.Mutation.UpdateRoutine == nil {
 			break
 		}
 
-		return e.complexity.SearchMaterialConnection.SearchEdges(childComplexity), true
-
-	case "SearchMaterialConnection.searchPageInfo":
-		if e.complexity.SearchMaterialConnection.SearchPageInfo == nil {
-			break
+		args, err := ec.field_Mutation_updateRoutine_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.SearchMaterialConnection.SearchPageInfo(childComplexity), true
+		return e.complexity.Mutation.UpdateRoutine(childComplexity, args["input"].(gqlmodels.UpdatableRoutineInput)), true
 
-	case "SearchMaterialConnection.searchTime":
-		if e.complexity.SearchMaterialConnection.SearchTime == nil {
+	case "Mutation.updateRoutineTask":
+		if e.complexity.Mutation.UpdateRoutineTask == nil {
 			break
 		}
 
-		return e.complexity.SearchMaterialConnection.SearchTime(childComplexity), true
-
-	case "SearchMaterialConnection.totalCount":
-		if e.complexity.SearchMaterialConnection.TotalCount == nil {
-			break
+		args, err := ec.field_Mutation_updateRoutineTask_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.SearchMaterialConnection.TotalCount(childComplexity), true
+		return e.complexity.Mutation.UpdateRoutineTask(childComplexity, args["input"].(gqlmodels.UpdatableRoutineTaskInput)), true
 
-	case "SearchMaterialEdge.encodedSearchCursor":
-		if e.complexity.SearchMaterialEdge.EncodedSearchCursor == nil {
+	case "Mutation.updateRoutines":
+		if e.complexity.Mutation.UpdateRoutines == nil {
 			break
 		}
 
-		return e.complexity.SearchMaterialEdge.EncodedSearchCursor(childComplexity), true
-
-	case "SearchMaterialEdge.node":
-		if e.complexity.SearchMaterialEdge.Node == nil {
-			break
+		args, err := ec.field_Mutation_updateRoutines_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.SearchMaterialEdge.Node(childComplexity), true
+		return e.complexity.Mutation.UpdateRoutines(childComplexity, args["input"].(gqlmodels.UpdateRoutinesInput)), true
 
-	case "SearchPageInfo.endEncodedSearchCursor":
-		if e.complexity.SearchPageInfo.EndEncodedSearchCursor == nil {
+	case "Mutation.updateStation":
+		if e.complexity.Mutation.UpdateStation == nil {
 			break
 		}
 
-		return e.complexity.SearchPageInfo.EndEncodedSearchCursor(childComplexity), true
-
-	case "SearchPageInfo.hasNextPage":
-		if e.complexity.SearchPageInfo.HasNextPage == nil {
-			break
+		args, err := ec.field_Mutation_updateStation_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.SearchPageInfo.HasNextPage(childComplexity), true
+		return e.complexity.Mutation.UpdateStation(childComplexity, args["input"].(gqlmodels.UpdateStationInput)), true
 
-	case "SearchPageInfo.hasPreviousPage":
-		if e.complexity.SearchPageInfo.HasPreviousPage == nil {
+	case "Mutation.updateStationPermission":
+		if e.complexity.Mutation.UpdateStationPermission == nil {
 			break
 		}
 
-		return e.complexity.SearchPageInfo.HasPreviousPage(childComplexity), true
-
-	case "SearchPageInfo.startEncodedSearchCursor":
-		if e.complexity.SearchPageInfo.StartEncodedSearchCursor == nil {
-			break
+		args, err := ec.field_Mutation_updateStationPermission_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.SearchPageInfo.StartEncodedSearchCursor(childComplexity), true
+		return e.complexity.Mutation.UpdateStationPermission(childComplexity, args["input"].(gqlmodels.UpdateStationPermissionInput)), true
 
-	case "SearchRootShelfConnection.searchEdges":
-		if e.complexity.SearchRootShelfConnection.SearchEdges == nil {
+	case "Mutation.updateStations":
+		if e.complexity.Mutation.UpdateStations == nil {
 			break
 		}
 
-		return e.complexity.SearchRootShelfConnection.SearchEdges(childComplexity), true
-
-	case "SearchRootShelfConnection.searchPageInfo":
-		if e.complexity.SearchRootShelfConnection.SearchPageInfo == nil {
-			break
+		args, err := ec.field_Mutation_updateStations_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.SearchRootShelfConnection.SearchPageInfo(childComplexity), true
+		return e.complexity.Mutation.UpdateStations(childComplexity, args["input"].(gqlmodels.UpdateStationsInput)), true
 
-	case "SearchRootShelfConnection.searchTime":
-		if e.complexity.SearchRootShelfConnection.SearchTime == nil {
+	case "Mutation.updateSubShelf":
+		if e.complexity.Mutation.UpdateSubShelf == nil {
 			break
 		}
 
-		return e.complexity.SearchRootShelfConnection.SearchTime(childComplexity), true
-
-	case "SearchRootShelfConnection.totalCount":
-		if e.complexity.SearchRootShelfConnection.TotalCount == nil {
-			break
+		args, err := ec.field_Mutation_updateSubShelf_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.SearchRootShelfConnection.TotalCount(childComplexity), true
+		return e.complexity.Mutation.UpdateSubShelf(childComplexity, args["input"].(gqlmodels.UpdateSubShelfInput)), true
 
-	case "SearchRootShelfEdge.encodedSearchCursor":
-		if e.complexity.SearchRootShelfEdge.EncodedSearchCursor == nil {
+	case "Mutation.updateSubShelves":
+		if e.complexity.Mutation.UpdateSubShelves == nil {
 			break
 		}
 
-		return e.complexity.SearchRootShelfEdge.EncodedSearchCursor(childComplexity), true
-
-	case "SearchRootShelfEdge.node":
-		if e.complexity.SearchRootShelfEdge.Node == nil {
-			break
+		args, err := ec.field_Mutation_updateSubShelves_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.SearchRootShelfEdge.Node(childComplexity), true
+		return e.complexity.Mutation.UpdateSubShelves(childComplexity, args["input"].(gqlmodels.UpdateSubShelvesInput)), true
 
-	case "SearchRoutineConnection.searchEdges":
-		if e.complexity.SearchRoutineConnection.SearchEdges == nil {
+	case "Mutation.upsertRootShelfPermission":
+		if e.complexity.Mutation.UpsertRootShelfPermission == nil {
 			break
 		}
 
-		return e.complexity.SearchRoutineConnection.SearchEdges(childComplexity), true
+		args, err := ec.field_Mutation_upsertRootShelfPermission_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "SearchRoutineConnection.searchPageInfo":
-		if e.complexity.SearchRoutineConnection.SearchPageInfo == nil {
+		return e.complexity.Mutation.UpsertRootShelfPermission(childComplexity, args["input"].(gqlmodels.UpsertRootShelfPermissionInput)), true
+
+	case "Mutation.upsertRootShelfPermissions":
+		if e.complexity.Mutation.UpsertRootShelfPermissions == nil {
 			break
 		}
 
-		return e.complexity.SearchRoutineConnection.SearchPageInfo(childComplexity), true
+		args, err := ec.field_Mutation_upsertRootShelfPermissions_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "SearchRoutineConnection.searchTime":
-		if e.complexity.SearchRoutineConnection.SearchTime == nil {
+		return e.complexity.Mutation.UpsertRootShelfPermissions(childComplexity, args["input"].(gqlmodels.UpsertRootShelfPermissionsInput)), true
+
+	case "Mutation.upsertStationPermission":
+		if e.complexity.Mutation.UpsertStationPermission == nil {
 			break
 		}
 
-		return e.complexity.SearchRoutineConnection.SearchTime(childComplexity), true
+		args, err := ec.field_Mutation_upsertStationPermission_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "SearchRoutineConnection.totalCount":
-		if e.complexity.SearchRoutineConnection.TotalCount == nil {
+		return e.complexity.Mutation.UpsertStationPermission(childComplexity, args["input"].(gqlmodels.UpsertStationPermissionInput)), true
+
+	case "Mutation.upsertStationPermissions":
+		if e.complexity.Mutation.UpsertStationPermissions == nil {
 			break
 		}
 
-		return e.complexity.SearchRoutineConnection.TotalCount(childComplexity), true
+		args, err := ec.field_Mutation_upsertStationPermissions_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "SearchRoutineEdge.encodedSearchCursor":
-		if e.complexity.SearchRoutineEdge.EncodedSearchCursor == nil {
+		return e.complexity.Mutation.UpsertStationPermissions(childComplexity, args["input"].(gqlmodels.UpsertStationPermissionsInput)), true
+
+	case "MyTrash.items":
+		if e.complexity.MyTrash.Items == nil {
 			break
 		}
 
-		return e.complexity.SearchRoutineEdge.EncodedSearchCursor(childComplexity), true
+		return e.complexity.MyTrash.Items(childComplexity), true
 
-	case "SearchRoutineEdge.node":
-		if e.complexity.SearchRoutineEdge.Node == nil {
+	case "MyTrash.retentionDays":
+		if e.complexity.MyTrash.RetentionDays == nil {
 			break
 		}
 
-		return e.complexity.SearchRoutineEdge.Node(childComplexity), true
+		return e.complexity.MyTrash.RetentionDays(childComplexity), true
 
-	case "SearchRoutineTagConnection.searchEdges":
-		if e.complexity.SearchRoutineTagConnection.SearchEdges == nil {
+	case "MyTrash.totalCount":
+		if e.complexity.MyTrash.TotalCount == nil {
 			break
 		}
 
-		return e.complexity.SearchRoutineTagConnection.SearchEdges(childComplexity), true
+		return e.complexity.MyTrash.TotalCount(childComplexity), true
 
-	case "SearchRoutineTagConnection.searchPageInfo":
-		if e.complexity.SearchRoutineTagConnection.SearchPageInfo == nil {
+	case "MyUsage.generatedAt":
+		if e.complexity.MyUsage.GeneratedAt == nil {
 			break
 		}
 
-		return e.complexity.SearchRoutineTagConnection.SearchPageInfo(childComplexity), true
+		return e.complexity.MyUsage.GeneratedAt(childComplexity), true
 
-	case "SearchRoutineTagConnection.searchTime":
-		if e.complexity.SearchRoutineTagConnection.SearchTime == nil {
+	case "MyUsage.limits":
+		if e.complexity.MyUsage.Limits == nil {
 			break
 		}
 
-		return e.complexity.SearchRoutineTagConnection.SearchTime(childComplexity), true
+		return e.complexity.MyUsage.Limits(childComplexity), true
 
-	case "SearchRoutineTagConnection.totalCount":
-		if e.complexity.SearchRoutineTagConnection.TotalCount == nil {
+	case "MyUsage.plan":
+		if e.complexity.MyUsage.Plan == nil {
 			break
 		}
 
-		return e.complexity.SearchRoutineTagConnection.TotalCount(childComplexity), true
+		return e.complexity.MyUsage.Plan(childComplexity), true
 
-	case "SearchRoutineTagEdge.encodedSearchCursor":
-		if e.complexity.SearchRoutineTagEdge.EncodedSearchCursor == nil {
+	case "MyUsage.snapshots":
+		if e.complexity.MyUsage.Snapshots == nil {
 			break
 		}
 
-		return e.complexity.SearchRoutineTagEdge.EncodedSearchCursor(childComplexity), true
+		return e.complexity.MyUsage.Snapshots(childComplexity), true
 
-	case "SearchRoutineTagEdge.node":
-		if e.complexity.SearchRoutineTagEdge.Node == nil {
+	case "PauseRoutineTaskPayload.updatedAt":
+		if e.complexity.PauseRoutineTaskPayload.UpdatedAt == nil {
 			break
 		}
 
-		return e.complexity.SearchRoutineTagEdge.Node(childComplexity), true
+		return e.complexity.PauseRoutineTaskPayload.UpdatedAt(childComplexity), true
 
-	case "SearchRoutineTaskConnection.searchEdges":
-		if e.complexity.SearchRoutineTaskConnection.SearchEdges == nil {
+	case "PrivateBlock.blockPackId":
+		if e.complexity.PrivateBlock.BlockPackID == nil {
 			break
 		}
 
-		return e.complexity.SearchRoutineTaskConnection.SearchEdges(childComplexity), true
+		return e.complexity.PrivateBlock.BlockPackID(childComplexity), true
 
-	case "SearchRoutineTaskConnection.searchPageInfo":
-		if e.complexity.SearchRoutineTaskConnection.SearchPageInfo == nil {
+	case "PrivateBlock.childrenIds":
+		if e.complexity.PrivateBlock.ChildrenIds == nil {
 			break
 		}
 
-		return e.complexity.SearchRoutineTaskConnection.SearchPageInfo(childComplexity), true
+		return e.complexity.PrivateBlock.ChildrenIds(childComplexity), true
 
-	case "SearchRoutineTaskConnection.searchTime":
-		if e.complexity.SearchRoutineTaskConnection.SearchTime == nil {
+	case "PrivateBlock.content":
+		if e.complexity.PrivateBlock.Content == nil {
 			break
 		}
 
-		return e.complexity.SearchRoutineTaskConnection.SearchTime(childComplexity), true
+		return e.complexity.PrivateBlock.Content(childComplexity), true
 
-	case "SearchRoutineTaskConnection.totalCount":
-		if e.complexity.SearchRoutineTaskConnection.TotalCount == nil {
+	case "PrivateBlock.createdAt":
+		if e.complexity.PrivateBlock.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.SearchRoutineTaskConnection.TotalCount(childComplexity), true
+		return e.complexity.PrivateBlock.CreatedAt(childComplexity), true
 
-	case "SearchRoutineTaskEdge.encodedSearchCursor":
-		if e.complexity.SearchRoutineTaskEdge.EncodedSearchCursor == nil {
+	case "PrivateBlock.id":
+		if e.complexity.PrivateBlock.ID == nil {
 			break
 		}
 
-		return e.complexity.SearchRoutineTaskEdge.EncodedSearchCursor(childComplexity), true
+		return e.complexity.PrivateBlock.ID(childComplexity), true
 
-	case "SearchRoutineTaskEdge.node":
-		if e.complexity.SearchRoutineTaskEdge.Node == nil {
+	case "PrivateBlock.nextBlockId":
+		if e.complexity.PrivateBlock.NextBlockID == nil {
 			break
 		}
 
-		return e.complexity.SearchRoutineTaskEdge.Node(childComplexity), true
+		return e.complexity.PrivateBlock.NextBlockID(childComplexity), true
 
-	case "SearchRoutineTaskRecordConnection.searchEdges":
-		if e.complexity.SearchRoutineTaskRecordConnection.SearchEdges == nil {
+	case "PrivateBlock.parentBlockId":
+		if e.complexity.PrivateBlock.ParentBlockID == nil {
 			break
 		}
 
-		return e.complexity.SearchRoutineTaskRecordConnection.SearchEdges(childComplexity), true
+		return e.complexity.PrivateBlock.ParentBlockID(childComplexity), true
 
-	case "SearchRoutineTaskRecordConnection.searchPageInfo":
-		if e.complexity.SearchRoutineTaskRecordConnection.SearchPageInfo == nil {
+	case "PrivateBlock.prevBlockId":
+		if e.complexity.PrivateBlock.PrevBlockID == nil {
 			break
 		}
 
-		return e.complexity.SearchRoutineTaskRecordConnection.SearchPageInfo(childComplexity), true
+		return e.complexity.PrivateBlock.PrevBlockID(childComplexity), true
 
-	case "SearchRoutineTaskRecordConnection.searchTime":
-		if e.complexity.SearchRoutineTaskRecordConnection.SearchTime == nil {
+	case "PrivateBlock.props":
+		if e.complexity.PrivateBlock.Props == nil {
 			break
 		}
 
-		return e.complexity.SearchRoutineTaskRecordConnection.SearchTime(childComplexity), true
+		return e.complexity.PrivateBlock.Props(childComplexity), true
 
-	case "SearchRoutineTaskRecordConnection.totalCount":
-		if e.complexity.SearchRoutineTaskRecordConnection.TotalCount == nil {
+	case "PrivateBlock.type":
+		if e.complexity.PrivateBlock.Type == nil {
 			break
 		}
 
-		return e.complexity.SearchRoutineTaskRecordConnection.TotalCount(childComplexity), true
+		return e.complexity.PrivateBlock.Type(childComplexity), true
 
-	case "SearchRoutineTaskRecordEdge.encodedSearchCursor":
-		if e.complexity.SearchRoutineTaskRecordEdge.EncodedSearchCursor == nil {
+	case "PrivateBlock.updatedAt":
+		if e.complexity.PrivateBlock.UpdatedAt == nil {
 			break
 		}
 
-		return e.complexity.SearchRoutineTaskRecordEdge.EncodedSearchCursor(childComplexity), true
+		return e.complexity.PrivateBlock.UpdatedAt(childComplexity), true
 
-	case "SearchRoutineTaskRecordEdge.node":
-		if e.complexity.SearchRoutineTaskRecordEdge.Node == nil {
+	case "PrivateBlockPack.blockCount":
+		if e.complexity.PrivateBlockPack.BlockCount == nil {
 			break
 		}
 
-		return e.complexity.SearchRoutineTaskRecordEdge.Node(childComplexity), true
+		return e.complexity.PrivateBlockPack.BlockCount(childComplexity), true
 
-	case "SearchStationConnection.searchEdges":
-		if e.complexity.SearchStationConnection.SearchEdges == nil {
+	case "PrivateBlockPack.blockIds":
+		if e.complexity.PrivateBlockPack.BlockIds == nil {
 			break
 		}
 
-		return e.complexity.SearchStationConnection.SearchEdges(childComplexity), true
+		return e.complexity.PrivateBlockPack.BlockIds(childComplexity), true
 
-	case "SearchStationConnection.searchPageInfo":
-		if e.complexity.SearchStationConnection.SearchPageInfo == nil {
+	case "PrivateBlockPack.createdAt":
+		if e.complexity.PrivateBlockPack.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.SearchStationConnection.SearchPageInfo(childComplexity), true
+		return e.complexity.PrivateBlockPack.CreatedAt(childComplexity), true
 
-	case "SearchStationConnection.searchTime":
-		if e.complexity.SearchStationConnection.SearchTime == nil {
+	case "PrivateBlockPack.deletedAt":
+		if e.complexity.PrivateBlockPack.DeletedAt == nil {
 			break
 		}
 
-		return e.complexity.SearchStationConnection.SearchTime(childComplexity), true
+		return e.complexity.PrivateBlockPack.DeletedAt(childComplexity), true
 
-	case "SearchStationConnection.totalCount":
-		if e.complexity.SearchStationConnection.TotalCount == nil {
+	case "PrivateBlockPack.headerBackgroundURL":
+		if e.complexity.PrivateBlockPack.HeaderBackgroundURL == nil {
 			break
 		}
 
-		return e.complexity.SearchStationConnection.TotalCount(childComplexity), true
+		return e.complexity.PrivateBlockPack.HeaderBackgroundURL(childComplexity), true
 
-	case "SearchStationEdge.encodedSearchCursor":
-		if e.complexity.SearchStationEdge.EncodedSearchCursor == nil {
+	case "PrivateBlockPack.id":
+		if e.complexity.PrivateBlockPack.ID == nil {
 			break
 		}
 
-		return e.complexity.SearchStationEdge.EncodedSearchCursor(childComplexity), true
+		return e.complexity.PrivateBlockPack.ID(childComplexity), true
 
-	case "SearchStationEdge.node":
-		if e.complexity.SearchStationEdge.Node == nil {
+	case "PrivateBlockPack.icon":
+		if e.complexity.PrivateBlockPack.Icon == nil {
 			break
 		}
 
-		return e.complexity.SearchStationEdge.Node(childComplexity), true
+		return e.complexity.PrivateBlockPack.Icon(childComplexity), true
 
-	case "SearchSubShelfConnection.searchEdges":
-		if e.complexity.SearchSubShelfConnection.SearchEdges == nil {
+	case "PrivateBlockPack.name":
+		if e.complexity.PrivateBlockPack.Name == nil {
 			break
 		}
 
-		return e.complexity.SearchSubShelfConnection.SearchEdges(childComplexity), true
+		return e.complexity.PrivateBlockPack.Name(childComplexity), true
 
-	case "SearchSubShelfConnection.searchPageInfo":
-		if e.complexity.SearchSubShelfConnection.SearchPageInfo == nil {
+	case "PrivateBlockPack.parentSubShelfId":
+		if e.complexity.PrivateBlockPack.ParentSubShelfID == nil {
 			break
 		}
 
-		return e.complexity.SearchSubShelfConnection.SearchPageInfo(childComplexity), true
+		return e.complexity.PrivateBlockPack.ParentSubShelfID(childComplexity), true
 
-	case "SearchSubShelfConnection.searchTime":
-		if e.complexity.SearchSubShelfConnection.SearchTime == nil {
+	case "PrivateBlockPack.updatedAt":
+		if e.complexity.PrivateBlockPack.UpdatedAt == nil {
 			break
 		}
 
-		return e.complexity.SearchSubShelfConnection.SearchTime(childComplexity), true
+		return e.complexity.PrivateBlockPack.UpdatedAt(childComplexity), true
 
-	case "SearchSubShelfConnection.totalCount":
-		if e.complexity.SearchSubShelfConnection.TotalCount == nil {
+	case "PrivateItem.createdAt":
+		if e.complexity.PrivateItem.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.SearchSubShelfConnection.TotalCount(childComplexity), true
+		return e.complexity.PrivateItem.CreatedAt(childComplexity), true
 
-	case "SearchSubShelfEdge.encodedSearchCursor":
-		if e.complexity.SearchSubShelfEdge.EncodedSearchCursor == nil {
+	case "PrivateItem.deletedAt":
+		if e.complexity.PrivateItem.DeletedAt == nil {
 			break
 		}
 
-		return e.complexity.SearchSubShelfEdge.EncodedSearchCursor(childComplexity), true
+		return e.complexity.PrivateItem.DeletedAt(childComplexity), true
 
-	case "SearchSubShelfEdge.node":
-		if e.complexity.SearchSubShelfEdge.Node == nil {
+	case "PrivateItem.id":
+		if e.complexity.PrivateItem.ID == nil {
 			break
 		}
 
-		return e.complexity.SearchSubShelfEdge.Node(childComplexity), true
+		return e.complexity.PrivateItem.ID(childComplexity), true
 
-	case "SearchThemeConnection.searchEdges":
-		if e.complexity.SearchThemeConnection.SearchEdges == nil {
+	case "PrivateItem.parentSubShelfId":
+		if e.complexity.PrivateItem.ParentSubShelfID == nil {
 			break
 		}
 
-		return e.complexity.SearchThemeConnection.SearchEdges(childComplexity), true
+		return e.complexity.PrivateItem.ParentSubShelfID(childComplexity), true
 
-	case "SearchThemeConnection.searchPageInfo":
-		if e.complexity.SearchThemeConnection.SearchPageInfo == nil {
+	case "PrivateItem.rootShelfId":
+		if e.complexity.PrivateItem.RootShelfID == nil {
 			break
 		}
 
-		return e.complexity.SearchThemeConnection.SearchPageInfo(childComplexity), true
+		return e.complexity.PrivateItem.RootShelfID(childComplexity), true
 
-	case "SearchThemeConnection.searchTime":
-		if e.complexity.SearchThemeConnection.SearchTime == nil {
+	case "PrivateItem.routineIds":
+		if e.complexity.PrivateItem.RoutineIds == nil {
 			break
 		}
 
-		return e.complexity.SearchThemeConnection.SearchTime(childComplexity), true
+		return e.complexity.PrivateItem.RoutineIds(childComplexity), true
 
-	case "SearchThemeConnection.totalCount":
-		if e.complexity.SearchThemeConnection.TotalCount == nil {
+	case "PrivateItem.type":
+		if e.complexity.PrivateItem.Type == nil {
 			break
 		}
 
-		return e.complexity.SearchThemeConnection.TotalCount(childComplexity), true
+		return e.complexity.PrivateItem.Type(childComplexity), true
 
-	case "SearchThemeEdge.encodedSearchCursor":
-		if e.complexity.SearchThemeEdge.EncodedSearchCursor == nil {
+	case "PrivateItem.updatedAt":
+		if e.complexity.PrivateItem.UpdatedAt == nil {
 			break
 		}
 
-		return e.complexity.SearchThemeEdge.EncodedSearchCursor(childComplexity), true
+		return e.complexity.PrivateItem.UpdatedAt(childComplexity), true
 
-	case "SearchThemeEdge.node":
-		if e.complexity.SearchThemeEdge.Node == nil {
+	case "PrivateMaterial.contentType":
+		if e.complexity.PrivateMaterial.ContentType == nil {
 			break
 		}
 
-		return e.complexity.SearchThemeEdge.Node(childComplexity), true
+		return e.complexity.PrivateMaterial.ContentType(childComplexity), true
 
-	case "SearchUserConnection.searchEdges":
-		if e.complexity.SearchUserConnection.SearchEdges == nil {
+	case "PrivateMaterial.createdAt":
+		if e.complexity.PrivateMaterial.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.SearchUserConnection.SearchEdges(childComplexity), true
+		return e.complexity.PrivateMaterial.CreatedAt(childComplexity), true
 
-	case "SearchUserConnection.searchPageInfo":
-		if e.complexity.SearchUserConnection.SearchPageInfo == nil {
+	case "PrivateMaterial.deletedAt":
+		if e.complexity.PrivateMaterial.DeletedAt == nil {
 			break
 		}
 
-		return e.complexity.SearchUserConnection.SearchPageInfo(childComplexity), true
+		return e.complexity.PrivateMaterial.DeletedAt(childComplexity), true
 
-	case "SearchUserConnection.searchTime":
-		if e.complexity.SearchUserConnection.SearchTime == nil {
+	case "PrivateMaterial.id":
+		if e.complexity.PrivateMaterial.ID == nil {
 			break
 		}
 
-		return e.complexity.SearchUserConnection.SearchTime(childComplexity), true
+		return e.complexity.PrivateMaterial.ID(childComplexity), true
 
-	case "SearchUserConnection.totalCount":
-		if e.complexity.SearchUserConnection.TotalCount == nil {
+	case "PrivateMaterial.name":
+		if e.complexity.PrivateMaterial.Name == nil {
 			break
 		}
 
-		return e.complexity.SearchUserConnection.TotalCount(childComplexity), true
+		return e.complexity.PrivateMaterial.Name(childComplexity), true
 
-	case "SearchUserEdge.encodedSearchCursor":
-		if e.complexity.SearchUserEdge.EncodedSearchCursor == nil {
+	case "PrivateMaterial.parentSubShelfId":
+		if e.complexity.PrivateMaterial.ParentSubShelfID == nil {
 			break
 		}
 
-		return e.complexity.SearchUserEdge.EncodedSearchCursor(childComplexity), true
+		return e.complexity.PrivateMaterial.ParentSubShelfID(childComplexity), true
 
-	case "SearchUserEdge.node":
-		if e.complexity.SearchUserEdge.Node == nil {
+	case "PrivateMaterial.parseMediaType":
+		if e.complexity.PrivateMaterial.ParseMediaType == nil {
 			break
 		}
 
-		return e.complexity.SearchUserEdge.Node(childComplexity), true
+		return e.complexity.PrivateMaterial.ParseMediaType(childComplexity), true
 
-	}
-	return 0, false
-}
+	case "PrivateMaterial.size":
+		if e.complexity.PrivateMaterial.Size == nil {
+			break
+		}
 
-func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
-	opCtx := graphql.GetOperationContext(ctx)
-	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
-	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
-		ec.unmarshalInputSearchBadgeCursorFields,
-		ec.unmarshalInputSearchBadgeFilters,
-		ec.unmarshalInputSearchBadgeInput,
-		ec.unmarshalInputSearchBlockCursorFields,
-		ec.unmarshalInputSearchBlockInput,
-		ec.unmarshalInputSearchBlockPackCursorFields,
-		ec.unmarshalInputSearchBlockPackInput,
-		ec.unmarshalInputSearchItemCursorFields,
-		ec.unmarshalInputSearchItemInput,
-		ec.unmarshalInputSearchMaterialCursorFields,
-		ec.unmarshalInputSearchMaterialInput,
-		ec.unmarshalInputSearchRootShelfCursorFields,
-		ec.unmarshalInputSearchRootShelfInput,
-		ec.unmarshalInputSearchRoutineCursorFields,
-		ec.unmarshalInputSearchRoutineInput,
-		ec.unmarshalInputSearchRoutineTagCursorFields,
-		ec.unmarshalInputSearchRoutineTagInput,
-		ec.unmarshalInputSearchRoutineTaskCursorFields,
-		ec.unmarshalInputSearchRoutineTaskInput,
-		ec.unmarshalInputSearchRoutineTaskRecordCursorFields,
-		ec.unmarshalInputSearchRoutineTaskRecordInput,
-		ec.unmarshalInputSearchStationCursorFields,
-		ec.unmarshalInputSearchStationInput,
-		ec.unmarshalInputSearchSubShelfCursorFields,
-		ec.unmarshalInputSearchSubShelfInput,
-		ec.unmarshalInputSearchThemeCursorFields,
-		ec.unmarshalInputSearchThemeFilters,
-		ec.unmarshalInputSearchThemeInput,
-		ec.unmarshalInputSearchUserCursorFields,
+		return e.complexity.PrivateMaterial.Size(childComplexity), true
+
+	case "PrivateMaterial.updatedAt":
+		if e.complexity.PrivateMaterial.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateMaterial.UpdatedAt(childComplexity), true
+
+	case "PrivateRootShelf.createdAt":
+		if e.complexity.PrivateRootShelf.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateRootShelf.CreatedAt(childComplexity), true
+
+	case "PrivateRootShelf.deletedAt":
+		if e.complexity.PrivateRootShelf.DeletedAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateRootShelf.DeletedAt(childComplexity), true
+
+	case "PrivateRootShelf.id":
+		if e.complexity.PrivateRootShelf.ID == nil {
+			break
+		}
+
+		return e.complexity.PrivateRootShelf.ID(childComplexity), true
+
+	case "PrivateRootShelf.itemCount":
+		if e.complexity.PrivateRootShelf.ItemCount == nil {
+			break
+		}
+
+		return e.complexity.PrivateRootShelf.ItemCount(childComplexity), true
+
+	case "PrivateRootShelf.itemIds":
+		if e.complexity.PrivateRootShelf.ItemIds == nil {
+			break
+		}
+
+		return e.complexity.PrivateRootShelf.ItemIds(childComplexity), true
+
+	case "PrivateRootShelf.lastAnalyzedAt":
+		if e.complexity.PrivateRootShelf.LastAnalyzedAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateRootShelf.LastAnalyzedAt(childComplexity), true
+
+	case "PrivateRootShelf.name":
+		if e.complexity.PrivateRootShelf.Name == nil {
+			break
+		}
+
+		return e.complexity.PrivateRootShelf.Name(childComplexity), true
+
+	case "PrivateRootShelf.owner":
+		if e.complexity.PrivateRootShelf.Owner == nil {
+			break
+		}
+
+		return e.complexity.PrivateRootShelf.Owner(childComplexity), true
+
+	case "PrivateRootShelf.permission":
+		if e.complexity.PrivateRootShelf.Permission == nil {
+			break
+		}
+
+		return e.complexity.PrivateRootShelf.Permission(childComplexity), true
+
+	case "PrivateRootShelf.sharers":
+		if e.complexity.PrivateRootShelf.Sharers == nil {
+			break
+		}
+
+		return e.complexity.PrivateRootShelf.Sharers(childComplexity), true
+
+	case "PrivateRootShelf.subShelfCount":
+		if e.complexity.PrivateRootShelf.SubShelfCount == nil {
+			break
+		}
+
+		return e.complexity.PrivateRootShelf.SubShelfCount(childComplexity), true
+
+	case "PrivateRootShelf.updatedAt":
+		if e.complexity.PrivateRootShelf.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateRootShelf.UpdatedAt(childComplexity), true
+
+	case "PrivateRoutine.createdAt":
+		if e.complexity.PrivateRoutine.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutine.CreatedAt(childComplexity), true
+
+	case "PrivateRoutine.deletedAt":
+		if e.complexity.PrivateRoutine.DeletedAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutine.DeletedAt(childComplexity), true
+
+	case "PrivateRoutine.description":
+		if e.complexity.PrivateRoutine.Description == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutine.Description(childComplexity), true
+
+	case "PrivateRoutine.id":
+		if e.complexity.PrivateRoutine.ID == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutine.ID(childComplexity), true
+
+	case "PrivateRoutine.isPinned":
+		if e.complexity.PrivateRoutine.IsPinned == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutine.IsPinned(childComplexity), true
+
+	case "PrivateRoutine.itemIds":
+		if e.complexity.PrivateRoutine.ItemIds == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutine.ItemIds(childComplexity), true
+
+	case "PrivateRoutine.period":
+		if e.complexity.PrivateRoutine.Period == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutine.Period(childComplexity), true
+
+	case "PrivateRoutine.recurrenceRule":
+		if e.complexity.PrivateRoutine.RecurrenceRule == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutine.RecurrenceRule(childComplexity), true
+
+	case "PrivateRoutine.scheduledEndAt":
+		if e.complexity.PrivateRoutine.ScheduledEndAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutine.ScheduledEndAt(childComplexity), true
+
+	case "PrivateRoutine.scheduledStartAt":
+		if e.complexity.PrivateRoutine.ScheduledStartAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutine.ScheduledStartAt(childComplexity), true
+
+	case "PrivateRoutine.stationId":
+		if e.complexity.PrivateRoutine.StationID == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutine.StationID(childComplexity), true
+
+	case "PrivateRoutine.status":
+		if e.complexity.PrivateRoutine.Status == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutine.Status(childComplexity), true
+
+	case "PrivateRoutine.tagIds":
+		if e.complexity.PrivateRoutine.TagIds == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutine.TagIds(childComplexity), true
+
+	case "PrivateRoutine.taskIds":
+		if e.complexity.PrivateRoutine.TaskIds == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutine.TaskIds(childComplexity), true
+
+	case "PrivateRoutine.timezone":
+		if e.complexity.PrivateRoutine.Timezone == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutine.Timezone(childComplexity), true
+
+	case "PrivateRoutine.title":
+		if e.complexity.PrivateRoutine.Title == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutine.Title(childComplexity), true
+
+	case "PrivateRoutine.updatedAt":
+		if e.complexity.PrivateRoutine.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutine.UpdatedAt(childComplexity), true
+
+	case "PrivateRoutineTag.color":
+		if e.complexity.PrivateRoutineTag.Color == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTag.Color(childComplexity), true
+
+	case "PrivateRoutineTag.createdAt":
+		if e.complexity.PrivateRoutineTag.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTag.CreatedAt(childComplexity), true
+
+	case "PrivateRoutineTag.id":
+		if e.complexity.PrivateRoutineTag.ID == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTag.ID(childComplexity), true
+
+	case "PrivateRoutineTag.icon":
+		if e.complexity.PrivateRoutineTag.Icon == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTag.Icon(childComplexity), true
+
+	case "PrivateRoutineTag.name":
+		if e.complexity.PrivateRoutineTag.Name == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTag.Name(childComplexity), true
+
+	case "PrivateRoutineTag.updatedAt":
+		if e.complexity.PrivateRoutineTag.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTag.UpdatedAt(childComplexity), true
+
+	case "PrivateRoutineTask.actualEndedAt":
+		if e.complexity.PrivateRoutineTask.ActualEndedAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTask.ActualEndedAt(childComplexity), true
+
+	case "PrivateRoutineTask.actualStartedAt":
+		if e.complexity.PrivateRoutineTask.ActualStartedAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTask.ActualStartedAt(childComplexity), true
+
+	case "PrivateRoutineTask.attempts":
+		if e.complexity.PrivateRoutineTask.Attempts == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTask.Attempts(childComplexity), true
+
+	case "PrivateRoutineTask.costUnit":
+		if e.complexity.PrivateRoutineTask.CostUnit == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTask.CostUnit(childComplexity), true
+
+	case "PrivateRoutineTask.createdAt":
+		if e.complexity.PrivateRoutineTask.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTask.CreatedAt(childComplexity), true
+
+	case "PrivateRoutineTask.id":
+		if e.complexity.PrivateRoutineTask.ID == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTask.ID(childComplexity), true
+
+	case "PrivateRoutineTask.maxAttempts":
+		if e.complexity.PrivateRoutineTask.MaxAttempts == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTask.MaxAttempts(childComplexity), true
+
+	case "PrivateRoutineTask.nextScheduledAt":
+		if e.complexity.PrivateRoutineTask.NextScheduledAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTask.NextScheduledAt(childComplexity), true
+
+	case "PrivateRoutineTask.payload":
+		if e.complexity.PrivateRoutineTask.Payload == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTask.Payload(childComplexity), true
+
+	case "PrivateRoutineTask.period":
+		if e.complexity.PrivateRoutineTask.Period == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTask.Period(childComplexity), true
+
+	case "PrivateRoutineTask.priority":
+		if e.complexity.PrivateRoutineTask.Priority == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTask.Priority(childComplexity), true
+
+	case "PrivateRoutineTask.purpose":
+		if e.complexity.PrivateRoutineTask.Purpose == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTask.Purpose(childComplexity), true
+
+	case "PrivateRoutineTask.recurrenceRule":
+		if e.complexity.PrivateRoutineTask.RecurrenceRule == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTask.RecurrenceRule(childComplexity), true
+
+	case "PrivateRoutineTask.routineId":
+		if e.complexity.PrivateRoutineTask.RoutineID == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTask.RoutineID(childComplexity), true
+
+	case "PrivateRoutineTask.scheduledAt":
+		if e.complexity.PrivateRoutineTask.ScheduledAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTask.ScheduledAt(childComplexity), true
+
+	case "PrivateRoutineTask.status":
+		if e.complexity.PrivateRoutineTask.Status == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTask.Status(childComplexity), true
+
+	case "PrivateRoutineTask.title":
+		if e.complexity.PrivateRoutineTask.Title == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTask.Title(childComplexity), true
+
+	case "PrivateRoutineTask.updatedAt":
+		if e.complexity.PrivateRoutineTask.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTask.UpdatedAt(childComplexity), true
+
+	case "PrivateRoutineTaskRecord.actualEndedAt":
+		if e.complexity.PrivateRoutineTaskRecord.ActualEndedAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTaskRecord.ActualEndedAt(childComplexity), true
+
+	case "PrivateRoutineTaskRecord.actualStartedAt":
+		if e.complexity.PrivateRoutineTaskRecord.ActualStartedAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTaskRecord.ActualStartedAt(childComplexity), true
+
+	case "PrivateRoutineTaskRecord.costUnit":
+		if e.complexity.PrivateRoutineTaskRecord.CostUnit == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTaskRecord.CostUnit(childComplexity), true
+
+	case "PrivateRoutineTaskRecord.createdAt":
+		if e.complexity.PrivateRoutineTaskRecord.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTaskRecord.CreatedAt(childComplexity), true
+
+	case "PrivateRoutineTaskRecord.errorCode":
+		if e.complexity.PrivateRoutineTaskRecord.ErrorCode == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTaskRecord.ErrorCode(childComplexity), true
+
+	case "PrivateRoutineTaskRecord.errorReason":
+		if e.complexity.PrivateRoutineTaskRecord.ErrorReason == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTaskRecord.ErrorReason(childComplexity), true
+
+	case "PrivateRoutineTaskRecord.id":
+		if e.complexity.PrivateRoutineTaskRecord.ID == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTaskRecord.ID(childComplexity), true
+
+	case "PrivateRoutineTaskRecord.purpose":
+		if e.complexity.PrivateRoutineTaskRecord.Purpose == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTaskRecord.Purpose(childComplexity), true
+
+	case "PrivateRoutineTaskRecord.routineTaskId":
+		if e.complexity.PrivateRoutineTaskRecord.RoutineTaskID == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTaskRecord.RoutineTaskID(childComplexity), true
+
+	case "PrivateRoutineTaskRecord.scheduledAt":
+		if e.complexity.PrivateRoutineTaskRecord.ScheduledAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTaskRecord.ScheduledAt(childComplexity), true
+
+	case "PrivateRoutineTaskRecord.status":
+		if e.complexity.PrivateRoutineTaskRecord.Status == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTaskRecord.Status(childComplexity), true
+
+	case "PrivateRoutineTaskRecord.totalAttempts":
+		if e.complexity.PrivateRoutineTaskRecord.TotalAttempts == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTaskRecord.TotalAttempts(childComplexity), true
+
+	case "PrivateRoutineTaskRecord.updatedAt":
+		if e.complexity.PrivateRoutineTaskRecord.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateRoutineTaskRecord.UpdatedAt(childComplexity), true
+
+	case "PrivateSearchableRoutine.createdAt":
+		if e.complexity.PrivateSearchableRoutine.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateSearchableRoutine.CreatedAt(childComplexity), true
+
+	case "PrivateSearchableRoutine.deletedAt":
+		if e.complexity.PrivateSearchableRoutine.DeletedAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateSearchableRoutine.DeletedAt(childComplexity), true
+
+	case "PrivateSearchableRoutine.id":
+		if e.complexity.PrivateSearchableRoutine.ID == nil {
+			break
+		}
+
+		return e.complexity.PrivateSearchableRoutine.ID(childComplexity), true
+
+	case "PrivateSearchableRoutine.isPinned":
+		if e.complexity.PrivateSearchableRoutine.IsPinned == nil {
+			break
+		}
+
+		return e.complexity.PrivateSearchableRoutine.IsPinned(childComplexity), true
+
+	case "PrivateSearchableRoutine.itemIds":
+		if e.complexity.PrivateSearchableRoutine.ItemIds == nil {
+			break
+		}
+
+		return e.complexity.PrivateSearchableRoutine.ItemIds(childComplexity), true
+
+	case "PrivateSearchableRoutine.period":
+		if e.complexity.PrivateSearchableRoutine.Period == nil {
+			break
+		}
+
+		return e.complexity.PrivateSearchableRoutine.Period(childComplexity), true
+
+	case "PrivateSearchableRoutine.recurrenceRule":
+		if e.complexity.PrivateSearchableRoutine.RecurrenceRule == nil {
+			break
+		}
+
+		return e.complexity.PrivateSearchableRoutine.RecurrenceRule(childComplexity), true
+
+	case "PrivateSearchableRoutine.scheduledEndAt":
+		if e.complexity.PrivateSearchableRoutine.ScheduledEndAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateSearchableRoutine.ScheduledEndAt(childComplexity), true
+
+	case "PrivateSearchableRoutine.scheduledStartAt":
+		if e.complexity.PrivateSearchableRoutine.ScheduledStartAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateSearchableRoutine.ScheduledStartAt(childComplexity), true
+
+	case "PrivateSearchableRoutine.stationId":
+		if e.complexity.PrivateSearchableRoutine.StationID == nil {
+			break
+		}
+
+		return e.complexity.PrivateSearchableRoutine.StationID(childComplexity), true
+
+	case "PrivateSearchableRoutine.status":
+		if e.complexity.PrivateSearchableRoutine.Status == nil {
+			break
+		}
+
+		return e.complexity.PrivateSearchableRoutine.Status(childComplexity), true
+
+	case "PrivateSearchableRoutine.tagIds":
+		if e.complexity.PrivateSearchableRoutine.TagIds == nil {
+			break
+		}
+
+		return e.complexity.PrivateSearchableRoutine.TagIds(childComplexity), true
+
+	case "PrivateSearchableRoutine.taskIds":
+		if e.complexity.PrivateSearchableRoutine.TaskIds == nil {
+			break
+		}
+
+		return e.complexity.PrivateSearchableRoutine.TaskIds(childComplexity), true
+
+	case "PrivateSearchableRoutine.timezone":
+		if e.complexity.PrivateSearchableRoutine.Timezone == nil {
+			break
+		}
+
+		return e.complexity.PrivateSearchableRoutine.Timezone(childComplexity), true
+
+	case "PrivateSearchableRoutine.title":
+		if e.complexity.PrivateSearchableRoutine.Title == nil {
+			break
+		}
+
+		return e.complexity.PrivateSearchableRoutine.Title(childComplexity), true
+
+	case "PrivateSearchableRoutine.updatedAt":
+		if e.complexity.PrivateSearchableRoutine.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateSearchableRoutine.UpdatedAt(childComplexity), true
+
+	case "PrivateSearchableStation.createdAt":
+		if e.complexity.PrivateSearchableStation.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateSearchableStation.CreatedAt(childComplexity), true
+
+	case "PrivateSearchableStation.deletedAt":
+		if e.complexity.PrivateSearchableStation.DeletedAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateSearchableStation.DeletedAt(childComplexity), true
+
+	case "PrivateSearchableStation.headerBackgroundURL":
+		if e.complexity.PrivateSearchableStation.HeaderBackgroundURL == nil {
+			break
+		}
+
+		return e.complexity.PrivateSearchableStation.HeaderBackgroundURL(childComplexity), true
+
+	case "PrivateSearchableStation.id":
+		if e.complexity.PrivateSearchableStation.ID == nil {
+			break
+		}
+
+		return e.complexity.PrivateSearchableStation.ID(childComplexity), true
+
+	case "PrivateSearchableStation.icon":
+		if e.complexity.PrivateSearchableStation.Icon == nil {
+			break
+		}
+
+		return e.complexity.PrivateSearchableStation.Icon(childComplexity), true
+
+	case "PrivateSearchableStation.name":
+		if e.complexity.PrivateSearchableStation.Name == nil {
+			break
+		}
+
+		return e.complexity.PrivateSearchableStation.Name(childComplexity), true
+
+	case "PrivateSearchableStation.permission":
+		if e.complexity.PrivateSearchableStation.Permission == nil {
+			break
+		}
+
+		return e.complexity.PrivateSearchableStation.Permission(childComplexity), true
+
+	case "PrivateSearchableStation.routineCount":
+		if e.complexity.PrivateSearchableStation.RoutineCount == nil {
+			break
+		}
+
+		return e.complexity.PrivateSearchableStation.RoutineCount(childComplexity), true
+
+	case "PrivateSearchableStation.updatedAt":
+		if e.complexity.PrivateSearchableStation.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateSearchableStation.UpdatedAt(childComplexity), true
+
+	case "PrivateStation.createdAt":
+		if e.complexity.PrivateStation.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateStation.CreatedAt(childComplexity), true
+
+	case "PrivateStation.deletedAt":
+		if e.complexity.PrivateStation.DeletedAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateStation.DeletedAt(childComplexity), true
+
+	case "PrivateStation.description":
+		if e.complexity.PrivateStation.Description == nil {
+			break
+		}
+
+		return e.complexity.PrivateStation.Description(childComplexity), true
+
+	case "PrivateStation.headerBackgroundURL":
+		if e.complexity.PrivateStation.HeaderBackgroundURL == nil {
+			break
+		}
+
+		return e.complexity.PrivateStation.HeaderBackgroundURL(childComplexity), true
+
+	case "PrivateStation.id":
+		if e.complexity.PrivateStation.ID == nil {
+			break
+		}
+
+		return e.complexity.PrivateStation.ID(childComplexity), true
+
+	case "PrivateStation.icon":
+		if e.complexity.PrivateStation.Icon == nil {
+			break
+		}
+
+		return e.complexity.PrivateStation.Icon(childComplexity), true
+
+	case "PrivateStation.name":
+		if e.complexity.PrivateStation.Name == nil {
+			break
+		}
+
+		return e.complexity.PrivateStation.Name(childComplexity), true
+
+	case "PrivateStation.permission":
+		if e.complexity.PrivateStation.Permission == nil {
+			break
+		}
+
+		return e.complexity.PrivateStation.Permission(childComplexity), true
+
+	case "PrivateStation.routineCount":
+		if e.complexity.PrivateStation.RoutineCount == nil {
+			break
+		}
+
+		return e.complexity.PrivateStation.RoutineCount(childComplexity), true
+
+	case "PrivateStation.updatedAt":
+		if e.complexity.PrivateStation.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateStation.UpdatedAt(childComplexity), true
+
+	case "PrivateSubShelf.createdAt":
+		if e.complexity.PrivateSubShelf.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateSubShelf.CreatedAt(childComplexity), true
+
+	case "PrivateSubShelf.deletedAt":
+		if e.complexity.PrivateSubShelf.DeletedAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateSubShelf.DeletedAt(childComplexity), true
+
+	case "PrivateSubShelf.id":
+		if e.complexity.PrivateSubShelf.ID == nil {
+			break
+		}
+
+		return e.complexity.PrivateSubShelf.ID(childComplexity), true
+
+	case "PrivateSubShelf.itemIds":
+		if e.complexity.PrivateSubShelf.ItemIds == nil {
+			break
+		}
+
+		return e.complexity.PrivateSubShelf.ItemIds(childComplexity), true
+
+	case "PrivateSubShelf.name":
+		if e.complexity.PrivateSubShelf.Name == nil {
+			break
+		}
+
+		return e.complexity.PrivateSubShelf.Name(childComplexity), true
+
+	case "PrivateSubShelf.nextSubShelfIds":
+		if e.complexity.PrivateSubShelf.NextSubShelfIds == nil {
+			break
+		}
+
+		return e.complexity.PrivateSubShelf.NextSubShelfIds(childComplexity), true
+
+	case "PrivateSubShelf.path":
+		if e.complexity.PrivateSubShelf.Path == nil {
+			break
+		}
+
+		return e.complexity.PrivateSubShelf.Path(childComplexity), true
+
+	case "PrivateSubShelf.prevSubShelfId":
+		if e.complexity.PrivateSubShelf.PrevSubShelfID == nil {
+			break
+		}
+
+		return e.complexity.PrivateSubShelf.PrevSubShelfID(childComplexity), true
+
+	case "PrivateSubShelf.rootShelfId":
+		if e.complexity.PrivateSubShelf.RootShelfID == nil {
+			break
+		}
+
+		return e.complexity.PrivateSubShelf.RootShelfID(childComplexity), true
+
+	case "PrivateSubShelf.updatedAt":
+		if e.complexity.PrivateSubShelf.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.PrivateSubShelf.UpdatedAt(childComplexity), true
+
+	case "PublicBadge.createdAt":
+		if e.complexity.PublicBadge.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.PublicBadge.CreatedAt(childComplexity), true
+
+	case "PublicBadge.description":
+		if e.complexity.PublicBadge.Description == nil {
+			break
+		}
+
+		return e.complexity.PublicBadge.Description(childComplexity), true
+
+	case "PublicBadge.imageURL":
+		if e.complexity.PublicBadge.ImageURL == nil {
+			break
+		}
+
+		return e.complexity.PublicBadge.ImageURL(childComplexity), true
+
+	case "PublicBadge.publicId":
+		if e.complexity.PublicBadge.PublicID == nil {
+			break
+		}
+
+		return e.complexity.PublicBadge.PublicID(childComplexity), true
+
+	case "PublicBadge.title":
+		if e.complexity.PublicBadge.Title == nil {
+			break
+		}
+
+		return e.complexity.PublicBadge.Title(childComplexity), true
+
+	case "PublicBadge.type":
+		if e.complexity.PublicBadge.Type == nil {
+			break
+		}
+
+		return e.complexity.PublicBadge.Type(childComplexity), true
+
+	case "PublicTheme.authorId":
+		if e.complexity.PublicTheme.AuthorID == nil {
+			break
+		}
+
+		return e.complexity.PublicTheme.AuthorID(childComplexity), true
+
+	case "PublicTheme.createdAt":
+		if e.complexity.PublicTheme.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.PublicTheme.CreatedAt(childComplexity), true
+
+	case "PublicTheme.downloadCount":
+		if e.complexity.PublicTheme.DownloadCount == nil {
+			break
+		}
+
+		return e.complexity.PublicTheme.DownloadCount(childComplexity), true
+
+	case "PublicTheme.downloadURL":
+		if e.complexity.PublicTheme.DownloadURL == nil {
+			break
+		}
+
+		return e.complexity.PublicTheme.DownloadURL(childComplexity), true
+
+	case "PublicTheme.isDark":
+		if e.complexity.PublicTheme.IsDark == nil {
+			break
+		}
+
+		return e.complexity.PublicTheme.IsDark(childComplexity), true
+
+	case "PublicTheme.isDefault":
+		if e.complexity.PublicTheme.IsDefault == nil {
+			break
+		}
+
+		return e.complexity.PublicTheme.IsDefault(childComplexity), true
+
+	case "PublicTheme.name":
+		if e.complexity.PublicTheme.Name == nil {
+			break
+		}
+
+		return e.complexity.PublicTheme.Name(childComplexity), true
+
+	case "PublicTheme.publicId":
+		if e.complexity.PublicTheme.PublicID == nil {
+			break
+		}
+
+		return e.complexity.PublicTheme.PublicID(childComplexity), true
+
+	case "PublicTheme.updatedAt":
+		if e.complexity.PublicTheme.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.PublicTheme.UpdatedAt(childComplexity), true
+
+	case "PublicTheme.version":
+		if e.complexity.PublicTheme.Version == nil {
+			break
+		}
+
+		return e.complexity.PublicTheme.Version(childComplexity), true
+
+	case "PublicUser.createdAt":
+		if e.complexity.PublicUser.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.PublicUser.CreatedAt(childComplexity), true
+
+	case "PublicUser.displayName":
+		if e.complexity.PublicUser.DisplayName == nil {
+			break
+		}
+
+		return e.complexity.PublicUser.DisplayName(childComplexity), true
+
+	case "PublicUser.info":
+		if e.complexity.PublicUser.Info == nil {
+			break
+		}
+
+		return e.complexity.PublicUser.Info(childComplexity), true
+
+	case "PublicUser.name":
+		if e.complexity.PublicUser.Name == nil {
+			break
+		}
+
+		return e.complexity.PublicUser.Name(childComplexity), true
+
+	case "PublicUser.plan":
+		if e.complexity.PublicUser.Plan == nil {
+			break
+		}
+
+		return e.complexity.PublicUser.Plan(childComplexity), true
+
+	case "PublicUser.publicId":
+		if e.complexity.PublicUser.PublicID == nil {
+			break
+		}
+
+		return e.complexity.PublicUser.PublicID(childComplexity), true
+
+	case "PublicUser.role":
+		if e.complexity.PublicUser.Role == nil {
+			break
+		}
+
+		return e.complexity.PublicUser.Role(childComplexity), true
+
+	case "PublicUser.status":
+		if e.complexity.PublicUser.Status == nil {
+			break
+		}
+
+		return e.complexity.PublicUser.Status(childComplexity), true
+
+	case "PublicUserInfo.avatarURL":
+		if e.complexity.PublicUserInfo.AvatarURL == nil {
+			break
+		}
+
+		return e.complexity.PublicUserInfo.AvatarURL(childComplexity), true
+
+	case "PublicUserInfo.birthDate":
+		if e.complexity.PublicUserInfo.BirthDate == nil {
+			break
+		}
+
+		return e.complexity.PublicUserInfo.BirthDate(childComplexity), true
+
+	case "PublicUserInfo.country":
+		if e.complexity.PublicUserInfo.Country == nil {
+			break
+		}
+
+		return e.complexity.PublicUserInfo.Country(childComplexity), true
+
+	case "PublicUserInfo.coverBackgroundURL":
+		if e.complexity.PublicUserInfo.CoverBackgroundURL == nil {
+			break
+		}
+
+		return e.complexity.PublicUserInfo.CoverBackgroundURL(childComplexity), true
+
+	case "PublicUserInfo.gender":
+		if e.complexity.PublicUserInfo.Gender == nil {
+			break
+		}
+
+		return e.complexity.PublicUserInfo.Gender(childComplexity), true
+
+	case "PublicUserInfo.header":
+		if e.complexity.PublicUserInfo.Header == nil {
+			break
+		}
+
+		return e.complexity.PublicUserInfo.Header(childComplexity), true
+
+	case "PublicUserInfo.introduction":
+		if e.complexity.PublicUserInfo.Introduction == nil {
+			break
+		}
+
+		return e.complexity.PublicUserInfo.Introduction(childComplexity), true
+
+	case "Query.getMyTrash":
+		if e.complexity.Query.GetMyTrash == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getMyTrash_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetMyTrash(childComplexity, args["input"].(*gqlmodels.GetMyTrashInput)), true
+
+	case "Query.getMyUsage":
+		if e.complexity.Query.GetMyUsage == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getMyUsage_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetMyUsage(childComplexity, args["input"].(*gqlmodels.GetMyUsageInput)), true
+
+	case "Query.searchBlockPacks":
+		if e.complexity.Query.SearchBlockPacks == nil {
+			break
+		}
+
+		args, err := ec.field_Query_searchBlockPacks_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SearchBlockPacks(childComplexity, args["input"].(gqlmodels.SearchBlockPackInput)), true
+
+	case "Query.searchBlocks":
+		if e.complexity.Query.SearchBlocks == nil {
+			break
+		}
+
+		args, err := ec.field_Query_searchBlocks_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SearchBlocks(childComplexity, args["input"].(gqlmodels.SearchBlockInput)), true
+
+	case "Query.searchItems":
+		if e.complexity.Query.SearchItems == nil {
+			break
+		}
+
+		args, err := ec.field_Query_searchItems_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SearchItems(childComplexity, args["input"].(gqlmodels.SearchItemInput)), true
+
+	case "Query.searchMaterials":
+		if e.complexity.Query.SearchMaterials == nil {
+			break
+		}
+
+		args, err := ec.field_Query_searchMaterials_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SearchMaterials(childComplexity, args["input"].(gqlmodels.SearchMaterialInput)), true
+
+	case "Query.searchRootShelves":
+		if e.complexity.Query.SearchRootShelves == nil {
+			break
+		}
+
+		args, err := ec.field_Query_searchRootShelves_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SearchRootShelves(childComplexity, args["input"].(gqlmodels.SearchRootShelfInput)), true
+
+	case "Query.searchRoutineTags":
+		if e.complexity.Query.SearchRoutineTags == nil {
+			break
+		}
+
+		args, err := ec.field_Query_searchRoutineTags_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SearchRoutineTags(childComplexity, args["input"].(gqlmodels.SearchRoutineTagInput)), true
+
+	case "Query.searchRoutineTaskRecords":
+		if e.complexity.Query.SearchRoutineTaskRecords == nil {
+			break
+		}
+
+		args, err := ec.field_Query_searchRoutineTaskRecords_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SearchRoutineTaskRecords(childComplexity, args["input"].(gqlmodels.SearchRoutineTaskRecordInput)), true
+
+	case "Query.searchRoutineTasks":
+		if e.complexity.Query.SearchRoutineTasks == nil {
+			break
+		}
+
+		args, err := ec.field_Query_searchRoutineTasks_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SearchRoutineTasks(childComplexity, args["input"].(gqlmodels.SearchRoutineTaskInput)), true
+
+	case "Query.searchRoutines":
+		if e.complexity.Query.SearchRoutines == nil {
+			break
+		}
+
+		args, err := ec.field_Query_searchRoutines_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SearchRoutines(childComplexity, args["input"].(gqlmodels.SearchRoutineInput)), true
+
+	case "Query.searchStations":
+		if e.complexity.Query.SearchStations == nil {
+			break
+		}
+
+		args, err := ec.field_Query_searchStations_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SearchStations(childComplexity, args["input"].(gqlmodels.SearchStationInput)), true
+
+	case "Query.searchSubShelves":
+		if e.complexity.Query.SearchSubShelves == nil {
+			break
+		}
+
+		args, err := ec.field_Query_searchSubShelves_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SearchSubShelves(childComplexity, args["input"].(gqlmodels.SearchSubShelfInput)), true
+
+	case "Query.searchThemes":
+		if e.complexity.Query.SearchThemes == nil {
+			break
+		}
+
+		args, err := ec.field_Query_searchThemes_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SearchThemes(childComplexity, args["input"].(gqlmodels.SearchThemeInput)), true
+
+	case "Query.searchUsers":
+		if e.complexity.Query.SearchUsers == nil {
+			break
+		}
+
+		args, err := ec.field_Query_searchUsers_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SearchUsers(childComplexity, args["input"].(gqlmodels.SearchUserInput)), true
+
+	case "ResourceEvent.change":
+		if e.complexity.ResourceEvent.Change == nil {
+			break
+		}
+
+		return e.complexity.ResourceEvent.Change(childComplexity), true
+
+	case "ResourceEvent.eventId":
+		if e.complexity.ResourceEvent.EventID == nil {
+			break
+		}
+
+		return e.complexity.ResourceEvent.EventID(childComplexity), true
+
+	case "ResourceEvent.eventType":
+		if e.complexity.ResourceEvent.EventType == nil {
+			break
+		}
+
+		return e.complexity.ResourceEvent.EventType(childComplexity), true
+
+	case "ResourceEvent.permission":
+		if e.complexity.ResourceEvent.Permission == nil {
+			break
+		}
+
+		return e.complexity.ResourceEvent.Permission(childComplexity), true
+
+	case "ResourceEvent.resourceId":
+		if e.complexity.ResourceEvent.ResourceID == nil {
+			break
+		}
+
+		return e.complexity.ResourceEvent.ResourceID(childComplexity), true
+
+	case "ResourceEvent.targetUserPublicId":
+		if e.complexity.ResourceEvent.TargetUserPublicID == nil {
+			break
+		}
+
+		return e.complexity.ResourceEvent.TargetUserPublicID(childComplexity), true
+
+	case "RestoreRootShelfPayload.createdAt":
+		if e.complexity.RestoreRootShelfPayload.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.RestoreRootShelfPayload.CreatedAt(childComplexity), true
+
+	case "RestoreRootShelfPayload.deletedAt":
+		if e.complexity.RestoreRootShelfPayload.DeletedAt == nil {
+			break
+		}
+
+		return e.complexity.RestoreRootShelfPayload.DeletedAt(childComplexity), true
+
+	case "RestoreRootShelfPayload.id":
+		if e.complexity.RestoreRootShelfPayload.Id == nil {
+			break
+		}
+
+		return e.complexity.RestoreRootShelfPayload.Id(childComplexity), true
+
+	case "RestoreRootShelfPayload.itemCount":
+		if e.complexity.RestoreRootShelfPayload.ItemCount == nil {
+			break
+		}
+
+		return e.complexity.RestoreRootShelfPayload.ItemCount(childComplexity), true
+
+	case "RestoreRootShelfPayload.lastAnalyzedAt":
+		if e.complexity.RestoreRootShelfPayload.LastAnalyzedAt == nil {
+			break
+		}
+
+		return e.complexity.RestoreRootShelfPayload.LastAnalyzedAt(childComplexity), true
+
+	case "RestoreRootShelfPayload.name":
+		if e.complexity.RestoreRootShelfPayload.Name == nil {
+			break
+		}
+
+		return e.complexity.RestoreRootShelfPayload.Name(childComplexity), true
+
+	case "RestoreRootShelfPayload.subShelfCount":
+		if e.complexity.RestoreRootShelfPayload.SubShelfCount == nil {
+			break
+		}
+
+		return e.complexity.RestoreRootShelfPayload.SubShelfCount(childComplexity), true
+
+	case "RestoreRootShelfPayload.updatedAt":
+		if e.complexity.RestoreRootShelfPayload.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.RestoreRootShelfPayload.UpdatedAt(childComplexity), true
+
+	case "RestoreStationPayload.createdAt":
+		if e.complexity.RestoreStationPayload.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.RestoreStationPayload.CreatedAt(childComplexity), true
+
+	case "RestoreStationPayload.deletedAt":
+		if e.complexity.RestoreStationPayload.DeletedAt == nil {
+			break
+		}
+
+		return e.complexity.RestoreStationPayload.DeletedAt(childComplexity), true
+
+	case "RestoreStationPayload.description":
+		if e.complexity.RestoreStationPayload.Description == nil {
+			break
+		}
+
+		return e.complexity.RestoreStationPayload.Description(childComplexity), true
+
+	case "RestoreStationPayload.headerBackgroundURL":
+		if e.complexity.RestoreStationPayload.HeaderBackgroundURL == nil {
+			break
+		}
+
+		return e.complexity.RestoreStationPayload.HeaderBackgroundURL(childComplexity), true
+
+	case "RestoreStationPayload.icon":
+		if e.complexity.RestoreStationPayload.Icon == nil {
+			break
+		}
+
+		return e.complexity.RestoreStationPayload.Icon(childComplexity), true
+
+	case "RestoreStationPayload.id":
+		if e.complexity.RestoreStationPayload.Id == nil {
+			break
+		}
+
+		return e.complexity.RestoreStationPayload.Id(childComplexity), true
+
+	case "RestoreStationPayload.name":
+		if e.complexity.RestoreStationPayload.Name == nil {
+			break
+		}
+
+		return e.complexity.RestoreStationPayload.Name(childComplexity), true
+
+	case "RestoreStationPayload.routineCount":
+		if e.complexity.RestoreStationPayload.RoutineCount == nil {
+			break
+		}
+
+		return e.complexity.RestoreStationPayload.RoutineCount(childComplexity), true
+
+	case "RestoreStationPayload.updatedAt":
+		if e.complexity.RestoreStationPayload.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.RestoreStationPayload.UpdatedAt(childComplexity), true
+
+	case "ResumeRoutineTaskPayload.updatedAt":
+		if e.complexity.ResumeRoutineTaskPayload.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.ResumeRoutineTaskPayload.UpdatedAt(childComplexity), true
+
+	case "RootShelfPermissionPayload.createdAt":
+		if e.complexity.RootShelfPermissionPayload.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.RootShelfPermissionPayload.CreatedAt(childComplexity), true
+
+	case "RootShelfPermissionPayload.permission":
+		if e.complexity.RootShelfPermissionPayload.Permission == nil {
+			break
+		}
+
+		return e.complexity.RootShelfPermissionPayload.Permission(childComplexity), true
+
+	case "RootShelfPermissionPayload.updatedAt":
+		if e.complexity.RootShelfPermissionPayload.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.RootShelfPermissionPayload.UpdatedAt(childComplexity), true
+
+	case "RootShelfPermissionPayload.userPublicId":
+		if e.complexity.RootShelfPermissionPayload.UserPublicId == nil {
+			break
+		}
+
+		return e.complexity.RootShelfPermissionPayload.UserPublicId(childComplexity), true
+
+	case "RoutinePayload.createdAt":
+		if e.complexity.RoutinePayload.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.RoutinePayload.CreatedAt(childComplexity), true
+
+	case "RoutinePayload.deletedAt":
+		if e.complexity.RoutinePayload.DeletedAt == nil {
+			break
+		}
+
+		return e.complexity.RoutinePayload.DeletedAt(childComplexity), true
+
+	case "RoutinePayload.description":
+		if e.complexity.RoutinePayload.Description == nil {
+			break
+		}
+
+		return e.complexity.RoutinePayload.Description(childComplexity), true
+
+	case "RoutinePayload.id":
+		if e.complexity.RoutinePayload.Id == nil {
+			break
+		}
+
+		return e.complexity.RoutinePayload.Id(childComplexity), true
+
+	case "RoutinePayload.isPinned":
+		if e.complexity.RoutinePayload.IsPinned == nil {
+			break
+		}
+
+		return e.complexity.RoutinePayload.IsPinned(childComplexity), true
+
+	case "RoutinePayload.itemIds":
+		if e.complexity.RoutinePayload.ItemIds == nil {
+			break
+		}
+
+		return e.complexity.RoutinePayload.ItemIds(childComplexity), true
+
+	case "RoutinePayload.period":
+		if e.complexity.RoutinePayload.Period == nil {
+			break
+		}
+
+		return e.complexity.RoutinePayload.Period(childComplexity), true
+
+	case "RoutinePayload.recurrenceRule":
+		if e.complexity.RoutinePayload.RecurrenceRule == nil {
+			break
+		}
+
+		return e.complexity.RoutinePayload.RecurrenceRule(childComplexity), true
+
+	case "RoutinePayload.scheduledEndAt":
+		if e.complexity.RoutinePayload.ScheduledEndAt == nil {
+			break
+		}
+
+		return e.complexity.RoutinePayload.ScheduledEndAt(childComplexity), true
+
+	case "RoutinePayload.scheduledStartAt":
+		if e.complexity.RoutinePayload.ScheduledStartAt == nil {
+			break
+		}
+
+		return e.complexity.RoutinePayload.ScheduledStartAt(childComplexity), true
+
+	case "RoutinePayload.stationId":
+		if e.complexity.RoutinePayload.StationId == nil {
+			break
+		}
+
+		return e.complexity.RoutinePayload.StationId(childComplexity), true
+
+	case "RoutinePayload.status":
+		if e.complexity.RoutinePayload.Status == nil {
+			break
+		}
+
+		return e.complexity.RoutinePayload.Status(childComplexity), true
+
+	case "RoutinePayload.tagIds":
+		if e.complexity.RoutinePayload.TagIds == nil {
+			break
+		}
+
+		return e.complexity.RoutinePayload.TagIds(childComplexity), true
+
+	case "RoutinePayload.taskIds":
+		if e.complexity.RoutinePayload.TaskIds == nil {
+			break
+		}
+
+		return e.complexity.RoutinePayload.TaskIds(childComplexity), true
+
+	case "RoutinePayload.timezone":
+		if e.complexity.RoutinePayload.Timezone == nil {
+			break
+		}
+
+		return e.complexity.RoutinePayload.Timezone(childComplexity), true
+
+	case "RoutinePayload.title":
+		if e.complexity.RoutinePayload.Title == nil {
+			break
+		}
+
+		return e.complexity.RoutinePayload.Title(childComplexity), true
+
+	case "RoutinePayload.updatedAt":
+		if e.complexity.RoutinePayload.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.RoutinePayload.UpdatedAt(childComplexity), true
+
+	case "RoutineTaskLifecycleEvent.attempt":
+		if e.complexity.RoutineTaskLifecycleEvent.Attempt == nil {
+			break
+		}
+
+		return e.complexity.RoutineTaskLifecycleEvent.Attempt(childComplexity), true
+
+	case "RoutineTaskLifecycleEvent.eventId":
+		if e.complexity.RoutineTaskLifecycleEvent.EventID == nil {
+			break
+		}
+
+		return e.complexity.RoutineTaskLifecycleEvent.EventID(childComplexity), true
+
+	case "RoutineTaskLifecycleEvent.occurredAt":
+		if e.complexity.RoutineTaskLifecycleEvent.OccurredAt == nil {
+			break
+		}
+
+		return e.complexity.RoutineTaskLifecycleEvent.OccurredAt(childComplexity), true
+
+	case "RoutineTaskLifecycleEvent.purpose":
+		if e.complexity.RoutineTaskLifecycleEvent.Purpose == nil {
+			break
+		}
+
+		return e.complexity.RoutineTaskLifecycleEvent.Purpose(childComplexity), true
+
+	case "RoutineTaskLifecycleEvent.routineId":
+		if e.complexity.RoutineTaskLifecycleEvent.RoutineID == nil {
+			break
+		}
+
+		return e.complexity.RoutineTaskLifecycleEvent.RoutineID(childComplexity), true
+
+	case "RoutineTaskLifecycleEvent.routineTaskId":
+		if e.complexity.RoutineTaskLifecycleEvent.RoutineTaskID == nil {
+			break
+		}
+
+		return e.complexity.RoutineTaskLifecycleEvent.RoutineTaskID(childComplexity), true
+
+	case "RoutineTaskLifecycleEvent.routineTaskRecordId":
+		if e.complexity.RoutineTaskLifecycleEvent.RoutineTaskRecordID == nil {
+			break
+		}
+
+		return e.complexity.RoutineTaskLifecycleEvent.RoutineTaskRecordID(childComplexity), true
+
+	case "RoutineTaskLifecycleEvent.status":
+		if e.complexity.RoutineTaskLifecycleEvent.Status == nil {
+			break
+		}
+
+		return e.complexity.RoutineTaskLifecycleEvent.Status(childComplexity), true
+
+	case "SaveMaterialPayload.updatedAt":
+		if e.complexity.SaveMaterialPayload.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.SaveMaterialPayload.UpdatedAt(childComplexity), true
+
+	case "SearchBadgeConnection.searchEdges":
+		if e.complexity.SearchBadgeConnection.SearchEdges == nil {
+			break
+		}
+
+		return e.complexity.SearchBadgeConnection.SearchEdges(childComplexity), true
+
+	case "SearchBadgeConnection.searchPageInfo":
+		if e.complexity.SearchBadgeConnection.SearchPageInfo == nil {
+			break
+		}
+
+		return e.complexity.SearchBadgeConnection.SearchPageInfo(childComplexity), true
+
+	case "SearchBadgeConnection.searchTime":
+		if e.complexity.SearchBadgeConnection.SearchTime == nil {
+			break
+		}
+
+		return e.complexity.SearchBadgeConnection.SearchTime(childComplexity), true
+
+	case "SearchBadgeConnection.totalCount":
+		if e.complexity.SearchBadgeConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.SearchBadgeConnection.TotalCount(childComplexity), true
+
+	case "SearchBadgeEdge.encodedSearchCursor":
+		if e.complexity.SearchBadgeEdge.EncodedSearchCursor == nil {
+			break
+		}
+
+		return e.complexity.SearchBadgeEdge.EncodedSearchCursor(childComplexity), true
+
+	case "SearchBadgeEdge.node":
+		if e.complexity.SearchBadgeEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.SearchBadgeEdge.Node(childComplexity), true
+
+	case "SearchBlockConnection.searchEdges":
+		if e.complexity.SearchBlockConnection.SearchEdges == nil {
+			break
+		}
+
+		return e.complexity.SearchBlockConnection.SearchEdges(childComplexity), true
+
+	case "SearchBlockConnection.searchPageInfo":
+		if e.complexity.SearchBlockConnection.SearchPageInfo == nil {
+			break
+		}
+
+		return e.complexity.SearchBlockConnection.SearchPageInfo(childComplexity), true
+
+	case "SearchBlockConnection.searchTime":
+		if e.complexity.SearchBlockConnection.SearchTime == nil {
+			break
+		}
+
+		return e.complexity.SearchBlockConnection.SearchTime(childComplexity), true
+
+	case "SearchBlockConnection.totalCount":
+		if e.complexity.SearchBlockConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.SearchBlockConnection.TotalCount(childComplexity), true
+
+	case "SearchBlockEdge.encodedSearchCursor":
+		if e.complexity.SearchBlockEdge.EncodedSearchCursor == nil {
+			break
+		}
+
+		return e.complexity.SearchBlockEdge.EncodedSearchCursor(childComplexity), true
+
+	case "SearchBlockEdge.highlight":
+		if e.complexity.SearchBlockEdge.Highlight == nil {
+			break
+		}
+
+		return e.complexity.SearchBlockEdge.Highlight(childComplexity), true
+
+	case "SearchBlockEdge.node":
+		if e.complexity.SearchBlockEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.SearchBlockEdge.Node(childComplexity), true
+
+	case "SearchBlockEdge.rank":
+		if e.complexity.SearchBlockEdge.Rank == nil {
+			break
+		}
+
+		return e.complexity.SearchBlockEdge.Rank(childComplexity), true
+
+	case "SearchBlockPackConnection.searchEdges":
+		if e.complexity.SearchBlockPackConnection.SearchEdges == nil {
+			break
+		}
+
+		return e.complexity.SearchBlockPackConnection.SearchEdges(childComplexity), true
+
+	case "SearchBlockPackConnection.searchPageInfo":
+		if e.complexity.SearchBlockPackConnection.SearchPageInfo == nil {
+			break
+		}
+
+		return e.complexity.SearchBlockPackConnection.SearchPageInfo(childComplexity), true
+
+	case "SearchBlockPackConnection.searchTime":
+		if e.complexity.SearchBlockPackConnection.SearchTime == nil {
+			break
+		}
+
+		return e.complexity.SearchBlockPackConnection.SearchTime(childComplexity), true
+
+	case "SearchBlockPackConnection.totalCount":
+		if e.complexity.SearchBlockPackConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.SearchBlockPackConnection.TotalCount(childComplexity), true
+
+	case "SearchBlockPackEdge.encodedSearchCursor":
+		if e.complexity.SearchBlockPackEdge.EncodedSearchCursor == nil {
+			break
+		}
+
+		return e.complexity.SearchBlockPackEdge.EncodedSearchCursor(childComplexity), true
+
+	case "SearchBlockPackEdge.highlight":
+		if e.complexity.SearchBlockPackEdge.Highlight == nil {
+			break
+		}
+
+		return e.complexity.SearchBlockPackEdge.Highlight(childComplexity), true
+
+	case "SearchBlockPackEdge.node":
+		if e.complexity.SearchBlockPackEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.SearchBlockPackEdge.Node(childComplexity), true
+
+	case "SearchBlockPackEdge.rank":
+		if e.complexity.SearchBlockPackEdge.Rank == nil {
+			break
+		}
+
+		return e.complexity.SearchBlockPackEdge.Rank(childComplexity), true
+
+	case "SearchItemConnection.searchEdges":
+		if e.complexity.SearchItemConnection.SearchEdges == nil {
+			break
+		}
+
+		return e.complexity.SearchItemConnection.SearchEdges(childComplexity), true
+
+	case "SearchItemConnection.searchPageInfo":
+		if e.complexity.SearchItemConnection.SearchPageInfo == nil {
+			break
+		}
+
+		return e.complexity.SearchItemConnection.SearchPageInfo(childComplexity), true
+
+	case "SearchItemConnection.searchTime":
+		if e.complexity.SearchItemConnection.SearchTime == nil {
+			break
+		}
+
+		return e.complexity.SearchItemConnection.SearchTime(childComplexity), true
+
+	case "SearchItemConnection.totalCount":
+		if e.complexity.SearchItemConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.SearchItemConnection.TotalCount(childComplexity), true
+
+	case "SearchItemEdge.encodedSearchCursor":
+		if e.complexity.SearchItemEdge.EncodedSearchCursor == nil {
+			break
+		}
+
+		return e.complexity.SearchItemEdge.EncodedSearchCursor(childComplexity), true
+
+	case "SearchItemEdge.node":
+		if e.complexity.SearchItemEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.SearchItemEdge.Node(childComplexity), true
+
+	case "SearchMaterialConnection.searchEdges":
+		if e.complexity.SearchMaterialConnection.SearchEdges == nil {
+			break
+		}
+
+		return e.complexity.SearchMaterialConnection.SearchEdges(childComplexity), true
+
+	case "SearchMaterialConnection.searchPageInfo":
+		if e.complexity.SearchMaterialConnection.SearchPageInfo == nil {
+			break
+		}
+
+		return e.complexity.SearchMaterialConnection.SearchPageInfo(childComplexity), true
+
+	case "SearchMaterialConnection.searchTime":
+		if e.complexity.SearchMaterialConnection.SearchTime == nil {
+			break
+		}
+
+		return e.complexity.SearchMaterialConnection.SearchTime(childComplexity), true
+
+	case "SearchMaterialConnection.totalCount":
+		if e.complexity.SearchMaterialConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.SearchMaterialConnection.TotalCount(childComplexity), true
+
+	case "SearchMaterialEdge.encodedSearchCursor":
+		if e.complexity.SearchMaterialEdge.EncodedSearchCursor == nil {
+			break
+		}
+
+		return e.complexity.SearchMaterialEdge.EncodedSearchCursor(childComplexity), true
+
+	case "SearchMaterialEdge.highlight":
+		if e.complexity.SearchMaterialEdge.Highlight == nil {
+			break
+		}
+
+		return e.complexity.SearchMaterialEdge.Highlight(childComplexity), true
+
+	case "SearchMaterialEdge.node":
+		if e.complexity.SearchMaterialEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.SearchMaterialEdge.Node(childComplexity), true
+
+	case "SearchMaterialEdge.rank":
+		if e.complexity.SearchMaterialEdge.Rank == nil {
+			break
+		}
+
+		return e.complexity.SearchMaterialEdge.Rank(childComplexity), true
+
+	case "SearchPageInfo.endEncodedSearchCursor":
+		if e.complexity.SearchPageInfo.EndEncodedSearchCursor == nil {
+			break
+		}
+
+		return e.complexity.SearchPageInfo.EndEncodedSearchCursor(childComplexity), true
+
+	case "SearchPageInfo.hasNextPage":
+		if e.complexity.SearchPageInfo.HasNextPage == nil {
+			break
+		}
+
+		return e.complexity.SearchPageInfo.HasNextPage(childComplexity), true
+
+	case "SearchPageInfo.hasPreviousPage":
+		if e.complexity.SearchPageInfo.HasPreviousPage == nil {
+			break
+		}
+
+		return e.complexity.SearchPageInfo.HasPreviousPage(childComplexity), true
+
+	case "SearchPageInfo.startEncodedSearchCursor":
+		if e.complexity.SearchPageInfo.StartEncodedSearchCursor == nil {
+			break
+		}
+
+		return e.complexity.SearchPageInfo.StartEncodedSearchCursor(childComplexity), true
+
+	case "SearchRootShelfConnection.searchEdges":
+		if e.complexity.SearchRootShelfConnection.SearchEdges == nil {
+			break
+		}
+
+		return e.complexity.SearchRootShelfConnection.SearchEdges(childComplexity), true
+
+	case "SearchRootShelfConnection.searchPageInfo":
+		if e.complexity.SearchRootShelfConnection.SearchPageInfo == nil {
+			break
+		}
+
+		return e.complexity.SearchRootShelfConnection.SearchPageInfo(childComplexity), true
+
+	case "SearchRootShelfConnection.searchTime":
+		if e.complexity.SearchRootShelfConnection.SearchTime == nil {
+			break
+		}
+
+		return e.complexity.SearchRootShelfConnection.SearchTime(childComplexity), true
+
+	case "SearchRootShelfConnection.totalCount":
+		if e.complexity.SearchRootShelfConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.SearchRootShelfConnection.TotalCount(childComplexity), true
+
+	case "SearchRootShelfEdge.encodedSearchCursor":
+		if e.complexity.SearchRootShelfEdge.EncodedSearchCursor == nil {
+			break
+		}
+
+		return e.complexity.SearchRootShelfEdge.EncodedSearchCursor(childComplexity), true
+
+	case "SearchRootShelfEdge.node":
+		if e.complexity.SearchRootShelfEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.SearchRootShelfEdge.Node(childComplexity), true
+
+	case "SearchRoutineConnection.searchEdges":
+		if e.complexity.SearchRoutineConnection.SearchEdges == nil {
+			break
+		}
+
+		return e.complexity.SearchRoutineConnection.SearchEdges(childComplexity), true
+
+	case "SearchRoutineConnection.searchPageInfo":
+		if e.complexity.SearchRoutineConnection.SearchPageInfo == nil {
+			break
+		}
+
+		return e.complexity.SearchRoutineConnection.SearchPageInfo(childComplexity), true
+
+	case "SearchRoutineConnection.searchTime":
+		if e.complexity.SearchRoutineConnection.SearchTime == nil {
+			break
+		}
+
+		return e.complexity.SearchRoutineConnection.SearchTime(childComplexity), true
+
+	case "SearchRoutineConnection.totalCount":
+		if e.complexity.SearchRoutineConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.SearchRoutineConnection.TotalCount(childComplexity), true
+
+	case "SearchRoutineEdge.encodedSearchCursor":
+		if e.complexity.SearchRoutineEdge.EncodedSearchCursor == nil {
+			break
+		}
+
+		return e.complexity.SearchRoutineEdge.EncodedSearchCursor(childComplexity), true
+
+	case "SearchRoutineEdge.node":
+		if e.complexity.SearchRoutineEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.SearchRoutineEdge.Node(childComplexity), true
+
+	case "SearchRoutineTagConnection.searchEdges":
+		if e.complexity.SearchRoutineTagConnection.SearchEdges == nil {
+			break
+		}
+
+		return e.complexity.SearchRoutineTagConnection.SearchEdges(childComplexity), true
+
+	case "SearchRoutineTagConnection.searchPageInfo":
+		if e.complexity.SearchRoutineTagConnection.SearchPageInfo == nil {
+			break
+		}
+
+		return e.complexity.SearchRoutineTagConnection.SearchPageInfo(childComplexity), true
+
+	case "SearchRoutineTagConnection.searchTime":
+		if e.complexity.SearchRoutineTagConnection.SearchTime == nil {
+			break
+		}
+
+		return e.complexity.SearchRoutineTagConnection.SearchTime(childComplexity), true
+
+	case "SearchRoutineTagConnection.totalCount":
+		if e.complexity.SearchRoutineTagConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.SearchRoutineTagConnection.TotalCount(childComplexity), true
+
+	case "SearchRoutineTagEdge.encodedSearchCursor":
+		if e.complexity.SearchRoutineTagEdge.EncodedSearchCursor == nil {
+			break
+		}
+
+		return e.complexity.SearchRoutineTagEdge.EncodedSearchCursor(childComplexity), true
+
+	case "SearchRoutineTagEdge.node":
+		if e.complexity.SearchRoutineTagEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.SearchRoutineTagEdge.Node(childComplexity), true
+
+	case "SearchRoutineTaskConnection.searchEdges":
+		if e.complexity.SearchRoutineTaskConnection.SearchEdges == nil {
+			break
+		}
+
+		return e.complexity.SearchRoutineTaskConnection.SearchEdges(childComplexity), true
+
+	case "SearchRoutineTaskConnection.searchPageInfo":
+		if e.complexity.SearchRoutineTaskConnection.SearchPageInfo == nil {
+			break
+		}
+
+		return e.complexity.SearchRoutineTaskConnection.SearchPageInfo(childComplexity), true
+
+	case "SearchRoutineTaskConnection.searchTime":
+		if e.complexity.SearchRoutineTaskConnection.SearchTime == nil {
+			break
+		}
+
+		return e.complexity.SearchRoutineTaskConnection.SearchTime(childComplexity), true
+
+	case "SearchRoutineTaskConnection.totalCount":
+		if e.complexity.SearchRoutineTaskConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.SearchRoutineTaskConnection.TotalCount(childComplexity), true
+
+	case "SearchRoutineTaskEdge.encodedSearchCursor":
+		if e.complexity.SearchRoutineTaskEdge.EncodedSearchCursor == nil {
+			break
+		}
+
+		return e.complexity.SearchRoutineTaskEdge.EncodedSearchCursor(childComplexity), true
+
+	case "SearchRoutineTaskEdge.node":
+		if e.complexity.SearchRoutineTaskEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.SearchRoutineTaskEdge.Node(childComplexity), true
+
+	case "SearchRoutineTaskRecordConnection.searchEdges":
+		if e.complexity.SearchRoutineTaskRecordConnection.SearchEdges == nil {
+			break
+		}
+
+		return e.complexity.SearchRoutineTaskRecordConnection.SearchEdges(childComplexity), true
+
+	case "SearchRoutineTaskRecordConnection.searchPageInfo":
+		if e.complexity.SearchRoutineTaskRecordConnection.SearchPageInfo == nil {
+			break
+		}
+
+		return e.complexity.SearchRoutineTaskRecordConnection.SearchPageInfo(childComplexity), true
+
+	case "SearchRoutineTaskRecordConnection.searchTime":
+		if e.complexity.SearchRoutineTaskRecordConnection.SearchTime == nil {
+			break
+		}
+
+		return e.complexity.SearchRoutineTaskRecordConnection.SearchTime(childComplexity), true
+
+	case "SearchRoutineTaskRecordConnection.totalCount":
+		if e.complexity.SearchRoutineTaskRecordConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.SearchRoutineTaskRecordConnection.TotalCount(childComplexity), true
+
+	case "SearchRoutineTaskRecordEdge.encodedSearchCursor":
+		if e.complexity.SearchRoutineTaskRecordEdge.EncodedSearchCursor == nil {
+			break
+		}
+
+		return e.complexity.SearchRoutineTaskRecordEdge.EncodedSearchCursor(childComplexity), true
+
+	case "SearchRoutineTaskRecordEdge.node":
+		if e.complexity.SearchRoutineTaskRecordEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.SearchRoutineTaskRecordEdge.Node(childComplexity), true
+
+	case "SearchStationConnection.searchEdges":
+		if e.complexity.SearchStationConnection.SearchEdges == nil {
+			break
+		}
+
+		return e.complexity.SearchStationConnection.SearchEdges(childComplexity), true
+
+	case "SearchStationConnection.searchPageInfo":
+		if e.complexity.SearchStationConnection.SearchPageInfo == nil {
+			break
+		}
+
+		return e.complexity.SearchStationConnection.SearchPageInfo(childComplexity), true
+
+	case "SearchStationConnection.searchTime":
+		if e.complexity.SearchStationConnection.SearchTime == nil {
+			break
+		}
+
+		return e.complexity.SearchStationConnection.SearchTime(childComplexity), true
+
+	case "SearchStationConnection.totalCount":
+		if e.complexity.SearchStationConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.SearchStationConnection.TotalCount(childComplexity), true
+
+	case "SearchStationEdge.encodedSearchCursor":
+		if e.complexity.SearchStationEdge.EncodedSearchCursor == nil {
+			break
+		}
+
+		return e.complexity.SearchStationEdge.EncodedSearchCursor(childComplexity), true
+
+	case "SearchStationEdge.node":
+		if e.complexity.SearchStationEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.SearchStationEdge.Node(childComplexity), true
+
+	case "SearchSubShelfConnection.searchEdges":
+		if e.complexity.SearchSubShelfConnection.SearchEdges == nil {
+			break
+		}
+
+		return e.complexity.SearchSubShelfConnection.SearchEdges(childComplexity), true
+
+	case "SearchSubShelfConnection.searchPageInfo":
+		if e.complexity.SearchSubShelfConnection.SearchPageInfo == nil {
+			break
+		}
+
+		return e.complexity.SearchSubShelfConnection.SearchPageInfo(childComplexity), true
+
+	case "SearchSubShelfConnection.searchTime":
+		if e.complexity.SearchSubShelfConnection.SearchTime == nil {
+			break
+		}
+
+		return e.complexity.SearchSubShelfConnection.SearchTime(childComplexity), true
+
+	case "SearchSubShelfConnection.totalCount":
+		if e.complexity.SearchSubShelfConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.SearchSubShelfConnection.TotalCount(childComplexity), true
+
+	case "SearchSubShelfEdge.encodedSearchCursor":
+		if e.complexity.SearchSubShelfEdge.EncodedSearchCursor == nil {
+			break
+		}
+
+		return e.complexity.SearchSubShelfEdge.EncodedSearchCursor(childComplexity), true
+
+	case "SearchSubShelfEdge.node":
+		if e.complexity.SearchSubShelfEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.SearchSubShelfEdge.Node(childComplexity), true
+
+	case "SearchThemeConnection.searchEdges":
+		if e.complexity.SearchThemeConnection.SearchEdges == nil {
+			break
+		}
+
+		return e.complexity.SearchThemeConnection.SearchEdges(childComplexity), true
+
+	case "SearchThemeConnection.searchPageInfo":
+		if e.complexity.SearchThemeConnection.SearchPageInfo == nil {
+			break
+		}
+
+		return e.complexity.SearchThemeConnection.SearchPageInfo(childComplexity), true
+
+	case "SearchThemeConnection.searchTime":
+		if e.complexity.SearchThemeConnection.SearchTime == nil {
+			break
+		}
+
+		return e.complexity.SearchThemeConnection.SearchTime(childComplexity), true
+
+	case "SearchThemeConnection.totalCount":
+		if e.complexity.SearchThemeConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.SearchThemeConnection.TotalCount(childComplexity), true
+
+	case "SearchThemeEdge.encodedSearchCursor":
+		if e.complexity.SearchThemeEdge.EncodedSearchCursor == nil {
+			break
+		}
+
+		return e.complexity.SearchThemeEdge.EncodedSearchCursor(childComplexity), true
+
+	case "SearchThemeEdge.node":
+		if e.complexity.SearchThemeEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.SearchThemeEdge.Node(childComplexity), true
+
+	case "SearchUserConnection.searchEdges":
+		if e.complexity.SearchUserConnection.SearchEdges == nil {
+			break
+		}
+
+		return e.complexity.SearchUserConnection.SearchEdges(childComplexity), true
+
+	case "SearchUserConnection.searchPageInfo":
+		if e.complexity.SearchUserConnection.SearchPageInfo == nil {
+			break
+		}
+
+		return e.complexity.SearchUserConnection.SearchPageInfo(childComplexity), true
+
+	case "SearchUserConnection.searchTime":
+		if e.complexity.SearchUserConnection.SearchTime == nil {
+			break
+		}
+
+		return e.complexity.SearchUserConnection.SearchTime(childComplexity), true
+
+	case "SearchUserConnection.totalCount":
+		if e.complexity.SearchUserConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.SearchUserConnection.TotalCount(childComplexity), true
+
+	case "SearchUserEdge.encodedSearchCursor":
+		if e.complexity.SearchUserEdge.EncodedSearchCursor == nil {
+			break
+		}
+
+		return e.complexity.SearchUserEdge.EncodedSearchCursor(childComplexity), true
+
+	case "SearchUserEdge.node":
+		if e.complexity.SearchUserEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.SearchUserEdge.Node(childComplexity), true
+
+	case "StationPermissionPayload.createdAt":
+		if e.complexity.StationPermissionPayload.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.StationPermissionPayload.CreatedAt(childComplexity), true
+
+	case "StationPermissionPayload.permission":
+		if e.complexity.StationPermissionPayload.Permission == nil {
+			break
+		}
+
+		return e.complexity.StationPermissionPayload.Permission(childComplexity), true
+
+	case "StationPermissionPayload.updatedAt":
+		if e.complexity.StationPermissionPayload.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.StationPermissionPayload.UpdatedAt(childComplexity), true
+
+	case "StationPermissionPayload.userPublicId":
+		if e.complexity.StationPermissionPayload.UserPublicId == nil {
+			break
+		}
+
+		return e.complexity.StationPermissionPayload.UserPublicId(childComplexity), true
+
+	case "SubShelfPayload.createdAt":
+		if e.complexity.SubShelfPayload.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.SubShelfPayload.CreatedAt(childComplexity), true
+
+	case "SubShelfPayload.deletedAt":
+		if e.complexity.SubShelfPayload.DeletedAt == nil {
+			break
+		}
+
+		return e.complexity.SubShelfPayload.DeletedAt(childComplexity), true
+
+	case "SubShelfPayload.id":
+		if e.complexity.SubShelfPayload.Id == nil {
+			break
+		}
+
+		return e.complexity.SubShelfPayload.Id(childComplexity), true
+
+	case "SubShelfPayload.name":
+		if e.complexity.SubShelfPayload.Name == nil {
+			break
+		}
+
+		return e.complexity.SubShelfPayload.Name(childComplexity), true
+
+	case "SubShelfPayload.path":
+		if e.complexity.SubShelfPayload.Path == nil {
+			break
+		}
+
+		return e.complexity.SubShelfPayload.Path(childComplexity), true
+
+	case "SubShelfPayload.prevSubShelfId":
+		if e.complexity.SubShelfPayload.PrevSubShelfId == nil {
+			break
+		}
+
+		return e.complexity.SubShelfPayload.PrevSubShelfId(childComplexity), true
+
+	case "SubShelfPayload.rootShelfId":
+		if e.complexity.SubShelfPayload.RootShelfId == nil {
+			break
+		}
+
+		return e.complexity.SubShelfPayload.RootShelfId(childComplexity), true
+
+	case "SubShelfPayload.updatedAt":
+		if e.complexity.SubShelfPayload.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.SubShelfPayload.UpdatedAt(childComplexity), true
+
+	case "Subscription.resourceEvents":
+		if e.complexity.Subscription.ResourceEvents == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_resourceEvents_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.ResourceEvents(childComplexity, args["input"].(*gqlmodels.SubscribeResourceEventsInput)), true
+
+	case "Subscription.routineTaskLifecycleEvents":
+		if e.complexity.Subscription.RoutineTaskLifecycleEvents == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_routineTaskLifecycleEvents_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.RoutineTaskLifecycleEvents(childComplexity, args["input"].(*gqlmodels.SubscribeRoutineTaskLifecycleEventsInput)), true
+
+	case "TransferRootShelfOwnershipPayload.newOwnerUserPublicId":
+		if e.complexity.TransferRootShelfOwnershipPayload.NewOwnerUserPublicId == nil {
+			break
+		}
+
+		return e.complexity.TransferRootShelfOwnershipPayload.NewOwnerUserPublicId(childComplexity), true
+
+	case "TransferRootShelfOwnershipPayload.previousOwnerUserPublicId":
+		if e.complexity.TransferRootShelfOwnershipPayload.PreviousOwnerUserPublicId == nil {
+			break
+		}
+
+		return e.complexity.TransferRootShelfOwnershipPayload.PreviousOwnerUserPublicId(childComplexity), true
+
+	case "TransferRootShelfOwnershipPayload.rootShelfId":
+		if e.complexity.TransferRootShelfOwnershipPayload.RootShelfId == nil {
+			break
+		}
+
+		return e.complexity.TransferRootShelfOwnershipPayload.RootShelfId(childComplexity), true
+
+	case "TransferRootShelfOwnershipPayload.updatedAt":
+		if e.complexity.TransferRootShelfOwnershipPayload.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.TransferRootShelfOwnershipPayload.UpdatedAt(childComplexity), true
+
+	case "TransferStationOwnershipPayload.newOwnerUserPublicId":
+		if e.complexity.TransferStationOwnershipPayload.NewOwnerUserPublicId == nil {
+			break
+		}
+
+		return e.complexity.TransferStationOwnershipPayload.NewOwnerUserPublicId(childComplexity), true
+
+	case "TransferStationOwnershipPayload.previousOwnerUserPublicId":
+		if e.complexity.TransferStationOwnershipPayload.PreviousOwnerUserPublicId == nil {
+			break
+		}
+
+		return e.complexity.TransferStationOwnershipPayload.PreviousOwnerUserPublicId(childComplexity), true
+
+	case "TransferStationOwnershipPayload.stationId":
+		if e.complexity.TransferStationOwnershipPayload.StationId == nil {
+			break
+		}
+
+		return e.complexity.TransferStationOwnershipPayload.StationId(childComplexity), true
+
+	case "TransferStationOwnershipPayload.updatedAt":
+		if e.complexity.TransferStationOwnershipPayload.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.TransferStationOwnershipPayload.UpdatedAt(childComplexity), true
+
+	case "TrashItem.deletedAt":
+		if e.complexity.TrashItem.DeletedAt == nil {
+			break
+		}
+
+		return e.complexity.TrashItem.DeletedAt(childComplexity), true
+
+	case "TrashItem.id":
+		if e.complexity.TrashItem.ID == nil {
+			break
+		}
+
+		return e.complexity.TrashItem.ID(childComplexity), true
+
+	case "TrashItem.name":
+		if e.complexity.TrashItem.Name == nil {
+			break
+		}
+
+		return e.complexity.TrashItem.Name(childComplexity), true
+
+	case "TrashItem.parentSubShelfId":
+		if e.complexity.TrashItem.ParentSubShelfID == nil {
+			break
+		}
+
+		return e.complexity.TrashItem.ParentSubShelfID(childComplexity), true
+
+	case "TrashItem.purgeAt":
+		if e.complexity.TrashItem.PurgeAt == nil {
+			break
+		}
+
+		return e.complexity.TrashItem.PurgeAt(childComplexity), true
+
+	case "TrashItem.rootShelfId":
+		if e.complexity.TrashItem.RootShelfID == nil {
+			break
+		}
+
+		return e.complexity.TrashItem.RootShelfID(childComplexity), true
+
+	case "TrashItem.size":
+		if e.complexity.TrashItem.Size == nil {
+			break
+		}
+
+		return e.complexity.TrashItem.Size(childComplexity), true
+
+	case "TrashItem.type":
+		if e.complexity.TrashItem.Type == nil {
+			break
+		}
+
+		return e.complexity.TrashItem.Type(childComplexity), true
+
+	case "UpdateBlockPackPayload.updatedAt":
+		if e.complexity.UpdateBlockPackPayload.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.UpdateBlockPackPayload.UpdatedAt(childComplexity), true
+
+	case "UpdateBlockPacksPayload.updatedAt":
+		if e.complexity.UpdateBlockPacksPayload.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.UpdateBlockPacksPayload.UpdatedAt(childComplexity), true
+
+	case "UpdateMaterialPayload.updatedAt":
+		if e.complexity.UpdateMaterialPayload.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.UpdateMaterialPayload.UpdatedAt(childComplexity), true
+
+	case "UpdateRootShelfPayload.updatedAt":
+		if e.complexity.UpdateRootShelfPayload.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.UpdateRootShelfPayload.UpdatedAt(childComplexity), true
+
+	case "UpdateRootShelvesPayload.updatedAt":
+		if e.complexity.UpdateRootShelvesPayload.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.UpdateRootShelvesPayload.UpdatedAt(childComplexity), true
+
+	case "UpdateRoutinePayload.updatedAt":
+		if e.complexity.UpdateRoutinePayload.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.UpdateRoutinePayload.UpdatedAt(childComplexity), true
+
+	case "UpdateRoutineTaskPayload.updatedAt":
+		if e.complexity.UpdateRoutineTaskPayload.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.UpdateRoutineTaskPayload.UpdatedAt(childComplexity), true
+
+	case "UpdateRoutinesPayload.updatedAt":
+		if e.complexity.UpdateRoutinesPayload.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.UpdateRoutinesPayload.UpdatedAt(childComplexity), true
+
+	case "UpdateStationPayload.updatedAt":
+		if e.complexity.UpdateStationPayload.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.UpdateStationPayload.UpdatedAt(childComplexity), true
+
+	case "UpdateStationsPayload.updatedAt":
+		if e.complexity.UpdateStationsPayload.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.UpdateStationsPayload.UpdatedAt(childComplexity), true
+
+	case "UpdateSubShelfPayload.updatedAt":
+		if e.complexity.UpdateSubShelfPayload.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.UpdateSubShelfPayload.UpdatedAt(childComplexity), true
+
+	case "UpdateSubShelvesPayload.updatedAt":
+		if e.complexity.UpdateSubShelvesPayload.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.UpdateSubShelvesPayload.UpdatedAt(childComplexity), true
+
+	case "UpsertRootShelfPermissionsPayload.permissions":
+		if e.complexity.UpsertRootShelfPermissionsPayload.Permissions == nil {
+			break
+		}
+
+		return e.complexity.UpsertRootShelfPermissionsPayload.Permissions(childComplexity), true
+
+	case "UpsertStationPermissionsPayload.permissions":
+		if e.complexity.UpsertStationPermissionsPayload.Permissions == nil {
+			break
+		}
+
+		return e.complexity.UpsertStationPermissionsPayload.Permissions(childComplexity), true
+
+	case "UsageLimit.key":
+		if e.complexity.UsageLimit.Key == nil {
+			break
+		}
+
+		return e.complexity.UsageLimit.Key(childComplexity), true
+
+	case "UsageLimit.limit":
+		if e.complexity.UsageLimit.Limit == nil {
+			break
+		}
+
+		return e.complexity.UsageLimit.Limit(childComplexity), true
+
+	case "UsageLimit.percentage":
+		if e.complexity.UsageLimit.Percentage == nil {
+			break
+		}
+
+		return e.complexity.UsageLimit.Percentage(childComplexity), true
+
+	case "UsageLimit.resetAt":
+		if e.complexity.UsageLimit.ResetAt == nil {
+			break
+		}
+
+		return e.complexity.UsageLimit.ResetAt(childComplexity), true
+
+	case "UsageLimit.used":
+		if e.complexity.UsageLimit.Used == nil {
+			break
+		}
+
+		return e.complexity.UsageLimit.Used(childComplexity), true
+
+	case "UsageSnapshot.additionalItemCount":
+		if e.complexity.UsageSnapshot.AdditionalItemCount == nil {
+			break
+		}
+
+		return e.complexity.UsageSnapshot.AdditionalItemCount(childComplexity), true
+
+	case "UsageSnapshot.blockCount":
+		if e.complexity.UsageSnapshot.BlockCount == nil {
+			break
+		}
+
+		return e.complexity.UsageSnapshot.BlockCount(childComplexity), true
+
+	case "UsageSnapshot.blockPackCount":
+		if e.complexity.UsageSnapshot.BlockPackCount == nil {
+			break
+		}
+
+		return e.complexity.UsageSnapshot.BlockPackCount(childComplexity), true
+
+	case "UsageSnapshot.date":
+		if e.complexity.UsageSnapshot.Date == nil {
+			break
+		}
+
+		return e.complexity.UsageSnapshot.Date(childComplexity), true
+
+	case "UsageSnapshot.materialCount":
+		if e.complexity.UsageSnapshot.MaterialCount == nil {
+			break
+		}
+
+		return e.complexity.UsageSnapshot.MaterialCount(childComplexity), true
+
+	case "UsageSnapshot.rootShelfCount":
+		if e.complexity.UsageSnapshot.RootShelfCount == nil {
+			break
+		}
+
+		return e.complexity.UsageSnapshot.RootShelfCount(childComplexity), true
+
+	case "UsageSnapshot.routineCount":
+		if e.complexity.UsageSnapshot.RoutineCount == nil {
+			break
+		}
+
+		return e.complexity.UsageSnapshot.RoutineCount(childComplexity), true
+
+	case "UsageSnapshot.routineTagCount":
+		if e.complexity.UsageSnapshot.RoutineTagCount == nil {
+			break
+		}
+
+		return e.complexity.UsageSnapshot.RoutineTagCount(childComplexity), true
+
+	case "UsageSnapshot.routineTaskCostUnitUsed":
+		if e.complexity.UsageSnapshot.RoutineTaskCostUnitUsed == nil {
+			break
+		}
+
+		return e.complexity.UsageSnapshot.RoutineTaskCostUnitUsed(childComplexity), true
+
+	case "UsageSnapshot.stationCount":
+		if e.complexity.UsageSnapshot.StationCount == nil {
+			break
+		}
+
+		return e.complexity.UsageSnapshot.StationCount(childComplexity), true
+
+	case "UsageSnapshot.workflowCount":
+		if e.complexity.UsageSnapshot.WorkflowCount == nil {
+			break
+		}
+
+		return e.complexity.UsageSnapshot.WorkflowCount(childComplexity), true
+
+	}
+	return 0, false
+}
+
+func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
+	opCtx := graphql.GetOperationContext(ctx)
+	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
+	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputCreatableBlockPackInput,
+		ec.unmarshalInputCreatableMaterialInput,
+		ec.unmarshalInputCreatableRootShelfInput,
+		ec.unmarshalInputCreatableRoutineInput,
+		ec.unmarshalInputCreatableRoutineTaskInput,
+		ec.unmarshalInputCreatableStationInput,
+		ec.unmarshalInputCreatableSubShelfInput,
+		ec.unmarshalInputCreateBlockPacksInput,
+		ec.unmarshalInputCreateRootShelfPermissionInput,
+		ec.unmarshalInputCreateRootShelvesInput,
+		ec.unmarshalInputCreateRoutinesByStationIdsInput,
+		ec.unmarshalInputCreateStationPermissionInput,
+		ec.unmarshalInputCreateStationsInput,
+		ec.unmarshalInputCreateSubShelvesByRootShelfIdsInput,
+		ec.unmarshalInputDeleteBlockPackInput,
+		ec.unmarshalInputDeleteBlockPacksInput,
+		ec.unmarshalInputDeleteMaterialInput,
+		ec.unmarshalInputDeleteMaterialsInput,
+		ec.unmarshalInputDeleteRootShelfInput,
+		ec.unmarshalInputDeleteRootShelfPermissionInput,
+		ec.unmarshalInputDeleteRootShelfPermissionsInput,
+		ec.unmarshalInputDeleteRootShelvesInput,
+		ec.unmarshalInputDeleteRoutineInput,
+		ec.unmarshalInputDeleteRoutinesInput,
+		ec.unmarshalInputDeleteStationInput,
+		ec.unmarshalInputDeleteStationPermissionInput,
+		ec.unmarshalInputDeleteStationPermissionsInput,
+		ec.unmarshalInputDeleteStationsInput,
+		ec.unmarshalInputDeleteSubShelfInput,
+		ec.unmarshalInputDeleteSubShelvesInput,
+		ec.unmarshalInputGetMyTrashInput,
+		ec.unmarshalInputGetMyUsageInput,
+		ec.unmarshalInputHardDeleteRoutineInput,
+		ec.unmarshalInputHardDeleteRoutineTaskInput,
+		ec.unmarshalInputHardDeleteRoutineTasksInput,
+		ec.unmarshalInputHardDeleteRoutinesInput,
+		ec.unmarshalInputHardDeleteStationInput,
+		ec.unmarshalInputHardDeleteStationsInput,
+		ec.unmarshalInputLeaveRootShelfInput,
+		ec.unmarshalInputLeaveRootShelvesInput,
+		ec.unmarshalInputLeaveStationInput,
+		ec.unmarshalInputLeaveStationsInput,
+		ec.unmarshalInputLinkRoutineItemInput,
+		ec.unmarshalInputLinkRoutineItemsInput,
+		ec.unmarshalInputLinkRoutineTagInput,
+		ec.unmarshalInputLinkRoutineTagsInput,
+		ec.unmarshalInputLinkableRoutineAndItemInput,
+		ec.unmarshalInputLinkableRoutineAndTagInput,
+		ec.unmarshalInputMovableBlockPackInput,
+		ec.unmarshalInputMovableSubShelfInput,
+		ec.unmarshalInputMoveBlockPackByParentSubShelfIdInput,
+		ec.unmarshalInputMoveBlockPacksByParentSubShelfIdsInput,
+		ec.unmarshalInputMoveMaterialInput,
+		ec.unmarshalInputMoveMaterialsInput,
+		ec.unmarshalInputMoveSubShelfByRootShelfIdInput,
+		ec.unmarshalInputMoveSubShelvesByRootShelfIdsInput,
+		ec.unmarshalInputPauseRoutineTaskInput,
+		ec.unmarshalInputRestoreBlockPackInput,
+		ec.unmarshalInputRestoreBlockPacksInput,
+		ec.unmarshalInputRestoreMaterialInput,
+		ec.unmarshalInputRestoreMaterialsInput,
+		ec.unmarshalInputRestoreRootShelfInput,
+		ec.unmarshalInputRestoreRootShelvesInput,
+		ec.unmarshalInputRestoreRoutineInput,
+		ec.unmarshalInputRestoreRoutinesInput,
+		ec.unmarshalInputRestoreStationInput,
+		ec.unmarshalInputRestoreStationsInput,
+		ec.unmarshalInputRestoreSubShelfInput,
+		ec.unmarshalInputRestoreSubShelvesInput,
+		ec.unmarshalInputResumeRoutineTaskInput,
+		ec.unmarshalInputSaveMaterialInput,
+		ec.unmarshalInputSearchBadgeCursorFields,
+		ec.unmarshalInputSearchBadgeFilters,
+		ec.unmarshalInputSearchBadgeInput,
+		ec.unmarshalInputSearchBlockCursorFields,
+		ec.unmarshalInputSearchBlockInput,
+		ec.unmarshalInputSearchBlockPackCursorFields,
+		ec.unmarshalInputSearchBlockPackInput,
+		ec.unmarshalInputSearchItemCursorFields,
+		ec.unmarshalInputSearchItemInput,
+		ec.unmarshalInputSearchMaterialCursorFields,
+		ec.unmarshalInputSearchMaterialInput,
+		ec.unmarshalInputSearchRootShelfCursorFields,
+		ec.unmarshalInputSearchRootShelfInput,
+		ec.unmarshalInputSearchRoutineCursorFields,
+		ec.unmarshalInputSearchRoutineInput,
+		ec.unmarshalInputSearchRoutineTagCursorFields,
+		ec.unmarshalInputSearchRoutineTagInput,
+		ec.unmarshalInputSearchRoutineTaskCursorFields,
+		ec.unmarshalInputSearchRoutineTaskInput,
+		ec.unmarshalInputSearchRoutineTaskRecordCursorFields,
+		ec.unmarshalInputSearchRoutineTaskRecordInput,
+		ec.unmarshalInputSearchStationCursorFields,
+		ec.unmarshalInputSearchStationInput,
+		ec.unmarshalInputSearchSubShelfCursorFields,
+		ec.unmarshalInputSearchSubShelfInput,
+		ec.unmarshalInputSearchThemeCursorFields,
+		ec.unmarshalInputSearchThemeFilters,
+		ec.unmarshalInputSearchThemeInput,
+		ec.unmarshalInputSearchUserCursorFields,
 		ec.unmarshalInputSearchUserFilters,
 		ec.unmarshalInputSearchUserInput,
+		ec.unmarshalInputSubscribeResourceEventsInput,
+		ec.unmarshalInputSubscribeRoutineTaskLifecycleEventsInput,
+		ec.unmarshalInputTransferRootShelfOwnershipInput,
+		ec.unmarshalInputTransferStationOwnershipInput,
+		ec.unmarshalInputUpdatableBlockPackInput,
+		ec.unmarshalInputUpdatableBlockPackValuesInput,
+		ec.unmarshalInputUpdatableMaterialInput,
+		ec.unmarshalInputUpdatableRootShelfInput,
+		ec.unmarshalInputUpdatableRootShelfValuesInput,
+		ec.unmarshalInputUpdatableRoutineInput,
+		ec.unmarshalInputUpdatableRoutineTaskInput,
+		ec.unmarshalInputUpdatableRoutineTaskValuesInput,
+		ec.unmarshalInputUpdatableRoutineValuesInput,
+		ec.unmarshalInputUpdatableStationInput,
+		ec.unmarshalInputUpdatableStationValuesInput,
+		ec.unmarshalInputUpdatableSubShelfInput,
+		ec.unmarshalInputUpdatableSubShelfValuesInput,
+		ec.unmarshalInputUpdateBlockPackInput,
+		ec.unmarshalInputUpdateBlockPackValuesInput,
+		ec.unmarshalInputUpdateBlockPacksInput,
+		ec.unmarshalInputUpdateMaterialInput,
+		ec.unmarshalInputUpdateRootShelfInput,
+		ec.unmarshalInputUpdateRootShelfPermissionInput,
+		ec.unmarshalInputUpdateRootShelfValuesInput,
+		ec.unmarshalInputUpdateRootShelvesInput,
+		ec.unmarshalInputUpdateRoutinesInput,
+		ec.unmarshalInputUpdateStationInput,
+		ec.unmarshalInputUpdateStationPermissionInput,
+		ec.unmarshalInputUpdateStationValuesInput,
+		ec.unmarshalInputUpdateStationsInput,
+		ec.unmarshalInputUpdateSubShelfInput,
+		ec.unmarshalInputUpdateSubShelfValuesInput,
+		ec.unmarshalInputUpdateSubShelvesInput,
+		ec.unmarshalInputUpsertRootShelfPermissionInput,
+		ec.unmarshalInputUpsertRootShelfPermissionsInput,
+		ec.unmarshalInputUpsertStationPermissionInput,
+		ec.unmarshalInputUpsertStationPermissionsInput,
+		ec.unmarshalInputUpsertableRootShelfPermissionInput,
+		ec.unmarshalInputUpsertableStationPermissionInput,
 	)
 	first := true
 
-	switch opCtx.Operation.Operation {
-	case ast.Query:
-		return func(ctx context.Context) *graphql.Response {
-			var response graphql.Response
-			var data graphql.Marshaler
-			if first {
-				first = false
-				ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
-				data = ec._Query(ctx, opCtx.Operation.SelectionSet)
-			} else {
-				if atomic.LoadInt32(&ec.pendingDeferred) > 0 {
-					result := <-ec.deferredResults
-					atomic.AddInt32(&ec.pendingDeferred, -1)
-					data = result.Result
-					response.Path = result.Path
-					response.Label = result.Label
-					response.Errors = result.Errors
-				} else {
-					return nil
-				}
-			}
-			var buf bytes.Buffer
-			data.MarshalGQL(&buf)
-			response.Data = buf.Bytes()
-			if atomic.LoadInt32(&ec.deferred) > 0 {
-				hasNext := atomic.LoadInt32(&ec.pendingDeferred) > 0
-				response.HasNext = &hasNext
-			}
+	switch opCtx.Operation.Operation {
+	case ast.Query:
+		return func(ctx context.Context) *graphql.Response {
+			var response graphql.Response
+			var data graphql.Marshaler
+			if first {
+				first = false
+				ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+				data = ec._Query(ctx, opCtx.Operation.SelectionSet)
+			} else {
+				if atomic.LoadInt32(&ec.pendingDeferred) > 0 {
+					result := <-ec.deferredResults
+					atomic.AddInt32(&ec.pendingDeferred, -1)
+					data = result.Result
+					response.Path = result.Path
+					response.Label = result.Label
+					response.Errors = result.Errors
+				} else {
+					return nil
+				}
+			}
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+			response.Data = buf.Bytes()
+			if atomic.LoadInt32(&ec.deferred) > 0 {
+				hasNext := atomic.LoadInt32(&ec.pendingDeferred) > 0
+				response.HasNext = &hasNext
+			}
+
+			return &response
+		}
+	case ast.Mutation:
+		return func(ctx context.Context) *graphql.Response {
+			if !first {
+				return nil
+			}
+			first = false
+			ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+			data := ec._Mutation(ctx, opCtx.Operation.SelectionSet)
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, opCtx.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next(ctx)
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+
+	default:
+		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
+	}
+}
+
+type executionContext struct {
+	*graphql.OperationContext
+	*executableSchema
+	deferred        int32
+	pendingDeferred int32
+	deferredResults chan graphql.DeferredResult
+}
+
+func (ec *executionContext) processDeferredGroup(dg graphql.DeferredGroup) {
+	atomic.AddInt32(&ec.pendingDeferred, 1)
+	go func() {
+		ctx := graphql.WithFreshResponseContext(dg.Context)
+		dg.FieldSet.Dispatch(ctx)
+		ds := graphql.DeferredResult{
+			Path:   dg.Path,
+			Label:  dg.Label,
+			Result: dg.FieldSet,
+			Errors: graphql.GetErrors(ctx),
+		}
+		// null fields should bubble up
+		if dg.FieldSet.Invalids > 0 {
+			ds.Result = graphql.Null
+		}
+		ec.deferredResults <- ds
+	}()
+}
+
+func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
+	}
+	return introspection.WrapSchema(ec.Schema()), nil
+}
+
+func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
+	}
+	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
+}
+
+var sources = []*ast.Source{
+	{Name: "../schemas/enums/access_control_permission_enum.graphql", Input: `enum AccessControlPermission {
+  Owner
+  Admin
+  Write
+  Read
+}
+`, BuiltIn: false},
+	{Name: "../schemas/enums/badge_type_enum.graphql", Input: `enum BadgeType {
+  Diamond
+  Golden
+  Silver
+  Bronze
+  Steel
+}
+`, BuiltIn: false},
+	{Name: "../schemas/enums/block_type_enum.graphql", Input: `enum BlockType {
+    BlockType_Paragraph # paragraph
+	BlockType_Heading, # heading
+	BlockType_Quote, # quote
+	BlockType_BulletListItem, # bulletListItem
+	BlockType_NumberedListItem, # numberedListItem
+	BlockType_CheckListItem, # checkListItem
+	BlockType_ToggleListItem, # toggleListItem
+	BlockType_Image, # image
+	BlockType_Video, # video
+	BlockType_Audio, # audio
+	BlockType_File, # file
+	BlockType_Table, # table
+	BlockType_CodeBlock, # codeBlock
+	BlockType_MathBlock, # mathBlock
+	BlockType_Diagram, # diagram
+	BlockType_Calendar # calendar
+}
+`, BuiltIn: false},
+	{Name: "../schemas/enums/country_code_enum.graphql", Input: `enum CountryCode {
+  COUNTRY_CODE_886 # Taiwan
+  COUNTRY_CODE_81 # Japan
+  COUNTRY_CODE_60 # Malaysia
+  COUNTRY_CODE_65 # Singapore
+  COUNTRY_CODE_86 # China
+  COUNTRY_CODE_1 # NANP
+  COUNTRY_CODE_44 # UK
+  COUNTRY_CODE_61 # Australia
+}
+`, BuiltIn: false},
+	{Name: "../schemas/enums/country_enum.graphql", Input: `enum Country {
+  Taiwan
+  Japan
+  Malaysia
+  Singapore
+  China
+  UnitedStatesOfAmerica
+  UnitedKingdom
+  Australia
+  Canada
+}
+`, BuiltIn: false},
+	{Name: "../schemas/enums/item_type_enum.graphql", Input: `enum ItemType {
+  ItemType_BlockPack
+  ItemType_Material
+}
+`, BuiltIn: false},
+	{Name: "../schemas/enums/language_enum.graphql", Input: `enum Language {
+  English
+  TraditionalChinese
+  SimpleChinese
+  Japanese
+  Korean
+}
+`, BuiltIn: false},
+	{Name: "../schemas/enums/material_content_type_enum.graphql", Input: `enum MaterialContentType {
+  None
+  Application_JSON
+  Application_PDF
+  Text_Plain
+  Text_HTML
+  Text_Markdown
+  Image_PNG
+  Image_JPG
+  Image_JPEG
+  Image_GIF
+  Image_SVG
+  Image_WebP
+  Video_MP4
+  Video_WebM
+  Audio_Mpeg
+}
+`, BuiltIn: false},
+	{Name: "../schemas/enums/routine_period_enum.graphql", Input: `enum RoutinePeriod {
+  RoutinePeriod_Daily
+  RoutinePeriod_Weekly
+  RoutinePeriod_Monthly
+}
+`, BuiltIn: false},
+	{Name: "../schemas/enums/routine_status_enum.graphql", Input: `enum RoutineStatus {
+  RoutineStatus_Scheduled
+  RoutineStatus_InProgress
+  RoutineStatus_Completed
+  RoutineStatus_OverDue
+}
+`, BuiltIn: false},
+	{Name: "../schemas/enums/routine_task_purpose_enum.graphql", Input: `enum RoutineTaskPurpose {
+  RoutineTaskPurpose_CreateRootShelf
+  RoutineTaskPurpose_UpdateRootShelf
+  RoutineTaskPurpose_ResetRootShelf
+  RoutineTaskPurpose_CreateSubShelf
+  RoutineTaskPurpose_UpdateSubShelf
+  RoutineTaskPurpose_ResetSubShelf
+  RoutineTaskPurpose_CreateBlockPack
+  RoutineTaskPurpose_UpdateBlockPack
+  RoutineTaskPurpose_ResetBlockPack
+  RoutineTaskPurpose_AppendBlock
+  RoutineTaskPurpose_UpdateBlock
+  RoutineTaskPurpose_ResetBlock
+  RoutineTaskPurpose_CreateRoutine
+  RoutineTaskPurpose_UpdateRoutine
+}
+`, BuiltIn: false},
+	{Name: "../schemas/enums/routine_task_record_error_code_enum.graphql", Input: `enum RoutineTaskRecordErrorCode {
+  RoutineTaskRecordErrorCode_PermissionDenied
+  RoutineTaskRecordErrorCode_PayloadInvalid
+  RoutineTaskRecordErrorCode_TargetNotFound
+  RoutineTaskRecordErrorCode_PlanLimitExceeded
+  RoutineTaskRecordErrorCode_HandlerFailed
+  RoutineTaskRecordErrorCode_DatabaseError
+  RoutineTaskRecordErrorCode_Timeout
+  RoutineTaskRecordErrorCode_Canceled
+  RoutineTaskRecordErrorCode_Unknown
+}
+`, BuiltIn: false},
+	{Name: "../schemas/enums/routine_task_record_status_enum.graphql", Input: `enum RoutineTaskRecordStatus {
+  RoutineTaskRecordStatus_Running
+  RoutineTaskRecordStatus_Success
+  RoutineTaskRecordStatus_Failed
+  RoutineTaskRecordStatus_Cancel
+}
+`, BuiltIn: false},
+	{Name: "../schemas/enums/routine_task_status_enum.graphql", Input: `enum RoutineTaskStatus {
+  RoutineTaskStatus_Idle
+  RoutineTaskStatus_Waiting
+  RoutineTaskStatus_Running
+  RoutineTaskStatus_Pause
+}
+`, BuiltIn: false},
+	{Name: "../schemas/enums/supported_icon_enum.graphql", Input: `enum SupportedIcon {
+  SupportedIcon_GrinningFace
+  SupportedIcon_SmilingFaceWithSmilingEyes
+  SupportedIcon_RedHeart
+  SupportedIcon_Fire
+  SupportedIcon_Star
+  SupportedIcon_Books
+  SupportedIcon_Notebook
+  SupportedIcon_PencilPaper
+  SupportedIcon_Lightbulb
+  SupportedIcon_Rocket
+  SupportedIcon_CheckMark
+  SupportedIcon_Pin
+  SupportedIcon_FolderOpen
+  SupportedIcon_Calendar
+  SupportedIcon_Clock
+}
+`, BuiltIn: false},
+	{Name: "../schemas/enums/user_gender_enum.graphql", Input: `enum UserGender {
+  Male
+  Female
+  PreferNotToSay
+}
+`, BuiltIn: false},
+	{Name: "../schemas/enums/user_plan_enum.graphql", Input: `enum UserPlan {
+  Enterprise
+  Ultimate
+  Pro
+  Free
+}
+`, BuiltIn: false},
+	{Name: "../schemas/enums/user_role_enum.graphql", Input: `enum UserRole {
+  Admin
+  Normal
+  Guest
+}
+`, BuiltIn: false},
+	{Name: "../schemas/enums/user_status_enum.graphql", Input: `enum UserStatus {
+  Online
+  AFK
+  DoNotDisturb
+  Offline
+}
+`, BuiltIn: false},
+	{Name: "../schemas/badge.graphql", Input: `type PublicBadge {
+  # id: UUID!
+  publicId: UUID! # the encoded of this field is the identifier of the PublicBadge
+  title: String!
+  description: String!
+  type: BadgeType!
+  imageURL: String
+  createdAt: Time!
+}
+`, BuiltIn: false},
+	{Name: "../schemas/block.graphql", Input: `type PrivateBlock {
+    id: UUID!
+    blockPackId: UUID!
+    parentBlockId: UUID
+    prevBlockId: UUID
+    nextBlockId: UUID
+    type: BlockType!
+    props: DatatypeJSON!
+    content: DatatypeJSON!
+    updatedAt: Time!
+    createdAt: Time!
+
+    # relations
+    childrenIds: [UUID!]!
+}
+`, BuiltIn: false},
+	{Name: "../schemas/block_pack.graphql", Input: `type PrivateBlockPack {
+  id: UUID!
+  parentSubShelfId: UUID!
+  name: String!
+  icon: SupportedIcon
+  headerBackgroundURL: String
+  blockCount: Int64!
+  deletedAt: Time
+  updatedAt: Time!
+  createdAt: Time!
+
+  # relations
+  blockIds: [UUID!]!
+}
+`, BuiltIn: false},
+	{Name: "../schemas/fields.graphql", Input: `# every schemas or graphql types which want to be searchable
+# are required to inheritence the structure (of cursor-based pagination) here
+
+# =============== Search Filters (part of Input) =============== #
+# input ExampleSearchFilters {}
+
+# =============== Search Sort By (part of Input) =============== #
+# enum ExampleSearchSortBy {
+#     RELEVANCE
+# }
+
+# =============== Search Sort Order (part of Input) =============== #
+# Note that this field is fixed, and should not build another one for customization
+enum SearchSortOrder {
+  ASC
+  DESC
+}
+
+# ==================== Search Input ==================== #
+# input ExampleSearchInput {
+#     query: String!
+#     after: String
+#     first: Int = 10
+#     before: String
+#     last: Int
+#     filters: SearchFilters
+#     sortBy: SearchSortBy = RELEVANCE
+#     sortOrder: SearchSortOrder = DESC
+# }
+
+# =============== Current Edge (part of Output) =============== #
+interface SearchEdge {
+  encodedSearchCursor: String!
+  # node: the data of the result, this should be implement in each models require search functionality
+}
+
+# =============== Page Info (part of Output) =============== #
+type SearchPageInfo {
+  hasNextPage: Boolean!
+  hasPreviousPage: Boolean!
+  startEncodedSearchCursor: String
+  endEncodedSearchCursor: String
+}
+
+# =============== Search Output (Connection) =============== #
+interface SearchConnection {
+  searchPageInfo: SearchPageInfo!
+  totalCount: Int!
+  searchTime: Float!
+}
+`, BuiltIn: false},
+	{Name: "../schemas/item.graphql", Input: `type PrivateItem {
+    id: UUID!
+    parentSubShelfId: UUID!
+    rootShelfId: UUID!
+    type: ItemType!
+    deletedAt: Time
+    updatedAt: Time!
+    createdAt: Time!
+
+    # relations
+    routineIds: [UUID!]!
+}
+`, BuiltIn: false},
+	{Name: "../schemas/material.graphql", Input: `type PrivateMaterial {
+  id: UUID!
+  parentSubShelfId: UUID!
+  name: String!
+  size: Int64!
+  contentType: MaterialContentType!
+  parseMediaType: String!
+  deletedAt: Time
+  updatedAt: Time!
+  createdAt: Time!
+}
+`, BuiltIn: false},
+	{Name: "../schemas/mutate_block_packs.graphql", Input: `# =============== Mutation =============== #
+
+extend type Mutation {
+  createBlockPack(input: CreatableBlockPackInput!): CreateBlockPackPayload!
+  createBlockPacks(input: CreateBlockPacksInput!): CreateBlockPacksPayload!
+  updateBlockPack(input: UpdateBlockPackInput!): UpdateBlockPackPayload!
+  updateBlockPacks(input: UpdateBlockPacksInput!): UpdateBlockPacksPayload!
+  moveBlockPackByParentSubShelfId(input: MoveBlockPackByParentSubShelfIdInput!): MoveBlockPackByParentSubShelfIdPayload!
+  moveBlockPacksByParentSubShelfId(input: MovableBlockPackInput!): MoveBlockPacksByParentSubShelfIdPayload!
+  moveBlockPacksByParentSubShelfIds(input: MoveBlockPacksByParentSubShelfIdsInput!): MoveBlockPacksByParentSubShelfIdsPayload!
+  restoreBlockPack(input: RestoreBlockPackInput!): BlockPackPayload!
+  restoreBlockPacks(input: RestoreBlockPacksInput!): [BlockPackPayload!]!
+  deleteBlockPack(input: DeleteBlockPackInput!): DeleteBlockPackPayload!
+  deleteBlockPacks(input: DeleteBlockPacksInput!): DeleteBlockPacksPayload!
+}
+
+# =============== Mutation Inputs =============== #
+
+input CreatableBlockPackInput {
+  id: UUID
+  parentSubShelfId: UUID!
+  name: String!
+  icon: SupportedIcon
+  headerBackgroundURL: String
+}
+
+input CreateBlockPacksInput {
+  createdBlockPacks: [CreatableBlockPackInput!]!
+}
+
+input UpdateBlockPackInput {
+  blockPackId: UUID!
+  values: UpdateBlockPackValuesInput!
+  setNull: [String!]
+}
+
+input UpdateBlockPackValuesInput {
+  name: String
+  icon: SupportedIcon
+  headerBackgroundURL: String
+}
+
+input UpdateBlockPacksInput {
+  updatedBlockPacks: [UpdatableBlockPackInput!]!
+}
+
+input UpdatableBlockPackInput {
+  blockPackId: UUID!
+  values: UpdatableBlockPackValuesInput!
+  setNull: [String!]
+}
+
+input UpdatableBlockPackValuesInput {
+  name: String
+  icon: SupportedIcon
+  headerBackgroundURL: String
+}
+
+input MoveBlockPackByParentSubShelfIdInput {
+  blockPackId: UUID!
+  destinationParentSubShelfId: UUID!
+}
+
+input MovableBlockPackInput {
+  blockPackIds: [UUID!]!
+  destinationParentSubShelfId: UUID!
+}
+
+input MoveBlockPacksByParentSubShelfIdsInput {
+  movedBlockPacks: [MovableBlockPackInput!]!
+}
+
+input RestoreBlockPackInput {
+  blockPackId: UUID!
+}
+
+input RestoreBlockPacksInput {
+  blockPackIds: [UUID!]!
+}
+
+input DeleteBlockPackInput {
+  blockPackId: UUID!
+}
+
+input DeleteBlockPacksInput {
+  blockPackIds: [UUID!]!
+}
+
+# =============== Mutation Payloads =============== #
+
+type CreateBlockPackPayload {
+  id: UUID!
+  createdAt: Time!
+}
+
+type CreateBlockPacksPayload {
+  ids: [UUID!]!
+  createdAt: Time!
+}
+
+type UpdateBlockPackPayload {
+  updatedAt: Time!
+}
+
+type UpdateBlockPacksPayload {
+  updatedAt: Time!
+}
+
+type MoveBlockPackByParentSubShelfIdPayload {
+  updatedAt: Time!
+}
+
+type MoveBlockPacksByParentSubShelfIdPayload {
+  updatedAt: Time!
+}
+
+type MoveBlockPacksByParentSubShelfIdsPayload {
+  updatedAt: Time!
+}
+
+type BlockPackPayload {
+  id: UUID!
+  parentSubShelfId: UUID!
+  name: String!
+  icon: SupportedIcon
+  headerBackgroundURL: String
+  blockCount: Int64!
+  lastUpdateSequence: Int64!
+  compactedUntilSequence: Int64!
+  projectedUntilSequence: Int64!
+  isProjectionCurrent: Boolean!
+  deletedAt: Time
+  updatedAt: Time!
+  createdAt: Time!
+}
+
+type DeleteBlockPackPayload {
+  deletedAt: Time!
+}
+
+type DeleteBlockPacksPayload {
+  deletedAt: Time!
+}
+`, BuiltIn: false},
+	{Name: "../schemas/mutate_materials.graphql", Input: `# =============== Mutation =============== #
+
+extend type Mutation {
+  createMaterial(input: CreatableMaterialInput!): CreateMaterialPayload!
+  updateMaterial(input: UpdateMaterialInput!): UpdateMaterialPayload!
+  saveMaterial(input: SaveMaterialInput!): SaveMaterialPayload!
+  moveMaterial(input: MoveMaterialInput!): MoveMaterialPayload!
+  moveMaterials(input: MoveMaterialsInput!): MoveMaterialsPayload!
+  restoreMaterial(input: RestoreMaterialInput!): MaterialPayload!
+  restoreMaterials(input: RestoreMaterialsInput!): [MaterialPayload!]!
+  deleteMaterial(input: DeleteMaterialInput!): DeleteMaterialPayload!
+  deleteMaterials(input: DeleteMaterialsInput!): DeleteMaterialsPayload!
+}
+
+# =============== Mutation Inputs =============== #
+
+input CreatableMaterialInput {
+  parentSubShelfId: UUID!
+  name: String!
+}
+
+input UpdateMaterialInput {
+  materialId: UUID!
+  values: UpdatableMaterialInput!
+  setNull: [String!]
+}
+
+input UpdatableMaterialInput {
+  name: String
+}
+
+input SaveMaterialInput {
+  materialId: UUID!
+  contentFile: Base64Bytes!
+}
+
+input MoveMaterialInput {
+  materialId: UUID!
+  destinationParentSubShelfId: UUID!
+}
+
+input MoveMaterialsInput {
+  materialIds: [UUID!]!
+  destinationParentSubShelfId: UUID!
+}
+
+input RestoreMaterialInput {
+  materialId: UUID!
+}
+
+input RestoreMaterialsInput {
+  materialIds: [UUID!]!
+}
+
+input DeleteMaterialInput {
+  materialId: UUID!
+}
+
+input DeleteMaterialsInput {
+  materialIds: [UUID!]!
+}
+
+# =============== Mutation Payloads =============== #
+
+type CreateMaterialPayload {
+  id: UUID!
+  createdAt: Time!
+}
+
+type UpdateMaterialPayload {
+  updatedAt: Time!
+}
+
+type SaveMaterialPayload {
+  updatedAt: Time!
+}
+
+type MoveMaterialPayload {
+  updatedAt: Time!
+}
+
+type MoveMaterialsPayload {
+  updatedAt: Time!
+}
+
+type MaterialPayload {
+  id: UUID!
+  parentSubShelfId: UUID!
+  name: String!
+  size: Int64!
+  contentType: MaterialContentType!
+  parseMediaType: String!
+  downloadURL: String!
+  deletedAt: Time
+  updatedAt: Time!
+  createdAt: Time!
+}
+
+type DeleteMaterialPayload {
+  deletedAt: Time!
+}
+
+type DeleteMaterialsPayload {
+  deletedAt: Time!
+}
+`, BuiltIn: false},
+	{Name: "../schemas/mutate_root_shelves.graphql", Input: `# =============== Mutation =============== #
+
+extend type Mutation {
+  createRootShelf(input: CreatableRootShelfInput!): CreateRootShelfPayload!
+  createRootShelves(input: CreateRootShelvesInput!): CreateRootShelvesPayload!
+  updateRootShelf(input: UpdateRootShelfInput!): UpdateRootShelfPayload!
+  updateRootShelves(input: UpdateRootShelvesInput!): UpdateRootShelvesPayload!
+  restoreRootShelf(input: RestoreRootShelfInput!): RestoreRootShelfPayload!
+  restoreRootShelves(input: RestoreRootShelvesInput!): [RestoreRootShelfPayload!]!
+  deleteRootShelf(input: DeleteRootShelfInput!): DeleteRootShelfPayload!
+  deleteRootShelves(input: DeleteRootShelvesInput!): DeleteRootShelvesPayload!
+  createRootShelfPermission(input: CreateRootShelfPermissionInput!): RootShelfPermissionPayload!
+  upsertRootShelfPermission(input: UpsertRootShelfPermissionInput!): RootShelfPermissionPayload!
+  upsertRootShelfPermissions(input: UpsertRootShelfPermissionsInput!): UpsertRootShelfPermissionsPayload!
+  updateRootShelfPermission(input: UpdateRootShelfPermissionInput!): RootShelfPermissionPayload!
+  transferRootShelfOwnership(input: TransferRootShelfOwnershipInput!): TransferRootShelfOwnershipPayload!
+  deleteRootShelfPermission(input: DeleteRootShelfPermissionInput!): Boolean!
+  deleteRootShelfPermissions(input: DeleteRootShelfPermissionsInput!): Boolean!
+  leaveRootShelf(input: LeaveRootShelfInput!): Boolean!
+  leaveRootShelves(input: LeaveRootShelvesInput!): Boolean!
+}
+
+# =============== Mutation Inputs =============== #
+
+input CreatableRootShelfInput {
+  id: UUID
+  name: String!
+}
+
+input CreateRootShelvesInput {
+  insertedRootShelves: [CreatableRootShelfInput!]!
+}
+
+input UpdateRootShelfInput {
+  rootShelfId: UUID!
+  values: UpdateRootShelfValuesInput!
+  setNull: [String!]
+}
+
+input UpdateRootShelfValuesInput {
+  name: String
+}
+
+input UpdateRootShelvesInput {
+  updatedRootShelves: [UpdatableRootShelfInput!]!
+}
+
+input UpdatableRootShelfInput {
+  rootShelfId: UUID!
+  values: UpdatableRootShelfValuesInput!
+  setNull: [String!]
+}
+
+input UpdatableRootShelfValuesInput {
+  name: String
+}
+
+input RestoreRootShelfInput {
+  rootShelfId: UUID!
+}
+
+input RestoreRootShelvesInput {
+  rootShelfIds: [UUID!]!
+}
+
+input DeleteRootShelfInput {
+  rootShelfId: UUID!
+}
+
+input DeleteRootShelvesInput {
+  rootShelfIds: [UUID!]!
+}
+
+input CreateRootShelfPermissionInput {
+  rootShelfId: UUID!
+  userPublicId: UUID!
+  permission: AccessControlPermission!
+}
+
+input UpsertRootShelfPermissionInput {
+  rootShelfId: UUID!
+  userPublicId: UUID!
+  permission: AccessControlPermission!
+}
+
+input UpsertRootShelfPermissionsInput {
+  rootShelfId: UUID!
+  permissions: [UpsertableRootShelfPermissionInput!]!
+}
+
+input UpsertableRootShelfPermissionInput {
+  userPublicId: UUID!
+  permission: AccessControlPermission!
+}
+
+input UpdateRootShelfPermissionInput {
+  rootShelfId: UUID!
+  userPublicId: UUID!
+  permission: AccessControlPermission!
+}
+
+input TransferRootShelfOwnershipInput {
+  rootShelfId: UUID!
+  targetUserPublicId: UUID!
+}
+
+input DeleteRootShelfPermissionInput {
+  rootShelfId: UUID!
+  userPublicId: UUID!
+}
+
+input DeleteRootShelfPermissionsInput {
+  rootShelfId: UUID!
+  userPublicIds: [UUID!]!
+}
+
+input LeaveRootShelfInput {
+  rootShelfId: UUID!
+}
+
+input LeaveRootShelvesInput {
+  rootShelfIds: [UUID!]!
+}
+
+# =============== Mutation Payloads =============== #
+
+type CreateRootShelfPayload {
+  id: UUID!
+  lastAnalyzedAt: Time!
+  createdAt: Time!
+}
+
+type CreateRootShelvesPayload {
+  ids: [UUID!]!
+  lastAnalyzedAt: Time!
+  createdAt: Time!
+}
+
+type UpdateRootShelfPayload {
+  updatedAt: Time!
+}
+
+type UpdateRootShelvesPayload {
+  updatedAt: Time!
+}
+
+type RestoreRootShelfPayload {
+  id: UUID!
+  name: String!
+  subShelfCount: Int64!
+  itemCount: Int64!
+  lastAnalyzedAt: Time!
+  deletedAt: Time
+  updatedAt: Time!
+  createdAt: Time!
+}
+
+type DeleteRootShelfPayload {
+  deletedAt: Time!
+}
+
+type DeleteRootShelvesPayload {
+  deletedAt: Time!
+}
+
+type RootShelfPermissionPayload {
+  userPublicId: UUID!
+  permission: String!
+  updatedAt: Time!
+  createdAt: Time!
+}
+
+type UpsertRootShelfPermissionsPayload {
+  permissions: [RootShelfPermissionPayload!]!
+}
+
+type TransferRootShelfOwnershipPayload {
+  rootShelfId: UUID!
+  previousOwnerUserPublicId: UUID!
+  newOwnerUserPublicId: UUID!
+  updatedAt: Time!
+}
+`, BuiltIn: false},
+	{Name: "../schemas/mutate_routine_tasks.graphql", Input: `# =============== Mutation =============== #
+
+extend type Mutation {
+  createRoutineTaskByRoutineId(input: CreatableRoutineTaskInput!): CreateRoutineTaskByRoutineIdPayload!
+  updateRoutineTask(input: UpdatableRoutineTaskInput!): UpdateRoutineTaskPayload!
+  pauseRoutineTask(input: PauseRoutineTaskInput!): PauseRoutineTaskPayload!
+  resumeRoutineTask(input: ResumeRoutineTaskInput!): ResumeRoutineTaskPayload!
+  hardDeleteRoutineTask(input: HardDeleteRoutineTaskInput!): HardDeleteRoutineTaskPayload!
+  hardDeleteRoutineTasks(input: HardDeleteRoutineTasksInput!): HardDeleteRoutineTasksPayload!
+}
+
+# =============== Mutation Inputs =============== #
+
+input CreatableRoutineTaskInput {
+  routineId: UUID!
+  title: String!
+  purpose: RoutineTaskPurpose!
+  payload: DatatypeJSON
+  priority: Int!
+  maxAttempts: Int!
+  period: RoutinePeriod
+  recurrenceRule: String
+  nextScheduledAt: Time!
+}
+
+input UpdatableRoutineTaskInput {
+  routineTaskId: UUID!
+  values: UpdatableRoutineTaskValuesInput!
+  setNull: [String!]
+}
+
+input UpdatableRoutineTaskValuesInput {
+  routineId: UUID
+  title: String
+  purpose: RoutineTaskPurpose
+  payload: DatatypeJSON
+  priority: Int
+  maxAttempts: Int
+  period: RoutinePeriod
+  recurrenceRule: String
+  nextScheduledAt: Time
+}
+
+input PauseRoutineTaskInput {
+  routineTaskId: UUID!
+}
+
+input ResumeRoutineTaskInput {
+  routineTaskId: UUID!
+}
+
+input HardDeleteRoutineTaskInput {
+  routineTaskId: UUID!
+}
+
+input HardDeleteRoutineTasksInput {
+  routineTaskIds: [UUID!]!
+}
+
+# =============== Mutation Payloads =============== #
+
+type CreateRoutineTaskByRoutineIdPayload {
+  id: UUID!
+  createdAt: Time!
+}
+
+type UpdateRoutineTaskPayload {
+  updatedAt: Time!
+}
+
+type PauseRoutineTaskPayload {
+  updatedAt: Time!
+}
+
+type ResumeRoutineTaskPayload {
+  updatedAt: Time!
+}
+
+type HardDeleteRoutineTaskPayload {
+  deletedAt: Time!
+}
+
+type HardDeleteRoutineTasksPayload {
+  deletedAt: Time!
+}
+`, BuiltIn: false},
+	{Name: "../schemas/mutate_routines.graphql", Input: `# =============== Mutation =============== #
+
+extend type Mutation {
+  createRoutineByStationId(input: CreatableRoutineInput!): CreateRoutineByStationIdPayload!
+  createRoutinesByStationIds(input: CreateRoutinesByStationIdsInput!): CreateRoutinesByStationIdsPayload!
+  updateRoutine(input: UpdatableRoutineInput!): UpdateRoutinePayload!
+  updateRoutines(input: UpdateRoutinesInput!): UpdateRoutinesPayload!
+  linkRoutineTag(input: LinkRoutineTagInput!): LinkRoutineTagPayload!
+  linkRoutineTags(input: LinkRoutineTagsInput!): LinkRoutineTagsPayload!
+  linkRoutineItem(input: LinkRoutineItemInput!): LinkRoutineItemPayload!
+  linkRoutineItems(input: LinkRoutineItemsInput!): LinkRoutineItemsPayload!
+  restoreRoutine(input: RestoreRoutineInput!): RoutinePayload!
+  restoreRoutines(input: RestoreRoutinesInput!): [RoutinePayload!]!
+  deleteRoutine(input: DeleteRoutineInput!): DeleteRoutinePayload!
+  deleteRoutines(input: DeleteRoutinesInput!): DeleteRoutinesPayload!
+  hardDeleteRoutine(input: HardDeleteRoutineInput!): HardDeleteRoutinePayload!
+  hardDeleteRoutines(input: HardDeleteRoutinesInput!): HardDeleteRoutinesPayload!
+}
+
+# =============== Mutation Inputs =============== #
+
+input CreatableRoutineInput {
+  id: UUID
+  stationId: UUID!
+  title: String!
+  description: String!
+  status: RoutineStatus
+  isPinned: Boolean
+  scheduledStartAt: Time
+  scheduledEndAt: Time
+  period: RoutinePeriod
+  recurrenceRule: String
+  timezone: String
+}
+
+input CreateRoutinesByStationIdsInput {
+  createdRoutines: [CreatableRoutineInput!]!
+}
+
+input UpdatableRoutineInput {
+  routineId: UUID!
+  values: UpdatableRoutineValuesInput!
+  setNull: [String!]
+}
+
+input UpdatableRoutineValuesInput {
+  stationId: UUID
+  title: String
+  description: String
+  status: RoutineStatus
+  isPinned: Boolean
+  scheduledStartAt: Time
+  scheduledEndAt: Time
+  period: RoutinePeriod
+  recurrenceRule: String
+  timezone: String
+}
+
+input UpdateRoutinesInput {
+  updatedRoutines: [UpdatableRoutineInput!]!
+}
+
+input LinkRoutineTagInput {
+  routineId: UUID!
+  routineTagId: UUID!
+  isUnlink: Boolean!
+}
+
+input LinkRoutineTagsInput {
+  linkedRoutinesAndTags: [LinkableRoutineAndTagInput!]!
+  isUnlink: Boolean!
+}
+
+input LinkableRoutineAndTagInput {
+  routineId: UUID!
+  routineTagId: UUID!
+}
+
+input LinkRoutineItemInput {
+  routineId: UUID!
+  itemId: UUID!
+  itemType: ItemType!
+  isUnlink: Boolean!
+}
+
+input LinkRoutineItemsInput {
+  linkedRoutinesAndItems: [LinkableRoutineAndItemInput!]!
+  isUnlink: Boolean!
+}
+
+input LinkableRoutineAndItemInput {
+  routineId: UUID!
+  itemId: UUID!
+  itemType: ItemType!
+}
+
+input RestoreRoutineInput {
+  routineId: UUID!
+}
+
+input RestoreRoutinesInput {
+  routineIds: [UUID!]!
+}
+
+input DeleteRoutineInput {
+  routineId: UUID!
+}
+
+input DeleteRoutinesInput {
+  routineIds: [UUID!]!
+}
+
+input HardDeleteRoutineInput {
+  routineId: UUID!
+}
+
+input HardDeleteRoutinesInput {
+  routineIds: [UUID!]!
+}
+
+# =============== Mutation Payloads =============== #
+
+type CreateRoutineByStationIdPayload {
+  id: UUID!
+  createdAt: Time!
+}
+
+type CreateRoutinesByStationIdsPayload {
+  ids: [UUID!]!
+  createdAt: Time!
+}
+
+type UpdateRoutinePayload {
+  updatedAt: Time!
+}
 
-			return &response
-		}
+type UpdateRoutinesPayload {
+  updatedAt: Time!
+}
 
-	default:
-		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
-	}
+type LinkRoutineTagPayload {
+  updatedAt: Time!
 }
 
-type executionContext struct {
-	*graphql.OperationContext
-	*executableSchema
-	deferred        int32
-	pendingDeferred int32
-	deferredResults chan graphql.DeferredResult
+type LinkRoutineTagsPayload {
+  updatedAt: Time!
 }
 
-func (ec *executionContext) processDeferredGroup(dg graphql.DeferredGroup) {
-	atomic.AddInt32(&ec.pendingDeferred, 1)
-	go func() {
-		ctx := graphql.WithFreshResponseContext(dg.Context)
-		dg.FieldSet.Dispatch(ctx)
-		ds := graphql.DeferredResult{
-			Path:   dg.Path,
-			Label:  dg.Label,
-			Result: dg.FieldSet,
-			Errors: graphql.GetErrors(ctx),
-		}
-		// null fields should bubble up
-		if dg.FieldSet.Invalids > 0 {
-			ds.Result = graphql.Null
-		}
-		ec.deferredResults <- ds
-	}()
+type LinkRoutineItemPayload {
+  updatedAt: Time!
 }
 
-func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
-	if ec.DisableIntrospection {
-		return nil, errors.New("introspection disabled")
-	}
-	return introspection.WrapSchema(ec.Schema()), nil
+type LinkRoutineItemsPayload {
+  updatedAt: Time!
 }
 
-func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
-	if ec.DisableIntrospection {
-		return nil, errors.New("introspection disabled")
-	}
-	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
+type RoutinePayload {
+  id: UUID!
+  stationId: UUID!
+  title: String!
+  description: String!
+  status: RoutineStatus!
+  isPinned: Boolean!
+  scheduledStartAt: Time!
+  scheduledEndAt: Time!
+  period: RoutinePeriod
+  recurrenceRule: String
+  timezone: String!
+  deletedAt: Time
+  updatedAt: Time!
+  createdAt: Time!
+  tagIds: [UUID!]!
+  taskIds: [UUID!]!
+  itemIds: [UUID!]!
 }
 
-var sources = []*ast.Source{
-	{Name: "../schemas/enums/access_control_permission_enum.graphql", Input: `enum AccessControlPermission {
-  Owner
-  Admin
-  Write
-  Read
+type DeleteRoutinePayload {
+  deletedAt: Time!
 }
-`, BuiltIn: false},
-	{Name: "../schemas/enums/badge_type_enum.graphql", Input: `enum BadgeType {
-  Diamond
-  Golden
-  Silver
-  Bronze
-  Steel
+
+type DeleteRoutinesPayload {
+  deletedAt: Time!
 }
-`, BuiltIn: false},
-	{Name: "../schemas/enums/block_type_enum.graphql", Input: `enum BlockType {
-    BlockType_Paragraph # paragraph
-	BlockType_Heading, # heading
-	BlockType_Quote, # quote
-	BlockType_BulletListItem, # bulletListItem
-	BlockType_NumberedListItem, # numberedListItem
-	BlockType_CheckListItem, # checkListItem
-	BlockType_ToggleListItem, # toggleListItem
-	BlockType_Image, # image
-	BlockType_Video, # video
-	BlockType_Audio, # audio
-	BlockType_File, # file
-	BlockType_Table, # table
-	BlockType_CodeBlock, # codeBlock
-	BlockType_MathBlock, # mathBlock
-	BlockType_Diagram, # diagram
-	BlockType_Calendar # calendar
+
+type HardDeleteRoutinePayload {
+  deletedAt: Time!
 }
-`, BuiltIn: false},
-	{Name: "../schemas/enums/country_code_enum.graphql", Input: `enum CountryCode {
-  COUNTRY_CODE_886 # Taiwan
-  COUNTRY_CODE_81 # Japan
-  COUNTRY_CODE_60 # Malaysia
-  COUNTRY_CODE_65 # Singapore
-  COUNTRY_CODE_86 # China
-  COUNTRY_CODE_1 # NANP
-  COUNTRY_CODE_44 # UK
-  COUNTRY_CODE_61 # Australia
+
+type HardDeleteRoutinesPayload {
+  deletedAt: Time!
 }
 `, BuiltIn: false},
-	{Name: "../schemas/enums/country_enum.graphql", Input: `enum Country {
-  Taiwan
-  Japan
-  Malaysia
-  Singapore
-  China
-  UnitedStatesOfAmerica
-  UnitedKingdom
-  Australia
-  Canada
+	{Name: "../schemas/mutate_stations.graphql", Input: `# =============== Mutation =============== #
+
+extend type Mutation {
+  createStation(input: CreatableStationInput!): CreateStationPayload!
+  createStations(input: CreateStationsInput!): CreateStationsPayload!
+  updateStation(input: UpdateStationInput!): UpdateStationPayload!
+  updateStations(input: UpdateStationsInput!): UpdateStationsPayload!
+  restoreStation(input: RestoreStationInput!): RestoreStationPayload!
+  restoreStations(input: RestoreStationsInput!): [RestoreStationPayload!]!
+  deleteStation(input: DeleteStationInput!): DeleteStationPayload!
+  deleteStations(input: DeleteStationsInput!): DeleteStationsPayload!
+  hardDeleteStation(input: HardDeleteStationInput!): HardDeleteStationPayload!
+  hardDeleteStations(input: HardDeleteStationsInput!): HardDeleteStationsPayload!
+  createStationPermission(input: CreateStationPermissionInput!): StationPermissionPayload!
+  upsertStationPermission(input: UpsertStationPermissionInput!): StationPermissionPayload!
+  upsertStationPermissions(input: UpsertStationPermissionsInput!): UpsertStationPermissionsPayload!
+  updateStationPermission(input: UpdateStationPermissionInput!): StationPermissionPayload!
+  transferStationOwnership(input: TransferStationOwnershipInput!): TransferStationOwnershipPayload!
+  deleteStationPermission(input: DeleteStationPermissionInput!): Boolean!
+  deleteStationPermissions(input: DeleteStationPermissionsInput!): Boolean!
+  leaveStation(input: LeaveStationInput!): Boolean!
+  leaveStations(input: LeaveStationsInput!): Boolean!
 }
-`, BuiltIn: false},
-	{Name: "../schemas/enums/item_type_enum.graphql", Input: `enum ItemType {
-  ItemType_BlockPack
-  ItemType_Material
+
+# =============== Mutation Inputs =============== #
+
+input CreatableStationInput {
+  id: UUID
+  name: String!
+  description: String!
+  icon: String
+  headerBackgroundURL: String
 }
-`, BuiltIn: false},
-	{Name: "../schemas/enums/language_enum.graphql", Input: `enum Language {
-  English
-  TraditionalChinese
-  SimpleChinese
-  Japanese
-  Korean
+
+input CreateStationsInput {
+  createdStations: [CreatableStationInput!]!
 }
-`, BuiltIn: false},
-	{Name: "../schemas/enums/material_content_type_enum.graphql", Input: `enum MaterialContentType {
-  None
-  Application_JSON
-  Application_PDF
-  Text_Plain
-  Text_HTML
-  Text_Markdown
-  Image_PNG
-  Image_JPG
-  Image_JPEG
-  Image_GIF
-  Image_SVG
-  Image_WebP
-  Video_MP4
-  Video_WebM
-  Audio_Mpeg
+
+input UpdateStationInput {
+  stationId: UUID!
+  values: UpdateStationValuesInput!
+  setNull: [String!]
+}
+
+input UpdateStationValuesInput {
+  name: String
+  description: String
+  icon: String
+  headerBackgroundURL: String
+}
+
+input UpdateStationsInput {
+  updatedStations: [UpdatableStationInput!]!
+}
+
+input UpdatableStationInput {
+  stationId: UUID!
+  values: UpdatableStationValuesInput!
+  setNull: [String!]
+}
+
+input UpdatableStationValuesInput {
+  name: String
+  description: String
+  icon: String
+  headerBackgroundURL: String
+}
+
+input RestoreStationInput {
+  stationId: UUID!
+}
+
+input RestoreStationsInput {
+  stationIds: [UUID!]!
+}
+
+input DeleteStationInput {
+  stationId: UUID!
+}
+
+input DeleteStationsInput {
+  stationIds: [UUID!]!
+}
+
+input HardDeleteStationInput {
+  stationId: UUID!
+}
+
+input HardDeleteStationsInput {
+  stationIds: [UUID!]!
+}
+
+input CreateStationPermissionInput {
+  stationId: UUID!
+  userPublicId: UUID!
+  permission: AccessControlPermission!
+}
+
+input UpsertStationPermissionInput {
+  stationId: UUID!
+  userPublicId: UUID!
+  permission: AccessControlPermission!
+}
+
+input UpsertStationPermissionsInput {
+  stationId: UUID!
+  permissions: [UpsertableStationPermissionInput!]!
+}
+
+input UpsertableStationPermissionInput {
+  userPublicId: UUID!
+  permission: AccessControlPermission!
+}
+
+input UpdateStationPermissionInput {
+  stationId: UUID!
+  userPublicId: UUID!
+  permission: AccessControlPermission!
+}
+
+input TransferStationOwnershipInput {
+  stationId: UUID!
+  targetUserPublicId: UUID!
+}
+
+input DeleteStationPermissionInput {
+  stationId: UUID!
+  userPublicId: UUID!
+}
+
+input DeleteStationPermissionsInput {
+  stationId: UUID!
+  userPublicIds: [UUID!]!
+}
+
+input LeaveStationInput {
+  stationId: UUID!
+}
+
+input LeaveStationsInput {
+  stationIds: [UUID!]!
+}
+
+# =============== Mutation Payloads =============== #
+
+type CreateStationPayload {
+  id: UUID!
+  createdAt: Time!
+}
+
+type CreateStationsPayload {
+  ids: [UUID!]!
+  createdAt: Time!
+}
+
+type UpdateStationPayload {
+  updatedAt: Time!
+}
+
+type UpdateStationsPayload {
+  updatedAt: Time!
+}
+
+type RestoreStationPayload {
+  id: UUID!
+  name: String!
+  description: String!
+  icon: String
+  headerBackgroundURL: String
+  routineCount: Int64!
+  deletedAt: Time
+  updatedAt: Time!
+  createdAt: Time!
+}
+
+type DeleteStationPayload {
+  deletedAt: Time!
+}
+
+type DeleteStationsPayload {
+  deletedAt: Time!
+}
+
+type HardDeleteStationPayload {
+  deletedAt: Time!
+}
+
+type HardDeleteStationsPayload {
+  deletedAt: Time!
+}
+
+type StationPermissionPayload {
+  userPublicId: UUID!
+  permission: String!
+  updatedAt: Time!
+  createdAt: Time!
+}
+
+type UpsertStationPermissionsPayload {
+  permissions: [StationPermissionPayload!]!
+}
+
+type TransferStationOwnershipPayload {
+  stationId: UUID!
+  previousOwnerUserPublicId: UUID!
+  newOwnerUserPublicId: UUID!
+  updatedAt: Time!
 }
 `, BuiltIn: false},
-	{Name: "../schemas/enums/routine_period_enum.graphql", Input: `enum RoutinePeriod {
-  RoutinePeriod_Daily
-  RoutinePeriod_Weekly
-  RoutinePeriod_Monthly
+	{Name: "../schemas/mutate_sub_shelves.graphql", Input: `# =============== Mutation =============== #
+
+extend type Mutation {
+  createSubShelfByRootShelfId(input: CreatableSubShelfInput!): CreateSubShelfByRootShelfIdPayload!
+  createSubShelvesByRootShelfIds(input: CreateSubShelvesByRootShelfIdsInput!): CreateSubShelvesByRootShelfIdsPayload!
+  updateSubShelf(input: UpdateSubShelfInput!): UpdateSubShelfPayload!
+  updateSubShelves(input: UpdateSubShelvesInput!): UpdateSubShelvesPayload!
+  moveSubShelfByRootShelfId(input: MoveSubShelfByRootShelfIdInput!): MoveSubShelfByRootShelfIdPayload!
+  moveSubShelvesByRootShelfId(input: MovableSubShelfInput!): MoveSubShelvesByRootShelfIdPayload!
+  moveSubShelvesByRootShelfIds(input: MoveSubShelvesByRootShelfIdsInput!): MoveSubShelvesByRootShelfIdsPayload!
+  restoreSubShelf(input: RestoreSubShelfInput!): SubShelfPayload!
+  restoreSubShelves(input: RestoreSubShelvesInput!): [SubShelfPayload!]!
+  deleteSubShelf(input: DeleteSubShelfInput!): DeleteSubShelfPayload!
+  deleteSubShelves(input: DeleteSubShelvesInput!): DeleteSubShelvesPayload!
 }
-`, BuiltIn: false},
-	{Name: "../schemas/enums/routine_status_enum.graphql", Input: `enum RoutineStatus {
-  RoutineStatus_Scheduled
-  RoutineStatus_InProgress
-  RoutineStatus_Completed
-  RoutineStatus_OverDue
+
+# =============== Mutation Inputs =============== #
+
+input CreatableSubShelfInput {
+  id: UUID
+  rootShelfId: UUID!
+  prevSubShelfId: UUID
+  name: String!
 }
-`, BuiltIn: false},
-	{Name: "../schemas/enums/routine_task_purpose_enum.graphql", Input: `enum RoutineTaskPurpose {
-  RoutineTaskPurpose_CreateRootShelf
-  RoutineTaskPurpose_UpdateRootShelf
-  RoutineTaskPurpose_ResetRootShelf
-  RoutineTaskPurpose_CreateSubShelf
-  RoutineTaskPurpose_UpdateSubShelf
-  RoutineTaskPurpose_ResetSubShelf
-  RoutineTaskPurpose_CreateBlockPack
-  RoutineTaskPurpose_UpdateBlockPack
-  RoutineTaskPurpose_ResetBlockPack
-  RoutineTaskPurpose_AppendBlock
-  RoutineTaskPurpose_UpdateBlock
-  RoutineTaskPurpose_ResetBlock
-  RoutineTaskPurpose_CreateRoutine
-  RoutineTaskPurpose_UpdateRoutine
+
+input CreateSubShelvesByRootShelfIdsInput {
+  createdSubShelves: [CreatableSubShelfInput!]!
 }
-`, BuiltIn: false},
-	{Name: "../schemas/enums/routine_task_record_error_code_enum.graphql", Input: `enum RoutineTaskRecordErrorCode {
-  RoutineTaskRecordErrorCode_PermissionDenied
-  RoutineTaskRecordErrorCode_PayloadInvalid
-  RoutineTaskRecordErrorCode_TargetNotFound
-  RoutineTaskRecordErrorCode_PlanLimitExceeded
-  RoutineTaskRecordErrorCode_HandlerFailed
-  RoutineTaskRecordErrorCode_DatabaseError
-  RoutineTaskRecordErrorCode_Timeout
-  RoutineTaskRecordErrorCode_Canceled
-  RoutineTaskRecordErrorCode_Unknown
+
+input UpdateSubShelfInput {
+  subShelfId: UUID!
+  values: UpdateSubShelfValuesInput!
+  setNull: [String!]
 }
-`, BuiltIn: false},
-	{Name: "../schemas/enums/routine_task_record_status_enum.graphql", Input: `enum RoutineTaskRecordStatus {
-  RoutineTaskRecordStatus_Running
-  RoutineTaskRecordStatus_Success
-  RoutineTaskRecordStatus_Failed
-  RoutineTaskRecordStatus_Cancel
+
+input UpdateSubShelfValuesInput {
+  name: String
 }
-`, BuiltIn: false},
-	{Name: "../schemas/enums/routine_task_status_enum.graphql", Input: `enum RoutineTaskStatus {
-  RoutineTaskStatus_Idle
-  RoutineTaskStatus_Waiting
-  RoutineTaskStatus_Running
-  RoutineTaskStatus_Pause
+
+input UpdateSubShelvesInput {
+  updatedSubShelves: [UpdatableSubShelfInput!]!
 }
-`, BuiltIn: false},
-	{Name: "../schemas/enums/supported_icon_enum.graphql", Input: `enum SupportedIcon {
-  SupportedIcon_GrinningFace
-  SupportedIcon_SmilingFaceWithSmilingEyes
-  SupportedIcon_RedHeart
-  SupportedIcon_Fire
-  SupportedIcon_Star
-  SupportedIcon_Books
-  SupportedIcon_Notebook
-  SupportedIcon_PencilPaper
-  SupportedIcon_Lightbulb
-  SupportedIcon_Rocket
-  SupportedIcon_CheckMark
-  SupportedIcon_Pin
-  SupportedIcon_FolderOpen
-  SupportedIcon_Calendar
-  SupportedIcon_Clock
+
+input UpdatableSubShelfInput {
+  subShelfId: UUID!
+  values: UpdatableSubShelfValuesInput!
+  setNull: [String!]
 }
-`, BuiltIn: false},
-	{Name: "../schemas/enums/user_gender_enum.graphql", Input: `enum UserGender {
-  Male
-  Female
-  PreferNotToSay
+
+input UpdatableSubShelfValuesInput {
+  name: String
 }
-`, BuiltIn: false},
-	{Name: "../schemas/enums/user_plan_enum.graphql", Input: `enum UserPlan {
-  Enterprise
-  Ultimate
-  Pro
-  Free
+
+input MoveSubShelfByRootShelfIdInput {
+  sourceRootShelfId: UUID!
+  sourceSubShelfId: UUID!
+  destinationRootShelfId: UUID!
+  destinationSubShelfId: UUID
 }
-`, BuiltIn: false},
-	{Name: "../schemas/enums/user_role_enum.graphql", Input: `enum UserRole {
-  Admin
-  Normal
-  Guest
+
+input MovableSubShelfInput {
+  sourceRootShelfId: UUID!
+  sourceSubShelfIds: [UUID!]!
+  destinationRootShelfId: UUID!
+  destinationSubShelfId: UUID
 }
-`, BuiltIn: false},
-	{Name: "../schemas/enums/user_status_enum.graphql", Input: `enum UserStatus {
-  Online
-  AFK
-  DoNotDisturb
-  Offline
+
+input MoveSubShelvesByRootShelfIdsInput {
+  movedSubShelves: [MovableSubShelfInput!]!
 }
-`, BuiltIn: false},
-	{Name: "../schemas/badge.graphql", Input: `type PublicBadge {
-  # id: UUID!
-  publicId: UUID! # the encoded of this field is the identifier of the PublicBadge
-  title: String!
-  description: String!
-  type: BadgeType!
-  imageURL: String
+
+input RestoreSubShelfInput {
+  subShelfId: UUID!
+}
+
+input RestoreSubShelvesInput {
+  subShelfIds: [UUID!]!
+}
+
+input DeleteSubShelfInput {
+  subShelfId: UUID!
+}
+
+input DeleteSubShelvesInput {
+  subShelfIds: [UUID!]!
+}
+
+# =============== Mutation Payloads =============== #
+
+type CreateSubShelfByRootShelfIdPayload {
+  id: UUID!
   createdAt: Time!
 }
-`, BuiltIn: false},
-	{Name: "../schemas/block.graphql", Input: `type PrivateBlock {
-    id: UUID!
-    blockPackId: UUID!
-    parentBlockId: UUID
-    prevBlockId: UUID
-    nextBlockId: UUID
-    type: BlockType!
-    props: DatatypeJSON!
-    content: DatatypeJSON!
-    updatedAt: Time!
-    createdAt: Time!
 
-    # relations
-    childrenIds: [UUID!]!
+type CreateSubShelvesByRootShelfIdsPayload {
+  ids: [UUID!]!
+  createdAt: Time!
 }
-`, BuiltIn: false},
-	{Name: "../schemas/block_pack.graphql", Input: `type PrivateBlockPack {
+
+type UpdateSubShelfPayload {
+  updatedAt: Time!
+}
+
+type UpdateSubShelvesPayload {
+  updatedAt: Time!
+}
+
+type MoveSubShelfByRootShelfIdPayload {
+  updatedAt: Time!
+}
+
+type MoveSubShelvesByRootShelfIdPayload {
+  updatedAt: Time!
+}
+
+type MoveSubShelvesByRootShelfIdsPayload {
+  updatedAt: Time!
+}
+
+type SubShelfPayload {
   id: UUID!
-  parentSubShelfId: UUID!
   name: String!
-  icon: SupportedIcon
-  headerBackgroundURL: String
-  blockCount: Int64!
+  rootShelfId: UUID!
+  prevSubShelfId: UUID
+  path: [UUID!]!
   deletedAt: Time
   updatedAt: Time!
   createdAt: Time!
+}
 
-  # relations
-  blockIds: [UUID!]!
+type DeleteSubShelfPayload {
+  deletedAt: Time!
 }
-`, BuiltIn: false},
-	{Name: "../schemas/fields.graphql", Input: `# every schemas or graphql types which want to be searchable
-# are required to inheritence the structure (of cursor-based pagination) here
 
-# =============== Search Filters (part of Input) =============== #
-# input ExampleSearchFilters {}
+type DeleteSubShelvesPayload {
+  deletedAt: Time!
+}
+`, BuiltIn: false},
+	{Name: "../schemas/my_trash.graphql", Input: `# the trash is assembled from the soft deleted rows of internal/core/data/database/schemas/root_shelf_schema.go,
+# sub_shelf_schema.go, material_schema.go, block_pack_schema.go, and the retention of plan_limitation_schema.go
 
-# =============== Search Sort By (part of Input) =============== #
-# enum ExampleSearchSortBy {
-#     RELEVANCE
-# }
+# =============== Trash Input =============== #
 
-# =============== Search Sort Order (part of Input) =============== #
-# Note that this field is fixed, and should not build another one for customization
-enum SearchSortOrder {
-  ASC
-  DESC
+input GetMyTrashInput {
+  types: [TrashItemType!] # every type when it is null or empty
+  first: Int = 50 # at most 200
+  offset: Int = 0
 }
 
-# ==================== Search Input ==================== #
-# input ExampleSearchInput {
-#     query: String!
-#     after: String
-#     first: Int = 10
-#     filters: SearchFilters
-#     sortBy: SearchSortBy = RELEVANCE
-#     sortOrder: SearchSortOrder = DESC
-# }
+# =============== Trash Item =============== #
 
-# =============== Current Edge (part of Output) =============== #
-interface SearchEdge {
-  encodedSearchCursor: String!
-  # node: the data of the result, this should be implement in each models require search functionality
+enum TrashItemType {
+  ROOT_SHELF
+  SUB_SHELF
+  MATERIAL
+  BLOCK_PACK
 }
 
-# =============== Page Info (part of Output) =============== #
-type SearchPageInfo {
-  hasNextPage: Boolean!
-  hasPreviousPage: Boolean!
-  startEncodedSearchCursor: String
-  endEncodedSearchCursor: String
+type TrashItem {
+  id: UUID!
+  type: TrashItemType!
+  name: String!
+  rootShelfId: UUID!
+  parentSubShelfId: UUID # null for the root shelves, and the previous sub shelf of the sub shelves
+  size: Int64! # the content size of the materials, 0 for the other types
+  deletedAt: Time!
+  purgeAt: Time # null when the plan keeps the trash forever
 }
 
-# =============== Search Output (Connection) =============== #
-interface SearchConnection {
-  searchPageInfo: SearchPageInfo!
+type MyTrash {
+  items: [TrashItem!]! # ordered by deletedAt descending, the items deleted with their shelf are represented by the shelf
   totalCount: Int!
-  searchTime: Float!
+  retentionDays: Int! # 0 when the plan keeps the trash forever
 }
 `, BuiltIn: false},
-	{Name: "../schemas/item.graphql", Input: `type PrivateItem {
-    id: UUID!
-    parentSubShelfId: UUID!
-    rootShelfId: UUID!
-    type: ItemType!
-    deletedAt: Time
-    updatedAt: Time!
-    createdAt: Time!
+	{Name: "../schemas/my_usage.graphql", Input: `# the usage is assembled from internal/core/data/database/schemas/user_account_schema.go,
+# user_quota_schema.go, plan_limitation_schema.go, and user_usage_snapshot_schema.go
 
-    # relations
-    routineIds: [UUID!]!
+# =============== Usage Input =============== #
+
+input GetMyUsageInput {
+  days: Int = 30 # the number of the recent days of the snapshots, at most 365
 }
-`, BuiltIn: false},
-	{Name: "../schemas/material.graphql", Input: `type PrivateMaterial {
-  id: UUID!
-  parentSubShelfId: UUID!
-  name: String!
-  size: Int64!
-  contentType: MaterialContentType!
-  parseMediaType: String!
-  deletedAt: Time
-  updatedAt: Time!
-  createdAt: Time!
+
+# =============== Usage Limit & Snapshot =============== #
+
+enum UsageLimitKey {
+  ROOT_SHELF
+  BLOCK_PACK
+  BLOCK
+  MATERIAL
+  WORKFLOW
+  ADDITIONAL_ITEM
+  STATION
+  ROUTINE_TAG
+  ROUTINE_TASK_COST_UNIT
+}
+
+type UsageLimit {
+  key: UsageLimitKey!
+  limit: Int64!
+  used: Int64!
+  percentage: Float! # the used of the limit in percent, it is 100 when the limit is 0
+  resetAt: Time # null for the limits which never reset
+}
+
+type UsageSnapshot {
+  date: Time!
+  rootShelfCount: Int64!
+  blockPackCount: Int64!
+  blockCount: Int64!
+  materialCount: Int64!
+  workflowCount: Int64!
+  additionalItemCount: Int64!
+  stationCount: Int64!
+  routineCount: Int64!
+  routineTagCount: Int64!
+  routineTaskCostUnitUsed: Int64!
+}
+
+type MyUsage {
+  plan: UserPlan!
+  limits: [UsageLimit!]!
+  snapshots: [UsageSnapshot!]! # ordered by date ascending
+  generatedAt: Time!
 }
 `, BuiltIn: false},
 	{Name: "../schemas/query.graphql", Input: `type Query {
@@ -2929,11 +7303,13 @@ interface SearchConnection {
   searchRoutineTags(input: SearchRoutineTagInput!): SearchRoutineTagConnection!
   searchRoutineTasks(input: SearchRoutineTaskInput!): SearchRoutineTaskConnection!
   searchRoutineTaskRecords(input: SearchRoutineTaskRecordInput!): SearchRoutineTaskRecordConnection!
+  getMyUsage(input: GetMyUsageInput): MyUsage!
+  getMyTrash(input: GetMyTrashInput): MyTrash!
 }
 
-# type Mutation {}
+type Mutation
 
-# type Subscription {}
+type Subscription
 `, BuiltIn: false},
 	{Name: "../schemas/root_shelf.graphql", Input: `type PrivateRootShelf {
   id: UUID!
@@ -2962,6 +7338,7 @@ interface SearchConnection {
   scheduledStartAt: Time!
   scheduledEndAt: Time!
   period: RoutinePeriod
+  recurrenceRule: String
   timezone: String!
   deletedAt: Time
   updatedAt: Time!
@@ -2982,6 +7359,7 @@ type PrivateSearchableRoutine {
   scheduledStartAt: Time!
   scheduledEndAt: Time!
   period: RoutinePeriod
+  recurrenceRule: String
   timezone: String!
   deletedAt: Time
   updatedAt: Time!
@@ -3013,6 +7391,7 @@ type PrivateSearchableRoutine {
   attempts: Int32!
   maxAttempts: Int32!
   period: RoutinePeriod
+  recurrenceRule: String
   nextScheduledAt: Time!
   scheduledAt: Time!
   actualStartedAt: Time
@@ -3061,6 +7440,8 @@ input SearchBadgeInput {
   query: String!
   after: String
   first: Int = 10
+  before: String
+  last: Int
   filters: SearchBadgeFilters
   sortBy: SearchBadgeSortBy = RELEVANCE
   sortOrder: SearchSortOrder = DESC
@@ -3102,6 +7483,8 @@ input SearchBlockInput {
     query: String!
     after: String
     first: Int = 10
+    before: String
+    last: Int
     sortBy: SearchBlockSortBy = RELEVANCE
     sortOrder: SearchSortOrder = DESC
 }
@@ -3117,6 +7500,10 @@ input SearchBlockCursorFields {
 type SearchBlockEdge implements SearchEdge {
     encodedSearchCursor: String!
     node: PrivateBlock!
+    # the full-text search relevance of the node, which is null if the query is empty
+    rank: Float
+    # the html-escaped snippet with the matched words wrapped in <mark> tags, which is null if the query is empty
+    highlight: String
 }
 
 type SearchBlockConnection implements SearchConnection {
@@ -3142,6 +7529,8 @@ input SearchBlockPackInput {
   isDeletedAt: Boolean
   after: String
   first: Int = 10
+  before: String
+  last: Int
   sortBy: SearchBlockPackSortBy = RELEVANCE
   sortOrder: SearchSortOrder = DESC
 }
@@ -3157,6 +7546,10 @@ input SearchBlockPackCursorFields {
 type SearchBlockPackEdge implements SearchEdge {
   encodedSearchCursor: String!
   node: PrivateBlockPack!
+  # the full-text search relevance of the node, which is null if the query is empty
+  rank: Float
+  # the html-escaped snippet with the matched words wrapped in <mark> tags, which is null if the query is empty
+  highlight: String
 }
 
 type SearchBlockPackConnection implements SearchConnection {
@@ -3182,6 +7575,8 @@ input SearchItemInput {
     isDeletedAt: Boolean
     after: String
     first: Int = 10
+    before: String
+    last: Int
     sortBy: SearchItemSortBy = RELEVANCE
     sortOrder: SearchSortOrder = DESC
 }
@@ -3224,6 +7619,8 @@ input SearchMaterialInput {
   isDeletedAt: Boolean
   after: String
   first: Int = 10
+  before: String
+  last: Int
   sortBy: SearchMaterialSortBy = RELEVANCE
   sortOrder: SearchSortOrder = DESC
 }
@@ -3239,6 +7636,10 @@ input SearchMaterialCursorFields {
 type SearchMaterialEdge implements SearchEdge {
   encodedSearchCursor: String!
   node: PrivateMaterial!
+  # the full-text search relevance of the node, which is null if the query is empty
+  rank: Float
+  # the html-escaped snippet with the matched words wrapped in <mark> tags, which is null if the query is empty
+  highlight: String
 }
 
 type SearchMaterialConnection implements SearchConnection {
@@ -3262,6 +7663,8 @@ input SearchRootShelfInput {
   isDeletedAt: Boolean
   after: String
   first: Int = 10
+  before: String
+  last: Int
   sortBy: SearchRootShelfSortBy = RELEVANCE
   sortOrder: SearchSortOrder = DESC
 }
@@ -3302,6 +7705,8 @@ input SearchRoutineTagInput {
   query: String!
   after: String
   first: Int = 10
+  before: String
+  last: Int
   sortBy: SearchRoutineTagSortBy = RELEVANCE
   sortOrder: SearchSortOrder = DESC
 }
@@ -3346,6 +7751,8 @@ input SearchRoutineTaskRecordInput {
   query: String!
   after: String
   first: Int = 10
+  before: String
+  last: Int
   sortBy: SearchRoutineTaskRecordSortBy = RELEVANCE
   sortOrder: SearchSortOrder = DESC
 }
@@ -3392,6 +7799,8 @@ input SearchRoutineTaskInput {
   query: String!
   after: String
   first: Int = 10
+  before: String
+  last: Int
   sortBy: SearchRoutineTaskSortBy = RELEVANCE
   sortOrder: SearchSortOrder = DESC
 }
@@ -3436,6 +7845,8 @@ input SearchRoutineInput {
   isDeletedAt: Boolean
   after: String
   first: Int = 10
+  before: String
+  last: Int
   sortBy: SearchRoutineSortBy = RELEVANCE
   sortOrder: SearchSortOrder = DESC
 }
@@ -3475,6 +7886,8 @@ input SearchStationInput {
   isDeletedAt: Boolean
   after: String
   first: Int = 10
+  before: String
+  last: Int
   sortBy: SearchStationSortBy = RELEVANCE
   sortOrder: SearchSortOrder = DESC
 }
@@ -3516,6 +7929,8 @@ input SearchSubShelfInput {
   isDeletedAt: Boolean
   after: String
   first: Int = 10
+  before: String
+  last: Int
   sortBy: SearchSubShelfSortBy = RELEVANCE
   sortOrder: SearchSortOrder = DESC
 }
@@ -3561,6 +7976,8 @@ input SearchThemeInput {
   query: String!
   after: String
   first: Int = 10
+  before: String
+  last: Int
   filters: SearchThemeFilters
   sortBy: SearchThemeSortBy = RELEVANCE
   sortOrder: SearchSortOrder = DESC
@@ -3614,6 +8031,8 @@ input SearchUserInput {
   badgePublicIds: [UUID!] # match users who have any public Badge
   after: String # base64 encoded cursor
   first: Int = 10 # the number of data we want to extract
+  before: String # base64 encoded cursor, the page is fetched backward from it if last is given
+  last: Int # the number of data we want to extract backward
   filters: SearchUserFilters
   sortBy: SearchUserSortBy = RELEVANCE
   sortOrder: SearchSortOrder = DESC
@@ -3685,6 +8104,54 @@ type PrivateSearchableStation {
   nextSubShelfIds: [UUID!]!
   itemIds: [UUID!]!
 }
+`, BuiltIn: false},
+	{Name: "../schemas/subscribe_events.graphql", Input: `# =============== Subscription =============== #
+
+extend type Subscription {
+  resourceEvents(input: SubscribeResourceEventsInput): ResourceEvent!
+  routineTaskLifecycleEvents(input: SubscribeRoutineTaskLifecycleEventsInput): RoutineTaskLifecycleEvent!
+}
+
+# =============== Subscription Inputs =============== #
+
+input SubscribeResourceEventsInput {
+  rootShelfIds: [UUID!]
+  blockPackIds: [UUID!]
+}
+
+input SubscribeRoutineTaskLifecycleEventsInput {
+  routineIds: [UUID!]
+  routineTaskIds: [UUID!]
+}
+
+# =============== Subscription Events =============== #
+
+enum ResourceEventChange {
+  ResourceEventChange_PermissionUpdated
+  ResourceEventChange_PermissionRevoked
+  ResourceEventChange_Updated
+  ResourceEventChange_Deleted
+}
+
+type ResourceEvent {
+  eventId: UUID!
+  eventType: String!
+  resourceId: UUID!
+  targetUserPublicId: UUID
+  change: ResourceEventChange!
+  permission: AccessControlPermission
+}
+
+type RoutineTaskLifecycleEvent {
+  eventId: UUID!
+  routineTaskId: UUID!
+  routineTaskRecordId: UUID!
+  routineId: UUID!
+  purpose: RoutineTaskPurpose!
+  status: String!
+  attempt: Int!
+  occurredAt: Time!
+}
 `, BuiltIn: false},
 	{Name: "../schemas/theme.graphql", Input: `type PublicTheme {
   # id: UUID!
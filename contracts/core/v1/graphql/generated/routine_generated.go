@@ -423,6 +423,47 @@ func (ec *executionContext) fieldContext_PrivateRoutine_period(_ context.Context
 	return fc, nil
 }
 
+func (ec *executionContext) _PrivateRoutine_recurrenceRule(ctx context.Context, field graphql.CollectedField, obj *gqlmodels.PrivateRoutine) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PrivateRoutine_recurrenceRule(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RecurrenceRule, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PrivateRoutine_recurrenceRule(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrivateRoutine",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _PrivateRoutine_timezone(ctx context.Context, field graphql.CollectedField, obj *gqlmodels.PrivateRoutine) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_PrivateRoutine_timezone(ctx, field)
 	if err != nil {
@@ -1077,6 +1118,47 @@ func (ec *executionContext) fieldContext_PrivateSearchableRoutine_period(_ conte
 	return fc, nil
 }
 
+func (ec *executionContext) _PrivateSearchableRoutine_recurrenceRule(ctx context.Context, field graphql.CollectedField, obj *gqlmodels.PrivateSearchableRoutine) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PrivateSearchableRoutine_recurrenceRule(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RecurrenceRule, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PrivateSearchableRoutine_recurrenceRule(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrivateSearchableRoutine",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _PrivateSearchableRoutine_timezone(ctx context.Context, field graphql.CollectedField, obj *gqlmodels.PrivateSearchableRoutine) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_PrivateSearchableRoutine_timezone(ctx, field)
 	if err != nil {
@@ -1447,6 +1529,8 @@ func (ec *executionContext) _PrivateRoutine(ctx context.Context, sel ast.Selecti
 			}
 		case "period":
 			out.Values[i] = ec._PrivateRoutine_period(ctx, field, obj)
+		case "recurrenceRule":
+			out.Values[i] = ec._PrivateRoutine_recurrenceRule(ctx, field, obj)
 		case "timezone":
 			out.Values[i] = ec._PrivateRoutine_timezone(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -1550,6 +1634,8 @@ func (ec *executionContext) _PrivateSearchableRoutine(ctx context.Context, sel a
 			}
 		case "period":
 			out.Values[i] = ec._PrivateSearchableRoutine_period(ctx, field, obj)
+		case "recurrenceRule":
+			out.Values[i] = ec._PrivateSearchableRoutine_recurrenceRule(ctx, field, obj)
 		case "timezone":
 			out.Values[i] = ec._PrivateSearchableRoutine_timezone(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
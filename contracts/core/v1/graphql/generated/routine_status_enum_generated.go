@@ -72,4 +72,38 @@ var (
 	}
 )
 
+func (ec *executionContext) unmarshalORoutineStatus2ᚖgithubᚗcomᚋHiIamJeff67ᚋnotegicᚑbackendᚋcontractsᚋtypesᚋenumsᚐRoutineStatus(ctx context.Context, v any) (*enums.RoutineStatus, error) {
+	if v == nil {
+		return nil, nil
+	}
+	tmp, err := graphql.UnmarshalString(v)
+	res := unmarshalORoutineStatus2ᚖgithubᚗcomᚋHiIamJeff67ᚋnotegicᚑbackendᚋcontractsᚋtypesᚋenumsᚐRoutineStatus[tmp]
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalORoutineStatus2ᚖgithubᚗcomᚋHiIamJeff67ᚋnotegicᚑbackendᚋcontractsᚋtypesᚋenumsᚐRoutineStatus(ctx context.Context, sel ast.SelectionSet, v *enums.RoutineStatus) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalString(marshalORoutineStatus2ᚖgithubᚗcomᚋHiIamJeff67ᚋnotegicᚑbackendᚋcontractsᚋtypesᚋenumsᚐRoutineStatus[*v])
+	return res
+}
+
+var (
+	unmarshalORoutineStatus2ᚖgithubᚗcomᚋHiIamJeff67ᚋnotegicᚑbackendᚋcontractsᚋtypesᚋenumsᚐRoutineStatus = map[string]enums.RoutineStatus{
+		"RoutineStatus_Scheduled":  enums.RoutineStatus_Scheduled,
+		"RoutineStatus_InProgress": enums.RoutineStatus_InProgress,
+		"RoutineStatus_Completed":  enums.RoutineStatus_Completed,
+		"RoutineStatus_OverDue":    enums.RoutineStatus_OverDue,
+	}
+	marshalORoutineStatus2ᚖgithubᚗcomᚋHiIamJeff67ᚋnotegicᚑbackendᚋcontractsᚋtypesᚋenumsᚐRoutineStatus = map[enums.RoutineStatus]string{
+		enums.RoutineStatus_Scheduled:  "RoutineStatus_Scheduled",
+		enums.RoutineStatus_InProgress: "RoutineStatus_InProgress",
+		enums.RoutineStatus_Completed:  "RoutineStatus_Completed",
+		enums.RoutineStatus_OverDue:    "RoutineStatus_OverDue",
+	}
+)
+
 // endregion ***************************** type.gotpl *****************************
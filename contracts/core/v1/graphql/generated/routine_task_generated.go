@@ -4,7 +4,7 @@ package generated
 
 import (
 	"context"
-	"encoding/json"
+	"encoding/json/jsontext"
 	"errors"
 	"strconv"
 	"sync/atomic"
@@ -233,9 +233,9 @@ func (ec *executionContext) _PrivateRoutineTask_payload(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(json.RawMessage)
+	res := resTmp.(jsontext.Value)
 	fc.Result = res
-	return ec.marshalNRawJSON2encodingᚋjsonᚐRawMessage(ctx, field.Selections, res)
+	return ec.marshalNRawJSON2encodingᚋjsonᚋjsontextᚐValue(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) fieldContext_PrivateRoutineTask_payload(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
@@ -512,6 +512,47 @@ func (ec *executionContext) fieldContext_PrivateRoutineTask_period(_ context.Con
 	return fc, nil
 }
 
+func (ec *executionContext) _PrivateRoutineTask_recurrenceRule(ctx context.Context, field graphql.CollectedField, obj *gqlmodels.PrivateRoutineTask) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PrivateRoutineTask_recurrenceRule(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RecurrenceRule, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PrivateRoutineTask_recurrenceRule(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrivateRoutineTask",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _PrivateRoutineTask_nextScheduledAt(ctx context.Context, field graphql.CollectedField, obj *gqlmodels.PrivateRoutineTask) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_PrivateRoutineTask_nextScheduledAt(ctx, field)
 	if err != nil {
@@ -845,6 +886,8 @@ func (ec *executionContext) _PrivateRoutineTask(ctx context.Context, sel ast.Sel
 			}
 		case "period":
 			out.Values[i] = ec._PrivateRoutineTask_period(ctx, field, obj)
+		case "recurrenceRule":
+			out.Values[i] = ec._PrivateRoutineTask_recurrenceRule(ctx, field, obj)
 		case "nextScheduledAt":
 			out.Values[i] = ec._PrivateRoutineTask_nextScheduledAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
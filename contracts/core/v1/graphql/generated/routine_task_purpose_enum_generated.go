@@ -92,4 +92,58 @@ var (
 	}
 )
 
+func (ec *executionContext) unmarshalORoutineTaskPurpose2ᚖgithubᚗcomᚋHiIamJeff67ᚋnotegicᚑbackendᚋcontractsᚋtypesᚋenumsᚐRoutineTaskPurpose(ctx context.Context, v any) (*enums.RoutineTaskPurpose, error) {
+	if v == nil {
+		return nil, nil
+	}
+	tmp, err := graphql.UnmarshalString(v)
+	res := unmarshalORoutineTaskPurpose2ᚖgithubᚗcomᚋHiIamJeff67ᚋnotegicᚑbackendᚋcontractsᚋtypesᚋenumsᚐRoutineTaskPurpose[tmp]
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalORoutineTaskPurpose2ᚖgithubᚗcomᚋHiIamJeff67ᚋnotegicᚑbackendᚋcontractsᚋtypesᚋenumsᚐRoutineTaskPurpose(ctx context.Context, sel ast.SelectionSet, v *enums.RoutineTaskPurpose) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalString(marshalORoutineTaskPurpose2ᚖgithubᚗcomᚋHiIamJeff67ᚋnotegicᚑbackendᚋcontractsᚋtypesᚋenumsᚐRoutineTaskPurpose[*v])
+	return res
+}
+
+var (
+	unmarshalORoutineTaskPurpose2ᚖgithubᚗcomᚋHiIamJeff67ᚋnotegicᚑbackendᚋcontractsᚋtypesᚋenumsᚐRoutineTaskPurpose = map[string]enums.RoutineTaskPurpose{
+		"RoutineTaskPurpose_CreateRootShelf": enums.RoutineTaskPurpose_CreateRootShelf,
+		"RoutineTaskPurpose_UpdateRootShelf": enums.RoutineTaskPurpose_UpdateRootShelf,
+		"RoutineTaskPurpose_ResetRootShelf":  enums.RoutineTaskPurpose_ResetRootShelf,
+		"RoutineTaskPurpose_CreateSubShelf":  enums.RoutineTaskPurpose_CreateSubShelf,
+		"RoutineTaskPurpose_UpdateSubShelf":  enums.RoutineTaskPurpose_UpdateSubShelf,
+		"RoutineTaskPurpose_ResetSubShelf":   enums.RoutineTaskPurpose_ResetSubShelf,
+		"RoutineTaskPurpose_CreateBlockPack": enums.RoutineTaskPurpose_CreateBlockPack,
+		"RoutineTaskPurpose_UpdateBlockPack": enums.RoutineTaskPurpose_UpdateBlockPack,
+		"RoutineTaskPurpose_ResetBlockPack":  enums.RoutineTaskPurpose_ResetBlockPack,
+		"RoutineTaskPurpose_AppendBlock":     enums.RoutineTaskPurpose_AppendBlock,
+		"RoutineTaskPurpose_UpdateBlock":     enums.RoutineTaskPurpose_UpdateBlock,
+		"RoutineTaskPurpose_ResetBlock":      enums.RoutineTaskPurpose_ResetBlock,
+		"RoutineTaskPurpose_CreateRoutine":   enums.RoutineTaskPurpose_CreateRoutine,
+		"RoutineTaskPurpose_UpdateRoutine":   enums.RoutineTaskPurpose_UpdateRoutine,
+	}
+	marshalORoutineTaskPurpose2ᚖgithubᚗcomᚋHiIamJeff67ᚋnotegicᚑbackendᚋcontractsᚋtypesᚋenumsᚐRoutineTaskPurpose = map[enums.RoutineTaskPurpose]string{
+		enums.RoutineTaskPurpose_CreateRootShelf: "RoutineTaskPurpose_CreateRootShelf",
+		enums.RoutineTaskPurpose_UpdateRootShelf: "RoutineTaskPurpose_UpdateRootShelf",
+		enums.RoutineTaskPurpose_ResetRootShelf:  "RoutineTaskPurpose_ResetRootShelf",
+		enums.RoutineTaskPurpose_CreateSubShelf:  "RoutineTaskPurpose_CreateSubShelf",
+		enums.RoutineTaskPurpose_UpdateSubShelf:  "RoutineTaskPurpose_UpdateSubShelf",
+		enums.RoutineTaskPurpose_ResetSubShelf:   "RoutineTaskPurpose_ResetSubShelf",
+		enums.RoutineTaskPurpose_CreateBlockPack: "RoutineTaskPurpose_CreateBlockPack",
+		enums.RoutineTaskPurpose_UpdateBlockPack: "RoutineTaskPurpose_UpdateBlockPack",
+		enums.RoutineTaskPurpose_ResetBlockPack:  "RoutineTaskPurpose_ResetBlockPack",
+		enums.RoutineTaskPurpose_AppendBlock:     "RoutineTaskPurpose_AppendBlock",
+		enums.RoutineTaskPurpose_UpdateBlock:     "RoutineTaskPurpose_UpdateBlock",
+		enums.RoutineTaskPurpose_ResetBlock:      "RoutineTaskPurpose_ResetBlock",
+		enums.RoutineTaskPurpose_CreateRoutine:   "RoutineTaskPurpose_CreateRoutine",
+		enums.RoutineTaskPurpose_UpdateRoutine:   "RoutineTaskPurpose_UpdateRoutine",
+	}
+)
+
 // endregion ***************************** type.gotpl *****************************
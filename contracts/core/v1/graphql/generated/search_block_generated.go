@@ -72,6 +72,10 @@ func (ec *executionContext) fieldContext_SearchBlockConnection_searchEdges(_ con
 				return ec.fieldContext_SearchBlockEdge_encodedSearchCursor(ctx, field)
 			case "node":
 				return ec.fieldContext_SearchBlockEdge_node(ctx, field)
+			case "rank":
+				return ec.fieldContext_SearchBlockEdge_rank(ctx, field)
+			case "highlight":
+				return ec.fieldContext_SearchBlockEdge_highlight(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type SearchBlockEdge", field.Name)
 		},
@@ -333,6 +337,88 @@ func (ec *executionContext) fieldContext_SearchBlockEdge_node(_ context.Context,
 	return fc, nil
 }
 
+func (ec *executionContext) _SearchBlockEdge_rank(ctx context.Context, field graphql.CollectedField, obj *gqlmodels.SearchBlockEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SearchBlockEdge_rank(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Rank, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*float64)
+	fc.Result = res
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SearchBlockEdge_rank(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchBlockEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchBlockEdge_highlight(ctx context.Context, field graphql.CollectedField, obj *gqlmodels.SearchBlockEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SearchBlockEdge_highlight(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Highlight, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SearchBlockEdge_highlight(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchBlockEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 // endregion **************************** field.gotpl *****************************
 
 // region    **************************** input.gotpl *****************************
@@ -381,7 +467,7 @@ func (ec *executionContext) unmarshalInputSearchBlockInput(ctx context.Context,
 		asMap["sortOrder"] = "DESC"
 	}
 
-	fieldsInOrder := [...]string{"query", "after", "first", "sortBy", "sortOrder"}
+	fieldsInOrder := [...]string{"query", "after", "first", "before", "last", "sortBy", "sortOrder"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -409,6 +495,20 @@ func (ec *executionContext) unmarshalInputSearchBlockInput(ctx context.Context,
 				return it, err
 			}
 			it.First = data
+		case "before":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("before"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Before = data
+		case "last":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("last"))
+			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Last = data
 		case "sortBy":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sortBy"))
 			data, err := ec.unmarshalOSearchBlockSortBy2ᚖgithubᚗcomᚋHiIamJeff67ᚋnotegicᚑbackendᚋcontractsᚋcoreᚋv1ᚋgraphqlᚋmodelsᚐSearchBlockSortBy(ctx, v)
@@ -512,6 +612,10 @@ func (ec *executionContext) _SearchBlockEdge(ctx context.Context, sel ast.Select
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "rank":
+			out.Values[i] = ec._SearchBlockEdge_rank(ctx, field, obj)
+		case "highlight":
+			out.Values[i] = ec._SearchBlockEdge_highlight(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
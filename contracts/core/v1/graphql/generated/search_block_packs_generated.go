@@ -72,6 +72,10 @@ func (ec *executionContext) fieldContext_SearchBlockPackConnection_searchEdges(_
 				return ec.fieldContext_SearchBlockPackEdge_encodedSearchCursor(ctx, field)
 			case "node":
 				return ec.fieldContext_SearchBlockPackEdge_node(ctx, field)
+			case "rank":
+				return ec.fieldContext_SearchBlockPackEdge_rank(ctx, field)
+			case "highlight":
+				return ec.fieldContext_SearchBlockPackEdge_highlight(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type SearchBlockPackEdge", field.Name)
 		},
@@ -331,6 +335,88 @@ func (ec *executionContext) fieldContext_SearchBlockPackEdge_node(_ context.Cont
 	return fc, nil
 }
 
+func (ec *executionContext) _SearchBlockPackEdge_rank(ctx context.Context, field graphql.CollectedField, obj *gqlmodels.SearchBlockPackEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SearchBlockPackEdge_rank(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Rank, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*float64)
+	fc.Result = res
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SearchBlockPackEdge_rank(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchBlockPackEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchBlockPackEdge_highlight(ctx context.Context, field graphql.CollectedField, obj *gqlmodels.SearchBlockPackEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SearchBlockPackEdge_highlight(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Highlight, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SearchBlockPackEdge_highlight(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchBlockPackEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 // endregion **************************** field.gotpl *****************************
 
 // region    **************************** input.gotpl *****************************
@@ -379,7 +465,7 @@ func (ec *executionContext) unmarshalInputSearchBlockPackInput(ctx context.Conte
 		asMap["sortOrder"] = "DESC"
 	}
 
-	fieldsInOrder := [...]string{"parentSubShelfId", "rootShelfId", "query", "isDeletedAt", "after", "first", "sortBy", "sortOrder"}
+	fieldsInOrder := [...]string{"parentSubShelfId", "rootShelfId", "query", "isDeletedAt", "after", "first", "before", "last", "sortBy", "sortOrder"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -428,6 +514,20 @@ func (ec *executionContext) unmarshalInputSearchBlockPackInput(ctx context.Conte
 				return it, err
 			}
 			it.First = data
+		case "before":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("before"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Before = data
+		case "last":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("last"))
+			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Last = data
 		case "sortBy":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sortBy"))
 			data, err := ec.unmarshalOSearchBlockPackSortBy2ᚖgithubᚗcomᚋHiIamJeff67ᚋnotegicᚑbackendᚋcontractsᚋcoreᚋv1ᚋgraphqlᚋmodelsᚐSearchBlockPackSortBy(ctx, v)
@@ -531,6 +631,10 @@ func (ec *executionContext) _SearchBlockPackEdge(ctx context.Context, sel ast.Se
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "rank":
+			out.Values[i] = ec._SearchBlockPackEdge_rank(ctx, field, obj)
+		case "highlight":
+			out.Values[i] = ec._SearchBlockPackEdge_highlight(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -385,7 +385,7 @@ func (ec *executionContext) unmarshalInputSearchRoutineTaskRecordInput(ctx conte
 		asMap["sortOrder"] = "DESC"
 	}
 
-	fieldsInOrder := [...]string{"routineTaskIds", "query", "after", "first", "sortBy", "sortOrder"}
+	fieldsInOrder := [...]string{"routineTaskIds", "query", "after", "first", "before", "last", "sortBy", "sortOrder"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -420,6 +420,20 @@ func (ec *executionContext) unmarshalInputSearchRoutineTaskRecordInput(ctx conte
 				return it, err
 			}
 			it.First = data
+		case "before":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("before"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Before = data
+		case "last":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("last"))
+			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Last = data
 		case "sortBy":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sortBy"))
 			data, err := ec.unmarshalOSearchRoutineTaskRecordSortBy2ᚖgithubᚗcomᚋHiIamJeff67ᚋnotegicᚑbackendᚋcontractsᚋcoreᚋv1ᚋgraphqlᚋmodelsᚐSearchRoutineTaskRecordSortBy(ctx, v)
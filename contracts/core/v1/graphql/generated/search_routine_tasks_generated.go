@@ -326,6 +326,8 @@ func (ec *executionContext) fieldContext_SearchRoutineTaskEdge_node(_ context.Co
 				return ec.fieldContext_PrivateRoutineTask_maxAttempts(ctx, field)
 			case "period":
 				return ec.fieldContext_PrivateRoutineTask_period(ctx, field)
+			case "recurrenceRule":
+				return ec.fieldContext_PrivateRoutineTask_recurrenceRule(ctx, field)
 			case "nextScheduledAt":
 				return ec.fieldContext_PrivateRoutineTask_nextScheduledAt(ctx, field)
 			case "scheduledAt":
@@ -393,7 +395,7 @@ func (ec *executionContext) unmarshalInputSearchRoutineTaskInput(ctx context.Con
 		asMap["sortOrder"] = "DESC"
 	}
 
-	fieldsInOrder := [...]string{"routineIds", "query", "after", "first", "sortBy", "sortOrder"}
+	fieldsInOrder := [...]string{"routineIds", "query", "after", "first", "before", "last", "sortBy", "sortOrder"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -428,6 +430,20 @@ func (ec *executionContext) unmarshalInputSearchRoutineTaskInput(ctx context.Con
 				return it, err
 			}
 			it.First = data
+		case "before":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("before"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Before = data
+		case "last":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("last"))
+			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Last = data
 		case "sortBy":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sortBy"))
 			data, err := ec.unmarshalOSearchRoutineTaskSortBy2ᚖgithubᚗcomᚋHiIamJeff67ᚋnotegicᚑbackendᚋcontractsᚋcoreᚋv1ᚋgraphqlᚋmodelsᚐSearchRoutineTaskSortBy(ctx, v)
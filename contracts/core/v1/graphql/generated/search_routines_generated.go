@@ -320,6 +320,8 @@ func (ec *executionContext) fieldContext_SearchRoutineEdge_node(_ context.Contex
 				return ec.fieldContext_PrivateSearchableRoutine_scheduledEndAt(ctx, field)
 			case "period":
 				return ec.fieldContext_PrivateSearchableRoutine_period(ctx, field)
+			case "recurrenceRule":
+				return ec.fieldContext_PrivateSearchableRoutine_recurrenceRule(ctx, field)
 			case "timezone":
 				return ec.fieldContext_PrivateSearchableRoutine_timezone(ctx, field)
 			case "deletedAt":
@@ -389,7 +391,7 @@ func (ec *executionContext) unmarshalInputSearchRoutineInput(ctx context.Context
 		asMap["sortOrder"] = "DESC"
 	}
 
-	fieldsInOrder := [...]string{"stationIds", "tagIds", "query", "isDeletedAt", "after", "first", "sortBy", "sortOrder"}
+	fieldsInOrder := [...]string{"stationIds", "tagIds", "query", "isDeletedAt", "after", "first", "before", "last", "sortBy", "sortOrder"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -438,6 +440,20 @@ func (ec *executionContext) unmarshalInputSearchRoutineInput(ctx context.Context
 				return it, err
 			}
 			it.First = data
+		case "before":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("before"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Before = data
+		case "last":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("last"))
+			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Last = data
 		case "sortBy":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sortBy"))
 			data, err := ec.unmarshalOSearchRoutineSortBy2ᚖgithubᚗcomᚋHiIamJeff67ᚋnotegicᚑbackendᚋcontractsᚋcoreᚋv1ᚋgraphqlᚋmodelsᚐSearchRoutineSortBy(ctx, v)
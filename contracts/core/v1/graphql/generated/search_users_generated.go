@@ -437,7 +437,7 @@ func (ec *executionContext) unmarshalInputSearchUserInput(ctx context.Context, o
 		asMap["sortOrder"] = "DESC"
 	}
 
-	fieldsInOrder := [...]string{"query", "rootShelfIds", "stationIds", "badgePublicIds", "after", "first", "filters", "sortBy", "sortOrder"}
+	fieldsInOrder := [...]string{"query", "rootShelfIds", "stationIds", "badgePublicIds", "after", "first", "before", "last", "filters", "sortBy", "sortOrder"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -486,6 +486,20 @@ func (ec *executionContext) unmarshalInputSearchUserInput(ctx context.Context, o
 				return it, err
 			}
 			it.First = data
+		case "before":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("before"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Before = data
+		case "last":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("last"))
+			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Last = data
 		case "filters":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filters"))
 			data, err := ec.unmarshalOSearchUserFilters2ᚖgithubᚗcomᚋHiIamJeff67ᚋnotegicᚑbackendᚋcontractsᚋcoreᚋv1ᚋgraphqlᚋmodelsᚐSearchUserFilters(ctx, v)
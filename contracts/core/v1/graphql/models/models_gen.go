@@ -4,12 +4,13 @@ package gqlmodels
 
 import (
 	"bytes"
-	"encoding/json"
+	"encoding/json/jsontext"
 	"fmt"
 	"io"
 	"strconv"
 	"time"
 
+	eventscontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/events"
 	"github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
 	"github.com/google/uuid"
 	"gorm.io/datatypes"
@@ -27,6 +28,311 @@ type SearchEdge interface {
 	GetEncodedSearchCursor() string
 }
 
+type CreatableBlockPackInput struct {
+	ID                  *uuid.UUID           `json:"id,omitempty"`
+	ParentSubShelfID    uuid.UUID            `json:"parentSubShelfId"`
+	Name                string               `json:"name"`
+	Icon                *enums.SupportedIcon `json:"icon,omitempty"`
+	HeaderBackgroundURL *string              `json:"headerBackgroundURL,omitempty"`
+}
+
+type CreatableMaterialInput struct {
+	ParentSubShelfID uuid.UUID `json:"parentSubShelfId"`
+	Name             string    `json:"name"`
+}
+
+type CreatableRootShelfInput struct {
+	ID   *uuid.UUID `json:"id,omitempty"`
+	Name string     `json:"name"`
+}
+
+type CreatableRoutineInput struct {
+	ID               *uuid.UUID           `json:"id,omitempty"`
+	StationID        uuid.UUID            `json:"stationId"`
+	Title            string               `json:"title"`
+	Description      string               `json:"description"`
+	Status           *enums.RoutineStatus `json:"status,omitempty"`
+	IsPinned         *bool                `json:"isPinned,omitempty"`
+	ScheduledStartAt *time.Time           `json:"scheduledStartAt,omitempty"`
+	ScheduledEndAt   *time.Time           `json:"scheduledEndAt,omitempty"`
+	Period           *enums.RoutinePeriod `json:"period,omitempty"`
+	RecurrenceRule   *string              `json:"recurrenceRule,omitempty"`
+	Timezone         *string              `json:"timezone,omitempty"`
+}
+
+type CreatableRoutineTaskInput struct {
+	RoutineID       uuid.UUID                `json:"routineId"`
+	Title           string                   `json:"title"`
+	Purpose         enums.RoutineTaskPurpose `json:"purpose"`
+	Payload         datatypes.JSON           `json:"payload,omitempty"`
+	Priority        int32                    `json:"priority"`
+	MaxAttempts     int32                    `json:"maxAttempts"`
+	Period          *enums.RoutinePeriod     `json:"period,omitempty"`
+	RecurrenceRule  *string                  `json:"recurrenceRule,omitempty"`
+	NextScheduledAt time.Time                `json:"nextScheduledAt"`
+}
+
+type CreatableStationInput struct {
+	ID                  *uuid.UUID `json:"id,omitempty"`
+	Name                string     `json:"name"`
+	Description         string     `json:"description"`
+	Icon                *string    `json:"icon,omitempty"`
+	HeaderBackgroundURL *string    `json:"headerBackgroundURL,omitempty"`
+}
+
+type CreatableSubShelfInput struct {
+	ID             *uuid.UUID `json:"id,omitempty"`
+	RootShelfID    uuid.UUID  `json:"rootShelfId"`
+	PrevSubShelfID *uuid.UUID `json:"prevSubShelfId,omitempty"`
+	Name           string     `json:"name"`
+}
+
+type CreateBlockPacksInput struct {
+	CreatedBlockPacks []*CreatableBlockPackInput `json:"createdBlockPacks"`
+}
+
+type CreateRootShelfPermissionInput struct {
+	RootShelfID  uuid.UUID                     `json:"rootShelfId"`
+	UserPublicID uuid.UUID                     `json:"userPublicId"`
+	Permission   enums.AccessControlPermission `json:"permission"`
+}
+
+type CreateRootShelvesInput struct {
+	InsertedRootShelves []*CreatableRootShelfInput `json:"insertedRootShelves"`
+}
+
+type CreateRoutinesByStationIdsInput struct {
+	CreatedRoutines []*CreatableRoutineInput `json:"createdRoutines"`
+}
+
+type CreateStationPermissionInput struct {
+	StationID    uuid.UUID                     `json:"stationId"`
+	UserPublicID uuid.UUID                     `json:"userPublicId"`
+	Permission   enums.AccessControlPermission `json:"permission"`
+}
+
+type CreateStationsInput struct {
+	CreatedStations []*CreatableStationInput `json:"createdStations"`
+}
+
+type CreateSubShelvesByRootShelfIdsInput struct {
+	CreatedSubShelves []*CreatableSubShelfInput `json:"createdSubShelves"`
+}
+
+type DeleteBlockPackInput struct {
+	BlockPackID uuid.UUID `json:"blockPackId"`
+}
+
+type DeleteBlockPacksInput struct {
+	BlockPackIds []uuid.UUID `json:"blockPackIds"`
+}
+
+type DeleteMaterialInput struct {
+	MaterialID uuid.UUID `json:"materialId"`
+}
+
+type DeleteMaterialsInput struct {
+	MaterialIds []uuid.UUID `json:"materialIds"`
+}
+
+type DeleteRootShelfInput struct {
+	RootShelfID uuid.UUID `json:"rootShelfId"`
+}
+
+type DeleteRootShelfPermissionInput struct {
+	RootShelfID  uuid.UUID `json:"rootShelfId"`
+	UserPublicID uuid.UUID `json:"userPublicId"`
+}
+
+type DeleteRootShelfPermissionsInput struct {
+	RootShelfID   uuid.UUID   `json:"rootShelfId"`
+	UserPublicIds []uuid.UUID `json:"userPublicIds"`
+}
+
+type DeleteRootShelvesInput struct {
+	RootShelfIds []uuid.UUID `json:"rootShelfIds"`
+}
+
+type DeleteRoutineInput struct {
+	RoutineID uuid.UUID `json:"routineId"`
+}
+
+type DeleteRoutinesInput struct {
+	RoutineIds []uuid.UUID `json:"routineIds"`
+}
+
+type DeleteStationInput struct {
+	StationID uuid.UUID `json:"stationId"`
+}
+
+type DeleteStationPermissionInput struct {
+	StationID    uuid.UUID `json:"stationId"`
+	UserPublicID uuid.UUID `json:"userPublicId"`
+}
+
+type DeleteStationPermissionsInput struct {
+	StationID     uuid.UUID   `json:"stationId"`
+	UserPublicIds []uuid.UUID `json:"userPublicIds"`
+}
+
+type DeleteStationsInput struct {
+	StationIds []uuid.UUID `json:"stationIds"`
+}
+
+type DeleteSubShelfInput struct {
+	SubShelfID uuid.UUID `json:"subShelfId"`
+}
+
+type DeleteSubShelvesInput struct {
+	SubShelfIds []uuid.UUID `json:"subShelfIds"`
+}
+
+type GetMyTrashInput struct {
+	Types  []TrashItemType `json:"types,omitempty"`
+	First  *int32          `json:"first,omitempty"`
+	Offset *int32          `json:"offset,omitempty"`
+}
+
+type GetMyUsageInput struct {
+	Days *int32 `json:"days,omitempty"`
+}
+
+type HardDeleteRoutineInput struct {
+	RoutineID uuid.UUID `json:"routineId"`
+}
+
+type HardDeleteRoutineTaskInput struct {
+	RoutineTaskID uuid.UUID `json:"routineTaskId"`
+}
+
+type HardDeleteRoutineTasksInput struct {
+	RoutineTaskIds []uuid.UUID `json:"routineTaskIds"`
+}
+
+type HardDeleteRoutinesInput struct {
+	RoutineIds []uuid.UUID `json:"routineIds"`
+}
+
+type HardDeleteStationInput struct {
+	StationID uuid.UUID `json:"stationId"`
+}
+
+type HardDeleteStationsInput struct {
+	StationIds []uuid.UUID `json:"stationIds"`
+}
+
+type LeaveRootShelfInput struct {
+	RootShelfID uuid.UUID `json:"rootShelfId"`
+}
+
+type LeaveRootShelvesInput struct {
+	RootShelfIds []uuid.UUID `json:"rootShelfIds"`
+}
+
+type LeaveStationInput struct {
+	StationID uuid.UUID `json:"stationId"`
+}
+
+type LeaveStationsInput struct {
+	StationIds []uuid.UUID `json:"stationIds"`
+}
+
+type LinkRoutineItemInput struct {
+	RoutineID uuid.UUID      `json:"routineId"`
+	ItemID    uuid.UUID      `json:"itemId"`
+	ItemType  enums.ItemType `json:"itemType"`
+	IsUnlink  bool           `json:"isUnlink"`
+}
+
+type LinkRoutineItemsInput struct {
+	LinkedRoutinesAndItems []*LinkableRoutineAndItemInput `json:"linkedRoutinesAndItems"`
+	IsUnlink               bool                           `json:"isUnlink"`
+}
+
+type LinkRoutineTagInput struct {
+	RoutineID    uuid.UUID `json:"routineId"`
+	RoutineTagID uuid.UUID `json:"routineTagId"`
+	IsUnlink     bool      `json:"isUnlink"`
+}
+
+type LinkRoutineTagsInput struct {
+	LinkedRoutinesAndTags []*LinkableRoutineAndTagInput `json:"linkedRoutinesAndTags"`
+	IsUnlink              bool                          `json:"isUnlink"`
+}
+
+type LinkableRoutineAndItemInput struct {
+	RoutineID uuid.UUID      `json:"routineId"`
+	ItemID    uuid.UUID      `json:"itemId"`
+	ItemType  enums.ItemType `json:"itemType"`
+}
+
+type LinkableRoutineAndTagInput struct {
+	RoutineID    uuid.UUID `json:"routineId"`
+	RoutineTagID uuid.UUID `json:"routineTagId"`
+}
+
+type MovableBlockPackInput struct {
+	BlockPackIds                []uuid.UUID `json:"blockPackIds"`
+	DestinationParentSubShelfID uuid.UUID   `json:"destinationParentSubShelfId"`
+}
+
+type MovableSubShelfInput struct {
+	SourceRootShelfID      uuid.UUID   `json:"sourceRootShelfId"`
+	SourceSubShelfIds      []uuid.UUID `json:"sourceSubShelfIds"`
+	DestinationRootShelfID uuid.UUID   `json:"destinationRootShelfId"`
+	DestinationSubShelfID  *uuid.UUID  `json:"destinationSubShelfId,omitempty"`
+}
+
+type MoveBlockPackByParentSubShelfIDInput struct {
+	BlockPackID                 uuid.UUID `json:"blockPackId"`
+	DestinationParentSubShelfID uuid.UUID `json:"destinationParentSubShelfId"`
+}
+
+type MoveBlockPacksByParentSubShelfIdsInput struct {
+	MovedBlockPacks []*MovableBlockPackInput `json:"movedBlockPacks"`
+}
+
+type MoveMaterialInput struct {
+	MaterialID                  uuid.UUID `json:"materialId"`
+	DestinationParentSubShelfID uuid.UUID `json:"destinationParentSubShelfId"`
+}
+
+type MoveMaterialsInput struct {
+	MaterialIds                 []uuid.UUID `json:"materialIds"`
+	DestinationParentSubShelfID uuid.UUID   `json:"destinationParentSubShelfId"`
+}
+
+type MoveSubShelfByRootShelfIDInput struct {
+	SourceRootShelfID      uuid.UUID  `json:"sourceRootShelfId"`
+	SourceSubShelfID       uuid.UUID  `json:"sourceSubShelfId"`
+	DestinationRootShelfID uuid.UUID  `json:"destinationRootShelfId"`
+	DestinationSubShelfID  *uuid.UUID `json:"destinationSubShelfId,omitempty"`
+}
+
+type MoveSubShelvesByRootShelfIdsInput struct {
+	MovedSubShelves []*MovableSubShelfInput `json:"movedSubShelves"`
+}
+
+type Mutation struct {
+}
+
+type MyTrash struct {
+	Items         []*TrashItem `json:"items"`
+	TotalCount    int32        `json:"totalCount"`
+	RetentionDays int32        `json:"retentionDays"`
+}
+
+type MyUsage struct {
+	Plan        enums.UserPlan   `json:"plan"`
+	Limits      []*UsageLimit    `json:"limits"`
+	Snapshots   []*UsageSnapshot `json:"snapshots"`
+	GeneratedAt time.Time        `json:"generatedAt"`
+}
+
+type PauseRoutineTaskInput struct {
+	RoutineTaskID uuid.UUID `json:"routineTaskId"`
+}
+
 type PrivateBlock struct {
 	ID            uuid.UUID       `json:"id"`
 	BlockPackID   uuid.UUID       `json:"blockPackId"`
@@ -102,6 +408,7 @@ type PrivateRoutine struct {
 	ScheduledStartAt time.Time            `json:"scheduledStartAt"`
 	ScheduledEndAt   time.Time            `json:"scheduledEndAt"`
 	Period           *enums.RoutinePeriod `json:"period,omitempty"`
+	RecurrenceRule   *string              `json:"recurrenceRule,omitempty"`
 	Timezone         string               `json:"timezone"`
 	DeletedAt        *time.Time           `json:"deletedAt,omitempty"`
 	UpdatedAt        time.Time            `json:"updatedAt"`
@@ -125,13 +432,14 @@ type PrivateRoutineTask struct {
 	RoutineID       uuid.UUID                `json:"routineId"`
 	Title           string                   `json:"title"`
 	Purpose         enums.RoutineTaskPurpose `json:"purpose"`
-	Payload         json.RawMessage          `json:"payload"`
+	Payload         jsontext.Value           `json:"payload"`
 	CostUnit        int64                    `json:"costUnit"`
 	Priority        int32                    `json:"priority"`
 	Status          enums.RoutineTaskStatus  `json:"status"`
 	Attempts        int32                    `json:"attempts"`
 	MaxAttempts     int32                    `json:"maxAttempts"`
 	Period          *enums.RoutinePeriod     `json:"period,omitempty"`
+	RecurrenceRule  *string                  `json:"recurrenceRule,omitempty"`
 	NextScheduledAt time.Time                `json:"nextScheduledAt"`
 	ScheduledAt     time.Time                `json:"scheduledAt"`
 	ActualStartedAt *time.Time               `json:"actualStartedAt,omitempty"`
@@ -165,6 +473,7 @@ type PrivateSearchableRoutine struct {
 	ScheduledStartAt time.Time            `json:"scheduledStartAt"`
 	ScheduledEndAt   time.Time            `json:"scheduledEndAt"`
 	Period           *enums.RoutinePeriod `json:"period,omitempty"`
+	RecurrenceRule   *string              `json:"recurrenceRule,omitempty"`
 	Timezone         string               `json:"timezone"`
 	DeletedAt        *time.Time           `json:"deletedAt,omitempty"`
 	UpdatedAt        time.Time            `json:"updatedAt"`
@@ -258,6 +567,83 @@ type PublicUserInfo struct {
 type Query struct {
 }
 
+type ResourceEvent struct {
+	EventID            uuid.UUID                          `json:"eventId"`
+	EventType          string                             `json:"eventType"`
+	ResourceID         uuid.UUID                          `json:"resourceId"`
+	TargetUserPublicID *uuid.UUID                         `json:"targetUserPublicId,omitempty"`
+	Change             eventscontract.ResourceEventChange `json:"change"`
+	Permission         *enums.AccessControlPermission     `json:"permission,omitempty"`
+}
+
+type RestoreBlockPackInput struct {
+	BlockPackID uuid.UUID `json:"blockPackId"`
+}
+
+type RestoreBlockPacksInput struct {
+	BlockPackIds []uuid.UUID `json:"blockPackIds"`
+}
+
+type RestoreMaterialInput struct {
+	MaterialID uuid.UUID `json:"materialId"`
+}
+
+type RestoreMaterialsInput struct {
+	MaterialIds []uuid.UUID `json:"materialIds"`
+}
+
+type RestoreRootShelfInput struct {
+	RootShelfID uuid.UUID `json:"rootShelfId"`
+}
+
+type RestoreRootShelvesInput struct {
+	RootShelfIds []uuid.UUID `json:"rootShelfIds"`
+}
+
+type RestoreRoutineInput struct {
+	RoutineID uuid.UUID `json:"routineId"`
+}
+
+type RestoreRoutinesInput struct {
+	RoutineIds []uuid.UUID `json:"routineIds"`
+}
+
+type RestoreStationInput struct {
+	StationID uuid.UUID `json:"stationId"`
+}
+
+type RestoreStationsInput struct {
+	StationIds []uuid.UUID `json:"stationIds"`
+}
+
+type RestoreSubShelfInput struct {
+	SubShelfID uuid.UUID `json:"subShelfId"`
+}
+
+type RestoreSubShelvesInput struct {
+	SubShelfIds []uuid.UUID `json:"subShelfIds"`
+}
+
+type ResumeRoutineTaskInput struct {
+	RoutineTaskID uuid.UUID `json:"routineTaskId"`
+}
+
+type RoutineTaskLifecycleEvent struct {
+	EventID             uuid.UUID                `json:"eventId"`
+	RoutineTaskID       uuid.UUID                `json:"routineTaskId"`
+	RoutineTaskRecordID uuid.UUID                `json:"routineTaskRecordId"`
+	RoutineID           uuid.UUID                `json:"routineId"`
+	Purpose             enums.RoutineTaskPurpose `json:"purpose"`
+	Status              string                   `json:"status"`
+	Attempt             int32                    `json:"attempt"`
+	OccurredAt          time.Time                `json:"occurredAt"`
+}
+
+type SaveMaterialInput struct {
+	MaterialID  uuid.UUID `json:"materialId"`
+	ContentFile []byte    `json:"contentFile"`
+}
+
 type SearchBadgeConnection struct {
 	SearchEdges    []*SearchBadgeEdge `json:"searchEdges"`
 	SearchPageInfo *SearchPageInfo    `json:"searchPageInfo"`
@@ -290,6 +676,8 @@ type SearchBadgeInput struct {
 	Query     string              `json:"query"`
 	After     *string             `json:"after,omitempty"`
 	First     *int32              `json:"first,omitempty"`
+	Before    *string             `json:"before,omitempty"`
+	Last      *int32              `json:"last,omitempty"`
 	Filters   *SearchBadgeFilters `json:"filters,omitempty"`
 	SortBy    *SearchBadgeSortBy  `json:"sortBy,omitempty"`
 	SortOrder *SearchSortOrder    `json:"sortOrder,omitempty"`
@@ -314,6 +702,8 @@ type SearchBlockCursorFields struct {
 type SearchBlockEdge struct {
 	EncodedSearchCursor string        `json:"encodedSearchCursor"`
 	Node                *PrivateBlock `json:"node"`
+	Rank                *float64      `json:"rank,omitempty"`
+	Highlight           *string       `json:"highlight,omitempty"`
 }
 
 func (SearchBlockEdge) IsSearchEdge()                       {}
@@ -323,6 +713,8 @@ type SearchBlockInput struct {
 	Query     string             `json:"query"`
 	After     *string            `json:"after,omitempty"`
 	First     *int32             `json:"first,omitempty"`
+	Before    *string            `json:"before,omitempty"`
+	Last      *int32             `json:"last,omitempty"`
 	SortBy    *SearchBlockSortBy `json:"sortBy,omitempty"`
 	SortOrder *SearchSortOrder   `json:"sortOrder,omitempty"`
 }
@@ -346,6 +738,8 @@ type SearchBlockPackCursorFields struct {
 type SearchBlockPackEdge struct {
 	EncodedSearchCursor string            `json:"encodedSearchCursor"`
 	Node                *PrivateBlockPack `json:"node"`
+	Rank                *float64          `json:"rank,omitempty"`
+	Highlight           *string           `json:"highlight,omitempty"`
 }
 
 func (SearchBlockPackEdge) IsSearchEdge()                       {}
@@ -358,6 +752,8 @@ type SearchBlockPackInput struct {
 	IsDeletedAt      *bool                  `json:"isDeletedAt,omitempty"`
 	After            *string                `json:"after,omitempty"`
 	First            *int32                 `json:"first,omitempty"`
+	Before           *string                `json:"before,omitempty"`
+	Last             *int32                 `json:"last,omitempty"`
 	SortBy           *SearchBlockPackSortBy `json:"sortBy,omitempty"`
 	SortOrder        *SearchSortOrder       `json:"sortOrder,omitempty"`
 }
@@ -393,6 +789,8 @@ type SearchItemInput struct {
 	IsDeletedAt      *bool             `json:"isDeletedAt,omitempty"`
 	After            *string           `json:"after,omitempty"`
 	First            *int32            `json:"first,omitempty"`
+	Before           *string           `json:"before,omitempty"`
+	Last             *int32            `json:"last,omitempty"`
 	SortBy           *SearchItemSortBy `json:"sortBy,omitempty"`
 	SortOrder        *SearchSortOrder  `json:"sortOrder,omitempty"`
 }
@@ -416,6 +814,8 @@ type SearchMaterialCursorFields struct {
 type SearchMaterialEdge struct {
 	EncodedSearchCursor string           `json:"encodedSearchCursor"`
 	Node                *PrivateMaterial `json:"node"`
+	Rank                *float64         `json:"rank,omitempty"`
+	Highlight           *string          `json:"highlight,omitempty"`
 }
 
 func (SearchMaterialEdge) IsSearchEdge()                       {}
@@ -428,6 +828,8 @@ type SearchMaterialInput struct {
 	IsDeletedAt      *bool                 `json:"isDeletedAt,omitempty"`
 	After            *string               `json:"after,omitempty"`
 	First            *int32                `json:"first,omitempty"`
+	Before           *string               `json:"before,omitempty"`
+	Last             *int32                `json:"last,omitempty"`
 	SortBy           *SearchMaterialSortBy `json:"sortBy,omitempty"`
 	SortOrder        *SearchSortOrder      `json:"sortOrder,omitempty"`
 }
@@ -468,6 +870,8 @@ type SearchRootShelfInput struct {
 	IsDeletedAt *bool                  `json:"isDeletedAt,omitempty"`
 	After       *string                `json:"after,omitempty"`
 	First       *int32                 `json:"first,omitempty"`
+	Before      *string                `json:"before,omitempty"`
+	Last        *int32                 `json:"last,omitempty"`
 	SortBy      *SearchRootShelfSortBy `json:"sortBy,omitempty"`
 	SortOrder   *SearchSortOrder       `json:"sortOrder,omitempty"`
 }
@@ -503,6 +907,8 @@ type SearchRoutineInput struct {
 	IsDeletedAt *bool                `json:"isDeletedAt,omitempty"`
 	After       *string              `json:"after,omitempty"`
 	First       *int32               `json:"first,omitempty"`
+	Before      *string              `json:"before,omitempty"`
+	Last        *int32               `json:"last,omitempty"`
 	SortBy      *SearchRoutineSortBy `json:"sortBy,omitempty"`
 	SortOrder   *SearchSortOrder     `json:"sortOrder,omitempty"`
 }
@@ -537,6 +943,8 @@ type SearchRoutineTagInput struct {
 	Query     string                  `json:"query"`
 	After     *string                 `json:"after,omitempty"`
 	First     *int32                  `json:"first,omitempty"`
+	Before    *string                 `json:"before,omitempty"`
+	Last      *int32                  `json:"last,omitempty"`
 	SortBy    *SearchRoutineTagSortBy `json:"sortBy,omitempty"`
 	SortOrder *SearchSortOrder        `json:"sortOrder,omitempty"`
 }
@@ -572,6 +980,8 @@ type SearchRoutineTaskInput struct {
 	Query      string                   `json:"query"`
 	After      *string                  `json:"after,omitempty"`
 	First      *int32                   `json:"first,omitempty"`
+	Before     *string                  `json:"before,omitempty"`
+	Last       *int32                   `json:"last,omitempty"`
 	SortBy     *SearchRoutineTaskSortBy `json:"sortBy,omitempty"`
 	SortOrder  *SearchSortOrder         `json:"sortOrder,omitempty"`
 }
@@ -609,6 +1019,8 @@ type SearchRoutineTaskRecordInput struct {
 	Query          string                         `json:"query"`
 	After          *string                        `json:"after,omitempty"`
 	First          *int32                         `json:"first,omitempty"`
+	Before         *string                        `json:"before,omitempty"`
+	Last           *int32                         `json:"last,omitempty"`
 	SortBy         *SearchRoutineTaskRecordSortBy `json:"sortBy,omitempty"`
 	SortOrder      *SearchSortOrder               `json:"sortOrder,omitempty"`
 }
@@ -642,6 +1054,8 @@ type SearchStationInput struct {
 	IsDeletedAt *bool                `json:"isDeletedAt,omitempty"`
 	After       *string              `json:"after,omitempty"`
 	First       *int32               `json:"first,omitempty"`
+	Before      *string              `json:"before,omitempty"`
+	Last        *int32               `json:"last,omitempty"`
 	SortBy      *SearchStationSortBy `json:"sortBy,omitempty"`
 	SortOrder   *SearchSortOrder     `json:"sortOrder,omitempty"`
 }
@@ -677,6 +1091,8 @@ type SearchSubShelfInput struct {
 	IsDeletedAt    *bool                 `json:"isDeletedAt,omitempty"`
 	After          *string               `json:"after,omitempty"`
 	First          *int32                `json:"first,omitempty"`
+	Before         *string               `json:"before,omitempty"`
+	Last           *int32                `json:"last,omitempty"`
 	SortBy         *SearchSubShelfSortBy `json:"sortBy,omitempty"`
 	SortOrder      *SearchSortOrder      `json:"sortOrder,omitempty"`
 }
@@ -714,6 +1130,8 @@ type SearchThemeInput struct {
 	Query     string              `json:"query"`
 	After     *string             `json:"after,omitempty"`
 	First     *int32              `json:"first,omitempty"`
+	Before    *string             `json:"before,omitempty"`
+	Last      *int32              `json:"last,omitempty"`
 	Filters   *SearchThemeFilters `json:"filters,omitempty"`
 	SortBy    *SearchThemeSortBy  `json:"sortBy,omitempty"`
 	SortOrder *SearchSortOrder    `json:"sortOrder,omitempty"`
@@ -759,11 +1177,270 @@ type SearchUserInput struct {
 	BadgePublicIds []uuid.UUID        `json:"badgePublicIds,omitempty"`
 	After          *string            `json:"after,omitempty"`
 	First          *int32             `json:"first,omitempty"`
+	Before         *string            `json:"before,omitempty"`
+	Last           *int32             `json:"last,omitempty"`
 	Filters        *SearchUserFilters `json:"filters,omitempty"`
 	SortBy         *SearchUserSortBy  `json:"sortBy,omitempty"`
 	SortOrder      *SearchSortOrder   `json:"sortOrder,omitempty"`
 }
 
+type SubscribeResourceEventsInput struct {
+	RootShelfIds []uuid.UUID `json:"rootShelfIds,omitempty"`
+	BlockPackIds []uuid.UUID `json:"blockPackIds,omitempty"`
+}
+
+type SubscribeRoutineTaskLifecycleEventsInput struct {
+	RoutineIds     []uuid.UUID `json:"routineIds,omitempty"`
+	RoutineTaskIds []uuid.UUID `json:"routineTaskIds,omitempty"`
+}
+
+type Subscription struct {
+}
+
+type TransferRootShelfOwnershipInput struct {
+	RootShelfID        uuid.UUID `json:"rootShelfId"`
+	TargetUserPublicID uuid.UUID `json:"targetUserPublicId"`
+}
+
+type TransferStationOwnershipInput struct {
+	StationID          uuid.UUID `json:"stationId"`
+	TargetUserPublicID uuid.UUID `json:"targetUserPublicId"`
+}
+
+type TrashItem struct {
+	ID               uuid.UUID     `json:"id"`
+	Type             TrashItemType `json:"type"`
+	Name             string        `json:"name"`
+	RootShelfID      uuid.UUID     `json:"rootShelfId"`
+	ParentSubShelfID *uuid.UUID    `json:"parentSubShelfId,omitempty"`
+	Size             int64         `json:"size"`
+	DeletedAt        time.Time     `json:"deletedAt"`
+	PurgeAt          *time.Time    `json:"purgeAt,omitempty"`
+}
+
+type UpdatableBlockPackInput struct {
+	BlockPackID uuid.UUID                      `json:"blockPackId"`
+	Values      *UpdatableBlockPackValuesInput `json:"values"`
+	SetNull     []string                       `json:"setNull,omitempty"`
+}
+
+type UpdatableBlockPackValuesInput struct {
+	Name                *string              `json:"name,omitempty"`
+	Icon                *enums.SupportedIcon `json:"icon,omitempty"`
+	HeaderBackgroundURL *string              `json:"headerBackgroundURL,omitempty"`
+}
+
+type UpdatableMaterialInput struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type UpdatableRootShelfInput struct {
+	RootShelfID uuid.UUID                      `json:"rootShelfId"`
+	Values      *UpdatableRootShelfValuesInput `json:"values"`
+	SetNull     []string                       `json:"setNull,omitempty"`
+}
+
+type UpdatableRootShelfValuesInput struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type UpdatableRoutineInput struct {
+	RoutineID uuid.UUID                    `json:"routineId"`
+	Values    *UpdatableRoutineValuesInput `json:"values"`
+	SetNull   []string                     `json:"setNull,omitempty"`
+}
+
+type UpdatableRoutineTaskInput struct {
+	RoutineTaskID uuid.UUID                        `json:"routineTaskId"`
+	Values        *UpdatableRoutineTaskValuesInput `json:"values"`
+	SetNull       []string                         `json:"setNull,omitempty"`
+}
+
+type UpdatableRoutineTaskValuesInput struct {
+	RoutineID       *uuid.UUID                `json:"routineId,omitempty"`
+	Title           *string                   `json:"title,omitempty"`
+	Purpose         *enums.RoutineTaskPurpose `json:"purpose,omitempty"`
+	Payload         datatypes.JSON            `json:"payload,omitempty"`
+	Priority        *int32                    `json:"priority,omitempty"`
+	MaxAttempts     *int32                    `json:"maxAttempts,omitempty"`
+	Period          *enums.RoutinePeriod      `json:"period,omitempty"`
+	RecurrenceRule  *string                   `json:"recurrenceRule,omitempty"`
+	NextScheduledAt *time.Time                `json:"nextScheduledAt,omitempty"`
+}
+
+type UpdatableRoutineValuesInput struct {
+	StationID        *uuid.UUID           `json:"stationId,omitempty"`
+	Title            *string              `json:"title,omitempty"`
+	Description      *string              `json:"description,omitempty"`
+	Status           *enums.RoutineStatus `json:"status,omitempty"`
+	IsPinned         *bool                `json:"isPinned,omitempty"`
+	ScheduledStartAt *time.Time           `json:"scheduledStartAt,omitempty"`
+	ScheduledEndAt   *time.Time           `json:"scheduledEndAt,omitempty"`
+	Period           *enums.RoutinePeriod `json:"period,omitempty"`
+	RecurrenceRule   *string              `json:"recurrenceRule,omitempty"`
+	Timezone         *string              `json:"timezone,omitempty"`
+}
+
+type UpdatableStationInput struct {
+	StationID uuid.UUID                    `json:"stationId"`
+	Values    *UpdatableStationValuesInput `json:"values"`
+	SetNull   []string                     `json:"setNull,omitempty"`
+}
+
+type UpdatableStationValuesInput struct {
+	Name                *string `json:"name,omitempty"`
+	Description         *string `json:"description,omitempty"`
+	Icon                *string `json:"icon,omitempty"`
+	HeaderBackgroundURL *string `json:"headerBackgroundURL,omitempty"`
+}
+
+type UpdatableSubShelfInput struct {
+	SubShelfID uuid.UUID                     `json:"subShelfId"`
+	Values     *UpdatableSubShelfValuesInput `json:"values"`
+	SetNull    []string                      `json:"setNull,omitempty"`
+}
+
+type UpdatableSubShelfValuesInput struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type UpdateBlockPackInput struct {
+	BlockPackID uuid.UUID                   `json:"blockPackId"`
+	Values      *UpdateBlockPackValuesInput `json:"values"`
+	SetNull     []string                    `json:"setNull,omitempty"`
+}
+
+type UpdateBlockPackValuesInput struct {
+	Name                *string              `json:"name,omitempty"`
+	Icon                *enums.SupportedIcon `json:"icon,omitempty"`
+	HeaderBackgroundURL *string              `json:"headerBackgroundURL,omitempty"`
+}
+
+type UpdateBlockPacksInput struct {
+	UpdatedBlockPacks []*UpdatableBlockPackInput `json:"updatedBlockPacks"`
+}
+
+type UpdateMaterialInput struct {
+	MaterialID uuid.UUID               `json:"materialId"`
+	Values     *UpdatableMaterialInput `json:"values"`
+	SetNull    []string                `json:"setNull,omitempty"`
+}
+
+type UpdateRootShelfInput struct {
+	RootShelfID uuid.UUID                   `json:"rootShelfId"`
+	Values      *UpdateRootShelfValuesInput `json:"values"`
+	SetNull     []string                    `json:"setNull,omitempty"`
+}
+
+type UpdateRootShelfPermissionInput struct {
+	RootShelfID  uuid.UUID                     `json:"rootShelfId"`
+	UserPublicID uuid.UUID                     `json:"userPublicId"`
+	Permission   enums.AccessControlPermission `json:"permission"`
+}
+
+type UpdateRootShelfValuesInput struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type UpdateRootShelvesInput struct {
+	UpdatedRootShelves []*UpdatableRootShelfInput `json:"updatedRootShelves"`
+}
+
+type UpdateRoutinesInput struct {
+	UpdatedRoutines []*UpdatableRoutineInput `json:"updatedRoutines"`
+}
+
+type UpdateStationInput struct {
+	StationID uuid.UUID                 `json:"stationId"`
+	Values    *UpdateStationValuesInput `json:"values"`
+	SetNull   []string                  `json:"setNull,omitempty"`
+}
+
+type UpdateStationPermissionInput struct {
+	StationID    uuid.UUID                     `json:"stationId"`
+	UserPublicID uuid.UUID                     `json:"userPublicId"`
+	Permission   enums.AccessControlPermission `json:"permission"`
+}
+
+type UpdateStationValuesInput struct {
+	Name                *string `json:"name,omitempty"`
+	Description         *string `json:"description,omitempty"`
+	Icon                *string `json:"icon,omitempty"`
+	HeaderBackgroundURL *string `json:"headerBackgroundURL,omitempty"`
+}
+
+type UpdateStationsInput struct {
+	UpdatedStations []*UpdatableStationInput `json:"updatedStations"`
+}
+
+type UpdateSubShelfInput struct {
+	SubShelfID uuid.UUID                  `json:"subShelfId"`
+	Values     *UpdateSubShelfValuesInput `json:"values"`
+	SetNull    []string                   `json:"setNull,omitempty"`
+}
+
+type UpdateSubShelfValuesInput struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type UpdateSubShelvesInput struct {
+	UpdatedSubShelves []*UpdatableSubShelfInput `json:"updatedSubShelves"`
+}
+
+type UpsertRootShelfPermissionInput struct {
+	RootShelfID  uuid.UUID                     `json:"rootShelfId"`
+	UserPublicID uuid.UUID                     `json:"userPublicId"`
+	Permission   enums.AccessControlPermission `json:"permission"`
+}
+
+type UpsertRootShelfPermissionsInput struct {
+	RootShelfID uuid.UUID                             `json:"rootShelfId"`
+	Permissions []*UpsertableRootShelfPermissionInput `json:"permissions"`
+}
+
+type UpsertStationPermissionInput struct {
+	StationID    uuid.UUID                     `json:"stationId"`
+	UserPublicID uuid.UUID                     `json:"userPublicId"`
+	Permission   enums.AccessControlPermission `json:"permission"`
+}
+
+type UpsertStationPermissionsInput struct {
+	StationID   uuid.UUID                           `json:"stationId"`
+	Permissions []*UpsertableStationPermissionInput `json:"permissions"`
+}
+
+type UpsertableRootShelfPermissionInput struct {
+	UserPublicID uuid.UUID                     `json:"userPublicId"`
+	Permission   enums.AccessControlPermission `json:"permission"`
+}
+
+type UpsertableStationPermissionInput struct {
+	UserPublicID uuid.UUID                     `json:"userPublicId"`
+	Permission   enums.AccessControlPermission `json:"permission"`
+}
+
+type UsageLimit struct {
+	Key        UsageLimitKey `json:"key"`
+	Limit      int64         `json:"limit"`
+	Used       int64         `json:"used"`
+	Percentage float64       `json:"percentage"`
+	ResetAt    *time.Time    `json:"resetAt,omitempty"`
+}
+
+type UsageSnapshot struct {
+	Date                    time.Time `json:"date"`
+	RootShelfCount          int64     `json:"rootShelfCount"`
+	BlockPackCount          int64     `json:"blockPackCount"`
+	BlockCount              int64     `json:"blockCount"`
+	MaterialCount           int64     `json:"materialCount"`
+	WorkflowCount           int64     `json:"workflowCount"`
+	AdditionalItemCount     int64     `json:"additionalItemCount"`
+	StationCount            int64     `json:"stationCount"`
+	RoutineCount            int64     `json:"routineCount"`
+	RoutineTagCount         int64     `json:"routineTagCount"`
+	RoutineTaskCostUnitUsed int64     `json:"routineTaskCostUnitUsed"`
+}
+
 type SearchBadgeSortBy string
 
 const (
@@ -1688,3 +2365,131 @@ func (e SearchUserSortBy) MarshalJSON() ([]byte, error) {
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
+
+type TrashItemType string
+
+const (
+	TrashItemTypeRootShelf TrashItemType = "ROOT_SHELF"
+	TrashItemTypeSubShelf  TrashItemType = "SUB_SHELF"
+	TrashItemTypeMaterial  TrashItemType = "MATERIAL"
+	TrashItemTypeBlockPack TrashItemType = "BLOCK_PACK"
+)
+
+var AllTrashItemType = []TrashItemType{
+	TrashItemTypeRootShelf,
+	TrashItemTypeSubShelf,
+	TrashItemTypeMaterial,
+	TrashItemTypeBlockPack,
+}
+
+func (e TrashItemType) IsValid() bool {
+	switch e {
+	case TrashItemTypeRootShelf, TrashItemTypeSubShelf, TrashItemTypeMaterial, TrashItemTypeBlockPack:
+		return true
+	}
+	return false
+}
+
+func (e TrashItemType) String() string {
+	return string(e)
+}
+
+func (e *TrashItemType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TrashItemType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TrashItemType", str)
+	}
+	return nil
+}
+
+func (e TrashItemType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TrashItemType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TrashItemType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type UsageLimitKey string
+
+const (
+	UsageLimitKeyRootShelf           UsageLimitKey = "ROOT_SHELF"
+	UsageLimitKeyBlockPack           UsageLimitKey = "BLOCK_PACK"
+	UsageLimitKeyBlock               UsageLimitKey = "BLOCK"
+	UsageLimitKeyMaterial            UsageLimitKey = "MATERIAL"
+	UsageLimitKeyWorkflow            UsageLimitKey = "WORKFLOW"
+	UsageLimitKeyAdditionalItem      UsageLimitKey = "ADDITIONAL_ITEM"
+	UsageLimitKeyStation             UsageLimitKey = "STATION"
+	UsageLimitKeyRoutineTag          UsageLimitKey = "ROUTINE_TAG"
+	UsageLimitKeyRoutineTaskCostUnit UsageLimitKey = "ROUTINE_TASK_COST_UNIT"
+)
+
+var AllUsageLimitKey = []UsageLimitKey{
+	UsageLimitKeyRootShelf,
+	UsageLimitKeyBlockPack,
+	UsageLimitKeyBlock,
+	UsageLimitKeyMaterial,
+	UsageLimitKeyWorkflow,
+	UsageLimitKeyAdditionalItem,
+	UsageLimitKeyStation,
+	UsageLimitKeyRoutineTag,
+	UsageLimitKeyRoutineTaskCostUnit,
+}
+
+func (e UsageLimitKey) IsValid() bool {
+	switch e {
+	case UsageLimitKeyRootShelf, UsageLimitKeyBlockPack, UsageLimitKeyBlock, UsageLimitKeyMaterial, UsageLimitKeyWorkflow, UsageLimitKeyAdditionalItem, UsageLimitKeyStation, UsageLimitKeyRoutineTag, UsageLimitKeyRoutineTaskCostUnit:
+		return true
+	}
+	return false
+}
+
+func (e UsageLimitKey) String() string {
+	return string(e)
+}
+
+func (e *UsageLimitKey) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = UsageLimitKey(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid UsageLimitKey", str)
+	}
+	return nil
+}
+
+func (e UsageLimitKey) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *UsageLimitKey) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e UsageLimitKey) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -17,5 +17,6 @@ type CreatableRoutineTask struct {
 	Priority        int32                           `json:"priority" validate:"omitempty,min=0,max=100"`
 	MaxAttempts     int32                           `json:"maxAttempts" validate:"omitempty,min=1,max=20"`
 	Period          *enumcontract.RoutinePeriod     `json:"period" validate:"omitnil,isroutineperiod"`
+	RecurrenceRule  *string                         `json:"recurrenceRule" validate:"omitnil,excluded_with=Period,max=512,isrecurrencerule"`
 	NextScheduledAt time.Time                       `json:"nextScheduledAt" validate:"required"`
 }
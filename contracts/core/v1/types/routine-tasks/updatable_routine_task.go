@@ -19,6 +19,7 @@ type UpdatableRoutineTask struct {
 		Priority        *int32                           `json:"priority" validate:"omitnil,min=0,max=100"`
 		MaxAttempts     *int32                           `json:"maxAttempts" validate:"omitnil,min=1,max=20"`
 		Period          *enumcontract.RoutinePeriod      `json:"period" validate:"omitnil,isroutineperiod"`
+		RecurrenceRule  *string                          `json:"recurrenceRule" validate:"omitnil,excluded_with=Period,max=512,isrecurrencerule"`
 		NextScheduledAt *time.Time                       `json:"nextScheduledAt" validate:"omitnil"`
 	} `json:"values"`
 	SetNull *map[string]bool `json:"setNull,omitempty"`
@@ -19,6 +19,7 @@ type UpdatableRoutine struct {
 		ScheduledStartAt *time.Time                  `json:"scheduledStartAt" validate:"omitnil"`
 		ScheduledEndAt   *time.Time                  `json:"scheduledEndAt" validate:"omitnil"`
 		Period           *enumcontract.RoutinePeriod `json:"period" validate:"omitnil,isroutineperiod"`
+		RecurrenceRule   *string                     `json:"recurrenceRule" validate:"omitnil,excluded_with=Period,max=512,isrecurrencerule"`
 		Timezone         *string                     `json:"timezone" validate:"omitnil,max=64,istimezone"`
 	} `json:"values"`
 	SetNull *map[string]bool `json:"setNull,omitempty"`
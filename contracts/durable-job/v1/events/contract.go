@@ -12,18 +12,26 @@ const (
 	DurableJobCoreYjsMaintenanceResultTopic  eventcontract.Topic = "notegic.core.durablejob.yjs-maintenance-result.v1"
 )
 
+const (
+	DurableJobCoreMaterialProcessingRequestTopic eventcontract.Topic = "notegic.durablejob.core.material-processing-request.v1"
+	DurableJobCoreMaterialProcessingResultTopic  eventcontract.Topic = "notegic.core.durablejob.material-processing-result.v1"
+)
+
 const (
 	AggregateType_BlockPack        eventcontract.AggregateType = "BlockPack"
 	AggregateType_DurableJobWorker eventcontract.AggregateType = "DurableJobWorker"
+	AggregateType_Material         eventcontract.AggregateType = "Material"
 	AggregateType_RoutineTask      eventcontract.AggregateType = "RoutineTask"
 )
 
 const (
-	EventType_RoutineTaskClaimRequested eventcontract.EventType = "RoutineTaskClaimRequested"
-	EventType_RoutineTasksAssigned      eventcontract.EventType = "RoutineTasksAssigned"
-	EventType_RoutineTasksCompleted     eventcontract.EventType = "RoutineTasksCompleted"
-	EventType_RoutineTasksFailed        eventcontract.EventType = "RoutineTasksFailed"
-	EventType_RoutineTaskRunning        eventcontract.EventType = "RoutineTaskRunning"
-	EventType_YjsMaintenanceRequested   eventcontract.EventType = "YjsMaintenanceRequested"
-	EventType_YjsMaintenanceCompleted   eventcontract.EventType = "YjsMaintenanceCompleted"
+	EventType_RoutineTaskClaimRequested   eventcontract.EventType = "RoutineTaskClaimRequested"
+	EventType_RoutineTasksAssigned        eventcontract.EventType = "RoutineTasksAssigned"
+	EventType_RoutineTasksCompleted       eventcontract.EventType = "RoutineTasksCompleted"
+	EventType_RoutineTasksFailed          eventcontract.EventType = "RoutineTasksFailed"
+	EventType_RoutineTaskRunning          eventcontract.EventType = "RoutineTaskRunning"
+	EventType_YjsMaintenanceRequested     eventcontract.EventType = "YjsMaintenanceRequested"
+	EventType_YjsMaintenanceCompleted     eventcontract.EventType = "YjsMaintenanceCompleted"
+	EventType_MaterialProcessingRequested eventcontract.EventType = "MaterialProcessingRequested"
+	EventType_MaterialProcessingCompleted eventcontract.EventType = "MaterialProcessingCompleted"
 )
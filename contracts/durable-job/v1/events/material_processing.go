@@ -0,0 +1,22 @@
+package durablejobeventscontract
+
+import (
+	"github.com/google/uuid"
+
+	enumcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
+)
+
+type MaterialProcessingRequestData struct {
+	RequestId     uuid.UUID `json:"requestId"`
+	MaterialId    uuid.UUID `json:"materialId"`
+	Attempt       int       `json:"attempt"`
+	CorrelationId string    `json:"correlationId"`
+}
+
+type MaterialProcessingResultData struct {
+	RequestId  uuid.UUID                             `json:"requestId"`
+	MaterialId uuid.UUID                             `json:"materialId"`
+	Success    bool                                  `json:"success"`
+	Status     enumcontract.MaterialProcessingStatus `json:"status"`
+	Error      string                                `json:"error,omitempty"`
+}
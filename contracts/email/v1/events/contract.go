@@ -3,8 +3,9 @@ package emaileventscontract
 import eventcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/events"
 
 const (
-	CoreEmailRequestTopic  eventcontract.Topic = "notegic.core.email.request.v1"
-	CoreEmailConsumerGroup                     = "notegic-email-core-v1"
+	CoreEmailRequestTopic         eventcontract.Topic = "notegic.core.email.request.v1"
+	NotificationEmailRequestTopic eventcontract.Topic = "notegic.notification.email.request.v1"
+	CoreEmailConsumerGroup                            = "notegic-email-core-v1"
 )
 
 const (
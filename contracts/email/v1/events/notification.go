@@ -0,0 +1,26 @@
+package emaileventscontract
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SendNotificationEmailRequestDto struct {
+	RequestId     uuid.UUID               `json:"requestId"`
+	Operation     string                  `json:"operation"`
+	OccurredAt    time.Time               `json:"occurredAt"`
+	To            string                  `json:"to" validate:"required,email"`
+	UserName      string                  `json:"userName" validate:"required"`
+	Digest        bool                    `json:"digest"`
+	Notifications []NotificationEmailItem `json:"notifications" validate:"required,min=1,max=50,dive"`
+}
+
+type NotificationEmailItem struct {
+	Type      string    `json:"type" validate:"required"`
+	Priority  string    `json:"priority" validate:"required"`
+	Title     string    `json:"title" validate:"required,max=200"`
+	Message   string    `json:"message" validate:"required,max=10000"`
+	ActionUrl string    `json:"actionUrl,omitempty" validate:"omitempty,url"`
+	CreatedAt time.Time `json:"createdAt" validate:"required"`
+}
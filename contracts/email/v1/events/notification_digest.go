@@ -0,0 +1,41 @@
+package emaileventscontract
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	NotificationDigestFrequency_Daily  = "daily"
+	NotificationDigestFrequency_Weekly = "weekly"
+)
+
+// SendNotificationDigestEmailRequestDto summarises the unread notifications and the failed routine tasks of one period,
+// the counts cover the whole period while the items are capped, and the unsubscribe URL accepts the one-click POST
+// of RFC 8058 with the unsubscribe token already in its query
+type SendNotificationDigestEmailRequestDto struct {
+	RequestId           uuid.UUID                     `json:"requestId"`
+	Operation           string                        `json:"operation"`
+	OccurredAt          time.Time                     `json:"occurredAt"`
+	To                  string                        `json:"to" validate:"required,email"`
+	UserName            string                        `json:"userName" validate:"required"`
+	Frequency           string                        `json:"frequency" validate:"required,oneof=daily weekly"`
+	PeriodStartedAt     time.Time                     `json:"periodStartedAt" validate:"required"`
+	PeriodEndedAt       time.Time                     `json:"periodEndedAt" validate:"required,gtfield=PeriodStartedAt"`
+	Timezone            string                        `json:"timezone" validate:"required"`
+	UnreadCount         int64                         `json:"unreadCount" validate:"gte=0"`
+	Notifications       []NotificationEmailItem       `json:"notifications" validate:"max=20,dive"`
+	FailureCount        int64                         `json:"failureCount" validate:"gte=0"`
+	RoutineTaskFailures []RoutineTaskFailureEmailItem `json:"routineTaskFailures" validate:"max=20,dive"`
+	UnsubscribeToken    string                        `json:"unsubscribeToken" validate:"required"`
+	UnsubscribeUrl      string                        `json:"unsubscribeUrl" validate:"required,url"`
+}
+
+type RoutineTaskFailureEmailItem struct {
+	RoutineTitle     string    `json:"routineTitle" validate:"required,max=128"`
+	RoutineTaskTitle string    `json:"routineTaskTitle" validate:"required,max=128"`
+	Purpose          string    `json:"purpose" validate:"required"`
+	ErrorCode        string    `json:"errorCode" validate:"required"`
+	FailedAt         time.Time `json:"failedAt" validate:"required"`
+}
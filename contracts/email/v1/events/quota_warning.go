@@ -0,0 +1,20 @@
+package emaileventscontract
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SendQuotaWarningEmailRequestDto struct {
+	RequestId  uuid.UUID  `json:"requestId"`
+	Operation  string     `json:"operation"`
+	OccurredAt time.Time  `json:"occurredAt"`
+	To         string     `json:"to" validate:"required,email"`
+	UserName   string     `json:"userName" validate:"required"`
+	LimitName  string     `json:"limitName" validate:"required"`
+	Threshold  int32      `json:"threshold" validate:"required,min=1,max=100"`
+	Used       int64      `json:"used" validate:"min=0"`
+	Maximum    int64      `json:"maximum" validate:"required,min=1"`
+	ResetAt    *time.Time `json:"resetAt,omitempty"`
+}
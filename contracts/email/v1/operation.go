@@ -1,7 +1,10 @@
 package emailcontract
 
 const (
-	SendWelcomeEmailOperation       = "email.send-welcome"
-	SendValidationEmailOperation    = "email.send-validation"
-	SendSecurityAlertEmailOperation = "email.send-security-alert"
+	SendWelcomeEmailOperation            = "email.send-welcome"
+	SendValidationEmailOperation         = "email.send-validation"
+	SendSecurityAlertEmailOperation      = "email.send-security-alert"
+	SendQuotaWarningEmailOperation       = "email.send-quota-warning"
+	SendNotificationEmailOperation       = "email.send-notification"
+	SendNotificationDigestEmailOperation = "email.send-notification-digest"
 )
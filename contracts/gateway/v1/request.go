@@ -15,9 +15,10 @@ type Request[D any] struct {
 // Tokens carries authentication credentials across the Gateway/Core boundary.
 // Gateway owns cookie extraction; Core only receives this typed value.
 type Tokens struct {
-	AccessToken  string `json:"accessToken,omitempty"`
-	RefreshToken string `json:"refreshToken,omitempty"`
-	CSRFToken    string `json:"csrfToken,omitempty"`
+	AccessToken       string `json:"accessToken,omitempty"`
+	RefreshToken      string `json:"refreshToken,omitempty"`
+	CSRFToken         string `json:"csrfToken,omitempty"`
+	ShareSessionToken string `json:"shareSessionToken,omitempty"`
 }
 
 func (r *Request[D]) GetVersion() string {
@@ -0,0 +1,79 @@
+package notificationscontract
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	GetMyNotificationPreferencesOperation    = "GetMyNotificationPreferences"
+	UpdateMyNotificationPreferencesOperation = "UpdateMyNotificationPreferences"
+	UnsubscribeMyNotificationDigestOperation = "UnsubscribeMyNotificationDigest"
+)
+
+const (
+	NotificationChannel_InApp   = "inApp"
+	NotificationChannel_Email   = "email"
+	NotificationChannel_WebPush = "webPush"
+)
+
+// NotificationPreferenceDto overrides the channels of one notification type, or of one template key of the type
+// when the template key is given, where the template key override wins over the type override
+type NotificationPreferenceDto struct {
+	Type        string `json:"type" validate:"required,isnotificationtype"`
+	TemplateKey string `json:"templateKey,omitempty" validate:"omitempty,max=128"`
+	InApp       bool   `json:"inApp"`
+	Email       bool   `json:"email"`
+	WebPush     bool   `json:"webPush"`
+	EmailDigest bool   `json:"emailDigest"`
+}
+
+const (
+	NotificationDigestFrequency_Off    = "off"
+	NotificationDigestFrequency_Daily  = "daily"
+	NotificationDigestFrequency_Weekly = "weekly"
+)
+
+// NotificationDigestSettingDto schedules the email digest of the unread notifications and the failed routine tasks,
+// the hour is the local hour in the timezone of the recipient, and the weekday (0 is Sunday) only applies to the
+// weekly digest
+type NotificationDigestSettingDto struct {
+	Frequency string `json:"frequency" validate:"required,oneof=off daily weekly"`
+	Hour      int    `json:"hour" validate:"min=0,max=23"`
+	Weekday   int    `json:"weekday" validate:"min=0,max=6"`
+}
+
+type GetNotificationPreferencesRequestDto struct {
+	RecipientUserPublicId uuid.UUID `json:"recipientUserPublicId" validate:"required"`
+}
+
+type GetNotificationPreferencesResponseDto struct {
+	Timezone     string                       `json:"timezone"`
+	Preferences  []NotificationPreferenceDto  `json:"preferences"`
+	Defaults     []NotificationPreferenceDto  `json:"defaults"`
+	Digest       NotificationDigestSettingDto `json:"digest"`
+	NextDigestAt *time.Time                   `json:"nextDigestAt"`
+}
+
+// UpdateNotificationPreferencesRequestDto replaces every override of the recipient, an omitted timezone or digest
+// keeps the current one
+type UpdateNotificationPreferencesRequestDto struct {
+	RecipientUserPublicId uuid.UUID                     `json:"recipientUserPublicId" validate:"required"`
+	Timezone              *string                       `json:"timezone,omitempty" validate:"omitempty,istimezone"`
+	Preferences           []NotificationPreferenceDto   `json:"preferences" validate:"omitempty,max=64,dive"`
+	Digest                *NotificationDigestSettingDto `json:"digest,omitempty" validate:"omitempty"`
+}
+
+type UpdateNotificationPreferencesResponseDto = GetNotificationPreferencesResponseDto
+
+// UnsubscribeNotificationDigestRequestDto turns the email digest of the recipient off, it is delegated by the
+// one-click unsubscribe link of a digest email, so it carries nothing but the recipient
+type UnsubscribeNotificationDigestRequestDto struct {
+	RecipientUserPublicId uuid.UUID `json:"recipientUserPublicId" validate:"required"`
+}
+
+type UnsubscribeNotificationDigestResponseDto struct {
+	Digest         NotificationDigestSettingDto `json:"digest"`
+	UnsubscribedAt time.Time                    `json:"unsubscribedAt"`
+}
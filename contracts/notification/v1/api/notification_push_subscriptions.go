@@ -0,0 +1,70 @@
+package notificationscontract
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	GetMyPushPublicKeyOperation           = "GetMyNotificationPushPublicKey"
+	RegisterMyPushSubscriptionOperation   = "RegisterMyNotificationPushSubscription"
+	UnregisterMyPushSubscriptionOperation = "UnregisterMyNotificationPushSubscription"
+)
+
+// PushSubscriptionKeysDto are the keys of a browser push subscription, both of them are base64url encoded
+// without padding, exactly as PushSubscription.toJSON() returns them
+type PushSubscriptionKeysDto struct {
+	P256dh string `json:"p256dh" validate:"required,base64rawurl,max=128"`
+	Auth   string `json:"auth" validate:"required,base64rawurl,max=64"`
+}
+
+type GetPushPublicKeyRequestDto struct {
+	RecipientUserPublicId uuid.UUID `json:"recipientUserPublicId" validate:"required"`
+}
+
+// GetPushPublicKeyResponseDto carries the VAPID public key, which the browser needs as the applicationServerKey
+// to subscribe
+type GetPushPublicKeyResponseDto struct {
+	PublicKey string `json:"publicKey"`
+}
+
+// RegisterPushSubscriptionRequestDto stores the subscription of one browser, registering the same endpoint again
+// refreshes its keys, and the expiration time is the epoch milliseconds given by the browser
+type RegisterPushSubscriptionRequestDto struct {
+	RecipientUserPublicId uuid.UUID               `json:"recipientUserPublicId" validate:"required"`
+	Endpoint              string                  `json:"endpoint" validate:"required,url,startswith=https://,max=2048"`
+	ExpirationTime        *int64                  `json:"expirationTime,omitempty" validate:"omitempty,gt=0"`
+	Keys                  PushSubscriptionKeysDto `json:"keys" validate:"required"`
+}
+
+type PushSubscriptionDto struct {
+	Id        uuid.UUID  `json:"id"`
+	Endpoint  string     `json:"endpoint"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+}
+
+type RegisterPushSubscriptionResponseDto = PushSubscriptionDto
+
+type UnregisterPushSubscriptionRequestDto struct {
+	RecipientUserPublicId uuid.UUID `json:"recipientUserPublicId" validate:"required"`
+	Endpoint              string    `json:"endpoint" validate:"required,url,max=2048"`
+}
+
+type UnregisterPushSubscriptionResponseDto struct {
+	DeletedCount int64 `json:"deletedCount"`
+}
+
+// PushNotificationMessageDto is the decrypted payload of a web push message, the tag stays the same when a delivery
+// is retried, so the service worker replaces a duplicate system notification instead of showing it twice
+type PushNotificationMessageDto struct {
+	Tag       string    `json:"tag"`
+	Type      string    `json:"type"`
+	Priority  string    `json:"priority"`
+	Title     string    `json:"title"`
+	Body      string    `json:"body"`
+	Url       string    `json:"url,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
@@ -380,7 +380,7 @@ func postmanEnvironment() map[string]any {
 		map[string]any{"key": "userAgent", "value": "Postman/Notegic-v1", "enabled": true},
 		map[string]any{"key": "apiKey", "value": "", "enabled": true, "type": "secret"},
 	}
-	for _, name := range []string{"id", "userPublicId", "stationId", "routineId", "routineTagId", "routineTaskId", "rootShelfId", "subShelfId", "prevSubShelfId", "parentSubShelfId", "materialId", "blockPackId", "blockId", "itemId"} {
+	for _, name := range []string{"id", "userPublicId", "stationId", "routineId", "routineTagId", "routineTaskId", "rootShelfId", "subShelfId", "prevSubShelfId", "parentSubShelfId", "materialId", "blockPackId", "blockId", "itemId", "archiveJobId", "snapshotId", "uploadId"} {
 		value := "00000000-0000-4000-8000-000000000001"
 		if name == "id" {
 			value = "1"
@@ -406,7 +406,7 @@ func curlExamples(endpoints []endpoint) string {
 	output.WriteString("api_gateway_base_url=\"${API_GATEWAY_BASE_URL:-http://localhost/api/development/v1}\"\napi_key=\"${API_KEY:-}\"\n")
 	output.WriteString("user_agent=\"${USER_AGENT:-NotegicCurlExample/1.0}\"\n")
 	output.WriteString("id=\"${AVATAR_ID:-1}\"\n")
-	for _, id := range []string{"userPublicId", "stationId", "routineId", "routineTagId", "routineTaskId", "rootShelfId", "subShelfId", "prevSubShelfId", "parentSubShelfId", "materialId", "blockPackId", "blockId", "itemId"} {
+	for _, id := range []string{"userPublicId", "stationId", "routineId", "routineTagId", "routineTaskId", "rootShelfId", "subShelfId", "prevSubShelfId", "parentSubShelfId", "materialId", "blockPackId", "blockId", "itemId", "archiveJobId", "snapshotId", "uploadId"} {
 		fmt.Fprintf(&output, "%s=\"${%s:-00000000-0000-4000-8000-000000000001}\"\n", id, strings.ToUpper(id))
 	}
 	output.WriteString("\n# Run individual functions deliberately. DELETE/reset functions are not invoked automatically.\n")
@@ -449,7 +449,7 @@ func httpExamples(endpoints []endpoint) string {
 	var output bytes.Buffer
 	output.WriteString("@apiGatewayBaseUrl = http://localhost/api/development/v1\n@apiKey = replace-with-your-api-key\n@userAgent = NotegicHttpFile/1.0\n")
 	output.WriteString("@id = 1\n")
-	for _, id := range []string{"userPublicId", "stationId", "routineId", "routineTagId", "routineTaskId", "rootShelfId", "subShelfId", "prevSubShelfId", "parentSubShelfId", "materialId", "blockPackId", "blockId", "itemId"} {
+	for _, id := range []string{"userPublicId", "stationId", "routineId", "routineTagId", "routineTaskId", "rootShelfId", "subShelfId", "prevSubShelfId", "parentSubShelfId", "materialId", "blockPackId", "blockId", "itemId", "archiveJobId", "snapshotId", "uploadId"} {
 		fmt.Fprintf(&output, "@%s = 00000000-0000-4000-8000-000000000001\n", id)
 	}
 	for _, route := range endpoints {
@@ -508,7 +508,7 @@ APIGateway v1 integrations authenticate with a user-owned `+"`X-API-Key`"+` head
 - The server persists only a SHA-256 digest and a short display prefix.
 - Keys can be expired or revoked; revoked keys fail immediately even if a cache entry exists.
 - Do not log request bodies containing credentials, `+"`X-API-Key`"+`, Cookie, Set-Cookie, or CSRF values.
-- Unauthorized rate limits are primarily keyed by client IP; API key ID is auxiliary only.`)
+- Unauthorized rate limits are keyed by client IP; each API key additionally has its own plan-derived allowance.`)
 	writeText(filepath.Join(base, "rules", "http-contract.md"), `# HTTP contract rules
 
 - Base path: `+"`/api/development/v1`"+` for the current Beta namespace.
@@ -527,17 +527,27 @@ APIGateway v1 integrations authenticate with a user-owned `+"`X-API-Key`"+` head
 The OpenAPI operation extensions `+"`x-go-request-dto`"+` and `+"`x-go-response-dto`"+` identify the source contracts used to generate each schema.`)
 	writeText(filepath.Join(base, "rules", "rate-limits-and-retries.md"), `# Rate limits and retry rules
 
-APIGateway emits these headers on rate-limited routes:
+APIGateway applies two limits to every resource route.
 
-- `+"`X-RateLimit-Limit`"+`: request allowance for the active window.
-- `+"`X-RateLimit-Remaining`"+`: remaining allowance.
-- `+"`X-RateLimit-Reset`"+`: Unix timestamp for the next reset estimate.
-- `+"`X-RateLimit-Window`"+`: configured window duration.
-- `+"`X-RateLimit-Policy`"+`: currently `+"`hybrid-token-bucket`"+`.
+The client IP limit emits these headers:
 
-Current APIGateway v1 routes use an IP/fingerprint limit of 1,000 requests per minute with a 100 requests/second token bucket and burst 10. An authenticated-user limiter exists internally but is not a documented allowance for these routes. These are service limits, not permanent entitlements, and may be lowered during Beta.
+- `+"`"+`X-RateLimit-Limit`+"`"+`: request allowance for the active window.
+- `+"`"+`X-RateLimit-Remaining`+"`"+`: remaining allowance.
+- `+"`"+`X-RateLimit-Reset`+"`"+`: Unix timestamp for the next reset estimate.
+- `+"`"+`X-RateLimit-Window`+"`"+`: configured window duration.
+- `+"`"+`X-RateLimit-Policy`+"`"+`: currently `+"`"+`hybrid-token-bucket`+"`"+`.
 
-On HTTP 429, wait until the reset time and add randomized backoff. Retry only idempotent reads or writes carrying an application-level idempotency guarantee. The current public mutations do not generally expose an idempotency key, so a client must reconcile state before retrying a timed-out mutation.`)
+The API key limit emits the standard `+"`"+`RateLimit-*`+"`"+` headers:
+
+- `+"`"+`RateLimit-Limit`+"`"+`: allowance of the most restrictive window.
+- `+"`"+`RateLimit-Remaining`+"`"+`: remaining allowance of that window.
+- `+"`"+`RateLimit-Reset`+"`"+`: seconds until that window resets.
+- `+"`"+`RateLimit-Policy`+"`"+`: every window of the key, e.g. `+"`"+`20;w=10, 1000;w=3600`+"`"+`.
+- `+"`"+`Retry-After`+"`"+`: seconds to wait, sent only with HTTP 429.
+
+Current APIGateway v1 routes use an IP/fingerprint limit of 1,000 requests per minute with a 100 requests/second token bucket and burst 10. Each API key also has a burst allowance per 10 seconds and a sustained allowance per hour, derived from the plan of its owner; a key that has not been used recently gets the Free allowance (20 and 1,000) on its first request. `+"`"+`GET /api-keys/me/rate-limit`+"`"+` returns the current consumption of the calling key without consuming it. These are service limits, not permanent entitlements, and may be lowered during Beta.
+
+On HTTP 429, wait for `+"`"+`Retry-After`+"`"+` or until the reset time and add randomized backoff. Retry only idempotent reads or writes carrying an application-level idempotency guarantee. The current public mutations do not generally expose an idempotency key, so a client must reconcile state before retrying a timed-out mutation.`)
 	writeText(filepath.Join(base, "rules", "origins-and-security.md"), `# Origin and security rules
 
 - Send the API key in `+"`X-API-Key`"+`; never put it in a URL, query string, or request body.
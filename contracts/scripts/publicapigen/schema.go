@@ -170,6 +170,8 @@ func applyValidation(schema map[string]any, validation string) {
 			schema["pattern"] = `^(?=.*[a-z])(?=.*[A-Z])(?=.*\d)(?=.*[^\w\s]).+$`
 		case "notfuture":
 			schema["description"] = "Must not be later than the current server time."
+		case "isrecurrencerule":
+			schema["description"] = "RFC 5545 RRULE value such as FREQ=WEEKLY;BYDAY=MO,WE,FR;BYHOUR=9;BYMINUTE=0, which cannot be set together with period."
 		case "oneof":
 			if len(parts) == 2 {
 				schema["enum"] = strings.Fields(parts[1])
@@ -259,6 +261,8 @@ func schemaExample(schema map[string]any, fieldName string) any {
 			return "Example-Password-123!"
 		case strings.Contains(name, "authcode"):
 			return "123456"
+		case strings.Contains(name, "recurrencerule"):
+			return "FREQ=WEEKLY;BYDAY=MO,WE,FR;BYHOUR=9;BYMINUTE=0"
 		case strings.HasSuffix(name, "id"):
 			return "00000000-0000-4000-8000-000000000001"
 		case strings.Contains(name, "url") || strings.Contains(name, "endpoint"):
@@ -0,0 +1,8 @@
+package enums
+
+type BillingInvoiceStatus string
+
+const (
+	BillingInvoiceStatus_Completed BillingInvoiceStatus = "COMPLETED"
+	BillingInvoiceStatus_Refunded  BillingInvoiceStatus = "REFUNDED"
+)
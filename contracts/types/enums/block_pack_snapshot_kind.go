@@ -0,0 +1,9 @@
+package enums
+
+type BlockPackSnapshotKind string
+
+const (
+	BlockPackSnapshotKind_Named      BlockPackSnapshotKind = "Named"
+	BlockPackSnapshotKind_Periodic   BlockPackSnapshotKind = "Periodic"
+	BlockPackSnapshotKind_PreRestore BlockPackSnapshotKind = "PreRestore"
+)
@@ -0,0 +1,12 @@
+package enums
+
+type MaterialProcessingStatus string
+
+const (
+	MaterialProcessingStatus_None       MaterialProcessingStatus = "None"
+	MaterialProcessingStatus_Pending    MaterialProcessingStatus = "Pending"
+	MaterialProcessingStatus_Processing MaterialProcessingStatus = "Processing"
+	MaterialProcessingStatus_Completed  MaterialProcessingStatus = "Completed"
+	MaterialProcessingStatus_Skipped    MaterialProcessingStatus = "Skipped"
+	MaterialProcessingStatus_Failed     MaterialProcessingStatus = "Failed"
+)
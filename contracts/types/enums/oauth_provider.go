@@ -0,0 +1,10 @@
+package enums
+
+type OAuthProvider string
+
+const (
+	OAuthProvider_Google OAuthProvider = "Google"
+	OAuthProvider_Github OAuthProvider = "GitHub"
+	OAuthProvider_Meta   OAuthProvider = "Meta"
+	OAuthProvider_OIDC   OAuthProvider = "OIDC"
+)
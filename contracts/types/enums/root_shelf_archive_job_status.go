@@ -0,0 +1,10 @@
+package enums
+
+type RootShelfArchiveJobStatus string
+
+const (
+	RootShelfArchiveJobStatus_Pending   RootShelfArchiveJobStatus = "Pending"
+	RootShelfArchiveJobStatus_Running   RootShelfArchiveJobStatus = "Running"
+	RootShelfArchiveJobStatus_Succeeded RootShelfArchiveJobStatus = "Succeeded"
+	RootShelfArchiveJobStatus_Failed    RootShelfArchiveJobStatus = "Failed"
+)
@@ -0,0 +1,8 @@
+package enums
+
+type RootShelfArchiveJobType string
+
+const (
+	RootShelfArchiveJobType_Export RootShelfArchiveJobType = "Export"
+	RootShelfArchiveJobType_Import RootShelfArchiveJobType = "Import"
+)
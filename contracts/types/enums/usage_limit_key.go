@@ -0,0 +1,15 @@
+package enums
+
+type UsageLimitKey string
+
+const (
+	UsageLimitKey_RootShelf           UsageLimitKey = "ROOT_SHELF"
+	UsageLimitKey_BlockPack           UsageLimitKey = "BLOCK_PACK"
+	UsageLimitKey_Block               UsageLimitKey = "BLOCK"
+	UsageLimitKey_Material            UsageLimitKey = "MATERIAL"
+	UsageLimitKey_Workflow            UsageLimitKey = "WORKFLOW"
+	UsageLimitKey_AdditionalItem      UsageLimitKey = "ADDITIONAL_ITEM"
+	UsageLimitKey_Station             UsageLimitKey = "STATION"
+	UsageLimitKey_RoutineTag          UsageLimitKey = "ROUTINE_TAG"
+	UsageLimitKey_RoutineTaskCostUnit UsageLimitKey = "ROUTINE_TASK_COST_UNIT"
+)
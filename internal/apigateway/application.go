@@ -15,8 +15,8 @@ import (
 	platformredis "github.com/HiIamJeff67/notegic-backend/shared/platform/redis"
 
 	gatewayconfig "github.com/HiIamJeff67/notegic-backend/internal/apigateway/configs"
+	apikeyratelimitpolicy "github.com/HiIamJeff67/notegic-backend/internal/apigateway/data/cache/apikeyratelimitpolicy"
 	ratelimitrecord "github.com/HiIamJeff67/notegic-backend/internal/apigateway/data/cache/ratelimitrecord"
-	ratelimit "github.com/HiIamJeff67/notegic-backend/internal/apigateway/ratelimit"
 	ratelimitmiddlewares "github.com/HiIamJeff67/notegic-backend/internal/apigateway/transports/api/middlewares"
 	developmentroutes "github.com/HiIamJeff67/notegic-backend/internal/apigateway/transports/api/routes/developmentroutes"
 	coreadapters "github.com/HiIamJeff67/notegic-backend/internal/apigateway/transports/core/adapters"
@@ -35,9 +35,9 @@ type ApplicationInterface interface {
 	loadConfig() gatewayconfig.Config
 	loadRedisConfig() platformredis.Config
 	initializeObservability() func()
-	initializeRateLimiter(gatewayconfig.Config, *platformredis.ClientSet, func()) *ratelimit.HybridRateLimiter
-	buildRouter(gatewayconfig.Config, *ratelimit.HybridRateLimiter, *platformredis.ClientSet, func()) *gin.Engine
-	startHTTP(gatewayconfig.Config, *gin.Engine, *ratelimit.HybridRateLimiter, *platformredis.ClientSet, func()) func()
+	initializeRateLimiters(gatewayconfig.Config, *platformredis.ClientSet, func()) developmentroutes.RateLimiters
+	buildRouter(gatewayconfig.Config, developmentroutes.RateLimiters, *platformredis.ClientSet, func()) *gin.Engine
+	startHTTP(gatewayconfig.Config, *gin.Engine, developmentroutes.RateLimiters, *platformredis.ClientSet, func()) func()
 }
 
 func NewApplication() *Application {
@@ -76,11 +76,11 @@ func (a *Application) initializeObservability() func() {
 
 }
 
-func (a *Application) initializeRateLimiter(
+func (a *Application) initializeRateLimiters(
 	config gatewayconfig.Config,
 	redisClientSet *platformredis.ClientSet,
 	shutdownObservability func(),
-) *ratelimit.HybridRateLimiter {
+) developmentroutes.RateLimiters {
 	rateLimitRecordCacheStore := ratelimitrecord.Register(context.Background(), redisClientSet)
 	if err := rateLimitRecordCacheStore.Initialize(context.Background()); err != nil {
 		_ = redisClientSet.Close()
@@ -90,22 +90,36 @@ func (a *Application) initializeRateLimiter(
 	rateLimitRecordCacheClient := ratelimitrecord.NewRateLimitRecordCacheClient(rateLimitRecordCacheStore)
 	unauthorizedRateLimitConfig := gatewayconfig.DefaultUnauthorizedRateLimitConfig()
 	unauthorizedRateLimitConfig.CacheClient = rateLimitRecordCacheClient
-	return ratelimitmiddlewares.InitUnauthorizedRateLimiter(unauthorizedRateLimitConfig)
+
+	apiKeyRateLimitPolicyCacheStore := apikeyratelimitpolicy.Register(context.Background(), redisClientSet)
+	if err := apiKeyRateLimitPolicyCacheStore.Initialize(context.Background()); err != nil {
+		_ = redisClientSet.Close()
+		shutdownObservability()
+		panic(err)
+	}
+	apiKeyRateLimitConfig := gatewayconfig.DefaultAPIKeyRateLimitConfig()
+	apiKeyRateLimitConfig.RecordCacheClient = rateLimitRecordCacheClient
+	apiKeyRateLimitConfig.PolicyCacheClient = apikeyratelimitpolicy.NewAPIKeyRateLimitPolicyCacheClient(apiKeyRateLimitPolicyCacheStore)
+
+	return developmentroutes.RateLimiters{
+		Unauthorized: ratelimitmiddlewares.InitUnauthorizedRateLimiter(unauthorizedRateLimitConfig),
+		APIKey:       ratelimitmiddlewares.InitAPIKeyRateLimiter(apiKeyRateLimitConfig),
+	}
 }
 
 func (a *Application) buildRouter(
 	config gatewayconfig.Config,
-	unauthorizedRateLimiter *ratelimit.HybridRateLimiter,
+	rateLimiters developmentroutes.RateLimiters,
 	redisClientSet *platformredis.ClientSet,
 	shutdownObservability func(),
 ) *gin.Engine {
 	router := developmentroutes.NewRouter(developmentroutes.APIRouteDependencies{
 		CoreAdapter:    coreadapters.NewCoreAdapter(config.CoreBaseUrl, config.CoreAdapterTimeout),
 		AllowedDomains: config.AllowedDomains,
-		RateLimiters:   developmentroutes.RateLimiters{Unauthorized: unauthorizedRateLimiter},
+		RateLimiters:   rateLimiters,
 	})
 	if err := router.SetTrustedProxies(config.TrustedProxies); err != nil {
-		unauthorizedRateLimiter.Stop()
+		rateLimiters.Unauthorized.Stop()
 		_ = redisClientSet.Close()
 		shutdownObservability()
 		panic(err)
@@ -118,13 +132,13 @@ func (a *Application) buildRouter(
 func (a *Application) startHTTP(
 	config gatewayconfig.Config,
 	router *gin.Engine,
-	unauthorizedRateLimiter *ratelimit.HybridRateLimiter,
+	rateLimiters developmentroutes.RateLimiters,
 	redisClientSet *platformredis.ClientSet,
 	shutdownObservability func(),
 ) func() {
 	listener, err := net.Listen("tcp", config.ListenAddress)
 	if err != nil {
-		unauthorizedRateLimiter.Stop()
+		rateLimiters.Unauthorized.Stop()
 		_ = redisClientSet.Close()
 		shutdownObservability()
 		panic(err)
@@ -150,7 +164,7 @@ func (a *Application) startHTTP(
 		if err := server.Shutdown(shutdownCtx); err != nil {
 			fmt.Println("Failed to shutdown Gateway server: ", err)
 		}
-		unauthorizedRateLimiter.Stop()
+		rateLimiters.Unauthorized.Stop()
 		if err := redisClientSet.Close(); err != nil {
 			fmt.Println("Failed to disconnect Gateway cache servers: ", err)
 		}
@@ -167,9 +181,9 @@ func (a *Application) Start() func() {
 		shutdownObservability()
 		panic(err)
 	}
-	unauthorizedRateLimiter := a.initializeRateLimiter(config, redisClientSet, shutdownObservability)
-	router := a.buildRouter(config, unauthorizedRateLimiter, redisClientSet, shutdownObservability)
-	return a.startHTTP(config, router, unauthorizedRateLimiter, redisClientSet, shutdownObservability)
+	rateLimiters := a.initializeRateLimiters(config, redisClientSet, shutdownObservability)
+	router := a.buildRouter(config, rateLimiters, redisClientSet, shutdownObservability)
+	return a.startHTTP(config, router, rateLimiters, redisClientSet, shutdownObservability)
 }
 
 // make sure Application struct followed the ApplicationInterface implementations
@@ -6,7 +6,9 @@ import (
 	rate "golang.org/x/time/rate"
 
 	platformredis "github.com/HiIamJeff67/notegic-backend/shared/platform/redis"
+	sharedtokens "github.com/HiIamJeff67/notegic-backend/shared/tokens"
 
+	apikeyratelimitpolicy "github.com/HiIamJeff67/notegic-backend/internal/apigateway/data/cache/apikeyratelimitpolicy"
 	ratelimitrecord "github.com/HiIamJeff67/notegic-backend/internal/apigateway/data/cache/ratelimitrecord"
 )
 
@@ -37,3 +39,17 @@ func DefaultUnauthorizedRateLimitConfig() RateLimitConfig {
 		MinSynchronizationInterval:           time.Second,
 	}
 }
+
+type APIKeyRateLimitConfig struct {
+	DefaultPolicy     sharedtokens.APIKeyRateLimitPolicy
+	BackendServerName platformredis.BackendServerName
+	RecordCacheClient *ratelimitrecord.RateLimitRecordCacheClient
+	PolicyCacheClient *apikeyratelimitpolicy.APIKeyRateLimitPolicyCacheClient
+}
+
+func DefaultAPIKeyRateLimitConfig() APIKeyRateLimitConfig {
+	return APIKeyRateLimitConfig{
+		DefaultPolicy:     sharedtokens.DefaultAPIKeyRateLimitPolicy(),
+		BackendServerName: platformredis.BackendServerName_EastAsia,
+	}
+}
@@ -0,0 +1,103 @@
+package apikeyratelimitpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-redis/redis"
+
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+	logs "github.com/HiIamJeff67/notegic-backend/shared/platform/observability/logs"
+	platformredis "github.com/HiIamJeff67/notegic-backend/shared/platform/redis"
+	sharedtokens "github.com/HiIamJeff67/notegic-backend/shared/tokens"
+)
+
+// APIKeyRateLimitPolicyCacheClient reads the policies Core publishes when it
+// authenticates an API key. APIGateway never writes them.
+type APIKeyRateLimitPolicyCacheClient struct {
+	cacheStore *APIKeyRateLimitPolicyCacheStore
+}
+
+/* ============================== Constructor ============================== */
+
+func NewAPIKeyRateLimitPolicyCacheClient(cacheStore *APIKeyRateLimitPolicyCacheStore) *APIKeyRateLimitPolicyCacheClient {
+	return &APIKeyRateLimitPolicyCacheClient{
+		cacheStore: cacheStore,
+	}
+}
+
+/* ============================== Auxiliary Methods ============================== */
+
+func (s *APIKeyRateLimitPolicyCacheClient) getRedisClient(identifier string) (*redis.Client, int, *exceptions.Exception) {
+	if s == nil || s.cacheStore == nil || s.cacheStore.ClientSet() == nil {
+		return nil, 0, exceptions.New(
+			"CacheClientUnavailable",
+			"Cache",
+			"GetRedisClient",
+			"API key rate limit policy cache client is unavailable",
+			http.StatusInternalServerError,
+			true,
+		)
+	}
+
+	redisClient, shardIndex, err := s.cacheStore.ClientSet().ClientForKey(identifier)
+	if err != nil {
+		return nil, 0, exceptions.New(
+			"CacheClientUnavailable",
+			"Cache",
+			"GetRedisClient",
+			"API key rate limit policy cache client is unavailable",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(err)
+	}
+
+	return redisClient, shardIndex, nil
+}
+
+func (s *APIKeyRateLimitPolicyCacheClient) formatAPIKeyRateLimitPolicyCacheKey(keyHash string) string {
+	return fmt.Sprintf("%s:%s", platformredis.CachePurpose_APIKeyRateLimitPolicy.String(), keyHash)
+}
+
+/* ============================== CRUD Method ============================== */
+
+// Get returns nil without an exception if Core has not published a policy for
+// the key yet.
+func (s *APIKeyRateLimitPolicyCacheClient) Get(keyHash string) (*sharedtokens.APIKeyRateLimitPolicy, *exceptions.Exception) {
+	redisClient, shardIndex, exception := s.getRedisClient(keyHash)
+	if exception != nil {
+		return nil, exception
+	}
+
+	cacheString, err := redisClient.Get(s.formatAPIKeyRateLimitPolicyCacheKey(keyHash)).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, exceptions.New(
+			"NotFound",
+			"Cache",
+			"GetAPIKeyRateLimitPolicy",
+			"API key rate limit policy was not found",
+			http.StatusNotFound,
+			true,
+		).WithOrigin(err)
+	}
+
+	var policy sharedtokens.APIKeyRateLimitPolicy
+	if err := json.Unmarshal([]byte(cacheString), &policy); err != nil {
+		return nil, exceptions.New(
+			"DeserializationFailed",
+			"Cache",
+			"GetAPIKeyRateLimitPolicy",
+			"Failed to decode API key rate limit policy",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(err)
+	}
+
+	logs.NotegicLogger.Debug(context.Background(), fmt.Sprintf("Successfully got API key rate limit policy from Redis shard %d", shardIndex))
+	return &policy, nil
+}
@@ -0,0 +1,37 @@
+package apikeyratelimitpolicy
+
+import (
+	"context"
+
+	platformredis "github.com/HiIamJeff67/notegic-backend/shared/platform/redis"
+)
+
+type APIKeyRateLimitPolicyCacheStore struct {
+	clientSet *platformredis.ClientSet
+}
+
+func NewAPIKeyRateLimitPolicyCacheStore(
+	clientSet *platformredis.ClientSet,
+) *APIKeyRateLimitPolicyCacheStore {
+	return &APIKeyRateLimitPolicyCacheStore{
+		clientSet: clientSet,
+	}
+}
+
+func Register(
+	_ context.Context,
+	clientSet *platformredis.ClientSet,
+) *APIKeyRateLimitPolicyCacheStore {
+	return NewAPIKeyRateLimitPolicyCacheStore(clientSet)
+}
+
+func (s *APIKeyRateLimitPolicyCacheStore) Initialize(_ context.Context) error {
+	return nil
+}
+
+func (s *APIKeyRateLimitPolicyCacheStore) ClientSet() *platformredis.ClientSet {
+	if s == nil {
+		return nil
+	}
+	return s.clientSet
+}
@@ -251,6 +251,77 @@ func (s *RateLimitRecordCacheClient) Delete(
 	return nil
 }
 
+/* ============================== Window Counter Method ============================== */
+
+// window counters are plain Redis integers keyed by the start of a fixed
+// window, so several gateway instances can share them with INCRBY
+func (s *RateLimitRecordCacheClient) formatRateLimitWindowCounterKey(
+	backendServerName platformredis.BackendServerName,
+	identifier string,
+	windowStartTime time.Time,
+) string {
+	return fmt.Sprintf("%s:%d", s.formatRateLimitRecordKey(backendServerName, identifier), windowStartTime.Unix())
+}
+
+func (s *RateLimitRecordCacheClient) IncreaseWindowCounter(
+	identifier string,
+	backendServerName platformredis.BackendServerName,
+	windowStartTime time.Time,
+	windowDuration time.Duration,
+	numOfChangingTokens int32,
+) (int32, *exceptions.Exception) {
+	redisClient, shardIndex, exception := s.getRedisClient(backendServerName)
+	if exception != nil {
+		return 0, exception
+	}
+
+	key := s.formatRateLimitWindowCounterKey(backendServerName, identifier, windowStartTime)
+	pipeline := redisClient.TxPipeline()
+	increaseCommand := pipeline.IncrBy(key, int64(numOfChangingTokens))
+	pipeline.Expire(key, s.calculateExpiration(identifier, windowStartTime, windowDuration))
+	if _, err := pipeline.Exec(); err != nil {
+		return 0, exceptions.New(
+			"FailedToUpdate",
+			"Cache",
+			"IncreaseRateLimitWindowCounter",
+			"Failed to increase the rate limit window counter",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(err)
+	}
+
+	logs.NotegicLogger.Debug(context.Background(), fmt.Sprintf("Successfully increased rate limit window counter in Redis shard %d", shardIndex))
+	return int32(increaseCommand.Val()), nil
+}
+
+func (s *RateLimitRecordCacheClient) GetWindowCounter(
+	identifier string,
+	backendServerName platformredis.BackendServerName,
+	windowStartTime time.Time,
+) (int32, *exceptions.Exception) {
+	redisClient, _, exception := s.getRedisClient(backendServerName)
+	if exception != nil {
+		return 0, exception
+	}
+
+	count, err := redisClient.Get(s.formatRateLimitWindowCounterKey(backendServerName, identifier, windowStartTime)).Int64()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, exceptions.New(
+			"NotFound",
+			"Cache",
+			"GetRateLimitWindowCounter",
+			"Rate limit window counter was not found",
+			http.StatusNotFound,
+			true,
+		).WithOrigin(err)
+	}
+
+	return int32(count), nil
+}
+
 /* ============================== Batch Method ============================== */
 
 func (s *RateLimitRecordCacheClient) BatchSynchronize(
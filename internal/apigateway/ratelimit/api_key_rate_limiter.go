@@ -0,0 +1,194 @@
+package ratelimiter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+	logs "github.com/HiIamJeff67/notegic-backend/shared/platform/observability/logs"
+	platformredis "github.com/HiIamJeff67/notegic-backend/shared/platform/redis"
+	sharedtokens "github.com/HiIamJeff67/notegic-backend/shared/tokens"
+
+	gatewayconfig "github.com/HiIamJeff67/notegic-backend/internal/apigateway/configs"
+	apikeyratelimitpolicy "github.com/HiIamJeff67/notegic-backend/internal/apigateway/data/cache/apikeyratelimitpolicy"
+	ratelimitrecord "github.com/HiIamJeff67/notegic-backend/internal/apigateway/data/cache/ratelimitrecord"
+)
+
+const (
+	APIKeyRateLimitWindowName_Burst     = "burst"
+	APIKeyRateLimitWindowName_Sustained = "sustained"
+)
+
+type APIKeyRateLimitWindow struct {
+	Name          string    `json:"name"`
+	Limit         int32     `json:"limit"`
+	Used          int32     `json:"used"`
+	Remaining     int32     `json:"remaining"`
+	WindowSeconds int64     `json:"windowSeconds"`
+	ResetAt       time.Time `json:"resetAt"`
+}
+
+type APIKeyRateLimitStatus struct {
+	Allowed bool                    `json:"-"`
+	Plan    string                  `json:"plan"`
+	Windows []APIKeyRateLimitWindow `json:"windows"`
+}
+
+// APIKeyRateLimiter budgets each API key by the burst and sustained windows of
+// the policy Core published for it. Unlike HybridRateLimiter there is no local
+// token bucket: both windows are fixed-window counters shared through Redis, so
+// every gateway instance sees the same consumption of a key.
+type APIKeyRateLimiter struct {
+	DefaultPolicy     sharedtokens.APIKeyRateLimitPolicy
+	BackendServerName platformredis.BackendServerName
+
+	recordCacheClient *ratelimitrecord.RateLimitRecordCacheClient
+	policyCacheClient *apikeyratelimitpolicy.APIKeyRateLimitPolicyCacheClient
+}
+
+func NewAPIKeyRateLimiter(config gatewayconfig.APIKeyRateLimitConfig) *APIKeyRateLimiter {
+	defaultPolicy := config.DefaultPolicy
+	if !defaultPolicy.IsValid() {
+		defaultPolicy = sharedtokens.DefaultAPIKeyRateLimitPolicy()
+	}
+
+	return &APIKeyRateLimiter{
+		DefaultPolicy:     defaultPolicy,
+		BackendServerName: config.BackendServerName,
+		recordCacheClient: config.RecordCacheClient,
+		policyCacheClient: config.PolicyCacheClient,
+	}
+}
+
+func (l *APIKeyRateLimiter) getPolicy(keyHash string) sharedtokens.APIKeyRateLimitPolicy {
+	if l.policyCacheClient == nil {
+		return l.DefaultPolicy
+	}
+
+	policy, exception := l.policyCacheClient.Get(keyHash)
+	if exception != nil || policy == nil || !policy.IsValid() {
+		return l.DefaultPolicy
+	}
+
+	return *policy
+}
+
+func (l *APIKeyRateLimiter) formatIdentifier(keyHash string, windowName string) string {
+	return fmt.Sprintf("api-key:%s:%s", keyHash, windowName)
+}
+
+// countOtherBackendServers sums what the other backend servers recorded for the
+// same window, in the same way HybridRateLimiter sums its records.
+func (l *APIKeyRateLimiter) countOtherBackendServers(identifier string, windowStartTime time.Time) int32 {
+	var count int32 = 0
+	for _, backendServerName := range platformredis.AllBackendServerNames {
+		if backendServerName == l.BackendServerName {
+			continue
+		}
+		otherCount, exception := l.recordCacheClient.GetWindowCounter(identifier, backendServerName, windowStartTime)
+		if exception != nil {
+			continue
+		}
+		count += otherCount
+	}
+
+	return count
+}
+
+func (l *APIKeyRateLimiter) countWindow(
+	keyHash string,
+	windowName string,
+	windowDuration time.Duration,
+	now time.Time,
+	n int32,
+) (time.Time, int32) {
+	windowStartTime := now.Truncate(windowDuration)
+	if l.recordCacheClient == nil {
+		return windowStartTime, 0
+	}
+
+	identifier := l.formatIdentifier(keyHash, windowName)
+	var used int32
+	var exception *exceptions.Exception
+	if n > 0 {
+		used, exception = l.recordCacheClient.IncreaseWindowCounter(identifier, l.BackendServerName, windowStartTime, windowDuration, n)
+	} else {
+		used, exception = l.recordCacheClient.GetWindowCounter(identifier, l.BackendServerName, windowStartTime)
+	}
+	if exception != nil {
+		// fail open like HybridRateLimiter does when Redis is unavailable
+		logs.NotegicLogger.Warn(context.Background(), fmt.Sprintf("Failed to count API key rate limit window %s: %v", windowName, exception))
+		return windowStartTime, 0
+	}
+
+	return windowStartTime, used + l.countOtherBackendServers(identifier, windowStartTime)
+}
+
+func (l *APIKeyRateLimiter) rollbackWindow(keyHash string, windowName string, windowStartTime time.Time, windowDuration time.Duration, n int32) {
+	if l.recordCacheClient == nil {
+		return
+	}
+	_, _ = l.recordCacheClient.IncreaseWindowCounter(l.formatIdentifier(keyHash, windowName), l.BackendServerName, windowStartTime, windowDuration, -n)
+}
+
+func newAPIKeyRateLimitWindow(name string, limit int32, windowDuration time.Duration, windowStartTime time.Time, used int32) APIKeyRateLimitWindow {
+	return APIKeyRateLimitWindow{
+		Name:          name,
+		Limit:         limit,
+		Used:          used,
+		Remaining:     max(0, limit-used),
+		WindowSeconds: int64(windowDuration / time.Second),
+		ResetAt:       windowStartTime.Add(windowDuration),
+	}
+}
+
+func (l *APIKeyRateLimiter) AllowN(keyHash string, now time.Time, n int32) APIKeyRateLimitStatus {
+	policy := l.getPolicy(keyHash)
+	burstStartTime, burstUsed := l.countWindow(keyHash, APIKeyRateLimitWindowName_Burst, policy.BurstWindow, now, n)
+	sustainedStartTime, sustainedUsed := l.countWindow(keyHash, APIKeyRateLimitWindowName_Sustained, policy.SustainedWindow, now, n)
+
+	allowed := burstUsed <= policy.BurstLimit && sustainedUsed <= policy.SustainedLimit
+	if !allowed {
+		// rejected requests do not consume the budget, so a client that keeps
+		// retrying is unblocked as soon as the window resets
+		l.rollbackWindow(keyHash, APIKeyRateLimitWindowName_Burst, burstStartTime, policy.BurstWindow, n)
+		l.rollbackWindow(keyHash, APIKeyRateLimitWindowName_Sustained, sustainedStartTime, policy.SustainedWindow, n)
+		logs.NotegicLogger.Debug(context.Background(), fmt.Sprintf("Request blocked by API key rate limiter for plan: %s, requested: %d", policy.Plan, n))
+	}
+
+	return APIKeyRateLimitStatus{
+		Allowed: allowed,
+		Plan:    policy.Plan,
+		Windows: []APIKeyRateLimitWindow{
+			newAPIKeyRateLimitWindow(APIKeyRateLimitWindowName_Burst, policy.BurstLimit, policy.BurstWindow, burstStartTime, burstUsed),
+			newAPIKeyRateLimitWindow(APIKeyRateLimitWindowName_Sustained, policy.SustainedLimit, policy.SustainedWindow, sustainedStartTime, sustainedUsed),
+		},
+	}
+}
+
+func (l *APIKeyRateLimiter) Allow(keyHash string) APIKeyRateLimitStatus {
+	return l.AllowN(keyHash, time.Now(), 1)
+}
+
+// GetStatus reports the current consumption of the key without consuming it.
+func (l *APIKeyRateLimiter) GetStatus(keyHash string) APIKeyRateLimitStatus {
+	status := l.AllowN(keyHash, time.Now(), 0)
+	status.Allowed = true
+	return status
+}
+
+// MostRestrictiveWindow is the window reported through the RateLimit headers:
+// the one with the least remaining budget, or the one resetting later on a tie.
+func (s APIKeyRateLimitStatus) MostRestrictiveWindow() APIKeyRateLimitWindow {
+	var mostRestrictive APIKeyRateLimitWindow
+	for index, window := range s.Windows {
+		if index == 0 ||
+			window.Remaining < mostRestrictive.Remaining ||
+			(window.Remaining == mostRestrictive.Remaining && window.ResetAt.After(mostRestrictive.ResetAt)) {
+			mostRestrictive = window
+		}
+	}
+
+	return mostRestrictive
+}
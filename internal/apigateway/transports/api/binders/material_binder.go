@@ -30,6 +30,10 @@ type MaterialBinderInterface interface {
 	BindRestoreMyMaterialsByIds(controllerFunc controllers.Func[*apicontract.RestoreMyMaterialsByIdsRequestDto]) gin.HandlerFunc
 	BindDeleteMyMaterialById(controllerFunc controllers.Func[*apicontract.DeleteMyMaterialByIdRequestDto]) gin.HandlerFunc
 	BindDeleteMyMaterialsByIds(controllerFunc controllers.Func[*apicontract.DeleteMyMaterialsByIdsRequestDto]) gin.HandlerFunc
+	BindInitiateMyMaterialUpload(controllerFunc controllers.Func[*apicontract.InitiateMyMaterialUploadRequestDto]) gin.HandlerFunc
+	BindUploadMyMaterialPart(controllerFunc controllers.Func[*apicontract.UploadMyMaterialPartRequestDto]) gin.HandlerFunc
+	BindCompleteMyMaterialUpload(controllerFunc controllers.Func[*apicontract.CompleteMyMaterialUploadRequestDto]) gin.HandlerFunc
+	BindAbortMyMaterialUpload(controllerFunc controllers.Func[*apicontract.AbortMyMaterialUploadRequestDto]) gin.HandlerFunc
 }
 
 type MaterialBinder struct{}
@@ -319,3 +323,103 @@ func (b *MaterialBinder) BindDeleteMyMaterialsByIds(controllerFunc controllers.F
 		controllerFunc(ctx, &requestDto)
 	}
 }
+
+func (b *MaterialBinder) BindInitiateMyMaterialUpload(controllerFunc controllers.Func[*apicontract.InitiateMyMaterialUploadRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var requestDto apicontract.InitiateMyMaterialUploadRequestDto
+
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+
+		if err := ctx.ShouldBindJSON(&requestDto.Body); err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("MaterialUpload").WithOrigin(err), ctx)
+			return
+		}
+
+		value, err := uuid.Parse(ctx.Param("material-id"))
+		if err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidInput("Material").WithOrigin(err), ctx)
+			return
+		}
+		requestDto.Param.MaterialId = value
+
+		controllerFunc(ctx, &requestDto)
+	}
+}
+
+func (b *MaterialBinder) BindUploadMyMaterialPart(controllerFunc controllers.Func[*apicontract.UploadMyMaterialPartRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var requestDto apicontract.UploadMyMaterialPartRequestDto
+
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+
+		content, err := io.ReadAll(ctx.Request.Body)
+		if err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("MaterialUpload").WithOrigin(err), ctx)
+			return
+		}
+		requestDto.Body.Content = content
+
+		requestDto.Param.StorageUploadId = ctx.Param("storage-upload-id")
+		partNumber, err := strconv.ParseInt(ctx.Param("part-number"), 10, 32)
+		if err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidInput("MaterialUpload").WithOrigin(err), ctx)
+			return
+		}
+		requestDto.Param.PartNumber = int32(partNumber)
+
+		controllerFunc(ctx, &requestDto)
+	}
+}
+
+func (b *MaterialBinder) BindCompleteMyMaterialUpload(controllerFunc controllers.Func[*apicontract.CompleteMyMaterialUploadRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var requestDto apicontract.CompleteMyMaterialUploadRequestDto
+
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+
+		if err := ctx.ShouldBindJSON(&requestDto.Body); err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("MaterialUpload").WithOrigin(err), ctx)
+			return
+		}
+
+		value, err := uuid.Parse(ctx.Param("material-id"))
+		if err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidInput("Material").WithOrigin(err), ctx)
+			return
+		}
+		requestDto.Param.MaterialId = value
+
+		value, err = uuid.Parse(ctx.Param("upload-id"))
+		if err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidInput("MaterialUpload").WithOrigin(err), ctx)
+			return
+		}
+		requestDto.Param.UploadId = value
+
+		controllerFunc(ctx, &requestDto)
+	}
+}
+
+func (b *MaterialBinder) BindAbortMyMaterialUpload(controllerFunc controllers.Func[*apicontract.AbortMyMaterialUploadRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var requestDto apicontract.AbortMyMaterialUploadRequestDto
+
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+
+		value, err := uuid.Parse(ctx.Param("material-id"))
+		if err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidInput("Material").WithOrigin(err), ctx)
+			return
+		}
+		requestDto.Param.MaterialId = value
+
+		value, err = uuid.Parse(ctx.Param("upload-id"))
+		if err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidInput("MaterialUpload").WithOrigin(err), ctx)
+			return
+		}
+		requestDto.Param.UploadId = value
+
+		controllerFunc(ctx, &requestDto)
+	}
+}
@@ -0,0 +1,54 @@
+package controllers
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+
+	exceptionwriter "github.com/HiIamJeff67/notegic-backend/shared/util/exceptionwriter"
+
+	sharedtokens "github.com/HiIamJeff67/notegic-backend/shared/tokens"
+
+	ratelimit "github.com/HiIamJeff67/notegic-backend/internal/apigateway/ratelimit"
+	middlewares "github.com/HiIamJeff67/notegic-backend/internal/apigateway/transports/api/middlewares"
+)
+
+type APIKeyControllerInterface interface {
+	GetMyAPIKeyRateLimit(ctx *gin.Context)
+}
+
+// APIKeyController serves what APIGateway itself knows about the calling key,
+// so unlike the resource controllers it never calls Core.
+type APIKeyController struct {
+	apiKeyRateLimiter *ratelimit.APIKeyRateLimiter
+}
+
+func NewAPIKeyController(apiKeyRateLimiter *ratelimit.APIKeyRateLimiter) APIKeyControllerInterface {
+	return &APIKeyController{
+		apiKeyRateLimiter: apiKeyRateLimiter,
+	}
+}
+
+func (c *APIKeyController) GetMyAPIKeyRateLimit(ctx *gin.Context) {
+	if c.apiKeyRateLimiter == nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.New(
+			"RateLimiterRequired",
+			"Gateway",
+			"GetMyAPIKeyRateLimit",
+			"The API key rate limiter is not configured",
+			http.StatusInternalServerError,
+			true,
+		), ctx)
+		return
+	}
+
+	keyHash := sharedtokens.HashAPIKey(strings.TrimSpace(ctx.GetHeader("X-API-Key")))
+	status := c.apiKeyRateLimiter.GetStatus(keyHash)
+	middlewares.SetAPIKeyRateLimitHeaders(ctx, status, time.Now())
+
+	writeClientResponse(ctx, status)
+}
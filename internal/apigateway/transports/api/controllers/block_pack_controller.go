@@ -15,8 +15,10 @@ type BlockPackControllerInterface interface {
 	GetMyBlockPackAndItsParentById(ctx *gin.Context, requestDto *apicontract.GetMyBlockPackAndItsParentByIdRequestDto)
 	GetMyBlockPacksByParentSubShelfId(ctx *gin.Context, requestDto *apicontract.GetMyBlockPacksByParentSubShelfIdRequestDto)
 	GetAllMyBlockPacksByRootShelfId(ctx *gin.Context, requestDto *apicontract.GetAllMyBlockPacksByRootShelfIdRequestDto)
+	GetMyBlockPackMarkdownById(ctx *gin.Context, requestDto *apicontract.GetMyBlockPackMarkdownByIdRequestDto)
 	CreateBlockPack(ctx *gin.Context, requestDto *apicontract.CreateBlockPackRequestDto)
 	CreateBlockPacks(ctx *gin.Context, requestDto *apicontract.CreateBlockPacksRequestDto)
+	CreateBlockPackFromMarkdown(ctx *gin.Context, requestDto *apicontract.CreateBlockPackFromMarkdownRequestDto)
 	UpdateMyBlockPackById(ctx *gin.Context, requestDto *apicontract.UpdateMyBlockPackByIdRequestDto)
 	UpdateMyBlockPacksByIds(ctx *gin.Context, requestDto *apicontract.UpdateMyBlockPacksByIdsRequestDto)
 	MoveMyBlockPackByParentSubShelfId(ctx *gin.Context, requestDto *apicontract.MoveMyBlockPackByParentSubShelfIdRequestDto)
@@ -26,6 +28,10 @@ type BlockPackControllerInterface interface {
 	RestoreMyBlockPacksByIds(ctx *gin.Context, requestDto *apicontract.RestoreMyBlockPacksByIdsRequestDto)
 	DeleteMyBlockPackById(ctx *gin.Context, requestDto *apicontract.DeleteMyBlockPackByIdRequestDto)
 	DeleteMyBlockPacksByIds(ctx *gin.Context, requestDto *apicontract.DeleteMyBlockPacksByIdsRequestDto)
+	CreateMyBlockPackSnapshotById(ctx *gin.Context, requestDto *apicontract.CreateMyBlockPackSnapshotByIdRequestDto)
+	GetMyBlockPackSnapshotsById(ctx *gin.Context, requestDto *apicontract.GetMyBlockPackSnapshotsByIdRequestDto)
+	GetMyBlockPackSnapshotDiffById(ctx *gin.Context, requestDto *apicontract.GetMyBlockPackSnapshotDiffByIdRequestDto)
+	RestoreMyBlockPackSnapshotById(ctx *gin.Context, requestDto *apicontract.RestoreMyBlockPackSnapshotByIdRequestDto)
 }
 
 type BlockPackController struct {
@@ -114,6 +120,25 @@ func (c *BlockPackController) GetAllMyBlockPacksByRootShelfId(ctx *gin.Context,
 	writeClientResponse(ctx, response.Data)
 }
 
+func (c *BlockPackController) GetMyBlockPackMarkdownById(ctx *gin.Context, requestDto *apicontract.GetMyBlockPackMarkdownByIdRequestDto) {
+	response, exception := coreadapters.CallSecurly[
+		apicontract.GetMyBlockPackMarkdownByIdRequestDto,
+		apicontract.GetMyBlockPackMarkdownByIdResponseDto,
+	](
+		ctx,
+		c.coreAdapter,
+		requestDto,
+		apicontract.GetMyBlockPackMarkdownByIdOperation,
+		"/core/v1/block-packs/get-markdown-by-id",
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+
+	writeClientResponse(ctx, response.Data)
+}
+
 func (c *BlockPackController) CreateBlockPack(ctx *gin.Context, requestDto *apicontract.CreateBlockPackRequestDto) {
 	response, exception := coreadapters.CallSecurly[
 		apicontract.CreateBlockPackRequestDto,
@@ -152,6 +177,25 @@ func (c *BlockPackController) CreateBlockPacks(ctx *gin.Context, requestDto *api
 	writeCreatedClientResponse(ctx, response.Data)
 }
 
+func (c *BlockPackController) CreateBlockPackFromMarkdown(ctx *gin.Context, requestDto *apicontract.CreateBlockPackFromMarkdownRequestDto) {
+	response, exception := coreadapters.CallSecurly[
+		apicontract.CreateBlockPackFromMarkdownRequestDto,
+		apicontract.CreateBlockPackFromMarkdownResponseDto,
+	](
+		ctx,
+		c.coreAdapter,
+		requestDto,
+		apicontract.CreateBlockPackFromMarkdownOperation,
+		"/core/v1/block-packs/create-from-markdown",
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+
+	writeCreatedClientResponse(ctx, response.Data)
+}
+
 func (c *BlockPackController) UpdateMyBlockPackById(ctx *gin.Context, requestDto *apicontract.UpdateMyBlockPackByIdRequestDto) {
 	response, exception := coreadapters.CallSecurly[
 		apicontract.UpdateMyBlockPackByIdRequestDto,
@@ -322,3 +366,79 @@ func (c *BlockPackController) DeleteMyBlockPacksByIds(ctx *gin.Context, requestD
 
 	writeClientResponse(ctx, response.Data)
 }
+
+func (c *BlockPackController) CreateMyBlockPackSnapshotById(ctx *gin.Context, requestDto *apicontract.CreateMyBlockPackSnapshotByIdRequestDto) {
+	response, exception := coreadapters.CallSecurly[
+		apicontract.CreateMyBlockPackSnapshotByIdRequestDto,
+		apicontract.CreateMyBlockPackSnapshotByIdResponseDto,
+	](
+		ctx,
+		c.coreAdapter,
+		requestDto,
+		apicontract.CreateMyBlockPackSnapshotByIdOperation,
+		"/core/v1/block-packs/create-snapshot-by-id",
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+
+	writeCreatedClientResponse(ctx, response.Data)
+}
+
+func (c *BlockPackController) GetMyBlockPackSnapshotsById(ctx *gin.Context, requestDto *apicontract.GetMyBlockPackSnapshotsByIdRequestDto) {
+	response, exception := coreadapters.CallSecurly[
+		apicontract.GetMyBlockPackSnapshotsByIdRequestDto,
+		apicontract.GetMyBlockPackSnapshotsByIdResponseDto,
+	](
+		ctx,
+		c.coreAdapter,
+		requestDto,
+		apicontract.GetMyBlockPackSnapshotsByIdOperation,
+		"/core/v1/block-packs/get-snapshots-by-id",
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+
+	writeClientResponse(ctx, response.Data)
+}
+
+func (c *BlockPackController) GetMyBlockPackSnapshotDiffById(ctx *gin.Context, requestDto *apicontract.GetMyBlockPackSnapshotDiffByIdRequestDto) {
+	response, exception := coreadapters.CallSecurly[
+		apicontract.GetMyBlockPackSnapshotDiffByIdRequestDto,
+		apicontract.GetMyBlockPackSnapshotDiffByIdResponseDto,
+	](
+		ctx,
+		c.coreAdapter,
+		requestDto,
+		apicontract.GetMyBlockPackSnapshotDiffByIdOperation,
+		"/core/v1/block-packs/get-snapshot-diff-by-id",
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+
+	writeClientResponse(ctx, response.Data)
+}
+
+func (c *BlockPackController) RestoreMyBlockPackSnapshotById(ctx *gin.Context, requestDto *apicontract.RestoreMyBlockPackSnapshotByIdRequestDto) {
+	response, exception := coreadapters.CallSecurly[
+		apicontract.RestoreMyBlockPackSnapshotByIdRequestDto,
+		apicontract.RestoreMyBlockPackSnapshotByIdResponseDto,
+	](
+		ctx,
+		c.coreAdapter,
+		requestDto,
+		apicontract.RestoreMyBlockPackSnapshotByIdOperation,
+		"/core/v1/block-packs/restore-snapshot-by-id",
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+
+	writeClientResponse(ctx, response.Data)
+}
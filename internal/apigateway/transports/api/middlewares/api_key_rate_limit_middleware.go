@@ -0,0 +1,86 @@
+package middlewares
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+
+	exceptionwriter "github.com/HiIamJeff67/notegic-backend/shared/util/exceptionwriter"
+
+	logs "github.com/HiIamJeff67/notegic-backend/shared/platform/observability/logs"
+	sharedtokens "github.com/HiIamJeff67/notegic-backend/shared/tokens"
+
+	gatewayconfig "github.com/HiIamJeff67/notegic-backend/internal/apigateway/configs"
+	ratelimit "github.com/HiIamJeff67/notegic-backend/internal/apigateway/ratelimit"
+)
+
+func InitAPIKeyRateLimiter(config gatewayconfig.APIKeyRateLimitConfig) *ratelimit.APIKeyRateLimiter {
+	limiter := ratelimit.NewAPIKeyRateLimiter(config)
+	logs.NotegicLogger.Info(context.Background(), fmt.Sprintf("API key rate limiter initialized with default plan: %s, burst: %d per %v, sustained: %d per %v", limiter.DefaultPolicy.Plan, limiter.DefaultPolicy.BurstLimit, limiter.DefaultPolicy.BurstWindow, limiter.DefaultPolicy.SustainedLimit, limiter.DefaultPolicy.SustainedWindow))
+	return limiter
+}
+
+// APIKeyRateLimitMiddleware must run after KeyMiddleware. The key is budgeted
+// by its hash, so the raw key never reaches Redis.
+func APIKeyRateLimitMiddleware(rateLimiter *ratelimit.APIKeyRateLimiter) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if rateLimiter == nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.New(
+				"RateLimiterRequired",
+				"Gateway",
+				"RateLimit",
+				"The API key rate limiter is not configured",
+				http.StatusInternalServerError,
+				true,
+			), ctx)
+			return
+		}
+
+		keyHash := sharedtokens.HashAPIKey(strings.TrimSpace(ctx.GetHeader("X-API-Key")))
+
+		status := rateLimiter.Allow(keyHash)
+		SetAPIKeyRateLimitHeaders(ctx, status, time.Now())
+		if !status.Allowed {
+			logs.NotegicLogger.Debug(ctx.Request.Context(), fmt.Sprintf("API key rate limit exceeded for plan: %s", status.Plan))
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.New(
+				"PermissionDeniedDueToTooManyRequests",
+				"Auth",
+				"Authorize",
+				"Too many requests with this API key; please wait before trying again",
+				http.StatusTooManyRequests,
+			), ctx, "server.responses.failed.rateLimit")
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
+// SetAPIKeyRateLimitHeaders writes the RateLimit header fields of the
+// httpapi-ratelimit-headers draft for the most restrictive window, and lists
+// every window of the policy in RateLimit-Policy.
+func SetAPIKeyRateLimitHeaders(ctx *gin.Context, status ratelimit.APIKeyRateLimitStatus, now time.Time) {
+	window := status.MostRestrictiveWindow()
+	resetIn := max(0, int64(window.ResetAt.Sub(now).Round(time.Second)/time.Second))
+
+	ctx.Header("RateLimit-Limit", strconv.Itoa(int(window.Limit)))
+	ctx.Header("RateLimit-Remaining", strconv.Itoa(int(window.Remaining)))
+	ctx.Header("RateLimit-Reset", strconv.FormatInt(resetIn, 10))
+
+	policies := make([]string, 0, len(status.Windows))
+	for _, policyWindow := range status.Windows {
+		policies = append(policies, fmt.Sprintf("%d;w=%d", policyWindow.Limit, policyWindow.WindowSeconds))
+	}
+	ctx.Header("RateLimit-Policy", strings.Join(policies, ", "))
+
+	if !status.Allowed {
+		ctx.Header("Retry-After", strconv.FormatInt(max(1, resetIn), 10))
+	}
+}
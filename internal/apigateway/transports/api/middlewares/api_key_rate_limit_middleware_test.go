@@ -0,0 +1,70 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	sharedtokens "github.com/HiIamJeff67/notegic-backend/shared/tokens"
+
+	gatewayconfig "github.com/HiIamJeff67/notegic-backend/internal/apigateway/configs"
+	ratelimit "github.com/HiIamJeff67/notegic-backend/internal/apigateway/ratelimit"
+)
+
+func TestAPIKeyRateLimitMiddlewareWritesDefaultPolicyHeaders(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	limiter := ratelimit.NewAPIKeyRateLimiter(gatewayconfig.DefaultAPIKeyRateLimitConfig())
+	router.GET("/", APIKeyRateLimitMiddleware(limiter), func(ctx *gin.Context) { ctx.Status(http.StatusNoContent) })
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	request.Header.Set("X-API-Key", "nzy_12345678901234567890123456789012")
+	router.ServeHTTP(response, request)
+	if response.Code != http.StatusNoContent {
+		t.Fatalf("expected the request to pass without Redis, got %d", response.Code)
+	}
+
+	policy := sharedtokens.DefaultAPIKeyRateLimitPolicy()
+	if got := response.Header().Get("RateLimit-Policy"); got != "20;w=10, 1000;w=3600" {
+		t.Fatalf("unexpected RateLimit-Policy for plan %s: %q", policy.Plan, got)
+	}
+	if got := response.Header().Get("RateLimit-Limit"); got != "20" {
+		t.Fatalf("expected the burst window to be the most restrictive, got limit %q", got)
+	}
+	if response.Header().Get("Retry-After") != "" {
+		t.Fatal("expected no Retry-After on an allowed request")
+	}
+}
+
+func TestSetAPIKeyRateLimitHeadersReportsTheExhaustedWindow(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	now := time.Unix(1_800_000_000, 0)
+	status := ratelimit.APIKeyRateLimitStatus{
+		Allowed: false,
+		Plan:    "Pro",
+		Windows: []ratelimit.APIKeyRateLimitWindow{
+			{Name: "burst", Limit: 50, Used: 12, Remaining: 38, WindowSeconds: 10, ResetAt: now.Add(4 * time.Second)},
+			{Name: "sustained", Limit: 5000, Used: 5000, Remaining: 0, WindowSeconds: 3600, ResetAt: now.Add(25 * time.Minute)},
+		},
+	}
+
+	response := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(response)
+	SetAPIKeyRateLimitHeaders(ctx, status, now)
+
+	for header, expected := range map[string]string{
+		"RateLimit-Limit":     "5000",
+		"RateLimit-Remaining": "0",
+		"RateLimit-Reset":     "1500",
+		"RateLimit-Policy":    "50;w=10, 5000;w=3600",
+		"Retry-After":         "1500",
+	} {
+		if got := response.Header().Get(header); got != expected {
+			t.Fatalf("expected %s to be %q, got %q", header, expected, got)
+		}
+	}
+}
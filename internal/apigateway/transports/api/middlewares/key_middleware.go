@@ -15,7 +15,9 @@ import (
 
 // KeyMiddleware is the APIGateway edge check. It intentionally verifies only
 // presence and format; ownership and revocation are authoritative in Core's
-// APIKeyMiddleware after the delegation credential is verified.
+// APIKeyMiddleware after the delegation credential is verified, and so are the
+// key's scopes and root shelf / station restrictions, which Core re-checks
+// against the scope carried by the delegation token of each call.
 func KeyMiddleware() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		if ctx.Request.Method == http.MethodOptions {
@@ -0,0 +1,50 @@
+package developmentroutes
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	controllers "github.com/HiIamJeff67/notegic-backend/internal/apigateway/transports/api/controllers"
+	interceptors "github.com/HiIamJeff67/notegic-backend/internal/apigateway/transports/api/interceptors"
+	middlewares "github.com/HiIamJeff67/notegic-backend/internal/apigateway/transports/api/middlewares"
+)
+
+type APIKeyRouteDependencies struct {
+	RateLimiters RateLimiters
+}
+
+func configureDevelopmentAPIKeyRoutes(
+	router *gin.RouterGroup,
+	deps APIKeyRouteDependencies,
+) {
+	rateLimiters := deps.RateLimiters
+	if router == nil {
+		router = DevelopmentAPIRouterGroup
+	}
+
+	apiKeyController := controllers.NewAPIKeyController(rateLimiters.APIKey)
+
+	apiKeyRoutes := router.Group("/api-keys")
+	// reading the consumption of a key must not consume its own budget
+	defaultMiddlewares := []gin.HandlerFunc{
+		middlewares.UnauthorizedRateLimitMiddleware(rateLimiters.Unauthorized),
+		middlewares.TimeoutMiddleware(3 * time.Second),
+		interceptors.ShareableResponseWriterInterceptor(
+			interceptors.EmbeddedInterceptor,
+		),
+	}
+	{
+		apiKeyRoutes.GET(
+			"/me/rate-limit",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("getMyAPIKeyRateLimit"),
+					middlewares.ApplyMeterMiddleware("server.requests.apiKey.getMyAPIKeyRateLimit"),
+				},
+				defaultMiddlewares,
+				apiKeyController.GetMyAPIKeyRateLimit,
+			)...,
+		)
+	}
+}
@@ -34,6 +34,7 @@ func configureDevelopmentBlockPackRoutes(
 	blockPackRoutes := router.Group("/block-packs")
 	defaultMiddlewares := []gin.HandlerFunc{
 		middlewares.UnauthorizedRateLimitMiddleware(rateLimiters.Unauthorized),
+		middlewares.APIKeyRateLimitMiddleware(rateLimiters.APIKey),
 		middlewares.TimeoutMiddleware(3 * time.Second),
 		interceptors.ShareableResponseWriterInterceptor(
 			interceptors.EmbeddedInterceptor,
@@ -88,6 +89,18 @@ func configureDevelopmentBlockPackRoutes(
 				blockPackBinder.BindGetAllMyBlockPacksByRootShelfId(blockPackController.GetAllMyBlockPacksByRootShelfId),
 			)...,
 		)
+		blockPackRoutes.GET(
+			"/:block-pack-id/markdown",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("getMyBlockPackMarkdownById"),
+					middlewares.ApplyMeterMiddleware("server.requests.blockPack.getMyBlockPackMarkdownById"),
+				},
+				defaultMiddlewares,
+				middlewares.AllowedPermissionsAbove(enumcontract.AccessControlPermission_Read),
+				blockPackBinder.BindGetMyBlockPackMarkdownById(blockPackController.GetMyBlockPackMarkdownById),
+			)...,
+		)
 		blockPackRoutes.POST(
 			"/sub-shelf/:parent-sub-shelf-id",
 			middlewares.Reposition(
@@ -116,6 +129,20 @@ func configureDevelopmentBlockPackRoutes(
 				blockPackBinder.BindCreateBlockPacks(blockPackController.CreateBlockPacks),
 			)...,
 		)
+		blockPackRoutes.POST(
+			"/sub-shelf/:parent-sub-shelf-id/markdown",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("createBlockPackFromMarkdown"),
+					middlewares.ApplyMeterMiddleware("server.requests.blockPack.createBlockPackFromMarkdown"),
+				},
+				append(
+					defaultMiddlewares,
+					middlewares.AllowedPermissionsAbove(enumcontract.AccessControlPermission_Write),
+				),
+				blockPackBinder.BindCreateBlockPackFromMarkdown(blockPackController.CreateBlockPackFromMarkdown),
+			)...,
+		)
 		blockPackRoutes.PUT(
 			"/:block-pack-id",
 			middlewares.Reposition(
@@ -242,5 +269,57 @@ func configureDevelopmentBlockPackRoutes(
 				blockPackBinder.BindDeleteMyBlockPacksByIds(blockPackController.DeleteMyBlockPacksByIds),
 			)...,
 		)
+		blockPackRoutes.GET(
+			"/:block-pack-id/snapshots",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("getMyBlockPackSnapshotsById"),
+					middlewares.ApplyMeterMiddleware("server.requests.blockPack.getMyBlockPackSnapshotsById"),
+				},
+				defaultMiddlewares,
+				middlewares.AllowedPermissionsAbove(enumcontract.AccessControlPermission_Read),
+				blockPackBinder.BindGetMyBlockPackSnapshotsById(blockPackController.GetMyBlockPackSnapshotsById),
+			)...,
+		)
+		blockPackRoutes.GET(
+			"/:block-pack-id/snapshots/diff",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("getMyBlockPackSnapshotDiffById"),
+					middlewares.ApplyMeterMiddleware("server.requests.blockPack.getMyBlockPackSnapshotDiffById"),
+				},
+				defaultMiddlewares,
+				middlewares.AllowedPermissionsAbove(enumcontract.AccessControlPermission_Read),
+				blockPackBinder.BindGetMyBlockPackSnapshotDiffById(blockPackController.GetMyBlockPackSnapshotDiffById),
+			)...,
+		)
+		blockPackRoutes.POST(
+			"/:block-pack-id/snapshots",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("createMyBlockPackSnapshotById"),
+					middlewares.ApplyMeterMiddleware("server.requests.blockPack.createMyBlockPackSnapshotById"),
+				},
+				append(
+					defaultMiddlewares,
+					middlewares.AllowedPermissionsAbove(enumcontract.AccessControlPermission_Write),
+				),
+				blockPackBinder.BindCreateMyBlockPackSnapshotById(blockPackController.CreateMyBlockPackSnapshotById),
+			)...,
+		)
+		blockPackRoutes.PATCH(
+			"/:block-pack-id/snapshots/:snapshot-id/restore",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("restoreMyBlockPackSnapshotById"),
+					middlewares.ApplyMeterMiddleware("server.requests.blockPack.restoreMyBlockPackSnapshotById"),
+				},
+				append(
+					defaultMiddlewares,
+					middlewares.AllowedPermissionsAbove(enumcontract.AccessControlPermission_Write),
+				),
+				blockPackBinder.BindRestoreMyBlockPackSnapshotById(blockPackController.RestoreMyBlockPackSnapshotById),
+			)...,
+		)
 	}
 }
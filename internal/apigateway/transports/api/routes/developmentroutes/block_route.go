@@ -33,6 +33,7 @@ func configureDevelopmentBlockRoutes(
 	blockRoutes := router.Group("/blocks")
 	defaultMiddlewares := []gin.HandlerFunc{
 		middlewares.UnauthorizedRateLimitMiddleware(rateLimiters.Unauthorized),
+		middlewares.APIKeyRateLimitMiddleware(rateLimiters.APIKey),
 		middlewares.TimeoutMiddleware(3 * time.Second),
 		interceptors.ShareableResponseWriterInterceptor(
 			interceptors.EmbeddedInterceptor,
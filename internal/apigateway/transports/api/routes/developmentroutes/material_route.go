@@ -5,6 +5,9 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	constants "github.com/HiIamJeff67/notegic-backend/shared/constants"
+	types "github.com/HiIamJeff67/notegic-backend/shared/types"
+
 	enumcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
 
 	binders "github.com/HiIamJeff67/notegic-backend/internal/apigateway/transports/api/binders"
@@ -34,6 +37,7 @@ func configureDevelopmentMaterialRoutes(
 	materialRoutes := router.Group("/materials")
 	defaultMiddlewares := []gin.HandlerFunc{
 		middlewares.UnauthorizedRateLimitMiddleware(rateLimiters.Unauthorized),
+		middlewares.APIKeyRateLimitMiddleware(rateLimiters.APIKey),
 		middlewares.TimeoutMiddleware(3 * time.Second),
 		interceptors.ShareableResponseWriterInterceptor(
 			interceptors.EmbeddedInterceptor,
@@ -223,5 +227,62 @@ func configureDevelopmentMaterialRoutes(
 				materialBinder.BindDeleteMyMaterialsByIds(materialController.DeleteMyMaterialsByIds),
 			)...,
 		)
+		materialRoutes.POST(
+			"/:material-id/uploads",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("initiateMyMaterialUpload"),
+					middlewares.ApplyMeterMiddleware("server.requests.material.initiateMyMaterialUpload"),
+				},
+				append(
+					defaultMiddlewares,
+					middlewares.AllowedPermissionsAbove(enumcontract.AccessControlPermission_Write),
+				),
+				materialBinder.BindInitiateMyMaterialUpload(materialController.InitiateMyMaterialUpload),
+			)...,
+		)
+		materialRoutes.PUT(
+			"/uploads/:storage-upload-id/parts/:part-number",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("uploadMyMaterialPart"),
+					middlewares.ApplyMeterMiddleware("server.requests.material.uploadMyMaterialPart"),
+					middlewares.MaxContextSizeMiddleware(constants.MaterialUploadPartSize.ToInt64(), types.Byte),
+				},
+				append(
+					defaultMiddlewares,
+					middlewares.AllowedPermissionsAbove(enumcontract.AccessControlPermission_Write),
+				),
+				materialBinder.BindUploadMyMaterialPart(materialController.UploadMyMaterialPart),
+			)...,
+		)
+		materialRoutes.POST(
+			"/:material-id/uploads/:upload-id/complete",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("completeMyMaterialUpload"),
+					middlewares.ApplyMeterMiddleware("server.requests.material.completeMyMaterialUpload"),
+				},
+				append(
+					defaultMiddlewares,
+					middlewares.AllowedPermissionsAbove(enumcontract.AccessControlPermission_Write),
+				),
+				materialBinder.BindCompleteMyMaterialUpload(materialController.CompleteMyMaterialUpload),
+			)...,
+		)
+		materialRoutes.DELETE(
+			"/:material-id/uploads/:upload-id",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("abortMyMaterialUpload"),
+					middlewares.ApplyMeterMiddleware("server.requests.material.abortMyMaterialUpload"),
+				},
+				append(
+					defaultMiddlewares,
+					middlewares.AllowedPermissionsAbove(enumcontract.AccessControlPermission_Write),
+				),
+				materialBinder.BindAbortMyMaterialUpload(materialController.AbortMyMaterialUpload),
+			)...,
+		)
 	}
 }
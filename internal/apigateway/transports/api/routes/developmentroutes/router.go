@@ -20,6 +20,7 @@ var (
 
 type RateLimiters struct {
 	Unauthorized *ratelimit.HybridRateLimiter
+	APIKey       *ratelimit.APIKeyRateLimiter
 }
 
 type APIRouteDependencies struct {
@@ -52,6 +53,7 @@ func NewRouter(deps APIRouteDependencies) *gin.Engine {
 	configureDevelopmentMaterialRoutes(DevelopmentAPIRouterGroup, MaterialRouteDependencies{CoreAdapter: coreAdapter, RateLimiters: rateLimiters})
 	configureDevelopmentBlockPackRoutes(DevelopmentAPIRouterGroup, BlockPackRouteDependencies{CoreAdapter: coreAdapter, RateLimiters: rateLimiters})
 	configureDevelopmentBlockRoutes(DevelopmentAPIRouterGroup, BlockRouteDependencies{CoreAdapter: coreAdapter, RateLimiters: rateLimiters})
+	configureDevelopmentAPIKeyRoutes(DevelopmentAPIRouterGroup, APIKeyRouteDependencies{RateLimiters: rateLimiters})
 
 	return DevelopmentRouter
 }
@@ -28,6 +28,7 @@ func TestNewRouterAPIGatewayAllowlist(t *testing.T) {
 		"/materials",
 		"/block-packs",
 		"/blocks",
+		"/api-keys",
 	} {
 		if !hasRouteUnderDomain(routes, domain) {
 			t.Errorf("APIGateway route allowlist is missing domain %q", domain)
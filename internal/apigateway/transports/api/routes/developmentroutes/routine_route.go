@@ -34,6 +34,7 @@ func configureDevelopmentRoutineRoutes(
 	routineRoutes := router.Group("/routines")
 	defaultMiddlewares := []gin.HandlerFunc{
 		middlewares.UnauthorizedRateLimitMiddleware(rateLimiters.Unauthorized),
+		middlewares.APIKeyRateLimitMiddleware(rateLimiters.APIKey),
 		middlewares.TimeoutMiddleware(3 * time.Second),
 		interceptors.ShareableResponseWriterInterceptor(
 			interceptors.EmbeddedInterceptor,
@@ -285,6 +286,7 @@ func configureDevelopmentRoutineRoutes(
 	visualizationRoutes := router.Group("/routines/visualizations")
 	visualizationMiddlewares := []gin.HandlerFunc{
 		middlewares.UnauthorizedRateLimitMiddleware(rateLimiters.Unauthorized),
+		middlewares.APIKeyRateLimitMiddleware(rateLimiters.APIKey),
 		middlewares.TimeoutMiddleware(3 * time.Second),
 		interceptors.ShareableResponseWriterInterceptor(
 			interceptors.EmbeddedInterceptor,
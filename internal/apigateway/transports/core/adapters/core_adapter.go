@@ -16,6 +16,8 @@ import (
 
 	gatewaycontract "github.com/HiIamJeff67/notegic-backend/contracts/gateway/v1"
 	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+
+	gatewaycontexts "github.com/HiIamJeff67/notegic-backend/internal/apigateway/contexts"
 )
 
 type CoreAdapter struct {
@@ -43,12 +45,14 @@ func IssueDelegationTokenFromSource(
 	gatewaySource string,
 	authMethod string,
 	apiKeyId string,
+	apiKeyScope string,
 ) (string, error) {
 	token, err := sharedtokens.GenerateDelegationToken(sharedtokens.DelegationTokenClaims{
 		Actor:              actor,
 		GatewaySource:      gatewaySource,
 		AuthMethod:         authMethod,
 		ApiKeyId:           apiKeyId,
+		APIKeyScope:        apiKeyScope,
 		UserSubject:        userSubject,
 		AllowedPermissions: allowedPermissions,
 		Operation:          operation,
@@ -215,6 +219,8 @@ func call[RequestDto any, ResponseDto any](
 // CallAsAPIKey is the Core adapter path for APIGateway requests. The edge
 // middleware has already checked the header shape; Core remains responsible
 // for hashing, cache/DB lookup, revocation, and actor-context population.
+// The route's allowed permissions and the scope the operation requires are
+// carried in the delegation token, so Core re-checks them against the key.
 func CallAsAPIKey[RequestDto any, ResponseDto any](
 	ctx *gin.Context,
 	client *CoreAdapter,
@@ -231,6 +237,28 @@ func CallAsAPIKey[RequestDto any, ResponseDto any](
 			http.StatusBadRequest,
 		)
 	}
+	apiKeyScope, err := sharedtokens.GetRequiredAPIKeyScope(operation)
+	if err != nil {
+		return nil, exceptions.New(
+			"Forbidden",
+			"Gateway",
+			operation,
+			"The operation is not available to API keys",
+			http.StatusForbidden,
+		).WithOrigin(err)
+	}
+	allowedPermissions, exception := gatewaycontexts.GetOptionalAllowedPermissions(ctx.Request.Context())
+	if exception != nil {
+		return nil, exception
+	}
+	var delegatedPermissions []string
+	if len(allowedPermissions) > 0 {
+		delegatedPermissions = make([]string, len(allowedPermissions))
+		for index, permission := range allowedPermissions {
+			delegatedPermissions[index] = string(permission)
+		}
+	}
+
 	requestId := ctx.GetHeader("X-Request-Id")
 	if requestId == "" {
 		requestId = uuid.NewString()
@@ -238,12 +266,13 @@ func CallAsAPIKey[RequestDto any, ResponseDto any](
 	delegationToken, err := IssueDelegationTokenFromSource(
 		"gateway",
 		"",
-		nil,
+		delegatedPermissions,
 		operation,
 		requestId,
 		sharedtokens.GatewaySourceAPI,
 		sharedtokens.AuthMethodAPIKey,
 		"",
+		apiKeyScope,
 	)
 	if err != nil {
 		return nil, exceptions.New(
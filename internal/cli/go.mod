@@ -3,7 +3,9 @@ module github.com/HiIamJeff67/notegic-backend/internal/cli
 go 1.26.0
 
 require (
+	github.com/HiIamJeff67/notegic-backend/contracts v0.0.0
 	github.com/HiIamJeff67/notegic-backend/shared v0.0.0
+	github.com/google/uuid v1.6.0
 	github.com/spf13/cobra v1.9.1
 )
 
@@ -12,7 +14,6 @@ replace github.com/HiIamJeff67/notegic-backend/shared => ../../shared
 replace github.com/HiIamJeff67/notegic-backend/contracts => ../../contracts
 
 require (
-	github.com/HiIamJeff67/notegic-backend/contracts v0.0.0 // indirect
 	github.com/bytedance/sonic v1.13.2 // indirect
 	github.com/bytedance/sonic/loader v0.2.4 // indirect
 	github.com/cespare/xxhash/v2 v2.3.0 // indirect
@@ -27,7 +28,6 @@ require (
 	github.com/go-playground/universal-translator v0.18.1 // indirect
 	github.com/go-playground/validator/v10 v10.27.0 // indirect
 	github.com/goccy/go-json v0.10.5 // indirect
-	github.com/google/uuid v1.6.0 // indirect
 	github.com/inconshreveable/mousetrap v1.1.0 // indirect
 	github.com/json-iterator/go v1.1.12 // indirect
 	github.com/klauspost/compress v1.18.6 // indirect
@@ -0,0 +1,399 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	eventcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/events"
+
+	platformkafka "github.com/HiIamJeff67/notegic-backend/shared/platform/kafka"
+	kafkatopics "github.com/HiIamJeff67/notegic-backend/shared/platform/kafka/topics"
+)
+
+type deadLetterFilterFlags struct {
+	eventType      string
+	classification string
+	since          string
+	until          string
+}
+
+func newKafkaDeadLetterCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "dlq",
+		Short: "Inspect, replay, and purge Kafka dead-letter topics.",
+	}
+	command.AddCommand(
+		newListKafkaDeadLettersCommand(),
+		newShowKafkaDeadLetterCommand(),
+		newReplayKafkaDeadLettersCommand(),
+		newPurgeKafkaDeadLettersCommand(),
+	)
+	return command
+}
+
+func newListKafkaDeadLettersCommand() *cobra.Command {
+	var filterFlags deadLetterFilterFlags
+	var cursorValue string
+	var limit int
+	command := &cobra.Command{
+		Use:   "list <topic>",
+		Short: "Page through the records of a dead-letter topic.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, arguments []string) error {
+			topic, err := resolveDeadLetterTopic(arguments[0])
+			if err != nil {
+				return err
+			}
+			filter, err := filterFlags.parse()
+			if err != nil {
+				return err
+			}
+			cursor, err := platformkafka.ParseDeadLetterCursor(cursorValue)
+			if err != nil {
+				return err
+			}
+			if limit <= 0 {
+				return errors.New("--limit must be a positive integer")
+			}
+
+			inspector, err := newDeadLetterInspector()
+			if err != nil {
+				return err
+			}
+			defer inspector.Close()
+
+			page, err := inspector.List(command.Context(), topic, cursor, filter, limit)
+			if err != nil {
+				return err
+			}
+			writeDeadLetterTable(command.OutOrStdout(), page.Records)
+			if !page.Done {
+				fmt.Fprintf(command.OutOrStdout(), "\nnext page: --cursor %s\n", page.Next.String())
+			}
+
+			return nil
+		},
+	}
+	filterFlags.register(command)
+	command.Flags().StringVar(&cursorValue, "cursor", "", "continue from a partition:offset cursor printed by a previous page")
+	command.Flags().IntVar(&limit, "limit", 50, "maximum number of records read for one page")
+	return command
+}
+
+func newShowKafkaDeadLetterCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "show <topic> <partition:offset>",
+		Short: "Print a dead-letter record with its original envelope.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(command *cobra.Command, arguments []string) error {
+			topic, err := resolveDeadLetterTopic(arguments[0])
+			if err != nil {
+				return err
+			}
+			partition, offset, err := platformkafka.ParseDeadLetterPosition(arguments[1])
+			if err != nil {
+				return err
+			}
+
+			inspector, err := newDeadLetterInspector()
+			if err != nil {
+				return err
+			}
+			defer inspector.Close()
+
+			record, err := inspector.Get(command.Context(), topic, partition, offset)
+			if err != nil {
+				return err
+			}
+
+			return writeDeadLetterRecord(command.OutOrStdout(), record)
+		},
+	}
+}
+
+func newReplayKafkaDeadLettersCommand() *cobra.Command {
+	var filterFlags deadLetterFilterFlags
+	var all bool
+	var dryRun bool
+	command := &cobra.Command{
+		Use:   "replay <topic> [partition:offset...]",
+		Short: "Republish dead-letter records to their source topic with a replay causation ID.",
+		Long: "Republish the selected dead-letter records to their source topic. The original event ID is kept, so " +
+			"consumers still deduplicate events they have processed, and the causation ID is set to one replay ID " +
+			"per invocation. Select records by position, or pass --all to replay every record matching the filters.",
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(command *cobra.Command, arguments []string) error {
+			topic, err := resolveDeadLetterTopic(arguments[0])
+			if err != nil {
+				return err
+			}
+			filter, err := filterFlags.parse()
+			if err != nil {
+				return err
+			}
+			if all == (len(arguments) > 1) {
+				return errors.New("select records either by partition:offset positions or by --all")
+			}
+
+			inspector, err := newDeadLetterInspector()
+			if err != nil {
+				return err
+			}
+			defer inspector.Close()
+
+			var records []platformkafka.DeadLetterRecord
+			if all {
+				cursor := make(platformkafka.DeadLetterCursor)
+				for {
+					page, err := inspector.List(command.Context(), topic, cursor, filter, 500)
+					if err != nil {
+						return err
+					}
+					records = append(records, page.Records...)
+					if page.Done || page.Next.String() == cursor.String() {
+						break
+					}
+					cursor = page.Next
+				}
+			} else {
+				for _, position := range arguments[1:] {
+					partition, offset, err := platformkafka.ParseDeadLetterPosition(position)
+					if err != nil {
+						return err
+					}
+					record, err := inspector.Get(command.Context(), topic, partition, offset)
+					if err != nil {
+						return err
+					}
+					if !filter.Matches(record) {
+						return fmt.Errorf("dead-letter record %s does not match the filters", position)
+					}
+					records = append(records, record)
+				}
+			}
+
+			replayId := uuid.New()
+			output := command.OutOrStdout()
+			if dryRun {
+				fmt.Fprintf(output, "dry run: %d record(s) would be replayed\n", len(records))
+				writeDeadLetterTable(output, records)
+				return nil
+			}
+			fmt.Fprintf(output, "replay ID: %s\n", replayId)
+			replayed := 0
+			for _, record := range records {
+				if err := inspector.Replay(command.Context(), record, replayId); err != nil {
+					fmt.Fprintf(output, "skipped %d:%d: %v\n", record.Partition, record.Offset, err)
+					continue
+				}
+				replayed++
+				fmt.Fprintf(output, "replayed %d:%d to %s\n", record.Partition, record.Offset, record.DeadLetter.SourceTopic)
+			}
+			if replayed != len(records) {
+				return fmt.Errorf("replayed %d of %d dead-letter records", replayed, len(records))
+			}
+
+			return nil
+		},
+	}
+	filterFlags.register(command)
+	command.Flags().BoolVar(&all, "all", false, "replay every record matching the filters")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "print the selected records without replaying them")
+	return command
+}
+
+func newPurgeKafkaDeadLettersCommand() *cobra.Command {
+	var before string
+	var confirmed bool
+	command := &cobra.Command{
+		Use:   "purge <topic>",
+		Short: "Delete the dead-letter records failed before a time.",
+		Long: "Delete the records of a dead-letter topic failed before --before, or every record without it. Kafka " +
+			"only deletes the records of a partition from its beginning, so a partition is purged up to its first " +
+			"record failed at or after the time. Without --yes the command only prints what would be deleted.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, arguments []string) error {
+			topic, err := resolveDeadLetterTopic(arguments[0])
+			if err != nil {
+				return err
+			}
+			failedBefore, err := parseDeadLetterTime(before, time.Now())
+			if err != nil {
+				return fmt.Errorf("--before: %w", err)
+			}
+
+			inspector, err := newDeadLetterInspector()
+			if err != nil {
+				return err
+			}
+			defer inspector.Close()
+
+			plan, err := inspector.PlanPurge(command.Context(), topic, failedBefore)
+			if err != nil {
+				return err
+			}
+			total := int64(0)
+			output := command.OutOrStdout()
+			for partition := int32(0); partition < int32(len(plan)); partition++ {
+				purge := plan[partition]
+				total += purge.To - purge.From
+				fmt.Fprintf(output, "partition %d: offsets [%d, %d)\n", partition, purge.From, purge.To)
+			}
+			if !confirmed {
+				fmt.Fprintf(output, "%d record(s) would be deleted from %s; pass --yes to delete them\n", total, topic)
+				return nil
+			}
+
+			if err := inspector.Purge(command.Context(), topic, plan); err != nil {
+				return err
+			}
+			fmt.Fprintf(output, "deleted %d record(s) from %s\n", total, topic)
+
+			return nil
+		},
+	}
+	command.Flags().StringVar(&before, "before", "", "purge records failed before an RFC 3339 time or a duration ago, such as 720h")
+	command.Flags().BoolVar(&confirmed, "yes", false, "delete the records instead of printing the plan")
+	return command
+}
+
+/* ============================== Auxiliary Functions ============================== */
+
+func newDeadLetterInspector() (*platformkafka.DeadLetterInspector, error) {
+	connectionConfig, err := platformkafka.LoadConnectionConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return platformkafka.NewDeadLetterInspector(platformkafka.ClientConfig{
+		ConnectionConfig: connectionConfig,
+		ClientId:         "notegic-kafka-dead-letter-inspector",
+	})
+}
+
+// resolveDeadLetterTopic accepts either a catalog topic or its dead-letter topic, and returns the dead-letter topic
+func resolveDeadLetterTopic(name string) (string, error) {
+	sourceTopic := strings.TrimSuffix(strings.TrimSpace(name), platformkafka.DeadLetterTopic(""))
+	for _, specification := range kafkatopics.All() {
+		if specification.Name == sourceTopic && specification.CreateDeadLetter {
+			return platformkafka.DeadLetterTopic(sourceTopic), nil
+		}
+	}
+
+	return "", fmt.Errorf("%q is not a Kafka topic with a dead-letter topic in the catalog", name)
+}
+
+func (f *deadLetterFilterFlags) register(command *cobra.Command) {
+	command.Flags().StringVar(&f.eventType, "event-type", "", "only records whose original event has this type")
+	command.Flags().StringVar(&f.classification, "classification", "", "only records with this classification: Transient, PoisonMessage, or SchemaIncompatible")
+	command.Flags().StringVar(&f.since, "since", "", "only records failed at or after an RFC 3339 time or a duration ago, such as 24h")
+	command.Flags().StringVar(&f.until, "until", "", "only records failed before an RFC 3339 time or a duration ago")
+}
+
+func (f deadLetterFilterFlags) parse() (platformkafka.DeadLetterFilter, error) {
+	filter := platformkafka.DeadLetterFilter{
+		EventType:      eventcontract.EventType(strings.TrimSpace(f.eventType)),
+		Classification: platformkafka.ErrorClassification(strings.TrimSpace(f.classification)),
+	}
+	switch filter.Classification {
+	case "", platformkafka.ErrorClassification_Transient,
+		platformkafka.ErrorClassification_PoisonMessage,
+		platformkafka.ErrorClassification_SchemaIncompatible:
+	default:
+		return platformkafka.DeadLetterFilter{}, fmt.Errorf("unknown dead-letter classification %q", f.classification)
+	}
+
+	now := time.Now()
+	var err error
+	if filter.FailedFrom, err = parseDeadLetterTime(f.since, now); err != nil {
+		return platformkafka.DeadLetterFilter{}, fmt.Errorf("--since: %w", err)
+	}
+	if filter.FailedUntil, err = parseDeadLetterTime(f.until, now); err != nil {
+		return platformkafka.DeadLetterFilter{}, fmt.Errorf("--until: %w", err)
+	}
+
+	return filter, nil
+}
+
+// parseDeadLetterTime parses an RFC 3339 time or a duration before now, and an empty value is the zero time
+func parseDeadLetterTime(value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if duration, err := time.ParseDuration(value); err == nil && duration > 0 {
+		return now.Add(-duration), nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is neither an RFC 3339 time nor a positive duration", value)
+	}
+
+	return parsed, nil
+}
+
+func writeDeadLetterTable(output io.Writer, records []platformkafka.DeadLetterRecord) {
+	writer := tabwriter.NewWriter(output, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "POSITION\tFAILED AT\tCLASSIFICATION\tEVENT TYPE\tEVENT ID\tCONSUMER GROUP\tERROR")
+	for _, record := range records {
+		eventType, eventId := "-", "-"
+		if record.Envelope != nil {
+			eventType = string(record.Envelope.EventType)
+			eventId = record.Envelope.EventId.String()
+		}
+		errorMessage := record.DeadLetter.Error
+		if record.DecodeErr != nil {
+			errorMessage = record.DecodeErr.Error()
+		}
+		if len(errorMessage) > 80 {
+			errorMessage = errorMessage[:77] + "..."
+		}
+		fmt.Fprintf(writer, "%d:%d\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			record.Partition,
+			record.Offset,
+			record.DeadLetter.FailedAt.Format(time.RFC3339),
+			record.DeadLetter.Classification,
+			eventType,
+			eventId,
+			record.DeadLetter.ConsumerGroup,
+			errorMessage,
+		)
+	}
+	writer.Flush()
+}
+
+// writeDeadLetterRecord prints the dead-letter metadata with the original value decoded as JSON when possible,
+// instead of the base64 encoding of the value in the dead-letter record
+func writeDeadLetterRecord(output io.Writer, record platformkafka.DeadLetterRecord) error {
+	view := struct {
+		Position    string                   `json:"position"`
+		DecodeError string                   `json:"decodeError,omitempty"`
+		DeadLetter  platformkafka.DeadLetter `json:"deadLetter"`
+		Envelope    json.RawMessage          `json:"envelope,omitempty"`
+		RawValue    string                   `json:"rawValue,omitempty"`
+	}{
+		Position:   fmt.Sprintf("%d:%d", record.Partition, record.Offset),
+		DeadLetter: record.DeadLetter,
+	}
+	if record.DecodeErr != nil {
+		view.DecodeError = record.DecodeErr.Error()
+	}
+	if json.Valid(record.DeadLetter.Value) {
+		view.Envelope = record.DeadLetter.Value
+	} else {
+		view.RawValue = string(record.DeadLetter.Value)
+	}
+	view.DeadLetter.Value = nil
+
+	encoder := json.NewEncoder(output)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(view)
+}
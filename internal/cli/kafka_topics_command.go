@@ -18,7 +18,10 @@ func newKafkaCommand() *cobra.Command {
 		Use:   "kafka",
 		Short: "Manage Kafka development resources.",
 	}
-	command.AddCommand(newEnsureKafkaTopicsCommand())
+	command.AddCommand(
+		newEnsureKafkaTopicsCommand(),
+		newKafkaDeadLetterCommand(),
+	)
 	return command
 }
 
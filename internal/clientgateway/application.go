@@ -13,13 +13,16 @@ import (
 
 	cookies "github.com/HiIamJeff67/notegic-backend/shared/cookies"
 	platform "github.com/HiIamJeff67/notegic-backend/shared/platform"
+	sharedtokens "github.com/HiIamJeff67/notegic-backend/shared/tokens"
 	types "github.com/HiIamJeff67/notegic-backend/shared/types"
 
 	observability "github.com/HiIamJeff67/notegic-backend/shared/platform/observability"
+	logs "github.com/HiIamJeff67/notegic-backend/shared/platform/observability/logs"
 	platformredis "github.com/HiIamJeff67/notegic-backend/shared/platform/redis"
 
 	gatewayconfig "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/configs"
 	ratelimitrecord "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/data/cache/ratelimitrecord"
+	realtimeevent "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/data/cache/realtimeevent"
 	ratelimit "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/ratelimit"
 	ratelimitmiddlewares "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/api/middlewares"
 	developmentroutes "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/api/routes/developmentroutes"
@@ -41,8 +44,9 @@ type ApplicationInterface interface {
 	loadRedisConfig() platformredis.Config
 	initializeObservability() func()
 	initializeRateLimiters(*platformredis.ClientSet, func()) (*ratelimit.HybridRateLimiter, *ratelimit.HybridRateLimiter)
-	buildRouter(gatewayconfig.Config, *ratelimit.HybridRateLimiter, *ratelimit.HybridRateLimiter, *platformredis.ClientSet, func()) *gin.Engine
-	startHTTP(gatewayconfig.Config, *gin.Engine, *ratelimit.HybridRateLimiter, *ratelimit.HybridRateLimiter, *platformredis.ClientSet, func()) func()
+	initializeRealtimeEventListener(*platformredis.ClientSet) *realtimeevent.RealtimeEventCacheClient
+	buildRouter(gatewayconfig.Config, *ratelimit.HybridRateLimiter, *ratelimit.HybridRateLimiter, *realtimeevent.RealtimeEventCacheClient, *platformredis.ClientSet, func()) *gin.Engine
+	startHTTP(gatewayconfig.Config, *gin.Engine, *ratelimit.HybridRateLimiter, *ratelimit.HybridRateLimiter, *realtimeevent.RealtimeEventCacheClient, *platformredis.ClientSet, func()) func()
 }
 
 func NewApplication() *Application {
@@ -99,10 +103,25 @@ func (a *Application) initializeRateLimiters(
 	return ratelimitmiddlewares.InitUnauthorizedRateLimiter(unauthorizedRateLimitConfig), ratelimitmiddlewares.InitAuthorizedRateLimiter(authorizedRateLimitConfig)
 }
 
+// initializeRealtimeEventListener degrades the GraphQL subscriptions instead of stopping the gateway,
+// since the queries and mutations do not depend on the realtime events.
+func (a *Application) initializeRealtimeEventListener(
+	redisClientSet *platformredis.ClientSet,
+) *realtimeevent.RealtimeEventCacheClient {
+	realtimeEventCacheStore := realtimeevent.Register(context.Background(), redisClientSet)
+	realtimeEventCacheClient := realtimeevent.NewRealtimeEventCacheClient(realtimeEventCacheStore)
+	if err := realtimeEventCacheClient.Listen(); err != nil {
+		logs.NotegicLogger.Error(context.Background(), err, "Failed to listen to the realtime events, GraphQL subscriptions are unavailable")
+		return nil
+	}
+	return realtimeEventCacheClient
+}
+
 func (a *Application) buildRouter(
 	config gatewayconfig.Config,
 	unauthorizedRateLimiter *ratelimit.HybridRateLimiter,
 	authorizedRateLimiter *ratelimit.HybridRateLimiter,
+	realtimeEventCacheClient *realtimeevent.RealtimeEventCacheClient,
 	redisClientSet *platformredis.ClientSet,
 	shutdownObservability func(),
 ) *gin.Engine {
@@ -122,12 +141,32 @@ func (a *Application) buildRouter(
 		HTTPOnly: true,
 		SameSite: http.SameSiteStrictMode,
 	})
+	shareSessionTokenCookieHandler := cookies.New(cookies.Config{
+		Name:     cookies.ValidCookieName_ShareSessionToken,
+		Path:     "/",
+		Duration: sharedtokens.ShareSessionTokenExpiresIn,
+		Secure:   platform.CurrentEnvironment == types.Environment_Production,
+		HTTPOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+	// the OAuth callback is a top level navigation back from the provider, which the strict mode would drop
+	oauthStateTokenCookieHandler := cookies.New(cookies.Config{
+		Name:     cookies.ValidCookieName_OAuthStateToken,
+		Path:     "/",
+		Duration: sharedtokens.OAuthStateTokenExpiresIn,
+		Secure:   platform.CurrentEnvironment == types.Environment_Production,
+		HTTPOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
 	router := developmentroutes.NewRouter(developmentroutes.APIRouteDependencies{
-		CoreAdapter:               coreadapters.NewCoreAdapter(config.CoreBaseUrl, config.CoreAdapterTimeout),
-		NotificationClient:        notificationadapters.NewNotificationAdapter(config.NotificationBaseUrl, config.NotificationAdapterTimeout),
-		AllowedDomains:            config.AllowedDomains,
-		AccessTokenCookieHandler:  accessTokenCookieHandler,
-		RefreshTokenCookieHandler: refreshTokenCookieHandler,
+		CoreAdapter:                    coreadapters.NewCoreAdapter(config.CoreBaseUrl, config.CoreAdapterTimeout),
+		RealtimeEventCacheClient:       realtimeEventCacheClient,
+		NotificationClient:             notificationadapters.NewNotificationAdapter(config.NotificationBaseUrl, config.NotificationAdapterTimeout),
+		AllowedDomains:                 config.AllowedDomains,
+		AccessTokenCookieHandler:       accessTokenCookieHandler,
+		RefreshTokenCookieHandler:      refreshTokenCookieHandler,
+		ShareSessionTokenCookieHandler: shareSessionTokenCookieHandler,
+		OAuthStateTokenCookieHandler:   oauthStateTokenCookieHandler,
 		RateLimiters: developmentroutes.RateLimiters{
 			Unauthorized: unauthorizedRateLimiter,
 			Authorized:   authorizedRateLimiter,
@@ -136,6 +175,7 @@ func (a *Application) buildRouter(
 	if err := router.SetTrustedProxies(config.TrustedProxies); err != nil {
 		unauthorizedRateLimiter.Stop()
 		authorizedRateLimiter.Stop()
+		realtimeEventCacheClient.Close()
 		_ = redisClientSet.Close()
 		shutdownObservability()
 		panic(err)
@@ -150,6 +190,7 @@ func (a *Application) startHTTP(
 	router *gin.Engine,
 	unauthorizedRateLimiter *ratelimit.HybridRateLimiter,
 	authorizedRateLimiter *ratelimit.HybridRateLimiter,
+	realtimeEventCacheClient *realtimeevent.RealtimeEventCacheClient,
 	redisClientSet *platformredis.ClientSet,
 	shutdownObservability func(),
 ) func() {
@@ -157,6 +198,7 @@ func (a *Application) startHTTP(
 	if err != nil {
 		unauthorizedRateLimiter.Stop()
 		authorizedRateLimiter.Stop()
+		realtimeEventCacheClient.Close()
 		_ = redisClientSet.Close()
 		shutdownObservability()
 		panic(err)
@@ -184,6 +226,7 @@ func (a *Application) startHTTP(
 		}
 		unauthorizedRateLimiter.Stop()
 		authorizedRateLimiter.Stop()
+		realtimeEventCacheClient.Close()
 		if err := redisClientSet.Close(); err != nil {
 			fmt.Println("Failed to disconnect Gateway cache servers: ", err)
 		}
@@ -201,8 +244,9 @@ func (a *Application) Start() func() {
 		panic(err)
 	}
 	unauthorizedRateLimiter, authorizedRateLimiter := a.initializeRateLimiters(redisClientSet, shutdownObservability)
-	router := a.buildRouter(config, unauthorizedRateLimiter, authorizedRateLimiter, redisClientSet, shutdownObservability)
-	return a.startHTTP(config, router, unauthorizedRateLimiter, authorizedRateLimiter, redisClientSet, shutdownObservability)
+	realtimeEventCacheClient := a.initializeRealtimeEventListener(redisClientSet)
+	router := a.buildRouter(config, unauthorizedRateLimiter, authorizedRateLimiter, realtimeEventCacheClient, redisClientSet, shutdownObservability)
+	return a.startHTTP(config, router, unauthorizedRateLimiter, authorizedRateLimiter, realtimeEventCacheClient, redisClientSet, shutdownObservability)
 }
 
 // make sure Application struct followed the ApplicationInterface implementations
@@ -0,0 +1,201 @@
+package realtimeevent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/google/uuid"
+
+	logs "github.com/HiIamJeff67/notegic-backend/shared/platform/observability/logs"
+)
+
+// The RealtimeGateway republishes the Core and DurableJob Kafka events on these Redis channels,
+// so the payloads below must stay in sync with the ones in its realtime lease cache client.
+
+type ResourceEvent struct {
+	EventId            uuid.UUID  `json:"eventId"`
+	EventType          string     `json:"eventType"`
+	ResourceId         uuid.UUID  `json:"resourceId"`
+	TargetUserPublicId *uuid.UUID `json:"targetUserPublicId,omitempty"`
+	Change             string     `json:"change"`
+	Permission         string     `json:"permission,omitempty"`
+}
+
+type RoutineTaskLifecycleEvent struct {
+	EventId             uuid.UUID `json:"eventId"`
+	RoutineTaskId       uuid.UUID `json:"routineTaskId"`
+	RoutineTaskRecordId uuid.UUID `json:"routineTaskRecordId"`
+	RoutineId           uuid.UUID `json:"routineId"`
+	ActorUserPublicId   uuid.UUID `json:"actorUserPublicId"`
+	Purpose             string    `json:"purpose"`
+	Status              string    `json:"status"`
+	Attempt             int32     `json:"attempt"`
+	OccurredAt          time.Time `json:"occurredAt"`
+}
+
+type subscriberSet[T any] struct {
+	mutex       sync.RWMutex
+	nextId      uint64
+	subscribers map[uint64]chan T
+}
+
+type RealtimeEventCacheClient struct {
+	cacheStore *RealtimeEventCacheStore
+
+	subscriberBufferSize int
+
+	listenerMutex sync.Mutex
+	pubsubs       []*redis.PubSub
+
+	resourceEventSubscribers             subscriberSet[ResourceEvent]
+	routineTaskLifecycleEventSubscribers subscriberSet[RoutineTaskLifecycleEvent]
+}
+
+/* ============================== Constructor ============================== */
+
+func NewRealtimeEventCacheClient(cacheStore *RealtimeEventCacheStore) *RealtimeEventCacheClient {
+	return &RealtimeEventCacheClient{
+		cacheStore: cacheStore,
+
+		subscriberBufferSize: 32,
+
+		resourceEventSubscribers: subscriberSet[ResourceEvent]{
+			subscribers: make(map[uint64]chan ResourceEvent),
+		},
+		routineTaskLifecycleEventSubscribers: subscriberSet[RoutineTaskLifecycleEvent]{
+			subscribers: make(map[uint64]chan RoutineTaskLifecycleEvent),
+		},
+	}
+}
+
+/* ============================== Auxiliary Methods ============================== */
+
+func (s *RealtimeEventCacheClient) resourceEventKey() string {
+	return "Realtime:resource:events"
+}
+
+func (s *RealtimeEventCacheClient) routineTaskLifecycleKey() string {
+	return "Realtime:routine-task:lifecycle-events"
+}
+
+func subscribe[T any](set *subscriberSet[T], bufferSize int) (<-chan T, func()) {
+	channel := make(chan T, bufferSize)
+
+	set.mutex.Lock()
+	id := set.nextId
+	set.nextId++
+	set.subscribers[id] = channel
+	set.mutex.Unlock()
+
+	var once sync.Once
+	return channel, func() {
+		once.Do(func() {
+			set.mutex.Lock()
+			delete(set.subscribers, id)
+			set.mutex.Unlock()
+			close(channel)
+		})
+	}
+}
+
+func broadcast[T any](set *subscriberSet[T], event T) {
+	set.mutex.RLock()
+	defer set.mutex.RUnlock()
+
+	for _, channel := range set.subscribers {
+		select {
+		case channel <- event:
+		default:
+			// never let a slow subscriber block the other subscribers on this gateway
+			if logs.NotegicLogger != nil {
+				logs.NotegicLogger.Warn(context.Background(), "Dropped a realtime event for a slow GraphQL subscriber")
+			}
+		}
+	}
+}
+
+/* ============================== Listener Methods ============================== */
+
+// Listen subscribes the realtime event channels on every Redis client,
+// since the RealtimeGateway may publish an event on the client of any routing key.
+func (s *RealtimeEventCacheClient) Listen() error {
+	if s == nil || s.cacheStore == nil {
+		return errors.New("realtime event cache store is unavailable")
+	}
+
+	s.listenerMutex.Lock()
+	defer s.listenerMutex.Unlock()
+	if len(s.pubsubs) > 0 {
+		return nil
+	}
+
+	for _, redisClient := range s.cacheStore.ClientSet().Clients() {
+		pubsub := redisClient.Subscribe(s.resourceEventKey(), s.routineTaskLifecycleKey())
+		if _, err := pubsub.Receive(); err != nil {
+			_ = pubsub.Close()
+			s.closePubSubs()
+			return err
+		}
+		s.pubsubs = append(s.pubsubs, pubsub)
+
+		go s.dispatch(pubsub)
+	}
+
+	return nil
+}
+
+func (s *RealtimeEventCacheClient) dispatch(pubsub *redis.PubSub) {
+	for message := range pubsub.Channel() {
+		switch message.Channel {
+		case s.resourceEventKey():
+			var event ResourceEvent
+			if err := json.Unmarshal([]byte(message.Payload), &event); err != nil {
+				continue
+			}
+
+			broadcast(&s.resourceEventSubscribers, event)
+		case s.routineTaskLifecycleKey():
+			var event RoutineTaskLifecycleEvent
+			if err := json.Unmarshal([]byte(message.Payload), &event); err != nil {
+				continue
+			}
+
+			broadcast(&s.routineTaskLifecycleEventSubscribers, event)
+		}
+	}
+}
+
+func (s *RealtimeEventCacheClient) Close() {
+	if s == nil {
+		return
+	}
+
+	s.listenerMutex.Lock()
+	defer s.listenerMutex.Unlock()
+	s.closePubSubs()
+}
+
+func (s *RealtimeEventCacheClient) closePubSubs() {
+	for _, pubsub := range s.pubsubs {
+		_ = pubsub.Close()
+	}
+	s.pubsubs = nil
+}
+
+/* ============================== Subscriber Methods ============================== */
+
+// SubscribeResourceEvents returns the channel of every resource event received by this gateway,
+// the caller must filter the events by the current user and call the returned function to unsubscribe.
+func (s *RealtimeEventCacheClient) SubscribeResourceEvents() (<-chan ResourceEvent, func()) {
+	return subscribe(&s.resourceEventSubscribers, s.subscriberBufferSize)
+}
+
+// SubscribeRoutineTaskLifecycleEvents returns the channel of every RoutineTask lifecycle event received by this gateway,
+// the caller must filter the events by the current user and call the returned function to unsubscribe.
+func (s *RealtimeEventCacheClient) SubscribeRoutineTaskLifecycleEvents() (<-chan RoutineTaskLifecycleEvent, func()) {
+	return subscribe(&s.routineTaskLifecycleEventSubscribers, s.subscriberBufferSize)
+}
@@ -0,0 +1,113 @@
+package realtimeevent
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis"
+	"github.com/google/uuid"
+
+	platformredis "github.com/HiIamJeff67/notegic-backend/shared/platform/redis"
+)
+
+func newTestRealtimeEventCacheClient(t *testing.T) (*RealtimeEventCacheClient, *redis.Client) {
+	t.Helper()
+
+	server, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("failed to start test redis server: %v", err)
+	}
+	t.Cleanup(server.Close)
+	redisClient := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { _ = redisClient.Close() })
+
+	client := NewRealtimeEventCacheClient(NewRealtimeEventCacheStore(platformredis.NewClientSetFromClients(redisClient)))
+	t.Cleanup(client.Close)
+	return client, redisClient
+}
+
+func publish(t *testing.T, redisClient *redis.Client, channel string, payload any) {
+	t.Helper()
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("failed to encode the realtime event: %v", err)
+	}
+	if err := redisClient.Publish(channel, data).Err(); err != nil {
+		t.Fatalf("failed to publish the realtime event: %v", err)
+	}
+}
+
+func TestRealtimeEventCacheClientBroadcastsEventsToEverySubscriber(t *testing.T) {
+	client, redisClient := newTestRealtimeEventCacheClient(t)
+	if err := client.Listen(); err != nil {
+		t.Fatalf("failed to listen the realtime events: %v", err)
+	}
+	firstEvents, unsubscribeFirst := client.SubscribeResourceEvents()
+	defer unsubscribeFirst()
+	secondEvents, unsubscribeSecond := client.SubscribeResourceEvents()
+	defer unsubscribeSecond()
+	lifecycleEvents, unsubscribeLifecycle := client.SubscribeRoutineTaskLifecycleEvents()
+	defer unsubscribeLifecycle()
+
+	// a malformed payload is skipped instead of stopping the dispatch
+	if err := redisClient.Publish(client.resourceEventKey(), "{").Err(); err != nil {
+		t.Fatalf("failed to publish the malformed event: %v", err)
+	}
+	resourceEvent := ResourceEvent{EventId: uuid.New(), EventType: "RootShelfDeleted", ResourceId: uuid.New(), Change: "deleted"}
+	publish(t, redisClient, client.resourceEventKey(), resourceEvent)
+	lifecycleEvent := RoutineTaskLifecycleEvent{EventId: uuid.New(), RoutineTaskId: uuid.New(), Status: "Completed"}
+	publish(t, redisClient, client.routineTaskLifecycleKey(), lifecycleEvent)
+
+	for _, events := range []<-chan ResourceEvent{firstEvents, secondEvents} {
+		select {
+		case event := <-events:
+			if event.EventId != resourceEvent.EventId || event.ResourceId != resourceEvent.ResourceId {
+				t.Fatalf("unexpected resource event: %#v", event)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatal("timed out waiting for the resource event")
+		}
+	}
+	select {
+	case event := <-lifecycleEvents:
+		if event.EventId != lifecycleEvent.EventId || event.Status != "Completed" {
+			t.Fatalf("unexpected lifecycle event: %#v", event)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for the lifecycle event")
+	}
+}
+
+func TestRealtimeEventCacheClientDropsEventsOfSlowSubscribers(t *testing.T) {
+	client := NewRealtimeEventCacheClient(nil)
+	client.subscriberBufferSize = 1
+	events, unsubscribe := client.SubscribeResourceEvents()
+
+	first, second := ResourceEvent{EventId: uuid.New()}, ResourceEvent{EventId: uuid.New()}
+	broadcast(&client.resourceEventSubscribers, first)
+	broadcast(&client.resourceEventSubscribers, second)
+	if event := <-events; event.EventId != first.EventId {
+		t.Fatalf("expected the buffered event, got %#v", event)
+	}
+	select {
+	case event := <-events:
+		t.Fatalf("expected the event beyond the buffer to be dropped, got %#v", event)
+	default:
+	}
+
+	unsubscribe()
+	unsubscribe()
+	if _, ok := <-events; ok {
+		t.Fatal("expected the channel to be closed after unsubscribing")
+	}
+	broadcast(&client.resourceEventSubscribers, first)
+}
+
+func TestRealtimeEventCacheClientListenRequiresCacheStore(t *testing.T) {
+	if err := NewRealtimeEventCacheClient(nil).Listen(); err == nil {
+		t.Fatal("expected Listen to fail without a cache store")
+	}
+}
@@ -0,0 +1,30 @@
+package realtimeevent
+
+import (
+	"context"
+
+	platformredis "github.com/HiIamJeff67/notegic-backend/shared/platform/redis"
+)
+
+type RealtimeEventCacheStore struct {
+	clientSet *platformredis.ClientSet
+}
+
+func NewRealtimeEventCacheStore(
+	clientSet *platformredis.ClientSet,
+) *RealtimeEventCacheStore {
+	return &RealtimeEventCacheStore{
+		clientSet: clientSet,
+	}
+}
+
+func Register(
+	_ context.Context,
+	clientSet *platformredis.ClientSet,
+) *RealtimeEventCacheStore {
+	return NewRealtimeEventCacheStore(clientSet)
+}
+
+func (s *RealtimeEventCacheStore) ClientSet() *platformredis.ClientSet {
+	return s.clientSet
+}
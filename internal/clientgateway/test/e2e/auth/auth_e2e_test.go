@@ -57,6 +57,13 @@ func TestAuthE2E(t *testing.T) {
 				HTTPOnly: true,
 				SameSite: http.SameSiteStrictMode,
 			}),
+			OAuthStateTokenCookieHandler: cookies.New(cookies.Config{
+				Name:     cookies.ValidCookieName_OAuthStateToken,
+				Path:     "/",
+				Duration: 10 * time.Minute,
+				HTTPOnly: true,
+				SameSite: http.SameSiteLaxMode,
+			}),
 			AuthorizedRateLimiter: nil,
 		},
 	)
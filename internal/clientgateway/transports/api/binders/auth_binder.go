@@ -2,9 +2,11 @@ package binders
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 
 	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
 
+	cookies "github.com/HiIamJeff67/notegic-backend/shared/cookies"
 	exceptionwriter "github.com/HiIamJeff67/notegic-backend/shared/util/exceptionwriter"
 
 	apicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/auth"
@@ -24,6 +26,22 @@ type AuthBinderInterface interface {
 	BindForgetPassword(controllerFunc controllers.Func[*apicontract.ForgetPasswordRequestDto]) gin.HandlerFunc
 	BindResetMe(controllerFunc controllers.Func[*apicontract.ResetMeRequestDto]) gin.HandlerFunc
 	BindDeleteMe(controllerFunc controllers.Func[*apicontract.DeleteMeRequestDto]) gin.HandlerFunc
+	BindGetMySessions(controllerFunc controllers.Func[*apicontract.GetMySessionsRequestDto]) gin.HandlerFunc
+	BindRevokeMySession(controllerFunc controllers.Func[*apicontract.RevokeMySessionRequestDto]) gin.HandlerFunc
+	BindRevokeMyOtherSessions(controllerFunc controllers.Func[*apicontract.RevokeMyOtherSessionsRequestDto]) gin.HandlerFunc
+	BindGetMyTwoFactor(controllerFunc controllers.Func[*apicontract.GetMyTwoFactorRequestDto]) gin.HandlerFunc
+	BindEnrollMyTwoFactor(controllerFunc controllers.Func[*apicontract.EnrollMyTwoFactorRequestDto]) gin.HandlerFunc
+	BindEnableMyTwoFactor(controllerFunc controllers.Func[*apicontract.EnableMyTwoFactorRequestDto]) gin.HandlerFunc
+	BindDisableMyTwoFactor(controllerFunc controllers.Func[*apicontract.DisableMyTwoFactorRequestDto]) gin.HandlerFunc
+	BindRegenerateMyTwoFactorRecoveryCodes(controllerFunc controllers.Func[*apicontract.RegenerateMyTwoFactorRecoveryCodesRequestDto]) gin.HandlerFunc
+	BindVerifyLoginTwoFactor(controllerFunc controllers.Func[*apicontract.VerifyLoginTwoFactorRequestDto]) gin.HandlerFunc
+	BindGetOAuthAuthorizationURL(controllerFunc controllers.Func[*apicontract.GetOAuthAuthorizationURLRequestDto]) gin.HandlerFunc
+	BindRegisterViaGithub(controllerFunc controllers.Func[*apicontract.RegisterViaGithubRequestDto]) gin.HandlerFunc
+	BindLoginViaGithub(controllerFunc controllers.Func[*apicontract.LoginViaGithubRequestDto]) gin.HandlerFunc
+	BindRegisterViaMeta(controllerFunc controllers.Func[*apicontract.RegisterViaMetaRequestDto]) gin.HandlerFunc
+	BindLoginViaMeta(controllerFunc controllers.Func[*apicontract.LoginViaMetaRequestDto]) gin.HandlerFunc
+	BindRegisterViaOIDC(controllerFunc controllers.Func[*apicontract.RegisterViaOIDCRequestDto]) gin.HandlerFunc
+	BindLoginViaOIDC(controllerFunc controllers.Func[*apicontract.LoginViaOIDCRequestDto]) gin.HandlerFunc
 }
 
 type AuthBinder struct{}
@@ -36,6 +54,7 @@ func (b *AuthBinder) BindRegister(controllerFunc controllers.Func[*apicontract.R
 	return func(ctx *gin.Context) {
 		requestDto := &apicontract.RegisterRequestDto{}
 		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+		requestDto.Header.IpAddress = ctx.ClientIP()
 		if err := ctx.ShouldBindJSON(&requestDto.Body); err != nil {
 			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("Auth").WithOrigin(err), ctx)
 			return
@@ -49,6 +68,8 @@ func (b *AuthBinder) BindRegisterViaGoogle(controllerFunc controllers.Func[*apic
 	return func(ctx *gin.Context) {
 		requestDto := &apicontract.RegisterViaGoogleRequestDto{}
 		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+		requestDto.Header.IpAddress = ctx.ClientIP()
+		requestDto.Header.OAuthStateToken, _ = ctx.Cookie(cookies.ValidCookieName_OAuthStateToken.String())
 		if err := ctx.ShouldBindJSON(&requestDto.Body); err != nil {
 			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("Auth").WithOrigin(err), ctx)
 			return
@@ -62,6 +83,7 @@ func (b *AuthBinder) BindLogin(controllerFunc controllers.Func[*apicontract.Logi
 	return func(ctx *gin.Context) {
 		requestDto := &apicontract.LoginRequestDto{}
 		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+		requestDto.Header.IpAddress = ctx.ClientIP()
 		if err := ctx.ShouldBindJSON(&requestDto.Body); err != nil {
 			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("Auth").WithOrigin(err), ctx)
 			return
@@ -75,6 +97,8 @@ func (b *AuthBinder) BindLoginViaGoogle(controllerFunc controllers.Func[*apicont
 	return func(ctx *gin.Context) {
 		requestDto := &apicontract.LoginViaGoogleRequestDto{}
 		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+		requestDto.Header.IpAddress = ctx.ClientIP()
+		requestDto.Header.OAuthStateToken, _ = ctx.Cookie(cookies.ValidCookieName_OAuthStateToken.String())
 		if err := ctx.ShouldBindJSON(&requestDto.Body); err != nil {
 			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("Auth").WithOrigin(err), ctx)
 			return
@@ -170,3 +194,210 @@ func (b *AuthBinder) BindDeleteMe(controllerFunc controllers.Func[*apicontract.D
 		controllerFunc(ctx, requestDto)
 	}
 }
+
+func (b *AuthBinder) BindGetMySessions(controllerFunc controllers.Func[*apicontract.GetMySessionsRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestDto := &apicontract.GetMySessionsRequestDto{}
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+
+		controllerFunc(ctx, requestDto)
+	}
+}
+
+func (b *AuthBinder) BindRevokeMySession(controllerFunc controllers.Func[*apicontract.RevokeMySessionRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestDto := &apicontract.RevokeMySessionRequestDto{}
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+		sessionId, err := uuid.Parse(ctx.Param("session-id"))
+		if err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("Auth").WithOrigin(err), ctx)
+			return
+		}
+		requestDto.Param.SessionId = sessionId
+
+		controllerFunc(ctx, requestDto)
+	}
+}
+
+func (b *AuthBinder) BindRevokeMyOtherSessions(controllerFunc controllers.Func[*apicontract.RevokeMyOtherSessionsRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestDto := &apicontract.RevokeMyOtherSessionsRequestDto{}
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+
+		controllerFunc(ctx, requestDto)
+	}
+}
+
+func (b *AuthBinder) BindGetMyTwoFactor(controllerFunc controllers.Func[*apicontract.GetMyTwoFactorRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestDto := &apicontract.GetMyTwoFactorRequestDto{}
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+
+		controllerFunc(ctx, requestDto)
+	}
+}
+
+func (b *AuthBinder) BindEnrollMyTwoFactor(controllerFunc controllers.Func[*apicontract.EnrollMyTwoFactorRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestDto := &apicontract.EnrollMyTwoFactorRequestDto{}
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+
+		controllerFunc(ctx, requestDto)
+	}
+}
+
+func (b *AuthBinder) BindEnableMyTwoFactor(controllerFunc controllers.Func[*apicontract.EnableMyTwoFactorRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestDto := &apicontract.EnableMyTwoFactorRequestDto{}
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+		if err := ctx.ShouldBindJSON(&requestDto.Body); err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("Auth").WithOrigin(err), ctx)
+			return
+		}
+
+		controllerFunc(ctx, requestDto)
+	}
+}
+
+func (b *AuthBinder) BindDisableMyTwoFactor(controllerFunc controllers.Func[*apicontract.DisableMyTwoFactorRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestDto := &apicontract.DisableMyTwoFactorRequestDto{}
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+		if err := ctx.ShouldBindJSON(&requestDto.Body); err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("Auth").WithOrigin(err), ctx)
+			return
+		}
+
+		controllerFunc(ctx, requestDto)
+	}
+}
+
+func (b *AuthBinder) BindRegenerateMyTwoFactorRecoveryCodes(controllerFunc controllers.Func[*apicontract.RegenerateMyTwoFactorRecoveryCodesRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestDto := &apicontract.RegenerateMyTwoFactorRecoveryCodesRequestDto{}
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+		if err := ctx.ShouldBindJSON(&requestDto.Body); err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("Auth").WithOrigin(err), ctx)
+			return
+		}
+
+		controllerFunc(ctx, requestDto)
+	}
+}
+
+func (b *AuthBinder) BindVerifyLoginTwoFactor(controllerFunc controllers.Func[*apicontract.VerifyLoginTwoFactorRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestDto := &apicontract.VerifyLoginTwoFactorRequestDto{}
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+		requestDto.Header.IpAddress = ctx.ClientIP()
+		if err := ctx.ShouldBindJSON(&requestDto.Body); err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("Auth").WithOrigin(err), ctx)
+			return
+		}
+
+		controllerFunc(ctx, requestDto)
+	}
+}
+
+func (b *AuthBinder) BindGetOAuthAuthorizationURL(controllerFunc controllers.Func[*apicontract.GetOAuthAuthorizationURLRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestDto := &apicontract.GetOAuthAuthorizationURLRequestDto{}
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+		if err := ctx.ShouldBindJSON(&requestDto.Body); err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("Auth").WithOrigin(err), ctx)
+			return
+		}
+
+		controllerFunc(ctx, requestDto)
+	}
+}
+
+func (b *AuthBinder) BindRegisterViaGithub(controllerFunc controllers.Func[*apicontract.RegisterViaGithubRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestDto := &apicontract.RegisterViaGithubRequestDto{}
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+		requestDto.Header.IpAddress = ctx.ClientIP()
+		requestDto.Header.OAuthStateToken, _ = ctx.Cookie(cookies.ValidCookieName_OAuthStateToken.String())
+		if err := ctx.ShouldBindJSON(&requestDto.Body); err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("Auth").WithOrigin(err), ctx)
+			return
+		}
+
+		controllerFunc(ctx, requestDto)
+	}
+}
+
+func (b *AuthBinder) BindLoginViaGithub(controllerFunc controllers.Func[*apicontract.LoginViaGithubRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestDto := &apicontract.LoginViaGithubRequestDto{}
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+		requestDto.Header.IpAddress = ctx.ClientIP()
+		requestDto.Header.OAuthStateToken, _ = ctx.Cookie(cookies.ValidCookieName_OAuthStateToken.String())
+		if err := ctx.ShouldBindJSON(&requestDto.Body); err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("Auth").WithOrigin(err), ctx)
+			return
+		}
+
+		controllerFunc(ctx, requestDto)
+	}
+}
+
+func (b *AuthBinder) BindRegisterViaMeta(controllerFunc controllers.Func[*apicontract.RegisterViaMetaRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestDto := &apicontract.RegisterViaMetaRequestDto{}
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+		requestDto.Header.IpAddress = ctx.ClientIP()
+		requestDto.Header.OAuthStateToken, _ = ctx.Cookie(cookies.ValidCookieName_OAuthStateToken.String())
+		if err := ctx.ShouldBindJSON(&requestDto.Body); err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("Auth").WithOrigin(err), ctx)
+			return
+		}
+
+		controllerFunc(ctx, requestDto)
+	}
+}
+
+func (b *AuthBinder) BindLoginViaMeta(controllerFunc controllers.Func[*apicontract.LoginViaMetaRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestDto := &apicontract.LoginViaMetaRequestDto{}
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+		requestDto.Header.IpAddress = ctx.ClientIP()
+		requestDto.Header.OAuthStateToken, _ = ctx.Cookie(cookies.ValidCookieName_OAuthStateToken.String())
+		if err := ctx.ShouldBindJSON(&requestDto.Body); err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("Auth").WithOrigin(err), ctx)
+			return
+		}
+
+		controllerFunc(ctx, requestDto)
+	}
+}
+
+func (b *AuthBinder) BindRegisterViaOIDC(controllerFunc controllers.Func[*apicontract.RegisterViaOIDCRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestDto := &apicontract.RegisterViaOIDCRequestDto{}
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+		requestDto.Header.IpAddress = ctx.ClientIP()
+		requestDto.Header.OAuthStateToken, _ = ctx.Cookie(cookies.ValidCookieName_OAuthStateToken.String())
+		if err := ctx.ShouldBindJSON(&requestDto.Body); err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("Auth").WithOrigin(err), ctx)
+			return
+		}
+
+		controllerFunc(ctx, requestDto)
+	}
+}
+
+func (b *AuthBinder) BindLoginViaOIDC(controllerFunc controllers.Func[*apicontract.LoginViaOIDCRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestDto := &apicontract.LoginViaOIDCRequestDto{}
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+		requestDto.Header.IpAddress = ctx.ClientIP()
+		requestDto.Header.OAuthStateToken, _ = ctx.Cookie(cookies.ValidCookieName_OAuthStateToken.String())
+		if err := ctx.ShouldBindJSON(&requestDto.Body); err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("Auth").WithOrigin(err), ctx)
+			return
+		}
+
+		controllerFunc(ctx, requestDto)
+	}
+}
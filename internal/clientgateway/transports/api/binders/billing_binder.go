@@ -0,0 +1,112 @@
+package binders
+
+import (
+	"github.com/gin-gonic/gin"
+
+	apicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/billing"
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+	exceptionwriter "github.com/HiIamJeff67/notegic-backend/shared/util/exceptionwriter"
+
+	controllers "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/api/controllers"
+)
+
+type BillingBinderInterface interface {
+	BindGetBillingPlans(controllers.Func[*apicontract.GetBillingPlansRequestDto]) gin.HandlerFunc
+	BindGetMySubscription(controllers.Func[*apicontract.GetMySubscriptionRequestDto]) gin.HandlerFunc
+	BindCreateMySubscription(controllers.Func[*apicontract.CreateMySubscriptionRequestDto]) gin.HandlerFunc
+	BindChangeMySubscriptionPlan(controllers.Func[*apicontract.ChangeMySubscriptionPlanRequestDto]) gin.HandlerFunc
+	BindCancelMySubscription(controllers.Func[*apicontract.CancelMySubscriptionRequestDto]) gin.HandlerFunc
+	BindGetMyBillingInvoices(controllers.Func[*apicontract.GetMyBillingInvoicesRequestDto]) gin.HandlerFunc
+	BindHandlePayPalWebhook(controllers.Func[*apicontract.HandlePayPalWebhookRequestDto]) gin.HandlerFunc
+}
+
+type BillingBinder struct{}
+
+func NewBillingBinder() BillingBinderInterface { return &BillingBinder{} }
+
+func (b *BillingBinder) BindGetBillingPlans(controllerFunc controllers.Func[*apicontract.GetBillingPlansRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		request := &apicontract.GetBillingPlansRequestDto{}
+		request.Header.UserAgent = ctx.GetHeader("User-Agent")
+		controllerFunc(ctx, request)
+	}
+}
+
+func (b *BillingBinder) BindGetMySubscription(controllerFunc controllers.Func[*apicontract.GetMySubscriptionRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		request := &apicontract.GetMySubscriptionRequestDto{}
+		request.Header.UserAgent = ctx.GetHeader("User-Agent")
+		controllerFunc(ctx, request)
+	}
+}
+
+func (b *BillingBinder) BindCreateMySubscription(controllerFunc controllers.Func[*apicontract.CreateMySubscriptionRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		request := &apicontract.CreateMySubscriptionRequestDto{}
+		request.Header.UserAgent = ctx.GetHeader("User-Agent")
+		if err := ctx.ShouldBindJSON(&request.Body); err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("Billing").WithOrigin(err), ctx)
+			return
+		}
+		controllerFunc(ctx, request)
+	}
+}
+
+func (b *BillingBinder) BindChangeMySubscriptionPlan(controllerFunc controllers.Func[*apicontract.ChangeMySubscriptionPlanRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		request := &apicontract.ChangeMySubscriptionPlanRequestDto{}
+		request.Header.UserAgent = ctx.GetHeader("User-Agent")
+		if err := ctx.ShouldBindJSON(&request.Body); err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("Billing").WithOrigin(err), ctx)
+			return
+		}
+		controllerFunc(ctx, request)
+	}
+}
+
+func (b *BillingBinder) BindCancelMySubscription(controllerFunc controllers.Func[*apicontract.CancelMySubscriptionRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		request := &apicontract.CancelMySubscriptionRequestDto{}
+		request.Header.UserAgent = ctx.GetHeader("User-Agent")
+		// the reason of the cancellation is optional, so is the body
+		if ctx.Request.ContentLength != 0 {
+			if err := ctx.ShouldBindJSON(&request.Body); err != nil {
+				exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("Billing").WithOrigin(err), ctx)
+				return
+			}
+		}
+		controllerFunc(ctx, request)
+	}
+}
+
+func (b *BillingBinder) BindGetMyBillingInvoices(controllerFunc controllers.Func[*apicontract.GetMyBillingInvoicesRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		request := &apicontract.GetMyBillingInvoicesRequestDto{}
+		request.Header.UserAgent = ctx.GetHeader("User-Agent")
+		if err := ctx.ShouldBindQuery(&request.Param); err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("Billing").WithOrigin(err), ctx)
+			return
+		}
+		controllerFunc(ctx, request)
+	}
+}
+
+// BindHandlePayPalWebhook keeps the body as it was received, since PayPal signs the exact bytes
+// of the delivery, and forwards the transmission headers the signature is verified with.
+func (b *BillingBinder) BindHandlePayPalWebhook(controllerFunc controllers.Func[*apicontract.HandlePayPalWebhookRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		request := &apicontract.HandlePayPalWebhookRequestDto{}
+		request.Header.AuthAlgo = ctx.GetHeader("PAYPAL-AUTH-ALGO")
+		request.Header.CertURL = ctx.GetHeader("PAYPAL-CERT-URL")
+		request.Header.TransmissionId = ctx.GetHeader("PAYPAL-TRANSMISSION-ID")
+		request.Header.TransmissionSig = ctx.GetHeader("PAYPAL-TRANSMISSION-SIG")
+		request.Header.TransmissionTime = ctx.GetHeader("PAYPAL-TRANSMISSION-TIME")
+		payload, err := ctx.GetRawData()
+		if err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("Billing").WithOrigin(err), ctx)
+			return
+		}
+		request.Body.Payload = string(payload)
+		controllerFunc(ctx, request)
+	}
+}
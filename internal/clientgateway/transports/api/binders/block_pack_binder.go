@@ -20,8 +20,10 @@ type BlockPackBinderInterface interface {
 	BindGetMyBlockPackAndItsParentById(controllerFunc controllers.Func[*apicontract.GetMyBlockPackAndItsParentByIdRequestDto]) gin.HandlerFunc
 	BindGetMyBlockPacksByParentSubShelfId(controllerFunc controllers.Func[*apicontract.GetMyBlockPacksByParentSubShelfIdRequestDto]) gin.HandlerFunc
 	BindGetAllMyBlockPacksByRootShelfId(controllerFunc controllers.Func[*apicontract.GetAllMyBlockPacksByRootShelfIdRequestDto]) gin.HandlerFunc
+	BindGetMyBlockPackMarkdownById(controllerFunc controllers.Func[*apicontract.GetMyBlockPackMarkdownByIdRequestDto]) gin.HandlerFunc
 	BindCreateBlockPack(controllerFunc controllers.Func[*apicontract.CreateBlockPackRequestDto]) gin.HandlerFunc
 	BindCreateBlockPacks(controllerFunc controllers.Func[*apicontract.CreateBlockPacksRequestDto]) gin.HandlerFunc
+	BindCreateBlockPackFromMarkdown(controllerFunc controllers.Func[*apicontract.CreateBlockPackFromMarkdownRequestDto]) gin.HandlerFunc
 	BindUpdateMyBlockPackById(controllerFunc controllers.Func[*apicontract.UpdateMyBlockPackByIdRequestDto]) gin.HandlerFunc
 	BindUpdateMyBlockPacksByIds(controllerFunc controllers.Func[*apicontract.UpdateMyBlockPacksByIdsRequestDto]) gin.HandlerFunc
 	BindMoveMyBlockPackByParentSubShelfId(controllerFunc controllers.Func[*apicontract.MoveMyBlockPackByParentSubShelfIdRequestDto]) gin.HandlerFunc
@@ -31,6 +33,10 @@ type BlockPackBinderInterface interface {
 	BindRestoreMyBlockPacksByIds(controllerFunc controllers.Func[*apicontract.RestoreMyBlockPacksByIdsRequestDto]) gin.HandlerFunc
 	BindDeleteMyBlockPackById(controllerFunc controllers.Func[*apicontract.DeleteMyBlockPackByIdRequestDto]) gin.HandlerFunc
 	BindDeleteMyBlockPacksByIds(controllerFunc controllers.Func[*apicontract.DeleteMyBlockPacksByIdsRequestDto]) gin.HandlerFunc
+	BindCreateMyBlockPackSnapshotById(controllerFunc controllers.Func[*apicontract.CreateMyBlockPackSnapshotByIdRequestDto]) gin.HandlerFunc
+	BindGetMyBlockPackSnapshotsById(controllerFunc controllers.Func[*apicontract.GetMyBlockPackSnapshotsByIdRequestDto]) gin.HandlerFunc
+	BindGetMyBlockPackSnapshotDiffById(controllerFunc controllers.Func[*apicontract.GetMyBlockPackSnapshotDiffByIdRequestDto]) gin.HandlerFunc
+	BindRestoreMyBlockPackSnapshotById(controllerFunc controllers.Func[*apicontract.RestoreMyBlockPackSnapshotByIdRequestDto]) gin.HandlerFunc
 }
 
 type BlockPackBinder struct{}
@@ -147,6 +153,23 @@ func (b *BlockPackBinder) BindGetAllMyBlockPacksByRootShelfId(controllerFunc con
 	}
 }
 
+func (b *BlockPackBinder) BindGetMyBlockPackMarkdownById(controllerFunc controllers.Func[*apicontract.GetMyBlockPackMarkdownByIdRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var requestDto apicontract.GetMyBlockPackMarkdownByIdRequestDto
+
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+
+		value, err := uuid.Parse(ctx.Param("block-pack-id"))
+		if err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidInput("BlockPack").WithOrigin(err), ctx)
+			return
+		}
+		requestDto.Param.BlockPackId = value
+
+		controllerFunc(ctx, &requestDto)
+	}
+}
+
 func (b *BlockPackBinder) BindCreateBlockPack(controllerFunc controllers.Func[*apicontract.CreateBlockPackRequestDto]) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		var requestDto apicontract.CreateBlockPackRequestDto
@@ -186,6 +209,29 @@ func (b *BlockPackBinder) BindCreateBlockPacks(controllerFunc controllers.Func[*
 	}
 }
 
+func (b *BlockPackBinder) BindCreateBlockPackFromMarkdown(controllerFunc controllers.Func[*apicontract.CreateBlockPackFromMarkdownRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var requestDto apicontract.CreateBlockPackFromMarkdownRequestDto
+
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+
+		if err := ctx.ShouldBindJSON(&requestDto.Body); err != nil {
+			exception := exceptions.InvalidDto("BlockPack").WithOrigin(err)
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+			return
+		}
+
+		value, err := uuid.Parse(ctx.Param("parent-sub-shelf-id"))
+		if err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidInput("BlockPack").WithOrigin(err), ctx)
+			return
+		}
+		requestDto.Body.ParentSubShelfId = value
+
+		controllerFunc(ctx, &requestDto)
+	}
+}
+
 func (b *BlockPackBinder) BindUpdateMyBlockPackById(controllerFunc controllers.Func[*apicontract.UpdateMyBlockPackByIdRequestDto]) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		var requestDto apicontract.UpdateMyBlockPackByIdRequestDto
@@ -345,3 +391,101 @@ func (b *BlockPackBinder) BindDeleteMyBlockPacksByIds(controllerFunc controllers
 		controllerFunc(ctx, &requestDto)
 	}
 }
+
+func (b *BlockPackBinder) BindCreateMyBlockPackSnapshotById(controllerFunc controllers.Func[*apicontract.CreateMyBlockPackSnapshotByIdRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var requestDto apicontract.CreateMyBlockPackSnapshotByIdRequestDto
+
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+
+		if err := ctx.ShouldBindJSON(&requestDto.Body); err != nil {
+			exception := exceptions.InvalidDto("BlockPack").WithOrigin(err)
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+			return
+		}
+
+		value, err := uuid.Parse(ctx.Param("block-pack-id"))
+		if err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidInput("BlockPack").WithOrigin(err), ctx)
+			return
+		}
+		requestDto.Param.BlockPackId = value
+
+		controllerFunc(ctx, &requestDto)
+	}
+}
+
+func (b *BlockPackBinder) BindGetMyBlockPackSnapshotsById(controllerFunc controllers.Func[*apicontract.GetMyBlockPackSnapshotsByIdRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var requestDto apicontract.GetMyBlockPackSnapshotsByIdRequestDto
+
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+
+		value, err := uuid.Parse(ctx.Param("block-pack-id"))
+		if err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidInput("BlockPack").WithOrigin(err), ctx)
+			return
+		}
+		requestDto.Param.BlockPackId = value
+
+		controllerFunc(ctx, &requestDto)
+	}
+}
+
+func (b *BlockPackBinder) BindGetMyBlockPackSnapshotDiffById(controllerFunc controllers.Func[*apicontract.GetMyBlockPackSnapshotDiffByIdRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var requestDto apicontract.GetMyBlockPackSnapshotDiffByIdRequestDto
+
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+
+		value, err := uuid.Parse(ctx.Param("block-pack-id"))
+		if err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidInput("BlockPack").WithOrigin(err), ctx)
+			return
+		}
+		requestDto.Param.BlockPackId = value
+
+		fromSnapshotId, err := uuid.Parse(ctx.Query("fromSnapshotId"))
+		if err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidInput("BlockPack").WithOrigin(err), ctx)
+			return
+		}
+		requestDto.Param.FromSnapshotId = fromSnapshotId
+
+		toSnapshotIdString := ctx.Query("toSnapshotId")
+		if toSnapshotIdString != "" {
+			toSnapshotId, err := uuid.Parse(toSnapshotIdString)
+			if err != nil {
+				exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidInput("BlockPack").WithOrigin(err), ctx)
+				return
+			}
+			requestDto.Param.ToSnapshotId = &toSnapshotId
+		}
+
+		controllerFunc(ctx, &requestDto)
+	}
+}
+
+func (b *BlockPackBinder) BindRestoreMyBlockPackSnapshotById(controllerFunc controllers.Func[*apicontract.RestoreMyBlockPackSnapshotByIdRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var requestDto apicontract.RestoreMyBlockPackSnapshotByIdRequestDto
+
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+
+		value, err := uuid.Parse(ctx.Param("block-pack-id"))
+		if err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidInput("BlockPack").WithOrigin(err), ctx)
+			return
+		}
+		requestDto.Param.BlockPackId = value
+
+		snapshotId, err := uuid.Parse(ctx.Param("snapshot-id"))
+		if err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidInput("BlockPack").WithOrigin(err), ctx)
+			return
+		}
+		requestDto.Param.SnapshotId = snapshotId
+
+		controllerFunc(ctx, &requestDto)
+	}
+}
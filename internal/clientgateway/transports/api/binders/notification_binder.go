@@ -16,6 +16,13 @@ type NotificationBinderInterface interface {
 	BindCountUnread(controllers.Func[*notificationscontract.CountUnreadNotificationsRequestDto]) gin.HandlerFunc
 	BindMarkRead(controllers.Func[*notificationscontract.MarkNotificationsReadRequestDto]) gin.HandlerFunc
 	BindDelete(controllers.Func[*notificationscontract.DeleteNotificationsRequestDto]) gin.HandlerFunc
+	BindGetPreferences(controllers.Func[*notificationscontract.GetNotificationPreferencesRequestDto]) gin.HandlerFunc
+	BindUpdatePreferences(controllers.Func[*notificationscontract.UpdateNotificationPreferencesRequestDto]) gin.HandlerFunc
+	BindGetPushPublicKey(controllers.Func[*notificationscontract.GetPushPublicKeyRequestDto]) gin.HandlerFunc
+	BindRegisterPushSubscription(controllers.Func[*notificationscontract.RegisterPushSubscriptionRequestDto]) gin.HandlerFunc
+	BindUnregisterPushSubscription(controllers.Func[*notificationscontract.UnregisterPushSubscriptionRequestDto]) gin.HandlerFunc
+	BindConfirmUnsubscribeDigest(controllers.Func[*notificationscontract.UnsubscribeNotificationDigestRequestDto]) gin.HandlerFunc
+	BindUnsubscribeDigest(controllers.Func[*notificationscontract.UnsubscribeNotificationDigestRequestDto]) gin.HandlerFunc
 }
 
 type NotificationBinder struct{}
@@ -70,3 +77,76 @@ func (b *NotificationBinder) BindDelete(
 		controllerFunc(ctx, requestDto)
 	}
 }
+
+func (b *NotificationBinder) BindGetPreferences(
+	controllerFunc controllers.Func[*notificationscontract.GetNotificationPreferencesRequestDto],
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		controllerFunc(ctx, &notificationscontract.GetNotificationPreferencesRequestDto{})
+	}
+}
+
+func (b *NotificationBinder) BindUpdatePreferences(
+	controllerFunc controllers.Func[*notificationscontract.UpdateNotificationPreferencesRequestDto],
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestDto := &notificationscontract.UpdateNotificationPreferencesRequestDto{}
+		if err := ctx.ShouldBindJSON(requestDto); err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("Notification").WithOrigin(err), ctx)
+			return
+		}
+		controllerFunc(ctx, requestDto)
+	}
+}
+
+func (b *NotificationBinder) BindGetPushPublicKey(
+	controllerFunc controllers.Func[*notificationscontract.GetPushPublicKeyRequestDto],
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		controllerFunc(ctx, &notificationscontract.GetPushPublicKeyRequestDto{})
+	}
+}
+
+func (b *NotificationBinder) BindRegisterPushSubscription(
+	controllerFunc controllers.Func[*notificationscontract.RegisterPushSubscriptionRequestDto],
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestDto := &notificationscontract.RegisterPushSubscriptionRequestDto{}
+		if err := ctx.ShouldBindJSON(requestDto); err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("Notification").WithOrigin(err), ctx)
+			return
+		}
+		controllerFunc(ctx, requestDto)
+	}
+}
+
+func (b *NotificationBinder) BindUnregisterPushSubscription(
+	controllerFunc controllers.Func[*notificationscontract.UnregisterPushSubscriptionRequestDto],
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestDto := &notificationscontract.UnregisterPushSubscriptionRequestDto{}
+		if err := ctx.ShouldBindJSON(requestDto); err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("Notification").WithOrigin(err), ctx)
+			return
+		}
+		controllerFunc(ctx, requestDto)
+	}
+}
+
+func (b *NotificationBinder) BindConfirmUnsubscribeDigest(
+	controllerFunc controllers.Func[*notificationscontract.UnsubscribeNotificationDigestRequestDto],
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		controllerFunc(ctx, &notificationscontract.UnsubscribeNotificationDigestRequestDto{})
+	}
+}
+
+// BindUnsubscribeDigest ignores the body, which is the List-Unsubscribe=One-Click form of RFC 8058 when the mail
+// client posts it, since the recipient comes from the unsubscribe token alone
+func (b *NotificationBinder) BindUnsubscribeDigest(
+	controllerFunc controllers.Func[*notificationscontract.UnsubscribeNotificationDigestRequestDto],
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		controllerFunc(ctx, &notificationscontract.UnsubscribeNotificationDigestRequestDto{})
+	}
+}
@@ -1,6 +1,7 @@
 package binders
 
 import (
+	"io"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
@@ -35,6 +36,9 @@ type RootShelfBinderInterface interface {
 	BindDeleteMyRootShelfPermissions(controllerFunc controllers.Func[*apicontract.DeleteMyRootShelfPermissionsRequestDto]) gin.HandlerFunc
 	BindLeaveMyRootShelf(controllerFunc controllers.Func[*apicontract.LeaveMyRootShelfRequestDto]) gin.HandlerFunc
 	BindLeaveMyRootShelves(controllerFunc controllers.Func[*apicontract.LeaveMyRootShelvesRequestDto]) gin.HandlerFunc
+	BindExportMyRootShelfArchiveById(controllerFunc controllers.Func[*apicontract.ExportMyRootShelfArchiveByIdRequestDto]) gin.HandlerFunc
+	BindCreateRootShelfFromArchive(controllerFunc controllers.Func[*apicontract.CreateRootShelfFromArchiveRequestDto]) gin.HandlerFunc
+	BindGetMyRootShelfArchiveJobById(controllerFunc controllers.Func[*apicontract.GetMyRootShelfArchiveJobByIdRequestDto]) gin.HandlerFunc
 }
 
 type RootShelfBinder struct{}
@@ -433,3 +437,78 @@ func (b *RootShelfBinder) BindLeaveMyRootShelves(controllerFunc controllers.Func
 		controllerFunc(ctx, requestDto)
 	}
 }
+
+func (b *RootShelfBinder) BindExportMyRootShelfArchiveById(controllerFunc controllers.Func[*apicontract.ExportMyRootShelfArchiveByIdRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var requestDto apicontract.ExportMyRootShelfArchiveByIdRequestDto
+
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+
+		value, err := uuid.Parse(ctx.Param("root-shelf-id"))
+		if err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidInput("Shelf").WithOrigin(err), ctx)
+			return
+		}
+		requestDto.Param.RootShelfId = value
+
+		controllerFunc(ctx, &requestDto)
+	}
+}
+
+func (b *RootShelfBinder) BindCreateRootShelfFromArchive(controllerFunc controllers.Func[*apicontract.CreateRootShelfFromArchiveRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var requestDto apicontract.CreateRootShelfFromArchiveRequestDto
+
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+
+		fileHeader, err := ctx.FormFile("archiveFile")
+		if err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("Shelf").WithOrigin(err), ctx)
+			return
+		}
+		file, err := fileHeader.Open()
+		if err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("Shelf").WithOrigin(err), ctx)
+			return
+		}
+		defer file.Close()
+
+		archiveFile, err := io.ReadAll(file)
+		if err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("Shelf").WithOrigin(err), ctx)
+			return
+		}
+		requestDto.Body.ArchiveFile = archiveFile
+
+		if targetRootShelfIdString := ctx.PostForm("targetRootShelfId"); targetRootShelfIdString != "" {
+			targetRootShelfId, err := uuid.Parse(targetRootShelfIdString)
+			if err != nil {
+				exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidInput("Shelf").WithOrigin(err), ctx)
+				return
+			}
+			requestDto.Body.TargetRootShelfId = &targetRootShelfId
+		}
+		if name, exists := ctx.GetPostForm("name"); exists {
+			requestDto.Body.Name = &name
+		}
+
+		controllerFunc(ctx, &requestDto)
+	}
+}
+
+func (b *RootShelfBinder) BindGetMyRootShelfArchiveJobById(controllerFunc controllers.Func[*apicontract.GetMyRootShelfArchiveJobByIdRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var requestDto apicontract.GetMyRootShelfArchiveJobByIdRequestDto
+
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+
+		value, err := uuid.Parse(ctx.Param("archive-job-id"))
+		if err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidInput("RootShelfArchiveJob").WithOrigin(err), ctx)
+			return
+		}
+		requestDto.Param.ArchiveJobId = value
+
+		controllerFunc(ctx, &requestDto)
+	}
+}
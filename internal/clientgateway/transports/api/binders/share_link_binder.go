@@ -0,0 +1,87 @@
+package binders
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	apicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/share-links"
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+	exceptionwriter "github.com/HiIamJeff67/notegic-backend/shared/util/exceptionwriter"
+
+	controllers "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/api/controllers"
+)
+
+type ShareLinkBinderInterface interface {
+	BindCreateMyShareLink(controllers.Func[*apicontract.CreateMyShareLinkRequestDto]) gin.HandlerFunc
+	BindGetMyShareLinksByRootShelfId(controllers.Func[*apicontract.GetMyShareLinksByRootShelfIdRequestDto]) gin.HandlerFunc
+	BindRevokeMyShareLinkById(controllers.Func[*apicontract.RevokeMyShareLinkByIdRequestDto]) gin.HandlerFunc
+	BindResolveShareLink(controllers.Func[*apicontract.ResolveShareLinkRequestDto]) gin.HandlerFunc
+}
+
+type ShareLinkBinder struct{}
+
+func NewShareLinkBinder() ShareLinkBinderInterface { return &ShareLinkBinder{} }
+
+func (b *ShareLinkBinder) BindCreateMyShareLink(controllerFunc controllers.Func[*apicontract.CreateMyShareLinkRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		request := &apicontract.CreateMyShareLinkRequestDto{}
+		request.Header.UserAgent = ctx.GetHeader("User-Agent")
+		if err := ctx.ShouldBindJSON(&request.Body); err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("ShareLink").WithOrigin(err), ctx)
+			return
+		}
+		rootShelfId, err := uuid.Parse(ctx.Param("root-shelf-id"))
+		if err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("ShareLink").WithOrigin(err), ctx)
+			return
+		}
+		request.Body.RootShelfId = rootShelfId
+		controllerFunc(ctx, request)
+	}
+}
+
+func (b *ShareLinkBinder) BindGetMyShareLinksByRootShelfId(controllerFunc controllers.Func[*apicontract.GetMyShareLinksByRootShelfIdRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		request := &apicontract.GetMyShareLinksByRootShelfIdRequestDto{}
+		request.Header.UserAgent = ctx.GetHeader("User-Agent")
+		rootShelfId, err := uuid.Parse(ctx.Param("root-shelf-id"))
+		if err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("ShareLink").WithOrigin(err), ctx)
+			return
+		}
+		request.Param.RootShelfId = rootShelfId
+		controllerFunc(ctx, request)
+	}
+}
+
+func (b *ShareLinkBinder) BindRevokeMyShareLinkById(controllerFunc controllers.Func[*apicontract.RevokeMyShareLinkByIdRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		request := &apicontract.RevokeMyShareLinkByIdRequestDto{}
+		request.Header.UserAgent = ctx.GetHeader("User-Agent")
+		rootShelfId, err := uuid.Parse(ctx.Param("root-shelf-id"))
+		if err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("ShareLink").WithOrigin(err), ctx)
+			return
+		}
+		shareLinkId, err := uuid.Parse(ctx.Param("share-link-id"))
+		if err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("ShareLink").WithOrigin(err), ctx)
+			return
+		}
+		request.Param.RootShelfId = rootShelfId
+		request.Param.ShareLinkId = shareLinkId
+		controllerFunc(ctx, request)
+	}
+}
+
+func (b *ShareLinkBinder) BindResolveShareLink(controllerFunc controllers.Func[*apicontract.ResolveShareLinkRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		request := &apicontract.ResolveShareLinkRequestDto{}
+		request.Header.UserAgent = ctx.GetHeader("User-Agent")
+		if err := ctx.ShouldBindJSON(&request.Body); err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("ShareLink").WithOrigin(err), ctx)
+			return
+		}
+		controllerFunc(ctx, request)
+	}
+}
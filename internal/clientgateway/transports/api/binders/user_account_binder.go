@@ -5,6 +5,7 @@ import (
 
 	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
 
+	cookies "github.com/HiIamJeff67/notegic-backend/shared/cookies"
 	exceptionwriter "github.com/HiIamJeff67/notegic-backend/shared/util/exceptionwriter"
 
 	apicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/user-accounts"
@@ -17,6 +18,13 @@ type UserAccountBinderInterface interface {
 	BindUpdateMyAccount(controllerFunc controllers.Func[*apicontract.UpdateMyAccountRequestDto]) gin.HandlerFunc
 	BindBindGoogleAccount(controllerFunc controllers.Func[*apicontract.BindGoogleAccountRequestDto]) gin.HandlerFunc
 	BindUnbindGoogleAccount(controllerFunc controllers.Func[*apicontract.UnbindGoogleAccountRequestDto]) gin.HandlerFunc
+	BindBindGithubAccount(controllerFunc controllers.Func[*apicontract.BindGithubAccountRequestDto]) gin.HandlerFunc
+	BindUnbindGithubAccount(controllerFunc controllers.Func[*apicontract.UnbindGithubAccountRequestDto]) gin.HandlerFunc
+	BindBindMetaAccount(controllerFunc controllers.Func[*apicontract.BindMetaAccountRequestDto]) gin.HandlerFunc
+	BindUnbindMetaAccount(controllerFunc controllers.Func[*apicontract.UnbindMetaAccountRequestDto]) gin.HandlerFunc
+	BindBindOIDCAccount(controllerFunc controllers.Func[*apicontract.BindOIDCAccountRequestDto]) gin.HandlerFunc
+	BindUnbindOIDCAccount(controllerFunc controllers.Func[*apicontract.UnbindOIDCAccountRequestDto]) gin.HandlerFunc
+	BindGetMyUsage(controllerFunc controllers.Func[*apicontract.GetMyUsageRequestDto]) gin.HandlerFunc
 }
 
 type UserAccountBinder struct{}
@@ -51,6 +59,7 @@ func (b *UserAccountBinder) BindBindGoogleAccount(controllerFunc controllers.Fun
 	return func(ctx *gin.Context) {
 		requestDto := &apicontract.BindGoogleAccountRequestDto{}
 		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+		requestDto.Header.OAuthStateToken, _ = ctx.Cookie(cookies.ValidCookieName_OAuthStateToken.String())
 		if err := ctx.ShouldBindJSON(&requestDto.Body); err != nil {
 			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("UserAccount").WithOrigin(err), ctx)
 			return
@@ -72,3 +81,97 @@ func (b *UserAccountBinder) BindUnbindGoogleAccount(controllerFunc controllers.F
 		controllerFunc(ctx, requestDto)
 	}
 }
+
+func (b *UserAccountBinder) BindBindGithubAccount(controllerFunc controllers.Func[*apicontract.BindGithubAccountRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestDto := &apicontract.BindGithubAccountRequestDto{}
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+		requestDto.Header.OAuthStateToken, _ = ctx.Cookie(cookies.ValidCookieName_OAuthStateToken.String())
+		if err := ctx.ShouldBindJSON(&requestDto.Body); err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("UserAccount").WithOrigin(err), ctx)
+			return
+		}
+
+		controllerFunc(ctx, requestDto)
+	}
+}
+
+func (b *UserAccountBinder) BindUnbindGithubAccount(controllerFunc controllers.Func[*apicontract.UnbindGithubAccountRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestDto := &apicontract.UnbindGithubAccountRequestDto{}
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+		if err := ctx.ShouldBindJSON(&requestDto.Body); err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("UserAccount").WithOrigin(err), ctx)
+			return
+		}
+
+		controllerFunc(ctx, requestDto)
+	}
+}
+
+func (b *UserAccountBinder) BindBindMetaAccount(controllerFunc controllers.Func[*apicontract.BindMetaAccountRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestDto := &apicontract.BindMetaAccountRequestDto{}
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+		requestDto.Header.OAuthStateToken, _ = ctx.Cookie(cookies.ValidCookieName_OAuthStateToken.String())
+		if err := ctx.ShouldBindJSON(&requestDto.Body); err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("UserAccount").WithOrigin(err), ctx)
+			return
+		}
+
+		controllerFunc(ctx, requestDto)
+	}
+}
+
+func (b *UserAccountBinder) BindUnbindMetaAccount(controllerFunc controllers.Func[*apicontract.UnbindMetaAccountRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestDto := &apicontract.UnbindMetaAccountRequestDto{}
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+		if err := ctx.ShouldBindJSON(&requestDto.Body); err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("UserAccount").WithOrigin(err), ctx)
+			return
+		}
+
+		controllerFunc(ctx, requestDto)
+	}
+}
+
+func (b *UserAccountBinder) BindBindOIDCAccount(controllerFunc controllers.Func[*apicontract.BindOIDCAccountRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestDto := &apicontract.BindOIDCAccountRequestDto{}
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+		requestDto.Header.OAuthStateToken, _ = ctx.Cookie(cookies.ValidCookieName_OAuthStateToken.String())
+		if err := ctx.ShouldBindJSON(&requestDto.Body); err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("UserAccount").WithOrigin(err), ctx)
+			return
+		}
+
+		controllerFunc(ctx, requestDto)
+	}
+}
+
+func (b *UserAccountBinder) BindUnbindOIDCAccount(controllerFunc controllers.Func[*apicontract.UnbindOIDCAccountRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestDto := &apicontract.UnbindOIDCAccountRequestDto{}
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+		if err := ctx.ShouldBindJSON(&requestDto.Body); err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("UserAccount").WithOrigin(err), ctx)
+			return
+		}
+
+		controllerFunc(ctx, requestDto)
+	}
+}
+
+func (b *UserAccountBinder) BindGetMyUsage(controllerFunc controllers.Func[*apicontract.GetMyUsageRequestDto]) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestDto := &apicontract.GetMyUsageRequestDto{}
+		requestDto.Header.UserAgent = ctx.GetHeader("User-Agent")
+		if err := ctx.ShouldBindQuery(&requestDto.Query); err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.InvalidDto("UserAccount").WithOrigin(err), ctx)
+			return
+		}
+
+		controllerFunc(ctx, requestDto)
+	}
+}
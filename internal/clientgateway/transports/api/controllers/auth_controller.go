@@ -27,23 +27,42 @@ type AuthControllerInterface interface {
 	ForgetPassword(ctx *gin.Context, requestDto *apicontract.ForgetPasswordRequestDto)
 	ResetMe(ctx *gin.Context, requestDto *apicontract.ResetMeRequestDto)
 	DeleteMe(ctx *gin.Context, requestDto *apicontract.DeleteMeRequestDto)
+	GetMySessions(ctx *gin.Context, requestDto *apicontract.GetMySessionsRequestDto)
+	RevokeMySession(ctx *gin.Context, requestDto *apicontract.RevokeMySessionRequestDto)
+	RevokeMyOtherSessions(ctx *gin.Context, requestDto *apicontract.RevokeMyOtherSessionsRequestDto)
+	GetMyTwoFactor(ctx *gin.Context, requestDto *apicontract.GetMyTwoFactorRequestDto)
+	EnrollMyTwoFactor(ctx *gin.Context, requestDto *apicontract.EnrollMyTwoFactorRequestDto)
+	EnableMyTwoFactor(ctx *gin.Context, requestDto *apicontract.EnableMyTwoFactorRequestDto)
+	DisableMyTwoFactor(ctx *gin.Context, requestDto *apicontract.DisableMyTwoFactorRequestDto)
+	RegenerateMyTwoFactorRecoveryCodes(ctx *gin.Context, requestDto *apicontract.RegenerateMyTwoFactorRecoveryCodesRequestDto)
+	VerifyLoginTwoFactor(ctx *gin.Context, requestDto *apicontract.VerifyLoginTwoFactorRequestDto)
+	GetOAuthAuthorizationURL(ctx *gin.Context, requestDto *apicontract.GetOAuthAuthorizationURLRequestDto)
+	RegisterViaGithub(ctx *gin.Context, requestDto *apicontract.RegisterViaGithubRequestDto)
+	LoginViaGithub(ctx *gin.Context, requestDto *apicontract.LoginViaGithubRequestDto)
+	RegisterViaMeta(ctx *gin.Context, requestDto *apicontract.RegisterViaMetaRequestDto)
+	LoginViaMeta(ctx *gin.Context, requestDto *apicontract.LoginViaMetaRequestDto)
+	RegisterViaOIDC(ctx *gin.Context, requestDto *apicontract.RegisterViaOIDCRequestDto)
+	LoginViaOIDC(ctx *gin.Context, requestDto *apicontract.LoginViaOIDCRequestDto)
 }
 
 type AuthController struct {
-	coreAdapter               *coreadapters.CoreAdapter
-	accessTokenCookieHandler  *cookies.CookieHandler
-	refreshTokenCookieHandler *cookies.CookieHandler
+	coreAdapter                  *coreadapters.CoreAdapter
+	accessTokenCookieHandler     *cookies.CookieHandler
+	refreshTokenCookieHandler    *cookies.CookieHandler
+	oauthStateTokenCookieHandler *cookies.CookieHandler
 }
 
 func NewAuthController(
 	coreAdapter *coreadapters.CoreAdapter,
 	accessTokenCookieHandler *cookies.CookieHandler,
 	refreshTokenCookieHandler *cookies.CookieHandler,
+	oauthStateTokenCookieHandler *cookies.CookieHandler,
 ) AuthControllerInterface {
 	return &AuthController{
-		coreAdapter:               coreAdapter,
-		accessTokenCookieHandler:  accessTokenCookieHandler,
-		refreshTokenCookieHandler: refreshTokenCookieHandler,
+		coreAdapter:                  coreAdapter,
+		accessTokenCookieHandler:     accessTokenCookieHandler,
+		refreshTokenCookieHandler:    refreshTokenCookieHandler,
+		oauthStateTokenCookieHandler: oauthStateTokenCookieHandler,
 	}
 }
 
@@ -85,6 +104,7 @@ func (c *AuthController) Register(ctx *gin.Context, requestDto *apicontract.Regi
 func (c *AuthController) RegisterViaGoogle(ctx *gin.Context, requestDto *apicontract.RegisterViaGoogleRequestDto) {
 	c.accessTokenCookieHandler.Delete(ctx)
 	c.refreshTokenCookieHandler.Delete(ctx)
+	c.oauthStateTokenCookieHandler.Delete(ctx)
 
 	response, exception := coreadapters.Call[apicontract.RegisterViaGoogleRequestDto, apicontract.RegisterViaGoogleResponseDto](
 		ctx,
@@ -97,6 +117,10 @@ func (c *AuthController) RegisterViaGoogle(ctx *gin.Context, requestDto *apicont
 		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
 		return
 	}
+	if response.Data.TwoFactorRequired {
+		writeTwoFactorChallengeResponse(ctx, response.Data.TwoFactorChallengeToken, response.Data.TwoFactorChallengeExpiresAt)
+		return
+	}
 
 	c.accessTokenCookieHandler.Set(ctx, response.Data.AccessToken)
 	c.refreshTokenCookieHandler.Set(ctx, response.Data.RefreshToken)
@@ -132,6 +156,10 @@ func (c *AuthController) Login(ctx *gin.Context, requestDto *apicontract.LoginRe
 		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
 		return
 	}
+	if response.Data.TwoFactorRequired {
+		writeTwoFactorChallengeResponse(ctx, response.Data.TwoFactorChallengeToken, response.Data.TwoFactorChallengeExpiresAt)
+		return
+	}
 
 	c.accessTokenCookieHandler.Set(ctx, response.Data.AccessToken)
 	c.refreshTokenCookieHandler.Set(ctx, response.Data.RefreshToken)
@@ -158,6 +186,7 @@ func (c *AuthController) Login(ctx *gin.Context, requestDto *apicontract.LoginRe
 func (c *AuthController) LoginViaGoogle(ctx *gin.Context, requestDto *apicontract.LoginViaGoogleRequestDto) {
 	c.accessTokenCookieHandler.Delete(ctx)
 	c.refreshTokenCookieHandler.Delete(ctx)
+	c.oauthStateTokenCookieHandler.Delete(ctx)
 
 	response, exception := coreadapters.Call[apicontract.LoginViaGoogleRequestDto, apicontract.LoginViaGoogleResponseDto](
 		ctx,
@@ -170,6 +199,10 @@ func (c *AuthController) LoginViaGoogle(ctx *gin.Context, requestDto *apicontrac
 		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
 		return
 	}
+	if response.Data.TwoFactorRequired {
+		writeTwoFactorChallengeResponse(ctx, response.Data.TwoFactorChallengeToken, response.Data.TwoFactorChallengeExpiresAt)
+		return
+	}
 
 	c.accessTokenCookieHandler.Set(ctx, response.Data.AccessToken)
 	c.refreshTokenCookieHandler.Set(ctx, response.Data.RefreshToken)
@@ -270,3 +303,409 @@ func (c *AuthController) DeleteMe(ctx *gin.Context, requestDto *apicontract.Dele
 
 	writeClientResponse(ctx, response.Data)
 }
+
+func (c *AuthController) GetMySessions(ctx *gin.Context, requestDto *apicontract.GetMySessionsRequestDto) {
+	response, exception := coreadapters.CallSecurly[apicontract.GetMySessionsRequestDto, apicontract.GetMySessionsResponseDto](ctx, c.coreAdapter, requestDto, apicontract.GetMySessionsOperation, "/core/v1/auth/sessions")
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+
+	writeClientResponse(ctx, response.Data)
+}
+
+func (c *AuthController) RevokeMySession(ctx *gin.Context, requestDto *apicontract.RevokeMySessionRequestDto) {
+	response, exception := coreadapters.CallSecurly[apicontract.RevokeMySessionRequestDto, apicontract.RevokeMySessionResponseDto](ctx, c.coreAdapter, requestDto, apicontract.RevokeMySessionOperation, "/core/v1/auth/sessions/revoke")
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+
+	// revoking the current session is the same as logging out from this device
+	if response.Data.IsCurrent {
+		c.accessTokenCookieHandler.Delete(ctx)
+		c.refreshTokenCookieHandler.Delete(ctx)
+	}
+	writeClientResponse(ctx, response.Data)
+}
+
+func (c *AuthController) RevokeMyOtherSessions(ctx *gin.Context, requestDto *apicontract.RevokeMyOtherSessionsRequestDto) {
+	response, exception := coreadapters.CallSecurly[apicontract.RevokeMyOtherSessionsRequestDto, apicontract.RevokeMyOtherSessionsResponseDto](ctx, c.coreAdapter, requestDto, apicontract.RevokeMyOtherSessionsOperation, "/core/v1/auth/sessions/revoke-others")
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+
+	writeClientResponse(ctx, response.Data)
+}
+
+func (c *AuthController) GetMyTwoFactor(ctx *gin.Context, requestDto *apicontract.GetMyTwoFactorRequestDto) {
+	response, exception := coreadapters.CallSecurly[apicontract.GetMyTwoFactorRequestDto, apicontract.GetMyTwoFactorResponseDto](ctx, c.coreAdapter, requestDto, apicontract.GetMyTwoFactorOperation, "/core/v1/auth/two-factor")
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+
+	writeClientResponse(ctx, response.Data)
+}
+
+func (c *AuthController) EnrollMyTwoFactor(ctx *gin.Context, requestDto *apicontract.EnrollMyTwoFactorRequestDto) {
+	response, exception := coreadapters.CallSecurly[apicontract.EnrollMyTwoFactorRequestDto, apicontract.EnrollMyTwoFactorResponseDto](ctx, c.coreAdapter, requestDto, apicontract.EnrollMyTwoFactorOperation, "/core/v1/auth/two-factor/enroll")
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+
+	writeClientResponse(ctx, response.Data)
+}
+
+func (c *AuthController) EnableMyTwoFactor(ctx *gin.Context, requestDto *apicontract.EnableMyTwoFactorRequestDto) {
+	response, exception := coreadapters.CallSecurly[apicontract.EnableMyTwoFactorRequestDto, apicontract.EnableMyTwoFactorResponseDto](ctx, c.coreAdapter, requestDto, apicontract.EnableMyTwoFactorOperation, "/core/v1/auth/two-factor/enable")
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+
+	writeClientResponse(ctx, response.Data)
+}
+
+func (c *AuthController) DisableMyTwoFactor(ctx *gin.Context, requestDto *apicontract.DisableMyTwoFactorRequestDto) {
+	response, exception := coreadapters.CallSecurly[apicontract.DisableMyTwoFactorRequestDto, apicontract.DisableMyTwoFactorResponseDto](ctx, c.coreAdapter, requestDto, apicontract.DisableMyTwoFactorOperation, "/core/v1/auth/two-factor/disable")
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+
+	writeClientResponse(ctx, response.Data)
+}
+
+func (c *AuthController) RegenerateMyTwoFactorRecoveryCodes(ctx *gin.Context, requestDto *apicontract.RegenerateMyTwoFactorRecoveryCodesRequestDto) {
+	response, exception := coreadapters.CallSecurly[apicontract.RegenerateMyTwoFactorRecoveryCodesRequestDto, apicontract.RegenerateMyTwoFactorRecoveryCodesResponseDto](ctx, c.coreAdapter, requestDto, apicontract.RegenerateMyTwoFactorRecoveryCodesOperation, "/core/v1/auth/two-factor/recovery-codes")
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+
+	writeClientResponse(ctx, response.Data)
+}
+
+func (c *AuthController) VerifyLoginTwoFactor(ctx *gin.Context, requestDto *apicontract.VerifyLoginTwoFactorRequestDto) {
+	c.accessTokenCookieHandler.Delete(ctx)
+	c.refreshTokenCookieHandler.Delete(ctx)
+
+	response, exception := coreadapters.Call[apicontract.VerifyLoginTwoFactorRequestDto, apicontract.VerifyLoginTwoFactorResponseDto](
+		ctx,
+		c.coreAdapter,
+		requestDto,
+		apicontract.VerifyLoginTwoFactorOperation,
+		"/core/v1/auth/login/two-factor",
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+
+	c.accessTokenCookieHandler.Set(ctx, response.Data.AccessToken)
+	c.refreshTokenCookieHandler.Set(ctx, response.Data.RefreshToken)
+	updatedAt := response.Data.UpdatedAt
+	writeClientResponse(ctx, struct {
+		PublicId    uuid.UUID  `json:"publicId"`
+		Name        string     `json:"name"`
+		DisplayName string     `json:"displayName"`
+		Email       string     `json:"email"`
+		CSRFToken   string     `json:"csrfToken"`
+		UpdatedAt   *time.Time `json:"updatedAt,omitempty"`
+		CreatedAt   time.Time  `json:"createdAt"`
+	}{
+		PublicId:    response.Data.PublicId,
+		Name:        response.Data.Name,
+		DisplayName: response.Data.DisplayName,
+		Email:       response.Data.Email,
+		CSRFToken:   response.Data.CSRFToken,
+		UpdatedAt:   &updatedAt,
+		CreatedAt:   response.Data.CreatedAt,
+	})
+}
+
+// the challenge token is exchanged for the session by VerifyLoginTwoFactor,
+// so no cookie is set until the second factor has been verified
+func writeTwoFactorChallengeResponse(ctx *gin.Context, challengeToken string, challengeExpiresAt *time.Time) {
+	writeClientResponse(ctx, struct {
+		TwoFactorRequired           bool       `json:"twoFactorRequired"`
+		TwoFactorChallengeToken     string     `json:"twoFactorChallengeToken"`
+		TwoFactorChallengeExpiresAt *time.Time `json:"twoFactorChallengeExpiresAt"`
+	}{
+		TwoFactorRequired:           true,
+		TwoFactorChallengeToken:     challengeToken,
+		TwoFactorChallengeExpiresAt: challengeExpiresAt,
+	})
+}
+
+// GetOAuthAuthorizationURL keeps the state token in an HttpOnly cookie instead of the response, so only this
+// browser can complete the authorization, and the first register, login or bind callback clears the cookie.
+func (c *AuthController) GetOAuthAuthorizationURL(ctx *gin.Context, requestDto *apicontract.GetOAuthAuthorizationURLRequestDto) {
+	response, exception := coreadapters.Call[apicontract.GetOAuthAuthorizationURLRequestDto, apicontract.GetOAuthAuthorizationURLResponseDto](
+		ctx,
+		c.coreAdapter,
+		requestDto,
+		apicontract.GetOAuthAuthorizationURLOperation,
+		"/core/v1/auth/oauth/authorization-url",
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+
+	c.oauthStateTokenCookieHandler.Set(ctx, response.Data.StateToken)
+	response.Data.StateToken = ""
+	writeClientResponse(ctx, response.Data)
+}
+
+func (c *AuthController) RegisterViaGithub(ctx *gin.Context, requestDto *apicontract.RegisterViaGithubRequestDto) {
+	c.accessTokenCookieHandler.Delete(ctx)
+	c.refreshTokenCookieHandler.Delete(ctx)
+	c.oauthStateTokenCookieHandler.Delete(ctx)
+
+	response, exception := coreadapters.Call[apicontract.RegisterViaGithubRequestDto, apicontract.RegisterViaGithubResponseDto](
+		ctx,
+		c.coreAdapter,
+		requestDto,
+		apicontract.RegisterViaGithubOperation,
+		"/core/v1/auth/register/github",
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+	if response.Data.TwoFactorRequired {
+		writeTwoFactorChallengeResponse(ctx, response.Data.TwoFactorChallengeToken, response.Data.TwoFactorChallengeExpiresAt)
+		return
+	}
+
+	c.accessTokenCookieHandler.Set(ctx, response.Data.AccessToken)
+	c.refreshTokenCookieHandler.Set(ctx, response.Data.RefreshToken)
+	writeClientResponse(ctx, struct {
+		PublicId    uuid.UUID `json:"publicId"`
+		Name        string    `json:"name"`
+		DisplayName string    `json:"displayName"`
+		Email       string    `json:"email"`
+		CSRFToken   string    `json:"csrfToken"`
+		CreatedAt   time.Time `json:"createdAt"`
+	}{
+		PublicId:    response.Data.PublicId,
+		Name:        response.Data.Name,
+		DisplayName: response.Data.DisplayName,
+		Email:       response.Data.Email,
+		CSRFToken:   response.Data.CSRFToken,
+		CreatedAt:   response.Data.CreatedAt,
+	})
+}
+
+func (c *AuthController) LoginViaGithub(ctx *gin.Context, requestDto *apicontract.LoginViaGithubRequestDto) {
+	c.accessTokenCookieHandler.Delete(ctx)
+	c.refreshTokenCookieHandler.Delete(ctx)
+	c.oauthStateTokenCookieHandler.Delete(ctx)
+
+	response, exception := coreadapters.Call[apicontract.LoginViaGithubRequestDto, apicontract.LoginViaGithubResponseDto](
+		ctx,
+		c.coreAdapter,
+		requestDto,
+		apicontract.LoginViaGithubOperation,
+		"/core/v1/auth/login/github",
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+	if response.Data.TwoFactorRequired {
+		writeTwoFactorChallengeResponse(ctx, response.Data.TwoFactorChallengeToken, response.Data.TwoFactorChallengeExpiresAt)
+		return
+	}
+
+	c.accessTokenCookieHandler.Set(ctx, response.Data.AccessToken)
+	c.refreshTokenCookieHandler.Set(ctx, response.Data.RefreshToken)
+	updatedAt := response.Data.UpdatedAt
+	writeClientResponse(ctx, struct {
+		PublicId    uuid.UUID  `json:"publicId"`
+		Name        string     `json:"name"`
+		DisplayName string     `json:"displayName"`
+		Email       string     `json:"email"`
+		CSRFToken   string     `json:"csrfToken"`
+		UpdatedAt   *time.Time `json:"updatedAt,omitempty"`
+		CreatedAt   time.Time  `json:"createdAt"`
+	}{
+		PublicId:    response.Data.PublicId,
+		Name:        response.Data.Name,
+		DisplayName: response.Data.DisplayName,
+		Email:       response.Data.Email,
+		CSRFToken:   response.Data.CSRFToken,
+		UpdatedAt:   &updatedAt,
+		CreatedAt:   response.Data.CreatedAt,
+	})
+}
+
+func (c *AuthController) RegisterViaMeta(ctx *gin.Context, requestDto *apicontract.RegisterViaMetaRequestDto) {
+	c.accessTokenCookieHandler.Delete(ctx)
+	c.refreshTokenCookieHandler.Delete(ctx)
+	c.oauthStateTokenCookieHandler.Delete(ctx)
+
+	response, exception := coreadapters.Call[apicontract.RegisterViaMetaRequestDto, apicontract.RegisterViaMetaResponseDto](
+		ctx,
+		c.coreAdapter,
+		requestDto,
+		apicontract.RegisterViaMetaOperation,
+		"/core/v1/auth/register/meta",
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+	if response.Data.TwoFactorRequired {
+		writeTwoFactorChallengeResponse(ctx, response.Data.TwoFactorChallengeToken, response.Data.TwoFactorChallengeExpiresAt)
+		return
+	}
+
+	c.accessTokenCookieHandler.Set(ctx, response.Data.AccessToken)
+	c.refreshTokenCookieHandler.Set(ctx, response.Data.RefreshToken)
+	writeClientResponse(ctx, struct {
+		PublicId    uuid.UUID `json:"publicId"`
+		Name        string    `json:"name"`
+		DisplayName string    `json:"displayName"`
+		Email       string    `json:"email"`
+		CSRFToken   string    `json:"csrfToken"`
+		CreatedAt   time.Time `json:"createdAt"`
+	}{
+		PublicId:    response.Data.PublicId,
+		Name:        response.Data.Name,
+		DisplayName: response.Data.DisplayName,
+		Email:       response.Data.Email,
+		CSRFToken:   response.Data.CSRFToken,
+		CreatedAt:   response.Data.CreatedAt,
+	})
+}
+
+func (c *AuthController) LoginViaMeta(ctx *gin.Context, requestDto *apicontract.LoginViaMetaRequestDto) {
+	c.accessTokenCookieHandler.Delete(ctx)
+	c.refreshTokenCookieHandler.Delete(ctx)
+	c.oauthStateTokenCookieHandler.Delete(ctx)
+
+	response, exception := coreadapters.Call[apicontract.LoginViaMetaRequestDto, apicontract.LoginViaMetaResponseDto](
+		ctx,
+		c.coreAdapter,
+		requestDto,
+		apicontract.LoginViaMetaOperation,
+		"/core/v1/auth/login/meta",
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+	if response.Data.TwoFactorRequired {
+		writeTwoFactorChallengeResponse(ctx, response.Data.TwoFactorChallengeToken, response.Data.TwoFactorChallengeExpiresAt)
+		return
+	}
+
+	c.accessTokenCookieHandler.Set(ctx, response.Data.AccessToken)
+	c.refreshTokenCookieHandler.Set(ctx, response.Data.RefreshToken)
+	updatedAt := response.Data.UpdatedAt
+	writeClientResponse(ctx, struct {
+		PublicId    uuid.UUID  `json:"publicId"`
+		Name        string     `json:"name"`
+		DisplayName string     `json:"displayName"`
+		Email       string     `json:"email"`
+		CSRFToken   string     `json:"csrfToken"`
+		UpdatedAt   *time.Time `json:"updatedAt,omitempty"`
+		CreatedAt   time.Time  `json:"createdAt"`
+	}{
+		PublicId:    response.Data.PublicId,
+		Name:        response.Data.Name,
+		DisplayName: response.Data.DisplayName,
+		Email:       response.Data.Email,
+		CSRFToken:   response.Data.CSRFToken,
+		UpdatedAt:   &updatedAt,
+		CreatedAt:   response.Data.CreatedAt,
+	})
+}
+
+func (c *AuthController) RegisterViaOIDC(ctx *gin.Context, requestDto *apicontract.RegisterViaOIDCRequestDto) {
+	c.accessTokenCookieHandler.Delete(ctx)
+	c.refreshTokenCookieHandler.Delete(ctx)
+	c.oauthStateTokenCookieHandler.Delete(ctx)
+
+	response, exception := coreadapters.Call[apicontract.RegisterViaOIDCRequestDto, apicontract.RegisterViaOIDCResponseDto](
+		ctx,
+		c.coreAdapter,
+		requestDto,
+		apicontract.RegisterViaOIDCOperation,
+		"/core/v1/auth/register/oidc",
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+	if response.Data.TwoFactorRequired {
+		writeTwoFactorChallengeResponse(ctx, response.Data.TwoFactorChallengeToken, response.Data.TwoFactorChallengeExpiresAt)
+		return
+	}
+
+	c.accessTokenCookieHandler.Set(ctx, response.Data.AccessToken)
+	c.refreshTokenCookieHandler.Set(ctx, response.Data.RefreshToken)
+	writeClientResponse(ctx, struct {
+		PublicId    uuid.UUID `json:"publicId"`
+		Name        string    `json:"name"`
+		DisplayName string    `json:"displayName"`
+		Email       string    `json:"email"`
+		CSRFToken   string    `json:"csrfToken"`
+		CreatedAt   time.Time `json:"createdAt"`
+	}{
+		PublicId:    response.Data.PublicId,
+		Name:        response.Data.Name,
+		DisplayName: response.Data.DisplayName,
+		Email:       response.Data.Email,
+		CSRFToken:   response.Data.CSRFToken,
+		CreatedAt:   response.Data.CreatedAt,
+	})
+}
+
+func (c *AuthController) LoginViaOIDC(ctx *gin.Context, requestDto *apicontract.LoginViaOIDCRequestDto) {
+	c.accessTokenCookieHandler.Delete(ctx)
+	c.refreshTokenCookieHandler.Delete(ctx)
+	c.oauthStateTokenCookieHandler.Delete(ctx)
+
+	response, exception := coreadapters.Call[apicontract.LoginViaOIDCRequestDto, apicontract.LoginViaOIDCResponseDto](
+		ctx,
+		c.coreAdapter,
+		requestDto,
+		apicontract.LoginViaOIDCOperation,
+		"/core/v1/auth/login/oidc",
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+	if response.Data.TwoFactorRequired {
+		writeTwoFactorChallengeResponse(ctx, response.Data.TwoFactorChallengeToken, response.Data.TwoFactorChallengeExpiresAt)
+		return
+	}
+
+	c.accessTokenCookieHandler.Set(ctx, response.Data.AccessToken)
+	c.refreshTokenCookieHandler.Set(ctx, response.Data.RefreshToken)
+	updatedAt := response.Data.UpdatedAt
+	writeClientResponse(ctx, struct {
+		PublicId    uuid.UUID  `json:"publicId"`
+		Name        string     `json:"name"`
+		DisplayName string     `json:"displayName"`
+		Email       string     `json:"email"`
+		CSRFToken   string     `json:"csrfToken"`
+		UpdatedAt   *time.Time `json:"updatedAt,omitempty"`
+		CreatedAt   time.Time  `json:"createdAt"`
+	}{
+		PublicId:    response.Data.PublicId,
+		Name:        response.Data.Name,
+		DisplayName: response.Data.DisplayName,
+		Email:       response.Data.Email,
+		CSRFToken:   response.Data.CSRFToken,
+		UpdatedAt:   &updatedAt,
+		CreatedAt:   response.Data.CreatedAt,
+	})
+}
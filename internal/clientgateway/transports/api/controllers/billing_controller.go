@@ -0,0 +1,93 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	apicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/billing"
+	exceptionwriter "github.com/HiIamJeff67/notegic-backend/shared/util/exceptionwriter"
+
+	coreadapters "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/core/adapters"
+)
+
+type BillingControllerInterface interface {
+	GetBillingPlans(*gin.Context, *apicontract.GetBillingPlansRequestDto)
+	GetMySubscription(*gin.Context, *apicontract.GetMySubscriptionRequestDto)
+	CreateMySubscription(*gin.Context, *apicontract.CreateMySubscriptionRequestDto)
+	ChangeMySubscriptionPlan(*gin.Context, *apicontract.ChangeMySubscriptionPlanRequestDto)
+	CancelMySubscription(*gin.Context, *apicontract.CancelMySubscriptionRequestDto)
+	GetMyBillingInvoices(*gin.Context, *apicontract.GetMyBillingInvoicesRequestDto)
+	HandlePayPalWebhook(*gin.Context, *apicontract.HandlePayPalWebhookRequestDto)
+}
+
+type BillingController struct {
+	coreAdapter *coreadapters.CoreAdapter
+}
+
+func NewBillingController(coreAdapter *coreadapters.CoreAdapter) BillingControllerInterface {
+	return &BillingController{
+		coreAdapter: coreAdapter,
+	}
+}
+
+func (c *BillingController) GetBillingPlans(ctx *gin.Context, request *apicontract.GetBillingPlansRequestDto) {
+	response, exception := coreadapters.Call[apicontract.GetBillingPlansRequestDto, apicontract.GetBillingPlansResponseDto](ctx, c.coreAdapter, request, apicontract.GetBillingPlansOperation, "/core/v1/billing/get-billing-plans")
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+	writeClientResponse(ctx, response.Data)
+}
+
+func (c *BillingController) GetMySubscription(ctx *gin.Context, request *apicontract.GetMySubscriptionRequestDto) {
+	response, exception := coreadapters.CallSecurly[apicontract.GetMySubscriptionRequestDto, apicontract.GetMySubscriptionResponseDto](ctx, c.coreAdapter, request, apicontract.GetMySubscriptionOperation, "/core/v1/billing/get-my-subscription")
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+	writeClientResponse(ctx, response.Data)
+}
+
+func (c *BillingController) CreateMySubscription(ctx *gin.Context, request *apicontract.CreateMySubscriptionRequestDto) {
+	response, exception := coreadapters.CallSecurly[apicontract.CreateMySubscriptionRequestDto, apicontract.CreateMySubscriptionResponseDto](ctx, c.coreAdapter, request, apicontract.CreateMySubscriptionOperation, "/core/v1/billing/create-my-subscription")
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+	writeCreatedClientResponse(ctx, response.Data)
+}
+
+func (c *BillingController) ChangeMySubscriptionPlan(ctx *gin.Context, request *apicontract.ChangeMySubscriptionPlanRequestDto) {
+	response, exception := coreadapters.CallSecurly[apicontract.ChangeMySubscriptionPlanRequestDto, apicontract.ChangeMySubscriptionPlanResponseDto](ctx, c.coreAdapter, request, apicontract.ChangeMySubscriptionPlanOperation, "/core/v1/billing/change-my-subscription-plan")
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+	writeClientResponse(ctx, response.Data)
+}
+
+func (c *BillingController) CancelMySubscription(ctx *gin.Context, request *apicontract.CancelMySubscriptionRequestDto) {
+	response, exception := coreadapters.CallSecurly[apicontract.CancelMySubscriptionRequestDto, apicontract.CancelMySubscriptionResponseDto](ctx, c.coreAdapter, request, apicontract.CancelMySubscriptionOperation, "/core/v1/billing/cancel-my-subscription")
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+	writeClientResponse(ctx, response.Data)
+}
+
+func (c *BillingController) GetMyBillingInvoices(ctx *gin.Context, request *apicontract.GetMyBillingInvoicesRequestDto) {
+	response, exception := coreadapters.CallSecurly[apicontract.GetMyBillingInvoicesRequestDto, apicontract.GetMyBillingInvoicesResponseDto](ctx, c.coreAdapter, request, apicontract.GetMyBillingInvoicesOperation, "/core/v1/billing/get-my-billing-invoices")
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+	writeClientResponse(ctx, response.Data)
+}
+
+func (c *BillingController) HandlePayPalWebhook(ctx *gin.Context, request *apicontract.HandlePayPalWebhookRequestDto) {
+	response, exception := coreadapters.Call[apicontract.HandlePayPalWebhookRequestDto, apicontract.HandlePayPalWebhookResponseDto](ctx, c.coreAdapter, request, apicontract.HandlePayPalWebhookOperation, "/core/v1/billing/paypal/webhook")
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+	writeClientResponse(ctx, response.Data)
+}
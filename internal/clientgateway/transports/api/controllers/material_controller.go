@@ -24,6 +24,10 @@ type MaterialControllerInterface interface {
 	RestoreMyMaterialsByIds(ctx *gin.Context, requestDto *apicontract.RestoreMyMaterialsByIdsRequestDto)
 	DeleteMyMaterialById(ctx *gin.Context, requestDto *apicontract.DeleteMyMaterialByIdRequestDto)
 	DeleteMyMaterialsByIds(ctx *gin.Context, requestDto *apicontract.DeleteMyMaterialsByIdsRequestDto)
+	InitiateMyMaterialUpload(ctx *gin.Context, requestDto *apicontract.InitiateMyMaterialUploadRequestDto)
+	UploadMyMaterialPart(ctx *gin.Context, requestDto *apicontract.UploadMyMaterialPartRequestDto)
+	CompleteMyMaterialUpload(ctx *gin.Context, requestDto *apicontract.CompleteMyMaterialUploadRequestDto)
+	AbortMyMaterialUpload(ctx *gin.Context, requestDto *apicontract.AbortMyMaterialUploadRequestDto)
 }
 
 type MaterialController struct {
@@ -282,3 +286,82 @@ func (c *MaterialController) DeleteMyMaterialsByIds(ctx *gin.Context, requestDto
 
 	writeClientResponse(ctx, response.Data)
 }
+
+func (c *MaterialController) InitiateMyMaterialUpload(ctx *gin.Context, requestDto *apicontract.InitiateMyMaterialUploadRequestDto) {
+	response, exception := coreadapters.CallSecurly[
+		apicontract.InitiateMyMaterialUploadRequestDto,
+		apicontract.InitiateMyMaterialUploadResponseDto,
+	](
+		ctx,
+		c.coreAdapter,
+		requestDto,
+		apicontract.InitiateMyMaterialUploadOperation,
+		"/core/v1/materials/initiate-upload",
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+
+	writeClientResponse(ctx, response.Data)
+}
+
+// UploadMyMaterialPart also returns the ETag of the part in its header, like the storages
+// which the parts are sent to directly
+func (c *MaterialController) UploadMyMaterialPart(ctx *gin.Context, requestDto *apicontract.UploadMyMaterialPartRequestDto) {
+	response, exception := coreadapters.CallSecurly[
+		apicontract.UploadMyMaterialPartRequestDto,
+		apicontract.UploadMyMaterialPartResponseDto,
+	](
+		ctx,
+		c.coreAdapter,
+		requestDto,
+		apicontract.UploadMyMaterialPartOperation,
+		"/core/v1/materials/upload-part",
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+
+	ctx.Header("ETag", response.Data.ETag)
+	writeClientResponse(ctx, response.Data)
+}
+
+func (c *MaterialController) CompleteMyMaterialUpload(ctx *gin.Context, requestDto *apicontract.CompleteMyMaterialUploadRequestDto) {
+	response, exception := coreadapters.CallSecurly[
+		apicontract.CompleteMyMaterialUploadRequestDto,
+		apicontract.CompleteMyMaterialUploadResponseDto,
+	](
+		ctx,
+		c.coreAdapter,
+		requestDto,
+		apicontract.CompleteMyMaterialUploadOperation,
+		"/core/v1/materials/complete-upload",
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+
+	writeClientResponse(ctx, response.Data)
+}
+
+func (c *MaterialController) AbortMyMaterialUpload(ctx *gin.Context, requestDto *apicontract.AbortMyMaterialUploadRequestDto) {
+	response, exception := coreadapters.CallSecurly[
+		apicontract.AbortMyMaterialUploadRequestDto,
+		apicontract.AbortMyMaterialUploadResponseDto,
+	](
+		ctx,
+		c.coreAdapter,
+		requestDto,
+		apicontract.AbortMyMaterialUploadOperation,
+		"/core/v1/materials/abort-upload",
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+
+	writeClientResponse(ctx, response.Data)
+}
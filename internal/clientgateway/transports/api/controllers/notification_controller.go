@@ -17,6 +17,13 @@ type NotificationControllerInterface interface {
 	CountUnread(ctx *gin.Context, requestDto *notificationscontract.CountUnreadNotificationsRequestDto)
 	MarkRead(ctx *gin.Context, requestDto *notificationscontract.MarkNotificationsReadRequestDto)
 	Delete(ctx *gin.Context, requestDto *notificationscontract.DeleteNotificationsRequestDto)
+	GetPreferences(ctx *gin.Context, requestDto *notificationscontract.GetNotificationPreferencesRequestDto)
+	UpdatePreferences(ctx *gin.Context, requestDto *notificationscontract.UpdateNotificationPreferencesRequestDto)
+	GetPushPublicKey(ctx *gin.Context, requestDto *notificationscontract.GetPushPublicKeyRequestDto)
+	RegisterPushSubscription(ctx *gin.Context, requestDto *notificationscontract.RegisterPushSubscriptionRequestDto)
+	UnregisterPushSubscription(ctx *gin.Context, requestDto *notificationscontract.UnregisterPushSubscriptionRequestDto)
+	ConfirmUnsubscribeDigest(ctx *gin.Context, requestDto *notificationscontract.UnsubscribeNotificationDigestRequestDto)
+	UnsubscribeDigest(ctx *gin.Context, requestDto *notificationscontract.UnsubscribeNotificationDigestRequestDto)
 }
 
 type NotificationController struct {
@@ -128,3 +135,166 @@ func (c *NotificationController) Delete(
 	}
 	writeClientResponse(ctx, response.Data)
 }
+
+func (c *NotificationController) GetPreferences(
+	ctx *gin.Context,
+	requestDto *notificationscontract.GetNotificationPreferencesRequestDto,
+) {
+	recipientUserPublicId, exception := gatewaycontexts.GetAndConvertContextFieldToUUID(
+		ctx,
+		sharedcontexts.ContextFieldName_User_PublicId,
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+	requestDto.RecipientUserPublicId = *recipientUserPublicId
+
+	response, exception := notificationadapters.CallSecurly[
+		notificationscontract.GetNotificationPreferencesRequestDto,
+		notificationscontract.GetNotificationPreferencesResponseDto,
+	](ctx, c.notificationClient, requestDto, notificationscontract.GetMyNotificationPreferencesOperation, "/internal/v1/notifications/preferences/get")
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+	writeClientResponse(ctx, response.Data)
+}
+
+func (c *NotificationController) UpdatePreferences(
+	ctx *gin.Context,
+	requestDto *notificationscontract.UpdateNotificationPreferencesRequestDto,
+) {
+	recipientUserPublicId, exception := gatewaycontexts.GetAndConvertContextFieldToUUID(
+		ctx,
+		sharedcontexts.ContextFieldName_User_PublicId,
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+	requestDto.RecipientUserPublicId = *recipientUserPublicId
+
+	response, exception := notificationadapters.CallSecurly[
+		notificationscontract.UpdateNotificationPreferencesRequestDto,
+		notificationscontract.UpdateNotificationPreferencesResponseDto,
+	](ctx, c.notificationClient, requestDto, notificationscontract.UpdateMyNotificationPreferencesOperation, "/internal/v1/notifications/preferences/update")
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+	writeClientResponse(ctx, response.Data)
+}
+
+func (c *NotificationController) GetPushPublicKey(
+	ctx *gin.Context,
+	requestDto *notificationscontract.GetPushPublicKeyRequestDto,
+) {
+	recipientUserPublicId, exception := gatewaycontexts.GetAndConvertContextFieldToUUID(
+		ctx,
+		sharedcontexts.ContextFieldName_User_PublicId,
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+	requestDto.RecipientUserPublicId = *recipientUserPublicId
+
+	response, exception := notificationadapters.CallSecurly[
+		notificationscontract.GetPushPublicKeyRequestDto,
+		notificationscontract.GetPushPublicKeyResponseDto,
+	](ctx, c.notificationClient, requestDto, notificationscontract.GetMyPushPublicKeyOperation, "/internal/v1/notifications/push/public-key")
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+	writeClientResponse(ctx, response.Data)
+}
+
+func (c *NotificationController) RegisterPushSubscription(
+	ctx *gin.Context,
+	requestDto *notificationscontract.RegisterPushSubscriptionRequestDto,
+) {
+	recipientUserPublicId, exception := gatewaycontexts.GetAndConvertContextFieldToUUID(
+		ctx,
+		sharedcontexts.ContextFieldName_User_PublicId,
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+	requestDto.RecipientUserPublicId = *recipientUserPublicId
+
+	response, exception := notificationadapters.CallSecurly[
+		notificationscontract.RegisterPushSubscriptionRequestDto,
+		notificationscontract.RegisterPushSubscriptionResponseDto,
+	](ctx, c.notificationClient, requestDto, notificationscontract.RegisterMyPushSubscriptionOperation, "/internal/v1/notifications/push/subscriptions/register")
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+	writeClientResponse(ctx, response.Data)
+}
+
+func (c *NotificationController) UnregisterPushSubscription(
+	ctx *gin.Context,
+	requestDto *notificationscontract.UnregisterPushSubscriptionRequestDto,
+) {
+	recipientUserPublicId, exception := gatewaycontexts.GetAndConvertContextFieldToUUID(
+		ctx,
+		sharedcontexts.ContextFieldName_User_PublicId,
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+	requestDto.RecipientUserPublicId = *recipientUserPublicId
+
+	response, exception := notificationadapters.CallSecurly[
+		notificationscontract.UnregisterPushSubscriptionRequestDto,
+		notificationscontract.UnregisterPushSubscriptionResponseDto,
+	](ctx, c.notificationClient, requestDto, notificationscontract.UnregisterMyPushSubscriptionOperation, "/internal/v1/notifications/push/subscriptions/unregister")
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+	writeClientResponse(ctx, response.Data)
+}
+
+// ConfirmUnsubscribeDigest only renders the confirmation page, the unsubscribe token is already verified by the middleware
+func (c *NotificationController) ConfirmUnsubscribeDigest(
+	ctx *gin.Context,
+	requestDto *notificationscontract.UnsubscribeNotificationDigestRequestDto,
+) {
+	writeNotificationDigestUnsubscribePage(ctx, false)
+}
+
+func (c *NotificationController) UnsubscribeDigest(
+	ctx *gin.Context,
+	requestDto *notificationscontract.UnsubscribeNotificationDigestRequestDto,
+) {
+	recipientUserPublicId, exception := gatewaycontexts.GetAndConvertContextFieldToUUID(
+		ctx,
+		sharedcontexts.ContextFieldName_User_PublicId,
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+	requestDto.RecipientUserPublicId = *recipientUserPublicId
+
+	response, exception := notificationadapters.CallSecurly[
+		notificationscontract.UnsubscribeNotificationDigestRequestDto,
+		notificationscontract.UnsubscribeNotificationDigestResponseDto,
+	](ctx, c.notificationClient, requestDto, notificationscontract.UnsubscribeMyNotificationDigestOperation, "/internal/v1/notifications/digest/unsubscribe")
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+	// the confirmation page posts its form from the browser, while the mail clients post the one-click unsubscribe
+	if ctx.NegotiateFormat(gin.MIMEJSON, gin.MIMEHTML) == gin.MIMEHTML {
+		writeNotificationDigestUnsubscribePage(ctx, true)
+		return
+	}
+	writeClientResponse(ctx, response.Data)
+}
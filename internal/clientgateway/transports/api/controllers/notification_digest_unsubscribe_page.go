@@ -0,0 +1,48 @@
+package controllers
+
+import (
+	"html/template"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// the unsubscribe link of a digest email is opened by the browser with GET, and the mail scanners prefetch
+// every link of an email, so the page only asks for a confirmation which posts back to the same link
+var notificationDigestUnsubscribePage = template.Must(template.New("notificationDigestUnsubscribePage").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="robots" content="noindex">
+<title>Notegic digest</title>
+</head>
+<body style="margin:0;padding:48px 16px;background:#f5f5f4;font-family:-apple-system,BlinkMacSystemFont,'Segoe UI',Roboto,sans-serif;color:#1c1917;">
+<main style="max-width:480px;margin:0 auto;padding:32px;background:#ffffff;border-radius:12px;">
+{{if .IsUnsubscribed}}
+<h1 style="margin:0 0 12px;font-size:20px;">You are unsubscribed</h1>
+<p style="margin:0;line-height:1.5;">Notegic no longer sends you the digest emails. You can turn them on again in the notification preferences.</p>
+{{else}}
+<h1 style="margin:0 0 12px;font-size:20px;">Unsubscribe from the digest</h1>
+<p style="margin:0 0 24px;line-height:1.5;">Notegic stops sending you the digest emails of your unread notifications and failed routine tasks.</p>
+<form method="post" action="{{.Action}}">
+<button type="submit" style="padding:10px 20px;border:0;border-radius:8px;background:#1c1917;color:#ffffff;font-size:14px;cursor:pointer;">Unsubscribe</button>
+</form>
+{{end}}
+</main>
+</body>
+</html>
+`))
+
+func writeNotificationDigestUnsubscribePage(ctx *gin.Context, isUnsubscribed bool) {
+	ctx.Status(http.StatusOK)
+	ctx.Header("Content-Type", gin.MIMEHTML+"; charset=utf-8")
+	ctx.Header("Cache-Control", "no-store")
+	if err := notificationDigestUnsubscribePage.Execute(ctx.Writer, map[string]any{
+		"IsUnsubscribed": isUnsubscribed,
+		// a relative link of the query alone posts to the same path, wherever the gateway is mounted
+		"Action": "?" + ctx.Request.URL.RawQuery,
+	}); err != nil {
+		_ = ctx.Error(err)
+	}
+}
@@ -33,6 +33,9 @@ type RootShelfControllerInterface interface {
 	DeleteMyRootShelfPermissions(ctx *gin.Context, requestDto *apicontract.DeleteMyRootShelfPermissionsRequestDto)
 	LeaveMyRootShelf(ctx *gin.Context, requestDto *apicontract.LeaveMyRootShelfRequestDto)
 	LeaveMyRootShelves(ctx *gin.Context, requestDto *apicontract.LeaveMyRootShelvesRequestDto)
+	ExportMyRootShelfArchiveById(ctx *gin.Context, requestDto *apicontract.ExportMyRootShelfArchiveByIdRequestDto)
+	CreateRootShelfFromArchive(ctx *gin.Context, requestDto *apicontract.CreateRootShelfFromArchiveRequestDto)
+	GetMyRootShelfArchiveJobById(ctx *gin.Context, requestDto *apicontract.GetMyRootShelfArchiveJobByIdRequestDto)
 }
 
 type RootShelfController struct {
@@ -381,3 +384,60 @@ func (c *RootShelfController) LeaveMyRootShelves(ctx *gin.Context, requestDto *a
 
 	ctx.Status(http.StatusNoContent)
 }
+
+func (c *RootShelfController) ExportMyRootShelfArchiveById(ctx *gin.Context, requestDto *apicontract.ExportMyRootShelfArchiveByIdRequestDto) {
+	response, exception := coreadapters.CallSecurly[
+		apicontract.ExportMyRootShelfArchiveByIdRequestDto,
+		apicontract.ExportMyRootShelfArchiveByIdResponseDto,
+	](
+		ctx,
+		c.coreAdapter,
+		requestDto,
+		apicontract.ExportMyRootShelfArchiveByIdOperation,
+		"/core/v1/root-shelves/get-archive-by-id",
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+
+	writeCreatedClientResponse(ctx, response.Data)
+}
+
+func (c *RootShelfController) CreateRootShelfFromArchive(ctx *gin.Context, requestDto *apicontract.CreateRootShelfFromArchiveRequestDto) {
+	response, exception := coreadapters.CallSecurly[
+		apicontract.CreateRootShelfFromArchiveRequestDto,
+		apicontract.CreateRootShelfFromArchiveResponseDto,
+	](
+		ctx,
+		c.coreAdapter,
+		requestDto,
+		apicontract.CreateRootShelfFromArchiveOperation,
+		"/core/v1/root-shelves/create-from-archive",
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+
+	writeCreatedClientResponse(ctx, response.Data)
+}
+
+func (c *RootShelfController) GetMyRootShelfArchiveJobById(ctx *gin.Context, requestDto *apicontract.GetMyRootShelfArchiveJobByIdRequestDto) {
+	response, exception := coreadapters.CallSecurly[
+		apicontract.GetMyRootShelfArchiveJobByIdRequestDto,
+		apicontract.GetMyRootShelfArchiveJobByIdResponseDto,
+	](
+		ctx,
+		c.coreAdapter,
+		requestDto,
+		apicontract.GetMyRootShelfArchiveJobByIdOperation,
+		"/core/v1/root-shelves/get-archive-job-by-id",
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+
+	writeClientResponse(ctx, response.Data)
+}
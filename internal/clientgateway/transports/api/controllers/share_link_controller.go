@@ -0,0 +1,73 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	apicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/share-links"
+	cookies "github.com/HiIamJeff67/notegic-backend/shared/cookies"
+	exceptionwriter "github.com/HiIamJeff67/notegic-backend/shared/util/exceptionwriter"
+
+	coreadapters "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/core/adapters"
+)
+
+type ShareLinkControllerInterface interface {
+	CreateMyShareLink(*gin.Context, *apicontract.CreateMyShareLinkRequestDto)
+	GetMyShareLinksByRootShelfId(*gin.Context, *apicontract.GetMyShareLinksByRootShelfIdRequestDto)
+	RevokeMyShareLinkById(*gin.Context, *apicontract.RevokeMyShareLinkByIdRequestDto)
+	ResolveShareLink(*gin.Context, *apicontract.ResolveShareLinkRequestDto)
+}
+
+type ShareLinkController struct {
+	coreAdapter                    *coreadapters.CoreAdapter
+	shareSessionTokenCookieHandler *cookies.CookieHandler
+}
+
+func NewShareLinkController(
+	coreAdapter *coreadapters.CoreAdapter,
+	shareSessionTokenCookieHandler *cookies.CookieHandler,
+) ShareLinkControllerInterface {
+	return &ShareLinkController{
+		coreAdapter:                    coreAdapter,
+		shareSessionTokenCookieHandler: shareSessionTokenCookieHandler,
+	}
+}
+
+func (c *ShareLinkController) CreateMyShareLink(ctx *gin.Context, request *apicontract.CreateMyShareLinkRequestDto) {
+	response, exception := coreadapters.CallSecurly[apicontract.CreateMyShareLinkRequestDto, apicontract.CreateMyShareLinkResponseDto](ctx, c.coreAdapter, request, apicontract.CreateMyShareLinkOperation, "/core/v1/share-links/create")
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+	writeCreatedClientResponse(ctx, response.Data)
+}
+
+func (c *ShareLinkController) GetMyShareLinksByRootShelfId(ctx *gin.Context, request *apicontract.GetMyShareLinksByRootShelfIdRequestDto) {
+	response, exception := coreadapters.CallSecurly[apicontract.GetMyShareLinksByRootShelfIdRequestDto, apicontract.GetMyShareLinksByRootShelfIdResponseDto](ctx, c.coreAdapter, request, apicontract.GetMyShareLinksByRootShelfIdOperation, "/core/v1/share-links/get-many-by-root-shelf-id")
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+	writeClientResponse(ctx, response.Data)
+}
+
+func (c *ShareLinkController) RevokeMyShareLinkById(ctx *gin.Context, request *apicontract.RevokeMyShareLinkByIdRequestDto) {
+	response, exception := coreadapters.CallSecurly[apicontract.RevokeMyShareLinkByIdRequestDto, apicontract.RevokeMyShareLinkByIdResponseDto](ctx, c.coreAdapter, request, apicontract.RevokeMyShareLinkByIdOperation, "/core/v1/share-links/revoke")
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+	writeClientResponse(ctx, response.Data)
+}
+
+// ResolveShareLink stores the share session in its own cookie, so the guest keeps any
+// user session of the browser, and the session is also returned for the clients
+// sending it through the X-Share-Session-Token header instead.
+func (c *ShareLinkController) ResolveShareLink(ctx *gin.Context, request *apicontract.ResolveShareLinkRequestDto) {
+	response, exception := coreadapters.Call[apicontract.ResolveShareLinkRequestDto, apicontract.ResolveShareLinkResponseDto](ctx, c.coreAdapter, request, apicontract.ResolveShareLinkOperation, "/core/v1/share-links/resolve")
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+	c.shareSessionTokenCookieHandler.Set(ctx, response.Data.ShareSessionToken)
+	writeClientResponse(ctx, response.Data)
+}
@@ -3,6 +3,7 @@ package controllers
 import (
 	"github.com/gin-gonic/gin"
 
+	cookies "github.com/HiIamJeff67/notegic-backend/shared/cookies"
 	exceptionwriter "github.com/HiIamJeff67/notegic-backend/shared/util/exceptionwriter"
 
 	apicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/user-accounts"
@@ -15,15 +16,27 @@ type UserAccountControllerInterface interface {
 	UpdateMyAccount(ctx *gin.Context, requestDto *apicontract.UpdateMyAccountRequestDto)
 	BindGoogleAccount(ctx *gin.Context, requestDto *apicontract.BindGoogleAccountRequestDto)
 	UnbindGoogleAccount(ctx *gin.Context, requestDto *apicontract.UnbindGoogleAccountRequestDto)
+	BindGithubAccount(ctx *gin.Context, requestDto *apicontract.BindGithubAccountRequestDto)
+	UnbindGithubAccount(ctx *gin.Context, requestDto *apicontract.UnbindGithubAccountRequestDto)
+	BindMetaAccount(ctx *gin.Context, requestDto *apicontract.BindMetaAccountRequestDto)
+	UnbindMetaAccount(ctx *gin.Context, requestDto *apicontract.UnbindMetaAccountRequestDto)
+	BindOIDCAccount(ctx *gin.Context, requestDto *apicontract.BindOIDCAccountRequestDto)
+	UnbindOIDCAccount(ctx *gin.Context, requestDto *apicontract.UnbindOIDCAccountRequestDto)
+	GetMyUsage(ctx *gin.Context, requestDto *apicontract.GetMyUsageRequestDto)
 }
 
 type UserAccountController struct {
-	coreAdapter *coreadapters.CoreAdapter
+	coreAdapter                  *coreadapters.CoreAdapter
+	oauthStateTokenCookieHandler *cookies.CookieHandler
 }
 
-func NewUserAccountController(coreAdapter *coreadapters.CoreAdapter) UserAccountControllerInterface {
+func NewUserAccountController(
+	coreAdapter *coreadapters.CoreAdapter,
+	oauthStateTokenCookieHandler *cookies.CookieHandler,
+) UserAccountControllerInterface {
 	return &UserAccountController{
-		coreAdapter: coreAdapter,
+		coreAdapter:                  coreAdapter,
+		oauthStateTokenCookieHandler: oauthStateTokenCookieHandler,
 	}
 }
 
@@ -75,6 +88,8 @@ func (c *UserAccountController) BindGoogleAccount(
 	ctx *gin.Context,
 	requestDto *apicontract.BindGoogleAccountRequestDto,
 ) {
+	c.oauthStateTokenCookieHandler.Delete(ctx)
+
 	response, exception := coreadapters.CallSecurly[
 		apicontract.BindGoogleAccountRequestDto,
 		apicontract.BindGoogleAccountResponseDto,
@@ -114,3 +129,163 @@ func (c *UserAccountController) UnbindGoogleAccount(
 
 	writeClientResponse(ctx, response.Data)
 }
+
+func (c *UserAccountController) BindGithubAccount(
+	ctx *gin.Context,
+	requestDto *apicontract.BindGithubAccountRequestDto,
+) {
+	c.oauthStateTokenCookieHandler.Delete(ctx)
+
+	response, exception := coreadapters.CallSecurly[
+		apicontract.BindGithubAccountRequestDto,
+		apicontract.BindGithubAccountResponseDto,
+	](
+		ctx,
+		c.coreAdapter,
+		requestDto,
+		apicontract.BindGithubAccountOperation,
+		"/core/v1/user-accounts/github/bind",
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+
+	writeClientResponse(ctx, response.Data)
+}
+
+func (c *UserAccountController) UnbindGithubAccount(
+	ctx *gin.Context,
+	requestDto *apicontract.UnbindGithubAccountRequestDto,
+) {
+	response, exception := coreadapters.CallSecurly[
+		apicontract.UnbindGithubAccountRequestDto,
+		apicontract.UnbindGithubAccountResponseDto,
+	](
+		ctx,
+		c.coreAdapter,
+		requestDto,
+		apicontract.UnbindGithubAccountOperation,
+		"/core/v1/user-accounts/github/unbind",
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+
+	writeClientResponse(ctx, response.Data)
+}
+
+func (c *UserAccountController) BindMetaAccount(
+	ctx *gin.Context,
+	requestDto *apicontract.BindMetaAccountRequestDto,
+) {
+	c.oauthStateTokenCookieHandler.Delete(ctx)
+
+	response, exception := coreadapters.CallSecurly[
+		apicontract.BindMetaAccountRequestDto,
+		apicontract.BindMetaAccountResponseDto,
+	](
+		ctx,
+		c.coreAdapter,
+		requestDto,
+		apicontract.BindMetaAccountOperation,
+		"/core/v1/user-accounts/meta/bind",
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+
+	writeClientResponse(ctx, response.Data)
+}
+
+func (c *UserAccountController) UnbindMetaAccount(
+	ctx *gin.Context,
+	requestDto *apicontract.UnbindMetaAccountRequestDto,
+) {
+	response, exception := coreadapters.CallSecurly[
+		apicontract.UnbindMetaAccountRequestDto,
+		apicontract.UnbindMetaAccountResponseDto,
+	](
+		ctx,
+		c.coreAdapter,
+		requestDto,
+		apicontract.UnbindMetaAccountOperation,
+		"/core/v1/user-accounts/meta/unbind",
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+
+	writeClientResponse(ctx, response.Data)
+}
+
+func (c *UserAccountController) BindOIDCAccount(
+	ctx *gin.Context,
+	requestDto *apicontract.BindOIDCAccountRequestDto,
+) {
+	c.oauthStateTokenCookieHandler.Delete(ctx)
+
+	response, exception := coreadapters.CallSecurly[
+		apicontract.BindOIDCAccountRequestDto,
+		apicontract.BindOIDCAccountResponseDto,
+	](
+		ctx,
+		c.coreAdapter,
+		requestDto,
+		apicontract.BindOIDCAccountOperation,
+		"/core/v1/user-accounts/oidc/bind",
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+
+	writeClientResponse(ctx, response.Data)
+}
+
+func (c *UserAccountController) UnbindOIDCAccount(
+	ctx *gin.Context,
+	requestDto *apicontract.UnbindOIDCAccountRequestDto,
+) {
+	response, exception := coreadapters.CallSecurly[
+		apicontract.UnbindOIDCAccountRequestDto,
+		apicontract.UnbindOIDCAccountResponseDto,
+	](
+		ctx,
+		c.coreAdapter,
+		requestDto,
+		apicontract.UnbindOIDCAccountOperation,
+		"/core/v1/user-accounts/oidc/unbind",
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+
+	writeClientResponse(ctx, response.Data)
+}
+
+func (c *UserAccountController) GetMyUsage(
+	ctx *gin.Context,
+	requestDto *apicontract.GetMyUsageRequestDto,
+) {
+	response, exception := coreadapters.CallSecurly[
+		apicontract.GetMyUsageRequestDto,
+		apicontract.GetMyUsageResponseDto,
+	](
+		ctx,
+		c.coreAdapter,
+		requestDto,
+		apicontract.GetMyUsageOperation,
+		"/core/v1/user-accounts/usage/get",
+	)
+	if exception != nil {
+		exceptionwriter.SafelyAbortAndResponseWithJSON(exception, ctx)
+		return
+	}
+
+	writeClientResponse(ctx, response.Data)
+}
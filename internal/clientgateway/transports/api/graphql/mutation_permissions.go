@@ -0,0 +1,117 @@
+package graphql
+
+import (
+	"context"
+
+	gqlgraphql "github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	enumcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
+
+	gatewaycontexts "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/contexts"
+	middlewares "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/api/middlewares"
+	"github.com/HiIamJeff67/notegic-backend/shared/util/exceptionwriter"
+)
+
+// the minimum permission of every mutation, which is the same as the one of its REST route, so a member can never
+// do more through GraphQL than through REST, while a mutation missing here requires the write permission
+var mutationPermissions = map[string]enumcontract.AccessControlPermission{
+	// stations
+	"createStation":            enumcontract.AccessControlPermission_Read,
+	"createStations":           enumcontract.AccessControlPermission_Read,
+	"updateStation":            enumcontract.AccessControlPermission_Admin,
+	"updateStations":           enumcontract.AccessControlPermission_Admin,
+	"restoreStation":           enumcontract.AccessControlPermission_Owner,
+	"restoreStations":          enumcontract.AccessControlPermission_Owner,
+	"deleteStation":            enumcontract.AccessControlPermission_Read,
+	"deleteStations":           enumcontract.AccessControlPermission_Owner,
+	"hardDeleteStation":        enumcontract.AccessControlPermission_Owner,
+	"hardDeleteStations":       enumcontract.AccessControlPermission_Owner,
+	"createStationPermission":  enumcontract.AccessControlPermission_Admin,
+	"upsertStationPermission":  enumcontract.AccessControlPermission_Admin,
+	"upsertStationPermissions": enumcontract.AccessControlPermission_Admin,
+	"updateStationPermission":  enumcontract.AccessControlPermission_Admin,
+	"transferStationOwnership": enumcontract.AccessControlPermission_Owner,
+	"deleteStationPermission":  enumcontract.AccessControlPermission_Admin,
+	"deleteStationPermissions": enumcontract.AccessControlPermission_Admin,
+	"leaveStation":             enumcontract.AccessControlPermission_Read,
+	"leaveStations":            enumcontract.AccessControlPermission_Read,
+	// root shelves
+	"createRootShelf":            enumcontract.AccessControlPermission_Read,
+	"createRootShelves":          enumcontract.AccessControlPermission_Read,
+	"updateRootShelf":            enumcontract.AccessControlPermission_Admin,
+	"updateRootShelves":          enumcontract.AccessControlPermission_Admin,
+	"restoreRootShelf":           enumcontract.AccessControlPermission_Owner,
+	"restoreRootShelves":         enumcontract.AccessControlPermission_Owner,
+	"deleteRootShelf":            enumcontract.AccessControlPermission_Read,
+	"deleteRootShelves":          enumcontract.AccessControlPermission_Owner,
+	"createRootShelfPermission":  enumcontract.AccessControlPermission_Admin,
+	"upsertRootShelfPermission":  enumcontract.AccessControlPermission_Admin,
+	"upsertRootShelfPermissions": enumcontract.AccessControlPermission_Admin,
+	"updateRootShelfPermission":  enumcontract.AccessControlPermission_Admin,
+	"transferRootShelfOwnership": enumcontract.AccessControlPermission_Owner,
+	"deleteRootShelfPermission":  enumcontract.AccessControlPermission_Admin,
+	"deleteRootShelfPermissions": enumcontract.AccessControlPermission_Admin,
+	"leaveRootShelf":             enumcontract.AccessControlPermission_Read,
+	"leaveRootShelves":           enumcontract.AccessControlPermission_Read,
+	// sub shelves
+	"createSubShelfByRootShelfId":    enumcontract.AccessControlPermission_Admin,
+	"createSubShelvesByRootShelfIds": enumcontract.AccessControlPermission_Admin,
+	"updateSubShelf":                 enumcontract.AccessControlPermission_Admin,
+	"updateSubShelves":               enumcontract.AccessControlPermission_Admin,
+	"moveSubShelfByRootShelfId":      enumcontract.AccessControlPermission_Admin,
+	"moveSubShelvesByRootShelfId":    enumcontract.AccessControlPermission_Admin,
+	"moveSubShelvesByRootShelfIds":   enumcontract.AccessControlPermission_Admin,
+	"restoreSubShelf":                enumcontract.AccessControlPermission_Admin,
+	"restoreSubShelves":              enumcontract.AccessControlPermission_Admin,
+	"deleteSubShelf":                 enumcontract.AccessControlPermission_Admin,
+	"deleteSubShelves":               enumcontract.AccessControlPermission_Admin,
+}
+
+// mutationPermission returns the permission a mutation requires at least
+func mutationPermission(field string) enumcontract.AccessControlPermission {
+	if permission, exists := mutationPermissions[field]; exists {
+		return permission
+	}
+	return enumcontract.AccessControlPermission_Write
+}
+
+// withMutationPermissions narrows the allowed permissions of the route, which only require the read permission for
+// the queries, down to the permission of each mutation, since the Core adapter delegates the permissions found
+// in the request of the gin context, and the mutations are resolved one after another so the request can be swapped
+func withMutationPermissions(server *handler.Server) {
+	server.AroundRootFields(func(ctx context.Context, next gqlgraphql.RootResolver) gqlgraphql.Marshaler {
+		fieldContext := gqlgraphql.GetRootFieldContext(ctx)
+		operationContext := gqlgraphql.GetOperationContext(ctx)
+		if fieldContext == nil || operationContext.Operation == nil || operationContext.Operation.Operation != ast.Mutation {
+			return next(ctx)
+		}
+
+		ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+		if exception != nil {
+			gqlgraphql.AddError(ctx, exceptionwriter.ToGraphQLError(exception, ctx))
+			return gqlgraphql.Null
+		}
+		request := ginContext.Request
+		ginContext.Request = request.WithContext(gatewaycontexts.WithAllowedPermissions(
+			request.Context(),
+			middlewares.PermissionsAbove(mutationPermission(fieldContext.Field.Name)),
+		))
+		defer func() { ginContext.Request = request }()
+
+		return next(ctx)
+	})
+}
+
+// withoutMutations rejects the mutations sent over the graphql-ws connection, whose operations share one gin context
+// concurrently, so the permission of a mutation could not be narrowed there
+func withoutMutations(server *handler.Server) {
+	server.AroundOperations(func(ctx context.Context, next gqlgraphql.OperationHandler) gqlgraphql.ResponseHandler {
+		if operationContext := gqlgraphql.GetOperationContext(ctx); operationContext.Operation != nil &&
+			operationContext.Operation.Operation == ast.Mutation {
+			return gqlgraphql.OneShot(gqlgraphql.ErrorResponse(ctx, "mutations must be sent to the GraphQL endpoint instead of the subscription endpoint"))
+		}
+		return next(ctx)
+	})
+}
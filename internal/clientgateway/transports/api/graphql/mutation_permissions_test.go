@@ -0,0 +1,154 @@
+package graphql
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	gatewaycontract "github.com/HiIamJeff67/notegic-backend/contracts/gateway/v1"
+	enumcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
+	sharedcontexts "github.com/HiIamJeff67/notegic-backend/shared/lib/contexts"
+	sharedtokens "github.com/HiIamJeff67/notegic-backend/shared/tokens"
+
+	middlewares "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/api/middlewares"
+	coreadapters "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/core/adapters"
+)
+
+type delegatedCall struct {
+	path               string
+	operation          string
+	allowedPermissions []string
+	data               map[string]any
+}
+
+// newGraphQLTestRouter serves the GraphQL handler behind the same allowed permissions as the API router,
+// and records every call delegated to the fake Core service
+func newGraphQLTestRouter(t *testing.T) (*gin.Engine, *[]delegatedCall) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	t.Setenv("CORE_DELEGATION_SECRET", "test-delegation-secret")
+	t.Setenv("CORE_DELEGATION_AUDIENCE", "notegic-core-test")
+	t.Setenv("CORE_DELEGATION_ISSUER", "notegic-gateway-test")
+
+	calls := &[]delegatedCall{}
+	core := httptest.NewServer(http.HandlerFunc(func(responseWriter http.ResponseWriter, request *http.Request) {
+		claims, err := sharedtokens.ParseDelegationToken(request.Header.Get("Authorization")[len("Bearer "):])
+		if err != nil {
+			t.Errorf("parse delegation token: %v", err)
+		}
+		requestEnvelope := gatewaycontract.Request[map[string]any]{}
+		if err := json.NewDecoder(request.Body).Decode(&requestEnvelope); err != nil {
+			t.Errorf("decode request envelope: %v", err)
+		}
+		*calls = append(*calls, delegatedCall{
+			path:               request.URL.Path,
+			operation:          requestEnvelope.Operation,
+			allowedPermissions: claims.AllowedPermissions,
+			data:               requestEnvelope.Dto,
+		})
+
+		responseWriter.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(responseWriter).Encode(&gatewaycontract.Response[map[string]any]{
+			Version:  gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{RequestId: requestEnvelope.Metadata.RequestId},
+			Data:     map[string]any{"deletedAt": time.Now().UTC().Format(time.RFC3339)},
+		})
+	}))
+	t.Cleanup(core.Close)
+
+	router := gin.New()
+	router.POST(
+		"/graphql",
+		func(ctx *gin.Context) {
+			ctx.Set(sharedcontexts.ContextFieldName_User_PublicId.String(), uuid.New())
+			ctx.Next()
+		},
+		middlewares.AllowedPermissionsAbove(enumcontract.AccessControlPermission_Read),
+		GraphQLHandler(coreadapters.NewCoreAdapter(core.URL, time.Second), nil),
+	)
+
+	return router, calls
+}
+
+func postGraphQL(t *testing.T, router *gin.Engine, query string) map[string]any {
+	t.Helper()
+
+	body, _ := json.Marshal(map[string]any{"query": query})
+	request := httptest.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(body))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+
+	response := map[string]any{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("decode GraphQL response %q: %v", recorder.Body.String(), err)
+	}
+	if response["errors"] != nil {
+		t.Fatalf("unexpected GraphQL errors: %v", response["errors"])
+	}
+	return response
+}
+
+func toStrings(permissions []enumcontract.AccessControlPermission) []string {
+	values := make([]string, len(permissions))
+	for index, permission := range permissions {
+		values[index] = string(permission)
+	}
+	return values
+}
+
+func TestGraphQLMutationsDelegateThePermissionOfTheirRESTRoutes(t *testing.T) {
+	router, calls := newGraphQLTestRouter(t)
+	stationId, routineId := uuid.New(), uuid.New()
+
+	// the mutations are resolved one after another, so each of them is delegated with its own permission
+	postGraphQL(t, router, `mutation {
+		deleteStation(input: {stationId: "`+stationId.String()+`"}) { deletedAt }
+		deleteStations(input: {stationIds: ["`+stationId.String()+`"]}) { deletedAt }
+		deleteRoutine(input: {routineId: "`+routineId.String()+`"}) { deletedAt }
+	}`)
+
+	expectedCalls := []struct {
+		path       string
+		permission enumcontract.AccessControlPermission
+	}{
+		{path: "/core/v1/stations/delete", permission: enumcontract.AccessControlPermission_Read},
+		{path: "/core/v1/stations/delete-many", permission: enumcontract.AccessControlPermission_Owner},
+		{path: "/core/v1/routines/delete", permission: enumcontract.AccessControlPermission_Write},
+	}
+	if len(*calls) != len(expectedCalls) {
+		t.Fatalf("expected %d Core calls, got %#v", len(expectedCalls), *calls)
+	}
+	for index, expected := range expectedCalls {
+		call := (*calls)[index]
+		if call.path != expected.path {
+			t.Fatalf("expected call %d to %s, got %s", index, expected.path, call.path)
+		}
+		if expectedPermissions := toStrings(middlewares.PermissionsAbove(expected.permission)); !slices.Equal(call.allowedPermissions, expectedPermissions) {
+			t.Fatalf("expected %s to delegate %v, got %v", call.path, expectedPermissions, call.allowedPermissions)
+		}
+	}
+	if routineIdValue, _ := (*calls)[2].data["body"].(map[string]any)["routineId"].(string); routineIdValue != routineId.String() {
+		t.Fatalf("expected the routine ID to be mapped into the request body, got %#v", (*calls)[2].data)
+	}
+}
+
+func TestMutationPermissionDefaultsToWrite(t *testing.T) {
+	if permission := mutationPermission("createRoutine"); permission != enumcontract.AccessControlPermission_Write {
+		t.Fatalf("expected an unlisted mutation to require Write, got %s", permission)
+	}
+	if permission := mutationPermission("updateStation"); permission != enumcontract.AccessControlPermission_Admin {
+		t.Fatalf("expected updateStation to require Admin, got %s", permission)
+	}
+	// every listed permission must be a known one, since PermissionsAbove panics on the others
+	for _, permission := range mutationPermissions {
+		middlewares.PermissionsAbove(permission)
+	}
+}
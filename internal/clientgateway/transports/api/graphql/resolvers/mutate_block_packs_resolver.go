@@ -0,0 +1,352 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.76
+
+import (
+	"context"
+
+	blockpackscontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/block-packs"
+	gqlmodels "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/graphql/models"
+	coretypes "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/types/block-packs"
+	gatewaycontexts "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/contexts"
+	coreadapters "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/core/adapters"
+	"github.com/HiIamJeff67/notegic-backend/shared/util/exceptionwriter"
+)
+
+// CreateBlockPack is the resolver for the createBlockPack field.
+func (r *mutationResolver) CreateBlockPack(ctx context.Context, input gqlmodels.CreatableBlockPackInput) (*blockpackscontract.CreateBlockPackResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &blockpackscontract.CreateBlockPackRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.Id = input.ID
+	request.Body.ParentSubShelfId = input.ParentSubShelfID
+	request.Body.Name = input.Name
+	request.Body.Icon = input.Icon
+	request.Body.HeaderBackgroundURL = input.HeaderBackgroundURL
+
+	response, exception := coreadapters.CallSecurly[
+		blockpackscontract.CreateBlockPackRequestDto,
+		blockpackscontract.CreateBlockPackResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		blockpackscontract.CreateBlockPackOperation,
+		"/core/v1/block-packs/create",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// CreateBlockPacks is the resolver for the createBlockPacks field.
+func (r *mutationResolver) CreateBlockPacks(ctx context.Context, input gqlmodels.CreateBlockPacksInput) (*blockpackscontract.CreateBlockPacksResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &blockpackscontract.CreateBlockPacksRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.CreatedBlockPacks = make([]coretypes.CreatableBlockPack, len(input.CreatedBlockPacks))
+	for index, item := range input.CreatedBlockPacks {
+		request.Body.CreatedBlockPacks[index].Id = item.ID
+		request.Body.CreatedBlockPacks[index].ParentSubShelfId = item.ParentSubShelfID
+		request.Body.CreatedBlockPacks[index].Name = item.Name
+		request.Body.CreatedBlockPacks[index].Icon = item.Icon
+		request.Body.CreatedBlockPacks[index].HeaderBackgroundURL = item.HeaderBackgroundURL
+	}
+
+	response, exception := coreadapters.CallSecurly[
+		blockpackscontract.CreateBlockPacksRequestDto,
+		blockpackscontract.CreateBlockPacksResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		blockpackscontract.CreateBlockPacksOperation,
+		"/core/v1/block-packs/create-many",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// UpdateBlockPack is the resolver for the updateBlockPack field.
+func (r *mutationResolver) UpdateBlockPack(ctx context.Context, input gqlmodels.UpdateBlockPackInput) (*blockpackscontract.UpdateMyBlockPackByIdResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &blockpackscontract.UpdateMyBlockPackByIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.Values.Name = input.Values.Name
+	request.Body.Values.Icon = input.Values.Icon
+	request.Body.Values.HeaderBackgroundURL = input.Values.HeaderBackgroundURL
+	request.Body.SetNull = toSetNull(input.SetNull)
+	request.Param.BlockPackId = input.BlockPackID
+
+	response, exception := coreadapters.CallSecurly[
+		blockpackscontract.UpdateMyBlockPackByIdRequestDto,
+		blockpackscontract.UpdateMyBlockPackByIdResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		blockpackscontract.UpdateMyBlockPackByIdOperation,
+		"/core/v1/block-packs/update",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// UpdateBlockPacks is the resolver for the updateBlockPacks field.
+func (r *mutationResolver) UpdateBlockPacks(ctx context.Context, input gqlmodels.UpdateBlockPacksInput) (*blockpackscontract.UpdateMyBlockPacksByIdsResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &blockpackscontract.UpdateMyBlockPacksByIdsRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.UpdatedBlockPacks = make([]coretypes.UpdatableBlockPack, len(input.UpdatedBlockPacks))
+	for index, item := range input.UpdatedBlockPacks {
+		request.Body.UpdatedBlockPacks[index].BlockPackId = item.BlockPackID
+		request.Body.UpdatedBlockPacks[index].Values.Name = item.Values.Name
+		request.Body.UpdatedBlockPacks[index].Values.Icon = item.Values.Icon
+		request.Body.UpdatedBlockPacks[index].Values.HeaderBackgroundURL = item.Values.HeaderBackgroundURL
+		request.Body.UpdatedBlockPacks[index].SetNull = toSetNull(item.SetNull)
+	}
+
+	response, exception := coreadapters.CallSecurly[
+		blockpackscontract.UpdateMyBlockPacksByIdsRequestDto,
+		blockpackscontract.UpdateMyBlockPacksByIdsResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		blockpackscontract.UpdateMyBlockPacksByIdsOperation,
+		"/core/v1/block-packs/update-many",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// MoveBlockPackByParentSubShelfID is the resolver for the moveBlockPackByParentSubShelfId field.
+func (r *mutationResolver) MoveBlockPackByParentSubShelfID(ctx context.Context, input gqlmodels.MoveBlockPackByParentSubShelfIDInput) (*blockpackscontract.MoveMyBlockPackByParentSubShelfIdResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &blockpackscontract.MoveMyBlockPackByParentSubShelfIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.BlockPackId = input.BlockPackID
+	request.Body.DestinationParentSubShelfId = input.DestinationParentSubShelfID
+
+	response, exception := coreadapters.CallSecurly[
+		blockpackscontract.MoveMyBlockPackByParentSubShelfIdRequestDto,
+		blockpackscontract.MoveMyBlockPackByParentSubShelfIdResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		blockpackscontract.MoveMyBlockPackByParentSubShelfIdOperation,
+		"/core/v1/block-packs/move",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// MoveBlockPacksByParentSubShelfID is the resolver for the moveBlockPacksByParentSubShelfId field.
+func (r *mutationResolver) MoveBlockPacksByParentSubShelfID(ctx context.Context, input gqlmodels.MovableBlockPackInput) (*blockpackscontract.MoveMyBlockPacksByParentSubShelfIdResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &blockpackscontract.MoveMyBlockPacksByParentSubShelfIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.BlockPackIds = input.BlockPackIds
+	request.Body.DestinationParentSubShelfId = input.DestinationParentSubShelfID
+
+	response, exception := coreadapters.CallSecurly[
+		blockpackscontract.MoveMyBlockPacksByParentSubShelfIdRequestDto,
+		blockpackscontract.MoveMyBlockPacksByParentSubShelfIdResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		blockpackscontract.MoveMyBlockPacksByParentSubShelfIdOperation,
+		"/core/v1/block-packs/move-many",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// MoveBlockPacksByParentSubShelfIds is the resolver for the moveBlockPacksByParentSubShelfIds field.
+func (r *mutationResolver) MoveBlockPacksByParentSubShelfIds(ctx context.Context, input gqlmodels.MoveBlockPacksByParentSubShelfIdsInput) (*blockpackscontract.MoveMyBlockPacksByParentSubShelfIdsResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &blockpackscontract.MoveMyBlockPacksByParentSubShelfIdsRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.MovedBlockPacks = make([]coretypes.MovableBlockPack, len(input.MovedBlockPacks))
+	for index, item := range input.MovedBlockPacks {
+		request.Body.MovedBlockPacks[index].BlockPackIds = item.BlockPackIds
+		request.Body.MovedBlockPacks[index].DestinationParentSubShelfId = item.DestinationParentSubShelfID
+	}
+
+	response, exception := coreadapters.CallSecurly[
+		blockpackscontract.MoveMyBlockPacksByParentSubShelfIdsRequestDto,
+		blockpackscontract.MoveMyBlockPacksByParentSubShelfIdsResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		blockpackscontract.MoveMyBlockPacksByParentSubShelfIdsOperation,
+		"/core/v1/block-packs/move-many-by-parent-sub-shelves",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// RestoreBlockPack is the resolver for the restoreBlockPack field.
+func (r *mutationResolver) RestoreBlockPack(ctx context.Context, input gqlmodels.RestoreBlockPackInput) (*blockpackscontract.BlockPackResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &blockpackscontract.RestoreMyBlockPackByIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Param.BlockPackId = input.BlockPackID
+
+	response, exception := coreadapters.CallSecurly[
+		blockpackscontract.RestoreMyBlockPackByIdRequestDto,
+		blockpackscontract.BlockPackResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		blockpackscontract.RestoreMyBlockPackByIdOperation,
+		"/core/v1/block-packs/restore",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// RestoreBlockPacks is the resolver for the restoreBlockPacks field.
+func (r *mutationResolver) RestoreBlockPacks(ctx context.Context, input gqlmodels.RestoreBlockPacksInput) ([]*blockpackscontract.BlockPackResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &blockpackscontract.RestoreMyBlockPacksByIdsRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.BlockPackIds = input.BlockPackIds
+
+	response, exception := coreadapters.CallSecurly[
+		blockpackscontract.RestoreMyBlockPacksByIdsRequestDto,
+		blockpackscontract.RestoreMyBlockPacksByIdsResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		blockpackscontract.RestoreMyBlockPacksByIdsOperation,
+		"/core/v1/block-packs/restore-many",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return toPointers(response.Data), nil
+}
+
+// DeleteBlockPack is the resolver for the deleteBlockPack field.
+func (r *mutationResolver) DeleteBlockPack(ctx context.Context, input gqlmodels.DeleteBlockPackInput) (*blockpackscontract.DeleteMyBlockPackByIdResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &blockpackscontract.DeleteMyBlockPackByIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Param.BlockPackId = input.BlockPackID
+
+	response, exception := coreadapters.CallSecurly[
+		blockpackscontract.DeleteMyBlockPackByIdRequestDto,
+		blockpackscontract.DeleteMyBlockPackByIdResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		blockpackscontract.DeleteMyBlockPackByIdOperation,
+		"/core/v1/block-packs/delete",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// DeleteBlockPacks is the resolver for the deleteBlockPacks field.
+func (r *mutationResolver) DeleteBlockPacks(ctx context.Context, input gqlmodels.DeleteBlockPacksInput) (*blockpackscontract.DeleteMyBlockPacksByIdsResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &blockpackscontract.DeleteMyBlockPacksByIdsRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.BlockPackIds = input.BlockPackIds
+
+	response, exception := coreadapters.CallSecurly[
+		blockpackscontract.DeleteMyBlockPacksByIdsRequestDto,
+		blockpackscontract.DeleteMyBlockPacksByIdsResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		blockpackscontract.DeleteMyBlockPacksByIdsOperation,
+		"/core/v1/block-packs/delete-many",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
@@ -0,0 +1,273 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.76
+
+import (
+	"context"
+
+	materialscontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/materials"
+	gqlmodels "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/graphql/models"
+	gatewaycontexts "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/contexts"
+	coreadapters "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/core/adapters"
+	"github.com/HiIamJeff67/notegic-backend/shared/util/exceptionwriter"
+)
+
+// CreateMaterial is the resolver for the createMaterial field.
+func (r *mutationResolver) CreateMaterial(ctx context.Context, input gqlmodels.CreatableMaterialInput) (*materialscontract.CreateMyMaterialResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &materialscontract.CreateMyMaterialRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.ParentSubShelfId = input.ParentSubShelfID
+	request.Body.Name = input.Name
+
+	response, exception := coreadapters.CallSecurly[
+		materialscontract.CreateMyMaterialRequestDto,
+		materialscontract.CreateMyMaterialResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		materialscontract.CreateMyMaterialOperation,
+		"/core/v1/materials/create",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// UpdateMaterial is the resolver for the updateMaterial field.
+func (r *mutationResolver) UpdateMaterial(ctx context.Context, input gqlmodels.UpdateMaterialInput) (*materialscontract.UpdateMyMaterialByIdResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &materialscontract.UpdateMyMaterialByIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.Values.Name = input.Values.Name
+	request.Body.SetNull = toSetNull(input.SetNull)
+	request.Param.MaterialId = input.MaterialID
+
+	response, exception := coreadapters.CallSecurly[
+		materialscontract.UpdateMyMaterialByIdRequestDto,
+		materialscontract.UpdateMyMaterialByIdResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		materialscontract.UpdateMyMaterialByIdOperation,
+		"/core/v1/materials/update",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// SaveMaterial is the resolver for the saveMaterial field.
+func (r *mutationResolver) SaveMaterial(ctx context.Context, input gqlmodels.SaveMaterialInput) (*materialscontract.SaveMyMaterialByIdResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &materialscontract.SaveMyMaterialByIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.ContentFile = input.ContentFile
+	request.Param.MaterialId = input.MaterialID
+
+	response, exception := coreadapters.CallSecurly[
+		materialscontract.SaveMyMaterialByIdRequestDto,
+		materialscontract.SaveMyMaterialByIdResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		materialscontract.SaveMyMaterialByIdOperation,
+		"/core/v1/materials/save",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// MoveMaterial is the resolver for the moveMaterial field.
+func (r *mutationResolver) MoveMaterial(ctx context.Context, input gqlmodels.MoveMaterialInput) (*materialscontract.MoveMyMaterialByIdResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &materialscontract.MoveMyMaterialByIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.MaterialId = input.MaterialID
+	request.Body.DestinationParentSubShelfId = input.DestinationParentSubShelfID
+
+	response, exception := coreadapters.CallSecurly[
+		materialscontract.MoveMyMaterialByIdRequestDto,
+		materialscontract.MoveMyMaterialByIdResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		materialscontract.MoveMyMaterialByIdOperation,
+		"/core/v1/materials/move",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// MoveMaterials is the resolver for the moveMaterials field.
+func (r *mutationResolver) MoveMaterials(ctx context.Context, input gqlmodels.MoveMaterialsInput) (*materialscontract.MoveMyMaterialsByIdsResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &materialscontract.MoveMyMaterialsByIdsRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.MaterialIds = input.MaterialIds
+	request.Body.DestinationParentSubShelfId = input.DestinationParentSubShelfID
+
+	response, exception := coreadapters.CallSecurly[
+		materialscontract.MoveMyMaterialsByIdsRequestDto,
+		materialscontract.MoveMyMaterialsByIdsResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		materialscontract.MoveMyMaterialsByIdsOperation,
+		"/core/v1/materials/move-many",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// RestoreMaterial is the resolver for the restoreMaterial field.
+func (r *mutationResolver) RestoreMaterial(ctx context.Context, input gqlmodels.RestoreMaterialInput) (*materialscontract.MaterialResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &materialscontract.RestoreMyMaterialByIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Param.MaterialId = input.MaterialID
+
+	response, exception := coreadapters.CallSecurly[
+		materialscontract.RestoreMyMaterialByIdRequestDto,
+		materialscontract.MaterialResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		materialscontract.RestoreMyMaterialByIdOperation,
+		"/core/v1/materials/restore",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// RestoreMaterials is the resolver for the restoreMaterials field.
+func (r *mutationResolver) RestoreMaterials(ctx context.Context, input gqlmodels.RestoreMaterialsInput) ([]*materialscontract.MaterialResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &materialscontract.RestoreMyMaterialsByIdsRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.MaterialIds = input.MaterialIds
+
+	response, exception := coreadapters.CallSecurly[
+		materialscontract.RestoreMyMaterialsByIdsRequestDto,
+		materialscontract.RestoreMyMaterialsByIdsResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		materialscontract.RestoreMyMaterialsByIdsOperation,
+		"/core/v1/materials/restore-many",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return toPointers(response.Data), nil
+}
+
+// DeleteMaterial is the resolver for the deleteMaterial field.
+func (r *mutationResolver) DeleteMaterial(ctx context.Context, input gqlmodels.DeleteMaterialInput) (*materialscontract.DeleteMyMaterialByIdResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &materialscontract.DeleteMyMaterialByIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Param.MaterialId = input.MaterialID
+
+	response, exception := coreadapters.CallSecurly[
+		materialscontract.DeleteMyMaterialByIdRequestDto,
+		materialscontract.DeleteMyMaterialByIdResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		materialscontract.DeleteMyMaterialByIdOperation,
+		"/core/v1/materials/delete",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// DeleteMaterials is the resolver for the deleteMaterials field.
+func (r *mutationResolver) DeleteMaterials(ctx context.Context, input gqlmodels.DeleteMaterialsInput) (*materialscontract.DeleteMyMaterialsByIdsResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &materialscontract.DeleteMyMaterialsByIdsRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.MaterialIds = input.MaterialIds
+
+	response, exception := coreadapters.CallSecurly[
+		materialscontract.DeleteMyMaterialsByIdsRequestDto,
+		materialscontract.DeleteMyMaterialsByIdsResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		materialscontract.DeleteMyMaterialsByIdsOperation,
+		"/core/v1/materials/delete-many",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
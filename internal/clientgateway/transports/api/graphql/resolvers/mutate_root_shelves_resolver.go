@@ -0,0 +1,522 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.76
+
+import (
+	"context"
+	"slices"
+
+	rootshelvescontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/root-shelves"
+	gqlmodels "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/graphql/models"
+	coretypes "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/types/root-shelves"
+	gatewaycontexts "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/contexts"
+	coreadapters "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/core/adapters"
+	"github.com/HiIamJeff67/notegic-backend/shared/util/exceptionwriter"
+)
+
+// CreateRootShelf is the resolver for the createRootShelf field.
+func (r *mutationResolver) CreateRootShelf(ctx context.Context, input gqlmodels.CreatableRootShelfInput) (*rootshelvescontract.CreateRootShelfResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &rootshelvescontract.CreateRootShelfRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.Id = input.ID
+	request.Body.Name = input.Name
+
+	response, exception := coreadapters.CallSecurly[
+		rootshelvescontract.CreateRootShelfRequestDto,
+		rootshelvescontract.CreateRootShelfResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		rootshelvescontract.CreateRootShelfOperation,
+		"/core/v1/root-shelves/create",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// CreateRootShelves is the resolver for the createRootShelves field.
+func (r *mutationResolver) CreateRootShelves(ctx context.Context, input gqlmodels.CreateRootShelvesInput) (*rootshelvescontract.CreateRootShelvesResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &rootshelvescontract.CreateRootShelvesRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.RootShelves = make([]coretypes.CreatableRootShelf, len(input.InsertedRootShelves))
+	for index, item := range input.InsertedRootShelves {
+		request.Body.RootShelves[index].Id = item.ID
+		request.Body.RootShelves[index].Name = item.Name
+	}
+
+	response, exception := coreadapters.CallSecurly[
+		rootshelvescontract.CreateRootShelvesRequestDto,
+		rootshelvescontract.CreateRootShelvesResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		rootshelvescontract.CreateRootShelvesOperation,
+		"/core/v1/root-shelves/create-many",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// UpdateRootShelf is the resolver for the updateRootShelf field.
+func (r *mutationResolver) UpdateRootShelf(ctx context.Context, input gqlmodels.UpdateRootShelfInput) (*rootshelvescontract.UpdateMyRootShelfByIdResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &rootshelvescontract.UpdateMyRootShelfByIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.Values.Name = input.Values.Name
+	request.Body.SetNull = toSetNull(input.SetNull)
+	request.Param.RootShelfId = input.RootShelfID
+
+	response, exception := coreadapters.CallSecurly[
+		rootshelvescontract.UpdateMyRootShelfByIdRequestDto,
+		rootshelvescontract.UpdateMyRootShelfByIdResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		rootshelvescontract.UpdateMyRootShelfByIdOperation,
+		"/core/v1/root-shelves/update",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// UpdateRootShelves is the resolver for the updateRootShelves field.
+func (r *mutationResolver) UpdateRootShelves(ctx context.Context, input gqlmodels.UpdateRootShelvesInput) (*rootshelvescontract.UpdateMyRootShelvesByIdsResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &rootshelvescontract.UpdateMyRootShelvesByIdsRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.UpdatedRootShelves = make([]coretypes.UpdatableRootShelf, len(input.UpdatedRootShelves))
+	for index, item := range input.UpdatedRootShelves {
+		request.Body.UpdatedRootShelves[index].RootShelfId = item.RootShelfID
+		request.Body.UpdatedRootShelves[index].Values.Name = item.Values.Name
+		request.Body.UpdatedRootShelves[index].SetNull = toSetNull(item.SetNull)
+	}
+
+	response, exception := coreadapters.CallSecurly[
+		rootshelvescontract.UpdateMyRootShelvesByIdsRequestDto,
+		rootshelvescontract.UpdateMyRootShelvesByIdsResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		rootshelvescontract.UpdateMyRootShelvesByIdsOperation,
+		"/core/v1/root-shelves/update-many",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// RestoreRootShelf is the resolver for the restoreRootShelf field.
+func (r *mutationResolver) RestoreRootShelf(ctx context.Context, input gqlmodels.RestoreRootShelfInput) (*rootshelvescontract.RestoreMyRootShelfByIdResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &rootshelvescontract.RestoreMyRootShelfByIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.RootShelfId = input.RootShelfID
+
+	response, exception := coreadapters.CallSecurly[
+		rootshelvescontract.RestoreMyRootShelfByIdRequestDto,
+		rootshelvescontract.RestoreMyRootShelfByIdResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		rootshelvescontract.RestoreMyRootShelfByIdOperation,
+		"/core/v1/root-shelves/restore",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// RestoreRootShelves is the resolver for the restoreRootShelves field.
+func (r *mutationResolver) RestoreRootShelves(ctx context.Context, input gqlmodels.RestoreRootShelvesInput) ([]*rootshelvescontract.RestoreMyRootShelfByIdResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &rootshelvescontract.RestoreMyRootShelvesByIdsRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.RootShelfIds = input.RootShelfIds
+
+	response, exception := coreadapters.CallSecurly[
+		rootshelvescontract.RestoreMyRootShelvesByIdsRequestDto,
+		rootshelvescontract.RestoreMyRootShelvesByIdsResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		rootshelvescontract.RestoreMyRootShelvesByIdsOperation,
+		"/core/v1/root-shelves/restore-many",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return toPointers(response.Data), nil
+}
+
+// DeleteRootShelf is the resolver for the deleteRootShelf field.
+func (r *mutationResolver) DeleteRootShelf(ctx context.Context, input gqlmodels.DeleteRootShelfInput) (*rootshelvescontract.DeleteMyRootShelfByIdResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &rootshelvescontract.DeleteMyRootShelfByIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.RootShelfId = input.RootShelfID
+
+	response, exception := coreadapters.CallSecurly[
+		rootshelvescontract.DeleteMyRootShelfByIdRequestDto,
+		rootshelvescontract.DeleteMyRootShelfByIdResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		rootshelvescontract.DeleteMyRootShelfByIdOperation,
+		"/core/v1/root-shelves/delete",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// DeleteRootShelves is the resolver for the deleteRootShelves field.
+func (r *mutationResolver) DeleteRootShelves(ctx context.Context, input gqlmodels.DeleteRootShelvesInput) (*rootshelvescontract.DeleteMyRootShelvesByIdsResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &rootshelvescontract.DeleteMyRootShelvesByIdsRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.RootShelfIds = input.RootShelfIds
+
+	response, exception := coreadapters.CallSecurly[
+		rootshelvescontract.DeleteMyRootShelvesByIdsRequestDto,
+		rootshelvescontract.DeleteMyRootShelvesByIdsResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		rootshelvescontract.DeleteMyRootShelvesByIdsOperation,
+		"/core/v1/root-shelves/delete-many",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// CreateRootShelfPermission is the resolver for the createRootShelfPermission field.
+func (r *mutationResolver) CreateRootShelfPermission(ctx context.Context, input gqlmodels.CreateRootShelfPermissionInput) (*rootshelvescontract.RootShelfPermissionResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &rootshelvescontract.CreateMyRootShelfPermissionRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.Permission = string(input.Permission)
+	request.Param.RootShelfId = input.RootShelfID
+	request.Param.UserPublicId = input.UserPublicID
+
+	response, exception := coreadapters.CallSecurly[
+		rootshelvescontract.CreateMyRootShelfPermissionRequestDto,
+		rootshelvescontract.RootShelfPermissionResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		rootshelvescontract.CreateMyRootShelfPermissionOperation,
+		"/core/v1/root-shelves/permissions/create",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// UpsertRootShelfPermission is the resolver for the upsertRootShelfPermission field.
+func (r *mutationResolver) UpsertRootShelfPermission(ctx context.Context, input gqlmodels.UpsertRootShelfPermissionInput) (*rootshelvescontract.RootShelfPermissionResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &rootshelvescontract.CreateMyRootShelfPermissionRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.Permission = string(input.Permission)
+	request.Param.RootShelfId = input.RootShelfID
+	request.Param.UserPublicId = input.UserPublicID
+
+	response, exception := coreadapters.CallSecurly[
+		rootshelvescontract.CreateMyRootShelfPermissionRequestDto,
+		rootshelvescontract.RootShelfPermissionResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		rootshelvescontract.UpsertMyRootShelfPermissionOperation,
+		"/core/v1/root-shelves/permissions/upsert",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// UpsertRootShelfPermissions is the resolver for the upsertRootShelfPermissions field.
+func (r *mutationResolver) UpsertRootShelfPermissions(ctx context.Context, input gqlmodels.UpsertRootShelfPermissionsInput) (*rootshelvescontract.UpsertMyRootShelfPermissionsResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &rootshelvescontract.UpsertMyRootShelfPermissionsRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.Permissions = make([]rootshelvescontract.UpsertableRootShelfPermission, len(input.Permissions))
+	for index, item := range input.Permissions {
+		request.Body.Permissions[index].UserPublicId = item.UserPublicID
+		request.Body.Permissions[index].Permission = string(item.Permission)
+	}
+	request.Param.RootShelfId = input.RootShelfID
+
+	response, exception := coreadapters.CallSecurly[
+		rootshelvescontract.UpsertMyRootShelfPermissionsRequestDto,
+		rootshelvescontract.UpsertMyRootShelfPermissionsResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		rootshelvescontract.UpsertMyRootShelfPermissionsOperation,
+		"/core/v1/root-shelves/permissions/upsert-many",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// UpdateRootShelfPermission is the resolver for the updateRootShelfPermission field.
+func (r *mutationResolver) UpdateRootShelfPermission(ctx context.Context, input gqlmodels.UpdateRootShelfPermissionInput) (*rootshelvescontract.RootShelfPermissionResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &rootshelvescontract.CreateMyRootShelfPermissionRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.Permission = string(input.Permission)
+	request.Param.RootShelfId = input.RootShelfID
+	request.Param.UserPublicId = input.UserPublicID
+
+	response, exception := coreadapters.CallSecurly[
+		rootshelvescontract.CreateMyRootShelfPermissionRequestDto,
+		rootshelvescontract.RootShelfPermissionResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		rootshelvescontract.UpdateMyRootShelfPermissionOperation,
+		"/core/v1/root-shelves/permissions/update",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// TransferRootShelfOwnership is the resolver for the transferRootShelfOwnership field.
+func (r *mutationResolver) TransferRootShelfOwnership(ctx context.Context, input gqlmodels.TransferRootShelfOwnershipInput) (*rootshelvescontract.TransferMyRootShelfOwnershipResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &rootshelvescontract.TransferMyRootShelfOwnershipRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.TargetUserPublicId = input.TargetUserPublicID
+	request.Param.RootShelfId = input.RootShelfID
+
+	response, exception := coreadapters.CallSecurly[
+		rootshelvescontract.TransferMyRootShelfOwnershipRequestDto,
+		rootshelvescontract.TransferMyRootShelfOwnershipResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		rootshelvescontract.TransferMyRootShelfOwnershipOperation,
+		"/core/v1/root-shelves/ownership/transfer",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// DeleteRootShelfPermission is the resolver for the deleteRootShelfPermission field.
+func (r *mutationResolver) DeleteRootShelfPermission(ctx context.Context, input gqlmodels.DeleteRootShelfPermissionInput) (bool, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return false, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &rootshelvescontract.GetMyRootShelfPermissionRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Param.RootShelfId = input.RootShelfID
+	request.Param.UserPublicId = input.UserPublicID
+
+	_, exception = coreadapters.CallSecurly[
+		rootshelvescontract.GetMyRootShelfPermissionRequestDto,
+		rootshelvescontract.DeleteMyRootShelfPermissionResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		rootshelvescontract.DeleteMyRootShelfPermissionOperation,
+		"/core/v1/root-shelves/permissions/delete",
+	)
+	if exception != nil {
+		return false, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return true, nil
+}
+
+// DeleteRootShelfPermissions is the resolver for the deleteRootShelfPermissions field.
+func (r *mutationResolver) DeleteRootShelfPermissions(ctx context.Context, input gqlmodels.DeleteRootShelfPermissionsInput) (bool, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return false, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &rootshelvescontract.DeleteMyRootShelfPermissionsRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.UserPublicIds = input.UserPublicIds
+	request.Param.RootShelfId = input.RootShelfID
+
+	_, exception = coreadapters.CallSecurly[
+		rootshelvescontract.DeleteMyRootShelfPermissionsRequestDto,
+		rootshelvescontract.DeleteMyRootShelfPermissionsResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		rootshelvescontract.DeleteMyRootShelfPermissionsOperation,
+		"/core/v1/root-shelves/permissions/delete-many",
+	)
+	if exception != nil {
+		return false, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return true, nil
+}
+
+// LeaveRootShelf is the resolver for the leaveRootShelf field.
+func (r *mutationResolver) LeaveRootShelf(ctx context.Context, input gqlmodels.LeaveRootShelfInput) (bool, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return false, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &rootshelvescontract.LeaveMyRootShelfRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Param.RootShelfId = input.RootShelfID
+
+	_, exception = coreadapters.CallSecurly[
+		rootshelvescontract.LeaveMyRootShelfRequestDto,
+		rootshelvescontract.LeaveMyRootShelfResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		rootshelvescontract.LeaveMyRootShelfOperation,
+		"/core/v1/root-shelves/memberships/leave",
+	)
+	if exception != nil {
+		return false, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return true, nil
+}
+
+// LeaveRootShelves is the resolver for the leaveRootShelves field.
+func (r *mutationResolver) LeaveRootShelves(ctx context.Context, input gqlmodels.LeaveRootShelvesInput) (bool, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return false, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &rootshelvescontract.LeaveMyRootShelvesRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.RootShelves = slices.Grow(request.Body.RootShelves, len(input.RootShelfIds))[:len(input.RootShelfIds)]
+	for index, item := range input.RootShelfIds {
+		request.Body.RootShelves[index].RootShelfId = item
+	}
+
+	_, exception = coreadapters.CallSecurly[
+		rootshelvescontract.LeaveMyRootShelvesRequestDto,
+		rootshelvescontract.LeaveMyRootShelvesResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		rootshelvescontract.LeaveMyRootShelvesOperation,
+		"/core/v1/root-shelves/memberships/leave-many",
+	)
+	if exception != nil {
+		return false, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,203 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.76
+
+import (
+	"context"
+
+	routinetaskscontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/routine-tasks"
+	gqlmodels "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/graphql/models"
+	gatewaycontexts "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/contexts"
+	coreadapters "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/core/adapters"
+	"github.com/HiIamJeff67/notegic-backend/shared/util/exceptionwriter"
+)
+
+// CreateRoutineTaskByRoutineID is the resolver for the createRoutineTaskByRoutineId field.
+func (r *mutationResolver) CreateRoutineTaskByRoutineID(ctx context.Context, input gqlmodels.CreatableRoutineTaskInput) (*routinetaskscontract.CreateRoutineTaskByRoutineIdResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &routinetaskscontract.CreateRoutineTaskByRoutineIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.RoutineId = input.RoutineID
+	request.Body.Title = input.Title
+	request.Body.Purpose = input.Purpose
+	request.Body.Payload = input.Payload
+	request.Body.Priority = input.Priority
+	request.Body.MaxAttempts = input.MaxAttempts
+	request.Body.Period = input.Period
+	request.Body.RecurrenceRule = input.RecurrenceRule
+	request.Body.NextScheduledAt = input.NextScheduledAt
+
+	response, exception := coreadapters.CallSecurly[
+		routinetaskscontract.CreateRoutineTaskByRoutineIdRequestDto,
+		routinetaskscontract.CreateRoutineTaskByRoutineIdResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		routinetaskscontract.CreateRoutineTaskByRoutineIdOperation,
+		"/core/v1/routine-tasks/create-by-routine-id",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// UpdateRoutineTask is the resolver for the updateRoutineTask field.
+func (r *mutationResolver) UpdateRoutineTask(ctx context.Context, input gqlmodels.UpdatableRoutineTaskInput) (*routinetaskscontract.UpdateMyRoutineTaskByIdResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &routinetaskscontract.UpdateMyRoutineTaskByIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.RoutineTaskId = input.RoutineTaskID
+	request.Body.Values.RoutineId = input.Values.RoutineID
+	request.Body.Values.Title = input.Values.Title
+	request.Body.Values.Purpose = input.Values.Purpose
+	if input.Values.Payload != nil {
+		request.Body.Values.Payload = &input.Values.Payload
+	}
+	request.Body.Values.Priority = input.Values.Priority
+	request.Body.Values.MaxAttempts = input.Values.MaxAttempts
+	request.Body.Values.Period = input.Values.Period
+	request.Body.Values.RecurrenceRule = input.Values.RecurrenceRule
+	request.Body.Values.NextScheduledAt = input.Values.NextScheduledAt
+	request.Body.SetNull = toSetNull(input.SetNull)
+
+	response, exception := coreadapters.CallSecurly[
+		routinetaskscontract.UpdateMyRoutineTaskByIdRequestDto,
+		routinetaskscontract.UpdateMyRoutineTaskByIdResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		routinetaskscontract.UpdateMyRoutineTaskByIdOperation,
+		"/core/v1/routine-tasks/update",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// PauseRoutineTask is the resolver for the pauseRoutineTask field.
+func (r *mutationResolver) PauseRoutineTask(ctx context.Context, input gqlmodels.PauseRoutineTaskInput) (*routinetaskscontract.PauseMyRoutineTaskByIdResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &routinetaskscontract.PauseMyRoutineTaskByIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.RoutineTaskId = input.RoutineTaskID
+
+	response, exception := coreadapters.CallSecurly[
+		routinetaskscontract.PauseMyRoutineTaskByIdRequestDto,
+		routinetaskscontract.PauseMyRoutineTaskByIdResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		routinetaskscontract.PauseMyRoutineTaskByIdOperation,
+		"/core/v1/routine-tasks/pause",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// ResumeRoutineTask is the resolver for the resumeRoutineTask field.
+func (r *mutationResolver) ResumeRoutineTask(ctx context.Context, input gqlmodels.ResumeRoutineTaskInput) (*routinetaskscontract.ResumeMyRoutineTaskByIdResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &routinetaskscontract.ResumeMyRoutineTaskByIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.RoutineTaskId = input.RoutineTaskID
+
+	response, exception := coreadapters.CallSecurly[
+		routinetaskscontract.ResumeMyRoutineTaskByIdRequestDto,
+		routinetaskscontract.ResumeMyRoutineTaskByIdResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		routinetaskscontract.ResumeMyRoutineTaskByIdOperation,
+		"/core/v1/routine-tasks/resume",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// HardDeleteRoutineTask is the resolver for the hardDeleteRoutineTask field.
+func (r *mutationResolver) HardDeleteRoutineTask(ctx context.Context, input gqlmodels.HardDeleteRoutineTaskInput) (*routinetaskscontract.HardDeleteMyRoutineTaskByIdResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &routinetaskscontract.HardDeleteMyRoutineTaskByIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.RoutineTaskId = input.RoutineTaskID
+
+	response, exception := coreadapters.CallSecurly[
+		routinetaskscontract.HardDeleteMyRoutineTaskByIdRequestDto,
+		routinetaskscontract.HardDeleteMyRoutineTaskByIdResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		routinetaskscontract.HardDeleteMyRoutineTaskByIdOperation,
+		"/core/v1/routine-tasks/hard-delete",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// HardDeleteRoutineTasks is the resolver for the hardDeleteRoutineTasks field.
+func (r *mutationResolver) HardDeleteRoutineTasks(ctx context.Context, input gqlmodels.HardDeleteRoutineTasksInput) (*routinetaskscontract.HardDeleteMyRoutineTasksByIdsResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &routinetaskscontract.HardDeleteMyRoutineTasksByIdsRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.RoutineTaskIds = input.RoutineTaskIds
+
+	response, exception := coreadapters.CallSecurly[
+		routinetaskscontract.HardDeleteMyRoutineTasksByIdsRequestDto,
+		routinetaskscontract.HardDeleteMyRoutineTasksByIdsResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		routinetaskscontract.HardDeleteMyRoutineTasksByIdsOperation,
+		"/core/v1/routine-tasks/hard-delete-many",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
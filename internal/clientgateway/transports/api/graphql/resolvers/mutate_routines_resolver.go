@@ -0,0 +1,473 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.76
+
+import (
+	"context"
+
+	routinescontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/routines"
+	gqlmodels "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/graphql/models"
+	coretypes "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/types/routines"
+	gatewaycontexts "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/contexts"
+	coreadapters "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/core/adapters"
+	"github.com/HiIamJeff67/notegic-backend/shared/util/exceptionwriter"
+)
+
+// CreateRoutineByStationID is the resolver for the createRoutineByStationId field.
+func (r *mutationResolver) CreateRoutineByStationID(ctx context.Context, input gqlmodels.CreatableRoutineInput) (*routinescontract.CreateRoutineByStationIdResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &routinescontract.CreateRoutineByStationIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.Id = input.ID
+	request.Body.StationId = input.StationID
+	request.Body.Title = input.Title
+	request.Body.Description = input.Description
+	request.Body.Status = input.Status
+	request.Body.IsPinned = input.IsPinned
+	request.Body.ScheduledStartAt = input.ScheduledStartAt
+	request.Body.ScheduledEndAt = input.ScheduledEndAt
+	request.Body.Period = input.Period
+	request.Body.RecurrenceRule = input.RecurrenceRule
+	request.Body.Timezone = input.Timezone
+
+	response, exception := coreadapters.CallSecurly[
+		routinescontract.CreateRoutineByStationIdRequestDto,
+		routinescontract.CreateRoutineByStationIdResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		routinescontract.CreateRoutineByStationIdOperation,
+		"/core/v1/routines/create-by-station-id",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// CreateRoutinesByStationIds is the resolver for the createRoutinesByStationIds field.
+func (r *mutationResolver) CreateRoutinesByStationIds(ctx context.Context, input gqlmodels.CreateRoutinesByStationIdsInput) (*routinescontract.CreateRoutinesByStationIdsResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &routinescontract.CreateRoutinesByStationIdsRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.CreatedRoutines = make([]coretypes.CreatableRoutine, len(input.CreatedRoutines))
+	for index, item := range input.CreatedRoutines {
+		request.Body.CreatedRoutines[index].Id = item.ID
+		request.Body.CreatedRoutines[index].StationId = item.StationID
+		request.Body.CreatedRoutines[index].Title = item.Title
+		request.Body.CreatedRoutines[index].Description = item.Description
+		request.Body.CreatedRoutines[index].Status = item.Status
+		request.Body.CreatedRoutines[index].IsPinned = item.IsPinned
+		request.Body.CreatedRoutines[index].ScheduledStartAt = item.ScheduledStartAt
+		request.Body.CreatedRoutines[index].ScheduledEndAt = item.ScheduledEndAt
+		request.Body.CreatedRoutines[index].Period = item.Period
+		request.Body.CreatedRoutines[index].RecurrenceRule = item.RecurrenceRule
+		request.Body.CreatedRoutines[index].Timezone = item.Timezone
+	}
+
+	response, exception := coreadapters.CallSecurly[
+		routinescontract.CreateRoutinesByStationIdsRequestDto,
+		routinescontract.CreateRoutinesByStationIdsResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		routinescontract.CreateRoutinesByStationIdsOperation,
+		"/core/v1/routines/create-many-by-station-ids",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// UpdateRoutine is the resolver for the updateRoutine field.
+func (r *mutationResolver) UpdateRoutine(ctx context.Context, input gqlmodels.UpdatableRoutineInput) (*routinescontract.UpdateMyRoutineByIdResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &routinescontract.UpdateMyRoutineByIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.RoutineId = input.RoutineID
+	request.Body.Values.StationId = input.Values.StationID
+	request.Body.Values.Title = input.Values.Title
+	request.Body.Values.Description = input.Values.Description
+	request.Body.Values.Status = input.Values.Status
+	request.Body.Values.IsPinned = input.Values.IsPinned
+	request.Body.Values.ScheduledStartAt = input.Values.ScheduledStartAt
+	request.Body.Values.ScheduledEndAt = input.Values.ScheduledEndAt
+	request.Body.Values.Period = input.Values.Period
+	request.Body.Values.RecurrenceRule = input.Values.RecurrenceRule
+	request.Body.Values.Timezone = input.Values.Timezone
+	request.Body.SetNull = toSetNull(input.SetNull)
+	request.Param.RoutineId = input.RoutineID
+
+	response, exception := coreadapters.CallSecurly[
+		routinescontract.UpdateMyRoutineByIdRequestDto,
+		routinescontract.UpdateMyRoutineByIdResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		routinescontract.UpdateMyRoutineByIdOperation,
+		"/core/v1/routines/update",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// UpdateRoutines is the resolver for the updateRoutines field.
+func (r *mutationResolver) UpdateRoutines(ctx context.Context, input gqlmodels.UpdateRoutinesInput) (*routinescontract.UpdateMyRoutinesByIdsResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &routinescontract.UpdateMyRoutinesByIdsRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.UpdatedRoutines = make([]coretypes.UpdatableRoutine, len(input.UpdatedRoutines))
+	for index, item := range input.UpdatedRoutines {
+		request.Body.UpdatedRoutines[index].RoutineId = item.RoutineID
+		request.Body.UpdatedRoutines[index].Values.StationId = item.Values.StationID
+		request.Body.UpdatedRoutines[index].Values.Title = item.Values.Title
+		request.Body.UpdatedRoutines[index].Values.Description = item.Values.Description
+		request.Body.UpdatedRoutines[index].Values.Status = item.Values.Status
+		request.Body.UpdatedRoutines[index].Values.IsPinned = item.Values.IsPinned
+		request.Body.UpdatedRoutines[index].Values.ScheduledStartAt = item.Values.ScheduledStartAt
+		request.Body.UpdatedRoutines[index].Values.ScheduledEndAt = item.Values.ScheduledEndAt
+		request.Body.UpdatedRoutines[index].Values.Period = item.Values.Period
+		request.Body.UpdatedRoutines[index].Values.RecurrenceRule = item.Values.RecurrenceRule
+		request.Body.UpdatedRoutines[index].Values.Timezone = item.Values.Timezone
+		request.Body.UpdatedRoutines[index].SetNull = toSetNull(item.SetNull)
+	}
+
+	response, exception := coreadapters.CallSecurly[
+		routinescontract.UpdateMyRoutinesByIdsRequestDto,
+		routinescontract.UpdateMyRoutinesByIdsResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		routinescontract.UpdateMyRoutinesByIdsOperation,
+		"/core/v1/routines/update-many",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// LinkRoutineTag is the resolver for the linkRoutineTag field.
+func (r *mutationResolver) LinkRoutineTag(ctx context.Context, input gqlmodels.LinkRoutineTagInput) (*routinescontract.LinkRoutineTagByIdResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &routinescontract.LinkRoutineTagByIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.RoutineId = input.RoutineID
+	request.Body.RoutineTagId = input.RoutineTagID
+	request.Body.IsUnlink = input.IsUnlink
+
+	response, exception := coreadapters.CallSecurly[
+		routinescontract.LinkRoutineTagByIdRequestDto,
+		routinescontract.LinkRoutineTagByIdResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		routinescontract.LinkRoutineTagByIdOperation,
+		"/core/v1/routines/link-tag",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// LinkRoutineTags is the resolver for the linkRoutineTags field.
+func (r *mutationResolver) LinkRoutineTags(ctx context.Context, input gqlmodels.LinkRoutineTagsInput) (*routinescontract.LinkRoutineTagsByIdsResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &routinescontract.LinkRoutineTagsByIdsRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.LinkedRoutinesAndTags = make([]coretypes.LinkableRoutineAndTag, len(input.LinkedRoutinesAndTags))
+	for index, item := range input.LinkedRoutinesAndTags {
+		request.Body.LinkedRoutinesAndTags[index].RoutineId = item.RoutineID
+		request.Body.LinkedRoutinesAndTags[index].RoutineTagId = item.RoutineTagID
+	}
+	request.Body.IsUnlink = input.IsUnlink
+
+	response, exception := coreadapters.CallSecurly[
+		routinescontract.LinkRoutineTagsByIdsRequestDto,
+		routinescontract.LinkRoutineTagsByIdsResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		routinescontract.LinkRoutineTagsByIdsOperation,
+		"/core/v1/routines/link-tags",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// LinkRoutineItem is the resolver for the linkRoutineItem field.
+func (r *mutationResolver) LinkRoutineItem(ctx context.Context, input gqlmodels.LinkRoutineItemInput) (*routinescontract.LinkRoutineItemByIdResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &routinescontract.LinkRoutineItemByIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.RoutineId = input.RoutineID
+	request.Body.ItemId = input.ItemID
+	request.Body.ItemType = input.ItemType
+	request.Body.IsUnlink = input.IsUnlink
+
+	response, exception := coreadapters.CallSecurly[
+		routinescontract.LinkRoutineItemByIdRequestDto,
+		routinescontract.LinkRoutineItemByIdResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		routinescontract.LinkRoutineItemByIdOperation,
+		"/core/v1/routines/link-item",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// LinkRoutineItems is the resolver for the linkRoutineItems field.
+func (r *mutationResolver) LinkRoutineItems(ctx context.Context, input gqlmodels.LinkRoutineItemsInput) (*routinescontract.LinkRoutineItemsByIdsResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &routinescontract.LinkRoutineItemsByIdsRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.LinkedRoutinesAndItems = make([]coretypes.LinkableRoutineAndItem, len(input.LinkedRoutinesAndItems))
+	for index, item := range input.LinkedRoutinesAndItems {
+		request.Body.LinkedRoutinesAndItems[index].RoutineId = item.RoutineID
+		request.Body.LinkedRoutinesAndItems[index].ItemId = item.ItemID
+		request.Body.LinkedRoutinesAndItems[index].ItemType = item.ItemType
+	}
+	request.Body.IsUnlink = input.IsUnlink
+
+	response, exception := coreadapters.CallSecurly[
+		routinescontract.LinkRoutineItemsByIdsRequestDto,
+		routinescontract.LinkRoutineItemsByIdsResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		routinescontract.LinkRoutineItemsByIdsOperation,
+		"/core/v1/routines/link-items",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// RestoreRoutine is the resolver for the restoreRoutine field.
+func (r *mutationResolver) RestoreRoutine(ctx context.Context, input gqlmodels.RestoreRoutineInput) (*routinescontract.RoutineResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &routinescontract.RestoreMyRoutineByIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.RoutineId = input.RoutineID
+
+	response, exception := coreadapters.CallSecurly[
+		routinescontract.RestoreMyRoutineByIdRequestDto,
+		routinescontract.RoutineResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		routinescontract.RestoreMyRoutineByIdOperation,
+		"/core/v1/routines/restore",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// RestoreRoutines is the resolver for the restoreRoutines field.
+func (r *mutationResolver) RestoreRoutines(ctx context.Context, input gqlmodels.RestoreRoutinesInput) ([]*routinescontract.RoutineResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &routinescontract.RestoreMyRoutinesByIdsRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.RoutineIds = input.RoutineIds
+
+	response, exception := coreadapters.CallSecurly[
+		routinescontract.RestoreMyRoutinesByIdsRequestDto,
+		routinescontract.RestoreMyRoutinesByIdsResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		routinescontract.RestoreMyRoutinesByIdsOperation,
+		"/core/v1/routines/restore-many",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return toPointers(response.Data), nil
+}
+
+// DeleteRoutine is the resolver for the deleteRoutine field.
+func (r *mutationResolver) DeleteRoutine(ctx context.Context, input gqlmodels.DeleteRoutineInput) (*routinescontract.DeleteMyRoutineByIdResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &routinescontract.DeleteMyRoutineByIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.RoutineId = input.RoutineID
+
+	response, exception := coreadapters.CallSecurly[
+		routinescontract.DeleteMyRoutineByIdRequestDto,
+		routinescontract.DeleteMyRoutineByIdResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		routinescontract.DeleteMyRoutineByIdOperation,
+		"/core/v1/routines/delete",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// DeleteRoutines is the resolver for the deleteRoutines field.
+func (r *mutationResolver) DeleteRoutines(ctx context.Context, input gqlmodels.DeleteRoutinesInput) (*routinescontract.DeleteMyRoutinesByIdsResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &routinescontract.DeleteMyRoutinesByIdsRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.RoutineIds = input.RoutineIds
+
+	response, exception := coreadapters.CallSecurly[
+		routinescontract.DeleteMyRoutinesByIdsRequestDto,
+		routinescontract.DeleteMyRoutinesByIdsResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		routinescontract.DeleteMyRoutinesByIdsOperation,
+		"/core/v1/routines/delete-many",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// HardDeleteRoutine is the resolver for the hardDeleteRoutine field.
+func (r *mutationResolver) HardDeleteRoutine(ctx context.Context, input gqlmodels.HardDeleteRoutineInput) (*routinescontract.HardDeleteMyRoutineByIdResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &routinescontract.DeleteMyRoutineByIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.RoutineId = input.RoutineID
+
+	response, exception := coreadapters.CallSecurly[
+		routinescontract.DeleteMyRoutineByIdRequestDto,
+		routinescontract.HardDeleteMyRoutineByIdResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		routinescontract.HardDeleteMyRoutineByIdOperation,
+		"/core/v1/routines/hard-delete",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// HardDeleteRoutines is the resolver for the hardDeleteRoutines field.
+func (r *mutationResolver) HardDeleteRoutines(ctx context.Context, input gqlmodels.HardDeleteRoutinesInput) (*routinescontract.HardDeleteMyRoutinesByIdsResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &routinescontract.DeleteMyRoutinesByIdsRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.RoutineIds = input.RoutineIds
+
+	response, exception := coreadapters.CallSecurly[
+		routinescontract.DeleteMyRoutinesByIdsRequestDto,
+		routinescontract.HardDeleteMyRoutinesByIdsResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		routinescontract.HardDeleteMyRoutinesByIdsOperation,
+		"/core/v1/routines/hard-delete-many",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
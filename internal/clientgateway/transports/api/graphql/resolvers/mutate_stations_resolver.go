@@ -0,0 +1,590 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.76
+
+import (
+	"context"
+	"slices"
+
+	stationscontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/stations"
+	gqlmodels "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/graphql/models"
+	coretypes "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/types/stations"
+	gatewaycontexts "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/contexts"
+	coreadapters "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/core/adapters"
+	"github.com/HiIamJeff67/notegic-backend/shared/util/exceptionwriter"
+)
+
+// CreateStation is the resolver for the createStation field.
+func (r *mutationResolver) CreateStation(ctx context.Context, input gqlmodels.CreatableStationInput) (*stationscontract.CreateStationResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &stationscontract.CreateStationRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.Id = input.ID
+	request.Body.Name = input.Name
+	request.Body.Description = input.Description
+	request.Body.Icon = input.Icon
+	request.Body.HeaderBackgroundURL = input.HeaderBackgroundURL
+
+	response, exception := coreadapters.CallSecurly[
+		stationscontract.CreateStationRequestDto,
+		stationscontract.CreateStationResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		stationscontract.CreateStationOperation,
+		"/core/v1/stations/create",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// CreateStations is the resolver for the createStations field.
+func (r *mutationResolver) CreateStations(ctx context.Context, input gqlmodels.CreateStationsInput) (*stationscontract.CreateStationsResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &stationscontract.CreateStationsRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.CreatedStations = make([]coretypes.CreatableStation, len(input.CreatedStations))
+	for index, item := range input.CreatedStations {
+		request.Body.CreatedStations[index].Id = item.ID
+		request.Body.CreatedStations[index].Name = item.Name
+		request.Body.CreatedStations[index].Description = item.Description
+		request.Body.CreatedStations[index].Icon = item.Icon
+		request.Body.CreatedStations[index].HeaderBackgroundURL = item.HeaderBackgroundURL
+	}
+
+	response, exception := coreadapters.CallSecurly[
+		stationscontract.CreateStationsRequestDto,
+		stationscontract.CreateStationsResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		stationscontract.CreateStationsOperation,
+		"/core/v1/stations/create-many",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// UpdateStation is the resolver for the updateStation field.
+func (r *mutationResolver) UpdateStation(ctx context.Context, input gqlmodels.UpdateStationInput) (*stationscontract.UpdateMyStationByIdResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &stationscontract.UpdateMyStationByIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.Values.Name = input.Values.Name
+	request.Body.Values.Description = input.Values.Description
+	request.Body.Values.Icon = input.Values.Icon
+	request.Body.Values.HeaderBackgroundURL = input.Values.HeaderBackgroundURL
+	request.Body.SetNull = toSetNull(input.SetNull)
+	request.Param.StationId = input.StationID
+
+	response, exception := coreadapters.CallSecurly[
+		stationscontract.UpdateMyStationByIdRequestDto,
+		stationscontract.UpdateMyStationByIdResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		stationscontract.UpdateMyStationByIdOperation,
+		"/core/v1/stations/update",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// UpdateStations is the resolver for the updateStations field.
+func (r *mutationResolver) UpdateStations(ctx context.Context, input gqlmodels.UpdateStationsInput) (*stationscontract.UpdateMyStationsByIdsResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &stationscontract.UpdateMyStationsByIdsRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.UpdatedStations = make([]coretypes.UpdatableStation, len(input.UpdatedStations))
+	for index, item := range input.UpdatedStations {
+		request.Body.UpdatedStations[index].StationId = item.StationID
+		request.Body.UpdatedStations[index].Values.Name = item.Values.Name
+		request.Body.UpdatedStations[index].Values.Description = item.Values.Description
+		request.Body.UpdatedStations[index].Values.Icon = item.Values.Icon
+		request.Body.UpdatedStations[index].Values.HeaderBackgroundURL = item.Values.HeaderBackgroundURL
+		request.Body.UpdatedStations[index].SetNull = toSetNull(item.SetNull)
+	}
+
+	response, exception := coreadapters.CallSecurly[
+		stationscontract.UpdateMyStationsByIdsRequestDto,
+		stationscontract.UpdateMyStationsByIdsResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		stationscontract.UpdateMyStationsByIdsOperation,
+		"/core/v1/stations/update-many",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// RestoreStation is the resolver for the restoreStation field.
+func (r *mutationResolver) RestoreStation(ctx context.Context, input gqlmodels.RestoreStationInput) (*stationscontract.RestoreMyStationByIdResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &stationscontract.RestoreMyStationByIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.StationId = input.StationID
+
+	response, exception := coreadapters.CallSecurly[
+		stationscontract.RestoreMyStationByIdRequestDto,
+		stationscontract.RestoreMyStationByIdResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		stationscontract.RestoreMyStationByIdOperation,
+		"/core/v1/stations/restore",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// RestoreStations is the resolver for the restoreStations field.
+func (r *mutationResolver) RestoreStations(ctx context.Context, input gqlmodels.RestoreStationsInput) ([]*stationscontract.RestoreMyStationByIdResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &stationscontract.RestoreMyStationsByIdsRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.StationIds = input.StationIds
+
+	response, exception := coreadapters.CallSecurly[
+		stationscontract.RestoreMyStationsByIdsRequestDto,
+		stationscontract.RestoreMyStationsByIdsResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		stationscontract.RestoreMyStationsByIdsOperation,
+		"/core/v1/stations/restore-many",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return toPointers(response.Data), nil
+}
+
+// DeleteStation is the resolver for the deleteStation field.
+func (r *mutationResolver) DeleteStation(ctx context.Context, input gqlmodels.DeleteStationInput) (*stationscontract.DeleteMyStationByIdResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &stationscontract.DeleteMyStationByIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.StationId = input.StationID
+
+	response, exception := coreadapters.CallSecurly[
+		stationscontract.DeleteMyStationByIdRequestDto,
+		stationscontract.DeleteMyStationByIdResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		stationscontract.DeleteMyStationByIdOperation,
+		"/core/v1/stations/delete",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// DeleteStations is the resolver for the deleteStations field.
+func (r *mutationResolver) DeleteStations(ctx context.Context, input gqlmodels.DeleteStationsInput) (*stationscontract.DeleteMyStationsByIdsResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &stationscontract.DeleteMyStationsByIdsRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.StationIds = input.StationIds
+
+	response, exception := coreadapters.CallSecurly[
+		stationscontract.DeleteMyStationsByIdsRequestDto,
+		stationscontract.DeleteMyStationsByIdsResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		stationscontract.DeleteMyStationsByIdsOperation,
+		"/core/v1/stations/delete-many",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// HardDeleteStation is the resolver for the hardDeleteStation field.
+func (r *mutationResolver) HardDeleteStation(ctx context.Context, input gqlmodels.HardDeleteStationInput) (*stationscontract.HardDeleteMyStationByIdResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &stationscontract.HardDeleteMyStationByIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.StationId = input.StationID
+
+	response, exception := coreadapters.CallSecurly[
+		stationscontract.HardDeleteMyStationByIdRequestDto,
+		stationscontract.HardDeleteMyStationByIdResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		stationscontract.HardDeleteMyStationByIdOperation,
+		"/core/v1/stations/hard-delete",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// HardDeleteStations is the resolver for the hardDeleteStations field.
+func (r *mutationResolver) HardDeleteStations(ctx context.Context, input gqlmodels.HardDeleteStationsInput) (*stationscontract.HardDeleteMyStationsByIdsResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &stationscontract.HardDeleteMyStationsByIdsRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.StationIds = input.StationIds
+
+	response, exception := coreadapters.CallSecurly[
+		stationscontract.HardDeleteMyStationsByIdsRequestDto,
+		stationscontract.HardDeleteMyStationsByIdsResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		stationscontract.HardDeleteMyStationsByIdsOperation,
+		"/core/v1/stations/hard-delete-many",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// CreateStationPermission is the resolver for the createStationPermission field.
+func (r *mutationResolver) CreateStationPermission(ctx context.Context, input gqlmodels.CreateStationPermissionInput) (*stationscontract.StationPermissionResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &stationscontract.CreateMyStationPermissionRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.Permission = string(input.Permission)
+	request.Param.StationId = input.StationID
+	request.Param.UserPublicId = input.UserPublicID
+
+	response, exception := coreadapters.CallSecurly[
+		stationscontract.CreateMyStationPermissionRequestDto,
+		stationscontract.StationPermissionResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		stationscontract.CreateMyStationPermissionOperation,
+		"/core/v1/stations/permissions/create",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// UpsertStationPermission is the resolver for the upsertStationPermission field.
+func (r *mutationResolver) UpsertStationPermission(ctx context.Context, input gqlmodels.UpsertStationPermissionInput) (*stationscontract.StationPermissionResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &stationscontract.CreateMyStationPermissionRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.Permission = string(input.Permission)
+	request.Param.StationId = input.StationID
+	request.Param.UserPublicId = input.UserPublicID
+
+	response, exception := coreadapters.CallSecurly[
+		stationscontract.CreateMyStationPermissionRequestDto,
+		stationscontract.StationPermissionResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		stationscontract.UpsertMyStationPermissionOperation,
+		"/core/v1/stations/permissions/upsert",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// UpsertStationPermissions is the resolver for the upsertStationPermissions field.
+func (r *mutationResolver) UpsertStationPermissions(ctx context.Context, input gqlmodels.UpsertStationPermissionsInput) (*stationscontract.UpsertMyStationPermissionsResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &stationscontract.UpsertMyStationPermissionsRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.Permissions = make([]stationscontract.UpsertableStationPermission, len(input.Permissions))
+	for index, item := range input.Permissions {
+		request.Body.Permissions[index].UserPublicId = item.UserPublicID
+		request.Body.Permissions[index].Permission = string(item.Permission)
+	}
+	request.Param.StationId = input.StationID
+
+	response, exception := coreadapters.CallSecurly[
+		stationscontract.UpsertMyStationPermissionsRequestDto,
+		stationscontract.UpsertMyStationPermissionsResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		stationscontract.UpsertMyStationPermissionsOperation,
+		"/core/v1/stations/permissions/upsert-many",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// UpdateStationPermission is the resolver for the updateStationPermission field.
+func (r *mutationResolver) UpdateStationPermission(ctx context.Context, input gqlmodels.UpdateStationPermissionInput) (*stationscontract.StationPermissionResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &stationscontract.CreateMyStationPermissionRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.Permission = string(input.Permission)
+	request.Param.StationId = input.StationID
+	request.Param.UserPublicId = input.UserPublicID
+
+	response, exception := coreadapters.CallSecurly[
+		stationscontract.CreateMyStationPermissionRequestDto,
+		stationscontract.StationPermissionResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		stationscontract.UpdateMyStationPermissionOperation,
+		"/core/v1/stations/permissions/update",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// TransferStationOwnership is the resolver for the transferStationOwnership field.
+func (r *mutationResolver) TransferStationOwnership(ctx context.Context, input gqlmodels.TransferStationOwnershipInput) (*stationscontract.TransferMyStationOwnershipResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &stationscontract.TransferMyStationOwnershipRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.TargetUserPublicId = input.TargetUserPublicID
+	request.Param.StationId = input.StationID
+
+	response, exception := coreadapters.CallSecurly[
+		stationscontract.TransferMyStationOwnershipRequestDto,
+		stationscontract.TransferMyStationOwnershipResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		stationscontract.TransferMyStationOwnershipOperation,
+		"/core/v1/stations/ownership/transfer",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// DeleteStationPermission is the resolver for the deleteStationPermission field.
+func (r *mutationResolver) DeleteStationPermission(ctx context.Context, input gqlmodels.DeleteStationPermissionInput) (bool, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return false, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &stationscontract.DeleteMyStationPermissionRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Param.StationId = input.StationID
+	request.Param.UserPublicId = input.UserPublicID
+
+	_, exception = coreadapters.CallSecurly[
+		stationscontract.DeleteMyStationPermissionRequestDto,
+		stationscontract.DeleteMyStationPermissionResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		stationscontract.DeleteMyStationPermissionOperation,
+		"/core/v1/stations/permissions/delete",
+	)
+	if exception != nil {
+		return false, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return true, nil
+}
+
+// DeleteStationPermissions is the resolver for the deleteStationPermissions field.
+func (r *mutationResolver) DeleteStationPermissions(ctx context.Context, input gqlmodels.DeleteStationPermissionsInput) (bool, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return false, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &stationscontract.DeleteMyStationPermissionsRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.UserPublicIds = input.UserPublicIds
+	request.Param.StationId = input.StationID
+
+	_, exception = coreadapters.CallSecurly[
+		stationscontract.DeleteMyStationPermissionsRequestDto,
+		stationscontract.DeleteMyStationPermissionsResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		stationscontract.DeleteMyStationPermissionsOperation,
+		"/core/v1/stations/permissions/delete-many",
+	)
+	if exception != nil {
+		return false, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return true, nil
+}
+
+// LeaveStation is the resolver for the leaveStation field.
+func (r *mutationResolver) LeaveStation(ctx context.Context, input gqlmodels.LeaveStationInput) (bool, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return false, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &stationscontract.LeaveMyStationRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Param.StationId = input.StationID
+
+	_, exception = coreadapters.CallSecurly[
+		stationscontract.LeaveMyStationRequestDto,
+		stationscontract.LeaveMyStationResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		stationscontract.LeaveMyStationOperation,
+		"/core/v1/stations/memberships/leave",
+	)
+	if exception != nil {
+		return false, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return true, nil
+}
+
+// LeaveStations is the resolver for the leaveStations field.
+func (r *mutationResolver) LeaveStations(ctx context.Context, input gqlmodels.LeaveStationsInput) (bool, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return false, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &stationscontract.LeaveMyStationsRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.Stations = slices.Grow(request.Body.Stations, len(input.StationIds))[:len(input.StationIds)]
+	for index, item := range input.StationIds {
+		request.Body.Stations[index].StationId = item
+	}
+
+	_, exception = coreadapters.CallSecurly[
+		stationscontract.LeaveMyStationsRequestDto,
+		stationscontract.LeaveMyStationsResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		stationscontract.LeaveMyStationsOperation,
+		"/core/v1/stations/memberships/leave-many",
+	)
+	if exception != nil {
+		return false, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,352 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.76
+
+import (
+	"context"
+
+	subshelvescontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/sub-shelves"
+	gqlmodels "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/graphql/models"
+	coretypes "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/types/sub-shelves"
+	gatewaycontexts "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/contexts"
+	coreadapters "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/core/adapters"
+	"github.com/HiIamJeff67/notegic-backend/shared/util/exceptionwriter"
+)
+
+// CreateSubShelfByRootShelfID is the resolver for the createSubShelfByRootShelfId field.
+func (r *mutationResolver) CreateSubShelfByRootShelfID(ctx context.Context, input gqlmodels.CreatableSubShelfInput) (*subshelvescontract.CreateSubShelfByRootShelfIdResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &subshelvescontract.CreateSubShelfByRootShelfIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.Id = input.ID
+	request.Body.RootShelfId = input.RootShelfID
+	request.Body.PrevSubShelfId = input.PrevSubShelfID
+	request.Body.Name = input.Name
+
+	response, exception := coreadapters.CallSecurly[
+		subshelvescontract.CreateSubShelfByRootShelfIdRequestDto,
+		subshelvescontract.CreateSubShelfByRootShelfIdResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		subshelvescontract.CreateSubShelfByRootShelfIdOperation,
+		"/core/v1/sub-shelves/create",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// CreateSubShelvesByRootShelfIds is the resolver for the createSubShelvesByRootShelfIds field.
+func (r *mutationResolver) CreateSubShelvesByRootShelfIds(ctx context.Context, input gqlmodels.CreateSubShelvesByRootShelfIdsInput) (*subshelvescontract.CreateSubShelvesByRootShelfIdsResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &subshelvescontract.CreateSubShelvesByRootShelfIdsRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.CreatedSubShelves = make([]coretypes.CreatableSubShelf, len(input.CreatedSubShelves))
+	for index, item := range input.CreatedSubShelves {
+		request.Body.CreatedSubShelves[index].Id = item.ID
+		request.Body.CreatedSubShelves[index].RootShelfId = item.RootShelfID
+		request.Body.CreatedSubShelves[index].PrevSubShelfId = item.PrevSubShelfID
+		request.Body.CreatedSubShelves[index].Name = item.Name
+	}
+
+	response, exception := coreadapters.CallSecurly[
+		subshelvescontract.CreateSubShelvesByRootShelfIdsRequestDto,
+		subshelvescontract.CreateSubShelvesByRootShelfIdsResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		subshelvescontract.CreateSubShelvesByRootShelfIdsOperation,
+		"/core/v1/sub-shelves/create-many",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// UpdateSubShelf is the resolver for the updateSubShelf field.
+func (r *mutationResolver) UpdateSubShelf(ctx context.Context, input gqlmodels.UpdateSubShelfInput) (*subshelvescontract.UpdateMySubShelfByIdResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &subshelvescontract.UpdateMySubShelfByIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.Values.Name = input.Values.Name
+	request.Body.SetNull = toSetNull(input.SetNull)
+	request.Param.SubShelfId = input.SubShelfID
+
+	response, exception := coreadapters.CallSecurly[
+		subshelvescontract.UpdateMySubShelfByIdRequestDto,
+		subshelvescontract.UpdateMySubShelfByIdResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		subshelvescontract.UpdateMySubShelfByIdOperation,
+		"/core/v1/sub-shelves/update",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// UpdateSubShelves is the resolver for the updateSubShelves field.
+func (r *mutationResolver) UpdateSubShelves(ctx context.Context, input gqlmodels.UpdateSubShelvesInput) (*subshelvescontract.UpdateMySubShelvesByIdsResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &subshelvescontract.UpdateMySubShelvesByIdsRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.UpdatedSubShelves = make([]coretypes.UpdatableSubShelf, len(input.UpdatedSubShelves))
+	for index, item := range input.UpdatedSubShelves {
+		request.Body.UpdatedSubShelves[index].SubShelfId = item.SubShelfID
+		request.Body.UpdatedSubShelves[index].Values.Name = item.Values.Name
+		request.Body.UpdatedSubShelves[index].SetNull = toSetNull(item.SetNull)
+	}
+
+	response, exception := coreadapters.CallSecurly[
+		subshelvescontract.UpdateMySubShelvesByIdsRequestDto,
+		subshelvescontract.UpdateMySubShelvesByIdsResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		subshelvescontract.UpdateMySubShelvesByIdsOperation,
+		"/core/v1/sub-shelves/update-many",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// MoveSubShelfByRootShelfID is the resolver for the moveSubShelfByRootShelfId field.
+func (r *mutationResolver) MoveSubShelfByRootShelfID(ctx context.Context, input gqlmodels.MoveSubShelfByRootShelfIDInput) (*subshelvescontract.MoveMySubShelfByRootShelfIdResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &subshelvescontract.MoveMySubShelfByRootShelfIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.SourceRootShelfId = input.SourceRootShelfID
+	request.Body.SourceSubShelfId = input.SourceSubShelfID
+	request.Body.DestinationRootShelfId = input.DestinationRootShelfID
+	request.Body.DestinationSubShelfId = input.DestinationSubShelfID
+
+	response, exception := coreadapters.CallSecurly[
+		subshelvescontract.MoveMySubShelfByRootShelfIdRequestDto,
+		subshelvescontract.MoveMySubShelfByRootShelfIdResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		subshelvescontract.MoveMySubShelfByRootShelfIdOperation,
+		"/core/v1/sub-shelves/move",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// MoveSubShelvesByRootShelfID is the resolver for the moveSubShelvesByRootShelfId field.
+func (r *mutationResolver) MoveSubShelvesByRootShelfID(ctx context.Context, input gqlmodels.MovableSubShelfInput) (*subshelvescontract.MoveMySubShelvesByRootShelfIdResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &subshelvescontract.MoveMySubShelvesByRootShelfIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.SourceRootShelfId = input.SourceRootShelfID
+	request.Body.SourceSubShelfIds = input.SourceSubShelfIds
+	request.Body.DestinationRootShelfId = input.DestinationRootShelfID
+	request.Body.DestinationSubShelfId = input.DestinationSubShelfID
+
+	response, exception := coreadapters.CallSecurly[
+		subshelvescontract.MoveMySubShelvesByRootShelfIdRequestDto,
+		subshelvescontract.MoveMySubShelvesByRootShelfIdResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		subshelvescontract.MoveMySubShelvesByRootShelfIdOperation,
+		"/core/v1/sub-shelves/move-many",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// MoveSubShelvesByRootShelfIds is the resolver for the moveSubShelvesByRootShelfIds field.
+func (r *mutationResolver) MoveSubShelvesByRootShelfIds(ctx context.Context, input gqlmodels.MoveSubShelvesByRootShelfIdsInput) (*subshelvescontract.MoveMySubShelvesByRootShelfIdsResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &subshelvescontract.MoveMySubShelvesByRootShelfIdsRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.MovedSubShelves = make([]coretypes.MovableSubShelf, len(input.MovedSubShelves))
+	for index, item := range input.MovedSubShelves {
+		request.Body.MovedSubShelves[index].SourceRootShelfId = item.SourceRootShelfID
+		request.Body.MovedSubShelves[index].SourceSubShelfIds = item.SourceSubShelfIds
+		request.Body.MovedSubShelves[index].DestinationRootShelfId = item.DestinationRootShelfID
+		request.Body.MovedSubShelves[index].DestinationSubShelfId = item.DestinationSubShelfID
+	}
+
+	response, exception := coreadapters.CallSecurly[
+		subshelvescontract.MoveMySubShelvesByRootShelfIdsRequestDto,
+		subshelvescontract.MoveMySubShelvesByRootShelfIdsResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		subshelvescontract.MoveMySubShelvesByRootShelfIdsOperation,
+		"/core/v1/sub-shelves/move-many-by-root-shelves",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// RestoreSubShelf is the resolver for the restoreSubShelf field.
+func (r *mutationResolver) RestoreSubShelf(ctx context.Context, input gqlmodels.RestoreSubShelfInput) (*subshelvescontract.SubShelfResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &subshelvescontract.RestoreMySubShelfByIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Param.SubShelfId = input.SubShelfID
+
+	response, exception := coreadapters.CallSecurly[
+		subshelvescontract.RestoreMySubShelfByIdRequestDto,
+		subshelvescontract.SubShelfResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		subshelvescontract.RestoreMySubShelfByIdOperation,
+		"/core/v1/sub-shelves/restore",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// RestoreSubShelves is the resolver for the restoreSubShelves field.
+func (r *mutationResolver) RestoreSubShelves(ctx context.Context, input gqlmodels.RestoreSubShelvesInput) ([]*subshelvescontract.SubShelfResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &subshelvescontract.RestoreMySubShelvesByIdsRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.SubShelfIds = input.SubShelfIds
+
+	response, exception := coreadapters.CallSecurly[
+		subshelvescontract.RestoreMySubShelvesByIdsRequestDto,
+		subshelvescontract.RestoreMySubShelvesByIdsResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		subshelvescontract.RestoreMySubShelvesByIdsOperation,
+		"/core/v1/sub-shelves/restore-many",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return toPointers(response.Data), nil
+}
+
+// DeleteSubShelf is the resolver for the deleteSubShelf field.
+func (r *mutationResolver) DeleteSubShelf(ctx context.Context, input gqlmodels.DeleteSubShelfInput) (*subshelvescontract.DeleteMySubShelfByIdResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &subshelvescontract.DeleteMySubShelfByIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Param.SubShelfId = input.SubShelfID
+
+	response, exception := coreadapters.CallSecurly[
+		subshelvescontract.DeleteMySubShelfByIdRequestDto,
+		subshelvescontract.DeleteMySubShelfByIdResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		subshelvescontract.DeleteMySubShelfByIdOperation,
+		"/core/v1/sub-shelves/delete",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// DeleteSubShelves is the resolver for the deleteSubShelves field.
+func (r *mutationResolver) DeleteSubShelves(ctx context.Context, input gqlmodels.DeleteSubShelvesInput) (*subshelvescontract.DeleteMySubShelvesByIdsResponseDto, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	request := &subshelvescontract.DeleteMySubShelvesByIdsRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Body.SubShelfIds = input.SubShelfIds
+
+	response, exception := coreadapters.CallSecurly[
+		subshelvescontract.DeleteMySubShelvesByIdsRequestDto,
+		subshelvescontract.DeleteMySubShelvesByIdsResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		subshelvescontract.DeleteMySubShelvesByIdsOperation,
+		"/core/v1/sub-shelves/delete-many",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
@@ -0,0 +1,30 @@
+package resolvers
+
+/* ============================== Auxiliary Functions ============================== */
+// The REST binders decode the request body straight into the Core request DTOs,
+// while the GraphQL inputs are generated models, so the mutation resolvers use these
+// functions to convert the shapes GraphQL cannot express.
+
+// toSetNull converts the list of field names to the set of fields the Core service should set to null
+func toSetNull(fields []string) *map[string]bool {
+	if fields == nil {
+		return nil
+	}
+
+	setNull := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		setNull[field] = true
+	}
+
+	return &setNull
+}
+
+// toPointers converts the data of a many-response DTO into the list type returned by gqlgen resolvers
+func toPointers[T any](values []T) []*T {
+	pointers := make([]*T, len(values))
+	for index := range values {
+		pointers[index] = &values[index]
+	}
+
+	return pointers
+}
@@ -1,9 +0,0 @@
-package resolvers
-
-type MutationResolverInterface interface{}
-
-type MutationResolver struct{ *Resolver }
-
-func (r *Resolver) Mutation() *MutationResolver {
-	return &MutationResolver{r}
-}
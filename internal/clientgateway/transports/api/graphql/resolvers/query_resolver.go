@@ -19,6 +19,7 @@ import (
 	stationscontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/stations"
 	subshelvescontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/sub-shelves"
 	themescontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/themes"
+	useraccountscontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/user-accounts"
 	userscontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/users"
 	"github.com/HiIamJeff67/notegic-backend/contracts/core/v1/graphql/generated"
 	gqlmodels "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/graphql/models"
@@ -339,7 +340,69 @@ func (r *queryResolver) SearchRoutineTaskRecords(ctx context.Context, input gqlm
 	return &response.Data, nil
 }
 
+// GetMyUsage is the resolver for the getMyUsage field.
+func (r *queryResolver) GetMyUsage(ctx context.Context, input *gqlmodels.GetMyUsageInput) (*gqlmodels.MyUsage, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+	if input == nil {
+		input = &gqlmodels.GetMyUsageInput{}
+	}
+
+	response, exception := coreadapters.CallSecurly[
+		useraccountscontract.GraphQLGetMyUsageRequestDto,
+		useraccountscontract.GraphQLGetMyUsageResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		input,
+		useraccountscontract.GraphQLGetMyUsageOperation,
+		"/core/v1/user-accounts/graphql/get-my-usage",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// GetMyTrash is the resolver for the getMyTrash field.
+func (r *queryResolver) GetMyTrash(ctx context.Context, input *gqlmodels.GetMyTrashInput) (*gqlmodels.MyTrash, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+	if input == nil {
+		input = &gqlmodels.GetMyTrashInput{}
+	}
+
+	response, exception := coreadapters.CallSecurly[
+		itemscontract.GetMyTrashRequestDto,
+		itemscontract.GetMyTrashResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		input,
+		itemscontract.GetMyTrashOperation,
+		"/core/v1/items/graphql/get-my-trash",
+	)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	return &response.Data, nil
+}
+
+// Mutation returns generated.MutationResolver implementation.
+func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
+
 // Query returns generated.QueryResolver implementation.
 func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
+// Subscription returns generated.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
+
+type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
@@ -1,6 +1,7 @@
 package resolvers
 
 import (
+	realtimeevent "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/data/cache/realtimeevent"
 	dataloaders "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/api/graphql/dataloaders"
 	coreadapters "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/core/adapters"
 )
@@ -8,13 +9,18 @@ import (
 // This file is GraphQL transport dependency injection.
 
 type Resolver struct {
-	dataloader  dataloaders.Dataloaders
-	coreAdapter *coreadapters.CoreAdapter
+	dataloader               dataloaders.Dataloaders
+	coreAdapter              *coreadapters.CoreAdapter
+	realtimeEventCacheClient *realtimeevent.RealtimeEventCacheClient
 }
 
-func NewResolver(coreAdapter *coreadapters.CoreAdapter) *Resolver {
+func NewResolver(
+	coreAdapter *coreadapters.CoreAdapter,
+	realtimeEventCacheClient *realtimeevent.RealtimeEventCacheClient,
+) *Resolver {
 	return &Resolver{
-		dataloader:  dataloaders.NewDataloaders(coreAdapter),
-		coreAdapter: coreAdapter,
+		dataloader:               dataloaders.NewDataloaders(coreAdapter),
+		coreAdapter:              coreAdapter,
+		realtimeEventCacheClient: realtimeEventCacheClient,
 	}
 }
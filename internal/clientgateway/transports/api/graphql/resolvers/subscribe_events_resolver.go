@@ -0,0 +1,142 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.76
+
+import (
+	"context"
+	"time"
+
+	gqlmodels "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/graphql/models"
+	gatewaycontexts "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/contexts"
+	"github.com/HiIamJeff67/notegic-backend/shared/util/exceptionwriter"
+)
+
+// ResourceEvents is the resolver for the resourceEvents field.
+func (r *subscriptionResolver) ResourceEvents(ctx context.Context, input *gqlmodels.SubscribeResourceEventsInput) (<-chan *gqlmodels.ResourceEvent, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+	userPublicId, exception := getSubscriberPublicId(ginContext)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+	realtimeEventCacheClient, exception := r.getRealtimeEventCacheClient()
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+	resourceIds, exception := r.authorizeResourceIds(ginContext, input)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+	expiresAt, exception := getSubscriptionExpiresAt(ginContext)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	events, unsubscribe := realtimeEventCacheClient.SubscribeResourceEvents()
+	resourceEvents := make(chan *gqlmodels.ResourceEvent)
+	go func() {
+		defer close(resourceEvents)
+		defer unsubscribe()
+
+		// the stream is completed once the token of the handshake expires, so the client subscribes again with a new one
+		expiration := time.NewTimer(time.Until(expiresAt))
+		defer expiration.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-expiration.C:
+				return
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+				if !isResourceEventVisible(event, userPublicId, resourceIds) {
+					continue
+				}
+				if isResourceEventTerminal(event, userPublicId) {
+					// stop forwarding the broadcast events of a resource the subscriber can no longer read
+					delete(resourceIds, event.ResourceId)
+				} else if exception := r.authorizeResourceEvent(ginContext, event); exception != nil {
+					if !isResourceAccessDenied(exception) {
+						return
+					}
+					// the access has been lost before its revocation event has reached this subscription
+					delete(resourceIds, event.ResourceId)
+					continue
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case resourceEvents <- toResourceEvent(event):
+				}
+			}
+		}
+	}()
+
+	return resourceEvents, nil
+}
+
+// RoutineTaskLifecycleEvents is the resolver for the routineTaskLifecycleEvents field.
+func (r *subscriptionResolver) RoutineTaskLifecycleEvents(ctx context.Context, input *gqlmodels.SubscribeRoutineTaskLifecycleEventsInput) (<-chan *gqlmodels.RoutineTaskLifecycleEvent, error) {
+	ginContext, exception := gatewaycontexts.GetAndConvertContextToGinContext(ctx)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+	userPublicId, exception := getSubscriberPublicId(ginContext)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+	realtimeEventCacheClient, exception := r.getRealtimeEventCacheClient()
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+	if input == nil {
+		input = &gqlmodels.SubscribeRoutineTaskLifecycleEventsInput{}
+	}
+	routineIds, routineTaskIds := toIdSet(input.RoutineIds), toIdSet(input.RoutineTaskIds)
+	expiresAt, exception := getSubscriptionExpiresAt(ginContext)
+	if exception != nil {
+		return nil, exceptionwriter.ToGraphQLError(exception, ctx)
+	}
+
+	events, unsubscribe := realtimeEventCacheClient.SubscribeRoutineTaskLifecycleEvents()
+	routineTaskLifecycleEvents := make(chan *gqlmodels.RoutineTaskLifecycleEvent)
+	go func() {
+		defer close(routineTaskLifecycleEvents)
+		defer unsubscribe()
+
+		expiration := time.NewTimer(time.Until(expiresAt))
+		defer expiration.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-expiration.C:
+				return
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+				if !isRoutineTaskLifecycleEventVisible(event, userPublicId, routineIds, routineTaskIds) {
+					continue
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case routineTaskLifecycleEvents <- toRoutineTaskLifecycleEvent(event):
+				}
+			}
+		}
+	}()
+
+	return routineTaskLifecycleEvents, nil
+}
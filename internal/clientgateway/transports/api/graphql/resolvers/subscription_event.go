@@ -0,0 +1,286 @@
+package resolvers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+	sharedcontexts "github.com/HiIamJeff67/notegic-backend/shared/lib/contexts"
+	sharedtokens "github.com/HiIamJeff67/notegic-backend/shared/tokens"
+
+	blockpackscontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/block-packs"
+	rootshelvescontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/root-shelves"
+	coreeventscontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/events"
+	gqlmodels "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/graphql/models"
+	enumcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
+	eventcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/events"
+
+	gatewaycontexts "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/contexts"
+	realtimeevent "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/data/cache/realtimeevent"
+	coreadapters "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/core/adapters"
+)
+
+// maximumSubscribedResourceIds bounds the Core service calls made to authorize one subscription
+const maximumSubscribedResourceIds = 32
+
+/* ============================== Auxiliary Functions ============================== */
+
+func getSubscriberPublicId(ginContext *gin.Context) (uuid.UUID, *exceptions.Exception) {
+	userPublicId, exception := gatewaycontexts.GetAndConvertContextFieldToUUID(
+		ginContext,
+		sharedcontexts.ContextFieldName_User_PublicId,
+	)
+	if exception != nil {
+		return uuid.Nil, exception
+	}
+	if userPublicId == nil || *userPublicId == uuid.Nil {
+		return uuid.Nil, exceptions.New(
+			"ContextFieldInvalid",
+			"Gateway",
+			"Subscribe",
+			"A valid user subject is required for a GraphQL subscription",
+			http.StatusInternalServerError,
+			true,
+		)
+	}
+
+	return *userPublicId, nil
+}
+
+// getSubscriptionExpiresAt returns when the token authenticating the subscription expires, read in the same order as
+// the JWT middleware sets them, since the graphql-ws connection is only authenticated at its handshake
+func getSubscriptionExpiresAt(ginContext *gin.Context) (time.Time, *exceptions.Exception) {
+	if shareSessionToken := getSubscriptionToken(ginContext, sharedcontexts.ContextFieldName_ShareSessionToken); shareSessionToken != "" {
+		if claims, err := sharedtokens.ParseShareSessionToken(shareSessionToken); err == nil && claims.ExpiresAt != nil {
+			return claims.ExpiresAt.Time, nil
+		}
+	}
+	if accessToken := getSubscriptionToken(ginContext, sharedcontexts.ContextFieldName_AccessToken); accessToken != "" {
+		if claims, err := sharedtokens.ParseAccessToken(accessToken); err == nil && claims.ExpiresAt != nil {
+			return claims.ExpiresAt.Time, nil
+		}
+	}
+	if refreshToken := getSubscriptionToken(ginContext, sharedcontexts.ContextFieldName_RefreshToken); refreshToken != "" {
+		if claims, err := sharedtokens.ParseRefreshToken(refreshToken); err == nil && claims.ExpiresAt != nil {
+			return claims.ExpiresAt.Time, nil
+		}
+	}
+
+	return time.Time{}, exceptions.New(
+		"SubscriptionUnauthorized",
+		"Gateway",
+		"Subscribe",
+		"A valid session is required for a GraphQL subscription",
+		http.StatusUnauthorized,
+	)
+}
+
+func getSubscriptionToken(ginContext *gin.Context, contextFieldName sharedcontexts.ContextFieldName) string {
+	token, exception := gatewaycontexts.GetAndConvertContextFieldToString(ginContext, contextFieldName)
+	if exception != nil || token == nil {
+		return ""
+	}
+
+	return *token
+}
+
+func (r *Resolver) getRealtimeEventCacheClient() (*realtimeevent.RealtimeEventCacheClient, *exceptions.Exception) {
+	if r.realtimeEventCacheClient == nil {
+		return nil, exceptions.New(
+			"RealtimeEventUnavailable",
+			"Gateway",
+			"Subscribe",
+			"GraphQL subscriptions are unavailable on this gateway",
+			http.StatusServiceUnavailable,
+			true,
+		)
+	}
+
+	return r.realtimeEventCacheClient, nil
+}
+
+// authorizeResourceIds asks the Core service for every requested resource,
+// so a user can only receive the broadcast events of the resources they can read.
+func (r *Resolver) authorizeResourceIds(
+	ginContext *gin.Context,
+	input *gqlmodels.SubscribeResourceEventsInput,
+) (map[uuid.UUID]struct{}, *exceptions.Exception) {
+	resourceIds := make(map[uuid.UUID]struct{})
+	if input == nil {
+		return resourceIds, nil
+	}
+	if len(input.RootShelfIds)+len(input.BlockPackIds) > maximumSubscribedResourceIds {
+		return nil, exceptions.InvalidInput("Subscription", "Too many resources are subscribed at once")
+	}
+
+	for _, rootShelfId := range input.RootShelfIds {
+		if exception := r.authorizeRootShelf(ginContext, rootShelfId); exception != nil {
+			return nil, exception
+		}
+		resourceIds[rootShelfId] = struct{}{}
+	}
+
+	for _, blockPackId := range input.BlockPackIds {
+		if exception := r.authorizeBlockPack(ginContext, blockPackId); exception != nil {
+			return nil, exception
+		}
+		resourceIds[blockPackId] = struct{}{}
+	}
+
+	return resourceIds, nil
+}
+
+// authorizeResourceEvent asks the Core service for the resource of the event again before it is delivered,
+// since the access of the subscriber may have changed since the subscription without an event reaching it yet
+func (r *Resolver) authorizeResourceEvent(ginContext *gin.Context, event realtimeevent.ResourceEvent) *exceptions.Exception {
+	switch eventcontract.EventType(event.EventType) {
+	case coreeventscontract.EventType_RootShelfPermissionChanged,
+		coreeventscontract.EventType_RootShelfPermissionRevoked,
+		coreeventscontract.EventType_RootShelfDeleted:
+		return r.authorizeRootShelf(ginContext, event.ResourceId)
+	case coreeventscontract.EventType_BlockPackChanged,
+		coreeventscontract.EventType_BlockPackDeleted:
+		return r.authorizeBlockPack(ginContext, event.ResourceId)
+	default:
+		return exceptions.New(
+			"ResourceEventUnsupported",
+			"Gateway",
+			"Subscribe",
+			"The resource of the event can not be authorized",
+			http.StatusForbidden,
+		)
+	}
+}
+
+// isResourceAccessDenied reports whether only the resource is unreadable, while any other exception ends the subscription
+func isResourceAccessDenied(exception *exceptions.Exception) bool {
+	return exception.HTTPStatusCode() == http.StatusForbidden || exception.HTTPStatusCode() == http.StatusNotFound
+}
+
+func (r *Resolver) authorizeRootShelf(ginContext *gin.Context, rootShelfId uuid.UUID) *exceptions.Exception {
+	request := &rootshelvescontract.GetMyRootShelfByIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Param.RootShelfId = rootShelfId
+	_, exception := coreadapters.CallSecurly[
+		rootshelvescontract.GetMyRootShelfByIdRequestDto,
+		rootshelvescontract.GetMyRootShelfByIdResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		rootshelvescontract.GetMyRootShelfByIdOperation,
+		"/core/v1/root-shelves/get-by-id",
+	)
+
+	return exception
+}
+
+func (r *Resolver) authorizeBlockPack(ginContext *gin.Context, blockPackId uuid.UUID) *exceptions.Exception {
+	request := &blockpackscontract.GetMyBlockPackByIdRequestDto{}
+	request.Header.UserAgent = ginContext.GetHeader("User-Agent")
+	request.Param.BlockPackId = blockPackId
+	_, exception := coreadapters.CallSecurly[
+		blockpackscontract.GetMyBlockPackByIdRequestDto,
+		blockpackscontract.GetMyBlockPackByIdResponseDto,
+	](
+		ginContext,
+		r.coreAdapter,
+		request,
+		blockpackscontract.GetMyBlockPackByIdOperation,
+		"/core/v1/block-packs/get-by-id",
+	)
+
+	return exception
+}
+
+// isResourceEventVisible mirrors the RealtimeGateway fan-out: a targeted event is only sent to its target user,
+// and a broadcast event is only sent to the subscribers of its resource.
+func isResourceEventVisible(
+	event realtimeevent.ResourceEvent,
+	userPublicId uuid.UUID,
+	resourceIds map[uuid.UUID]struct{},
+) bool {
+	_, isSubscribed := resourceIds[event.ResourceId]
+	if event.TargetUserPublicId != nil {
+		return *event.TargetUserPublicId == userPublicId && (len(resourceIds) == 0 || isSubscribed)
+	}
+
+	return isSubscribed
+}
+
+// isResourceEventTerminal reports whether the subscriber can no longer read the resource after the event
+func isResourceEventTerminal(event realtimeevent.ResourceEvent, userPublicId uuid.UUID) bool {
+	switch coreeventscontract.ResourceEventChange(event.Change) {
+	case coreeventscontract.ResourceEventChange_Deleted:
+		return true
+	case coreeventscontract.ResourceEventChange_PermissionRevoked:
+		return event.TargetUserPublicId == nil || *event.TargetUserPublicId == userPublicId
+	default:
+		return false
+	}
+}
+
+func toResourceEvent(event realtimeevent.ResourceEvent) *gqlmodels.ResourceEvent {
+	var permission *enumcontract.AccessControlPermission
+	if event.Permission != "" {
+		value := enumcontract.AccessControlPermission(event.Permission)
+		permission = &value
+	}
+
+	return &gqlmodels.ResourceEvent{
+		EventID:            event.EventId,
+		EventType:          event.EventType,
+		ResourceID:         event.ResourceId,
+		TargetUserPublicID: event.TargetUserPublicId,
+		Change:             coreeventscontract.ResourceEventChange(event.Change),
+		Permission:         permission,
+	}
+}
+
+func toIdSet(ids []uuid.UUID) map[uuid.UUID]struct{} {
+	set := make(map[uuid.UUID]struct{}, len(ids))
+	for _, id := range ids {
+		set[id] = struct{}{}
+	}
+
+	return set
+}
+
+func isRoutineTaskLifecycleEventVisible(
+	event realtimeevent.RoutineTaskLifecycleEvent,
+	userPublicId uuid.UUID,
+	routineIds map[uuid.UUID]struct{},
+	routineTaskIds map[uuid.UUID]struct{},
+) bool {
+	if event.ActorUserPublicId != userPublicId {
+		return false
+	}
+	if len(routineIds) > 0 {
+		if _, ok := routineIds[event.RoutineId]; !ok {
+			return false
+		}
+	}
+	if len(routineTaskIds) > 0 {
+		if _, ok := routineTaskIds[event.RoutineTaskId]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func toRoutineTaskLifecycleEvent(event realtimeevent.RoutineTaskLifecycleEvent) *gqlmodels.RoutineTaskLifecycleEvent {
+	return &gqlmodels.RoutineTaskLifecycleEvent{
+		EventID:             event.EventId,
+		RoutineTaskID:       event.RoutineTaskId,
+		RoutineTaskRecordID: event.RoutineTaskRecordId,
+		RoutineID:           event.RoutineId,
+		Purpose:             enumcontract.RoutineTaskPurpose(event.Purpose),
+		Status:              event.Status,
+		Attempt:             event.Attempt,
+		OccurredAt:          event.OccurredAt,
+	}
+}
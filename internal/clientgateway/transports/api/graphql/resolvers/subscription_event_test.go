@@ -0,0 +1,315 @@
+package resolvers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	rootshelvescontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/root-shelves"
+	coreeventscontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/events"
+	gqlmodels "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/graphql/models"
+	gatewaycontract "github.com/HiIamJeff67/notegic-backend/contracts/gateway/v1"
+	enumcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+	sharedcontexts "github.com/HiIamJeff67/notegic-backend/shared/lib/contexts"
+	platformredis "github.com/HiIamJeff67/notegic-backend/shared/platform/redis"
+	sharedtokens "github.com/HiIamJeff67/notegic-backend/shared/tokens"
+
+	realtimeevent "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/data/cache/realtimeevent"
+	coreadapters "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/core/adapters"
+)
+
+// newSubscriptionTestContext authenticates the handshake of a subscription with an access token expiring at the given time
+func newSubscriptionTestContext(t *testing.T, expiresAt time.Time) *gin.Context {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	t.Setenv("JWT_ACCESS_TOKEN_SECRET_KEY", "test-access-token-secret")
+
+	accessToken, err := sharedtokens.SignJWT("test-access-token-secret", sharedtokens.AccessTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(expiresAt), Subject: uuid.NewString()},
+	})
+	if err != nil {
+		t.Fatalf("failed to sign the access token: %v", err)
+	}
+	ginContext, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ginContext.Request = httptest.NewRequest(http.MethodGet, "/graphql", nil)
+	ginContext.Set(sharedcontexts.ContextFieldName_User_PublicId.String(), uuid.New())
+	ginContext.Set(sharedcontexts.ContextFieldName_AccessToken.String(), accessToken)
+
+	return ginContext
+}
+
+// newReadableRootShelvesCore serves the root shelves readable by the subscriber, and forbids every other one
+func newReadableRootShelvesCore(t *testing.T, readableRootShelfIds *sync.Map) *coreadapters.CoreAdapter {
+	t.Helper()
+	t.Setenv("CORE_DELEGATION_SECRET", "test-delegation-secret")
+	t.Setenv("CORE_DELEGATION_AUDIENCE", "notegic-core-test")
+	t.Setenv("CORE_DELEGATION_ISSUER", "notegic-gateway-test")
+
+	core := httptest.NewServer(http.HandlerFunc(func(responseWriter http.ResponseWriter, request *http.Request) {
+		requestEnvelope := gatewaycontract.Request[rootshelvescontract.GetMyRootShelfByIdRequestDto]{}
+		if err := json.NewDecoder(request.Body).Decode(&requestEnvelope); err != nil {
+			t.Errorf("decode request envelope: %v", err)
+		}
+		response := &gatewaycontract.Response[map[string]any]{
+			Version:  gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{RequestId: requestEnvelope.Metadata.RequestId},
+		}
+		responseWriter.Header().Set("Content-Type", "application/json")
+		if _, readable := readableRootShelfIds.Load(requestEnvelope.Dto.Param.RootShelfId); !readable {
+			response.Exception = exceptions.New("Forbidden", "Core", "GetMyRootShelfById", "The root shelf is not readable", http.StatusForbidden)
+			responseWriter.WriteHeader(http.StatusForbidden)
+		}
+		_ = json.NewEncoder(responseWriter).Encode(response)
+	}))
+	t.Cleanup(core.Close)
+
+	return coreadapters.NewCoreAdapter(core.URL, time.Second)
+}
+
+func newListeningRealtimeEventCacheClient(t *testing.T) (*realtimeevent.RealtimeEventCacheClient, *redis.Client) {
+	t.Helper()
+
+	server, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("failed to start test redis server: %v", err)
+	}
+	t.Cleanup(server.Close)
+	redisClient := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { _ = redisClient.Close() })
+
+	client := realtimeevent.NewRealtimeEventCacheClient(realtimeevent.NewRealtimeEventCacheStore(platformredis.NewClientSetFromClients(redisClient)))
+	t.Cleanup(client.Close)
+	if err := client.Listen(); err != nil {
+		t.Fatalf("failed to listen the realtime events: %v", err)
+	}
+
+	return client, redisClient
+}
+
+func publishResourceEvent(t *testing.T, redisClient *redis.Client, event realtimeevent.ResourceEvent) {
+	t.Helper()
+
+	data, _ := json.Marshal(event)
+	if err := redisClient.Publish("Realtime:resource:events", data).Err(); err != nil {
+		t.Fatalf("failed to publish the resource event: %v", err)
+	}
+}
+
+/* ============================== Tests ============================== */
+
+func TestIsResourceEventVisible(t *testing.T) {
+	userPublicId, otherUserPublicId := uuid.New(), uuid.New()
+	subscribedId, unsubscribedId := uuid.New(), uuid.New()
+	resourceIds := toIdSet([]uuid.UUID{subscribedId})
+
+	testCases := []struct {
+		name        string
+		event       realtimeevent.ResourceEvent
+		resourceIds map[uuid.UUID]struct{}
+		expected    bool
+	}{
+		{
+			name:        "a broadcast event of a subscribed resource is visible",
+			event:       realtimeevent.ResourceEvent{ResourceId: subscribedId},
+			resourceIds: resourceIds,
+			expected:    true,
+		},
+		{
+			name:        "a broadcast event of another resource is hidden",
+			event:       realtimeevent.ResourceEvent{ResourceId: unsubscribedId},
+			resourceIds: resourceIds,
+			expected:    false,
+		},
+		{
+			name:        "a broadcast event is hidden without any subscribed resource",
+			event:       realtimeevent.ResourceEvent{ResourceId: subscribedId},
+			resourceIds: map[uuid.UUID]struct{}{},
+			expected:    false,
+		},
+		{
+			name:        "a targeted event is visible to its target without any subscribed resource",
+			event:       realtimeevent.ResourceEvent{ResourceId: unsubscribedId, TargetUserPublicId: &userPublicId},
+			resourceIds: map[uuid.UUID]struct{}{},
+			expected:    true,
+		},
+		{
+			name:        "a targeted event of another resource is hidden once resources are subscribed",
+			event:       realtimeevent.ResourceEvent{ResourceId: unsubscribedId, TargetUserPublicId: &userPublicId},
+			resourceIds: resourceIds,
+			expected:    false,
+		},
+		{
+			name:        "a targeted event of another user is hidden",
+			event:       realtimeevent.ResourceEvent{ResourceId: subscribedId, TargetUserPublicId: &otherUserPublicId},
+			resourceIds: resourceIds,
+			expected:    false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if visible := isResourceEventVisible(testCase.event, userPublicId, testCase.resourceIds); visible != testCase.expected {
+				t.Fatalf("expected the visibility %v, got %v", testCase.expected, visible)
+			}
+		})
+	}
+}
+
+func TestIsResourceEventTerminal(t *testing.T) {
+	userPublicId, otherUserPublicId := uuid.New(), uuid.New()
+
+	testCases := []struct {
+		name     string
+		event    realtimeevent.ResourceEvent
+		expected bool
+	}{
+		{
+			name:     "a deleted resource ends the subscription",
+			event:    realtimeevent.ResourceEvent{Change: string(coreeventscontract.ResourceEventChange_Deleted)},
+			expected: true,
+		},
+		{
+			name:     "a revoked permission of the subscriber ends the subscription",
+			event:    realtimeevent.ResourceEvent{Change: string(coreeventscontract.ResourceEventChange_PermissionRevoked), TargetUserPublicId: &userPublicId},
+			expected: true,
+		},
+		{
+			name:     "a revoked permission of another user keeps the subscription",
+			event:    realtimeevent.ResourceEvent{Change: string(coreeventscontract.ResourceEventChange_PermissionRevoked), TargetUserPublicId: &otherUserPublicId},
+			expected: false,
+		},
+		{
+			name:     "an updated resource keeps the subscription",
+			event:    realtimeevent.ResourceEvent{Change: string(coreeventscontract.ResourceEventChange_Updated)},
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if terminal := isResourceEventTerminal(testCase.event, userPublicId); terminal != testCase.expected {
+				t.Fatalf("expected the termination %v, got %v", testCase.expected, terminal)
+			}
+		})
+	}
+}
+
+func TestIsRoutineTaskLifecycleEventVisible(t *testing.T) {
+	userPublicId := uuid.New()
+	routineId, routineTaskId := uuid.New(), uuid.New()
+	event := realtimeevent.RoutineTaskLifecycleEvent{
+		RoutineId:         routineId,
+		RoutineTaskId:     routineTaskId,
+		ActorUserPublicId: userPublicId,
+	}
+
+	if !isRoutineTaskLifecycleEventVisible(event, userPublicId, nil, nil) {
+		t.Fatal("expected the event of the actor to be visible without any filter")
+	}
+	if isRoutineTaskLifecycleEventVisible(event, uuid.New(), nil, nil) {
+		t.Fatal("expected the event of another actor to be hidden")
+	}
+	if !isRoutineTaskLifecycleEventVisible(event, userPublicId, toIdSet([]uuid.UUID{routineId}), toIdSet([]uuid.UUID{routineTaskId})) {
+		t.Fatal("expected the event of the filtered routine task to be visible")
+	}
+	if isRoutineTaskLifecycleEventVisible(event, userPublicId, toIdSet([]uuid.UUID{uuid.New()}), nil) {
+		t.Fatal("expected the event of another routine to be hidden")
+	}
+	if isRoutineTaskLifecycleEventVisible(event, userPublicId, nil, toIdSet([]uuid.UUID{uuid.New()})) {
+		t.Fatal("expected the event of another routine task to be hidden")
+	}
+}
+
+func TestToResourceEventKeepsTheOptionalPermission(t *testing.T) {
+	event := toResourceEvent(realtimeevent.ResourceEvent{ResourceId: uuid.New(), Permission: string(enumcontract.AccessControlPermission_Write)})
+	if event.Permission == nil || *event.Permission != enumcontract.AccessControlPermission_Write {
+		t.Fatalf("expected the Write permission, got %v", event.Permission)
+	}
+	if event := toResourceEvent(realtimeevent.ResourceEvent{}); event.Permission != nil {
+		t.Fatalf("expected no permission, got %v", *event.Permission)
+	}
+}
+
+func TestAuthorizeResourceIdsRejectsTooManyResources(t *testing.T) {
+	input := &gqlmodels.SubscribeResourceEventsInput{RootShelfIds: make([]uuid.UUID, maximumSubscribedResourceIds+1)}
+	if _, exception := (&Resolver{}).authorizeResourceIds(nil, input); exception == nil {
+		t.Fatal("expected too many resources to be rejected")
+	}
+	resourceIds, exception := (&Resolver{}).authorizeResourceIds(nil, nil)
+	if exception != nil || len(resourceIds) != 0 {
+		t.Fatalf("expected no resource without an input, got %v, %v", resourceIds, exception)
+	}
+}
+
+func TestGetSubscriptionExpiresAtReadsTheTokenOfTheHandshake(t *testing.T) {
+	expiresAt := time.Now().Add(time.Minute).Truncate(time.Second)
+	ginContext := newSubscriptionTestContext(t, expiresAt)
+
+	if got, exception := getSubscriptionExpiresAt(ginContext); exception != nil || !got.Equal(expiresAt) {
+		t.Fatalf("getSubscriptionExpiresAt() = %v, %v, want %v", got, exception, expiresAt)
+	}
+
+	ginContext.Set(sharedcontexts.ContextFieldName_AccessToken.String(), "invalid-token")
+	if _, exception := getSubscriptionExpiresAt(ginContext); exception == nil || exception.HTTPStatusCode() != http.StatusUnauthorized {
+		t.Fatalf("getSubscriptionExpiresAt() exception = %v, want an unauthorized subscription", exception)
+	}
+}
+
+func TestResourceEventsReauthorizesEveryEventAndEndsWithTheToken(t *testing.T) {
+	keptRootShelfId, revokedRootShelfId := uuid.New(), uuid.New()
+	readableRootShelfIds := &sync.Map{}
+	readableRootShelfIds.Store(keptRootShelfId, struct{}{})
+	readableRootShelfIds.Store(revokedRootShelfId, struct{}{})
+	realtimeEventCacheClient, redisClient := newListeningRealtimeEventCacheClient(t)
+	resolver := NewResolver(newReadableRootShelvesCore(t, readableRootShelfIds), realtimeEventCacheClient)
+
+	// the token expires after the authorization at the handshake, while the stream is still open
+	ginContext := newSubscriptionTestContext(t, time.Now().Add(2*time.Second))
+	ctx := context.WithValue(context.Background(), sharedcontexts.ContextFieldName_GinContext, ginContext)
+	events, err := resolver.Subscription().ResourceEvents(ctx, &gqlmodels.SubscribeResourceEventsInput{
+		RootShelfIds: []uuid.UUID{keptRootShelfId, revokedRootShelfId},
+	})
+	if err != nil {
+		t.Fatalf("ResourceEvents() error = %v", err)
+	}
+
+	// the access is lost without its revocation event, so only the event of the kept root shelf is delivered
+	readableRootShelfIds.Delete(revokedRootShelfId)
+	changedEventType := string(coreeventscontract.EventType_RootShelfPermissionChanged)
+	publishResourceEvent(t, redisClient, realtimeevent.ResourceEvent{
+		EventId: uuid.New(), EventType: changedEventType, ResourceId: revokedRootShelfId, Change: string(coreeventscontract.ResourceEventChange_Updated),
+	})
+	keptEvent := realtimeevent.ResourceEvent{
+		EventId: uuid.New(), EventType: changedEventType, ResourceId: keptRootShelfId, Change: string(coreeventscontract.ResourceEventChange_Updated),
+	}
+	publishResourceEvent(t, redisClient, keptEvent)
+
+	select {
+	case event := <-events:
+		if event == nil || event.EventID != keptEvent.EventId {
+			t.Fatalf("ResourceEvents() delivered %+v, want only the event of the readable root shelf", event)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("ResourceEvents() did not deliver the event of the readable root shelf")
+	}
+
+	select {
+	case event, ok := <-events:
+		if ok {
+			t.Fatalf("ResourceEvents() delivered %+v, want the stream closed with the token", event)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("ResourceEvents() kept the stream open after the token expired")
+	}
+}
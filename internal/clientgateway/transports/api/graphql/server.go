@@ -2,25 +2,60 @@ package graphql
 
 import (
 	"context"
+	"net/http"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
 
 	sharedcontexts "github.com/HiIamJeff67/notegic-backend/shared/lib/contexts"
 
 	generated "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/graphql/generated"
 
+	realtimeevent "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/data/cache/realtimeevent"
 	resolvers "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/api/graphql/resolvers"
 	coreadapters "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/core/adapters"
 )
 
-func GraphQLHandler(coreAdapter *coreadapters.CoreAdapter) gin.HandlerFunc {
-	resolver := resolvers.NewResolver(coreAdapter)
+func GraphQLHandler(
+	coreAdapter *coreadapters.CoreAdapter,
+	realtimeEventCacheClient *realtimeevent.RealtimeEventCacheClient,
+) gin.HandlerFunc {
+	resolver := resolvers.NewResolver(coreAdapter, realtimeEventCacheClient)
 	server := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{
 		Resolvers: resolver,
 	}))
+	withMutationPermissions(server)
 
+	return withGinContext(server)
+}
+
+// SubscriptionHandler serves the Subscription root over the graphql-ws protocol,
+// it must not be wrapped by the buffering middlewares since the connection is hijacked.
+func SubscriptionHandler(
+	coreAdapter *coreadapters.CoreAdapter,
+	realtimeEventCacheClient *realtimeevent.RealtimeEventCacheClient,
+) gin.HandlerFunc {
+	resolver := resolvers.NewResolver(coreAdapter, realtimeEventCacheClient)
+	server := handler.New(generated.NewExecutableSchema(generated.Config{
+		Resolvers: resolver,
+	}))
+	server.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+		Upgrader: websocket.Upgrader{
+			// the request origin has been checked by the domain white list middleware of the API router group
+			CheckOrigin: func(_ *http.Request) bool { return true },
+		},
+	})
+	withoutMutations(server)
+
+	return withGinContext(server)
+}
+
+func withGinContext(server *handler.Server) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		requestContext := context.WithValue(
 			ctx.Request.Context(),
@@ -19,10 +19,10 @@ import (
 )
 
 // Adds non-sensitive refresh metadata to the public response.
-// The access token is written only to the Gateway cookie and never to JSON.
+// The access token and the rotated refresh token are written only to the Gateway cookies and never to JSON.
 // Note: this interceptor should be placed below the `JWTMiddleware`,
 // so that it can access the `AccessToken` and `CSRFToken` in the context field
-func RefreshTokenInterceptor(accessTokenCookieHandler *cookies.CookieHandler) func(string) gin.HandlerFunc {
+func RefreshTokenInterceptor(accessTokenCookieHandler, refreshTokenCookieHandler *cookies.CookieHandler) func(string) gin.HandlerFunc {
 	return func(responseWriterKey string) gin.HandlerFunc {
 		return func(ctx *gin.Context) {
 			var writer *responsewriter.ResponseWriter
@@ -47,7 +47,18 @@ func RefreshTokenInterceptor(accessTokenCookieHandler *cookies.CookieHandler) fu
 				return
 			}
 
-			if writer.ResponseWriter.Written() || writer.Status() >= 400 {
+			if writer.ResponseWriter.Written() {
+				return
+			}
+
+			// the refresh token of the session is rotated whenever it is used, so the device must
+			// replace its refresh token cookie even if the request itself has failed, or the previous
+			// refresh token will be taken as a reused one and sign the device out on the next refresh
+			if refreshToken, exception := contexts.GetAndConvertContextFieldToString(ctx, sharedcontexts.ContextFieldName_NewRefreshToken); exception == nil && refreshToken != nil {
+				refreshTokenCookieHandler.Set(ctx, *refreshToken)
+			}
+
+			if writer.Status() >= 400 {
 				return
 			}
 
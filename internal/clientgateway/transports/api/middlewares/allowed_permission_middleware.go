@@ -19,12 +19,17 @@ var orderedAccessControlPermissions = []enumcontract.AccessControlPermission{
 }
 
 func AllowedPermissionsAbove(permission enumcontract.AccessControlPermission) gin.HandlerFunc {
+	return AllowedPermissionsWithin(PermissionsAbove(permission)...)
+}
+
+// PermissionsAbove lists the permission and every permission above it, which is the scope of AllowedPermissionsAbove
+func PermissionsAbove(permission enumcontract.AccessControlPermission) []enumcontract.AccessControlPermission {
 	index := slices.Index(orderedAccessControlPermissions, permission)
 	if index < 0 {
 		panic(fmt.Sprintf("invalid access control permission: %s", permission))
 	}
 
-	return AllowedPermissionsWithin(orderedAccessControlPermissions[index:]...)
+	return slices.Clone(orderedAccessControlPermissions[index:])
 }
 
 func AllowedPermissionsBelow(permission enumcontract.AccessControlPermission) gin.HandlerFunc {
@@ -11,7 +11,7 @@ func CORSMiddleware() gin.HandlerFunc {
 		}
 		ctx.Header("Access-Control-Allow-Credentials", "true")
 		ctx.Header("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS, PATCH")
-		ctx.Header("Access-Control-Allow-Headers", "Content-Type, Authorization, User-Agent, X-Requested-With, X-CSRF-Token")
+		ctx.Header("Access-Control-Allow-Headers", "Content-Type, Authorization, User-Agent, X-Requested-With, X-CSRF-Token, X-Share-Session-Token")
 		ctx.Header("Access-Control-Max-Age", "86400") // 24 hours
 
 		if ctx.Request.Method == "OPTIONS" {
@@ -12,6 +12,11 @@ import (
 	sharedcontexts "github.com/HiIamJeff67/notegic-backend/shared/lib/contexts"
 )
 
+const ShareSessionTokenHeader = "X-Share-Session-Token"
+
+// JWTMiddleware prefers an explicit share session header over the user session, so a
+// signed-in user can still open a share link as a guest, while the share session
+// cookie is only used when there is no user session at all.
 func JWTMiddleware(accessTokenCookieHandler, refreshTokenCookieHandler *cookies.CookieHandler) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		ctx.Set(sharedcontexts.ContextFieldName_User_Id.String(), nil)
@@ -21,11 +26,18 @@ func JWTMiddleware(accessTokenCookieHandler, refreshTokenCookieHandler *cookies.
 		ctx.Set(sharedcontexts.ContextFieldName_AccessToken.String(), nil)
 		ctx.Set(sharedcontexts.ContextFieldName_RefreshToken.String(), nil)
 		ctx.Set(sharedcontexts.ContextFieldName_CSRFToken.String(), nil)
+		ctx.Set(sharedcontexts.ContextFieldName_ShareSessionToken.String(), nil)
 		ctx.Set(sharedcontexts.ContextFieldName_IsNewTokens.String(), false)
 		if csrfToken := ctx.GetHeader("X-CSRF-Token"); strings.TrimSpace(csrfToken) != "" {
 			ctx.Set(sharedcontexts.ContextFieldName_CSRFToken.String(), csrfToken)
 		}
 
+		if shareSessionToken := strings.TrimSpace(ctx.GetHeader(ShareSessionTokenHeader)); shareSessionToken != "" {
+			setShareSession(ctx, shareSessionToken)
+			ctx.Next()
+			return
+		}
+
 		accessToken, _ := accessTokenCookieHandler.Get(ctx)
 		if strings.TrimSpace(accessToken) == "" {
 			authorizationHeader := ctx.GetHeader("Authorization")
@@ -54,10 +66,28 @@ func JWTMiddleware(accessTokenCookieHandler, refreshTokenCookieHandler *cookies.
 					ctx.Set(sharedcontexts.ContextFieldName_User_Name.String(), claims.Name)
 					ctx.Set(sharedcontexts.ContextFieldName_User_Email.String(), claims.Email)
 					ctx.Set(sharedcontexts.ContextFieldName_RefreshToken.String(), refreshToken)
+					ctx.Next()
+					return
 				}
 			}
 		}
 
+		if shareSessionToken, err := ctx.Cookie(cookies.ValidCookieName_ShareSessionToken.String()); err == nil && shareSessionToken != "" {
+			setShareSession(ctx, shareSessionToken)
+		}
+
 		ctx.Next()
 	}
 }
+
+// setShareSession only verifies the share session here, whether its share link is
+// still active is checked by Core, which receives the share session as a token.
+func setShareSession(ctx *gin.Context, shareSessionToken string) {
+	claims, err := sharedtokens.ParseShareSessionToken(shareSessionToken)
+	if err != nil || claims.UserAgent != ctx.GetHeader("User-Agent") {
+		return
+	}
+
+	ctx.Set(sharedcontexts.ContextFieldName_User_PublicId.String(), claims.Subject)
+	ctx.Set(sharedcontexts.ContextFieldName_ShareSessionToken.String(), shareSessionToken)
+}
@@ -101,3 +101,60 @@ func TestJWTMiddlewareFallsBackToRefreshTokenSubject(t *testing.T) {
 		t.Fatalf("expected status %d, got %d", http.StatusNoContent, responseRecorder.Code)
 	}
 }
+
+func TestJWTMiddlewarePrefersShareSessionHeaderOverAccessToken(t *testing.T) {
+	t.Setenv("JWT_ACCESS_TOKEN_SECRET_KEY", "test-access-secret")
+	t.Setenv("JWT_SHARE_SESSION_TOKEN_SECRET_KEY", "test-share-session-secret")
+	accessToken, err := sharedtokens.GenerateAccessToken(
+		"83bdeac1-02de-42fe-a7a8-4e1a83174866",
+		sharedtokens.AccessTokenClaims{
+			Name:      "notegic",
+			Email:     "notegic@example.com",
+			UserAgent: "test-agent",
+		},
+	)
+	if err != nil {
+		t.Fatalf("generate access token: %v", err)
+	}
+	shareSessionToken, _, err := sharedtokens.GenerateShareSessionToken(
+		"1d6f4f6c-3f0e-4d43-9a51-3f4f8a5a2b61",
+		nil,
+		sharedtokens.ShareSessionTokenClaims{
+			ShareLinkId: "5b9f2a7e-8c1d-4e3f-9a6b-7c8d9e0f1a2b",
+			RootShelfId: "6c0a3b8f-9d2e-4f4a-8b7c-8d9e0f1a2b3c",
+			Permission:  sharedtokens.ShareLinkPermissionRead,
+			UserAgent:   "test-agent",
+		},
+	)
+	if err != nil {
+		t.Fatalf("generate share session token: %v", err)
+	}
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	accessTokenCookieHandler, refreshTokenCookieHandler := newTestCookieHandlers()
+	router.Use(JWTMiddleware(accessTokenCookieHandler, refreshTokenCookieHandler))
+	router.GET("/", func(ctx *gin.Context) {
+		value, exists := ctx.Get(sharedcontexts.ContextFieldName_User_PublicId.String())
+		if !exists || value != "1d6f4f6c-3f0e-4d43-9a51-3f4f8a5a2b61" {
+			t.Fatalf("unexpected user public ID context: %#v", value)
+		}
+		if value, _ := ctx.Get(sharedcontexts.ContextFieldName_ShareSessionToken.String()); value != *shareSessionToken {
+			t.Fatalf("unexpected share session token context: %#v", value)
+		}
+		if value, _ := ctx.Get(sharedcontexts.ContextFieldName_AccessToken.String()); value != nil {
+			t.Fatalf("expected no access token context, got %#v", value)
+		}
+		ctx.Status(http.StatusNoContent)
+	})
+
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	request.Header.Set("User-Agent", "test-agent")
+	request.Header.Set(ShareSessionTokenHeader, *shareSessionToken)
+	request.AddCookie(&http.Cookie{Name: "accessToken", Value: *accessToken})
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, request)
+	if responseRecorder.Code != http.StatusNoContent {
+		t.Fatalf("expected status %d, got %d", http.StatusNoContent, responseRecorder.Code)
+	}
+}
@@ -0,0 +1,50 @@
+package middlewares
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+
+	sharedcontexts "github.com/HiIamJeff67/notegic-backend/shared/lib/contexts"
+	sharedtokens "github.com/HiIamJeff67/notegic-backend/shared/tokens"
+	exceptionwriter "github.com/HiIamJeff67/notegic-backend/shared/util/exceptionwriter"
+)
+
+const NotificationDigestUnsubscribeTokenQuery = "token"
+
+// NotificationDigestUnsubscribeMiddleware authenticates the unsubscribe link of a digest email, which is opened by the
+// browser or posted by the mail client without any session, so the unsubscribe token in the query is the only credential.
+func NotificationDigestUnsubscribeMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Set(sharedcontexts.ContextFieldName_User_PublicId.String(), nil)
+
+		token := strings.TrimSpace(ctx.Query(NotificationDigestUnsubscribeTokenQuery))
+		if token == "" {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.New(
+				"MissingUnsubscribeToken",
+				"Notification",
+				"UnsubscribeMyNotificationDigest",
+				"The unsubscribe token is required",
+				http.StatusUnauthorized,
+			), ctx)
+			return
+		}
+		userPublicId, err := sharedtokens.ParseNotificationDigestUnsubscribeToken(token)
+		if err != nil {
+			exceptionwriter.SafelyAbortAndResponseWithJSON(exceptions.New(
+				"InvalidUnsubscribeToken",
+				"Notification",
+				"UnsubscribeMyNotificationDigest",
+				"The unsubscribe token is invalid or expired",
+				http.StatusUnauthorized,
+			).WithOrigin(err), ctx)
+			return
+		}
+
+		ctx.Set(sharedcontexts.ContextFieldName_User_PublicId.String(), userPublicId.String())
+		ctx.Next()
+	}
+}
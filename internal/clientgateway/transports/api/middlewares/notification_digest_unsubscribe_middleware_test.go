@@ -0,0 +1,54 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	sharedtokens "github.com/HiIamJeff67/notegic-backend/shared/tokens"
+
+	sharedcontexts "github.com/HiIamJeff67/notegic-backend/shared/lib/contexts"
+)
+
+func TestNotificationDigestUnsubscribeMiddlewareAuthenticatesTokenSubject(t *testing.T) {
+	t.Setenv("JWT_NOTIFICATION_DIGEST_UNSUBSCRIBE_TOKEN_SECRET_KEY", "test-unsubscribe-secret")
+	userPublicId := uuid.New()
+	token, err := sharedtokens.GenerateNotificationDigestUnsubscribeToken(userPublicId, time.Now())
+	if err != nil {
+		t.Fatalf("generate unsubscribe token: %v", err)
+	}
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/", NotificationDigestUnsubscribeMiddleware(), func(ctx *gin.Context) {
+		value, exists := ctx.Get(sharedcontexts.ContextFieldName_User_PublicId.String())
+		if !exists || value != userPublicId.String() {
+			t.Fatalf("unexpected user public ID context: %#v", value)
+		}
+		ctx.Status(http.StatusNoContent)
+	})
+
+	for _, test := range []struct {
+		name     string
+		query    string
+		wantCode int
+	}{
+		{name: "valid token", query: "?token=" + url.QueryEscape(*token), wantCode: http.StatusNoContent},
+		{name: "missing token", query: "", wantCode: http.StatusUnauthorized},
+		{name: "forged token", query: "?token=forged", wantCode: http.StatusUnauthorized},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodPost, "/"+test.query, nil)
+			response := httptest.NewRecorder()
+			router.ServeHTTP(response, request)
+			if response.Code != test.wantCode {
+				t.Fatalf("status = %d, want %d", response.Code, test.wantCode)
+			}
+		})
+	}
+}
@@ -34,7 +34,7 @@ func configureDevelopmentAPIKeyRoutes(
 		middlewares.TimeoutMiddleware(3 * time.Second),
 		middlewares.GatewayAuthenticationMiddleware(accessTokenCookieHandler, refreshTokenCookieHandler),
 		interceptors.ShareableResponseWriterInterceptor(
-			interceptors.RefreshTokenInterceptor(accessTokenCookieHandler),
+			interceptors.RefreshTokenInterceptor(accessTokenCookieHandler, refreshTokenCookieHandler),
 			interceptors.EmbeddedInterceptor,
 		),
 	}
@@ -15,10 +15,11 @@ import (
 )
 
 type AuthRouteDependencies struct {
-	CoreAdapter               *coreadapters.CoreAdapter
-	AccessTokenCookieHandler  *cookies.CookieHandler
-	RefreshTokenCookieHandler *cookies.CookieHandler
-	RateLimiters              RateLimiters
+	CoreAdapter                  *coreadapters.CoreAdapter
+	AccessTokenCookieHandler     *cookies.CookieHandler
+	RefreshTokenCookieHandler    *cookies.CookieHandler
+	OAuthStateTokenCookieHandler *cookies.CookieHandler
+	RateLimiters                 RateLimiters
 }
 
 func configureDevelopmentAuthRoutes(
@@ -35,6 +36,7 @@ func configureDevelopmentAuthRoutes(
 		coreAdapter,
 		accessTokenCookieHandler,
 		refreshTokenCookieHandler,
+		deps.OAuthStateTokenCookieHandler,
 	)
 
 	authRoutes := router.Group("/auth")
@@ -71,6 +73,70 @@ func configureDevelopmentAuthRoutes(
 			middlewares.TimeoutMiddleware(3*time.Second),
 			authBinder.BindLoginViaGoogle(authController.LoginViaGoogle),
 		)
+		authRoutes.POST(
+			"/login/two-factor",
+			middlewares.ApplyTracerMiddleware("verifyLoginTwoFactor"),
+			middlewares.ApplyMeterMiddleware("server.requests.auth.verifyLoginTwoFactor"),
+			middlewares.UnauthorizedRateLimitMiddleware(rateLimiters.Unauthorized),
+			middlewares.TimeoutMiddleware(3*time.Second),
+			authBinder.BindVerifyLoginTwoFactor(authController.VerifyLoginTwoFactor),
+		)
+		authRoutes.POST(
+			"/oauth/authorization-url",
+			middlewares.ApplyTracerMiddleware("getOAuthAuthorizationURL"),
+			middlewares.ApplyMeterMiddleware("server.requests.auth.getOAuthAuthorizationURL"),
+			middlewares.UnauthorizedRateLimitMiddleware(rateLimiters.Unauthorized),
+			middlewares.TimeoutMiddleware(3*time.Second),
+			authBinder.BindGetOAuthAuthorizationURL(authController.GetOAuthAuthorizationURL),
+		)
+		authRoutes.POST(
+			"/register-via-github",
+			middlewares.ApplyTracerMiddleware("registerViaGithub"),
+			middlewares.ApplyMeterMiddleware("server.requests.auth.registerViaGithub"),
+			middlewares.UnauthorizedRateLimitMiddleware(rateLimiters.Unauthorized),
+			middlewares.TimeoutMiddleware(5*time.Second),
+			authBinder.BindRegisterViaGithub(authController.RegisterViaGithub),
+		)
+		authRoutes.POST(
+			"/login-via-github",
+			middlewares.ApplyTracerMiddleware("loginViaGithub"),
+			middlewares.ApplyMeterMiddleware("server.requests.auth.loginViaGithub"),
+			middlewares.UnauthorizedRateLimitMiddleware(rateLimiters.Unauthorized),
+			middlewares.TimeoutMiddleware(3*time.Second),
+			authBinder.BindLoginViaGithub(authController.LoginViaGithub),
+		)
+		authRoutes.POST(
+			"/register-via-meta",
+			middlewares.ApplyTracerMiddleware("registerViaMeta"),
+			middlewares.ApplyMeterMiddleware("server.requests.auth.registerViaMeta"),
+			middlewares.UnauthorizedRateLimitMiddleware(rateLimiters.Unauthorized),
+			middlewares.TimeoutMiddleware(5*time.Second),
+			authBinder.BindRegisterViaMeta(authController.RegisterViaMeta),
+		)
+		authRoutes.POST(
+			"/login-via-meta",
+			middlewares.ApplyTracerMiddleware("loginViaMeta"),
+			middlewares.ApplyMeterMiddleware("server.requests.auth.loginViaMeta"),
+			middlewares.UnauthorizedRateLimitMiddleware(rateLimiters.Unauthorized),
+			middlewares.TimeoutMiddleware(3*time.Second),
+			authBinder.BindLoginViaMeta(authController.LoginViaMeta),
+		)
+		authRoutes.POST(
+			"/register-via-oidc",
+			middlewares.ApplyTracerMiddleware("registerViaOIDC"),
+			middlewares.ApplyMeterMiddleware("server.requests.auth.registerViaOIDC"),
+			middlewares.UnauthorizedRateLimitMiddleware(rateLimiters.Unauthorized),
+			middlewares.TimeoutMiddleware(5*time.Second),
+			authBinder.BindRegisterViaOIDC(authController.RegisterViaOIDC),
+		)
+		authRoutes.POST(
+			"/login-via-oidc",
+			middlewares.ApplyTracerMiddleware("loginViaOIDC"),
+			middlewares.ApplyMeterMiddleware("server.requests.auth.loginViaOIDC"),
+			middlewares.UnauthorizedRateLimitMiddleware(rateLimiters.Unauthorized),
+			middlewares.TimeoutMiddleware(3*time.Second),
+			authBinder.BindLoginViaOIDC(authController.LoginViaOIDC),
+		)
 		authRoutes.POST(
 			"/logout",
 			middlewares.ApplyTracerMiddleware("logout"),
@@ -99,7 +165,7 @@ func configureDevelopmentAuthRoutes(
 			middlewares.TimeoutMiddleware(3*time.Second),
 			middlewares.GatewayAuthenticationMiddleware(accessTokenCookieHandler, refreshTokenCookieHandler),
 			interceptors.ShareableResponseWriterInterceptor(
-				interceptors.RefreshTokenInterceptor(accessTokenCookieHandler),
+				interceptors.RefreshTokenInterceptor(accessTokenCookieHandler, refreshTokenCookieHandler),
 				interceptors.EmbeddedInterceptor,
 			),
 			authBinder.BindValidateEmail(authController.ValidateEmail),
@@ -112,7 +178,7 @@ func configureDevelopmentAuthRoutes(
 			middlewares.TimeoutMiddleware(3*time.Second),
 			middlewares.GatewayAuthenticationMiddleware(accessTokenCookieHandler, refreshTokenCookieHandler),
 			interceptors.ShareableResponseWriterInterceptor(
-				interceptors.RefreshTokenInterceptor(accessTokenCookieHandler),
+				interceptors.RefreshTokenInterceptor(accessTokenCookieHandler, refreshTokenCookieHandler),
 				interceptors.EmbeddedInterceptor,
 			),
 			authBinder.BindResetEmail(authController.ResetEmail),
@@ -133,11 +199,115 @@ func configureDevelopmentAuthRoutes(
 			middlewares.TimeoutMiddleware(3*time.Second),
 			middlewares.GatewayAuthenticationMiddleware(accessTokenCookieHandler, refreshTokenCookieHandler),
 			interceptors.ShareableResponseWriterInterceptor(
-				interceptors.RefreshTokenInterceptor(accessTokenCookieHandler),
+				interceptors.RefreshTokenInterceptor(accessTokenCookieHandler, refreshTokenCookieHandler),
 				interceptors.EmbeddedInterceptor,
 			),
 			authBinder.BindResetMe(authController.ResetMe),
 		)
+		authRoutes.GET(
+			"/sessions",
+			middlewares.ApplyTracerMiddleware("getMySessions"),
+			middlewares.ApplyMeterMiddleware("server.requests.auth.getMySessions"),
+			middlewares.UnauthorizedRateLimitMiddleware(rateLimiters.Unauthorized),
+			middlewares.TimeoutMiddleware(3*time.Second),
+			middlewares.GatewayAuthenticationMiddleware(accessTokenCookieHandler, refreshTokenCookieHandler),
+			interceptors.ShareableResponseWriterInterceptor(
+				interceptors.RefreshTokenInterceptor(accessTokenCookieHandler, refreshTokenCookieHandler),
+				interceptors.EmbeddedInterceptor,
+			),
+			authBinder.BindGetMySessions(authController.GetMySessions),
+		)
+		authRoutes.DELETE(
+			"/sessions/:session-id",
+			middlewares.ApplyTracerMiddleware("revokeMySession"),
+			middlewares.ApplyMeterMiddleware("server.requests.auth.revokeMySession"),
+			middlewares.UnauthorizedRateLimitMiddleware(rateLimiters.Unauthorized),
+			middlewares.TimeoutMiddleware(3*time.Second),
+			middlewares.GatewayAuthenticationMiddleware(accessTokenCookieHandler, refreshTokenCookieHandler),
+			interceptors.ShareableResponseWriterInterceptor(
+				interceptors.RefreshTokenInterceptor(accessTokenCookieHandler, refreshTokenCookieHandler),
+				interceptors.EmbeddedInterceptor,
+			),
+			authBinder.BindRevokeMySession(authController.RevokeMySession),
+		)
+		authRoutes.DELETE(
+			"/sessions",
+			middlewares.ApplyTracerMiddleware("revokeMyOtherSessions"),
+			middlewares.ApplyMeterMiddleware("server.requests.auth.revokeMyOtherSessions"),
+			middlewares.UnauthorizedRateLimitMiddleware(rateLimiters.Unauthorized),
+			middlewares.TimeoutMiddleware(3*time.Second),
+			middlewares.GatewayAuthenticationMiddleware(accessTokenCookieHandler, refreshTokenCookieHandler),
+			interceptors.ShareableResponseWriterInterceptor(
+				interceptors.RefreshTokenInterceptor(accessTokenCookieHandler, refreshTokenCookieHandler),
+				interceptors.EmbeddedInterceptor,
+			),
+			authBinder.BindRevokeMyOtherSessions(authController.RevokeMyOtherSessions),
+		)
+		authRoutes.GET(
+			"/two-factor",
+			middlewares.ApplyTracerMiddleware("getMyTwoFactor"),
+			middlewares.ApplyMeterMiddleware("server.requests.auth.getMyTwoFactor"),
+			middlewares.UnauthorizedRateLimitMiddleware(rateLimiters.Unauthorized),
+			middlewares.TimeoutMiddleware(3*time.Second),
+			middlewares.GatewayAuthenticationMiddleware(accessTokenCookieHandler, refreshTokenCookieHandler),
+			interceptors.ShareableResponseWriterInterceptor(
+				interceptors.RefreshTokenInterceptor(accessTokenCookieHandler, refreshTokenCookieHandler),
+				interceptors.EmbeddedInterceptor,
+			),
+			authBinder.BindGetMyTwoFactor(authController.GetMyTwoFactor),
+		)
+		authRoutes.POST(
+			"/two-factor/enroll",
+			middlewares.ApplyTracerMiddleware("enrollMyTwoFactor"),
+			middlewares.ApplyMeterMiddleware("server.requests.auth.enrollMyTwoFactor"),
+			middlewares.UnauthorizedRateLimitMiddleware(rateLimiters.Unauthorized),
+			middlewares.TimeoutMiddleware(3*time.Second),
+			middlewares.GatewayAuthenticationMiddleware(accessTokenCookieHandler, refreshTokenCookieHandler),
+			interceptors.ShareableResponseWriterInterceptor(
+				interceptors.RefreshTokenInterceptor(accessTokenCookieHandler, refreshTokenCookieHandler),
+				interceptors.EmbeddedInterceptor,
+			),
+			authBinder.BindEnrollMyTwoFactor(authController.EnrollMyTwoFactor),
+		)
+		authRoutes.POST(
+			"/two-factor/enable",
+			middlewares.ApplyTracerMiddleware("enableMyTwoFactor"),
+			middlewares.ApplyMeterMiddleware("server.requests.auth.enableMyTwoFactor"),
+			middlewares.UnauthorizedRateLimitMiddleware(rateLimiters.Unauthorized),
+			middlewares.TimeoutMiddleware(5*time.Second),
+			middlewares.GatewayAuthenticationMiddleware(accessTokenCookieHandler, refreshTokenCookieHandler),
+			interceptors.ShareableResponseWriterInterceptor(
+				interceptors.RefreshTokenInterceptor(accessTokenCookieHandler, refreshTokenCookieHandler),
+				interceptors.EmbeddedInterceptor,
+			),
+			authBinder.BindEnableMyTwoFactor(authController.EnableMyTwoFactor),
+		)
+		authRoutes.DELETE(
+			"/two-factor",
+			middlewares.ApplyTracerMiddleware("disableMyTwoFactor"),
+			middlewares.ApplyMeterMiddleware("server.requests.auth.disableMyTwoFactor"),
+			middlewares.UnauthorizedRateLimitMiddleware(rateLimiters.Unauthorized),
+			middlewares.TimeoutMiddleware(5*time.Second),
+			middlewares.GatewayAuthenticationMiddleware(accessTokenCookieHandler, refreshTokenCookieHandler),
+			interceptors.ShareableResponseWriterInterceptor(
+				interceptors.RefreshTokenInterceptor(accessTokenCookieHandler, refreshTokenCookieHandler),
+				interceptors.EmbeddedInterceptor,
+			),
+			authBinder.BindDisableMyTwoFactor(authController.DisableMyTwoFactor),
+		)
+		authRoutes.POST(
+			"/two-factor/recovery-codes",
+			middlewares.ApplyTracerMiddleware("regenerateMyTwoFactorRecoveryCodes"),
+			middlewares.ApplyMeterMiddleware("server.requests.auth.regenerateMyTwoFactorRecoveryCodes"),
+			middlewares.UnauthorizedRateLimitMiddleware(rateLimiters.Unauthorized),
+			middlewares.TimeoutMiddleware(5*time.Second),
+			middlewares.GatewayAuthenticationMiddleware(accessTokenCookieHandler, refreshTokenCookieHandler),
+			interceptors.ShareableResponseWriterInterceptor(
+				interceptors.RefreshTokenInterceptor(accessTokenCookieHandler, refreshTokenCookieHandler),
+				interceptors.EmbeddedInterceptor,
+			),
+			authBinder.BindRegenerateMyTwoFactorRecoveryCodes(authController.RegenerateMyTwoFactorRecoveryCodes),
+		)
 		authRoutes.DELETE(
 			"/delete-me",
 			middlewares.ApplyTracerMiddleware("deleteMe"),
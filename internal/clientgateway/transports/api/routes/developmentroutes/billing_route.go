@@ -0,0 +1,125 @@
+package developmentroutes
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	cookies "github.com/HiIamJeff67/notegic-backend/shared/cookies"
+
+	binders "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/api/binders"
+	controllers "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/api/controllers"
+	interceptors "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/api/interceptors"
+	middlewares "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/api/middlewares"
+	coreadapters "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/core/adapters"
+)
+
+type BillingRouteDependencies struct {
+	CoreAdapter               *coreadapters.CoreAdapter
+	AccessTokenCookieHandler  *cookies.CookieHandler
+	RefreshTokenCookieHandler *cookies.CookieHandler
+	RateLimiters              RateLimiters
+}
+
+func configureDevelopmentBillingRoutes(
+	router *gin.RouterGroup,
+	deps BillingRouteDependencies,
+) {
+	coreAdapter, accessTokenCookieHandler, refreshTokenCookieHandler, rateLimiters := deps.CoreAdapter, deps.AccessTokenCookieHandler, deps.RefreshTokenCookieHandler, deps.RateLimiters
+	binder := binders.NewBillingBinder()
+	controller := controllers.NewBillingController(coreAdapter)
+	billingRoutes := router.Group("/billing")
+	myBillingRoutes := router.Group("/me/billing")
+	defaultMiddlewares := []gin.HandlerFunc{
+		middlewares.UnauthorizedRateLimitMiddleware(rateLimiters.Unauthorized),
+		middlewares.TimeoutMiddleware(3 * time.Second),
+		middlewares.GatewayAuthenticationMiddleware(accessTokenCookieHandler, refreshTokenCookieHandler),
+		interceptors.ShareableResponseWriterInterceptor(
+			interceptors.RefreshTokenInterceptor(accessTokenCookieHandler, refreshTokenCookieHandler),
+			interceptors.EmbeddedInterceptor,
+		),
+	}
+	// the requests calling PayPal wait for its response, which takes longer than the other requests
+	providerMiddlewares := []gin.HandlerFunc{
+		middlewares.UnauthorizedRateLimitMiddleware(rateLimiters.Unauthorized),
+		middlewares.TimeoutMiddleware(15 * time.Second),
+		middlewares.GatewayAuthenticationMiddleware(accessTokenCookieHandler, refreshTokenCookieHandler),
+		interceptors.ShareableResponseWriterInterceptor(
+			interceptors.RefreshTokenInterceptor(accessTokenCookieHandler, refreshTokenCookieHandler),
+			interceptors.EmbeddedInterceptor,
+		),
+	}
+	{
+		billingRoutes.GET(
+			"/plans",
+			middlewares.ApplyTracerMiddleware("getBillingPlans"),
+			middlewares.ApplyMeterMiddleware("server.requests.billing.getBillingPlans"),
+			middlewares.UnauthorizedRateLimitMiddleware(rateLimiters.Unauthorized),
+			middlewares.TimeoutMiddleware(3*time.Second),
+			binder.BindGetBillingPlans(controller.GetBillingPlans),
+		)
+		billingRoutes.POST(
+			"/paypal/webhook",
+			middlewares.ApplyTracerMiddleware("handlePayPalWebhook"),
+			middlewares.ApplyMeterMiddleware("server.requests.billing.handlePayPalWebhook"),
+			middlewares.UnauthorizedRateLimitMiddleware(rateLimiters.Unauthorized),
+			middlewares.TimeoutMiddleware(15*time.Second),
+			binder.BindHandlePayPalWebhook(controller.HandlePayPalWebhook),
+		)
+		myBillingRoutes.GET(
+			"/subscription",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("getMySubscription"),
+					middlewares.ApplyMeterMiddleware("server.requests.billing.getMySubscription"),
+				},
+				defaultMiddlewares,
+				binder.BindGetMySubscription(controller.GetMySubscription),
+			)...,
+		)
+		myBillingRoutes.POST(
+			"/subscription",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("createMySubscription"),
+					middlewares.ApplyMeterMiddleware("server.requests.billing.createMySubscription"),
+				},
+				providerMiddlewares,
+				binder.BindCreateMySubscription(controller.CreateMySubscription),
+			)...,
+		)
+		myBillingRoutes.PUT(
+			"/subscription/plan",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("changeMySubscriptionPlan"),
+					middlewares.ApplyMeterMiddleware("server.requests.billing.changeMySubscriptionPlan"),
+				},
+				providerMiddlewares,
+				binder.BindChangeMySubscriptionPlan(controller.ChangeMySubscriptionPlan),
+			)...,
+		)
+		myBillingRoutes.DELETE(
+			"/subscription",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("cancelMySubscription"),
+					middlewares.ApplyMeterMiddleware("server.requests.billing.cancelMySubscription"),
+				},
+				providerMiddlewares,
+				binder.BindCancelMySubscription(controller.CancelMySubscription),
+			)...,
+		)
+		myBillingRoutes.GET(
+			"/invoices",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("getMyBillingInvoices"),
+					middlewares.ApplyMeterMiddleware("server.requests.billing.getMyBillingInvoices"),
+				},
+				defaultMiddlewares,
+				binder.BindGetMyBillingInvoices(controller.GetMyBillingInvoices),
+			)...,
+		)
+	}
+}
@@ -41,7 +41,7 @@ func configureDevelopmentBlockPackRoutes(
 		middlewares.TimeoutMiddleware(3 * time.Second),
 		middlewares.GatewayAuthenticationMiddleware(accessTokenCookieHandler, refreshTokenCookieHandler),
 		interceptors.ShareableResponseWriterInterceptor(
-			interceptors.RefreshTokenInterceptor(accessTokenCookieHandler),
+			interceptors.RefreshTokenInterceptor(accessTokenCookieHandler, refreshTokenCookieHandler),
 			interceptors.EmbeddedInterceptor,
 		),
 	}
@@ -94,6 +94,18 @@ func configureDevelopmentBlockPackRoutes(
 				blockPackBinder.BindGetAllMyBlockPacksByRootShelfId(blockPackController.GetAllMyBlockPacksByRootShelfId),
 			)...,
 		)
+		blockPackRoutes.GET(
+			"/:block-pack-id/markdown",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("getMyBlockPackMarkdownById"),
+					middlewares.ApplyMeterMiddleware("server.requests.blockPack.getMyBlockPackMarkdownById"),
+				},
+				defaultMiddlewares,
+				middlewares.AllowedPermissionsAbove(enumcontract.AccessControlPermission_Read),
+				blockPackBinder.BindGetMyBlockPackMarkdownById(blockPackController.GetMyBlockPackMarkdownById),
+			)...,
+		)
 		blockPackRoutes.POST(
 			"/sub-shelf/:parent-sub-shelf-id",
 			middlewares.Reposition(
@@ -122,6 +134,20 @@ func configureDevelopmentBlockPackRoutes(
 				blockPackBinder.BindCreateBlockPacks(blockPackController.CreateBlockPacks),
 			)...,
 		)
+		blockPackRoutes.POST(
+			"/sub-shelf/:parent-sub-shelf-id/markdown",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("createBlockPackFromMarkdown"),
+					middlewares.ApplyMeterMiddleware("server.requests.blockPack.createBlockPackFromMarkdown"),
+				},
+				append(
+					defaultMiddlewares,
+					middlewares.AllowedPermissionsAbove(enumcontract.AccessControlPermission_Write),
+				),
+				blockPackBinder.BindCreateBlockPackFromMarkdown(blockPackController.CreateBlockPackFromMarkdown),
+			)...,
+		)
 		blockPackRoutes.PUT(
 			"/:block-pack-id",
 			middlewares.Reposition(
@@ -248,5 +274,57 @@ func configureDevelopmentBlockPackRoutes(
 				blockPackBinder.BindDeleteMyBlockPacksByIds(blockPackController.DeleteMyBlockPacksByIds),
 			)...,
 		)
+		blockPackRoutes.GET(
+			"/:block-pack-id/snapshots",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("getMyBlockPackSnapshotsById"),
+					middlewares.ApplyMeterMiddleware("server.requests.blockPack.getMyBlockPackSnapshotsById"),
+				},
+				defaultMiddlewares,
+				middlewares.AllowedPermissionsAbove(enumcontract.AccessControlPermission_Read),
+				blockPackBinder.BindGetMyBlockPackSnapshotsById(blockPackController.GetMyBlockPackSnapshotsById),
+			)...,
+		)
+		blockPackRoutes.GET(
+			"/:block-pack-id/snapshots/diff",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("getMyBlockPackSnapshotDiffById"),
+					middlewares.ApplyMeterMiddleware("server.requests.blockPack.getMyBlockPackSnapshotDiffById"),
+				},
+				defaultMiddlewares,
+				middlewares.AllowedPermissionsAbove(enumcontract.AccessControlPermission_Read),
+				blockPackBinder.BindGetMyBlockPackSnapshotDiffById(blockPackController.GetMyBlockPackSnapshotDiffById),
+			)...,
+		)
+		blockPackRoutes.POST(
+			"/:block-pack-id/snapshots",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("createMyBlockPackSnapshotById"),
+					middlewares.ApplyMeterMiddleware("server.requests.blockPack.createMyBlockPackSnapshotById"),
+				},
+				append(
+					defaultMiddlewares,
+					middlewares.AllowedPermissionsAbove(enumcontract.AccessControlPermission_Write),
+				),
+				blockPackBinder.BindCreateMyBlockPackSnapshotById(blockPackController.CreateMyBlockPackSnapshotById),
+			)...,
+		)
+		blockPackRoutes.PATCH(
+			"/:block-pack-id/snapshots/:snapshot-id/restore",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("restoreMyBlockPackSnapshotById"),
+					middlewares.ApplyMeterMiddleware("server.requests.blockPack.restoreMyBlockPackSnapshotById"),
+				},
+				append(
+					defaultMiddlewares,
+					middlewares.AllowedPermissionsAbove(enumcontract.AccessControlPermission_Write),
+				),
+				blockPackBinder.BindRestoreMyBlockPackSnapshotById(blockPackController.RestoreMyBlockPackSnapshotById),
+			)...,
+		)
 	}
 }
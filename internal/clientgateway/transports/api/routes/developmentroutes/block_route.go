@@ -40,7 +40,7 @@ func configureDevelopmentBlockRoutes(
 		middlewares.TimeoutMiddleware(3 * time.Second),
 		middlewares.GatewayAuthenticationMiddleware(accessTokenCookieHandler, refreshTokenCookieHandler),
 		interceptors.ShareableResponseWriterInterceptor(
-			interceptors.RefreshTokenInterceptor(accessTokenCookieHandler),
+			interceptors.RefreshTokenInterceptor(accessTokenCookieHandler, refreshTokenCookieHandler),
 			interceptors.EmbeddedInterceptor,
 		),
 	}
@@ -9,6 +9,7 @@ import (
 
 	enumcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
 
+	realtimeevent "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/data/cache/realtimeevent"
 	graphql "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/api/graphql"
 	interceptors "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/api/interceptors"
 	middlewares "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/api/middlewares"
@@ -17,6 +18,7 @@ import (
 
 type GraphQLRouteDependencies struct {
 	CoreAdapter               *coreadapters.CoreAdapter
+	RealtimeEventCacheClient  *realtimeevent.RealtimeEventCacheClient
 	AccessTokenCookieHandler  *cookies.CookieHandler
 	RefreshTokenCookieHandler *cookies.CookieHandler
 	RateLimiters              RateLimiters
@@ -26,7 +28,8 @@ func configureDevelopmentGraphQLRoutes(
 	router *gin.RouterGroup,
 	deps GraphQLRouteDependencies,
 ) {
-	coreAdapter, accessTokenCookieHandler, refreshTokenCookieHandler, rateLimiters := deps.CoreAdapter, deps.AccessTokenCookieHandler, deps.RefreshTokenCookieHandler, deps.RateLimiters
+	coreAdapter, realtimeEventCacheClient := deps.CoreAdapter, deps.RealtimeEventCacheClient
+	accessTokenCookieHandler, refreshTokenCookieHandler, rateLimiters := deps.AccessTokenCookieHandler, deps.RefreshTokenCookieHandler, deps.RateLimiters
 	if router == nil {
 		router = DevelopmentAPIRouterGroup
 	}
@@ -39,14 +42,26 @@ func configureDevelopmentGraphQLRoutes(
 		middlewares.GatewayAuthenticationMiddleware(accessTokenCookieHandler, refreshTokenCookieHandler),
 		middlewares.AllowedPermissionsAbove(enumcontract.AccessControlPermission_Read),
 		interceptors.ShareableResponseWriterInterceptor(
-			interceptors.RefreshTokenInterceptor(accessTokenCookieHandler),
+			interceptors.RefreshTokenInterceptor(accessTokenCookieHandler, refreshTokenCookieHandler),
 			interceptors.EmbeddedInterceptor,
 		),
 	)
 	{
-		graphqlRoutes.POST("/", graphql.GraphQLHandler(coreAdapter))
+		graphqlRoutes.POST("/", graphql.GraphQLHandler(coreAdapter, realtimeEventCacheClient))
 		if gin.Mode() == gin.DebugMode {
 			graphqlRoutes.GET("/", graphql.PlaygroundHandler())
 		}
 	}
+
+	// the graphql-ws connection is long-lived and hijacked, so it skips the timeout middleware and the interceptors
+	subscriptionRoutes := router.Group("/graphql/subscriptions")
+
+	subscriptionRoutes.Use(
+		middlewares.UnauthorizedRateLimitMiddleware(rateLimiters.Unauthorized),
+		middlewares.GatewayAuthenticationMiddleware(accessTokenCookieHandler, refreshTokenCookieHandler),
+		middlewares.AllowedPermissionsAbove(enumcontract.AccessControlPermission_Read),
+	)
+	{
+		subscriptionRoutes.GET("/", graphql.SubscriptionHandler(coreAdapter, realtimeEventCacheClient))
+	}
 }
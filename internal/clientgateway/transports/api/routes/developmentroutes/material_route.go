@@ -5,7 +5,9 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	constants "github.com/HiIamJeff67/notegic-backend/shared/constants"
 	cookies "github.com/HiIamJeff67/notegic-backend/shared/cookies"
+	types "github.com/HiIamJeff67/notegic-backend/shared/types"
 
 	enumcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
 
@@ -41,7 +43,7 @@ func configureDevelopmentMaterialRoutes(
 		middlewares.TimeoutMiddleware(3 * time.Second),
 		middlewares.GatewayAuthenticationMiddleware(accessTokenCookieHandler, refreshTokenCookieHandler),
 		interceptors.ShareableResponseWriterInterceptor(
-			interceptors.RefreshTokenInterceptor(accessTokenCookieHandler),
+			interceptors.RefreshTokenInterceptor(accessTokenCookieHandler, refreshTokenCookieHandler),
 			interceptors.EmbeddedInterceptor,
 		),
 	}
@@ -229,5 +231,62 @@ func configureDevelopmentMaterialRoutes(
 				materialBinder.BindDeleteMyMaterialsByIds(materialController.DeleteMyMaterialsByIds),
 			)...,
 		)
+		materialRoutes.POST(
+			"/:material-id/uploads",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("initiateMyMaterialUpload"),
+					middlewares.ApplyMeterMiddleware("server.requests.material.initiateMyMaterialUpload"),
+				},
+				append(
+					defaultMiddlewares,
+					middlewares.AllowedPermissionsAbove(enumcontract.AccessControlPermission_Write),
+				),
+				materialBinder.BindInitiateMyMaterialUpload(materialController.InitiateMyMaterialUpload),
+			)...,
+		)
+		materialRoutes.PUT(
+			"/uploads/:storage-upload-id/parts/:part-number",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("uploadMyMaterialPart"),
+					middlewares.ApplyMeterMiddleware("server.requests.material.uploadMyMaterialPart"),
+					middlewares.MaxContextSizeMiddleware(constants.MaterialUploadPartSize.ToInt64(), types.Byte),
+				},
+				append(
+					defaultMiddlewares,
+					middlewares.AllowedPermissionsAbove(enumcontract.AccessControlPermission_Write),
+				),
+				materialBinder.BindUploadMyMaterialPart(materialController.UploadMyMaterialPart),
+			)...,
+		)
+		materialRoutes.POST(
+			"/:material-id/uploads/:upload-id/complete",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("completeMyMaterialUpload"),
+					middlewares.ApplyMeterMiddleware("server.requests.material.completeMyMaterialUpload"),
+				},
+				append(
+					defaultMiddlewares,
+					middlewares.AllowedPermissionsAbove(enumcontract.AccessControlPermission_Write),
+				),
+				materialBinder.BindCompleteMyMaterialUpload(materialController.CompleteMyMaterialUpload),
+			)...,
+		)
+		materialRoutes.DELETE(
+			"/:material-id/uploads/:upload-id",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("abortMyMaterialUpload"),
+					middlewares.ApplyMeterMiddleware("server.requests.material.abortMyMaterialUpload"),
+				},
+				append(
+					defaultMiddlewares,
+					middlewares.AllowedPermissionsAbove(enumcontract.AccessControlPermission_Write),
+				),
+				materialBinder.BindAbortMyMaterialUpload(materialController.AbortMyMaterialUpload),
+			)...,
+		)
 	}
 }
@@ -38,7 +38,7 @@ func configureDevelopmentNotificationRoutes(
 		middlewares.TimeoutMiddleware(1 * time.Second),
 		middlewares.GatewayAuthenticationMiddleware(accessTokenCookieHandler, refreshTokenCookieHandler),
 		interceptors.ShareableResponseWriterInterceptor(
-			interceptors.RefreshTokenInterceptor(accessTokenCookieHandler),
+			interceptors.RefreshTokenInterceptor(accessTokenCookieHandler, refreshTokenCookieHandler),
 			interceptors.EmbeddedInterceptor,
 		),
 	}
@@ -87,5 +87,92 @@ func configureDevelopmentNotificationRoutes(
 				notificationBinder.BindDelete(notificationController.Delete),
 			)...,
 		)
+		notificationRoutes.GET(
+			"/preferences",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("getMyNotificationPreferences"),
+					middlewares.ApplyMeterMiddleware("server.requests.notifications.preferences.get"),
+				},
+				defaultMiddlewares,
+				notificationBinder.BindGetPreferences(notificationController.GetPreferences),
+			)...,
+		)
+		notificationRoutes.PUT(
+			"/preferences",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("updateMyNotificationPreferences"),
+					middlewares.ApplyMeterMiddleware("server.requests.notifications.preferences.update"),
+				},
+				defaultMiddlewares,
+				notificationBinder.BindUpdatePreferences(notificationController.UpdatePreferences),
+			)...,
+		)
+		notificationRoutes.GET(
+			"/push/public-key",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("getMyNotificationPushPublicKey"),
+					middlewares.ApplyMeterMiddleware("server.requests.notifications.push.publicKey"),
+				},
+				defaultMiddlewares,
+				notificationBinder.BindGetPushPublicKey(notificationController.GetPushPublicKey),
+			)...,
+		)
+		notificationRoutes.POST(
+			"/push/subscriptions",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("registerMyNotificationPushSubscription"),
+					middlewares.ApplyMeterMiddleware("server.requests.notifications.push.subscriptions.register"),
+				},
+				defaultMiddlewares,
+				notificationBinder.BindRegisterPushSubscription(notificationController.RegisterPushSubscription),
+			)...,
+		)
+		notificationRoutes.DELETE(
+			"/push/subscriptions",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("unregisterMyNotificationPushSubscription"),
+					middlewares.ApplyMeterMiddleware("server.requests.notifications.push.subscriptions.unregister"),
+				},
+				defaultMiddlewares,
+				notificationBinder.BindUnregisterPushSubscription(notificationController.UnregisterPushSubscription),
+			)...,
+		)
+		// the unsubscribe link of a digest email is opened and posted without any session, so it is authenticated by
+		// its unsubscribe token instead of the cookies, opening it only shows a confirmation page which posts back to it
+		notificationRoutes.GET(
+			"/digest/unsubscribe",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("confirmUnsubscribeMyNotificationDigest"),
+					middlewares.ApplyMeterMiddleware("server.requests.notifications.digest.unsubscribe.confirm"),
+				},
+				[]gin.HandlerFunc{
+					middlewares.UnauthorizedRateLimitMiddleware(rateLimiters.Unauthorized),
+					middlewares.TimeoutMiddleware(1 * time.Second),
+					middlewares.NotificationDigestUnsubscribeMiddleware(),
+				},
+				notificationBinder.BindConfirmUnsubscribeDigest(notificationController.ConfirmUnsubscribeDigest),
+			)...,
+		)
+		notificationRoutes.POST(
+			"/digest/unsubscribe",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("unsubscribeMyNotificationDigest"),
+					middlewares.ApplyMeterMiddleware("server.requests.notifications.digest.unsubscribe"),
+				},
+				[]gin.HandlerFunc{
+					middlewares.UnauthorizedRateLimitMiddleware(rateLimiters.Unauthorized),
+					middlewares.TimeoutMiddleware(1 * time.Second),
+					middlewares.NotificationDigestUnsubscribeMiddleware(),
+				},
+				notificationBinder.BindUnsubscribeDigest(notificationController.UnsubscribeDigest),
+			)...,
+		)
 	}
 }
@@ -41,7 +41,7 @@ func configureDevelopmentRootShelfRoutes(
 		middlewares.TimeoutMiddleware(1 * time.Second),
 		middlewares.GatewayAuthenticationMiddleware(accessTokenCookieHandler, refreshTokenCookieHandler),
 		interceptors.ShareableResponseWriterInterceptor(
-			interceptors.RefreshTokenInterceptor(accessTokenCookieHandler),
+			interceptors.RefreshTokenInterceptor(accessTokenCookieHandler, refreshTokenCookieHandler),
 			interceptors.EmbeddedInterceptor,
 		),
 	}
@@ -312,5 +312,48 @@ func configureDevelopmentRootShelfRoutes(
 				rootShelfBinder.BindLeaveMyRootShelves(rootShelfController.LeaveMyRootShelves),
 			)...,
 		)
+		rootShelfRoutes.POST(
+			"/:root-shelf-id/archive",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("exportMyRootShelfArchiveById"),
+					middlewares.ApplyMeterMiddleware("server.requests.rootShelf.exportMyRootShelfArchiveById"),
+				},
+				append(
+					defaultMiddlewares,
+					middlewares.AllowedPermissionsAbove(enumcontract.AccessControlPermission_Read),
+				),
+				rootShelfBinder.BindExportMyRootShelfArchiveById(rootShelfController.ExportMyRootShelfArchiveById),
+			)...,
+		)
+		rootShelfRoutes.POST(
+			"/archive",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("createRootShelfFromArchive"),
+					middlewares.ApplyMeterMiddleware("server.requests.rootShelf.createRootShelfFromArchive"),
+					middlewares.MultipartMiddleware(),
+				},
+				append(
+					defaultMiddlewares,
+					middlewares.AllowedPermissionsAbove(enumcontract.AccessControlPermission_Write),
+				),
+				rootShelfBinder.BindCreateRootShelfFromArchive(rootShelfController.CreateRootShelfFromArchive),
+			)...,
+		)
+		rootShelfRoutes.GET(
+			"/archive-jobs/:archive-job-id",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("getMyRootShelfArchiveJobById"),
+					middlewares.ApplyMeterMiddleware("server.requests.rootShelf.getMyRootShelfArchiveJobById"),
+				},
+				append(
+					defaultMiddlewares,
+					middlewares.AllowedPermissionsAbove(enumcontract.AccessControlPermission_Read),
+				),
+				rootShelfBinder.BindGetMyRootShelfArchiveJobById(rootShelfController.GetMyRootShelfArchiveJobById),
+			)...,
+		)
 	}
 }
@@ -10,6 +10,7 @@ import (
 
 	gatewaycontract "github.com/HiIamJeff67/notegic-backend/contracts/gateway/v1"
 
+	realtimeevent "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/data/cache/realtimeevent"
 	ratelimit "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/ratelimit"
 	middlewares "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/api/middlewares"
 	coreadapters "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/core/adapters"
@@ -27,17 +28,20 @@ type RateLimiters struct {
 }
 
 type APIRouteDependencies struct {
-	CoreAdapter               *coreadapters.CoreAdapter
-	NotificationClient        *notificationadapters.NotificationAdapter
-	AllowedDomains            []string
-	AccessTokenCookieHandler  *cookies.CookieHandler
-	RefreshTokenCookieHandler *cookies.CookieHandler
-	RateLimiters              RateLimiters
+	CoreAdapter                    *coreadapters.CoreAdapter
+	RealtimeEventCacheClient       *realtimeevent.RealtimeEventCacheClient
+	NotificationClient             *notificationadapters.NotificationAdapter
+	AllowedDomains                 []string
+	AccessTokenCookieHandler       *cookies.CookieHandler
+	RefreshTokenCookieHandler      *cookies.CookieHandler
+	ShareSessionTokenCookieHandler *cookies.CookieHandler
+	OAuthStateTokenCookieHandler   *cookies.CookieHandler
+	RateLimiters                   RateLimiters
 }
 
 func NewRouter(deps APIRouteDependencies) *gin.Engine {
 	DevelopmentRouter = logs.WithGinLogger(gin.New())
-	coreAdapter, notificationClient := deps.CoreAdapter, deps.NotificationClient
+	coreAdapter, notificationClient, realtimeEventCacheClient := deps.CoreAdapter, deps.NotificationClient, deps.RealtimeEventCacheClient
 	allowedDomains, accessTokenCookieHandler := deps.AllowedDomains, deps.AccessTokenCookieHandler
 	refreshTokenCookieHandler, rateLimiters := deps.RefreshTokenCookieHandler, deps.RateLimiters
 	DevelopmentAPIRouterGroup = DevelopmentRouter.Group("/" + gatewaycontract.APIDevelopmentBaseURL) // use in development mode
@@ -49,11 +53,11 @@ func NewRouter(deps APIRouteDependencies) *gin.Engine {
 	DevelopmentAPIRouterGroup.OPTIONS("/*path", func(ctx *gin.Context) { ctx.Status(200) })
 	fmt.Println("API router group path:", DevelopmentAPIRouterGroup.BasePath())
 
-	configureDevelopmentAuthRoutes(DevelopmentAPIRouterGroup, AuthRouteDependencies{CoreAdapter: coreAdapter, AccessTokenCookieHandler: accessTokenCookieHandler, RefreshTokenCookieHandler: refreshTokenCookieHandler, RateLimiters: rateLimiters})
+	configureDevelopmentAuthRoutes(DevelopmentAPIRouterGroup, AuthRouteDependencies{CoreAdapter: coreAdapter, AccessTokenCookieHandler: accessTokenCookieHandler, RefreshTokenCookieHandler: refreshTokenCookieHandler, OAuthStateTokenCookieHandler: deps.OAuthStateTokenCookieHandler, RateLimiters: rateLimiters})
 	configureDevelopmentUserRoutes(DevelopmentAPIRouterGroup, UserRouteDependencies{CoreAdapter: coreAdapter, AccessTokenCookieHandler: accessTokenCookieHandler, RefreshTokenCookieHandler: refreshTokenCookieHandler, RateLimiters: rateLimiters})
 	configureDevelopmentUserInfoRoutes(DevelopmentAPIRouterGroup, UserInfoRouteDependencies{CoreAdapter: coreAdapter, AccessTokenCookieHandler: accessTokenCookieHandler, RefreshTokenCookieHandler: refreshTokenCookieHandler, RateLimiters: rateLimiters})
 	configureUserSettingRoutes(DevelopmentAPIRouterGroup, UserSettingRouteDependencies{CoreAdapter: coreAdapter, AccessTokenCookieHandler: accessTokenCookieHandler, RefreshTokenCookieHandler: refreshTokenCookieHandler, RateLimiters: rateLimiters})
-	configureDevelopmentUserAccountRoutes(DevelopmentAPIRouterGroup, UserAccountRouteDependencies{CoreAdapter: coreAdapter, AccessTokenCookieHandler: accessTokenCookieHandler, RefreshTokenCookieHandler: refreshTokenCookieHandler, RateLimiters: rateLimiters})
+	configureDevelopmentUserAccountRoutes(DevelopmentAPIRouterGroup, UserAccountRouteDependencies{CoreAdapter: coreAdapter, AccessTokenCookieHandler: accessTokenCookieHandler, RefreshTokenCookieHandler: refreshTokenCookieHandler, OAuthStateTokenCookieHandler: deps.OAuthStateTokenCookieHandler, RateLimiters: rateLimiters})
 	configureDevelopmentAPIKeyRoutes(DevelopmentAPIRouterGroup, APIKeyRouteDependencies{CoreAdapter: coreAdapter, AccessTokenCookieHandler: accessTokenCookieHandler, RefreshTokenCookieHandler: refreshTokenCookieHandler, RateLimiters: rateLimiters})
 
 	configureDevelopmentStationRoutes(DevelopmentAPIRouterGroup, StationRouteDependencies{CoreAdapter: coreAdapter, AccessTokenCookieHandler: accessTokenCookieHandler, RefreshTokenCookieHandler: refreshTokenCookieHandler, RateLimiters: rateLimiters})
@@ -65,10 +69,12 @@ func NewRouter(deps APIRouteDependencies) *gin.Engine {
 	configureDevelopmentMaterialRoutes(DevelopmentAPIRouterGroup, MaterialRouteDependencies{CoreAdapter: coreAdapter, AccessTokenCookieHandler: accessTokenCookieHandler, RefreshTokenCookieHandler: refreshTokenCookieHandler, RateLimiters: rateLimiters})
 	configureDevelopmentBlockPackRoutes(DevelopmentAPIRouterGroup, BlockPackRouteDependencies{CoreAdapter: coreAdapter, AccessTokenCookieHandler: accessTokenCookieHandler, RefreshTokenCookieHandler: refreshTokenCookieHandler, RateLimiters: rateLimiters})
 	configureDevelopmentBlockRoutes(DevelopmentAPIRouterGroup, BlockRouteDependencies{CoreAdapter: coreAdapter, AccessTokenCookieHandler: accessTokenCookieHandler, RefreshTokenCookieHandler: refreshTokenCookieHandler, RateLimiters: rateLimiters})
+	configureDevelopmentShareLinkRoutes(DevelopmentAPIRouterGroup, ShareLinkRouteDependencies{CoreAdapter: coreAdapter, AccessTokenCookieHandler: accessTokenCookieHandler, RefreshTokenCookieHandler: refreshTokenCookieHandler, ShareSessionTokenCookieHandler: deps.ShareSessionTokenCookieHandler, RateLimiters: rateLimiters})
+	configureDevelopmentBillingRoutes(DevelopmentAPIRouterGroup, BillingRouteDependencies{CoreAdapter: coreAdapter, AccessTokenCookieHandler: accessTokenCookieHandler, RefreshTokenCookieHandler: refreshTokenCookieHandler, RateLimiters: rateLimiters})
 
 	configureDevelopmentRoutineTaskRecordRoutes(DevelopmentAPIRouterGroup, RoutineTaskRecordRouteDependencies{CoreAdapter: coreAdapter, AccessTokenCookieHandler: accessTokenCookieHandler, RefreshTokenCookieHandler: refreshTokenCookieHandler, RateLimiters: rateLimiters})
 	configureDevelopmentRealtimeRoutes(DevelopmentAPIRouterGroup, RealtimeRouteDependencies{CoreAdapter: coreAdapter, AccessTokenCookieHandler: accessTokenCookieHandler, RefreshTokenCookieHandler: refreshTokenCookieHandler, RateLimiters: rateLimiters})
-	configureDevelopmentGraphQLRoutes(DevelopmentAPIRouterGroup, GraphQLRouteDependencies{CoreAdapter: coreAdapter, AccessTokenCookieHandler: accessTokenCookieHandler, RefreshTokenCookieHandler: refreshTokenCookieHandler, RateLimiters: rateLimiters})
+	configureDevelopmentGraphQLRoutes(DevelopmentAPIRouterGroup, GraphQLRouteDependencies{CoreAdapter: coreAdapter, RealtimeEventCacheClient: realtimeEventCacheClient, AccessTokenCookieHandler: accessTokenCookieHandler, RefreshTokenCookieHandler: refreshTokenCookieHandler, RateLimiters: rateLimiters})
 	configureDevelopmentNotificationRoutes(DevelopmentAPIRouterGroup, NotificationRouteDependencies{NotificationClient: notificationClient, AccessTokenCookieHandler: accessTokenCookieHandler, RefreshTokenCookieHandler: refreshTokenCookieHandler, RateLimiters: rateLimiters})
 
 	configureStaticRoutes(DevelopmentAPIRouterGroup, StaticRouteDependencies{RateLimiters: rateLimiters})
@@ -0,0 +1,96 @@
+package developmentroutes
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	enumcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
+	cookies "github.com/HiIamJeff67/notegic-backend/shared/cookies"
+
+	binders "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/api/binders"
+	controllers "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/api/controllers"
+	interceptors "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/api/interceptors"
+	middlewares "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/api/middlewares"
+	coreadapters "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/core/adapters"
+)
+
+type ShareLinkRouteDependencies struct {
+	CoreAdapter                    *coreadapters.CoreAdapter
+	AccessTokenCookieHandler       *cookies.CookieHandler
+	RefreshTokenCookieHandler      *cookies.CookieHandler
+	ShareSessionTokenCookieHandler *cookies.CookieHandler
+	RateLimiters                   RateLimiters
+}
+
+func configureDevelopmentShareLinkRoutes(
+	router *gin.RouterGroup,
+	deps ShareLinkRouteDependencies,
+) {
+	coreAdapter, accessTokenCookieHandler, refreshTokenCookieHandler, rateLimiters := deps.CoreAdapter, deps.AccessTokenCookieHandler, deps.RefreshTokenCookieHandler, deps.RateLimiters
+	binder := binders.NewShareLinkBinder()
+	controller := controllers.NewShareLinkController(coreAdapter, deps.ShareSessionTokenCookieHandler)
+	rootShelfRoutes := router.Group("/root-shelves")
+	shareLinkRoutes := router.Group("/share-links")
+	defaultMiddlewares := []gin.HandlerFunc{
+		middlewares.UnauthorizedRateLimitMiddleware(rateLimiters.Unauthorized),
+		middlewares.TimeoutMiddleware(3 * time.Second),
+		middlewares.GatewayAuthenticationMiddleware(accessTokenCookieHandler, refreshTokenCookieHandler),
+		interceptors.ShareableResponseWriterInterceptor(
+			interceptors.RefreshTokenInterceptor(accessTokenCookieHandler, refreshTokenCookieHandler),
+			interceptors.EmbeddedInterceptor,
+		),
+	}
+	{
+		rootShelfRoutes.POST(
+			"/:root-shelf-id/share-links",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("createMyShareLink"),
+					middlewares.ApplyMeterMiddleware("server.requests.shareLink.create"),
+				},
+				append(
+					defaultMiddlewares,
+					middlewares.AllowedPermissionsAbove(enumcontract.AccessControlPermission_Admin),
+				),
+				binder.BindCreateMyShareLink(controller.CreateMyShareLink),
+			)...,
+		)
+		rootShelfRoutes.GET(
+			"/:root-shelf-id/share-links",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("getMyShareLinksByRootShelfId"),
+					middlewares.ApplyMeterMiddleware("server.requests.shareLink.getMyShareLinksByRootShelfId"),
+				},
+				append(
+					defaultMiddlewares,
+					middlewares.AllowedPermissionsAbove(enumcontract.AccessControlPermission_Admin),
+				),
+				binder.BindGetMyShareLinksByRootShelfId(controller.GetMyShareLinksByRootShelfId),
+			)...,
+		)
+		rootShelfRoutes.DELETE(
+			"/:root-shelf-id/share-links/:share-link-id",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("revokeMyShareLinkById"),
+					middlewares.ApplyMeterMiddleware("server.requests.shareLink.revoke"),
+				},
+				append(
+					defaultMiddlewares,
+					middlewares.AllowedPermissionsAbove(enumcontract.AccessControlPermission_Admin),
+				),
+				binder.BindRevokeMyShareLinkById(controller.RevokeMyShareLinkById),
+			)...,
+		)
+		shareLinkRoutes.POST(
+			"/resolve",
+			middlewares.ApplyTracerMiddleware("resolveShareLink"),
+			middlewares.ApplyMeterMiddleware("server.requests.shareLink.resolve"),
+			middlewares.UnauthorizedRateLimitMiddleware(rateLimiters.Unauthorized),
+			middlewares.TimeoutMiddleware(3*time.Second),
+			binder.BindResolveShareLink(controller.ResolveShareLink),
+		)
+	}
+}
@@ -15,10 +15,11 @@ import (
 )
 
 type UserAccountRouteDependencies struct {
-	CoreAdapter               *coreadapters.CoreAdapter
-	AccessTokenCookieHandler  *cookies.CookieHandler
-	RefreshTokenCookieHandler *cookies.CookieHandler
-	RateLimiters              RateLimiters
+	CoreAdapter                  *coreadapters.CoreAdapter
+	AccessTokenCookieHandler     *cookies.CookieHandler
+	RefreshTokenCookieHandler    *cookies.CookieHandler
+	OAuthStateTokenCookieHandler *cookies.CookieHandler
+	RateLimiters                 RateLimiters
 }
 
 func configureDevelopmentUserAccountRoutes(
@@ -31,7 +32,7 @@ func configureDevelopmentUserAccountRoutes(
 	}
 
 	userAccountBinder := binders.NewUserAccountBinder()
-	userAccountController := controllers.NewUserAccountController(coreAdapter)
+	userAccountController := controllers.NewUserAccountController(coreAdapter, deps.OAuthStateTokenCookieHandler)
 
 	userAccountRoutes := router.Group("/me/account")
 	defaultMiddlewares := []gin.HandlerFunc{
@@ -39,7 +40,7 @@ func configureDevelopmentUserAccountRoutes(
 		middlewares.TimeoutMiddleware(3 * time.Second),
 		middlewares.GatewayAuthenticationMiddleware(accessTokenCookieHandler, refreshTokenCookieHandler),
 		interceptors.ShareableResponseWriterInterceptor(
-			interceptors.RefreshTokenInterceptor(accessTokenCookieHandler),
+			interceptors.RefreshTokenInterceptor(accessTokenCookieHandler, refreshTokenCookieHandler),
 			interceptors.EmbeddedInterceptor,
 		),
 	}
@@ -88,5 +89,82 @@ func configureDevelopmentUserAccountRoutes(
 				userAccountBinder.BindUnbindGoogleAccount(userAccountController.UnbindGoogleAccount),
 			)...,
 		)
+		userAccountRoutes.PUT(
+			"/github",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("bindGithubAccount"),
+					middlewares.ApplyMeterMiddleware("server.requests.userAccount.bindGithubAccount"),
+				},
+				defaultMiddlewares,
+				userAccountBinder.BindBindGithubAccount(userAccountController.BindGithubAccount),
+			)...,
+		)
+		userAccountRoutes.DELETE(
+			"/github",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("unbindGithubAccount"),
+					middlewares.ApplyMeterMiddleware("server.requests.userAccount.unbindGithubAccount"),
+				},
+				defaultMiddlewares,
+				userAccountBinder.BindUnbindGithubAccount(userAccountController.UnbindGithubAccount),
+			)...,
+		)
+		userAccountRoutes.PUT(
+			"/meta",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("bindMetaAccount"),
+					middlewares.ApplyMeterMiddleware("server.requests.userAccount.bindMetaAccount"),
+				},
+				defaultMiddlewares,
+				userAccountBinder.BindBindMetaAccount(userAccountController.BindMetaAccount),
+			)...,
+		)
+		userAccountRoutes.DELETE(
+			"/meta",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("unbindMetaAccount"),
+					middlewares.ApplyMeterMiddleware("server.requests.userAccount.unbindMetaAccount"),
+				},
+				defaultMiddlewares,
+				userAccountBinder.BindUnbindMetaAccount(userAccountController.UnbindMetaAccount),
+			)...,
+		)
+		userAccountRoutes.PUT(
+			"/oidc",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("bindOIDCAccount"),
+					middlewares.ApplyMeterMiddleware("server.requests.userAccount.bindOIDCAccount"),
+				},
+				defaultMiddlewares,
+				userAccountBinder.BindBindOIDCAccount(userAccountController.BindOIDCAccount),
+			)...,
+		)
+		userAccountRoutes.DELETE(
+			"/oidc",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("unbindOIDCAccount"),
+					middlewares.ApplyMeterMiddleware("server.requests.userAccount.unbindOIDCAccount"),
+				},
+				defaultMiddlewares,
+				userAccountBinder.BindUnbindOIDCAccount(userAccountController.UnbindOIDCAccount),
+			)...,
+		)
+		userAccountRoutes.GET(
+			"/usage",
+			middlewares.Reposition(
+				[]gin.HandlerFunc{
+					middlewares.ApplyTracerMiddleware("getMyUsage"),
+					middlewares.ApplyMeterMiddleware("server.requests.userAccount.getMyUsage"),
+				},
+				defaultMiddlewares,
+				userAccountBinder.BindGetMyUsage(userAccountController.GetMyUsage),
+			)...,
+		)
 	}
 }
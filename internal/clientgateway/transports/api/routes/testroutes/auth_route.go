@@ -13,10 +13,11 @@ import (
 )
 
 type AuthRouteDependencies struct {
-	CoreAdapter               *coreadapters.CoreAdapter
-	AccessTokenCookieHandler  *cookies.CookieHandler
-	RefreshTokenCookieHandler *cookies.CookieHandler
-	AuthorizedRateLimiter     *ratelimit.HybridRateLimiter
+	CoreAdapter                  *coreadapters.CoreAdapter
+	AccessTokenCookieHandler     *cookies.CookieHandler
+	RefreshTokenCookieHandler    *cookies.CookieHandler
+	OAuthStateTokenCookieHandler *cookies.CookieHandler
+	AuthorizedRateLimiter        *ratelimit.HybridRateLimiter
 }
 
 func testAuthorizedRateLimitMiddleware(rateLimiter *ratelimit.HybridRateLimiter) gin.HandlerFunc {
@@ -39,6 +40,7 @@ func ConfigureTestAuthRoutes(
 		coreAdapter,
 		accessTokenCookieHandler,
 		refreshTokenCookieHandler,
+		deps.OAuthStateTokenCookieHandler,
 	)
 
 	authRoutes := routerGroup.Group("/auth")
@@ -7,6 +7,7 @@ import (
 
 	enumcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
 
+	realtimeevent "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/data/cache/realtimeevent"
 	graphql "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/api/graphql"
 	middlewares "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/api/middlewares"
 	coreadapters "github.com/HiIamJeff67/notegic-backend/internal/clientgateway/transports/core/adapters"
@@ -14,6 +15,7 @@ import (
 
 type GraphQLRouteDependencies struct {
 	CoreAdapter               *coreadapters.CoreAdapter
+	RealtimeEventCacheClient  *realtimeevent.RealtimeEventCacheClient
 	AccessTokenCookieHandler  *cookies.CookieHandler
 	RefreshTokenCookieHandler *cookies.CookieHandler
 }
@@ -22,7 +24,8 @@ func ConfigureTestGraphQLRoutes(
 	routerGroup *gin.RouterGroup,
 	deps GraphQLRouteDependencies,
 ) {
-	coreAdapter, accessTokenCookieHandler, refreshTokenCookieHandler := deps.CoreAdapter, deps.AccessTokenCookieHandler, deps.RefreshTokenCookieHandler
+	coreAdapter, realtimeEventCacheClient := deps.CoreAdapter, deps.RealtimeEventCacheClient
+	accessTokenCookieHandler, refreshTokenCookieHandler := deps.AccessTokenCookieHandler, deps.RefreshTokenCookieHandler
 	graphqlRoutes := routerGroup.Group("/graphql")
 
 	graphqlRoutes.Use(
@@ -30,9 +33,19 @@ func ConfigureTestGraphQLRoutes(
 		middlewares.AllowedPermissionsAbove(enumcontract.AccessControlPermission_Read),
 	)
 	{
-		graphqlRoutes.POST("/", graphql.GraphQLHandler(coreAdapter))
+		graphqlRoutes.POST("/", graphql.GraphQLHandler(coreAdapter, realtimeEventCacheClient))
 		if gin.Mode() == gin.DebugMode {
 			graphqlRoutes.GET("/", graphql.PlaygroundHandler())
 		}
 	}
+
+	subscriptionRoutes := routerGroup.Group("/graphql/subscriptions")
+
+	subscriptionRoutes.Use(
+		middlewares.JWTMiddleware(accessTokenCookieHandler, refreshTokenCookieHandler),
+		middlewares.AllowedPermissionsAbove(enumcontract.AccessControlPermission_Read),
+	)
+	{
+		subscriptionRoutes.GET("/", graphql.SubscriptionHandler(coreAdapter, realtimeEventCacheClient))
+	}
 }
@@ -44,11 +44,22 @@ func IssueDelegationToken(
 	allowedPermissions []string,
 	operation string,
 	requestId string,
+) (string, error) {
+	return issueDelegationToken(sharedtokens.AuthMethodJWT, actor, userSubject, allowedPermissions, operation, requestId)
+}
+
+func issueDelegationToken(
+	authMethod string,
+	actor string,
+	userSubject string,
+	allowedPermissions []string,
+	operation string,
+	requestId string,
 ) (string, error) {
 	token, err := sharedtokens.GenerateDelegationToken(sharedtokens.DelegationTokenClaims{
 		Actor:              actor,
 		GatewaySource:      sharedtokens.GatewaySourceClient,
-		AuthMethod:         sharedtokens.AuthMethodJWT,
+		AuthMethod:         authMethod,
 		UserSubject:        userSubject,
 		AllowedPermissions: allowedPermissions,
 		Operation:          operation,
@@ -180,6 +191,9 @@ func call[RequestDto any, ResponseDto any](
 		gatewayContext.Set(sharedcontexts.ContextFieldName_IsNewTokens.String(), true)
 		gatewayContext.Set(sharedcontexts.ContextFieldName_AccessToken.String(), response.Tokens.AccessToken)
 		gatewayContext.Set(sharedcontexts.ContextFieldName_CSRFToken.String(), response.Tokens.CSRFToken)
+		if response.Tokens.RefreshToken != "" {
+			gatewayContext.Set(sharedcontexts.ContextFieldName_NewRefreshToken.String(), response.Tokens.RefreshToken)
+		}
 	}
 	if response.Version != gatewaycontract.Version {
 		return nil, exceptions.New(
@@ -387,7 +401,19 @@ func CallSecurly[RequestDto any, ResponseDto any](
 		requestId = uuid.NewString()
 	}
 
-	delegationToken, err := IssueDelegationToken(
+	// a share session is delegated as the creator of its share link, and Core
+	// narrows it down to the share link with the forwarded share session token
+	authMethod := sharedtokens.AuthMethodJWT
+	shareSessionToken, tokenException := gatewaycontexts.GetAndConvertContextFieldToString(
+		ctx,
+		sharedcontexts.ContextFieldName_ShareSessionToken,
+	)
+	if tokenException == nil && shareSessionToken != nil && *shareSessionToken != "" {
+		authMethod = sharedtokens.AuthMethodShareLink
+	}
+
+	delegationToken, err := issueDelegationToken(
+		authMethod,
 		"gateway",
 		userSubject.String(),
 		delegatedPermissions,
@@ -433,6 +459,9 @@ func CallSecurly[RequestDto any, ResponseDto any](
 	); tokenException == nil && csrfToken != nil {
 		tokens.CSRFToken = *csrfToken
 	}
+	if authMethod == sharedtokens.AuthMethodShareLink {
+		tokens.ShareSessionToken = *shareSessionToken
+	}
 
 	return call[RequestDto, ResponseDto](
 		client,
@@ -24,28 +24,28 @@ import (
 	platformredis "github.com/HiIamJeff67/notegic-backend/shared/platform/redis"
 
 	coreconfig "github.com/HiIamJeff67/notegic-backend/internal/core/configs"
+	contexts "github.com/HiIamJeff67/notegic-backend/internal/core/contexts"
 	apikeycache "github.com/HiIamJeff67/notegic-backend/internal/core/data/cache/apikey"
 	userdata "github.com/HiIamJeff67/notegic-backend/internal/core/data/cache/userdata"
 	data "github.com/HiIamJeff67/notegic-backend/internal/core/data/database"
+	migrations "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/migrations"
 	repositories "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/repositories"
-	schemas "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas"
-	constraints "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/constraints"
-	enums "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/enums"
-	triggers "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/triggers"
-	views "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/views"
 	scopes "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/scopes"
 	seeds "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/seeds"
 	storage "github.com/HiIamJeff67/notegic-backend/internal/core/data/storage"
 	apikeyservices "github.com/HiIamJeff67/notegic-backend/internal/core/services/apikey"
 	authservices "github.com/HiIamJeff67/notegic-backend/internal/core/services/auth"
+	billingservices "github.com/HiIamJeff67/notegic-backend/internal/core/services/billing"
 	blockservices "github.com/HiIamJeff67/notegic-backend/internal/core/services/blocks"
 	materialservices "github.com/HiIamJeff67/notegic-backend/internal/core/services/material"
 	otherservices "github.com/HiIamJeff67/notegic-backend/internal/core/services/other"
 	realtimeservices "github.com/HiIamJeff67/notegic-backend/internal/core/services/realtime"
 	routineservices "github.com/HiIamJeff67/notegic-backend/internal/core/services/routines"
+	sharelinkservices "github.com/HiIamJeff67/notegic-backend/internal/core/services/sharelink"
 	shelfservices "github.com/HiIamJeff67/notegic-backend/internal/core/services/shelves"
 	userservices "github.com/HiIamJeff67/notegic-backend/internal/core/services/user"
 	coretransports "github.com/HiIamJeff67/notegic-backend/internal/core/transports"
+	billingtransport "github.com/HiIamJeff67/notegic-backend/internal/core/transports/billing"
 	durablejobtransport "github.com/HiIamJeff67/notegic-backend/internal/core/transports/durablejob"
 	durablejobconsumers "github.com/HiIamJeff67/notegic-backend/internal/core/transports/durablejob/consumers"
 	durablejobproducers "github.com/HiIamJeff67/notegic-backend/internal/core/transports/durablejob/producers"
@@ -75,9 +75,11 @@ type ApplicationInterface interface {
 	initializeRedis(platformredis.Config, func()) *platformredis.ClientSet
 	initializeCacheClients(coreconfig.Config, *platformredis.ClientSet, func()) (*userdata.UserDataCacheClient, *apikeycache.APIKeyCacheClient)
 	initializeYjsClient(coreconfig.Config) *yjsworkertransport.DocumentInitializationClient
+	initializeBillingProvider(coreconfig.Config) billingtransport.ProviderInterface
+	initializeStorage() storage.StorageInterface
 	initializeKafka(platformkafka.ConnectionConfig) (*platformkafka.Producer, bool)
-	initializeWorkers(coreconfig.Config, platformkafka.ConnectionConfig, *platformkafka.Producer, *yjsworkertransport.DocumentInitializationClient) func()
-	buildRouter(coreconfig.Config, *platformkafka.Producer, *userdata.UserDataCacheClient, *yjsworkertransport.DocumentInitializationClient, *apikeycache.APIKeyCacheClient) *gin.Engine
+	initializeWorkers(coreconfig.Config, platformkafka.ConnectionConfig, *platformkafka.Producer, *userdata.UserDataCacheClient, *yjsworkertransport.DocumentInitializationClient, billingtransport.ProviderInterface, storage.StorageInterface) func()
+	buildRouter(coreconfig.Config, *platformkafka.Producer, *userdata.UserDataCacheClient, *yjsworkertransport.DocumentInitializationClient, billingtransport.ProviderInterface, *apikeycache.APIKeyCacheClient, storage.StorageInterface) *gin.Engine
 	startHTTP(coreconfig.Config, *platformredis.ClientSet, *platformkafka.Producer, bool, func(), *gin.Engine, func()) func()
 	Start() func()
 	IsHealthy() bool
@@ -101,7 +103,9 @@ func (a *Application) buildRouter(
 	kafkaProducer *platformkafka.Producer,
 	userDataCacheClient *userdata.UserDataCacheClient,
 	yjsDocumentInitializationClient *yjsworkertransport.DocumentInitializationClient,
+	billingProvider billingtransport.ProviderInterface,
 	apiKeyCacheClient *apikeycache.APIKeyCacheClient,
+	objectStorage storage.StorageInterface,
 ) *gin.Engine {
 	validator := validation.New()
 
@@ -121,12 +125,16 @@ func (a *Application) buildRouter(
 	userInfoRepository := repositories.NewUserInfoRepository()
 	userAccountRepository := repositories.NewUserAccountRepository()
 	userSettingRepository := repositories.NewUserSettingRepository()
+	userSessionRepository := repositories.NewUserSessionRepository()
+	userTwoFactorRepository := repositories.NewUserTwoFactorRepository()
 	rootShelfRepository := repositories.NewRootShelfRepository(rootShelfScope)
 	stationRepository := repositories.NewStationRepository(stationScope)
 	usersToShelvesRepository := repositories.NewUsersToShelvesRepository()
 	usersToStationsRepository := repositories.NewUsersToStationsRepository()
 	blockRepository := repositories.NewBlockRepository(blockScope)
 	blockPackRepository := repositories.NewBlockPackRepository(blockPackScope)
+	blockPackYjsRepository := repositories.NewBlockPackYjsRepository()
+	blockPackYjsSnapshotRepository := repositories.NewBlockPackYjsSnapshotRepository()
 	subShelfRepository := repositories.NewSubShelfRepository(subShelfScope)
 	materialRepository := repositories.NewMaterialRepository(materialScope)
 	routineRepository := repositories.NewRoutineRepository(routineScope)
@@ -135,9 +143,14 @@ func (a *Application) buildRouter(
 	routineTaskRecordRepository := repositories.NewRoutineTaskRecordRepository(routineTaskRecordScope)
 	itemRepository := repositories.NewItemRepository(itemScope)
 	outboxEventRepository := repositories.NewOutboxEventRepository()
-	inMemoryStorage := storage.NewInMemoryStorage()
 
-	oauthService := authservices.NewOAuthService(config.OAuthGoogle.OAuthConfig())
+	oauthService := authservices.NewOAuthService(authservices.OAuthConfigs{
+		Google:        config.OAuthGoogle.OAuthConfig(),
+		Github:        config.OAuthGithub.OAuthConfig(),
+		Meta:          config.OAuthMeta.OAuthConfig(),
+		OIDC:          config.OAuthOIDC.OAuthConfig(),
+		OIDCIssuerURL: config.OAuthOIDC.IssuerUrl,
+	})
 	emailClient := emailtransport.NewClient(
 		data.DB,
 	)
@@ -149,6 +162,8 @@ func (a *Application) buildRouter(
 		userInfoRepository,
 		userAccountRepository,
 		userSettingRepository,
+		userSessionRepository,
+		userTwoFactorRepository,
 		rootShelfRepository,
 		outboxEventRepository,
 		oauthService,
@@ -164,6 +179,16 @@ func (a *Application) buildRouter(
 		usersToShelvesRepository,
 		blockPackRepository,
 	)
+	rootShelfArchiveService := shelfservices.NewRootShelfArchiveService(
+		validator,
+		data.DB,
+		objectStorage,
+		yjsDocumentInitializationClient,
+		rootShelfRepository,
+		repositories.NewRootShelfArchiveJobRepository(),
+		outboxEventRepository,
+		config.StorageKeySalt,
+	)
 	stationService := routineservices.NewStationService(
 		validator,
 		data.DB,
@@ -188,6 +213,7 @@ func (a *Application) buildRouter(
 		userRepository,
 		userAccountRepository,
 		repositories.NewUserQuotaRepository(),
+		repositories.NewUserUsageSnapshotRepository(),
 		oauthService,
 	)
 	userService := userservices.NewUserService(
@@ -223,7 +249,7 @@ func (a *Application) buildRouter(
 	subShelfService := shelfservices.NewSubShelfService(
 		validator,
 		data.DB,
-		inMemoryStorage,
+		objectStorage,
 		subShelfScope,
 		subShelfRepository,
 		rootShelfRepository,
@@ -233,17 +259,30 @@ func (a *Application) buildRouter(
 	blockPackService := blockservices.NewBlockPackService(
 		validator,
 		data.DB,
+		yjsDocumentInitializationClient,
 		blockPackScope,
 		subShelfRepository,
 		blockPackRepository,
 	)
+	blockPackSnapshotService := blockservices.NewBlockPackSnapshotService(
+		validator,
+		data.DB,
+		yjsworkertransport.NewDocumentHistoryClient(config.YjsDocumentHistory),
+		blockPackRepository,
+		blockPackYjsRepository,
+		blockPackYjsSnapshotRepository,
+		outboxEventRepository,
+	)
 	materialService := materialservices.NewMaterialService(
 		validator,
 		data.DB,
-		inMemoryStorage,
+		objectStorage,
 		materialScope,
 		subShelfRepository,
 		materialRepository,
+		repositories.NewMaterialUploadRepository(),
+		outboxEventRepository,
+		config.MaterialUpload.Expiration,
 		config.StorageKeySalt,
 	)
 	routineService := routineservices.NewRoutineService(
@@ -271,11 +310,37 @@ func (a *Application) buildRouter(
 		routineTaskExecutionService,
 	)
 	themeService := otherservices.NewThemeService(data.DB)
-	itemService := shelfservices.NewItemService(data.DB, itemScope)
+	itemService := shelfservices.NewItemService(data.DB, itemScope, repositories.NewTrashRepository())
 	badgeService := otherservices.NewBadgeService(data.DB)
 	apiKeyRepository := repositories.NewAPIKeyRepository()
 	apiKeyService := apikeyservices.NewAPIKeyService(validator, data.DB, apiKeyRepository, apiKeyCacheClient)
-	authMiddleware := coremiddlewares.AuthMiddleware(userRepository, userDataCacheClient)
+	shareLinkRepository := repositories.NewShareLinkRepository()
+	shareLinkService := sharelinkservices.NewShareLinkService(
+		validator,
+		data.DB,
+		shareLinkRepository,
+		rootShelfRepository,
+		userRepository,
+		outboxEventRepository,
+	)
+	billingService := billingservices.NewBillingService(
+		validator,
+		data.DB,
+		billingProvider,
+		config.Billing.GracePeriod,
+		userDataCacheClient,
+		userRepository,
+		repositories.NewBillingPlanRepository(),
+		repositories.NewUsersToBillingPlansRepository(),
+		repositories.NewBillingInvoiceRepository(),
+	)
+	// the share sessions are forwarded by the client gateway as the creator of
+	// the share link, so they are authenticated in place of the user sessions
+	authMiddleware := coremiddlewares.EitherMiddleware(
+		[]gin.HandlerFunc{coremiddlewares.ShareLinkMiddleware(shareLinkRepository, userRepository)},
+		[]gin.HandlerFunc{coremiddlewares.AuthMiddleware(userRepository, userSessionRepository, userDataCacheClient)},
+		func(ctx *gin.Context) bool { return contexts.IsShareLinkSession(ctx.Request.Context()) },
+	)[0]
 	apiKeyMiddleware := coremiddlewares.APIKeyMiddleware(
 		apiKeyRepository,
 		userRepository,
@@ -293,7 +358,8 @@ func (a *Application) buildRouter(
 			AuthMiddleware: authMiddleware,
 		},
 		RootShelf: gatewayrouters.RootShelfRouterDependencies{
-			Service: rootShelfService, AuthMiddleware: authMiddleware, APIKeyMiddleware: apiKeyMiddleware,
+			Service: rootShelfService, ArchiveService: rootShelfArchiveService,
+			AuthMiddleware: authMiddleware, APIKeyMiddleware: apiKeyMiddleware,
 		},
 		Station: gatewayrouters.StationRouterDependencies{
 			Service: stationService, AuthMiddleware: authMiddleware, APIKeyMiddleware: apiKeyMiddleware,
@@ -314,7 +380,7 @@ func (a *Application) buildRouter(
 			Service: subShelfService, AuthMiddleware: authMiddleware, APIKeyMiddleware: apiKeyMiddleware,
 		},
 		BlockPack: gatewayrouters.BlockPackRouterDependencies{
-			Service: blockPackService, AuthMiddleware: authMiddleware, APIKeyMiddleware: apiKeyMiddleware,
+			Service: blockPackService, SnapshotService: blockPackSnapshotService, AuthMiddleware: authMiddleware, APIKeyMiddleware: apiKeyMiddleware,
 		},
 		Material: gatewayrouters.MaterialRouterDependencies{
 			Service: materialService, AuthMiddleware: authMiddleware, APIKeyMiddleware: apiKeyMiddleware,
@@ -328,6 +394,10 @@ func (a *Application) buildRouter(
 		Theme: gatewayrouters.ThemeRouterDependencies{Service: themeService},
 		Item:  gatewayrouters.ItemRouterDependencies{Service: itemService, AuthMiddleware: authMiddleware},
 		Badge: gatewayrouters.BadgeRouterDependencies{Service: badgeService, AuthMiddleware: authMiddleware},
+		ShareLink: gatewayrouters.ShareLinkRouterDependencies{
+			Service: shareLinkService, AuthMiddleware: authMiddleware,
+		},
+		Billing: gatewayrouters.BillingRouterDependencies{Service: billingService, AuthMiddleware: authMiddleware},
 	})
 	durablejobrouters.ConfigureBlockProjectionRoutes(router, blockService)
 	return router
@@ -370,14 +440,13 @@ func (a *Application) initializeDatabase(
 ) {
 	data.DB = data.Connect(config)
 	for _, migrate := range []func() error{
-		func() error { return platformpostgres.MigrateEnumsToDatabase(data.DB, enums.MigratingEnums) },
-		func() error { return platformpostgres.MigrateTablesToDatabase(data.DB, schemas.MigratingTables) },
-		func() error { return platformpostgres.MigrateViewsToDatabase(data.DB, views.MigratingViewSQLs) },
-		func() error {
-			return platformpostgres.MigrateTriggersToDatabase(data.DB, triggers.MigratingTriggerSQLs)
-		},
 		func() error {
-			return platformpostgres.MigrateConstraintsToDatabase(data.DB, constraints.MigratingConstraintSQLs)
+			migrator, err := migrations.NewVersionedMigrator(data.DB)
+			if err != nil {
+				return err
+			}
+			_, err = migrator.Apply(context.Background(), migrator.LatestVersion())
+			return err
 		},
 		func() error { return platformpostgres.SeedDefaultDataToDatabase(data.DB, seeds.SeedingDefaultDataSQLs) },
 	} {
@@ -446,6 +515,19 @@ func (a *Application) initializeYjsClient(config coreconfig.Config) *yjsworkertr
 	return yjsworkertransport.NewDocumentInitializationClient(config.YjsDocumentInitialization)
 }
 
+// initializeBillingProvider returns a nil provider instead of a nil client when PayPal is not
+// configured, so the billing service can tell the missing provider apart
+func (a *Application) initializeBillingProvider(config coreconfig.Config) billingtransport.ProviderInterface {
+	if !config.PayPal.IsConfigured() {
+		return nil
+	}
+	return billingtransport.NewPayPalClient(config.PayPal)
+}
+
+func (a *Application) initializeStorage() storage.StorageInterface {
+	return storage.NewInMemoryStorage()
+}
+
 func (a *Application) initializeKafka(
 	config platformkafka.ConnectionConfig,
 ) (*platformkafka.Producer, bool) {
@@ -467,7 +549,10 @@ func (a *Application) initializeWorkers(
 	config coreconfig.Config,
 	kafkaConnection platformkafka.ConnectionConfig,
 	kafkaProducer *platformkafka.Producer,
+	userDataCacheClient *userdata.UserDataCacheClient,
 	yjsDocumentInitializationClient *yjsworkertransport.DocumentInitializationClient,
+	billingProvider billingtransport.ProviderInterface,
+	objectStorage storage.StorageInterface,
 ) func() {
 	outboxRelay := coretransports.NewOutboxRelay(
 		data.DB,
@@ -479,10 +564,62 @@ func (a *Application) initializeWorkers(
 		data.DB,
 		repositories.NewOutboxEventRepository(),
 	)
+	materialProcessingReconciliationWorker := coreworkers.NewMaterialProcessingReconciliationWorker(
+		data.DB,
+		repositories.NewMaterialRepository(scopes.NewMaterialScope()),
+		repositories.NewOutboxEventRepository(),
+	)
 	quotaCycleWorker := coreworkers.NewQuotaCycleWorker(
 		data.DB,
 		config.QuotaCycleWorker,
 		repositories.NewUserQuotaRepository(),
+		repositories.NewUserUsageSnapshotRepository(),
+	)
+	quotaWarningWorker := coreworkers.NewQuotaWarningWorker(
+		data.DB,
+		config.QuotaWarningWorker,
+		repositories.NewUserQuotaWarningRepository(),
+		repositories.NewOutboxEventRepository(),
+		emailtransport.NewClient(data.DB),
+	)
+	rootShelfArchiveWorker := coreworkers.NewRootShelfArchiveWorker(
+		config.RootShelfArchiveWorker,
+		shelfservices.NewRootShelfArchiveService(
+			validation.New(),
+			data.DB,
+			objectStorage,
+			yjsDocumentInitializationClient,
+			repositories.NewRootShelfRepository(scopes.NewRootShelfScope()),
+			repositories.NewRootShelfArchiveJobRepository(),
+			repositories.NewOutboxEventRepository(),
+			config.StorageKeySalt,
+		),
+	)
+	trashPurgeWorker := coreworkers.NewTrashPurgeWorker(
+		data.DB,
+		config.TrashPurgeWorker,
+		objectStorage,
+		repositories.NewTrashRepository(),
+	)
+	materialUploadCleanupWorker := coreworkers.NewMaterialUploadCleanupWorker(
+		data.DB,
+		config.MaterialUpload,
+		objectStorage,
+		repositories.NewMaterialUploadRepository(),
+	)
+	billingWorker := coreworkers.NewBillingWorker(
+		config.Billing,
+		billingservices.NewBillingService(
+			validation.New(),
+			data.DB,
+			billingProvider,
+			config.Billing.GracePeriod,
+			userDataCacheClient,
+			repositories.NewUserRepository(),
+			repositories.NewBillingPlanRepository(),
+			repositories.NewUsersToBillingPlansRepository(),
+			repositories.NewBillingInvoiceRepository(),
+		),
 	)
 	routineTaskExecutionService := routineservices.NewRoutineTaskExecutionService(
 		validation.New(),
@@ -550,6 +687,25 @@ func (a *Application) initializeWorkers(
 			MaximumPollRecords:  config.KafkaConsumer.MaximumPollRecords,
 		},
 	)
+	materialProcessingRequestConsumer := durablejobconsumers.NewMaterialProcessingRequestConsumer(
+		materialservices.NewMaterialProcessingService(
+			data.DB,
+			objectStorage,
+			repositories.NewMaterialRepository(scopes.NewMaterialScope()),
+		),
+		durablejobproducers.NewMaterialProcessingResultProducer(kafkaProducer),
+		platformkafka.ConsumerConfig{
+			ClientConfig: platformkafka.ClientConfig{
+				ConnectionConfig: kafkaConnection,
+				ClientId:         "notegic-core-durablejob-material-processing",
+			},
+			ConsumerGroup:       durablejobtransport.MaterialProcessingRequestConsumerGroup,
+			MaximumAttempts:     config.KafkaConsumer.MaximumAttempts,
+			InitialRetryBackoff: config.KafkaConsumer.InitialRetryBackoff,
+			MaximumRetryBackoff: config.KafkaConsumer.MaximumRetryBackoff,
+			MaximumPollRecords:  config.KafkaConsumer.MaximumPollRecords,
+		},
+	)
 	yjsMaintenanceResultConsumer := yjsworkerconsumers.NewYjsMaintenanceResultConsumer(
 		durablejobproducers.NewYjsMaintenanceResultProducer(kafkaProducer),
 		platformkafka.ConsumerConfig{
@@ -590,17 +746,31 @@ func (a *Application) initializeWorkers(
 	)
 	shutdownOutboxRelay := outboxRelay.Start(context.Background())
 	shutdownYjsMaintenanceReconciliationWorker := yjsMaintenanceReconciliationWorker.Start(context.Background())
+	shutdownMaterialProcessingReconciliationWorker := materialProcessingReconciliationWorker.Start(context.Background())
 	shutdownQuotaCycleWorker := quotaCycleWorker.Start(context.Background())
+	shutdownQuotaWarningWorker := quotaWarningWorker.Start(context.Background())
+	shutdownRootShelfArchiveWorker := rootShelfArchiveWorker.Start(context.Background())
+	shutdownTrashPurgeWorker := trashPurgeWorker.Start(context.Background())
+	shutdownMaterialUploadCleanupWorker := materialUploadCleanupWorker.Start(context.Background())
+	shutdownBillingWorker := billingWorker.Start(context.Background())
 	shutdownRoutineTaskClaimConsumer := routineTaskClaimConsumer.Start(context.Background())
 	shutdownRoutineTaskResultConsumer := routineTaskResultConsumer.Start(context.Background())
 	shutdownYjsMaintenanceRequestConsumer := yjsMaintenanceRequestConsumer.Start(context.Background())
+	shutdownMaterialProcessingRequestConsumer := materialProcessingRequestConsumer.Start(context.Background())
 	shutdownYjsMaintenanceResultConsumer := yjsMaintenanceResultConsumer.Start(context.Background())
 	shutdownYjsCommandConsumer := yjsCommandConsumer.Start(context.Background())
 	return func() {
 		shutdownYjsCommandConsumer()
 		shutdownYjsMaintenanceResultConsumer()
+		shutdownMaterialProcessingRequestConsumer()
 		shutdownYjsMaintenanceRequestConsumer()
+		shutdownMaterialProcessingReconciliationWorker()
 		shutdownYjsMaintenanceReconciliationWorker()
+		shutdownBillingWorker()
+		shutdownMaterialUploadCleanupWorker()
+		shutdownTrashPurgeWorker()
+		shutdownRootShelfArchiveWorker()
+		shutdownQuotaWarningWorker()
 		shutdownQuotaCycleWorker()
 		shutdownRoutineTaskResultConsumer()
 		shutdownRoutineTaskClaimConsumer()
@@ -667,9 +837,11 @@ func (a *Application) Start() func() {
 	redisClientSet := a.initializeRedis(redisConfig, shutdownObservability)
 	userDataCacheClient, apiKeyCacheClient := a.initializeCacheClients(config, redisClientSet, shutdownObservability)
 	yjsDocumentInitializationClient := a.initializeYjsClient(config)
+	billingProvider := a.initializeBillingProvider(config)
+	objectStorage := a.initializeStorage()
 	kafkaProducer, kafkaReady := a.initializeKafka(kafkaConnectionConfig)
-	shutdownWorkers := a.initializeWorkers(config, kafkaConnectionConfig, kafkaProducer, yjsDocumentInitializationClient)
-	router := a.buildRouter(config, kafkaProducer, userDataCacheClient, yjsDocumentInitializationClient, apiKeyCacheClient)
+	shutdownWorkers := a.initializeWorkers(config, kafkaConnectionConfig, kafkaProducer, userDataCacheClient, yjsDocumentInitializationClient, billingProvider, objectStorage)
+	router := a.buildRouter(config, kafkaProducer, userDataCacheClient, yjsDocumentInitializationClient, billingProvider, apiKeyCacheClient, objectStorage)
 	return a.startHTTP(config, redisClientSet, kafkaProducer, kafkaReady, shutdownWorkers, router, shutdownObservability)
 }
 
@@ -11,11 +11,6 @@ import (
 
 	coreconfig "github.com/HiIamJeff67/notegic-backend/internal/core/configs"
 	data "github.com/HiIamJeff67/notegic-backend/internal/core/data/database"
-	schemas "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas"
-	constraints "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/constraints"
-	enums "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/enums"
-	triggers "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/triggers"
-	views "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/views"
 	seeds "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/seeds"
 )
 
@@ -89,37 +84,6 @@ var truncateDatabaseCommand = &cobra.Command{
 	},
 }
 
-var migrateDatabaseCommand = &cobra.Command{
-	Use:   "migrateDB",
-	Short: "Migrate enums, tables, and some triggers to the database.",
-	Long:  "Use some migration SQLs to migrate required enums, tables, and some triggers to the database.",
-	Run: func(_ *cobra.Command, _ []string) {
-		config, err := coreconfig.LoadPostgresConfig()
-		if err != nil {
-			panic(err)
-		}
-		db := data.Connect(config)
-		defer data.Disconnect(db)
-
-		logs.NotegicLogger.Info(context.Background(), fmt.Sprintf("Start the process of migrating database schema to %v", config.Name))
-
-		for _, migrate := range []func() error{
-			func() error { return platformpostgres.MigrateEnumsToDatabase(db, enums.MigratingEnums) },
-			func() error { return platformpostgres.MigrateTablesToDatabase(db, schemas.MigratingTables) },
-			func() error { return platformpostgres.MigrateViewsToDatabase(db, views.MigratingViewSQLs) },
-			func() error { return platformpostgres.MigrateTriggersToDatabase(db, triggers.MigratingTriggerSQLs) },
-			func() error {
-				return platformpostgres.MigrateConstraintsToDatabase(db, constraints.MigratingConstraintSQLs)
-			},
-		} {
-			if err := migrate(); err != nil {
-				logs.NotegicLogger.Error(context.Background(), err, "Failed to migrate database schema")
-				return
-			}
-		}
-	},
-}
-
 var seedDatabaseCommand = &cobra.Command{
 	Use:   "seedDB",
 	Short: "Seed some default data for management or main business logic.",
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	logs "github.com/HiIamJeff67/notegic-backend/shared/platform/observability/logs"
+	platformpostgres "github.com/HiIamJeff67/notegic-backend/shared/platform/postgres"
+
+	coreconfig "github.com/HiIamJeff67/notegic-backend/internal/core/configs"
+	data "github.com/HiIamJeff67/notegic-backend/internal/core/data/database"
+	migrations "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/migrations"
+)
+
+var migrateCommand = &cobra.Command{
+	Use:   "migrate",
+	Short: "Inspect or apply the versioned migrations of the database.",
+	Long:  "Use the numbered up and down migrations tracked in the schema_migrations table to move the database between schema versions.",
+}
+
+var migrateStatusCommand = &cobra.Command{
+	Use:   "status",
+	Short: "View the applied and pending migrations.",
+	Args:  cobra.NoArgs,
+	RunE: func(command *cobra.Command, _ []string) error {
+		return withVersionedMigrator(func(migrator *platformpostgres.VersionedMigrator) error {
+			statuses, err := migrator.Status(command.Context())
+			if err != nil {
+				return err
+			}
+
+			logs.NotegicLogger.Info(context.Background(), "=============== Database Migration List ===============")
+			for _, status := range statuses {
+				state := "pending"
+				if status.AppliedAt != nil {
+					state = fmt.Sprintf("applied at %s", status.AppliedAt.Format("2006-01-02 15:04:05 MST"))
+				}
+				logs.NotegicLogger.Info(context.Background(), fmt.Sprintf("%04d_%-40s | %s", status.Version, status.Name, state))
+			}
+			logs.NotegicLogger.Info(context.Background(), "=============== Database Migration List ===============")
+			return nil
+		})
+	},
+}
+
+var migrateUpCommand = &cobra.Command{
+	Use:   "up",
+	Short: "Apply all the pending migrations.",
+	Args:  cobra.NoArgs,
+	RunE: func(command *cobra.Command, _ []string) error {
+		return withVersionedMigrator(func(migrator *platformpostgres.VersionedMigrator) error {
+			return migrateToVersion(command, migrator, migrator.LatestVersion())
+		})
+	},
+}
+
+var migrateDownCommand = &cobra.Command{
+	Use:   "down",
+	Short: "Revert the most recently applied migrations.",
+	Args:  cobra.NoArgs,
+	RunE: func(command *cobra.Command, _ []string) error {
+		steps, err := command.Flags().GetInt("steps")
+		if err != nil {
+			return err
+		}
+
+		return withVersionedMigrator(func(migrator *platformpostgres.VersionedMigrator) error {
+			target, err := migrator.DownTarget(command.Context(), steps)
+			if err != nil {
+				return err
+			}
+			return migrateToVersion(command, migrator, target)
+		})
+	},
+}
+
+var migrateToCommand = &cobra.Command{
+	Use:   "to <version>",
+	Short: "Apply or revert the migrations until the database is at the given version.",
+	Long:  "Apply the pending migrations up to the given version and revert the applied ones after it, the version 0 reverts every migration.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(command *cobra.Command, args []string) error {
+		target, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil || target < 0 {
+			return fmt.Errorf("the version %q must be a non-negative integer", args[0])
+		}
+
+		return withVersionedMigrator(func(migrator *platformpostgres.VersionedMigrator) error {
+			return migrateToVersion(command, migrator, target)
+		})
+	},
+}
+
+/* ============================== Auxiliary Functions ============================== */
+
+func withVersionedMigrator(run func(migrator *platformpostgres.VersionedMigrator) error) error {
+	config, err := coreconfig.LoadPostgresConfig()
+	if err != nil {
+		return err
+	}
+	db := data.Connect(config)
+	defer data.Disconnect(db)
+
+	migrator, err := migrations.NewVersionedMigrator(db)
+	if err != nil {
+		return err
+	}
+	return run(migrator)
+}
+
+func migrateToVersion(command *cobra.Command, migrator *platformpostgres.VersionedMigrator, target int64) error {
+	dryRun, err := command.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+
+	if dryRun {
+		steps, err := migrator.Plan(command.Context(), target)
+		if err != nil {
+			return err
+		}
+		if len(steps) == 0 {
+			_, err := fmt.Fprintf(command.OutOrStdout(), "-- the database is already at version %d\n", target)
+			return err
+		}
+		return platformpostgres.WriteVersionedMigrationSQL(command.OutOrStdout(), steps)
+	}
+
+	allowDestroyBaseline, err := command.Flags().GetBool("allow-destroy-baseline")
+	if err != nil {
+		return err
+	}
+	if !allowDestroyBaseline && target < migrations.BaselineVersion {
+		steps, err := migrator.Plan(command.Context(), target)
+		if err != nil {
+			return err
+		}
+		for _, step := range steps {
+			if step.Direction == platformpostgres.VersionedMigrationDirection_Down && step.Migration.Version == migrations.BaselineVersion {
+				return fmt.Errorf("reverting the baseline drops every table of the database, pass --allow-destroy-baseline to do it")
+			}
+		}
+	}
+
+	steps, err := migrator.Apply(command.Context(), target)
+	if err != nil {
+		return err
+	}
+	logs.NotegicLogger.Info(context.Background(), fmt.Sprintf("The database is at version %d after %d migrations", target, len(steps)))
+	return nil
+}
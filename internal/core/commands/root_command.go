@@ -29,11 +29,20 @@ var rootCommand = &cobra.Command{
 func init() {
 	truncateDatabaseCommand.Flags().String("database", "", "The name of the database to truncate the table inside it")
 	truncateDatabaseCommand.Flags().String("table", "", "The name of the table to truncate")
+	migrateCommand.PersistentFlags().Bool("dry-run", false, "Print the SQL of the migrations instead of applying them")
+	migrateCommand.PersistentFlags().Bool("allow-destroy-baseline", false, "Allow reverting the baseline migration, which drops every table of the database")
+	migrateDownCommand.Flags().Int("steps", 1, "The number of the most recently applied migrations to revert")
+	migrateCommand.AddCommand(
+		migrateStatusCommand,
+		migrateUpCommand,
+		migrateDownCommand,
+		migrateToCommand,
+	)
 	rootCommand.AddCommand(
 		viewAllAvailableDatabasesCommand,
 		truncateDatabaseCommand,
 		viewAllDatabaseEnumsCommand,
-		migrateDatabaseCommand,
+		migrateCommand,
 		seedDatabaseCommand,
 		writeGraphQLEnumMappingValuesToConfig,
 	)
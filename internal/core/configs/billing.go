@@ -0,0 +1,67 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+type BillingConfig struct {
+	GracePeriod    time.Duration
+	WorkerInterval time.Duration
+}
+
+func loadBillingConfig() (BillingConfig, error) {
+	gracePeriod, err := time.ParseDuration(strings.TrimSpace(os.Getenv("BILLING_GRACE_PERIOD")))
+	if err != nil || gracePeriod <= 0 {
+		return BillingConfig{}, fmt.Errorf("BILLING_GRACE_PERIOD must be a positive Go duration")
+	}
+	workerInterval, err := time.ParseDuration(strings.TrimSpace(os.Getenv("CORE_BILLING_WORKER_INTERVAL")))
+	if err != nil || workerInterval <= 0 {
+		return BillingConfig{}, fmt.Errorf("CORE_BILLING_WORKER_INTERVAL must be a positive Go duration")
+	}
+
+	return BillingConfig{
+		GracePeriod:    gracePeriod,
+		WorkerInterval: workerInterval,
+	}, nil
+}
+
+// PayPal is optional like the other third party providers, it is only enabled when all of
+// its variables are set, and the billing operations which need it are rejected otherwise.
+type PayPalConfig struct {
+	BaseUrl      string
+	ClientId     string
+	ClientSecret string
+	WebhookId    string
+	ReturnUrl    string
+	CancelUrl    string
+}
+
+func loadPayPalConfig() (PayPalConfig, error) {
+	config := PayPalConfig{
+		BaseUrl:      strings.TrimRight(strings.TrimSpace(os.Getenv("PAYPAL_BASE_URL")), "/"),
+		ClientId:     strings.TrimSpace(os.Getenv("PAYPAL_CLIENT_ID")),
+		ClientSecret: os.Getenv("PAYPAL_CLIENT_SECRET"),
+		WebhookId:    strings.TrimSpace(os.Getenv("PAYPAL_WEBHOOK_ID")),
+		ReturnUrl:    strings.TrimSpace(os.Getenv("PAYPAL_RETURN_URL")),
+		CancelUrl:    strings.TrimSpace(os.Getenv("PAYPAL_CANCEL_URL")),
+	}
+	if !isAllOrNoneSet(
+		config.BaseUrl,
+		config.ClientId,
+		config.ClientSecret,
+		config.WebhookId,
+		config.ReturnUrl,
+		config.CancelUrl,
+	) {
+		return PayPalConfig{}, fmt.Errorf("PAYPAL_BASE_URL, PAYPAL_CLIENT_ID, PAYPAL_CLIENT_SECRET, PAYPAL_WEBHOOK_ID, PAYPAL_RETURN_URL, and PAYPAL_CANCEL_URL must be set together")
+	}
+
+	return config, nil
+}
+
+func (c PayPalConfig) IsConfigured() bool {
+	return c.ClientId != ""
+}
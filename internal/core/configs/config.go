@@ -11,12 +11,22 @@ import (
 type Config struct {
 	Postgres                  platformpostgres.Config
 	ListenAddress             string
+	Billing                   BillingConfig
 	OAuthGoogle               OAuthGoogleConfig
+	OAuthGithub               OAuthGithubConfig
+	OAuthMeta                 OAuthMetaConfig
+	OAuthOIDC                 OAuthOIDCConfig
 	OutboxRelay               OutboxRelayConfig
+	PayPal                    PayPalConfig
 	KafkaConsumer             KafkaConsumerConfig
+	MaterialUpload            MaterialUploadConfig
 	QuotaCycleWorker          QuotaCycleWorkerConfig
+	QuotaWarningWorker        QuotaWarningWorkerConfig
+	RootShelfArchiveWorker    RootShelfArchiveWorkerConfig
+	TrashPurgeWorker          TrashPurgeWorkerConfig
 	UserDataCache             UserDataCacheConfig
 	YjsDocumentInitialization YjsDocumentInitializationConfig
+	YjsDocumentHistory        YjsDocumentHistoryConfig
 	StorageKeySalt            string
 }
 
@@ -29,22 +39,58 @@ func LoadConfig() (Config, error) {
 	if listenAddress == "" {
 		return Config{}, fmt.Errorf("CORE_LISTEN_ADDRESS is required")
 	}
+	billing, err := loadBillingConfig()
+	if err != nil {
+		return Config{}, err
+	}
 	oauthGoogle, err := loadOAuthGoogleConfig()
 	if err != nil {
 		return Config{}, err
 	}
+	oauthGithub, err := loadOAuthGithubConfig()
+	if err != nil {
+		return Config{}, err
+	}
+	oauthMeta, err := loadOAuthMetaConfig()
+	if err != nil {
+		return Config{}, err
+	}
+	oauthOIDC, err := loadOAuthOIDCConfig()
+	if err != nil {
+		return Config{}, err
+	}
 	outboxRelay, err := loadOutboxRelayConfig()
 	if err != nil {
 		return Config{}, err
 	}
+	payPal, err := loadPayPalConfig()
+	if err != nil {
+		return Config{}, err
+	}
 	kafkaConsumer, err := loadKafkaConsumerConfig()
 	if err != nil {
 		return Config{}, err
 	}
+	materialUpload, err := loadMaterialUploadConfig()
+	if err != nil {
+		return Config{}, err
+	}
 	quotaCycleWorker, err := loadQuotaCycleWorkerConfig()
 	if err != nil {
 		return Config{}, err
 	}
+	quotaWarningWorker, err := loadQuotaWarningWorkerConfig()
+	if err != nil {
+		return Config{}, err
+	}
+	rootShelfArchiveWorker, err := loadRootShelfArchiveWorkerConfig()
+	if err != nil {
+		return Config{}, err
+	}
+	trashPurgeWorker, err := loadTrashPurgeWorkerConfig()
+	if err != nil {
+		return Config{}, err
+	}
 	storageKeySalt := os.Getenv("STORAGE_KEY_SALT")
 	if storageKeySalt == "" {
 		return Config{}, fmt.Errorf("STORAGE_KEY_SALT is required")
@@ -57,15 +103,29 @@ func LoadConfig() (Config, error) {
 	if err != nil {
 		return Config{}, err
 	}
+	yjsDocumentHistory, err := loadYjsDocumentHistoryConfig()
+	if err != nil {
+		return Config{}, err
+	}
 	return Config{
 		Postgres:                  postgres,
 		ListenAddress:             listenAddress,
+		Billing:                   billing,
 		OAuthGoogle:               oauthGoogle,
+		OAuthGithub:               oauthGithub,
+		OAuthMeta:                 oauthMeta,
+		OAuthOIDC:                 oauthOIDC,
 		OutboxRelay:               outboxRelay,
+		PayPal:                    payPal,
 		KafkaConsumer:             kafkaConsumer,
+		MaterialUpload:            materialUpload,
 		QuotaCycleWorker:          quotaCycleWorker,
+		QuotaWarningWorker:        quotaWarningWorker,
+		RootShelfArchiveWorker:    rootShelfArchiveWorker,
+		TrashPurgeWorker:          trashPurgeWorker,
 		UserDataCache:             userDataCache,
 		YjsDocumentInitialization: yjsDocumentInitialization,
+		YjsDocumentHistory:        yjsDocumentHistory,
 		StorageKeySalt:            storageKeySalt,
 	}, nil
 }
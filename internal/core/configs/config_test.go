@@ -11,6 +11,8 @@ func TestLoadConfig(t *testing.T) {
 	t.Setenv("DB_PASSWORD", "secret")
 	t.Setenv("DB_NAME", "notegic")
 	t.Setenv("DOCKER_DB_PORT", "5432")
+	t.Setenv("BILLING_GRACE_PERIOD", "168h")
+	t.Setenv("CORE_BILLING_WORKER_INTERVAL", "10m")
 	t.Setenv("OAUTH_GOOGLE_CLIENT_ID", "client-id")
 	t.Setenv("OAUTH_GOOGLE_CLIENT_SECRET", "client-secret")
 	t.Setenv("OAUTH_GOOGLE_REDIRECT_URL", "http://gateway/auth/google/callback")
@@ -26,11 +28,23 @@ func TestLoadConfig(t *testing.T) {
 	t.Setenv("KAFKA_CONSUMER_MAXIMUM_RETRY_BACKOFF", "5s")
 	t.Setenv("KAFKA_CONSUMER_MAXIMUM_POLL_RECORDS", "100")
 	t.Setenv("CORE_QUOTA_CYCLE_WORKER_INTERVAL", "24h")
+	t.Setenv("CORE_USAGE_SNAPSHOT_RETENTION", "8760h")
+	t.Setenv("CORE_QUOTA_WARNING_WORKER_INTERVAL", "5m")
+	t.Setenv("CORE_QUOTA_WARNING_EMAIL_ENABLED", "false")
+	t.Setenv("CORE_ROOT_SHELF_ARCHIVE_WORKER_POLL_INTERVAL", "5s")
+	t.Setenv("CORE_ROOT_SHELF_ARCHIVE_WORKER_CLAIM_TIMEOUT", "10m")
+	t.Setenv("CORE_TRASH_PURGE_WORKER_INTERVAL", "1h")
+	t.Setenv("CORE_TRASH_PURGE_BATCH_SIZE", "200")
+	t.Setenv("CORE_MATERIAL_UPLOAD_EXPIRATION", "24h")
+	t.Setenv("CORE_MATERIAL_UPLOAD_CLEANUP_INTERVAL", "1h")
 	t.Setenv("STORAGE_KEY_SALT", "salt")
 	t.Setenv("CORE_USER_DATA_CACHE_EXPIRES_IN", "1h")
 	t.Setenv("CORE_USER_DATA_CACHE_MAX_ROTATION_RETRIES", "5")
 	t.Setenv("YJS_DOCUMENT_INITIALIZATION_WORKER_URL", "http://notegic-yjs-worker:8787/core/yjs-document-initialization/v1")
 	t.Setenv("YJS_DOCUMENT_INITIALIZATION_WORKER_TIMEOUT", "30s")
+	t.Setenv("YJS_DOCUMENT_PROJECTION_WORKER_URL", "http://notegic-yjs-worker:8787/core/yjs-document-projection/v1")
+	t.Setenv("YJS_DOCUMENT_RESTORATION_WORKER_URL", "http://notegic-yjs-worker:8787/core/yjs-document-restoration/v1")
+	t.Setenv("YJS_DOCUMENT_HISTORY_WORKER_TIMEOUT", "30s")
 
 	config, err := LoadConfig()
 	if err != nil {
@@ -40,3 +54,49 @@ func TestLoadConfig(t *testing.T) {
 		t.Fatalf("LoadConfig() = %#v", config)
 	}
 }
+
+func TestLoadOAuthOIDCConfigRequiresAllVariables(t *testing.T) {
+	if config, err := loadOAuthOIDCConfig(); err != nil || config.OAuthConfig() != nil {
+		t.Fatalf("loadOAuthOIDCConfig() = %#v, %v, expected the provider to be disabled", config, err)
+	}
+
+	t.Setenv("OAUTH_OIDC_ISSUER_URL", "https://id.example.com/")
+	t.Setenv("OAUTH_OIDC_CLIENT_ID", "client-id")
+	if _, err := loadOAuthOIDCConfig(); err == nil {
+		t.Fatal("loadOAuthOIDCConfig() expected an error for a partially configured provider")
+	}
+
+	t.Setenv("OAUTH_OIDC_CLIENT_SECRET", "client-secret")
+	t.Setenv("OAUTH_OIDC_REDIRECT_URL", "http://gateway/auth/oidc/callback")
+	config, err := loadOAuthOIDCConfig()
+	if err != nil {
+		t.Fatalf("loadOAuthOIDCConfig() error = %v", err)
+	}
+	if config.IssuerUrl != "https://id.example.com" || config.OAuthConfig() == nil {
+		t.Fatalf("loadOAuthOIDCConfig() = %#v", config)
+	}
+}
+
+func TestLoadPayPalConfigRequiresAllVariables(t *testing.T) {
+	if config, err := loadPayPalConfig(); err != nil || config.IsConfigured() {
+		t.Fatalf("loadPayPalConfig() = %#v, %v, expected PayPal to be disabled", config, err)
+	}
+
+	t.Setenv("PAYPAL_BASE_URL", "https://api-m.sandbox.paypal.com/")
+	t.Setenv("PAYPAL_CLIENT_ID", "client-id")
+	if _, err := loadPayPalConfig(); err == nil {
+		t.Fatal("loadPayPalConfig() expected an error for a partially configured provider")
+	}
+
+	t.Setenv("PAYPAL_CLIENT_SECRET", "client-secret")
+	t.Setenv("PAYPAL_WEBHOOK_ID", "webhook-id")
+	t.Setenv("PAYPAL_RETURN_URL", "http://client/billing/approved")
+	t.Setenv("PAYPAL_CANCEL_URL", "http://client/billing/cancelled")
+	config, err := loadPayPalConfig()
+	if err != nil {
+		t.Fatalf("loadPayPalConfig() error = %v", err)
+	}
+	if config.BaseUrl != "https://api-m.sandbox.paypal.com" || !config.IsConfigured() {
+		t.Fatalf("loadPayPalConfig() = %#v", config)
+	}
+}
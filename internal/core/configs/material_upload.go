@@ -0,0 +1,35 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+type MaterialUploadConfig struct {
+	// how long an initiated upload and its presigned part URLs stay valid
+	Expiration time.Duration
+	// how often the expired uploads are aborted by the cleanup worker
+	CleanupInterval time.Duration
+}
+
+func loadMaterialUploadConfig() (MaterialUploadConfig, error) {
+	expiration, err := time.ParseDuration(
+		strings.TrimSpace(os.Getenv("CORE_MATERIAL_UPLOAD_EXPIRATION")),
+	)
+	if err != nil || expiration <= 0 {
+		return MaterialUploadConfig{}, fmt.Errorf("CORE_MATERIAL_UPLOAD_EXPIRATION must be a positive Go duration")
+	}
+	cleanupInterval, err := time.ParseDuration(
+		strings.TrimSpace(os.Getenv("CORE_MATERIAL_UPLOAD_CLEANUP_INTERVAL")),
+	)
+	if err != nil || cleanupInterval <= 0 {
+		return MaterialUploadConfig{}, fmt.Errorf("CORE_MATERIAL_UPLOAD_CLEANUP_INTERVAL must be a positive Go duration")
+	}
+
+	return MaterialUploadConfig{
+		Expiration:      expiration,
+		CleanupInterval: cleanupInterval,
+	}, nil
+}
@@ -6,6 +6,8 @@ import (
 	"strings"
 
 	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/facebook"
+	"golang.org/x/oauth2/github"
 	"golang.org/x/oauth2/google"
 )
 
@@ -40,3 +42,120 @@ func (c OAuthGoogleConfig) OAuthConfig() *oauth2.Config {
 		Endpoint: google.Endpoint,
 	}
 }
+
+// The other OAuth providers are optional, each of them is only enabled when all of its
+// variables are set, and a partially configured provider fails the startup instead.
+type OAuthGithubConfig struct {
+	ClientId     string
+	ClientSecret string
+	RedirectUrl  string
+}
+
+func loadOAuthGithubConfig() (OAuthGithubConfig, error) {
+	config := OAuthGithubConfig{
+		ClientId:     strings.TrimSpace(os.Getenv("OAUTH_GITHUB_CLIENT_ID")),
+		ClientSecret: os.Getenv("OAUTH_GITHUB_CLIENT_SECRET"),
+		RedirectUrl:  strings.TrimSpace(os.Getenv("OAUTH_GITHUB_REDIRECT_URL")),
+	}
+	if !isAllOrNoneSet(config.ClientId, config.ClientSecret, config.RedirectUrl) {
+		return OAuthGithubConfig{}, fmt.Errorf("OAUTH_GITHUB_CLIENT_ID, OAUTH_GITHUB_CLIENT_SECRET, and OAUTH_GITHUB_REDIRECT_URL must be set together")
+	}
+
+	return config, nil
+}
+
+// OAuthConfig returns nil if the provider is not configured.
+func (c OAuthGithubConfig) OAuthConfig() *oauth2.Config {
+	if c.ClientId == "" {
+		return nil
+	}
+	return &oauth2.Config{
+		ClientID:     c.ClientId,
+		ClientSecret: c.ClientSecret,
+		RedirectURL:  c.RedirectUrl,
+		Scopes:       []string{"read:user", "user:email"},
+		Endpoint:     github.Endpoint,
+	}
+}
+
+type OAuthMetaConfig struct {
+	ClientId     string
+	ClientSecret string
+	RedirectUrl  string
+}
+
+func loadOAuthMetaConfig() (OAuthMetaConfig, error) {
+	config := OAuthMetaConfig{
+		ClientId:     strings.TrimSpace(os.Getenv("OAUTH_META_CLIENT_ID")),
+		ClientSecret: os.Getenv("OAUTH_META_CLIENT_SECRET"),
+		RedirectUrl:  strings.TrimSpace(os.Getenv("OAUTH_META_REDIRECT_URL")),
+	}
+	if !isAllOrNoneSet(config.ClientId, config.ClientSecret, config.RedirectUrl) {
+		return OAuthMetaConfig{}, fmt.Errorf("OAUTH_META_CLIENT_ID, OAUTH_META_CLIENT_SECRET, and OAUTH_META_REDIRECT_URL must be set together")
+	}
+
+	return config, nil
+}
+
+// OAuthConfig returns nil if the provider is not configured.
+func (c OAuthMetaConfig) OAuthConfig() *oauth2.Config {
+	if c.ClientId == "" {
+		return nil
+	}
+	return &oauth2.Config{
+		ClientID:     c.ClientId,
+		ClientSecret: c.ClientSecret,
+		RedirectURL:  c.RedirectUrl,
+		Scopes:       []string{"email", "public_profile"},
+		Endpoint:     facebook.Endpoint,
+	}
+}
+
+// The endpoints of the generic OpenID Connect provider are discovered from its issuer.
+type OAuthOIDCConfig struct {
+	IssuerUrl    string
+	ClientId     string
+	ClientSecret string
+	RedirectUrl  string
+}
+
+func loadOAuthOIDCConfig() (OAuthOIDCConfig, error) {
+	config := OAuthOIDCConfig{
+		IssuerUrl:    strings.TrimRight(strings.TrimSpace(os.Getenv("OAUTH_OIDC_ISSUER_URL")), "/"),
+		ClientId:     strings.TrimSpace(os.Getenv("OAUTH_OIDC_CLIENT_ID")),
+		ClientSecret: os.Getenv("OAUTH_OIDC_CLIENT_SECRET"),
+		RedirectUrl:  strings.TrimSpace(os.Getenv("OAUTH_OIDC_REDIRECT_URL")),
+	}
+	if !isAllOrNoneSet(config.IssuerUrl, config.ClientId, config.ClientSecret, config.RedirectUrl) {
+		return OAuthOIDCConfig{}, fmt.Errorf("OAUTH_OIDC_ISSUER_URL, OAUTH_OIDC_CLIENT_ID, OAUTH_OIDC_CLIENT_SECRET, and OAUTH_OIDC_REDIRECT_URL must be set together")
+	}
+	if config.IssuerUrl != "" && !strings.HasPrefix(config.IssuerUrl, "https://") {
+		return OAuthOIDCConfig{}, fmt.Errorf("OAUTH_OIDC_ISSUER_URL must use https")
+	}
+
+	return config, nil
+}
+
+// OAuthConfig returns nil if the provider is not configured, the endpoint is left
+// empty until it is discovered.
+func (c OAuthOIDCConfig) OAuthConfig() *oauth2.Config {
+	if c.ClientId == "" {
+		return nil
+	}
+	return &oauth2.Config{
+		ClientID:     c.ClientId,
+		ClientSecret: c.ClientSecret,
+		RedirectURL:  c.RedirectUrl,
+		Scopes:       []string{"openid", "email", "profile"},
+	}
+}
+
+func isAllOrNoneSet(values ...string) bool {
+	setCount := 0
+	for _, value := range values {
+		if value != "" {
+			setCount++
+		}
+	}
+	return setCount == 0 || setCount == len(values)
+}
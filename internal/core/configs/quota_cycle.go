@@ -9,6 +9,8 @@ import (
 
 type QuotaCycleWorkerConfig struct {
 	Interval time.Duration
+	// the daily usage snapshots older than the retention are removed by the worker
+	UsageSnapshotRetention time.Duration
 }
 
 func loadQuotaCycleWorkerConfig() (QuotaCycleWorkerConfig, error) {
@@ -18,8 +20,15 @@ func loadQuotaCycleWorkerConfig() (QuotaCycleWorkerConfig, error) {
 	if err != nil || interval <= 0 {
 		return QuotaCycleWorkerConfig{}, fmt.Errorf("CORE_QUOTA_CYCLE_WORKER_INTERVAL must be a positive Go duration")
 	}
+	usageSnapshotRetention, err := time.ParseDuration(
+		strings.TrimSpace(os.Getenv("CORE_USAGE_SNAPSHOT_RETENTION")),
+	)
+	if err != nil || usageSnapshotRetention < 24*time.Hour {
+		return QuotaCycleWorkerConfig{}, fmt.Errorf("CORE_USAGE_SNAPSHOT_RETENTION must be a Go duration of at least 24h")
+	}
 
 	return QuotaCycleWorkerConfig{
-		Interval: interval,
+		Interval:               interval,
+		UsageSnapshotRetention: usageSnapshotRetention,
 	}, nil
 }
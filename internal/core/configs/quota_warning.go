@@ -0,0 +1,35 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type QuotaWarningWorkerConfig struct {
+	Interval time.Duration
+	// the warnings are delivered as notifications to the users who keep them on, and also emailed when enabled
+	EmailEnabled bool
+}
+
+func loadQuotaWarningWorkerConfig() (QuotaWarningWorkerConfig, error) {
+	interval, err := time.ParseDuration(
+		strings.TrimSpace(os.Getenv("CORE_QUOTA_WARNING_WORKER_INTERVAL")),
+	)
+	if err != nil || interval <= 0 {
+		return QuotaWarningWorkerConfig{}, fmt.Errorf("CORE_QUOTA_WARNING_WORKER_INTERVAL must be a positive Go duration")
+	}
+	emailEnabled, err := strconv.ParseBool(
+		strings.TrimSpace(os.Getenv("CORE_QUOTA_WARNING_EMAIL_ENABLED")),
+	)
+	if err != nil {
+		return QuotaWarningWorkerConfig{}, fmt.Errorf("CORE_QUOTA_WARNING_EMAIL_ENABLED must be a boolean")
+	}
+
+	return QuotaWarningWorkerConfig{
+		Interval:     interval,
+		EmailEnabled: emailEnabled,
+	}, nil
+}
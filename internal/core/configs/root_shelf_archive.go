@@ -0,0 +1,33 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+type RootShelfArchiveWorkerConfig struct {
+	PollInterval time.Duration
+	ClaimTimeout time.Duration
+}
+
+func loadRootShelfArchiveWorkerConfig() (RootShelfArchiveWorkerConfig, error) {
+	pollInterval, err := time.ParseDuration(
+		strings.TrimSpace(os.Getenv("CORE_ROOT_SHELF_ARCHIVE_WORKER_POLL_INTERVAL")),
+	)
+	if err != nil || pollInterval <= 0 {
+		return RootShelfArchiveWorkerConfig{}, fmt.Errorf("CORE_ROOT_SHELF_ARCHIVE_WORKER_POLL_INTERVAL must be a positive Go duration")
+	}
+	claimTimeout, err := time.ParseDuration(
+		strings.TrimSpace(os.Getenv("CORE_ROOT_SHELF_ARCHIVE_WORKER_CLAIM_TIMEOUT")),
+	)
+	if err != nil || claimTimeout <= 0 {
+		return RootShelfArchiveWorkerConfig{}, fmt.Errorf("CORE_ROOT_SHELF_ARCHIVE_WORKER_CLAIM_TIMEOUT must be a positive Go duration")
+	}
+
+	return RootShelfArchiveWorkerConfig{
+		PollInterval: pollInterval,
+		ClaimTimeout: claimTimeout,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type TrashPurgeWorkerConfig struct {
+	Interval time.Duration
+	// the maximum number of the expired trash items purged in one run
+	BatchSize int
+}
+
+func loadTrashPurgeWorkerConfig() (TrashPurgeWorkerConfig, error) {
+	interval, err := time.ParseDuration(
+		strings.TrimSpace(os.Getenv("CORE_TRASH_PURGE_WORKER_INTERVAL")),
+	)
+	if err != nil || interval <= 0 {
+		return TrashPurgeWorkerConfig{}, fmt.Errorf("CORE_TRASH_PURGE_WORKER_INTERVAL must be a positive Go duration")
+	}
+	batchSize, err := strconv.Atoi(
+		strings.TrimSpace(os.Getenv("CORE_TRASH_PURGE_BATCH_SIZE")),
+	)
+	if err != nil || batchSize <= 0 {
+		return TrashPurgeWorkerConfig{}, fmt.Errorf("CORE_TRASH_PURGE_BATCH_SIZE must be a positive integer")
+	}
+
+	return TrashPurgeWorkerConfig{
+		Interval:  interval,
+		BatchSize: batchSize,
+	}, nil
+}
@@ -28,3 +28,30 @@ func loadYjsDocumentInitializationConfig() (YjsDocumentInitializationConfig, err
 
 	return config, nil
 }
+
+type YjsDocumentHistoryConfig struct {
+	ProjectionEndpoint  string
+	RestorationEndpoint string
+	Timeout             time.Duration
+}
+
+func loadYjsDocumentHistoryConfig() (YjsDocumentHistoryConfig, error) {
+	config := YjsDocumentHistoryConfig{
+		ProjectionEndpoint:  strings.TrimRight(strings.TrimSpace(os.Getenv("YJS_DOCUMENT_PROJECTION_WORKER_URL")), "/"),
+		RestorationEndpoint: strings.TrimRight(strings.TrimSpace(os.Getenv("YJS_DOCUMENT_RESTORATION_WORKER_URL")), "/"),
+	}
+	if config.ProjectionEndpoint == "" {
+		return YjsDocumentHistoryConfig{}, fmt.Errorf("YJS_DOCUMENT_PROJECTION_WORKER_URL is required")
+	}
+	if config.RestorationEndpoint == "" {
+		return YjsDocumentHistoryConfig{}, fmt.Errorf("YJS_DOCUMENT_RESTORATION_WORKER_URL is required")
+	}
+
+	timeout, err := time.ParseDuration(strings.TrimSpace(os.Getenv("YJS_DOCUMENT_HISTORY_WORKER_TIMEOUT")))
+	if err != nil || timeout <= 0 {
+		return YjsDocumentHistoryConfig{}, fmt.Errorf("YJS_DOCUMENT_HISTORY_WORKER_TIMEOUT must be a positive Go duration")
+	}
+	config.Timeout = timeout
+
+	return config, nil
+}
@@ -27,6 +27,10 @@ func WithAPIKeyId(ctx context.Context, apiKeyId string) context.Context {
 	return sharedcontexts.WithValue(ctx, sharedcontexts.ContextFieldName_API_Key_Id, apiKeyId)
 }
 
+func WithAPIKeyScope(ctx context.Context, apiKeyScope string) context.Context {
+	return sharedcontexts.WithValue(ctx, sharedcontexts.ContextFieldName_API_Key_Scope, apiKeyScope)
+}
+
 func WithDelegationMetadata(ctx context.Context, claims *sharedtokens.DelegationTokenClaims) context.Context {
 	if claims == nil {
 		return ctx
@@ -44,6 +48,9 @@ func WithDelegationMetadata(ctx context.Context, claims *sharedtokens.Delegation
 	if claims.ApiKeyId != "" {
 		ctx = WithAPIKeyId(ctx, claims.ApiKeyId)
 	}
+	if claims.APIKeyScope != "" {
+		ctx = WithAPIKeyScope(ctx, claims.APIKeyScope)
+	}
 	return ctx
 }
 
@@ -66,7 +73,7 @@ func IsClientGateway(ctx context.Context) bool {
 
 func GetAuthMethod(ctx context.Context) (string, *exceptions.Exception) {
 	method, err := sharedcontexts.GetValue[string](ctx, sharedcontexts.ContextFieldName_Auth_Method)
-	if err != nil || (method != sharedtokens.AuthMethodJWT && method != sharedtokens.AuthMethodAPIKey) {
+	if err != nil || (method != sharedtokens.AuthMethodJWT && method != sharedtokens.AuthMethodAPIKey && method != sharedtokens.AuthMethodShareLink) {
 		return "", exceptions.New(
 			"DelegationClaimsInvalid", "API", "ReadAuthMethod",
 			"The verified delegation context does not contain a valid authentication method",
@@ -88,6 +95,18 @@ func GetAPIKeyId(ctx context.Context) (string, *exceptions.Exception) {
 	return apiKeyId, nil
 }
 
+func GetAPIKeyScope(ctx context.Context) (string, *exceptions.Exception) {
+	apiKeyScope, err := sharedcontexts.GetValue[string](ctx, sharedcontexts.ContextFieldName_API_Key_Scope)
+	if err != nil || apiKeyScope == "" {
+		return "", exceptions.New(
+			"DelegationClaimsInvalid", "API", "ReadAPIKeyScope",
+			"The verified delegation context does not contain a valid API key scope",
+			http.StatusInternalServerError, true,
+		).WithOrigin(err)
+	}
+	return apiKeyScope, nil
+}
+
 func WithAllowedPermissions(
 	ctx context.Context,
 	allowedPermissions []enums.AccessControlPermission,
@@ -123,6 +142,14 @@ func WithActorUserPublicId(ctx context.Context, actorUserPublicId uuid.UUID) con
 	)
 }
 
+func WithActorSessionId(ctx context.Context, actorSessionId uuid.UUID) context.Context {
+	return sharedcontexts.WithValue(
+		ctx,
+		sharedcontexts.ContextFieldName_User_SessionId,
+		actorSessionId,
+	)
+}
+
 func GetAllowedPermissions(
 	ctx context.Context,
 ) ([]enums.AccessControlPermission, *exceptions.Exception) {
@@ -200,3 +227,22 @@ func GetActorUserPublicId(ctx context.Context) (uuid.UUID, *exceptions.Exception
 
 	return actorUserPublicId, nil
 }
+
+func GetActorSessionId(ctx context.Context) (uuid.UUID, *exceptions.Exception) {
+	actorSessionId, err := sharedcontexts.GetValue[uuid.UUID](
+		ctx,
+		sharedcontexts.ContextFieldName_User_SessionId,
+	)
+	if err != nil || actorSessionId == uuid.Nil {
+		return uuid.Nil, exceptions.New(
+			"DelegationClaimsInvalid",
+			"API",
+			"ReadActorSessionId",
+			"The authenticated context does not contain a valid actor session ID",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(err)
+	}
+
+	return actorSessionId, nil
+}
@@ -0,0 +1,40 @@
+package contexts
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+
+	sharedcontexts "github.com/HiIamJeff67/notegic-backend/shared/lib/contexts"
+)
+
+// APIKeyRestriction narrows an API key to some root shelves or stations. An
+// empty list leaves that kind of resource unrestricted.
+type APIKeyRestriction struct {
+	RootShelfIds []uuid.UUID
+	StationIds   []uuid.UUID
+}
+
+func WithAPIKeyRestriction(ctx context.Context, restriction APIKeyRestriction) context.Context {
+	return sharedcontexts.WithValue(
+		ctx,
+		sharedcontexts.ContextFieldName_API_Key_Restriction,
+		APIKeyRestriction{
+			RootShelfIds: slices.Clone(restriction.RootShelfIds),
+			StationIds:   slices.Clone(restriction.StationIds),
+		},
+	)
+}
+
+func GetOptionalAPIKeyRestriction(ctx context.Context) *APIKeyRestriction {
+	if ctx == nil {
+		return nil
+	}
+	restriction, err := sharedcontexts.GetValue[APIKeyRestriction](ctx, sharedcontexts.ContextFieldName_API_Key_Restriction)
+	if err != nil {
+		return nil
+	}
+
+	return &restriction
+}
@@ -0,0 +1,47 @@
+package contexts
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	sharedcontexts "github.com/HiIamJeff67/notegic-backend/shared/lib/contexts"
+	sharedtokens "github.com/HiIamJeff67/notegic-backend/shared/tokens"
+)
+
+// ShareLinkRestriction narrows a share session to the root shelf of its share
+// link, or to a single block pack of it if BlockPackId is set. ExpiresAt is the
+// expiration of the share session rather than the share link.
+type ShareLinkRestriction struct {
+	ShareLinkId uuid.UUID
+	RootShelfId uuid.UUID
+	BlockPackId *uuid.UUID
+	Permission  string
+	ExpiresAt   time.Time
+}
+
+func WithShareLinkRestriction(ctx context.Context, restriction ShareLinkRestriction) context.Context {
+	if restriction.BlockPackId != nil {
+		blockPackId := *restriction.BlockPackId
+		restriction.BlockPackId = &blockPackId
+	}
+	return sharedcontexts.WithValue(ctx, sharedcontexts.ContextFieldName_Share_Link, restriction)
+}
+
+func GetOptionalShareLinkRestriction(ctx context.Context) *ShareLinkRestriction {
+	if ctx == nil {
+		return nil
+	}
+	restriction, err := sharedcontexts.GetValue[ShareLinkRestriction](ctx, sharedcontexts.ContextFieldName_Share_Link)
+	if err != nil {
+		return nil
+	}
+
+	return &restriction
+}
+
+func IsShareLinkSession(ctx context.Context) bool {
+	method, err := sharedcontexts.GetValue[string](ctx, sharedcontexts.ContextFieldName_Auth_Method)
+	return err == nil && method == sharedtokens.AuthMethodShareLink
+}
@@ -13,29 +13,38 @@ import (
 	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
 	logs "github.com/HiIamJeff67/notegic-backend/shared/platform/observability/logs"
 	platformredis "github.com/HiIamJeff67/notegic-backend/shared/platform/redis"
+	sharedtokens "github.com/HiIamJeff67/notegic-backend/shared/tokens"
 )
 
-const defaultCacheExpiresIn = 5 * time.Minute
+const (
+	defaultCacheExpiresIn           = 5 * time.Minute
+	defaultRateLimitPolicyExpiresIn = 30 * time.Minute
+)
 
 type APIKeyCache struct {
-	Id           uuid.UUID  `json:"id"`
-	UserId       uuid.UUID  `json:"userId"`
-	UserPublicId uuid.UUID  `json:"userPublicId"`
-	ExpiresAt    *time.Time `json:"expiresAt"`
-	RevokedAt    *time.Time `json:"revokedAt"`
+	Id           uuid.UUID   `json:"id"`
+	UserId       uuid.UUID   `json:"userId"`
+	UserPublicId uuid.UUID   `json:"userPublicId"`
+	Scopes       []string    `json:"scopes"`
+	RootShelfIds []uuid.UUID `json:"rootShelfIds"`
+	StationIds   []uuid.UUID `json:"stationIds"`
+	ExpiresAt    *time.Time  `json:"expiresAt"`
+	RevokedAt    *time.Time  `json:"revokedAt"`
 }
 
 type APIKeyCacheClient struct {
-	cacheStore *APIKeyCacheStore
-	expiresIn  time.Duration
+	cacheStore               *APIKeyCacheStore
+	expiresIn                time.Duration
+	rateLimitPolicyExpiresIn time.Duration
 }
 
 /* ============================== Constructor ============================== */
 
 func NewAPIKeyCacheClient(cacheStore *APIKeyCacheStore) *APIKeyCacheClient {
 	return &APIKeyCacheClient{
-		cacheStore: cacheStore,
-		expiresIn:  defaultCacheExpiresIn,
+		cacheStore:               cacheStore,
+		expiresIn:                defaultCacheExpiresIn,
+		rateLimitPolicyExpiresIn: defaultRateLimitPolicyExpiresIn,
 	}
 }
 
@@ -72,6 +81,12 @@ func (s *APIKeyCacheClient) formatAPIKeyCacheKey(keyHash string) string {
 	return fmt.Sprintf("%s:%s", platformredis.CachePurpose_APIKey.String(), keyHash)
 }
 
+// the rate limit policy is read by APIGateway, so its key must stay in sync with
+// the APIGateway api key rate limit policy cache client
+func (s *APIKeyCacheClient) formatAPIKeyRateLimitPolicyCacheKey(keyHash string) string {
+	return fmt.Sprintf("%s:%s", platformredis.CachePurpose_APIKeyRateLimitPolicy.String(), keyHash)
+}
+
 /* ============================== CRUD Method ============================== */
 
 func (s *APIKeyCacheClient) Get(keyHash string) (*APIKeyCache, *exceptions.Exception) {
@@ -164,3 +179,41 @@ func (s *APIKeyCacheClient) Delete(keyHash string) *exceptions.Exception {
 	logs.NotegicLogger.Debug(context.Background(), fmt.Sprintf("Successfully deleted cached API key from Redis shard %d", shardIndex))
 	return nil
 }
+
+/* ============================== Rate Limit Policy Method ============================== */
+
+// SetRateLimitPolicy publishes the policy APIGateway enforces for the key. It
+// outlives the API key cache entry, so it is refreshed whenever Core loads the
+// key from the database while the key is in use.
+func (s *APIKeyCacheClient) SetRateLimitPolicy(keyHash string, policy sharedtokens.APIKeyRateLimitPolicy) *exceptions.Exception {
+	redisClient, shardIndex, exception := s.getRedisClient(keyHash)
+	if exception != nil {
+		return exception
+	}
+
+	value, err := json.Marshal(policy)
+	if err != nil {
+		return exceptions.New(
+			"SerializationFailed",
+			"Cache",
+			"SetAPIKeyRateLimitPolicy",
+			"Failed to encode API key rate limit policy",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(err)
+	}
+
+	if err := redisClient.Set(s.formatAPIKeyRateLimitPolicyCacheKey(keyHash), string(value), s.rateLimitPolicyExpiresIn).Err(); err != nil {
+		return exceptions.New(
+			"FailedToCreate",
+			"Cache",
+			"SetAPIKeyRateLimitPolicy",
+			"Failed to store API key rate limit policy",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(err)
+	}
+
+	logs.NotegicLogger.Debug(context.Background(), fmt.Sprintf("Successfully set API key rate limit policy in Redis shard %d", shardIndex))
+	return nil
+}
@@ -0,0 +1,18 @@
+package inputs
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	enums "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/enums"
+)
+
+type CreateBillingInvoiceInput struct {
+	UsersToBillingPlansId uuid.UUID
+	BillingPlanId         string
+	ProviderPaymentId     string
+	Amount                string
+	CurrencyCode          enums.SupportedCurrencyCode
+	PaidAt                time.Time
+}
@@ -0,0 +1,16 @@
+package inputs
+
+import (
+	"github.com/google/uuid"
+
+	enums "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/enums"
+)
+
+type CreateBlockPackYjsSnapshotInput struct {
+	BlockPackId    uuid.UUID                   `json:"blockPackId" gorm:"column:block_pack_id;"`
+	Kind           enums.BlockPackSnapshotKind `json:"kind" gorm:"column:kind;"`
+	Name           *string                     `json:"name" gorm:"column:name;"`
+	State          []byte                      `json:"state" gorm:"column:state;"`
+	UpdateSequence int64                       `json:"updateSequence" gorm:"column:update_sequence;"`
+	CreatorId      *uuid.UUID                  `json:"creatorId" gorm:"column:creator_id;"`
+}
@@ -16,12 +16,14 @@ type CreateMaterialInput struct {
 }
 
 type UpdateMaterialInput struct {
-	ParentSubShelfId *uuid.UUID                 `json:"parentSubShelfId" gorm:"column:parent_sub_shelf_id;"`
-	Name             *string                    `json:"name" gorm:"column:name;"`
-	Size             *int64                     `json:"size" gorm:"column:size;"`
-	ContentKey       *string                    `json:"contentKey" gorm:"column:content_key;"`
-	ContentType      *enums.MaterialContentType `json:"contentType" gorm:"column:content_type;"`
-	ParseMediaType   string                     `json:"parseMediaType" gorm:"column:parse_media_type;"`
+	ParentSubShelfId *uuid.UUID                      `json:"parentSubShelfId" gorm:"column:parent_sub_shelf_id;"`
+	Name             *string                         `json:"name" gorm:"column:name;"`
+	Size             *int64                          `json:"size" gorm:"column:size;"`
+	ContentKey       *string                         `json:"contentKey" gorm:"column:content_key;"`
+	ContentType      *enums.MaterialContentType      `json:"contentType" gorm:"column:content_type;"`
+	ParseMediaType   string                          `json:"parseMediaType" gorm:"column:parse_media_type;"`
+	SearchText       *string                         `json:"searchText" gorm:"column:search_text;"`
+	ProcessingStatus *enums.MaterialProcessingStatus `json:"processingStatus" gorm:"column:processing_status;"`
 }
 
 type PartialUpdateMaterialInput = PartialUpdateInput[UpdateMaterialInput]
@@ -37,3 +39,9 @@ type BulkDeleteMaterialInput struct {
 	UserId uuid.UUID `json:"userId" gorm:"column:user_id;"`
 	Id     uuid.UUID `json:"id" gorm:"column:id;"`
 }
+
+type FinishMaterialProcessingInput struct {
+	ProcessingStatus enums.MaterialProcessingStatus `json:"processingStatus" gorm:"column:processing_status;"`
+	SearchText       *string                        `json:"searchText" gorm:"column:search_text;"`
+	PreviewKey       *string                        `json:"previewKey" gorm:"column:preview_key;"`
+}
@@ -0,0 +1,21 @@
+package inputs
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	enums "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/enums"
+)
+
+type CreateMaterialUploadInput struct {
+	Id              uuid.UUID                 `json:"id" gorm:"column:id;"`
+	MaterialId      uuid.UUID                 `json:"materialId" gorm:"column:material_id;"`
+	ContentKey      string                    `json:"contentKey" gorm:"column:content_key;"`
+	StorageUploadId string                    `json:"storageUploadId" gorm:"column:storage_upload_id;"`
+	Size            int64                     `json:"size" gorm:"column:size;"`
+	ContentType     enums.MaterialContentType `json:"contentType" gorm:"column:content_type;"`
+	PartSize        int64                     `json:"partSize" gorm:"column:part_size;"`
+	PartCount       int32                     `json:"partCount" gorm:"column:part_count;"`
+	ExpiresAt       time.Time                 `json:"expiresAt" gorm:"column:expires_at;"`
+}
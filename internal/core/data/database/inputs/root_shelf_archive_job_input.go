@@ -0,0 +1,28 @@
+package inputs
+
+import (
+	"github.com/google/uuid"
+
+	enums "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/enums"
+)
+
+type CreateRootShelfArchiveJobInput struct {
+	Id                  *uuid.UUID                    `json:"id" gorm:"column:id;"`
+	Type                enums.RootShelfArchiveJobType `json:"type" gorm:"column:type;"`
+	SourceRootShelfId   *uuid.UUID                    `json:"sourceRootShelfId" gorm:"column:source_root_shelf_id;"`
+	TargetRootShelfId   *uuid.UUID                    `json:"targetRootShelfId" gorm:"column:target_root_shelf_id;"`
+	TargetRootShelfName *string                       `json:"targetRootShelfName" gorm:"column:target_root_shelf_name;"`
+	ArchiveKey          string                        `json:"archiveKey" gorm:"column:archive_key;"`
+	ArchiveSize         int64                         `json:"archiveSize" gorm:"column:archive_size;"`
+}
+
+/* ============================== System Only Input ============================== */
+
+type UpdateRootShelfArchiveJobProgressInput struct {
+	ProcessedItemCount   int64      `json:"processedItemCount" gorm:"column:processed_item_count;"`
+	TotalItemCount       int64      `json:"totalItemCount" gorm:"column:total_item_count;"`
+	LastNotifiedProgress *int32     `json:"lastNotifiedProgress" gorm:"column:last_notified_progress;"`
+	SourceRootShelfId    *uuid.UUID `json:"sourceRootShelfId" gorm:"column:source_root_shelf_id;"`
+	TargetRootShelfId    *uuid.UUID `json:"targetRootShelfId" gorm:"column:target_root_shelf_id;"`
+	ArchiveSize          *int64     `json:"archiveSize" gorm:"column:archive_size;"`
+}
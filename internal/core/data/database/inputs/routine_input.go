@@ -17,6 +17,7 @@ type CreateRoutineInput struct {
 	ScheduledStartAt *time.Time           `json:"scheduledStartAt" gorm:"column:scheduled_start_at;"`
 	ScheduledEndAt   *time.Time           `json:"scheduledEndAt" gorm:"column:scheduled_end_at;"`
 	Period           *enums.RoutinePeriod `json:"period" gorm:"column:period;"`
+	RecurrenceRule   *string              `json:"recurrenceRule" gorm:"column:recurrence_rule;"`
 	Timezone         *string              `json:"timezone" gorm:"column:timezone;"`
 }
 
@@ -30,6 +31,7 @@ type CreateRoutineByStationIdInput struct {
 	ScheduledStartAt *time.Time           `json:"scheduledStartAt" gorm:"column:scheduled_start_at;"`
 	ScheduledEndAt   *time.Time           `json:"scheduledEndAt" gorm:"column:scheduled_end_at;"`
 	Period           *enums.RoutinePeriod `json:"period" gorm:"column:period;"`
+	RecurrenceRule   *string              `json:"recurrenceRule" gorm:"column:recurrence_rule;"`
 	Timezone         *string              `json:"timezone" gorm:"column:timezone;"`
 }
 
@@ -42,6 +44,7 @@ type UpdateRoutineInput struct {
 	ScheduledStartAt *time.Time           `json:"scheduledStartAt" gorm:"column:scheduled_start_at;"`
 	ScheduledEndAt   *time.Time           `json:"scheduledEndAt" gorm:"column:scheduled_end_at;"`
 	Period           *enums.RoutinePeriod `json:"period" gorm:"column:period;"`
+	RecurrenceRule   *string              `json:"recurrenceRule" gorm:"column:recurrence_rule;"`
 	Timezone         *string              `json:"timezone" gorm:"column:timezone;"`
 }
 
@@ -70,6 +73,7 @@ type BulkCreateRoutineInput struct {
 	ScheduledStartAt *time.Time           `json:"scheduledStartAt" gorm:"column:scheduled_start_at;"`
 	ScheduledEndAt   *time.Time           `json:"scheduledEndAt" gorm:"column:scheduled_end_at;"`
 	Period           *enums.RoutinePeriod `json:"period" gorm:"column:period;"`
+	RecurrenceRule   *string              `json:"recurrenceRule" gorm:"column:recurrence_rule;"`
 	Timezone         *string              `json:"timezone" gorm:"column:timezone;"`
 }
 
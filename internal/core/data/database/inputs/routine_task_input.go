@@ -17,6 +17,7 @@ type CreateRoutineTaskInput struct {
 	Priority        int32                    `json:"priority" gorm:"column:priority;"`
 	MaxAttempts     int32                    `json:"maxAttempts" gorm:"column:max_attempts;"`
 	Period          *enums.RoutinePeriod     `json:"period" gorm:"column:period;"`
+	RecurrenceRule  *string                  `json:"recurrenceRule" gorm:"column:recurrence_rule;"`
 	NextScheduledAt time.Time                `json:"nextScheduledAt" gorm:"column:next_scheduled_at;"`
 	ScheduledAt     time.Time                `json:"scheduledAt" gorm:"column:scheduled_at;"`
 }
@@ -30,20 +31,23 @@ type CreateRoutineTaskByRoutineIdInput struct {
 	Priority        int32                    `json:"priority" gorm:"column:priority;"`
 	MaxAttempts     int32                    `json:"maxAttempts" gorm:"column:max_attempts;"`
 	Period          *enums.RoutinePeriod     `json:"period" gorm:"column:period;"`
+	RecurrenceRule  *string                  `json:"recurrenceRule" gorm:"column:recurrence_rule;"`
 	NextScheduledAt time.Time                `json:"nextScheduledAt" gorm:"column:next_scheduled_at;"`
 	ScheduledAt     time.Time                `json:"scheduledAt" gorm:"column:scheduled_at;"`
 }
 
 type UpdateRoutineTaskInput struct {
-	RoutineId       *uuid.UUID                `json:"routineId" gorm:"column:routine_id;"`
-	Title           *string                   `json:"title" gorm:"column:title;"`
-	Purpose         *enums.RoutineTaskPurpose `json:"purpose" gorm:"column:purpose;"`
-	Payload         *datatypes.JSON           `json:"payload" gorm:"column:payload;"`
-	Priority        *int32                    `json:"priority" gorm:"column:priority;"`
-	MaxAttempts     *int32                    `json:"maxAttempts" gorm:"column:max_attempts;"`
-	Period          *enums.RoutinePeriod      `json:"period" gorm:"column:period;"`
-	NextScheduledAt *time.Time                `json:"nextScheduledAt" gorm:"column:next_scheduled_at;"`
-	ScheduledAt     *time.Time                `json:"scheduledAt" gorm:"column:scheduled_at;"`
+	RoutineId         *uuid.UUID                `json:"routineId" gorm:"column:routine_id;"`
+	Title             *string                   `json:"title" gorm:"column:title;"`
+	Purpose           *enums.RoutineTaskPurpose `json:"purpose" gorm:"column:purpose;"`
+	Payload           *datatypes.JSON           `json:"payload" gorm:"column:payload;"`
+	Priority          *int32                    `json:"priority" gorm:"column:priority;"`
+	MaxAttempts       *int32                    `json:"maxAttempts" gorm:"column:max_attempts;"`
+	Period            *enums.RoutinePeriod      `json:"period" gorm:"column:period;"`
+	RecurrenceRule    *string                   `json:"recurrenceRule" gorm:"column:recurrence_rule;"`
+	NextScheduledAt   *time.Time                `json:"nextScheduledAt" gorm:"column:next_scheduled_at;"`
+	ScheduledAt       *time.Time                `json:"scheduledAt" gorm:"column:scheduled_at;"`
+	RecurrenceStartAt *time.Time                `json:"recurrenceStartAt" gorm:"column:recurrence_start_at;"`
 }
 
 type PartialUpdateRoutineTaskInput = PartialUpdateInput[UpdateRoutineTaskInput]
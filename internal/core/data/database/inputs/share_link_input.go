@@ -0,0 +1,19 @@
+package inputs
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	enums "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/enums"
+)
+
+type CreateShareLinkInput struct {
+	TokenHash    string                        `json:"tokenHash" gorm:"column:token_hash;"`
+	RootShelfId  uuid.UUID                     `json:"rootShelfId" gorm:"column:root_shelf_id;"`
+	BlockPackId  *uuid.UUID                    `json:"blockPackId" gorm:"column:block_pack_id;"`
+	Permission   enums.AccessControlPermission `json:"permission" gorm:"column:permission;"`
+	PasswordHash *string                       `json:"passwordHash" gorm:"column:password_hash;"`
+	MaxUseCount  *int32                        `json:"maxUseCount" gorm:"column:max_use_count;"`
+	ExpiresAt    *time.Time                    `json:"expiresAt" gorm:"column:expires_at;"`
+}
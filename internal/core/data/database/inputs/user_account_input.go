@@ -14,6 +14,9 @@ type CreateUserAccountInput struct {
 	PhoneNumber       *string            `json:"phoneNumber" gorm:"column:phone_number;"`
 	GoogleCredential  *string            `json:"googleCredential" gorm:"column:google_credential;"`
 	DiscordCredential *string            `json:"discordCredential" gorm:"column:discord_credential;"`
+	GithubCredential  *string            `json:"githubCredential" gorm:"column:github_credential;"`
+	MetaCredential    *string            `json:"metaCredential" gorm:"column:meta_credential;"`
+	OIDCCredential    *string            `json:"oidcCredential" gorm:"column:oidc_credential;"`
 }
 
 type UpdateUserAccountInput struct {
@@ -25,6 +28,9 @@ type UpdateUserAccountInput struct {
 	PhoneNumber        *string            `json:"phoneNumber" gorm:"column:phone_number;"`
 	GoogleCredential   *string            `json:"googleCredential" gorm:"column:google_credential;"`
 	DiscordCredential  *string            `json:"discordCredential" gorm:"column:discord_credential;"`
+	GithubCredential   *string            `json:"githubCredential" gorm:"column:github_credential;"`
+	MetaCredential     *string            `json:"metaCredential" gorm:"column:meta_credential;"`
+	OIDCCredential     *string            `json:"oidcCredential" gorm:"column:oidc_credential;"`
 }
 
 type PartialUpdateUserAccountInput = PartialUpdateInput[UpdateUserAccountInput]
@@ -7,12 +7,11 @@ import (
 )
 
 type CreateUserInput struct {
-	Name         string `json:"name" gorm:"column:name;"`
-	DisplayName  string `json:"displayName" gorm:"column:display_name"`
-	Email        string `json:"email" gorm:"column:email;"`
-	Password     string `json:"password" gorm:"column:password;"` // hashed password
-	RefreshToken string `json:"refreshToken" gorm:"column:refresh_token;"`
-	UserAgent    string `json:"userAgent" gorm:"column:user_agent;"`
+	Name        string `json:"name" gorm:"column:name;"`
+	DisplayName string `json:"displayName" gorm:"column:display_name"`
+	Email       string `json:"email" gorm:"column:email;"`
+	Password    string `json:"password" gorm:"column:password;"` // hashed password
+	UserAgent   string `json:"userAgent" gorm:"column:user_agent;"`
 }
 
 type UpdateUserInput struct {
@@ -20,7 +19,6 @@ type UpdateUserInput struct {
 	DisplayName    *string           `json:"displayName" gorm:"column:display_name;"`
 	Email          *string           `json:"email" gorm:"column:email;"`
 	Password       *string           `json:"password" gorm:"column:password;"`
-	RefreshToken   *string           `json:"refreshToken" gorm:"column:refresh_token;"`
 	LoginCount     *int32            `json:"loginCount" gorm:"column:login_count;"`
 	BlockLoginUtil *time.Time        `json:"blockLoginUntil" gorm:"column:block_login_until"`
 	UserAgent      *string           `json:"userAgent" gorm:"column:user_agent;"`
@@ -0,0 +1,16 @@
+package inputs
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateUserSessionInput struct {
+	Id               uuid.UUID `json:"id" gorm:"column:id;"`
+	DeviceLabel      string    `json:"deviceLabel" gorm:"column:device_label;"`
+	UserAgent        string    `json:"userAgent" gorm:"column:user_agent;"`
+	IpAddress        string    `json:"ipAddress" gorm:"column:ip_address;"`
+	RefreshTokenHash string    `json:"refreshTokenHash" gorm:"column:refresh_token_hash;"`
+	ExpiresAt        time.Time `json:"expiresAt" gorm:"column:expires_at;"`
+}
@@ -0,0 +1,5 @@
+package inputs
+
+type CreateUserTwoFactorInput struct {
+	EncryptedSecret string `json:"encryptedSecret" gorm:"column:encrypted_secret;"`
+}
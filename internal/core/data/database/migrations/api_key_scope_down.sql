@@ -0,0 +1,5 @@
+ALTER TABLE "APIKeyTable" DROP COLUMN IF EXISTS "station_ids";
+-- ============================== SQL Separator ==============================
+ALTER TABLE "APIKeyTable" DROP COLUMN IF EXISTS "root_shelf_ids";
+-- ============================== SQL Separator ==============================
+ALTER TABLE "APIKeyTable" DROP COLUMN IF EXISTS "scopes";
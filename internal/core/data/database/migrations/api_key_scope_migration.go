@@ -0,0 +1,20 @@
+package migrations
+
+import (
+	_ "embed"
+
+	platformpostgres "github.com/HiIamJeff67/notegic-backend/shared/platform/postgres"
+)
+
+//go:embed api_key_scope_up.sql
+var apiKeyScopeUpSQL string
+
+//go:embed api_key_scope_down.sql
+var apiKeyScopeDownSQL string
+
+var addAPIKeyScopeColumnsMigration = platformpostgres.VersionedMigration{
+	Version: 4,
+	Name:    "add_api_key_scope_columns",
+	UpSQL:   apiKeyScopeUpSQL,
+	DownSQL: apiKeyScopeDownSQL,
+}
@@ -0,0 +1,5 @@
+ALTER TABLE "APIKeyTable" ADD COLUMN "scopes" text[] NOT NULL DEFAULT '{}';
+-- ============================== SQL Separator ==============================
+ALTER TABLE "APIKeyTable" ADD COLUMN "root_shelf_ids" uuid[] NOT NULL DEFAULT '{}';
+-- ============================== SQL Separator ==============================
+ALTER TABLE "APIKeyTable" ADD COLUMN "station_ids" uuid[] NOT NULL DEFAULT '{}';
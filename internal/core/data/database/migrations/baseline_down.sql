@@ -0,0 +1,77 @@
+-- dropping the tables cascades to their views, triggers and constraints
+DROP TABLE IF EXISTS "UsersToBillingPlansTable" CASCADE;
+DROP TABLE IF EXISTS "BillingPlanTable" CASCADE;
+DROP TABLE IF EXISTS "OutboxEventTable" CASCADE;
+DROP TABLE IF EXISTS "InboxEventTable" CASCADE;
+DROP TABLE IF EXISTS "RoutineTaskRecordTable" CASCADE;
+DROP TABLE IF EXISTS "RoutineTaskTable" CASCADE;
+DROP TABLE IF EXISTS "RoutinesToItemsTable" CASCADE;
+DROP TABLE IF EXISTS "UsersToStationsTable" CASCADE;
+DROP TABLE IF EXISTS "RoutinesToTagsTable" CASCADE;
+DROP TABLE IF EXISTS "RoutineTagTable" CASCADE;
+DROP TABLE IF EXISTS "RoutineTable" CASCADE;
+DROP TABLE IF EXISTS "StationTable" CASCADE;
+DROP TABLE IF EXISTS "ItemTable" CASCADE;
+DROP TABLE IF EXISTS "BlockTable" CASCADE;
+DROP TABLE IF EXISTS "BlockPackYjsUpdateTable" CASCADE;
+DROP TABLE IF EXISTS "BlockPackYjsDocumentTable" CASCADE;
+DROP TABLE IF EXISTS "BlockPackTable" CASCADE;
+DROP TABLE IF EXISTS "MaterialTable" CASCADE;
+DROP TABLE IF EXISTS "SubShelfTable" CASCADE;
+DROP TABLE IF EXISTS "UsersToShelvesTable" CASCADE;
+DROP TABLE IF EXISTS "RootShelfTable" CASCADE;
+DROP TABLE IF EXISTS "ThemeTable" CASCADE;
+DROP TABLE IF EXISTS "UsersToBadgesTable" CASCADE;
+DROP TABLE IF EXISTS "BadgeTable" CASCADE;
+DROP TABLE IF EXISTS "APIKeyTable" CASCADE;
+DROP TABLE IF EXISTS "UserSettingTable" CASCADE;
+DROP TABLE IF EXISTS "UserQuotaTable" CASCADE;
+DROP TABLE IF EXISTS "UserAccountTable" CASCADE;
+DROP TABLE IF EXISTS "UserInfoTable" CASCADE;
+DROP TABLE IF EXISTS "UserTable" CASCADE;
+DROP TABLE IF EXISTS "PlanLimitationTable" CASCADE;
+-- ============================== SQL Separator ==============================
+-- drop the trigger and search functions created by the baseline
+DO $$
+DECLARE
+    function_signature regprocedure;
+BEGIN
+    FOR function_signature IN
+        SELECT p.oid::regprocedure
+        FROM pg_proc AS p
+        INNER JOIN pg_namespace AS n ON n.oid = p.pronamespace
+        LEFT JOIN pg_depend AS d ON d.objid = p.oid AND d.deptype = 'e'
+        WHERE n.nspname = 'public'
+          AND p.prokind = 'f'
+          AND d.objid IS NULL
+    LOOP
+        EXECUTE format('DROP FUNCTION IF EXISTS %s CASCADE;', function_signature);
+    END LOOP;
+END $$;
+-- ============================== SQL Separator ==============================
+DROP TYPE IF EXISTS "AccessControlPermission";
+DROP TYPE IF EXISTS "BadgeType";
+DROP TYPE IF EXISTS "BillingIntervalUnit";
+DROP TYPE IF EXISTS "BillingPlanName";
+DROP TYPE IF EXISTS "BillingPlanStatus";
+DROP TYPE IF EXISTS "BlockType";
+DROP TYPE IF EXISTS "Country";
+DROP TYPE IF EXISTS "CountryCode";
+DROP TYPE IF EXISTS "ItemType";
+DROP TYPE IF EXISTS "Language";
+DROP TYPE IF EXISTS "MaterialContentType";
+DROP TYPE IF EXISTS "RoutinePeriod";
+DROP TYPE IF EXISTS "RoutineStatus";
+DROP TYPE IF EXISTS "RoutineTaskPurpose";
+DROP TYPE IF EXISTS "RoutineTaskRecordErrorCode";
+DROP TYPE IF EXISTS "RoutineTaskRecordStatus";
+DROP TYPE IF EXISTS "RoutineTaskStatus";
+DROP TYPE IF EXISTS "SupportedCurrencyCode";
+DROP TYPE IF EXISTS "SupportedIcon";
+DROP TYPE IF EXISTS "UserGender";
+DROP TYPE IF EXISTS "UserPlan";
+DROP TYPE IF EXISTS "UserRole";
+DROP TYPE IF EXISTS "UserSettingDensity";
+DROP TYPE IF EXISTS "UserSettingStartSurface";
+DROP TYPE IF EXISTS "UserStatus";
+DROP TYPE IF EXISTS "UsersToBillingPlansStatus";
@@ -0,0 +1,37 @@
+package migrations
+
+import (
+	_ "embed"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	platformpostgres "github.com/HiIamJeff67/notegic-backend/shared/platform/postgres"
+)
+
+// reverting the baseline drops every table of the Core, so the migrate command
+// only does it when it is explicitly allowed to destroy the baseline
+const BaselineVersion int64 = 1
+
+//go:embed baseline_up.sql
+var baselineUpSQL string
+
+//go:embed baseline_down.sql
+var baselineDownSQL string
+
+// the baseline is the frozen schema of the Core before the versioned migrations were introduced,
+// the databases created before them by the automatic migration on every start already have this schema,
+// so only its version is recorded for them, and the later versions bring them to the latest schema
+var baselineMigration = platformpostgres.VersionedMigration{
+	Version: BaselineVersion,
+	Name:    "baseline",
+	UpSQL:   baselineUpSQL,
+	DownSQL: baselineDownSQL,
+	IsAdopted: func(tx *gorm.DB) (bool, error) {
+		var exists bool
+		if err := tx.Raw(`SELECT to_regclass('"UserTable"') IS NOT NULL;`).Scan(&exists).Error; err != nil {
+			return false, fmt.Errorf("check the existing baseline: %w", err)
+		}
+		return exists, nil
+	},
+}
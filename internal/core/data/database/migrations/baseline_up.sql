@@ -0,0 +1,1723 @@
+-- the schema of the Core before the versioned migrations were introduced, frozen so that the
+-- baseline never follows the schema structs, change the schema with a new versioned migration instead
+CREATE TYPE "AccessControlPermission" AS ENUM ('Read', 'Write', 'Admin', 'Owner');
+-- ============================== SQL Separator ==============================
+CREATE TYPE "BadgeType" AS ENUM ('Diamond', 'Golden', 'Silver', 'Bronze', 'Steel');
+-- ============================== SQL Separator ==============================
+CREATE TYPE "BillingIntervalUnit" AS ENUM ('DAY', 'WEEK', 'MONTH', 'YEAR');
+-- ============================== SQL Separator ==============================
+CREATE TYPE "BillingPlanName" AS ENUM ('Notegic Monthly Free Plan', 'Notegic Monthly Pro Plan', 'Notegic Yearly Pro Plan', 'Notegic Monthly Premium Plan', 'Notegic Yearly Premium Plan', 'Notegic Monthly Ultimate Plan', 'Notegic Yearly Ultimate Plan', 'Notegic Monthly Enterprise Plan', 'Notegic Yearly Enterprise Plan');
+-- ============================== SQL Separator ==============================
+CREATE TYPE "BillingPlanStatus" AS ENUM ('CREATED', 'ACTIVE', 'INACTIVE');
+-- ============================== SQL Separator ==============================
+CREATE TYPE "BlockType" AS ENUM ('paragraph', 'quote', 'heading', 'bulletListItem', 'numberedListItem', 'checkListItem', 'toggleListItem', 'image', 'video', 'audio', 'file', 'table', 'codeBlock', 'mathBlock', 'diagram', 'calendar');
+-- ============================== SQL Separator ==============================
+CREATE TYPE "Country" AS ENUM ('Taiwan', 'Japan', 'Malaysia', 'Singapore', 'China', 'UnitedStatesOfAmerica', 'UnitedKingdom', 'Australia', 'Canada');
+-- ============================== SQL Separator ==============================
+CREATE TYPE "CountryCode" AS ENUM ('+886', '+81', '+60', '+65', '+86', '+1', '+44', '+61');
+-- ============================== SQL Separator ==============================
+CREATE TYPE "ItemType" AS ENUM ('BlockPack', 'Material');
+-- ============================== SQL Separator ==============================
+CREATE TYPE "Language" AS ENUM ('English', 'TraditionalChinese', 'SimpleChinese', 'Japanese', 'Korean');
+-- ============================== SQL Separator ==============================
+CREATE TYPE "MaterialContentType" AS ENUM ('none', 'application/json', 'application/pdf', 'text/plain', 'text/html', 'text/markdown', 'image/png', 'image/jpg', 'image/jpeg', 'image/gif', 'image/svg+xml', 'image/webp', 'video/mp4', 'video/webm', 'audio/mpeg');
+-- ============================== SQL Separator ==============================
+CREATE TYPE "RoutinePeriod" AS ENUM ('Daily', 'Weekly', 'Monthly');
+-- ============================== SQL Separator ==============================
+CREATE TYPE "RoutineStatus" AS ENUM ('Scheduled', 'InProgress', 'Completed', 'OverDue');
+-- ============================== SQL Separator ==============================
+CREATE TYPE "RoutineTaskPurpose" AS ENUM ('CreateRootShelf', 'UpdateRootShelf', 'ResetRootShelf', 'CreateSubShelf', 'UpdateSubShelf', 'ResetSubShelf', 'CreateBlockPack', 'UpdateBlockPack', 'ResetBlockPack', 'AppendBlock', 'UpdateBlock', 'ResetBlock', 'CreateRoutine', 'UpdateRoutine');
+-- ============================== SQL Separator ==============================
+CREATE TYPE "RoutineTaskRecordErrorCode" AS ENUM ('PermissionDenied', 'PayloadInvalid', 'TargetNotFound', 'PlanLimitExceeded', 'HandlerFailed', 'DatabaseError', 'Timeout', 'Canceled', 'Unknown');
+-- ============================== SQL Separator ==============================
+CREATE TYPE "RoutineTaskRecordStatus" AS ENUM ('Running', 'Success', 'Failed', 'Cancel');
+-- ============================== SQL Separator ==============================
+CREATE TYPE "RoutineTaskStatus" AS ENUM ('Idle', 'Waiting', 'Running', 'Pause');
+-- ============================== SQL Separator ==============================
+CREATE TYPE "SupportedCurrencyCode" AS ENUM ('USD', 'EUR', 'JPY', 'TWD', 'KRW', 'CNY');
+-- ============================== SQL Separator ==============================
+CREATE TYPE "SupportedIcon" AS ENUM ('😀', '😊', '❤️', '🔥', '⭐', '📚', '📓', '📝', '💡', '🚀', '✅', '📌', '📂', '📅', '⏰');
+-- ============================== SQL Separator ==============================
+CREATE TYPE "UserGender" AS ENUM ('Male', 'Female', 'PreferNotToSay');
+-- ============================== SQL Separator ==============================
+CREATE TYPE "UserPlan" AS ENUM ('Enterprise', 'Ultimate', 'Premium', 'Pro', 'Free');
+-- ============================== SQL Separator ==============================
+CREATE TYPE "UserRole" AS ENUM ('Admin', 'Normal', 'Guest');
+-- ============================== SQL Separator ==============================
+CREATE TYPE "UserSettingDensity" AS ENUM ('Comfortable', 'Balanced', 'Compact');
+-- ============================== SQL Separator ==============================
+CREATE TYPE "UserSettingStartSurface" AS ENUM ('Dashboard', 'Routines');
+-- ============================== SQL Separator ==============================
+CREATE TYPE "UserStatus" AS ENUM ('Online', 'AFK', 'DoNotDisturb', 'Offline');
+-- ============================== SQL Separator ==============================
+CREATE TYPE "UsersToBillingPlansStatus" AS ENUM ('APPROVAL_PENDING', 'APPROVED', 'ACTIVE', 'SUSPENDED', 'CANCELLED', 'EXPIRED');
+-- ============================== SQL Separator ==============================
+CREATE TABLE "PlanLimitationTable" ("key" "UserPlan","max_root_shelf_count" integer NOT NULL,"max_block_pack_count" integer NOT NULL,"max_block_count" integer NOT NULL,"max_material_count" integer NOT NULL,"max_work_flow_count" integer NOT NULL,"max_additional_item_count" integer NOT NULL,"max_sub_shelf_count_per_root_shelf" integer NOT NULL,"max_item_count_per_root_shelf" integer NOT NULL,"max_block_count_per_block_pack" integer NOT NULL,"max_material_size" bigint NOT NULL,"max_station_count" integer NOT NULL,"max_routine_tag_count" integer NOT NULL,"max_routine_count_per_station" integer NOT NULL,"max_routine_task_cost_unit_count" integer NOT NULL,"max_routine_task_attempts" integer NOT NULL,"max_realtime_room_subscriber_count" integer NOT NULL DEFAULT 0,"updated_at" timestamptz NOT NULL,"created_at" timestamptz NOT NULL,PRIMARY KEY ("key"));
+-- ============================== SQL Separator ==============================
+CREATE TABLE "UserTable" ("id" uuid DEFAULT gen_random_uuid(),"public_id" uuid NOT NULL DEFAULT gen_random_uuid(),"name" varchar(32) NOT NULL,"display_name" varchar(32) NOT NULL,"email" text NOT NULL,"password" varchar(1024) NOT NULL,"refresh_token" text NOT NULL,"login_count" integer NOT NULL DEFAULT 0,"block_login_until" timestamptz NOT NULL,"user_agent" text NOT NULL,"role" "UserRole" NOT NULL DEFAULT 'Guest',"plan" "UserPlan" NOT NULL DEFAULT 'Free',"prev_status" "UserStatus" NOT NULL DEFAULT 'Online',"status" "UserStatus" NOT NULL DEFAULT 'Online',"updated_at" timestamptz NOT NULL,"created_at" timestamptz NOT NULL,PRIMARY KEY ("id"),CONSTRAINT "uni_UserTable_public_id" UNIQUE ("public_id"),CONSTRAINT "uni_UserTable_name" UNIQUE ("name"),CONSTRAINT "uni_UserTable_email" UNIQUE ("email"));
+-- ============================== SQL Separator ==============================
+CREATE TABLE "UserInfoTable" ("id" uuid DEFAULT gen_random_uuid(),"user_id" uuid NOT NULL,"cover_background_url" text DEFAULT null,"avatar_url" text DEFAULT null,"header" varchar(64),"introduction" varchar(256),"gender" "UserGender" NOT NULL DEFAULT 'PreferNotToSay',"country" "Country","birth_date" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,"updated_at" timestamptz NOT NULL,PRIMARY KEY ("id"),CONSTRAINT "uni_UserInfoTable_user_id" UNIQUE ("user_id"));
+-- ============================== SQL Separator ==============================
+CREATE TABLE "UserAccountTable" ("id" uuid NOT NULL DEFAULT gen_random_uuid(),"user_id" uuid NOT NULL,"auth_code" text NOT NULL,"auth_code_expired_at" timestamptz NOT NULL,"block_auth_code_until" timestamptz NOT NULL,"country_code" "CountryCode","backup_email" text,"phone_number" text,"google_credential" text,"discord_credential" text,"root_shelf_count" bigint NOT NULL DEFAULT 0,"block_pack_count" bigint NOT NULL DEFAULT 0,"block_count" bigint NOT NULL DEFAULT 0,"material_count" bigint NOT NULL DEFAULT 0,"workflow_count" bigint NOT NULL DEFAULT 0,"additional_item_count" bigint NOT NULL DEFAULT 0,"station_count" bigint NOT NULL DEFAULT 0,"routine_count" bigint NOT NULL DEFAULT 0,"routine_tag_count" bigint NOT NULL DEFAULT 0,"updated_at" timestamptz NOT NULL,PRIMARY KEY ("id"),CONSTRAINT "uni_UserAccountTable_user_id" UNIQUE ("user_id"),CONSTRAINT "uni_UserAccountTable_backup_email" UNIQUE ("backup_email"),CONSTRAINT "uni_UserAccountTable_phone_number" UNIQUE ("phone_number"),CONSTRAINT "uni_UserAccountTable_google_credential" UNIQUE ("google_credential"),CONSTRAINT "uni_UserAccountTable_discord_credential" UNIQUE ("discord_credential"),CONSTRAINT "user_account_check_max_routine_count" CHECK (routine_count <= 100000),CONSTRAINT "user_account_check_max_station_count" CHECK (station_count <= 200));
+-- ============================== SQL Separator ==============================
+CREATE TABLE "UserQuotaTable" ("id" uuid DEFAULT gen_random_uuid(),"user_id" uuid NOT NULL,"routine_task_cost_unit_used" bigint NOT NULL DEFAULT 0,"cycle_started_at" timestamptz NOT NULL,"next_reset_at" timestamptz NOT NULL,"updated_at" timestamptz NOT NULL,"created_at" timestamptz NOT NULL,PRIMARY KEY ("id"),CONSTRAINT "uni_UserQuotaTable_user_id" UNIQUE ("user_id"),CONSTRAINT "user_quota_check_routine_task_cost_unit_used_non_negative" CHECK (routine_task_cost_unit_used >= 0));
+-- ============================== SQL Separator ==============================
+CREATE INDEX IF NOT EXISTS "idx_UserQuotaTable_next_reset_at" ON "UserQuotaTable" ("next_reset_at");
+-- ============================== SQL Separator ==============================
+CREATE TABLE "UserSettingTable" ("id" uuid DEFAULT gen_random_uuid(),"user_id" uuid NOT NULL,"language" "Language" NOT NULL DEFAULT 'English',"density" "UserSettingDensity" NOT NULL DEFAULT 'Balanced',"start_surface" "UserSettingStartSurface" NOT NULL DEFAULT 'Dashboard',"reduce_motion" boolean NOT NULL DEFAULT false,"line_wrap" boolean NOT NULL DEFAULT true,"quick_insert" boolean NOT NULL DEFAULT true,"private_previews" boolean NOT NULL DEFAULT false,"routine_nudges" boolean NOT NULL DEFAULT true,"sync_notifications" boolean NOT NULL DEFAULT true,"quiet_mode" boolean NOT NULL DEFAULT true,"quiet_mode_start_minute" bigint NOT NULL DEFAULT 1320,"quiet_mode_end_minute" bigint NOT NULL DEFAULT 480,"updated_at" timestamptz NOT NULL,PRIMARY KEY ("id"),CONSTRAINT "uni_UserSettingTable_user_id" UNIQUE ("user_id"));
+-- ============================== SQL Separator ==============================
+CREATE TABLE "APIKeyTable" ("id" uuid DEFAULT gen_random_uuid(),"public_id" uuid NOT NULL DEFAULT gen_random_uuid(),"user_id" uuid NOT NULL,"name" varchar(64) NOT NULL,"key_prefix" varchar(16) NOT NULL,"key_hash" varchar(64) NOT NULL,"last_used_at" timestamptz,"expires_at" timestamptz,"revoked_at" timestamptz,"created_at" timestamptz NOT NULL,"updated_at" timestamptz NOT NULL,PRIMARY KEY ("id"),CONSTRAINT "uni_APIKeyTable_public_id" UNIQUE ("public_id"),CONSTRAINT "uni_APIKeyTable_key_hash" UNIQUE ("key_hash"));
+-- ============================== SQL Separator ==============================
+CREATE INDEX IF NOT EXISTS "idx_APIKeyTable_revoked_at" ON "APIKeyTable" ("revoked_at");
+-- ============================== SQL Separator ==============================
+CREATE INDEX IF NOT EXISTS "idx_APIKeyTable_key_prefix" ON "APIKeyTable" ("key_prefix");
+-- ============================== SQL Separator ==============================
+CREATE INDEX IF NOT EXISTS "idx_APIKeyTable_user_id" ON "APIKeyTable" ("user_id");
+-- ============================== SQL Separator ==============================
+CREATE TABLE "BadgeTable" ("id" uuid DEFAULT gen_random_uuid(),"public_id" uuid NOT NULL DEFAULT gen_random_uuid(),"title" varchar(64) NOT NULL,"description" varchar(256) NOT NULL,"type" "BadgeType" NOT NULL DEFAULT 'Bronze',"image_url" text,"created_at" timestamptz NOT NULL,PRIMARY KEY ("id"),CONSTRAINT "uni_BadgeTable_public_id" UNIQUE ("public_id"));
+-- ============================== SQL Separator ==============================
+CREATE TABLE "UsersToBadgesTable" ("user_id" uuid,"badge_id" uuid,"created_at" timestamptz NOT NULL,PRIMARY KEY ("user_id","badge_id"));
+-- ============================== SQL Separator ==============================
+CREATE TABLE "ThemeTable" ("id" uuid DEFAULT gen_random_uuid(),"public_id" uuid NOT NULL DEFAULT gen_random_uuid(),"author_id" uuid NOT NULL,"name" varchar(128) NOT NULL,"is_dark" boolean NOT NULL DEFAULT true,"version" text NOT NULL,"is_default" boolean NOT NULL,"download_url" text,"download_count" bigint,"created_at" timestamptz NOT NULL,"updated_at" timestamptz NOT NULL,PRIMARY KEY ("id"),CONSTRAINT "uni_ThemeTable_public_id" UNIQUE ("public_id"),CONSTRAINT "uni_ThemeTable_name" UNIQUE ("name"));
+-- ============================== SQL Separator ==============================
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_ThemeTable_author_id" ON "ThemeTable" ("author_id");
+-- ============================== SQL Separator ==============================
+CREATE TABLE "RootShelfTable" ("id" uuid DEFAULT gen_random_uuid(),"owner_id" uuid NOT NULL,"name" varchar(128) NOT NULL DEFAULT 'undefined',"sub_shelf_count" bigint NOT NULL DEFAULT 0,"item_count" bigint NOT NULL DEFAULT 0,"last_analyzed_at" timestamptz NOT NULL DEFAULT NOW(),"deleted_at" timestamptz DEFAULT null,"updated_at" timestamptz NOT NULL,"created_at" timestamptz NOT NULL,PRIMARY KEY ("id"));
+-- ============================== SQL Separator ==============================
+CREATE TABLE "UsersToShelvesTable" ("user_id" uuid,"root_shelf_id" uuid,"permission" "AccessControlPermission" NOT NULL DEFAULT 'Read',"updated_at" timestamptz NOT NULL,"created_at" timestamptz NOT NULL,PRIMARY KEY ("user_id","root_shelf_id"));
+-- ============================== SQL Separator ==============================
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_root_shelf_owner" ON "UsersToShelvesTable" ("root_shelf_id") WHERE permission = 'Owner';
+-- ============================== SQL Separator ==============================
+CREATE TABLE "SubShelfTable" ("id" uuid DEFAULT gen_random_uuid(),"name" varchar(128) NOT NULL DEFAULT 'undefined',"root_shelf_id" uuid NOT NULL,"prev_sub_shelf_id" uuid,"path" uuid[] NOT NULL DEFAULT '{}',"deleted_at" timestamptz DEFAULT null,"updated_at" timestamptz NOT NULL,"created_at" timestamptz NOT NULL,PRIMARY KEY ("id"),CONSTRAINT "sub_shelf_check_prev_sub_shelf_id" CHECK (prev_sub_shelf_id != id),CONSTRAINT "sub_shelf_check_path_length" CHECK (cardinality(path) >= 0 AND cardinality(path) <= 100));
+-- ============================== SQL Separator ==============================
+CREATE TABLE "MaterialTable" ("id" uuid NOT NULL,"parent_sub_shelf_id" uuid NOT NULL,"name" varchar(128) NOT NULL DEFAULT 'undefined',"size" bigint NOT NULL DEFAULT 0,"content_key" text NOT NULL,"content_type" "MaterialContentType" NOT NULL DEFAULT 'none',"parse_media_type" varchar(128) NOT NULL DEFAULT '',"deleted_at" timestamptz DEFAULT null,"updated_at" timestamptz NOT NULL,"created_at" timestamptz NOT NULL,PRIMARY KEY ("id"),CONSTRAINT "uni_MaterialTable_content_key" UNIQUE ("content_key"));
+-- ============================== SQL Separator ==============================
+CREATE TABLE "BlockPackTable" ("id" uuid NOT NULL DEFAULT gen_random_uuid(),"parent_sub_shelf_id" uuid NOT NULL,"name" varchar(128) NOT NULL DEFAULT 'undefined',"icon" "SupportedIcon" DEFAULT null,"header_background_url" text DEFAULT null,"block_count" bigint NOT NULL DEFAULT 0,"deleted_at" timestamptz DEFAULT null,"updated_at" timestamptz NOT NULL,"created_at" timestamptz NOT NULL,PRIMARY KEY ("id"),CONSTRAINT "block_pack_check_max_block_count" CHECK (block_count <= 1000));
+-- ============================== SQL Separator ==============================
+CREATE TABLE "BlockPackYjsDocumentTable" ("id" uuid NOT NULL DEFAULT gen_random_uuid(),"block_pack_id" uuid NOT NULL,"snapshot" bytea NOT NULL DEFAULT '\x',"state_vector" bytea NOT NULL DEFAULT '\x',"last_update_sequence" bigint NOT NULL DEFAULT 0,"compacted_until_sequence" bigint NOT NULL DEFAULT 0,"last_compacted_at" timestamptz DEFAULT null,"projected_until_sequence" bigint NOT NULL DEFAULT -1,"deleted_at" timestamptz DEFAULT null,"updated_at" timestamptz NOT NULL,"created_at" timestamptz NOT NULL,PRIMARY KEY ("id"));
+-- ============================== SQL Separator ==============================
+CREATE UNIQUE INDEX IF NOT EXISTS "block_pack_yjs_document_idx_block_pack_id" ON "BlockPackYjsDocumentTable" ("block_pack_id");
+-- ============================== SQL Separator ==============================
+CREATE TABLE "BlockPackYjsUpdateTable" ("id" uuid NOT NULL DEFAULT gen_random_uuid(),"block_pack_id" uuid NOT NULL,"update_sequence" bigint NOT NULL,"persistence_batch_id" uuid NOT NULL DEFAULT gen_random_uuid(),"payload" bytea NOT NULL,"origin_connection_id" uuid DEFAULT null,"origin_client_id" text DEFAULT null,"compacted_at" timestamptz DEFAULT null,"created_at" timestamptz NOT NULL,PRIMARY KEY ("id"));
+-- ============================== SQL Separator ==============================
+CREATE UNIQUE INDEX IF NOT EXISTS "block_pack_yjs_update_idx_block_pack_id_persistence_batch_id" ON "BlockPackYjsUpdateTable" ("persistence_batch_id");
+-- ============================== SQL Separator ==============================
+CREATE UNIQUE INDEX IF NOT EXISTS "block_pack_yjs_update_idx_block_pack_id_update_sequence" ON "BlockPackYjsUpdateTable" ("block_pack_id","update_sequence");
+-- ============================== SQL Separator ==============================
+CREATE TABLE "BlockTable" ("id" uuid NOT NULL DEFAULT gen_random_uuid(),"block_pack_id" uuid NOT NULL,"parent_block_id" uuid,"prev_block_id" uuid,"next_block_id" uuid,"type" "BlockType" NOT NULL DEFAULT 'paragraph',"props" JSONB NOT NULL DEFAULT '{}',"content" JSONB DEFAULT '{}',"updated_at" timestamptz NOT NULL,"created_at" timestamptz NOT NULL,PRIMARY KEY ("id"),CONSTRAINT "block_check_parent_block_id_is_not_itself" CHECK (parent_block_id != id),CONSTRAINT "block_check_prev_block_id_is_not_itself" CHECK (prev_block_id != id),CONSTRAINT "block_check_props_size" CHECK (octet_length(props::text) <= 4096),CONSTRAINT "block_check_next_block_id_is_not_itself" CHECK (next_block_id != id),CONSTRAINT "block_check_content_size" CHECK (octet_length(content::text) <= 16384));
+-- ============================== SQL Separator ==============================
+CREATE TABLE "ItemTable" ("id" uuid,"parent_sub_shelf_id" uuid NOT NULL,"root_shelf_id" uuid NOT NULL,"type" "ItemType","deleted_at" timestamptz DEFAULT null,"updated_at" timestamptz NOT NULL,"created_at" timestamptz NOT NULL,PRIMARY KEY ("id","type"));
+-- ============================== SQL Separator ==============================
+CREATE TABLE "StationTable" ("id" uuid DEFAULT gen_random_uuid(),"owner_id" uuid NOT NULL,"name" varchar(128) NOT NULL DEFAULT 'undefined',"description" varchar(1024) NOT NULL DEFAULT '',"icon" "SupportedIcon" DEFAULT null,"header_background_url" text DEFAULT null,"routine_count" bigint NOT NULL DEFAULT 0,"deleted_at" timestamptz DEFAULT null,"updated_at" timestamptz NOT NULL,"created_at" timestamptz NOT NULL,PRIMARY KEY ("id"),CONSTRAINT "station_check_max_routine_count" CHECK (routine_count <= 500));
+-- ============================== SQL Separator ==============================
+CREATE TABLE "RoutineTable" ("id" uuid DEFAULT gen_random_uuid(),"station_id" uuid NOT NULL,"title" text NOT NULL DEFAULT 'undefined',"description" varchar(1024) NOT NULL DEFAULT '',"status" "RoutineStatus" NOT NULL DEFAULT 'Scheduled',"is_pinned" boolean NOT NULL DEFAULT false,"scheduled_start_at" timestamptz NOT NULL DEFAULT NOW(),"scheduled_end_at" timestamptz NOT NULL DEFAULT NOW() + INTERVAL '1 hour',"period" "RoutinePeriod" DEFAULT null,"timezone" varchar(64) NOT NULL DEFAULT 'UTC',"deleted_at" timestamptz DEFAULT null,"updated_at" timestamptz NOT NULL,"created_at" timestamptz NOT NULL,PRIMARY KEY ("id"));
+-- ============================== SQL Separator ==============================
+CREATE UNIQUE INDEX IF NOT EXISTS "routine_idx_id_station_id" ON "RoutineTable" ("id","station_id");
+-- ============================== SQL Separator ==============================
+CREATE TABLE "RoutineTagTable" ("id" uuid DEFAULT gen_random_uuid(),"owner_id" uuid NOT NULL,"name" text NOT NULL DEFAULT 'undefined',"color" varchar(7) NOT NULL DEFAULT '#FFFFFF',"icon" "SupportedIcon" DEFAULT null,"updated_at" timestamptz NOT NULL,"created_at" timestamptz NOT NULL,PRIMARY KEY ("id"),CONSTRAINT "routine_tag_check_color_hex_code" CHECK (color ~ '^#[0-9A-Fa-f]{6}$'));
+-- ============================== SQL Separator ==============================
+CREATE INDEX IF NOT EXISTS "idx_RoutineTagTable_owner_id" ON "RoutineTagTable" ("owner_id");
+-- ============================== SQL Separator ==============================
+CREATE UNIQUE INDEX IF NOT EXISTS "routine_tag_idx_id_owner_id" ON "RoutineTagTable" ("id","owner_id");
+-- ============================== SQL Separator ==============================
+CREATE TABLE "RoutinesToTagsTable" ("routine_id" uuid,"tag_id" uuid,"user_id" uuid NOT NULL,"station_id" uuid NOT NULL,"created_at" timestamptz NOT NULL,PRIMARY KEY ("routine_id","tag_id"));
+-- ============================== SQL Separator ==============================
+CREATE INDEX IF NOT EXISTS "routines_to_tags_idx_user_id_station_id" ON "RoutinesToTagsTable" ("user_id","station_id");
+-- ============================== SQL Separator ==============================
+CREATE INDEX IF NOT EXISTS "routines_to_tags_idx_user_id_routine_id" ON "RoutinesToTagsTable" ("user_id","routine_id");
+-- ============================== SQL Separator ==============================
+CREATE TABLE "UsersToStationsTable" ("user_id" uuid,"station_id" uuid,"permission" "AccessControlPermission" NOT NULL DEFAULT 'Read',"updated_at" timestamptz NOT NULL,"created_at" timestamptz NOT NULL,PRIMARY KEY ("user_id","station_id"));
+-- ============================== SQL Separator ==============================
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_station_owner" ON "UsersToStationsTable" ("station_id") WHERE permission = 'Owner';
+-- ============================== SQL Separator ==============================
+CREATE TABLE "RoutinesToItemsTable" ("routine_id" uuid,"item_id" uuid,"type" "ItemType","created_at" timestamptz NOT NULL,PRIMARY KEY ("routine_id","item_id","type"));
+-- ============================== SQL Separator ==============================
+CREATE TABLE "RoutineTaskTable" ("id" uuid DEFAULT gen_random_uuid(),"routine_id" uuid NOT NULL,"actor_user_id" uuid NOT NULL,"title" varchar(128) NOT NULL DEFAULT 'undefined',"purpose" "RoutineTaskPurpose" NOT NULL DEFAULT 'CreateBlockPack',"payload" JSONB NOT NULL DEFAULT '{}',"cost_unit" bigint NOT NULL DEFAULT 0,"priority" integer NOT NULL DEFAULT 0,"status" "RoutineTaskStatus" NOT NULL DEFAULT 'Idle',"attempts" integer NOT NULL DEFAULT 0,"max_attempts" integer NOT NULL DEFAULT 1,"period" "RoutinePeriod" DEFAULT null,"next_scheduled_at" timestamptz NOT NULL DEFAULT NOW(),"scheduled_at" timestamptz NOT NULL DEFAULT NOW(),"actual_started_at" timestamptz DEFAULT null,"actual_ended_at" timestamptz DEFAULT null,"updated_at" timestamptz NOT NULL,"created_at" timestamptz NOT NULL,PRIMARY KEY ("id"),CONSTRAINT "routine_task_check_priority_validation" CHECK (priority >= 0 AND priority <= 100),CONSTRAINT "routine_task_check_attempts_non_negative" CHECK (attempts >= 0),CONSTRAINT "routine_task_check_max_attempts_non_negative" CHECK (max_attempts > 0),CONSTRAINT "routine_task_check_cost_unit_non_negative" CHECK (cost_unit >= 0),CONSTRAINT "routine_task_check_payload_size" CHECK (octet_length(payload::text) <= 16777216));
+-- ============================== SQL Separator ==============================
+CREATE TABLE "RoutineTaskRecordTable" ("id" uuid DEFAULT gen_random_uuid(),"routine_task_id" uuid NOT NULL,"purpose" "RoutineTaskPurpose" NOT NULL DEFAULT 'CreateBlockPack',"status" "RoutineTaskRecordStatus" NOT NULL DEFAULT 'Running',"error_code" "RoutineTaskRecordErrorCode" DEFAULT null,"error_reason" varchar(256) DEFAULT null,"cost_unit" bigint NOT NULL DEFAULT 0,"total_attempts" bigint NOT NULL DEFAULT 0,"scheduled_at" timestamptz NOT NULL DEFAULT NOW(),"actual_started_at" timestamptz DEFAULT null,"actual_ended_at" timestamptz DEFAULT null,"updated_at" timestamptz NOT NULL,"created_at" timestamptz NOT NULL,PRIMARY KEY ("id"),CONSTRAINT "routine_task_record_cost_unit_non_negative" CHECK (cost_unit >= 0),CONSTRAINT "routine_task_record_total_attempts_non_negative" CHECK (total_attempts >= 0));
+-- ============================== SQL Separator ==============================
+CREATE TABLE "InboxEventTable" ("event_id" uuid,"consumed_at" timestamptz NOT NULL,PRIMARY KEY ("event_id"));
+-- ============================== SQL Separator ==============================
+CREATE TABLE "OutboxEventTable" ("id" uuid DEFAULT gen_random_uuid(),"aggregate_type" varchar(64) NOT NULL,"aggregate_id" uuid NOT NULL,"event_type" varchar(128) NOT NULL,"topic" varchar(255) NOT NULL,"kafka_key" varchar(255) NOT NULL,"payload" JSONB NOT NULL,"metadata" JSONB NOT NULL,"available_at" timestamptz NOT NULL,"published_at" timestamptz,"publish_count" integer NOT NULL DEFAULT 0,"last_error" text,"claimed_by" varchar(255),"claimed_at" timestamptz,"created_at" timestamptz NOT NULL,PRIMARY KEY ("id"));
+-- ============================== SQL Separator ==============================
+CREATE INDEX IF NOT EXISTS "idx_OutboxEventTable_claimed_at" ON "OutboxEventTable" ("claimed_at");
+-- ============================== SQL Separator ==============================
+CREATE INDEX IF NOT EXISTS "idx_OutboxEventTable_claimed_by" ON "OutboxEventTable" ("claimed_by");
+-- ============================== SQL Separator ==============================
+CREATE INDEX IF NOT EXISTS "outbox_event_unpublished_index" ON "OutboxEventTable" ("available_at","aggregate_type","aggregate_id","published_at");
+-- ============================== SQL Separator ==============================
+CREATE TABLE "BillingPlanTable" ("id" text,"product_id" text NOT NULL,"name" "BillingPlanName" NOT NULL,"status" "BillingPlanStatus" NOT NULL,"interval_unit" "BillingIntervalUnit" NOT NULL,"price" decimal NOT NULL,"currency_code" "SupportedCurrencyCode" NOT NULL,"updated_at" timestamptz NOT NULL,"created_at" timestamptz NOT NULL,PRIMARY KEY ("id"),CONSTRAINT "uni_BillingPlanTable_name" UNIQUE ("name"));
+-- ============================== SQL Separator ==============================
+CREATE TABLE "UsersToBillingPlansTable" ("id" uuid DEFAULT gen_random_uuid(),"user_id" uuid NOT NULL,"billing_plan_id" text NOT NULL,"status" "UsersToBillingPlansStatus" NOT NULL,"start_date" timestamptz NOT NULL DEFAULT NOW(),"end_date" timestamptz DEFAULT null,"next_billing_date" timestamptz,"failure_count" integer NOT NULL DEFAULT 0,"updated_at" timestamptz NOT NULL,"created_at" timestamptz NOT NULL,PRIMARY KEY ("id"));
+-- ============================== SQL Separator ==============================
+CREATE UNIQUE INDEX IF NOT EXISTS "users_to_billing_plans_idx_user_id_billing_plan_id_partial_active" ON "UsersToBillingPlansTable" ("billing_plan_id");
+-- ============================== SQL Separator ==============================
+CREATE UNIQUE INDEX IF NOT EXISTS "users_to_billing_plans_idx_user_id_billing_plan_id_partial_status" ON "UsersToBillingPlansTable" ("user_id");
+-- ============================== SQL Separator ==============================
+CREATE OR REPLACE VIEW "UserView" AS
+SELECT
+    "public_id",
+    "status"
+FROM "UserTable";
+-- ============================== SQL Separator ==============================
+CREATE OR REPLACE FUNCTION trigger_function_cascading_soft_delete_root_shelf()
+RETURNS TRIGGER AS $$
+DECLARE
+    updated_sub_shelf_ids uuid[];
+BEGIN
+    IF OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL THEN
+        WITH updated_sub_shelves AS (
+            UPDATE "SubShelfTable"
+            SET deleted_at = NEW.deleted_at
+            WHERE root_shelf_id = NEW.id 
+            AND deleted_at IS NULL
+            RETURNING id
+        )
+        SELECT COALESCE(array_agg(id), ARRAY[]::uuid[])
+        INTO updated_sub_shelf_ids
+        FROM updated_sub_shelves;
+
+        UPDATE "MaterialTable"
+        SET deleted_at = NEW.deleted_at
+        WHERE "MaterialTable".parent_sub_shelf_id = ANY(updated_sub_shelf_ids)
+        AND "MaterialTable".deleted_at IS NULL;
+
+        UPDATE "BlockPackTable"
+        SET deleted_at = NEW.deleted_at
+        WHERE "BlockPackTable".parent_sub_shelf_id = ANY(updated_sub_shelf_ids)
+        AND "BlockPackTable".deleted_at IS NULL;
+    END IF;
+    
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_cascading_soft_delete_root_shelf ON "RootShelfTable"
+-- ============================== SQL Separator ==============================
+CREATE TRIGGER trigger_cascading_soft_delete_root_shelf
+    AFTER UPDATE 
+    ON "RootShelfTable"
+    FOR EACH ROW
+    EXECUTE FUNCTION trigger_function_cascading_soft_delete_root_shelf();
+-- ============================== SQL Separator ==============================
+CREATE OR REPLACE FUNCTION trigger_function_cascading_soft_delete_sub_shelf()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL THEN
+        UPDATE "MaterialTable"
+        SET deleted_at = NEW.deleted_at
+        WHERE parent_sub_shelf_id = NEW.id AND deleted_at IS NULL;
+
+        UPDATE "BlockPackTable"
+        SET deleted_at = NEW.deleted_at
+        WHERE parent_sub_shelf_id = NEW.id AND deleted_at IS NULL;
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_cascading_soft_delete_sub_shelf ON "SubShelfTable"
+-- ============================== SQL Separator ==============================
+CREATE TRIGGER trigger_cascading_soft_delete_sub_shelf
+    AFTER UPDATE 
+    ON "SubShelfTable"
+    FOR EACH ROW
+    EXECUTE FUNCTION trigger_function_cascading_soft_delete_sub_shelf();
+-- ============================== SQL Separator ==============================
+CREATE OR REPLACE FUNCTION trigger_function_cascading_restore_soft_deleted_root_shelf()
+RETURNS TRIGGER AS $$
+DECLARE
+    updated_sub_shelf_ids uuid[];
+BEGIN
+    IF OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL THEN
+        WITH updated_sub_shelves AS (
+            UPDATE "SubShelfTable"
+            SET deleted_at = NULL
+            WHERE root_shelf_id = NEW.id 
+            AND deleted_at = OLD.deleted_at
+            RETURNING id
+        )
+        SELECT COALESCE(array_agg(id), ARRAY[]::uuid[])
+        INTO updated_sub_shelf_ids
+        FROM updated_sub_shelves;
+
+        UPDATE "MaterialTable"
+        SET deleted_at = NULL
+        WHERE "MaterialTable".parent_sub_shelf_id = ANY(updated_sub_shelf_ids)
+        AND "MaterialTable".deleted_at = OLD.deleted_at;
+
+        UPDATE "BlockPackTable"
+        SET deleted_at = NULL
+        WHERE "BlockPackTable".parent_sub_shelf_id = ANY(updated_sub_shelf_ids)
+        AND "BlockPackTable".deleted_at = OLD.deleted_at;
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_cascading_restore_soft_deleted_root_shelf ON "RootShelfTable"
+-- ============================== SQL Separator ==============================
+CREATE TRIGGER trigger_cascading_restore_soft_deleted_root_shelf
+    AFTER UPDATE 
+    ON "RootShelfTable"
+    FOR EACH ROW
+    EXECUTE FUNCTION trigger_function_cascading_restore_soft_deleted_root_shelf();
+-- ============================== SQL Separator ==============================
+CREATE OR REPLACE FUNCTION trigger_function_cascading_restore_soft_deleted_sub_shelf()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL THEN
+        UPDATE "MaterialTable"
+        SET deleted_at = NULL
+        WHERE parent_sub_shelf_id = NEW.id
+        AND deleted_at = OLD.deleted_at;
+
+        UPDATE "BlockPackTable"
+        SET deleted_at = NULL
+        WHERE parent_sub_shelf_id = NEW.id
+        AND deleted_at = OLD.deleted_at;
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_cascading_restore_soft_deleted_sub_shelf ON "SubShelfTable"
+-- ============================== SQL Separator ==============================
+CREATE TRIGGER trigger_cascading_restore_soft_deleted_sub_shelf
+    AFTER UPDATE 
+    ON "SubShelfTable"
+    FOR EACH ROW
+    EXECUTE FUNCTION trigger_function_cascading_restore_soft_deleted_sub_shelf();
+-- ============================== SQL Separator ==============================
+CREATE OR REPLACE FUNCTION trigger_function_cascading_move_sub_shelf()
+RETURNS TRIGGER AS $$
+DECLARE
+    child RECORD;
+    source_index INTEGER;
+    child_path_suffix uuid[];
+    new_child_path uuid[];
+
+    child_ids uuid[] := ARRAY[]::uuid[];
+    child_new_paths TEXT[] := ARRAY[]::TEXT[];
+BEGIN
+    IF OLD.prev_sub_shelf_id IS DISTINCT FROM NEW.prev_sub_shelf_id
+    OR OLD.root_shelf_id IS DISTINCT FROM NEW.root_shelf_id THEN
+
+        FOR child IN
+            SELECT id, path
+            FROM "SubShelfTable"
+            WHERE root_shelf_id = OLD.root_shelf_id
+            AND path @> ARRAY[NEW.id]::uuid[]
+            AND id != NEW.id
+            AND deleted_at IS NULL
+        LOOP
+            source_index := NULL;
+            FOR i IN 1..array_length(child.path, 1) LOOP
+                IF child.path[i] = NEW.id THEN
+                    source_index := i;
+                    EXIT;
+                END IF;
+            END LOOP;
+
+            IF source_index IS NOT NULL THEN
+                child_path_suffix := child.path[source_index:array_length(child.path, 1)];
+
+                new_child_path := NEW.path || child_path_suffix;
+
+                child_ids := child_ids || child.id;
+                child_new_paths := child_new_paths || ('{' || array_to_string(new_child_path, ',') || '}');
+            END IF;
+        END LOOP;
+
+        IF array_length(child_ids, 1) > 0 THEN
+            UPDATE "SubShelfTable"
+            SET
+                root_shelf_id = NEW.root_shelf_id,
+                path = child_sub_shelf.new_path::uuid[], 
+                updated_at = NOW()
+            FROM (
+                SELECT
+                    unnest(child_ids) AS id, 
+                    unnest(child_new_paths) AS new_path
+            ) AS child_sub_shelf
+            WHERE "SubShelfTable".id = child_sub_shelf.id
+                AND deleted_at IS NULL;
+        END IF;
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_cascading_move_sub_shelf ON "SubShelfTable"
+-- ============================== SQL Separator ==============================
+CREATE TRIGGER trigger_cascading_move_sub_shelf
+    AFTER UPDATE OF prev_sub_shelf_id, root_shelf_id
+    ON "SubShelfTable"
+    FOR EACH ROW
+    EXECUTE FUNCTION trigger_function_cascading_move_sub_shelf();
+-- ============================== SQL Separator ==============================
+CREATE OR REPLACE FUNCTION trigger_function_sync_block_pack_yjs_document_deleted_at()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF OLD.deleted_at IS DISTINCT FROM NEW.deleted_at THEN
+        UPDATE "BlockPackYjsDocumentTable"
+        SET
+            deleted_at = NEW.deleted_at,
+            updated_at = NOW()
+        WHERE block_pack_id = NEW.id
+        AND deleted_at IS DISTINCT FROM NEW.deleted_at;
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_sync_block_pack_yjs_document_deleted_at ON "BlockPackTable";
+-- ============================== SQL Separator ==============================
+CREATE TRIGGER trigger_sync_block_pack_yjs_document_deleted_at
+    AFTER UPDATE OF deleted_at
+    ON "BlockPackTable"
+    FOR EACH ROW
+    EXECUTE FUNCTION trigger_function_sync_block_pack_yjs_document_deleted_at();
+-- ============================== SQL Separator ==============================
+CREATE OR REPLACE FUNCTION trigger_function_project_sub_shelves_to_items()
+RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE "ItemTable" item
+    SET root_shelf_id = new_sub_shelves.root_shelf_id
+    FROM new_rows AS new_sub_shelves
+    JOIN old_rows AS old_sub_shelves
+      ON old_sub_shelves.id = new_sub_shelves.id
+    WHERE item.parent_sub_shelf_id = new_sub_shelves.id
+      AND old_sub_shelves.root_shelf_id IS DISTINCT FROM new_sub_shelves.root_shelf_id;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_project_sub_shelves_to_items ON "SubShelfTable";
+-- ============================== SQL Separator ==============================
+CREATE TRIGGER trigger_project_sub_shelves_to_items
+    AFTER UPDATE
+    ON "SubShelfTable"
+    REFERENCING OLD TABLE AS old_rows NEW TABLE AS new_rows
+    FOR EACH STATEMENT
+    EXECUTE FUNCTION trigger_function_project_sub_shelves_to_items();
+-- ============================== SQL Separator ==============================
+CREATE OR REPLACE FUNCTION trigger_function_project_materials_to_items_after_insert_or_update()
+RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO "ItemTable" (
+        id,
+        parent_sub_shelf_id,
+        root_shelf_id,
+        type,
+        deleted_at,
+        updated_at,
+        created_at
+    )
+    SELECT
+        material.id,
+        material.parent_sub_shelf_id,
+        sub_shelf.root_shelf_id,
+        'Material'::"ItemType",
+        material.deleted_at,
+        material.updated_at,
+        material.created_at
+    FROM new_rows AS material
+    JOIN "SubShelfTable" AS sub_shelf
+      ON sub_shelf.id = material.parent_sub_shelf_id
+    ON CONFLICT (id, type) DO UPDATE SET
+        parent_sub_shelf_id = EXCLUDED.parent_sub_shelf_id,
+        root_shelf_id = EXCLUDED.root_shelf_id,
+        deleted_at = EXCLUDED.deleted_at,
+        updated_at = EXCLUDED.updated_at,
+        created_at = EXCLUDED.created_at;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- ============================== SQL Separator ==============================
+CREATE OR REPLACE FUNCTION trigger_function_delete_material_items_after_delete()
+RETURNS TRIGGER AS $$
+BEGIN
+    DELETE FROM "ItemTable" item
+    USING old_rows AS material
+    WHERE item.id = material.id
+      AND item.type = 'Material'::"ItemType";
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_project_materials_to_items_after_insert ON "MaterialTable";
+-- ============================== SQL Separator ==============================
+CREATE TRIGGER trigger_project_materials_to_items_after_insert
+    AFTER INSERT
+    ON "MaterialTable"
+    REFERENCING NEW TABLE AS new_rows
+    FOR EACH STATEMENT
+    EXECUTE FUNCTION trigger_function_project_materials_to_items_after_insert_or_update();
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_project_materials_to_items_after_update ON "MaterialTable";
+-- ============================== SQL Separator ==============================
+CREATE TRIGGER trigger_project_materials_to_items_after_update
+    AFTER UPDATE
+    ON "MaterialTable"
+    REFERENCING NEW TABLE AS new_rows
+    FOR EACH STATEMENT
+    EXECUTE FUNCTION trigger_function_project_materials_to_items_after_insert_or_update();
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_delete_material_items_after_delete ON "MaterialTable";
+-- ============================== SQL Separator ==============================
+CREATE TRIGGER trigger_delete_material_items_after_delete
+    AFTER DELETE
+    ON "MaterialTable"
+    REFERENCING OLD TABLE AS old_rows
+    FOR EACH STATEMENT
+    EXECUTE FUNCTION trigger_function_delete_material_items_after_delete();
+-- ============================== SQL Separator ==============================
+CREATE OR REPLACE FUNCTION trigger_function_project_block_packs_to_items_after_insert_or_update()
+RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO "ItemTable" (
+        id,
+        parent_sub_shelf_id,
+        root_shelf_id,
+        type,
+        deleted_at,
+        updated_at,
+        created_at
+    )
+    SELECT
+        block_pack.id,
+        block_pack.parent_sub_shelf_id,
+        sub_shelf.root_shelf_id,
+        'BlockPack'::"ItemType",
+        block_pack.deleted_at,
+        block_pack.updated_at,
+        block_pack.created_at
+    FROM new_rows AS block_pack
+    JOIN "SubShelfTable" AS sub_shelf
+      ON sub_shelf.id = block_pack.parent_sub_shelf_id
+    ON CONFLICT (id, type) DO UPDATE SET
+        parent_sub_shelf_id = EXCLUDED.parent_sub_shelf_id,
+        root_shelf_id = EXCLUDED.root_shelf_id,
+        deleted_at = EXCLUDED.deleted_at,
+        updated_at = EXCLUDED.updated_at,
+        created_at = EXCLUDED.created_at;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- ============================== SQL Separator ==============================
+CREATE OR REPLACE FUNCTION trigger_function_delete_block_pack_items_after_delete()
+RETURNS TRIGGER AS $$
+BEGIN
+    DELETE FROM "ItemTable" item
+    USING old_rows AS block_pack
+    WHERE item.id = block_pack.id
+      AND item.type = 'BlockPack'::"ItemType";
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_project_block_packs_to_items_after_insert ON "BlockPackTable";
+-- ============================== SQL Separator ==============================
+CREATE TRIGGER trigger_project_block_packs_to_items_after_insert
+    AFTER INSERT
+    ON "BlockPackTable"
+    REFERENCING NEW TABLE AS new_rows
+    FOR EACH STATEMENT
+    EXECUTE FUNCTION trigger_function_project_block_packs_to_items_after_insert_or_update();
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_project_block_packs_to_items_after_update ON "BlockPackTable";
+-- ============================== SQL Separator ==============================
+CREATE TRIGGER trigger_project_block_packs_to_items_after_update
+    AFTER UPDATE
+    ON "BlockPackTable"
+    REFERENCING NEW TABLE AS new_rows
+    FOR EACH STATEMENT
+    EXECUTE FUNCTION trigger_function_project_block_packs_to_items_after_insert_or_update();
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_delete_block_pack_items_after_delete ON "BlockPackTable";
+-- ============================== SQL Separator ==============================
+CREATE TRIGGER trigger_delete_block_pack_items_after_delete
+    AFTER DELETE
+    ON "BlockPackTable"
+    REFERENCING OLD TABLE AS old_rows
+    FOR EACH STATEMENT
+    EXECUTE FUNCTION trigger_function_delete_block_pack_items_after_delete();
+-- ============================== SQL Separator ==============================
+CREATE OR REPLACE FUNCTION trigger_function_accounting_mutated_block_pack()
+RETURNS TRIGGER AS $$
+DECLARE
+    current_count BIGINT;
+    max_count INTEGER;
+    current_count_per_root_shelf BIGINT;
+    max_count_per_root_shelf INTEGER;
+    plan_name TEXT;
+    root_shelf_id UUID;
+    owner_id UUID;
+BEGIN
+    IF (TG_OP = 'INSERT') THEN
+        SELECT
+            pl.max_block_pack_count,
+            pl.max_item_count_per_root_shelf,
+            u.plan::TEXT,
+            rs.id,
+            u.id
+        INTO
+            max_count,
+            max_count_per_root_shelf,
+            plan_name,
+            root_shelf_id,
+            owner_id
+        FROM "SubShelfTable" ss
+        JOIN "RootShelfTable" rs ON ss.root_shelf_id = rs.id
+        JOIN "UserTable" u ON rs.owner_id = u.id
+        JOIN "PlanLimitationTable" pl ON u.plan = pl.key
+        WHERE ss.id = NEW.parent_sub_shelf_id
+        FOR UPDATE OF rs;
+
+        IF NOT FOUND THEN
+            RAISE EXCEPTION 'Data integrity: Cannot find owner for BlockPack (SubShelf ID: %). Possible orphan record.', NEW.parent_sub_shelf_id
+            USING ERRCODE = 'data_exception';
+        END IF;
+
+        UPDATE "UserAccountTable"
+        SET
+            block_pack_count = block_pack_count + 1,
+            updated_at = NOW()
+        WHERE user_id = owner_id
+        RETURNING block_pack_count INTO current_count;
+
+        IF current_count > max_count THEN
+            RAISE EXCEPTION 'Quota exceeded: Plan "%" allows maximum % block packs. Current count: %.', 
+                plan_name, max_count, current_count
+            USING ERRCODE = 'check_violation';
+        END IF;
+
+        UPDATE "RootShelfTable"
+        SET
+            item_count = item_count + 1,
+            updated_at = NOW()
+        WHERE id = root_shelf_id
+        RETURNING item_count INTO current_count_per_root_shelf;
+
+        IF current_count_per_root_shelf > max_count_per_root_shelf THEN
+            RAISE EXCEPTION 'Quota exceeded: Plan "%" allows maximum % block packs per root shelf. Current count: %.', 
+                plan_name, max_count_per_root_shelf, current_count_per_root_shelf
+            USING ERRCODE = 'check_violation';
+        END IF;
+
+        RETURN NEW;
+    
+    ELSIF (TG_OP = 'DELETE') THEN
+        PERFORM 1
+        FROM "SubShelfTable" ss
+        JOIN "RootShelfTable" rs ON ss.root_shelf_id = rs.id
+        WHERE ss.id = OLD.parent_sub_shelf_id
+        FOR UPDATE OF rs;
+
+        UPDATE "UserAccountTable"
+        SET
+            block_pack_count = GREATEST(0, block_pack_count - 1),
+            updated_at = NOW()
+        FROM "SubShelfTable" ss, "RootShelfTable" rs
+        WHERE ss.id = OLD.parent_sub_shelf_id
+        AND ss.root_shelf_id = rs.id
+        AND user_id = rs.owner_id;
+
+        IF NOT FOUND THEN
+            RAISE EXCEPTION 'Data integrity: Cannot find UserAccount of the owner for BlockPack (SubShelf ID: %). Possible orphan record.', OLD.parent_sub_shelf_id
+            USING ERRCODE = 'integrity_constraint_violation';
+        END IF;
+
+        UPDATE "RootShelfTable"
+        SET
+            item_count = GREATEST(0, item_count - 1),
+            updated_at = NOW()
+        FROM "SubShelfTable" ss
+        WHERE ss.id = OLD.parent_sub_shelf_id
+        AND "RootShelfTable".id = ss.root_shelf_id;
+
+        IF NOT FOUND THEN
+            RAISE EXCEPTION 'Data integrity: Cannot find RootShelf for BlockPack (SubShelf ID: %). Possible orphan record.', OLD.parent_sub_shelf_id
+            USING ERRCODE = 'integrity_constraint_violation';
+        END IF;
+
+        RETURN OLD;
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_accounting_mutated_block_pack ON "BlockPackTable"
+-- ============================== SQL Separator ==============================
+CREATE TRIGGER trigger_accounting_mutated_block_pack
+    BEFORE INSERT OR DELETE 
+    ON "BlockPackTable"
+    FOR EACH ROW
+    EXECUTE FUNCTION trigger_function_accounting_mutated_block_pack();
+-- ============================== SQL Separator ==============================
+CREATE OR REPLACE FUNCTION trigger_function_accounting_inserted_block()
+RETURNS TRIGGER AS $$
+DECLARE
+    r RECORD;
+BEGIN
+    IF (TG_OP <> 'INSERT') THEN
+        RAISE EXCEPTION 'Invalid operation for trigger_function_accounting_inserted_block: %. Expected INSERT.', TG_OP
+        USING ERRCODE = 'program_limit_exceeded';
+    END IF;
+
+    PERFORM 1
+    FROM "RootShelfTable" rs
+    JOIN "SubShelfTable" ss ON ss.root_shelf_id = rs.id
+    JOIN "BlockPackTable" bp ON bp.parent_sub_shelf_id = ss.id
+    JOIN new_table nb ON nb.block_pack_id = bp.id
+    ORDER BY rs.id
+    FOR UPDATE OF rs;
+
+    FOR r IN -- batch account the user block count, if all the mutated blocks belong to one user, then this will only execute once
+        WITH new_blocks_agg AS (
+            SELECT 
+                block_pack_id, 
+                count(*) as count_delta
+            FROM new_table
+            GROUP BY block_pack_id
+        ),
+        owner_deltas AS (
+            SELECT 
+                owner_uts.user_id AS owner_id,
+                sum(nba.count_delta) as total_delta
+            FROM new_blocks_agg nba
+            JOIN "BlockPackTable" bp ON nba.block_pack_id = bp.id
+            JOIN "SubShelfTable" ss ON bp.parent_sub_shelf_id = ss.id
+            JOIN "UsersToShelvesTable" owner_uts ON ss.root_shelf_id = owner_uts.root_shelf_id AND owner_uts.permission = 'Owner'
+            GROUP BY owner_uts.user_id
+        ),
+        updated_accounts AS (
+            UPDATE "UserAccountTable" ua
+            SET 
+                block_count = block_count + od.total_delta,
+                updated_at = NOW()
+            FROM owner_deltas od
+            WHERE ua.user_id = od.owner_id
+            RETURNING ua.user_id, ua.block_count
+        )
+
+        -- finally select the limitation and iteratively check the updated block count of the user is not exceeded the limitation
+        SELECT 
+            u.id, u.plan, ua.block_count, pl.max_block_count
+        FROM updated_accounts ua
+        JOIN "UserTable" u ON ua.user_id = u.id
+        JOIN "PlanLimitationTable" pl ON u.plan = pl.key
+        WHERE ua.block_count > pl.max_block_count
+    LOOP
+        RAISE EXCEPTION 'Quota exceeded: Plan "%" allows maximum % blocks. Current count: %.', 
+            r.plan, r.max_block_count, r.block_count
+        USING ERRCODE = 'check_violation';
+    END LOOP;
+
+    FOR r IN
+        WITH new_blocks_agg AS (
+            SELECT 
+                block_pack_id, 
+                count(*) as count_delta
+            FROM new_table
+            GROUP BY block_pack_id
+        ),
+        bp_deltas AS (
+            SELECT 
+                nba.block_pack_id, 
+                sum(nba.count_delta) as total_delta
+            FROM new_blocks_agg nba
+            GROUP BY nba.block_pack_id
+        ),
+        updated_packs AS (
+            UPDATE "BlockPackTable" bp
+            SET 
+                block_count = block_count + bpu.total_delta,
+                updated_at = NOW()
+            FROM bp_deltas bpu
+            WHERE bp.id = bpu.block_pack_id
+            RETURNING bp.id, bp.block_count
+        )
+
+        SELECT DISTINCT ON (up.id)
+            up.id, up.block_count, pl.max_block_count_per_block_pack, u.plan
+        FROM updated_packs up
+        JOIN "BlockPackTable" bp ON bp.id = up.id
+        JOIN "SubShelfTable" ss ON bp.parent_sub_shelf_id = ss.id
+        JOIN "UsersToShelvesTable" owner_uts ON ss.root_shelf_id = owner_uts.root_shelf_id AND owner_uts.permission = 'Owner'
+        JOIN "UserTable" u ON owner_uts.user_id = u.id
+        JOIN "PlanLimitationTable" pl ON u.plan = pl.key
+        WHERE up.block_count > pl.max_block_count_per_block_pack
+    LOOP
+            RAISE EXCEPTION 'Quota exceeded: Plan "%" allows maximum % blocks in each block pack. Current count: %.', 
+            r.plan, r.max_block_count_per_block_pack, r.block_count
+        USING ERRCODE = 'check_violation';
+    END LOOP;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_accounting_inserted_block ON "BlockTable"
+-- ============================== SQL Separator ==============================
+CREATE TRIGGER trigger_accounting_inserted_block
+    AFTER INSERT 
+    ON "BlockTable"
+    REFERENCING NEW TABLE AS new_table
+    FOR EACH STATEMENT
+    EXECUTE FUNCTION trigger_function_accounting_inserted_block();
+-- ============================== SQL Separator ==============================
+CREATE OR REPLACE FUNCTION trigger_function_accounting_deleted_block()
+RETURNS TRIGGER AS $$
+DECLARE
+    r RECORD;
+BEGIN
+    IF (TG_OP <> 'DELETE') THEN
+        RAISE EXCEPTION 'Invalid operation for trigger_function_accounting_deleted_block: %. Expected DELETE.', TG_OP
+        USING ERRCODE = 'program_limit_exceeded';
+    END IF;
+
+    PERFORM 1
+    FROM "RootShelfTable" rs
+    JOIN "SubShelfTable" ss ON ss.root_shelf_id = rs.id
+    JOIN "BlockPackTable" bp ON bp.parent_sub_shelf_id = ss.id
+    JOIN old_table ob ON ob.block_pack_id = bp.id
+    ORDER BY rs.id
+    FOR UPDATE OF rs;
+
+    WITH old_blocks_agg AS (
+        SELECT 
+            block_pack_id, 
+            count(*) as count_delta
+        FROM old_table
+        GROUP BY block_pack_id
+    ),
+    owner_deltas AS (
+        SELECT 
+            owner_uts.user_id AS owner_id,
+            sum(oba.count_delta) as total_delta
+        FROM old_blocks_agg oba
+        JOIN "BlockPackTable" bp ON oba.block_pack_id = bp.id
+        JOIN "SubShelfTable" ss ON bp.parent_sub_shelf_id = ss.id
+        JOIN "UsersToShelvesTable" owner_uts ON ss.root_shelf_id = owner_uts.root_shelf_id AND owner_uts.permission = 'Owner'
+        GROUP BY owner_uts.user_id
+    )
+    UPDATE "UserAccountTable" ua
+    SET 
+        block_count = GREATEST(0, block_count - od.total_delta),
+        updated_at = NOW()
+    FROM owner_deltas od
+    WHERE ua.user_id = od.owner_id;
+
+    WITH old_blocks_agg AS (
+        SELECT 
+            block_pack_id, 
+            count(*) as count_delta
+        FROM old_table
+        GROUP BY block_pack_id
+    ),
+    bp_updates AS (
+        SELECT 
+            oba.block_pack_id, 
+            sum(oba.count_delta) as total_delta
+        FROM old_blocks_agg oba
+        GROUP BY oba.block_pack_id
+    )
+    UPDATE "BlockPackTable" bp
+    SET 
+        block_count = GREATEST(0, block_count - bpu.total_delta),
+        updated_at = NOW()
+    FROM bp_updates bpu
+    WHERE bp.id = bpu.block_pack_id;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_accounting_deleted_block ON "BlockTable"
+-- ============================== SQL Separator ==============================
+CREATE TRIGGER trigger_accounting_deleted_block
+    AFTER DELETE 
+    ON "BlockTable"
+    REFERENCING OLD TABLE AS old_table
+    FOR EACH STATEMENT
+    EXECUTE FUNCTION trigger_function_accounting_deleted_block();
+-- ============================== SQL Separator ==============================
+CREATE OR REPLACE FUNCTION trigger_function_accounting_mutated_root_shelf()
+RETURNS TRIGGER AS $$
+DECLARE
+    current_count BIGINT;
+    max_count INTEGER;
+    max_root_shelf_count INTEGER;
+    max_block_pack_count INTEGER;
+    max_material_count INTEGER;
+    max_block_count INTEGER;
+    plan_name TEXT;
+    block_pack_delta BIGINT;
+    material_delta BIGINT;
+    block_delta BIGINT;
+    max_sub_shelf_count INTEGER;
+    max_item_count INTEGER;
+    max_block_count_per_block_pack INTEGER;
+    largest_block_pack_count BIGINT;
+    transferred_root_shelf_count BIGINT;
+    transferred_block_pack_count BIGINT;
+    transferred_material_count BIGINT;
+    transferred_block_count BIGINT;
+BEGIN
+    IF (TG_OP = 'INSERT') THEN
+        SELECT
+            pl.max_root_shelf_count,
+            u.plan::TEXT
+        INTO
+            max_count,
+            plan_name
+        FROM "UserTable" u
+        JOIN "PlanLimitationTable" pl ON u.plan = pl.key
+        WHERE u.id = NEW.owner_id;
+
+        IF NOT FOUND THEN
+            RAISE EXCEPTION 'Data integrity: Cannot find owner for RootShelf. Possible orphan record.'
+            USING ERRCODE = 'data_exception';
+        END IF;
+
+        UPDATE "UserAccountTable" ua
+        SET 
+            root_shelf_count = root_shelf_count + 1,
+            updated_at = NOW()
+        WHERE ua.user_id = NEW.owner_id
+        RETURNING root_shelf_count INTO current_count;
+
+        IF current_count > max_count THEN
+            RAISE EXCEPTION 'Quota exceeded: Plan "%" allows maximum % root shelves. Current count: %.', 
+                plan_name, max_count, current_count
+            USING ERRCODE = 'check_violation';
+        END IF;
+
+        RETURN NEW;
+
+    ELSIF (TG_OP = 'DELETE') THEN
+        UPDATE "UserAccountTable"
+        SET
+            root_shelf_count = GREATEST(0, root_shelf_count - 1),
+            updated_at = NOW()
+        WHERE user_id = OLD.owner_id;
+
+        IF NOT FOUND THEN
+             RAISE EXCEPTION 'Data integrity: Cannot find UserAccount of the owner for RootShelf (Owner ID: %).', OLD.owner_id
+             USING ERRCODE = 'integrity_constraint_violation';
+        END IF;
+
+        RETURN OLD;
+
+    ELSIF (TG_OP = 'UPDATE') THEN
+        IF NEW.owner_id IS NOT DISTINCT FROM OLD.owner_id THEN
+            RETURN NEW;
+        END IF;
+
+        SELECT
+            pl.max_root_shelf_count,
+            pl.max_block_pack_count,
+            pl.max_material_count,
+            pl.max_block_count,
+            pl.max_sub_shelf_count_per_root_shelf,
+            pl.max_item_count_per_root_shelf,
+            pl.max_block_count_per_block_pack,
+            u.plan::TEXT
+        INTO
+            max_root_shelf_count,
+            max_block_pack_count,
+            max_material_count,
+            max_block_count,
+            max_sub_shelf_count,
+            max_item_count,
+            max_block_count_per_block_pack,
+            plan_name
+        FROM "UserTable" u
+        JOIN "PlanLimitationTable" pl ON u.plan = pl.key
+        WHERE u.id = NEW.owner_id;
+
+        IF NOT FOUND THEN
+            RAISE EXCEPTION 'Data integrity: Cannot find new owner for RootShelf. Possible orphan record.'
+            USING ERRCODE = 'data_exception';
+        END IF;
+
+        SELECT count(*) INTO block_pack_delta
+        FROM "BlockPackTable" bp
+        JOIN "SubShelfTable" ss ON bp.parent_sub_shelf_id = ss.id
+        WHERE ss.root_shelf_id = NEW.id;
+
+        SELECT count(*) INTO material_delta
+        FROM "MaterialTable" m
+        JOIN "SubShelfTable" ss ON m.parent_sub_shelf_id = ss.id
+        WHERE ss.root_shelf_id = NEW.id;
+
+        SELECT count(*) INTO block_delta
+        FROM "BlockTable" b
+        JOIN "BlockPackTable" bp ON b.block_pack_id = bp.id
+        JOIN "SubShelfTable" ss ON bp.parent_sub_shelf_id = ss.id
+        WHERE ss.root_shelf_id = NEW.id;
+
+        SELECT COALESCE(max(bp.block_count), 0) INTO largest_block_pack_count
+        FROM "BlockPackTable" bp
+        JOIN "SubShelfTable" ss ON bp.parent_sub_shelf_id = ss.id
+        WHERE ss.root_shelf_id = NEW.id;
+
+        IF NEW.sub_shelf_count > max_sub_shelf_count THEN
+            RAISE EXCEPTION 'Quota exceeded: Plan "%" allows maximum % sub shelves per root shelf. Current count: %.',
+                plan_name, max_sub_shelf_count, NEW.sub_shelf_count
+            USING ERRCODE = 'check_violation';
+        END IF;
+        IF NEW.item_count > max_item_count THEN
+            RAISE EXCEPTION 'Quota exceeded: Plan "%" allows maximum % items per root shelf. Current count: %.',
+                plan_name, max_item_count, NEW.item_count
+            USING ERRCODE = 'check_violation';
+        END IF;
+        IF largest_block_pack_count > max_block_count_per_block_pack THEN
+            RAISE EXCEPTION 'Quota exceeded: Plan "%" allows maximum % blocks in each block pack. Current count: %.',
+                plan_name, max_block_count_per_block_pack, largest_block_pack_count
+            USING ERRCODE = 'check_violation';
+        END IF;
+
+        UPDATE "UserAccountTable"
+        SET
+            root_shelf_count = GREATEST(0, root_shelf_count - 1),
+            block_pack_count = GREATEST(0, block_pack_count - block_pack_delta),
+            material_count = GREATEST(0, material_count - material_delta),
+            block_count = GREATEST(0, block_count - block_delta),
+            updated_at = NOW()
+        WHERE user_id = OLD.owner_id;
+
+        IF NOT FOUND THEN
+            RAISE EXCEPTION 'Data integrity: Cannot find UserAccount of the previous RootShelf owner (Owner ID: %).', OLD.owner_id
+            USING ERRCODE = 'integrity_constraint_violation';
+        END IF;
+
+        UPDATE "UserAccountTable"
+        SET
+            root_shelf_count = root_shelf_count + 1,
+            block_pack_count = block_pack_count + block_pack_delta,
+            material_count = material_count + material_delta,
+            block_count = block_count + block_delta,
+            updated_at = NOW()
+        WHERE user_id = NEW.owner_id
+        RETURNING root_shelf_count, block_pack_count, material_count, block_count
+        INTO transferred_root_shelf_count, transferred_block_pack_count, transferred_material_count, transferred_block_count;
+
+        IF NOT FOUND THEN
+            RAISE EXCEPTION 'Data integrity: Cannot find UserAccount of the new RootShelf owner (Owner ID: %).', NEW.owner_id
+            USING ERRCODE = 'integrity_constraint_violation';
+        END IF;
+
+        IF transferred_root_shelf_count > max_root_shelf_count
+            OR transferred_block_pack_count > max_block_pack_count
+            OR transferred_material_count > max_material_count
+            OR transferred_block_count > max_block_count THEN
+            RAISE EXCEPTION 'Quota exceeded while transferring RootShelf ownership to plan "%".', plan_name
+            USING ERRCODE = 'check_violation';
+        END IF;
+
+        RETURN NEW;
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_accounting_mutated_root_shelf ON "RootShelfTable"
+-- ============================== SQL Separator ==============================
+CREATE TRIGGER trigger_accounting_mutated_root_shelf
+    BEFORE INSERT OR DELETE OR UPDATE
+    ON "RootShelfTable"
+    FOR EACH ROW
+    EXECUTE FUNCTION trigger_function_accounting_mutated_root_shelf();
+-- ============================== SQL Separator ==============================
+CREATE OR REPLACE FUNCTION trigger_function_accounting_mutated_sub_shelf()
+RETURNS TRIGGER AS $$
+DECLARE
+    current_count BIGINT;
+    max_count INTEGER;
+    plan_name TEXT;
+BEGIN
+    IF (TG_OP = 'INSERT') THEN
+        SELECT
+            pl.max_sub_shelf_count_per_root_shelf, 
+            u.plan::TEXT
+        INTO
+            max_count,
+            plan_name
+        FROM "RootShelfTable" rs
+        JOIN "UserTable" u ON rs.owner_id = u.id
+        JOIN "PlanLimitationTable" pl ON u.plan = pl.key
+        WHERE rs.id = NEW.root_shelf_id
+        FOR UPDATE OF rs;
+
+        IF NOT FOUND THEN
+            RAISE EXCEPTION 'Data integrity: Cannot find owner for SubShelf (RootShelf ID: %). Possible orphan record.', NEW.root_shelf_id
+            USING ERRCODE = 'integrity_constraint_violation';
+        END IF;
+
+        UPDATE "RootShelfTable"
+        SET
+            sub_shelf_count = sub_shelf_count + 1,
+            updated_at = NOW()
+        WHERE id = NEW.root_shelf_id
+        RETURNING sub_shelf_count INTO current_count;
+
+        IF current_count > max_count THEN
+            RAISE EXCEPTION 'Quota exceeded: Plan "%" allows maximum % sub shelves per root shelf. Current count: %.', 
+                plan_name, max_count, current_count
+            USING ERRCODE = 'check_violation';
+        END IF;
+
+        RETURN NEW;
+
+    ELSIF (TG_OP = 'DELETE') THEN
+        UPDATE "RootShelfTable"
+        SET
+            sub_shelf_count = GREATEST(0, sub_shelf_count - 1),
+            updated_at = NOW()
+        WHERE id = OLD.root_shelf_id;
+
+        IF NOT FOUND THEN
+            RAISE EXCEPTION 'Data integrity: Cannot find RootShelf for SubShelf (RootShelf ID: %). Possible orphan record.', OLD.root_shelf_id
+            USING ERRCODE = 'integrity_constraint_violation';
+        END IF;
+
+        RETURN OLD;
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_accounting_mutated_sub_shelf ON "SubShelfTable"
+-- ============================== SQL Separator ==============================
+CREATE TRIGGER trigger_accounting_mutated_sub_shelf
+    BEFORE INSERT OR DELETE 
+    ON "SubShelfTable"
+    FOR EACH ROW
+    EXECUTE FUNCTION trigger_function_accounting_mutated_sub_shelf();
+-- ============================== SQL Separator ==============================
+CREATE OR REPLACE FUNCTION trigger_function_accounting_mutated_material()
+RETURNS TRIGGER AS $$
+DECLARE
+    current_count BIGINT;
+    max_count INTEGER;
+    current_count_per_root_shelf BIGINT;
+    max_count_per_root_shelf INTEGER;
+    plan_name TEXT;
+    root_shelf_id UUID;
+    owner_id UUID;
+BEGIN
+    IF (TG_OP = 'INSERT') THEN
+        SELECT
+            pl.max_material_count,
+            pl.max_item_count_per_root_shelf,
+            u.plan::TEXT, 
+            rs.id,
+            u.id
+        INTO
+            max_count,
+            max_count_per_root_shelf,
+            plan_name,
+            root_shelf_id,
+            owner_id
+        FROM "SubShelfTable" ss
+        JOIN "RootShelfTable" rs ON ss.root_shelf_id = rs.id
+        JOIN "UserTable" u ON rs.owner_id = u.id
+        JOIN "PlanLimitationTable" pl ON u.plan = pl.key
+        WHERE ss.id = NEW.parent_sub_shelf_id
+        FOR UPDATE OF rs;
+
+        IF NOT FOUND THEN
+            RAISE EXCEPTION 'Data integrity: Cannot find owner for Material (SubShelf ID: %). Possible orphan record.', NEW.parent_sub_shelf_id
+            USING ERRCODE = 'data_exception';
+        END IF;
+
+        UPDATE "UserAccountTable"
+        SET
+            material_count = material_count + 1,
+            updated_at = NOW()
+        WHERE user_id = owner_id
+        RETURNING material_count INTO current_count;
+
+        IF current_count > max_count THEN
+            RAISE EXCEPTION 'Quota exceeded: Plan "%" allows maximum % materials. Current count: %.', 
+                plan_name, max_count, current_count
+            USING ERRCODE = 'check_violation';
+        END IF;
+
+        UPDATE "RootShelfTable"
+        SET
+            item_count = item_count + 1,
+            updated_at = NOW()
+        WHERE id = root_shelf_id
+        RETURNING item_count INTO current_count_per_root_shelf;
+
+        IF current_count_per_root_shelf > max_count_per_root_shelf THEN
+            RAISE EXCEPTION 'Quota exceeded: Plan "%" allows maximum % items per root shelf. Current count: %.', 
+                plan_name, max_count_per_root_shelf, current_count_per_root_shelf
+            USING ERRCODE = 'check_violation';
+        END IF;
+
+        RETURN NEW;
+
+    ELSIF (TG_OP = 'DELETE') THEN
+        PERFORM 1
+        FROM "SubShelfTable" ss
+        JOIN "RootShelfTable" rs ON ss.root_shelf_id = rs.id
+        WHERE ss.id = OLD.parent_sub_shelf_id
+        FOR UPDATE OF rs;
+
+        UPDATE "UserAccountTable"
+        SET
+            material_count = GREATEST(0, material_count - 1),
+            updated_at = NOW()
+        FROM "SubShelfTable" ss, "RootShelfTable" rs
+        WHERE ss.id = OLD.parent_sub_shelf_id
+        AND ss.root_shelf_id = rs.id
+        AND user_id = rs.owner_id;
+
+        IF NOT FOUND THEN
+            RAISE EXCEPTION 'Data integrity: Cannot find UserAccount of the owner for Material (SubShelf ID: %). Possible orphan record.', OLD.parent_sub_shelf_id
+            USING ERRCODE = 'integrity_constraint_violation';
+        END IF;
+
+        UPDATE "RootShelfTable"
+        SET
+            item_count = GREATEST(0, item_count - 1),
+            updated_at = NOW()
+        FROM "SubShelfTable" ss
+        WHERE ss.id = OLD.parent_sub_shelf_id
+        AND "RootShelfTable".id = ss.root_shelf_id;
+
+        IF NOT FOUND THEN
+            RAISE EXCEPTION 'Data integrity: Cannot find RootShelf for Material (SubShelf ID: %). Possible orphan record.', OLD.parent_sub_shelf_id
+            USING ERRCODE = 'integrity_constraint_violation';
+        END IF;
+
+        RETURN OLD;
+    END IF;
+
+    RETURN NULL;
+END;
+$$LANGUAGE plpgsql;
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_accounting_mutated_material ON "MaterialTable"
+-- ============================== SQL Separator ==============================
+CREATE TRIGGER trigger_accounting_mutated_material
+    BEFORE INSERT OR DELETE 
+    ON "MaterialTable"
+    FOR EACH ROW
+    EXECUTE FUNCTION trigger_function_accounting_mutated_material();
+-- ============================== SQL Separator ==============================
+CREATE OR REPLACE FUNCTION trigger_function_accounting_inserted_routine_task()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF (TG_OP <> 'INSERT') THEN
+        RAISE EXCEPTION 'Invalid operation for trigger_function_accounting_inserted_routine_task: %. Expected INSERT.', TG_OP
+        USING ERRCODE = 'program_limit_exceeded';
+    END IF;
+
+    NEW.cost_unit = (octet_length(COALESCE(NEW.payload::text, ''))::bigint + 1023) / 1024;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_accounting_inserted_routine_task ON "RoutineTaskTable"
+-- ============================== SQL Separator ==============================
+CREATE TRIGGER trigger_accounting_inserted_routine_task
+    BEFORE INSERT
+    ON "RoutineTaskTable"
+    FOR EACH ROW
+    EXECUTE FUNCTION trigger_function_accounting_inserted_routine_task();
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_accounting_deleted_routine_task ON "RoutineTaskTable"
+-- ============================== SQL Separator ==============================
+DROP FUNCTION IF EXISTS trigger_function_accounting_deleted_routine_task();
+-- ============================== SQL Separator ==============================
+CREATE OR REPLACE FUNCTION trigger_function_accounting_updated_routine_task()
+RETURNS TRIGGER AS $$
+DECLARE
+    new_cost_unit bigint;
+BEGIN
+    IF (TG_OP <> 'UPDATE') THEN
+        RAISE EXCEPTION 'Invalid operation for trigger_function_accounting_updated_routine_task: %. Expected UPDATE.', TG_OP
+        USING ERRCODE = 'program_limit_exceeded';
+    END IF;
+
+    new_cost_unit = (octet_length(COALESCE(NEW.payload::text, ''))::bigint + 1023) / 1024;
+    NEW.cost_unit = new_cost_unit;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_accounting_updated_routine_task ON "RoutineTaskTable"
+-- ============================== SQL Separator ==============================
+CREATE TRIGGER trigger_accounting_updated_routine_task
+    BEFORE UPDATE OF routine_id, payload, cost_unit
+    ON "RoutineTaskTable"
+    FOR EACH ROW
+    EXECUTE FUNCTION trigger_function_accounting_updated_routine_task();
+-- ============================== SQL Separator ==============================
+CREATE OR REPLACE FUNCTION trigger_function_accounting_inserted_routine_tag()
+RETURNS TRIGGER AS $$
+DECLARE
+    r RECORD;
+BEGIN
+    IF (TG_OP <> 'INSERT') THEN
+        RAISE EXCEPTION 'Invalid operation for trigger_function_accounting_inserted_routine_tag: %. Expected INSERT.', TG_OP
+        USING ERRCODE = 'program_limit_exceeded';
+    END IF;
+
+    FOR r IN
+        WITH owner_tag_deltas AS (
+            SELECT
+                owner_id AS user_id,
+                count(*) as total_delta
+            FROM new_table
+            GROUP BY owner_id
+        ),
+        updated_accounts AS (
+            UPDATE "UserAccountTable" ua
+            SET
+                routine_tag_count = routine_tag_count + otd.total_delta,
+                updated_at = NOW()
+            FROM owner_tag_deltas otd
+            WHERE ua.user_id = otd.user_id
+            RETURNING ua.user_id
+        )
+
+        SELECT
+            ua.user_id,
+            u.plan,
+            acc.routine_tag_count,
+            pl.max_routine_tag_count
+        FROM updated_accounts ua
+        JOIN "UserAccountTable" acc ON acc.user_id = ua.user_id
+        JOIN "UserTable" u ON u.id = ua.user_id
+        JOIN "PlanLimitationTable" pl ON u.plan = pl.key
+        WHERE acc.routine_tag_count > pl.max_routine_tag_count
+    LOOP
+        RAISE EXCEPTION 'Quota exceeded: Plan "%" allows maximum % routine tags. Current count: %.',
+            r.plan, r.max_routine_tag_count, r.routine_tag_count
+        USING ERRCODE = 'check_violation';
+    END LOOP;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_accounting_inserted_routine_tag ON "RoutineTagTable"
+-- ============================== SQL Separator ==============================
+CREATE TRIGGER trigger_accounting_inserted_routine_tag
+    AFTER INSERT
+    ON "RoutineTagTable"
+    REFERENCING NEW TABLE AS new_table
+    FOR EACH STATEMENT
+    EXECUTE FUNCTION trigger_function_accounting_inserted_routine_tag();
+-- ============================== SQL Separator ==============================
+CREATE OR REPLACE FUNCTION trigger_function_accounting_deleted_routine_tag()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF (TG_OP <> 'DELETE') THEN
+        RAISE EXCEPTION 'Invalid operation for trigger_function_accounting_deleted_routine_tag: %. Expected DELETE.', TG_OP
+        USING ERRCODE = 'program_limit_exceeded';
+    END IF;
+
+    WITH owner_tag_deltas AS (
+        SELECT
+            owner_id AS user_id,
+            count(*) as total_delta
+        FROM old_table
+        GROUP BY owner_id
+    )
+    UPDATE "UserAccountTable" ua
+    SET
+        routine_tag_count = GREATEST(0, routine_tag_count - otd.total_delta),
+        updated_at = NOW()
+    FROM owner_tag_deltas otd
+    WHERE ua.user_id = otd.user_id;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_accounting_deleted_routine_tag ON "RoutineTagTable"
+-- ============================== SQL Separator ==============================
+CREATE TRIGGER trigger_accounting_deleted_routine_tag
+    AFTER DELETE
+    ON "RoutineTagTable"
+    REFERENCING OLD TABLE AS old_table
+    FOR EACH STATEMENT
+    EXECUTE FUNCTION trigger_function_accounting_deleted_routine_tag();
+-- ============================== SQL Separator ==============================
+CREATE OR REPLACE FUNCTION trigger_function_accounting_inserted_routine()
+RETURNS TRIGGER AS $$
+DECLARE
+    r RECORD;
+BEGIN
+    IF (TG_OP <> 'INSERT') THEN
+        RAISE EXCEPTION 'Invalid operation for trigger_function_accounting_inserted_routine: %. Expected INSERT.', TG_OP
+        USING ERRCODE = 'program_limit_exceeded';
+    END IF;
+
+    FOR r IN
+        WITH station_deltas AS (
+            SELECT
+                station_id,
+                count(*) as total_delta
+            FROM new_table
+            GROUP BY station_id
+        ),
+        updated_stations AS (
+            UPDATE "StationTable" s
+            SET
+                routine_count = routine_count + sd.total_delta,
+                updated_at = NOW()
+            FROM station_deltas sd
+            WHERE s.id = sd.station_id
+            RETURNING s.id, s.owner_id, s.routine_count
+        ),
+        owner_deltas AS (
+            SELECT
+                us.owner_id,
+                sum(sd.total_delta) as total_delta
+            FROM updated_stations us
+            JOIN station_deltas sd ON sd.station_id = us.id
+            GROUP BY us.owner_id
+        ),
+        updated_accounts AS (
+            UPDATE "UserAccountTable" ua
+            SET
+                routine_count = routine_count + od.total_delta,
+                updated_at = NOW()
+            FROM owner_deltas od
+            WHERE ua.user_id = od.owner_id
+            RETURNING ua.user_id
+        )
+
+        SELECT
+            us.id, u.plan, us.routine_count, pl.max_routine_count_per_station
+        FROM updated_stations us
+        JOIN "UserTable" u ON us.owner_id = u.id
+        JOIN "PlanLimitationTable" pl ON u.plan = pl.key
+        LEFT JOIN updated_accounts ua ON ua.user_id = us.owner_id
+        WHERE us.routine_count > pl.max_routine_count_per_station
+    LOOP
+        RAISE EXCEPTION 'Quota exceeded: Plan "%" allows maximum % routines per station. Current count: %.',
+            r.plan, r.max_routine_count_per_station, r.routine_count
+        USING ERRCODE = 'check_violation';
+    END LOOP;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_accounting_inserted_routine ON "RoutineTable"
+-- ============================== SQL Separator ==============================
+CREATE TRIGGER trigger_accounting_inserted_routine
+    AFTER INSERT
+    ON "RoutineTable"
+    REFERENCING NEW TABLE AS new_table
+    FOR EACH STATEMENT
+    EXECUTE FUNCTION trigger_function_accounting_inserted_routine();
+-- ============================== SQL Separator ==============================
+CREATE OR REPLACE FUNCTION trigger_function_accounting_deleted_routine()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF (TG_OP <> 'DELETE') THEN
+        RAISE EXCEPTION 'Invalid operation for trigger_function_accounting_deleted_routine: %. Expected DELETE.', TG_OP
+        USING ERRCODE = 'program_limit_exceeded';
+    END IF;
+
+    WITH station_deltas AS (
+        SELECT
+            station_id,
+            count(*) as total_delta
+        FROM old_table
+        GROUP BY station_id
+    ),
+    updated_stations AS (
+        UPDATE "StationTable" s
+        SET
+            routine_count = GREATEST(0, routine_count - sd.total_delta),
+            updated_at = NOW()
+        FROM station_deltas sd
+        WHERE s.id = sd.station_id
+        RETURNING s.id, s.owner_id
+    ),
+    owner_deltas AS (
+        SELECT
+            us.owner_id,
+            sum(sd.total_delta) as total_delta
+        FROM updated_stations us
+        JOIN station_deltas sd ON sd.station_id = us.id
+        GROUP BY us.owner_id
+    )
+    UPDATE "UserAccountTable" ua
+    SET
+        routine_count = GREATEST(0, routine_count - od.total_delta),
+        updated_at = NOW()
+    FROM owner_deltas od
+    WHERE ua.user_id = od.owner_id;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_accounting_deleted_routine ON "RoutineTable"
+-- ============================== SQL Separator ==============================
+CREATE TRIGGER trigger_accounting_deleted_routine
+    AFTER DELETE
+    ON "RoutineTable"
+    REFERENCING OLD TABLE AS old_table
+    FOR EACH STATEMENT
+    EXECUTE FUNCTION trigger_function_accounting_deleted_routine();
+-- ============================== SQL Separator ==============================
+CREATE OR REPLACE FUNCTION trigger_function_accounting_inserted_station()
+RETURNS TRIGGER AS $$
+DECLARE
+    r RECORD;
+BEGIN
+    IF (TG_OP <> 'INSERT') THEN
+        RAISE EXCEPTION 'Invalid operation for trigger_function_accounting_inserted_station: %. Expected INSERT.', TG_OP
+        USING ERRCODE = 'program_limit_exceeded';
+    END IF;
+
+    FOR r IN
+        WITH owner_deltas AS (
+            SELECT
+                owner_id,
+                count(*) as total_delta
+            FROM new_table
+            GROUP BY owner_id
+        ),
+        updated_accounts AS (
+            UPDATE "UserAccountTable" ua
+            SET
+                station_count = station_count + od.total_delta,
+                updated_at = NOW()
+            FROM owner_deltas od
+            WHERE ua.user_id = od.owner_id
+            RETURNING ua.user_id, ua.station_count
+        )
+
+        SELECT
+            u.id, u.plan, ua.station_count, pl.max_station_count
+        FROM updated_accounts ua
+        JOIN "UserTable" u ON ua.user_id = u.id
+        JOIN "PlanLimitationTable" pl ON u.plan = pl.key
+        WHERE ua.station_count > pl.max_station_count
+    LOOP
+        RAISE EXCEPTION 'Quota exceeded: Plan "%" allows maximum % stations. Current count: %.',
+            r.plan, r.max_station_count, r.station_count
+        USING ERRCODE = 'check_violation';
+    END LOOP;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_accounting_inserted_station ON "StationTable"
+-- ============================== SQL Separator ==============================
+CREATE TRIGGER trigger_accounting_inserted_station
+    AFTER INSERT
+    ON "StationTable"
+    REFERENCING NEW TABLE AS new_table
+    FOR EACH STATEMENT
+    EXECUTE FUNCTION trigger_function_accounting_inserted_station();
+-- ============================== SQL Separator ==============================
+CREATE OR REPLACE FUNCTION trigger_function_accounting_deleted_station()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF (TG_OP <> 'DELETE') THEN
+        RAISE EXCEPTION 'Invalid operation for trigger_function_accounting_deleted_station: %. Expected DELETE.', TG_OP
+        USING ERRCODE = 'program_limit_exceeded';
+    END IF;
+
+    WITH owner_deltas AS (
+        SELECT
+            owner_id,
+            count(*) as station_delta
+        FROM old_table
+        GROUP BY owner_id
+    )
+    UPDATE "UserAccountTable" ua
+    SET
+        station_count = GREATEST(0, station_count - od.station_delta),
+        updated_at = NOW()
+    FROM owner_deltas od
+    WHERE ua.user_id = od.owner_id;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_accounting_deleted_station ON "StationTable"
+-- ============================== SQL Separator ==============================
+CREATE TRIGGER trigger_accounting_deleted_station
+    AFTER DELETE
+    ON "StationTable"
+    REFERENCING OLD TABLE AS old_table
+    FOR EACH STATEMENT
+    EXECUTE FUNCTION trigger_function_accounting_deleted_station();
+-- ============================== SQL Separator ==============================
+CREATE OR REPLACE FUNCTION trigger_function_accounting_mutated_station()
+RETURNS TRIGGER AS $$
+DECLARE
+    target_plan_name TEXT;
+    max_station_count INTEGER;
+    max_routine_count_per_station INTEGER;
+    transferred_station_count BIGINT;
+BEGIN
+    IF TG_OP <> 'UPDATE' THEN
+        RAISE EXCEPTION 'Invalid operation for trigger_function_accounting_mutated_station: %. Expected UPDATE.', TG_OP
+        USING ERRCODE = 'program_limit_exceeded';
+    END IF;
+
+    IF NEW.owner_id IS NOT DISTINCT FROM OLD.owner_id THEN
+        RETURN NEW;
+    END IF;
+
+    SELECT
+        pl.max_station_count,
+        pl.max_routine_count_per_station,
+        u.plan::TEXT
+    INTO
+        max_station_count,
+        max_routine_count_per_station,
+        target_plan_name
+    FROM "UserTable" u
+    JOIN "PlanLimitationTable" pl ON pl.key = u.plan
+    WHERE u.id = NEW.owner_id;
+
+    IF NOT FOUND THEN
+        RAISE EXCEPTION 'Data integrity: Cannot find new owner for Station. Possible orphan record.'
+        USING ERRCODE = 'data_exception';
+    END IF;
+
+    IF NEW.routine_count > max_routine_count_per_station THEN
+        RAISE EXCEPTION 'Quota exceeded: Plan "%" allows maximum % routines per station. Current count: %.',
+            target_plan_name, max_routine_count_per_station, NEW.routine_count
+        USING ERRCODE = 'check_violation';
+    END IF;
+
+    UPDATE "UserAccountTable"
+    SET
+        station_count = GREATEST(0, station_count - 1),
+        routine_count = GREATEST(0, routine_count - OLD.routine_count),
+        updated_at = NOW()
+    WHERE user_id = OLD.owner_id;
+
+    IF NOT FOUND THEN
+        RAISE EXCEPTION 'Data integrity: Cannot find UserAccount of the previous Station owner (Owner ID: %).', OLD.owner_id
+        USING ERRCODE = 'integrity_constraint_violation';
+    END IF;
+
+    UPDATE "UserAccountTable"
+    SET
+        station_count = station_count + 1,
+        routine_count = routine_count + NEW.routine_count,
+        updated_at = NOW()
+    WHERE user_id = NEW.owner_id
+    RETURNING station_count
+    INTO transferred_station_count;
+
+    IF NOT FOUND THEN
+        RAISE EXCEPTION 'Data integrity: Cannot find UserAccount of the new Station owner (Owner ID: %).', NEW.owner_id
+        USING ERRCODE = 'integrity_constraint_violation';
+    END IF;
+
+    IF transferred_station_count > max_station_count THEN
+        RAISE EXCEPTION 'Quota exceeded while transferring Station ownership to plan "%".', target_plan_name
+        USING ERRCODE = 'check_violation';
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_accounting_mutated_station ON "StationTable";
+-- ============================== SQL Separator ==============================
+CREATE TRIGGER trigger_accounting_mutated_station
+    BEFORE UPDATE
+    ON "StationTable"
+    FOR EACH ROW
+    EXECUTE FUNCTION trigger_function_accounting_mutated_station();
+-- ============================== SQL Separator ==============================
+DROP INDEX IF EXISTS "users_to_billing_plans_idx_user_id_billing_plan_id_partial_status";
+-- ============================== SQL Separator ==============================
+CREATE UNIQUE INDEX users_to_billing_plans_idx_user_id_billing_plan_id_partial_status
+ON "UsersToBillingPlansTable" (user_id, billing_plan_id)
+WHERE status = 'APPROVAL_PENDING' or status = 'APPROVED' or status = 'ACTIVE';
+-- ============================== SQL Separator ==============================
+DROP INDEX IF EXISTS "block_idx_tree_root";
+DROP INDEX IF EXISTS "block_idx_single_root_head";
+DROP INDEX IF EXISTS "block_idx_single_child_head";
+DROP INDEX IF EXISTS "block_idx_unique_prev_block";
+DROP INDEX IF EXISTS "block_idx_unique_next_block";
+ALTER TABLE "BlockTable" DROP CONSTRAINT IF EXISTS block_unique_sibling_prev;
+ALTER TABLE "BlockTable" DROP CONSTRAINT IF EXISTS block_unique_sibling_next;
+-- ============================== SQL Separator ==============================
+-- BlockTable is a full Yjs projection. Pointer changes are valid only as a
+-- complete document state, so validate sibling uniqueness at transaction commit.
+ALTER TABLE "BlockTable"
+ADD CONSTRAINT block_unique_sibling_prev
+UNIQUE NULLS NOT DISTINCT (block_pack_id, parent_block_id, prev_block_id)
+DEFERRABLE INITIALLY DEFERRED;
+-- ============================== SQL Separator ==============================
+ALTER TABLE "BlockTable"
+ADD CONSTRAINT block_unique_sibling_next
+UNIQUE NULLS NOT DISTINCT (block_pack_id, parent_block_id, next_block_id)
+DEFERRABLE INITIALLY DEFERRED;
+-- ============================== SQL Separator ==============================
+ALTER TABLE "RoutineTable" DROP CONSTRAINT IF EXISTS routine_check_scheduled_start_minute_precision;
+ALTER TABLE "RoutineTable" DROP CONSTRAINT IF EXISTS routine_check_scheduled_end_minute_precision;
+-- ============================== SQL Separator ==============================
+ALTER TABLE "RoutineTable"
+ADD CONSTRAINT routine_check_scheduled_start_minute_precision
+CHECK (
+    scheduled_start_at IS NULL
+    OR date_trunc('minute', scheduled_start_at) = scheduled_start_at
+);
+-- ============================== SQL Separator ==============================
+ALTER TABLE "RoutineTable"
+ADD CONSTRAINT routine_check_scheduled_end_minute_precision
+CHECK (
+    scheduled_end_at IS NULL
+    OR date_trunc('minute', scheduled_end_at) = scheduled_end_at
+);
+-- ============================== SQL Separator ==============================
+ALTER TABLE "RoutineTable" DROP CONSTRAINT IF EXISTS routine_check_scheduled_time_in_period;
+-- ============================== SQL Separator ==============================
+ALTER TABLE "RoutineTable"
+ADD CONSTRAINT routine_check_scheduled_time_in_period
+CHECK (
+    scheduled_end_at > scheduled_start_at
+    AND (
+        period IS NULL
+        OR scheduled_end_at <= scheduled_start_at + CASE period
+            WHEN 'Daily'::"RoutinePeriod" THEN INTERVAL '1 day'
+            WHEN 'Weekly'::"RoutinePeriod" THEN INTERVAL '1 week'
+            WHEN 'Monthly'::"RoutinePeriod" THEN INTERVAL '1 month'
+        END
+    )
+);
+-- ============================== SQL Separator ==============================
+ALTER TABLE "UserAccountTable"
+DROP COLUMN IF EXISTS routine_task_cost_unit_count;
@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS "BillingInvoiceTable";
+-- ============================== SQL Separator ==============================
+-- the mistaken unique index of the billing plan id is not restored, since it rejects the second subscriber of any billing plan
+DROP INDEX IF EXISTS "idx_UsersToBillingPlansTable_user_id";
+-- ============================== SQL Separator ==============================
+ALTER TABLE "UsersToBillingPlansTable" DROP COLUMN IF EXISTS "grace_period_ends_at";
+-- ============================== SQL Separator ==============================
+ALTER TABLE "UsersToBillingPlansTable" DROP COLUMN IF EXISTS "provider_subscription_id";
+-- ============================== SQL Separator ==============================
+DROP TYPE IF EXISTS "BillingInvoiceStatus";
@@ -0,0 +1,20 @@
+package migrations
+
+import (
+	_ "embed"
+
+	platformpostgres "github.com/HiIamJeff67/notegic-backend/shared/platform/postgres"
+)
+
+//go:embed billing_lifecycle_up.sql
+var billingLifecycleUpSQL string
+
+//go:embed billing_lifecycle_down.sql
+var billingLifecycleDownSQL string
+
+var addBillingLifecycleMigration = platformpostgres.VersionedMigration{
+	Version: 12,
+	Name:    "add_billing_lifecycle",
+	UpSQL:   billingLifecycleUpSQL,
+	DownSQL: billingLifecycleDownSQL,
+}
@@ -0,0 +1,19 @@
+CREATE TYPE "BillingInvoiceStatus" AS ENUM ('COMPLETED', 'REFUNDED');
+-- ============================== SQL Separator ==============================
+ALTER TABLE "UsersToBillingPlansTable" ADD COLUMN "provider_subscription_id" text DEFAULT null;
+-- ============================== SQL Separator ==============================
+ALTER TABLE "UsersToBillingPlansTable" ADD COLUMN "grace_period_ends_at" timestamptz DEFAULT null;
+-- ============================== SQL Separator ==============================
+ALTER TABLE "UsersToBillingPlansTable" ADD CONSTRAINT "uni_UsersToBillingPlansTable_provider_subscription_id" UNIQUE ("provider_subscription_id");
+-- ============================== SQL Separator ==============================
+CREATE INDEX IF NOT EXISTS "idx_UsersToBillingPlansTable_user_id" ON "UsersToBillingPlansTable" ("user_id");
+-- ============================== SQL Separator ==============================
+-- the billing plan id was once declared as a standalone unique index by mistake,
+-- which allowed only one user to subscribe to each billing plan
+DROP INDEX IF EXISTS "users_to_billing_plans_idx_user_id_billing_plan_id_partial_active";
+-- ============================== SQL Separator ==============================
+CREATE TABLE "BillingInvoiceTable" ("id" uuid DEFAULT gen_random_uuid(),"user_id" uuid NOT NULL,"users_to_billing_plans_id" uuid NOT NULL,"billing_plan_id" text NOT NULL,"provider_payment_id" text NOT NULL,"amount" decimal NOT NULL,"currency_code" "SupportedCurrencyCode" NOT NULL,"status" "BillingInvoiceStatus" NOT NULL DEFAULT 'COMPLETED',"paid_at" timestamptz NOT NULL,"refunded_at" timestamptz DEFAULT null,"updated_at" timestamptz NOT NULL,"created_at" timestamptz NOT NULL,PRIMARY KEY ("id"),CONSTRAINT "uni_BillingInvoiceTable_provider_payment_id" UNIQUE ("provider_payment_id"));
+-- ============================== SQL Separator ==============================
+CREATE INDEX IF NOT EXISTS "idx_BillingInvoiceTable_users_to_billing_plans_id" ON "BillingInvoiceTable" ("users_to_billing_plans_id");
+-- ============================== SQL Separator ==============================
+CREATE INDEX IF NOT EXISTS "billing_invoice_idx_user_id_paid_at" ON "BillingInvoiceTable" ("user_id","paid_at");
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "BlockPackYjsSnapshotTable";
+-- ============================== SQL Separator ==============================
+DROP TYPE IF EXISTS "BlockPackSnapshotKind";
@@ -0,0 +1,20 @@
+package migrations
+
+import (
+	_ "embed"
+
+	platformpostgres "github.com/HiIamJeff67/notegic-backend/shared/platform/postgres"
+)
+
+//go:embed block_pack_yjs_snapshot_up.sql
+var blockPackYjsSnapshotUpSQL string
+
+//go:embed block_pack_yjs_snapshot_down.sql
+var blockPackYjsSnapshotDownSQL string
+
+var createBlockPackYjsSnapshotTableMigration = platformpostgres.VersionedMigration{
+	Version: 7,
+	Name:    "create_block_pack_yjs_snapshot_table",
+	UpSQL:   blockPackYjsSnapshotUpSQL,
+	DownSQL: blockPackYjsSnapshotDownSQL,
+}
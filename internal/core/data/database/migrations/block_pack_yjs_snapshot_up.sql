@@ -0,0 +1,5 @@
+CREATE TYPE "BlockPackSnapshotKind" AS ENUM ('Named', 'Periodic', 'PreRestore');
+-- ============================== SQL Separator ==============================
+CREATE TABLE "BlockPackYjsSnapshotTable" ("id" uuid NOT NULL DEFAULT gen_random_uuid(),"block_pack_id" uuid NOT NULL,"kind" "BlockPackSnapshotKind" NOT NULL,"name" varchar(128) DEFAULT null,"state" bytea NOT NULL,"update_sequence" bigint NOT NULL DEFAULT 0,"creator_id" uuid DEFAULT null,"created_at" timestamptz NOT NULL,PRIMARY KEY ("id"));
+-- ============================== SQL Separator ==============================
+CREATE INDEX IF NOT EXISTS "block_pack_yjs_snapshot_idx_block_pack_id_kind_created_at" ON "BlockPackYjsSnapshotTable" ("block_pack_id","kind","created_at");
@@ -0,0 +1,37 @@
+DROP TRIGGER IF EXISTS trigger_reproject_search_vectors_after_language_update ON "UserSettingTable";
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_project_material_search_vector_before_insert_or_update ON "MaterialTable";
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_project_block_pack_search_vector_before_insert_or_update ON "BlockPackTable";
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_project_block_search_vector_before_insert_or_update ON "BlockTable";
+-- ============================== SQL Separator ==============================
+DROP FUNCTION IF EXISTS trigger_function_reproject_search_vectors_after_language_update();
+-- ============================== SQL Separator ==============================
+DROP FUNCTION IF EXISTS trigger_function_project_material_search_vector_before_insert_or_update();
+-- ============================== SQL Separator ==============================
+DROP FUNCTION IF EXISTS trigger_function_project_block_pack_search_vector_before_insert_or_update();
+-- ============================== SQL Separator ==============================
+DROP FUNCTION IF EXISTS trigger_function_project_block_search_vector_before_insert_or_update();
+-- ============================== SQL Separator ==============================
+DROP FUNCTION IF EXISTS block_search_text(JSONB);
+-- ============================== SQL Separator ==============================
+DROP FUNCTION IF EXISTS search_config_of_sub_shelf(UUID);
+-- ============================== SQL Separator ==============================
+DROP FUNCTION IF EXISTS search_config_of_user(UUID);
+-- ============================== SQL Separator ==============================
+DROP FUNCTION IF EXISTS search_config_of_language("Language");
+-- ============================== SQL Separator ==============================
+DROP INDEX IF EXISTS "material_idx_search_vector";
+-- ============================== SQL Separator ==============================
+DROP INDEX IF EXISTS "block_pack_idx_search_vector";
+-- ============================== SQL Separator ==============================
+DROP INDEX IF EXISTS "block_idx_search_vector";
+-- ============================== SQL Separator ==============================
+ALTER TABLE "MaterialTable" DROP COLUMN IF EXISTS "search_vector";
+-- ============================== SQL Separator ==============================
+ALTER TABLE "MaterialTable" DROP COLUMN IF EXISTS "search_text";
+-- ============================== SQL Separator ==============================
+ALTER TABLE "BlockPackTable" DROP COLUMN IF EXISTS "search_vector";
+-- ============================== SQL Separator ==============================
+ALTER TABLE "BlockTable" DROP COLUMN IF EXISTS "search_vector";
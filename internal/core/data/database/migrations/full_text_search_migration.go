@@ -0,0 +1,20 @@
+package migrations
+
+import (
+	_ "embed"
+
+	platformpostgres "github.com/HiIamJeff67/notegic-backend/shared/platform/postgres"
+)
+
+//go:embed full_text_search_up.sql
+var fullTextSearchUpSQL string
+
+//go:embed full_text_search_down.sql
+var fullTextSearchDownSQL string
+
+var addFullTextSearchVectorsMigration = platformpostgres.VersionedMigration{
+	Version: 3,
+	Name:    "add_full_text_search_vectors",
+	UpSQL:   fullTextSearchUpSQL,
+	DownSQL: fullTextSearchDownSQL,
+}
@@ -0,0 +1,203 @@
+ALTER TABLE "BlockTable" ADD COLUMN "search_vector" tsvector;
+-- ============================== SQL Separator ==============================
+ALTER TABLE "BlockPackTable" ADD COLUMN "search_vector" tsvector;
+-- ============================== SQL Separator ==============================
+ALTER TABLE "MaterialTable" ADD COLUMN "search_text" text DEFAULT null;
+-- ============================== SQL Separator ==============================
+ALTER TABLE "MaterialTable" ADD COLUMN "search_vector" tsvector;
+-- ============================== SQL Separator ==============================
+-- PostgreSQL ships stemming configurations for English only, the other supported languages
+-- (Chinese, Japanese and Korean) fall back to the simple configuration
+CREATE OR REPLACE FUNCTION search_config_of_language(language "Language")
+RETURNS regconfig AS $$
+    SELECT CASE language
+        WHEN 'English'::"Language" THEN 'english'::regconfig
+        ELSE 'simple'::regconfig
+    END;
+$$ LANGUAGE sql IMMUTABLE;
+-- ============================== SQL Separator ==============================
+CREATE OR REPLACE FUNCTION search_config_of_user(target_user_id UUID)
+RETURNS regconfig AS $$
+    SELECT COALESCE(
+        (
+            SELECT search_config_of_language(user_setting.language)
+            FROM "UserSettingTable" AS user_setting
+            WHERE user_setting.user_id = target_user_id
+        ),
+        'simple'::regconfig
+    );
+$$ LANGUAGE sql STABLE;
+-- ============================== SQL Separator ==============================
+-- the search vectors of the items are projected in the language of the owner of their root shelf
+CREATE OR REPLACE FUNCTION search_config_of_sub_shelf(target_sub_shelf_id UUID)
+RETURNS regconfig AS $$
+    SELECT COALESCE(
+        (
+            SELECT search_config_of_user(root_shelf.owner_id)
+            FROM "SubShelfTable" AS sub_shelf
+            JOIN "RootShelfTable" AS root_shelf
+              ON root_shelf.id = sub_shelf.root_shelf_id
+            WHERE sub_shelf.id = target_sub_shelf_id
+        ),
+        'simple'::regconfig
+    );
+$$ LANGUAGE sql STABLE;
+-- ============================== SQL Separator ==============================
+-- extract the plain text of the BlockNote inline contents (including the nested table cells and links),
+-- so that the JSON keys, styles and other markups are not indexed
+CREATE OR REPLACE FUNCTION block_search_text(content JSONB)
+RETURNS TEXT AS $$
+    SELECT COALESCE(string_agg(text_node.value #>> '{}', ' '), '')
+    FROM jsonb_path_query(
+        COALESCE(content, '{}'::jsonb),
+        'strict $.**.text ? (@.type() == "string")',
+        '{}'::jsonb,
+        true
+    ) AS text_node(value);
+$$ LANGUAGE sql IMMUTABLE;
+-- ============================== SQL Separator ==============================
+CREATE OR REPLACE FUNCTION trigger_function_project_block_search_vector_before_insert_or_update()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.search_vector := to_tsvector(
+        search_config_of_sub_shelf((
+            SELECT block_pack.parent_sub_shelf_id
+            FROM "BlockPackTable" AS block_pack
+            WHERE block_pack.id = NEW.block_pack_id
+        )),
+        block_search_text(NEW.content)
+    );
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_project_block_search_vector_before_insert_or_update ON "BlockTable";
+-- ============================== SQL Separator ==============================
+CREATE TRIGGER trigger_project_block_search_vector_before_insert_or_update
+    BEFORE INSERT OR UPDATE OF content, block_pack_id
+    ON "BlockTable"
+    FOR EACH ROW
+    EXECUTE FUNCTION trigger_function_project_block_search_vector_before_insert_or_update();
+-- ============================== SQL Separator ==============================
+-- backfill the blocks created before the search vector is projected
+UPDATE "BlockTable" AS block
+SET search_vector = to_tsvector(
+    search_config_of_sub_shelf(block_pack.parent_sub_shelf_id),
+    block_search_text(block.content)
+)
+FROM "BlockPackTable" AS block_pack
+WHERE block_pack.id = block.block_pack_id
+  AND block.search_vector IS NULL;
+-- ============================== SQL Separator ==============================
+CREATE OR REPLACE FUNCTION trigger_function_project_block_pack_search_vector_before_insert_or_update()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.search_vector := setweight(
+        to_tsvector(search_config_of_sub_shelf(NEW.parent_sub_shelf_id), NEW.name),
+        'A'
+    );
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_project_block_pack_search_vector_before_insert_or_update ON "BlockPackTable";
+-- ============================== SQL Separator ==============================
+CREATE TRIGGER trigger_project_block_pack_search_vector_before_insert_or_update
+    BEFORE INSERT OR UPDATE OF name, parent_sub_shelf_id
+    ON "BlockPackTable"
+    FOR EACH ROW
+    EXECUTE FUNCTION trigger_function_project_block_pack_search_vector_before_insert_or_update();
+-- ============================== SQL Separator ==============================
+-- backfill the block packs created before the search vector is projected
+UPDATE "BlockPackTable"
+SET search_vector = setweight(
+    to_tsvector(search_config_of_sub_shelf(parent_sub_shelf_id), name),
+    'A'
+)
+WHERE search_vector IS NULL;
+-- ============================== SQL Separator ==============================
+CREATE OR REPLACE FUNCTION trigger_function_project_material_search_vector_before_insert_or_update()
+RETURNS TRIGGER AS $$
+DECLARE
+    search_config regconfig;
+BEGIN
+    search_config := search_config_of_sub_shelf(NEW.parent_sub_shelf_id);
+    NEW.search_vector :=
+        setweight(to_tsvector(search_config, NEW.name), 'A') ||
+        setweight(to_tsvector(search_config, COALESCE(NEW.search_text, '')), 'B');
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_project_material_search_vector_before_insert_or_update ON "MaterialTable";
+-- ============================== SQL Separator ==============================
+CREATE TRIGGER trigger_project_material_search_vector_before_insert_or_update
+    BEFORE INSERT OR UPDATE OF name, search_text, parent_sub_shelf_id
+    ON "MaterialTable"
+    FOR EACH ROW
+    EXECUTE FUNCTION trigger_function_project_material_search_vector_before_insert_or_update();
+-- ============================== SQL Separator ==============================
+-- backfill the materials created before the search vector is projected
+UPDATE "MaterialTable"
+SET search_vector =
+    setweight(to_tsvector(search_config_of_sub_shelf(parent_sub_shelf_id), name), 'A') ||
+    setweight(to_tsvector(search_config_of_sub_shelf(parent_sub_shelf_id), COALESCE(search_text, '')), 'B')
+WHERE search_vector IS NULL;
+-- ============================== SQL Separator ==============================
+-- re-project the search vectors of the items owned by the user once the language of the user is changed
+CREATE OR REPLACE FUNCTION trigger_function_reproject_search_vectors_after_language_update()
+RETURNS TRIGGER AS $$
+DECLARE
+    search_config regconfig;
+BEGIN
+    search_config := search_config_of_language(NEW.language);
+
+    UPDATE "BlockPackTable" AS block_pack
+    SET search_vector = setweight(to_tsvector(search_config, block_pack.name), 'A')
+    FROM "SubShelfTable" AS sub_shelf
+    JOIN "RootShelfTable" AS root_shelf
+      ON root_shelf.id = sub_shelf.root_shelf_id
+    WHERE sub_shelf.id = block_pack.parent_sub_shelf_id
+      AND root_shelf.owner_id = NEW.user_id;
+
+    UPDATE "BlockTable" AS block
+    SET search_vector = to_tsvector(search_config, block_search_text(block.content))
+    FROM "BlockPackTable" AS block_pack
+    JOIN "SubShelfTable" AS sub_shelf
+      ON sub_shelf.id = block_pack.parent_sub_shelf_id
+    JOIN "RootShelfTable" AS root_shelf
+      ON root_shelf.id = sub_shelf.root_shelf_id
+    WHERE block_pack.id = block.block_pack_id
+      AND root_shelf.owner_id = NEW.user_id;
+
+    UPDATE "MaterialTable" AS material
+    SET search_vector =
+        setweight(to_tsvector(search_config, material.name), 'A') ||
+        setweight(to_tsvector(search_config, COALESCE(material.search_text, '')), 'B')
+    FROM "SubShelfTable" AS sub_shelf
+    JOIN "RootShelfTable" AS root_shelf
+      ON root_shelf.id = sub_shelf.root_shelf_id
+    WHERE sub_shelf.id = material.parent_sub_shelf_id
+      AND root_shelf.owner_id = NEW.user_id;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- ============================== SQL Separator ==============================
+DROP TRIGGER IF EXISTS trigger_reproject_search_vectors_after_language_update ON "UserSettingTable";
+-- ============================== SQL Separator ==============================
+CREATE TRIGGER trigger_reproject_search_vectors_after_language_update
+    AFTER UPDATE OF language
+    ON "UserSettingTable"
+    FOR EACH ROW
+    WHEN (OLD.language IS DISTINCT FROM NEW.language)
+    EXECUTE FUNCTION trigger_function_reproject_search_vectors_after_language_update();
+-- ============================== SQL Separator ==============================
+CREATE INDEX IF NOT EXISTS "block_idx_search_vector" ON "BlockTable" USING gin("search_vector");
+-- ============================== SQL Separator ==============================
+CREATE INDEX IF NOT EXISTS "block_pack_idx_search_vector" ON "BlockPackTable" USING gin("search_vector");
+-- ============================== SQL Separator ==============================
+CREATE INDEX IF NOT EXISTS "material_idx_search_vector" ON "MaterialTable" USING gin("search_vector");
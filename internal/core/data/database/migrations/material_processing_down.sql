@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS "material_idx_processing_status_updated_at";
+-- ============================== SQL Separator ==============================
+ALTER TABLE "MaterialTable" DROP COLUMN IF EXISTS "preview_key";
+-- ============================== SQL Separator ==============================
+ALTER TABLE "MaterialTable" DROP COLUMN IF EXISTS "processing_status";
+-- ============================== SQL Separator ==============================
+DROP TYPE IF EXISTS "MaterialProcessingStatus";
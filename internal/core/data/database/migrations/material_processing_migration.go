@@ -0,0 +1,20 @@
+package migrations
+
+import (
+	_ "embed"
+
+	platformpostgres "github.com/HiIamJeff67/notegic-backend/shared/platform/postgres"
+)
+
+//go:embed material_processing_up.sql
+var materialProcessingUpSQL string
+
+//go:embed material_processing_down.sql
+var materialProcessingDownSQL string
+
+var addMaterialProcessingColumnsMigration = platformpostgres.VersionedMigration{
+	Version: 17,
+	Name:    "add_material_processing_columns",
+	UpSQL:   materialProcessingUpSQL,
+	DownSQL: materialProcessingDownSQL,
+}
@@ -0,0 +1,8 @@
+CREATE TYPE "MaterialProcessingStatus" AS ENUM ('None', 'Pending', 'Processing', 'Completed', 'Skipped', 'Failed');
+-- ============================== SQL Separator ==============================
+-- the existing materials stay as None, since their search text has been extracted synchronously before
+ALTER TABLE "MaterialTable" ADD COLUMN "processing_status" "MaterialProcessingStatus" NOT NULL DEFAULT 'None';
+-- ============================== SQL Separator ==============================
+ALTER TABLE "MaterialTable" ADD COLUMN "preview_key" text DEFAULT null;
+-- ============================== SQL Separator ==============================
+CREATE INDEX IF NOT EXISTS "material_idx_processing_status_updated_at" ON "MaterialTable" ("processing_status","updated_at");
@@ -0,0 +1,20 @@
+package migrations
+
+import (
+	_ "embed"
+
+	platformpostgres "github.com/HiIamJeff67/notegic-backend/shared/platform/postgres"
+)
+
+//go:embed material_upload_up.sql
+var materialUploadUpSQL string
+
+//go:embed material_upload_down.sql
+var materialUploadDownSQL string
+
+var createMaterialUploadTableMigration = platformpostgres.VersionedMigration{
+	Version: 16,
+	Name:    "create_material_upload_table",
+	UpSQL:   materialUploadUpSQL,
+	DownSQL: materialUploadDownSQL,
+}
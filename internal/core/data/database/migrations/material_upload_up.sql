@@ -0,0 +1,5 @@
+CREATE TABLE "MaterialUploadTable" ("id" uuid NOT NULL,"material_id" uuid NOT NULL,"user_id" uuid NOT NULL,"content_key" text NOT NULL,"storage_upload_id" text NOT NULL,"size" bigint NOT NULL,"content_type" "MaterialContentType" NOT NULL,"part_size" bigint NOT NULL,"part_count" integer NOT NULL,"expires_at" timestamptz NOT NULL,"created_at" timestamptz NOT NULL,PRIMARY KEY ("id"),CONSTRAINT "uni_MaterialUploadTable_content_key" UNIQUE ("content_key"));
+-- ============================== SQL Separator ==============================
+CREATE INDEX IF NOT EXISTS "material_upload_idx_expires_at" ON "MaterialUploadTable" ("expires_at");
+-- ============================== SQL Separator ==============================
+CREATE INDEX IF NOT EXISTS "material_upload_idx_material_id" ON "MaterialUploadTable" ("material_id");
@@ -0,0 +1,37 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+
+	platformpostgres "github.com/HiIamJeff67/notegic-backend/shared/platform/postgres"
+)
+
+// only one replica of the Core migrates at a time under this advisory lock
+const VersionedMigrationLockName = "notegic-core:schema_migrations"
+
+// place the versioned migrations here in the order of their versions, and never edit
+// a released one, change the schema with a new versioned migration instead
+var VersionedMigrations = []platformpostgres.VersionedMigration{
+	baselineMigration,
+	addRoutineRecurrenceRuleColumnsMigration,
+	addFullTextSearchVectorsMigration,
+	addAPIKeyScopeColumnsMigration,
+	addPlanLimitationAPIKeyRateLimitColumnsMigration,
+	createRootShelfArchiveJobTableMigration,
+	createBlockPackYjsSnapshotTableMigration,
+	createShareLinkTableMigration,
+	createUserSessionTableMigration,
+	createUserTwoFactorTableMigration,
+	addUserAccountOAuthCredentialColumnsMigration,
+	addBillingLifecycleMigration,
+	createUserUsageSnapshotTableMigration,
+	createUserQuotaWarningTableMigration,
+	addPlanLimitationTrashRetentionDaysColumnMigration,
+	createMaterialUploadTableMigration,
+	addMaterialProcessingColumnsMigration,
+	addSubscriptionProviderCancellationColumnMigration,
+}
+
+func NewVersionedMigrator(db *gorm.DB) (*platformpostgres.VersionedMigrator, error) {
+	return platformpostgres.NewVersionedMigrator(db, VersionedMigrationLockName, VersionedMigrations)
+}
@@ -0,0 +1,3 @@
+ALTER TABLE "PlanLimitationTable" DROP COLUMN IF EXISTS "max_api_key_sustained_request_count";
+-- ============================== SQL Separator ==============================
+ALTER TABLE "PlanLimitationTable" DROP COLUMN IF EXISTS "max_api_key_burst_request_count";
@@ -0,0 +1,20 @@
+package migrations
+
+import (
+	_ "embed"
+
+	platformpostgres "github.com/HiIamJeff67/notegic-backend/shared/platform/postgres"
+)
+
+//go:embed plan_limitation_api_key_rate_limit_up.sql
+var planLimitationApiKeyRateLimitUpSQL string
+
+//go:embed plan_limitation_api_key_rate_limit_down.sql
+var planLimitationApiKeyRateLimitDownSQL string
+
+var addPlanLimitationAPIKeyRateLimitColumnsMigration = platformpostgres.VersionedMigration{
+	Version: 5,
+	Name:    "add_plan_limitation_api_key_rate_limit_columns",
+	UpSQL:   planLimitationApiKeyRateLimitUpSQL,
+	DownSQL: planLimitationApiKeyRateLimitDownSQL,
+}
@@ -0,0 +1,3 @@
+ALTER TABLE "PlanLimitationTable" ADD COLUMN "max_api_key_burst_request_count" integer NOT NULL DEFAULT 0;
+-- ============================== SQL Separator ==============================
+ALTER TABLE "PlanLimitationTable" ADD COLUMN "max_api_key_sustained_request_count" integer NOT NULL DEFAULT 0;
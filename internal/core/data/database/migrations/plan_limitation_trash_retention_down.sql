@@ -0,0 +1 @@
+ALTER TABLE "PlanLimitationTable" DROP COLUMN IF EXISTS "trash_retention_days";
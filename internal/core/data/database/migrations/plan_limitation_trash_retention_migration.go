@@ -0,0 +1,20 @@
+package migrations
+
+import (
+	_ "embed"
+
+	platformpostgres "github.com/HiIamJeff67/notegic-backend/shared/platform/postgres"
+)
+
+//go:embed plan_limitation_trash_retention_up.sql
+var planLimitationTrashRetentionUpSQL string
+
+//go:embed plan_limitation_trash_retention_down.sql
+var planLimitationTrashRetentionDownSQL string
+
+var addPlanLimitationTrashRetentionDaysColumnMigration = platformpostgres.VersionedMigration{
+	Version: 15,
+	Name:    "add_plan_limitation_trash_retention_days_column",
+	UpSQL:   planLimitationTrashRetentionUpSQL,
+	DownSQL: planLimitationTrashRetentionDownSQL,
+}
@@ -0,0 +1 @@
+ALTER TABLE "PlanLimitationTable" ADD COLUMN "trash_retention_days" integer NOT NULL DEFAULT 30;
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "RootShelfArchiveJobTable";
+-- ============================== SQL Separator ==============================
+DROP TYPE IF EXISTS "RootShelfArchiveJobType";
+-- ============================== SQL Separator ==============================
+DROP TYPE IF EXISTS "RootShelfArchiveJobStatus";
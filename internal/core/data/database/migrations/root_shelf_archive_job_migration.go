@@ -0,0 +1,20 @@
+package migrations
+
+import (
+	_ "embed"
+
+	platformpostgres "github.com/HiIamJeff67/notegic-backend/shared/platform/postgres"
+)
+
+//go:embed root_shelf_archive_job_up.sql
+var rootShelfArchiveJobUpSQL string
+
+//go:embed root_shelf_archive_job_down.sql
+var rootShelfArchiveJobDownSQL string
+
+var createRootShelfArchiveJobTableMigration = platformpostgres.VersionedMigration{
+	Version: 6,
+	Name:    "create_root_shelf_archive_job_table",
+	UpSQL:   rootShelfArchiveJobUpSQL,
+	DownSQL: rootShelfArchiveJobDownSQL,
+}
@@ -0,0 +1,9 @@
+CREATE TYPE "RootShelfArchiveJobStatus" AS ENUM ('Pending', 'Running', 'Succeeded', 'Failed');
+-- ============================== SQL Separator ==============================
+CREATE TYPE "RootShelfArchiveJobType" AS ENUM ('Export', 'Import');
+-- ============================== SQL Separator ==============================
+CREATE TABLE "RootShelfArchiveJobTable" ("id" uuid DEFAULT gen_random_uuid(),"owner_id" uuid NOT NULL,"type" "RootShelfArchiveJobType" NOT NULL,"status" "RootShelfArchiveJobStatus" NOT NULL DEFAULT 'Pending',"source_root_shelf_id" uuid DEFAULT null,"target_root_shelf_id" uuid DEFAULT null,"target_root_shelf_name" varchar(128) DEFAULT null,"archive_key" text NOT NULL,"archive_size" bigint NOT NULL DEFAULT 0,"total_item_count" bigint NOT NULL DEFAULT 0,"processed_item_count" bigint NOT NULL DEFAULT 0,"last_notified_progress" integer NOT NULL DEFAULT 0,"attempts" integer NOT NULL DEFAULT 0,"error_message" text DEFAULT null,"claimed_until" timestamptz DEFAULT null,"started_at" timestamptz DEFAULT null,"completed_at" timestamptz DEFAULT null,"updated_at" timestamptz NOT NULL,"created_at" timestamptz NOT NULL,PRIMARY KEY ("id"),CONSTRAINT "uni_RootShelfArchiveJobTable_archive_key" UNIQUE ("archive_key"));
+-- ============================== SQL Separator ==============================
+CREATE INDEX IF NOT EXISTS "root_shelf_archive_job_idx_status_created_at" ON "RootShelfArchiveJobTable" ("status","created_at");
+-- ============================== SQL Separator ==============================
+CREATE INDEX IF NOT EXISTS "idx_RootShelfArchiveJobTable_owner_id" ON "RootShelfArchiveJobTable" ("owner_id");
@@ -0,0 +1,9 @@
+ALTER TABLE "RoutineTaskTable" DROP CONSTRAINT IF EXISTS routine_task_check_period_recurrence_rule_exclusive;
+-- ============================== SQL Separator ==============================
+ALTER TABLE "RoutineTable" DROP CONSTRAINT IF EXISTS routine_check_period_recurrence_rule_exclusive;
+-- ============================== SQL Separator ==============================
+ALTER TABLE "RoutineTaskTable" DROP COLUMN IF EXISTS "recurrence_start_at";
+-- ============================== SQL Separator ==============================
+ALTER TABLE "RoutineTaskTable" DROP COLUMN IF EXISTS "recurrence_rule";
+-- ============================== SQL Separator ==============================
+ALTER TABLE "RoutineTable" DROP COLUMN IF EXISTS "recurrence_rule";
@@ -0,0 +1,20 @@
+package migrations
+
+import (
+	_ "embed"
+
+	platformpostgres "github.com/HiIamJeff67/notegic-backend/shared/platform/postgres"
+)
+
+//go:embed routine_recurrence_rule_up.sql
+var routineRecurrenceRuleUpSQL string
+
+//go:embed routine_recurrence_rule_down.sql
+var routineRecurrenceRuleDownSQL string
+
+var addRoutineRecurrenceRuleColumnsMigration = platformpostgres.VersionedMigration{
+	Version: 2,
+	Name:    "add_routine_recurrence_rule_columns",
+	UpSQL:   routineRecurrenceRuleUpSQL,
+	DownSQL: routineRecurrenceRuleDownSQL,
+}
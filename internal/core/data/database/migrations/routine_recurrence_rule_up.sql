@@ -0,0 +1,19 @@
+ALTER TABLE "RoutineTable" ADD COLUMN "recurrence_rule" varchar(512) DEFAULT null;
+-- ============================== SQL Separator ==============================
+ALTER TABLE "RoutineTaskTable" ADD COLUMN "recurrence_rule" varchar(512) DEFAULT null;
+-- ============================== SQL Separator ==============================
+ALTER TABLE "RoutineTaskTable" ADD COLUMN "recurrence_start_at" timestamptz DEFAULT null;
+-- ============================== SQL Separator ==============================
+ALTER TABLE "RoutineTable"
+ADD CONSTRAINT routine_check_period_recurrence_rule_exclusive
+CHECK (
+    period IS NULL
+    OR recurrence_rule IS NULL
+);
+-- ============================== SQL Separator ==============================
+ALTER TABLE "RoutineTaskTable"
+ADD CONSTRAINT routine_task_check_period_recurrence_rule_exclusive
+CHECK (
+    period IS NULL
+    OR recurrence_rule IS NULL
+);
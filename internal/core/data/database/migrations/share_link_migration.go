@@ -0,0 +1,20 @@
+package migrations
+
+import (
+	_ "embed"
+
+	platformpostgres "github.com/HiIamJeff67/notegic-backend/shared/platform/postgres"
+)
+
+//go:embed share_link_up.sql
+var shareLinkUpSQL string
+
+//go:embed share_link_down.sql
+var shareLinkDownSQL string
+
+var createShareLinkTableMigration = platformpostgres.VersionedMigration{
+	Version: 8,
+	Name:    "create_share_link_table",
+	UpSQL:   shareLinkUpSQL,
+	DownSQL: shareLinkDownSQL,
+}
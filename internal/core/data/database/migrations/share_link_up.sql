@@ -0,0 +1,7 @@
+CREATE TABLE "ShareLinkTable" ("id" uuid DEFAULT gen_random_uuid(),"token_hash" varchar(64) NOT NULL,"root_shelf_id" uuid NOT NULL,"block_pack_id" uuid DEFAULT null,"permission" "AccessControlPermission" NOT NULL DEFAULT 'Read',"password_hash" text DEFAULT null,"max_use_count" integer DEFAULT null,"use_count" integer NOT NULL DEFAULT 0,"expires_at" timestamptz DEFAULT null,"revoked_at" timestamptz DEFAULT null,"last_used_at" timestamptz DEFAULT null,"creator_id" uuid NOT NULL,"updated_at" timestamptz NOT NULL,"created_at" timestamptz NOT NULL,PRIMARY KEY ("id"),CONSTRAINT "uni_ShareLinkTable_token_hash" UNIQUE ("token_hash"));
+-- ============================== SQL Separator ==============================
+CREATE INDEX IF NOT EXISTS "idx_ShareLinkTable_creator_id" ON "ShareLinkTable" ("creator_id");
+-- ============================== SQL Separator ==============================
+CREATE INDEX IF NOT EXISTS "idx_ShareLinkTable_block_pack_id" ON "ShareLinkTable" ("block_pack_id");
+-- ============================== SQL Separator ==============================
+CREATE INDEX IF NOT EXISTS "share_link_idx_root_shelf_id_created_at" ON "ShareLinkTable" ("root_shelf_id","created_at");
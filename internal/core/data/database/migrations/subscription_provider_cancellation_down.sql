@@ -0,0 +1 @@
+ALTER TABLE "UsersToBillingPlansTable" DROP COLUMN IF EXISTS "provider_cancellation_reason";
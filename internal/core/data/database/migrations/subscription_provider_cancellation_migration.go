@@ -0,0 +1,20 @@
+package migrations
+
+import (
+	_ "embed"
+
+	platformpostgres "github.com/HiIamJeff67/notegic-backend/shared/platform/postgres"
+)
+
+//go:embed subscription_provider_cancellation_up.sql
+var subscriptionProviderCancellationUpSQL string
+
+//go:embed subscription_provider_cancellation_down.sql
+var subscriptionProviderCancellationDownSQL string
+
+var addSubscriptionProviderCancellationColumnMigration = platformpostgres.VersionedMigration{
+	Version: 18,
+	Name:    "add_subscription_provider_cancellation_column",
+	UpSQL:   subscriptionProviderCancellationUpSQL,
+	DownSQL: subscriptionProviderCancellationDownSQL,
+}
@@ -0,0 +1,2 @@
+-- set while the subscription cancelled or expired locally still has to be cancelled at the provider
+ALTER TABLE "UsersToBillingPlansTable" ADD COLUMN "provider_cancellation_reason" text DEFAULT null;
@@ -0,0 +1,5 @@
+ALTER TABLE "UserAccountTable" DROP COLUMN IF EXISTS "oidc_credential";
+-- ============================== SQL Separator ==============================
+ALTER TABLE "UserAccountTable" DROP COLUMN IF EXISTS "meta_credential";
+-- ============================== SQL Separator ==============================
+ALTER TABLE "UserAccountTable" DROP COLUMN IF EXISTS "github_credential";
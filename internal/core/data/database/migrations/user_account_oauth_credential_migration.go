@@ -0,0 +1,20 @@
+package migrations
+
+import (
+	_ "embed"
+
+	platformpostgres "github.com/HiIamJeff67/notegic-backend/shared/platform/postgres"
+)
+
+//go:embed user_account_oauth_credential_up.sql
+var userAccountOauthCredentialUpSQL string
+
+//go:embed user_account_oauth_credential_down.sql
+var userAccountOauthCredentialDownSQL string
+
+var addUserAccountOAuthCredentialColumnsMigration = platformpostgres.VersionedMigration{
+	Version: 11,
+	Name:    "add_user_account_oauth_credential_columns",
+	UpSQL:   userAccountOauthCredentialUpSQL,
+	DownSQL: userAccountOauthCredentialDownSQL,
+}
@@ -0,0 +1,11 @@
+ALTER TABLE "UserAccountTable" ADD COLUMN "github_credential" text;
+-- ============================== SQL Separator ==============================
+ALTER TABLE "UserAccountTable" ADD COLUMN "meta_credential" text;
+-- ============================== SQL Separator ==============================
+ALTER TABLE "UserAccountTable" ADD COLUMN "oidc_credential" text;
+-- ============================== SQL Separator ==============================
+ALTER TABLE "UserAccountTable" ADD CONSTRAINT "uni_UserAccountTable_github_credential" UNIQUE ("github_credential");
+-- ============================== SQL Separator ==============================
+ALTER TABLE "UserAccountTable" ADD CONSTRAINT "uni_UserAccountTable_meta_credential" UNIQUE ("meta_credential");
+-- ============================== SQL Separator ==============================
+ALTER TABLE "UserAccountTable" ADD CONSTRAINT "uni_UserAccountTable_oidc_credential" UNIQUE ("oidc_credential");
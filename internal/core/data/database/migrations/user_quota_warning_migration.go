@@ -0,0 +1,20 @@
+package migrations
+
+import (
+	_ "embed"
+
+	platformpostgres "github.com/HiIamJeff67/notegic-backend/shared/platform/postgres"
+)
+
+//go:embed user_quota_warning_up.sql
+var userQuotaWarningUpSQL string
+
+//go:embed user_quota_warning_down.sql
+var userQuotaWarningDownSQL string
+
+var createUserQuotaWarningTableMigration = platformpostgres.VersionedMigration{
+	Version: 14,
+	Name:    "create_user_quota_warning_table",
+	UpSQL:   userQuotaWarningUpSQL,
+	DownSQL: userQuotaWarningDownSQL,
+}
@@ -0,0 +1,3 @@
+CREATE TABLE "UserQuotaWarningTable" ("id" uuid DEFAULT gen_random_uuid(),"user_id" uuid NOT NULL,"limit_key" varchar(32) NOT NULL,"threshold" integer NOT NULL,"cycle_started_at" timestamptz NOT NULL,"used" bigint NOT NULL,"maximum" bigint NOT NULL,"created_at" timestamptz NOT NULL,PRIMARY KEY ("id"));
+-- ============================== SQL Separator ==============================
+CREATE UNIQUE INDEX IF NOT EXISTS "user_quota_warning_idx_user_id_limit_key_threshold_cycle" ON "UserQuotaWarningTable" ("user_id","limit_key","threshold","cycle_started_at");
@@ -0,0 +1,6 @@
+-- the users sign in again, since their sessions can not be folded back into a single refresh token
+ALTER TABLE "UserTable" ADD COLUMN "refresh_token" text NOT NULL DEFAULT '';
+-- ============================== SQL Separator ==============================
+ALTER TABLE "UserTable" ALTER COLUMN "refresh_token" DROP DEFAULT;
+-- ============================== SQL Separator ==============================
+DROP TABLE IF EXISTS "UserSessionTable";
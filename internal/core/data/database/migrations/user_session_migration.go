@@ -0,0 +1,20 @@
+package migrations
+
+import (
+	_ "embed"
+
+	platformpostgres "github.com/HiIamJeff67/notegic-backend/shared/platform/postgres"
+)
+
+//go:embed user_session_up.sql
+var userSessionUpSQL string
+
+//go:embed user_session_down.sql
+var userSessionDownSQL string
+
+var createUserSessionTableMigration = platformpostgres.VersionedMigration{
+	Version: 9,
+	Name:    "create_user_session_table",
+	UpSQL:   userSessionUpSQL,
+	DownSQL: userSessionDownSQL,
+}
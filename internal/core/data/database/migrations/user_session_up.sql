@@ -0,0 +1,8 @@
+CREATE TABLE "UserSessionTable" ("id" uuid,"user_id" uuid NOT NULL,"device_label" varchar(64) NOT NULL,"user_agent" text NOT NULL,"ip_address" varchar(45) NOT NULL DEFAULT '',"refresh_token_hash" varchar(64) NOT NULL,"prev_refresh_token_hash" varchar(64) DEFAULT null,"refreshed_at" timestamptz DEFAULT null,"last_seen_at" timestamptz NOT NULL,"expires_at" timestamptz NOT NULL,"revoked_at" timestamptz DEFAULT null,"updated_at" timestamptz NOT NULL,"created_at" timestamptz NOT NULL,PRIMARY KEY ("id"),CONSTRAINT "uni_UserSessionTable_refresh_token_hash" UNIQUE ("refresh_token_hash"));
+-- ============================== SQL Separator ==============================
+CREATE INDEX IF NOT EXISTS "idx_UserSessionTable_prev_refresh_token_hash" ON "UserSessionTable" ("prev_refresh_token_hash");
+-- ============================== SQL Separator ==============================
+CREATE INDEX IF NOT EXISTS "user_session_idx_user_id_last_seen_at" ON "UserSessionTable" ("user_id","last_seen_at");
+-- ============================== SQL Separator ==============================
+-- the single refresh token of each user is replaced by the sessions, so every user signs in again
+ALTER TABLE "UserTable" DROP COLUMN IF EXISTS "refresh_token";
@@ -0,0 +1,20 @@
+package migrations
+
+import (
+	_ "embed"
+
+	platformpostgres "github.com/HiIamJeff67/notegic-backend/shared/platform/postgres"
+)
+
+//go:embed user_two_factor_up.sql
+var userTwoFactorUpSQL string
+
+//go:embed user_two_factor_down.sql
+var userTwoFactorDownSQL string
+
+var createUserTwoFactorTableMigration = platformpostgres.VersionedMigration{
+	Version: 10,
+	Name:    "create_user_two_factor_table",
+	UpSQL:   userTwoFactorUpSQL,
+	DownSQL: userTwoFactorDownSQL,
+}
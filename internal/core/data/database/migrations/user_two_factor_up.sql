@@ -0,0 +1 @@
+CREATE TABLE "UserTwoFactorTable" ("id" uuid DEFAULT gen_random_uuid(),"user_id" uuid NOT NULL,"encrypted_secret" varchar(256) NOT NULL,"recovery_code_hashes" text[] NOT NULL DEFAULT '{}',"last_used_step" bigint NOT NULL DEFAULT 0,"enabled_at" timestamptz DEFAULT null,"updated_at" timestamptz NOT NULL,"created_at" timestamptz NOT NULL,PRIMARY KEY ("id"),CONSTRAINT "uni_UserTwoFactorTable_user_id" UNIQUE ("user_id"));
@@ -0,0 +1,20 @@
+package migrations
+
+import (
+	_ "embed"
+
+	platformpostgres "github.com/HiIamJeff67/notegic-backend/shared/platform/postgres"
+)
+
+//go:embed user_usage_snapshot_up.sql
+var userUsageSnapshotUpSQL string
+
+//go:embed user_usage_snapshot_down.sql
+var userUsageSnapshotDownSQL string
+
+var createUserUsageSnapshotTableMigration = platformpostgres.VersionedMigration{
+	Version: 13,
+	Name:    "create_user_usage_snapshot_table",
+	UpSQL:   userUsageSnapshotUpSQL,
+	DownSQL: userUsageSnapshotDownSQL,
+}
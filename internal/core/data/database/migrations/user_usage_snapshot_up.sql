@@ -0,0 +1,5 @@
+CREATE TABLE "UserUsageSnapshotTable" ("id" uuid DEFAULT gen_random_uuid(),"user_id" uuid NOT NULL,"snapshot_date" date NOT NULL,"root_shelf_count" bigint NOT NULL DEFAULT 0,"block_pack_count" bigint NOT NULL DEFAULT 0,"block_count" bigint NOT NULL DEFAULT 0,"material_count" bigint NOT NULL DEFAULT 0,"workflow_count" bigint NOT NULL DEFAULT 0,"additional_item_count" bigint NOT NULL DEFAULT 0,"station_count" bigint NOT NULL DEFAULT 0,"routine_count" bigint NOT NULL DEFAULT 0,"routine_tag_count" bigint NOT NULL DEFAULT 0,"routine_task_cost_unit_used" bigint NOT NULL DEFAULT 0,"updated_at" timestamptz NOT NULL,"created_at" timestamptz NOT NULL,PRIMARY KEY ("id"));
+-- ============================== SQL Separator ==============================
+CREATE INDEX IF NOT EXISTS "idx_UserUsageSnapshotTable_snapshot_date" ON "UserUsageSnapshotTable" ("snapshot_date");
+-- ============================== SQL Separator ==============================
+CREATE UNIQUE INDEX IF NOT EXISTS "user_usage_snapshot_idx_user_id_snapshot_date" ON "UserUsageSnapshotTable" ("user_id","snapshot_date");
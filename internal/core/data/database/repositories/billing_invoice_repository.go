@@ -0,0 +1,107 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm/clause"
+
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+
+	inputs "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/inputs"
+	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
+	schemas "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas"
+	enums "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/enums"
+	apiexceptions "github.com/HiIamJeff67/notegic-backend/internal/core/exceptions"
+)
+
+type BillingInvoiceRepositoryInterface interface {
+	GetManyByUserId(userId uuid.UUID, limit int, offset int, opts ...options.RepositoryOptions) ([]schemas.BillingInvoice, *exceptions.Exception)
+	CreateOneIfNotExists(userId uuid.UUID, input inputs.CreateBillingInvoiceInput, opts ...options.RepositoryOptions) (bool, *exceptions.Exception)
+	RefundOneByProviderPaymentId(providerPaymentId string, refundedAt time.Time, opts ...options.RepositoryOptions) (bool, *exceptions.Exception)
+}
+
+type BillingInvoiceRepository struct{}
+
+func NewBillingInvoiceRepository() BillingInvoiceRepositoryInterface {
+	return &BillingInvoiceRepository{}
+}
+
+func (r *BillingInvoiceRepository) GetManyByUserId(
+	userId uuid.UUID,
+	limit int,
+	offset int,
+	opts ...options.RepositoryOptions,
+) ([]schemas.BillingInvoice, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	billingInvoices := []schemas.BillingInvoice{}
+	result := parsedOptions.DB.
+		Model(&schemas.BillingInvoice{}).
+		Where("user_id = ?", userId).
+		Order("paid_at DESC, id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&billingInvoices)
+	if result.Error != nil {
+		return nil, apiexceptions.NewBillingInvoiceException().NotFound().WithOrigin(result.Error)
+	}
+
+	return billingInvoices, nil
+}
+
+// CreateOneIfNotExists records the payment only once, it returns false without
+// an exception if the payment of the provider has already been recorded.
+func (r *BillingInvoiceRepository) CreateOneIfNotExists(
+	userId uuid.UUID,
+	input inputs.CreateBillingInvoiceInput,
+	opts ...options.RepositoryOptions,
+) (bool, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	newBillingInvoice := schemas.BillingInvoice{
+		UserId:                userId,
+		UsersToBillingPlansId: input.UsersToBillingPlansId,
+		BillingPlanId:         input.BillingPlanId,
+		ProviderPaymentId:     input.ProviderPaymentId,
+		Amount:                input.Amount,
+		CurrencyCode:          input.CurrencyCode,
+		Status:                enums.BillingInvoiceStatus_Completed,
+		PaidAt:                input.PaidAt,
+	}
+	result := parsedOptions.DB.
+		Model(&schemas.BillingInvoice{}).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "provider_payment_id"}},
+			DoNothing: true,
+		}).
+		Create(&newBillingInvoice)
+	if result.Error != nil {
+		return false, apiexceptions.NewBillingInvoiceException().FailedToCreate().WithOrigin(result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// RefundOneByProviderPaymentId returns false without an exception if the payment
+// has not been recorded or has already been refunded.
+func (r *BillingInvoiceRepository) RefundOneByProviderPaymentId(
+	providerPaymentId string,
+	refundedAt time.Time,
+	opts ...options.RepositoryOptions,
+) (bool, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	result := parsedOptions.DB.
+		Model(&schemas.BillingInvoice{}).
+		Where("provider_payment_id = ? AND status <> ?", providerPaymentId, enums.BillingInvoiceStatus_Refunded).
+		Updates(map[string]any{
+			"status":      enums.BillingInvoiceStatus_Refunded,
+			"refunded_at": refundedAt,
+		})
+	if result.Error != nil {
+		return false, apiexceptions.NewBillingInvoiceException().FailedToUpdate().WithOrigin(result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,57 @@
+package repositories
+
+import (
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+
+	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
+	schemas "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas"
+	enums "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/enums"
+	apiexceptions "github.com/HiIamJeff67/notegic-backend/internal/core/exceptions"
+)
+
+type BillingPlanRepositoryInterface interface {
+	GetOneById(id string, opts ...options.RepositoryOptions) (*schemas.BillingPlan, *exceptions.Exception)
+	GetAllActive(opts ...options.RepositoryOptions) ([]schemas.BillingPlan, *exceptions.Exception)
+}
+
+type BillingPlanRepository struct{}
+
+func NewBillingPlanRepository() BillingPlanRepositoryInterface {
+	return &BillingPlanRepository{}
+}
+
+func (r *BillingPlanRepository) GetOneById(
+	id string,
+	opts ...options.RepositoryOptions,
+) (*schemas.BillingPlan, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	billingPlan := &schemas.BillingPlan{}
+	result := parsedOptions.DB.
+		Model(&schemas.BillingPlan{}).
+		Where("id = ?", id).
+		First(billingPlan)
+	if result.Error != nil {
+		return nil, apiexceptions.NewBillingPlanException().NotFound().WithOrigin(result.Error)
+	}
+
+	return billingPlan, nil
+}
+
+func (r *BillingPlanRepository) GetAllActive(
+	opts ...options.RepositoryOptions,
+) ([]schemas.BillingPlan, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	billingPlans := []schemas.BillingPlan{}
+	result := parsedOptions.DB.
+		Model(&schemas.BillingPlan{}).
+		Where("status = ?", enums.BillingPlanStatus_Active).
+		Order("price ASC, interval_unit ASC").
+		Find(&billingPlans)
+	if result.Error != nil {
+		return nil, apiexceptions.NewBillingPlanException().NotFound().WithOrigin(result.Error)
+	}
+
+	return billingPlans, nil
+}
@@ -199,7 +199,12 @@ func (r *BlockPackRepository) CheckPermissionAndGetOneWithOwnerIdById(
 			Model(&schemas.UsersToShelves{}).
 			Select("1").
 			Where("root_shelf_id = ss.root_shelf_id").
-			Where("user_id = ? AND permission IN ?", userId, allowedPermissions)
+			Where("user_id = ? AND permission IN ?", userId, allowedPermissions).
+			Scopes(
+				scopes.RestrictRootShelvesOfAPIKey(`"UsersToShelvesTable".root_shelf_id`),
+				scopes.RestrictRootShelvesOfShareLink(`"UsersToShelvesTable".root_shelf_id`),
+				scopes.RestrictBlockPacksOfShareLink(`"BlockPackTable".id`),
+			)
 		query = query.Where("EXISTS (?)", subQuery)
 	}
 
@@ -242,7 +247,12 @@ func (r *BlockPackRepository) CheckPermissionsAndGetManyWithOwnerIdsByIds(
 			Model(&schemas.UsersToShelves{}).
 			Select("1").
 			Where("root_shelf_id = ss.root_shelf_id").
-			Where("user_id = ? AND permission IN ?", userId, allowedPermissions)
+			Where("user_id = ? AND permission IN ?", userId, allowedPermissions).
+			Scopes(
+				scopes.RestrictRootShelvesOfAPIKey(`"UsersToShelvesTable".root_shelf_id`),
+				scopes.RestrictRootShelvesOfShareLink(`"UsersToShelvesTable".root_shelf_id`),
+				scopes.RestrictBlockPacksOfShareLink(`"BlockPackTable".id`),
+			)
 		query = query.Where("EXISTS (?)", subQuery)
 	}
 
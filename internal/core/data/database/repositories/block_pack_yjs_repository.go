@@ -290,6 +290,14 @@ func (r *BlockPackYjsRepository) ApplyCompactedYjsDocument(
 		return false, err
 	}
 
+	if err := captureDuePeriodicBlockPackYjsSnapshots(tx, []uuid.UUID{blockPackId}, now); err != nil {
+		if shouldStartTransaction {
+			tx.Rollback()
+		}
+
+		return false, err
+	}
+
 	if shouldStartTransaction {
 		if err := tx.Commit().Error; err != nil {
 			return false, err
@@ -583,6 +591,10 @@ func (r *BlockPackYjsRepository) BulkApplyCompactedYjsDocuments(
 		return nil, err
 	}
 
+	if err := captureDuePeriodicBlockPackYjsSnapshots(db, appliedBlockPackIds, now); err != nil {
+		return nil, err
+	}
+
 	return appliedBlockPackIds, nil
 }
 
@@ -0,0 +1,238 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	yjsworkercontract "github.com/HiIamJeff67/notegic-backend/contracts/yjs-worker/v1"
+	constants "github.com/HiIamJeff67/notegic-backend/shared/constants"
+
+	inputs "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/inputs"
+	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
+	schemas "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas"
+	enums "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/enums"
+)
+
+type BlockPackYjsSnapshotRepositoryInterface interface {
+	GetOneById(blockPackId uuid.UUID, snapshotId uuid.UUID, opts ...options.RepositoryOptions) (*schemas.BlockPackYjsSnapshot, error)
+	GetManyByBlockPackId(blockPackId uuid.UUID, opts ...options.RepositoryOptions) ([]schemas.BlockPackYjsSnapshot, error)
+	CountByBlockPackIdAndKind(blockPackId uuid.UUID, kind enums.BlockPackSnapshotKind, opts ...options.RepositoryOptions) (int64, error)
+	CreateOne(input inputs.CreateBlockPackYjsSnapshotInput, opts ...options.RepositoryOptions) (*schemas.BlockPackYjsSnapshot, error)
+}
+
+type BlockPackYjsSnapshotRepository struct{}
+
+func NewBlockPackYjsSnapshotRepository() BlockPackYjsSnapshotRepositoryInterface {
+	return &BlockPackYjsSnapshotRepository{}
+}
+
+func (r *BlockPackYjsSnapshotRepository) GetOneById(
+	blockPackId uuid.UUID,
+	snapshotId uuid.UUID,
+	opts ...options.RepositoryOptions,
+) (*schemas.BlockPackYjsSnapshot, error) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	var snapshot schemas.BlockPackYjsSnapshot
+	if err := parsedOptions.DB.
+		Model(&schemas.BlockPackYjsSnapshot{}).
+		Where("id = ? AND block_pack_id = ?", snapshotId, blockPackId).
+		First(&snapshot).Error; err != nil {
+		return nil, err
+	}
+
+	return &snapshot, nil
+}
+
+// GetManyByBlockPackId omits the state of the snapshots, since it is only required by the diff and the restore.
+func (r *BlockPackYjsSnapshotRepository) GetManyByBlockPackId(
+	blockPackId uuid.UUID,
+	opts ...options.RepositoryOptions,
+) ([]schemas.BlockPackYjsSnapshot, error) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	snapshots := []schemas.BlockPackYjsSnapshot{}
+	if err := parsedOptions.DB.
+		Model(&schemas.BlockPackYjsSnapshot{}).
+		Omit("state").
+		Where("block_pack_id = ?", blockPackId).
+		Order("created_at DESC, id DESC").
+		Find(&snapshots).Error; err != nil {
+		return nil, err
+	}
+
+	return snapshots, nil
+}
+
+func (r *BlockPackYjsSnapshotRepository) CountByBlockPackIdAndKind(
+	blockPackId uuid.UUID,
+	kind enums.BlockPackSnapshotKind,
+	opts ...options.RepositoryOptions,
+) (int64, error) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	var count int64
+	if err := parsedOptions.DB.
+		Model(&schemas.BlockPackYjsSnapshot{}).
+		Where("block_pack_id = ? AND kind = ?", blockPackId, kind).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// CreateOne also prunes the oldest periodic and pre-restore snapshots beyond their retention,
+// the named snapshots are kept until the BlockPack is deleted.
+func (r *BlockPackYjsSnapshotRepository) CreateOne(
+	input inputs.CreateBlockPackYjsSnapshotInput,
+	opts ...options.RepositoryOptions,
+) (*schemas.BlockPackYjsSnapshot, error) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+	shouldStartTransaction := !parsedOptions.IsTransactionStarted
+	if shouldStartTransaction {
+		parsedOptions.DB = parsedOptions.DB.Begin()
+	}
+
+	tx := parsedOptions.DB
+
+	snapshot := schemas.BlockPackYjsSnapshot{
+		Id:             uuid.New(),
+		BlockPackId:    input.BlockPackId,
+		Kind:           input.Kind,
+		Name:           input.Name,
+		State:          input.State,
+		UpdateSequence: input.UpdateSequence,
+		CreatorId:      input.CreatorId,
+	}
+	if err := tx.Create(&snapshot).Error; err != nil {
+		if shouldStartTransaction {
+			tx.Rollback()
+		}
+
+		return nil, err
+	}
+
+	if err := pruneBlockPackYjsSnapshots(tx, []uuid.UUID{input.BlockPackId}, input.Kind); err != nil {
+		if shouldStartTransaction {
+			tx.Rollback()
+		}
+
+		return nil, err
+	}
+
+	if shouldStartTransaction {
+		if err := tx.Commit().Error; err != nil {
+			return nil, err
+		}
+	}
+
+	return &snapshot, nil
+}
+
+/* ============================== Private Methods ============================== */
+
+// captureDuePeriodicBlockPackYjsSnapshots captures the compacted documents whose latest periodic
+// snapshot is older than the snapshot interval, it is called right after the compaction is applied.
+func captureDuePeriodicBlockPackYjsSnapshots(
+	db *gorm.DB,
+	blockPackIds []uuid.UUID,
+	now time.Time,
+) error {
+	if len(blockPackIds) == 0 {
+		return nil
+	}
+
+	var recentBlockPackIds []uuid.UUID
+	if err := db.Model(&schemas.BlockPackYjsSnapshot{}).
+		Distinct("block_pack_id").
+		Where("block_pack_id IN ? AND kind = ?", blockPackIds, enums.BlockPackSnapshotKind_Periodic).
+		Where("created_at > ?", now.Add(-constants.BlockPackPeriodicSnapshotInterval)).
+		Pluck("block_pack_id", &recentBlockPackIds).Error; err != nil {
+		return err
+	}
+
+	recentBlockPackIdSet := make(map[uuid.UUID]bool, len(recentBlockPackIds))
+	for _, blockPackId := range recentBlockPackIds {
+		recentBlockPackIdSet[blockPackId] = true
+	}
+	dueBlockPackIds := make([]uuid.UUID, 0, len(blockPackIds))
+	for _, blockPackId := range blockPackIds {
+		if !recentBlockPackIdSet[blockPackId] {
+			dueBlockPackIds = append(dueBlockPackIds, blockPackId)
+		}
+	}
+	if len(dueBlockPackIds) == 0 {
+		return nil
+	}
+
+	var documents []schemas.BlockPackYjsDocument
+	if err := db.Model(&schemas.BlockPackYjsDocument{}).
+		Where("block_pack_id IN ? AND deleted_at IS NULL", dueBlockPackIds).
+		Find(&documents).Error; err != nil {
+		return err
+	}
+	if len(documents) == 0 {
+		return nil
+	}
+
+	snapshots := make([]schemas.BlockPackYjsSnapshot, 0, len(documents))
+	for _, document := range documents {
+		state, err := yjsworkercontract.YjsDocumentState{
+			Snapshot:               document.Snapshot,
+			StateVector:            document.StateVector,
+			LastUpdateSequence:     document.CompactedUntilSequence,
+			CompactedUntilSequence: document.CompactedUntilSequence,
+			ProjectedUntilSequence: min(document.ProjectedUntilSequence, document.CompactedUntilSequence),
+		}.MarshalBytes()
+		if err != nil {
+			return err
+		}
+
+		snapshots = append(snapshots, schemas.BlockPackYjsSnapshot{
+			Id:             uuid.New(),
+			BlockPackId:    document.BlockPackId,
+			Kind:           enums.BlockPackSnapshotKind_Periodic,
+			State:          state,
+			UpdateSequence: document.CompactedUntilSequence,
+		})
+	}
+	if err := db.Create(&snapshots).Error; err != nil {
+		return err
+	}
+
+	return pruneBlockPackYjsSnapshots(db, dueBlockPackIds, enums.BlockPackSnapshotKind_Periodic)
+}
+
+func pruneBlockPackYjsSnapshots(
+	db *gorm.DB,
+	blockPackIds []uuid.UUID,
+	kind enums.BlockPackSnapshotKind,
+) error {
+	var maxCount int
+	switch kind {
+	case enums.BlockPackSnapshotKind_Periodic:
+		maxCount = constants.MaxBlockPackPeriodicSnapshotCount
+	case enums.BlockPackSnapshotKind_PreRestore:
+		maxCount = constants.MaxBlockPackPreRestoreSnapshotCount
+	default:
+		return nil
+	}
+
+	return db.Exec(`
+		DELETE FROM "BlockPackYjsSnapshotTable"
+		WHERE id IN (
+			SELECT id FROM (
+				SELECT id, ROW_NUMBER() OVER (PARTITION BY block_pack_id ORDER BY created_at DESC, id DESC) AS snapshot_rank
+				FROM "BlockPackYjsSnapshotTable"
+				WHERE block_pack_id IN ? AND kind = ?
+			) AS ranked_snapshot
+			WHERE ranked_snapshot.snapshot_rank > ?
+		)`,
+		blockPackIds,
+		kind,
+		maxCount,
+	).Error
+}
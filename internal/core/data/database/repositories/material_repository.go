@@ -41,6 +41,9 @@ type MaterialRepositoryInterface interface {
 
 	BulkCheckPermissionsAndGetManyByIds(inputs []inputs.BulkCheckMaterialPermissionInput, preloads []schemas.MaterialRelation, allowedPermissions []enums.AccessControlPermission, opts ...options.RepositoryOptions) ([]bool, []schemas.Material, *exceptions.Exception)
 	BulkDeleteMany(inputs []inputs.BulkDeleteMaterialInput, opts ...options.RepositoryOptions) ([]bool, *exceptions.Exception)
+	ClaimOneForProcessingById(id uuid.UUID, opts ...options.RepositoryOptions) (*schemas.Material, *exceptions.Exception)
+	FinishProcessingById(id uuid.UUID, contentKey string, size int64, input inputs.FinishMaterialProcessingInput, opts ...options.RepositoryOptions) (*schemas.Material, *exceptions.Exception)
+	GetManyUnprocessedIds(updatedBefore time.Time, limit int, opts ...options.RepositoryOptions) ([]uuid.UUID, *exceptions.Exception)
 }
 
 type MaterialRepository struct {
@@ -646,3 +649,94 @@ func (r *MaterialRepository) BulkDeleteMany(
 
 	return successes, nil
 }
+
+// ClaimOneForProcessingById marks the material as processing if it is waiting for the processing, or a previous attempt
+// has failed or has been interrupted, and returns nil if the material has been deleted or no longer needs the processing
+func (r *MaterialRepository) ClaimOneForProcessingById(
+	id uuid.UUID,
+	opts ...options.RepositoryOptions,
+) (*schemas.Material, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	var claimedMaterials []schemas.Material
+	result := parsedOptions.DB.Model(&claimedMaterials).
+		Clauses(clause.Returning{}).
+		Where("id = ? AND deleted_at IS NULL AND processing_status IN ?", id, []enums.MaterialProcessingStatus{
+			enums.MaterialProcessingStatus_Pending,
+			enums.MaterialProcessingStatus_Processing,
+			enums.MaterialProcessingStatus_Failed,
+		}).
+		Updates(map[string]any{
+			"processing_status": enums.MaterialProcessingStatus_Processing,
+			"updated_at":        time.Now(),
+		})
+	if result.Error != nil {
+		return nil, apiexceptions.NewMaterialException().FailedToUpdate().WithOrigin(result.Error)
+	}
+	if len(claimedMaterials) == 0 {
+		return nil, nil
+	}
+
+	return &claimedMaterials[0], nil
+}
+
+// FinishProcessingById writes the outcome of the processing only if the material still has the processed content,
+// and returns nil if the content has been replaced in the meantime, since the new content has its own processing
+func (r *MaterialRepository) FinishProcessingById(
+	id uuid.UUID,
+	contentKey string,
+	size int64,
+	input inputs.FinishMaterialProcessingInput,
+	opts ...options.RepositoryOptions,
+) (*schemas.Material, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	var finishedMaterials []schemas.Material
+	result := parsedOptions.DB.Model(&finishedMaterials).
+		Clauses(clause.Returning{}).
+		Where("id = ? AND content_key = ? AND size = ? AND processing_status = ?",
+			id,
+			contentKey,
+			size,
+			enums.MaterialProcessingStatus_Processing,
+		).
+		Updates(map[string]any{
+			"processing_status": input.ProcessingStatus,
+			"search_text":       input.SearchText,
+			"preview_key":       input.PreviewKey,
+			"updated_at":        time.Now(),
+		})
+	if result.Error != nil {
+		return nil, apiexceptions.NewMaterialException().FailedToUpdate().WithOrigin(result.Error)
+	}
+	if len(finishedMaterials) == 0 {
+		return nil, nil
+	}
+
+	return &finishedMaterials[0], nil
+}
+
+// GetManyUnprocessedIds returns the materials which have been waiting for or stuck in the processing since before the given time
+func (r *MaterialRepository) GetManyUnprocessedIds(
+	updatedBefore time.Time,
+	limit int,
+	opts ...options.RepositoryOptions,
+) ([]uuid.UUID, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	var ids []uuid.UUID
+	result := parsedOptions.DB.Model(&schemas.Material{}).
+		Select("id").
+		Where("processing_status IN ? AND updated_at < ? AND deleted_at IS NULL", []enums.MaterialProcessingStatus{
+			enums.MaterialProcessingStatus_Pending,
+			enums.MaterialProcessingStatus_Processing,
+		}, updatedBefore).
+		Order("updated_at ASC").
+		Limit(limit).
+		Scan(&ids)
+	if result.Error != nil {
+		return nil, apiexceptions.NewMaterialException().NotFound().WithOrigin(result.Error)
+	}
+
+	return ids, nil
+}
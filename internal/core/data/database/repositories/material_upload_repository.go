@@ -0,0 +1,178 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm/clause"
+
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+
+	inputs "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/inputs"
+	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
+	schemas "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas"
+	scopes "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/scopes"
+	apiexceptions "github.com/HiIamJeff67/notegic-backend/internal/core/exceptions"
+)
+
+type MaterialUploadRepositoryInterface interface {
+	GetOneById(id uuid.UUID, materialId uuid.UUID, userId uuid.UUID, opts ...options.RepositoryOptions) (*schemas.MaterialUpload, *exceptions.Exception)
+	GetOneByStorageUploadId(storageUploadId string, userId uuid.UUID, opts ...options.RepositoryOptions) (*schemas.MaterialUpload, *exceptions.Exception)
+	GetManyExpired(now time.Time, limit int, opts ...options.RepositoryOptions) ([]schemas.MaterialUpload, *exceptions.Exception)
+	GetMaxMaterialSizeByMaterialId(materialId uuid.UUID, opts ...options.RepositoryOptions) (int64, *exceptions.Exception)
+	CreateOne(userId uuid.UUID, input inputs.CreateMaterialUploadInput, opts ...options.RepositoryOptions) (*schemas.MaterialUpload, *exceptions.Exception)
+	DeleteOneById(id uuid.UUID, opts ...options.RepositoryOptions) *exceptions.Exception
+}
+
+type MaterialUploadRepository struct{}
+
+func NewMaterialUploadRepository() MaterialUploadRepositoryInterface {
+	return &MaterialUploadRepository{}
+}
+
+// GetOneById only returns the upload initiated by the given user for the given material,
+// so an upload can neither be completed by another user nor into another material
+func (r *MaterialUploadRepository) GetOneById(
+	id uuid.UUID,
+	materialId uuid.UUID,
+	userId uuid.UUID,
+	opts ...options.RepositoryOptions,
+) (*schemas.MaterialUpload, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	materialUpload := &schemas.MaterialUpload{}
+	result := parsedOptions.DB.
+		Model(&schemas.MaterialUpload{}).
+		Where("id = ? AND material_id = ? AND user_id = ?", id, materialId, userId).
+		Scopes(scopes.Locking(parsedOptions.LockingStrength)).
+		First(materialUpload)
+	if result.Error != nil {
+		return nil, apiexceptions.NewMaterialUploadException().NotFound().WithOrigin(result.Error)
+	}
+
+	return materialUpload, nil
+}
+
+// GetOneByStorageUploadId only returns the upload initiated by the given user,
+// so the parts of an upload can not be sent by another user
+func (r *MaterialUploadRepository) GetOneByStorageUploadId(
+	storageUploadId string,
+	userId uuid.UUID,
+	opts ...options.RepositoryOptions,
+) (*schemas.MaterialUpload, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	materialUpload := &schemas.MaterialUpload{}
+	result := parsedOptions.DB.
+		Model(&schemas.MaterialUpload{}).
+		Where("storage_upload_id = ? AND user_id = ?", storageUploadId, userId).
+		Scopes(scopes.Locking(parsedOptions.LockingStrength)).
+		First(materialUpload)
+	if result.Error != nil {
+		return nil, apiexceptions.NewMaterialUploadException().NotFound().WithOrigin(result.Error)
+	}
+
+	return materialUpload, nil
+}
+
+// GetManyExpired skips the uploads locked by their completions, so an upload is never
+// aborted while it is being completed right at its expiration
+func (r *MaterialUploadRepository) GetManyExpired(
+	now time.Time,
+	limit int,
+	opts ...options.RepositoryOptions,
+) ([]schemas.MaterialUpload, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	materialUploads := []schemas.MaterialUpload{}
+	result := parsedOptions.DB.
+		Model(&schemas.MaterialUpload{}).
+		Where("expires_at <= ?", now).
+		Order("expires_at ASC, id ASC").
+		Limit(limit).
+		Clauses(clause.Locking{Strength: options.LockingStrengthUpdate, Options: "SKIP LOCKED"}).
+		Find(&materialUploads)
+	if result.Error != nil {
+		return nil, apiexceptions.NewMaterialUploadException().NotFound().WithOrigin(result.Error)
+	}
+
+	return materialUploads, nil
+}
+
+// GetMaxMaterialSizeByMaterialId returns the maximum material size in the plan of the owner of
+// the root shelf of the material, since the material is accounted to that owner
+func (r *MaterialUploadRepository) GetMaxMaterialSizeByMaterialId(
+	materialId uuid.UUID,
+	opts ...options.RepositoryOptions,
+) (int64, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	var maxMaterialSizes []int64
+	result := parsedOptions.DB.
+		Raw(`
+		SELECT plan_limitation.max_material_size
+		FROM "MaterialTable" AS material
+		JOIN "SubShelfTable" AS sub_shelf ON sub_shelf.id = material.parent_sub_shelf_id
+		JOIN "RootShelfTable" AS root_shelf ON root_shelf.id = sub_shelf.root_shelf_id
+		JOIN "UserTable" AS owner_user ON owner_user.id = root_shelf.owner_id
+		JOIN "PlanLimitationTable" AS plan_limitation ON plan_limitation.key = owner_user.plan
+		WHERE material.id = ?
+		`, materialId).
+		Scan(&maxMaterialSizes)
+	if exception := exceptions.Cover(nil, []exceptions.Pair{
+		{First: result.Error != nil, Second: apiexceptions.NewMaterialException().NotFound().WithOrigin(result.Error)},
+		{First: len(maxMaterialSizes) == 0, Second: apiexceptions.NewMaterialException().NotFound()},
+	}); exception != nil {
+		return 0, exception
+	}
+
+	return maxMaterialSizes[0], nil
+}
+
+func (r *MaterialUploadRepository) CreateOne(
+	userId uuid.UUID,
+	input inputs.CreateMaterialUploadInput,
+	opts ...options.RepositoryOptions,
+) (*schemas.MaterialUpload, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	newMaterialUpload := schemas.MaterialUpload{
+		Id:              input.Id,
+		MaterialId:      input.MaterialId,
+		UserId:          userId,
+		ContentKey:      input.ContentKey,
+		StorageUploadId: input.StorageUploadId,
+		Size:            input.Size,
+		ContentType:     input.ContentType,
+		PartSize:        input.PartSize,
+		PartCount:       input.PartCount,
+		ExpiresAt:       input.ExpiresAt,
+	}
+	result := parsedOptions.DB.
+		Model(&schemas.MaterialUpload{}).
+		Create(&newMaterialUpload)
+	if result.Error != nil {
+		return nil, apiexceptions.NewMaterialUploadException().FailedToCreate().WithOrigin(result.Error)
+	}
+
+	return &newMaterialUpload, nil
+}
+
+func (r *MaterialUploadRepository) DeleteOneById(
+	id uuid.UUID,
+	opts ...options.RepositoryOptions,
+) *exceptions.Exception {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	result := parsedOptions.DB.
+		Where("id = ?", id).
+		Delete(&schemas.MaterialUpload{})
+	if exception := exceptions.Cover(nil, []exceptions.Pair{
+		{First: result.Error != nil, Second: apiexceptions.NewMaterialUploadException().FailedToDelete().WithOrigin(result.Error)},
+		{First: result.RowsAffected == 0, Second: apiexceptions.NewMaterialUploadException().NotFound()},
+	}); exception != nil {
+		return exception
+	}
+
+	return nil
+}
@@ -22,6 +22,7 @@ import (
 	inputs "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/inputs"
 	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
 	schemas "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas"
+	enums "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/enums"
 	durablejobeventbuilders "github.com/HiIamJeff67/notegic-backend/internal/core/transports/durablejob/eventbuilders"
 )
 
@@ -36,11 +37,16 @@ type OutboxEventRepositoryInterface interface {
 	EnqueueManyRootShelfDeleted(tx *gorm.DB, correlationId string, rootShelfIds []uuid.UUID, targetUserPublicIdsByRootShelfId map[uuid.UUID][]uuid.UUID) error
 	EnqueueBlockPackChanged(tx *gorm.DB, correlationId string, blockPackIds []uuid.UUID) error
 	EnqueueBlockPackDeleted(tx *gorm.DB, correlationId string, blockPackIds []uuid.UUID) error
+	EnqueueBlockPackDocumentRestored(tx *gorm.DB, correlationId string, blockPackId uuid.UUID, snapshotId uuid.UUID, updateSequence int64) error
 	EnqueueUserSessionsRevoked(tx *gorm.DB, correlationId string, userPublicId uuid.UUID) error
+	EnqueueShareLinkRevoked(tx *gorm.DB, correlationId string, shareLinkId uuid.UUID, rootShelfId uuid.UUID) error
 	EnqueueUserDeleted(tx *gorm.DB, correlationId string, userPublicId uuid.UUID, deletedAt time.Time) error
 	EnqueueNotificationRequested(tx *gorm.DB, correlationId string, data coreeventscontract.NotificationRequestedData) error
+	EnqueueRoutineTasksFailed(tx *gorm.DB, correlationId string, routineTaskRecordIds []uuid.UUID) error
 	EnqueueYjsMaintenanceHint(tx *gorm.DB, correlationId string, blockPackId uuid.UUID, reason string) error
 	EnqueueManyYjsMaintenanceHints(tx *gorm.DB, correlationId string, blockPackIds []uuid.UUID, reason string) error
+	EnqueueMaterialProcessingHint(tx *gorm.DB, correlationId string, materialId uuid.UUID, reason string) error
+	EnqueueManyMaterialProcessingHints(tx *gorm.DB, correlationId string, materialIds []uuid.UUID, reason string) error
 	ClaimAvailable(ctx context.Context, workerId string, batchSize int, claimTimeout time.Duration, opts ...options.RepositoryOptions) ([]schemas.OutboxEvent, *exceptions.Exception)
 	MarkPublishedMany(ctx context.Context, eventIds []uuid.UUID, workerId string, opts ...options.RepositoryOptions) *exceptions.Exception
 	MarkFailedMany(ctx context.Context, failureInputs []inputs.FailedOutboxEventInput, workerId string, opts ...options.RepositoryOptions) *exceptions.Exception
@@ -481,6 +487,35 @@ func (r *OutboxEventRepository) EnqueueBlockPackDeleted(
 	return EnqueueOutboxEvents(tx, coreeventscontract.CoreLifecycleTopic, events)
 }
 
+func (r *OutboxEventRepository) EnqueueBlockPackDocumentRestored(
+	tx *gorm.DB,
+	correlationId string,
+	blockPackId uuid.UUID,
+	snapshotId uuid.UUID,
+	updateSequence int64,
+) error {
+	return EnqueueOutboxEvents(
+		tx,
+		coreeventscontract.CoreLifecycleTopic,
+		[]eventcontract.EventEnvelope[coreeventscontract.BlockPackDocumentRestoredData]{
+			{
+				SchemaVersion: eventcontract.Version,
+				EventId:       uuid.New(),
+				EventType:     coreeventscontract.EventType_BlockPackDocumentRestored,
+				AggregateType: coreeventscontract.AggregateType_BlockPack,
+				AggregateId:   blockPackId,
+				KafkaKey:      blockPackId.String(),
+				OccurredAt:    time.Now().UTC(),
+				CorrelationId: correlationId,
+				Data: coreeventscontract.BlockPackDocumentRestoredData{
+					SnapshotId:     snapshotId,
+					UpdateSequence: updateSequence,
+				},
+			},
+		},
+	)
+}
+
 func (r *OutboxEventRepository) EnqueueUserSessionsRevoked(
 	tx *gorm.DB,
 	correlationId string,
@@ -505,6 +540,33 @@ func (r *OutboxEventRepository) EnqueueUserSessionsRevoked(
 	)
 }
 
+func (r *OutboxEventRepository) EnqueueShareLinkRevoked(
+	tx *gorm.DB,
+	correlationId string,
+	shareLinkId uuid.UUID,
+	rootShelfId uuid.UUID,
+) error {
+	return EnqueueOutboxEvents(
+		tx,
+		coreeventscontract.CoreLifecycleTopic,
+		[]eventcontract.EventEnvelope[coreeventscontract.ShareLinkRevokedData]{
+			{
+				SchemaVersion: eventcontract.Version,
+				EventId:       uuid.New(),
+				EventType:     coreeventscontract.EventType_ShareLinkRevoked,
+				AggregateType: coreeventscontract.AggregateType_ShareLink,
+				AggregateId:   shareLinkId,
+				KafkaKey:      shareLinkId.String(),
+				OccurredAt:    time.Now().UTC(),
+				CorrelationId: correlationId,
+				Data: coreeventscontract.ShareLinkRevokedData{
+					RootShelfId: rootShelfId,
+				},
+			},
+		},
+	)
+}
+
 func (r *OutboxEventRepository) EnqueueUserDeleted(
 	tx *gorm.DB,
 	correlationId string,
@@ -545,6 +607,13 @@ func (r *OutboxEventRepository) EnqueueNotificationRequested(
 		data.TemplateKey == "" || data.TemplateVersion <= 0 || data.DedupeKey == "" {
 		return errors.New("notification request is incomplete")
 	}
+	if data.Recipient == nil {
+		recipient, err := findNotificationRecipient(tx, data.RecipientUserPublicId)
+		if err != nil {
+			return err
+		}
+		data.Recipient = recipient
+	}
 
 	envelope := eventcontract.EventEnvelope[coreeventscontract.NotificationRequestedData]{
 		SchemaVersion: eventcontract.Version,
@@ -565,6 +634,90 @@ func (r *OutboxEventRepository) EnqueueNotificationRequested(
 	)
 }
 
+// EnqueueRoutineTasksFailed reads the failed records back in the same transaction that failed them, so the owner of
+// the station and the error code are exactly what got committed, records of a deleted station are skipped
+func (r *OutboxEventRepository) EnqueueRoutineTasksFailed(
+	tx *gorm.DB,
+	correlationId string,
+	routineTaskRecordIds []uuid.UUID,
+) error {
+	if tx == nil {
+		return errors.New("routine task failure events require a transaction")
+	}
+	if len(routineTaskRecordIds) == 0 {
+		return nil
+	}
+
+	var failures []coreeventscontract.RoutineTaskFailedData
+	if err := tx.Table(`"RoutineTaskRecordTable" AS rtr`).
+		Select(`u.public_id AS recipient_user_public_id, r.id AS routine_id, r.title AS routine_title, `+
+			`rt.id AS routine_task_id, rt.title AS routine_task_title, rtr.id AS routine_task_record_id, `+
+			`rtr.purpose AS purpose, rtr.error_code AS error_code, COALESCE(rtr.actual_ended_at, rtr.updated_at) AS failed_at`).
+		Joins(`JOIN "RoutineTaskTable" AS rt ON rt.id = rtr.routine_task_id`).
+		Joins(`JOIN "RoutineTable" AS r ON r.id = rt.routine_id`).
+		Joins(`JOIN "StationTable" AS s ON s.id = r.station_id AND s.deleted_at IS NULL`).
+		Joins(`JOIN "UserTable" AS u ON u.id = s.owner_id`).
+		Where("rtr.id IN ? AND rtr.status = ?", routineTaskRecordIds, enums.RoutineTaskRecordStatus_Failed).
+		Order("rtr.id ASC").
+		Scan(&failures).Error; err != nil {
+		return err
+	}
+	if len(failures) == 0 {
+		return nil
+	}
+
+	recipients := make(map[uuid.UUID]*coreeventscontract.NotificationRecipient)
+	events := make([]eventcontract.EventEnvelope[coreeventscontract.RoutineTaskFailedData], 0, len(failures))
+	for _, failure := range failures {
+		recipient, exists := recipients[failure.RecipientUserPublicId]
+		if !exists {
+			var err error
+			recipient, err = findNotificationRecipient(tx, failure.RecipientUserPublicId)
+			if err != nil {
+				return err
+			}
+			recipients[failure.RecipientUserPublicId] = recipient
+		}
+		failure.Recipient = recipient
+		failure.FailedAt = failure.FailedAt.UTC()
+		events = append(events, eventcontract.EventEnvelope[coreeventscontract.RoutineTaskFailedData]{
+			SchemaVersion: eventcontract.Version,
+			EventId:       uuid.New(),
+			EventType:     coreeventscontract.EventType_RoutineTaskFailed,
+			AggregateType: coreeventscontract.AggregateType_RoutineTask,
+			AggregateId:   failure.RoutineTaskId,
+			KafkaKey:      failure.RecipientUserPublicId.String(),
+			OccurredAt:    failure.FailedAt,
+			CorrelationId: correlationId,
+			Data:          failure,
+		})
+	}
+
+	return EnqueueOutboxEvents(tx, coreeventscontract.CoreNotificationTopic, events)
+}
+
+// findNotificationRecipient snapshots the recipient in the same transaction as the request, a missing user setting
+// falls back to the quiet mode defaults of the setting, and a missing user leaves the recipient empty
+func findNotificationRecipient(tx *gorm.DB, userPublicId uuid.UUID) (*coreeventscontract.NotificationRecipient, error) {
+	var recipients []coreeventscontract.NotificationRecipient
+	if err := tx.Table(`"UserTable" AS u`).
+		Select(`u.email AS email, u.name AS name, `+
+			`COALESCE(us.quiet_mode, true) AS quiet_mode, `+
+			`COALESCE(us.quiet_mode_start_minute, 1320) AS quiet_mode_start_minute, `+
+			`COALESCE(us.quiet_mode_end_minute, 480) AS quiet_mode_end_minute`).
+		Joins(`LEFT JOIN "UserSettingTable" AS us ON us.user_id = u.id`).
+		Where("u.public_id = ?", userPublicId).
+		Limit(1).
+		Scan(&recipients).Error; err != nil {
+		return nil, err
+	}
+	if len(recipients) == 0 {
+		return nil, nil
+	}
+
+	return &recipients[0], nil
+}
+
 func (r *OutboxEventRepository) EnqueueYjsMaintenanceHint(
 	tx *gorm.DB,
 	correlationId string,
@@ -622,6 +775,58 @@ func (r *OutboxEventRepository) EnqueueManyYjsMaintenanceHints(
 	return EnqueueOutboxEvents(tx, coreeventscontract.CoreDurableJobYjsMaintenanceHintTopic, events)
 }
 
+func (r *OutboxEventRepository) EnqueueMaterialProcessingHint(
+	tx *gorm.DB,
+	correlationId string,
+	materialId uuid.UUID,
+	reason string,
+) error {
+	if tx == nil || materialId == uuid.Nil {
+		return errors.New("material processing hint requires a transaction and Material ID")
+	}
+
+	return r.EnqueueManyMaterialProcessingHints(tx, correlationId, []uuid.UUID{materialId}, reason)
+}
+
+// EnqueueManyMaterialProcessingHints reads the materials in the transaction, so the hints carry the content written by it
+func (r *OutboxEventRepository) EnqueueManyMaterialProcessingHints(
+	tx *gorm.DB,
+	correlationId string,
+	materialIds []uuid.UUID,
+	reason string,
+) error {
+	if tx == nil {
+		return errors.New("material processing hints require a transaction")
+	}
+	if len(materialIds) == 0 {
+		return nil
+	}
+
+	var materials []schemas.Material
+	if err := tx.Model(&schemas.Material{}).
+		Where("id IN ? AND deleted_at IS NULL", materialIds).
+		Find(&materials).Error; err != nil {
+		return err
+	}
+	if len(materials) != len(materialIds) {
+		return errors.New("material processing hints require existing materials for every Material ID")
+	}
+
+	eventBuilder := durablejobeventbuilders.NewMaterialProcessingHintEventBuilder()
+	occurredAt := time.Now().UTC()
+	events := make([]eventcontract.EventEnvelope[coreeventscontract.MaterialProcessingHintData], 0, len(materials))
+	for _, material := range materials {
+		events = append(events, eventBuilder.Build(coreeventscontract.MaterialProcessingHintData{
+			MaterialId:  material.Id,
+			ContentType: *material.ContentType.ToContractable(),
+			Size:        material.Size,
+			Reason:      reason,
+		}, correlationId, occurredAt))
+	}
+
+	return EnqueueOutboxEvents(tx, coreeventscontract.CoreDurableJobMaterialProcessingHintTopic, events)
+}
+
 func SerializeOutboxEvent(event schemas.OutboxEvent) ([]byte, error) {
 	var metadata outboxEventMetadata
 	if err := json.Unmarshal(event.Metadata, &metadata); err != nil {
@@ -0,0 +1,248 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+
+	inputs "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/inputs"
+	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
+	schemas "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas"
+	enums "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/enums"
+	apiexceptions "github.com/HiIamJeff67/notegic-backend/internal/core/exceptions"
+)
+
+type RootShelfArchiveJobRepositoryInterface interface {
+	GetOneByIdAndOwnerId(id uuid.UUID, ownerId uuid.UUID, opts ...options.RepositoryOptions) (*schemas.RootShelfArchiveJob, *exceptions.Exception)
+	CreateOne(ownerId uuid.UUID, input inputs.CreateRootShelfArchiveJobInput, opts ...options.RepositoryOptions) (*schemas.RootShelfArchiveJob, *exceptions.Exception)
+
+	// System Only Methods
+	ClaimNext(now time.Time, claimTimeout time.Duration, opts ...options.RepositoryOptions) (*schemas.RootShelfArchiveJob, *exceptions.Exception)
+	UpdateProgressById(id uuid.UUID, input inputs.UpdateRootShelfArchiveJobProgressInput, opts ...options.RepositoryOptions) *exceptions.Exception
+	MarkSucceededById(id uuid.UUID, completedAt time.Time, opts ...options.RepositoryOptions) *exceptions.Exception
+	MarkFailedById(id uuid.UUID, errorMessage string, completedAt time.Time, opts ...options.RepositoryOptions) *exceptions.Exception
+}
+
+type RootShelfArchiveJobRepository struct{}
+
+func NewRootShelfArchiveJobRepository() RootShelfArchiveJobRepositoryInterface {
+	return &RootShelfArchiveJobRepository{}
+}
+
+func (r *RootShelfArchiveJobRepository) GetOneByIdAndOwnerId(
+	id uuid.UUID,
+	ownerId uuid.UUID,
+	opts ...options.RepositoryOptions,
+) (*schemas.RootShelfArchiveJob, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	job := &schemas.RootShelfArchiveJob{}
+	result := parsedOptions.DB.
+		Model(&schemas.RootShelfArchiveJob{}).
+		Where("id = ? AND owner_id = ?", id, ownerId).
+		First(job)
+	if result.Error != nil {
+		return nil, apiexceptions.NewRootShelfArchiveJobException().NotFound().WithOrigin(result.Error)
+	}
+
+	return job, nil
+}
+
+func (r *RootShelfArchiveJobRepository) CreateOne(
+	ownerId uuid.UUID,
+	input inputs.CreateRootShelfArchiveJobInput,
+	opts ...options.RepositoryOptions,
+) (*schemas.RootShelfArchiveJob, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	newJob := schemas.RootShelfArchiveJob{
+		Id:                  uuid.New(),
+		OwnerId:             ownerId,
+		Type:                input.Type,
+		Status:              enums.RootShelfArchiveJobStatus_Pending,
+		SourceRootShelfId:   input.SourceRootShelfId,
+		TargetRootShelfId:   input.TargetRootShelfId,
+		TargetRootShelfName: input.TargetRootShelfName,
+		ArchiveKey:          input.ArchiveKey,
+		ArchiveSize:         input.ArchiveSize,
+	}
+	if input.Id != nil && *input.Id != uuid.Nil {
+		newJob.Id = *input.Id
+	}
+
+	result := parsedOptions.DB.
+		Model(&schemas.RootShelfArchiveJob{}).
+		Create(&newJob)
+	if result.Error != nil {
+		return nil, apiexceptions.NewRootShelfArchiveJobException().FailedToCreate().WithOrigin(result.Error)
+	}
+
+	return &newJob, nil
+}
+
+/* ============================== System Only Methods ============================== */
+
+// ClaimNext claims the oldest pending job, or the oldest running job whose claim has expired,
+// the expired jobs are retried since the process working on them is considered to be gone
+func (r *RootShelfArchiveJobRepository) ClaimNext(
+	now time.Time,
+	claimTimeout time.Duration,
+	opts ...options.RepositoryOptions,
+) (*schemas.RootShelfArchiveJob, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	shouldStartTransaction := !parsedOptions.IsTransactionStarted
+	if shouldStartTransaction {
+		parsedOptions.DB = parsedOptions.DB.Begin()
+	}
+
+	job := &schemas.RootShelfArchiveJob{}
+	result := parsedOptions.DB.
+		Model(&schemas.RootShelfArchiveJob{}).
+		Clauses(clause.Locking{Strength: options.LockingStrengthUpdate, Options: "SKIP LOCKED"}).
+		Where("status = ? OR (status = ? AND claimed_until < ?)",
+			enums.RootShelfArchiveJobStatus_Pending,
+			enums.RootShelfArchiveJobStatus_Running,
+			now,
+		).
+		Order("created_at ASC").
+		Limit(1).
+		Find(job)
+	if result.Error != nil {
+		parsedOptions.DB.Rollback()
+		return nil, apiexceptions.NewRootShelfArchiveJobException().FailedToUpdate().WithOrigin(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		if shouldStartTransaction {
+			parsedOptions.DB.Rollback()
+		}
+		return nil, nil
+	}
+
+	claimedUntil := now.Add(claimTimeout)
+	job.Status = enums.RootShelfArchiveJobStatus_Running
+	job.ClaimedUntil = &claimedUntil
+	job.Attempts++
+	if job.StartedAt == nil {
+		job.StartedAt = &now
+	}
+	result = parsedOptions.DB.
+		Model(&schemas.RootShelfArchiveJob{}).
+		Where("id = ?", job.Id).
+		Updates(map[string]any{
+			"status":        job.Status,
+			"claimed_until": job.ClaimedUntil,
+			"attempts":      job.Attempts,
+			"started_at":    job.StartedAt,
+		})
+	if result.Error != nil {
+		parsedOptions.DB.Rollback()
+		return nil, apiexceptions.NewRootShelfArchiveJobException().FailedToUpdate().WithOrigin(result.Error)
+	}
+
+	if shouldStartTransaction {
+		if err := parsedOptions.DB.Commit().Error; err != nil {
+			parsedOptions.DB.Rollback()
+			return nil, apiexceptions.NewRootShelfArchiveJobException().FailedToCommitTransaction().WithOrigin(err)
+		}
+	}
+
+	return job, nil
+}
+
+func (r *RootShelfArchiveJobRepository) UpdateProgressById(
+	id uuid.UUID,
+	input inputs.UpdateRootShelfArchiveJobProgressInput,
+	opts ...options.RepositoryOptions,
+) *exceptions.Exception {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	values := map[string]any{
+		"processed_item_count": input.ProcessedItemCount,
+		"total_item_count":     input.TotalItemCount,
+	}
+	if input.LastNotifiedProgress != nil {
+		values["last_notified_progress"] = *input.LastNotifiedProgress
+	}
+	if input.SourceRootShelfId != nil {
+		values["source_root_shelf_id"] = *input.SourceRootShelfId
+	}
+	if input.TargetRootShelfId != nil {
+		values["target_root_shelf_id"] = *input.TargetRootShelfId
+	}
+	if input.ArchiveSize != nil {
+		values["archive_size"] = *input.ArchiveSize
+	}
+
+	result := parsedOptions.DB.
+		Model(&schemas.RootShelfArchiveJob{}).
+		Where("id = ? AND status = ?", id, enums.RootShelfArchiveJobStatus_Running).
+		Updates(values)
+	if exception := exceptions.Cover(nil, []exceptions.Pair{
+		{First: result.Error != nil, Second: apiexceptions.NewRootShelfArchiveJobException().FailedToUpdate().WithOrigin(result.Error)},
+		{First: result.RowsAffected == 0, Second: apiexceptions.NewRootShelfArchiveJobException().NoChanges()},
+	}); exception != nil {
+		return exception
+	}
+
+	return nil
+}
+
+func (r *RootShelfArchiveJobRepository) MarkSucceededById(
+	id uuid.UUID,
+	completedAt time.Time,
+	opts ...options.RepositoryOptions,
+) *exceptions.Exception {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	result := parsedOptions.DB.
+		Model(&schemas.RootShelfArchiveJob{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"status":                 enums.RootShelfArchiveJobStatus_Succeeded,
+			"processed_item_count":   gorm.Expr("total_item_count"),
+			"last_notified_progress": 100,
+			"error_message":          nil,
+			"claimed_until":          nil,
+			"completed_at":           completedAt,
+		})
+	if exception := exceptions.Cover(nil, []exceptions.Pair{
+		{First: result.Error != nil, Second: apiexceptions.NewRootShelfArchiveJobException().FailedToUpdate().WithOrigin(result.Error)},
+		{First: result.RowsAffected == 0, Second: apiexceptions.NewRootShelfArchiveJobException().NoChanges()},
+	}); exception != nil {
+		return exception
+	}
+
+	return nil
+}
+
+func (r *RootShelfArchiveJobRepository) MarkFailedById(
+	id uuid.UUID,
+	errorMessage string,
+	completedAt time.Time,
+	opts ...options.RepositoryOptions,
+) *exceptions.Exception {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	result := parsedOptions.DB.
+		Model(&schemas.RootShelfArchiveJob{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"status":        enums.RootShelfArchiveJobStatus_Failed,
+			"error_message": errorMessage,
+			"claimed_until": nil,
+			"completed_at":  completedAt,
+		})
+	if exception := exceptions.Cover(nil, []exceptions.Pair{
+		{First: result.Error != nil, Second: apiexceptions.NewRootShelfArchiveJobException().FailedToUpdate().WithOrigin(result.Error)},
+		{First: result.RowsAffected == 0, Second: apiexceptions.NewRootShelfArchiveJobException().NoChanges()},
+	}); exception != nil {
+		return exception
+	}
+
+	return nil
+}
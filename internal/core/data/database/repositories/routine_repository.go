@@ -16,6 +16,7 @@ import (
 
 	array "github.com/HiIamJeff67/notegic-backend/shared/lib/array"
 	partialupdate "github.com/HiIamJeff67/notegic-backend/shared/lib/partialupdate"
+	recurrence "github.com/HiIamJeff67/notegic-backend/shared/lib/recurrence"
 
 	inputs "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/inputs"
 	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
@@ -274,6 +275,11 @@ func (r *RoutineRepository) GetAllByTimeRange(
 						AND ((monthly_occurrence.occurrence_start_at AT TIME ZONE "RoutineTable".timezone) + ("RoutineTable".scheduled_end_at - "RoutineTable".scheduled_start_at)) > @query_from
 				)
 			)
+			OR (
+				-- the occurrences of the recurrence rules are expanded and filtered after the query
+				"RoutineTable".recurrence_rule IS NOT NULL
+				AND "RoutineTable".scheduled_start_at < @query_to
+			)
 		)
 	`
 	result := parsedOptions.DB.
@@ -283,6 +289,7 @@ func (r *RoutineRepository) GetAllByTimeRange(
 		Joins(`INNER JOIN "StationTable" station ON station.id = "RoutineTable".station_id AND station.deleted_at IS NULL`).
 		Where(`"RoutineTable".station_id IN ?`, stationIds).
 		Where("uts.user_id = ? AND uts.permission IN ?", userId, parsedOptions.AllowedPermissions).
+		Scopes(scopes.RestrictStationsOfAPIKey("uts.station_id")).
 		Where(timeRangeCondition, sql.Named("query_from", from), sql.Named("query_to", to)).
 		Scopes(r.routineScope.FilterOnlyDeleted(parsedOptions.OnlyDeleted)).
 		Scopes(r.routineScope.IncludePreloads(preloads, &userId)).
@@ -294,7 +301,14 @@ func (r *RoutineRepository) GetAllByTimeRange(
 		return nil, apiexceptions.NewRoutineException().NotFound().WithOrigin(result.Error)
 	}
 
-	return routines, nil
+	occurringRoutines := make([]schemas.Routine, 0, len(routines))
+	for _, routine := range routines {
+		if routine.RecurrenceRule == nil || hasRoutineOccurrenceWithin(routine, from, to) {
+			occurringRoutines = append(occurringRoutines, routine)
+		}
+	}
+
+	return occurringRoutines, nil
 }
 
 func (r *RoutineRepository) CreateOneByStationId(
@@ -338,6 +352,10 @@ func (r *RoutineRepository) CreateOneByStationId(
 		return nil, apiexceptions.NewRoutineException().InvalidInput().WithOrigin(err)
 	}
 	newRoutine.StationId = stationId
+	if err := alignRoutineRecurrence(&newRoutine); err != nil {
+		parsedOptions.DB.Rollback()
+		return nil, apiexceptions.NewRoutineException().InvalidInput().WithOrigin(err)
+	}
 
 	result := parsedOptions.DB.Model(&schemas.Routine{}).
 		Create(&newRoutine)
@@ -418,6 +436,10 @@ func (r *RoutineRepository) CreateManyByStationIds(
 			return nil, apiexceptions.NewRoutineException().InvalidInput().WithOrigin(err)
 		}
 		newRoutine.StationId = in.StationId
+		if err := alignRoutineRecurrence(&newRoutine); err != nil {
+			parsedOptions.DB.Rollback()
+			return nil, apiexceptions.NewRoutineException().InvalidInput().WithOrigin(err)
+		}
 		newRoutines = append(newRoutines, newRoutine)
 	}
 	if len(newRoutines) == 0 {
@@ -497,6 +519,10 @@ func (r *RoutineRepository) UpdateOneById(
 		truncatedScheduledEndAt := input.Values.ScheduledEndAt.Truncate(time.Minute)
 		input.Values.ScheduledEndAt = &truncatedScheduledEndAt
 	}
+	if err := resolveRoutineRecurrenceUpdate(*existingRoutine, &input); err != nil {
+		parsedOptions.DB.Rollback()
+		return nil, apiexceptions.NewRoutineException().InvalidInput().WithOrigin(err)
+	}
 
 	updates, err := partialupdate.PartialUpdatePreprocess(input.Values, input.SetNull, *existingRoutine)
 	if err != nil {
@@ -560,9 +586,9 @@ func (r *RoutineRepository) UpdateManyByIds(
 		parsedOptions.DB.Rollback()
 		return apiexceptions.NewRoutineException().NoPermission("update these routines")
 	}
-	isRoutineValid := make(map[uuid.UUID]bool, len(validRoutines))
+	validRoutineById := make(map[uuid.UUID]schemas.Routine, len(validRoutines))
 	for _, validRoutine := range validRoutines {
-		isRoutineValid[validRoutine.Id] = true
+		validRoutineById[validRoutine.Id] = validRoutine
 	}
 
 	targetStationIdSet := make(map[uuid.UUID]bool)
@@ -593,11 +619,17 @@ func (r *RoutineRepository) UpdateManyByIds(
 	var valuePlaceholders []string
 	var valueArgs []interface{}
 	for _, in := range input {
-		if !isRoutineValid[in.Id] {
+		validRoutine, isRoutineValid := validRoutineById[in.Id]
+		if !isRoutineValid {
 			continue
 		}
+		if err := resolveRoutineRecurrenceUpdate(validRoutine, &in.PartialUpdateInput); err != nil {
+			parsedOptions.DB.Rollback()
+			return apiexceptions.NewRoutineException().InvalidInput().WithOrigin(err)
+		}
 
 		setPeriodNull := partialupdate.CheckSetNull(in.PartialUpdateInput.SetNull, "Period")
+		setRecurrenceRuleNull := partialupdate.CheckSetNull(in.PartialUpdateInput.SetNull, "RecurrenceRule")
 
 		scheduledStartAt := in.PartialUpdateInput.Values.ScheduledStartAt
 		if scheduledStartAt != nil {
@@ -610,7 +642,7 @@ func (r *RoutineRepository) UpdateManyByIds(
 			scheduledEndAt = &truncatedScheduledEndAt
 		}
 
-		valuePlaceholders = append(valuePlaceholders, `(?::uuid, ?::uuid, ?::text, ?::text, ?::"RoutineStatus", ?::boolean, ?::timestamptz, ?::timestamptz, ?::"RoutinePeriod", ?::text, ?::boolean)`)
+		valuePlaceholders = append(valuePlaceholders, `(?::uuid, ?::uuid, ?::text, ?::text, ?::"RoutineStatus", ?::boolean, ?::timestamptz, ?::timestamptz, ?::"RoutinePeriod", ?::text, ?::text, ?::boolean, ?::boolean)`)
 		valueArgs = append(valueArgs,
 			in.Id,
 			in.PartialUpdateInput.Values.StationId,
@@ -621,8 +653,10 @@ func (r *RoutineRepository) UpdateManyByIds(
 			scheduledStartAt,
 			scheduledEndAt,
 			in.PartialUpdateInput.Values.Period,
+			in.PartialUpdateInput.Values.RecurrenceRule,
 			in.PartialUpdateInput.Values.Timezone,
 			setPeriodNull,
+			setRecurrenceRuleNull,
 		)
 	}
 
@@ -645,9 +679,13 @@ func (r *RoutineRepository) UpdateManyByIds(
 				WHEN v.set_period_null::boolean THEN NULL
 				ELSE COALESCE(v.period::"RoutinePeriod", r.period)
 			END,
+			recurrence_rule = CASE
+				WHEN v.set_recurrence_rule_null::boolean THEN NULL
+				ELSE COALESCE(v.recurrence_rule::text, r.recurrence_rule)
+			END,
 			timezone = COALESCE(v.timezone::text, r.timezone),
 			updated_at = NOW()
-		FROM (VALUES %s) AS v(id, station_id, title, description, status, is_pinned, scheduled_start_at, scheduled_end_at, period, timezone, set_period_null)
+		FROM (VALUES %s) AS v(id, station_id, title, description, status, is_pinned, scheduled_start_at, scheduled_end_at, period, recurrence_rule, timezone, set_period_null, set_recurrence_rule_null)
 		WHERE r.id = v.id::uuid AND r.deleted_at IS NULL
 	`, strings.Join(valuePlaceholders, ","))
 	result := parsedOptions.DB.Exec(sql, valueArgs...)
@@ -998,7 +1036,7 @@ func (r *RoutineRepository) BulkCreateMany(
 			timezone = *in.Timezone
 		}
 
-		newRoutines = append(newRoutines, schemas.Routine{
+		newRoutine := schemas.Routine{
 			Id:               newRoutineId,
 			StationId:        in.StationId,
 			Title:            in.Title,
@@ -1008,8 +1046,13 @@ func (r *RoutineRepository) BulkCreateMany(
 			ScheduledStartAt: *scheduledStartAt,
 			ScheduledEndAt:   *scheduledEndAt,
 			Period:           in.Period,
+			RecurrenceRule:   in.RecurrenceRule,
 			Timezone:         timezone,
-		})
+		}
+		if err := alignRoutineRecurrence(&newRoutine); err != nil {
+			continue
+		}
+		newRoutines = append(newRoutines, newRoutine)
 		successIndexes = append(successIndexes, index)
 	}
 
@@ -1066,11 +1109,15 @@ func (r *RoutineRepository) BulkUpdateMany(
 	checkOptions := append(opts, options.WithTransactionDB(parsedOptions.DB))
 	checkOptions = append(checkOptions, options.WithOnlyDeleted(types.Ternary_Negative))
 	checkOptions = append(checkOptions, options.WithLockingStrength(options.LockingStrengthNoKeyUpdate))
-	successes, _, exception := r.BulkCheckPermissionsAndGetManyByIds(checkInputs, nil, parsedOptions.AllowedPermissions, checkOptions...)
+	successes, validRoutines, exception := r.BulkCheckPermissionsAndGetManyByIds(checkInputs, nil, parsedOptions.AllowedPermissions, checkOptions...)
 	if exception != nil {
 		parsedOptions.DB.Rollback()
 		return nil, exception
 	}
+	validRoutineById := make(map[uuid.UUID]schemas.Routine, len(validRoutines))
+	for _, validRoutine := range validRoutines {
+		validRoutineById[validRoutine.Id] = validRoutine
+	}
 
 	targetStationIds := make([]uuid.UUID, 0, len(bulkInputs))
 	targetUserIds := make([]uuid.UUID, 0, len(bulkInputs))
@@ -1116,13 +1163,18 @@ func (r *RoutineRepository) BulkUpdateMany(
 	}
 
 	valuePlaceholders := make([]string, 0, len(bulkInputs))
-	valueArgs := make([]interface{}, 0, len(bulkInputs)*12)
+	valueArgs := make([]interface{}, 0, len(bulkInputs)*14)
 	for index, in := range bulkInputs {
 		if !successes[index] {
 			continue
 		}
+		if err := resolveRoutineRecurrenceUpdate(validRoutineById[in.Id], &in.PartialUpdateInput); err != nil {
+			successes[index] = false
+			continue
+		}
 
 		setPeriodNull := partialupdate.CheckSetNull(in.PartialUpdateInput.SetNull, "Period")
+		setRecurrenceRuleNull := partialupdate.CheckSetNull(in.PartialUpdateInput.SetNull, "RecurrenceRule")
 
 		scheduledStartAt := in.PartialUpdateInput.Values.ScheduledStartAt
 		if scheduledStartAt != nil {
@@ -1136,7 +1188,7 @@ func (r *RoutineRepository) BulkUpdateMany(
 			scheduledEndAt = &truncatedScheduledEndAt
 		}
 
-		valuePlaceholders = append(valuePlaceholders, `(?::int, ?::uuid, ?::uuid, ?::text, ?::text, ?::"RoutineStatus", ?::boolean, ?::timestamptz, ?::timestamptz, ?::"RoutinePeriod", ?::text, ?::boolean)`)
+		valuePlaceholders = append(valuePlaceholders, `(?::int, ?::uuid, ?::uuid, ?::text, ?::text, ?::"RoutineStatus", ?::boolean, ?::timestamptz, ?::timestamptz, ?::"RoutinePeriod", ?::text, ?::text, ?::boolean, ?::boolean)`)
 		valueArgs = append(valueArgs,
 			index,
 			in.Id,
@@ -1148,8 +1200,10 @@ func (r *RoutineRepository) BulkUpdateMany(
 			scheduledStartAt,
 			scheduledEndAt,
 			in.PartialUpdateInput.Values.Period,
+			in.PartialUpdateInput.Values.RecurrenceRule,
 			in.PartialUpdateInput.Values.Timezone,
 			setPeriodNull,
+			setRecurrenceRuleNull,
 		)
 	}
 	if len(valuePlaceholders) == 0 {
@@ -1160,7 +1214,7 @@ func (r *RoutineRepository) BulkUpdateMany(
 	}
 
 	sql := fmt.Sprintf(`
-		WITH payload(idx, id, station_id, title, description, status, is_pinned, scheduled_start_at, scheduled_end_at, period, timezone, set_period_null) AS (
+		WITH payload(idx, id, station_id, title, description, status, is_pinned, scheduled_start_at, scheduled_end_at, period, recurrence_rule, timezone, set_period_null, set_recurrence_rule_null) AS (
 			VALUES %s
 		),
 		updated AS (
@@ -1177,6 +1231,10 @@ func (r *RoutineRepository) BulkUpdateMany(
 					WHEN v.set_period_null::boolean THEN NULL
 					ELSE COALESCE(v.period::"RoutinePeriod", r.period)
 				END,
+				recurrence_rule = CASE
+					WHEN v.set_recurrence_rule_null::boolean THEN NULL
+					ELSE COALESCE(v.recurrence_rule::text, r.recurrence_rule)
+				END,
 				timezone = COALESCE(v.timezone::text, r.timezone),
 				updated_at = NOW()
 			FROM payload AS v
@@ -1214,3 +1272,113 @@ func (r *RoutineRepository) BulkUpdateMany(
 
 	return successes, nil
 }
+
+/* ============================== Recurrence Helpers ============================== */
+
+// alignRecurrenceRule normalizes the recurrence rule and returns its first occurrence at or after the given time
+// in the given timezone, the first occurrence then becomes the DTSTART of the rule
+func alignRecurrenceRule(recurrenceRule string, at time.Time, timezone string) (string, time.Time, error) {
+	rule, err := recurrence.Parse(recurrenceRule)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	// the occurrences are in minute precision, so the first one after the previous minute is the first one at or after the given time
+	at = at.Truncate(time.Minute)
+	firstOccurrence, ok := rule.Next(at, at.Add(-time.Minute), location)
+	if !ok {
+		return "", time.Time{}, fmt.Errorf("recurrence rule %s has no occurrence after %s", rule.String(), at.Format(time.RFC3339))
+	}
+
+	return rule.String(), firstOccurrence.UTC(), nil
+}
+
+// alignRoutineRecurrence shifts the scheduled time range of the routine to the first occurrence of its recurrence rule
+// and keeps the duration of the routine, so the scheduled start is always the DTSTART of the rule
+func alignRoutineRecurrence(routine *schemas.Routine) error {
+	if routine.RecurrenceRule == nil {
+		return nil
+	}
+
+	recurrenceRule, scheduledStartAt, err := alignRecurrenceRule(*routine.RecurrenceRule, routine.ScheduledStartAt, routine.Timezone)
+	if err != nil {
+		return err
+	}
+	duration := routine.ScheduledEndAt.Sub(routine.ScheduledStartAt)
+	routine.RecurrenceRule = &recurrenceRule
+	routine.ScheduledStartAt = scheduledStartAt
+	routine.ScheduledEndAt = scheduledStartAt.Add(duration)
+
+	return nil
+}
+
+// resolveRoutineRecurrenceUpdate realigns the recurrence rule of the routine with the given update values,
+// note that setting either the period or the recurrence rule clears the other one
+func resolveRoutineRecurrenceUpdate(existingRoutine schemas.Routine, input *inputs.PartialUpdateRoutineInput) error {
+	if input.Values.RecurrenceRule != nil {
+		markSetNull(&input.SetNull, "Period")
+	}
+	if input.Values.Period != nil {
+		markSetNull(&input.SetNull, "RecurrenceRule")
+	}
+	if partialupdate.CheckSetNull(input.SetNull, "RecurrenceRule") {
+		return nil
+	}
+	if input.Values.RecurrenceRule == nil && existingRoutine.RecurrenceRule == nil {
+		return nil
+	}
+	if input.Values.RecurrenceRule == nil && input.Values.ScheduledStartAt == nil &&
+		input.Values.ScheduledEndAt == nil && input.Values.Timezone == nil {
+		return nil
+	}
+
+	routine := existingRoutine
+	if input.Values.RecurrenceRule != nil {
+		routine.RecurrenceRule = input.Values.RecurrenceRule
+	}
+	if input.Values.ScheduledStartAt != nil {
+		routine.ScheduledStartAt = *input.Values.ScheduledStartAt
+	}
+	if input.Values.ScheduledEndAt != nil {
+		routine.ScheduledEndAt = *input.Values.ScheduledEndAt
+	}
+	if input.Values.Timezone != nil {
+		routine.Timezone = *input.Values.Timezone
+	}
+	if err := alignRoutineRecurrence(&routine); err != nil {
+		return err
+	}
+	input.Values.RecurrenceRule = routine.RecurrenceRule
+	input.Values.ScheduledStartAt = &routine.ScheduledStartAt
+	input.Values.ScheduledEndAt = &routine.ScheduledEndAt
+
+	return nil
+}
+
+// hasRoutineOccurrenceWithin checks if any occurrence of the recurrence rule of the routine overlaps with [from, to)
+func hasRoutineOccurrenceWithin(routine schemas.Routine, from time.Time, to time.Time) bool {
+	rule, err := recurrence.Parse(*routine.RecurrenceRule)
+	if err != nil {
+		return false
+	}
+	location, err := time.LoadLocation(routine.Timezone)
+	if err != nil {
+		return false
+	}
+
+	// an occurrence overlaps with the range if it starts before the end of the range and ends after the start of the range
+	duration := routine.ScheduledEndAt.Sub(routine.ScheduledStartAt)
+	occurrence, ok := rule.Next(routine.ScheduledStartAt, from.Add(-duration), location)
+	return ok && occurrence.Before(to)
+}
+
+func markSetNull(setNull **map[string]bool, fieldName string) {
+	if *setNull == nil {
+		*setNull = &map[string]bool{}
+	}
+	(**setNull)[fieldName] = true
+}
@@ -171,6 +171,7 @@ func (r *RoutineTaskRecordRepository) GetAllByRoutineTaskId(
 		Joins(`INNER JOIN "UsersToStationsTable" uts ON uts.station_id = routine.station_id`).
 		Where(`"RoutineTaskRecordTable".routine_task_id = ?`, routineTaskId).
 		Where("uts.user_id = ? AND uts.permission IN ?", userId, parsedOptions.AllowedPermissions).
+		Scopes(scopes.RestrictStationsOfAPIKey("uts.station_id")).
 		Scopes(r.routineTaskRecordScope.IncludePreloads(preloads)).
 		Order(`"RoutineTaskRecordTable".created_at DESC`).
 		Limit(limit).
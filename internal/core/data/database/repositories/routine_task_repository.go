@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jinzhu/copier"
+	"gorm.io/gorm"
 
 	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
 
@@ -181,6 +182,7 @@ func (r *RoutineTaskRepository) GetAllByUserId(
 		Joins(`INNER JOIN "UsersToStationsTable" uts ON uts.station_id = routine.station_id`).
 		Joins(`INNER JOIN "StationTable" station ON station.id = routine.station_id AND station.deleted_at IS NULL`).
 		Where("uts.user_id = ? AND uts.permission IN ?", userId, parsedOptions.AllowedPermissions).
+		Scopes(scopes.RestrictStationsOfAPIKey("uts.station_id")).
 		Scopes(r.routineTaskScope.IncludePreloads(preloads)).
 		Find(&routineTasks)
 	if result.Error != nil {
@@ -210,6 +212,7 @@ func (r *RoutineTaskRepository) GetAllByRoutineIds(
 		Joins(`INNER JOIN "StationTable" station ON station.id = routine.station_id AND station.deleted_at IS NULL`).
 		Where(`"RoutineTaskTable".routine_id IN ?`, routineIds).
 		Where("uts.user_id = ? AND uts.permission IN ?", userId, parsedOptions.AllowedPermissions).
+		Scopes(scopes.RestrictStationsOfAPIKey("uts.station_id")).
 		Scopes(r.routineTaskScope.IncludePreloads(preloads)).
 		Find(&routineTasks)
 	if result.Error != nil {
@@ -262,6 +265,17 @@ func (r *RoutineTaskRepository) CreateOneByRoutineId(
 	newRoutineTask.ActorUserId = input.ActorUserId
 	newRoutineTask.NextScheduledAt = input.NextScheduledAt.Truncate(time.Minute)
 	newRoutineTask.ScheduledAt = newRoutineTask.NextScheduledAt
+	if newRoutineTask.RecurrenceRule != nil {
+		timezoneByRoutineId, err := getRoutineTimezonesByIds(parsedOptions.DB, []uuid.UUID{routineId})
+		if err != nil {
+			parsedOptions.DB.Rollback()
+			return nil, apiexceptions.NewRoutineTaskException().FailedToCreate().WithOrigin(err)
+		}
+		if err := alignRoutineTaskRecurrence(&newRoutineTask, timezoneByRoutineId[routineId]); err != nil {
+			parsedOptions.DB.Rollback()
+			return nil, apiexceptions.NewRoutineTaskException().InvalidInput().WithOrigin(err)
+		}
+	}
 
 	result := parsedOptions.DB.
 		Model(&schemas.RoutineTask{}).
@@ -319,14 +333,15 @@ func (r *RoutineTaskRepository) CreateManyByRoutineIds(
 		return nil, exception
 	}
 
-	isRoutineValid := make(map[uuid.UUID]bool, len(validRoutines))
+	timezoneByRoutineId := make(map[uuid.UUID]string, len(validRoutines))
 	for _, validRoutine := range validRoutines {
-		isRoutineValid[validRoutine.Id] = true
+		timezoneByRoutineId[validRoutine.Id] = validRoutine.Timezone
 	}
 
 	newRoutineTasks := make([]schemas.RoutineTask, 0, len(input))
 	for _, in := range input {
-		if !isRoutineValid[in.RoutineId] {
+		timezone, isRoutineValid := timezoneByRoutineId[in.RoutineId]
+		if !isRoutineValid {
 			continue
 		}
 		if in.NextScheduledAt.IsZero() {
@@ -345,6 +360,10 @@ func (r *RoutineTaskRepository) CreateManyByRoutineIds(
 		newRoutineTask.RoutineId = in.RoutineId
 		newRoutineTask.NextScheduledAt = in.NextScheduledAt.Truncate(time.Minute)
 		newRoutineTask.ScheduledAt = newRoutineTask.NextScheduledAt
+		if err := alignRoutineTaskRecurrence(&newRoutineTask, timezone); err != nil {
+			parsedOptions.DB.Rollback()
+			return nil, apiexceptions.NewRoutineTaskException().InvalidInput().WithOrigin(err)
+		}
 		newRoutineTasks = append(newRoutineTasks, newRoutineTask)
 	}
 
@@ -425,6 +444,23 @@ func (r *RoutineTaskRepository) UpdateOneById(
 			}
 		}
 	}
+	targetRoutineId := existingRoutineTask.RoutineId
+	if input.Values.RoutineId != nil {
+		targetRoutineId = *input.Values.RoutineId
+	}
+	timezoneByRoutineId := make(map[uuid.UUID]string)
+	if input.Values.RecurrenceRule != nil || existingRoutineTask.RecurrenceRule != nil {
+		var err error
+		timezoneByRoutineId, err = getRoutineTimezonesByIds(parsedOptions.DB, []uuid.UUID{targetRoutineId})
+		if err != nil {
+			parsedOptions.DB.Rollback()
+			return nil, apiexceptions.NewRoutineTaskException().FailedToUpdate().WithOrigin(err)
+		}
+	}
+	if err := resolveRoutineTaskRecurrenceUpdate(*existingRoutineTask, timezoneByRoutineId[targetRoutineId], &input); err != nil {
+		parsedOptions.DB.Rollback()
+		return nil, apiexceptions.NewRoutineTaskException().InvalidInput().WithOrigin(err)
+	}
 
 	updates, err := partialupdate.PartialUpdatePreprocess(input.Values, input.SetNull, *existingRoutineTask)
 	if err != nil {
@@ -483,9 +519,9 @@ func (r *RoutineTaskRepository) UpdateManyByIds(
 		return apiexceptions.NewRoutineTaskException().NoPermission("update these routine tasks")
 	}
 
-	isRoutineTaskValid := make(map[uuid.UUID]bool, len(validRoutineTasks))
+	validRoutineTaskById := make(map[uuid.UUID]schemas.RoutineTask, len(validRoutineTasks))
 	for _, validRoutineTask := range validRoutineTasks {
-		isRoutineTaskValid[validRoutineTask.Id] = true
+		validRoutineTaskById[validRoutineTask.Id] = validRoutineTask
 	}
 
 	targetRoutineIdSet := make(map[uuid.UUID]bool)
@@ -508,14 +544,50 @@ func (r *RoutineTaskRepository) UpdateManyByIds(
 		}
 	}
 
+	recurringRoutineIdSet := make(map[uuid.UUID]bool)
+	for _, in := range input {
+		validRoutineTask, isRoutineTaskValid := validRoutineTaskById[in.Id]
+		if !isRoutineTaskValid ||
+			(in.PartialUpdateInput.Values.RecurrenceRule == nil && validRoutineTask.RecurrenceRule == nil) {
+			continue
+		}
+		recurringRoutineIdSet[validRoutineTask.RoutineId] = true
+		if in.PartialUpdateInput.Values.RoutineId != nil {
+			recurringRoutineIdSet[*in.PartialUpdateInput.Values.RoutineId] = true
+		}
+	}
+	timezoneByRoutineId := make(map[uuid.UUID]string)
+	if len(recurringRoutineIdSet) > 0 {
+		recurringRoutineIds := make([]uuid.UUID, 0, len(recurringRoutineIdSet))
+		for recurringRoutineId := range recurringRoutineIdSet {
+			recurringRoutineIds = append(recurringRoutineIds, recurringRoutineId)
+		}
+		var err error
+		timezoneByRoutineId, err = getRoutineTimezonesByIds(parsedOptions.DB, recurringRoutineIds)
+		if err != nil {
+			parsedOptions.DB.Rollback()
+			return apiexceptions.NewRoutineTaskException().FailedToUpdate().WithOrigin(err)
+		}
+	}
+
 	var valuePlaceholders []string
 	var valueArgs []interface{}
 	for _, in := range input {
-		if !isRoutineTaskValid[in.Id] {
+		validRoutineTask, isRoutineTaskValid := validRoutineTaskById[in.Id]
+		if !isRoutineTaskValid {
 			continue
 		}
+		targetRoutineId := validRoutineTask.RoutineId
+		if in.PartialUpdateInput.Values.RoutineId != nil {
+			targetRoutineId = *in.PartialUpdateInput.Values.RoutineId
+		}
+		if err := resolveRoutineTaskRecurrenceUpdate(validRoutineTask, timezoneByRoutineId[targetRoutineId], &in.PartialUpdateInput); err != nil {
+			parsedOptions.DB.Rollback()
+			return apiexceptions.NewRoutineTaskException().InvalidInput().WithOrigin(err)
+		}
 
 		setPeriodNull := partialupdate.CheckSetNull(in.PartialUpdateInput.SetNull, "Period")
+		setRecurrenceRuleNull := partialupdate.CheckSetNull(in.PartialUpdateInput.SetNull, "RecurrenceRule")
 
 		nextScheduledAt := in.PartialUpdateInput.Values.NextScheduledAt
 		if nextScheduledAt != nil {
@@ -529,7 +601,7 @@ func (r *RoutineTaskRepository) UpdateManyByIds(
 			scheduledAt = &truncatedScheduledAt
 		}
 
-		valuePlaceholders = append(valuePlaceholders, `(?::uuid, ?::uuid, ?::text, ?::"RoutineTaskPurpose", ?::jsonb, ?::integer, ?::integer, ?::"RoutinePeriod", ?::timestamptz, ?::timestamptz, ?::boolean)`)
+		valuePlaceholders = append(valuePlaceholders, `(?::uuid, ?::uuid, ?::text, ?::"RoutineTaskPurpose", ?::jsonb, ?::integer, ?::integer, ?::"RoutinePeriod", ?::text, ?::timestamptz, ?::timestamptz, ?::timestamptz, ?::boolean, ?::boolean)`)
 		valueArgs = append(valueArgs,
 			in.Id,
 			in.PartialUpdateInput.Values.RoutineId,
@@ -539,9 +611,12 @@ func (r *RoutineTaskRepository) UpdateManyByIds(
 			in.PartialUpdateInput.Values.Priority,
 			in.PartialUpdateInput.Values.MaxAttempts,
 			in.PartialUpdateInput.Values.Period,
+			in.PartialUpdateInput.Values.RecurrenceRule,
+			in.PartialUpdateInput.Values.RecurrenceStartAt,
 			nextScheduledAt,
 			scheduledAt,
 			setPeriodNull,
+			setRecurrenceRuleNull,
 		)
 	}
 
@@ -563,6 +638,14 @@ func (r *RoutineTaskRepository) UpdateManyByIds(
 				WHEN v.set_period_null::boolean THEN NULL
 				ELSE COALESCE(v.period::"RoutinePeriod", rt.period)
 			END,
+			recurrence_rule = CASE
+				WHEN v.set_recurrence_rule_null::boolean THEN NULL
+				ELSE COALESCE(v.recurrence_rule::text, rt.recurrence_rule)
+			END,
+			recurrence_start_at = CASE
+				WHEN v.set_recurrence_rule_null::boolean THEN NULL
+				ELSE COALESCE(v.recurrence_start_at::timestamptz, rt.recurrence_start_at)
+			END,
 			next_scheduled_at = COALESCE(v.next_scheduled_at::timestamptz, rt.next_scheduled_at),
 			scheduled_at = CASE
 				WHEN v.scheduled_at IS NOT NULL THEN v.scheduled_at::timestamptz
@@ -570,7 +653,7 @@ func (r *RoutineTaskRepository) UpdateManyByIds(
 				ELSE rt.scheduled_at
 			END,
 			updated_at = NOW()
-		FROM (VALUES %s) AS v(id, routine_id, title, purpose, payload, priority, max_attempts, period, next_scheduled_at, scheduled_at, set_period_null)
+		FROM (VALUES %s) AS v(id, routine_id, title, purpose, payload, priority, max_attempts, period, recurrence_rule, recurrence_start_at, next_scheduled_at, scheduled_at, set_period_null, set_recurrence_rule_null)
 		WHERE rt.id = v.id::uuid
 	`, strings.Join(valuePlaceholders, ","))
 	result := parsedOptions.DB.Exec(sql, valueArgs...)
@@ -639,3 +722,86 @@ func (r *RoutineTaskRepository) HardDeleteManyByIds(
 
 	return nil
 }
+
+/* ============================== Recurrence Helpers ============================== */
+
+func getRoutineTimezonesByIds(db *gorm.DB, routineIds []uuid.UUID) (map[uuid.UUID]string, error) {
+	var routines []struct {
+		Id       uuid.UUID `gorm:"column:id;"`
+		Timezone string    `gorm:"column:timezone;"`
+	}
+	result := db.Model(&schemas.Routine{}).
+		Select("id, timezone").
+		Where("id IN ?", routineIds).
+		Scan(&routines)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	timezoneByRoutineId := make(map[uuid.UUID]string, len(routines))
+	for _, routine := range routines {
+		timezoneByRoutineId[routine.Id] = routine.Timezone
+	}
+	return timezoneByRoutineId, nil
+}
+
+// alignRoutineTaskRecurrence moves the next scheduled time of the routine task to the first occurrence of its recurrence rule
+// in the timezone of its routine, and anchors the rule on that occurrence
+func alignRoutineTaskRecurrence(routineTask *schemas.RoutineTask, timezone string) error {
+	if routineTask.RecurrenceRule == nil {
+		return nil
+	}
+
+	recurrenceRule, firstOccurrence, err := alignRecurrenceRule(*routineTask.RecurrenceRule, routineTask.NextScheduledAt, timezone)
+	if err != nil {
+		return err
+	}
+	routineTask.RecurrenceRule = &recurrenceRule
+	routineTask.RecurrenceStartAt = &firstOccurrence
+	routineTask.NextScheduledAt = firstOccurrence
+	routineTask.ScheduledAt = firstOccurrence
+
+	return nil
+}
+
+// resolveRoutineTaskRecurrenceUpdate realigns the recurrence rule of the routine task with the given update values,
+// note that setting either the period or the recurrence rule clears the other one
+func resolveRoutineTaskRecurrenceUpdate(existingRoutineTask schemas.RoutineTask, timezone string, input *inputs.PartialUpdateRoutineTaskInput) error {
+	if input.Values.RecurrenceRule != nil {
+		markSetNull(&input.SetNull, "Period")
+	}
+	if input.Values.Period != nil {
+		markSetNull(&input.SetNull, "RecurrenceRule")
+	}
+	if partialupdate.CheckSetNull(input.SetNull, "RecurrenceRule") {
+		markSetNull(&input.SetNull, "RecurrenceStartAt")
+		return nil
+	}
+	if input.Values.RecurrenceRule == nil && existingRoutineTask.RecurrenceRule == nil {
+		return nil
+	}
+	if input.Values.RecurrenceRule == nil && input.Values.NextScheduledAt == nil && input.Values.RoutineId == nil {
+		return nil
+	}
+
+	// without an explicit next scheduled time, the rule is realigned from now on instead of replaying the past occurrences
+	routineTask := existingRoutineTask
+	if now := time.Now().Truncate(time.Minute); routineTask.NextScheduledAt.Before(now) {
+		routineTask.NextScheduledAt = now
+	}
+	if input.Values.NextScheduledAt != nil {
+		routineTask.NextScheduledAt = *input.Values.NextScheduledAt
+	}
+	if input.Values.RecurrenceRule != nil {
+		routineTask.RecurrenceRule = input.Values.RecurrenceRule
+	}
+	if err := alignRoutineTaskRecurrence(&routineTask, timezone); err != nil {
+		return err
+	}
+	input.Values.RecurrenceRule = routineTask.RecurrenceRule
+	input.Values.RecurrenceStartAt = routineTask.RecurrenceStartAt
+	input.Values.NextScheduledAt = &routineTask.NextScheduledAt
+	input.Values.ScheduledAt = &routineTask.ScheduledAt
+
+	return nil
+}
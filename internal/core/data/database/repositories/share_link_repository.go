@@ -0,0 +1,168 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+
+	inputs "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/inputs"
+	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
+	schemas "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas"
+	apiexceptions "github.com/HiIamJeff67/notegic-backend/internal/core/exceptions"
+)
+
+type ShareLinkRepositoryInterface interface {
+	GetOneById(id uuid.UUID, opts ...options.RepositoryOptions) (*schemas.ShareLink, *exceptions.Exception)
+	GetOneByTokenHash(tokenHash string, opts ...options.RepositoryOptions) (*schemas.ShareLink, *exceptions.Exception)
+	GetManyByRootShelfId(rootShelfId uuid.UUID, opts ...options.RepositoryOptions) ([]schemas.ShareLink, *exceptions.Exception)
+	CreateOne(creatorId uuid.UUID, input inputs.CreateShareLinkInput, opts ...options.RepositoryOptions) (*schemas.ShareLink, *exceptions.Exception)
+	RevokeOneById(id uuid.UUID, rootShelfId uuid.UUID, revokedAt time.Time, opts ...options.RepositoryOptions) (*schemas.ShareLink, *exceptions.Exception)
+	ConsumeOneById(id uuid.UUID, usedAt time.Time, opts ...options.RepositoryOptions) *exceptions.Exception
+}
+
+type ShareLinkRepository struct{}
+
+func NewShareLinkRepository() ShareLinkRepositoryInterface {
+	return &ShareLinkRepository{}
+}
+
+func (r *ShareLinkRepository) GetOneById(
+	id uuid.UUID,
+	opts ...options.RepositoryOptions,
+) (*schemas.ShareLink, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	shareLink := &schemas.ShareLink{}
+	result := parsedOptions.DB.
+		Model(&schemas.ShareLink{}).
+		Where("id = ?", id).
+		First(shareLink)
+	if result.Error != nil {
+		return nil, apiexceptions.NewShareLinkException().NotFound().WithOrigin(result.Error)
+	}
+
+	return shareLink, nil
+}
+
+func (r *ShareLinkRepository) GetOneByTokenHash(
+	tokenHash string,
+	opts ...options.RepositoryOptions,
+) (*schemas.ShareLink, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	shareLink := &schemas.ShareLink{}
+	result := parsedOptions.DB.
+		Model(&schemas.ShareLink{}).
+		Where("token_hash = ?", tokenHash).
+		First(shareLink)
+	if result.Error != nil {
+		return nil, apiexceptions.NewShareLinkException().NotFound().WithOrigin(result.Error)
+	}
+
+	return shareLink, nil
+}
+
+func (r *ShareLinkRepository) GetManyByRootShelfId(
+	rootShelfId uuid.UUID,
+	opts ...options.RepositoryOptions,
+) ([]schemas.ShareLink, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	shareLinks := []schemas.ShareLink{}
+	result := parsedOptions.DB.
+		Model(&schemas.ShareLink{}).
+		Where("root_shelf_id = ?", rootShelfId).
+		Order("created_at DESC, id DESC").
+		Find(&shareLinks)
+	if result.Error != nil {
+		return nil, apiexceptions.NewShareLinkException().NotFound().WithOrigin(result.Error)
+	}
+
+	return shareLinks, nil
+}
+
+func (r *ShareLinkRepository) CreateOne(
+	creatorId uuid.UUID,
+	input inputs.CreateShareLinkInput,
+	opts ...options.RepositoryOptions,
+) (*schemas.ShareLink, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	newShareLink := schemas.ShareLink{
+		Id:           uuid.New(),
+		TokenHash:    input.TokenHash,
+		RootShelfId:  input.RootShelfId,
+		BlockPackId:  input.BlockPackId,
+		Permission:   input.Permission,
+		PasswordHash: input.PasswordHash,
+		MaxUseCount:  input.MaxUseCount,
+		ExpiresAt:    input.ExpiresAt,
+		CreatorId:    creatorId,
+	}
+	result := parsedOptions.DB.
+		Model(&schemas.ShareLink{}).
+		Create(&newShareLink)
+	if result.Error != nil {
+		return nil, apiexceptions.NewShareLinkException().FailedToCreate().WithOrigin(result.Error)
+	}
+
+	return &newShareLink, nil
+}
+
+// RevokeOneById only revokes the share link of the given root shelf, revoking a
+// revoked share link is a no-op that returns the share link as it is.
+func (r *ShareLinkRepository) RevokeOneById(
+	id uuid.UUID,
+	rootShelfId uuid.UUID,
+	revokedAt time.Time,
+	opts ...options.RepositoryOptions,
+) (*schemas.ShareLink, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	var revokedShareLink schemas.ShareLink
+	result := parsedOptions.DB.
+		Model(&revokedShareLink).
+		Where("id = ? AND root_shelf_id = ?", id, rootShelfId).
+		Clauses(clause.Returning{}).
+		Update("revoked_at", gorm.Expr("COALESCE(revoked_at, ?)", revokedAt))
+	if exception := exceptions.Cover(nil, []exceptions.Pair{
+		{First: result.Error != nil, Second: apiexceptions.NewShareLinkException().FailedToUpdate().WithOrigin(result.Error)},
+		{First: result.RowsAffected == 0 || revokedShareLink.Id == uuid.Nil, Second: apiexceptions.NewShareLinkException().NotFound()},
+	}); exception != nil {
+		return nil, exception
+	}
+
+	return &revokedShareLink, nil
+}
+
+// ConsumeOneById counts one use of the share link in a single conditional update,
+// so the concurrent resolutions can never exceed the maximum use count.
+func (r *ShareLinkRepository) ConsumeOneById(
+	id uuid.UUID,
+	usedAt time.Time,
+	opts ...options.RepositoryOptions,
+) *exceptions.Exception {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	result := parsedOptions.DB.
+		Model(&schemas.ShareLink{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Where("expires_at IS NULL OR expires_at > ?", usedAt).
+		Where("max_use_count IS NULL OR use_count < max_use_count").
+		Updates(map[string]any{
+			"use_count":    gorm.Expr("use_count + 1"),
+			"last_used_at": usedAt,
+		})
+	if result.Error != nil {
+		return apiexceptions.NewShareLinkException().FailedToUpdate().WithOrigin(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apiexceptions.NewShareLinkException().Inactive()
+	}
+
+	return nil
+}
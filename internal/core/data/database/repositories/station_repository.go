@@ -246,7 +246,8 @@ func (r *StationRepository) GetAllByUserId(
 		Model(&schemas.Station{}).
 		Select(`"StationTable".*, uts.permission AS permission`).
 		Joins(`INNER JOIN "UsersToStationsTable" uts ON uts.station_id = "StationTable".id`).
-		Where("uts.user_id = ?", userId)
+		Where("uts.user_id = ?", userId).
+		Scopes(scopes.RestrictStationsOfAPIKey("uts.station_id"))
 	if parsedOptions.HasAllowedPermissions() {
 		result = result.Where("uts.permission IN ?", parsedOptions.AllowedPermissions)
 	}
@@ -200,6 +200,10 @@ func (r *SubShelfRepository) GetAllByRootShelfId(
 			Select("1").
 			Where(`root_shelf_id = "SubShelfTable".root_shelf_id AND user_id = ? AND permission IN ?`,
 				userId, parsedOptions.AllowedPermissions,
+			).
+			Scopes(
+				scopes.RestrictRootShelvesOfAPIKey(`"UsersToShelvesTable".root_shelf_id`),
+				scopes.RestrictRootShelvesOfShareLink(`"UsersToShelvesTable".root_shelf_id`),
 			)
 		query = query.Where("EXISTS (?)", subQuery)
 	}
@@ -0,0 +1,459 @@
+package repositories
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+	types "github.com/HiIamJeff67/notegic-backend/shared/types"
+
+	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
+)
+
+const (
+	TrashedItemType_RootShelf = "ROOT_SHELF"
+	TrashedItemType_SubShelf  = "SUB_SHELF"
+	TrashedItemType_Material  = "MATERIAL"
+	TrashedItemType_BlockPack = "BLOCK_PACK"
+)
+
+// trashedItemsSQL selects the soft deleted items at the top of each deletion, the items
+// deleted together with their shelf are represented by that shelf, since the cascading
+// triggers restore them with the shelf, and the purge deletes them with the shelf
+const trashedItemsSQL = `
+	SELECT
+		root_shelf.id,
+		'ROOT_SHELF' AS type,
+		root_shelf.name,
+		root_shelf.owner_id,
+		root_shelf.id AS root_shelf_id,
+		NULL::uuid AS parent_sub_shelf_id,
+		0::bigint AS size,
+		root_shelf.deleted_at
+	FROM "RootShelfTable" AS root_shelf
+	WHERE root_shelf.deleted_at IS NOT NULL
+	UNION ALL
+	SELECT
+		sub_shelf.id,
+		'SUB_SHELF',
+		sub_shelf.name,
+		root_shelf.owner_id,
+		sub_shelf.root_shelf_id,
+		sub_shelf.prev_sub_shelf_id,
+		0::bigint,
+		sub_shelf.deleted_at
+	FROM "SubShelfTable" AS sub_shelf
+	JOIN "RootShelfTable" AS root_shelf ON root_shelf.id = sub_shelf.root_shelf_id
+	WHERE sub_shelf.deleted_at IS NOT NULL
+	AND root_shelf.deleted_at IS NULL
+	UNION ALL
+	SELECT
+		material.id,
+		'MATERIAL',
+		material.name,
+		root_shelf.owner_id,
+		sub_shelf.root_shelf_id,
+		material.parent_sub_shelf_id,
+		material.size,
+		material.deleted_at
+	FROM "MaterialTable" AS material
+	JOIN "SubShelfTable" AS sub_shelf ON sub_shelf.id = material.parent_sub_shelf_id
+	JOIN "RootShelfTable" AS root_shelf ON root_shelf.id = sub_shelf.root_shelf_id
+	WHERE material.deleted_at IS NOT NULL
+	AND sub_shelf.deleted_at IS NULL
+	UNION ALL
+	SELECT
+		block_pack.id,
+		'BLOCK_PACK',
+		block_pack.name,
+		root_shelf.owner_id,
+		sub_shelf.root_shelf_id,
+		block_pack.parent_sub_shelf_id,
+		0::bigint,
+		block_pack.deleted_at
+	FROM "BlockPackTable" AS block_pack
+	JOIN "SubShelfTable" AS sub_shelf ON sub_shelf.id = block_pack.parent_sub_shelf_id
+	JOIN "RootShelfTable" AS root_shelf ON root_shelf.id = sub_shelf.root_shelf_id
+	WHERE block_pack.deleted_at IS NOT NULL
+	AND sub_shelf.deleted_at IS NULL
+`
+
+type TrashedItem struct {
+	Id               uuid.UUID  `gorm:"column:id"`
+	Type             string     `gorm:"column:type"`
+	Name             string     `gorm:"column:name"`
+	OwnerId          uuid.UUID  `gorm:"column:owner_id"`
+	RootShelfId      uuid.UUID  `gorm:"column:root_shelf_id"`
+	ParentSubShelfId *uuid.UUID `gorm:"column:parent_sub_shelf_id"`
+	Size             int64      `gorm:"column:size"`
+	DeletedAt        time.Time  `gorm:"column:deleted_at"`
+	PurgeAt          *time.Time `gorm:"column:purge_at"` // null when the plan of the owner keeps the trash forever
+}
+
+type PurgedTrash struct {
+	RootShelfCount int64
+	SubShelfCount  int64
+	MaterialCount  int64
+	BlockPackCount int64
+	// the storage keys and the preview keys of the materials deleted by the purge, including the ones under the purged shelves
+	ContentKeys []string
+}
+
+type TrashRepositoryInterface interface {
+	GetManyByOwnerId(ctx context.Context, ownerId uuid.UUID, itemTypes []string, limit int, offset int, opts ...options.RepositoryOptions) ([]TrashedItem, int64, *exceptions.Exception)
+	GetRetentionDaysByOwnerId(ctx context.Context, ownerId uuid.UUID, opts ...options.RepositoryOptions) (int32, *exceptions.Exception)
+
+	/* ============================== System Only Method ============================== */
+
+	PurgeExpired(ctx context.Context, now time.Time, limit int, opts ...options.RepositoryOptions) (*PurgedTrash, *exceptions.Exception)
+}
+
+type TrashRepository struct{}
+
+func NewTrashRepository() TrashRepositoryInterface {
+	return &TrashRepository{}
+}
+
+func (r *TrashRepository) GetManyByOwnerId(
+	ctx context.Context,
+	ownerId uuid.UUID,
+	itemTypes []string,
+	limit int,
+	offset int,
+	opts ...options.RepositoryOptions,
+) ([]TrashedItem, int64, *exceptions.Exception) {
+	if ownerId == uuid.Nil || limit <= 0 || offset < 0 {
+		return nil, 0, exceptions.New(
+			"InvalidDto",
+			"Trash",
+			"GetManyByOwnerId",
+			"Trash request is invalid",
+			http.StatusBadRequest,
+		)
+	}
+
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	type TrashedItemRow struct {
+		TrashedItem
+		TotalCount int64 `gorm:"column:total_count"`
+	}
+	rows := []TrashedItemRow{}
+	result := parsedOptions.DB.
+		WithContext(ctx).
+		Raw(`
+		WITH trashed_item AS (`+trashedItemsSQL+`)
+		SELECT
+			trashed_item.*,
+			CASE WHEN plan_limitation.trash_retention_days > 0
+				THEN trashed_item.deleted_at + make_interval(days => plan_limitation.trash_retention_days)
+			END AS purge_at,
+			COUNT(*) OVER () AS total_count
+		FROM trashed_item
+		JOIN "UserTable" AS owner_user ON owner_user.id = trashed_item.owner_id
+		JOIN "PlanLimitationTable" AS plan_limitation ON plan_limitation.key = owner_user.plan
+		WHERE trashed_item.owner_id = ?
+		AND (? OR trashed_item.type IN ?)
+		ORDER BY trashed_item.deleted_at DESC, trashed_item.id ASC
+		LIMIT ? OFFSET ?
+		`, ownerId, len(itemTypes) == 0, itemTypes, limit, offset).
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, 0, exceptions.New(
+			"FailedToGet",
+			"Trash",
+			"GetManyByOwnerId",
+			"Failed to retrieve the trash",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(result.Error)
+	}
+
+	trashedItems := make([]TrashedItem, 0, len(rows))
+	var totalCount int64
+	for _, row := range rows {
+		trashedItems = append(trashedItems, row.TrashedItem)
+		totalCount = row.TotalCount
+	}
+	// the window count is unknown when the offset skips every row, so count them again
+	if len(rows) == 0 && offset > 0 {
+		result = parsedOptions.DB.
+			WithContext(ctx).
+			Raw(`
+			WITH trashed_item AS (`+trashedItemsSQL+`)
+			SELECT COUNT(*)
+			FROM trashed_item
+			WHERE trashed_item.owner_id = ?
+			AND (? OR trashed_item.type IN ?)
+			`, ownerId, len(itemTypes) == 0, itemTypes).
+			Scan(&totalCount)
+		if result.Error != nil {
+			return nil, 0, exceptions.New(
+				"FailedToGet",
+				"Trash",
+				"GetManyByOwnerId",
+				"Failed to count the trash",
+				http.StatusInternalServerError,
+				true,
+			).WithOrigin(result.Error)
+		}
+	}
+
+	return trashedItems, totalCount, nil
+}
+
+func (r *TrashRepository) GetRetentionDaysByOwnerId(
+	ctx context.Context,
+	ownerId uuid.UUID,
+	opts ...options.RepositoryOptions,
+) (int32, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	var retentionDays []int32
+	result := parsedOptions.DB.
+		WithContext(ctx).
+		Raw(`
+		SELECT plan_limitation.trash_retention_days
+		FROM "UserTable" AS owner_user
+		JOIN "PlanLimitationTable" AS plan_limitation ON plan_limitation.key = owner_user.plan
+		WHERE owner_user.id = ?
+		`, ownerId).
+		Scan(&retentionDays)
+	if result.Error != nil {
+		return 0, exceptions.New(
+			"FailedToGet",
+			"Trash",
+			"GetRetentionDaysByOwnerId",
+			"Failed to retrieve the trash retention",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(result.Error)
+	}
+	if len(retentionDays) == 0 {
+		return 0, exceptions.New(
+			"NotFound",
+			"Trash",
+			"GetRetentionDaysByOwnerId",
+			"The owner of the trash is not found",
+			http.StatusNotFound,
+		)
+	}
+
+	return retentionDays[0], nil
+}
+
+/* ============================== System Only Method ============================== */
+
+// PurgeExpired hard deletes at most limit trashed items whose retention has passed together with
+// the trashed rows under the purged shelves, the accounting triggers decrement the counters of the
+// owners as the rows are deleted. Every deletion checks deleted_at again under the row lock, so an
+// item restored after it was selected survives, and only the storage keys of the materials actually
+// deleted are returned. A trashed sub shelf is kept while its subtree still holds any live sub shelf
+// or item, because purging it would otherwise orphan them.
+func (r *TrashRepository) PurgeExpired(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+	opts ...options.RepositoryOptions,
+) (*PurgedTrash, *exceptions.Exception) {
+	if limit <= 0 {
+		return nil, exceptions.New(
+			"InvalidDto",
+			"Trash",
+			"PurgeExpired",
+			"Trash purge request is invalid",
+			http.StatusBadRequest,
+		)
+	}
+
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+	db := parsedOptions.DB.WithContext(ctx)
+
+	expiredItems := []TrashedItem{}
+	result := db.
+		Raw(`
+		WITH trashed_item AS (`+trashedItemsSQL+`)
+		SELECT trashed_item.id, trashed_item.type, trashed_item.deleted_at
+		FROM trashed_item
+		JOIN "UserTable" AS owner_user ON owner_user.id = trashed_item.owner_id
+		JOIN "PlanLimitationTable" AS plan_limitation ON plan_limitation.key = owner_user.plan
+		WHERE plan_limitation.trash_retention_days > 0
+		AND trashed_item.deleted_at + make_interval(days => plan_limitation.trash_retention_days) <= ?
+		AND (
+			trashed_item.type <> 'SUB_SHELF'
+			OR NOT EXISTS (
+				SELECT 1
+				FROM "SubShelfTable" AS descendant
+				WHERE (descendant.id = trashed_item.id OR descendant.path @> ARRAY[trashed_item.id])
+				AND (
+					descendant.deleted_at IS NULL
+					OR EXISTS (
+						SELECT 1 FROM "MaterialTable" AS material
+						WHERE material.parent_sub_shelf_id = descendant.id AND material.deleted_at IS NULL
+					)
+					OR EXISTS (
+						SELECT 1 FROM "BlockPackTable" AS block_pack
+						WHERE block_pack.parent_sub_shelf_id = descendant.id AND block_pack.deleted_at IS NULL
+					)
+				)
+			)
+		)
+		ORDER BY trashed_item.deleted_at ASC
+		LIMIT ?
+		`, now, limit).
+		Scan(&expiredItems)
+	if result.Error != nil {
+		return nil, exceptions.New(
+			"FailedToGet",
+			"Trash",
+			"PurgeExpired",
+			"Failed to retrieve the expired trash",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(result.Error)
+	}
+
+	purgedTrash := &PurgedTrash{ContentKeys: []string{}}
+	if len(expiredItems) == 0 {
+		return purgedTrash, nil
+	}
+
+	// a restore after the selection clears deleted_at, and a deletion after it sets a later
+	// one, so the latest selected deleted_at of each type only matches the still expired rows
+	idsByType := make(map[string][]uuid.UUID, 4)
+	cutoffByType := make(map[string]time.Time, 4)
+	for _, expiredItem := range expiredItems {
+		idsByType[expiredItem.Type] = append(idsByType[expiredItem.Type], expiredItem.Id)
+		if expiredItem.DeletedAt.After(cutoffByType[expiredItem.Type]) {
+			cutoffByType[expiredItem.Type] = expiredItem.DeletedAt
+		}
+	}
+
+	type purgedRow struct {
+		Id         uuid.UUID `gorm:"column:id"`
+		ContentKey *string   `gorm:"column:content_key"`
+		PreviewKey *string   `gorm:"column:preview_key"`
+	}
+	purge := func(sql string, values ...any) ([]purgedRow, *exceptions.Exception) {
+		purgedRows := []purgedRow{}
+		if err := db.Raw(sql, values...).Scan(&purgedRows).Error; err != nil {
+			return nil, exceptions.New(
+				"FailedToDelete",
+				"Trash",
+				"PurgeExpired",
+				"Failed to purge the expired trash",
+				http.StatusInternalServerError,
+				true,
+			).WithOrigin(err)
+		}
+		for _, purgedRow := range purgedRows {
+			for _, key := range []*string{purgedRow.ContentKey, purgedRow.PreviewKey} {
+				if key != nil && *key != "" {
+					purgedTrash.ContentKeys = append(purgedTrash.ContentKeys, *key)
+				}
+			}
+		}
+		return purgedRows, nil
+	}
+	purgedIds := func(purgedRows []purgedRow) []uuid.UUID {
+		ids := make([]uuid.UUID, 0, len(purgedRows))
+		for _, purgedRow := range purgedRows {
+			ids = append(ids, purgedRow.Id)
+		}
+		return ids
+	}
+
+	purgedRootShelves, exception := purge(`
+		DELETE FROM "RootShelfTable"
+		WHERE id IN ? AND deleted_at IS NOT NULL AND deleted_at <= ?
+		RETURNING id
+		`, nonEmptyIds(idsByType[TrashedItemType_RootShelf]), cutoffByType[TrashedItemType_RootShelf])
+	if exception != nil {
+		return nil, exception
+	}
+	purgedSubShelves, exception := purge(`
+		DELETE FROM "SubShelfTable"
+		WHERE id IN ? AND deleted_at IS NOT NULL AND deleted_at <= ?
+		RETURNING id
+		`, nonEmptyIds(idsByType[TrashedItemType_SubShelf]), cutoffByType[TrashedItemType_SubShelf])
+	if exception != nil {
+		return nil, exception
+	}
+	purgedMaterials, exception := purge(`
+		DELETE FROM "MaterialTable"
+		WHERE id IN ? AND deleted_at IS NOT NULL AND deleted_at <= ?
+		RETURNING id, content_key, preview_key
+		`, nonEmptyIds(idsByType[TrashedItemType_Material]), cutoffByType[TrashedItemType_Material])
+	if exception != nil {
+		return nil, exception
+	}
+	purgedBlockPacks, exception := purge(`
+		DELETE FROM "BlockPackTable"
+		WHERE id IN ? AND deleted_at IS NOT NULL AND deleted_at <= ?
+		RETURNING id
+		`, nonEmptyIds(idsByType[TrashedItemType_BlockPack]), cutoffByType[TrashedItemType_BlockPack])
+	if exception != nil {
+		return nil, exception
+	}
+	purgedTrash.RootShelfCount = int64(len(purgedRootShelves))
+	purgedTrash.SubShelfCount = int64(len(purgedSubShelves))
+	purgedTrash.MaterialCount = int64(len(purgedMaterials))
+	purgedTrash.BlockPackCount = int64(len(purgedBlockPacks))
+
+	// the rows under the purged shelves are represented by them, so they go with them, the
+	// descendant sub shelves are deleted last since the items are found through them
+	if len(purgedRootShelves) == 0 && len(purgedSubShelves) == 0 {
+		return purgedTrash, nil
+	}
+	rootShelfIds := nonEmptyIds(purgedIds(purgedRootShelves))
+	subShelfIds := nonEmptyIds(purgedIds(purgedSubShelves))
+	for _, sql := range []string{`
+		DELETE FROM "MaterialTable"
+		WHERE deleted_at IS NOT NULL
+		AND (
+			parent_sub_shelf_id IN ?
+			OR parent_sub_shelf_id IN (
+				SELECT id FROM "SubShelfTable" WHERE root_shelf_id IN ? OR path && ?
+			)
+		)
+		RETURNING id, content_key, preview_key
+		`, `
+		DELETE FROM "BlockPackTable"
+		WHERE deleted_at IS NOT NULL
+		AND (
+			parent_sub_shelf_id IN ?
+			OR parent_sub_shelf_id IN (
+				SELECT id FROM "SubShelfTable" WHERE root_shelf_id IN ? OR path && ?
+			)
+		)
+		RETURNING id
+		`,
+	} {
+		if _, exception := purge(sql, subShelfIds, rootShelfIds, types.UUIDArray(subShelfIds)); exception != nil {
+			return nil, exception
+		}
+	}
+	if _, exception := purge(`
+		DELETE FROM "SubShelfTable"
+		WHERE deleted_at IS NOT NULL
+		AND (root_shelf_id IN ? OR path && ?)
+		RETURNING id
+		`, rootShelfIds, types.UUIDArray(subShelfIds)); exception != nil {
+		return nil, exception
+	}
+
+	return purgedTrash, nil
+}
+
+/* ============================== Auxiliary Functions ============================== */
+
+// nonEmptyIds keeps the IN and overlap conditions valid SQL when there is no id of a type
+func nonEmptyIds(ids []uuid.UUID) []uuid.UUID {
+	if len(ids) == 0 {
+		return []uuid.UUID{uuid.Nil}
+	}
+	return ids
+}
@@ -0,0 +1,227 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
+)
+
+/* ============================== Test Doubles ============================== */
+
+type scriptedQuery struct {
+	sql  string
+	args []driver.NamedValue
+}
+
+// scriptedRows are the rows a test answers a statement with, so it describes what the
+// database returns without running PostgreSQL
+type scriptedRows struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+type scriptedConnector struct {
+	answer  func(sql string) scriptedRows
+	queries *[]scriptedQuery
+}
+
+func (c scriptedConnector) Connect(context.Context) (driver.Conn, error) { return c, nil }
+func (c scriptedConnector) Driver() driver.Driver                        { return c }
+func (c scriptedConnector) Open(string) (driver.Conn, error)             { return c, nil }
+func (scriptedConnector) Prepare(query string) (driver.Stmt, error) {
+	return nil, driver.ErrSkip
+}
+func (scriptedConnector) Close() error                                   { return nil }
+func (c scriptedConnector) Begin() (driver.Tx, error)                    { return c, nil }
+func (scriptedConnector) Commit() error                                  { return nil }
+func (scriptedConnector) Rollback() error                                { return nil }
+func (scriptedConnector) CheckNamedValue(value *driver.NamedValue) error { return nil }
+func (c scriptedConnector) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	*c.queries = append(*c.queries, scriptedQuery{sql: query, args: args})
+	rows := c.answer(query)
+	return &rows, nil
+}
+
+// ExecContext answers a statement without rows with the number of the scripted rows as the affected rows
+func (c scriptedConnector) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	*c.queries = append(*c.queries, scriptedQuery{sql: query, args: args})
+	rows := c.answer(query)
+	return driver.RowsAffected(len(rows.values)), nil
+}
+
+func (r *scriptedRows) Columns() []string { return r.columns }
+func (r *scriptedRows) Close() error      { return nil }
+func (r *scriptedRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
+
+/* ============================== Test Helpers ============================== */
+
+func newScriptedTestDB(t *testing.T, answer func(sql string) scriptedRows) (*gorm.DB, *[]scriptedQuery) {
+	t.Helper()
+
+	queries := &[]scriptedQuery{}
+	db, err := gorm.Open(
+		postgres.New(postgres.Config{Conn: sql.OpenDB(scriptedConnector{answer: answer, queries: queries})}),
+		&gorm.Config{DisableAutomaticPing: true, SkipDefaultTransaction: true},
+	)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	return db, queries
+}
+
+func findScriptedQuery(queries []scriptedQuery, prefix string) (scriptedQuery, bool) {
+	for _, query := range queries {
+		if strings.HasPrefix(strings.TrimSpace(query.sql), prefix) {
+			return query, true
+		}
+	}
+	return scriptedQuery{}, false
+}
+
+/* ============================== Tests ============================== */
+
+func TestTrashRepositoryPurgeExpiredReturnsOnlyTheKeysOfTheDeletedRows(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	rootShelfId := uuid.New()
+	deletedMaterialId := uuid.New()
+	restoredMaterialId := uuid.New()
+	descendantMaterialId := uuid.New()
+	latestDeletedAt := now.Add(-31 * 24 * time.Hour)
+
+	db, queries := newScriptedTestDB(t, func(sql string) scriptedRows {
+		switch statement := strings.TrimSpace(sql); {
+		case strings.HasPrefix(statement, "WITH trashed_item"):
+			return scriptedRows{columns: []string{"id", "type", "deleted_at"}, values: [][]driver.Value{
+				{rootShelfId.String(), TrashedItemType_RootShelf, now.Add(-40 * 24 * time.Hour)},
+				{deletedMaterialId.String(), TrashedItemType_Material, now.Add(-35 * 24 * time.Hour)},
+				{restoredMaterialId.String(), TrashedItemType_Material, latestDeletedAt},
+			}}
+		case strings.HasPrefix(statement, `DELETE FROM "RootShelfTable"`):
+			return scriptedRows{columns: []string{"id"}, values: [][]driver.Value{{rootShelfId.String()}}}
+		case strings.HasPrefix(statement, `DELETE FROM "MaterialTable"`) && !strings.Contains(statement, "parent_sub_shelf_id"):
+			// the other material is restored after the selection, so its row does not match anymore
+			return scriptedRows{columns: []string{"id", "content_key", "preview_key"}, values: [][]driver.Value{
+				{deletedMaterialId.String(), "deleted-content", "deleted-preview"},
+			}}
+		case strings.HasPrefix(statement, `DELETE FROM "MaterialTable"`):
+			return scriptedRows{columns: []string{"id", "content_key", "preview_key"}, values: [][]driver.Value{
+				{descendantMaterialId.String(), "descendant-content", nil},
+			}}
+		}
+		return scriptedRows{columns: []string{"id"}}
+	})
+
+	purgedTrash, exception := NewTrashRepository().PurgeExpired(context.Background(), now, 10, options.WithDB(db))
+	if exception != nil {
+		t.Fatalf("PurgeExpired() exception = %v", exception)
+	}
+
+	wantContentKeys := []string{"deleted-content", "deleted-preview", "descendant-content"}
+	if !reflect.DeepEqual(purgedTrash.ContentKeys, wantContentKeys) {
+		t.Fatalf("PurgeExpired() content keys = %v, want %v", purgedTrash.ContentKeys, wantContentKeys)
+	}
+	if purgedTrash.RootShelfCount != 1 || purgedTrash.MaterialCount != 1 {
+		t.Fatalf("PurgeExpired() purged %d root shelves and %d materials, want 1 and 1",
+			purgedTrash.RootShelfCount, purgedTrash.MaterialCount)
+	}
+
+	materialDeletion, ok := findScriptedQuery(*queries, `DELETE FROM "MaterialTable"`)
+	if !ok {
+		t.Fatal("PurgeExpired() did not delete the expired materials")
+	}
+	if !strings.Contains(materialDeletion.sql, "deleted_at IS NOT NULL AND deleted_at <=") ||
+		!strings.Contains(materialDeletion.sql, "RETURNING id, content_key, preview_key") {
+		t.Fatalf("PurgeExpired() deletes the materials without checking the trash again: %s", materialDeletion.sql)
+	}
+	cutoff := materialDeletion.args[len(materialDeletion.args)-1].Value
+	if cutoffTime, ok := cutoff.(time.Time); !ok || !cutoffTime.Equal(latestDeletedAt) {
+		t.Fatalf("PurgeExpired() material cutoff = %v, want the latest selected deleted_at %v", cutoff, latestDeletedAt)
+	}
+	if _, ok := findScriptedQuery(*queries, `DELETE FROM "SubShelfTable"
+		WHERE deleted_at IS NOT NULL`); !ok {
+		t.Error("PurgeExpired() did not delete the sub shelves under the purged root shelf")
+	}
+}
+
+func TestTrashRepositoryPurgeExpiredKeepsTheDescendantsOfARestoredShelf(t *testing.T) {
+	rootShelfId := uuid.New()
+	db, queries := newScriptedTestDB(t, func(sql string) scriptedRows {
+		if strings.HasPrefix(strings.TrimSpace(sql), "WITH trashed_item") {
+			return scriptedRows{columns: []string{"id", "type", "deleted_at"}, values: [][]driver.Value{
+				{rootShelfId.String(), TrashedItemType_RootShelf, time.Now().Add(-40 * 24 * time.Hour)},
+			}}
+		}
+		// the root shelf is restored after the selection, so no deletion matches it
+		return scriptedRows{columns: []string{"id"}}
+	})
+
+	purgedTrash, exception := NewTrashRepository().PurgeExpired(context.Background(), time.Now(), 10, options.WithDB(db))
+	if exception != nil {
+		t.Fatalf("PurgeExpired() exception = %v", exception)
+	}
+
+	if purgedTrash.RootShelfCount != 0 || len(purgedTrash.ContentKeys) != 0 {
+		t.Fatalf("PurgeExpired() = %+v, want nothing purged", purgedTrash)
+	}
+	for _, query := range *queries {
+		if strings.Contains(query.sql, "parent_sub_shelf_id IN (") || strings.Contains(query.sql, "root_shelf_id IN") {
+			t.Fatalf("PurgeExpired() deleted the descendants of a restored shelf: %s", query.sql)
+		}
+	}
+}
+
+func TestTrashRepositoryGetManyByOwnerIdListsEveryTypeOfTrash(t *testing.T) {
+	ownerId := uuid.New()
+	deletedAt := time.Now().UTC().Truncate(time.Microsecond)
+	purgeAt := deletedAt.Add(30 * 24 * time.Hour)
+	itemTypes := []string{TrashedItemType_RootShelf, TrashedItemType_SubShelf, TrashedItemType_Material, TrashedItemType_BlockPack}
+
+	db, queries := newScriptedTestDB(t, func(sql string) scriptedRows {
+		rows := scriptedRows{columns: []string{
+			"id", "type", "name", "owner_id", "root_shelf_id", "parent_sub_shelf_id", "size", "deleted_at", "purge_at", "total_count",
+		}}
+		for _, itemType := range itemTypes {
+			rows.values = append(rows.values, []driver.Value{
+				uuid.NewString(), itemType, strings.ToLower(itemType), ownerId.String(), uuid.NewString(), nil, int64(0), deletedAt, purgeAt, int64(len(itemTypes)),
+			})
+		}
+		return rows
+	})
+
+	trashedItems, totalCount, exception := NewTrashRepository().GetManyByOwnerId(context.Background(), ownerId, nil, 10, 0, options.WithDB(db))
+	if exception != nil {
+		t.Fatalf("GetManyByOwnerId() exception = %v", exception)
+	}
+
+	if totalCount != int64(len(itemTypes)) || len(trashedItems) != len(itemTypes) {
+		t.Fatalf("GetManyByOwnerId() = %d items of %d, want %d", len(trashedItems), totalCount, len(itemTypes))
+	}
+	for index, trashedItem := range trashedItems {
+		if trashedItem.Type != itemTypes[index] || trashedItem.PurgeAt == nil || !trashedItem.PurgeAt.Equal(purgeAt) {
+			t.Fatalf("GetManyByOwnerId() item %d = %+v, want a %s purged at %v", index, trashedItem, itemTypes[index], purgeAt)
+		}
+	}
+	for _, table := range []string{"RootShelfTable", "SubShelfTable", "MaterialTable", "BlockPackTable"} {
+		if !strings.Contains((*queries)[0].sql, `FROM "`+table+`"`) {
+			t.Errorf("GetManyByOwnerId() does not list the trash of %s", table)
+		}
+	}
+}
@@ -20,6 +20,7 @@ import (
 
 type UserQuotaRepositoryInterface interface {
 	GetRoutineTaskCostUnitUsed(ctx context.Context, userId uuid.UUID, opts ...options.RepositoryOptions) (int64, *exceptions.Exception)
+	GetOneByUserId(ctx context.Context, userId uuid.UUID, opts ...options.RepositoryOptions) (*schemas.UserQuota, *exceptions.Exception)
 	InitializeMissing(ctx context.Context, now time.Time, opts ...options.RepositoryOptions) *exceptions.Exception
 	InitializeMissingForUserIds(ctx context.Context, userIds []uuid.UUID, now time.Time, opts ...options.RepositoryOptions) *exceptions.Exception
 	ResetDue(ctx context.Context, now time.Time, opts ...options.RepositoryOptions) (int64, *exceptions.Exception)
@@ -73,6 +74,49 @@ func (r *UserQuotaRepository) GetRoutineTaskCostUnitUsed(
 	return routineTaskCostUnitUsed[0], nil
 }
 
+// GetOneByUserId returns nil without any exception if the quota of the user
+// has not been initialized by the quota cycle worker yet
+func (r *UserQuotaRepository) GetOneByUserId(
+	ctx context.Context,
+	userId uuid.UUID,
+	opts ...options.RepositoryOptions,
+) (*schemas.UserQuota, *exceptions.Exception) {
+	if userId == uuid.Nil {
+		return nil, exceptions.New(
+			"InvalidDto",
+			"UserQuota",
+			"GetOneByUserId",
+			"User quota request is invalid",
+			http.StatusBadRequest,
+		)
+	}
+
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	userQuotas := []schemas.UserQuota{}
+	result := parsedOptions.DB.
+		WithContext(ctx).
+		Model(&schemas.UserQuota{}).
+		Where("user_id = ?", userId).
+		Limit(1).
+		Find(&userQuotas)
+	if result.Error != nil {
+		return nil, exceptions.New(
+			"FailedToGet",
+			"UserQuota",
+			"GetOneByUserId",
+			"Failed to retrieve the user quota",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(result.Error)
+	}
+	if len(userQuotas) == 0 {
+		return nil, nil
+	}
+
+	return &userQuotas[0], nil
+}
+
 func (r *UserQuotaRepository) InitializeMissing(
 	ctx context.Context,
 	now time.Time,
@@ -0,0 +1,171 @@
+package repositories
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	enumcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+
+	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
+)
+
+type UserQuotaWarningRepositoryInterface interface {
+	CreateManyCrossed(ctx context.Context, thresholds []int32, now time.Time, opts ...options.RepositoryOptions) ([]CrossedUserQuotaWarning, *exceptions.Exception)
+	DeleteBeforeCurrentCycles(ctx context.Context, opts ...options.RepositoryOptions) (int64, *exceptions.Exception)
+}
+
+type UserQuotaWarningRepository struct{}
+
+// CrossedUserQuotaWarning is a warning created by CreateManyCrossed together with
+// the recipient, whether the recipient wants to be notified, and the end of the quota cycle the warning belongs to
+type CrossedUserQuotaWarning struct {
+	UserId                 uuid.UUID                  `gorm:"column:user_id"`
+	UserPublicId           uuid.UUID                  `gorm:"column:user_public_id"`
+	UserName               string                     `gorm:"column:user_name"`
+	UserEmail              string                     `gorm:"column:user_email"`
+	IsNotificationsEnabled bool                       `gorm:"column:is_notifications_enabled"`
+	LimitKey               enumcontract.UsageLimitKey `gorm:"column:limit_key"`
+	Threshold              int32                      `gorm:"column:threshold"`
+	Used                   int64                      `gorm:"column:used"`
+	Maximum                int64                      `gorm:"column:maximum"`
+	CycleStartedAt         time.Time                  `gorm:"column:cycle_started_at"`
+	NextResetAt            time.Time                  `gorm:"column:next_reset_at"`
+}
+
+func NewUserQuotaWarningRepository() UserQuotaWarningRepositoryInterface {
+	return &UserQuotaWarningRepository{}
+}
+
+// CreateManyCrossed records a warning for every threshold (in percent) of every limitation
+// the users have reached in their current quota cycles, and only returns the warnings which
+// have not been recorded before, the limitations of zero are skipped since nothing can be used,
+// the limit keys are the values of enumcontract.UsageLimitKey
+func (r *UserQuotaWarningRepository) CreateManyCrossed(
+	ctx context.Context,
+	thresholds []int32,
+	now time.Time,
+	opts ...options.RepositoryOptions,
+) ([]CrossedUserQuotaWarning, *exceptions.Exception) {
+	if len(thresholds) == 0 {
+		return []CrossedUserQuotaWarning{}, nil
+	}
+
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	crossedWarnings := []CrossedUserQuotaWarning{}
+	result := parsedOptions.DB.
+		WithContext(ctx).
+		Raw(`
+		WITH usage AS (
+			SELECT
+				user_quota.user_id,
+				user_quota.cycle_started_at,
+				limitation.limit_key,
+				limitation.used,
+				limitation.maximum
+			FROM "UserQuotaTable" AS user_quota
+			INNER JOIN "UserTable" AS users ON users.id = user_quota.user_id
+			INNER JOIN "UserAccountTable" AS user_account ON user_account.user_id = user_quota.user_id
+			INNER JOIN "PlanLimitationTable" AS plan_limitation ON plan_limitation.key = users.plan
+			CROSS JOIN LATERAL (VALUES
+				('ROOT_SHELF', user_account.root_shelf_count, plan_limitation.max_root_shelf_count::bigint),
+				('BLOCK_PACK', user_account.block_pack_count, plan_limitation.max_block_pack_count::bigint),
+				('BLOCK', user_account.block_count, plan_limitation.max_block_count::bigint),
+				('MATERIAL', user_account.material_count, plan_limitation.max_material_count::bigint),
+				('WORKFLOW', user_account.workflow_count, plan_limitation.max_work_flow_count::bigint),
+				('ADDITIONAL_ITEM', user_account.additional_item_count, plan_limitation.max_additional_item_count::bigint),
+				('STATION', user_account.station_count, plan_limitation.max_station_count::bigint),
+				('ROUTINE_TAG', user_account.routine_tag_count, plan_limitation.max_routine_tag_count::bigint),
+				('ROUTINE_TASK_COST_UNIT', user_quota.routine_task_cost_unit_used, plan_limitation.max_routine_task_cost_unit_count::bigint)
+			) AS limitation(limit_key, used, maximum)
+			WHERE limitation.maximum > 0
+		), inserted AS (
+			INSERT INTO "UserQuotaWarningTable" (
+				user_id,
+				limit_key,
+				threshold,
+				cycle_started_at,
+				used,
+				maximum,
+				created_at
+			)
+			SELECT
+				usage.user_id,
+				usage.limit_key,
+				threshold.value,
+				usage.cycle_started_at,
+				usage.used,
+				usage.maximum,
+				?
+			FROM usage
+			CROSS JOIN unnest(ARRAY[?]::integer[]) AS threshold(value)
+			WHERE usage.used * 100 >= usage.maximum * threshold.value
+			ON CONFLICT (user_id, limit_key, threshold, cycle_started_at) DO NOTHING
+			RETURNING user_id, limit_key, threshold, cycle_started_at, used, maximum
+		)
+		SELECT
+			inserted.user_id,
+			users.public_id AS user_public_id,
+			users.name AS user_name,
+			users.email AS user_email,
+			COALESCE(user_setting.sync_notifications, true) AS is_notifications_enabled,
+			inserted.limit_key,
+			inserted.threshold,
+			inserted.used,
+			inserted.maximum,
+			inserted.cycle_started_at,
+			user_quota.next_reset_at
+		FROM inserted
+		INNER JOIN "UserTable" AS users ON users.id = inserted.user_id
+		INNER JOIN "UserQuotaTable" AS user_quota ON user_quota.user_id = inserted.user_id
+		LEFT JOIN "UserSettingTable" AS user_setting ON user_setting.user_id = inserted.user_id
+		ORDER BY inserted.user_id, inserted.limit_key, inserted.threshold
+		`, now, thresholds).
+		Scan(&crossedWarnings)
+	if result.Error != nil {
+		return nil, exceptions.New(
+			"FailedToCreate",
+			"UserQuotaWarning",
+			"CreateManyCrossed",
+			"Failed to record the crossed quota warnings",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(result.Error)
+	}
+
+	return crossedWarnings, nil
+}
+
+// DeleteBeforeCurrentCycles removes the warnings of the quota cycles which have been reset,
+// since they can never conflict with the warnings of the current cycles again
+func (r *UserQuotaWarningRepository) DeleteBeforeCurrentCycles(
+	ctx context.Context,
+	opts ...options.RepositoryOptions,
+) (int64, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	result := parsedOptions.DB.
+		WithContext(ctx).
+		Exec(`
+		DELETE FROM "UserQuotaWarningTable" AS warning
+		USING "UserQuotaTable" AS user_quota
+		WHERE user_quota.user_id = warning.user_id
+			AND warning.cycle_started_at < user_quota.cycle_started_at
+		`)
+	if result.Error != nil {
+		return 0, exceptions.New(
+			"FailedToDelete",
+			"UserQuotaWarning",
+			"DeleteBeforeCurrentCycles",
+			"Failed to delete the quota warnings of the previous cycles",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,210 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+
+	inputs "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/inputs"
+	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
+	schemas "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas"
+	apiexceptions "github.com/HiIamJeff67/notegic-backend/internal/core/exceptions"
+)
+
+// the last seen time of a session is only written once per this duration,
+// so the authenticated requests are not turned into a write for every call
+const userSessionLastSeenPrecision time.Duration = time.Minute
+
+type UserSessionRepositoryInterface interface {
+	GetOneById(id uuid.UUID, opts ...options.RepositoryOptions) (*schemas.UserSession, *exceptions.Exception)
+	GetManyActiveByUserId(userId uuid.UUID, now time.Time, opts ...options.RepositoryOptions) ([]schemas.UserSession, *exceptions.Exception)
+	CreateOne(userId uuid.UUID, input inputs.CreateUserSessionInput, opts ...options.RepositoryOptions) (*schemas.UserSession, *exceptions.Exception)
+	RotateOneById(id uuid.UUID, refreshTokenHash string, newRefreshTokenHash string, ipAddress string, rotatedAt time.Time, expiresAt time.Time, opts ...options.RepositoryOptions) (*schemas.UserSession, *exceptions.Exception)
+	TouchOneById(id uuid.UUID, seenAt time.Time, opts ...options.RepositoryOptions) *exceptions.Exception
+	RevokeOneById(id uuid.UUID, userId uuid.UUID, revokedAt time.Time, opts ...options.RepositoryOptions) (*schemas.UserSession, *exceptions.Exception)
+	RevokeManyByUserId(userId uuid.UUID, exceptId *uuid.UUID, revokedAt time.Time, opts ...options.RepositoryOptions) (int64, *exceptions.Exception)
+}
+
+type UserSessionRepository struct{}
+
+func NewUserSessionRepository() UserSessionRepositoryInterface {
+	return &UserSessionRepository{}
+}
+
+func (r *UserSessionRepository) GetOneById(
+	id uuid.UUID,
+	opts ...options.RepositoryOptions,
+) (*schemas.UserSession, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	userSession := &schemas.UserSession{}
+	result := parsedOptions.DB.
+		Model(&schemas.UserSession{}).
+		Where("id = ?", id).
+		First(userSession)
+	if result.Error != nil {
+		return nil, apiexceptions.NewUserSessionException().NotFound().WithOrigin(result.Error)
+	}
+
+	return userSession, nil
+}
+
+func (r *UserSessionRepository) GetManyActiveByUserId(
+	userId uuid.UUID,
+	now time.Time,
+	opts ...options.RepositoryOptions,
+) ([]schemas.UserSession, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	userSessions := []schemas.UserSession{}
+	result := parsedOptions.DB.
+		Model(&schemas.UserSession{}).
+		Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userId, now).
+		Order("last_seen_at DESC, id DESC").
+		Find(&userSessions)
+	if result.Error != nil {
+		return nil, apiexceptions.NewUserSessionException().NotFound().WithOrigin(result.Error)
+	}
+
+	return userSessions, nil
+}
+
+func (r *UserSessionRepository) CreateOne(
+	userId uuid.UUID,
+	input inputs.CreateUserSessionInput,
+	opts ...options.RepositoryOptions,
+) (*schemas.UserSession, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	if input.Id == uuid.Nil {
+		input.Id = uuid.New()
+	}
+	now := time.Now()
+	newUserSession := schemas.UserSession{
+		Id:               input.Id,
+		UserId:           userId,
+		DeviceLabel:      input.DeviceLabel,
+		UserAgent:        input.UserAgent,
+		IpAddress:        input.IpAddress,
+		RefreshTokenHash: input.RefreshTokenHash,
+		LastSeenAt:       now,
+		ExpiresAt:        input.ExpiresAt,
+	}
+	result := parsedOptions.DB.
+		Model(&schemas.UserSession{}).
+		Create(&newUserSession)
+	if result.Error != nil {
+		return nil, apiexceptions.NewUserSessionException().FailedToCreate().WithOrigin(result.Error)
+	}
+
+	return &newUserSession, nil
+}
+
+// RotateOneById swaps the refresh token of an active session in a single conditional update,
+// so only one of the concurrent refreshes presenting the same refresh token can win the rotation.
+func (r *UserSessionRepository) RotateOneById(
+	id uuid.UUID,
+	refreshTokenHash string,
+	newRefreshTokenHash string,
+	ipAddress string,
+	rotatedAt time.Time,
+	expiresAt time.Time,
+	opts ...options.RepositoryOptions,
+) (*schemas.UserSession, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	var rotatedUserSession schemas.UserSession
+	result := parsedOptions.DB.
+		Model(&rotatedUserSession).
+		Where("id = ? AND refresh_token_hash = ?", id, refreshTokenHash).
+		Where("revoked_at IS NULL AND expires_at > ?", rotatedAt).
+		Clauses(clause.Returning{}).
+		Updates(map[string]any{
+			"prev_refresh_token_hash": gorm.Expr("refresh_token_hash"),
+			"refresh_token_hash":      newRefreshTokenHash,
+			"ip_address":              ipAddress,
+			"refreshed_at":            rotatedAt,
+			"last_seen_at":            rotatedAt,
+			"expires_at":              expiresAt,
+		})
+	if exception := exceptions.Cover(nil, []exceptions.Pair{
+		{First: result.Error != nil, Second: apiexceptions.NewUserSessionException().FailedToUpdate().WithOrigin(result.Error)},
+		{First: result.RowsAffected == 0 || rotatedUserSession.Id == uuid.Nil, Second: apiexceptions.NewUserSessionException().NotFound()},
+	}); exception != nil {
+		return nil, exception
+	}
+
+	return &rotatedUserSession, nil
+}
+
+func (r *UserSessionRepository) TouchOneById(
+	id uuid.UUID,
+	seenAt time.Time,
+	opts ...options.RepositoryOptions,
+) *exceptions.Exception {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	result := parsedOptions.DB.
+		Model(&schemas.UserSession{}).
+		Where("id = ? AND last_seen_at < ?", id, seenAt.Add(-userSessionLastSeenPrecision)).
+		UpdateColumn("last_seen_at", seenAt)
+	if result.Error != nil {
+		return apiexceptions.NewUserSessionException().FailedToUpdate().WithOrigin(result.Error)
+	}
+
+	return nil
+}
+
+// RevokeOneById only revokes the session of the given user, revoking a revoked
+// session is a no-op that returns the session as it is.
+func (r *UserSessionRepository) RevokeOneById(
+	id uuid.UUID,
+	userId uuid.UUID,
+	revokedAt time.Time,
+	opts ...options.RepositoryOptions,
+) (*schemas.UserSession, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	var revokedUserSession schemas.UserSession
+	result := parsedOptions.DB.
+		Model(&revokedUserSession).
+		Where("id = ? AND user_id = ?", id, userId).
+		Clauses(clause.Returning{}).
+		Update("revoked_at", gorm.Expr("COALESCE(revoked_at, ?)", revokedAt))
+	if exception := exceptions.Cover(nil, []exceptions.Pair{
+		{First: result.Error != nil, Second: apiexceptions.NewUserSessionException().FailedToUpdate().WithOrigin(result.Error)},
+		{First: result.RowsAffected == 0 || revokedUserSession.Id == uuid.Nil, Second: apiexceptions.NewUserSessionException().NotFound()},
+	}); exception != nil {
+		return nil, exception
+	}
+
+	return &revokedUserSession, nil
+}
+
+// RevokeManyByUserId revokes all the active sessions of the user except the given one,
+// and returns the number of the sessions it has revoked.
+func (r *UserSessionRepository) RevokeManyByUserId(
+	userId uuid.UUID,
+	exceptId *uuid.UUID,
+	revokedAt time.Time,
+	opts ...options.RepositoryOptions,
+) (int64, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	query := parsedOptions.DB.
+		Model(&schemas.UserSession{}).
+		Where("user_id = ? AND revoked_at IS NULL", userId)
+	if exceptId != nil {
+		query = query.Where("id <> ?", *exceptId)
+	}
+	result := query.Update("revoked_at", revokedAt)
+	if result.Error != nil {
+		return 0, apiexceptions.NewUserSessionException().FailedToUpdate().WithOrigin(result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
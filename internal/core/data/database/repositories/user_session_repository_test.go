@@ -0,0 +1,89 @@
+package repositories
+
+import (
+	"database/sql/driver"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
+)
+
+/* ============================== Tests ============================== */
+
+func TestUserSessionRepositoryRotateOneByIdSwapsTheRefreshTokenConditionally(t *testing.T) {
+	sessionId := uuid.New()
+	userId := uuid.New()
+	rotatedAt := time.Now().UTC().Truncate(time.Microsecond)
+	db, queries := newScriptedTestDB(t, func(sql string) scriptedRows {
+		return scriptedRows{
+			columns: []string{"id", "user_id", "refresh_token_hash", "prev_refresh_token_hash", "refreshed_at"},
+			values:  [][]driver.Value{{sessionId.String(), userId.String(), "new-hash", "old-hash", rotatedAt}},
+		}
+	})
+
+	session, exception := NewUserSessionRepository().RotateOneById(
+		sessionId, "old-hash", "new-hash", "127.0.0.1", rotatedAt, rotatedAt.Add(time.Hour), options.WithDB(db),
+	)
+	if exception != nil {
+		t.Fatalf("RotateOneById() exception = %v", exception)
+	}
+
+	if session.RefreshTokenHash != "new-hash" || session.PrevRefreshTokenHash == nil || *session.PrevRefreshTokenHash != "old-hash" {
+		t.Fatalf("RotateOneById() = %+v, want the returned rotated session", session)
+	}
+	statement := (*queries)[0].sql
+	for _, want := range []string{
+		`"prev_refresh_token_hash"=refresh_token_hash`,
+		"id = $",
+		"refresh_token_hash = $",
+		"revoked_at IS NULL AND expires_at >",
+		"RETURNING *",
+	} {
+		if !strings.Contains(statement, want) {
+			t.Errorf("RotateOneById() statement does not contain %q: %s", want, statement)
+		}
+	}
+}
+
+func TestUserSessionRepositoryRotateOneByIdLosesToAConcurrentRotation(t *testing.T) {
+	// the concurrent rotation has already replaced the refresh token, so no row matches
+	db, _ := newScriptedTestDB(t, func(sql string) scriptedRows {
+		return scriptedRows{columns: []string{"id"}}
+	})
+
+	now := time.Now()
+	session, exception := NewUserSessionRepository().RotateOneById(
+		uuid.New(), "old-hash", "another-hash", "127.0.0.1", now, now.Add(time.Hour), options.WithDB(db),
+	)
+	if exception == nil || exception.Reason != "NotFound" || session != nil {
+		t.Fatalf("RotateOneById() = %+v, %v, want NotFound", session, exception)
+	}
+}
+
+func TestUserSessionRepositoryRevokeOneByIdKeepsTheFirstRevocation(t *testing.T) {
+	sessionId := uuid.New()
+	userId := uuid.New()
+	revokedAt := time.Now().UTC().Truncate(time.Microsecond)
+	db, queries := newScriptedTestDB(t, func(sql string) scriptedRows {
+		return scriptedRows{
+			columns: []string{"id", "user_id", "revoked_at"},
+			values:  [][]driver.Value{{sessionId.String(), userId.String(), revokedAt.Add(-time.Hour)}},
+		}
+	})
+
+	session, exception := NewUserSessionRepository().RevokeOneById(sessionId, userId, revokedAt, options.WithDB(db))
+	if exception != nil {
+		t.Fatalf("RevokeOneById() exception = %v", exception)
+	}
+
+	if session.RevokedAt == nil || !session.RevokedAt.Equal(revokedAt.Add(-time.Hour)) {
+		t.Fatalf("RevokeOneById() revoked at %v, want the first revocation", session.RevokedAt)
+	}
+	statement := (*queries)[0]
+	if !strings.Contains(statement.sql, `"revoked_at"=COALESCE(revoked_at, $1)`) || !strings.Contains(statement.sql, "user_id = $") {
+		t.Fatalf("RevokeOneById() statement = %s", statement.sql)
+	}
+}
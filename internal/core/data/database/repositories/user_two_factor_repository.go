@@ -0,0 +1,230 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	pg "github.com/lib/pq"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+
+	inputs "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/inputs"
+	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
+	schemas "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas"
+	scopes "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/scopes"
+	apiexceptions "github.com/HiIamJeff67/notegic-backend/internal/core/exceptions"
+)
+
+type UserTwoFactorRepositoryInterface interface {
+	GetOneByUserId(userId uuid.UUID, opts ...options.RepositoryOptions) (*schemas.UserTwoFactor, *exceptions.Exception)
+	CreateOne(userId uuid.UUID, input inputs.CreateUserTwoFactorInput, opts ...options.RepositoryOptions) (*schemas.UserTwoFactor, *exceptions.Exception)
+	ResetPendingOneByUserId(userId uuid.UUID, encryptedSecret string, opts ...options.RepositoryOptions) (*schemas.UserTwoFactor, *exceptions.Exception)
+	EnableOneByUserId(userId uuid.UUID, usedStep int64, recoveryCodeHashes []string, enabledAt time.Time, opts ...options.RepositoryOptions) (*schemas.UserTwoFactor, *exceptions.Exception)
+	UseStepByUserId(userId uuid.UUID, usedStep int64, opts ...options.RepositoryOptions) (*schemas.UserTwoFactor, *exceptions.Exception)
+	UseRecoveryCodeByUserId(userId uuid.UUID, recoveryCodeHash string, opts ...options.RepositoryOptions) (*schemas.UserTwoFactor, *exceptions.Exception)
+	RegenerateRecoveryCodesByUserId(userId uuid.UUID, usedStep int64, recoveryCodeHashes []string, opts ...options.RepositoryOptions) (*schemas.UserTwoFactor, *exceptions.Exception)
+	DeleteOneByUserId(userId uuid.UUID, opts ...options.RepositoryOptions) *exceptions.Exception
+}
+
+type UserTwoFactorRepository struct{}
+
+func NewUserTwoFactorRepository() UserTwoFactorRepositoryInterface {
+	return &UserTwoFactorRepository{}
+}
+
+func (r *UserTwoFactorRepository) GetOneByUserId(
+	userId uuid.UUID,
+	opts ...options.RepositoryOptions,
+) (*schemas.UserTwoFactor, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	userTwoFactor := &schemas.UserTwoFactor{}
+	result := parsedOptions.DB.
+		Model(&schemas.UserTwoFactor{}).
+		Scopes(scopes.Locking(parsedOptions.LockingStrength)).
+		Where("user_id = ?", userId).
+		First(userTwoFactor)
+	if result.Error != nil {
+		return nil, apiexceptions.NewUserTwoFactorException().NotFound().WithOrigin(result.Error)
+	}
+
+	return userTwoFactor, nil
+}
+
+func (r *UserTwoFactorRepository) CreateOne(
+	userId uuid.UUID,
+	input inputs.CreateUserTwoFactorInput,
+	opts ...options.RepositoryOptions,
+) (*schemas.UserTwoFactor, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	newUserTwoFactor := schemas.UserTwoFactor{
+		UserId:             userId,
+		EncryptedSecret:    input.EncryptedSecret,
+		RecoveryCodeHashes: pg.StringArray{},
+	}
+	result := parsedOptions.DB.
+		Model(&schemas.UserTwoFactor{}).
+		Create(&newUserTwoFactor)
+	if result.Error != nil {
+		return nil, apiexceptions.NewUserTwoFactorException().FailedToCreate().WithOrigin(result.Error)
+	}
+
+	return &newUserTwoFactor, nil
+}
+
+// ResetPendingOneByUserId replaces the secret of an enrollment which has not been verified yet,
+// so starting the enrollment again invalidates the QR code shown before.
+func (r *UserTwoFactorRepository) ResetPendingOneByUserId(
+	userId uuid.UUID,
+	encryptedSecret string,
+	opts ...options.RepositoryOptions,
+) (*schemas.UserTwoFactor, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	var resetUserTwoFactor schemas.UserTwoFactor
+	result := parsedOptions.DB.
+		Model(&resetUserTwoFactor).
+		Where("user_id = ? AND enabled_at IS NULL", userId).
+		Clauses(clause.Returning{}).
+		Updates(map[string]any{
+			"encrypted_secret": encryptedSecret,
+			"last_used_step":   0,
+			"created_at":       time.Now(),
+		})
+	if exception := exceptions.Cover(nil, []exceptions.Pair{
+		{First: result.Error != nil, Second: apiexceptions.NewUserTwoFactorException().FailedToUpdate().WithOrigin(result.Error)},
+		{First: result.RowsAffected == 0, Second: apiexceptions.NewUserTwoFactorException().AlreadyEnabled()},
+	}); exception != nil {
+		return nil, exception
+	}
+
+	return &resetUserTwoFactor, nil
+}
+
+func (r *UserTwoFactorRepository) EnableOneByUserId(
+	userId uuid.UUID,
+	usedStep int64,
+	recoveryCodeHashes []string,
+	enabledAt time.Time,
+	opts ...options.RepositoryOptions,
+) (*schemas.UserTwoFactor, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	var enabledUserTwoFactor schemas.UserTwoFactor
+	result := parsedOptions.DB.
+		Model(&enabledUserTwoFactor).
+		Where("user_id = ? AND enabled_at IS NULL", userId).
+		Clauses(clause.Returning{}).
+		Updates(map[string]any{
+			"recovery_code_hashes": pg.StringArray(recoveryCodeHashes),
+			"last_used_step":       usedStep,
+			"enabled_at":           enabledAt,
+		})
+	if exception := exceptions.Cover(nil, []exceptions.Pair{
+		{First: result.Error != nil, Second: apiexceptions.NewUserTwoFactorException().FailedToUpdate().WithOrigin(result.Error)},
+		{First: result.RowsAffected == 0, Second: apiexceptions.NewUserTwoFactorException().AlreadyEnabled()},
+	}); exception != nil {
+		return nil, exception
+	}
+
+	return &enabledUserTwoFactor, nil
+}
+
+// UseStepByUserId marks the time step of a verified TOTP code as used in a single conditional
+// update, so the same code cannot be accepted twice even by the concurrent requests.
+func (r *UserTwoFactorRepository) UseStepByUserId(
+	userId uuid.UUID,
+	usedStep int64,
+	opts ...options.RepositoryOptions,
+) (*schemas.UserTwoFactor, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	var usedUserTwoFactor schemas.UserTwoFactor
+	result := parsedOptions.DB.
+		Model(&usedUserTwoFactor).
+		Where("user_id = ? AND enabled_at IS NOT NULL AND last_used_step < ?", userId, usedStep).
+		Clauses(clause.Returning{}).
+		Update("last_used_step", usedStep)
+	if exception := exceptions.Cover(nil, []exceptions.Pair{
+		{First: result.Error != nil, Second: apiexceptions.NewUserTwoFactorException().FailedToUpdate().WithOrigin(result.Error)},
+		{First: result.RowsAffected == 0, Second: apiexceptions.NewUserTwoFactorException().WrongCode()},
+	}); exception != nil {
+		return nil, exception
+	}
+
+	return &usedUserTwoFactor, nil
+}
+
+// UseRecoveryCodeByUserId removes the digest of the recovery code once it is used,
+// and returns the second factor with the remaining recovery codes.
+func (r *UserTwoFactorRepository) UseRecoveryCodeByUserId(
+	userId uuid.UUID,
+	recoveryCodeHash string,
+	opts ...options.RepositoryOptions,
+) (*schemas.UserTwoFactor, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	var usedUserTwoFactor schemas.UserTwoFactor
+	result := parsedOptions.DB.
+		Model(&usedUserTwoFactor).
+		Where("user_id = ? AND enabled_at IS NOT NULL AND ? = ANY(recovery_code_hashes)", userId, recoveryCodeHash).
+		Clauses(clause.Returning{}).
+		Update("recovery_code_hashes", gorm.Expr("array_remove(recovery_code_hashes, ?)", recoveryCodeHash))
+	if exception := exceptions.Cover(nil, []exceptions.Pair{
+		{First: result.Error != nil, Second: apiexceptions.NewUserTwoFactorException().FailedToUpdate().WithOrigin(result.Error)},
+		{First: result.RowsAffected == 0, Second: apiexceptions.NewUserTwoFactorException().WrongCode()},
+	}); exception != nil {
+		return nil, exception
+	}
+
+	return &usedUserTwoFactor, nil
+}
+
+func (r *UserTwoFactorRepository) RegenerateRecoveryCodesByUserId(
+	userId uuid.UUID,
+	usedStep int64,
+	recoveryCodeHashes []string,
+	opts ...options.RepositoryOptions,
+) (*schemas.UserTwoFactor, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	var regeneratedUserTwoFactor schemas.UserTwoFactor
+	result := parsedOptions.DB.
+		Model(&regeneratedUserTwoFactor).
+		Where("user_id = ? AND enabled_at IS NOT NULL AND last_used_step < ?", userId, usedStep).
+		Clauses(clause.Returning{}).
+		Updates(map[string]any{
+			"recovery_code_hashes": pg.StringArray(recoveryCodeHashes),
+			"last_used_step":       usedStep,
+		})
+	if exception := exceptions.Cover(nil, []exceptions.Pair{
+		{First: result.Error != nil, Second: apiexceptions.NewUserTwoFactorException().FailedToUpdate().WithOrigin(result.Error)},
+		{First: result.RowsAffected == 0, Second: apiexceptions.NewUserTwoFactorException().WrongCode()},
+	}); exception != nil {
+		return nil, exception
+	}
+
+	return &regeneratedUserTwoFactor, nil
+}
+
+func (r *UserTwoFactorRepository) DeleteOneByUserId(
+	userId uuid.UUID,
+	opts ...options.RepositoryOptions,
+) *exceptions.Exception {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	result := parsedOptions.DB.
+		Where("user_id = ?", userId).
+		Delete(&schemas.UserTwoFactor{})
+	if exception := exceptions.Cover(nil, []exceptions.Pair{
+		{First: result.Error != nil, Second: apiexceptions.NewUserTwoFactorException().FailedToDelete().WithOrigin(result.Error)},
+		{First: result.RowsAffected == 0, Second: apiexceptions.NewUserTwoFactorException().NotEnabled()},
+	}); exception != nil {
+		return exception
+	}
+
+	return nil
+}
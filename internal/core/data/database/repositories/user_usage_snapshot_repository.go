@@ -0,0 +1,164 @@
+package repositories
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+
+	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
+	schemas "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas"
+)
+
+type UserUsageSnapshotRepositoryInterface interface {
+	RecordDaily(ctx context.Context, now time.Time, opts ...options.RepositoryOptions) (int64, *exceptions.Exception)
+	GetManyByUserIdSince(ctx context.Context, userId uuid.UUID, since time.Time, opts ...options.RepositoryOptions) ([]schemas.UserUsageSnapshot, *exceptions.Exception)
+	DeleteBefore(ctx context.Context, before time.Time, opts ...options.RepositoryOptions) (int64, *exceptions.Exception)
+}
+
+type UserUsageSnapshotRepository struct{}
+
+func NewUserUsageSnapshotRepository() UserUsageSnapshotRepositoryInterface {
+	return &UserUsageSnapshotRepository{}
+}
+
+// RecordDaily upserts the snapshots of the date of now for every user, so recording
+// again in the same day refreshes the snapshots to the latest usage of that day,
+// except the used cost units which only drop when the quota cycle is reset,
+// so the highest one of the day is kept instead of the zero after the reset
+func (r *UserUsageSnapshotRepository) RecordDaily(
+	ctx context.Context,
+	now time.Time,
+	opts ...options.RepositoryOptions,
+) (int64, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	result := parsedOptions.DB.
+		WithContext(ctx).
+		Exec(`
+		INSERT INTO "UserUsageSnapshotTable" (
+			user_id,
+			snapshot_date,
+			root_shelf_count,
+			block_pack_count,
+			block_count,
+			material_count,
+			workflow_count,
+			additional_item_count,
+			station_count,
+			routine_count,
+			routine_tag_count,
+			routine_task_cost_unit_used,
+			updated_at,
+			created_at
+		)
+		SELECT
+			user_account.user_id,
+			?::date,
+			user_account.root_shelf_count,
+			user_account.block_pack_count,
+			user_account.block_count,
+			user_account.material_count,
+			user_account.workflow_count,
+			user_account.additional_item_count,
+			user_account.station_count,
+			user_account.routine_count,
+			user_account.routine_tag_count,
+			COALESCE(user_quota.routine_task_cost_unit_used, 0),
+			?,
+			?
+		FROM "UserAccountTable" AS user_account
+		LEFT JOIN "UserQuotaTable" AS user_quota ON user_quota.user_id = user_account.user_id
+		ON CONFLICT (user_id, snapshot_date) DO UPDATE SET
+			root_shelf_count = EXCLUDED.root_shelf_count,
+			block_pack_count = EXCLUDED.block_pack_count,
+			block_count = EXCLUDED.block_count,
+			material_count = EXCLUDED.material_count,
+			workflow_count = EXCLUDED.workflow_count,
+			additional_item_count = EXCLUDED.additional_item_count,
+			station_count = EXCLUDED.station_count,
+			routine_count = EXCLUDED.routine_count,
+			routine_tag_count = EXCLUDED.routine_tag_count,
+			routine_task_cost_unit_used = GREATEST("UserUsageSnapshotTable".routine_task_cost_unit_used, EXCLUDED.routine_task_cost_unit_used),
+			updated_at = EXCLUDED.updated_at
+		`, now.UTC().Format(time.DateOnly), now, now)
+	if result.Error != nil {
+		return 0, exceptions.New(
+			"FailedToCreate",
+			"UserUsageSnapshot",
+			"RecordDaily",
+			"Failed to record the daily usage snapshots",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
+
+func (r *UserUsageSnapshotRepository) GetManyByUserIdSince(
+	ctx context.Context,
+	userId uuid.UUID,
+	since time.Time,
+	opts ...options.RepositoryOptions,
+) ([]schemas.UserUsageSnapshot, *exceptions.Exception) {
+	if userId == uuid.Nil {
+		return nil, exceptions.New(
+			"InvalidDto",
+			"UserUsageSnapshot",
+			"GetManyByUserIdSince",
+			"User usage snapshot request is invalid",
+			http.StatusBadRequest,
+		)
+	}
+
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	snapshots := []schemas.UserUsageSnapshot{}
+	result := parsedOptions.DB.
+		WithContext(ctx).
+		Model(&schemas.UserUsageSnapshot{}).
+		Where("user_id = ? AND snapshot_date >= ?::date", userId, since.UTC().Format(time.DateOnly)).
+		Order("snapshot_date ASC").
+		Find(&snapshots)
+	if result.Error != nil {
+		return nil, exceptions.New(
+			"FailedToGet",
+			"UserUsageSnapshot",
+			"GetManyByUserIdSince",
+			"Failed to retrieve the usage snapshots",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(result.Error)
+	}
+
+	return snapshots, nil
+}
+
+func (r *UserUsageSnapshotRepository) DeleteBefore(
+	ctx context.Context,
+	before time.Time,
+	opts ...options.RepositoryOptions,
+) (int64, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	result := parsedOptions.DB.
+		WithContext(ctx).
+		Where("snapshot_date < ?::date", before.UTC().Format(time.DateOnly)).
+		Delete(&schemas.UserUsageSnapshot{})
+	if result.Error != nil {
+		return 0, exceptions.New(
+			"FailedToDelete",
+			"UserUsageSnapshot",
+			"DeleteBefore",
+			"Failed to delete the expired usage snapshots",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
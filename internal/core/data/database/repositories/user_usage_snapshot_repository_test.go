@@ -0,0 +1,110 @@
+package repositories
+
+import (
+	"context"
+	"database/sql/driver"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
+)
+
+/* ============================== Tests ============================== */
+
+func TestUserUsageSnapshotRepositoryRecordDailyKeepsTheUsageBeforeTheReset(t *testing.T) {
+	now := time.Date(2026, time.October, 19, 3, 30, 0, 0, time.FixedZone("UTC+8", 8*60*60))
+	db, queries := newScriptedTestDB(t, func(sql string) scriptedRows {
+		return scriptedRows{values: [][]driver.Value{{}, {}}}
+	})
+
+	recordedCount, exception := NewUserUsageSnapshotRepository().RecordDaily(context.Background(), now, options.WithDB(db))
+	if exception != nil {
+		t.Fatalf("RecordDaily() exception = %v", exception)
+	}
+
+	if recordedCount != 2 {
+		t.Fatalf("RecordDaily() = %d, want 2", recordedCount)
+	}
+	statement := (*queries)[0]
+	if !strings.Contains(statement.sql, `GREATEST("UserUsageSnapshotTable".routine_task_cost_unit_used, EXCLUDED.routine_task_cost_unit_used)`) {
+		t.Fatalf("RecordDaily() overwrites the used cost units of the day: %s", statement.sql)
+	}
+	if !strings.Contains(statement.sql, "root_shelf_count = EXCLUDED.root_shelf_count") {
+		t.Fatalf("RecordDaily() does not refresh the counters of the day: %s", statement.sql)
+	}
+	// the snapshot date is the date in UTC, which is still the day before the date in UTC+8
+	if snapshotDate := statement.args[0].Value; snapshotDate != "2026-10-18" {
+		t.Fatalf("RecordDaily() snapshot date = %v, want 2026-10-18", snapshotDate)
+	}
+}
+
+func TestUserUsageSnapshotRepositoryGetManyByUserIdSince(t *testing.T) {
+	userId := uuid.New()
+	snapshotDate := time.Date(2026, time.October, 17, 0, 0, 0, 0, time.UTC)
+	db, queries := newScriptedTestDB(t, func(sql string) scriptedRows {
+		return scriptedRows{
+			columns: []string{"id", "user_id", "snapshot_date", "root_shelf_count", "routine_task_cost_unit_used"},
+			values:  [][]driver.Value{{uuid.NewString(), userId.String(), snapshotDate, int64(3), int64(42)}},
+		}
+	})
+
+	snapshots, exception := NewUserUsageSnapshotRepository().GetManyByUserIdSince(
+		context.Background(),
+		userId,
+		time.Date(2026, time.October, 12, 9, 0, 0, 0, time.UTC),
+		options.WithDB(db),
+	)
+	if exception != nil {
+		t.Fatalf("GetManyByUserIdSince() exception = %v", exception)
+	}
+
+	if len(snapshots) != 1 || snapshots[0].RootShelfCount != 3 || snapshots[0].RoutineTaskCostUnitUsed != 42 ||
+		!snapshots[0].SnapshotDate.Equal(snapshotDate) {
+		t.Fatalf("GetManyByUserIdSince() = %+v, want the scripted snapshot", snapshots)
+	}
+	query := (*queries)[0]
+	if !strings.Contains(query.sql, "snapshot_date >= $2::date") || !strings.Contains(query.sql, "ORDER BY snapshot_date ASC") {
+		t.Fatalf("GetManyByUserIdSince() query = %s", query.sql)
+	}
+	if since := query.args[1].Value; since != "2026-10-12" {
+		t.Fatalf("GetManyByUserIdSince() since = %v, want the date 2026-10-12", since)
+	}
+}
+
+func TestUserUsageSnapshotRepositoryGetManyByUserIdSinceRejectsANilUser(t *testing.T) {
+	db, queries := newScriptedTestDB(t, func(sql string) scriptedRows { return scriptedRows{} })
+
+	if _, exception := NewUserUsageSnapshotRepository().GetManyByUserIdSince(context.Background(), uuid.Nil, time.Now(), options.WithDB(db)); exception == nil {
+		t.Fatal("GetManyByUserIdSince() exception = nil, want InvalidDto")
+	}
+	if len(*queries) != 0 {
+		t.Fatalf("GetManyByUserIdSince() sent %d queries for a nil user", len(*queries))
+	}
+}
+
+func TestUserUsageSnapshotRepositoryDeleteBefore(t *testing.T) {
+	db, queries := newScriptedTestDB(t, func(sql string) scriptedRows {
+		return scriptedRows{values: [][]driver.Value{{}}}
+	})
+
+	deletedCount, exception := NewUserUsageSnapshotRepository().DeleteBefore(
+		context.Background(),
+		time.Date(2026, time.January, 1, 12, 0, 0, 0, time.UTC),
+		options.WithDB(db),
+	)
+	if exception != nil {
+		t.Fatalf("DeleteBefore() exception = %v", exception)
+	}
+
+	if deletedCount != 1 {
+		t.Fatalf("DeleteBefore() = %d, want 1", deletedCount)
+	}
+	statement := (*queries)[0]
+	if !strings.HasPrefix(statement.sql, `DELETE FROM "UserUsageSnapshotTable" WHERE snapshot_date < $1::date`) ||
+		statement.args[0].Value != "2026-01-01" {
+		t.Fatalf("DeleteBefore() statement = %s with %v", statement.sql, statement.args)
+	}
+}
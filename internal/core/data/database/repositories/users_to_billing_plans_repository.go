@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jinzhu/copier"
@@ -14,6 +15,7 @@ import (
 	inputs "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/inputs"
 	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
 	schemas "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas"
+	enums "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/enums"
 	scopes "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/scopes"
 	apiexceptions "github.com/HiIamJeff67/notegic-backend/internal/core/exceptions"
 )
@@ -21,8 +23,15 @@ import (
 type UsersToBillingPlansRepositoryInterface interface {
 	GetOnyById(id uuid.UUID, userId uuid.UUID, opts ...options.RepositoryOptions) (*schemas.UsersToBillingPlans, *exceptions.Exception)
 	GetAllByUserId(userId uuid.UUID, opts ...options.RepositoryOptions) ([]schemas.UsersToBillingPlans, *exceptions.Exception)
+	GetOneByProviderSubscriptionId(providerSubscriptionId string, opts ...options.RepositoryOptions) (*schemas.UsersToBillingPlans, *exceptions.Exception)
+	GetLatestByUserId(userId uuid.UUID, opts ...options.RepositoryOptions) (*schemas.UsersToBillingPlans, *exceptions.Exception)
+	GetManyEntitlingByUserId(userId uuid.UUID, now time.Time, opts ...options.RepositoryOptions) ([]schemas.UsersToBillingPlans, *exceptions.Exception)
+	GetManyLapsed(now time.Time, limit int, opts ...options.RepositoryOptions) ([]schemas.UsersToBillingPlans, *exceptions.Exception)
+	GetManyPendingProviderCancellation(limit int, opts ...options.RepositoryOptions) ([]schemas.UsersToBillingPlans, *exceptions.Exception)
 	CreateOne(userId uuid.UUID, input inputs.CreateUsersToBillingPlansInput, opts ...options.RepositoryOptions) (*uuid.UUID, *exceptions.Exception)
 	UpdateOneById(id uuid.UUID, userId uuid.UUID, input inputs.PartialUpdateUsersToBillingPlansInput, opts ...options.RepositoryOptions) (*schemas.UsersToBillingPlans, *exceptions.Exception)
+	UpdateStateOneById(usersToBillingPlans *schemas.UsersToBillingPlans, opts ...options.RepositoryOptions) *exceptions.Exception
+	ClearProviderCancellationOneById(id uuid.UUID, opts ...options.RepositoryOptions) *exceptions.Exception
 	DeleteOneById(id uuid.UUID, userId uuid.UUID, opts ...options.RepositoryOptions) *exceptions.Exception
 	DeleteManyByIds(ids []uuid.UUID, userId uuid.UUID, opts ...options.RepositoryOptions) *exceptions.Exception
 }
@@ -72,6 +81,119 @@ func (r *UsersToBillingPlansRepository) GetAllByUserId(
 	return usersToBillingPlans, nil
 }
 
+// GetOneByProviderSubscriptionId is used by the webhooks of the billing provider,
+// which only know the subscription by the id the provider has assigned to it.
+func (r *UsersToBillingPlansRepository) GetOneByProviderSubscriptionId(
+	providerSubscriptionId string, opts ...options.RepositoryOptions,
+) (*schemas.UsersToBillingPlans, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	var usersToBillingPlans schemas.UsersToBillingPlans
+	result := parsedOptions.DB.Model(&schemas.UsersToBillingPlans{}).
+		Preload(string(schemas.UsersToBullingPlansRelation_BillingPlan)).
+		Where("provider_subscription_id = ?", providerSubscriptionId).
+		Scopes(scopes.Locking(parsedOptions.LockingStrength)).
+		First(&usersToBillingPlans)
+	if result.Error != nil {
+		return nil, apiexceptions.NewUsersToBillingPlansException().NotFound().WithOrigin(result.Error)
+	}
+
+	return &usersToBillingPlans, nil
+}
+
+func (r *UsersToBillingPlansRepository) GetLatestByUserId(
+	userId uuid.UUID, opts ...options.RepositoryOptions,
+) (*schemas.UsersToBillingPlans, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	var usersToBillingPlans schemas.UsersToBillingPlans
+	result := parsedOptions.DB.Model(&schemas.UsersToBillingPlans{}).
+		Preload(string(schemas.UsersToBullingPlansRelation_BillingPlan)).
+		Where("user_id = ?", userId).
+		Order("created_at DESC, id DESC").
+		Scopes(scopes.Locking(parsedOptions.LockingStrength)).
+		First(&usersToBillingPlans)
+	if result.Error != nil {
+		return nil, apiexceptions.NewUsersToBillingPlansException().NotFound().WithOrigin(result.Error)
+	}
+
+	return &usersToBillingPlans, nil
+}
+
+// GetManyEntitlingByUserId returns the subscriptions which still grant their plan to the user,
+// the suspended ones until their grace period ends and the cancelled ones until their paid period ends.
+func (r *UsersToBillingPlansRepository) GetManyEntitlingByUserId(
+	userId uuid.UUID, now time.Time, opts ...options.RepositoryOptions,
+) ([]schemas.UsersToBillingPlans, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	usersToBillingPlans := []schemas.UsersToBillingPlans{}
+	result := parsedOptions.DB.Model(&schemas.UsersToBillingPlans{}).
+		Preload(string(schemas.UsersToBullingPlansRelation_BillingPlan)).
+		Where("user_id = ?", userId).
+		Where(
+			parsedOptions.DB.
+				Where("status = ? AND (grace_period_ends_at IS NULL OR grace_period_ends_at > ?)", enums.UsersToBillingPlansStatus_Active, now).
+				Or("status = ? AND grace_period_ends_at > ?", enums.UsersToBillingPlansStatus_Suspended, now).
+				Or("status = ? AND end_date > ?", enums.UsersToBillingPlansStatus_Cancelled, now),
+		).
+		Find(&usersToBillingPlans)
+	if result.Error != nil {
+		return nil, apiexceptions.NewUsersToBillingPlansException().NotFound().WithOrigin(result.Error)
+	}
+
+	return usersToBillingPlans, nil
+}
+
+// GetManyLapsed returns the subscriptions whose grace period or paid period has ended,
+// which have to be expired to stop granting their plan to the users.
+func (r *UsersToBillingPlansRepository) GetManyLapsed(
+	now time.Time, limit int, opts ...options.RepositoryOptions,
+) ([]schemas.UsersToBillingPlans, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	usersToBillingPlans := []schemas.UsersToBillingPlans{}
+	result := parsedOptions.DB.Model(&schemas.UsersToBillingPlans{}).
+		Where(
+			parsedOptions.DB.
+				Where("status IN ? AND grace_period_ends_at <= ?", []enums.UsersToBillingPlansStatus{
+					enums.UsersToBillingPlansStatus_Active,
+					enums.UsersToBillingPlansStatus_Suspended,
+				}, now).
+				Or("status = ? AND (end_date IS NULL OR end_date <= ?)", enums.UsersToBillingPlansStatus_Cancelled, now),
+		).
+		Order("updated_at ASC").
+		Limit(limit).
+		Scopes(scopes.Locking(parsedOptions.LockingStrength)).
+		Find(&usersToBillingPlans)
+	if result.Error != nil {
+		return nil, apiexceptions.NewUsersToBillingPlansException().NotFound().WithOrigin(result.Error)
+	}
+
+	return usersToBillingPlans, nil
+}
+
+// GetManyPendingProviderCancellation returns the subscriptions cancelled or expired locally
+// whose cancellation has not reached the provider yet.
+func (r *UsersToBillingPlansRepository) GetManyPendingProviderCancellation(
+	limit int, opts ...options.RepositoryOptions,
+) ([]schemas.UsersToBillingPlans, *exceptions.Exception) {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	usersToBillingPlans := []schemas.UsersToBillingPlans{}
+	result := parsedOptions.DB.Model(&schemas.UsersToBillingPlans{}).
+		Where("provider_cancellation_reason IS NOT NULL AND provider_subscription_id IS NOT NULL").
+		Order("updated_at ASC").
+		Limit(limit).
+		Scopes(scopes.Locking(parsedOptions.LockingStrength)).
+		Find(&usersToBillingPlans)
+	if result.Error != nil {
+		return nil, apiexceptions.NewUsersToBillingPlansException().NotFound().WithOrigin(result.Error)
+	}
+
+	return usersToBillingPlans, nil
+}
+
 func (r *UsersToBillingPlansRepository) CreateOne(
 	userId uuid.UUID,
 	input inputs.CreateUsersToBillingPlansInput,
@@ -137,6 +259,53 @@ func (r *UsersToBillingPlansRepository) UpdateOneById(
 	return &updates, nil
 }
 
+// UpdateStateOneById writes the state of the subscription as it is, including the nil
+// fields, so the caller is expected to have locked and loaded the subscription first.
+func (r *UsersToBillingPlansRepository) UpdateStateOneById(
+	usersToBillingPlans *schemas.UsersToBillingPlans,
+	opts ...options.RepositoryOptions,
+) *exceptions.Exception {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	result := parsedOptions.DB.Model(&schemas.UsersToBillingPlans{}).
+		Where("id = ?", usersToBillingPlans.Id).
+		Updates(map[string]any{
+			"billing_plan_id":              usersToBillingPlans.BillingPlanId,
+			"status":                       usersToBillingPlans.Status,
+			"end_date":                     usersToBillingPlans.EndDate,
+			"next_billing_date":            usersToBillingPlans.NextBillingDate,
+			"grace_period_ends_at":         usersToBillingPlans.GracePeriodEndsAt,
+			"failure_count":                usersToBillingPlans.FailureCount,
+			"provider_cancellation_reason": usersToBillingPlans.ProviderCancellationReason,
+		})
+	if exception := exceptions.Cover(nil, []exceptions.Pair{
+		{First: result.Error != nil, Second: apiexceptions.NewUsersToBillingPlansException().FailedToUpdate().WithOrigin(result.Error)},
+		{First: result.RowsAffected == 0, Second: apiexceptions.NewUsersToBillingPlansException().NotFound()},
+	}); exception != nil {
+		return exception
+	}
+
+	return nil
+}
+
+// ClearProviderCancellationOneById marks the cancellation of the subscription as done at the provider,
+// it changes nothing if another caller has already done so.
+func (r *UsersToBillingPlansRepository) ClearProviderCancellationOneById(
+	id uuid.UUID,
+	opts ...options.RepositoryOptions,
+) *exceptions.Exception {
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+
+	result := parsedOptions.DB.Model(&schemas.UsersToBillingPlans{}).
+		Where("id = ? AND provider_cancellation_reason IS NOT NULL", id).
+		Update("provider_cancellation_reason", nil)
+	if result.Error != nil {
+		return apiexceptions.NewUsersToBillingPlansException().FailedToUpdate().WithOrigin(result.Error)
+	}
+
+	return nil
+}
+
 func (r *UsersToBillingPlansRepository) DeleteOneById(
 	id uuid.UUID,
 	userId uuid.UUID,
@@ -4,24 +4,30 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	pg "github.com/lib/pq"
 
 	platformpostgres "github.com/HiIamJeff67/notegic-backend/shared/platform/postgres"
 )
 
 // APIKey stores only the digest of a credential. The clear-text secret is
 // returned once at creation time and must never be persisted or logged.
+// Empty Scopes, RootShelfIds, or StationIds leave the key unrestricted on that
+// axis, so it acts with the full rights of its owner.
 type APIKey struct {
-	Id         uuid.UUID  `json:"id" gorm:"column:id; type:uuid; primaryKey; default:gen_random_uuid();"`
-	PublicId   uuid.UUID  `json:"publicId" gorm:"column:public_id; type:uuid; unique; not null; default:gen_random_uuid();"`
-	UserId     uuid.UUID  `json:"userId" gorm:"column:user_id; type:uuid; not null; index;"`
-	Name       string     `json:"name" gorm:"column:name; not null; size:64;"`
-	KeyPrefix  string     `json:"keyPrefix" gorm:"column:key_prefix; not null; size:16; index;"`
-	KeyHash    string     `json:"-" gorm:"column:key_hash; not null; size:64; unique;"`
-	LastUsedAt *time.Time `json:"lastUsedAt" gorm:"column:last_used_at; type:timestamptz;"`
-	ExpiresAt  *time.Time `json:"expiresAt" gorm:"column:expires_at; type:timestamptz;"`
-	RevokedAt  *time.Time `json:"revokedAt" gorm:"column:revoked_at; type:timestamptz; index;"`
-	CreatedAt  time.Time  `json:"createdAt" gorm:"column:created_at; type:timestamptz; not null; autoCreateTime:true;"`
-	UpdatedAt  time.Time  `json:"updatedAt" gorm:"column:updated_at; type:timestamptz; not null; autoUpdateTime:true;"`
+	Id           uuid.UUID      `json:"id" gorm:"column:id; type:uuid; primaryKey; default:gen_random_uuid();"`
+	PublicId     uuid.UUID      `json:"publicId" gorm:"column:public_id; type:uuid; unique; not null; default:gen_random_uuid();"`
+	UserId       uuid.UUID      `json:"userId" gorm:"column:user_id; type:uuid; not null; index;"`
+	Name         string         `json:"name" gorm:"column:name; not null; size:64;"`
+	KeyPrefix    string         `json:"keyPrefix" gorm:"column:key_prefix; not null; size:16; index;"`
+	KeyHash      string         `json:"-" gorm:"column:key_hash; not null; size:64; unique;"`
+	Scopes       pg.StringArray `json:"scopes" gorm:"column:scopes; type:text[]; not null; default:'{}';"`
+	RootShelfIds pg.StringArray `json:"rootShelfIds" gorm:"column:root_shelf_ids; type:uuid[]; not null; default:'{}';"`
+	StationIds   pg.StringArray `json:"stationIds" gorm:"column:station_ids; type:uuid[]; not null; default:'{}';"`
+	LastUsedAt   *time.Time     `json:"lastUsedAt" gorm:"column:last_used_at; type:timestamptz;"`
+	ExpiresAt    *time.Time     `json:"expiresAt" gorm:"column:expires_at; type:timestamptz;"`
+	RevokedAt    *time.Time     `json:"revokedAt" gorm:"column:revoked_at; type:timestamptz; index;"`
+	CreatedAt    time.Time      `json:"createdAt" gorm:"column:created_at; type:timestamptz; not null; autoCreateTime:true;"`
+	UpdatedAt    time.Time      `json:"updatedAt" gorm:"column:updated_at; type:timestamptz; not null; autoUpdateTime:true;"`
 }
 
 func (APIKey) TableName() string {
@@ -29,3 +35,33 @@ func (APIKey) TableName() string {
 }
 
 type APIKeyRelation platformpostgres.RelationName
+
+/* ============================== Relative Type Conversion ============================== */
+
+func (k *APIKey) GetRootShelfIds() []uuid.UUID {
+	return parseAPIKeyResourceIds(k.RootShelfIds)
+}
+
+func (k *APIKey) GetStationIds() []uuid.UUID {
+	return parseAPIKeyResourceIds(k.StationIds)
+}
+
+func NewAPIKeyResourceIds(ids []uuid.UUID) pg.StringArray {
+	resourceIds := make(pg.StringArray, len(ids))
+	for index, id := range ids {
+		resourceIds[index] = id.String()
+	}
+
+	return resourceIds
+}
+
+func parseAPIKeyResourceIds(resourceIds pg.StringArray) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(resourceIds))
+	for _, resourceId := range resourceIds {
+		if id, err := uuid.Parse(resourceId); err == nil {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
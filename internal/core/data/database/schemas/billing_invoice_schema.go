@@ -0,0 +1,34 @@
+package schemas
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	enums "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/enums"
+)
+
+// BillingInvoice records each payment captured by the billing provider for a subscription,
+// the payment id of the provider is unique so that a redelivered webhook never records it twice.
+type BillingInvoice struct {
+	Id                    uuid.UUID                   `json:"id" gorm:"column:id; type:uuid; primaryKey; default:gen_random_uuid();"`
+	UserId                uuid.UUID                   `json:"userId" gorm:"column:user_id; type:uuid; not null; index:billing_invoice_idx_user_id_paid_at,priority:1;"`
+	UsersToBillingPlansId uuid.UUID                   `json:"usersToBillingPlansId" gorm:"column:users_to_billing_plans_id; type:uuid; not null; index;"`
+	BillingPlanId         string                      `json:"billingPlanId" gorm:"column:billing_plan_id; not null;"`
+	ProviderPaymentId     string                      `json:"providerPaymentId" gorm:"column:provider_payment_id; not null; unique;"`
+	Amount                string                      `json:"amount" gorm:"column:amount; type:decimal; not null;"` // kept as the decimal string of the provider, a float can not hold every amount exactly
+	CurrencyCode          enums.SupportedCurrencyCode `json:"currencyCode" gorm:"column:currency_code; type:\"SupportedCurrencyCode\"; not null;"`
+	Status                enums.BillingInvoiceStatus  `json:"status" gorm:"column:status; type:\"BillingInvoiceStatus\"; not null; default:'COMPLETED';"`
+	PaidAt                time.Time                   `json:"paidAt" gorm:"column:paid_at; type:timestamptz; not null; index:billing_invoice_idx_user_id_paid_at,priority:2;"`
+	RefundedAt            *time.Time                  `json:"refundedAt" gorm:"column:refunded_at; type:timestamptz; default:null;"`
+	UpdatedAt             time.Time                   `json:"updatedAt" gorm:"column:updated_at; type:timestamptz; not null; autoUpdateTime:true;"`
+	CreatedAt             time.Time                   `json:"createdAt" gorm:"column:created_at; type:timestamptz; not null; autoCreateTime:true;"`
+
+	// relations
+	User                User                `json:"user" gorm:"foreignKey:UserId; references:Id; constraint:OnUpdate:CASCADE, OnDelete:CASCADE;"`
+	UsersToBillingPlans UsersToBillingPlans `json:"usersToBillingPlans" gorm:"foreignKey:UsersToBillingPlansId; references:Id; constraint:OnUpdate:CASCADE, OnDelete:CASCADE;"`
+}
+
+func (BillingInvoice) TableName() string {
+	return "BillingInvoiceTable"
+}
@@ -20,6 +20,7 @@ type BlockPack struct {
 	Icon                *enums.SupportedIcon `json:"icon" gorm:"column:icon; type:\"SupportedIcon\"; default:null;"`
 	HeaderBackgroundURL *string              `json:"headerBackgroundURL" gorm:"column:header_background_url; default:null;"`
 	BlockCount          int64                `json:"blockCount" gorm:"column:block_count; type:bigint; not null; default:0; check:block_pack_check_max_block_count,block_count <= 1000;"`
+	SearchVector        *string              `json:"-" gorm:"column:search_vector; type:tsvector; index:block_pack_idx_search_vector,type:gin; ->:false; <-:false;"` // projected by trigger_project_block_pack_search_vector_before_insert_or_update
 	DeletedAt           *time.Time           `json:"deletedAt" gorm:"column:deleted_at; type:timestamptz; default:null;"`
 	UpdatedAt           time.Time            `json:"updatedAt" gorm:"column:updated_at; type:timestamptz; not null; autoUpdateTime:true;"`
 	CreatedAt           time.Time            `json:"createdAt" gorm:"column:created_at; type:timestamptz; not null; autoCreateTime:true;"`
@@ -0,0 +1,37 @@
+package schemas
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	platformpostgres "github.com/HiIamJeff67/notegic-backend/shared/platform/postgres"
+
+	enums "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/enums"
+)
+
+type BlockPackYjsSnapshot struct {
+	Id             uuid.UUID                   `json:"id" gorm:"column:id; type:uuid; primaryKey; not null; default:gen_random_uuid();"`
+	BlockPackId    uuid.UUID                   `json:"blockPackId" gorm:"column:block_pack_id; type:uuid; not null; index:block_pack_yjs_snapshot_idx_block_pack_id_kind_created_at,priority:1;"`
+	Kind           enums.BlockPackSnapshotKind `json:"kind" gorm:"column:kind; type:\"BlockPackSnapshotKind\"; not null; index:block_pack_yjs_snapshot_idx_block_pack_id_kind_created_at,priority:2;"`
+	Name           *string                     `json:"name" gorm:"column:name; size:128; default:null;"` // only the named snapshots have a name
+	State          []byte                      `json:"state" gorm:"column:state; type:bytea; not null;"` // the marshaled YjsDocumentState, including the uncompacted updates
+	UpdateSequence int64                       `json:"updateSequence" gorm:"column:update_sequence; type:bigint; not null; default:0;"`
+	CreatorId      *uuid.UUID                  `json:"creatorId" gorm:"column:creator_id; type:uuid; default:null;"` // null if the snapshot is captured by the compaction
+	CreatedAt      time.Time                   `json:"createdAt" gorm:"column:created_at; type:timestamptz; not null; autoCreateTime:true; index:block_pack_yjs_snapshot_idx_block_pack_id_kind_created_at,priority:3;"`
+
+	// relations
+	BlockPack BlockPack `json:"blockPack" gorm:"foreignKey:BlockPackId; references:Id; constraint:OnUpdate:CASCADE, OnDelete:CASCADE;"`
+	Creator   *User     `json:"creator" gorm:"foreignKey:CreatorId; references:Id; constraint:OnUpdate:CASCADE, OnDelete:SET NULL;"`
+}
+
+func (BlockPackYjsSnapshot) TableName() string {
+	return "BlockPackYjsSnapshotTable"
+}
+
+type BlockPackYjsSnapshotRelation platformpostgres.RelationName
+
+const (
+	BlockPackYjsSnapshotRelation_BlockPack BlockPackYjsSnapshotRelation = "BlockPack"
+	BlockPackYjsSnapshotRelation_Creator   BlockPackYjsSnapshotRelation = "Creator"
+)
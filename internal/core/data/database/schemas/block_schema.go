@@ -23,6 +23,7 @@ type Block struct {
 	Type          enums.BlockType `json:"type" gorm:"column:type; type:\"BlockType\"; not null; default:'paragraph';"`
 	Props         datatypes.JSON  `json:"props" gorm:"column:props; type:jsonb; not null; default:'{}'; check:block_check_props_size,octet_length(props::text) <= 4096;"`
 	Content       datatypes.JSON  `json:"content" gorm:"column:content; type:jsonb; default:'{}'; check:block_check_content_size,octet_length(content::text) <= 16384;"`
+	SearchVector  *string         `json:"-" gorm:"column:search_vector; type:tsvector; index:block_idx_search_vector,type:gin; ->:false; <-:false;"` // projected by trigger_project_block_search_vector_before_insert_or_update
 	UpdatedAt     time.Time       `json:"updatedAt" gorm:"column:updated_at; type:timestamptz; not null; autoUpdateTime:true;"`
 	CreatedAt     time.Time       `json:"createdAt" gorm:"column:created_at; type:timestamptz; not null; autoCreateTime:true;"`
 
@@ -3,14 +3,20 @@ package constraints
 import (
 	blockconstraints "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/constraints/block_constraints"
 	routineconstraints "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/constraints/routine_constraints"
+	routinetaskconstraints "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/constraints/routine_task_constraints"
+	userconstraints "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/constraints/user_constraints"
 	userquotaconstraints "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/constraints/user_quota_constraints"
 	userstobillingplansconstraints "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/constraints/users_to_billing_plans_constraints"
 )
 
 var MigratingConstraintSQLs = []string{
 	userstobillingplansconstraints.UserIdBillingPlanIdPartialStatusIndexSQL,
+	userstobillingplansconstraints.DropLegacyBillingPlanIdUniqueIndexSQL,
 	blockconstraints.BlockSiblingPointerConstraintsSQL,
 	routineconstraints.RoutineScheduledTimeMinutePrecisionCheckSQL,
 	routineconstraints.RoutineScheduledTimeInPeriodCheckSQL,
+	routineconstraints.RoutinePeriodRecurrenceRuleExclusiveCheckSQL,
+	routinetaskconstraints.RoutineTaskPeriodRecurrenceRuleExclusiveCheckSQL,
 	userquotaconstraints.DropLegacyRoutineTaskCostUnitCountSQL,
+	userconstraints.DropLegacyRefreshTokenSQL,
 }
@@ -0,0 +1,10 @@
+ALTER TABLE "RoutineTable" DROP CONSTRAINT IF EXISTS routine_check_period_recurrence_rule_exclusive;
+
+-- ============================== SQL Separator ==============================
+
+ALTER TABLE "RoutineTable"
+ADD CONSTRAINT routine_check_period_recurrence_rule_exclusive
+CHECK (
+    period IS NULL
+    OR recurrence_rule IS NULL
+);
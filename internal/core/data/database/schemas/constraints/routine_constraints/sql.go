@@ -10,4 +10,7 @@ var (
 
 	//go:embed routine_scheduled_time_in_period_check.sql
 	RoutineScheduledTimeInPeriodCheckSQL string
+
+	//go:embed routine_period_recurrence_rule_exclusive_check.sql
+	RoutinePeriodRecurrenceRuleExclusiveCheckSQL string
 )
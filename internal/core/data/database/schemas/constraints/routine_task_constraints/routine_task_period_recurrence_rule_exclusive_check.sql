@@ -0,0 +1,10 @@
+ALTER TABLE "RoutineTaskTable" DROP CONSTRAINT IF EXISTS routine_task_check_period_recurrence_rule_exclusive;
+
+-- ============================== SQL Separator ==============================
+
+ALTER TABLE "RoutineTaskTable"
+ADD CONSTRAINT routine_task_check_period_recurrence_rule_exclusive
+CHECK (
+    period IS NULL
+    OR recurrence_rule IS NULL
+);
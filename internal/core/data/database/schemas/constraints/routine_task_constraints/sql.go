@@ -0,0 +1,10 @@
+package routinetaskconstraints
+
+import (
+	_ "embed"
+)
+
+var (
+	//go:embed routine_task_period_recurrence_rule_exclusive_check.sql
+	RoutineTaskPeriodRecurrenceRuleExclusiveCheckSQL string
+)
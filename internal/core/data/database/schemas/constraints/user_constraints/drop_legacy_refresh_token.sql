@@ -0,0 +1,2 @@
+ALTER TABLE "UserTable"
+DROP COLUMN IF EXISTS refresh_token;
@@ -0,0 +1,8 @@
+package userconstraints
+
+import _ "embed"
+
+var (
+	//go:embed drop_legacy_refresh_token.sql
+	DropLegacyRefreshTokenSQL string
+)
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS "users_to_billing_plans_idx_user_id_billing_plan_id_partial_active";
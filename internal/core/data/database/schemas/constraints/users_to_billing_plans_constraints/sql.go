@@ -7,4 +7,9 @@ import (
 var (
 	//go:embed user_id_billing_plan_id_partial_status_idx.sql
 	UserIdBillingPlanIdPartialStatusIndexSQL string
+
+	// the billing plan id was once declared as a standalone unique index by mistake,
+	// which allowed only one user to subscribe to each billing plan
+	//go:embed drop_legacy_billing_plan_id_unique_idx.sql
+	DropLegacyBillingPlanIdUniqueIndexSQL string
 )
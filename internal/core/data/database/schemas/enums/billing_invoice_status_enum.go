@@ -0,0 +1,82 @@
+package enums
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"slices"
+
+	enumcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
+)
+
+type BillingInvoiceStatus enumcontract.BillingInvoiceStatus
+
+func (value *BillingInvoiceStatus) ToContractable() *enumcontract.BillingInvoiceStatus {
+	if value == nil {
+		return nil
+	}
+
+	contractValue := enumcontract.BillingInvoiceStatus(*value)
+	return &contractValue
+}
+
+func (value *BillingInvoiceStatus) ToStorable() *BillingInvoiceStatus {
+	if value == nil {
+		return nil
+	}
+
+	storableValue := *value
+	return &storableValue
+}
+
+const (
+	BillingInvoiceStatus_Completed BillingInvoiceStatus = BillingInvoiceStatus(enumcontract.BillingInvoiceStatus_Completed)
+	BillingInvoiceStatus_Refunded  BillingInvoiceStatus = BillingInvoiceStatus(enumcontract.BillingInvoiceStatus_Refunded)
+)
+
+var AllBillingInvoiceStatuses = []BillingInvoiceStatus{
+	BillingInvoiceStatus_Completed,
+	BillingInvoiceStatus_Refunded,
+}
+
+var AllBillingInvoiceStatusStrings = []string{
+	string(BillingInvoiceStatus_Completed),
+	string(BillingInvoiceStatus_Refunded),
+}
+
+func (bis BillingInvoiceStatus) Name() string {
+	return reflect.TypeOf(bis).Name()
+}
+
+func (bis *BillingInvoiceStatus) Scan(value any) error {
+	switch v := value.(type) {
+	case []byte:
+		*bis = BillingInvoiceStatus(string(v))
+		return nil
+	case string:
+		*bis = BillingInvoiceStatus(v)
+		return nil
+	}
+	return scanError(value, bis)
+}
+
+func (bis BillingInvoiceStatus) Value() (driver.Value, error) {
+	return string(bis), nil
+}
+
+func (bis BillingInvoiceStatus) String() string {
+	return string(bis)
+}
+
+func (bis *BillingInvoiceStatus) IsValidEnum() bool {
+	return slices.Contains(AllBillingInvoiceStatuses, *bis)
+}
+
+func ConvertStringToBillingInvoiceStatus(enumString string) (*BillingInvoiceStatus, error) {
+	for _, billingInvoiceStatus := range AllBillingInvoiceStatuses {
+		if string(billingInvoiceStatus) == enumString {
+			return &billingInvoiceStatus, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid billing invoice status: %s", enumString)
+}
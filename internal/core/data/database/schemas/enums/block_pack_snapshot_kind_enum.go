@@ -0,0 +1,59 @@
+package enums
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"slices"
+
+	enumcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
+)
+
+type BlockPackSnapshotKind enumcontract.BlockPackSnapshotKind
+
+const (
+	BlockPackSnapshotKind_Named      BlockPackSnapshotKind = BlockPackSnapshotKind(enumcontract.BlockPackSnapshotKind_Named)
+	BlockPackSnapshotKind_Periodic   BlockPackSnapshotKind = BlockPackSnapshotKind(enumcontract.BlockPackSnapshotKind_Periodic)
+	BlockPackSnapshotKind_PreRestore BlockPackSnapshotKind = BlockPackSnapshotKind(enumcontract.BlockPackSnapshotKind_PreRestore)
+)
+
+var AllBlockPackSnapshotKinds = []BlockPackSnapshotKind{
+	BlockPackSnapshotKind_Named,
+	BlockPackSnapshotKind_Periodic,
+	BlockPackSnapshotKind_PreRestore,
+}
+
+var AllBlockPackSnapshotKindStrings = []string{
+	string(BlockPackSnapshotKind_Named),
+	string(BlockPackSnapshotKind_Periodic),
+	string(BlockPackSnapshotKind_PreRestore),
+}
+
+func (t BlockPackSnapshotKind) Name() string { return reflect.TypeOf(t).Name() }
+
+func (t *BlockPackSnapshotKind) Scan(value any) error {
+	switch v := value.(type) {
+	case []byte:
+		*t = BlockPackSnapshotKind(string(v))
+		return nil
+	case string:
+		*t = BlockPackSnapshotKind(v)
+		return nil
+	}
+	return scanError(value, t)
+}
+
+func (t BlockPackSnapshotKind) Value() (driver.Value, error) { return string(t), nil }
+func (t BlockPackSnapshotKind) String() string               { return string(t) }
+func (t *BlockPackSnapshotKind) IsValidEnum() bool {
+	return slices.Contains(AllBlockPackSnapshotKinds, *t)
+}
+
+func ConvertStringToBlockPackSnapshotKind(value string) (*BlockPackSnapshotKind, error) {
+	for _, kind := range AllBlockPackSnapshotKinds {
+		if string(kind) == value {
+			return &kind, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid block pack snapshot kind: %s", value)
+}
@@ -0,0 +1,78 @@
+package enums
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"slices"
+
+	enumcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
+)
+
+// MaterialProcessingStatus indicates the progress of the text extraction and the preview generation of a material.
+type MaterialProcessingStatus enumcontract.MaterialProcessingStatus
+
+func (value *MaterialProcessingStatus) ToContractable() *enumcontract.MaterialProcessingStatus {
+	if value == nil {
+		return nil
+	}
+
+	contractValue := enumcontract.MaterialProcessingStatus(*value)
+	return &contractValue
+}
+
+const (
+	MaterialProcessingStatus_None       MaterialProcessingStatus = MaterialProcessingStatus(enumcontract.MaterialProcessingStatus_None)
+	MaterialProcessingStatus_Pending    MaterialProcessingStatus = MaterialProcessingStatus(enumcontract.MaterialProcessingStatus_Pending)
+	MaterialProcessingStatus_Processing MaterialProcessingStatus = MaterialProcessingStatus(enumcontract.MaterialProcessingStatus_Processing)
+	MaterialProcessingStatus_Completed  MaterialProcessingStatus = MaterialProcessingStatus(enumcontract.MaterialProcessingStatus_Completed)
+	MaterialProcessingStatus_Skipped    MaterialProcessingStatus = MaterialProcessingStatus(enumcontract.MaterialProcessingStatus_Skipped)
+	MaterialProcessingStatus_Failed     MaterialProcessingStatus = MaterialProcessingStatus(enumcontract.MaterialProcessingStatus_Failed)
+)
+
+var AllMaterialProcessingStatuses = []MaterialProcessingStatus{
+	MaterialProcessingStatus_None,
+	MaterialProcessingStatus_Pending,
+	MaterialProcessingStatus_Processing,
+	MaterialProcessingStatus_Completed,
+	MaterialProcessingStatus_Skipped,
+	MaterialProcessingStatus_Failed,
+}
+
+var AllMaterialProcessingStatusStrings = []string{
+	string(MaterialProcessingStatus_None),
+	string(MaterialProcessingStatus_Pending),
+	string(MaterialProcessingStatus_Processing),
+	string(MaterialProcessingStatus_Completed),
+	string(MaterialProcessingStatus_Skipped),
+	string(MaterialProcessingStatus_Failed),
+}
+
+func (s MaterialProcessingStatus) Name() string { return reflect.TypeOf(s).Name() }
+
+func (s *MaterialProcessingStatus) Scan(value any) error {
+	switch v := value.(type) {
+	case []byte:
+		*s = MaterialProcessingStatus(string(v))
+		return nil
+	case string:
+		*s = MaterialProcessingStatus(v)
+		return nil
+	}
+	return scanError(value, s)
+}
+
+func (s MaterialProcessingStatus) Value() (driver.Value, error) { return string(s), nil }
+func (s MaterialProcessingStatus) String() string               { return string(s) }
+func (s *MaterialProcessingStatus) IsValidEnum() bool {
+	return slices.Contains(AllMaterialProcessingStatuses, *s)
+}
+
+func ConvertStringToMaterialProcessingStatus(value string) (*MaterialProcessingStatus, error) {
+	for _, status := range AllMaterialProcessingStatuses {
+		if string(status) == value {
+			return &status, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid material processing status: %s", value)
+}
@@ -5,9 +5,11 @@ var MigratingEnums = map[string][]string{
 	new(AccessControlPermission).Name():    AllAccessControlPermissionStrings,
 	new(BadgeType).Name():                  AllBadgeTypeStrings,
 	new(BillingIntervalUnit).Name():        AllBillingIntervalUnitStrings,
+	new(BillingInvoiceStatus).Name():       AllBillingInvoiceStatusStrings,
 	new(BillingPlanName).Name():            AllBillingPlanNameStrings,
 	new(BillingPlanStatus).Name():          AllBillingPlanStatusStrings,
 	new(BlockType).Name():                  AllBlockTypeStrings,
+	new(BlockPackSnapshotKind).Name():      AllBlockPackSnapshotKindStrings,
 	new(CountryCode).Name():                AllCountryCodeStrings,
 	new(Country).Name():                    AllCountryStrings,
 	new(ItemType).Name():                   AllItemTypeStrings,
@@ -15,6 +17,9 @@ var MigratingEnums = map[string][]string{
 	new(UserSettingDensity).Name():         AllUserSettingDensityStrings,
 	new(UserSettingStartSurface).Name():    AllUserSettingStartSurfaceStrings,
 	new(MaterialContentType).Name():        AllMaterialContentTypeStrings,
+	new(MaterialProcessingStatus).Name():   AllMaterialProcessingStatusStrings,
+	new(RootShelfArchiveJobStatus).Name():  AllRootShelfArchiveJobStatusStrings,
+	new(RootShelfArchiveJobType).Name():    AllRootShelfArchiveJobTypeStrings,
 	new(RoutinePeriod).Name():              AllRoutinePeriodStrings,
 	new(RoutineStatus).Name():              AllRoutineStatusStrings,
 	new(RoutineTaskPurpose).Name():         AllRoutineTaskPurposeStrings,
@@ -0,0 +1,62 @@
+package enums
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"slices"
+
+	enumcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
+)
+
+type RootShelfArchiveJobStatus enumcontract.RootShelfArchiveJobStatus
+
+const (
+	RootShelfArchiveJobStatus_Pending   RootShelfArchiveJobStatus = RootShelfArchiveJobStatus(enumcontract.RootShelfArchiveJobStatus_Pending)
+	RootShelfArchiveJobStatus_Running   RootShelfArchiveJobStatus = RootShelfArchiveJobStatus(enumcontract.RootShelfArchiveJobStatus_Running)
+	RootShelfArchiveJobStatus_Succeeded RootShelfArchiveJobStatus = RootShelfArchiveJobStatus(enumcontract.RootShelfArchiveJobStatus_Succeeded)
+	RootShelfArchiveJobStatus_Failed    RootShelfArchiveJobStatus = RootShelfArchiveJobStatus(enumcontract.RootShelfArchiveJobStatus_Failed)
+)
+
+var AllRootShelfArchiveJobStatuses = []RootShelfArchiveJobStatus{
+	RootShelfArchiveJobStatus_Pending,
+	RootShelfArchiveJobStatus_Running,
+	RootShelfArchiveJobStatus_Succeeded,
+	RootShelfArchiveJobStatus_Failed,
+}
+
+var AllRootShelfArchiveJobStatusStrings = []string{
+	string(RootShelfArchiveJobStatus_Pending),
+	string(RootShelfArchiveJobStatus_Running),
+	string(RootShelfArchiveJobStatus_Succeeded),
+	string(RootShelfArchiveJobStatus_Failed),
+}
+
+func (s RootShelfArchiveJobStatus) Name() string { return reflect.TypeOf(s).Name() }
+
+func (s *RootShelfArchiveJobStatus) Scan(value any) error {
+	switch v := value.(type) {
+	case []byte:
+		*s = RootShelfArchiveJobStatus(string(v))
+		return nil
+	case string:
+		*s = RootShelfArchiveJobStatus(v)
+		return nil
+	}
+	return scanError(value, s)
+}
+
+func (s RootShelfArchiveJobStatus) Value() (driver.Value, error) { return string(s), nil }
+func (s RootShelfArchiveJobStatus) String() string               { return string(s) }
+func (s *RootShelfArchiveJobStatus) IsValidEnum() bool {
+	return slices.Contains(AllRootShelfArchiveJobStatuses, *s)
+}
+
+func ConvertStringToRootShelfArchiveJobStatus(value string) (*RootShelfArchiveJobStatus, error) {
+	for _, status := range AllRootShelfArchiveJobStatuses {
+		if string(status) == value {
+			return &status, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid root shelf archive job status: %s", value)
+}
@@ -0,0 +1,56 @@
+package enums
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"slices"
+
+	enumcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
+)
+
+type RootShelfArchiveJobType enumcontract.RootShelfArchiveJobType
+
+const (
+	RootShelfArchiveJobType_Export RootShelfArchiveJobType = RootShelfArchiveJobType(enumcontract.RootShelfArchiveJobType_Export)
+	RootShelfArchiveJobType_Import RootShelfArchiveJobType = RootShelfArchiveJobType(enumcontract.RootShelfArchiveJobType_Import)
+)
+
+var AllRootShelfArchiveJobTypes = []RootShelfArchiveJobType{
+	RootShelfArchiveJobType_Export,
+	RootShelfArchiveJobType_Import,
+}
+
+var AllRootShelfArchiveJobTypeStrings = []string{
+	string(RootShelfArchiveJobType_Export),
+	string(RootShelfArchiveJobType_Import),
+}
+
+func (t RootShelfArchiveJobType) Name() string { return reflect.TypeOf(t).Name() }
+
+func (t *RootShelfArchiveJobType) Scan(value any) error {
+	switch v := value.(type) {
+	case []byte:
+		*t = RootShelfArchiveJobType(string(v))
+		return nil
+	case string:
+		*t = RootShelfArchiveJobType(v)
+		return nil
+	}
+	return scanError(value, t)
+}
+
+func (t RootShelfArchiveJobType) Value() (driver.Value, error) { return string(t), nil }
+func (t RootShelfArchiveJobType) String() string               { return string(t) }
+func (t *RootShelfArchiveJobType) IsValidEnum() bool {
+	return slices.Contains(AllRootShelfArchiveJobTypes, *t)
+}
+
+func ConvertStringToRootShelfArchiveJobType(value string) (*RootShelfArchiveJobType, error) {
+	for _, jobType := range AllRootShelfArchiveJobTypes {
+		if string(jobType) == value {
+			return &jobType, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid root shelf archive job type: %s", value)
+}
@@ -14,16 +14,20 @@ import (
 )
 
 type Material struct {
-	Id               uuid.UUID                 `json:"id" gorm:"column:id; type:uuid; primaryKey; not null;"`
-	ParentSubShelfId uuid.UUID                 `json:"parentSubShelfId" gorm:"column:parent_sub_shelf_id; type:uuid; not null;"` // Previous unique-name constraint: uniqueIndex:material_idx_parent_sub_shelf_id_name,where:deleted_at IS NULL
-	Name             string                    `json:"name" gorm:"column:name; size:128; not null; default:'undefined';"`        // Previous unique-name constraint: uniqueIndex:material_idx_parent_sub_shelf_id_name,where:deleted_at IS NULL
-	Size             int64                     `json:"size" gorm:"column:size; type:bigint; not null; default:0;"`
-	ContentKey       string                    `json:"contentKey" gorm:"column:content_key; unique; not null;"`
-	ContentType      enums.MaterialContentType `json:"contentType" gorm:"column:content_type; type:\"MaterialContentType\"; not null; default:'none';"`
-	ParseMediaType   string                    `json:"parseMediaType" gorm:"column:parse_media_type; size:128; not null; default:'';"`
-	DeletedAt        *time.Time                `json:"deletedAt" gorm:"column:deleted_at; type:timestamptz; default:null;"`
-	UpdatedAt        time.Time                 `json:"updatedAt" gorm:"column:updated_at; type:timestamptz; not null; autoUpdateTime:true;"`
-	CreatedAt        time.Time                 `json:"createdAt" gorm:"column:created_at; type:timestamptz; not null; autoCreateTime:true;"`
+	Id               uuid.UUID                      `json:"id" gorm:"column:id; type:uuid; primaryKey; not null;"`
+	ParentSubShelfId uuid.UUID                      `json:"parentSubShelfId" gorm:"column:parent_sub_shelf_id; type:uuid; not null;"` // Previous unique-name constraint: uniqueIndex:material_idx_parent_sub_shelf_id_name,where:deleted_at IS NULL
+	Name             string                         `json:"name" gorm:"column:name; size:128; not null; default:'undefined';"`        // Previous unique-name constraint: uniqueIndex:material_idx_parent_sub_shelf_id_name,where:deleted_at IS NULL
+	Size             int64                          `json:"size" gorm:"column:size; type:bigint; not null; default:0;"`
+	ContentKey       string                         `json:"contentKey" gorm:"column:content_key; unique; not null;"`
+	ContentType      enums.MaterialContentType      `json:"contentType" gorm:"column:content_type; type:\"MaterialContentType\"; not null; default:'none';"`
+	ParseMediaType   string                         `json:"parseMediaType" gorm:"column:parse_media_type; size:128; not null; default:'';"`
+	ProcessingStatus enums.MaterialProcessingStatus `json:"processingStatus" gorm:"column:processing_status; type:\"MaterialProcessingStatus\"; not null; default:'None'; index:material_idx_processing_status_updated_at,priority:1;"`
+	PreviewKey       *string                        `json:"previewKey" gorm:"column:preview_key; default:null;"`                                                          // the object key of the generated PNG preview, which is stored alongside the content key
+	SearchText       *string                        `json:"-" gorm:"column:search_text; type:text; default:null;"`                                                        // the extracted text of the textual contents, which is only written for the search projection
+	SearchVector     *string                        `json:"-" gorm:"column:search_vector; type:tsvector; index:material_idx_search_vector,type:gin; ->:false; <-:false;"` // projected by trigger_project_material_search_vector_before_insert_or_update
+	DeletedAt        *time.Time                     `json:"deletedAt" gorm:"column:deleted_at; type:timestamptz; default:null;"`
+	UpdatedAt        time.Time                      `json:"updatedAt" gorm:"column:updated_at; type:timestamptz; not null; autoUpdateTime:true; index:material_idx_processing_status_updated_at,priority:2;"`
+	CreatedAt        time.Time                      `json:"createdAt" gorm:"column:created_at; type:timestamptz; not null; autoCreateTime:true;"`
 
 	// relations
 	ParentSubShelf SubShelf `json:"parentSubShelf" gorm:"foreignKey:ParentSubShelfId; references:Id; constraint:OnUpdate:CASCADE, OnDelete:CASCADE;"`
@@ -0,0 +1,32 @@
+package schemas
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	enums "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/enums"
+)
+
+// MaterialUpload tracks a multipart upload of a material content which is sent directly to the
+// storage, the parts are staged under their own content key until the upload is completed, and
+// the abandoned uploads are aborted once they expire, so it has no foreign keys in order to keep
+// the uploads of the deleted materials and users reachable by the cleanup worker
+type MaterialUpload struct {
+	Id              uuid.UUID                 `json:"id" gorm:"column:id; type:uuid; primaryKey; not null;"`
+	MaterialId      uuid.UUID                 `json:"materialId" gorm:"column:material_id; type:uuid; not null; index:material_upload_idx_material_id;"`
+	UserId          uuid.UUID                 `json:"userId" gorm:"column:user_id; type:uuid; not null;"`
+	ContentKey      string                    `json:"contentKey" gorm:"column:content_key; unique; not null;"`
+	StorageUploadId string                    `json:"-" gorm:"column:storage_upload_id; not null;"`
+	Size            int64                     `json:"size" gorm:"column:size; type:bigint; not null;"`
+	ContentType     enums.MaterialContentType `json:"contentType" gorm:"column:content_type; type:\"MaterialContentType\"; not null;"`
+	PartSize        int64                     `json:"partSize" gorm:"column:part_size; type:bigint; not null;"`
+	PartCount       int32                     `json:"partCount" gorm:"column:part_count; type:integer; not null;"`
+	ExpiresAt       time.Time                 `json:"expiresAt" gorm:"column:expires_at; type:timestamptz; not null; index:material_upload_idx_expires_at;"`
+	CreatedAt       time.Time                 `json:"createdAt" gorm:"column:created_at; type:timestamptz; not null; autoCreateTime:true;"`
+}
+
+// MaterialUpload Table Name
+func (MaterialUpload) TableName() string {
+	return "MaterialUploadTable"
+}
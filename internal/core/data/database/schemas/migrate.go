@@ -7,8 +7,12 @@ var MigratingTables = []any{
 	&UserInfo{},
 	&UserAccount{},
 	&UserQuota{},
+	&UserUsageSnapshot{},
+	&UserQuotaWarning{},
 	&UserSetting{},
 	&APIKey{},
+	&UserSession{},
+	&UserTwoFactor{},
 
 	&UsersToBadges{},
 	&Badge{},
@@ -19,11 +23,15 @@ var MigratingTables = []any{
 	&RootShelf{},
 	&SubShelf{},
 	&Material{},
+	&MaterialUpload{},
 	&BlockPack{},
 	&BlockPackYjsDocument{},
 	&BlockPackYjsUpdate{},
+	&BlockPackYjsSnapshot{},
 	&Block{},
 	&Item{},
+	&RootShelfArchiveJob{},
+	&ShareLink{},
 
 	&Station{},
 	&Routine{},
@@ -37,6 +45,7 @@ var MigratingTables = []any{
 	&OutboxEvent{},
 
 	&UsersToBillingPlans{},
+	&BillingInvoice{},
 
 	// private tables
 	&PlanLimitation{},
@@ -26,6 +26,9 @@ type PlanLimitation struct {
 	MaxRoutineTaskCostUnitCount    int32          `json:"maxRoutineTaskCostUnitCount" gorm:"column:max_routine_task_cost_unit_count; type:integer; not null;"`
 	MaxRoutineTaskAttempts         int32          `json:"maxRoutineTaskAttempts" gorm:"column:max_routine_task_attempts; type:integer; not null;"`
 	MaxRealtimeRoomSubscriberCount int32          `json:"maxRealtimeRoomSubscriberCount" gorm:"column:max_realtime_room_subscriber_count; type:integer; not null; default:0;"`
+	MaxAPIKeyBurstRequestCount     int32          `json:"maxAPIKeyBurstRequestCount" gorm:"column:max_api_key_burst_request_count; type:integer; not null; default:0;"`
+	MaxAPIKeySustainedRequestCount int32          `json:"maxAPIKeySustainedRequestCount" gorm:"column:max_api_key_sustained_request_count; type:integer; not null; default:0;"`
+	TrashRetentionDays             int32          `json:"trashRetentionDays" gorm:"column:trash_retention_days; type:integer; not null; default:30;"` // the soft deleted items are purged after these days, 0 keeps them forever
 	UpdatedAt                      time.Time      `json:"updatedAt" gorm:"column:updated_at; type:timestamptz; not null; autoUpdateTime:true;"`
 	CreatedAt                      time.Time      `json:"createdAt" gorm:"column:created_at; type:timestamptz; not null; autoCreateTime:true;"`
 }
@@ -0,0 +1,39 @@
+package schemas
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	enums "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/enums"
+)
+
+type RootShelfArchiveJob struct {
+	Id                   uuid.UUID                       `json:"id" gorm:"column:id; type:uuid; primaryKey; default:gen_random_uuid();"`
+	OwnerId              uuid.UUID                       `json:"ownerId" gorm:"column:owner_id; type:uuid; not null; index;"`
+	Type                 enums.RootShelfArchiveJobType   `json:"type" gorm:"column:type; type:\"RootShelfArchiveJobType\"; not null;"`
+	Status               enums.RootShelfArchiveJobStatus `json:"status" gorm:"column:status; type:\"RootShelfArchiveJobStatus\"; not null; default:'Pending'; index:root_shelf_archive_job_idx_status_created_at,priority:1;"`
+	SourceRootShelfId    *uuid.UUID                      `json:"sourceRootShelfId" gorm:"column:source_root_shelf_id; type:uuid; default:null;"` // the exported root shelf, or the root shelf id recorded in the imported manifest
+	TargetRootShelfId    *uuid.UUID                      `json:"targetRootShelfId" gorm:"column:target_root_shelf_id; type:uuid; default:null;"` // the root shelf receiving the imported tree, created by the import if it is null
+	TargetRootShelfName  *string                         `json:"targetRootShelfName" gorm:"column:target_root_shelf_name; size:128; default:null;"`
+	ArchiveKey           string                          `json:"archiveKey" gorm:"column:archive_key; not null; unique;"`
+	ArchiveSize          int64                           `json:"archiveSize" gorm:"column:archive_size; type:bigint; not null; default:0;"`
+	TotalItemCount       int64                           `json:"totalItemCount" gorm:"column:total_item_count; type:bigint; not null; default:0;"`
+	ProcessedItemCount   int64                           `json:"processedItemCount" gorm:"column:processed_item_count; type:bigint; not null; default:0;"`
+	LastNotifiedProgress int32                           `json:"lastNotifiedProgress" gorm:"column:last_notified_progress; type:integer; not null; default:0;"`
+	Attempts             int32                           `json:"attempts" gorm:"column:attempts; type:integer; not null; default:0;"`
+	ErrorMessage         *string                         `json:"errorMessage" gorm:"column:error_message; type:text; default:null;"`
+	ClaimedUntil         *time.Time                      `json:"claimedUntil" gorm:"column:claimed_until; type:timestamptz; default:null;"`
+	StartedAt            *time.Time                      `json:"startedAt" gorm:"column:started_at; type:timestamptz; default:null;"`
+	CompletedAt          *time.Time                      `json:"completedAt" gorm:"column:completed_at; type:timestamptz; default:null;"`
+	UpdatedAt            time.Time                       `json:"updatedAt" gorm:"column:updated_at; type:timestamptz; not null; autoUpdateTime:true;"`
+	CreatedAt            time.Time                       `json:"createdAt" gorm:"column:created_at; type:timestamptz; not null; autoCreateTime:true; index:root_shelf_archive_job_idx_status_created_at,priority:2;"`
+
+	// relations
+	Owner User `json:"owner" gorm:"foreignKey:OwnerId; references:Id; constraint:OnUpdate:CASCADE, OnDelete:CASCADE;"`
+}
+
+// RootShelfArchiveJob Table Name
+func (RootShelfArchiveJob) TableName() string {
+	return "RootShelfArchiveJobTable"
+}
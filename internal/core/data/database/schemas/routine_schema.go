@@ -23,6 +23,7 @@ type Routine struct {
 	ScheduledStartAt time.Time            `json:"scheduledStartAt" gorm:"column:scheduled_start_at; type:timestamptz; not null; default:NOW();"`                 // check: routine_check_scheduled_start_minute_precision and routine_check_scheduled_time_in_period
 	ScheduledEndAt   time.Time            `json:"scheduledEndAt" gorm:"column:scheduled_end_at; type:timestamptz; not null; default:NOW() + INTERVAL '1 hour';"` // check: routine_check_scheduled_end_minute_precision and routine_check_scheduled_time_in_period
 	Period           *enums.RoutinePeriod `json:"period" gorm:"column:period; type:\"RoutinePeriod\"; default:null;"`                                            // check: routine_check_scheduled_time_in_period
+	RecurrenceRule   *string              `json:"recurrenceRule" gorm:"column:recurrence_rule; size:512; default:null;"`                                         // check: routine_check_period_recurrence_rule_exclusive
 	Timezone         string               `json:"timezone" gorm:"column:timezone; size:64; not null; default:'UTC';"`                                            // validate by validation package with time.LoadLocation
 	DeletedAt        *time.Time           `json:"deletedAt" gorm:"column:deleted_at; type:timestamptz; default:null;"`
 	UpdatedAt        time.Time            `json:"updatedAt" gorm:"column:updated_at; type:timestamptz; not null; autoUpdateTime:true;"`
@@ -78,6 +79,7 @@ func (r *Routine) ToPrivateRoutine() *gqlmodels.PrivateRoutine {
 		ScheduledStartAt: r.ScheduledStartAt,
 		ScheduledEndAt:   r.ScheduledEndAt,
 		Period:           (*enumcontract.RoutinePeriod)(r.Period),
+		RecurrenceRule:   r.RecurrenceRule,
 		Timezone:         r.Timezone,
 		DeletedAt:        r.DeletedAt,
 		UpdatedAt:        r.UpdatedAt,
@@ -113,6 +115,7 @@ func (r *Routine) ToPrivateSearchableRoutine() *gqlmodels.PrivateSearchableRouti
 		ScheduledStartAt: r.ScheduledStartAt,
 		ScheduledEndAt:   r.ScheduledEndAt,
 		Period:           (*enumcontract.RoutinePeriod)(r.Period),
+		RecurrenceRule:   r.RecurrenceRule,
 		Timezone:         r.Timezone,
 		DeletedAt:        r.DeletedAt,
 		UpdatedAt:        r.UpdatedAt,
@@ -27,7 +27,9 @@ type RoutineTask struct {
 	Status            enums.RoutineTaskStatus  `json:"status" gorm:"column:status; type:\"RoutineTaskStatus\"; not null; default:'Idle';"`
 	Attempts          int32                    `json:"attempts" gorm:"column:attempts; type:integer; not null; default:0; check:routine_task_check_attempts_non_negative,attempts >= 0;"`
 	MaxAttempts       int32                    `json:"maxAttempts" gorm:"column:max_attempts; type:integer; not null; default:1; check:routine_task_check_max_attempts_non_negative,max_attempts > 0;"`
-	Period            *enums.RoutinePeriod     `json:"period" gorm:"column:period; type:\"RoutinePeriod\"; default:null;"`
+	Period            *enums.RoutinePeriod     `json:"period" gorm:"column:period; type:\"RoutinePeriod\"; default:null;"`                   // check: routine_task_check_period_recurrence_rule_exclusive
+	RecurrenceRule    *string                  `json:"recurrenceRule" gorm:"column:recurrence_rule; size:512; default:null;"`                // check: routine_task_check_period_recurrence_rule_exclusive
+	RecurrenceStartAt *time.Time               `json:"recurrenceStartAt" gorm:"column:recurrence_start_at; type:timestamptz; default:null;"` // the DTSTART of the recurrence rule, which anchors its INTERVAL and COUNT
 	NextScheduledAt   time.Time                `json:"nextScheduledAt" gorm:"column:next_scheduled_at; type:timestamptz; not null; default:NOW();"`
 	ScheduledAt       time.Time                `json:"scheduledAt" gorm:"column:scheduled_at; type:timestamptz; not null; default:NOW();"`
 	ActualStartedAt   *time.Time               `json:"actualStartedAt" gorm:"column:actual_started_at; type:timestamptz; default:null;"`
@@ -72,6 +74,7 @@ func (rt *RoutineTask) ToPrivateRoutineTask() *gqlmodels.PrivateRoutineTask {
 		Attempts:        rt.Attempts,
 		MaxAttempts:     rt.MaxAttempts,
 		Period:          (*enumcontract.RoutinePeriod)(rt.Period),
+		RecurrenceRule:  rt.RecurrenceRule,
 		NextScheduledAt: rt.NextScheduledAt,
 		ScheduledAt:     rt.ScheduledAt,
 		ActualStartedAt: rt.ActualStartedAt,
@@ -0,0 +1,61 @@
+package schemas
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	platformpostgres "github.com/HiIamJeff67/notegic-backend/shared/platform/postgres"
+
+	enums "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/enums"
+)
+
+// ShareLink stores only the digest of the share link token, the clear-text token
+// is returned once at creation time. A share link without a BlockPackId shares
+// the whole root shelf, and the guest session resolved from it acts as its creator.
+type ShareLink struct {
+	Id           uuid.UUID                     `json:"id" gorm:"column:id; type:uuid; primaryKey; default:gen_random_uuid();"`
+	TokenHash    string                        `json:"-" gorm:"column:token_hash; not null; size:64; unique;"`
+	RootShelfId  uuid.UUID                     `json:"rootShelfId" gorm:"column:root_shelf_id; type:uuid; not null; index:share_link_idx_root_shelf_id_created_at,priority:1;"`
+	BlockPackId  *uuid.UUID                    `json:"blockPackId" gorm:"column:block_pack_id; type:uuid; default:null; index;"`
+	Permission   enums.AccessControlPermission `json:"permission" gorm:"column:permission; type:\"AccessControlPermission\"; not null; default:'Read';"` // only Read
+	PasswordHash *string                       `json:"-" gorm:"column:password_hash; default:null;"`
+	MaxUseCount  *int32                        `json:"maxUseCount" gorm:"column:max_use_count; type:integer; default:null;"` // null for unlimited uses
+	UseCount     int32                         `json:"useCount" gorm:"column:use_count; type:integer; not null; default:0;"`
+	ExpiresAt    *time.Time                    `json:"expiresAt" gorm:"column:expires_at; type:timestamptz; default:null;"`
+	RevokedAt    *time.Time                    `json:"revokedAt" gorm:"column:revoked_at; type:timestamptz; default:null;"`
+	LastUsedAt   *time.Time                    `json:"lastUsedAt" gorm:"column:last_used_at; type:timestamptz; default:null;"`
+	CreatorId    uuid.UUID                     `json:"creatorId" gorm:"column:creator_id; type:uuid; not null; index;"`
+	UpdatedAt    time.Time                     `json:"updatedAt" gorm:"column:updated_at; type:timestamptz; not null; autoUpdateTime:true;"`
+	CreatedAt    time.Time                     `json:"createdAt" gorm:"column:created_at; type:timestamptz; not null; autoCreateTime:true; index:share_link_idx_root_shelf_id_created_at,priority:2;"`
+
+	// relations
+	RootShelf RootShelf  `json:"rootShelf" gorm:"foreignKey:RootShelfId; references:Id; constraint:OnUpdate:CASCADE, OnDelete:CASCADE;"`
+	BlockPack *BlockPack `json:"blockPack" gorm:"foreignKey:BlockPackId; references:Id; constraint:OnUpdate:CASCADE, OnDelete:CASCADE;"`
+	Creator   User       `json:"creator" gorm:"foreignKey:CreatorId; references:Id; constraint:OnUpdate:CASCADE, OnDelete:CASCADE;"`
+}
+
+func (ShareLink) TableName() string {
+	return "ShareLinkTable"
+}
+
+type ShareLinkRelation platformpostgres.RelationName
+
+const (
+	ShareLinkRelation_RootShelf ShareLinkRelation = "RootShelf"
+	ShareLinkRelation_BlockPack ShareLinkRelation = "BlockPack"
+	ShareLinkRelation_Creator   ShareLinkRelation = "Creator"
+)
+
+// IsActive reports whether the share link can still be resolved or used by its sessions.
+func (l *ShareLink) IsActive(now time.Time) bool {
+	return l.RevokedAt == nil &&
+		(l.ExpiresAt == nil || l.ExpiresAt.After(now)) &&
+		(l.MaxUseCount == nil || l.UseCount < *l.MaxUseCount)
+}
+
+// IsSessionValid reports whether the share sessions resolved from the share link
+// are still valid, the use count only limits how many times it can be resolved.
+func (l *ShareLink) IsSessionValid(now time.Time) bool {
+	return l.RevokedAt == nil && (l.ExpiresAt == nil || l.ExpiresAt.After(now))
+}
@@ -4,6 +4,7 @@ import (
 	accountingtriggersql "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/triggers/accounting_triggers"
 	blockpackyjstriggersql "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/triggers/block_pack_yjs_triggers"
 	itemprojectiontriggersql "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/triggers/item_projection_triggers"
+	searchprojectiontriggersql "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/triggers/search_projection_triggers"
 	shelfitemcascadingtriggersql "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/triggers/shelf_item_cascading_triggers"
 )
 
@@ -17,6 +18,11 @@ var MigratingTriggerSQLs = []string{
 	itemprojectiontriggersql.ProjectSubShelvesToItemsTriggerSQL,
 	itemprojectiontriggersql.ProjectMaterialsToItemsTriggerSQL,
 	itemprojectiontriggersql.ProjectBlockPacksToItemsTriggerSQL,
+	searchprojectiontriggersql.SearchProjectionFunctionsSQL,
+	searchprojectiontriggersql.ProjectBlocksSearchVectorTriggerSQL,
+	searchprojectiontriggersql.ProjectBlockPacksSearchVectorTriggerSQL,
+	searchprojectiontriggersql.ProjectMaterialsSearchVectorTriggerSQL,
+	searchprojectiontriggersql.ReprojectSearchVectorsAfterLanguageUpdateTriggerSQL,
 	accountingtriggersql.AccountingMutatedBlockPackTriggerSQL,
 	accountingtriggersql.AccountingInsertedBlockTriggerSQL,
 	accountingtriggersql.AccountingDeletedBlockTriggerSQL,
@@ -0,0 +1,33 @@
+CREATE OR REPLACE FUNCTION trigger_function_project_block_pack_search_vector_before_insert_or_update()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.search_vector := setweight(
+        to_tsvector(search_config_of_sub_shelf(NEW.parent_sub_shelf_id), NEW.name),
+        'A'
+    );
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- ============================== SQL Separator ==============================
+
+DROP TRIGGER IF EXISTS trigger_project_block_pack_search_vector_before_insert_or_update ON "BlockPackTable";
+
+-- ============================== SQL Separator ==============================
+
+CREATE TRIGGER trigger_project_block_pack_search_vector_before_insert_or_update
+    BEFORE INSERT OR UPDATE OF name, parent_sub_shelf_id
+    ON "BlockPackTable"
+    FOR EACH ROW
+    EXECUTE FUNCTION trigger_function_project_block_pack_search_vector_before_insert_or_update();
+
+-- ============================== SQL Separator ==============================
+
+-- backfill the block packs created before the search vector is projected
+UPDATE "BlockPackTable"
+SET search_vector = setweight(
+    to_tsvector(search_config_of_sub_shelf(parent_sub_shelf_id), name),
+    'A'
+)
+WHERE search_vector IS NULL;
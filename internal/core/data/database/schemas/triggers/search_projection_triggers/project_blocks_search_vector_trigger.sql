@@ -0,0 +1,39 @@
+CREATE OR REPLACE FUNCTION trigger_function_project_block_search_vector_before_insert_or_update()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.search_vector := to_tsvector(
+        search_config_of_sub_shelf((
+            SELECT block_pack.parent_sub_shelf_id
+            FROM "BlockPackTable" AS block_pack
+            WHERE block_pack.id = NEW.block_pack_id
+        )),
+        block_search_text(NEW.content)
+    );
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- ============================== SQL Separator ==============================
+
+DROP TRIGGER IF EXISTS trigger_project_block_search_vector_before_insert_or_update ON "BlockTable";
+
+-- ============================== SQL Separator ==============================
+
+CREATE TRIGGER trigger_project_block_search_vector_before_insert_or_update
+    BEFORE INSERT OR UPDATE OF content, block_pack_id
+    ON "BlockTable"
+    FOR EACH ROW
+    EXECUTE FUNCTION trigger_function_project_block_search_vector_before_insert_or_update();
+
+-- ============================== SQL Separator ==============================
+
+-- backfill the blocks created before the search vector is projected
+UPDATE "BlockTable" AS block
+SET search_vector = to_tsvector(
+    search_config_of_sub_shelf(block_pack.parent_sub_shelf_id),
+    block_search_text(block.content)
+)
+FROM "BlockPackTable" AS block_pack
+WHERE block_pack.id = block.block_pack_id
+  AND block.search_vector IS NULL;
@@ -0,0 +1,34 @@
+CREATE OR REPLACE FUNCTION trigger_function_project_material_search_vector_before_insert_or_update()
+RETURNS TRIGGER AS $$
+DECLARE
+    search_config regconfig;
+BEGIN
+    search_config := search_config_of_sub_shelf(NEW.parent_sub_shelf_id);
+    NEW.search_vector :=
+        setweight(to_tsvector(search_config, NEW.name), 'A') ||
+        setweight(to_tsvector(search_config, COALESCE(NEW.search_text, '')), 'B');
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- ============================== SQL Separator ==============================
+
+DROP TRIGGER IF EXISTS trigger_project_material_search_vector_before_insert_or_update ON "MaterialTable";
+
+-- ============================== SQL Separator ==============================
+
+CREATE TRIGGER trigger_project_material_search_vector_before_insert_or_update
+    BEFORE INSERT OR UPDATE OF name, search_text, parent_sub_shelf_id
+    ON "MaterialTable"
+    FOR EACH ROW
+    EXECUTE FUNCTION trigger_function_project_material_search_vector_before_insert_or_update();
+
+-- ============================== SQL Separator ==============================
+
+-- backfill the materials created before the search vector is projected
+UPDATE "MaterialTable"
+SET search_vector =
+    setweight(to_tsvector(search_config_of_sub_shelf(parent_sub_shelf_id), name), 'A') ||
+    setweight(to_tsvector(search_config_of_sub_shelf(parent_sub_shelf_id), COALESCE(search_text, '')), 'B')
+WHERE search_vector IS NULL;
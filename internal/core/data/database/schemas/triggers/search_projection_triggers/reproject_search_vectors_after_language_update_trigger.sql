@@ -0,0 +1,52 @@
+-- re-project the search vectors of the items owned by the user once the language of the user is changed
+CREATE OR REPLACE FUNCTION trigger_function_reproject_search_vectors_after_language_update()
+RETURNS TRIGGER AS $$
+DECLARE
+    search_config regconfig;
+BEGIN
+    search_config := search_config_of_language(NEW.language);
+
+    UPDATE "BlockPackTable" AS block_pack
+    SET search_vector = setweight(to_tsvector(search_config, block_pack.name), 'A')
+    FROM "SubShelfTable" AS sub_shelf
+    JOIN "RootShelfTable" AS root_shelf
+      ON root_shelf.id = sub_shelf.root_shelf_id
+    WHERE sub_shelf.id = block_pack.parent_sub_shelf_id
+      AND root_shelf.owner_id = NEW.user_id;
+
+    UPDATE "BlockTable" AS block
+    SET search_vector = to_tsvector(search_config, block_search_text(block.content))
+    FROM "BlockPackTable" AS block_pack
+    JOIN "SubShelfTable" AS sub_shelf
+      ON sub_shelf.id = block_pack.parent_sub_shelf_id
+    JOIN "RootShelfTable" AS root_shelf
+      ON root_shelf.id = sub_shelf.root_shelf_id
+    WHERE block_pack.id = block.block_pack_id
+      AND root_shelf.owner_id = NEW.user_id;
+
+    UPDATE "MaterialTable" AS material
+    SET search_vector =
+        setweight(to_tsvector(search_config, material.name), 'A') ||
+        setweight(to_tsvector(search_config, COALESCE(material.search_text, '')), 'B')
+    FROM "SubShelfTable" AS sub_shelf
+    JOIN "RootShelfTable" AS root_shelf
+      ON root_shelf.id = sub_shelf.root_shelf_id
+    WHERE sub_shelf.id = material.parent_sub_shelf_id
+      AND root_shelf.owner_id = NEW.user_id;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- ============================== SQL Separator ==============================
+
+DROP TRIGGER IF EXISTS trigger_reproject_search_vectors_after_language_update ON "UserSettingTable";
+
+-- ============================== SQL Separator ==============================
+
+CREATE TRIGGER trigger_reproject_search_vectors_after_language_update
+    AFTER UPDATE OF language
+    ON "UserSettingTable"
+    FOR EACH ROW
+    WHEN (OLD.language IS DISTINCT FROM NEW.language)
+    EXECUTE FUNCTION trigger_function_reproject_search_vectors_after_language_update();
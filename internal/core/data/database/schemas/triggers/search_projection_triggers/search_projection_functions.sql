@@ -0,0 +1,55 @@
+-- PostgreSQL ships stemming configurations for English only, the other supported languages
+-- (Chinese, Japanese and Korean) fall back to the simple configuration
+CREATE OR REPLACE FUNCTION search_config_of_language(language "Language")
+RETURNS regconfig AS $$
+    SELECT CASE language
+        WHEN 'English'::"Language" THEN 'english'::regconfig
+        ELSE 'simple'::regconfig
+    END;
+$$ LANGUAGE sql IMMUTABLE;
+
+-- ============================== SQL Separator ==============================
+
+CREATE OR REPLACE FUNCTION search_config_of_user(target_user_id UUID)
+RETURNS regconfig AS $$
+    SELECT COALESCE(
+        (
+            SELECT search_config_of_language(user_setting.language)
+            FROM "UserSettingTable" AS user_setting
+            WHERE user_setting.user_id = target_user_id
+        ),
+        'simple'::regconfig
+    );
+$$ LANGUAGE sql STABLE;
+
+-- ============================== SQL Separator ==============================
+
+-- the search vectors of the items are projected in the language of the owner of their root shelf
+CREATE OR REPLACE FUNCTION search_config_of_sub_shelf(target_sub_shelf_id UUID)
+RETURNS regconfig AS $$
+    SELECT COALESCE(
+        (
+            SELECT search_config_of_user(root_shelf.owner_id)
+            FROM "SubShelfTable" AS sub_shelf
+            JOIN "RootShelfTable" AS root_shelf
+              ON root_shelf.id = sub_shelf.root_shelf_id
+            WHERE sub_shelf.id = target_sub_shelf_id
+        ),
+        'simple'::regconfig
+    );
+$$ LANGUAGE sql STABLE;
+
+-- ============================== SQL Separator ==============================
+
+-- extract the plain text of the BlockNote inline contents (including the nested table cells and links),
+-- so that the JSON keys, styles and other markups are not indexed
+CREATE OR REPLACE FUNCTION block_search_text(content JSONB)
+RETURNS TEXT AS $$
+    SELECT COALESCE(string_agg(text_node.value #>> '{}', ' '), '')
+    FROM jsonb_path_query(
+        COALESCE(content, '{}'::jsonb),
+        'strict $.**.text ? (@.type() == "string")',
+        '{}'::jsonb,
+        true
+    ) AS text_node(value);
+$$ LANGUAGE sql IMMUTABLE;
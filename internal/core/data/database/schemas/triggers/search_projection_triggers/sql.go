@@ -0,0 +1,22 @@
+package searchprojectiontriggersql
+
+import (
+	_ "embed"
+)
+
+var (
+	//go:embed search_projection_functions.sql
+	SearchProjectionFunctionsSQL string
+
+	//go:embed project_blocks_search_vector_trigger.sql
+	ProjectBlocksSearchVectorTriggerSQL string
+
+	//go:embed project_block_packs_search_vector_trigger.sql
+	ProjectBlockPacksSearchVectorTriggerSQL string
+
+	//go:embed project_materials_search_vector_trigger.sql
+	ProjectMaterialsSearchVectorTriggerSQL string
+
+	//go:embed reproject_search_vectors_after_language_update_trigger.sql
+	ReprojectSearchVectorsAfterLanguageUpdateTriggerSQL string
+)
@@ -7,6 +7,8 @@ import (
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 
+	enumcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
+
 	enums "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/enums"
 )
 
@@ -21,6 +23,9 @@ type UserAccount struct {
 	PhoneNumber         *string            `json:"phoneNumber" gorm:"column:phone_number; unique;"`               // validate:"omitnil,max=0,max=15,isnumberstring"
 	GoogleCredential    *string            `json:"googleCredential" gorm:"column:google_credential; unique;"`     // validate:"omitnil"
 	DiscordCredential   *string            `json:"discordCredential" gorm:"column:discord_credential; unique;"`   // validate:"omitnil"
+	GithubCredential    *string            `json:"githubCredential" gorm:"column:github_credential; unique;"`     // validate:"omitnil"
+	MetaCredential      *string            `json:"metaCredential" gorm:"column:meta_credential; unique;"`         // validate:"omitnil"
+	OIDCCredential      *string            `json:"oidcCredential" gorm:"column:oidc_credential; unique;"`         // validate:"omitnil"
 	RootShelfCount      int64              `json:"rootShelfCount" gorm:"column:root_shelf_count; type:bigint; not null; default:0;"`
 	BlockPackCount      int64              `json:"blockPackCount" gorm:"column:block_pack_count; type:bigint; not null; default:0;"`
 	BlockCount          int64              `json:"blockCount" gorm:"column:block_count; type:bigint; not null; default:0;"`
@@ -42,6 +47,22 @@ func (UserAccount) TableName() string {
 // note that there's no type like PublicUserAccount,
 // since the userAccount shouldn't be public
 
+// GetOAuthCredential returns the identity of the given OAuth provider bound to the user account,
+// or nil if the provider has not been bound
+func (ua *UserAccount) GetOAuthCredential(provider enumcontract.OAuthProvider) *string {
+	switch provider {
+	case enumcontract.OAuthProvider_Google:
+		return ua.GoogleCredential
+	case enumcontract.OAuthProvider_Github:
+		return ua.GithubCredential
+	case enumcontract.OAuthProvider_Meta:
+		return ua.MetaCredential
+	case enumcontract.OAuthProvider_OIDC:
+		return ua.OIDCCredential
+	}
+	return nil
+}
+
 /* ============================== Trigger Hook ============================== */
 
 func (ua *UserAccount) BeforeCreate(tx *gorm.DB) error {
@@ -0,0 +1,27 @@
+package schemas
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserQuotaWarning records the thresholds of the limitations a user has been warned about,
+// it is written by the quota warning worker once per threshold, limitation, and quota cycle,
+// so a user who keeps staying above a threshold is not warned again until the next cycle
+type UserQuotaWarning struct {
+	Id             uuid.UUID `json:"id" gorm:"column:id; type:uuid; primaryKey; default:gen_random_uuid();"`
+	UserId         uuid.UUID `json:"userId" gorm:"column:user_id; type:uuid; not null; uniqueIndex:user_quota_warning_idx_user_id_limit_key_threshold_cycle,priority:1;"`
+	LimitKey       string    `json:"limitKey" gorm:"column:limit_key; size:32; not null; uniqueIndex:user_quota_warning_idx_user_id_limit_key_threshold_cycle,priority:2;"`
+	Threshold      int32     `json:"threshold" gorm:"column:threshold; type:integer; not null; uniqueIndex:user_quota_warning_idx_user_id_limit_key_threshold_cycle,priority:3;"`
+	CycleStartedAt time.Time `json:"cycleStartedAt" gorm:"column:cycle_started_at; type:timestamptz; not null; uniqueIndex:user_quota_warning_idx_user_id_limit_key_threshold_cycle,priority:4;"`
+	Used           int64     `json:"used" gorm:"column:used; type:bigint; not null;"`
+	Maximum        int64     `json:"maximum" gorm:"column:maximum; type:bigint; not null;"`
+	CreatedAt      time.Time `json:"createdAt" gorm:"column:created_at; type:timestamptz; not null; autoCreateTime:true;"`
+
+	User User `gorm:"foreignKey:UserId; references:Id; constraint:OnUpdate:CASCADE, OnDelete:CASCADE;"`
+}
+
+func (UserQuotaWarning) TableName() string {
+	return "UserQuotaWarningTable"
+}
@@ -21,7 +21,6 @@ type User struct {
 	DisplayName     string           `json:"displayName" gorm:"column:display_name; not null; size:32;"`                                // validate:"required,min=6,max=32,alphaandnum"
 	Email           string           `json:"email" gorm:"column:email; unique; not null;"`                                              // validate:"required,email"
 	Password        string           `json:"password" gorm:"column:password; not null; size:1024;"`                                     // validate:"required,min=8,max=1024"      // since we store the hashed password which is quite long
-	RefreshToken    string           `json:"refreshToken" gorm:"column:refresh_token; not null;"`                                       // validate:"omitnil"
 	LoginCount      int32            `json:"loginCount" gorm:"column:login_count; type:integer; not null; default:0;"`
 	BlockLoginUntil time.Time        `json:"blockLoginUntil" gorm:"column:block_login_until; type:timestamptz; not null;"`
 	UserAgent       string           `json:"userAgent" gorm:"column:user_agent; not null;"`                          // validate:"required,isuseragent"
@@ -37,6 +36,8 @@ type User struct {
 	UserAccount         UserAccount           `json:"userAccount" gorm:"foreignKey:UserId; references:Id; constraint:OnUpdate:CASCADE, OnDelete:CASCADE;"`
 	UserSetting         UserSetting           `json:"userSetting" gorm:"foreignKey:UserId; references:Id; constraint:OnUpdate:CASCADE, OnDelete:CASCADE;"`
 	APIKeys             []APIKey              `json:"apiKeys" gorm:"foreignKey:UserId; references:Id; constraint:OnUpdate:CASCADE, OnDelete:CASCADE;"`
+	UserSessions        []UserSession         `json:"userSessions" gorm:"foreignKey:UserId; references:Id; constraint:OnUpdate:CASCADE, OnDelete:CASCADE;"`
+	UserTwoFactor       UserTwoFactor         `json:"userTwoFactor" gorm:"foreignKey:UserId; references:Id; constraint:OnUpdate:CASCADE, OnDelete:CASCADE;"`
 	Themes              []Theme               `json:"themes" gorm:"foreignKey:AuthorId; references:Id; constraint:OnUpdate:CASCADE, OnDelete:CASCADE;"`
 	UsersToBadges       []UsersToBadges       `json:"usersToBadges" gorm:"foreignKey:UserId; references:Id; constraint:OnUpdate:CASCADE, OnDelete:CASCADE;"`
 	UsersToShelves      []UsersToShelves      `json:"usersToShelves" gorm:"foreignKey:UserId; references:Id; constraint:OnUpdate:CASCADE, OnDelete:CASCADE;"`
@@ -60,6 +61,8 @@ const (
 	UserRelation_UserAccount         UserRelation = "UserAccount"
 	UserRelation_UserSetting         UserRelation = "UserSetting"
 	UserRelation_APIKeys             UserRelation = "APIKeys"
+	UserRelation_UserSessions        UserRelation = "UserSessions"
+	UserRelation_UserTwoFactor       UserRelation = "UserTwoFactor"
 	UserRelation_Themes              UserRelation = "Themes"
 	UserRelation_UsersToBadges       UserRelation = "UsersToBadges"
 	UserRelation_UsersToShelves      UserRelation = "UsersToShelves"
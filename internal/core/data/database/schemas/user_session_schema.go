@@ -0,0 +1,46 @@
+package schemas
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	platformpostgres "github.com/HiIamJeff67/notegic-backend/shared/platform/postgres"
+)
+
+// UserSession is one signed-in device of the user, it only stores the digests of
+// the current and the previous refresh token. The refresh token is rotated on every
+// use, so presenting the previous one again means the token family has leaked.
+type UserSession struct {
+	Id                   uuid.UUID  `json:"id" gorm:"column:id; type:uuid; primaryKey;"`
+	UserId               uuid.UUID  `json:"userId" gorm:"column:user_id; type:uuid; not null; index:user_session_idx_user_id_last_seen_at,priority:1;"`
+	DeviceLabel          string     `json:"deviceLabel" gorm:"column:device_label; not null; size:64;"`
+	UserAgent            string     `json:"userAgent" gorm:"column:user_agent; not null;"`
+	IpAddress            string     `json:"ipAddress" gorm:"column:ip_address; not null; size:45; default:'';"` // the longest textual IPv6 address
+	RefreshTokenHash     string     `json:"-" gorm:"column:refresh_token_hash; not null; size:64; unique;"`
+	PrevRefreshTokenHash *string    `json:"-" gorm:"column:prev_refresh_token_hash; size:64; default:null; index;"`
+	RefreshedAt          *time.Time `json:"refreshedAt" gorm:"column:refreshed_at; type:timestamptz; default:null;"`
+	LastSeenAt           time.Time  `json:"lastSeenAt" gorm:"column:last_seen_at; type:timestamptz; not null; index:user_session_idx_user_id_last_seen_at,priority:2;"`
+	ExpiresAt            time.Time  `json:"expiresAt" gorm:"column:expires_at; type:timestamptz; not null;"`
+	RevokedAt            *time.Time `json:"revokedAt" gorm:"column:revoked_at; type:timestamptz; default:null;"`
+	UpdatedAt            time.Time  `json:"updatedAt" gorm:"column:updated_at; type:timestamptz; not null; autoUpdateTime:true;"`
+	CreatedAt            time.Time  `json:"createdAt" gorm:"column:created_at; type:timestamptz; not null; autoCreateTime:true;"`
+
+	// relations
+	User User `json:"user" gorm:"foreignKey:UserId; references:Id; constraint:OnUpdate:CASCADE, OnDelete:CASCADE;"`
+}
+
+func (UserSession) TableName() string {
+	return "UserSessionTable"
+}
+
+type UserSessionRelation platformpostgres.RelationName
+
+const (
+	UserSessionRelation_User UserSessionRelation = "User"
+)
+
+// IsActive reports whether the session can still be refreshed or used by its access tokens.
+func (s *UserSession) IsActive(now time.Time) bool {
+	return s.RevokedAt == nil && s.ExpiresAt.After(now)
+}
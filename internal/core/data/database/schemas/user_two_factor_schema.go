@@ -0,0 +1,30 @@
+package schemas
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	pg "github.com/lib/pq"
+)
+
+// UserTwoFactor is the TOTP second factor of the user. It is pending until the first code
+// is verified, the secret is sealed since it must be recovered to validate the codes,
+// and only the digests of the unused recovery codes are kept.
+type UserTwoFactor struct {
+	Id                 uuid.UUID      `json:"id" gorm:"column:id; type:uuid; primaryKey; default:gen_random_uuid();"`
+	UserId             uuid.UUID      `json:"userId" gorm:"column:user_id; type:uuid; not null; unique;"`
+	EncryptedSecret    string         `json:"-" gorm:"column:encrypted_secret; not null; size:256;"`
+	RecoveryCodeHashes pg.StringArray `json:"-" gorm:"column:recovery_code_hashes; type:text[]; not null; default:'{}';"`
+	LastUsedStep       int64          `json:"-" gorm:"column:last_used_step; not null; default:0;"` // the TOTP time step of the last accepted code, to reject replays
+	EnabledAt          *time.Time     `json:"enabledAt" gorm:"column:enabled_at; type:timestamptz; default:null;"`
+	UpdatedAt          time.Time      `json:"updatedAt" gorm:"column:updated_at; type:timestamptz; not null; autoUpdateTime:true;"`
+	CreatedAt          time.Time      `json:"createdAt" gorm:"column:created_at; type:timestamptz; not null; autoCreateTime:true;"`
+}
+
+func (UserTwoFactor) TableName() string {
+	return "UserTwoFactorTable"
+}
+
+func (f *UserTwoFactor) IsEnabled() bool {
+	return f.EnabledAt != nil
+}
@@ -0,0 +1,34 @@
+package schemas
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserUsageSnapshot records the usage of a user once per day, it is written by the
+// quota cycle worker from the counters of UserAccount and UserQuota, so the users
+// can see the trends of their usage before they hit the limitations of their plans
+type UserUsageSnapshot struct {
+	Id                      uuid.UUID `json:"id" gorm:"column:id; type:uuid; primaryKey; default:gen_random_uuid();"`
+	UserId                  uuid.UUID `json:"userId" gorm:"column:user_id; type:uuid; not null; uniqueIndex:user_usage_snapshot_idx_user_id_snapshot_date,priority:1;"`
+	SnapshotDate            time.Time `json:"snapshotDate" gorm:"column:snapshot_date; type:date; not null; uniqueIndex:user_usage_snapshot_idx_user_id_snapshot_date,priority:2; index;"`
+	RootShelfCount          int64     `json:"rootShelfCount" gorm:"column:root_shelf_count; type:bigint; not null; default:0;"`
+	BlockPackCount          int64     `json:"blockPackCount" gorm:"column:block_pack_count; type:bigint; not null; default:0;"`
+	BlockCount              int64     `json:"blockCount" gorm:"column:block_count; type:bigint; not null; default:0;"`
+	MaterialCount           int64     `json:"materialCount" gorm:"column:material_count; type:bigint; not null; default:0;"`
+	WorkflowCount           int64     `json:"workflowCount" gorm:"column:workflow_count; type:bigint; not null; default:0;"`
+	AdditionalItemCount     int64     `json:"additionalItemCount" gorm:"column:additional_item_count; type:bigint; not null; default:0;"`
+	StationCount            int64     `json:"stationCount" gorm:"column:station_count; type:bigint; not null; default:0;"`
+	RoutineCount            int64     `json:"routineCount" gorm:"column:routine_count; type:bigint; not null; default:0;"`
+	RoutineTagCount         int64     `json:"routineTagCount" gorm:"column:routine_tag_count; type:bigint; not null; default:0;"`
+	RoutineTaskCostUnitUsed int64     `json:"routineTaskCostUnitUsed" gorm:"column:routine_task_cost_unit_used; type:bigint; not null; default:0;"`
+	UpdatedAt               time.Time `json:"updatedAt" gorm:"column:updated_at; type:timestamptz; not null; autoUpdateTime:true;"`
+	CreatedAt               time.Time `json:"createdAt" gorm:"column:created_at; type:timestamptz; not null; autoCreateTime:true;"`
+
+	User User `gorm:"foreignKey:UserId; references:Id; constraint:OnUpdate:CASCADE, OnDelete:CASCADE;"`
+}
+
+func (UserUsageSnapshot) TableName() string {
+	return "UserUsageSnapshotTable"
+}
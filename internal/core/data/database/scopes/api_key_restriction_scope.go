@@ -0,0 +1,34 @@
+package scopes
+
+import (
+	"gorm.io/gorm"
+
+	contexts "github.com/HiIamJeff67/notegic-backend/internal/core/contexts"
+)
+
+// RestrictRootShelvesOfAPIKey limits a query to the root shelves the API key of
+// the request is restricted to. The restriction is read from the statement
+// context, so it is a no-op for requests that are not authenticated by a key.
+func RestrictRootShelvesOfAPIKey(rootShelfIdColumn string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		restriction := contexts.GetOptionalAPIKeyRestriction(db.Statement.Context)
+		if restriction == nil || len(restriction.RootShelfIds) == 0 {
+			return db
+		}
+
+		return db.Where(rootShelfIdColumn+" IN ?", restriction.RootShelfIds)
+	}
+}
+
+// RestrictStationsOfAPIKey limits a query to the stations the API key of the
+// request is restricted to, in the same way as RestrictRootShelvesOfAPIKey.
+func RestrictStationsOfAPIKey(stationIdColumn string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		restriction := contexts.GetOptionalAPIKeyRestriction(db.Statement.Context)
+		if restriction == nil || len(restriction.StationIds) == 0 {
+			return db
+		}
+
+		return db.Where(stationIdColumn+" IN ?", restriction.StationIds)
+	}
+}
@@ -36,6 +36,9 @@ func (sc *BlockPackScope) PassPermissionCheck(id uuid.UUID, userId uuid.UUID, pe
 			Joins("INNER JOIN \"SubShelfTable\" ss ON ss.root_shelf_id = \"UsersToShelvesTable\".root_shelf_id").
 			Where("ss.id = \"BlockPackTable\".parent_sub_shelf_id").
 			Where("user_id = ? AND permission IN ?", userId, permissions)
+		subQuery = RestrictRootShelvesOfAPIKey(`"UsersToShelvesTable".root_shelf_id`)(subQuery)
+		subQuery = RestrictRootShelvesOfShareLink(`"UsersToShelvesTable".root_shelf_id`)(subQuery)
+		subQuery = RestrictBlockPacksOfShareLink(`"BlockPackTable".id`)(subQuery)
 		return db.Where("\"BlockPackTable\".id = ? AND EXISTS (?)", id, subQuery)
 	}
 }
@@ -53,6 +56,9 @@ func (sc *BlockPackScope) PassPermissionChecks(ids []uuid.UUID, userId uuid.UUID
 			Joins("INNER JOIN \"SubShelfTable\" ss ON ss.root_shelf_id = \"UsersToShelvesTable\".root_shelf_id").
 			Where("ss.id = \"BlockPackTable\".parent_sub_shelf_id").
 			Where("user_id = ? AND permission IN ?", userId, permissions)
+		subQuery = RestrictRootShelvesOfAPIKey(`"UsersToShelvesTable".root_shelf_id`)(subQuery)
+		subQuery = RestrictRootShelvesOfShareLink(`"UsersToShelvesTable".root_shelf_id`)(subQuery)
+		subQuery = RestrictBlockPacksOfShareLink(`"BlockPackTable".id`)(subQuery)
 		return db.Where("\"BlockPackTable\".id IN ? AND EXISTS (?)", ids, subQuery)
 	}
 }
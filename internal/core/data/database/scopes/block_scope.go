@@ -35,6 +35,9 @@ func (sc *BlockScope) PassPermissionCheck(id uuid.UUID, userId uuid.UUID, permis
 			Where("bp.id = \"BlockTable\".block_pack_id").
 			Where("bp.deleted_at IS NULL").
 			Where("user_id = ? AND permission IN ?", userId, permissions)
+		subQuery = RestrictRootShelvesOfAPIKey(`"UsersToShelvesTable".root_shelf_id`)(subQuery)
+		subQuery = RestrictRootShelvesOfShareLink(`"UsersToShelvesTable".root_shelf_id`)(subQuery)
+		subQuery = RestrictBlockPacksOfShareLink(`bp.id`)(subQuery)
 		return db.Where("\"BlockTable\".id = ? AND EXISTS (?)", id, subQuery)
 	}
 }
@@ -54,6 +57,9 @@ func (sc *BlockScope) PassPermissionChecks(ids []uuid.UUID, userId uuid.UUID, pe
 			Where("bp.id = \"BlockTable\".block_pack_id").
 			Where("bp.deleted_at IS NULL").
 			Where("user_id = ? AND permission IN ?", userId, permissions)
+		subQuery = RestrictRootShelvesOfAPIKey(`"UsersToShelvesTable".root_shelf_id`)(subQuery)
+		subQuery = RestrictRootShelvesOfShareLink(`"UsersToShelvesTable".root_shelf_id`)(subQuery)
+		subQuery = RestrictBlockPacksOfShareLink(`bp.id`)(subQuery)
 		return db.Where("\"BlockTable\".id IN ? AND EXISTS (?)", ids, subQuery)
 	}
 }
@@ -0,0 +1,58 @@
+package scopes
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	searchcursor "github.com/HiIamJeff67/notegic-backend/shared/lib/searchcursor"
+	searchtext "github.com/HiIamJeff67/notegic-backend/shared/lib/searchtext"
+)
+
+// the query is parsed in both the language of the searching user and the simple configuration,
+// so that the vectors projected in the language of another owner can still be matched by their exact words
+const fullTextSearchQuerySQL = `(websearch_to_tsquery(search_config_of_user(?), ?) || websearch_to_tsquery('simple'::regconfig, ?))`
+
+type FullTextSearchResult struct {
+	Id        uuid.UUID `gorm:"column:id;"`
+	Rank      float64   `gorm:"column:rank;"`
+	Highlight string    `gorm:"column:highlight;"`
+}
+
+// MatchFullTextSearch filters the rows of the table whose search_vector matches the query
+func MatchFullTextSearch(tableName string, query string, userId uuid.UUID) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(
+			fmt.Sprintf(`%q.search_vector @@ %s`, tableName, fullTextSearchQuerySQL),
+			userId, query, query,
+		)
+	}
+}
+
+// FullTextSearchRankKey builds the keyset key of the relevance of the rows of the table to the query
+func FullTextSearchRankKey(tableName string, query string, userId uuid.UUID) searchcursor.KeysetKey {
+	return searchcursor.KeysetKey{
+		Column: fmt.Sprintf(`ts_rank(%q.search_vector, %s)`, tableName, fullTextSearchQuerySQL),
+		Type:   "real",
+		Vars:   []any{userId, query, query},
+	}
+}
+
+// SelectFullTextSearchResults selects the relevance and the highlighted snippet of the rows of the table,
+// where the snippet is built from the text returned by the textSQL expression,
+// and it should only be applied to the rows of the current page since ts_headline is expensive
+func SelectFullTextSearchResults(tableName string, textSQL string, query string, userId uuid.UUID) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Select(
+			fmt.Sprintf(
+				`%q.id AS id, ts_rank(%q.search_vector, %s) AS rank, ts_headline(search_config_of_user(?), %s, %s, ?) AS highlight`,
+				tableName, tableName, fullTextSearchQuerySQL, textSQL, fullTextSearchQuerySQL,
+			),
+			userId, query, query,
+			userId,
+			userId, query, query,
+			searchtext.HighlightOptions,
+		)
+	}
+}
@@ -34,6 +34,8 @@ func (sc *ItemScope) PassPermissionCheck(id uuid.UUID, itemType enums.ItemType,
 			Model(&schemas.UsersToShelves{}).
 			Select("1").
 			Where("root_shelf_id = \"ItemTable\".root_shelf_id AND user_id = ? AND permission IN ?", userId, permissions)
+		subQuery = RestrictRootShelvesOfAPIKey(`"UsersToShelvesTable".root_shelf_id`)(subQuery)
+		subQuery = RestrictRootShelvesOfShareLink(`"UsersToShelvesTable".root_shelf_id`)(subQuery)
 		return db.Where("\"ItemTable\".id = ? AND \"ItemTable\".type = ? AND EXISTS (?)", id, itemType, subQuery)
 	}
 }
@@ -53,6 +55,8 @@ func (sc *ItemScope) PassPermissionChecks(itemIdentities []types.Pair[uuid.UUID,
 			Model(&schemas.UsersToShelves{}).
 			Select("1").
 			Where("root_shelf_id = \"ItemTable\".root_shelf_id AND user_id = ? AND permission IN ?", userId, permissions)
+		subQuery = RestrictRootShelvesOfAPIKey(`"UsersToShelvesTable".root_shelf_id`)(subQuery)
+		subQuery = RestrictRootShelvesOfShareLink(`"UsersToShelvesTable".root_shelf_id`)(subQuery)
 		return db.Where("(\"ItemTable\".id, \"ItemTable\".type) IN ? AND EXISTS (?)", values, subQuery)
 	}
 }
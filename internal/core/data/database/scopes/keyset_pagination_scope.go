@@ -0,0 +1,38 @@
+package scopes
+
+import (
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	searchcursor "github.com/HiIamJeff67/notegic-backend/shared/lib/searchcursor"
+)
+
+// PaginateByKeyset filters the rows strictly between the after cursor and the before cursor (if any) and orders them by the keyset,
+// where the order is reversed if isBackward is true so that the rows closest to the before cursor can be fetched first
+func PaginateByKeyset(keyset *searchcursor.Keyset, afterCursor *searchcursor.KeysetCursor, beforeCursor *searchcursor.KeysetCursor, isBackward bool) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if afterCursor != nil {
+			sql, args := keyset.Where(afterCursor, false)
+			db = db.Where(sql, args...)
+		}
+		if beforeCursor != nil {
+			sql, args := keyset.Where(beforeCursor, true)
+			db = db.Where(sql, args...)
+		}
+
+		// the orders are built into a single expression since gorm drops the expression orders once they are merged with other orders
+		orders := keyset.OrderBy(isBackward)
+		orderSQLs := make([]string, len(orders))
+		var orderVars []any
+		for index, order := range orders {
+			orderSQLs[index] = order.SQL
+			orderVars = append(orderVars, order.Vars...)
+		}
+
+		return db.Order(clause.OrderBy{
+			Expression: clause.Expr{SQL: strings.Join(orderSQLs, ", "), Vars: orderVars},
+		})
+	}
+}
@@ -36,6 +36,8 @@ func (sc *MaterialScope) PassPermissionCheck(id uuid.UUID, userId uuid.UUID, per
 			Joins("INNER JOIN \"SubShelfTable\" ss ON ss.root_shelf_id = \"UsersToShelvesTable\".root_shelf_id").
 			Where("ss.id = \"MaterialTable\".parent_sub_shelf_id").
 			Where("user_id = ? AND permission IN ?", userId, permissions)
+		subQuery = RestrictRootShelvesOfAPIKey(`"UsersToShelvesTable".root_shelf_id`)(subQuery)
+		subQuery = RestrictRootShelvesOfShareLink(`"UsersToShelvesTable".root_shelf_id`)(subQuery)
 		return db.Where("\"MaterialTable\".id = ? AND EXISTS (?)", id, subQuery)
 	}
 }
@@ -53,6 +55,8 @@ func (sc *MaterialScope) PassPermissionChecks(ids []uuid.UUID, userId uuid.UUID,
 			Joins("INNER JOIN \"SubShelfTable\" ss ON ss.root_shelf_id = \"UsersToShelvesTable\".root_shelf_id").
 			Where("ss.id = \"MaterialTable\".parent_sub_shelf_id").
 			Where("user_id = ? AND permission IN ?", userId, permissions)
+		subQuery = RestrictRootShelvesOfAPIKey(`"UsersToShelvesTable".root_shelf_id`)(subQuery)
+		subQuery = RestrictRootShelvesOfShareLink(`"UsersToShelvesTable".root_shelf_id`)(subQuery)
 		return db.Where("\"MaterialTable\".id IN ? AND EXISTS (?)", ids, subQuery)
 	}
 }
@@ -34,6 +34,8 @@ func (sc *RootShelfScope) PassPermissionCheck(id uuid.UUID, userId uuid.UUID, pe
 			Model(&schemas.UsersToShelves{}).
 			Select("1").
 			Where("root_shelf_id = \"RootShelfTable\".id AND user_id = ? AND permission IN ?", userId, permissions)
+		subQuery = RestrictRootShelvesOfAPIKey(`"UsersToShelvesTable".root_shelf_id`)(subQuery)
+		subQuery = RestrictRootShelvesOfShareLink(`"UsersToShelvesTable".root_shelf_id`)(subQuery)
 		return db.Where("id = ? AND EXISTS (?)", id, subQuery)
 	}
 }
@@ -49,6 +51,8 @@ func (sc *RootShelfScope) PassPermissionChecks(ids []uuid.UUID, userId uuid.UUID
 			Model(&schemas.UsersToShelves{}).
 			Select("1").
 			Where("root_shelf_id = \"RootShelfTable\".id AND user_id = ? AND permission IN ?", userId, permissions)
+		subQuery = RestrictRootShelvesOfAPIKey(`"UsersToShelvesTable".root_shelf_id`)(subQuery)
+		subQuery = RestrictRootShelvesOfShareLink(`"UsersToShelvesTable".root_shelf_id`)(subQuery)
 		return db.Where("id IN ? AND EXISTS (?)", ids, subQuery)
 	}
 }
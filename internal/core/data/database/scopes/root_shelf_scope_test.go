@@ -1,6 +1,7 @@
 package scopes
 
 import (
+	"context"
 	"strings"
 	"testing"
 
@@ -8,6 +9,7 @@ import (
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 
+	contexts "github.com/HiIamJeff67/notegic-backend/internal/core/contexts"
 	schemas "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas"
 	enums "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/enums"
 )
@@ -87,4 +89,45 @@ func TestRootShelfPermissionPolicy(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("api key restriction limits the permission filter", func(t *testing.T) {
+		ctx := contexts.WithAPIKeyRestriction(context.Background(), contexts.APIKeyRestriction{
+			RootShelfIds: []uuid.UUID{rootShelfId},
+		})
+
+		var rootShelves []schemas.RootShelf
+		result := db.WithContext(ctx).
+			Scopes(scope.PassPermissionCheck(rootShelfId, userId, []enums.AccessControlPermission{
+				enums.AccessControlPermission_Read,
+			})).
+			Find(&rootShelves)
+
+		if !strings.Contains(result.Statement.SQL.String(), `"UsersToShelvesTable".root_shelf_id IN`) {
+			t.Fatalf("expected the API key restriction in the permission filter: %s", result.Statement.SQL.String())
+		}
+
+		subQuery := db.WithContext(ctx).
+			Model(&schemas.UsersToShelves{}).
+			Select("1").
+			Where(`root_shelf_id = "RootShelfTable".id`).
+			Scopes(RestrictRootShelvesOfAPIKey(`"UsersToShelvesTable".root_shelf_id`))
+		result = db.WithContext(ctx).Where("EXISTS (?)", subQuery).Find(&rootShelves)
+
+		if !strings.Contains(result.Statement.SQL.String(), `"UsersToShelvesTable".root_shelf_id IN`) {
+			t.Fatalf("expected the API key restriction in a scoped subquery: %s", result.Statement.SQL.String())
+		}
+	})
+
+	t.Run("missing api key restriction keeps the permission filter", func(t *testing.T) {
+		var rootShelves []schemas.RootShelf
+		result := db.
+			Scopes(scope.PassPermissionCheck(rootShelfId, userId, []enums.AccessControlPermission{
+				enums.AccessControlPermission_Read,
+			})).
+			Find(&rootShelves)
+
+		if strings.Contains(result.Statement.SQL.String(), `"UsersToShelvesTable".root_shelf_id IN`) {
+			t.Fatal("expected requests without an API key restriction to skip it")
+		}
+	})
 }
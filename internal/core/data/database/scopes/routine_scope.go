@@ -34,6 +34,7 @@ func (sc *RoutineScope) PassPermissionCheck(id uuid.UUID, userId uuid.UUID, perm
 			Model(&schemas.UsersToStations{}).
 			Select("1").
 			Where("station_id = \"RoutineTable\".station_id AND user_id = ? AND permission IN ?", userId, permissions)
+		subQuery = RestrictStationsOfAPIKey(`"UsersToStationsTable".station_id`)(subQuery)
 		return db.Where("\"RoutineTable\".id = ? AND EXISTS (?)", id, subQuery)
 	}
 }
@@ -49,6 +50,7 @@ func (sc *RoutineScope) PassPermissionChecks(ids []uuid.UUID, userId uuid.UUID,
 			Model(&schemas.UsersToStations{}).
 			Select("1").
 			Where("station_id = \"RoutineTable\".station_id AND user_id = ? AND permission IN ?", userId, permissions)
+		subQuery = RestrictStationsOfAPIKey(`"UsersToStationsTable".station_id`)(subQuery)
 		return db.Where("\"RoutineTable\".id IN ? AND EXISTS (?)", ids, subQuery)
 	}
 }
@@ -37,6 +37,7 @@ func (sc *RoutineTaskRecordScope) PassPermissionCheck(
 			Joins(`INNER JOIN "UsersToStationsTable" uts ON uts.station_id = routine.station_id`).
 			Where(`"RoutineTaskTable".id = "RoutineTaskRecordTable".routine_task_id`).
 			Where("uts.user_id = ? AND uts.permission IN ?", userId, permissions)
+		subQuery = RestrictStationsOfAPIKey(`uts.station_id`)(subQuery)
 		return db.Where(`"RoutineTaskRecordTable".id = ? AND EXISTS (?)`, id, subQuery)
 	}
 }
@@ -58,6 +59,7 @@ func (sc *RoutineTaskRecordScope) PassPermissionChecks(
 			Joins(`INNER JOIN "UsersToStationsTable" uts ON uts.station_id = routine.station_id`).
 			Where(`"RoutineTaskTable".id = "RoutineTaskRecordTable".routine_task_id`).
 			Where("uts.user_id = ? AND uts.permission IN ?", userId, permissions)
+		subQuery = RestrictStationsOfAPIKey(`uts.station_id`)(subQuery)
 		return db.Where(`"RoutineTaskRecordTable".id IN ? AND EXISTS (?)`, ids, subQuery)
 	}
 }
@@ -34,6 +34,7 @@ func (sc *RoutineTaskScope) PassPermissionCheck(id uuid.UUID, userId uuid.UUID,
 			Where(`routine.id = "RoutineTaskTable".routine_id`).
 			Where(`routine.deleted_at IS NULL`).
 			Where("uts.user_id = ? AND uts.permission IN ?", userId, permissions)
+		subQuery = RestrictStationsOfAPIKey(`uts.station_id`)(subQuery)
 		return db.Where("\"RoutineTaskTable\".id = ? AND EXISTS (?)", id, subQuery)
 	}
 }
@@ -52,6 +53,7 @@ func (sc *RoutineTaskScope) PassPermissionChecks(ids []uuid.UUID, userId uuid.UU
 			Where(`routine.id = "RoutineTaskTable".routine_id`).
 			Where(`routine.deleted_at IS NULL`).
 			Where("uts.user_id = ? AND uts.permission IN ?", userId, permissions)
+		subQuery = RestrictStationsOfAPIKey(`uts.station_id`)(subQuery)
 		return db.Where("\"RoutineTaskTable\".id IN ? AND EXISTS (?)", ids, subQuery)
 	}
 }
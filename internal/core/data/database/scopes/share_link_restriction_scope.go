@@ -0,0 +1,33 @@
+package scopes
+
+import (
+	"gorm.io/gorm"
+
+	contexts "github.com/HiIamJeff67/notegic-backend/internal/core/contexts"
+)
+
+// RestrictRootShelvesOfShareLink limits a query to the root shelf of the share
+// link the request is resolved from, it is a no-op for the other requests.
+func RestrictRootShelvesOfShareLink(rootShelfIdColumn string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		restriction := contexts.GetOptionalShareLinkRestriction(db.Statement.Context)
+		if restriction == nil {
+			return db
+		}
+
+		return db.Where(rootShelfIdColumn+" = ?", restriction.RootShelfId)
+	}
+}
+
+// RestrictBlockPacksOfShareLink limits a query to the block pack of the share
+// link the request is resolved from, if the share link only shares a block pack.
+func RestrictBlockPacksOfShareLink(blockPackIdColumn string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		restriction := contexts.GetOptionalShareLinkRestriction(db.Statement.Context)
+		if restriction == nil || restriction.BlockPackId == nil {
+			return db
+		}
+
+		return db.Where(blockPackIdColumn+" = ?", *restriction.BlockPackId)
+	}
+}
@@ -33,6 +33,7 @@ func (ss *StationScope) PassPermissionCheck(id uuid.UUID, userId uuid.UUID, perm
 			Model(&schemas.UsersToStations{}).
 			Select("1").
 			Where("station_id = \"StationTable\".id AND user_id = ? AND permission IN ?", userId, permissions)
+		subQuery = RestrictStationsOfAPIKey(`"UsersToStationsTable".station_id`)(subQuery)
 		return db.Where("id = ? AND EXISTS (?)", id, subQuery)
 	}
 }
@@ -47,6 +48,7 @@ func (ss *StationScope) PassPermissionChecks(ids []uuid.UUID, userId uuid.UUID,
 			Model(&schemas.UsersToStations{}).
 			Select("1").
 			Where("station_id = \"StationTable\".id AND user_id = ? AND permission IN ?", userId, permissions)
+		subQuery = RestrictStationsOfAPIKey(`"UsersToStationsTable".station_id`)(subQuery)
 		return db.Where("\"StationTable\".id IN ? AND EXISTS (?)", ids, subQuery)
 	}
 }
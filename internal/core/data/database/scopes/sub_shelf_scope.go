@@ -34,6 +34,8 @@ func (sc *SubShelfScope) PassPermissionCheck(id uuid.UUID, userId uuid.UUID, per
 			Model(&schemas.UsersToShelves{}).
 			Select("1").
 			Where("root_shelf_id = \"SubShelfTable\".root_shelf_id AND user_id = ? AND permission IN ?", userId, permissions)
+		subQuery = RestrictRootShelvesOfAPIKey(`"UsersToShelvesTable".root_shelf_id`)(subQuery)
+		subQuery = RestrictRootShelvesOfShareLink(`"UsersToShelvesTable".root_shelf_id`)(subQuery)
 		return db.Where("id = ? AND EXISTS (?)", id, subQuery)
 	}
 }
@@ -49,6 +51,8 @@ func (sc *SubShelfScope) PassPermissionChecks(ids []uuid.UUID, userId uuid.UUID,
 			Model(&schemas.UsersToShelves{}).
 			Select("1").
 			Where("root_shelf_id = \"SubShelfTable\".root_shelf_id AND user_id = ? AND permission IN ?", userId, permissions)
+		subQuery = RestrictRootShelvesOfAPIKey(`"UsersToShelvesTable".root_shelf_id`)(subQuery)
+		subQuery = RestrictRootShelvesOfShareLink(`"UsersToShelvesTable".root_shelf_id`)(subQuery)
 		return db.Where("id IN ? AND EXISTS (?)", ids, subQuery)
 	}
 }
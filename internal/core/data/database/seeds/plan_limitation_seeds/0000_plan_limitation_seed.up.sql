@@ -17,14 +17,17 @@ INSERT INTO "PlanLimitationTable" (
     max_routine_task_cost_unit_count,
     max_routine_task_attempts,
     max_realtime_room_subscriber_count,
+    max_api_key_burst_request_count,
+    max_api_key_sustained_request_count,
+    trash_retention_days,
     updated_at,
     created_at
 ) VALUES
-('Free',        10,     20,     1000,   10,     2,      5,      20,     20,     100,    5242880,   10,   5,     20,     100,    3,      5, NOW(), NOW()),
-('Pro',         50,     100,    5000,   50,     10,     50,     100,    100,    200,    20971520,  20,   25,    50,     300,    10,     15, NOW(), NOW()),
-('Premium',     150,    300,    15000,  150,    30,     150,    200,    200,    500,    52428800,  50,   50,    100,    600,    10,     30, NOW(), NOW()),
-('Ultimate',    300,    200,    30000,  300,    60,     300,    500,    500,    1000,   209715200, 100,  100,   300,    1200,   20,     60, NOW(), NOW()),
-('Enterprise',  1000,   2000,   100000, 1000,   100,    1000,   1000,   1000,   1000,   524288000, 200,  200,   500,    6000,   20,    250, NOW(), NOW())
+('Free',        10,     20,     1000,   10,     2,      5,      20,     20,     100,    5242880,   10,   5,     20,     100,    3,      5,   20,   1000, 7,   NOW(), NOW()),
+('Pro',         50,     100,    5000,   50,     10,     50,     100,    100,    200,    20971520,  20,   25,    50,     300,    10,     15,  50,   5000, 30,  NOW(), NOW()),
+('Premium',     150,    300,    15000,  150,    30,     150,    200,    200,    500,    52428800,  50,   50,    100,    600,    10,     30,  100,  15000, 30,  NOW(), NOW()),
+('Ultimate',    300,    200,    30000,  300,    60,     300,    500,    500,    1000,   209715200, 100,  100,   300,    1200,   20,     60,  200,  30000, 60,  NOW(), NOW()),
+('Enterprise',  1000,   2000,   100000, 1000,   100,    1000,   1000,   1000,   1000,   524288000, 200,  200,   500,    6000,   20,    250, 500,  100000, 90,  NOW(), NOW())
 ON CONFLICT (key) DO UPDATE SET
     max_root_shelf_count = EXCLUDED.max_root_shelf_count, 
     max_block_pack_count = EXCLUDED.max_block_pack_count, 
@@ -42,4 +45,7 @@ ON CONFLICT (key) DO UPDATE SET
     max_routine_task_cost_unit_count = EXCLUDED.max_routine_task_cost_unit_count,
     max_routine_task_attempts = EXCLUDED.max_routine_task_attempts,
     max_realtime_room_subscriber_count = EXCLUDED.max_realtime_room_subscriber_count,
+    max_api_key_burst_request_count = EXCLUDED.max_api_key_burst_request_count,
+    max_api_key_sustained_request_count = EXCLUDED.max_api_key_sustained_request_count,
+    trash_retention_days = EXCLUDED.trash_retention_days,
     updated_at = NOW();
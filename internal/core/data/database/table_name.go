@@ -3,11 +3,15 @@ package database
 import platformpostgres "github.com/HiIamJeff67/notegic-backend/shared/platform/postgres"
 
 const (
-	TableName_UserTable        platformpostgres.TableName = "UserTable"
-	TableName_UserAccountTable platformpostgres.TableName = "UserAccountTable"
-	TableName_UserQuotaTable   platformpostgres.TableName = "UserQuotaTable"
-	TableName_UserInfoTable    platformpostgres.TableName = "UserInfoTable"
-	TableName_UserSettingTable platformpostgres.TableName = "UserSettingTable"
+	TableName_UserTable              platformpostgres.TableName = "UserTable"
+	TableName_UserAccountTable       platformpostgres.TableName = "UserAccountTable"
+	TableName_UserQuotaTable         platformpostgres.TableName = "UserQuotaTable"
+	TableName_UserUsageSnapshotTable platformpostgres.TableName = "UserUsageSnapshotTable"
+	TableName_UserQuotaWarningTable  platformpostgres.TableName = "UserQuotaWarningTable"
+	TableName_UserInfoTable          platformpostgres.TableName = "UserInfoTable"
+	TableName_UserSettingTable       platformpostgres.TableName = "UserSettingTable"
+	TableName_UserSessionTable       platformpostgres.TableName = "UserSessionTable"
+	TableName_UserTwoFactorTable     platformpostgres.TableName = "UserTwoFactorTable"
 
 	TableName_BadgeTable         platformpostgres.TableName = "BadgeTable"
 	TableName_UsersToBadgesTable platformpostgres.TableName = "UsersToBadgesTable"
@@ -18,11 +22,15 @@ const (
 	TableName_RootShelfTable            platformpostgres.TableName = "RootShelfTable"
 	TableName_SubShelfTable             platformpostgres.TableName = "SubShelfTable"
 	TableName_MaterialTable             platformpostgres.TableName = "MaterialTable"
+	TableName_MaterialUploadTable       platformpostgres.TableName = "MaterialUploadTable"
 	TableName_BlockPackTable            platformpostgres.TableName = "BlockPackTable"
 	TableName_BlockPackYjsDocumentTable platformpostgres.TableName = "BlockPackYjsDocumentTable"
 	TableName_BlockPackYjsUpdateTable   platformpostgres.TableName = "BlockPackYjsUpdateTable"
+	TableName_BlockPackYjsSnapshotTable platformpostgres.TableName = "BlockPackYjsSnapshotTable"
 	TableName_BlockTable                platformpostgres.TableName = "BlockTable"
 	TableName_ItemTable                 platformpostgres.TableName = "ItemTable"
+	TableName_RootShelfArchiveJobTable  platformpostgres.TableName = "RootShelfArchiveJobTable"
+	TableName_ShareLinkTable            platformpostgres.TableName = "ShareLinkTable"
 
 	TableName_RoutinesToItemsTable   platformpostgres.TableName = "RoutinesToItemsTable"
 	TableName_UsersToStationsTable   platformpostgres.TableName = "UsersToStationsTable"
@@ -41,11 +49,15 @@ const (
 )
 
 var _validTableNames = map[string]platformpostgres.TableName{
-	"UserTable":        TableName_UserTable,
-	"UserAccountTable": TableName_UserAccountTable,
-	"UserQuotaTable":   TableName_UserQuotaTable,
-	"UserInfoTable":    TableName_UserInfoTable,
-	"UserSettingTable": TableName_UserSettingTable,
+	"UserTable":              TableName_UserTable,
+	"UserAccountTable":       TableName_UserAccountTable,
+	"UserQuotaTable":         TableName_UserQuotaTable,
+	"UserUsageSnapshotTable": TableName_UserUsageSnapshotTable,
+	"UserQuotaWarningTable":  TableName_UserQuotaWarningTable,
+	"UserInfoTable":          TableName_UserInfoTable,
+	"UserSettingTable":       TableName_UserSettingTable,
+	"UserSessionTable":       TableName_UserSessionTable,
+	"UserTwoFactorTable":     TableName_UserTwoFactorTable,
 
 	"BadgeTable":         TableName_BadgeTable,
 	"UsersToBadgesTable": TableName_UsersToBadgesTable,
@@ -56,11 +68,15 @@ var _validTableNames = map[string]platformpostgres.TableName{
 	"RootShelfTable":            TableName_RootShelfTable,
 	"SubShelfTable":             TableName_SubShelfTable,
 	"MaterialTable":             TableName_MaterialTable,
+	"MaterialUploadTable":       TableName_MaterialUploadTable,
 	"BlockPackTable":            TableName_BlockPackTable,
 	"BlockPackYjsDocumentTable": TableName_BlockPackYjsDocumentTable,
 	"BlockPackYjsUpdateTable":   TableName_BlockPackYjsUpdateTable,
+	"BlockPackYjsSnapshotTable": TableName_BlockPackYjsSnapshotTable,
 	"BlockTable":                TableName_BlockTable,
 	"ItemTable":                 TableName_ItemTable,
+	"RootShelfArchiveJobTable":  TableName_RootShelfArchiveJobTable,
+	"ShareLinkTable":            TableName_ShareLinkTable,
 
 	"RoutinesToItemsTable":   TableName_RoutinesToItemsTable,
 	"UsersToStationsTable":   TableName_UsersToStationsTable,
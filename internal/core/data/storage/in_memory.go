@@ -9,10 +9,13 @@ import (
 	"io"
 	"net/http"
 	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
+
 	constants "github.com/HiIamJeff67/notegic-backend/shared/constants"
 
 	gatewaycontract "github.com/HiIamJeff67/notegic-backend/contracts/gateway/v1"
@@ -29,14 +32,24 @@ type InMemoryObject struct {
 	ETag           string
 }
 
+type InMemoryMultipartUpload struct {
+	Key         string
+	ContentType string
+	Parts       map[int32][]byte
+	ETags       map[int32]string
+	CreatedAt   time.Time
+}
+
 type inMemoryStorage struct {
 	storageMutex sync.RWMutex
 	data         map[string]*InMemoryObject
+	uploads      map[string]*InMemoryMultipartUpload
 }
 
 func NewInMemoryStorage() StorageInterface {
 	return &inMemoryStorage{
-		data: map[string]*InMemoryObject{},
+		data:    map[string]*InMemoryObject{},
+		uploads: map[string]*InMemoryMultipartUpload{},
 	}
 }
 
@@ -159,3 +172,102 @@ func (s *inMemoryStorage) PresignPutObjectByKey(ctx context.Context, key string,
 func (s *inMemoryStorage) PresignGetObjectByKey(ctx context.Context, key string, option *PresignOptions) (string, error) {
 	return "http://localhost:" + "/" + gatewaycontract.APIDevelopmentBaseURL + "/" + "storage/mock/files/" + key, nil
 }
+
+func (s *inMemoryStorage) CreateMultipartUploadByKey(ctx context.Context, key string, option *PutOptions) (string, error) {
+	upload := &InMemoryMultipartUpload{
+		Key:       key,
+		Parts:     map[int32][]byte{},
+		ETags:     map[int32]string{},
+		CreatedAt: time.Now(),
+	}
+	if option != nil {
+		upload.ContentType = option.ContentType
+	}
+	uploadId := uuid.NewString()
+
+	s.storageMutex.Lock()
+	s.uploads[uploadId] = upload
+	s.storageMutex.Unlock()
+
+	return uploadId, nil
+}
+
+func (s *inMemoryStorage) UploadPartByKey(ctx context.Context, key string, uploadId string, partNumber int32, reader io.Reader) (string, error) {
+	if partNumber <= 0 {
+		return "", fmt.Errorf("part number %d must be positive", partNumber)
+	}
+
+	b, err := io.ReadAll(io.LimitReader(reader, constants.MaxInMemoryStorageFileSize.ToInt64()+1))
+	if err != nil {
+		return "", fmt.Errorf("read part bytes: %w", err)
+	}
+
+	s.storageMutex.Lock()
+	defer s.storageMutex.Unlock()
+	upload, ok := s.uploads[uploadId]
+	if !ok || upload.Key != key {
+		return "", fmt.Errorf("multipart upload %q of object %q not found", uploadId, key)
+	}
+	eTag := s.GenerateETag(b)
+	upload.Parts[partNumber] = b
+	upload.ETags[partNumber] = eTag
+
+	return eTag, nil
+}
+
+// For Testing：return the path of the part upload route of the gateways, which sends the part to UploadPartByKey,
+// the path is relative to the gateway the upload is initiated from, and requires the same authentication
+func (s *inMemoryStorage) PresignUploadPartByKey(ctx context.Context, key string, uploadId string, partNumber int32, option *PresignOptions) (string, error) {
+	return "/" + gatewaycontract.APIDevelopmentBaseURL + "/" + "materials/uploads/" + uploadId + "/parts/" + strconv.Itoa(int(partNumber)), nil
+}
+
+func (s *inMemoryStorage) CompleteMultipartUploadByKey(ctx context.Context, key string, uploadId string, parts []UploadedPart) (*Object, error) {
+	s.storageMutex.Lock()
+	defer s.storageMutex.Unlock()
+	upload, ok := s.uploads[uploadId]
+	if !ok || upload.Key != key {
+		return nil, fmt.Errorf("multipart upload %q of object %q not found", uploadId, key)
+	}
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("multipart upload %q has no parts", uploadId)
+	}
+
+	var buffer bytes.Buffer
+	for index, part := range parts {
+		if index > 0 && part.PartNumber <= parts[index-1].PartNumber {
+			return nil, fmt.Errorf("parts of multipart upload %q must be in ascending order", uploadId)
+		}
+		data, ok := upload.Parts[part.PartNumber]
+		if !ok || upload.ETags[part.PartNumber] != part.ETag {
+			return nil, fmt.Errorf("part %d of multipart upload %q not found", part.PartNumber, uploadId)
+		}
+		buffer.Write(data)
+	}
+
+	object, err := s.NewObject(key, &buffer, int64(buffer.Len()))
+	if err != nil {
+		return nil, err
+	}
+	s.data[key] = &InMemoryObject{
+		Data:           object.Data,
+		ContentType:    object.ContentType,
+		ParseMediaType: object.ParseMediaType,
+		CreatedAt:      object.LastModified,
+		UpdatedAt:      object.LastModified,
+		ETag:           object.ETag,
+	}
+	delete(s.uploads, uploadId)
+
+	return object, nil
+}
+
+func (s *inMemoryStorage) AbortMultipartUploadByKey(ctx context.Context, key string, uploadId string) error {
+	s.storageMutex.Lock()
+	defer s.storageMutex.Unlock()
+	upload, ok := s.uploads[uploadId]
+	if !ok || upload.Key != key {
+		return fmt.Errorf("multipart upload %q of object %q not found", uploadId, key)
+	}
+	delete(s.uploads, uploadId)
+	return nil
+}
@@ -29,6 +29,12 @@ type Object struct {
 	ETag           string
 }
 
+// UploadedPart identifies a part of a multipart upload by the ETag returned when it was uploaded
+type UploadedPart struct {
+	PartNumber int32
+	ETag       string
+}
+
 type StorageInterface interface {
 	ListKeys() []string
 	GetKey(ownerIndicator string, objectIndicator string, salt string) string
@@ -38,4 +44,9 @@ type StorageInterface interface {
 	DeleteObjectByKey(ctx context.Context, key string) error
 	PresignPutObjectByKey(ctx context.Context, key string, option *PresignOptions) (string, error)
 	PresignGetObjectByKey(ctx context.Context, key string, option *PresignOptions) (string, error)
+	CreateMultipartUploadByKey(ctx context.Context, key string, option *PutOptions) (string, error)
+	UploadPartByKey(ctx context.Context, key string, uploadId string, partNumber int32, reader io.Reader) (string, error)
+	PresignUploadPartByKey(ctx context.Context, key string, uploadId string, partNumber int32, option *PresignOptions) (string, error)
+	CompleteMultipartUploadByKey(ctx context.Context, key string, uploadId string, parts []UploadedPart) (*Object, error)
+	AbortMultipartUploadByKey(ctx context.Context, key string, uploadId string) error
 }
@@ -0,0 +1,110 @@
+package apiexceptions
+
+import (
+	"net/http"
+
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+)
+
+type BillingException struct {
+	CoreException
+}
+
+func NewBillingException() BillingException {
+	return BillingException{
+		CoreException: NewCoreException("Billing"),
+	}
+}
+
+func (BillingException) ProviderNotConfigured(method string) *exceptions.Exception {
+	return exceptions.New(
+		"ProviderNotConfigured",
+		"Billing",
+		method,
+		"The billing provider is not configured",
+		http.StatusServiceUnavailable,
+	)
+}
+
+func (BillingException) ProviderUnavailable(method string) *exceptions.Exception {
+	return exceptions.New(
+		"ProviderUnavailable",
+		"Billing",
+		method,
+		"The billing provider is unavailable, please try again later",
+		http.StatusBadGateway,
+		true,
+	)
+}
+
+func (BillingException) ProviderRejected(method string) *exceptions.Exception {
+	return exceptions.New(
+		"ProviderRejected",
+		"Billing",
+		method,
+		"The billing provider rejected the request of the subscription",
+		http.StatusUnprocessableEntity,
+	)
+}
+
+func (BillingException) PlanNotSubscribable() *exceptions.Exception {
+	return exceptions.New(
+		"PlanNotSubscribable",
+		"Billing",
+		"Subscribe",
+		"The billing plan does not exist, is not active, or is free",
+		http.StatusBadRequest,
+	)
+}
+
+func (BillingException) SubscriptionAlreadyExists() *exceptions.Exception {
+	return exceptions.New(
+		"SubscriptionAlreadyExists",
+		"Billing",
+		"Subscribe",
+		"The user already has a subscription, change its plan or cancel it instead",
+		http.StatusConflict,
+	)
+}
+
+func (BillingException) NoActiveSubscription(method string) *exceptions.Exception {
+	return exceptions.New(
+		"NoActiveSubscription",
+		"Billing",
+		method,
+		"The user does not have an active subscription",
+		http.StatusConflict,
+	)
+}
+
+func (BillingException) SamePlan() *exceptions.Exception {
+	return exceptions.New(
+		"SamePlan",
+		"Billing",
+		"ChangePlan",
+		"The subscription is already on the billing plan",
+		http.StatusConflict,
+	)
+}
+
+// InvalidWebhookSignature hides why the verification failed,
+// so a forged delivery learns nothing about the webhook.
+func (BillingException) InvalidWebhookSignature() *exceptions.Exception {
+	return exceptions.New(
+		"InvalidWebhookSignature",
+		"Billing",
+		"HandleWebhook",
+		"The signature of the webhook delivery is invalid",
+		http.StatusUnauthorized,
+	)
+}
+
+func (BillingException) InvalidWebhookEvent() *exceptions.Exception {
+	return exceptions.New(
+		"InvalidWebhookEvent",
+		"Billing",
+		"HandleWebhook",
+		"The webhook event is invalid",
+		http.StatusBadRequest,
+	)
+}
@@ -0,0 +1,11 @@
+package apiexceptions
+
+type BillingInvoiceException struct {
+	CoreException
+}
+
+func NewBillingInvoiceException() BillingInvoiceException {
+	return BillingInvoiceException{
+		CoreException: NewCoreException("BillingInvoice"),
+	}
+}
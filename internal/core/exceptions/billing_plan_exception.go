@@ -0,0 +1,11 @@
+package apiexceptions
+
+type BillingPlanException struct {
+	CoreException
+}
+
+func NewBillingPlanException() BillingPlanException {
+	return BillingPlanException{
+		CoreException: NewCoreException("BillingPlan"),
+	}
+}
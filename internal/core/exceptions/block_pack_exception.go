@@ -29,3 +29,23 @@ func (BlockPackException) NoRootBlockInBlockPack(blockPackId uuid.UUID) *excepti
 		true,
 	)
 }
+
+func (BlockPackException) MaximumBlockCountExceeded(blockCount int, maxBlockCount int32) *exceptions.Exception {
+	return exceptions.New(
+		"MaximumBlockCountExceeded",
+		"BlockPack",
+		"Validate",
+		fmt.Sprintf("The block count of %d exceeds the maximum block count of %d in each block pack", blockCount, maxBlockCount),
+		http.StatusBadRequest,
+	)
+}
+
+func (BlockPackException) BlockTooLarge(blockType string) *exceptions.Exception {
+	return exceptions.New(
+		"BlockTooLarge",
+		"BlockPack",
+		"Validate",
+		fmt.Sprintf("A block of type %s exceeds the maximum block size", blockType),
+		http.StatusBadRequest,
+	)
+}
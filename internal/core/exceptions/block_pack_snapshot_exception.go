@@ -0,0 +1,61 @@
+package apiexceptions
+
+import (
+	"fmt"
+	"net/http"
+
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+)
+
+type BlockPackSnapshotException struct {
+	CoreException
+}
+
+func NewBlockPackSnapshotException() BlockPackSnapshotException {
+	return BlockPackSnapshotException{
+		CoreException: NewCoreException("BlockPackSnapshot"),
+	}
+}
+
+func (BlockPackSnapshotException) MaximumNamedSnapshotCountExceeded(maxCount int) *exceptions.Exception {
+	return exceptions.New(
+		"MaximumNamedSnapshotCountExceeded",
+		"BlockPackSnapshot",
+		"Create",
+		fmt.Sprintf("Each block pack can only keep up to %d named snapshots", maxCount),
+		http.StatusConflict,
+	)
+}
+
+func (BlockPackSnapshotException) FailedToProject() *exceptions.Exception {
+	return exceptions.New(
+		"FailedToProject",
+		"BlockPackSnapshot",
+		"Diff",
+		"Failed to project the block pack snapshots",
+		http.StatusBadGateway,
+		true,
+	)
+}
+
+func (BlockPackSnapshotException) FailedToRestore() *exceptions.Exception {
+	return exceptions.New(
+		"FailedToRestore",
+		"BlockPackSnapshot",
+		"Restore",
+		"Failed to restore the block pack snapshot",
+		http.StatusBadGateway,
+		true,
+	)
+}
+
+func (BlockPackSnapshotException) DocumentChangedDuringRestore() *exceptions.Exception {
+	return exceptions.New(
+		"DocumentChangedDuringRestore",
+		"BlockPackSnapshot",
+		"Restore",
+		"The block pack document has been changed during the restore, please try again",
+		http.StatusConflict,
+		true,
+	)
+}
@@ -0,0 +1,58 @@
+package apiexceptions
+
+import (
+	"fmt"
+	"net/http"
+
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+)
+
+type MaterialUploadException struct {
+	CoreException
+}
+
+func NewMaterialUploadException() MaterialUploadException {
+	return MaterialUploadException{
+		CoreException: NewCoreException("MaterialUpload"),
+	}
+}
+
+func (MaterialUploadException) Expired() *exceptions.Exception {
+	return exceptions.New(
+		"Expired",
+		"MaterialUpload",
+		"Complete",
+		"The upload has expired, please initiate a new one",
+		http.StatusGone,
+	)
+}
+
+func (MaterialUploadException) MaximumSizeExceeded(size int64, maxSize int64) *exceptions.Exception {
+	return exceptions.New(
+		"MaximumSizeExceeded",
+		"MaterialUpload",
+		"Validate",
+		fmt.Sprintf("The material of %d bytes exceeds the limit of %d bytes in your plan", size, maxSize),
+		http.StatusForbidden,
+	)
+}
+
+func (MaterialUploadException) SizeMismatch(declaredSize int64, actualSize int64) *exceptions.Exception {
+	return exceptions.New(
+		"SizeMismatch",
+		"MaterialUpload",
+		"Complete",
+		fmt.Sprintf("The uploaded content has %d bytes, but %d bytes were declared", actualSize, declaredSize),
+		http.StatusUnprocessableEntity,
+	)
+}
+
+func (MaterialUploadException) ContentTypeMismatch(declaredContentType string, actualContentType string) *exceptions.Exception {
+	return exceptions.New(
+		"ContentTypeMismatch",
+		"MaterialUpload",
+		"Complete",
+		fmt.Sprintf("The uploaded content is of %s, but %s was declared", actualContentType, declaredContentType),
+		http.StatusUnprocessableEntity,
+	)
+}
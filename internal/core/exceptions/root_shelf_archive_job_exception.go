@@ -0,0 +1,59 @@
+package apiexceptions
+
+import (
+	"fmt"
+	"net/http"
+
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+)
+
+type RootShelfArchiveJobException struct {
+	CoreException
+}
+
+func NewRootShelfArchiveJobException() RootShelfArchiveJobException {
+	return RootShelfArchiveJobException{
+		CoreException: NewCoreException("RootShelfArchiveJob"),
+	}
+}
+
+func (RootShelfArchiveJobException) ArchiveTooLarge(size int64, maxSize int64) *exceptions.Exception {
+	return exceptions.New(
+		"ArchiveTooLarge",
+		"RootShelfArchiveJob",
+		"Validate",
+		fmt.Sprintf("The archive of %d bytes exceeds the maximum archive size of %d bytes", size, maxSize),
+		http.StatusRequestEntityTooLarge,
+	)
+}
+
+func (RootShelfArchiveJobException) InvalidArchive() *exceptions.Exception {
+	return exceptions.New(
+		"InvalidArchive",
+		"RootShelfArchiveJob",
+		"Validate",
+		"The archive is not a valid root shelf archive",
+		http.StatusBadRequest,
+	)
+}
+
+func (RootShelfArchiveJobException) QuotaExceeded(resource string, count int64, maxCount int64) *exceptions.Exception {
+	return exceptions.New(
+		"QuotaExceeded",
+		"RootShelfArchiveJob",
+		"Import",
+		fmt.Sprintf("Importing the archive requires %d %s, which exceeds the limit of %d in your plan", count, resource, maxCount),
+		http.StatusForbidden,
+	)
+}
+
+func (RootShelfArchiveJobException) MaximumAttemptsExceeded(attempts int32) *exceptions.Exception {
+	return exceptions.New(
+		"MaximumAttemptsExceeded",
+		"RootShelfArchiveJob",
+		"Process",
+		fmt.Sprintf("The archive job has been abandoned after %d attempts", attempts),
+		http.StatusInternalServerError,
+		true,
+	)
+}
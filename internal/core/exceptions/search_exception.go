@@ -46,3 +46,14 @@ func (SearchException) FailedToUnmarshalSearchCursor() *exceptions.Exception {
 		true,
 	)
 }
+
+func (SearchException) FailedToRankFullTextSearch() *exceptions.Exception {
+	return exceptions.New(
+		"FullTextSearchRankFailed",
+		"Search",
+		"FullText",
+		"Failed to rank and highlight the full-text search results",
+		http.StatusInternalServerError,
+		true,
+	)
+}
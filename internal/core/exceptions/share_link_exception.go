@@ -0,0 +1,59 @@
+package apiexceptions
+
+import (
+	"net/http"
+
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+)
+
+type ShareLinkException struct {
+	CoreException
+}
+
+func NewShareLinkException() ShareLinkException {
+	return ShareLinkException{
+		CoreException: NewCoreException("ShareLink"),
+	}
+}
+
+// Inactive hides whether the share link is revoked, expired or used up,
+// so the holder of a stale token learns nothing about the share link.
+func (ShareLinkException) Inactive() *exceptions.Exception {
+	return exceptions.New(
+		"Inactive",
+		"ShareLink",
+		"Resolve",
+		"The share link is invalid, expired, revoked or has been used up",
+		http.StatusGone,
+	)
+}
+
+func (ShareLinkException) PasswordRequired() *exceptions.Exception {
+	return exceptions.New(
+		"PasswordRequired",
+		"ShareLink",
+		"Resolve",
+		"The share link is protected by a password",
+		http.StatusUnauthorized,
+	)
+}
+
+func (ShareLinkException) PasswordIncorrect() *exceptions.Exception {
+	return exceptions.New(
+		"PasswordIncorrect",
+		"ShareLink",
+		"Resolve",
+		"The password of the share link is incorrect",
+		http.StatusUnauthorized,
+	)
+}
+
+func (ShareLinkException) OperationNotAllowed(operation string) *exceptions.Exception {
+	return exceptions.New(
+		"OperationNotAllowed",
+		"ShareLink",
+		"Authorize",
+		"The share link does not allow the operation "+operation,
+		http.StatusForbidden,
+	)
+}
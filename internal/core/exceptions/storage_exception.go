@@ -47,3 +47,45 @@ func (StorageException) FailedToPresignedGetObject(object any) *exceptions.Excep
 		true,
 	)
 }
+
+func (StorageException) FailedToCreateMultipartUpload(key any) *exceptions.Exception {
+	return exceptions.New(
+		"FailedToCreateMultipartUpload",
+		"Storage",
+		"CreateMultipartUpload",
+		fmt.Sprintf("Failed to create multipart upload of object %v", key),
+		http.StatusInternalServerError,
+		true,
+	)
+}
+
+func (StorageException) FailedToPresignUploadPart(key any) *exceptions.Exception {
+	return exceptions.New(
+		"FailedToPresignUploadPart",
+		"Storage",
+		"PresignUploadPart",
+		fmt.Sprintf("Failed to presign upload part of object %v", key),
+		http.StatusInternalServerError,
+		true,
+	)
+}
+
+func (StorageException) FailedToUploadPart(key any) *exceptions.Exception {
+	return exceptions.New(
+		"FailedToUploadPart",
+		"Storage",
+		"UploadPart",
+		fmt.Sprintf("Failed to upload part of object %v", key),
+		http.StatusBadRequest,
+	)
+}
+
+func (StorageException) FailedToCompleteMultipartUpload(key any) *exceptions.Exception {
+	return exceptions.New(
+		"FailedToCompleteMultipartUpload",
+		"Storage",
+		"CompleteMultipartUpload",
+		fmt.Sprintf("Failed to complete multipart upload of object %v", key),
+		http.StatusBadRequest,
+	)
+}
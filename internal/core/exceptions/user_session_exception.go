@@ -0,0 +1,40 @@
+package apiexceptions
+
+import (
+	"net/http"
+
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+)
+
+type UserSessionException struct {
+	CoreException
+}
+
+func NewUserSessionException() UserSessionException {
+	return UserSessionException{
+		CoreException: NewCoreException("UserSession"),
+	}
+}
+
+// Inactive hides whether the session is revoked or expired, the device has to sign in again either way.
+func (UserSessionException) Inactive() *exceptions.Exception {
+	return exceptions.New(
+		"Inactive",
+		"UserSession",
+		"Authenticate",
+		"The session is expired or has been revoked, please sign in again",
+		http.StatusUnauthorized,
+	)
+}
+
+// RefreshTokenReused is raised when a rotated refresh token is presented again,
+// the whole session is revoked since its refresh token family may have leaked.
+func (UserSessionException) RefreshTokenReused() *exceptions.Exception {
+	return exceptions.New(
+		"RefreshTokenReused",
+		"UserSession",
+		"Refresh",
+		"The refresh token has already been used, the session has been revoked",
+		http.StatusUnauthorized,
+	)
+}
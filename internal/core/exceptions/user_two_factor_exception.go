@@ -0,0 +1,59 @@
+package apiexceptions
+
+import (
+	"net/http"
+
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+)
+
+type UserTwoFactorException struct {
+	CoreException
+}
+
+func NewUserTwoFactorException() UserTwoFactorException {
+	return UserTwoFactorException{
+		CoreException: NewCoreException("UserTwoFactor"),
+	}
+}
+
+func (UserTwoFactorException) AlreadyEnabled() *exceptions.Exception {
+	return exceptions.New(
+		"AlreadyEnabled",
+		"UserTwoFactor",
+		"Enroll",
+		"The two-factor authentication has already been enabled, disable it first to enroll again",
+		http.StatusConflict,
+	)
+}
+
+func (UserTwoFactorException) NotEnabled() *exceptions.Exception {
+	return exceptions.New(
+		"NotEnabled",
+		"UserTwoFactor",
+		"Verify",
+		"The two-factor authentication has not been enabled",
+		http.StatusConflict,
+	)
+}
+
+// WrongCode also covers the codes which have already been used,
+// so a replayed code cannot be told apart from a wrong one.
+func (UserTwoFactorException) WrongCode() *exceptions.Exception {
+	return exceptions.New(
+		"WrongCode",
+		"UserTwoFactor",
+		"Verify",
+		"The two-factor authentication code or recovery code does not match",
+		http.StatusUnauthorized,
+	)
+}
+
+func (UserTwoFactorException) InvalidChallenge() *exceptions.Exception {
+	return exceptions.New(
+		"InvalidChallenge",
+		"UserTwoFactor",
+		"Verify",
+		"The two-factor challenge is invalid or expired, please login again",
+		http.StatusUnauthorized,
+	)
+}
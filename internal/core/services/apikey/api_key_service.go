@@ -3,10 +3,12 @@ package apikey
 import (
 	"context"
 	"net/http"
+	"slices"
 	"time"
 
 	validator "github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
+	pg "github.com/lib/pq"
 	"gorm.io/gorm"
 
 	apicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/api-keys"
@@ -57,6 +59,11 @@ func (s *APIKeyService) CreateMyAPIKey(
 	if exception := s.validate(request, "CreateMyAPIKey"); exception != nil {
 		return nil, exception
 	}
+	for _, scope := range request.Body.Scopes {
+		if err := sharedtokens.ValidateAPIKeyScope(scope); err != nil {
+			return nil, exceptions.New("InvalidRequest", "APIKey", "CreateMyAPIKey", "API key scope is invalid", http.StatusBadRequest).WithOrigin(err)
+		}
+	}
 	userId, exception := contexts.GetActorUserId(ctx)
 	if exception != nil {
 		return nil, exception
@@ -69,14 +76,19 @@ func (s *APIKeyService) CreateMyAPIKey(
 	created, exception := s.repository.Create(&schemas.APIKey{
 		Id: uuid.New(), PublicId: uuid.New(), UserId: userId,
 		Name: request.Body.Name, KeyPrefix: keyPrefix, KeyHash: keyHash,
-		ExpiresAt: request.Body.ExpiresAt, CreatedAt: now, UpdatedAt: now,
+		Scopes:       pg.StringArray(slices.Clone(request.Body.Scopes)),
+		RootShelfIds: schemas.NewAPIKeyResourceIds(request.Body.RootShelfIds),
+		StationIds:   schemas.NewAPIKeyResourceIds(request.Body.StationIds),
+		ExpiresAt:    request.Body.ExpiresAt, CreatedAt: now, UpdatedAt: now,
 	}, options.WithDB(s.db.WithContext(ctx)))
 	if exception != nil {
 		return nil, exception
 	}
 	return &apicontract.CreateMyAPIKeyResponseDto{
 		PublicId: created.PublicId.String(), Name: created.Name, KeyPrefix: created.KeyPrefix,
-		Secret: secret, ExpiresAt: created.ExpiresAt, CreatedAt: created.CreatedAt,
+		Secret: secret, Scopes: []string(created.Scopes),
+		RootShelfIds: created.GetRootShelfIds(), StationIds: created.GetStationIds(),
+		ExpiresAt: created.ExpiresAt, CreatedAt: created.CreatedAt,
 	}, nil
 }
 
@@ -99,6 +111,7 @@ func (s *APIKeyService) ListMyAPIKeys(
 	for _, key := range keys {
 		items = append(items, apicontract.APIKeySummary{
 			PublicId: key.PublicId.String(), Name: key.Name, KeyPrefix: key.KeyPrefix,
+			Scopes: []string(key.Scopes), RootShelfIds: key.GetRootShelfIds(), StationIds: key.GetStationIds(),
 			LastUsedAt: key.LastUsedAt, ExpiresAt: key.ExpiresAt, RevokedAt: key.RevokedAt, CreatedAt: key.CreatedAt,
 		})
 	}
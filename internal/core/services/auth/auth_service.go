@@ -3,6 +3,7 @@ package auth
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -16,6 +17,7 @@ import (
 	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
 
+	enumcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
 	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
 	constants "github.com/HiIamJeff67/notegic-backend/shared/constants"
 	stringutil "github.com/HiIamJeff67/notegic-backend/shared/lib/strings"
@@ -52,6 +54,13 @@ type AuthServiceInterface interface {
 	RegisterViaGoogle(ctx context.Context, requestDto *apicontract.RegisterViaGoogleRequestDto) (*apicontract.RegisterViaGoogleResponseDto, *exceptions.Exception)
 	Login(ctx context.Context, requestDto *apicontract.LoginRequestDto) (*apicontract.LoginResponseDto, *exceptions.Exception)
 	LoginViaGoogle(ctx context.Context, requestDto *apicontract.LoginViaGoogleRequestDto) (*apicontract.LoginViaGoogleResponseDto, *exceptions.Exception)
+	GetOAuthAuthorizationURL(ctx context.Context, requestDto *apicontract.GetOAuthAuthorizationURLRequestDto) (*apicontract.GetOAuthAuthorizationURLResponseDto, *exceptions.Exception)
+	RegisterViaGithub(ctx context.Context, requestDto *apicontract.RegisterViaGithubRequestDto) (*apicontract.RegisterViaGithubResponseDto, *exceptions.Exception)
+	LoginViaGithub(ctx context.Context, requestDto *apicontract.LoginViaGithubRequestDto) (*apicontract.LoginViaGithubResponseDto, *exceptions.Exception)
+	RegisterViaMeta(ctx context.Context, requestDto *apicontract.RegisterViaMetaRequestDto) (*apicontract.RegisterViaMetaResponseDto, *exceptions.Exception)
+	LoginViaMeta(ctx context.Context, requestDto *apicontract.LoginViaMetaRequestDto) (*apicontract.LoginViaMetaResponseDto, *exceptions.Exception)
+	RegisterViaOIDC(ctx context.Context, requestDto *apicontract.RegisterViaOIDCRequestDto) (*apicontract.RegisterViaOIDCResponseDto, *exceptions.Exception)
+	LoginViaOIDC(ctx context.Context, requestDto *apicontract.LoginViaOIDCRequestDto) (*apicontract.LoginViaOIDCResponseDto, *exceptions.Exception)
 	Logout(ctx context.Context, requestDto *apicontract.LogoutRequestDto) (*apicontract.LogoutResponseDto, *exceptions.Exception)
 	SendAuthCode(ctx context.Context, requestDto *apicontract.SendAuthCodeRequestDto) (*apicontract.SendAuthCodeResponseDto, *exceptions.Exception)
 	ValidateEmail(ctx context.Context, requestDto *apicontract.ValidateEmailRequestDto) (*apicontract.ValidateEmailResponseDto, *exceptions.Exception)
@@ -59,21 +68,32 @@ type AuthServiceInterface interface {
 	ForgetPassword(ctx context.Context, requestDto *apicontract.ForgetPasswordRequestDto) (*apicontract.ForgetPasswordResponseDto, *exceptions.Exception)
 	ResetMe(ctx context.Context, requestDto *apicontract.ResetMeRequestDto) (*apicontract.ResetMeResponseDto, *exceptions.Exception)
 	DeleteMe(ctx context.Context, requestDto *apicontract.DeleteMeRequestDto) (*apicontract.DeleteMeResponseDto, *exceptions.Exception)
+	GetMySessions(ctx context.Context, requestDto *apicontract.GetMySessionsRequestDto) (*apicontract.GetMySessionsResponseDto, *exceptions.Exception)
+	RevokeMySession(ctx context.Context, requestDto *apicontract.RevokeMySessionRequestDto) (*apicontract.RevokeMySessionResponseDto, *exceptions.Exception)
+	RevokeMyOtherSessions(ctx context.Context, requestDto *apicontract.RevokeMyOtherSessionsRequestDto) (*apicontract.RevokeMyOtherSessionsResponseDto, *exceptions.Exception)
+	GetMyTwoFactor(ctx context.Context, requestDto *apicontract.GetMyTwoFactorRequestDto) (*apicontract.GetMyTwoFactorResponseDto, *exceptions.Exception)
+	EnrollMyTwoFactor(ctx context.Context, requestDto *apicontract.EnrollMyTwoFactorRequestDto) (*apicontract.EnrollMyTwoFactorResponseDto, *exceptions.Exception)
+	EnableMyTwoFactor(ctx context.Context, requestDto *apicontract.EnableMyTwoFactorRequestDto) (*apicontract.EnableMyTwoFactorResponseDto, *exceptions.Exception)
+	DisableMyTwoFactor(ctx context.Context, requestDto *apicontract.DisableMyTwoFactorRequestDto) (*apicontract.DisableMyTwoFactorResponseDto, *exceptions.Exception)
+	RegenerateMyTwoFactorRecoveryCodes(ctx context.Context, requestDto *apicontract.RegenerateMyTwoFactorRecoveryCodesRequestDto) (*apicontract.RegenerateMyTwoFactorRecoveryCodesResponseDto, *exceptions.Exception)
+	VerifyLoginTwoFactor(ctx context.Context, requestDto *apicontract.VerifyLoginTwoFactorRequestDto) (*apicontract.VerifyLoginTwoFactorResponseDto, *exceptions.Exception)
 }
 
 type AuthService struct {
-	validator             *validator.Validate
-	db                    *gorm.DB
-	userRepository        repositories.UserRepositoryInterface
-	userInfoRepository    repositories.UserInfoRepositoryInterface
-	userAccountRepository repositories.UserAccountRepositoryInterface
-	userSettingRepository repositories.UserSettingRepositoryInterface
-	rootShelfRepository   repositories.RootShelfRepositoryInterface
-	outboxRepository      repositories.OutboxEventRepositoryInterface
-	oauthService          OAuthServiceInterface
-	emailClient           emailtransport.ClientInterface
-	userDataCacheClient   *userdata.UserDataCacheClient
-	authCodeGenerator     *authcode.AuthCodeGenerator
+	validator               *validator.Validate
+	db                      *gorm.DB
+	userRepository          repositories.UserRepositoryInterface
+	userInfoRepository      repositories.UserInfoRepositoryInterface
+	userAccountRepository   repositories.UserAccountRepositoryInterface
+	userSettingRepository   repositories.UserSettingRepositoryInterface
+	userSessionRepository   repositories.UserSessionRepositoryInterface
+	userTwoFactorRepository repositories.UserTwoFactorRepositoryInterface
+	rootShelfRepository     repositories.RootShelfRepositoryInterface
+	outboxRepository        repositories.OutboxEventRepositoryInterface
+	oauthService            OAuthServiceInterface
+	emailClient             emailtransport.ClientInterface
+	userDataCacheClient     *userdata.UserDataCacheClient
+	authCodeGenerator       *authcode.AuthCodeGenerator
 }
 
 func NewAuthService(
@@ -83,6 +103,8 @@ func NewAuthService(
 	userInfoRepository repositories.UserInfoRepositoryInterface,
 	userAccountRepository repositories.UserAccountRepositoryInterface,
 	userSettingRepository repositories.UserSettingRepositoryInterface,
+	userSessionRepository repositories.UserSessionRepositoryInterface,
+	userTwoFactorRepository repositories.UserTwoFactorRepositoryInterface,
 	rootShelfRepository repositories.RootShelfRepositoryInterface,
 	outboxRepository repositories.OutboxEventRepositoryInterface,
 	oauthService OAuthServiceInterface,
@@ -99,19 +121,27 @@ func NewAuthService(
 	if outboxRepository == nil {
 		outboxRepository = repositories.NewOutboxEventRepository()
 	}
+	if userSessionRepository == nil {
+		userSessionRepository = repositories.NewUserSessionRepository()
+	}
+	if userTwoFactorRepository == nil {
+		userTwoFactorRepository = repositories.NewUserTwoFactorRepository()
+	}
 	return &AuthService{
-		validator:             validator,
-		db:                    db,
-		userRepository:        userRepository,
-		userInfoRepository:    userInfoRepository,
-		userAccountRepository: userAccountRepository,
-		userSettingRepository: userSettingRepository,
-		rootShelfRepository:   rootShelfRepository,
-		outboxRepository:      outboxRepository,
-		oauthService:          oauthService,
-		emailClient:           emailClient,
-		userDataCacheClient:   userDataCacheClient,
-		authCodeGenerator:     authCodeGenerator,
+		validator:               validator,
+		db:                      db,
+		userRepository:          userRepository,
+		userInfoRepository:      userInfoRepository,
+		userAccountRepository:   userAccountRepository,
+		userSettingRepository:   userSettingRepository,
+		userSessionRepository:   userSessionRepository,
+		userTwoFactorRepository: userTwoFactorRepository,
+		rootShelfRepository:     rootShelfRepository,
+		outboxRepository:        outboxRepository,
+		oauthService:            oauthService,
+		emailClient:             emailClient,
+		userDataCacheClient:     userDataCacheClient,
+		authCodeGenerator:       authCodeGenerator,
 	}
 }
 
@@ -165,13 +195,14 @@ func (s *AuthService) hashPassword(password string) (string, *exceptions.Excepti
 	return string(bytes), nil
 }
 
-func (s *AuthService) generateAccessToken(userPublicId uuid.UUID, name string, email string, userAgent string) (*string, *exceptions.Exception) {
+func (s *AuthService) generateAccessToken(userPublicId uuid.UUID, name string, email string, userAgent string, sessionId uuid.UUID) (*string, *exceptions.Exception) {
 	token, err := sharedtokens.GenerateAccessToken(
 		userPublicId.String(),
 		sharedtokens.AccessTokenClaims{
 			Name:      name,
 			Email:     email,
 			UserAgent: userAgent,
+			SessionId: sessionId.String(),
 		},
 	)
 	if err != nil {
@@ -188,13 +219,14 @@ func (s *AuthService) generateAccessToken(userPublicId uuid.UUID, name string, e
 	return token, nil
 }
 
-func (s *AuthService) generateRefreshToken(userPublicId uuid.UUID, name string, email string, userAgent string) (*string, *exceptions.Exception) {
+func (s *AuthService) generateRefreshToken(userPublicId uuid.UUID, name string, email string, userAgent string, sessionId uuid.UUID) (*string, *exceptions.Exception) {
 	token, err := sharedtokens.GenerateRefreshToken(
 		userPublicId.String(),
 		sharedtokens.RefreshTokenClaims{
 			Name:      name,
 			Email:     email,
 			UserAgent: userAgent,
+			SessionId: sessionId.String(),
 		},
 	)
 	if err != nil {
@@ -211,6 +243,94 @@ func (s *AuthService) generateRefreshToken(userPublicId uuid.UUID, name string,
 	return token, nil
 }
 
+// createUserSession signs the tokens of a new session of the user on the device,
+// and only keeps the digest of the refresh token in the session.
+func (s *AuthService) createUserSession(
+	tx *gorm.DB,
+	user *schemas.User,
+	deviceLabel *string,
+	userAgent string,
+	ipAddress string,
+) (*string, *string, *exceptions.Exception) {
+	sessionId := uuid.New()
+	accessToken, exception := s.generateAccessToken(user.PublicId, user.Name, user.Email, userAgent, sessionId)
+	if exception != nil {
+		return nil, nil, exception
+	}
+	refreshToken, exception := s.generateRefreshToken(user.PublicId, user.Name, user.Email, userAgent, sessionId)
+	if exception != nil {
+		return nil, nil, exception
+	}
+
+	label := s.getDefaultDeviceLabel(userAgent)
+	if deviceLabel != nil && strings.TrimSpace(*deviceLabel) != "" {
+		label = strings.TrimSpace(*deviceLabel)
+	}
+	if _, exception := s.userSessionRepository.CreateOne(
+		user.Id,
+		inputs.CreateUserSessionInput{
+			Id:               sessionId,
+			DeviceLabel:      label,
+			UserAgent:        userAgent,
+			IpAddress:        ipAddress,
+			RefreshTokenHash: sharedtokens.HashRefreshToken(*refreshToken),
+			ExpiresAt:        time.Now().Add(sharedtokens.RefreshTokenExpiresIn),
+		},
+		options.WithTransactionDB(tx),
+	); exception != nil {
+		return nil, nil, exception
+	}
+
+	return accessToken, refreshToken, nil
+}
+
+var deviceLabelBrowsers = []struct{ token, name string }{
+	{"Edg/", "Edge"},
+	{"OPR/", "Opera"},
+	{"Firefox/", "Firefox"},
+	{"Chrome/", "Chrome"},
+	{"Safari/", "Safari"},
+}
+
+var deviceLabelPlatforms = []struct{ token, name string }{
+	{"Android", "Android"},
+	{"iPhone", "iOS"},
+	{"iPad", "iPadOS"},
+	{"Windows", "Windows"},
+	{"Mac OS X", "macOS"},
+	{"Linux", "Linux"},
+}
+
+// getDefaultDeviceLabel names the device from its user agent when the client does not
+// give a device label, e.g. "Chrome on macOS", the order of the lookups matters since
+// most browsers also mention the engines of the others in their user agents.
+func (s *AuthService) getDefaultDeviceLabel(userAgent string) string {
+	browser, platform := "", ""
+	for _, candidate := range deviceLabelBrowsers {
+		if strings.Contains(userAgent, candidate.token) {
+			browser = candidate.name
+			break
+		}
+	}
+	for _, candidate := range deviceLabelPlatforms {
+		if strings.Contains(userAgent, candidate.token) {
+			platform = candidate.name
+			break
+		}
+	}
+
+	switch {
+	case browser != "" && platform != "":
+		return browser + " on " + platform
+	case browser != "":
+		return browser
+	case platform != "":
+		return platform
+	default:
+		return "Unknown device"
+	}
+}
+
 func (s *AuthService) generateCSRFToken() (*string, *exceptions.Exception) {
 	token, err := sharedtokens.GenerateCSRFToken(sharedtokens.CSRFTokenClaims{})
 	if err != nil {
@@ -316,21 +436,12 @@ func (s *AuthService) Register(
 		return nil, exception
 	}
 
-	newAccessToken, exception := s.generateAccessToken(
-		createdUser.PublicId,
-		createUserInput.Name,
-		createUserInput.Email,
-		createUserInput.UserAgent,
-	)
-	if exception != nil {
-		tx.Rollback()
-		return nil, exception
-	}
-	newRefreshToken, exception := s.generateRefreshToken(
-		createdUser.PublicId,
-		createUserInput.Name,
-		createUserInput.Email,
-		createUserInput.UserAgent,
+	newAccessToken, newRefreshToken, exception := s.createUserSession(
+		tx,
+		createdUser,
+		nil,
+		reqDto.Header.UserAgent,
+		reqDto.Header.IpAddress,
 	)
 	if exception != nil {
 		tx.Rollback()
@@ -345,21 +456,7 @@ func (s *AuthService) Register(
 	authCode := s.authCodeGenerator.Generate()
 	authCodeExpiredAt := s.authCodeGenerator.ExpireAt(time.Now())
 
-	newUser, exception := s.userRepository.UpdateOneById(
-		*newUserId,
-		inputs.PartialUpdateUserInput{
-			Values: inputs.UpdateUserInput{
-				RefreshToken: newRefreshToken,
-			},
-			SetNull: nil,
-		},
-		options.WithTransactionDB(tx),
-		options.WithLockingStrength(options.LockingStrengthNoKeyUpdate),
-	)
-	if exception != nil {
-		tx.Rollback()
-		return nil, exception
-	}
+	newUser := createdUser
 
 	_, exception = s.userInfoRepository.CreateOneByUserId(
 		*newUserId,
@@ -454,7 +551,86 @@ func (s *AuthService) RegisterViaGoogle(
 		return nil, apiexceptions.NewAuthException().InvalidDto().WithOrigin(err)
 	}
 
-	userInfo, exception := s.oauthService.GetGoogleUserInfo(ctx, reqDto.Body.AuthorizationCode)
+	return s.registerViaOAuth(
+		ctx,
+		enumcontract.OAuthProvider_Google,
+		reqDto.Body.AuthorizationCode,
+		reqDto.Body.State,
+		reqDto.Header.OAuthStateToken,
+		reqDto.Header.UserAgent,
+		reqDto.Header.IpAddress,
+	)
+}
+
+func (s *AuthService) RegisterViaGithub(
+	ctx context.Context, reqDto *apicontract.RegisterViaGithubRequestDto,
+) (*apicontract.RegisterViaGithubResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(reqDto); err != nil {
+		return nil, apiexceptions.NewAuthException().InvalidDto().WithOrigin(err)
+	}
+
+	response, exception := s.registerViaOAuth(
+		ctx,
+		enumcontract.OAuthProvider_Github,
+		reqDto.Body.AuthorizationCode,
+		reqDto.Body.State,
+		reqDto.Header.OAuthStateToken,
+		reqDto.Header.UserAgent,
+		reqDto.Header.IpAddress,
+	)
+	return (*apicontract.RegisterViaGithubResponseDto)(response), exception
+}
+
+func (s *AuthService) RegisterViaMeta(
+	ctx context.Context, reqDto *apicontract.RegisterViaMetaRequestDto,
+) (*apicontract.RegisterViaMetaResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(reqDto); err != nil {
+		return nil, apiexceptions.NewAuthException().InvalidDto().WithOrigin(err)
+	}
+
+	response, exception := s.registerViaOAuth(
+		ctx,
+		enumcontract.OAuthProvider_Meta,
+		reqDto.Body.AuthorizationCode,
+		reqDto.Body.State,
+		reqDto.Header.OAuthStateToken,
+		reqDto.Header.UserAgent,
+		reqDto.Header.IpAddress,
+	)
+	return (*apicontract.RegisterViaMetaResponseDto)(response), exception
+}
+
+func (s *AuthService) RegisterViaOIDC(
+	ctx context.Context, reqDto *apicontract.RegisterViaOIDCRequestDto,
+) (*apicontract.RegisterViaOIDCResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(reqDto); err != nil {
+		return nil, apiexceptions.NewAuthException().InvalidDto().WithOrigin(err)
+	}
+
+	response, exception := s.registerViaOAuth(
+		ctx,
+		enumcontract.OAuthProvider_OIDC,
+		reqDto.Body.AuthorizationCode,
+		reqDto.Body.State,
+		reqDto.Header.OAuthStateToken,
+		reqDto.Header.UserAgent,
+		reqDto.Header.IpAddress,
+	)
+	return (*apicontract.RegisterViaOIDCResponseDto)(response), exception
+}
+
+// registerViaOAuth creates a new user with the identity of the OAuth provider, or logs in
+// the existing user of the same email if the provider has already been bound to the user.
+func (s *AuthService) registerViaOAuth(
+	ctx context.Context,
+	provider enumcontract.OAuthProvider,
+	authorizationCode string,
+	state string,
+	stateToken string,
+	userAgent string,
+	ipAddress string,
+) (*apicontract.RegisterViaGoogleResponseDto, *exceptions.Exception) {
+	userInfo, exception := s.oauthService.GetUserInfo(ctx, provider, authorizationCode, state, stateToken, userAgent)
 	if exception != nil {
 		return nil, exception
 	}
@@ -467,12 +643,15 @@ func (s *AuthService) RegisterViaGoogle(
 		options.WithLockingStrength(options.LockingStrengthNoKeyUpdate),
 	)
 	if lookupException == nil && existingUser != nil {
-		loginResponse, loginException := s.loginViaGoogleUser(
+		loginResponse, loginException := s.loginViaOAuthUser(
 			ctx,
 			tx,
+			provider,
 			existingUser,
 			userInfo,
-			reqDto.Header.UserAgent,
+			nil,
+			userAgent,
+			ipAddress,
 		)
 		if loginException != nil {
 			return nil, loginException
@@ -487,6 +666,10 @@ func (s *AuthService) RegisterViaGoogle(
 			RefreshToken: loginResponse.RefreshToken,
 			CSRFToken:    loginResponse.CSRFToken,
 			CreatedAt:    loginResponse.CreatedAt,
+
+			TwoFactorRequired:           loginResponse.TwoFactorRequired,
+			TwoFactorChallengeToken:     loginResponse.TwoFactorChallengeToken,
+			TwoFactorChallengeExpiresAt: loginResponse.TwoFactorChallengeExpiresAt,
 		}, nil
 	}
 	if lookupException != nil &&
@@ -534,7 +717,7 @@ func (s *AuthService) RegisterViaGoogle(
 		DisplayName: s.generateRandomFakeDisplayName(), // we generate a default display name for the new user
 		Email:       userInfo.Email,
 		Password:    hashedPassword,
-		UserAgent:   reqDto.Header.UserAgent,
+		UserAgent:   userAgent,
 	}
 	newUserId, exception := s.userRepository.CreateOne(
 		createUserInput,
@@ -555,21 +738,12 @@ func (s *AuthService) RegisterViaGoogle(
 		return nil, exception
 	}
 
-	newAccessToken, exception := s.generateAccessToken(
-		createdUser.PublicId,
-		createUserInput.Name,
-		createUserInput.Email,
-		createUserInput.UserAgent,
-	)
-	if exception != nil {
-		tx.Rollback()
-		return nil, exception
-	}
-	newRefreshToken, exception := s.generateRefreshToken(
-		createdUser.PublicId,
-		createUserInput.Name,
-		createUserInput.Email,
-		createUserInput.UserAgent,
+	newAccessToken, newRefreshToken, exception := s.createUserSession(
+		tx,
+		createdUser,
+		nil,
+		userAgent,
+		ipAddress,
 	)
 	if exception != nil {
 		tx.Rollback()
@@ -584,21 +758,7 @@ func (s *AuthService) RegisterViaGoogle(
 	authCode := s.authCodeGenerator.Generate()
 	authCodeExpiredAt := s.authCodeGenerator.ExpireAt(time.Now())
 
-	newUser, exception := s.userRepository.UpdateOneById(
-		*newUserId,
-		inputs.PartialUpdateUserInput{
-			Values: inputs.UpdateUserInput{
-				RefreshToken: newRefreshToken,
-			},
-			SetNull: nil,
-		},
-		options.WithTransactionDB(tx),
-		options.WithLockingStrength(options.LockingStrengthNoKeyUpdate),
-	)
-	if exception != nil {
-		tx.Rollback()
-		return nil, exception
-	}
+	newUser := createdUser
 
 	_, exception = s.userInfoRepository.CreateOneByUserId(
 		*newUserId,
@@ -611,13 +771,23 @@ func (s *AuthService) RegisterViaGoogle(
 		return nil, exception
 	}
 
+	createUserAccountInput := inputs.CreateUserAccountInput{
+		AuthCode:          authCode,
+		AuthCodeExpiredAt: authCodeExpiredAt,
+	}
+	switch provider {
+	case enumcontract.OAuthProvider_Google:
+		createUserAccountInput.GoogleCredential = &userInfo.Id
+	case enumcontract.OAuthProvider_Github:
+		createUserAccountInput.GithubCredential = &userInfo.Id
+	case enumcontract.OAuthProvider_Meta:
+		createUserAccountInput.MetaCredential = &userInfo.Id
+	case enumcontract.OAuthProvider_OIDC:
+		createUserAccountInput.OIDCCredential = &userInfo.Id
+	}
 	_, exception = s.userAccountRepository.CreateOneByUserId(
 		*newUserId,
-		inputs.CreateUserAccountInput{
-			AuthCode:          authCode,
-			AuthCodeExpiredAt: authCodeExpiredAt,
-			GoogleCredential:  &userInfo.Id,
-		},
+		createUserAccountInput,
 		options.WithTransactionDB(tx),
 		options.WithLockingStrength(options.LockingStrengthNoKeyUpdate),
 	)
@@ -771,7 +941,198 @@ func (s *AuthService) Login(
 		return nil, apiexceptions.NewAuthException().WrongPassword() // login procedure early ends here
 	}
 
-	if user.UserAgent != reqDto.Header.UserAgent {
+	if response, exception := s.challengeTwoFactor(tx, user, reqDto.Body.DeviceLabel, reqDto.Header.UserAgent); exception != nil || response != nil {
+		return response, exception
+	}
+
+	return s.signInUser(ctx, tx, user, reqDto.Body.DeviceLabel, reqDto.Header.UserAgent, reqDto.Header.IpAddress)
+}
+
+func (s *AuthService) LoginViaGoogle(
+	ctx context.Context, reqDto *apicontract.LoginViaGoogleRequestDto,
+) (*apicontract.LoginViaGoogleResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(reqDto); err != nil {
+		return nil, apiexceptions.NewAuthException().InvalidDto().WithOrigin(err)
+	}
+
+	response, exception := s.loginViaOAuth(
+		ctx,
+		enumcontract.OAuthProvider_Google,
+		reqDto.Body.AuthorizationCode,
+		reqDto.Body.State,
+		reqDto.Header.OAuthStateToken,
+		reqDto.Body.DeviceLabel,
+		reqDto.Header.UserAgent,
+		reqDto.Header.IpAddress,
+	)
+	return (*apicontract.LoginViaGoogleResponseDto)(response), exception
+}
+
+func (s *AuthService) LoginViaGithub(
+	ctx context.Context, reqDto *apicontract.LoginViaGithubRequestDto,
+) (*apicontract.LoginViaGithubResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(reqDto); err != nil {
+		return nil, apiexceptions.NewAuthException().InvalidDto().WithOrigin(err)
+	}
+
+	response, exception := s.loginViaOAuth(
+		ctx,
+		enumcontract.OAuthProvider_Github,
+		reqDto.Body.AuthorizationCode,
+		reqDto.Body.State,
+		reqDto.Header.OAuthStateToken,
+		reqDto.Body.DeviceLabel,
+		reqDto.Header.UserAgent,
+		reqDto.Header.IpAddress,
+	)
+	return (*apicontract.LoginViaGithubResponseDto)(response), exception
+}
+
+func (s *AuthService) LoginViaMeta(
+	ctx context.Context, reqDto *apicontract.LoginViaMetaRequestDto,
+) (*apicontract.LoginViaMetaResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(reqDto); err != nil {
+		return nil, apiexceptions.NewAuthException().InvalidDto().WithOrigin(err)
+	}
+
+	response, exception := s.loginViaOAuth(
+		ctx,
+		enumcontract.OAuthProvider_Meta,
+		reqDto.Body.AuthorizationCode,
+		reqDto.Body.State,
+		reqDto.Header.OAuthStateToken,
+		reqDto.Body.DeviceLabel,
+		reqDto.Header.UserAgent,
+		reqDto.Header.IpAddress,
+	)
+	return (*apicontract.LoginViaMetaResponseDto)(response), exception
+}
+
+func (s *AuthService) LoginViaOIDC(
+	ctx context.Context, reqDto *apicontract.LoginViaOIDCRequestDto,
+) (*apicontract.LoginViaOIDCResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(reqDto); err != nil {
+		return nil, apiexceptions.NewAuthException().InvalidDto().WithOrigin(err)
+	}
+
+	response, exception := s.loginViaOAuth(
+		ctx,
+		enumcontract.OAuthProvider_OIDC,
+		reqDto.Body.AuthorizationCode,
+		reqDto.Body.State,
+		reqDto.Header.OAuthStateToken,
+		reqDto.Body.DeviceLabel,
+		reqDto.Header.UserAgent,
+		reqDto.Header.IpAddress,
+	)
+	return (*apicontract.LoginViaOIDCResponseDto)(response), exception
+}
+
+func (s *AuthService) loginViaOAuth(
+	ctx context.Context,
+	provider enumcontract.OAuthProvider,
+	authorizationCode string,
+	state string,
+	stateToken string,
+	deviceLabel *string,
+	userAgent string,
+	ipAddress string,
+) (*apicontract.LoginResponseDto, *exceptions.Exception) {
+	userInfo, exception := s.oauthService.GetUserInfo(ctx, provider, authorizationCode, state, stateToken, userAgent)
+	if exception != nil {
+		return nil, exception
+	}
+
+	tx := s.db.WithContext(ctx).Begin()
+	user, exception := s.userRepository.GetOneByEmail(
+		userInfo.Email,
+		[]schemas.UserRelation{schemas.UserRelation_UserAccount},
+		options.WithTransactionDB(tx),
+		options.WithLockingStrength(options.LockingStrengthNoKeyUpdate),
+	)
+	if exception != nil {
+		tx.Rollback()
+		return nil, exception
+	}
+	if user == nil {
+		tx.Rollback()
+		return nil, apiexceptions.NewAuthException().InvalidDto()
+	}
+
+	return s.loginViaOAuthUser(ctx, tx, provider, user, userInfo, deviceLabel, userAgent, ipAddress)
+}
+
+// loginViaOAuthUser logs in the user only if the identity of the OAuth provider has been bound
+// to the user, a mismatched identity is counted as a failed login like a wrong password.
+func (s *AuthService) loginViaOAuthUser(
+	ctx context.Context,
+	tx *gorm.DB,
+	provider enumcontract.OAuthProvider,
+	user *schemas.User,
+	userInfo *oauthUserInfo,
+	deviceLabel *string,
+	userAgent string,
+	ipAddress string,
+) (*apicontract.LoginResponseDto, *exceptions.Exception) {
+
+	if user.BlockLoginUntil.After(time.Now()) {
+		tx.Rollback()
+		return nil, apiexceptions.NewAuthException().LoginBlockedDueToTryingTooManyTimes(user.BlockLoginUntil)
+	}
+
+	credential := user.UserAccount.GetOAuthCredential(provider)
+	if credential == nil || userInfo.Id != *credential {
+		newLoginCount := user.LoginCount + 1
+		blockLoginUntil, exception := s.getLoginBlockedUntilByLoginCount(newLoginCount)
+		if exception != nil {
+			tx.Rollback()
+			return nil, exception
+		}
+
+		_, exception = s.userRepository.UpdateOneById(
+			user.Id,
+			inputs.PartialUpdateUserInput{
+				Values: inputs.UpdateUserInput{
+					LoginCount:     &newLoginCount,
+					BlockLoginUtil: blockLoginUntil,
+				},
+				SetNull: nil,
+			},
+			options.WithTransactionDB(tx),
+			options.WithLockingStrength(options.LockingStrengthNoKeyUpdate),
+		)
+		if exception != nil {
+			tx.Rollback()
+			return nil, exception
+		}
+
+		if blockLoginUntil != nil {
+			tx.Rollback()
+			return nil, apiexceptions.NewAuthException().LoginBlockedDueToTryingTooManyTimes(*blockLoginUntil)
+		}
+
+		tx.Rollback()
+		return nil, apiexceptions.NewAuthException().WrongPassword() // login via OAuth procedure early ends here
+	}
+
+	if response, exception := s.challengeTwoFactor(tx, user, deviceLabel, userAgent); exception != nil || response != nil {
+		return response, exception
+	}
+
+	return s.signInUser(ctx, tx, user, deviceLabel, userAgent, ipAddress)
+}
+
+// signInUser starts a new session of the user on the device once all the factors of the login
+// have been verified, it resets the failed login count and commits the given transaction.
+func (s *AuthService) signInUser(
+	ctx context.Context,
+	tx *gorm.DB,
+	user *schemas.User,
+	deviceLabel *string,
+	userAgent string,
+	ipAddress string,
+) (*apicontract.LoginResponseDto, *exceptions.Exception) {
+	if user.UserAgent != userAgent {
 		// send a security email to warn the user
 		if exception := s.emailClient.SendSecurityAlertEmail(ctx, emaildto.SendSecurityAlertEmailRequestDto{
 			To:               user.Email,
@@ -786,12 +1147,7 @@ func (s *AuthService) Login(
 		}
 	}
 
-	newAccessToken, exception := s.generateAccessToken(user.PublicId, user.Name, user.Email, user.UserAgent)
-	if exception != nil {
-		tx.Rollback()
-		return nil, exception
-	}
-	newRefreshToken, exception := s.generateRefreshToken(user.PublicId, user.Name, user.Email, user.UserAgent)
+	newAccessToken, newRefreshToken, exception := s.createUserSession(tx, user, deviceLabel, userAgent, ipAddress)
 	if exception != nil {
 		tx.Rollback()
 		return nil, exception
@@ -803,13 +1159,17 @@ func (s *AuthService) Login(
 	}
 
 	// check if the user data cache exists
-	if _, exception := s.userDataCacheClient.Get(user.Name); exception == nil {
+	if userDataCache, exception := s.userDataCacheClient.Get(user.Name); exception == nil {
+		// the CSRF token is shared by all the sessions of the user,
+		// so signing in on another device must not invalidate the existing one
+		if userDataCache.CSRFToken != "" {
+			newCSRFToken = &userDataCache.CSRFToken
+		}
 		// then just update the existing user data cache
 		if exception = s.userDataCacheClient.Update(
 			user.Name,
 			cacheinputs.UpdateUserDataCacheInput{
 				AccessToken: newAccessToken,
-				CSRFToken:   newCSRFToken,
 			},
 		); exception != nil {
 			_ = logs.NotegicLogger.JSON(ctx, slog.LevelError, exception.String(), exception)
@@ -879,16 +1239,15 @@ func (s *AuthService) Login(
 		}
 	}
 
-	// update the refresh token and the status of the user
+	// update the last user agent and the status of the user
 	var zeroLoginCount int32 = 0 // reset the login count if the login procedure is valid
 	updatedUser, exception := s.userRepository.UpdateOneById(
 		user.Id,
 		inputs.PartialUpdateUserInput{
 			Values: inputs.UpdateUserInput{
-				Status:       &user.PrevStatus,
-				RefreshToken: newRefreshToken,
-				UserAgent:    &reqDto.Header.UserAgent,
-				LoginCount:   &zeroLoginCount,
+				Status:     &user.PrevStatus,
+				UserAgent:  &userAgent,
+				LoginCount: &zeroLoginCount,
 			},
 			SetNull: nil,
 		},
@@ -911,253 +1270,57 @@ func (s *AuthService) Login(
 		DisplayName:  user.DisplayName,
 		Email:        user.Email,
 		AccessToken:  *newAccessToken,
-		RefreshToken: updatedUser.RefreshToken,
+		RefreshToken: *newRefreshToken,
 		CSRFToken:    *newCSRFToken,
 		UpdatedAt:    updatedUser.UpdatedAt,
 		CreatedAt:    user.CreatedAt,
 	}, nil
 }
 
-func (s *AuthService) LoginViaGoogle(
-	ctx context.Context, reqDto *apicontract.LoginViaGoogleRequestDto,
-) (*apicontract.LoginViaGoogleResponseDto, *exceptions.Exception) {
+// GetOAuthAuthorizationURL starts the sign in with the given OAuth provider, the returned state and
+// state token are required by the register, login and bind endpoints of every provider.
+func (s *AuthService) GetOAuthAuthorizationURL(
+	ctx context.Context, reqDto *apicontract.GetOAuthAuthorizationURLRequestDto,
+) (*apicontract.GetOAuthAuthorizationURLResponseDto, *exceptions.Exception) {
 	if err := s.validator.Struct(reqDto); err != nil {
 		return nil, apiexceptions.NewAuthException().InvalidDto().WithOrigin(err)
 	}
 
-	userInfo, exception := s.oauthService.GetGoogleUserInfo(ctx, reqDto.Body.AuthorizationCode)
+	authorizationURL, state, stateToken, expiresAt, exception := s.oauthService.GetAuthorizationURL(
+		ctx, reqDto.Body.Provider, reqDto.Header.UserAgent,
+	)
 	if exception != nil {
 		return nil, exception
 	}
 
-	tx := s.db.WithContext(ctx).Begin()
-	user, exception := s.userRepository.GetOneByEmail(
-		userInfo.Email,
-		[]schemas.UserRelation{schemas.UserRelation_UserAccount},
-		options.WithTransactionDB(tx),
-		options.WithLockingStrength(options.LockingStrengthNoKeyUpdate),
-	)
+	return &apicontract.GetOAuthAuthorizationURLResponseDto{
+		Provider:         reqDto.Body.Provider,
+		AuthorizationURL: authorizationURL,
+		State:            state,
+		StateToken:       stateToken,
+		ExpiresAt:        expiresAt,
+	}, nil
+}
+
+func (s *AuthService) Logout(
+	ctx context.Context, reqDto *apicontract.LogoutRequestDto,
+) (*apicontract.LogoutResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(reqDto); err != nil {
+		return nil, apiexceptions.NewAuthException().InvalidDto().WithOrigin(err)
+	}
+	actorUserId, exception := contexts.GetActorUserId(ctx)
 	if exception != nil {
-		tx.Rollback()
 		return nil, exception
 	}
-	if user == nil {
-		tx.Rollback()
-		return nil, apiexceptions.NewAuthException().InvalidDto()
+	actorUserPublicId, exception := contexts.GetActorUserPublicId(ctx)
+	if exception != nil {
+		return nil, exception
 	}
-
-	return s.loginViaGoogleUser(ctx, tx, user, userInfo, reqDto.Header.UserAgent)
-}
-
-func (s *AuthService) loginViaGoogleUser(
-	ctx context.Context,
-	tx *gorm.DB,
-	user *schemas.User,
-	userInfo *googleUserInfo,
-	userAgent string,
-) (*apicontract.LoginViaGoogleResponseDto, *exceptions.Exception) {
-
-	if user.BlockLoginUntil.After(time.Now()) {
-		tx.Rollback()
-		return nil, apiexceptions.NewAuthException().LoginBlockedDueToTryingTooManyTimes(user.BlockLoginUntil)
-	}
-
-	if user.UserAccount.GoogleCredential == nil || userInfo.Id != *user.UserAccount.GoogleCredential {
-		newLoginCount := user.LoginCount + 1
-		blockLoginUntil, exception := s.getLoginBlockedUntilByLoginCount(newLoginCount)
-		if exception != nil {
-			tx.Rollback()
-			return nil, exception
-		}
-
-		_, exception = s.userRepository.UpdateOneById(
-			user.Id,
-			inputs.PartialUpdateUserInput{
-				Values: inputs.UpdateUserInput{
-					LoginCount:     &newLoginCount,
-					BlockLoginUtil: blockLoginUntil,
-				},
-				SetNull: nil,
-			},
-			options.WithTransactionDB(tx),
-			options.WithLockingStrength(options.LockingStrengthNoKeyUpdate),
-		)
-		if exception != nil {
-			tx.Rollback()
-			return nil, exception
-		}
-
-		if blockLoginUntil != nil {
-			tx.Rollback()
-			return nil, apiexceptions.NewAuthException().LoginBlockedDueToTryingTooManyTimes(*blockLoginUntil)
-		}
-
-		tx.Rollback()
-		return nil, apiexceptions.NewAuthException().WrongPassword() // login via google procedure early ends here
-	}
-
-	if user.UserAgent != userAgent {
-		// send a security email to warn the user
-		if exception := s.emailClient.SendSecurityAlertEmail(ctx, emaildto.SendSecurityAlertEmailRequestDto{
-			To:               user.Email,
-			UserName:         user.Name,
-			Status:           user.Status.String(),
-			AlertType:        "Login in Different Place",
-			Reason:           "Your account has a recent login action in other place",
-			TimeOfOccurrence: time.Now(),
-			OtherDetails:     "",
-		}); exception != nil {
-			_ = logs.NotegicLogger.JSON(ctx, slog.LevelError, exception.String(), exception)
-		}
-	}
-
-	newAccessToken, exception := s.generateAccessToken(user.PublicId, user.Name, user.Email, user.UserAgent)
-	if exception != nil {
-		tx.Rollback()
-		return nil, exception
-	}
-	newRefreshToken, exception := s.generateRefreshToken(user.PublicId, user.Name, user.Email, user.UserAgent)
-	if exception != nil {
-		tx.Rollback()
-		return nil, exception
-	}
-	newCSRFToken, exception := s.generateCSRFToken()
-	if exception != nil {
-		tx.Rollback()
-		return nil, exception
-	}
-
-	// check if the user data cache exists
-	if _, exception := s.userDataCacheClient.Get(user.Name); exception == nil {
-		// then just update the existing user data cache
-		if exception = s.userDataCacheClient.Update(
-			user.Name,
-			cacheinputs.UpdateUserDataCacheInput{
-				AccessToken: newAccessToken,
-				CSRFToken:   newCSRFToken,
-			},
-		); exception != nil {
-			_ = logs.NotegicLogger.JSON(ctx, slog.LevelError, exception.String(), exception)
-		}
-	} else { // else if it does not exist
-		// then we have to first get the relative data from different tables
-		// we done this by one custom sql so it's not that slow...
-		// once we have the required data, we set it as the user data cache
-		output := struct {
-			Id          uuid.UUID        `gorm:"id"`
-			PublicId    uuid.UUID        `gorm:"public_id"`
-			Name        string           `gorm:"name"`
-			DisplayName string           `gorm:"display_name"`
-			Email       string           `gorm:"email"`
-			Role        enums.UserRole   `gorm:"role"`
-			Plan        enums.UserPlan   `gorm:"plan"`
-			Status      enums.UserStatus `gorm:"status"`
-			AvatarURL   *string          `gorm:"avatar_url"`
-			CreatedAt   time.Time        `gorm:"created_at"`
-			UpdatedAt   time.Time        `gorm:"updated_at"`
-		}{}
-		err := tx.Raw(usersql.GetUserDataCacheByIdSQL, user.Id).
-			Row().
-			Scan(
-				&output.Id,
-				&output.PublicId,
-				&output.Name,
-				&output.DisplayName,
-				&output.Email,
-				&output.Role,
-				&output.Plan,
-				&output.Status,
-				&output.AvatarURL,
-				&output.CreatedAt,
-				&output.UpdatedAt,
-			)
-		if err != nil {
-			tx.Rollback()
-			return nil, apiexceptions.NewUserException().NotFound().WithOrigin(err)
-		}
-
-		newUserDataCache := userdata.UserDataCache{
-			Id:          user.Id,
-			PublicId:    output.PublicId,
-			Name:        output.Name,
-			DisplayName: output.DisplayName,
-			Email:       output.Email,
-			AccessToken: *newAccessToken,
-			CSRFToken:   *newCSRFToken,
-			Role:        output.Role,
-			Plan:        output.Plan,
-			Status:      output.Status,
-			AvatarURL:   "",
-			CreatedAt:   output.CreatedAt,
-			UpdatedAt:   output.UpdatedAt,
-		}
-		if output.AvatarURL != nil {
-			newUserDataCache.AvatarURL = *output.AvatarURL
-		}
-		exception := s.userDataCacheClient.Set(
-			user.Name,
-			newUserDataCache,
-		)
-		if exception != nil {
-			tx.Rollback()
-			return nil, exception
-		}
-	}
-
-	// update the refresh token and the status of the user
-	var zeroLoginCount int32 = 0 // reset the login count if the login procedure is valid
-	updatedUser, exception := s.userRepository.UpdateOneById(
-		user.Id,
-		inputs.PartialUpdateUserInput{
-			Values: inputs.UpdateUserInput{
-				Status:       &user.PrevStatus,
-				RefreshToken: newRefreshToken,
-				UserAgent:    &userAgent,
-				LoginCount:   &zeroLoginCount,
-			},
-			SetNull: nil,
-		},
-		options.WithTransactionDB(tx),
-		options.WithLockingStrength(options.LockingStrengthNoKeyUpdate),
-	)
-	if exception != nil {
-		tx.Rollback()
-		return nil, exception
-	}
-
-	if err := tx.Commit().Error; err != nil {
-		tx.Rollback()
-		return nil, apiexceptions.NewUserException().FailedToCommitTransaction().WithOrigin(err)
-	}
-
-	return &apicontract.LoginViaGoogleResponseDto{
-		PublicId:     user.PublicId,
-		Name:         user.Name,
-		DisplayName:  user.DisplayName,
-		Email:        user.Email,
-		AccessToken:  *newAccessToken,
-		RefreshToken: updatedUser.RefreshToken,
-		CSRFToken:    *newCSRFToken,
-		UpdatedAt:    updatedUser.UpdatedAt,
-		CreatedAt:    user.CreatedAt,
-	}, nil
-}
-
-func (s *AuthService) Logout(
-	ctx context.Context, reqDto *apicontract.LogoutRequestDto,
-) (*apicontract.LogoutResponseDto, *exceptions.Exception) {
-	if err := s.validator.Struct(reqDto); err != nil {
-		return nil, apiexceptions.NewAuthException().InvalidDto().WithOrigin(err)
-	}
-	actorUserId, exception := contexts.GetActorUserId(ctx)
-	if exception != nil {
-		return nil, exception
-	}
-	actorUserPublicId, exception := contexts.GetActorUserPublicId(ctx)
+	actorUserName, exception := contexts.GetActorUserName(ctx)
 	if exception != nil {
 		return nil, exception
 	}
-	actorUserName, exception := contexts.GetActorUserName(ctx)
+	actorSessionId, exception := contexts.GetActorSessionId(ctx)
 	if exception != nil {
 		return nil, exception
 	}
@@ -1174,14 +1337,44 @@ func (s *AuthService) Logout(
 		).WithOrigin(tx.Error)
 	}
 
+	now := time.Now()
+	if _, exception := s.userSessionRepository.RevokeOneById(
+		actorSessionId,
+		actorUserId,
+		now,
+		options.WithTransactionDB(tx),
+	); exception != nil {
+		tx.Rollback()
+		return nil, exception
+	}
+	// the other devices of the user stay signed in, so the user only goes offline
+	// and loses the realtime connections once its last session is gone
+	remainingSessions, exception := s.userSessionRepository.GetManyActiveByUserId(
+		actorUserId,
+		now,
+		options.WithTransactionDB(tx),
+	)
+	if exception != nil {
+		tx.Rollback()
+		return nil, exception
+	}
+	if len(remainingSessions) > 0 {
+		if err := tx.Commit().Error; err != nil {
+			tx.Rollback()
+			return nil, apiexceptions.NewUserException().FailedToCommitTransaction().WithOrigin(err)
+		}
+
+		return &apicontract.LogoutResponseDto{
+			UpdatedAt: now,
+		}, nil
+	}
+
 	offlineStatus := enums.UserStatus_Offline
-	emptyString := ""
 	updatedUser, exception := s.userRepository.UpdateOneById(
 		actorUserId,
 		inputs.PartialUpdateUserInput{
 			Values: inputs.UpdateUserInput{
-				Status:       &offlineStatus,
-				RefreshToken: &emptyString,
+				Status: &offlineStatus,
 			},
 			SetNull: nil,
 		},
@@ -1382,63 +1575,21 @@ func (s *AuthService) ForgetPassword(
 		return nil, apiexceptions.NewAuthException().WrongAuthCode()
 	}
 
-	newAccessToken, exception := s.generateAccessToken(user.PublicId, user.Name, user.Email, user.UserAgent)
-	if exception != nil {
-		tx.Rollback()
-		return nil, exception
-	}
-	newRefreshToken, exception := s.generateRefreshToken(user.PublicId, user.Name, user.Email, user.UserAgent)
-	if exception != nil {
-		tx.Rollback()
-		return nil, exception
-	}
-	newCSRFToken, exception := s.generateCSRFToken()
-	if exception != nil {
-		tx.Rollback()
-		return nil, exception
-	}
-
-	// update the access token of the user
-	exception = s.userDataCacheClient.Update(user.Name, cacheinputs.UpdateUserDataCacheInput{AccessToken: newAccessToken})
-	if exception != nil {
-		_ = logs.NotegicLogger.JSON(ctx, slog.LevelError, exception.String(), exception)
-		// and also try to set the new user cache data
-		exception = s.userDataCacheClient.Set(user.Name, userdata.UserDataCache{
-			Id:          user.Id,
-			PublicId:    user.PublicId,
-			Name:        user.Name,
-			DisplayName: user.DisplayName,
-			Email:       user.Email,
-			AccessToken: *newAccessToken,
-			CSRFToken:   *newCSRFToken,
-			Role:        user.Role,
-			Plan:        user.Plan,
-			Status:      user.Status,
-			AvatarURL:   *user.UserInfo.AvatarURL,
-			CreatedAt:   user.CreatedAt,
-			UpdatedAt:   user.UpdatedAt,
-		})
-		if exception != nil {
-			_ = logs.NotegicLogger.JSON(ctx, slog.LevelError, exception.String(), exception)
-		}
-	}
-
 	hashedPassword, exception := s.hashPassword(reqDto.Body.NewPassword)
 	if exception != nil {
 		tx.Rollback()
 		return nil, exception
 	}
 
-	// update the refresh token and the status of the user
+	// update the password and the last user agent of the user
 	var zeroLoginCount int32 = 0 // reset the login count if the login procedure is valid
 	updatedUser, exception := s.userRepository.UpdateOneById(
 		user.Id,
 		inputs.PartialUpdateUserInput{
 			Values: inputs.UpdateUserInput{
-				Password:     &hashedPassword,
-				RefreshToken: newRefreshToken,
-				UserAgent:    &reqDto.Header.UserAgent,
-				LoginCount:   &zeroLoginCount,
+				Password:   &hashedPassword,
+				UserAgent:  &reqDto.Header.UserAgent,
+				LoginCount: &zeroLoginCount,
 			},
 			SetNull: nil,
 		},
@@ -1449,6 +1600,16 @@ func (s *AuthService) ForgetPassword(
 		tx.Rollback()
 		return nil, exception
 	}
+	// every device has to sign in again with the new password
+	if _, exception := s.userSessionRepository.RevokeManyByUserId(
+		user.Id,
+		nil,
+		time.Now(),
+		options.WithTransactionDB(tx),
+	); exception != nil {
+		tx.Rollback()
+		return nil, exception
+	}
 	if err := repositories.NewOutboxEventRepository().EnqueueUserSessionsRevoked(
 		tx,
 		user.PublicId.String(),
@@ -1470,6 +1631,11 @@ func (s *AuthService) ForgetPassword(
 		return nil, apiexceptions.NewUserException().FailedToCommitTransaction().WithOrigin(err)
 	}
 
+	// the cached access and CSRF tokens belong to the revoked sessions
+	if exception = s.userDataCacheClient.Delete(user.Name); exception != nil {
+		_ = logs.NotegicLogger.JSON(ctx, slog.LevelError, exception.String(), exception)
+	}
+
 	return &apicontract.ForgetPasswordResponseDto{
 		UpdatedAt: updatedUser.UpdatedAt,
 	}, nil
@@ -1654,10 +1820,714 @@ func (s *AuthService) DeleteMe(
 	}, nil
 }
 
-func (s *AuthService) RegisterViaMeta() {}
+func (s *AuthService) GetMySessions(
+	ctx context.Context, reqDto *apicontract.GetMySessionsRequestDto,
+) (*apicontract.GetMySessionsResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(reqDto); err != nil {
+		return nil, apiexceptions.NewAuthException().InvalidDto().WithOrigin(err)
+	}
+	actorUserId, exception := contexts.GetActorUserId(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+	actorSessionId, exception := contexts.GetActorSessionId(ctx)
+	if exception != nil {
+		return nil, exception
+	}
 
-func (s *AuthService) RegisterViaGithub() {}
+	userSessions, exception := s.userSessionRepository.GetManyActiveByUserId(
+		actorUserId,
+		time.Now(),
+		options.WithDB(s.db.WithContext(ctx)),
+	)
+	if exception != nil {
+		return nil, exception
+	}
+
+	responseDto := make(apicontract.GetMySessionsResponseDto, 0, len(userSessions))
+	for index := range userSessions {
+		responseDto = append(responseDto, s.toSessionResponseDto(&userSessions[index], actorSessionId))
+	}
+
+	return &responseDto, nil
+}
+
+func (s *AuthService) RevokeMySession(
+	ctx context.Context, reqDto *apicontract.RevokeMySessionRequestDto,
+) (*apicontract.RevokeMySessionResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(reqDto); err != nil {
+		return nil, apiexceptions.NewAuthException().InvalidDto().WithOrigin(err)
+	}
+	actorUserId, exception := contexts.GetActorUserId(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+	actorSessionId, exception := contexts.GetActorSessionId(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+
+	revokedUserSession, exception := s.userSessionRepository.RevokeOneById(
+		reqDto.Param.SessionId,
+		actorUserId,
+		time.Now(),
+		options.WithDB(s.db.WithContext(ctx)),
+	)
+	if exception != nil {
+		return nil, exception
+	}
+
+	responseDto := s.toSessionResponseDto(revokedUserSession, actorSessionId)
+	return &responseDto, nil
+}
+
+func (s *AuthService) RevokeMyOtherSessions(
+	ctx context.Context, reqDto *apicontract.RevokeMyOtherSessionsRequestDto,
+) (*apicontract.RevokeMyOtherSessionsResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(reqDto); err != nil {
+		return nil, apiexceptions.NewAuthException().InvalidDto().WithOrigin(err)
+	}
+	actorUserId, exception := contexts.GetActorUserId(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+	actorSessionId, exception := contexts.GetActorSessionId(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+
+	revokedAt := time.Now()
+	revokedCount, exception := s.userSessionRepository.RevokeManyByUserId(
+		actorUserId,
+		&actorSessionId,
+		revokedAt,
+		options.WithDB(s.db.WithContext(ctx)),
+	)
+	if exception != nil {
+		return nil, exception
+	}
+
+	return &apicontract.RevokeMyOtherSessionsResponseDto{
+		RevokedCount: revokedCount,
+		RevokedAt:    revokedAt,
+	}, nil
+}
+
+func (s *AuthService) toSessionResponseDto(
+	userSession *schemas.UserSession,
+	actorSessionId uuid.UUID,
+) apicontract.SessionResponseDto {
+	return apicontract.SessionResponseDto{
+		Id:          userSession.Id,
+		DeviceLabel: userSession.DeviceLabel,
+		UserAgent:   userSession.UserAgent,
+		IpAddress:   userSession.IpAddress,
+		IsCurrent:   userSession.Id == actorSessionId,
+		LastSeenAt:  userSession.LastSeenAt,
+		ExpiresAt:   userSession.ExpiresAt,
+		RevokedAt:   userSession.RevokedAt,
+		CreatedAt:   userSession.CreatedAt,
+	}
+}
+
+/* ============================== Two-Factor Authentication ============================== */
+
+// challengeTwoFactor stops the login of a user who has enabled the two-factor authentication
+// before any session is created, and returns the challenge that should be verified with a code.
+// It returns nothing if the user has not enabled it, and the login should go on.
+func (s *AuthService) challengeTwoFactor(
+	tx *gorm.DB,
+	user *schemas.User,
+	deviceLabel *string,
+	userAgent string,
+) (*apicontract.LoginResponseDto, *exceptions.Exception) {
+	userTwoFactor, exception := s.userTwoFactorRepository.GetOneByUserId(
+		user.Id,
+		options.WithTransactionDB(tx),
+	)
+	if exception != nil {
+		if errors.Is(exception.Origin(), gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		tx.Rollback()
+		return nil, exception
+	}
+	if !userTwoFactor.IsEnabled() {
+		return nil, nil
+	}
+
+	claims := sharedtokens.TwoFactorChallengeTokenClaims{UserAgent: userAgent}
+	if deviceLabel != nil {
+		claims.DeviceLabel = *deviceLabel
+	}
+	challengeToken, expiresAt, err := sharedtokens.GenerateTwoFactorChallengeToken(user.PublicId.String(), claims)
+	if err != nil {
+		tx.Rollback()
+		return nil, exceptions.New(
+			"FailedToGenerateTwoFactorChallengeToken",
+			"Auth",
+			"ChallengeTwoFactor",
+			"Failed to generate the two-factor challenge token",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(err)
+	}
+
+	// nothing has been written yet, the failed login count is only reset once the challenge is verified
+	tx.Rollback()
+	return &apicontract.LoginResponseDto{
+		TwoFactorRequired:           true,
+		TwoFactorChallengeToken:     *challengeToken,
+		TwoFactorChallengeExpiresAt: &expiresAt,
+	}, nil
+}
+
+// verifyTwoFactorCode accepts either a TOTP code or an unused recovery code of the user,
+// and returns the number of the remaining recovery codes if a recovery code has been used.
+func (s *AuthService) verifyTwoFactorCode(
+	tx *gorm.DB,
+	userId uuid.UUID,
+	code string,
+	now time.Time,
+) (*int, *exceptions.Exception) {
+	userTwoFactor, exception := s.userTwoFactorRepository.GetOneByUserId(
+		userId,
+		options.WithTransactionDB(tx),
+	)
+	if exception != nil {
+		if errors.Is(exception.Origin(), gorm.ErrRecordNotFound) {
+			return nil, apiexceptions.NewUserTwoFactorException().NotEnabled()
+		}
+		return nil, exception
+	}
+	if !userTwoFactor.IsEnabled() {
+		return nil, apiexceptions.NewUserTwoFactorException().NotEnabled()
+	}
+
+	if len(code) == sharedtokens.TOTPDigits && stringutil.IsNumberString(code) {
+		step, exception := s.validateTwoFactorTOTPCode(userTwoFactor, code, now)
+		if exception != nil {
+			return nil, exception
+		}
+		if _, exception := s.userTwoFactorRepository.UseStepByUserId(
+			userId,
+			step,
+			options.WithTransactionDB(tx),
+		); exception != nil {
+			return nil, exception
+		}
+		return nil, nil
+	}
+
+	usedUserTwoFactor, exception := s.userTwoFactorRepository.UseRecoveryCodeByUserId(
+		userId,
+		sharedtokens.HashTOTPRecoveryCode(code),
+		options.WithTransactionDB(tx),
+	)
+	if exception != nil {
+		return nil, exception
+	}
+	remainingRecoveryCodeCount := len(usedUserTwoFactor.RecoveryCodeHashes)
+	return &remainingRecoveryCodeCount, nil
+}
+
+// validateTwoFactorTOTPCode returns the time step of the code, the codes of the time steps
+// which are not after the last used one are rejected as replays.
+func (s *AuthService) validateTwoFactorTOTPCode(
+	userTwoFactor *schemas.UserTwoFactor,
+	code string,
+	now time.Time,
+) (int64, *exceptions.Exception) {
+	secret, err := sharedtokens.DecryptTOTPSecret(userTwoFactor.EncryptedSecret)
+	if err != nil {
+		return 0, exceptions.New(
+			"FailedToDecryptTOTPSecret",
+			"Auth",
+			"VerifyTwoFactor",
+			"Failed to decrypt the TOTP secret",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(err)
+	}
+	step, ok := sharedtokens.ValidateTOTPCode(secret, code, now)
+	if !ok || step <= userTwoFactor.LastUsedStep {
+		return 0, apiexceptions.NewUserTwoFactorException().WrongCode()
+	}
+	return step, nil
+}
+
+// countFailedTwoFactorAttempt shares the failed login count with the password, so guessing
+// the codes of a challenge blocks the login just like guessing the password does.
+// Unlike the other steps of the login, the count is committed even though the attempt fails.
+func (s *AuthService) countFailedTwoFactorAttempt(
+	tx *gorm.DB,
+	user *schemas.User,
+) *exceptions.Exception {
+	newLoginCount := user.LoginCount + 1
+	blockLoginUntil, exception := s.getLoginBlockedUntilByLoginCount(newLoginCount)
+	if exception != nil {
+		tx.Rollback()
+		return exception
+	}
+
+	if _, exception = s.userRepository.UpdateOneById(
+		user.Id,
+		inputs.PartialUpdateUserInput{
+			Values: inputs.UpdateUserInput{
+				LoginCount:     &newLoginCount,
+				BlockLoginUtil: blockLoginUntil,
+			},
+			SetNull: nil,
+		},
+		options.WithTransactionDB(tx),
+		options.WithLockingStrength(options.LockingStrengthNoKeyUpdate),
+	); exception != nil {
+		tx.Rollback()
+		return exception
+	}
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
+		return apiexceptions.NewUserException().FailedToCommitTransaction().WithOrigin(err)
+	}
+
+	if blockLoginUntil != nil {
+		return apiexceptions.NewAuthException().LoginBlockedDueToTryingTooManyTimes(*blockLoginUntil)
+	}
+	return apiexceptions.NewUserTwoFactorException().WrongCode()
+}
+
+func (s *AuthService) sendTwoFactorSecurityAlertEmail(
+	ctx context.Context,
+	user *schemas.User,
+	alertType string,
+	reason string,
+	otherDetails string,
+) {
+	if exception := s.emailClient.SendSecurityAlertEmail(ctx, emaildto.SendSecurityAlertEmailRequestDto{
+		To:               user.Email,
+		UserName:         user.Name,
+		Status:           user.Status.String(),
+		AlertType:        alertType,
+		Reason:           reason,
+		TimeOfOccurrence: time.Now(),
+		OtherDetails:     otherDetails,
+	}); exception != nil {
+		_ = logs.NotegicLogger.JSON(ctx, slog.LevelError, exception.String(), exception)
+	}
+}
+
+func (s *AuthService) GetMyTwoFactor(
+	ctx context.Context, reqDto *apicontract.GetMyTwoFactorRequestDto,
+) (*apicontract.GetMyTwoFactorResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(reqDto); err != nil {
+		return nil, apiexceptions.NewAuthException().InvalidDto().WithOrigin(err)
+	}
+	actorUserId, exception := contexts.GetActorUserId(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+
+	userTwoFactor, exception := s.userTwoFactorRepository.GetOneByUserId(
+		actorUserId,
+		options.WithDB(s.db.WithContext(ctx)),
+	)
+	if exception != nil {
+		if errors.Is(exception.Origin(), gorm.ErrRecordNotFound) {
+			return &apicontract.GetMyTwoFactorResponseDto{}, nil
+		}
+		return nil, exception
+	}
+
+	return &apicontract.GetMyTwoFactorResponseDto{
+		IsEnabled:                  userTwoFactor.IsEnabled(),
+		IsPending:                  !userTwoFactor.IsEnabled(),
+		RemainingRecoveryCodeCount: len(userTwoFactor.RecoveryCodeHashes),
+		EnabledAt:                  userTwoFactor.EnabledAt,
+	}, nil
+}
+
+// EnrollMyTwoFactor starts or restarts a pending enrollment with a new secret,
+// the second factor is only enabled after a code of the secret is verified.
+func (s *AuthService) EnrollMyTwoFactor(
+	ctx context.Context, reqDto *apicontract.EnrollMyTwoFactorRequestDto,
+) (*apicontract.EnrollMyTwoFactorResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(reqDto); err != nil {
+		return nil, apiexceptions.NewAuthException().InvalidDto().WithOrigin(err)
+	}
+	actorUserId, exception := contexts.GetActorUserId(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+
+	secret, err := sharedtokens.GenerateTOTPSecret()
+	if err != nil {
+		return nil, exceptions.New(
+			"FailedToGenerateTOTPSecret",
+			"Auth",
+			"EnrollMyTwoFactor",
+			"Failed to generate the TOTP secret",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(err)
+	}
+	encryptedSecret, err := sharedtokens.EncryptTOTPSecret(secret)
+	if err != nil {
+		return nil, exceptions.New(
+			"FailedToEncryptTOTPSecret",
+			"Auth",
+			"EnrollMyTwoFactor",
+			"Failed to encrypt the TOTP secret",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(err)
+	}
+
+	tx := s.db.WithContext(ctx).Begin()
+
+	user, exception := s.userRepository.GetOneById(
+		actorUserId,
+		nil,
+		options.WithTransactionDB(tx),
+	)
+	if exception != nil {
+		tx.Rollback()
+		return nil, exception
+	}
+
+	var userTwoFactor *schemas.UserTwoFactor
+	if _, exception = s.userTwoFactorRepository.GetOneByUserId(
+		actorUserId,
+		options.WithTransactionDB(tx),
+		options.WithLockingStrength(options.LockingStrengthUpdate),
+	); exception == nil {
+		userTwoFactor, exception = s.userTwoFactorRepository.ResetPendingOneByUserId(
+			actorUserId,
+			encryptedSecret,
+			options.WithTransactionDB(tx),
+		)
+	} else if errors.Is(exception.Origin(), gorm.ErrRecordNotFound) {
+		userTwoFactor, exception = s.userTwoFactorRepository.CreateOne(
+			actorUserId,
+			inputs.CreateUserTwoFactorInput{EncryptedSecret: encryptedSecret},
+			options.WithTransactionDB(tx),
+		)
+	}
+	if exception != nil {
+		tx.Rollback()
+		return nil, exception
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
+		return nil, apiexceptions.NewUserTwoFactorException().FailedToCommitTransaction().WithOrigin(err)
+	}
+
+	return &apicontract.EnrollMyTwoFactorResponseDto{
+		Secret:          secret,
+		ProvisioningURI: sharedtokens.GetTOTPProvisioningURI(user.Email, secret),
+		CreatedAt:       userTwoFactor.CreatedAt,
+	}, nil
+}
+
+func (s *AuthService) EnableMyTwoFactor(
+	ctx context.Context, reqDto *apicontract.EnableMyTwoFactorRequestDto,
+) (*apicontract.EnableMyTwoFactorResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(reqDto); err != nil {
+		return nil, apiexceptions.NewAuthException().InvalidDto().WithOrigin(err)
+	}
+	actorUserId, exception := contexts.GetActorUserId(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+
+	tx := s.db.WithContext(ctx).Begin()
+
+	user, exception := s.userRepository.GetOneById(
+		actorUserId,
+		nil,
+		options.WithTransactionDB(tx),
+	)
+	if exception != nil {
+		tx.Rollback()
+		return nil, exception
+	}
+	userTwoFactor, exception := s.userTwoFactorRepository.GetOneByUserId(
+		actorUserId,
+		options.WithTransactionDB(tx),
+		options.WithLockingStrength(options.LockingStrengthUpdate),
+	)
+	if exception != nil {
+		tx.Rollback()
+		return nil, exception
+	}
+	if userTwoFactor.IsEnabled() {
+		tx.Rollback()
+		return nil, apiexceptions.NewUserTwoFactorException().AlreadyEnabled()
+	}
+
+	now := time.Now()
+	step, exception := s.validateTwoFactorTOTPCode(userTwoFactor, reqDto.Body.Code, now)
+	if exception != nil {
+		tx.Rollback()
+		return nil, exception
+	}
+	recoveryCodes, recoveryCodeHashes, err := sharedtokens.GenerateTOTPRecoveryCodes()
+	if err != nil {
+		tx.Rollback()
+		return nil, exceptions.New(
+			"FailedToGenerateRecoveryCodes",
+			"Auth",
+			"EnableMyTwoFactor",
+			"Failed to generate the recovery codes",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(err)
+	}
+	enabledUserTwoFactor, exception := s.userTwoFactorRepository.EnableOneByUserId(
+		actorUserId,
+		step,
+		recoveryCodeHashes,
+		now,
+		options.WithTransactionDB(tx),
+	)
+	if exception != nil {
+		tx.Rollback()
+		return nil, exception
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
+		return nil, apiexceptions.NewUserTwoFactorException().FailedToCommitTransaction().WithOrigin(err)
+	}
+
+	s.sendTwoFactorSecurityAlertEmail(
+		ctx,
+		user,
+		"Two-Factor Authentication Enabled",
+		"The two-factor authentication has been enabled on your account",
+		"",
+	)
+
+	return &apicontract.EnableMyTwoFactorResponseDto{
+		RecoveryCodes: recoveryCodes,
+		EnabledAt:     *enabledUserTwoFactor.EnabledAt,
+	}, nil
+}
+
+// DisableMyTwoFactor re-authenticates the user with both the email auth code
+// and a code of the second factor, so neither a stolen session nor a stolen device
+// is enough to remove the second factor.
+func (s *AuthService) DisableMyTwoFactor(
+	ctx context.Context, reqDto *apicontract.DisableMyTwoFactorRequestDto,
+) (*apicontract.DisableMyTwoFactorResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(reqDto); err != nil {
+		return nil, apiexceptions.NewAuthException().InvalidDto().WithOrigin(err)
+	}
+	actorUserId, exception := contexts.GetActorUserId(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+
+	tx := s.db.WithContext(ctx).Begin()
+
+	user, exception := s.userRepository.GetOneById(
+		actorUserId,
+		nil,
+		options.WithTransactionDB(tx),
+	)
+	if exception != nil {
+		tx.Rollback()
+		return nil, exception
+	}
+
+	var userAccount schemas.UserAccount
+	if err := tx.Model(&userAccount).
+		Where("user_id = ? AND auth_code = ? AND auth_code_expired_at > NOW()", actorUserId, reqDto.Body.AuthCode).
+		First(&userAccount).Error; err != nil {
+		tx.Rollback()
+		return nil, apiexceptions.NewAuthException().WrongAuthCode().WithOrigin(err)
+	}
+
+	now := time.Now()
+	if _, exception := s.verifyTwoFactorCode(tx, actorUserId, reqDto.Body.Code, now); exception != nil {
+		tx.Rollback()
+		return nil, exception
+	}
+	if exception := s.userTwoFactorRepository.DeleteOneByUserId(
+		actorUserId,
+		options.WithTransactionDB(tx),
+	); exception != nil {
+		tx.Rollback()
+		return nil, exception
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
+		return nil, apiexceptions.NewUserTwoFactorException().FailedToCommitTransaction().WithOrigin(err)
+	}
+
+	s.sendTwoFactorSecurityAlertEmail(
+		ctx,
+		user,
+		"Two-Factor Authentication Disabled",
+		"The two-factor authentication has been disabled on your account",
+		"",
+	)
+
+	return &apicontract.DisableMyTwoFactorResponseDto{
+		DisabledAt: now,
+	}, nil
+}
+
+// RegenerateMyTwoFactorRecoveryCodes replaces all the recovery codes, including the unused ones,
+// it only accepts a TOTP code since the recovery codes being replaced may have leaked.
+func (s *AuthService) RegenerateMyTwoFactorRecoveryCodes(
+	ctx context.Context, reqDto *apicontract.RegenerateMyTwoFactorRecoveryCodesRequestDto,
+) (*apicontract.RegenerateMyTwoFactorRecoveryCodesResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(reqDto); err != nil {
+		return nil, apiexceptions.NewAuthException().InvalidDto().WithOrigin(err)
+	}
+	actorUserId, exception := contexts.GetActorUserId(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+
+	tx := s.db.WithContext(ctx).Begin()
+
+	user, exception := s.userRepository.GetOneById(
+		actorUserId,
+		nil,
+		options.WithTransactionDB(tx),
+	)
+	if exception != nil {
+		tx.Rollback()
+		return nil, exception
+	}
+	userTwoFactor, exception := s.userTwoFactorRepository.GetOneByUserId(
+		actorUserId,
+		options.WithTransactionDB(tx),
+	)
+	if exception != nil {
+		tx.Rollback()
+		return nil, exception
+	}
+	if !userTwoFactor.IsEnabled() {
+		tx.Rollback()
+		return nil, apiexceptions.NewUserTwoFactorException().NotEnabled()
+	}
+
+	step, exception := s.validateTwoFactorTOTPCode(userTwoFactor, reqDto.Body.Code, time.Now())
+	if exception != nil {
+		tx.Rollback()
+		return nil, exception
+	}
+	recoveryCodes, recoveryCodeHashes, err := sharedtokens.GenerateTOTPRecoveryCodes()
+	if err != nil {
+		tx.Rollback()
+		return nil, exceptions.New(
+			"FailedToGenerateRecoveryCodes",
+			"Auth",
+			"RegenerateMyTwoFactorRecoveryCodes",
+			"Failed to generate the recovery codes",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(err)
+	}
+	regeneratedUserTwoFactor, exception := s.userTwoFactorRepository.RegenerateRecoveryCodesByUserId(
+		actorUserId,
+		step,
+		recoveryCodeHashes,
+		options.WithTransactionDB(tx),
+	)
+	if exception != nil {
+		tx.Rollback()
+		return nil, exception
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
+		return nil, apiexceptions.NewUserTwoFactorException().FailedToCommitTransaction().WithOrigin(err)
+	}
+
+	s.sendTwoFactorSecurityAlertEmail(
+		ctx,
+		user,
+		"Recovery Codes Regenerated",
+		"The recovery codes of your two-factor authentication have been regenerated, the previous ones no longer work",
+		"",
+	)
+
+	return &apicontract.RegenerateMyTwoFactorRecoveryCodesResponseDto{
+		RecoveryCodes: recoveryCodes,
+		UpdatedAt:     regeneratedUserTwoFactor.UpdatedAt,
+	}, nil
+}
+
+// VerifyLoginTwoFactor completes the login started by Login or the OAuth logins,
+// the challenge can only be verified from the device it was issued to.
+func (s *AuthService) VerifyLoginTwoFactor(
+	ctx context.Context, reqDto *apicontract.VerifyLoginTwoFactorRequestDto,
+) (*apicontract.VerifyLoginTwoFactorResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(reqDto); err != nil {
+		return nil, apiexceptions.NewAuthException().InvalidDto().WithOrigin(err)
+	}
+
+	claims, err := sharedtokens.ParseTwoFactorChallengeToken(reqDto.Body.ChallengeToken)
+	if err != nil {
+		return nil, apiexceptions.NewUserTwoFactorException().InvalidChallenge().WithOrigin(err)
+	}
+	if claims.UserAgent != reqDto.Header.UserAgent {
+		return nil, apiexceptions.NewUserTwoFactorException().InvalidChallenge()
+	}
+	userPublicId, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return nil, apiexceptions.NewUserTwoFactorException().InvalidChallenge().WithOrigin(err)
+	}
+
+	tx := s.db.WithContext(ctx).Begin()
+
+	user, exception := s.userRepository.GetOneByPublicId(
+		userPublicId,
+		nil,
+		options.WithTransactionDB(tx),
+		options.WithLockingStrength(options.LockingStrengthNoKeyUpdate),
+	)
+	if exception != nil {
+		tx.Rollback()
+		return nil, exception
+	}
+	if user.BlockLoginUntil.After(time.Now()) {
+		tx.Rollback()
+		return nil, apiexceptions.NewAuthException().LoginBlockedDueToTryingTooManyTimes(user.BlockLoginUntil)
+	}
+
+	remainingRecoveryCodeCount, exception := s.verifyTwoFactorCode(tx, user.Id, reqDto.Body.Code, time.Now())
+	if exception != nil {
+		if exception.Reason == apiexceptions.NewUserTwoFactorException().WrongCode().Reason {
+			return nil, s.countFailedTwoFactorAttempt(tx, user)
+		}
+		tx.Rollback()
+		return nil, exception
+	}
 
-func (s *AuthService) LoginViaMeta() {}
+	var deviceLabel *string
+	if claims.DeviceLabel != "" {
+		deviceLabel = &claims.DeviceLabel
+	}
+	responseDto, exception := s.signInUser(ctx, tx, user, deviceLabel, reqDto.Header.UserAgent, reqDto.Header.IpAddress)
+	if exception != nil {
+		return nil, exception
+	}
+
+	if remainingRecoveryCodeCount != nil {
+		s.sendTwoFactorSecurityAlertEmail(
+			ctx,
+			user,
+			"Recovery Code Used",
+			"A recovery code has been used to login to your account",
+			fmt.Sprintf("%d recovery codes remain unused", *remainingRecoveryCodeCount),
+		)
+	}
 
-func (s *AuthService) LoginViaGithub() {}
+	return responseDto, nil
+}
@@ -0,0 +1,322 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"golang.org/x/oauth2"
+
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+)
+
+// oauthProvider is implemented by each provider registered in the OAuthService,
+// the authorization code flow is shared and only the user info differs.
+type oauthProvider interface {
+	getOAuthConfig(ctx context.Context) (*oauth2.Config, *exceptions.Exception)
+	getUserInfo(ctx context.Context, client *http.Client) (*oauthUserInfo, *exceptions.Exception)
+}
+
+// getOAuthProviderJSON requests the given URL of the provider and decodes its JSON response,
+// the client errors of the provider usually mean the token has been revoked or has expired.
+func getOAuthProviderJSON(
+	ctx context.Context, client *http.Client, url string, headers map[string]string, value any,
+) *exceptions.Exception {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return exceptions.New(
+			"RequestFailed",
+			"OAuth",
+			"GetUserInfo",
+			"Failed to request the OAuth provider",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(err)
+	}
+	request.Header.Set("Accept", "application/json")
+	for key, header := range headers {
+		request.Header.Set(key, header)
+	}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return exceptions.New(
+			"OAuthProviderUnavailable",
+			"OAuth",
+			"GetUserInfo",
+			"The OAuth provider is unavailable",
+			http.StatusBadGateway,
+			true,
+		).WithOrigin(err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		if response.StatusCode >= http.StatusBadRequest && response.StatusCode < http.StatusInternalServerError {
+			return exceptions.New(
+				"InvalidAuthenticationCode",
+				"OAuth",
+				"GetUserInfo",
+				"Authentication code is invalid or expired",
+				http.StatusBadRequest,
+			).WithOrigin(
+				fmt.Errorf("%s returned HTTP status %d", url, response.StatusCode),
+			)
+		}
+
+		return exceptions.New(
+			"OAuthProviderUnavailable",
+			"OAuth",
+			"GetUserInfo",
+			"The OAuth provider is unavailable",
+			http.StatusBadGateway,
+			true,
+		).WithOrigin(
+			fmt.Errorf("%s returned HTTP status %d", url, response.StatusCode),
+		)
+	}
+
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		return exceptions.New(
+			"ResponseReadFailed",
+			"OAuth",
+			"GetUserInfo",
+			"Failed to read the OAuth provider response",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(err)
+	}
+	if err := json.Unmarshal(data, value); err != nil {
+		return exceptions.New(
+			"InvalidResponse",
+			"OAuth",
+			"GetUserInfo",
+			"OAuth provider response is invalid",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(err)
+	}
+
+	return nil
+}
+
+/* ============================== Google ============================== */
+
+type googleOAuthProvider struct {
+	oauthConfig *oauth2.Config
+}
+
+type googleUserInfo struct {
+	Id            string `json:"id"`
+	Email         string `json:"email"`
+	VerifiedEmail bool   `json:"verified_email"`
+	Name          string `json:"name"`
+}
+
+func (p *googleOAuthProvider) getOAuthConfig(_ context.Context) (*oauth2.Config, *exceptions.Exception) {
+	return p.oauthConfig, nil
+}
+
+func (p *googleOAuthProvider) getUserInfo(
+	ctx context.Context, client *http.Client,
+) (*oauthUserInfo, *exceptions.Exception) {
+	var userInfo googleUserInfo
+	if exception := getOAuthProviderJSON(
+		ctx, client, "https://www.googleapis.com/oauth2/v2/userinfo", nil, &userInfo,
+	); exception != nil {
+		return nil, exception
+	}
+
+	return &oauthUserInfo{
+		Id:            userInfo.Id,
+		Email:         userInfo.Email,
+		VerifiedEmail: userInfo.VerifiedEmail,
+		Name:          userInfo.Name,
+	}, nil
+}
+
+/* ============================== GitHub ============================== */
+
+type githubOAuthProvider struct {
+	oauthConfig *oauth2.Config
+}
+
+type githubUserInfo struct {
+	Id    int64  `json:"id"`
+	Login string `json:"login"`
+	Name  string `json:"name"`
+}
+
+type githubUserEmail struct {
+	Email    string `json:"email"`
+	Primary  bool   `json:"primary"`
+	Verified bool   `json:"verified"`
+}
+
+var githubHeaders = map[string]string{
+	"Accept":               "application/vnd.github+json",
+	"X-GitHub-Api-Version": "2022-11-28",
+}
+
+func (p *githubOAuthProvider) getOAuthConfig(_ context.Context) (*oauth2.Config, *exceptions.Exception) {
+	return p.oauthConfig, nil
+}
+
+// getUserInfo reads the primary email from the email list, since the email of the profile
+// is only the public one, which may be empty or not verified.
+func (p *githubOAuthProvider) getUserInfo(
+	ctx context.Context, client *http.Client,
+) (*oauthUserInfo, *exceptions.Exception) {
+	var userInfo githubUserInfo
+	if exception := getOAuthProviderJSON(
+		ctx, client, "https://api.github.com/user", githubHeaders, &userInfo,
+	); exception != nil {
+		return nil, exception
+	}
+	var userEmails []githubUserEmail
+	if exception := getOAuthProviderJSON(
+		ctx, client, "https://api.github.com/user/emails", githubHeaders, &userEmails,
+	); exception != nil {
+		return nil, exception
+	}
+
+	result := &oauthUserInfo{Name: userInfo.Name}
+	if userInfo.Id != 0 {
+		result.Id = strconv.FormatInt(userInfo.Id, 10)
+	}
+	if result.Name == "" {
+		result.Name = userInfo.Login
+	}
+	for _, userEmail := range userEmails {
+		if userEmail.Primary {
+			result.Email = userEmail.Email
+			result.VerifiedEmail = userEmail.Verified
+			break
+		}
+	}
+	return result, nil
+}
+
+/* ============================== Meta ============================== */
+
+type metaOAuthProvider struct {
+	oauthConfig *oauth2.Config
+}
+
+type metaUserInfo struct {
+	Id    string `json:"id"`
+	Email string `json:"email"`
+	Name  string `json:"name"`
+}
+
+func (p *metaOAuthProvider) getOAuthConfig(_ context.Context) (*oauth2.Config, *exceptions.Exception) {
+	return p.oauthConfig, nil
+}
+
+// getUserInfo trusts the email returned by the Graph API, since it only returns the
+// confirmed email of the user, and omits it if the user has none or declined to share it.
+func (p *metaOAuthProvider) getUserInfo(
+	ctx context.Context, client *http.Client,
+) (*oauthUserInfo, *exceptions.Exception) {
+	var userInfo metaUserInfo
+	if exception := getOAuthProviderJSON(
+		ctx, client, "https://graph.facebook.com/me?fields=id,name,email", nil, &userInfo,
+	); exception != nil {
+		return nil, exception
+	}
+
+	return &oauthUserInfo{
+		Id:            userInfo.Id,
+		Email:         userInfo.Email,
+		VerifiedEmail: userInfo.Email != "",
+		Name:          userInfo.Name,
+	}, nil
+}
+
+/* ============================== OpenID Connect ============================== */
+
+type oidcOAuthProvider struct {
+	issuerURL   string
+	oauthConfig *oauth2.Config
+
+	mutex            sync.Mutex
+	discoveredConfig *oauth2.Config
+	userInfoURL      string
+}
+
+type oidcDiscoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserInfoEndpoint      string `json:"userinfo_endpoint"`
+}
+
+type oidcUserInfo struct {
+	Subject       string `json:"sub"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	Name          string `json:"name"`
+}
+
+// getOAuthConfig discovers the endpoints of the issuer on the first use, a failed discovery
+// is not cached so that the provider recovers once the issuer is reachable again.
+func (p *oidcOAuthProvider) getOAuthConfig(ctx context.Context) (*oauth2.Config, *exceptions.Exception) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.discoveredConfig != nil {
+		return p.discoveredConfig, nil
+	}
+
+	var document oidcDiscoveryDocument
+	if exception := getOAuthProviderJSON(
+		ctx, http.DefaultClient, p.issuerURL+"/.well-known/openid-configuration", nil, &document,
+	); exception != nil {
+		return nil, exception
+	}
+	if document.Issuer != p.issuerURL ||
+		document.AuthorizationEndpoint == "" ||
+		document.TokenEndpoint == "" ||
+		document.UserInfoEndpoint == "" {
+		return nil, exceptions.New(
+			"InvalidDiscoveryDocument",
+			"OAuth",
+			"GetOAuthConfig",
+			"The OpenID Connect discovery document of the issuer is invalid",
+			http.StatusBadGateway,
+			true,
+		)
+	}
+
+	discoveredConfig := *p.oauthConfig
+	discoveredConfig.Endpoint = oauth2.Endpoint{
+		AuthURL:  document.AuthorizationEndpoint,
+		TokenURL: document.TokenEndpoint,
+	}
+	p.discoveredConfig = &discoveredConfig
+	p.userInfoURL = document.UserInfoEndpoint
+	return p.discoveredConfig, nil
+}
+
+func (p *oidcOAuthProvider) getUserInfo(
+	ctx context.Context, client *http.Client,
+) (*oauthUserInfo, *exceptions.Exception) {
+	p.mutex.Lock()
+	userInfoURL := p.userInfoURL
+	p.mutex.Unlock()
+
+	var userInfo oidcUserInfo
+	if exception := getOAuthProviderJSON(ctx, client, userInfoURL, nil, &userInfo); exception != nil {
+		return nil, exception
+	}
+
+	return &oauthUserInfo{
+		Id:            userInfo.Subject,
+		Email:         userInfo.Email,
+		VerifiedEmail: userInfo.EmailVerified,
+		Name:          userInfo.Name,
+	}, nil
+}
@@ -2,142 +2,206 @@ package auth
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
-	"fmt"
-	"io"
 	"net/http"
+	"time"
 
 	"golang.org/x/oauth2"
 
+	enumcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
 	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+	sharedtokens "github.com/HiIamJeff67/notegic-backend/shared/tokens"
 )
 
 type OAuthServiceInterface interface {
-	GetGoogleUserInfo(ctx context.Context, authenticationCode string) (*googleUserInfo, *exceptions.Exception)
+	GetAuthorizationURL(ctx context.Context, provider enumcontract.OAuthProvider, userAgent string) (authorizationURL string, state string, stateToken string, expiresAt time.Time, exception *exceptions.Exception)
+	GetUserInfo(ctx context.Context, provider enumcontract.OAuthProvider, authorizationCode string, state string, stateToken string, userAgent string) (*oauthUserInfo, *exceptions.Exception)
+}
+
+// OAuthConfigs holds the OAuth client of each provider, the providers left nil are not
+// configured and are rejected. The endpoint of the OIDC provider is discovered from
+// its issuer, so it can be left empty.
+type OAuthConfigs struct {
+	Google        *oauth2.Config
+	Github        *oauth2.Config
+	Meta          *oauth2.Config
+	OIDC          *oauth2.Config
+	OIDCIssuerURL string
 }
 
 type OAuthService struct {
-	oauthGoogleConfig *oauth2.Config
+	providers map[enumcontract.OAuthProvider]oauthProvider
 }
 
-func NewOAuthService(oauthGoogleConfig *oauth2.Config) OAuthServiceInterface {
+func NewOAuthService(oauthConfigs OAuthConfigs) OAuthServiceInterface {
+	providers := make(map[enumcontract.OAuthProvider]oauthProvider)
+	if oauthConfigs.Google != nil {
+		providers[enumcontract.OAuthProvider_Google] = &googleOAuthProvider{oauthConfig: oauthConfigs.Google}
+	}
+	if oauthConfigs.Github != nil {
+		providers[enumcontract.OAuthProvider_Github] = &githubOAuthProvider{oauthConfig: oauthConfigs.Github}
+	}
+	if oauthConfigs.Meta != nil {
+		providers[enumcontract.OAuthProvider_Meta] = &metaOAuthProvider{oauthConfig: oauthConfigs.Meta}
+	}
+	if oauthConfigs.OIDC != nil {
+		providers[enumcontract.OAuthProvider_OIDC] = &oidcOAuthProvider{
+			issuerURL:   oauthConfigs.OIDCIssuerURL,
+			oauthConfig: oauthConfigs.OIDC,
+		}
+	}
+
 	return &OAuthService{
-		oauthGoogleConfig: oauthGoogleConfig,
+		providers: providers,
 	}
 }
 
 /* ============================== Service Methods for OAuth ============================== */
 
-type googleUserInfo struct {
-	Id            string `json:"id"`
-	Email         string `json:"email"`
-	VerifiedEmail bool   `json:"verifiedEmail"`
-	Name          string `json:"name"`
-	GivenName     string `json:"givenName"`
-	FamilyName    string `json:"familyName"`
-	Picture       string `json:"picture"`
-	Locale        string `json:"locale"`
+// oauthUserInfo is the identity shared by all the providers, the Id is the stable
+// identifier of the user at the provider, which is stored as the credential.
+type oauthUserInfo struct {
+	Id            string
+	Email         string
+	VerifiedEmail bool
+	Name          string
 }
 
-func (s *OAuthService) GetGoogleUserInfo(
-	ctx context.Context, authenticationCode string,
-) (*googleUserInfo, *exceptions.Exception) {
-	token, err := s.oauthGoogleConfig.Exchange(ctx, authenticationCode)
-	if err != nil {
-		var retrieveError *oauth2.RetrieveError
-		if errors.As(err, &retrieveError) && retrieveError.Response != nil &&
-			retrieveError.Response.StatusCode >= http.StatusBadRequest &&
-			retrieveError.Response.StatusCode < http.StatusInternalServerError {
-			return nil, exceptions.New(
-				"InvalidAuthenticationCode",
-				"OAuth",
-				"GetGoogleUserInfo",
-				"Authentication code is invalid or expired",
-				http.StatusBadRequest,
-			).WithOrigin(err)
-		}
-
+func (s *OAuthService) getProvider(
+	provider enumcontract.OAuthProvider, method string,
+) (oauthProvider, *exceptions.Exception) {
+	oauthProvider, ok := s.providers[provider]
+	if !ok {
 		return nil, exceptions.New(
-			"TokenExchangeFailed",
+			"ProviderNotConfigured",
 			"OAuth",
-			"GetGoogleUserInfo",
-			"Failed to exchange the OAuth token",
+			method,
+			"The OAuth provider is not supported or not configured",
+			http.StatusNotFound,
+		)
+	}
+	return oauthProvider, nil
+}
+
+// GetAuthorizationURL starts an authorization code flow with PKCE, the returned state is sent back
+// by the OAuth provider together with the authorization code, while the returned state token must be
+// kept by the gateway in an HttpOnly cookie of the browser, and sent back together with the state.
+func (s *OAuthService) GetAuthorizationURL(
+	ctx context.Context, provider enumcontract.OAuthProvider, userAgent string,
+) (string, string, string, time.Time, *exceptions.Exception) {
+	oauthProvider, exception := s.getProvider(provider, "GetAuthorizationURL")
+	if exception != nil {
+		return "", "", "", time.Time{}, exception
+	}
+	oauthConfig, exception := oauthProvider.getOAuthConfig(ctx)
+	if exception != nil {
+		return "", "", "", time.Time{}, exception
+	}
+
+	stateToken, state, codeVerifier, expiresAt, err := sharedtokens.GenerateOAuthStateToken(sharedtokens.OAuthStateTokenClaims{
+		Provider:  string(provider),
+		UserAgent: userAgent,
+	})
+	if err != nil {
+		return "", "", "", time.Time{}, exceptions.New(
+			"FailedToGenerateState",
+			"OAuth",
+			"GetAuthorizationURL",
+			"Failed to generate the OAuth state",
 			http.StatusInternalServerError,
 			true,
 		).WithOrigin(err)
 	}
 
-	client := s.oauthGoogleConfig.Client(ctx, token)
-	response, err := client.Get("https://www.googleapis.com/oauth2/v2/userinfo")
+	authorizationURL := oauthConfig.AuthCodeURL(state, oauth2.S256ChallengeOption(codeVerifier))
+	return authorizationURL, state, *stateToken, expiresAt, nil
+}
+
+// GetUserInfo validates the state against the state token issued by GetAuthorizationURL to the same
+// browser, redeems the authorization code with the PKCE code verifier of the state token, and fetches
+// the identity of the user, every provider requires the state, so a callback is never accepted
+// from a browser which has not started the authorization.
+func (s *OAuthService) GetUserInfo(
+	ctx context.Context,
+	provider enumcontract.OAuthProvider,
+	authorizationCode string,
+	state string,
+	stateToken string,
+	userAgent string,
+) (*oauthUserInfo, *exceptions.Exception) {
+	oauthProvider, exception := s.getProvider(provider, "GetUserInfo")
+	if exception != nil {
+		return nil, exception
+	}
+	oauthConfig, exception := oauthProvider.getOAuthConfig(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+
+	claims, err := sharedtokens.ParseOAuthStateToken(stateToken, state)
+	if err == nil && (claims.Provider != string(provider) || claims.UserAgent != userAgent) {
+		err = errors.New("OAuth state does not match the provider or the user agent")
+	}
 	if err != nil {
 		return nil, exceptions.New(
-			"InvalidAuthenticationCode",
+			"InvalidState",
 			"OAuth",
-			"GetGoogleUserInfo",
-			"Authentication code is invalid",
+			"GetUserInfo",
+			"OAuth state is invalid or expired, please sign in again",
 			http.StatusBadRequest,
 		).WithOrigin(err)
 	}
-	defer response.Body.Close()
-	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
-		if response.StatusCode >= http.StatusBadRequest && response.StatusCode < http.StatusInternalServerError {
+
+	token, err := oauthConfig.Exchange(ctx, authorizationCode, oauth2.VerifierOption(claims.CodeVerifier))
+	if err != nil {
+		var retrieveError *oauth2.RetrieveError
+		if errors.As(err, &retrieveError) && retrieveError.Response != nil &&
+			retrieveError.Response.StatusCode >= http.StatusBadRequest &&
+			retrieveError.Response.StatusCode < http.StatusInternalServerError {
 			return nil, exceptions.New(
 				"InvalidAuthenticationCode",
 				"OAuth",
-				"GetGoogleUserInfo",
+				"GetUserInfo",
 				"Authentication code is invalid or expired",
 				http.StatusBadRequest,
-			).WithOrigin(
-				fmt.Errorf("Google userinfo returned HTTP status %d", response.StatusCode),
-			)
+			).WithOrigin(err)
 		}
 
 		return nil, exceptions.New(
-			"OAuthProviderUnavailable",
-			"OAuth",
-			"GetGoogleUserInfo",
-			"The OAuth provider is unavailable",
-			http.StatusBadGateway,
-			true,
-		).WithOrigin(
-			fmt.Errorf("Google userinfo returned HTTP status %d", response.StatusCode),
-		)
-	}
-
-	data, err := io.ReadAll(response.Body)
-	if err != nil {
-		return nil, exceptions.New(
-			"ResponseReadFailed",
+			"TokenExchangeFailed",
 			"OAuth",
-			"GetGoogleUserInfo",
-			"Failed to read the OAuth provider response",
+			"GetUserInfo",
+			"Failed to exchange the OAuth token",
 			http.StatusInternalServerError,
 			true,
 		).WithOrigin(err)
 	}
 
-	var userInfo googleUserInfo
-	if err := json.Unmarshal(data, &userInfo); err != nil {
-		return nil, exceptions.New(
-			"InvalidResponse",
-			"OAuth",
-			"GetGoogleUserInfo",
-			"OAuth provider response is invalid",
-			http.StatusInternalServerError,
-			true,
-		).WithOrigin(err)
+	userInfo, exception := oauthProvider.getUserInfo(ctx, oauthConfig.Client(ctx, token))
+	if exception != nil {
+		return nil, exception
 	}
 	if userInfo.Id == "" || userInfo.Email == "" {
 		return nil, exceptions.New(
 			"InvalidResponse",
 			"OAuth",
-			"GetGoogleUserInfo",
+			"GetUserInfo",
 			"OAuth provider response does not contain a user ID and email",
 			http.StatusBadGateway,
 			true,
 		)
 	}
+	// the accounts are matched by their emails, so an unverified email could take over
+	// the account of another user who owns that email
+	if !userInfo.VerifiedEmail {
+		return nil, exceptions.New(
+			"EmailNotVerified",
+			"OAuth",
+			"GetUserInfo",
+			"The email of the OAuth account has not been verified by the provider",
+			http.StatusForbidden,
+		)
+	}
 
-	return &userInfo, nil
+	return userInfo, nil
 }
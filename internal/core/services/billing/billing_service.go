@@ -0,0 +1,1012 @@
+package billing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"regexp"
+	"slices"
+	"time"
+
+	validator "github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+	logs "github.com/HiIamJeff67/notegic-backend/shared/platform/observability/logs"
+
+	apicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/billing"
+
+	contexts "github.com/HiIamJeff67/notegic-backend/internal/core/contexts"
+	userdata "github.com/HiIamJeff67/notegic-backend/internal/core/data/cache/userdata"
+	cacheinputs "github.com/HiIamJeff67/notegic-backend/internal/core/data/cache/userdata/inputs"
+	inputs "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/inputs"
+	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
+	repositories "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/repositories"
+	schemas "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas"
+	enums "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/enums"
+	apiexceptions "github.com/HiIamJeff67/notegic-backend/internal/core/exceptions"
+	billingtransport "github.com/HiIamJeff67/notegic-backend/internal/core/transports/billing"
+)
+
+const (
+	defaultBillingInvoiceLimit = 20
+	cancelledByUserReason      = "Cancelled by the user"
+	lapsedSubscriptionReason   = "The payment of the subscription has failed beyond its grace period"
+)
+
+// the namespace of the inbox event ids derived from the event ids of PayPal,
+// which are not UUIDs themselves
+var payPalWebhookEventNamespace = uuid.MustParse("8b0e7bb4-5d0c-4f43-9a7e-4b0b6f1d7c39")
+
+// PayPal sends the amounts as decimal strings in the major unit of the currency,
+// they are stored as they are instead of going through a float
+var payPalAmountPattern = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?$`)
+
+// a subscription in these statuses blocks a new one, while the one still pending
+// the approval of the user is replaced by the new one instead
+var liveSubscriptionStatuses = []enums.UsersToBillingPlansStatus{
+	enums.UsersToBillingPlansStatus_Approved,
+	enums.UsersToBillingPlansStatus_Active,
+	enums.UsersToBillingPlansStatus_Suspended,
+}
+
+// the statuses each status can move to, a cancelled or expired subscription is final at
+// the provider, so a late or redelivered event can never bring it back
+var subscriptionTransitions = map[enums.UsersToBillingPlansStatus][]enums.UsersToBillingPlansStatus{
+	enums.UsersToBillingPlansStatus_ApprovalPending: {
+		enums.UsersToBillingPlansStatus_Approved,
+		enums.UsersToBillingPlansStatus_Active,
+		enums.UsersToBillingPlansStatus_Cancelled,
+		enums.UsersToBillingPlansStatus_Expired,
+	},
+	enums.UsersToBillingPlansStatus_Approved: {
+		enums.UsersToBillingPlansStatus_Active,
+		enums.UsersToBillingPlansStatus_Cancelled,
+		enums.UsersToBillingPlansStatus_Expired,
+	},
+	enums.UsersToBillingPlansStatus_Active: {
+		enums.UsersToBillingPlansStatus_Suspended,
+		enums.UsersToBillingPlansStatus_Cancelled,
+		enums.UsersToBillingPlansStatus_Expired,
+	},
+	enums.UsersToBillingPlansStatus_Suspended: {
+		enums.UsersToBillingPlansStatus_Active,
+		enums.UsersToBillingPlansStatus_Cancelled,
+		enums.UsersToBillingPlansStatus_Expired,
+	},
+	enums.UsersToBillingPlansStatus_Cancelled: {
+		enums.UsersToBillingPlansStatus_Expired,
+	},
+}
+
+// the webhook events of PayPal handled by HandlePayPalWebhook
+const (
+	payPalEvent_SubscriptionActivated     = "BILLING.SUBSCRIPTION.ACTIVATED"
+	payPalEvent_SubscriptionReActivated   = "BILLING.SUBSCRIPTION.RE-ACTIVATED"
+	payPalEvent_SubscriptionUpdated       = "BILLING.SUBSCRIPTION.UPDATED"
+	payPalEvent_SubscriptionSuspended     = "BILLING.SUBSCRIPTION.SUSPENDED"
+	payPalEvent_SubscriptionCancelled     = "BILLING.SUBSCRIPTION.CANCELLED"
+	payPalEvent_SubscriptionExpired       = "BILLING.SUBSCRIPTION.EXPIRED"
+	payPalEvent_SubscriptionPaymentFailed = "BILLING.SUBSCRIPTION.PAYMENT.FAILED"
+	payPalEvent_PaymentSaleCompleted      = "PAYMENT.SALE.COMPLETED"
+	payPalEvent_PaymentSaleRefunded       = "PAYMENT.SALE.REFUNDED"
+)
+
+type payPalSubscriptionResource struct {
+	Id          string `json:"id"`
+	PlanId      string `json:"plan_id"`
+	Status      string `json:"status"`
+	BillingInfo *struct {
+		NextBillingTime *time.Time `json:"next_billing_time"`
+	} `json:"billing_info"`
+}
+
+type payPalSaleResource struct {
+	Id                 string `json:"id"`
+	SaleId             string `json:"sale_id"`
+	BillingAgreementId string `json:"billing_agreement_id"`
+	Amount             struct {
+		Total    string `json:"total"`
+		Currency string `json:"currency"`
+	} `json:"amount"`
+	CreateTime *time.Time `json:"create_time"`
+}
+
+type BillingServiceInterface interface {
+	GetBillingPlans(ctx context.Context, requestDto *apicontract.GetBillingPlansRequestDto) (*apicontract.GetBillingPlansResponseDto, *exceptions.Exception)
+	GetMySubscription(ctx context.Context, requestDto *apicontract.GetMySubscriptionRequestDto) (*apicontract.GetMySubscriptionResponseDto, *exceptions.Exception)
+	CreateMySubscription(ctx context.Context, requestDto *apicontract.CreateMySubscriptionRequestDto) (*apicontract.CreateMySubscriptionResponseDto, *exceptions.Exception)
+	ChangeMySubscriptionPlan(ctx context.Context, requestDto *apicontract.ChangeMySubscriptionPlanRequestDto) (*apicontract.ChangeMySubscriptionPlanResponseDto, *exceptions.Exception)
+	CancelMySubscription(ctx context.Context, requestDto *apicontract.CancelMySubscriptionRequestDto) (*apicontract.CancelMySubscriptionResponseDto, *exceptions.Exception)
+	GetMyBillingInvoices(ctx context.Context, requestDto *apicontract.GetMyBillingInvoicesRequestDto) (*apicontract.GetMyBillingInvoicesResponseDto, *exceptions.Exception)
+	HandlePayPalWebhook(ctx context.Context, requestDto *apicontract.HandlePayPalWebhookRequestDto) (*apicontract.HandlePayPalWebhookResponseDto, *exceptions.Exception)
+
+	ExpireNextLapsedSubscription(ctx context.Context) (bool, *exceptions.Exception)
+	CancelNextPendingProviderSubscription(ctx context.Context) (bool, *exceptions.Exception)
+}
+
+type BillingService struct {
+	validator                     *validator.Validate
+	db                            *gorm.DB
+	provider                      billingtransport.ProviderInterface
+	gracePeriod                   time.Duration
+	userDataCacheClient           *userdata.UserDataCacheClient
+	userRepository                repositories.UserRepositoryInterface
+	billingPlanRepository         repositories.BillingPlanRepositoryInterface
+	usersToBillingPlansRepository repositories.UsersToBillingPlansRepositoryInterface
+	billingInvoiceRepository      repositories.BillingInvoiceRepositoryInterface
+}
+
+// NewBillingService accepts a nil provider when PayPal is not configured,
+// the plans and the existing subscriptions can still be read in that case.
+func NewBillingService(
+	validator *validator.Validate,
+	db *gorm.DB,
+	provider billingtransport.ProviderInterface,
+	gracePeriod time.Duration,
+	userDataCacheClient *userdata.UserDataCacheClient,
+	userRepository repositories.UserRepositoryInterface,
+	billingPlanRepository repositories.BillingPlanRepositoryInterface,
+	usersToBillingPlansRepository repositories.UsersToBillingPlansRepositoryInterface,
+	billingInvoiceRepository repositories.BillingInvoiceRepositoryInterface,
+) BillingServiceInterface {
+	return &BillingService{
+		validator:                     validator,
+		db:                            db,
+		provider:                      provider,
+		gracePeriod:                   gracePeriod,
+		userDataCacheClient:           userDataCacheClient,
+		userRepository:                userRepository,
+		billingPlanRepository:         billingPlanRepository,
+		usersToBillingPlansRepository: usersToBillingPlansRepository,
+		billingInvoiceRepository:      billingInvoiceRepository,
+	}
+}
+
+/* ============================== Auxiliary Functions ============================== */
+
+// userPlanOf maps a billing plan to the plan it grants, the monthly and the yearly
+// billing plans of the same tier grant the same plan
+func userPlanOf(billingPlanName enums.BillingPlanName) enums.UserPlan {
+	switch billingPlanName {
+	case enums.BillingPlanName_NotegicMonthlyProPlan, enums.BillingPlanName_NotegicYearlyProPlan:
+		return enums.UserPlan_Pro
+	case enums.BillingPlanName_NotegicMonthlyPremiumPlan, enums.BillingPlanName_NotegicYearlyPremiumPlan:
+		return enums.UserPlan_Premium
+	case enums.BillingPlanName_NotegicMonthlyUltimatePlan, enums.BillingPlanName_NotegicYearlyUltimatePlan:
+		return enums.UserPlan_Ultimate
+	case enums.BillingPlanName_NotegicMonthlyEnterprisePlan, enums.BillingPlanName_NotegicYearlyEnterprisePlan:
+		return enums.UserPlan_Enterprise
+	default:
+		return enums.UserPlan_Free
+	}
+}
+
+// isHigherUserPlan relies on enums.AllUserPlans being placed in the descending order
+func isHigherUserPlan(plan enums.UserPlan, than enums.UserPlan) bool {
+	return slices.Index(enums.AllUserPlans, plan) < slices.Index(enums.AllUserPlans, than)
+}
+
+func isSubscribable(billingPlan *schemas.BillingPlan) bool {
+	return billingPlan.Status == enums.BillingPlanStatus_Active &&
+		billingPlan.Price > 0 &&
+		userPlanOf(billingPlan.Name) != enums.UserPlan_Free
+}
+
+func newBillingPlanResponseDto(billingPlan schemas.BillingPlan) apicontract.BillingPlanResponseDto {
+	plan := userPlanOf(billingPlan.Name)
+	return apicontract.BillingPlanResponseDto{
+		Id:           billingPlan.Id,
+		Name:         *billingPlan.Name.ToContractable(),
+		Plan:         *plan.ToContractable(),
+		IntervalUnit: *billingPlan.IntervalUnit.ToContractable(),
+		Price:        billingPlan.Price,
+		CurrencyCode: *billingPlan.CurrencyCode.ToContractable(),
+	}
+}
+
+func newSubscriptionResponseDto(subscription schemas.UsersToBillingPlans) apicontract.SubscriptionResponseDto {
+	var nextBillingDate *time.Time
+	if !subscription.NextBillingDate.IsZero() {
+		nextBillingDate = &subscription.NextBillingDate
+	}
+
+	return apicontract.SubscriptionResponseDto{
+		Id:                subscription.Id,
+		BillingPlan:       newBillingPlanResponseDto(subscription.BillingPlan),
+		Status:            *subscription.Status.ToContractable(),
+		StartDate:         subscription.StartDate,
+		EndDate:           subscription.EndDate,
+		NextBillingDate:   nextBillingDate,
+		GracePeriodEndsAt: subscription.GracePeriodEndsAt,
+		FailureCount:      subscription.FailureCount,
+		CreatedAt:         subscription.CreatedAt,
+		UpdatedAt:         subscription.UpdatedAt,
+	}
+}
+
+func newBillingInvoiceResponseDto(billingInvoice schemas.BillingInvoice) apicontract.BillingInvoiceResponseDto {
+	return apicontract.BillingInvoiceResponseDto{
+		Id:             billingInvoice.Id,
+		SubscriptionId: billingInvoice.UsersToBillingPlansId,
+		BillingPlanId:  billingInvoice.BillingPlanId,
+		Amount:         billingInvoice.Amount,
+		CurrencyCode:   *billingInvoice.CurrencyCode.ToContractable(),
+		Status:         *billingInvoice.Status.ToContractable(),
+		PaidAt:         billingInvoice.PaidAt,
+		RefundedAt:     billingInvoice.RefundedAt,
+	}
+}
+
+// newProviderException tells the requests rejected by the provider apart from the provider
+// being unreachable, only the latter is worth retrying
+func newProviderException(method string, err error) *exceptions.Exception {
+	var providerError *billingtransport.ProviderError
+	if errors.As(err, &providerError) && providerError.IsClientError() {
+		return apiexceptions.NewBillingException().ProviderRejected(method).WithOrigin(err)
+	}
+
+	return apiexceptions.NewBillingException().ProviderUnavailable(method).WithOrigin(err)
+}
+
+// cancelProviderSubscription cancels the subscription at the provider once its local cancellation
+// has been committed, so that no transaction is held open across the call, the subscriptions the
+// provider has already cancelled or no longer knows about are done all the same
+func (s *BillingService) cancelProviderSubscription(
+	ctx context.Context,
+	subscription *schemas.UsersToBillingPlans,
+	method string,
+) *exceptions.Exception {
+	if s.provider == nil || subscription.ProviderSubscriptionId == nil || subscription.ProviderCancellationReason == nil {
+		return nil
+	}
+
+	if err := s.provider.CancelSubscription(
+		ctx,
+		*subscription.ProviderSubscriptionId,
+		*subscription.ProviderCancellationReason,
+	); err != nil {
+		var providerError *billingtransport.ProviderError
+		if !errors.As(err, &providerError) || !providerError.IsClientError() {
+			return apiexceptions.NewBillingException().ProviderUnavailable(method).WithOrigin(err)
+		}
+	}
+	if exception := s.usersToBillingPlansRepository.ClearProviderCancellationOneById(
+		subscription.Id,
+		options.WithDB(s.db.WithContext(ctx)),
+	); exception != nil {
+		return exception
+	}
+	subscription.ProviderCancellationReason = nil
+
+	return nil
+}
+
+// transitSubscription moves the subscription to the status and fills the dates the status
+// depends on, it returns false if the move is not allowed or changes nothing
+func (s *BillingService) transitSubscription(
+	subscription *schemas.UsersToBillingPlans,
+	status enums.UsersToBillingPlansStatus,
+	now time.Time,
+) bool {
+	if subscription.Status == status || !slices.Contains(subscriptionTransitions[subscription.Status], status) {
+		return false
+	}
+
+	switch status {
+	case enums.UsersToBillingPlansStatus_Active:
+		subscription.GracePeriodEndsAt = nil
+		subscription.FailureCount = 0
+		subscription.EndDate = nil
+	case enums.UsersToBillingPlansStatus_Suspended:
+		if subscription.GracePeriodEndsAt == nil {
+			gracePeriodEndsAt := now.Add(s.gracePeriod)
+			subscription.GracePeriodEndsAt = &gracePeriodEndsAt
+		}
+	case enums.UsersToBillingPlansStatus_Cancelled:
+		// the plan is kept until the end of the period which has been paid,
+		// or until the grace period ends if the payment is already failing
+		endDate := now
+		if subscription.GracePeriodEndsAt != nil {
+			endDate = *subscription.GracePeriodEndsAt
+		} else if subscription.Status == enums.UsersToBillingPlansStatus_Active && subscription.NextBillingDate.After(now) {
+			endDate = subscription.NextBillingDate
+		}
+		subscription.EndDate = &endDate
+	case enums.UsersToBillingPlansStatus_Expired:
+		if subscription.EndDate == nil || subscription.EndDate.After(now) {
+			subscription.EndDate = &now
+		}
+	}
+	subscription.Status = status
+
+	return true
+}
+
+// syncUserPlan grants the user the highest plan among the subscriptions still entitling it,
+// the returned user carries the plan to be written into the cache after the commit
+func (s *BillingService) syncUserPlan(
+	tx *gorm.DB,
+	userId uuid.UUID,
+	now time.Time,
+) (*schemas.User, *exceptions.Exception) {
+	user, exception := s.userRepository.GetOneById(
+		userId,
+		nil,
+		options.WithTransactionDB(tx),
+		options.WithLockingStrength(options.LockingStrengthNoKeyUpdate),
+	)
+	if exception != nil {
+		return nil, exception
+	}
+
+	subscriptions, exception := s.usersToBillingPlansRepository.GetManyEntitlingByUserId(
+		userId,
+		now,
+		options.WithTransactionDB(tx),
+	)
+	if exception != nil {
+		return nil, exception
+	}
+
+	plan := enums.UserPlan_Free
+	for _, subscription := range subscriptions {
+		if subscriptionPlan := userPlanOf(subscription.BillingPlan.Name); isHigherUserPlan(subscriptionPlan, plan) {
+			plan = subscriptionPlan
+		}
+	}
+	if user.Plan == plan {
+		return user, nil
+	}
+
+	if _, exception := s.userRepository.UpdateOneById(
+		userId,
+		inputs.PartialUpdateUserInput{
+			Values: inputs.UpdateUserInput{
+				Plan: &plan,
+			},
+			SetNull: nil,
+		},
+		options.WithTransactionDB(tx),
+	); exception != nil {
+		return nil, exception
+	}
+	user.Plan = plan
+
+	return user, nil
+}
+
+func (s *BillingService) updateUserPlanCache(ctx context.Context, user *schemas.User) {
+	exception := s.userDataCacheClient.Update(user.Name, cacheinputs.UpdateUserDataCacheInput{
+		Plan: &user.Plan,
+	})
+	// the user data is only cached while the user is signed in
+	if exception != nil && exception.HTTPStatusCode() != http.StatusNotFound && logs.NotegicLogger != nil {
+		logs.NotegicLogger.Error(
+			ctx,
+			exception.Origin(),
+			exception.String(),
+		)
+	}
+}
+
+/* ============================== Service Methods for Billing Plans ============================== */
+
+func (s *BillingService) GetBillingPlans(
+	ctx context.Context,
+	requestDto *apicontract.GetBillingPlansRequestDto,
+) (*apicontract.GetBillingPlansResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(requestDto); err != nil {
+		return nil, apiexceptions.NewBillingException().InvalidDto().WithOrigin(err)
+	}
+
+	billingPlans, exception := s.billingPlanRepository.GetAllActive(
+		options.WithDB(s.db.WithContext(ctx)),
+	)
+	if exception != nil {
+		return nil, exception
+	}
+
+	responseDto := make(apicontract.GetBillingPlansResponseDto, 0, len(billingPlans))
+	for _, billingPlan := range billingPlans {
+		responseDto = append(responseDto, newBillingPlanResponseDto(billingPlan))
+	}
+
+	return &responseDto, nil
+}
+
+/* ============================== Service Methods for Subscriptions ============================== */
+
+func (s *BillingService) GetMySubscription(
+	ctx context.Context,
+	requestDto *apicontract.GetMySubscriptionRequestDto,
+) (*apicontract.GetMySubscriptionResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(requestDto); err != nil {
+		return nil, apiexceptions.NewBillingException().InvalidDto().WithOrigin(err)
+	}
+
+	db := s.db.WithContext(ctx)
+
+	actorUserId, exception := contexts.GetActorUserId(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+	user, exception := s.userRepository.GetOneById(actorUserId, nil, options.WithDB(db))
+	if exception != nil {
+		return nil, exception
+	}
+
+	responseDto := &apicontract.GetMySubscriptionResponseDto{
+		Plan: *user.Plan.ToContractable(),
+	}
+	subscription, exception := s.usersToBillingPlansRepository.GetLatestByUserId(actorUserId, options.WithDB(db))
+	if exception == nil {
+		subscriptionResponseDto := newSubscriptionResponseDto(*subscription)
+		responseDto.Subscription = &subscriptionResponseDto
+	}
+
+	return responseDto, nil
+}
+
+func (s *BillingService) CreateMySubscription(
+	ctx context.Context,
+	requestDto *apicontract.CreateMySubscriptionRequestDto,
+) (*apicontract.CreateMySubscriptionResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(requestDto); err != nil {
+		return nil, apiexceptions.NewBillingException().InvalidDto().WithOrigin(err)
+	}
+	if s.provider == nil {
+		return nil, apiexceptions.NewBillingException().ProviderNotConfigured("CreateMySubscription")
+	}
+
+	db := s.db.WithContext(ctx)
+
+	actorUserId, exception := contexts.GetActorUserId(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+	billingPlan, exception := s.billingPlanRepository.GetOneById(requestDto.Body.BillingPlanId, options.WithDB(db))
+	if exception != nil || !isSubscribable(billingPlan) {
+		return nil, apiexceptions.NewBillingException().PlanNotSubscribable()
+	}
+
+	now := time.Now()
+	tx := db.Begin()
+	// the user is locked so that the concurrent requests of the same user create one subscription at most
+	if _, exception := s.userRepository.GetOneById(
+		actorUserId,
+		nil,
+		options.WithTransactionDB(tx),
+		options.WithLockingStrength(options.LockingStrengthNoKeyUpdate),
+	); exception != nil {
+		tx.Rollback()
+		return nil, exception
+	}
+	latestSubscription, exception := s.usersToBillingPlansRepository.GetLatestByUserId(
+		actorUserId,
+		options.WithTransactionDB(tx),
+		options.WithLockingStrength(options.LockingStrengthNoKeyUpdate),
+	)
+	if exception == nil {
+		if slices.Contains(liveSubscriptionStatuses, latestSubscription.Status) {
+			tx.Rollback()
+			return nil, apiexceptions.NewBillingException().SubscriptionAlreadyExists()
+		}
+		// the approval of the previous attempt is abandoned, PayPal expires it on its own
+		if s.transitSubscription(latestSubscription, enums.UsersToBillingPlansStatus_Expired, now) {
+			if exception := s.usersToBillingPlansRepository.UpdateStateOneById(
+				latestSubscription,
+				options.WithTransactionDB(tx),
+			); exception != nil {
+				tx.Rollback()
+				return nil, exception
+			}
+		}
+	}
+
+	providerSubscription, err := s.provider.CreateSubscription(ctx, billingtransport.CreateSubscriptionInput{
+		RequestId: uuid.NewString(),
+		PlanId:    billingPlan.Id,
+		CustomId:  actorUserId.String(),
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, newProviderException("CreateMySubscription", err)
+	}
+	if providerSubscription.ApprovalURL == "" {
+		tx.Rollback()
+		return nil, apiexceptions.NewBillingException().ProviderUnavailable("CreateMySubscription")
+	}
+
+	if _, exception := s.usersToBillingPlansRepository.CreateOne(
+		actorUserId,
+		inputs.CreateUsersToBillingPlansInput{
+			BillingPlanId:          billingPlan.Id,
+			ProviderSubscriptionId: &providerSubscription.Id,
+			Status:                 enums.UsersToBillingPlansStatus_ApprovalPending,
+			StartDate:              now,
+		},
+		options.WithTransactionDB(tx),
+	); exception != nil {
+		tx.Rollback()
+		return nil, exception
+	}
+	subscription, exception := s.usersToBillingPlansRepository.GetLatestByUserId(
+		actorUserId,
+		options.WithTransactionDB(tx),
+	)
+	if exception != nil {
+		tx.Rollback()
+		return nil, exception
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, apiexceptions.NewBillingException().FailedToCommitTransaction().WithOrigin(err)
+	}
+
+	return &apicontract.CreateMySubscriptionResponseDto{
+		Subscription: newSubscriptionResponseDto(*subscription),
+		ApprovalURL:  providerSubscription.ApprovalURL,
+	}, nil
+}
+
+func (s *BillingService) ChangeMySubscriptionPlan(
+	ctx context.Context,
+	requestDto *apicontract.ChangeMySubscriptionPlanRequestDto,
+) (*apicontract.ChangeMySubscriptionPlanResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(requestDto); err != nil {
+		return nil, apiexceptions.NewBillingException().InvalidDto().WithOrigin(err)
+	}
+	if s.provider == nil {
+		return nil, apiexceptions.NewBillingException().ProviderNotConfigured("ChangeMySubscriptionPlan")
+	}
+
+	db := s.db.WithContext(ctx)
+
+	actorUserId, exception := contexts.GetActorUserId(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+	billingPlan, exception := s.billingPlanRepository.GetOneById(requestDto.Body.BillingPlanId, options.WithDB(db))
+	if exception != nil || !isSubscribable(billingPlan) {
+		return nil, apiexceptions.NewBillingException().PlanNotSubscribable()
+	}
+
+	subscription, exception := s.usersToBillingPlansRepository.GetLatestByUserId(actorUserId, options.WithDB(db))
+	if exception != nil ||
+		subscription.ProviderSubscriptionId == nil ||
+		(subscription.Status != enums.UsersToBillingPlansStatus_Active &&
+			subscription.Status != enums.UsersToBillingPlansStatus_Suspended) {
+		return nil, apiexceptions.NewBillingException().NoActiveSubscription("ChangeMySubscriptionPlan")
+	}
+	if subscription.BillingPlanId == billingPlan.Id {
+		return nil, apiexceptions.NewBillingException().SamePlan()
+	}
+
+	// the billing plan of the subscription is only switched by the BILLING.SUBSCRIPTION.UPDATED
+	// webhook, after the user approves the change at PayPal
+	providerSubscription, err := s.provider.ReviseSubscription(ctx, *subscription.ProviderSubscriptionId, billingPlan.Id)
+	if err != nil {
+		return nil, newProviderException("ChangeMySubscriptionPlan", err)
+	}
+
+	return &apicontract.ChangeMySubscriptionPlanResponseDto{
+		Subscription: newSubscriptionResponseDto(*subscription),
+		ApprovalURL:  providerSubscription.ApprovalURL,
+	}, nil
+}
+
+func (s *BillingService) CancelMySubscription(
+	ctx context.Context,
+	requestDto *apicontract.CancelMySubscriptionRequestDto,
+) (*apicontract.CancelMySubscriptionResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(requestDto); err != nil {
+		return nil, apiexceptions.NewBillingException().InvalidDto().WithOrigin(err)
+	}
+	if s.provider == nil {
+		return nil, apiexceptions.NewBillingException().ProviderNotConfigured("CancelMySubscription")
+	}
+
+	db := s.db.WithContext(ctx)
+
+	actorUserId, exception := contexts.GetActorUserId(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+
+	now := time.Now()
+	tx := db.Begin()
+	subscription, exception := s.usersToBillingPlansRepository.GetLatestByUserId(
+		actorUserId,
+		options.WithTransactionDB(tx),
+		options.WithLockingStrength(options.LockingStrengthNoKeyUpdate),
+	)
+	if exception != nil ||
+		subscription.ProviderSubscriptionId == nil ||
+		!slices.Contains(liveSubscriptionStatuses, subscription.Status) {
+		tx.Rollback()
+		return nil, apiexceptions.NewBillingException().NoActiveSubscription("CancelMySubscription")
+	}
+
+	reason := cancelledByUserReason
+	if requestDto.Body.Reason != nil {
+		reason = *requestDto.Body.Reason
+	}
+
+	// the BILLING.SUBSCRIPTION.CANCELLED webhook of this cancellation changes nothing afterward,
+	// and the billing worker retries the cancellation at PayPal if the call below fails
+	s.transitSubscription(subscription, enums.UsersToBillingPlansStatus_Cancelled, now)
+	subscription.ProviderCancellationReason = &reason
+	if exception := s.usersToBillingPlansRepository.UpdateStateOneById(
+		subscription,
+		options.WithTransactionDB(tx),
+	); exception != nil {
+		tx.Rollback()
+		return nil, exception
+	}
+	user, exception := s.syncUserPlan(tx, actorUserId, now)
+	if exception != nil {
+		tx.Rollback()
+		return nil, exception
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, apiexceptions.NewBillingException().FailedToCommitTransaction().WithOrigin(err)
+	}
+	s.updateUserPlanCache(ctx, user)
+	if exception := s.cancelProviderSubscription(ctx, subscription, "CancelMySubscription"); exception != nil && logs.NotegicLogger != nil {
+		logs.NotegicLogger.Error(
+			ctx,
+			exception.Origin(),
+			exception.String(),
+		)
+	}
+
+	return &apicontract.CancelMySubscriptionResponseDto{
+		Subscription: newSubscriptionResponseDto(*subscription),
+	}, nil
+}
+
+/* ============================== Service Methods for Billing Invoices ============================== */
+
+func (s *BillingService) GetMyBillingInvoices(
+	ctx context.Context,
+	requestDto *apicontract.GetMyBillingInvoicesRequestDto,
+) (*apicontract.GetMyBillingInvoicesResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(requestDto); err != nil {
+		return nil, apiexceptions.NewBillingException().InvalidDto().WithOrigin(err)
+	}
+
+	actorUserId, exception := contexts.GetActorUserId(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+
+	limit := requestDto.Param.Limit
+	if limit == 0 {
+		limit = defaultBillingInvoiceLimit
+	}
+	billingInvoices, exception := s.billingInvoiceRepository.GetManyByUserId(
+		actorUserId,
+		limit,
+		requestDto.Param.Offset,
+		options.WithDB(s.db.WithContext(ctx)),
+	)
+	if exception != nil {
+		return nil, exception
+	}
+
+	responseDto := make(apicontract.GetMyBillingInvoicesResponseDto, 0, len(billingInvoices))
+	for _, billingInvoice := range billingInvoices {
+		responseDto = append(responseDto, newBillingInvoiceResponseDto(billingInvoice))
+	}
+
+	return &responseDto, nil
+}
+
+/* ============================== Service Methods for Webhooks ============================== */
+
+func (s *BillingService) HandlePayPalWebhook(
+	ctx context.Context,
+	requestDto *apicontract.HandlePayPalWebhookRequestDto,
+) (*apicontract.HandlePayPalWebhookResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(requestDto); err != nil {
+		return nil, apiexceptions.NewBillingException().InvalidDto().WithOrigin(err)
+	}
+	if s.provider == nil {
+		return nil, apiexceptions.NewBillingException().ProviderNotConfigured("HandlePayPalWebhook")
+	}
+
+	event, err := s.provider.VerifyWebhookEvent(ctx, billingtransport.WebhookHeaders{
+		AuthAlgo:         requestDto.Header.AuthAlgo,
+		CertURL:          requestDto.Header.CertURL,
+		TransmissionId:   requestDto.Header.TransmissionId,
+		TransmissionSig:  requestDto.Header.TransmissionSig,
+		TransmissionTime: requestDto.Header.TransmissionTime,
+	}, []byte(requestDto.Body.Payload))
+	if err != nil {
+		if errors.Is(err, billingtransport.ErrInvalidWebhookSignature) {
+			return nil, apiexceptions.NewBillingException().InvalidWebhookSignature().WithOrigin(err)
+		}
+		var providerError *billingtransport.ProviderError
+		if !errors.As(err, &providerError) {
+			return nil, apiexceptions.NewBillingException().InvalidWebhookEvent().WithOrigin(err)
+		}
+		return nil, apiexceptions.NewBillingException().ProviderUnavailable("HandlePayPalWebhook").WithOrigin(err)
+	}
+	responseDto := &apicontract.HandlePayPalWebhookResponseDto{
+		EventId:   event.Id,
+		EventType: event.EventType,
+	}
+
+	now := time.Now()
+	tx := s.db.WithContext(ctx).Begin()
+	// PayPal redelivers an event until it is acknowledged, so it is recorded once in the inbox
+	result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&schemas.InboxEvent{
+		EventId: uuid.NewSHA1(payPalWebhookEventNamespace, []byte(event.Id)),
+	})
+	if result.Error != nil {
+		tx.Rollback()
+		return nil, apiexceptions.NewBillingException().FailedToCreate().WithOrigin(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		tx.Rollback()
+		return responseDto, nil
+	}
+
+	subscription, exception := s.applyPayPalWebhookEvent(tx, event, now)
+	if exception != nil {
+		tx.Rollback()
+		return nil, exception
+	}
+	var user *schemas.User
+	if subscription != nil {
+		if user, exception = s.syncUserPlan(tx, subscription.UserId, now); exception != nil {
+			tx.Rollback()
+			return nil, exception
+		}
+		responseDto.Processed = true
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, apiexceptions.NewBillingException().FailedToCommitTransaction().WithOrigin(err)
+	}
+	if user != nil {
+		s.updateUserPlanCache(ctx, user)
+	}
+
+	return responseDto, nil
+}
+
+// applyPayPalWebhookEvent returns the subscription changed by the event, or nil if the event
+// is not supported or refers to a subscription or a payment this application does not know
+func (s *BillingService) applyPayPalWebhookEvent(
+	tx *gorm.DB,
+	event *billingtransport.WebhookEvent,
+	now time.Time,
+) (*schemas.UsersToBillingPlans, *exceptions.Exception) {
+	switch event.EventType {
+	case payPalEvent_SubscriptionActivated,
+		payPalEvent_SubscriptionReActivated,
+		payPalEvent_SubscriptionUpdated,
+		payPalEvent_SubscriptionSuspended,
+		payPalEvent_SubscriptionCancelled,
+		payPalEvent_SubscriptionExpired,
+		payPalEvent_SubscriptionPaymentFailed:
+		var resource payPalSubscriptionResource
+		if err := json.Unmarshal(event.Resource, &resource); err != nil || resource.Id == "" {
+			return nil, apiexceptions.NewBillingException().InvalidWebhookEvent().WithOrigin(err)
+		}
+		subscription, exception := s.usersToBillingPlansRepository.GetOneByProviderSubscriptionId(
+			resource.Id,
+			options.WithTransactionDB(tx),
+			options.WithLockingStrength(options.LockingStrengthNoKeyUpdate),
+		)
+		if exception != nil {
+			return nil, nil
+		}
+		if resource.BillingInfo != nil && resource.BillingInfo.NextBillingTime != nil {
+			subscription.NextBillingDate = *resource.BillingInfo.NextBillingTime
+		}
+
+		switch event.EventType {
+		case payPalEvent_SubscriptionActivated, payPalEvent_SubscriptionReActivated:
+			s.transitSubscription(subscription, enums.UsersToBillingPlansStatus_Active, now)
+		case payPalEvent_SubscriptionUpdated:
+			// the approved plan change of ChangeMySubscriptionPlan arrives here
+			if resource.PlanId != "" && resource.PlanId != subscription.BillingPlanId {
+				if _, exception := s.billingPlanRepository.GetOneById(resource.PlanId, options.WithTransactionDB(tx)); exception == nil {
+					subscription.BillingPlanId = resource.PlanId
+				}
+			}
+			if resource.Status == string(enums.UsersToBillingPlansStatus_Active) {
+				s.transitSubscription(subscription, enums.UsersToBillingPlansStatus_Active, now)
+			}
+		case payPalEvent_SubscriptionSuspended:
+			s.transitSubscription(subscription, enums.UsersToBillingPlansStatus_Suspended, now)
+		case payPalEvent_SubscriptionCancelled:
+			s.transitSubscription(subscription, enums.UsersToBillingPlansStatus_Cancelled, now)
+			// the subscription is already cancelled at PayPal, so there is nothing left to retry
+			subscription.ProviderCancellationReason = nil
+		case payPalEvent_SubscriptionExpired:
+			s.transitSubscription(subscription, enums.UsersToBillingPlansStatus_Expired, now)
+			subscription.ProviderCancellationReason = nil
+		case payPalEvent_SubscriptionPaymentFailed:
+			if subscription.Status == enums.UsersToBillingPlansStatus_Active ||
+				subscription.Status == enums.UsersToBillingPlansStatus_Suspended {
+				subscription.FailureCount++
+				if subscription.GracePeriodEndsAt == nil {
+					gracePeriodEndsAt := now.Add(s.gracePeriod)
+					subscription.GracePeriodEndsAt = &gracePeriodEndsAt
+				}
+			}
+		}
+
+		if exception := s.usersToBillingPlansRepository.UpdateStateOneById(
+			subscription,
+			options.WithTransactionDB(tx),
+		); exception != nil {
+			return nil, exception
+		}
+		return subscription, nil
+
+	case payPalEvent_PaymentSaleCompleted:
+		var resource payPalSaleResource
+		if err := json.Unmarshal(event.Resource, &resource); err != nil || resource.Id == "" {
+			return nil, apiexceptions.NewBillingException().InvalidWebhookEvent().WithOrigin(err)
+		}
+		if resource.BillingAgreementId == "" {
+			// a one-time payment which does not belong to any subscription
+			return nil, nil
+		}
+		currencyCode := enums.SupportedCurrencyCode(resource.Amount.Currency)
+		if !payPalAmountPattern.MatchString(resource.Amount.Total) || !currencyCode.IsValidEnum() {
+			return nil, apiexceptions.NewBillingException().InvalidWebhookEvent()
+		}
+		subscription, exception := s.usersToBillingPlansRepository.GetOneByProviderSubscriptionId(
+			resource.BillingAgreementId,
+			options.WithTransactionDB(tx),
+			options.WithLockingStrength(options.LockingStrengthNoKeyUpdate),
+		)
+		if exception != nil {
+			return nil, nil
+		}
+
+		paidAt := now
+		if resource.CreateTime != nil {
+			paidAt = *resource.CreateTime
+		}
+		if _, exception := s.billingInvoiceRepository.CreateOneIfNotExists(
+			subscription.UserId,
+			inputs.CreateBillingInvoiceInput{
+				UsersToBillingPlansId: subscription.Id,
+				BillingPlanId:         subscription.BillingPlanId,
+				ProviderPaymentId:     resource.Id,
+				Amount:                resource.Amount.Total,
+				CurrencyCode:          currencyCode,
+				PaidAt:                paidAt,
+			},
+			options.WithTransactionDB(tx),
+		); exception != nil {
+			return nil, exception
+		}
+		// a successful payment ends the grace period of the failing ones before it
+		if subscription.Status == enums.UsersToBillingPlansStatus_Active {
+			subscription.FailureCount = 0
+			subscription.GracePeriodEndsAt = nil
+		} else {
+			s.transitSubscription(subscription, enums.UsersToBillingPlansStatus_Active, now)
+		}
+		if exception := s.usersToBillingPlansRepository.UpdateStateOneById(
+			subscription,
+			options.WithTransactionDB(tx),
+		); exception != nil {
+			return nil, exception
+		}
+		return subscription, nil
+
+	case payPalEvent_PaymentSaleRefunded:
+		var resource payPalSaleResource
+		if err := json.Unmarshal(event.Resource, &resource); err != nil || resource.SaleId == "" {
+			return nil, apiexceptions.NewBillingException().InvalidWebhookEvent().WithOrigin(err)
+		}
+		refunded, exception := s.billingInvoiceRepository.RefundOneByProviderPaymentId(
+			resource.SaleId,
+			now,
+			options.WithTransactionDB(tx),
+		)
+		if exception != nil || !refunded {
+			return nil, exception
+		}
+		// a refund does not revoke the plan by itself, PayPal cancels the subscription if it has to
+		return nil, nil
+	}
+
+	return nil, nil
+}
+
+/* ============================== Service Methods for Workers ============================== */
+
+// ExpireNextLapsedSubscription expires one subscription whose grace period or paid period has
+// ended and downgrades its user, it returns false when there is no lapsed subscription left
+func (s *BillingService) ExpireNextLapsedSubscription(ctx context.Context) (bool, *exceptions.Exception) {
+	now := time.Now()
+	tx := s.db.WithContext(ctx).Begin()
+	subscriptions, exception := s.usersToBillingPlansRepository.GetManyLapsed(
+		now,
+		1,
+		options.WithTransactionDB(tx),
+		options.WithLockingStrength(options.LockingStrengthNoKeyUpdate),
+	)
+	if exception != nil {
+		tx.Rollback()
+		return false, exception
+	}
+	if len(subscriptions) == 0 {
+		tx.Rollback()
+		return false, nil
+	}
+	subscription := subscriptions[0]
+
+	// the subscription whose payment keeps failing is cancelled at PayPal so that it stops retrying,
+	// which happens after the commit, the cancelled ones have been marked by CancelMySubscription already
+	if subscription.ProviderSubscriptionId != nil && subscription.Status != enums.UsersToBillingPlansStatus_Cancelled {
+		reason := lapsedSubscriptionReason
+		subscription.ProviderCancellationReason = &reason
+	}
+	s.transitSubscription(&subscription, enums.UsersToBillingPlansStatus_Expired, now)
+	if exception := s.usersToBillingPlansRepository.UpdateStateOneById(
+		&subscription,
+		options.WithTransactionDB(tx),
+	); exception != nil {
+		tx.Rollback()
+		return false, exception
+	}
+	user, exception := s.syncUserPlan(tx, subscription.UserId, now)
+	if exception != nil {
+		tx.Rollback()
+		return false, exception
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return false, apiexceptions.NewBillingException().FailedToCommitTransaction().WithOrigin(err)
+	}
+	s.updateUserPlanCache(ctx, user)
+	if exception := s.cancelProviderSubscription(ctx, &subscription, "ExpireNextLapsedSubscription"); exception != nil && logs.NotegicLogger != nil {
+		logs.NotegicLogger.Error(
+			ctx,
+			exception.Origin(),
+			exception.String(),
+		)
+	}
+
+	return true, nil
+}
+
+// CancelNextPendingProviderSubscription retries the cancellation at the provider of one subscription
+// cancelled or expired locally, it returns false when there is no pending cancellation left
+func (s *BillingService) CancelNextPendingProviderSubscription(ctx context.Context) (bool, *exceptions.Exception) {
+	if s.provider == nil {
+		return false, nil
+	}
+
+	subscriptions, exception := s.usersToBillingPlansRepository.GetManyPendingProviderCancellation(
+		1,
+		options.WithDB(s.db.WithContext(ctx)),
+	)
+	if exception != nil {
+		return false, exception
+	}
+	if len(subscriptions) == 0 {
+		return false, nil
+	}
+
+	if exception := s.cancelProviderSubscription(ctx, &subscriptions[0], "CancelNextPendingProviderSubscription"); exception != nil {
+		return false, exception
+	}
+
+	return true, nil
+}
@@ -0,0 +1,520 @@
+package billing
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+
+	apicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/billing"
+	enumcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
+
+	contexts "github.com/HiIamJeff67/notegic-backend/internal/core/contexts"
+	inputs "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/inputs"
+	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
+	repositories "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/repositories"
+	schemas "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas"
+	enums "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/enums"
+	apiexceptions "github.com/HiIamJeff67/notegic-backend/internal/core/exceptions"
+	billingtransport "github.com/HiIamJeff67/notegic-backend/internal/core/transports/billing"
+	validation "github.com/HiIamJeff67/notegic-backend/internal/core/validations"
+)
+
+const testUserAgent = "NotegicTest/1.0"
+
+/* ============================== Test Doubles ============================== */
+
+// billingTestDatabase is the state shared by the connections of billingTestConnector
+type billingTestDatabase struct {
+	openTransactions int
+	inboxEventIds    map[string]bool
+}
+
+// billingTestConnector supports the transactions and the inbox insertion of the webhooks,
+// since the repositories are replaced by the fakes below
+type billingTestConnector struct {
+	database *billingTestDatabase
+}
+
+func (c billingTestConnector) Connect(context.Context) (driver.Conn, error) { return c, nil }
+func (c billingTestConnector) Driver() driver.Driver                        { return c }
+func (c billingTestConnector) Open(string) (driver.Conn, error)             { return c, nil }
+func (billingTestConnector) Prepare(query string) (driver.Stmt, error) {
+	return nil, driver.ErrSkip
+}
+func (billingTestConnector) Close() error { return nil }
+func (c billingTestConnector) Begin() (driver.Tx, error) {
+	c.database.openTransactions++
+	return c, nil
+}
+func (c billingTestConnector) Commit() error {
+	c.database.openTransactions--
+	return nil
+}
+func (c billingTestConnector) Rollback() error {
+	c.database.openTransactions--
+	return nil
+}
+func (c billingTestConnector) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if !strings.HasPrefix(query, `INSERT INTO "InboxEventTable"`) {
+		return nil, errors.New("unexpected statement " + query)
+	}
+	eventId := fmt.Sprint(args[0].Value)
+	if c.database.inboxEventIds[eventId] {
+		return driver.RowsAffected(0), nil
+	}
+	c.database.inboxEventIds[eventId] = true
+	return driver.RowsAffected(1), nil
+}
+
+type fakeUserRepository struct {
+	repositories.UserRepositoryInterface
+	user *schemas.User
+}
+
+func (r *fakeUserRepository) GetOneById(id uuid.UUID, preloads []schemas.UserRelation, opts ...options.RepositoryOptions) (*schemas.User, *exceptions.Exception) {
+	if r.user.Id != id {
+		return nil, apiexceptions.NewUserException().NotFound()
+	}
+	user := *r.user
+	return &user, nil
+}
+
+func (r *fakeUserRepository) UpdateOneById(id uuid.UUID, input inputs.PartialUpdateUserInput, opts ...options.RepositoryOptions) (*schemas.User, *exceptions.Exception) {
+	r.user.Plan = *input.Values.Plan
+	user := *r.user
+	return &user, nil
+}
+
+type fakeBillingPlanRepository struct {
+	repositories.BillingPlanRepositoryInterface
+	billingPlans map[string]schemas.BillingPlan
+}
+
+func (r *fakeBillingPlanRepository) GetOneById(id string, opts ...options.RepositoryOptions) (*schemas.BillingPlan, *exceptions.Exception) {
+	billingPlan, ok := r.billingPlans[id]
+	if !ok {
+		return nil, apiexceptions.NewBillingPlanException().NotFound()
+	}
+	return &billingPlan, nil
+}
+
+type fakeUsersToBillingPlansRepository struct {
+	repositories.UsersToBillingPlansRepositoryInterface
+	billingPlanRepository *fakeBillingPlanRepository
+	subscription          *schemas.UsersToBillingPlans
+	stateUpdates          int
+}
+
+func (r *fakeUsersToBillingPlansRepository) load() *schemas.UsersToBillingPlans {
+	subscription := *r.subscription
+	subscription.BillingPlan = r.billingPlanRepository.billingPlans[subscription.BillingPlanId]
+	return &subscription
+}
+
+func (r *fakeUsersToBillingPlansRepository) GetLatestByUserId(userId uuid.UUID, opts ...options.RepositoryOptions) (*schemas.UsersToBillingPlans, *exceptions.Exception) {
+	if r.subscription == nil || r.subscription.UserId != userId {
+		return nil, apiexceptions.NewUsersToBillingPlansException().NotFound()
+	}
+	return r.load(), nil
+}
+
+func (r *fakeUsersToBillingPlansRepository) GetOneByProviderSubscriptionId(providerSubscriptionId string, opts ...options.RepositoryOptions) (*schemas.UsersToBillingPlans, *exceptions.Exception) {
+	if r.subscription == nil || r.subscription.ProviderSubscriptionId == nil || *r.subscription.ProviderSubscriptionId != providerSubscriptionId {
+		return nil, apiexceptions.NewUsersToBillingPlansException().NotFound()
+	}
+	return r.load(), nil
+}
+
+func (r *fakeUsersToBillingPlansRepository) GetManyEntitlingByUserId(userId uuid.UUID, now time.Time, opts ...options.RepositoryOptions) ([]schemas.UsersToBillingPlans, *exceptions.Exception) {
+	subscription := r.load()
+	switch {
+	case subscription.UserId != userId:
+	case subscription.Status == enums.UsersToBillingPlansStatus_Active && (subscription.GracePeriodEndsAt == nil || subscription.GracePeriodEndsAt.After(now)),
+		subscription.Status == enums.UsersToBillingPlansStatus_Suspended && subscription.GracePeriodEndsAt != nil && subscription.GracePeriodEndsAt.After(now),
+		subscription.Status == enums.UsersToBillingPlansStatus_Cancelled && subscription.EndDate != nil && subscription.EndDate.After(now):
+		return []schemas.UsersToBillingPlans{*subscription}, nil
+	}
+	return []schemas.UsersToBillingPlans{}, nil
+}
+
+func (r *fakeUsersToBillingPlansRepository) GetManyLapsed(now time.Time, limit int, opts ...options.RepositoryOptions) ([]schemas.UsersToBillingPlans, *exceptions.Exception) {
+	subscription := r.load()
+	switch {
+	case (subscription.Status == enums.UsersToBillingPlansStatus_Active || subscription.Status == enums.UsersToBillingPlansStatus_Suspended) &&
+		subscription.GracePeriodEndsAt != nil && !subscription.GracePeriodEndsAt.After(now),
+		subscription.Status == enums.UsersToBillingPlansStatus_Cancelled && (subscription.EndDate == nil || !subscription.EndDate.After(now)):
+		return []schemas.UsersToBillingPlans{*subscription}, nil
+	}
+	return []schemas.UsersToBillingPlans{}, nil
+}
+
+func (r *fakeUsersToBillingPlansRepository) GetManyPendingProviderCancellation(limit int, opts ...options.RepositoryOptions) ([]schemas.UsersToBillingPlans, *exceptions.Exception) {
+	if r.subscription.ProviderCancellationReason == nil || r.subscription.ProviderSubscriptionId == nil {
+		return []schemas.UsersToBillingPlans{}, nil
+	}
+	return []schemas.UsersToBillingPlans{*r.load()}, nil
+}
+
+func (r *fakeUsersToBillingPlansRepository) UpdateStateOneById(usersToBillingPlans *schemas.UsersToBillingPlans, opts ...options.RepositoryOptions) *exceptions.Exception {
+	r.stateUpdates++
+	subscription := *usersToBillingPlans
+	subscription.BillingPlan = schemas.BillingPlan{}
+	r.subscription = &subscription
+	return nil
+}
+
+func (r *fakeUsersToBillingPlansRepository) ClearProviderCancellationOneById(id uuid.UUID, opts ...options.RepositoryOptions) *exceptions.Exception {
+	if r.subscription.Id == id {
+		r.subscription.ProviderCancellationReason = nil
+	}
+	return nil
+}
+
+type fakeBillingInvoiceRepository struct {
+	repositories.BillingInvoiceRepositoryInterface
+	creations []inputs.CreateBillingInvoiceInput
+}
+
+func (r *fakeBillingInvoiceRepository) CreateOneIfNotExists(userId uuid.UUID, input inputs.CreateBillingInvoiceInput, opts ...options.RepositoryOptions) (bool, *exceptions.Exception) {
+	r.creations = append(r.creations, input)
+	return true, nil
+}
+
+// fakeProvider trusts every webhook delivery and records the cancellations with the number
+// of transactions still open when they are sent
+type fakeProvider struct {
+	billingtransport.ProviderInterface
+	database                 *billingTestDatabase
+	cancelErr                error
+	cancelledSubscriptionIds []string
+	openTransactionsOnCancel []int
+}
+
+func (p *fakeProvider) CancelSubscription(ctx context.Context, subscriptionId string, reason string) error {
+	p.cancelledSubscriptionIds = append(p.cancelledSubscriptionIds, subscriptionId)
+	p.openTransactionsOnCancel = append(p.openTransactionsOnCancel, p.database.openTransactions)
+	return p.cancelErr
+}
+
+func (p *fakeProvider) VerifyWebhookEvent(ctx context.Context, headers billingtransport.WebhookHeaders, payload []byte) (*billingtransport.WebhookEvent, error) {
+	var event billingtransport.WebhookEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+/* ============================== Test Helpers ============================== */
+
+type billingTestFixture struct {
+	ctx                           context.Context
+	service                       *BillingService
+	database                      *billingTestDatabase
+	provider                      *fakeProvider
+	userRepository                *fakeUserRepository
+	usersToBillingPlansRepository *fakeUsersToBillingPlansRepository
+	billingInvoiceRepository      *fakeBillingInvoiceRepository
+}
+
+func newBillingTestFixture(t *testing.T, status enums.UsersToBillingPlansStatus) *billingTestFixture {
+	t.Helper()
+
+	database := &billingTestDatabase{inboxEventIds: map[string]bool{}}
+	db, err := gorm.Open(
+		postgres.New(postgres.Config{Conn: sql.OpenDB(billingTestConnector{database: database})}),
+		&gorm.Config{DisableAutomaticPing: true, SkipDefaultTransaction: true},
+	)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+
+	userId := uuid.New()
+	providerSubscriptionId := "I-SUBSCRIPTION"
+	billingPlanRepository := &fakeBillingPlanRepository{billingPlans: map[string]schemas.BillingPlan{
+		"P-PRO": {Id: "P-PRO", Name: enums.BillingPlanName_NotegicMonthlyProPlan},
+	}}
+	fixture := &billingTestFixture{
+		database:       database,
+		provider:       &fakeProvider{database: database},
+		userRepository: &fakeUserRepository{user: &schemas.User{Id: userId, Name: "tester", Plan: enums.UserPlan_Pro}},
+		usersToBillingPlansRepository: &fakeUsersToBillingPlansRepository{
+			billingPlanRepository: billingPlanRepository,
+			subscription: &schemas.UsersToBillingPlans{
+				Id:                     uuid.New(),
+				UserId:                 userId,
+				BillingPlanId:          "P-PRO",
+				ProviderSubscriptionId: &providerSubscriptionId,
+				Status:                 status,
+				NextBillingDate:        time.Now().Add(7 * 24 * time.Hour),
+			},
+		},
+		billingInvoiceRepository: &fakeBillingInvoiceRepository{},
+	}
+	fixture.service = NewBillingService(
+		validation.New(),
+		db,
+		fixture.provider,
+		72*time.Hour,
+		nil,
+		fixture.userRepository,
+		billingPlanRepository,
+		fixture.usersToBillingPlansRepository,
+		fixture.billingInvoiceRepository,
+	).(*BillingService)
+	fixture.ctx = contexts.WithActorUserId(context.Background(), userId)
+
+	return fixture
+}
+
+func (f *billingTestFixture) deliver(t *testing.T, eventId string, eventType string, resource any) (*apicontract.HandlePayPalWebhookResponseDto, *exceptions.Exception) {
+	t.Helper()
+
+	rawResource, err := json.Marshal(resource)
+	if err != nil {
+		t.Fatalf("failed to marshal the resource: %v", err)
+	}
+	payload, err := json.Marshal(billingtransport.WebhookEvent{Id: eventId, EventType: eventType, Resource: rawResource})
+	if err != nil {
+		t.Fatalf("failed to marshal the event: %v", err)
+	}
+
+	requestDto := &apicontract.HandlePayPalWebhookRequestDto{}
+	requestDto.Header.AuthAlgo = "SHA256withRSA"
+	requestDto.Header.CertURL = "https://api.paypal.com/v1/notifications/certs/CERT"
+	requestDto.Header.TransmissionId = "transmission"
+	requestDto.Header.TransmissionSig = "signature"
+	requestDto.Header.TransmissionTime = time.Now().Format(time.RFC3339)
+	requestDto.Body.Payload = string(payload)
+
+	return f.service.HandlePayPalWebhook(context.Background(), requestDto)
+}
+
+func (f *billingTestFixture) cancel(t *testing.T) *apicontract.CancelMySubscriptionResponseDto {
+	t.Helper()
+
+	requestDto := &apicontract.CancelMySubscriptionRequestDto{}
+	requestDto.Header.UserAgent = testUserAgent
+	responseDto, exception := f.service.CancelMySubscription(f.ctx, requestDto)
+	if exception != nil {
+		t.Fatalf("CancelMySubscription() exception = %v", exception)
+	}
+	return responseDto
+}
+
+/* ============================== Tests ============================== */
+
+func TestBillingServiceTransitSubscription(t *testing.T) {
+	now := time.Now()
+	service := &BillingService{gracePeriod: 72 * time.Hour}
+
+	tests := []struct {
+		name   string
+		from   enums.UsersToBillingPlansStatus
+		to     enums.UsersToBillingPlansStatus
+		wantOk bool
+	}{
+		{"activates an approved subscription", enums.UsersToBillingPlansStatus_Approved, enums.UsersToBillingPlansStatus_Active, true},
+		{"suspends an active subscription", enums.UsersToBillingPlansStatus_Active, enums.UsersToBillingPlansStatus_Suspended, true},
+		{"ignores the same status again", enums.UsersToBillingPlansStatus_Active, enums.UsersToBillingPlansStatus_Active, false},
+		{"ignores the cancellation of a cancelled subscription", enums.UsersToBillingPlansStatus_Cancelled, enums.UsersToBillingPlansStatus_Cancelled, false},
+		{"does not revive a cancelled subscription", enums.UsersToBillingPlansStatus_Cancelled, enums.UsersToBillingPlansStatus_Active, false},
+		{"does not revive an expired subscription", enums.UsersToBillingPlansStatus_Expired, enums.UsersToBillingPlansStatus_Active, false},
+		{"does not cancel an expired subscription", enums.UsersToBillingPlansStatus_Expired, enums.UsersToBillingPlansStatus_Cancelled, false},
+		{"expires a cancelled subscription", enums.UsersToBillingPlansStatus_Cancelled, enums.UsersToBillingPlansStatus_Expired, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			subscription := &schemas.UsersToBillingPlans{Status: test.from, NextBillingDate: now.Add(time.Hour)}
+
+			if ok := service.transitSubscription(subscription, test.to, now); ok != test.wantOk {
+				t.Fatalf("transitSubscription() = %v, want %v", ok, test.wantOk)
+			}
+			if wantStatus := map[bool]enums.UsersToBillingPlansStatus{true: test.to, false: test.from}[test.wantOk]; subscription.Status != wantStatus {
+				t.Fatalf("transitSubscription() status = %s, want %s", subscription.Status, wantStatus)
+			}
+		})
+	}
+}
+
+func TestBillingServiceTransitSubscriptionKeepsThePaidPeriodOfACancelledSubscription(t *testing.T) {
+	now := time.Now()
+	service := &BillingService{gracePeriod: 72 * time.Hour}
+	subscription := &schemas.UsersToBillingPlans{Status: enums.UsersToBillingPlansStatus_Active, NextBillingDate: now.Add(24 * time.Hour)}
+
+	service.transitSubscription(subscription, enums.UsersToBillingPlansStatus_Suspended, now)
+	gracePeriodEndsAt := *subscription.GracePeriodEndsAt
+	// a redelivered suspension does not extend the grace period
+	service.transitSubscription(subscription, enums.UsersToBillingPlansStatus_Suspended, now.Add(time.Hour))
+	if !subscription.GracePeriodEndsAt.Equal(gracePeriodEndsAt) {
+		t.Fatalf("transitSubscription() moved the grace period from %v to %v", gracePeriodEndsAt, *subscription.GracePeriodEndsAt)
+	}
+
+	service.transitSubscription(subscription, enums.UsersToBillingPlansStatus_Cancelled, now)
+	if subscription.EndDate == nil || !subscription.EndDate.Equal(gracePeriodEndsAt) {
+		t.Fatalf("transitSubscription() end date = %v, want the end of the grace period %v", subscription.EndDate, gracePeriodEndsAt)
+	}
+}
+
+func TestBillingServiceHandlePayPalWebhookProcessesARedeliveredEventOnce(t *testing.T) {
+	fixture := newBillingTestFixture(t, enums.UsersToBillingPlansStatus_Active)
+	resource := payPalSubscriptionResource{Id: "I-SUBSCRIPTION"}
+
+	for delivery := range 2 {
+		responseDto, exception := fixture.deliver(t, "WH-SUSPENDED", payPalEvent_SubscriptionSuspended, resource)
+		if exception != nil {
+			t.Fatalf("HandlePayPalWebhook() delivery %d exception = %v", delivery, exception)
+		}
+		if responseDto.Processed != (delivery == 0) {
+			t.Fatalf("HandlePayPalWebhook() delivery %d processed = %v", delivery, responseDto.Processed)
+		}
+	}
+
+	if fixture.usersToBillingPlansRepository.stateUpdates != 1 {
+		t.Fatalf("HandlePayPalWebhook() updated the subscription %d times, want 1", fixture.usersToBillingPlansRepository.stateUpdates)
+	}
+	if fixture.usersToBillingPlansRepository.subscription.Status != enums.UsersToBillingPlansStatus_Suspended {
+		t.Fatalf("HandlePayPalWebhook() status = %s, want SUSPENDED", fixture.usersToBillingPlansRepository.subscription.Status)
+	}
+	if fixture.database.openTransactions != 0 {
+		t.Fatalf("HandlePayPalWebhook() left %d transactions open", fixture.database.openTransactions)
+	}
+}
+
+func TestBillingServiceHandlePayPalWebhookDoesNotReviveACancelledSubscription(t *testing.T) {
+	fixture := newBillingTestFixture(t, enums.UsersToBillingPlansStatus_Active)
+	fixture.cancel(t)
+
+	// the activation is delivered late, after the user has cancelled the subscription
+	if _, exception := fixture.deliver(t, "WH-ACTIVATED", payPalEvent_SubscriptionActivated, payPalSubscriptionResource{Id: "I-SUBSCRIPTION"}); exception != nil {
+		t.Fatalf("HandlePayPalWebhook() exception = %v", exception)
+	}
+
+	if status := fixture.usersToBillingPlansRepository.subscription.Status; status != enums.UsersToBillingPlansStatus_Cancelled {
+		t.Fatalf("HandlePayPalWebhook() status = %s, want CANCELLED", status)
+	}
+}
+
+func TestBillingServiceHandlePayPalWebhookKeepsTheExactAmountOfAPayment(t *testing.T) {
+	fixture := newBillingTestFixture(t, enums.UsersToBillingPlansStatus_Suspended)
+	resource := payPalSaleResource{Id: "SALE", BillingAgreementId: "I-SUBSCRIPTION"}
+	resource.Amount.Total = "19.99"
+	resource.Amount.Currency = "USD"
+
+	if _, exception := fixture.deliver(t, "WH-SALE", payPalEvent_PaymentSaleCompleted, resource); exception != nil {
+		t.Fatalf("HandlePayPalWebhook() exception = %v", exception)
+	}
+
+	creations := fixture.billingInvoiceRepository.creations
+	if len(creations) != 1 || creations[0].Amount != "19.99" {
+		t.Fatalf("HandlePayPalWebhook() created the invoices %+v, want one of 19.99", creations)
+	}
+	if status := fixture.usersToBillingPlansRepository.subscription.Status; status != enums.UsersToBillingPlansStatus_Active {
+		t.Fatalf("HandlePayPalWebhook() status = %s, want ACTIVE after the payment", status)
+	}
+}
+
+func TestBillingServiceHandlePayPalWebhookRejectsAnAmountWhichIsNotADecimal(t *testing.T) {
+	for _, total := range []string{"", "1e3", "-5.00", "9,99", "NaN"} {
+		fixture := newBillingTestFixture(t, enums.UsersToBillingPlansStatus_Active)
+		resource := payPalSaleResource{Id: "SALE", BillingAgreementId: "I-SUBSCRIPTION"}
+		resource.Amount.Total = total
+		resource.Amount.Currency = "USD"
+
+		_, exception := fixture.deliver(t, "WH-SALE", payPalEvent_PaymentSaleCompleted, resource)
+		if exception == nil || exception.Reason != "InvalidWebhookEvent" {
+			t.Fatalf("HandlePayPalWebhook() of the amount %q exception = %v, want InvalidWebhookEvent", total, exception)
+		}
+		if len(fixture.billingInvoiceRepository.creations) != 0 {
+			t.Fatalf("HandlePayPalWebhook() created an invoice of the amount %q", total)
+		}
+	}
+}
+
+func TestBillingServiceCancelMySubscriptionCallsTheProviderAfterTheCommit(t *testing.T) {
+	fixture := newBillingTestFixture(t, enums.UsersToBillingPlansStatus_Active)
+
+	responseDto := fixture.cancel(t)
+
+	if responseDto.Subscription.Status != enumcontract.UsersToBillingPlansStatus(enums.UsersToBillingPlansStatus_Cancelled) {
+		t.Fatalf("CancelMySubscription() status = %s, want CANCELLED", responseDto.Subscription.Status)
+	}
+	if len(fixture.provider.openTransactionsOnCancel) != 1 || fixture.provider.openTransactionsOnCancel[0] != 0 {
+		t.Fatalf("CancelMySubscription() cancelled at the provider with the open transactions %v, want one call outside of them",
+			fixture.provider.openTransactionsOnCancel)
+	}
+	if fixture.usersToBillingPlansRepository.subscription.ProviderCancellationReason != nil {
+		t.Fatal("CancelMySubscription() kept the cancellation pending after the provider has cancelled it")
+	}
+}
+
+func TestBillingServiceCancelMySubscriptionRetriesTheProviderLater(t *testing.T) {
+	fixture := newBillingTestFixture(t, enums.UsersToBillingPlansStatus_Active)
+	fixture.provider.cancelErr = errors.New("connection refused")
+
+	fixture.cancel(t)
+
+	subscription := fixture.usersToBillingPlansRepository.subscription
+	if subscription.Status != enums.UsersToBillingPlansStatus_Cancelled || subscription.ProviderCancellationReason == nil {
+		t.Fatalf("CancelMySubscription() = %s with the pending reason %v, want a pending cancellation", subscription.Status, subscription.ProviderCancellationReason)
+	}
+	if fixture.userRepository.user.Plan != enums.UserPlan_Pro {
+		t.Fatalf("CancelMySubscription() plan = %s, want the plan kept until the paid period ends", fixture.userRepository.user.Plan)
+	}
+
+	if cancelled, exception := fixture.service.CancelNextPendingProviderSubscription(context.Background()); exception == nil || cancelled {
+		t.Fatalf("CancelNextPendingProviderSubscription() = %v, %v, want the provider to be unavailable", cancelled, exception)
+	}
+	fixture.provider.cancelErr = nil
+	if cancelled, exception := fixture.service.CancelNextPendingProviderSubscription(context.Background()); exception != nil || !cancelled {
+		t.Fatalf("CancelNextPendingProviderSubscription() = %v, %v, want the cancellation to be done", cancelled, exception)
+	}
+	if cancelled, _ := fixture.service.CancelNextPendingProviderSubscription(context.Background()); cancelled {
+		t.Fatal("CancelNextPendingProviderSubscription() cancelled a subscription twice")
+	}
+}
+
+func TestBillingServiceExpireNextLapsedSubscriptionCommitsBeforeTheProvider(t *testing.T) {
+	fixture := newBillingTestFixture(t, enums.UsersToBillingPlansStatus_Suspended)
+	gracePeriodEndsAt := time.Now().Add(-time.Minute)
+	fixture.usersToBillingPlansRepository.subscription.GracePeriodEndsAt = &gracePeriodEndsAt
+	fixture.provider.cancelErr = &billingtransport.ProviderError{StatusCode: 503}
+
+	expired, exception := fixture.service.ExpireNextLapsedSubscription(context.Background())
+	if exception != nil || !expired {
+		t.Fatalf("ExpireNextLapsedSubscription() = %v, %v, want the subscription expired", expired, exception)
+	}
+
+	subscription := fixture.usersToBillingPlansRepository.subscription
+	if subscription.Status != enums.UsersToBillingPlansStatus_Expired || subscription.ProviderCancellationReason == nil {
+		t.Fatalf("ExpireNextLapsedSubscription() = %s with the pending reason %v, want an expired subscription to cancel at the provider",
+			subscription.Status, subscription.ProviderCancellationReason)
+	}
+	if fixture.userRepository.user.Plan != enums.UserPlan_Free {
+		t.Fatalf("ExpireNextLapsedSubscription() plan = %s, want FREE", fixture.userRepository.user.Plan)
+	}
+	if fixture.provider.openTransactionsOnCancel[0] != 0 {
+		t.Fatal("ExpireNextLapsedSubscription() cancelled at the provider inside the transaction")
+	}
+
+	// PayPal cancels the subscription by itself, so there is nothing left to retry
+	if _, exception := fixture.deliver(t, "WH-CANCELLED", payPalEvent_SubscriptionCancelled, payPalSubscriptionResource{Id: "I-SUBSCRIPTION"}); exception != nil {
+		t.Fatalf("HandlePayPalWebhook() exception = %v", exception)
+	}
+	if subscription := fixture.usersToBillingPlansRepository.subscription; subscription.Status != enums.UsersToBillingPlansStatus_Expired ||
+		subscription.ProviderCancellationReason != nil {
+		t.Fatalf("HandlePayPalWebhook() = %s with the pending reason %v, want an expired subscription with nothing pending",
+			subscription.Status, subscription.ProviderCancellationReason)
+	}
+}
@@ -3,6 +3,7 @@ package blocks
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"net/http"
 	"strings"
@@ -11,6 +12,7 @@ import (
 	validator "github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 	pg "github.com/lib/pq"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 
 	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
@@ -18,11 +20,17 @@ import (
 	types "github.com/HiIamJeff67/notegic-backend/shared/types"
 
 	searchcursor "github.com/HiIamJeff67/notegic-backend/shared/lib/searchcursor"
+	searchtext "github.com/HiIamJeff67/notegic-backend/shared/lib/searchtext"
 	logs "github.com/HiIamJeff67/notegic-backend/shared/platform/observability/logs"
 
+	blockmarkdown "github.com/HiIamJeff67/notegic-backend/shared/util/blockmarkdown"
+	editableblock "github.com/HiIamJeff67/notegic-backend/shared/util/editableblock"
+
 	apicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/block-packs"
 	coreeventscontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/events"
 	gqlmodels "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/graphql/models"
+	blocknote "github.com/HiIamJeff67/notegic-backend/contracts/types/blocknote"
+	enumcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
 
 	contexts "github.com/HiIamJeff67/notegic-backend/internal/core/contexts"
 	data "github.com/HiIamJeff67/notegic-backend/internal/core/data/database"
@@ -41,8 +49,10 @@ type BlockPackServiceInterface interface {
 	GetMyBlockPackAndItsParentById(ctx context.Context, requestDto *apicontract.GetMyBlockPackAndItsParentByIdRequestDto) (*apicontract.GetMyBlockPackAndItsParentByIdResponseDto, *exceptions.Exception)
 	GetMyBlockPacksByParentSubShelfId(ctx context.Context, requestDto *apicontract.GetMyBlockPacksByParentSubShelfIdRequestDto) (*apicontract.GetMyBlockPacksByParentSubShelfIdResponseDto, *exceptions.Exception)
 	GetAllMyBlockPacksByRootShelfId(ctx context.Context, requestDto *apicontract.GetAllMyBlockPacksByRootShelfIdRequestDto) (*apicontract.GetAllMyBlockPacksByRootShelfIdResponseDto, *exceptions.Exception)
+	GetMyBlockPackMarkdownById(ctx context.Context, requestDto *apicontract.GetMyBlockPackMarkdownByIdRequestDto) (*apicontract.GetMyBlockPackMarkdownByIdResponseDto, *exceptions.Exception)
 	CreateBlockPack(ctx context.Context, requestDto *apicontract.CreateBlockPackRequestDto) (*apicontract.CreateBlockPackResponseDto, *exceptions.Exception)
 	CreateBlockPacks(ctx context.Context, requestDto *apicontract.CreateBlockPacksRequestDto) (*apicontract.CreateBlockPacksResponseDto, *exceptions.Exception)
+	CreateBlockPackFromMarkdown(ctx context.Context, requestDto *apicontract.CreateBlockPackFromMarkdownRequestDto) (*apicontract.CreateBlockPackFromMarkdownResponseDto, *exceptions.Exception)
 	UpdateMyBlockPackById(ctx context.Context, requestDto *apicontract.UpdateMyBlockPackByIdRequestDto) (*apicontract.UpdateMyBlockPackByIdResponseDto, *exceptions.Exception)
 	UpdateMyBlockPacksByIds(ctx context.Context, requestDto *apicontract.UpdateMyBlockPacksByIdsRequestDto) (*apicontract.UpdateMyBlockPacksByIdsResponseDto, *exceptions.Exception)
 	MoveMyBlockPackByParentSubShelfId(ctx context.Context, requestDto *apicontract.MoveMyBlockPackByParentSubShelfIdRequestDto) (*apicontract.MoveMyBlockPackByParentSubShelfIdResponseDto, *exceptions.Exception)
@@ -56,27 +66,37 @@ type BlockPackServiceInterface interface {
 	SearchPrivateBlockPacks(ctx context.Context, userId uuid.UUID, gqlInput gqlmodels.SearchBlockPackInput) (*gqlmodels.SearchBlockPackConnection, *exceptions.Exception)
 }
 
+type YjsDocumentInitializer interface {
+	InitializeDocuments(
+		context.Context,
+		[]apicontract.InitializeBlockPackYjsDocumentReqDto,
+	) ([]apicontract.InitializeBlockPackYjsDocumentResDto, error)
+}
+
 type BlockPackService struct {
-	validator           *validator.Validate
-	db                  *gorm.DB
-	blockPackScope      scopes.BlockPackScopeInterface
-	subShelfRepository  repositories.SubShelfRepositoryInterface
-	blockPackRepository repositories.BlockPackRepositoryInterface
+	validator              *validator.Validate
+	db                     *gorm.DB
+	yjsDocumentInitializer YjsDocumentInitializer
+	blockPackScope         scopes.BlockPackScopeInterface
+	subShelfRepository     repositories.SubShelfRepositoryInterface
+	blockPackRepository    repositories.BlockPackRepositoryInterface
 }
 
 func NewBlockPackService(
 	validator *validator.Validate,
 	db *gorm.DB,
+	yjsDocumentInitializer YjsDocumentInitializer,
 	blockPackScope scopes.BlockPackScopeInterface,
 	subShelfRepository repositories.SubShelfRepositoryInterface,
 	blockPackRepository repositories.BlockPackRepositoryInterface,
 ) BlockPackServiceInterface {
 	return &BlockPackService{
-		validator:           validator,
-		db:                  db,
-		blockPackScope:      blockPackScope,
-		subShelfRepository:  subShelfRepository,
-		blockPackRepository: blockPackRepository,
+		validator:              validator,
+		db:                     db,
+		yjsDocumentInitializer: yjsDocumentInitializer,
+		blockPackScope:         blockPackScope,
+		subShelfRepository:     subShelfRepository,
+		blockPackRepository:    blockPackRepository,
 	}
 }
 
@@ -263,6 +283,7 @@ func (s *BlockPackService) GetMyBlockPacksByParentSubShelfId(
 			actorUserId,
 			allowedPermissions,
 		).Scopes(scopes.NewBlockPackScope().FilterOnlyDeleted(onlyDeleted)).
+		Scopes(scopes.RestrictRootShelvesOfShareLink("ss.root_shelf_id"), scopes.RestrictBlockPacksOfShareLink(`"BlockPackTable".id`)).
 		Order("name ASC").
 		Limit(int(data.MaxBlockPackOfSubShelf)).
 		Scan(&resDto)
@@ -315,6 +336,7 @@ func (s *BlockPackService) GetAllMyBlockPacksByRootShelfId(
 		Where("ss.root_shelf_id = ? AND uts.user_id = ? AND uts.permission IN ?",
 			requestDto.Param.RootShelfId, actorUserId, allowedPermissions,
 		).Scopes(scopes.NewBlockPackScope().FilterOnlyDeleted(onlyDeleted)).
+		Scopes(scopes.RestrictRootShelvesOfShareLink("ss.root_shelf_id"), scopes.RestrictBlockPacksOfShareLink(`"BlockPackTable".id`)).
 		Limit(int(data.MaxBlockPackOfRootShelf)).
 		Order("name ASC").
 		Scan(&resDto)
@@ -325,6 +347,72 @@ func (s *BlockPackService) GetAllMyBlockPacksByRootShelfId(
 	return &resDto, nil
 }
 
+func (s *BlockPackService) GetMyBlockPackMarkdownById(
+	ctx context.Context, requestDto *apicontract.GetMyBlockPackMarkdownByIdRequestDto,
+) (*apicontract.GetMyBlockPackMarkdownByIdResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(requestDto); err != nil {
+		return nil, apiexceptions.NewBlockPackException().InvalidDto().WithOrigin(err)
+	}
+
+	db := s.db.WithContext(ctx)
+
+	allowedPermissions, exception := contexts.GetAllowedPermissions(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+	actorUserId, exception := contexts.GetActorUserId(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+
+	blockPack, exception := s.blockPackRepository.CheckPermissionAndGetOneById(
+		requestDto.Param.BlockPackId,
+		actorUserId,
+		nil,
+		allowedPermissions,
+		options.WithDB(db),
+		options.WithAllowedPermissions(allowedPermissions),
+		options.WithOnlyDeleted(types.Ternary_Negative),
+	)
+	if exception != nil {
+		return nil, exception
+	}
+
+	var blocks []schemas.Block
+	if err := db.Model(&schemas.Block{}).
+		Where("block_pack_id = ?", blockPack.Id).
+		Order("created_at ASC").
+		Order("id ASC").
+		Find(&blocks).Error; err != nil {
+		return nil, apiexceptions.NewBlockException().NotFound().WithOrigin(err)
+	}
+
+	flattenedBlocks := make([]blocknote.RawFlattenedEditableBlock, len(blocks))
+	for index, block := range blocks {
+		flattenedBlocks[index] = blocknote.RawFlattenedEditableBlock{
+			Id:            block.Id,
+			ParentBlockId: block.ParentBlockId,
+			PrevBlockId:   block.PrevBlockId,
+			NextBlockId:   block.NextBlockId,
+			Type:          enumcontract.BlockType(block.Type),
+			Props:         json.RawMessage(block.Props),
+			Content:       json.RawMessage(block.Content),
+		}
+	}
+	arborizedBlocks, err := editableblock.ArborizeEditableBlocks(flattenedBlocks)
+	if err != nil {
+		return nil, apiexceptions.NewBlockPackException().InvalidType(blockPack.Id).WithOrigin(err)
+	}
+
+	return &apicontract.GetMyBlockPackMarkdownByIdResponseDto{
+		Id:         blockPack.Id,
+		Name:       blockPack.Name,
+		Markdown:   blockmarkdown.ExportEditableBlocks(arborizedBlocks),
+		BlockCount: int64(len(blocks)),
+		UpdatedAt:  blockPack.UpdatedAt,
+	}, nil
+}
+
 func (s *BlockPackService) CreateBlockPack(
 	ctx context.Context, requestDto *apicontract.CreateBlockPackRequestDto,
 ) (*apicontract.CreateBlockPackResponseDto, *exceptions.Exception) {
@@ -459,6 +547,159 @@ func (s *BlockPackService) CreateBlockPacks(
 	}, nil
 }
 
+func (s *BlockPackService) CreateBlockPackFromMarkdown(
+	ctx context.Context, requestDto *apicontract.CreateBlockPackFromMarkdownRequestDto,
+) (*apicontract.CreateBlockPackFromMarkdownResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(requestDto); err != nil {
+		return nil, apiexceptions.NewBlockPackException().InvalidDto().WithOrigin(err)
+	}
+
+	allowedPermissions, exception := contexts.GetAllowedPermissions(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+	actorUserId, exception := contexts.GetActorUserId(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+
+	db := s.db.WithContext(ctx)
+
+	arborizedBlocks := blockmarkdown.ImportEditableBlocks([]byte(requestDto.Body.Markdown))
+	blockCount := blockmarkdown.CountEditableBlocks(arborizedBlocks)
+
+	// the accounting trigger enforces the same limit, checking it first avoids
+	// initializing a Yjs document which can never be stored
+	var maxBlockCountPerBlockPack int32
+	result := db.
+		Model(&schemas.SubShelf{}).
+		Select(`"PlanLimitationTable".max_block_count_per_block_pack`).
+		Joins(`INNER JOIN "RootShelfTable" ON "RootShelfTable".id = "SubShelfTable".root_shelf_id`).
+		Joins(`INNER JOIN "UserTable" ON "UserTable".id = "RootShelfTable".owner_id`).
+		Joins(`INNER JOIN "PlanLimitationTable" ON "PlanLimitationTable".key = "UserTable".plan`).
+		Where(`"SubShelfTable".id = ?`, requestDto.Body.ParentSubShelfId).
+		Where(`"SubShelfTable".deleted_at IS NULL`).
+		Where(`"RootShelfTable".deleted_at IS NULL`).
+		Scan(&maxBlockCountPerBlockPack)
+	if result.Error != nil {
+		return nil, apiexceptions.NewBlockPackException().FailedToCreate().WithOrigin(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, apiexceptions.NewShelfException().NotFound().WithOrigin(gorm.ErrRecordNotFound)
+	}
+	if int64(blockCount) > int64(maxBlockCountPerBlockPack) {
+		return nil, apiexceptions.NewBlockPackException().MaximumBlockCountExceeded(blockCount, maxBlockCountPerBlockPack)
+	}
+
+	flattenedBlocks, _, err := editableblock.FlattenEditableBlocks(arborizedBlocks)
+	if err != nil {
+		return nil, apiexceptions.NewBlockPackException().InvalidInput().WithOrigin(err)
+	}
+	for _, flattenedBlock := range flattenedBlocks {
+		if len(flattenedBlock.Props) > constants.MaxBlockPropsSize ||
+			len(flattenedBlock.Content) > constants.MaxBlockContentSize {
+			return nil, apiexceptions.NewBlockPackException().BlockTooLarge(string(flattenedBlock.Type))
+		}
+	}
+
+	if s.yjsDocumentInitializer == nil {
+		return nil, exceptions.New(
+			"DependencyUnavailable",
+			"BlockPack",
+			"Create",
+			"The Yjs worker document initializer is not configured",
+			http.StatusServiceUnavailable,
+			true,
+		)
+	}
+	initializationResDtos, err := s.yjsDocumentInitializer.InitializeDocuments(
+		ctx,
+		[]apicontract.InitializeBlockPackYjsDocumentReqDto{{Blocks: arborizedBlocks}},
+	)
+	if err != nil {
+		return nil, exceptions.New(
+			"FailedToCreate",
+			"BlockPack",
+			"Create",
+			"Failed to initialize block pack documents",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(err)
+	}
+
+	tx := db.Begin()
+
+	newBlockPackId, exception := s.blockPackRepository.CreateOneBySubShelfId(
+		requestDto.Body.ParentSubShelfId,
+		actorUserId,
+		inputs.CreateBlockPackInput{
+			Id:                  requestDto.Body.Id,
+			Name:                requestDto.Body.Name,
+			Icon:                (*enums.SupportedIcon)(requestDto.Body.Icon).ToStorable(),
+			HeaderBackgroundURL: requestDto.Body.HeaderBackgroundURL,
+		},
+		options.WithTransactionDB(tx),
+		options.WithAllowedPermissions(allowedPermissions),
+		options.WithLockingStrength(options.LockingStrengthNoKeyUpdate),
+	)
+	if exception != nil {
+		tx.Rollback()
+		return nil, exception
+	}
+
+	document := schemas.BlockPackYjsDocument{
+		BlockPackId:            *newBlockPackId,
+		Snapshot:               initializationResDtos[0].Snapshot,
+		StateVector:            initializationResDtos[0].StateVector,
+		ProjectedUntilSequence: 0,
+	}
+	if err := tx.Create(&document).Error; err != nil {
+		tx.Rollback()
+		return nil, apiexceptions.NewBlockPackException().FailedToCreate().WithOrigin(err)
+	}
+
+	if len(flattenedBlocks) > 0 {
+		blocks := make([]schemas.Block, len(flattenedBlocks))
+		for index, flattenedBlock := range flattenedBlocks {
+			blocks[index] = schemas.Block{
+				Id:            flattenedBlock.Id,
+				BlockPackId:   *newBlockPackId,
+				ParentBlockId: flattenedBlock.ParentBlockId,
+				PrevBlockId:   flattenedBlock.PrevBlockId,
+				NextBlockId:   flattenedBlock.NextBlockId,
+				Type:          enums.BlockType(flattenedBlock.Type),
+				Props:         datatypes.JSON(flattenedBlock.Props),
+				Content:       datatypes.JSON(flattenedBlock.Content),
+			}
+		}
+		if err := tx.CreateInBatches(&blocks, constants.MaxBatchCreateBlockSize).Error; err != nil {
+			tx.Rollback()
+			return nil, apiexceptions.NewBlockException().FailedToCreate().WithOrigin(err)
+		}
+	}
+
+	if err := repositories.NewOutboxEventRepository().EnqueueYjsMaintenanceHint(
+		tx,
+		uuid.NewString(),
+		*newBlockPackId,
+		"block_pack_imported",
+	); err != nil {
+		tx.Rollback()
+		return nil, apiexceptions.NewBlockPackException().FailedToCreate().WithOrigin(err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
+		return nil, apiexceptions.NewBlockPackException().FailedToCommitTransaction().WithOrigin(err)
+	}
+
+	return &apicontract.CreateBlockPackFromMarkdownResponseDto{
+		Id:         *newBlockPackId,
+		BlockCount: int64(blockCount),
+		CreatedAt:  time.Now(),
+	}, nil
+}
+
 func (s *BlockPackService) UpdateMyBlockPackById(
 	ctx context.Context, requestDto *apicontract.UpdateMyBlockPackByIdRequestDto,
 ) (*apicontract.UpdateMyBlockPackByIdResponseDto, *exceptions.Exception) {
@@ -1149,58 +1390,18 @@ func (s *BlockPackService) SearchPrivateBlockPacks(
 		query = query.Where("ss.root_shelf_id = ?", *gqlInput.RootShelfID)
 	}
 
-	if len(strings.ReplaceAll(gqlInput.Query, " ", "")) > 0 {
-		query = query.Where(
-			`"BlockPackTable".name ILIKE ?`,
-			"%"+gqlInput.Query+"%",
-		)
-	}
-	if gqlInput.After != nil && len(strings.ReplaceAll(*gqlInput.After, " ", "")) > 0 {
-		searchCursor, err := searchcursor.Decode[gqlmodels.SearchBlockPackCursorFields](*gqlInput.After)
-		if err != nil {
-			return nil, apiexceptions.NewSearchException().FailedToDecode().WithOrigin(err)
-		}
-
-		query = query.Where(`"BlockPackTable".id > ?`, searchCursor.Fields.ID)
+	isFullTextSearch := len(strings.ReplaceAll(gqlInput.Query, " ", "")) > 0
+	if isFullTextSearch {
+		query = query.Scopes(scopes.MatchFullTextSearch(schemas.BlockPack{}.TableName(), gqlInput.Query, userId))
 	}
 
-	if gqlInput.SortBy != nil && gqlInput.SortOrder != nil {
-		cending := gqlmodels.SearchSortOrderAsc.String()
-		if *gqlInput.SortOrder == gqlmodels.SearchSortOrderDesc {
-			cending = gqlmodels.SearchSortOrderDesc.String()
-		}
-
-		switch *gqlInput.SortBy {
-		case gqlmodels.SearchBlockPackSortByName:
-			query = query.Order(`"BlockPackTable".name ` + cending).
-				Order(`"BlockPackTable".updated_at ` + cending).
-				Order(`"BlockPackTable".created_at ` + cending)
-		case gqlmodels.SearchBlockPackSortByBlockCount:
-			query = query.Order(`"BlockPackTable".block_count ` + cending).
-				Order(`"BlockPackTable".name ` + cending).
-				Order(`"BlockPackTable".updated_at ` + cending).
-				Order(`"BlockPackTable".created_at ` + cending)
-		case gqlmodels.SearchBlockPackSortByLastUpdate:
-			query = query.Order(`"BlockPackTable".updated_at ` + cending).
-				Order(`"BlockPackTable".name ` + cending).
-				Order(`"BlockPackTable".created_at ` + cending)
-		case gqlmodels.SearchBlockPackSortByCreatedAt:
-			query = query.Order(`"BlockPackTable".created_at ` + cending).
-				Order(`"BlockPackTable".name ` + cending).
-				Order(`"BlockPackTable".updated_at ` + cending)
-		default:
-			query = query.Order(`"BlockPackTable".name ` + cending).
-				Order(`"BlockPackTable".updated_at ` + cending).
-				Order(`"BlockPackTable".created_at ` + cending)
-		}
-	}
-
-	limit := constants.DefaultSearchLimit
-	if gqlInput.First != nil && *gqlInput.First > 0 {
-		limit = int(*gqlInput.First)
+	keyset := newBlockPackSearchKeyset(gqlInput, isFullTextSearch, userId)
+	page, err := keyset.NewPage(gqlInput.After, gqlInput.Before, gqlInput.First, gqlInput.Last, constants.DefaultSearchLimit, constants.MaxSearchLimit)
+	if err != nil {
+		return nil, apiexceptions.NewSearchException().FailedToDecode().WithOrigin(err)
 	}
-	limit = min(limit, constants.MaxSearchLimit)
-	query = query.Limit(limit + 1)
+	query = query.Scopes(scopes.PaginateByKeyset(keyset, page.AfterCursor, page.BeforeCursor, page.IsBackward)).
+		Limit(page.Limit + 1)
 
 	var blockPacks []schemas.BlockPack
 	if err := query.Scopes(s.blockPackScope.IncludePreloads(
@@ -1211,32 +1412,66 @@ func (s *BlockPackService) SearchPrivateBlockPacks(
 		return nil, apiexceptions.NewBlockPackException().NotFound().WithOrigin(err)
 	}
 
-	hasNextPage := len(blockPacks) > limit
+	blockPacks, hasNextPage, hasPreviousPage := searchcursor.Slice(page, blockPacks)
+
+	fullTextSearchResultById := make(map[uuid.UUID]scopes.FullTextSearchResult, len(blockPacks))
+	if isFullTextSearch && len(blockPacks) > 0 {
+		blockPackIds := make([]uuid.UUID, len(blockPacks))
+		for index, blockPack := range blockPacks {
+			blockPackIds[index] = blockPack.Id
+		}
+
+		var fullTextSearchResults []scopes.FullTextSearchResult
+		if err := db.Model(&schemas.BlockPack{}).
+			Scopes(scopes.SelectFullTextSearchResults(schemas.BlockPack{}.TableName(), `"BlockPackTable".name`, gqlInput.Query, userId)).
+			Where(`"BlockPackTable".id IN ?`, blockPackIds).
+			Find(&fullTextSearchResults).Error; err != nil {
+			return nil, apiexceptions.NewSearchException().FailedToRankFullTextSearch().WithOrigin(err)
+		}
+		for _, fullTextSearchResult := range fullTextSearchResults {
+			fullTextSearchResultById[fullTextSearchResult.Id] = fullTextSearchResult
+		}
+	}
+
 	searchEdges := make([]*gqlmodels.SearchBlockPackEdge, len(blockPacks))
 
 	for index, blockPack := range blockPacks {
-		searchCursor := searchcursor.SearchCursor[gqlmodels.SearchBlockPackCursorFields]{
-			Fields: gqlmodels.SearchBlockPackCursorFields{
-				ID: blockPack.Id,
-			},
+		fullTextSearchResult, isRanked := fullTextSearchResultById[blockPack.Id]
+
+		var sortValues []any
+		switch keyset.SortBy {
+		case gqlmodels.SearchBlockPackSortByRelevance.String():
+			sortValues = []any{fullTextSearchResult.Rank, blockPack.Id}
+		case gqlmodels.SearchBlockPackSortByBlockCount.String():
+			sortValues = []any{blockPack.BlockCount, blockPack.Name, blockPack.UpdatedAt, blockPack.CreatedAt, blockPack.Id}
+		case gqlmodels.SearchBlockPackSortByLastUpdate.String():
+			sortValues = []any{blockPack.UpdatedAt, blockPack.Name, blockPack.CreatedAt, blockPack.Id}
+		case gqlmodels.SearchBlockPackSortByCreatedAt.String():
+			sortValues = []any{blockPack.CreatedAt, blockPack.Name, blockPack.UpdatedAt, blockPack.Id}
+		case gqlmodels.SearchBlockPackSortByName.String():
+			sortValues = []any{blockPack.Name, blockPack.UpdatedAt, blockPack.CreatedAt, blockPack.Id}
+		default:
+			sortValues = []any{blockPack.Id}
 		}
-		encodedSearchCursor, err := searchCursor.Encode()
+		encodedSearchCursor, err := keyset.Encode(sortValues...)
 		if err != nil {
 			return nil, apiexceptions.NewSearchException().FailedToEncode().WithOrigin(err)
 		}
-		if encodedSearchCursor == nil {
-			return nil, apiexceptions.NewSearchException().FailedToUnmarshalSearchCursor()
-		}
 
 		searchEdges[index] = &gqlmodels.SearchBlockPackEdge{
-			EncodedSearchCursor: *encodedSearchCursor,
+			EncodedSearchCursor: encodedSearchCursor,
 			Node:                blockPack.ToPrivateBlockPack(),
 		}
+		if isRanked {
+			highlight := searchtext.FormatHighlight(fullTextSearchResult.Highlight)
+			searchEdges[index].Rank = &fullTextSearchResult.Rank
+			searchEdges[index].Highlight = &highlight
+		}
 	}
 
 	searchPageInfo := &gqlmodels.SearchPageInfo{
 		HasNextPage:     hasNextPage,
-		HasPreviousPage: gqlInput.After != nil && len(strings.ReplaceAll(*gqlInput.After, " ", "")) > 0,
+		HasPreviousPage: hasPreviousPage,
 	}
 
 	if len(searchEdges) > 0 {
@@ -1245,9 +1480,6 @@ func (s *BlockPackService) SearchPrivateBlockPacks(
 	}
 
 	searchTime := float64(time.Since(startTime).Nanoseconds()) / 1e6
-	if hasNextPage {
-		searchEdges = searchEdges[:limit]
-	}
 
 	return &gqlmodels.SearchBlockPackConnection{
 		SearchEdges:    searchEdges,
@@ -1256,3 +1488,37 @@ func (s *BlockPackService) SearchPrivateBlockPacks(
 		SearchTime:     searchTime,
 	}, nil
 }
+
+/* ============================== Auxiliary Functions ============================== */
+
+// newBlockPackSearchKeyset builds the keyset of the requested sort order, where the relevance falls back to the name if the query is empty
+func newBlockPackSearchKeyset(gqlInput gqlmodels.SearchBlockPackInput, isFullTextSearch bool, userId uuid.UUID) *searchcursor.Keyset {
+	isDescending := gqlInput.SortOrder != nil && *gqlInput.SortOrder == gqlmodels.SearchSortOrderDesc
+
+	idKey := searchcursor.KeysetKey{Column: `"BlockPackTable".id`, Type: "uuid"}
+	nameKey := searchcursor.KeysetKey{Column: `"BlockPackTable".name`, Type: "text"}
+	blockCountKey := searchcursor.KeysetKey{Column: `"BlockPackTable".block_count`, Type: "bigint"}
+	updatedAtKey := searchcursor.KeysetKey{Column: `"BlockPackTable".updated_at`, Type: "timestamptz"}
+	createdAtKey := searchcursor.KeysetKey{Column: `"BlockPackTable".created_at`, Type: "timestamptz"}
+
+	if gqlInput.SortBy == nil {
+		return searchcursor.NewKeyset("", isDescending, idKey)
+	}
+
+	switch *gqlInput.SortBy {
+	case gqlmodels.SearchBlockPackSortByRelevance:
+		if isFullTextSearch {
+			rankKey := scopes.FullTextSearchRankKey(schemas.BlockPack{}.TableName(), gqlInput.Query, userId)
+			return searchcursor.NewKeyset(gqlmodels.SearchBlockPackSortByRelevance.String(), isDescending, rankKey, idKey)
+		}
+		return searchcursor.NewKeyset(gqlmodels.SearchBlockPackSortByName.String(), isDescending, nameKey, updatedAtKey, createdAtKey, idKey)
+	case gqlmodels.SearchBlockPackSortByBlockCount:
+		return searchcursor.NewKeyset(gqlmodels.SearchBlockPackSortByBlockCount.String(), isDescending, blockCountKey, nameKey, updatedAtKey, createdAtKey, idKey)
+	case gqlmodels.SearchBlockPackSortByLastUpdate:
+		return searchcursor.NewKeyset(gqlmodels.SearchBlockPackSortByLastUpdate.String(), isDescending, updatedAtKey, nameKey, createdAtKey, idKey)
+	case gqlmodels.SearchBlockPackSortByCreatedAt:
+		return searchcursor.NewKeyset(gqlmodels.SearchBlockPackSortByCreatedAt.String(), isDescending, createdAtKey, nameKey, updatedAtKey, idKey)
+	default:
+		return searchcursor.NewKeyset(gqlmodels.SearchBlockPackSortByName.String(), isDescending, nameKey, updatedAtKey, createdAtKey, idKey)
+	}
+}
@@ -0,0 +1,516 @@
+package blocks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	validator "github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+	constants "github.com/HiIamJeff67/notegic-backend/shared/constants"
+	types "github.com/HiIamJeff67/notegic-backend/shared/types"
+
+	editableblock "github.com/HiIamJeff67/notegic-backend/shared/util/editableblock"
+
+	apicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/block-packs"
+	blocknote "github.com/HiIamJeff67/notegic-backend/contracts/types/blocknote"
+	enumcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
+	yjsworkercontract "github.com/HiIamJeff67/notegic-backend/contracts/yjs-worker/v1"
+
+	contexts "github.com/HiIamJeff67/notegic-backend/internal/core/contexts"
+	inputs "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/inputs"
+	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
+	repositories "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/repositories"
+	schemas "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas"
+	enums "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/enums"
+	apiexceptions "github.com/HiIamJeff67/notegic-backend/internal/core/exceptions"
+)
+
+type BlockPackSnapshotServiceInterface interface {
+	CreateMyBlockPackSnapshotById(ctx context.Context, requestDto *apicontract.CreateMyBlockPackSnapshotByIdRequestDto) (*apicontract.CreateMyBlockPackSnapshotByIdResponseDto, *exceptions.Exception)
+	GetMyBlockPackSnapshotsById(ctx context.Context, requestDto *apicontract.GetMyBlockPackSnapshotsByIdRequestDto) (*apicontract.GetMyBlockPackSnapshotsByIdResponseDto, *exceptions.Exception)
+	GetMyBlockPackSnapshotDiffById(ctx context.Context, requestDto *apicontract.GetMyBlockPackSnapshotDiffByIdRequestDto) (*apicontract.GetMyBlockPackSnapshotDiffByIdResponseDto, *exceptions.Exception)
+	RestoreMyBlockPackSnapshotById(ctx context.Context, requestDto *apicontract.RestoreMyBlockPackSnapshotByIdRequestDto) (*apicontract.RestoreMyBlockPackSnapshotByIdResponseDto, *exceptions.Exception)
+}
+
+type YjsDocumentHistorian interface {
+	ProjectDocuments(
+		context.Context,
+		[]apicontract.ProjectBlockPackYjsDocumentReqDto,
+	) ([]apicontract.ProjectBlockPackYjsDocumentResDto, error)
+	RestoreDocument(
+		context.Context,
+		apicontract.RestoreBlockPackYjsDocumentReqDto,
+	) (*apicontract.RestoreBlockPackYjsDocumentResDto, error)
+}
+
+type BlockPackSnapshotService struct {
+	validator                      *validator.Validate
+	db                             *gorm.DB
+	yjsDocumentHistorian           YjsDocumentHistorian
+	blockPackRepository            repositories.BlockPackRepositoryInterface
+	blockPackYjsRepository         repositories.BlockPackYjsRepositoryInterface
+	blockPackYjsSnapshotRepository repositories.BlockPackYjsSnapshotRepositoryInterface
+	outboxEventRepository          repositories.OutboxEventRepositoryInterface
+}
+
+func NewBlockPackSnapshotService(
+	validator *validator.Validate,
+	db *gorm.DB,
+	yjsDocumentHistorian YjsDocumentHistorian,
+	blockPackRepository repositories.BlockPackRepositoryInterface,
+	blockPackYjsRepository repositories.BlockPackYjsRepositoryInterface,
+	blockPackYjsSnapshotRepository repositories.BlockPackYjsSnapshotRepositoryInterface,
+	outboxEventRepository repositories.OutboxEventRepositoryInterface,
+) BlockPackSnapshotServiceInterface {
+	return &BlockPackSnapshotService{
+		validator:                      validator,
+		db:                             db,
+		yjsDocumentHistorian:           yjsDocumentHistorian,
+		blockPackRepository:            blockPackRepository,
+		blockPackYjsRepository:         blockPackYjsRepository,
+		blockPackYjsSnapshotRepository: blockPackYjsSnapshotRepository,
+		outboxEventRepository:          outboxEventRepository,
+	}
+}
+
+/* ============================== Main Methods ============================== */
+
+func (s *BlockPackSnapshotService) CreateMyBlockPackSnapshotById(
+	ctx context.Context, requestDto *apicontract.CreateMyBlockPackSnapshotByIdRequestDto,
+) (*apicontract.CreateMyBlockPackSnapshotByIdResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(requestDto); err != nil {
+		return nil, apiexceptions.NewBlockPackSnapshotException().InvalidDto().WithOrigin(err)
+	}
+
+	actorUserId, exception := s.checkPermission(ctx, requestDto.Param.BlockPackId)
+	if exception != nil {
+		return nil, exception
+	}
+
+	tx := s.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return nil, exceptions.New(
+			"TransactionBeginFailed",
+			"BlockPackSnapshot",
+			"CreateMyBlockPackSnapshotById",
+			"Failed to begin the block pack snapshot transaction",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(tx.Error)
+	}
+
+	namedSnapshotCount, err := s.blockPackYjsSnapshotRepository.CountByBlockPackIdAndKind(
+		requestDto.Param.BlockPackId,
+		enums.BlockPackSnapshotKind_Named,
+		options.WithTransactionDB(tx),
+	)
+	if err != nil {
+		tx.Rollback()
+		return nil, apiexceptions.NewBlockPackSnapshotException().FailedToCreate().WithOrigin(err)
+	}
+	if namedSnapshotCount >= int64(constants.MaxBlockPackNamedSnapshotCount) {
+		tx.Rollback()
+		return nil, apiexceptions.NewBlockPackSnapshotException().MaximumNamedSnapshotCountExceeded(constants.MaxBlockPackNamedSnapshotCount)
+	}
+
+	state, updateSequence, exception := s.loadCurrentState(requestDto.Param.BlockPackId, options.WithDB(tx))
+	if exception != nil {
+		tx.Rollback()
+		return nil, exception
+	}
+
+	snapshot, err := s.blockPackYjsSnapshotRepository.CreateOne(
+		inputs.CreateBlockPackYjsSnapshotInput{
+			BlockPackId:    requestDto.Param.BlockPackId,
+			Kind:           enums.BlockPackSnapshotKind_Named,
+			Name:           &requestDto.Body.Name,
+			State:          state,
+			UpdateSequence: updateSequence,
+			CreatorId:      &actorUserId,
+		},
+		options.WithTransactionDB(tx),
+	)
+	if err != nil {
+		tx.Rollback()
+		return nil, apiexceptions.NewBlockPackSnapshotException().FailedToCreate().WithOrigin(err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, apiexceptions.NewBlockPackSnapshotException().FailedToCommitTransaction().WithOrigin(err)
+	}
+
+	responseDto := toBlockPackSnapshotResponseDto(*snapshot)
+	return &responseDto, nil
+}
+
+func (s *BlockPackSnapshotService) GetMyBlockPackSnapshotsById(
+	ctx context.Context, requestDto *apicontract.GetMyBlockPackSnapshotsByIdRequestDto,
+) (*apicontract.GetMyBlockPackSnapshotsByIdResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(requestDto); err != nil {
+		return nil, apiexceptions.NewBlockPackSnapshotException().InvalidDto().WithOrigin(err)
+	}
+
+	if _, exception := s.checkPermission(ctx, requestDto.Param.BlockPackId); exception != nil {
+		return nil, exception
+	}
+
+	snapshots, err := s.blockPackYjsSnapshotRepository.GetManyByBlockPackId(
+		requestDto.Param.BlockPackId,
+		options.WithDB(s.db.WithContext(ctx)),
+	)
+	if err != nil {
+		return nil, apiexceptions.NewBlockPackSnapshotException().NotFound().WithOrigin(err)
+	}
+
+	responseDto := make(apicontract.GetMyBlockPackSnapshotsByIdResponseDto, len(snapshots))
+	for index, snapshot := range snapshots {
+		responseDto[index] = toBlockPackSnapshotResponseDto(snapshot)
+	}
+
+	return &responseDto, nil
+}
+
+func (s *BlockPackSnapshotService) GetMyBlockPackSnapshotDiffById(
+	ctx context.Context, requestDto *apicontract.GetMyBlockPackSnapshotDiffByIdRequestDto,
+) (*apicontract.GetMyBlockPackSnapshotDiffByIdResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(requestDto); err != nil {
+		return nil, apiexceptions.NewBlockPackSnapshotException().InvalidDto().WithOrigin(err)
+	}
+
+	if _, exception := s.checkPermission(ctx, requestDto.Param.BlockPackId); exception != nil {
+		return nil, exception
+	}
+
+	db := s.db.WithContext(ctx)
+
+	fromSnapshot, err := s.blockPackYjsSnapshotRepository.GetOneById(
+		requestDto.Param.BlockPackId,
+		requestDto.Param.FromSnapshotId,
+		options.WithDB(db),
+	)
+	if err != nil {
+		return nil, apiexceptions.NewBlockPackSnapshotException().NotFound().WithOrigin(err)
+	}
+
+	var toState []byte
+	var toUpdateSequence int64
+	if requestDto.Param.ToSnapshotId != nil {
+		toSnapshot, err := s.blockPackYjsSnapshotRepository.GetOneById(
+			requestDto.Param.BlockPackId,
+			*requestDto.Param.ToSnapshotId,
+			options.WithDB(db),
+		)
+		if err != nil {
+			return nil, apiexceptions.NewBlockPackSnapshotException().NotFound().WithOrigin(err)
+		}
+		toState, toUpdateSequence = toSnapshot.State, toSnapshot.UpdateSequence
+	} else {
+		var exception *exceptions.Exception
+		toState, toUpdateSequence, exception = s.loadCurrentState(requestDto.Param.BlockPackId, options.WithDB(db))
+		if exception != nil {
+			return nil, exception
+		}
+	}
+
+	projectedDtos, err := s.yjsDocumentHistorian.ProjectDocuments(
+		ctx,
+		[]apicontract.ProjectBlockPackYjsDocumentReqDto{{State: fromSnapshot.State}, {State: toState}},
+	)
+	if err != nil {
+		return nil, apiexceptions.NewBlockPackSnapshotException().FailedToProject().WithOrigin(err)
+	}
+	fromBlocks, _, err := editableblock.FlattenEditableBlocks(projectedDtos[0].Blocks)
+	if err != nil {
+		return nil, apiexceptions.NewBlockPackSnapshotException().FailedToProject().WithOrigin(err)
+	}
+	toBlocks, _, err := editableblock.FlattenEditableBlocks(projectedDtos[1].Blocks)
+	if err != nil {
+		return nil, apiexceptions.NewBlockPackSnapshotException().FailedToProject().WithOrigin(err)
+	}
+
+	responseDto := diffBlockPackSnapshotBlocks(fromBlocks, toBlocks)
+	responseDto.BlockPackId = requestDto.Param.BlockPackId
+	responseDto.FromSnapshotId = fromSnapshot.Id
+	responseDto.ToSnapshotId = requestDto.Param.ToSnapshotId
+	responseDto.FromUpdateSequence = fromSnapshot.UpdateSequence
+	responseDto.ToUpdateSequence = toUpdateSequence
+
+	return &responseDto, nil
+}
+
+// RestoreMyBlockPackSnapshotById appends the restoration as a regular Yjs update, so the history
+// is kept and the connected editors receive it like any other change after they resync.
+func (s *BlockPackSnapshotService) RestoreMyBlockPackSnapshotById(
+	ctx context.Context, requestDto *apicontract.RestoreMyBlockPackSnapshotByIdRequestDto,
+) (*apicontract.RestoreMyBlockPackSnapshotByIdResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(requestDto); err != nil {
+		return nil, apiexceptions.NewBlockPackSnapshotException().InvalidDto().WithOrigin(err)
+	}
+
+	actorUserId, exception := s.checkPermission(ctx, requestDto.Param.BlockPackId)
+	if exception != nil {
+		return nil, exception
+	}
+
+	db := s.db.WithContext(ctx)
+
+	targetSnapshot, err := s.blockPackYjsSnapshotRepository.GetOneById(
+		requestDto.Param.BlockPackId,
+		requestDto.Param.SnapshotId,
+		options.WithDB(db),
+	)
+	if err != nil {
+		return nil, apiexceptions.NewBlockPackSnapshotException().NotFound().WithOrigin(err)
+	}
+
+	currentState, currentUpdateSequence, exception := s.loadCurrentState(requestDto.Param.BlockPackId, options.WithDB(db))
+	if exception != nil {
+		return nil, exception
+	}
+
+	restoredDto, err := s.yjsDocumentHistorian.RestoreDocument(ctx, apicontract.RestoreBlockPackYjsDocumentReqDto{
+		CurrentState: currentState,
+		TargetState:  targetSnapshot.State,
+	})
+	if err != nil {
+		return nil, apiexceptions.NewBlockPackSnapshotException().FailedToRestore().WithOrigin(err)
+	}
+
+	tx := db.Begin()
+	if tx.Error != nil {
+		return nil, exceptions.New(
+			"TransactionBeginFailed",
+			"BlockPackSnapshot",
+			"RestoreMyBlockPackSnapshotById",
+			"Failed to begin the block pack snapshot transaction",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(tx.Error)
+	}
+
+	preRestoreSnapshot, err := s.blockPackYjsSnapshotRepository.CreateOne(
+		inputs.CreateBlockPackYjsSnapshotInput{
+			BlockPackId:    requestDto.Param.BlockPackId,
+			Kind:           enums.BlockPackSnapshotKind_PreRestore,
+			State:          currentState,
+			UpdateSequence: currentUpdateSequence,
+			CreatorId:      &actorUserId,
+		},
+		options.WithTransactionDB(tx),
+	)
+	if err != nil {
+		tx.Rollback()
+		return nil, apiexceptions.NewBlockPackSnapshotException().FailedToCreate().WithOrigin(err)
+	}
+
+	updateSequence, err := s.blockPackYjsRepository.AppendUpdate(
+		requestDto.Param.BlockPackId,
+		inputs.AppendBlockPackYjsUpdateInput{
+			PersistenceBatchId: uuid.New(),
+			Payload:            restoredDto.Update,
+		},
+		options.WithTransactionDB(tx),
+	)
+	if err != nil {
+		tx.Rollback()
+		return nil, apiexceptions.NewBlockPackSnapshotException().FailedToRestore().WithOrigin(err)
+	}
+	// the restoration update is computed against the loaded state, so it is rejected if any update
+	// has been persisted in the meantime instead of silently reverting that update
+	if updateSequence != currentUpdateSequence+1 {
+		tx.Rollback()
+		return nil, apiexceptions.NewBlockPackSnapshotException().DocumentChangedDuringRestore()
+	}
+
+	if err := s.outboxEventRepository.EnqueueBlockPackDocumentRestored(
+		tx,
+		uuid.NewString(),
+		requestDto.Param.BlockPackId,
+		targetSnapshot.Id,
+		updateSequence,
+	); err != nil {
+		tx.Rollback()
+		return nil, apiexceptions.NewBlockPackSnapshotException().FailedToRestore().WithOrigin(err)
+	}
+	if err := s.outboxEventRepository.EnqueueYjsMaintenanceHint(
+		tx,
+		uuid.NewString(),
+		requestDto.Param.BlockPackId,
+		"block_pack_snapshot_restored",
+	); err != nil {
+		tx.Rollback()
+		return nil, apiexceptions.NewBlockPackSnapshotException().FailedToRestore().WithOrigin(err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, apiexceptions.NewBlockPackSnapshotException().FailedToCommitTransaction().WithOrigin(err)
+	}
+
+	return &apicontract.RestoreMyBlockPackSnapshotByIdResponseDto{
+		BlockPackId:          requestDto.Param.BlockPackId,
+		SnapshotId:           targetSnapshot.Id,
+		PreRestoreSnapshotId: preRestoreSnapshot.Id,
+		UpdateSequence:       updateSequence,
+		RestoredAt:           time.Now(),
+	}, nil
+}
+
+/* ============================== Private Methods ============================== */
+
+func (s *BlockPackSnapshotService) checkPermission(
+	ctx context.Context, blockPackId uuid.UUID,
+) (uuid.UUID, *exceptions.Exception) {
+	allowedPermissions, exception := contexts.GetAllowedPermissions(ctx)
+	if exception != nil {
+		return uuid.Nil, exception
+	}
+	actorUserId, exception := contexts.GetActorUserId(ctx)
+	if exception != nil {
+		return uuid.Nil, exception
+	}
+
+	if _, exception := s.blockPackRepository.CheckPermissionAndGetOneById(
+		blockPackId,
+		actorUserId,
+		nil,
+		allowedPermissions,
+		options.WithDB(s.db.WithContext(ctx)),
+		options.WithAllowedPermissions(allowedPermissions),
+		options.WithOnlyDeleted(types.Ternary_Negative),
+	); exception != nil {
+		return uuid.Nil, exception
+	}
+
+	return actorUserId, nil
+}
+
+func (s *BlockPackSnapshotService) loadCurrentState(
+	blockPackId uuid.UUID, opts ...options.RepositoryOptions,
+) ([]byte, int64, *exceptions.Exception) {
+	document, updates, err := s.blockPackYjsRepository.LoadDocumentAndUpdates(blockPackId, opts...)
+	if err != nil {
+		return nil, 0, apiexceptions.NewBlockPackException().NotFound().WithOrigin(err)
+	}
+
+	state := yjsworkercontract.YjsDocumentState{
+		Snapshot:               document.Snapshot,
+		StateVector:            document.StateVector,
+		LastUpdateSequence:     document.LastUpdateSequence,
+		CompactedUntilSequence: document.CompactedUntilSequence,
+		ProjectedUntilSequence: document.ProjectedUntilSequence,
+		Updates:                make([]yjsworkercontract.YjsDocumentUpdate, len(updates)),
+	}
+	for index, update := range updates {
+		state.Updates[index] = yjsworkercontract.YjsDocumentUpdate{
+			UpdateSequence: update.UpdateSequence,
+			Payload:        update.Payload,
+		}
+	}
+
+	payload, err := state.MarshalBytes()
+	if err != nil {
+		return nil, 0, apiexceptions.NewBlockPackSnapshotException().FailedToMarshalData(state).WithOrigin(err)
+	}
+
+	return payload, document.LastUpdateSequence, nil
+}
+
+func toBlockPackSnapshotResponseDto(snapshot schemas.BlockPackYjsSnapshot) apicontract.BlockPackSnapshotResponseDto {
+	return apicontract.BlockPackSnapshotResponseDto{
+		Id:             snapshot.Id,
+		BlockPackId:    snapshot.BlockPackId,
+		Kind:           enumcontract.BlockPackSnapshotKind(snapshot.Kind),
+		Name:           snapshot.Name,
+		UpdateSequence: snapshot.UpdateSequence,
+		CreatorId:      snapshot.CreatorId,
+		CreatedAt:      snapshot.CreatedAt,
+	}
+}
+
+func toBlockPackSnapshotDiffBlockDto(block blocknote.RawFlattenedEditableBlock) apicontract.BlockPackSnapshotDiffBlockDto {
+	return apicontract.BlockPackSnapshotDiffBlockDto{
+		Id:            block.Id,
+		ParentBlockId: block.ParentBlockId,
+		PrevBlockId:   block.PrevBlockId,
+		Type:          block.Type,
+		Props:         block.Props,
+		Content:       block.Content,
+	}
+}
+
+// diffBlockPackSnapshotBlocks matches the blocks by their ids, the blocks keep their ids across
+// the edits, so a block is either added, removed, or changed in its content or its position.
+func diffBlockPackSnapshotBlocks(
+	fromBlocks []blocknote.RawFlattenedEditableBlock,
+	toBlocks []blocknote.RawFlattenedEditableBlock,
+) apicontract.GetMyBlockPackSnapshotDiffByIdResponseDto {
+	responseDto := apicontract.GetMyBlockPackSnapshotDiffByIdResponseDto{
+		AddedBlocks:   []apicontract.BlockPackSnapshotDiffBlockDto{},
+		RemovedBlocks: []apicontract.BlockPackSnapshotDiffBlockDto{},
+		ChangedBlocks: []apicontract.BlockPackSnapshotChangedBlockDto{},
+	}
+
+	fromBlockById := make(map[uuid.UUID]blocknote.RawFlattenedEditableBlock, len(fromBlocks))
+	for _, block := range fromBlocks {
+		fromBlockById[block.Id] = block
+	}
+	toBlockIdSet := make(map[uuid.UUID]bool, len(toBlocks))
+
+	for _, toBlock := range toBlocks {
+		toBlockIdSet[toBlock.Id] = true
+
+		fromBlock, exists := fromBlockById[toBlock.Id]
+		if !exists {
+			responseDto.AddedBlocks = append(responseDto.AddedBlocks, toBlockPackSnapshotDiffBlockDto(toBlock))
+			continue
+		}
+
+		isContentChanged := fromBlock.Type != toBlock.Type ||
+			!isSameRawJSON(fromBlock.Props, toBlock.Props) ||
+			!isSameRawJSON(fromBlock.Content, toBlock.Content)
+		isPositionChanged := !isSameBlockId(fromBlock.ParentBlockId, toBlock.ParentBlockId) ||
+			!isSameBlockId(fromBlock.PrevBlockId, toBlock.PrevBlockId)
+		if !isContentChanged && !isPositionChanged {
+			continue
+		}
+
+		responseDto.ChangedBlocks = append(responseDto.ChangedBlocks, apicontract.BlockPackSnapshotChangedBlockDto{
+			Id:                toBlock.Id,
+			IsContentChanged:  isContentChanged,
+			IsPositionChanged: isPositionChanged,
+			Before:            toBlockPackSnapshotDiffBlockDto(fromBlock),
+			After:             toBlockPackSnapshotDiffBlockDto(toBlock),
+		})
+	}
+
+	for _, fromBlock := range fromBlocks {
+		if !toBlockIdSet[fromBlock.Id] {
+			responseDto.RemovedBlocks = append(responseDto.RemovedBlocks, toBlockPackSnapshotDiffBlockDto(fromBlock))
+		}
+	}
+
+	return responseDto
+}
+
+func isSameRawJSON(a json.RawMessage, b json.RawMessage) bool {
+	var compactedA, compactedB bytes.Buffer
+	if json.Compact(&compactedA, a) != nil || json.Compact(&compactedB, b) != nil {
+		return bytes.Equal(a, b)
+	}
+
+	return bytes.Equal(compactedA.Bytes(), compactedB.Bytes())
+}
+
+func isSameBlockId(a *uuid.UUID, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
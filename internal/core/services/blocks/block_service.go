@@ -19,6 +19,7 @@ import (
 	types "github.com/HiIamJeff67/notegic-backend/shared/types"
 
 	searchcursor "github.com/HiIamJeff67/notegic-backend/shared/lib/searchcursor"
+	searchtext "github.com/HiIamJeff67/notegic-backend/shared/lib/searchtext"
 
 	editableblock "github.com/HiIamJeff67/notegic-backend/shared/util/editableblock"
 
@@ -643,72 +644,78 @@ func (s *BlockService) SearchPrivateBlocks(
 		Scopes(s.subShelfScope.FilterOnlyDeleted(types.Ternary_Negative)).
 		Scopes(s.blockScope.IncludePreloads([]schemas.BlockRelation{schemas.BlockRelation_Children}))
 
-	if len(strings.ReplaceAll(gqlInput.Query, " ", "")) > 0 {
-		pattern := "%" + gqlInput.Query + "%"
-		query = query.Where(`"BlockTable".content::text ILIKE ? OR "BlockTable".props::text ILIKE ? OR "BlockTable".type::text ILIKE ?`, pattern, pattern, pattern)
+	isFullTextSearch := len(strings.ReplaceAll(gqlInput.Query, " ", "")) > 0
+	if isFullTextSearch {
+		query = query.Scopes(scopes.MatchFullTextSearch(schemas.Block{}.TableName(), gqlInput.Query, userId))
 	}
 
-	if gqlInput.After != nil && len(strings.ReplaceAll(*gqlInput.After, " ", "")) > 0 {
-		searchCursor, err := searchcursor.Decode[gqlmodels.SearchBlockCursorFields](*gqlInput.After)
-		if err != nil {
-			return nil, apiexceptions.NewSearchException().FailedToDecode().WithOrigin(err)
-		}
+	keyset := newBlockSearchKeyset(gqlInput, isFullTextSearch, userId)
+	page, err := keyset.NewPage(gqlInput.After, gqlInput.Before, gqlInput.First, gqlInput.Last, constants.DefaultSearchLimit, constants.MaxSearchLimit)
+	if err != nil {
+		return nil, apiexceptions.NewSearchException().FailedToDecode().WithOrigin(err)
+	}
+	query = query.Scopes(scopes.PaginateByKeyset(keyset, page.AfterCursor, page.BeforeCursor, page.IsBackward)).
+		Limit(page.Limit + 1)
 
-		query = query.Where(`"BlockTable".id > ?`, searchCursor.Fields.ID)
+	var blocks []schemas.Block
+	if err := query.Find(&blocks).Error; err != nil {
+		return nil, apiexceptions.NewBlockException().NotFound().WithOrigin(err)
 	}
 
-	if gqlInput.SortBy != nil && gqlInput.SortOrder != nil {
-		cending := gqlmodels.SearchSortOrderAsc.String()
-		if *gqlInput.SortOrder == gqlmodels.SearchSortOrderDesc {
-			cending = gqlmodels.SearchSortOrderDesc.String()
-		}
+	blocks, hasNextPage, hasPreviousPage := searchcursor.Slice(page, blocks)
 
-		switch *gqlInput.SortBy {
-		case gqlmodels.SearchBlockSortByType:
-			query = query.Order(`"BlockTable".type ` + cending).Order(`"BlockTable".updated_at ` + cending).Order(`"BlockTable".created_at ` + cending)
-		case gqlmodels.SearchBlockSortByLastUpdate:
-			query = query.Order(`"BlockTable".updated_at ` + cending).Order(`"BlockTable".type ` + cending).Order(`"BlockTable".created_at ` + cending)
-		case gqlmodels.SearchBlockSortByCreatedAt:
-			query = query.Order(`"BlockTable".created_at ` + cending).Order(`"BlockTable".type ` + cending).Order(`"BlockTable".updated_at ` + cending)
-		default:
-			query = query.Order(`"BlockTable".type ` + cending).Order(`"BlockTable".updated_at ` + cending).Order(`"BlockTable".created_at ` + cending)
+	fullTextSearchResultById := make(map[uuid.UUID]scopes.FullTextSearchResult, len(blocks))
+	if isFullTextSearch && len(blocks) > 0 {
+		blockIds := make([]uuid.UUID, len(blocks))
+		for index, block := range blocks {
+			blockIds[index] = block.Id
 		}
-	}
-
-	limit := constants.DefaultSearchLimit
-	if gqlInput.First != nil && *gqlInput.First > 0 {
-		limit = int(*gqlInput.First)
-	}
-	limit = min(limit, constants.MaxSearchLimit)
-	query = query.Limit(limit + 1)
 
-	var blocks []schemas.Block
-	if err := query.Find(&blocks).Error; err != nil {
-		return nil, apiexceptions.NewBlockException().NotFound().WithOrigin(err)
+		var fullTextSearchResults []scopes.FullTextSearchResult
+		if err := db.Model(&schemas.Block{}).
+			Scopes(scopes.SelectFullTextSearchResults(schemas.Block{}.TableName(), `block_search_text("BlockTable".content)`, gqlInput.Query, userId)).
+			Where(`"BlockTable".id IN ?`, blockIds).
+			Find(&fullTextSearchResults).Error; err != nil {
+			return nil, apiexceptions.NewSearchException().FailedToRankFullTextSearch().WithOrigin(err)
+		}
+		for _, fullTextSearchResult := range fullTextSearchResults {
+			fullTextSearchResultById[fullTextSearchResult.Id] = fullTextSearchResult
+		}
 	}
 
-	hasNextPage := len(blocks) > limit
 	searchEdges := make([]*gqlmodels.SearchBlockEdge, len(blocks))
 	for index, block := range blocks {
-		searchCursor := searchcursor.SearchCursor[gqlmodels.SearchBlockCursorFields]{Fields: gqlmodels.SearchBlockCursorFields{ID: block.Id}}
-		encodedSearchCursor, err := searchCursor.Encode()
+		fullTextSearchResult, isRanked := fullTextSearchResultById[block.Id]
+
+		var sortValues []any
+		switch keyset.SortBy {
+		case gqlmodels.SearchBlockSortByRelevance.String():
+			sortValues = []any{fullTextSearchResult.Rank, block.Id}
+		case gqlmodels.SearchBlockSortByLastUpdate.String():
+			sortValues = []any{block.UpdatedAt, block.Type, block.CreatedAt, block.Id}
+		case gqlmodels.SearchBlockSortByCreatedAt.String():
+			sortValues = []any{block.CreatedAt, block.Type, block.UpdatedAt, block.Id}
+		case gqlmodels.SearchBlockSortByType.String():
+			sortValues = []any{block.Type, block.UpdatedAt, block.CreatedAt, block.Id}
+		default:
+			sortValues = []any{block.Id}
+		}
+		encodedSearchCursor, err := keyset.Encode(sortValues...)
 		if err != nil {
 			return nil, apiexceptions.NewSearchException().FailedToEncode().WithOrigin(err)
 		}
-		if encodedSearchCursor == nil {
-			return nil, apiexceptions.NewSearchException().FailedToUnmarshalSearchCursor()
-		}
 
-		searchEdges[index] = &gqlmodels.SearchBlockEdge{EncodedSearchCursor: *encodedSearchCursor, Node: block.ToPrivateBlock()}
+		searchEdges[index] = &gqlmodels.SearchBlockEdge{EncodedSearchCursor: encodedSearchCursor, Node: block.ToPrivateBlock()}
+		if isRanked {
+			highlight := searchtext.FormatHighlight(fullTextSearchResult.Highlight)
+			searchEdges[index].Rank = &fullTextSearchResult.Rank
+			searchEdges[index].Highlight = &highlight
+		}
 	}
 
 	searchPageInfo := &gqlmodels.SearchPageInfo{
 		HasNextPage:     hasNextPage,
-		HasPreviousPage: gqlInput.After != nil && len(strings.ReplaceAll(*gqlInput.After, " ", "")) > 0,
-	}
-
-	if hasNextPage {
-		searchEdges = searchEdges[:limit]
+		HasPreviousPage: hasPreviousPage,
 	}
 
 	if len(searchEdges) > 0 {
@@ -725,3 +732,34 @@ func (s *BlockService) SearchPrivateBlocks(
 		SearchTime:     searchTime,
 	}, nil
 }
+
+/* ============================== Auxiliary Functions ============================== */
+
+// newBlockSearchKeyset builds the keyset of the requested sort order, where the relevance falls back to the type if the query is empty
+func newBlockSearchKeyset(gqlInput gqlmodels.SearchBlockInput, isFullTextSearch bool, userId uuid.UUID) *searchcursor.Keyset {
+	isDescending := gqlInput.SortOrder != nil && *gqlInput.SortOrder == gqlmodels.SearchSortOrderDesc
+
+	idKey := searchcursor.KeysetKey{Column: `"BlockTable".id`, Type: "uuid"}
+	typeKey := searchcursor.KeysetKey{Column: `"BlockTable".type`, Type: `"BlockType"`}
+	updatedAtKey := searchcursor.KeysetKey{Column: `"BlockTable".updated_at`, Type: "timestamptz"}
+	createdAtKey := searchcursor.KeysetKey{Column: `"BlockTable".created_at`, Type: "timestamptz"}
+
+	if gqlInput.SortBy == nil {
+		return searchcursor.NewKeyset("", isDescending, idKey)
+	}
+
+	switch *gqlInput.SortBy {
+	case gqlmodels.SearchBlockSortByRelevance:
+		if isFullTextSearch {
+			rankKey := scopes.FullTextSearchRankKey(schemas.Block{}.TableName(), gqlInput.Query, userId)
+			return searchcursor.NewKeyset(gqlmodels.SearchBlockSortByRelevance.String(), isDescending, rankKey, idKey)
+		}
+		return searchcursor.NewKeyset(gqlmodels.SearchBlockSortByType.String(), isDescending, typeKey, updatedAtKey, createdAtKey, idKey)
+	case gqlmodels.SearchBlockSortByLastUpdate:
+		return searchcursor.NewKeyset(gqlmodels.SearchBlockSortByLastUpdate.String(), isDescending, updatedAtKey, typeKey, createdAtKey, idKey)
+	case gqlmodels.SearchBlockSortByCreatedAt:
+		return searchcursor.NewKeyset(gqlmodels.SearchBlockSortByCreatedAt.String(), isDescending, createdAtKey, typeKey, updatedAtKey, idKey)
+	default:
+		return searchcursor.NewKeyset(gqlmodels.SearchBlockSortByType.String(), isDescending, typeKey, updatedAtKey, createdAtKey, idKey)
+	}
+}
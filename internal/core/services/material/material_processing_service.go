@@ -0,0 +1,251 @@
+package material
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"gorm.io/gorm"
+
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+	types "github.com/HiIamJeff67/notegic-backend/shared/types"
+
+	pdf "github.com/HiIamJeff67/notegic-backend/shared/lib/pdf"
+	searchtext "github.com/HiIamJeff67/notegic-backend/shared/lib/searchtext"
+	thumbnail "github.com/HiIamJeff67/notegic-backend/shared/lib/thumbnail"
+
+	logs "github.com/HiIamJeff67/notegic-backend/shared/platform/observability/logs"
+
+	inputs "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/inputs"
+	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
+	repositories "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/repositories"
+	schemas "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas"
+	enums "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/enums"
+	storage "github.com/HiIamJeff67/notegic-backend/internal/core/data/storage"
+	apiexceptions "github.com/HiIamJeff67/notegic-backend/internal/core/exceptions"
+)
+
+type MaterialProcessingServiceInterface interface {
+	ProcessMaterialById(ctx context.Context, materialId uuid.UUID) (enums.MaterialProcessingStatus, *exceptions.Exception)
+}
+
+type MaterialProcessingService struct {
+	db                 *gorm.DB
+	storage            storage.StorageInterface
+	materialRepository repositories.MaterialRepositoryInterface
+}
+
+func NewMaterialProcessingService(
+	db *gorm.DB,
+	storage storage.StorageInterface,
+	materialRepository repositories.MaterialRepositoryInterface,
+) MaterialProcessingServiceInterface {
+	return &MaterialProcessingService{
+		db:                 db,
+		storage:            storage,
+		materialRepository: materialRepository,
+	}
+}
+
+/* ============================== Constants ============================== */
+
+const (
+	// the larger contents are skipped, since the whole content is read into the memory to be processed
+	maxMaterialProcessingSize types.ByteType = 64 * types.MB
+	// the previews fit in this square, which is enough for the cards and the lists of the clients
+	materialPreviewMaxDimension = 320
+	// the preview of a material is stored alongside its content
+	materialPreviewKeySuffix = ".preview"
+)
+
+// ProcessMaterialById extracts the search text and generates the preview of the current content of the material,
+// where the malformed contents are recorded as failed without an exception since retrying them never helps,
+// and the exceptions are only returned for the transient failures, so the caller can retry them later
+func (s *MaterialProcessingService) ProcessMaterialById(
+	ctx context.Context, materialId uuid.UUID,
+) (enums.MaterialProcessingStatus, *exceptions.Exception) {
+	db := s.db.WithContext(ctx)
+
+	material, exception := s.materialRepository.ClaimOneForProcessingById(materialId, options.WithDB(db))
+	if exception != nil {
+		return enums.MaterialProcessingStatus_Failed, exception
+	}
+	// the material has been deleted or processed by a previous delivery
+	if material == nil {
+		return enums.MaterialProcessingStatus_Skipped, nil
+	}
+
+	finishInput := inputs.FinishMaterialProcessingInput{
+		ProcessingStatus: enums.MaterialProcessingStatus_Skipped,
+		SearchText:       material.SearchText,
+	}
+	if material.Size <= maxMaterialProcessingSize.ToInt64() && isProcessableMaterialContentType(material.ContentType) {
+		content, exception := s.readContent(ctx, material)
+		if exception != nil {
+			s.failProcessing(ctx, material)
+			return enums.MaterialProcessingStatus_Failed, exception
+		}
+
+		searchText, preview, err := processMaterialContent(material.ContentType, content)
+		finishInput.ProcessingStatus = enums.MaterialProcessingStatus_Completed
+		finishInput.SearchText = nil
+		if len(searchText) > 0 {
+			finishInput.SearchText = &searchText
+		}
+		if err != nil {
+			finishInput.ProcessingStatus = enums.MaterialProcessingStatus_Failed
+			if logs.NotegicLogger != nil {
+				logs.NotegicLogger.Warn(ctx, "Failed to process the content of Material", attribute.String("error.message", err.Error()))
+			}
+		}
+		if preview != nil {
+			previewKey := material.ContentKey + materialPreviewKeySuffix
+			object, err := s.storage.NewObject(previewKey, bytes.NewReader(preview), int64(len(preview)))
+			if err != nil {
+				s.failProcessing(ctx, material)
+				return enums.MaterialProcessingStatus_Failed, apiexceptions.NewStorageException().FailedToReadObjectBytes().WithOrigin(err)
+			}
+			if err := s.storage.PutObjectByKey(ctx, previewKey, object); err != nil {
+				s.failProcessing(ctx, material)
+				return enums.MaterialProcessingStatus_Failed, apiexceptions.NewStorageException().FailedToPutObject(previewKey).WithOrigin(err)
+			}
+			finishInput.PreviewKey = &previewKey
+		}
+	}
+
+	finishedMaterial, exception := s.materialRepository.FinishProcessingById(
+		material.Id,
+		material.ContentKey,
+		material.Size,
+		finishInput,
+		options.WithDB(db),
+	)
+	if exception != nil {
+		return enums.MaterialProcessingStatus_Failed, exception
+	}
+	if finishedMaterial == nil {
+		// the content has been replaced in the meantime, the preview is only kept if the new content still owns the same key
+		if finishInput.PreviewKey != nil {
+			if reader, _, err := s.storage.GetObjectByKey(ctx, material.ContentKey, &storage.GetOptions{}); err == nil {
+				reader.Close()
+			} else {
+				s.deletePreview(ctx, *finishInput.PreviewKey)
+			}
+		}
+		return enums.MaterialProcessingStatus_Skipped, nil
+	}
+
+	// the preview of the previous content of the same key is stale once the new content has none
+	if finishInput.PreviewKey == nil && material.PreviewKey != nil {
+		s.deletePreview(ctx, *material.PreviewKey)
+	}
+
+	return finishedMaterial.ProcessingStatus, nil
+}
+
+/* ============================== Helper Functions ============================== */
+
+// readContent reads the content of the material, and a content whose size differs from the material is regarded as
+// transient, since the content of a saved material is only put after the material has been updated
+func (s *MaterialProcessingService) readContent(ctx context.Context, material *schemas.Material) ([]byte, *exceptions.Exception) {
+	reader, _, err := s.storage.GetObjectByKey(ctx, material.ContentKey, &storage.GetOptions{})
+	if err != nil {
+		return nil, apiexceptions.NewStorageException().FailedToReadObjectBytes().WithOrigin(err)
+	}
+	defer reader.Close()
+
+	content, err := io.ReadAll(io.LimitReader(reader, material.Size+1))
+	if err != nil {
+		return nil, apiexceptions.NewStorageException().FailedToReadObjectBytes().WithOrigin(err)
+	}
+	if int64(len(content)) != material.Size {
+		return nil, apiexceptions.NewStorageException().FailedToReadObjectBytes().
+			WithOrigin(errors.New("the content of Material does not match its size yet"))
+	}
+
+	return content, nil
+}
+
+// failProcessing records the failure of a transient error, so the material is claimable again by the retries
+func (s *MaterialProcessingService) failProcessing(ctx context.Context, material *schemas.Material) {
+	if _, exception := s.materialRepository.FinishProcessingById(
+		material.Id,
+		material.ContentKey,
+		material.Size,
+		inputs.FinishMaterialProcessingInput{
+			ProcessingStatus: enums.MaterialProcessingStatus_Failed,
+			SearchText:       material.SearchText,
+			PreviewKey:       material.PreviewKey,
+		},
+		options.WithDB(s.db.WithContext(ctx)),
+	); exception != nil && logs.NotegicLogger != nil {
+		logs.NotegicLogger.Error(ctx, exception.Origin(), exception.String())
+	}
+}
+
+func (s *MaterialProcessingService) deletePreview(ctx context.Context, previewKey string) {
+	if err := s.storage.DeleteObjectByKey(ctx, previewKey); err != nil && logs.NotegicLogger != nil {
+		logs.NotegicLogger.Error(ctx, err, "Failed to delete the preview of Material")
+	}
+}
+
+func isProcessableMaterialContentType(contentType enums.MaterialContentType) bool {
+	switch contentType {
+	case enums.MaterialContentType_PlainText,
+		enums.MaterialContentType_Markdown,
+		enums.MaterialContentType_HTML,
+		enums.MaterialContentType_JSON,
+		enums.MaterialContentType_PDF,
+		enums.MaterialContentType_PNG,
+		enums.MaterialContentType_JPG,
+		enums.MaterialContentType_JPEG,
+		enums.MaterialContentType_GIF:
+		return true
+	default:
+		return false
+	}
+}
+
+// processMaterialContent extracts the search text of the textual contents and the PDFs, and generates the preview
+// of the images and the first page of the PDFs, where a PDF page without any image simply has no preview,
+// and a parser panic on a malicious upload only fails the processing of that Material
+func processMaterialContent(contentType enums.MaterialContentType, content []byte) (searchText string, preview []byte, err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			searchText, preview, err = "", nil, fmt.Errorf("failed to process the material content: %v", recovered)
+		}
+	}()
+
+	switch contentType {
+	case enums.MaterialContentType_PlainText, enums.MaterialContentType_Markdown:
+		return searchtext.FromPlainText(content), nil, nil
+	case enums.MaterialContentType_HTML:
+		return searchtext.FromHTML(content), nil, nil
+	case enums.MaterialContentType_JSON:
+		return searchtext.FromJSON(content), nil, nil
+	case enums.MaterialContentType_PDF:
+		document, err := pdf.Open(content)
+		if err != nil {
+			return "", nil, err
+		}
+		searchText := searchtext.FromPlainText([]byte(document.Text(searchtext.MaxLength)))
+		firstPageImage, err := document.FirstPageImage()
+		if err != nil {
+			return searchText, nil, nil
+		}
+		preview, err := thumbnail.FromImage(firstPageImage, materialPreviewMaxDimension)
+		if err != nil {
+			return searchText, nil, nil
+		}
+		return searchText, preview, nil
+	case enums.MaterialContentType_PNG, enums.MaterialContentType_JPG, enums.MaterialContentType_JPEG, enums.MaterialContentType_GIF:
+		preview, err := thumbnail.FromImageBytes(content, materialPreviewMaxDimension)
+		return "", preview, err
+	default:
+		return "", nil, nil
+	}
+}
@@ -16,6 +16,7 @@ import (
 	types "github.com/HiIamJeff67/notegic-backend/shared/types"
 
 	searchcursor "github.com/HiIamJeff67/notegic-backend/shared/lib/searchcursor"
+	searchtext "github.com/HiIamJeff67/notegic-backend/shared/lib/searchtext"
 
 	apicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/materials"
 	gqlmodels "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/graphql/models"
@@ -49,18 +50,25 @@ type MaterialServiceInterface interface {
 	RestoreMyMaterialsByIds(ctx context.Context, requestDto *apicontract.RestoreMyMaterialsByIdsRequestDto) (*apicontract.RestoreMyMaterialsByIdsResponseDto, *exceptions.Exception)
 	DeleteMyMaterialById(ctx context.Context, requestDto *apicontract.DeleteMyMaterialByIdRequestDto) (*apicontract.DeleteMyMaterialByIdResponseDto, *exceptions.Exception)
 	DeleteMyMaterialsByIds(ctx context.Context, requestDto *apicontract.DeleteMyMaterialsByIdsRequestDto) (*apicontract.DeleteMyMaterialsByIdsResponseDto, *exceptions.Exception)
+	InitiateMyMaterialUpload(ctx context.Context, requestDto *apicontract.InitiateMyMaterialUploadRequestDto) (*apicontract.InitiateMyMaterialUploadResponseDto, *exceptions.Exception)
+	UploadMyMaterialPart(ctx context.Context, requestDto *apicontract.UploadMyMaterialPartRequestDto) (*apicontract.UploadMyMaterialPartResponseDto, *exceptions.Exception)
+	CompleteMyMaterialUpload(ctx context.Context, requestDto *apicontract.CompleteMyMaterialUploadRequestDto) (*apicontract.CompleteMyMaterialUploadResponseDto, *exceptions.Exception)
+	AbortMyMaterialUpload(ctx context.Context, requestDto *apicontract.AbortMyMaterialUploadRequestDto) (*apicontract.AbortMyMaterialUploadResponseDto, *exceptions.Exception)
 
 	SearchPrivateMaterials(ctx context.Context, userId uuid.UUID, gqlInput gqlmodels.SearchMaterialInput) (*gqlmodels.SearchMaterialConnection, *exceptions.Exception)
 }
 
 type MaterialService struct {
-	validator          *validator.Validate
-	db                 *gorm.DB
-	storage            storage.StorageInterface
-	materialScope      scopes.MaterialScopeInterface
-	subShelfRepository repositories.SubShelfRepositoryInterface
-	materialRepository repositories.MaterialRepositoryInterface
-	storageKeySalt     string
+	validator                *validator.Validate
+	db                       *gorm.DB
+	storage                  storage.StorageInterface
+	materialScope            scopes.MaterialScopeInterface
+	subShelfRepository       repositories.SubShelfRepositoryInterface
+	materialRepository       repositories.MaterialRepositoryInterface
+	materialUploadRepository repositories.MaterialUploadRepositoryInterface
+	outboxEventRepository    repositories.OutboxEventRepositoryInterface
+	materialUploadExpiration time.Duration
+	storageKeySalt           string
 }
 
 func NewMaterialService(
@@ -70,19 +78,32 @@ func NewMaterialService(
 	materialScope scopes.MaterialScopeInterface,
 	subShelfRepository repositories.SubShelfRepositoryInterface,
 	materialRepository repositories.MaterialRepositoryInterface,
+	materialUploadRepository repositories.MaterialUploadRepositoryInterface,
+	outboxEventRepository repositories.OutboxEventRepositoryInterface,
+	materialUploadExpiration time.Duration,
 	storageKeySalt string,
 ) MaterialServiceInterface {
 	return &MaterialService{
-		validator:          validator,
-		db:                 db,
-		storage:            storage,
-		materialScope:      materialScope,
-		subShelfRepository: subShelfRepository,
-		materialRepository: materialRepository,
-		storageKeySalt:     storageKeySalt,
+		validator:                validator,
+		db:                       db,
+		storage:                  storage,
+		materialScope:            materialScope,
+		subShelfRepository:       subShelfRepository,
+		materialRepository:       materialRepository,
+		materialUploadRepository: materialUploadRepository,
+		outboxEventRepository:    outboxEventRepository,
+		materialUploadExpiration: materialUploadExpiration,
+		storageKeySalt:           storageKeySalt,
 	}
 }
 
+/* ============================== Constants ============================== */
+
+const (
+	// the maximum number of parts of a multipart upload in the S3 compatible storages
+	materialUploadMaxPartCount int64 = 10000
+)
+
 func (s *MaterialService) GetMyMaterialById(
 	ctx context.Context, requestDto *apicontract.GetMyMaterialByIdRequestDto,
 ) (*apicontract.GetMyMaterialByIdResponseDto, *exceptions.Exception) {
@@ -133,6 +154,8 @@ func (s *MaterialService) GetMyMaterialById(
 		ContentType:      *material.ContentType.ToContractable(),
 		ParseMediaType:   material.ParseMediaType,
 		DownloadURL:      downloadURL,
+		ProcessingStatus: *material.ProcessingStatus.ToContractable(),
+		PreviewURL:       s.presignPreview(ctx, material.PreviewKey),
 		DeletedAt:        material.DeletedAt,
 		UpdatedAt:        material.UpdatedAt,
 		CreatedAt:        material.CreatedAt,
@@ -241,6 +264,7 @@ func (s *MaterialService) GetMyMaterialsByParentSubShelfId(
 			actorUserId,
 			allowedPermissions,
 		).Scopes(scopes.NewMaterialScope().FilterOnlyDeleted(onlyDeleted)).
+		Scopes(scopes.RestrictRootShelvesOfShareLink("ss.root_shelf_id")).
 		Order("name ASC").
 		Limit(int(data.MaxMaterialsOfSubShelf)).
 		Find(&materials)
@@ -262,6 +286,8 @@ func (s *MaterialService) GetMyMaterialsByParentSubShelfId(
 			ContentType:      *material.ContentType.ToContractable(),
 			ParseMediaType:   material.ParseMediaType,
 			DownloadURL:      downloadURL,
+			ProcessingStatus: *material.ProcessingStatus.ToContractable(),
+			PreviewURL:       s.presignPreview(ctx, material.PreviewKey),
 			DeletedAt:        material.DeletedAt,
 			UpdatedAt:        material.UpdatedAt,
 			CreatedAt:        material.CreatedAt,
@@ -305,6 +331,7 @@ func (s *MaterialService) GetAllMyMaterialsByRootShelfId(
 		Where("ss.root_shelf_id = ? AND uts.user_id = ? AND uts.permission IN ?",
 			requestDto.Param.RootShelfId, actorUserId, allowedPermissions,
 		).Scopes(scopes.NewMaterialScope().FilterOnlyDeleted(onlyDeleted)).
+		Scopes(scopes.RestrictRootShelvesOfShareLink("ss.root_shelf_id")).
 		Limit(int(data.MaxMaterialsOfRootShelf)).
 		Order("name ASC").
 		Find(&materials)
@@ -326,6 +353,8 @@ func (s *MaterialService) GetAllMyMaterialsByRootShelfId(
 			ContentType:      *material.ContentType.ToContractable(),
 			ParseMediaType:   material.ParseMediaType,
 			DownloadURL:      downloadURL,
+			ProcessingStatus: *material.ProcessingStatus.ToContractable(),
+			PreviewURL:       s.presignPreview(ctx, material.PreviewKey),
 			DeletedAt:        material.DeletedAt,
 			UpdatedAt:        material.UpdatedAt,
 			CreatedAt:        material.CreatedAt,
@@ -491,17 +520,34 @@ func (s *MaterialService) SaveMyMaterialById(
 	partialUpdate.Values.ParseMediaType = object.ParseMediaType
 	partialUpdate.Values.Size = &size
 	partialUpdate.Values.ContentType = contentType
+	// the search text and the preview of the new content are generated asynchronously by the material processing
+	pendingStatus := enums.MaterialProcessingStatus_Pending
+	partialUpdate.Values.ProcessingStatus = &pendingStatus
 
+	tx := db.Begin()
 	material, exception := s.materialRepository.UpdateOneById(
 		requestDto.Param.MaterialId,
 		actorUserId,
 		partialUpdate,
-		options.WithDB(db),
+		options.WithTransactionDB(tx),
 		options.WithAllowedPermissions(allowedPermissions),
 	)
 	if exception != nil {
+		tx.Rollback()
 		return nil, exception
 	}
+	if err := s.outboxEventRepository.EnqueueMaterialProcessingHint(
+		tx,
+		uuid.NewString(),
+		material.Id,
+		"material_saved",
+	); err != nil {
+		tx.Rollback()
+		return nil, apiexceptions.NewMaterialException().FailedToUpdate().WithOrigin(err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, apiexceptions.NewMaterialException().FailedToCommitTransaction().WithOrigin(err)
+	}
 
 	// if there does exist a file, then put the file at the end to ensure the entire operation is consistent
 	if err := s.storage.PutObjectByKey(ctx, material.ContentKey, object); err != nil {
@@ -631,6 +677,8 @@ func (s *MaterialService) RestoreMyMaterialById(
 		ContentType:      *restoredMaterial.ContentType.ToContractable(),
 		ParseMediaType:   restoredMaterial.ParseMediaType,
 		DownloadURL:      downloadURL,
+		ProcessingStatus: *restoredMaterial.ProcessingStatus.ToContractable(),
+		PreviewURL:       s.presignPreview(ctx, restoredMaterial.PreviewKey),
 		DeletedAt:        restoredMaterial.DeletedAt,
 		UpdatedAt:        restoredMaterial.UpdatedAt,
 		CreatedAt:        restoredMaterial.CreatedAt,
@@ -678,6 +726,8 @@ func (s *MaterialService) RestoreMyMaterialsByIds(
 			ContentType:      *restoredMaterial.ContentType.ToContractable(),
 			ParseMediaType:   restoredMaterial.ParseMediaType,
 			DownloadURL:      downloadURL,
+			ProcessingStatus: *restoredMaterial.ProcessingStatus.ToContractable(),
+			PreviewURL:       s.presignPreview(ctx, restoredMaterial.PreviewKey),
 			DeletedAt:        restoredMaterial.DeletedAt,
 			UpdatedAt:        restoredMaterial.UpdatedAt,
 			CreatedAt:        restoredMaterial.CreatedAt,
@@ -750,6 +800,363 @@ func (s *MaterialService) DeleteMyMaterialsByIds(
 	}, nil
 }
 
+func (s *MaterialService) InitiateMyMaterialUpload(
+	ctx context.Context, requestDto *apicontract.InitiateMyMaterialUploadRequestDto,
+) (*apicontract.InitiateMyMaterialUploadResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(requestDto); err != nil {
+		return nil, apiexceptions.NewMaterialUploadException().InvalidDto().WithOrigin(err)
+	}
+	contentType, err := enums.ConvertStringToMaterialContentType(string(requestDto.Body.ContentType))
+	if err != nil {
+		return nil, apiexceptions.NewMaterialUploadException().InvalidDto().WithOrigin(err)
+	}
+	if *contentType == enums.MaterialContentType_None {
+		return nil, apiexceptions.NewMaterialUploadException().InvalidDto()
+	}
+
+	db := s.db.WithContext(ctx)
+	allowedPermissions, exception := contexts.GetAllowedPermissions(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+	actorUserId, exception := contexts.GetActorUserId(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+	actorUserPublicId, exception := contexts.GetActorUserPublicId(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+
+	material, exception := s.materialRepository.GetOneById(
+		requestDto.Param.MaterialId,
+		actorUserId,
+		options.WithDB(db),
+		options.WithAllowedPermissions(allowedPermissions),
+		options.WithOnlyDeleted(types.Ternary_Negative),
+	)
+	if exception != nil {
+		return nil, exception
+	}
+
+	// reject the uploads which can never be completed before any part is sent
+	maxMaterialSize, exception := s.materialUploadRepository.GetMaxMaterialSizeByMaterialId(
+		material.Id,
+		options.WithDB(db),
+	)
+	if exception != nil {
+		return nil, exception
+	}
+	if requestDto.Body.Size > maxMaterialSize {
+		return nil, apiexceptions.NewMaterialUploadException().MaximumSizeExceeded(requestDto.Body.Size, maxMaterialSize)
+	}
+	partSize := constants.MaterialUploadPartSize.ToInt64()
+	partCount := (requestDto.Body.Size + partSize - 1) / partSize
+	if partCount > materialUploadMaxPartCount {
+		return nil, apiexceptions.NewMaterialUploadException().MaximumSizeExceeded(requestDto.Body.Size, partSize*materialUploadMaxPartCount)
+	}
+
+	// the parts are staged under a content key of their own, so the current content of the
+	// material stays readable until the upload is completed and verified
+	uploadId := uuid.New()
+	contentKey := s.storage.GetKey(
+		actorUserPublicId.String(),
+		material.Id.String()+"/uploads/"+uploadId.String(),
+		s.storageKeySalt,
+	)
+	storageUploadId, err := s.storage.CreateMultipartUploadByKey(ctx, contentKey, &storage.PutOptions{
+		ContentType: string(*contentType),
+	})
+	if err != nil {
+		return nil, apiexceptions.NewStorageException().FailedToCreateMultipartUpload(contentKey).WithOrigin(err)
+	}
+
+	expiresAt := time.Now().Add(s.materialUploadExpiration)
+	parts := make([]apicontract.MaterialUploadPartResponseDto, 0, partCount)
+	for partNumber := int32(1); int64(partNumber) <= partCount; partNumber++ {
+		uploadURL, err := s.storage.PresignUploadPartByKey(ctx, contentKey, storageUploadId, partNumber, &storage.PresignOptions{
+			Expires: s.materialUploadExpiration,
+		})
+		if err != nil {
+			s.abortStorageUpload(ctx, contentKey, storageUploadId)
+			return nil, apiexceptions.NewStorageException().FailedToPresignUploadPart(contentKey).WithOrigin(err)
+		}
+		parts = append(parts, apicontract.MaterialUploadPartResponseDto{
+			PartNumber: partNumber,
+			UploadURL:  uploadURL,
+		})
+	}
+
+	_, exception = s.materialUploadRepository.CreateOne(
+		actorUserId,
+		inputs.CreateMaterialUploadInput{
+			Id:              uploadId,
+			MaterialId:      material.Id,
+			ContentKey:      contentKey,
+			StorageUploadId: storageUploadId,
+			Size:            requestDto.Body.Size,
+			ContentType:     *contentType,
+			PartSize:        partSize,
+			PartCount:       int32(partCount),
+			ExpiresAt:       expiresAt,
+		},
+		options.WithDB(db),
+	)
+	if exception != nil {
+		s.abortStorageUpload(ctx, contentKey, storageUploadId)
+		return nil, exception
+	}
+
+	return &apicontract.InitiateMyMaterialUploadResponseDto{
+		UploadId:  uploadId,
+		PartSize:  partSize,
+		Parts:     parts,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// UploadMyMaterialPart stores a part sent to the URL returned by a storage which can not presign
+// the part uploads, the part is rejected once it is larger than the part size of the upload
+func (s *MaterialService) UploadMyMaterialPart(
+	ctx context.Context, requestDto *apicontract.UploadMyMaterialPartRequestDto,
+) (*apicontract.UploadMyMaterialPartResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(requestDto); err != nil {
+		return nil, apiexceptions.NewMaterialUploadException().InvalidDto().WithOrigin(err)
+	}
+
+	db := s.db.WithContext(ctx)
+	actorUserId, exception := contexts.GetActorUserId(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+
+	materialUpload, exception := s.materialUploadRepository.GetOneByStorageUploadId(
+		requestDto.Param.StorageUploadId,
+		actorUserId,
+		options.WithDB(db),
+	)
+	if exception != nil {
+		return nil, exception
+	}
+	if !materialUpload.ExpiresAt.After(time.Now()) {
+		return nil, apiexceptions.NewMaterialUploadException().Expired()
+	}
+	if int64(requestDto.Param.PartNumber) > int64(materialUpload.PartCount) ||
+		int64(len(requestDto.Body.Content)) > materialUpload.PartSize {
+		return nil, apiexceptions.NewMaterialUploadException().InvalidDto()
+	}
+
+	eTag, err := s.storage.UploadPartByKey(
+		ctx,
+		materialUpload.ContentKey,
+		materialUpload.StorageUploadId,
+		requestDto.Param.PartNumber,
+		bytes.NewReader(requestDto.Body.Content),
+	)
+	if err != nil {
+		return nil, apiexceptions.NewStorageException().FailedToUploadPart(materialUpload.ContentKey).WithOrigin(err)
+	}
+
+	return &apicontract.UploadMyMaterialPartResponseDto{
+		PartNumber: requestDto.Param.PartNumber,
+		ETag:       eTag,
+	}, nil
+}
+
+// CompleteMyMaterialUpload assembles the uploaded parts and verifies the assembled object against
+// the declared size and content type, and the plan of the owner, before it replaces the content of
+// the material, the upload is consumed by a failed verification and has to be initiated again
+func (s *MaterialService) CompleteMyMaterialUpload(
+	ctx context.Context, requestDto *apicontract.CompleteMyMaterialUploadRequestDto,
+) (*apicontract.CompleteMyMaterialUploadResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(requestDto); err != nil {
+		return nil, apiexceptions.NewMaterialUploadException().InvalidDto().WithOrigin(err)
+	}
+
+	db := s.db.WithContext(ctx)
+	allowedPermissions, exception := contexts.GetAllowedPermissions(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+	actorUserId, exception := contexts.GetActorUserId(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+
+	// the material is locked before the upload, so the previous content read here can not be replaced
+	// by a concurrent completion and the upload stays locked until the material is updated,
+	// so the cleanup worker can not abort it in the middle
+	tx := db.Begin()
+	material, exception := s.materialRepository.GetOneById(
+		requestDto.Param.MaterialId,
+		actorUserId,
+		options.WithTransactionDB(tx),
+		options.WithAllowedPermissions(allowedPermissions),
+		options.WithOnlyDeleted(types.Ternary_Negative),
+		options.WithLockingStrength(options.LockingStrengthUpdate),
+	)
+	if exception != nil {
+		tx.Rollback()
+		return nil, exception
+	}
+	previousContentKey := material.ContentKey
+
+	materialUpload, exception := s.materialUploadRepository.GetOneById(
+		requestDto.Param.UploadId,
+		requestDto.Param.MaterialId,
+		actorUserId,
+		options.WithTransactionDB(tx),
+		options.WithLockingStrength(options.LockingStrengthUpdate),
+	)
+	if exception != nil {
+		tx.Rollback()
+		return nil, exception
+	}
+	if !materialUpload.ExpiresAt.After(time.Now()) {
+		tx.Rollback()
+		return nil, apiexceptions.NewMaterialUploadException().Expired()
+	}
+
+	uploadedParts := make([]storage.UploadedPart, 0, len(requestDto.Body.Parts))
+	for _, part := range requestDto.Body.Parts {
+		if int64(part.PartNumber) > int64(materialUpload.PartCount) {
+			tx.Rollback()
+			return nil, apiexceptions.NewMaterialUploadException().InvalidDto()
+		}
+		uploadedParts = append(uploadedParts, storage.UploadedPart{
+			PartNumber: part.PartNumber,
+			ETag:       part.ETag,
+		})
+	}
+	object, err := s.storage.CompleteMultipartUploadByKey(ctx, materialUpload.ContentKey, materialUpload.StorageUploadId, uploadedParts)
+	if err != nil {
+		tx.Rollback()
+		return nil, apiexceptions.NewStorageException().FailedToCompleteMultipartUpload(materialUpload.ContentKey).WithOrigin(err)
+	}
+
+	// verify the assembled object, since the parts are sent to the storage without passing through here
+	maxMaterialSize, exception := s.materialUploadRepository.GetMaxMaterialSizeByMaterialId(
+		material.Id,
+		options.WithTransactionDB(tx),
+	)
+	if exception != nil {
+		tx.Rollback()
+		return nil, exception
+	}
+	if exception := exceptions.Cover(nil, []exceptions.Pair{
+		{First: object.Size != materialUpload.Size, Second: apiexceptions.NewMaterialUploadException().SizeMismatch(materialUpload.Size, object.Size)},
+		{First: object.Size > maxMaterialSize, Second: apiexceptions.NewMaterialUploadException().MaximumSizeExceeded(object.Size, maxMaterialSize)},
+		{First: !isCompatibleMaterialContentType(materialUpload.ContentType, object.ContentType), Second: apiexceptions.NewMaterialUploadException().ContentTypeMismatch(string(materialUpload.ContentType), object.ContentType)},
+	}); exception != nil {
+		if exception := s.materialUploadRepository.DeleteOneById(materialUpload.Id, options.WithTransactionDB(tx)); exception != nil {
+			tx.Rollback()
+			return nil, exception
+		}
+		if err := tx.Commit().Error; err != nil {
+			return nil, apiexceptions.NewMaterialUploadException().FailedToCommitTransaction().WithOrigin(err)
+		}
+		s.deleteStorageObject(ctx, materialUpload.ContentKey)
+		return nil, exception
+	}
+
+	pendingStatus := enums.MaterialProcessingStatus_Pending
+	partialUpdate := inputs.PartialUpdateMaterialInput{
+		Values: inputs.UpdateMaterialInput{
+			Size:           &object.Size,
+			ContentKey:     &materialUpload.ContentKey,
+			ContentType:    &materialUpload.ContentType,
+			ParseMediaType: object.ParseMediaType,
+			// the search text and the preview of the new content are generated asynchronously by the material processing
+			ProcessingStatus: &pendingStatus,
+		},
+		SetNull: nil,
+	}
+	updatedMaterial, exception := s.materialRepository.UpdateOneById(
+		material.Id,
+		actorUserId,
+		partialUpdate,
+		options.WithTransactionDB(tx),
+		options.WithAllowedPermissions(allowedPermissions),
+	)
+	if exception != nil {
+		tx.Rollback()
+		s.deleteStorageObject(ctx, materialUpload.ContentKey)
+		return nil, exception
+	}
+	if exception := s.materialUploadRepository.DeleteOneById(materialUpload.Id, options.WithTransactionDB(tx)); exception != nil {
+		tx.Rollback()
+		s.deleteStorageObject(ctx, materialUpload.ContentKey)
+		return nil, exception
+	}
+	if err := s.outboxEventRepository.EnqueueMaterialProcessingHint(
+		tx,
+		uuid.NewString(),
+		material.Id,
+		"material_upload_completed",
+	); err != nil {
+		tx.Rollback()
+		s.deleteStorageObject(ctx, materialUpload.ContentKey)
+		return nil, apiexceptions.NewMaterialUploadException().FailedToCommitTransaction().WithOrigin(err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		s.deleteStorageObject(ctx, materialUpload.ContentKey)
+		return nil, apiexceptions.NewMaterialUploadException().FailedToCommitTransaction().WithOrigin(err)
+	}
+
+	// the previous content is only removed once the material points to the new one
+	s.deleteStorageObject(ctx, previousContentKey)
+	if material.PreviewKey != nil {
+		s.deleteStorageObject(ctx, *material.PreviewKey)
+	}
+
+	return &apicontract.CompleteMyMaterialUploadResponseDto{
+		Size:           updatedMaterial.Size,
+		ContentType:    *updatedMaterial.ContentType.ToContractable(),
+		ParseMediaType: updatedMaterial.ParseMediaType,
+		UpdatedAt:      updatedMaterial.UpdatedAt,
+	}, nil
+}
+
+func (s *MaterialService) AbortMyMaterialUpload(
+	ctx context.Context, requestDto *apicontract.AbortMyMaterialUploadRequestDto,
+) (*apicontract.AbortMyMaterialUploadResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(requestDto); err != nil {
+		return nil, apiexceptions.NewMaterialUploadException().InvalidDto().WithOrigin(err)
+	}
+
+	db := s.db.WithContext(ctx)
+	actorUserId, exception := contexts.GetActorUserId(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+
+	tx := db.Begin()
+	materialUpload, exception := s.materialUploadRepository.GetOneById(
+		requestDto.Param.UploadId,
+		requestDto.Param.MaterialId,
+		actorUserId,
+		options.WithTransactionDB(tx),
+		options.WithLockingStrength(options.LockingStrengthUpdate),
+	)
+	if exception != nil {
+		tx.Rollback()
+		return nil, exception
+	}
+	if exception := s.materialUploadRepository.DeleteOneById(materialUpload.Id, options.WithTransactionDB(tx)); exception != nil {
+		tx.Rollback()
+		return nil, exception
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, apiexceptions.NewMaterialUploadException().FailedToCommitTransaction().WithOrigin(err)
+	}
+
+	s.abortStorageUpload(ctx, materialUpload.ContentKey, materialUpload.StorageUploadId)
+
+	return &apicontract.AbortMyMaterialUploadResponseDto{
+		AbortedAt: time.Now(),
+	}, nil
+}
+
 /* ============================== Service Methods for GraphQL Material ============================== */
 
 func (s *MaterialService) SearchPrivateMaterials(
@@ -783,97 +1190,85 @@ func (s *MaterialService) SearchPrivateMaterials(
 		query = query.Where("ss.root_shelf_id = ?", *gqlInput.RootShelfID)
 	}
 
-	if len(strings.ReplaceAll(gqlInput.Query, " ", "")) > 0 {
-		query = query.Where(
-			`"MaterialTable".name ILIKE ? OR "MaterialTable".content_type::text ILIKE ? OR "MaterialTable".parse_media_type ILIKE ?`,
-			"%"+gqlInput.Query+"%",
-			"%"+gqlInput.Query+"%",
-			"%"+gqlInput.Query+"%",
-		)
+	isFullTextSearch := len(strings.ReplaceAll(gqlInput.Query, " ", "")) > 0
+	if isFullTextSearch {
+		query = query.Scopes(scopes.MatchFullTextSearch(schemas.Material{}.TableName(), gqlInput.Query, userId))
 	}
-	if gqlInput.After != nil && len(strings.ReplaceAll(*gqlInput.After, " ", "")) > 0 {
-		searchCursor, err := searchcursor.Decode[gqlmodels.SearchMaterialCursorFields](*gqlInput.After)
-		if err != nil {
-			return nil, apiexceptions.NewSearchException().FailedToDecode().WithOrigin(err)
-		}
+	keyset := newMaterialSearchKeyset(gqlInput, isFullTextSearch, userId)
+	page, err := keyset.NewPage(gqlInput.After, gqlInput.Before, gqlInput.First, gqlInput.Last, constants.DefaultSearchLimit, constants.MaxSearchLimit)
+	if err != nil {
+		return nil, apiexceptions.NewSearchException().FailedToDecode().WithOrigin(err)
+	}
+	query = query.Scopes(scopes.PaginateByKeyset(keyset, page.AfterCursor, page.BeforeCursor, page.IsBackward)).
+		Limit(page.Limit + 1)
 
-		query = query.Where(`"MaterialTable".id > ?`, searchCursor.Fields.ID)
+	var materials []schemas.Material
+	if err := query.Find(&materials).Error; err != nil {
+		return nil, apiexceptions.NewMaterialException().NotFound().WithOrigin(err)
 	}
 
-	if gqlInput.SortBy != nil && gqlInput.SortOrder != nil {
-		cending := gqlmodels.SearchSortOrderAsc.String()
-		if *gqlInput.SortOrder == gqlmodels.SearchSortOrderDesc {
-			cending = gqlmodels.SearchSortOrderDesc.String()
-		}
+	materials, hasNextPage, hasPreviousPage := searchcursor.Slice(page, materials)
 
-		switch *gqlInput.SortBy {
-		case gqlmodels.SearchMaterialSortByName:
-			query = query.Order(`"MaterialTable".name ` + cending).
-				Order(`"MaterialTable".updated_at ` + cending).
-				Order(`"MaterialTable".created_at ` + cending)
-		case gqlmodels.SearchMaterialSortBySize:
-			query = query.Order(`"MaterialTable".size ` + cending).
-				Order(`"MaterialTable".name ` + cending).
-				Order(`"MaterialTable".updated_at ` + cending).
-				Order(`"MaterialTable".created_at ` + cending)
-		case gqlmodels.SearchMaterialSortByContentType:
-			query = query.Order(`"MaterialTable".content_type ` + cending).
-				Order(`"MaterialTable".name ` + cending).
-				Order(`"MaterialTable".updated_at ` + cending).
-				Order(`"MaterialTable".created_at ` + cending)
-		case gqlmodels.SearchMaterialSortByLastUpdate:
-			query = query.Order(`"MaterialTable".updated_at ` + cending).
-				Order(`"MaterialTable".name ` + cending).
-				Order(`"MaterialTable".created_at ` + cending)
-		case gqlmodels.SearchMaterialSortByCreatedAt:
-			query = query.Order(`"MaterialTable".created_at ` + cending).
-				Order(`"MaterialTable".name ` + cending).
-				Order(`"MaterialTable".updated_at ` + cending)
-		default:
-			query = query.Order(`"MaterialTable".name ` + cending).
-				Order(`"MaterialTable".updated_at ` + cending).
-				Order(`"MaterialTable".created_at ` + cending)
+	fullTextSearchResultById := make(map[uuid.UUID]scopes.FullTextSearchResult, len(materials))
+	if isFullTextSearch && len(materials) > 0 {
+		materialIds := make([]uuid.UUID, len(materials))
+		for index, material := range materials {
+			materialIds[index] = material.Id
 		}
-	}
 
-	limit := constants.DefaultSearchLimit
-	if gqlInput.First != nil && *gqlInput.First > 0 {
-		limit = int(*gqlInput.First)
-	}
-	limit = min(limit, constants.MaxSearchLimit)
-	query = query.Limit(limit + 1)
-
-	var materials []schemas.Material
-	if err := query.Find(&materials).Error; err != nil {
-		return nil, apiexceptions.NewMaterialException().NotFound().WithOrigin(err)
+		var fullTextSearchResults []scopes.FullTextSearchResult
+		if err := db.Model(&schemas.Material{}).
+			Scopes(scopes.SelectFullTextSearchResults(schemas.Material{}.TableName(), `concat_ws(' ', "MaterialTable".name, "MaterialTable".search_text)`, gqlInput.Query, userId)).
+			Where(`"MaterialTable".id IN ?`, materialIds).
+			Find(&fullTextSearchResults).Error; err != nil {
+			return nil, apiexceptions.NewSearchException().FailedToRankFullTextSearch().WithOrigin(err)
+		}
+		for _, fullTextSearchResult := range fullTextSearchResults {
+			fullTextSearchResultById[fullTextSearchResult.Id] = fullTextSearchResult
+		}
 	}
 
-	hasNextPage := len(materials) > limit
 	searchEdges := make([]*gqlmodels.SearchMaterialEdge, len(materials))
 
 	for index, material := range materials {
-		searchCursor := searchcursor.SearchCursor[gqlmodels.SearchMaterialCursorFields]{
-			Fields: gqlmodels.SearchMaterialCursorFields{
-				ID: material.Id,
-			},
+		fullTextSearchResult, isRanked := fullTextSearchResultById[material.Id]
+
+		var sortValues []any
+		switch keyset.SortBy {
+		case gqlmodels.SearchMaterialSortByRelevance.String():
+			sortValues = []any{fullTextSearchResult.Rank, material.Id}
+		case gqlmodels.SearchMaterialSortBySize.String():
+			sortValues = []any{material.Size, material.Name, material.UpdatedAt, material.CreatedAt, material.Id}
+		case gqlmodels.SearchMaterialSortByContentType.String():
+			sortValues = []any{material.ContentType, material.Name, material.UpdatedAt, material.CreatedAt, material.Id}
+		case gqlmodels.SearchMaterialSortByLastUpdate.String():
+			sortValues = []any{material.UpdatedAt, material.Name, material.CreatedAt, material.Id}
+		case gqlmodels.SearchMaterialSortByCreatedAt.String():
+			sortValues = []any{material.CreatedAt, material.Name, material.UpdatedAt, material.Id}
+		case gqlmodels.SearchMaterialSortByName.String():
+			sortValues = []any{material.Name, material.UpdatedAt, material.CreatedAt, material.Id}
+		default:
+			sortValues = []any{material.Id}
 		}
-		encodedSearchCursor, err := searchCursor.Encode()
+		encodedSearchCursor, err := keyset.Encode(sortValues...)
 		if err != nil {
 			return nil, apiexceptions.NewSearchException().FailedToEncode().WithOrigin(err)
 		}
-		if encodedSearchCursor == nil {
-			return nil, apiexceptions.NewSearchException().FailedToUnmarshalSearchCursor()
-		}
 
 		searchEdges[index] = &gqlmodels.SearchMaterialEdge{
-			EncodedSearchCursor: *encodedSearchCursor,
+			EncodedSearchCursor: encodedSearchCursor,
 			Node:                material.ToPrivateMaterial(),
 		}
+		if isRanked {
+			highlight := searchtext.FormatHighlight(fullTextSearchResult.Highlight)
+			searchEdges[index].Rank = &fullTextSearchResult.Rank
+			searchEdges[index].Highlight = &highlight
+		}
 	}
 
 	searchPageInfo := &gqlmodels.SearchPageInfo{
 		HasNextPage:     hasNextPage,
-		HasPreviousPage: gqlInput.After != nil && len(strings.ReplaceAll(*gqlInput.After, " ", "")) > 0,
+		HasPreviousPage: hasPreviousPage,
 	}
 
 	if len(searchEdges) > 0 {
@@ -882,9 +1277,6 @@ func (s *MaterialService) SearchPrivateMaterials(
 	}
 
 	searchTime := float64(time.Since(startTime).Nanoseconds()) / 1e6
-	if hasNextPage {
-		searchEdges = searchEdges[:limit]
-	}
 
 	return &gqlmodels.SearchMaterialConnection{
 		SearchEdges:    searchEdges,
@@ -893,3 +1285,82 @@ func (s *MaterialService) SearchPrivateMaterials(
 		SearchTime:     searchTime,
 	}, nil
 }
+
+/* ============================== Helper Functions ============================== */
+
+// the storage only aborts or deletes once the rows are settled, so a failure leaves an orphaned
+// object at most, which is only logged since no material points to it
+func (s *MaterialService) abortStorageUpload(ctx context.Context, contentKey string, storageUploadId string) {
+	if err := s.storage.AbortMultipartUploadByKey(ctx, contentKey, storageUploadId); err != nil && logs.NotegicLogger != nil {
+		logs.NotegicLogger.Error(ctx, err, "Failed to abort the multipart upload of Material")
+	}
+}
+
+func (s *MaterialService) deleteStorageObject(ctx context.Context, contentKey string) {
+	if err := s.storage.DeleteObjectByKey(ctx, contentKey); err != nil && logs.NotegicLogger != nil {
+		logs.NotegicLogger.Error(ctx, err, "Failed to delete the content of Material")
+	}
+}
+
+// presignPreview presigns the preview of a material if it has one, and a failure is only logged since the preview is optional
+func (s *MaterialService) presignPreview(ctx context.Context, previewKey *string) *string {
+	if previewKey == nil {
+		return nil
+	}
+	previewURL, err := s.storage.PresignGetObjectByKey(ctx, *previewKey, nil)
+	if err != nil {
+		logs.NotegicLogger.Error(ctx, err, "Failed to presign the preview of Material")
+		return nil
+	}
+	return &previewURL
+}
+
+// isCompatibleMaterialContentType checks the detected content type against the declared one, where
+// the textual formats are only detectable as plain text, and the JPG is only detectable as the JPEG
+func isCompatibleMaterialContentType(declaredContentType enums.MaterialContentType, detectedContentType string) bool {
+	switch declaredContentType {
+	case enums.MaterialContentType_Markdown, enums.MaterialContentType_JSON:
+		return detectedContentType == string(enums.MaterialContentType_PlainText) ||
+			detectedContentType == string(declaredContentType)
+	case enums.MaterialContentType_JPG:
+		return detectedContentType == string(enums.MaterialContentType_JPEG) ||
+			detectedContentType == string(declaredContentType)
+	default:
+		return detectedContentType == string(declaredContentType)
+	}
+}
+
+// newMaterialSearchKeyset builds the keyset of the requested sort order, where the relevance falls back to the name if the query is empty
+func newMaterialSearchKeyset(gqlInput gqlmodels.SearchMaterialInput, isFullTextSearch bool, userId uuid.UUID) *searchcursor.Keyset {
+	isDescending := gqlInput.SortOrder != nil && *gqlInput.SortOrder == gqlmodels.SearchSortOrderDesc
+
+	idKey := searchcursor.KeysetKey{Column: `"MaterialTable".id`, Type: "uuid"}
+	nameKey := searchcursor.KeysetKey{Column: `"MaterialTable".name`, Type: "text"}
+	sizeKey := searchcursor.KeysetKey{Column: `"MaterialTable".size`, Type: "bigint"}
+	contentTypeKey := searchcursor.KeysetKey{Column: `"MaterialTable".content_type`, Type: `"MaterialContentType"`}
+	updatedAtKey := searchcursor.KeysetKey{Column: `"MaterialTable".updated_at`, Type: "timestamptz"}
+	createdAtKey := searchcursor.KeysetKey{Column: `"MaterialTable".created_at`, Type: "timestamptz"}
+
+	if gqlInput.SortBy == nil {
+		return searchcursor.NewKeyset("", isDescending, idKey)
+	}
+
+	switch *gqlInput.SortBy {
+	case gqlmodels.SearchMaterialSortByRelevance:
+		if isFullTextSearch {
+			rankKey := scopes.FullTextSearchRankKey(schemas.Material{}.TableName(), gqlInput.Query, userId)
+			return searchcursor.NewKeyset(gqlmodels.SearchMaterialSortByRelevance.String(), isDescending, rankKey, idKey)
+		}
+		return searchcursor.NewKeyset(gqlmodels.SearchMaterialSortByName.String(), isDescending, nameKey, updatedAtKey, createdAtKey, idKey)
+	case gqlmodels.SearchMaterialSortBySize:
+		return searchcursor.NewKeyset(gqlmodels.SearchMaterialSortBySize.String(), isDescending, sizeKey, nameKey, updatedAtKey, createdAtKey, idKey)
+	case gqlmodels.SearchMaterialSortByContentType:
+		return searchcursor.NewKeyset(gqlmodels.SearchMaterialSortByContentType.String(), isDescending, contentTypeKey, nameKey, updatedAtKey, createdAtKey, idKey)
+	case gqlmodels.SearchMaterialSortByLastUpdate:
+		return searchcursor.NewKeyset(gqlmodels.SearchMaterialSortByLastUpdate.String(), isDescending, updatedAtKey, nameKey, createdAtKey, idKey)
+	case gqlmodels.SearchMaterialSortByCreatedAt:
+		return searchcursor.NewKeyset(gqlmodels.SearchMaterialSortByCreatedAt.String(), isDescending, createdAtKey, nameKey, updatedAtKey, idKey)
+	default:
+		return searchcursor.NewKeyset(gqlmodels.SearchMaterialSortByName.String(), isDescending, nameKey, updatedAtKey, createdAtKey, idKey)
+	}
+}
@@ -0,0 +1,450 @@
+package material
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+	constants "github.com/HiIamJeff67/notegic-backend/shared/constants"
+
+	apicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/materials"
+	enumcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
+
+	contexts "github.com/HiIamJeff67/notegic-backend/internal/core/contexts"
+	inputs "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/inputs"
+	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
+	repositories "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/repositories"
+	schemas "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas"
+	enums "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/enums"
+	storage "github.com/HiIamJeff67/notegic-backend/internal/core/data/storage"
+	apiexceptions "github.com/HiIamJeff67/notegic-backend/internal/core/exceptions"
+	validation "github.com/HiIamJeff67/notegic-backend/internal/core/validations"
+)
+
+const testUserAgent = "NotegicTest/1.0"
+
+/* ============================== Test Doubles ============================== */
+
+// transactionOnlyConnector only supports the transactions, since the repositories are replaced
+// by the fakes below and the service itself never sends a statement to the database
+type transactionOnlyConnector struct{}
+
+func (c transactionOnlyConnector) Connect(context.Context) (driver.Conn, error) { return c, nil }
+func (c transactionOnlyConnector) Driver() driver.Driver                        { return c }
+func (c transactionOnlyConnector) Open(string) (driver.Conn, error)             { return c, nil }
+func (transactionOnlyConnector) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("unexpected statement " + query)
+}
+func (transactionOnlyConnector) Close() error                { return nil }
+func (c transactionOnlyConnector) Begin() (driver.Tx, error) { return c, nil }
+func (transactionOnlyConnector) Commit() error               { return nil }
+func (transactionOnlyConnector) Rollback() error             { return nil }
+
+type fakeMaterialRepository struct {
+	repositories.MaterialRepositoryInterface
+	material    *schemas.Material
+	updates     []inputs.PartialUpdateMaterialInput
+	lockedReads int
+}
+
+func (r *fakeMaterialRepository) GetOneById(id uuid.UUID, userId uuid.UUID, opts ...options.RepositoryOptions) (*schemas.Material, *exceptions.Exception) {
+	if r.material == nil || r.material.Id != id {
+		return nil, apiexceptions.NewMaterialException().NotFound()
+	}
+	parsedOptions := options.ParseRepositoryOptions(opts...)
+	if parsedOptions.IsTransactionStarted && parsedOptions.LockingStrength != nil &&
+		*parsedOptions.LockingStrength == options.LockingStrengthUpdate {
+		r.lockedReads++
+	}
+	material := *r.material
+	return &material, nil
+}
+
+func (r *fakeMaterialRepository) UpdateOneById(id uuid.UUID, userId uuid.UUID, input inputs.PartialUpdateMaterialInput, opts ...options.RepositoryOptions) (*schemas.Material, *exceptions.Exception) {
+	r.updates = append(r.updates, input)
+	r.material.Size = *input.Values.Size
+	r.material.ContentKey = *input.Values.ContentKey
+	r.material.ContentType = *input.Values.ContentType
+	r.material.ParseMediaType = input.Values.ParseMediaType
+	r.material.UpdatedAt = time.Now()
+	material := *r.material
+	return &material, nil
+}
+
+type fakeMaterialUploadRepository struct {
+	maxMaterialSize int64
+	uploads         map[uuid.UUID]schemas.MaterialUpload
+}
+
+func (r *fakeMaterialUploadRepository) GetOneById(id uuid.UUID, materialId uuid.UUID, userId uuid.UUID, opts ...options.RepositoryOptions) (*schemas.MaterialUpload, *exceptions.Exception) {
+	materialUpload, ok := r.uploads[id]
+	if !ok || materialUpload.MaterialId != materialId || materialUpload.UserId != userId {
+		return nil, apiexceptions.NewMaterialUploadException().NotFound()
+	}
+	return &materialUpload, nil
+}
+
+func (r *fakeMaterialUploadRepository) GetOneByStorageUploadId(storageUploadId string, userId uuid.UUID, opts ...options.RepositoryOptions) (*schemas.MaterialUpload, *exceptions.Exception) {
+	for _, materialUpload := range r.uploads {
+		if materialUpload.StorageUploadId == storageUploadId && materialUpload.UserId == userId {
+			return &materialUpload, nil
+		}
+	}
+	return nil, apiexceptions.NewMaterialUploadException().NotFound()
+}
+
+func (r *fakeMaterialUploadRepository) GetManyExpired(now time.Time, limit int, opts ...options.RepositoryOptions) ([]schemas.MaterialUpload, *exceptions.Exception) {
+	return nil, nil
+}
+
+func (r *fakeMaterialUploadRepository) GetMaxMaterialSizeByMaterialId(materialId uuid.UUID, opts ...options.RepositoryOptions) (int64, *exceptions.Exception) {
+	return r.maxMaterialSize, nil
+}
+
+func (r *fakeMaterialUploadRepository) CreateOne(userId uuid.UUID, input inputs.CreateMaterialUploadInput, opts ...options.RepositoryOptions) (*schemas.MaterialUpload, *exceptions.Exception) {
+	materialUpload := schemas.MaterialUpload{
+		Id:              input.Id,
+		MaterialId:      input.MaterialId,
+		UserId:          userId,
+		ContentKey:      input.ContentKey,
+		StorageUploadId: input.StorageUploadId,
+		Size:            input.Size,
+		ContentType:     input.ContentType,
+		PartSize:        input.PartSize,
+		PartCount:       input.PartCount,
+		ExpiresAt:       input.ExpiresAt,
+	}
+	r.uploads[materialUpload.Id] = materialUpload
+	return &materialUpload, nil
+}
+
+func (r *fakeMaterialUploadRepository) DeleteOneById(id uuid.UUID, opts ...options.RepositoryOptions) *exceptions.Exception {
+	if _, ok := r.uploads[id]; !ok {
+		return apiexceptions.NewMaterialUploadException().NotFound()
+	}
+	delete(r.uploads, id)
+	return nil
+}
+
+type fakeOutboxEventRepository struct {
+	repositories.OutboxEventRepositoryInterface
+	processingHints []uuid.UUID
+}
+
+func (r *fakeOutboxEventRepository) EnqueueMaterialProcessingHint(tx *gorm.DB, correlationId string, materialId uuid.UUID, reason string) error {
+	r.processingHints = append(r.processingHints, materialId)
+	return nil
+}
+
+/* ============================== Test Helpers ============================== */
+
+type materialUploadTestFixture struct {
+	ctx                      context.Context
+	service                  MaterialServiceInterface
+	storage                  storage.StorageInterface
+	materialRepository       *fakeMaterialRepository
+	materialUploadRepository *fakeMaterialUploadRepository
+	outboxEventRepository    *fakeOutboxEventRepository
+}
+
+func newMaterialUploadTestFixture(t *testing.T) *materialUploadTestFixture {
+	t.Helper()
+
+	db, err := gorm.Open(
+		postgres.New(postgres.Config{Conn: sql.OpenDB(transactionOnlyConnector{})}),
+		&gorm.Config{DisableAutomaticPing: true, SkipDefaultTransaction: true},
+	)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+
+	inMemoryStorage := storage.NewInMemoryStorage()
+	previousContentKey := "previous-content"
+	previousContent, err := inMemoryStorage.NewObject(previousContentKey, strings.NewReader("previous"), int64(len("previous")))
+	if err != nil {
+		t.Fatalf("failed to create the previous content: %v", err)
+	}
+	if err := inMemoryStorage.PutObjectByKey(context.Background(), previousContentKey, previousContent); err != nil {
+		t.Fatalf("failed to put the previous content: %v", err)
+	}
+
+	fixture := &materialUploadTestFixture{
+		storage: inMemoryStorage,
+		materialRepository: &fakeMaterialRepository{material: &schemas.Material{
+			Id:          uuid.New(),
+			ContentKey:  previousContentKey,
+			ContentType: enums.MaterialContentType_PlainText,
+		}},
+		materialUploadRepository: &fakeMaterialUploadRepository{
+			maxMaterialSize: constants.MaxInMemoryStorageFileSize.ToInt64(),
+			uploads:         map[uuid.UUID]schemas.MaterialUpload{},
+		},
+		outboxEventRepository: &fakeOutboxEventRepository{},
+	}
+	fixture.service = NewMaterialService(
+		validation.New(),
+		db,
+		inMemoryStorage,
+		nil,
+		nil,
+		fixture.materialRepository,
+		fixture.materialUploadRepository,
+		fixture.outboxEventRepository,
+		time.Hour,
+		"salt",
+	)
+
+	ctx := contexts.WithAllowedPermissions(context.Background(), []enums.AccessControlPermission{enums.AccessControlPermission_Write})
+	ctx = contexts.WithActorUserId(ctx, uuid.New())
+	fixture.ctx = contexts.WithActorUserPublicId(ctx, uuid.New())
+
+	return fixture
+}
+
+func (f *materialUploadTestFixture) initiate(t *testing.T, size int64, contentType enumcontract.MaterialContentType) *apicontract.InitiateMyMaterialUploadResponseDto {
+	t.Helper()
+
+	requestDto := &apicontract.InitiateMyMaterialUploadRequestDto{}
+	requestDto.Header.UserAgent = testUserAgent
+	requestDto.Body.Size = size
+	requestDto.Body.ContentType = contentType
+	requestDto.Param.MaterialId = f.materialRepository.material.Id
+
+	responseDto, exception := f.service.InitiateMyMaterialUpload(f.ctx, requestDto)
+	if exception != nil {
+		t.Fatalf("InitiateMyMaterialUpload() exception = %v", exception)
+	}
+	return responseDto
+}
+
+// uploadPart sends the part as the part upload route of the gateways does for the URLs of the in-memory storage
+func (f *materialUploadTestFixture) uploadPart(uploadId uuid.UUID, partNumber int32, content []byte) (*apicontract.UploadMyMaterialPartResponseDto, *exceptions.Exception) {
+	requestDto := &apicontract.UploadMyMaterialPartRequestDto{}
+	requestDto.Header.UserAgent = testUserAgent
+	requestDto.Body.Content = content
+	requestDto.Param.StorageUploadId = f.materialUploadRepository.uploads[uploadId].StorageUploadId
+	requestDto.Param.PartNumber = partNumber
+
+	return f.service.UploadMyMaterialPart(f.ctx, requestDto)
+}
+
+func (f *materialUploadTestFixture) mustUploadPart(t *testing.T, uploadId uuid.UUID, content string) string {
+	t.Helper()
+
+	responseDto, exception := f.uploadPart(uploadId, 1, []byte(content))
+	if exception != nil {
+		t.Fatalf("UploadMyMaterialPart() exception = %v", exception)
+	}
+	return responseDto.ETag
+}
+
+func (f *materialUploadTestFixture) complete(uploadId uuid.UUID, partNumber int32, eTag string) (*apicontract.CompleteMyMaterialUploadResponseDto, *exceptions.Exception) {
+	requestDto := &apicontract.CompleteMyMaterialUploadRequestDto{}
+	requestDto.Header.UserAgent = testUserAgent
+	requestDto.Body.Parts = append(requestDto.Body.Parts, struct {
+		PartNumber int32  `json:"partNumber" validate:"required,min=1"`
+		ETag       string `json:"eTag" validate:"required"`
+	}{PartNumber: partNumber, ETag: eTag})
+	requestDto.Param.MaterialId = f.materialRepository.material.Id
+	requestDto.Param.UploadId = uploadId
+
+	return f.service.CompleteMyMaterialUpload(f.ctx, requestDto)
+}
+
+/* ============================== Tests ============================== */
+
+func TestInitiateMyMaterialUploadPlansThePartsOnTheGatewayRoute(t *testing.T) {
+	fixture := newMaterialUploadTestFixture(t)
+	partSize := constants.MaterialUploadPartSize.ToInt64()
+
+	responseDto := fixture.initiate(t, partSize+1, enumcontract.MaterialContentType_PlainText)
+
+	if responseDto.PartSize != partSize || len(responseDto.Parts) != 2 {
+		t.Fatalf("InitiateMyMaterialUpload() = part size %d with %d parts, want %d with 2 parts", responseDto.PartSize, len(responseDto.Parts), partSize)
+	}
+	materialUpload, ok := fixture.materialUploadRepository.uploads[responseDto.UploadId]
+	if !ok {
+		t.Fatal("InitiateMyMaterialUpload() did not create the upload")
+	}
+	if materialUpload.PartCount != 2 || materialUpload.Size != partSize+1 || materialUpload.ContentKey == fixture.materialRepository.material.ContentKey {
+		t.Fatalf("InitiateMyMaterialUpload() created %+v", materialUpload)
+	}
+	for index, part := range responseDto.Parts {
+		wantURLSuffix := "/materials/uploads/" + materialUpload.StorageUploadId + "/parts/" + strconv.Itoa(index+1)
+		if part.PartNumber != int32(index+1) || !strings.HasSuffix(part.UploadURL, wantURLSuffix) {
+			t.Errorf("part %d = %+v, want an upload URL ending with %q", index, part, wantURLSuffix)
+		}
+	}
+}
+
+func TestInitiateMyMaterialUploadRejectsTheSizeAboveThePlan(t *testing.T) {
+	fixture := newMaterialUploadTestFixture(t)
+	fixture.materialUploadRepository.maxMaterialSize = 4
+
+	requestDto := &apicontract.InitiateMyMaterialUploadRequestDto{}
+	requestDto.Header.UserAgent = testUserAgent
+	requestDto.Body.Size = 5
+	requestDto.Body.ContentType = enumcontract.MaterialContentType_PlainText
+	requestDto.Param.MaterialId = fixture.materialRepository.material.Id
+
+	_, exception := fixture.service.InitiateMyMaterialUpload(fixture.ctx, requestDto)
+	if exception == nil || exception.Reason != apiexceptions.NewMaterialUploadException().MaximumSizeExceeded(5, 4).Reason {
+		t.Fatalf("InitiateMyMaterialUpload() exception = %v, want MaximumSizeExceeded", exception)
+	}
+	if len(fixture.materialUploadRepository.uploads) != 0 {
+		t.Fatal("InitiateMyMaterialUpload() created an upload above the plan")
+	}
+}
+
+func TestUploadMyMaterialPartRejectsThePartsOutsideTheUpload(t *testing.T) {
+	fixture := newMaterialUploadTestFixture(t)
+	responseDto := fixture.initiate(t, 5, enumcontract.MaterialContentType_PlainText)
+
+	if _, exception := fixture.uploadPart(responseDto.UploadId, 2, []byte("hello")); exception == nil {
+		t.Error("UploadMyMaterialPart() of a part number above the part count exception = nil")
+	}
+	if _, exception := fixture.uploadPart(responseDto.UploadId, 1, make([]byte, constants.MaterialUploadPartSize+1)); exception == nil {
+		t.Error("UploadMyMaterialPart() of a part larger than the part size exception = nil")
+	}
+
+	materialUpload := fixture.materialUploadRepository.uploads[responseDto.UploadId]
+	materialUpload.ExpiresAt = time.Now().Add(-time.Second)
+	fixture.materialUploadRepository.uploads[materialUpload.Id] = materialUpload
+	if _, exception := fixture.uploadPart(responseDto.UploadId, 1, []byte("hello")); exception == nil ||
+		exception.Reason != apiexceptions.NewMaterialUploadException().Expired().Reason {
+		t.Errorf("UploadMyMaterialPart() of an expired upload exception = %v, want Expired", exception)
+	}
+}
+
+func TestCompleteMyMaterialUploadReplacesTheContent(t *testing.T) {
+	fixture := newMaterialUploadTestFixture(t)
+	responseDto := fixture.initiate(t, 5, enumcontract.MaterialContentType_PlainText)
+	eTag := fixture.mustUploadPart(t, responseDto.UploadId, "hello")
+	contentKey := fixture.materialUploadRepository.uploads[responseDto.UploadId].ContentKey
+
+	completeResponseDto, exception := fixture.complete(responseDto.UploadId, 1, eTag)
+	if exception != nil {
+		t.Fatalf("CompleteMyMaterialUpload() exception = %v", exception)
+	}
+
+	if completeResponseDto.Size != 5 || fixture.materialRepository.material.ContentKey != contentKey {
+		t.Fatalf("CompleteMyMaterialUpload() = %+v with the content key %q, want the uploaded content", completeResponseDto, fixture.materialRepository.material.ContentKey)
+	}
+	if len(fixture.materialUploadRepository.uploads) != 0 || len(fixture.outboxEventRepository.processingHints) != 1 {
+		t.Fatal("CompleteMyMaterialUpload() did not consume the upload and request the processing")
+	}
+	if _, _, err := fixture.storage.GetObjectByKey(fixture.ctx, "previous-content", nil); err == nil {
+		t.Fatal("CompleteMyMaterialUpload() kept the previous content")
+	}
+}
+
+func TestCompleteMyMaterialUploadReplacesTheContentReadUnderTheLock(t *testing.T) {
+	fixture := newMaterialUploadTestFixture(t)
+	firstResponseDto := fixture.initiate(t, 5, enumcontract.MaterialContentType_PlainText)
+	secondResponseDto := fixture.initiate(t, 5, enumcontract.MaterialContentType_PlainText)
+	firstETag := fixture.mustUploadPart(t, firstResponseDto.UploadId, "hello")
+	secondETag := fixture.mustUploadPart(t, secondResponseDto.UploadId, "world")
+	firstContentKey := fixture.materialUploadRepository.uploads[firstResponseDto.UploadId].ContentKey
+	secondContentKey := fixture.materialUploadRepository.uploads[secondResponseDto.UploadId].ContentKey
+
+	if _, exception := fixture.complete(firstResponseDto.UploadId, 1, firstETag); exception != nil {
+		t.Fatalf("CompleteMyMaterialUpload() of the first upload exception = %v", exception)
+	}
+	if _, exception := fixture.complete(secondResponseDto.UploadId, 1, secondETag); exception != nil {
+		t.Fatalf("CompleteMyMaterialUpload() of the second upload exception = %v", exception)
+	}
+
+	if fixture.materialRepository.lockedReads != 2 {
+		t.Fatalf("CompleteMyMaterialUpload() read the material under a lock %d times, want once per completion", fixture.materialRepository.lockedReads)
+	}
+	// the second completion replaces the content of the first one instead of the content before both
+	if _, _, err := fixture.storage.GetObjectByKey(fixture.ctx, firstContentKey, nil); err == nil {
+		t.Fatal("CompleteMyMaterialUpload() kept the content replaced by the second upload")
+	}
+	if _, _, err := fixture.storage.GetObjectByKey(fixture.ctx, secondContentKey, nil); err != nil ||
+		fixture.materialRepository.material.ContentKey != secondContentKey {
+		t.Fatalf("CompleteMyMaterialUpload() lost the content of the second upload: %v", err)
+	}
+}
+
+func TestCompleteMyMaterialUploadRejectsTheMismatchedObjects(t *testing.T) {
+	tests := []struct {
+		name         string
+		declaredSize int64
+		contentType  enumcontract.MaterialContentType
+		content      string
+		want         *exceptions.Exception
+	}{
+		{
+			name:         "size mismatch",
+			declaredSize: 4,
+			contentType:  enumcontract.MaterialContentType_PlainText,
+			content:      "hel",
+			want:         apiexceptions.NewMaterialUploadException().SizeMismatch(4, 3),
+		},
+		{
+			name:         "content type mismatch",
+			declaredSize: 5,
+			contentType:  enumcontract.MaterialContentType_PNG,
+			content:      "hello",
+			want:         apiexceptions.NewMaterialUploadException().ContentTypeMismatch("image/png", "text/plain"),
+		},
+	}
+	for _, test := range tests {
+		fixture := newMaterialUploadTestFixture(t)
+		responseDto := fixture.initiate(t, test.declaredSize, test.contentType)
+		eTag := fixture.mustUploadPart(t, responseDto.UploadId, test.content)
+		contentKey := fixture.materialUploadRepository.uploads[responseDto.UploadId].ContentKey
+
+		_, exception := fixture.complete(responseDto.UploadId, 1, eTag)
+		if exception == nil || exception.Reason != test.want.Reason {
+			t.Errorf("%s: CompleteMyMaterialUpload() exception = %v, want %v", test.name, exception, test.want.Reason)
+			continue
+		}
+
+		if len(fixture.materialRepository.updates) != 0 || fixture.materialRepository.material.ContentKey != "previous-content" {
+			t.Errorf("%s: CompleteMyMaterialUpload() updated the material", test.name)
+		}
+		if len(fixture.materialUploadRepository.uploads) != 0 {
+			t.Errorf("%s: CompleteMyMaterialUpload() kept the upload of the rejected object", test.name)
+		}
+		if _, _, err := fixture.storage.GetObjectByKey(fixture.ctx, contentKey, nil); err == nil {
+			t.Errorf("%s: CompleteMyMaterialUpload() kept the rejected object", test.name)
+		}
+	}
+}
+
+func TestAbortMyMaterialUploadDiscardsTheParts(t *testing.T) {
+	fixture := newMaterialUploadTestFixture(t)
+	responseDto := fixture.initiate(t, 5, enumcontract.MaterialContentType_PlainText)
+	materialUpload := fixture.materialUploadRepository.uploads[responseDto.UploadId]
+	fixture.mustUploadPart(t, responseDto.UploadId, "hello")
+
+	requestDto := &apicontract.AbortMyMaterialUploadRequestDto{}
+	requestDto.Header.UserAgent = testUserAgent
+	requestDto.Param.MaterialId = fixture.materialRepository.material.Id
+	requestDto.Param.UploadId = responseDto.UploadId
+	if _, exception := fixture.service.AbortMyMaterialUpload(fixture.ctx, requestDto); exception != nil {
+		t.Fatalf("AbortMyMaterialUpload() exception = %v", exception)
+	}
+
+	if len(fixture.materialUploadRepository.uploads) != 0 {
+		t.Fatal("AbortMyMaterialUpload() kept the upload")
+	}
+	if _, err := fixture.storage.UploadPartByKey(fixture.ctx, materialUpload.ContentKey, materialUpload.StorageUploadId, 1, strings.NewReader("hello")); err == nil {
+		t.Fatal("AbortMyMaterialUpload() kept the multipart upload in the storage")
+	}
+	if _, exception := fixture.complete(responseDto.UploadId, 1, "eTag"); exception == nil {
+		t.Fatal("CompleteMyMaterialUpload() of an aborted upload exception = nil")
+	}
+}
@@ -17,6 +17,7 @@ import (
 	gqlmodels "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/graphql/models"
 
 	schemas "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas"
+	scopes "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/scopes"
 )
 
 type ThemeServiceInterface interface {
@@ -80,54 +81,21 @@ func (s *ThemeService) SearchPublicThemes(
 			"%"+gqlInput.Query+"%",
 		)
 	}
-	if gqlInput.After != nil && len(strings.ReplaceAll(*gqlInput.After, " ", "")) > 0 {
-		searchCursor, err := searchcursor.Decode[gqlmodels.SearchThemeCursorFields](*gqlInput.After)
-		if err != nil {
-			return nil, exceptions.New(
-				"CursorDecodeFailed",
-				"Search",
-				"SearchPublicThemes",
-				"Failed to decode the search cursor",
-				http.StatusInternalServerError,
-				true,
-			).WithOrigin(err)
-		}
-
-		query = query.Where("public_id > ?", searchCursor.Fields.PublicID)
-	}
 
-	if gqlInput.SortBy != nil && gqlInput.SortOrder != nil {
-		var cending string = gqlmodels.SearchSortOrderAsc.String()
-		if *gqlInput.SortOrder == gqlmodels.SearchSortOrderDesc {
-			cending = gqlmodels.SearchSortOrderDesc.String()
-		}
-
-		switch *gqlInput.SortBy {
-		case gqlmodels.SearchThemeSortByName:
-			query = query.Order("name " + cending).
-				Order("updated_at " + cending).
-				Order("created_at " + cending)
-		case gqlmodels.SearchThemeSortByLastUpdate:
-			query = query.Order("updated_at " + cending).
-				Order("name " + cending).
-				Order("created_at " + cending)
-		case gqlmodels.SearchThemeSortByCreatedAt:
-			query = query.Order("created_at " + cending).
-				Order("name " + cending).
-				Order("updated_at " + cending)
-		default:
-			query = query.Order("name " + cending).
-				Order("updated_at " + cending).
-				Order("created_at " + cending)
-		}
-	}
-
-	limit := constants.DefaultSearchLimit
-	if gqlInput.First != nil && *gqlInput.First > 0 {
-		limit = int(*gqlInput.First)
+	keyset := newThemeSearchKeyset(gqlInput)
+	page, err := keyset.NewPage(gqlInput.After, gqlInput.Before, gqlInput.First, gqlInput.Last, constants.DefaultSearchLimit, constants.MaxSearchLimit)
+	if err != nil {
+		return nil, exceptions.New(
+			"CursorDecodeFailed",
+			"Search",
+			"SearchPublicThemes",
+			"Failed to decode the search cursor",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(err)
 	}
-	limit = min(limit, constants.MaxSearchLimit)
-	query = query.Limit(limit + 1)
+	query = query.Scopes(scopes.PaginateByKeyset(keyset, page.AfterCursor, page.BeforeCursor, page.IsBackward)).
+		Limit(page.Limit + 1)
 
 	var themes []schemas.Theme
 	if err := query.Find(&themes).Error; err != nil {
@@ -141,16 +109,22 @@ func (s *ThemeService) SearchPublicThemes(
 		).WithOrigin(err)
 	}
 
-	hasNextPage := len(themes) > limit
+	themes, hasNextPage, hasPreviousPage := searchcursor.Slice(page, themes)
 	searchEdges := make([]*gqlmodels.SearchThemeEdge, len(themes))
 
 	for index, theme := range themes {
-		searchCursor := searchcursor.SearchCursor[gqlmodels.SearchThemeCursorFields]{
-			Fields: gqlmodels.SearchThemeCursorFields{
-				PublicID: theme.PublicId,
-			},
+		var sortValues []any
+		switch keyset.SortBy {
+		case gqlmodels.SearchThemeSortByName.String():
+			sortValues = []any{theme.Name, theme.UpdatedAt, theme.CreatedAt, theme.PublicId}
+		case gqlmodels.SearchThemeSortByLastUpdate.String():
+			sortValues = []any{theme.UpdatedAt, theme.Name, theme.CreatedAt, theme.PublicId}
+		case gqlmodels.SearchThemeSortByCreatedAt.String():
+			sortValues = []any{theme.CreatedAt, theme.Name, theme.UpdatedAt, theme.PublicId}
+		default:
+			sortValues = []any{theme.PublicId}
 		}
-		encodedSearchCursor, err := searchCursor.Encode()
+		encodedSearchCursor, err := keyset.Encode(sortValues...)
 		if err != nil {
 			return nil, exceptions.New(
 				"CursorEncodeFailed",
@@ -161,26 +135,16 @@ func (s *ThemeService) SearchPublicThemes(
 				true,
 			).WithOrigin(err)
 		}
-		if encodedSearchCursor == nil {
-			return nil, exceptions.New(
-				"CursorEncodingFailed",
-				"Search",
-				"SearchPublicThemes",
-				"Failed to encode the search cursor",
-				http.StatusInternalServerError,
-				true,
-			)
-		}
 
 		searchEdges[index] = &gqlmodels.SearchThemeEdge{
-			EncodedSearchCursor: *encodedSearchCursor,
+			EncodedSearchCursor: encodedSearchCursor,
 			Node:                theme.ToPublicTheme(),
 		}
 	}
 
 	searchPageInfo := &gqlmodels.SearchPageInfo{
 		HasNextPage:     hasNextPage,
-		HasPreviousPage: gqlInput.After != nil && len(strings.ReplaceAll(*gqlInput.After, " ", "")) > 0,
+		HasPreviousPage: hasPreviousPage,
 	}
 
 	if len(searchEdges) > 0 {
@@ -189,9 +153,6 @@ func (s *ThemeService) SearchPublicThemes(
 	}
 
 	searchTime := float64(time.Since(startTime).Nanoseconds()) / 1e6
-	if hasNextPage {
-		searchEdges = searchEdges[:limit]
-	}
 
 	return &gqlmodels.SearchThemeConnection{
 		SearchEdges:    searchEdges,
@@ -200,3 +161,28 @@ func (s *ThemeService) SearchPublicThemes(
 		SearchTime:     searchTime,
 	}, nil
 }
+
+/* ============================== Auxiliary Functions ============================== */
+
+// newThemeSearchKeyset builds the keyset of the requested sort order, where the public id is used as the tie-breaker to avoid exposing the id
+func newThemeSearchKeyset(gqlInput gqlmodels.SearchThemeInput) *searchcursor.Keyset {
+	isDescending := gqlInput.SortOrder != nil && *gqlInput.SortOrder == gqlmodels.SearchSortOrderDesc
+
+	publicIdKey := searchcursor.KeysetKey{Column: `"ThemeTable".public_id`, Type: "uuid"}
+	nameKey := searchcursor.KeysetKey{Column: `"ThemeTable".name`, Type: "text"}
+	updatedAtKey := searchcursor.KeysetKey{Column: `"ThemeTable".updated_at`, Type: "timestamptz"}
+	createdAtKey := searchcursor.KeysetKey{Column: `"ThemeTable".created_at`, Type: "timestamptz"}
+
+	if gqlInput.SortBy == nil {
+		return searchcursor.NewKeyset("", isDescending, publicIdKey)
+	}
+
+	switch *gqlInput.SortBy {
+	case gqlmodels.SearchThemeSortByLastUpdate:
+		return searchcursor.NewKeyset(gqlmodels.SearchThemeSortByLastUpdate.String(), isDescending, updatedAtKey, nameKey, createdAtKey, publicIdKey)
+	case gqlmodels.SearchThemeSortByCreatedAt:
+		return searchcursor.NewKeyset(gqlmodels.SearchThemeSortByCreatedAt.String(), isDescending, createdAtKey, nameKey, updatedAtKey, publicIdKey)
+	default:
+		return searchcursor.NewKeyset(gqlmodels.SearchThemeSortByName.String(), isDescending, nameKey, updatedAtKey, createdAtKey, publicIdKey)
+	}
+}
@@ -25,6 +25,7 @@ import (
 	repositories "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/repositories"
 	schemas "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas"
 	enums "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/enums"
+	apiexceptions "github.com/HiIamJeff67/notegic-backend/internal/core/exceptions"
 )
 
 type RealtimeServiceInterface interface {
@@ -102,6 +103,11 @@ func (s *RealtimeService) CreateMyRealtimeConnectionTicket(
 		RealtimeProtocolVersion: constants.RealtimeProtocolVersion,
 	}
 	connectionClaims.Subject = userPublicId.String()
+	if restriction := contexts.GetOptionalShareLinkRestriction(ctx); restriction != nil {
+		connectionClaims.ShareLinkId = restriction.ShareLinkId.String()
+		shareSessionExpiresAt := restriction.ExpiresAt
+		connectionClaims.ShareSessionExpiresAt = &shareSessionExpiresAt
+	}
 	connectionTicket, expiresAt, err := sharedtokens.GenerateRealtimeConnectionTicket(connectionClaims)
 	if err != nil {
 		return nil, exceptions.New(
@@ -149,6 +155,11 @@ func (s *RealtimeService) CreateMyBlockPackChannelTicket(
 			http.StatusBadRequest,
 		)
 	}
+	// a share session only reads the shared block packs
+	restriction := contexts.GetOptionalShareLinkRestriction(ctx)
+	if restriction != nil && permission == enumscontract.ChannelPermission_Write {
+		return nil, apiexceptions.NewShareLinkException().OperationNotAllowed(string(apicontract.CreateMyBlockPackChannelTicketOperation))
+	}
 	allowedPermissions := make([]enums.AccessControlPermission, len(sharedAllowedPermissions))
 	for index, sharedAllowedPermission := range sharedAllowedPermissions {
 		allowedPermissions[index] = enums.AccessControlPermission(sharedAllowedPermission)
@@ -243,6 +254,9 @@ func (s *RealtimeService) CreateMyBlockPackChannelTicket(
 		MaximumBlockCount:                roomPolicy.MaximumBlockCount,
 	}
 	channelClaims.Subject = userPublicId.String()
+	if restriction != nil {
+		channelClaims.ShareLinkId = restriction.ShareLinkId.String()
+	}
 	channelTicket, expiresAt, err := sharedtokens.GenerateRealtimeBlockPackTicket(channelClaims)
 	if err != nil {
 		return nil, exceptions.New(
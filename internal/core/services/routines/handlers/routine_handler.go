@@ -126,6 +126,7 @@ func (s *RoutineHandler) HandleCreateRoutine(
 			ScheduledStartAt: payload.ScheduledStartAt,
 			ScheduledEndAt:   payload.ScheduledEndAt,
 			Period:           (*coreenums.RoutinePeriod)(payload.Period),
+			RecurrenceRule:   payload.RecurrenceRule,
 			Timezone:         payload.Timezone,
 		})
 		taskIndexes = append(taskIndexes, candidateTaskIndexes[candidateIndex])
@@ -227,6 +228,7 @@ func (s *RoutineHandler) HandleUpdateRoutine(
 					ScheduledStartAt: payload.ScheduledStartAt,
 					ScheduledEndAt:   payload.ScheduledEndAt,
 					Period:           (*coreenums.RoutinePeriod)(payload.Period),
+					RecurrenceRule:   payload.RecurrenceRule,
 					Timezone:         payload.Timezone,
 				},
 			},
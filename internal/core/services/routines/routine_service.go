@@ -218,6 +218,41 @@ func (s *RoutineService) visualizeMyRoutineTimeCount(
 	return data, nil
 }
 
+// newRoutineSearchKeyset builds the keyset of the requested sort order
+func newRoutineSearchKeyset(gqlInput gqlmodels.SearchRoutineInput) *searchcursor.Keyset {
+	isDescending := gqlInput.SortOrder != nil && *gqlInput.SortOrder == gqlmodels.SearchSortOrderDesc
+
+	idKey := searchcursor.KeysetKey{Column: `"RoutineTable".id`, Type: "uuid"}
+	titleKey := searchcursor.KeysetKey{Column: `"RoutineTable".title`, Type: "text"}
+	statusKey := searchcursor.KeysetKey{Column: `"RoutineTable".status`, Type: `"RoutineStatus"`}
+	scheduledStartAtKey := searchcursor.KeysetKey{Column: `"RoutineTable".scheduled_start_at`, Type: "timestamptz"}
+	scheduledEndAtKey := searchcursor.KeysetKey{Column: `"RoutineTable".scheduled_end_at`, Type: "timestamptz"}
+	periodKey := searchcursor.KeysetKey{Column: `"RoutineTable".period`, Type: `"RoutinePeriod"`}
+	updatedAtKey := searchcursor.KeysetKey{Column: `"RoutineTable".updated_at`, Type: "timestamptz"}
+	createdAtKey := searchcursor.KeysetKey{Column: `"RoutineTable".created_at`, Type: "timestamptz"}
+
+	if gqlInput.SortBy == nil {
+		return searchcursor.NewKeyset("", isDescending, idKey)
+	}
+
+	switch *gqlInput.SortBy {
+	case gqlmodels.SearchRoutineSortByStatus:
+		return searchcursor.NewKeyset(gqlmodels.SearchRoutineSortByStatus.String(), isDescending, statusKey, titleKey, updatedAtKey, createdAtKey, idKey)
+	case gqlmodels.SearchRoutineSortByScheduledStartAt:
+		return searchcursor.NewKeyset(gqlmodels.SearchRoutineSortByScheduledStartAt.String(), isDescending, scheduledStartAtKey, titleKey, updatedAtKey, createdAtKey, idKey)
+	case gqlmodels.SearchRoutineSortByScheduledEndAt:
+		return searchcursor.NewKeyset(gqlmodels.SearchRoutineSortByScheduledEndAt.String(), isDescending, scheduledEndAtKey, titleKey, updatedAtKey, createdAtKey, idKey)
+	case gqlmodels.SearchRoutineSortByPeriod:
+		return searchcursor.NewKeyset(gqlmodels.SearchRoutineSortByPeriod.String(), isDescending, periodKey, titleKey, updatedAtKey, createdAtKey, idKey)
+	case gqlmodels.SearchRoutineSortByLastUpdate:
+		return searchcursor.NewKeyset(gqlmodels.SearchRoutineSortByLastUpdate.String(), isDescending, updatedAtKey, titleKey, createdAtKey, idKey)
+	case gqlmodels.SearchRoutineSortByCreatedAt:
+		return searchcursor.NewKeyset(gqlmodels.SearchRoutineSortByCreatedAt.String(), isDescending, createdAtKey, titleKey, updatedAtKey, idKey)
+	default:
+		return searchcursor.NewKeyset(gqlmodels.SearchRoutineSortByTitle.String(), isDescending, titleKey, updatedAtKey, createdAtKey, idKey)
+	}
+}
+
 /* ============================== Service Methods for Routine ============================== */
 
 /* ============================== Main Methods ============================== */
@@ -300,6 +335,7 @@ func (s *RoutineService) GetMyRoutineById(
 		ScheduledStartAt: routine.ScheduledStartAt,
 		ScheduledEndAt:   routine.ScheduledEndAt,
 		Period:           routine.Period.ToContractable(),
+		RecurrenceRule:   routine.RecurrenceRule,
 		Timezone:         routine.Timezone,
 		DeletedAt:        routine.DeletedAt,
 		UpdatedAt:        routine.UpdatedAt,
@@ -399,6 +435,7 @@ func (s *RoutineService) GetMyRoutinesByStationId(
 			ScheduledStartAt: routine.ScheduledStartAt,
 			ScheduledEndAt:   routine.ScheduledEndAt,
 			Period:           routine.Period.ToContractable(),
+			RecurrenceRule:   routine.RecurrenceRule,
 			Timezone:         routine.Timezone,
 			DeletedAt:        routine.DeletedAt,
 			UpdatedAt:        routine.UpdatedAt,
@@ -498,6 +535,7 @@ func (s *RoutineService) GetAllMyRoutinesByTimeRange(
 			ScheduledStartAt: routine.ScheduledStartAt,
 			ScheduledEndAt:   routine.ScheduledEndAt,
 			Period:           routine.Period.ToContractable(),
+			RecurrenceRule:   routine.RecurrenceRule,
 			Timezone:         routine.Timezone,
 			DeletedAt:        routine.DeletedAt,
 			UpdatedAt:        routine.UpdatedAt,
@@ -540,6 +578,7 @@ func (s *RoutineService) CreateRoutineByStationId(
 			ScheduledStartAt: reqDto.Body.ScheduledStartAt,
 			ScheduledEndAt:   reqDto.Body.ScheduledEndAt,
 			Period:           (*enums.RoutinePeriod)(reqDto.Body.Period).ToStorable(),
+			RecurrenceRule:   reqDto.Body.RecurrenceRule,
 			Timezone:         reqDto.Body.Timezone,
 		},
 		options.WithDB(db),
@@ -584,6 +623,7 @@ func (s *RoutineService) CreateRoutinesByStationIds(
 			ScheduledStartAt: createdRoutine.ScheduledStartAt,
 			ScheduledEndAt:   createdRoutine.ScheduledEndAt,
 			Period:           (*enums.RoutinePeriod)(createdRoutine.Period).ToStorable(),
+			RecurrenceRule:   createdRoutine.RecurrenceRule,
 			Timezone:         createdRoutine.Timezone,
 		}
 	}
@@ -633,6 +673,7 @@ func (s *RoutineService) UpdateMyRoutineById(
 				ScheduledStartAt: reqDto.Body.Values.ScheduledStartAt,
 				ScheduledEndAt:   reqDto.Body.Values.ScheduledEndAt,
 				Period:           (*enums.RoutinePeriod)(reqDto.Body.Values.Period).ToStorable(),
+				RecurrenceRule:   reqDto.Body.Values.RecurrenceRule,
 				Timezone:         reqDto.Body.Values.Timezone,
 			},
 			SetNull: reqDto.Body.SetNull,
@@ -680,6 +721,7 @@ func (s *RoutineService) UpdateMyRoutinesByIds(
 					ScheduledStartAt: updatedRoutine.Values.ScheduledStartAt,
 					ScheduledEndAt:   updatedRoutine.Values.ScheduledEndAt,
 					Period:           (*enums.RoutinePeriod)(updatedRoutine.Values.Period).ToStorable(),
+					RecurrenceRule:   updatedRoutine.Values.RecurrenceRule,
 					Timezone:         updatedRoutine.Values.Timezone,
 				},
 				SetNull: updatedRoutine.SetNull,
@@ -1154,6 +1196,7 @@ func (s *RoutineService) RestoreMyRoutineById(
 		ScheduledStartAt: restoredRoutine.ScheduledStartAt,
 		ScheduledEndAt:   restoredRoutine.ScheduledEndAt,
 		Period:           restoredRoutine.Period.ToContractable(),
+		RecurrenceRule:   restoredRoutine.RecurrenceRule,
 		Timezone:         restoredRoutine.Timezone,
 		DeletedAt:        restoredRoutine.DeletedAt,
 		UpdatedAt:        restoredRoutine.UpdatedAt,
@@ -1200,6 +1243,7 @@ func (s *RoutineService) RestoreMyRoutinesByIds(
 			ScheduledStartAt: restoredRoutine.ScheduledStartAt,
 			ScheduledEndAt:   restoredRoutine.ScheduledEndAt,
 			Period:           restoredRoutine.Period.ToContractable(),
+			RecurrenceRule:   restoredRoutine.RecurrenceRule,
 			Timezone:         restoredRoutine.Timezone,
 			DeletedAt:        restoredRoutine.DeletedAt,
 			UpdatedAt:        restoredRoutine.UpdatedAt,
@@ -1632,70 +1676,13 @@ func (s *RoutineService) SearchPrivateRoutines(
 			"%"+gqlInput.Query+"%",
 		)
 	}
-	if gqlInput.After != nil && len(strings.ReplaceAll(*gqlInput.After, " ", "")) > 0 {
-		searchCursor, err := searchcursor.Decode[gqlmodels.SearchRoutineCursorFields](*gqlInput.After)
-		if err != nil {
-			return nil, apiexceptions.NewSearchException().FailedToDecode().WithOrigin(err)
-		}
-
-		query = query.Where(
-			`"RoutineTable".id > ?`,
-			searchCursor.Fields.ID,
-		)
-	}
-
-	if gqlInput.SortBy != nil && gqlInput.SortOrder != nil {
-		var cending string = gqlmodels.SearchSortOrderAsc.String()
-		if *gqlInput.SortOrder == gqlmodels.SearchSortOrderDesc {
-			cending = gqlmodels.SearchSortOrderDesc.String()
-		}
-
-		switch *gqlInput.SortBy {
-		case gqlmodels.SearchRoutineSortByTitle:
-			query = query.Order("title " + cending).
-				Order("updated_at " + cending).
-				Order("created_at " + cending)
-		case gqlmodels.SearchRoutineSortByStatus:
-			query = query.Order("status " + cending).
-				Order("title " + cending).
-				Order("updated_at " + cending).
-				Order("created_at " + cending)
-		case gqlmodels.SearchRoutineSortByScheduledStartAt:
-			query = query.Order("scheduled_start_at " + cending).
-				Order("title " + cending).
-				Order("updated_at " + cending).
-				Order("created_at " + cending)
-		case gqlmodels.SearchRoutineSortByScheduledEndAt:
-			query = query.Order("scheduled_end_at " + cending).
-				Order("title " + cending).
-				Order("updated_at " + cending).
-				Order("created_at " + cending)
-		case gqlmodels.SearchRoutineSortByPeriod:
-			query = query.Order("period " + cending).
-				Order("title " + cending).
-				Order("updated_at " + cending).
-				Order("created_at " + cending)
-		case gqlmodels.SearchRoutineSortByLastUpdate:
-			query = query.Order("updated_at " + cending).
-				Order("title " + cending).
-				Order("created_at " + cending)
-		case gqlmodels.SearchRoutineSortByCreatedAt:
-			query = query.Order("created_at " + cending).
-				Order("title " + cending).
-				Order("updated_at " + cending)
-		default:
-			query = query.Order("title " + cending).
-				Order("updated_at " + cending).
-				Order("created_at " + cending)
-		}
-	}
-
-	limit := constants.DefaultSearchLimit
-	if gqlInput.First != nil && *gqlInput.First > 0 {
-		limit = int(*gqlInput.First)
+	keyset := newRoutineSearchKeyset(gqlInput)
+	page, err := keyset.NewPage(gqlInput.After, gqlInput.Before, gqlInput.First, gqlInput.Last, constants.DefaultSearchLimit, constants.MaxSearchLimit)
+	if err != nil {
+		return nil, apiexceptions.NewSearchException().FailedToDecode().WithOrigin(err)
 	}
-	limit = min(limit, constants.MaxSearchLimit)
-	query = query.Limit(limit + 1)
+	query = query.Scopes(scopes.PaginateByKeyset(keyset, page.AfterCursor, page.BeforeCursor, page.IsBackward)).
+		Limit(page.Limit + 1)
 
 	var routines []schemas.Routine
 	if err := query.Scopes(s.routineScope.IncludePreloads(
@@ -1708,6 +1695,9 @@ func (s *RoutineService) SearchPrivateRoutines(
 	)).Find(&routines).Error; err != nil {
 		return nil, apiexceptions.NewRoutineException().NotFound().WithOrigin(err)
 	}
+
+	routines, hasNextPage, hasPreviousPage := searchcursor.Slice(page, routines)
+
 	permittedItemIdentitySet, exception := s.filterReadableRoutineItems(
 		ctx,
 		userId,
@@ -1731,32 +1721,42 @@ func (s *RoutineService) SearchPrivateRoutines(
 		routines[index].RoutinesToItems = filteredRoutineToItems
 	}
 
-	hasNextPage := len(routines) > limit
 	searchEdges := make([]*gqlmodels.SearchRoutineEdge, len(routines))
 
 	for index, routine := range routines {
-		searchCursor := searchcursor.SearchCursor[gqlmodels.SearchRoutineCursorFields]{
-			Fields: gqlmodels.SearchRoutineCursorFields{
-				ID: routine.Id,
-			},
+		var sortValues []any
+		switch keyset.SortBy {
+		case gqlmodels.SearchRoutineSortByTitle.String():
+			sortValues = []any{routine.Title, routine.UpdatedAt, routine.CreatedAt, routine.Id}
+		case gqlmodels.SearchRoutineSortByStatus.String():
+			sortValues = []any{routine.Status, routine.Title, routine.UpdatedAt, routine.CreatedAt, routine.Id}
+		case gqlmodels.SearchRoutineSortByScheduledStartAt.String():
+			sortValues = []any{routine.ScheduledStartAt, routine.Title, routine.UpdatedAt, routine.CreatedAt, routine.Id}
+		case gqlmodels.SearchRoutineSortByScheduledEndAt.String():
+			sortValues = []any{routine.ScheduledEndAt, routine.Title, routine.UpdatedAt, routine.CreatedAt, routine.Id}
+		case gqlmodels.SearchRoutineSortByPeriod.String():
+			sortValues = []any{routine.Period, routine.Title, routine.UpdatedAt, routine.CreatedAt, routine.Id}
+		case gqlmodels.SearchRoutineSortByLastUpdate.String():
+			sortValues = []any{routine.UpdatedAt, routine.Title, routine.CreatedAt, routine.Id}
+		case gqlmodels.SearchRoutineSortByCreatedAt.String():
+			sortValues = []any{routine.CreatedAt, routine.Title, routine.UpdatedAt, routine.Id}
+		default:
+			sortValues = []any{routine.Id}
 		}
-		encodedSearchCursor, err := searchCursor.Encode()
+		encodedSearchCursor, err := keyset.Encode(sortValues...)
 		if err != nil {
 			return nil, apiexceptions.NewSearchException().FailedToEncode().WithOrigin(err)
 		}
-		if encodedSearchCursor == nil {
-			return nil, apiexceptions.NewSearchException().FailedToUnmarshalSearchCursor()
-		}
 
 		searchEdges[index] = &gqlmodels.SearchRoutineEdge{
-			EncodedSearchCursor: *encodedSearchCursor,
+			EncodedSearchCursor: encodedSearchCursor,
 			Node:                routine.ToPrivateSearchableRoutine(),
 		}
 	}
 
 	searchPageInfo := &gqlmodels.SearchPageInfo{
 		HasNextPage:     hasNextPage,
-		HasPreviousPage: false,
+		HasPreviousPage: hasPreviousPage,
 	}
 
 	if len(searchEdges) > 0 {
@@ -1765,9 +1765,6 @@ func (s *RoutineService) SearchPrivateRoutines(
 	}
 
 	searchTime := float64(time.Since(startTime).Nanoseconds()) / 1e6
-	if hasNextPage {
-		searchEdges = searchEdges[:limit]
-	}
 
 	return &gqlmodels.SearchRoutineConnection{
 		SearchEdges:    searchEdges,
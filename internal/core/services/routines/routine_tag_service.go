@@ -24,6 +24,7 @@ import (
 	repositories "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/repositories"
 	schemas "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas"
 	enums "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/enums"
+	scopes "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/scopes"
 	apiexceptions "github.com/HiIamJeff67/notegic-backend/internal/core/exceptions"
 )
 
@@ -91,6 +92,29 @@ func newRoutineTagResponseDto(routineTag schemas.RoutineTag) apicontract.Routine
 	}
 }
 
+// newRoutineTagSearchKeyset builds the keyset of the requested sort order
+func newRoutineTagSearchKeyset(gqlInput gqlmodels.SearchRoutineTagInput) *searchcursor.Keyset {
+	isDescending := gqlInput.SortOrder != nil && *gqlInput.SortOrder == gqlmodels.SearchSortOrderDesc
+
+	idKey := searchcursor.KeysetKey{Column: `"RoutineTagTable".id`, Type: "uuid"}
+	nameKey := searchcursor.KeysetKey{Column: `"RoutineTagTable".name`, Type: "text"}
+	updatedAtKey := searchcursor.KeysetKey{Column: `"RoutineTagTable".updated_at`, Type: "timestamptz"}
+	createdAtKey := searchcursor.KeysetKey{Column: `"RoutineTagTable".created_at`, Type: "timestamptz"}
+
+	if gqlInput.SortBy == nil {
+		return searchcursor.NewKeyset("", isDescending, idKey)
+	}
+
+	switch *gqlInput.SortBy {
+	case gqlmodels.SearchRoutineTagSortByLastUpdate:
+		return searchcursor.NewKeyset(gqlmodels.SearchRoutineTagSortByLastUpdate.String(), isDescending, updatedAtKey, nameKey, createdAtKey, idKey)
+	case gqlmodels.SearchRoutineTagSortByCreatedAt:
+		return searchcursor.NewKeyset(gqlmodels.SearchRoutineTagSortByCreatedAt.String(), isDescending, createdAtKey, nameKey, updatedAtKey, idKey)
+	default:
+		return searchcursor.NewKeyset(gqlmodels.SearchRoutineTagSortByName.String(), isDescending, nameKey, updatedAtKey, createdAtKey, idKey)
+	}
+}
+
 /* ============================== Service Methods for RoutineTag ============================== */
 
 func (s *RoutineTagService) GetMyRoutineTagById(
@@ -399,79 +423,49 @@ func (s *RoutineTagService) SearchPrivateRoutineTags(
 			"%"+gqlInput.Query+"%",
 		)
 	}
-	if gqlInput.After != nil && len(strings.ReplaceAll(*gqlInput.After, " ", "")) > 0 {
-		searchCursor, err := searchcursor.Decode[gqlmodels.SearchRoutineTagCursorFields](*gqlInput.After)
-		if err != nil {
-			return nil, apiexceptions.NewSearchException().FailedToDecode().WithOrigin(err)
-		}
-
-		query = query.Where("id > ?", searchCursor.Fields.ID)
-	}
-
-	if gqlInput.SortBy != nil && gqlInput.SortOrder != nil {
-		var cending string = gqlmodels.SearchSortOrderAsc.String()
-		if *gqlInput.SortOrder == gqlmodels.SearchSortOrderDesc {
-			cending = gqlmodels.SearchSortOrderDesc.String()
-		}
-
-		switch *gqlInput.SortBy {
-		case gqlmodels.SearchRoutineTagSortByName:
-			query = query.Order("name " + cending).
-				Order("updated_at " + cending).
-				Order("created_at " + cending)
-		case gqlmodels.SearchRoutineTagSortByLastUpdate:
-			query = query.Order("updated_at " + cending).
-				Order("name " + cending).
-				Order("created_at " + cending)
-		case gqlmodels.SearchRoutineTagSortByCreatedAt:
-			query = query.Order("created_at " + cending).
-				Order("name " + cending).
-				Order("updated_at " + cending)
-		default:
-			query = query.Order("name " + cending).
-				Order("updated_at " + cending).
-				Order("created_at " + cending)
-		}
-	}
-
-	limit := constants.DefaultSearchLimit
-	if gqlInput.First != nil && *gqlInput.First > 0 {
-		limit = int(*gqlInput.First)
+	keyset := newRoutineTagSearchKeyset(gqlInput)
+	page, err := keyset.NewPage(gqlInput.After, gqlInput.Before, gqlInput.First, gqlInput.Last, constants.DefaultSearchLimit, constants.MaxSearchLimit)
+	if err != nil {
+		return nil, apiexceptions.NewSearchException().FailedToDecode().WithOrigin(err)
 	}
-	limit = min(limit, constants.MaxSearchLimit)
-	query = query.Limit(limit + 1)
+	query = query.Scopes(scopes.PaginateByKeyset(keyset, page.AfterCursor, page.BeforeCursor, page.IsBackward)).
+		Limit(page.Limit + 1)
 
 	var routineTags []schemas.RoutineTag
 	if err := query.Find(&routineTags).Error; err != nil {
 		return nil, apiexceptions.NewRoutineTagException().NotFound().WithOrigin(err)
 	}
 
-	hasNextPage := len(routineTags) > limit
+	routineTags, hasNextPage, hasPreviousPage := searchcursor.Slice(page, routineTags)
+
 	searchEdges := make([]*gqlmodels.SearchRoutineTagEdge, len(routineTags))
 
 	for index, routineTag := range routineTags {
-		searchCursor := searchcursor.SearchCursor[gqlmodels.SearchRoutineTagCursorFields]{
-			Fields: gqlmodels.SearchRoutineTagCursorFields{
-				ID: routineTag.Id,
-			},
+		var sortValues []any
+		switch keyset.SortBy {
+		case gqlmodels.SearchRoutineTagSortByName.String():
+			sortValues = []any{routineTag.Name, routineTag.UpdatedAt, routineTag.CreatedAt, routineTag.Id}
+		case gqlmodels.SearchRoutineTagSortByLastUpdate.String():
+			sortValues = []any{routineTag.UpdatedAt, routineTag.Name, routineTag.CreatedAt, routineTag.Id}
+		case gqlmodels.SearchRoutineTagSortByCreatedAt.String():
+			sortValues = []any{routineTag.CreatedAt, routineTag.Name, routineTag.UpdatedAt, routineTag.Id}
+		default:
+			sortValues = []any{routineTag.Id}
 		}
-		encodedSearchCursor, err := searchCursor.Encode()
+		encodedSearchCursor, err := keyset.Encode(sortValues...)
 		if err != nil {
 			return nil, apiexceptions.NewSearchException().FailedToEncode().WithOrigin(err)
 		}
-		if encodedSearchCursor == nil {
-			return nil, apiexceptions.NewSearchException().FailedToUnmarshalSearchCursor()
-		}
 
 		searchEdges[index] = &gqlmodels.SearchRoutineTagEdge{
-			EncodedSearchCursor: *encodedSearchCursor,
+			EncodedSearchCursor: encodedSearchCursor,
 			Node:                routineTag.ToPrivateRoutineTag(),
 		}
 	}
 
 	searchPageInfo := &gqlmodels.SearchPageInfo{
 		HasNextPage:     hasNextPage,
-		HasPreviousPage: gqlInput.After != nil && len(strings.ReplaceAll(*gqlInput.After, " ", "")) > 0,
+		HasPreviousPage: hasPreviousPage,
 	}
 
 	if len(searchEdges) > 0 {
@@ -480,9 +474,6 @@ func (s *RoutineTagService) SearchPrivateRoutineTags(
 	}
 
 	searchTime := float64(time.Since(startTime).Nanoseconds()) / 1e6
-	if hasNextPage {
-		searchEdges = searchEdges[:limit]
-	}
 
 	return &gqlmodels.SearchRoutineTagConnection{
 		SearchEdges:    searchEdges,
@@ -419,7 +419,12 @@ func finalizeCompletedRoutineTasks(
 	result := tx.Model(&schemas.RoutineTask{}).
 		Where("id IN ? AND status = ?", taskIds, coreenums.RoutineTaskStatus_Running).
 		Updates(map[string]any{
-			"status":          coreenums.RoutineTaskStatus_Idle,
+			// the tasks whose recurrence rules have no occurrence after this run are paused
+			"status": gorm.Expr(
+				"CASE WHEN recurrence_rule IS NOT NULL AND scheduled_at <= actual_started_at THEN ? ELSE ? END",
+				coreenums.RoutineTaskStatus_Pause,
+				coreenums.RoutineTaskStatus_Idle,
+			),
 			"attempts":        0,
 			"actual_ended_at": now,
 			"updated_at":      now,
@@ -437,7 +442,10 @@ func finalizeCompletedRoutineTasks(
 	if result.RowsAffected != int64(len(taskIds)) {
 		var finalizedTaskCount int64
 		tx.Model(&schemas.RoutineTask{}).
-			Where("id IN ? AND status = ?", taskIds, coreenums.RoutineTaskStatus_Idle).
+			Where("id IN ? AND status IN ?", taskIds, []coreenums.RoutineTaskStatus{
+				coreenums.RoutineTaskStatus_Idle,
+				coreenums.RoutineTaskStatus_Pause,
+			}).
 			Count(&finalizedTaskCount)
 		if finalizedTaskCount != int64(len(taskIds)) {
 			return exceptions.New(
@@ -501,91 +501,61 @@ func (s *RoutineTaskRecordService) SearchPrivateRoutineTaskRecords(
 			"%"+gqlInput.Query+"%",
 		)
 	}
-	if gqlInput.After != nil && len(strings.ReplaceAll(*gqlInput.After, " ", "")) > 0 {
-		searchCursor, err := searchcursor.Decode[gqlmodels.SearchRoutineTaskRecordCursorFields](*gqlInput.After)
-		if err != nil {
-			return nil, apiexceptions.NewSearchException().FailedToDecode().WithOrigin(err)
-		}
-
-		query = query.Where(`"RoutineTaskRecordTable".id > ?`, searchCursor.Fields.ID)
-	}
-
-	if gqlInput.SortBy != nil && gqlInput.SortOrder != nil {
-		cending := gqlmodels.SearchSortOrderAsc.String()
-		if *gqlInput.SortOrder == gqlmodels.SearchSortOrderDesc {
-			cending = gqlmodels.SearchSortOrderDesc.String()
-		}
-
-		switch *gqlInput.SortBy {
-		case gqlmodels.SearchRoutineTaskRecordSortByPurpose:
-			query = query.Order(`"RoutineTaskRecordTable".purpose ` + cending).
-				Order(`"RoutineTaskRecordTable".created_at ` + cending)
-		case gqlmodels.SearchRoutineTaskRecordSortByStatus:
-			query = query.Order(`"RoutineTaskRecordTable".status ` + cending).
-				Order(`"RoutineTaskRecordTable".created_at ` + cending)
-		case gqlmodels.SearchRoutineTaskRecordSortByCostUnit:
-			query = query.Order(`"RoutineTaskRecordTable".cost_unit ` + cending).
-				Order(`"RoutineTaskRecordTable".created_at ` + cending)
-		case gqlmodels.SearchRoutineTaskRecordSortByTotalAttempts:
-			query = query.Order(`"RoutineTaskRecordTable".total_attempts ` + cending).
-				Order(`"RoutineTaskRecordTable".created_at ` + cending)
-		case gqlmodels.SearchRoutineTaskRecordSortByScheduledAt:
-			query = query.Order(`"RoutineTaskRecordTable".scheduled_at ` + cending).
-				Order(`"RoutineTaskRecordTable".created_at ` + cending)
-		case gqlmodels.SearchRoutineTaskRecordSortByActualStartedAt:
-			query = query.Order(`"RoutineTaskRecordTable".actual_started_at ` + cending).
-				Order(`"RoutineTaskRecordTable".created_at ` + cending)
-		case gqlmodels.SearchRoutineTaskRecordSortByActualEndedAt:
-			query = query.Order(`"RoutineTaskRecordTable".actual_ended_at ` + cending).
-				Order(`"RoutineTaskRecordTable".created_at ` + cending)
-		case gqlmodels.SearchRoutineTaskRecordSortByLastUpdate:
-			query = query.Order(`"RoutineTaskRecordTable".updated_at ` + cending).
-				Order(`"RoutineTaskRecordTable".created_at ` + cending)
-		case gqlmodels.SearchRoutineTaskRecordSortByCreatedAt:
-			query = query.Order(`"RoutineTaskRecordTable".created_at ` + cending)
-		default:
-			query = query.Order(`"RoutineTaskRecordTable".created_at ` + cending)
-		}
-	}
-
-	limit := constants.DefaultSearchLimit
-	if gqlInput.First != nil && *gqlInput.First > 0 {
-		limit = int(*gqlInput.First)
+	keyset := newRoutineTaskRecordSearchKeyset(gqlInput)
+	page, err := keyset.NewPage(gqlInput.After, gqlInput.Before, gqlInput.First, gqlInput.Last, constants.DefaultSearchLimit, constants.MaxSearchLimit)
+	if err != nil {
+		return nil, apiexceptions.NewSearchException().FailedToDecode().WithOrigin(err)
 	}
-	limit = min(limit, constants.MaxSearchLimit)
-	query = query.Limit(limit + 1)
+	query = query.Scopes(scopes.PaginateByKeyset(keyset, page.AfterCursor, page.BeforeCursor, page.IsBackward)).
+		Limit(page.Limit + 1)
 
 	var routineTaskRecords []PrivateRoutineTaskRecord
 	if err := query.Find(&routineTaskRecords).Error; err != nil {
 		return nil, apiexceptions.NewRoutineTaskException().NotFound().WithOrigin(err)
 	}
 
-	hasNextPage := len(routineTaskRecords) > limit
+	routineTaskRecords, hasNextPage, hasPreviousPage := searchcursor.Slice(page, routineTaskRecords)
+
 	searchEdges := make([]*gqlmodels.SearchRoutineTaskRecordEdge, len(routineTaskRecords))
 
 	for index, routineTaskRecord := range routineTaskRecords {
-		searchCursor := searchcursor.SearchCursor[gqlmodels.SearchRoutineTaskRecordCursorFields]{
-			Fields: gqlmodels.SearchRoutineTaskRecordCursorFields{
-				ID: routineTaskRecord.Id,
-			},
+		var sortValues []any
+		switch keyset.SortBy {
+		case gqlmodels.SearchRoutineTaskRecordSortByPurpose.String():
+			sortValues = []any{routineTaskRecord.Purpose, routineTaskRecord.CreatedAt, routineTaskRecord.Id}
+		case gqlmodels.SearchRoutineTaskRecordSortByStatus.String():
+			sortValues = []any{routineTaskRecord.Status, routineTaskRecord.CreatedAt, routineTaskRecord.Id}
+		case gqlmodels.SearchRoutineTaskRecordSortByCostUnit.String():
+			sortValues = []any{routineTaskRecord.CostUnit, routineTaskRecord.CreatedAt, routineTaskRecord.Id}
+		case gqlmodels.SearchRoutineTaskRecordSortByTotalAttempts.String():
+			sortValues = []any{routineTaskRecord.TotalAttempts, routineTaskRecord.CreatedAt, routineTaskRecord.Id}
+		case gqlmodels.SearchRoutineTaskRecordSortByScheduledAt.String():
+			sortValues = []any{routineTaskRecord.ScheduledAt, routineTaskRecord.CreatedAt, routineTaskRecord.Id}
+		case gqlmodels.SearchRoutineTaskRecordSortByActualStartedAt.String():
+			sortValues = []any{routineTaskRecord.ActualStartedAt, routineTaskRecord.CreatedAt, routineTaskRecord.Id}
+		case gqlmodels.SearchRoutineTaskRecordSortByActualEndedAt.String():
+			sortValues = []any{routineTaskRecord.ActualEndedAt, routineTaskRecord.CreatedAt, routineTaskRecord.Id}
+		case gqlmodels.SearchRoutineTaskRecordSortByLastUpdate.String():
+			sortValues = []any{routineTaskRecord.UpdatedAt, routineTaskRecord.CreatedAt, routineTaskRecord.Id}
+		case gqlmodels.SearchRoutineTaskRecordSortByCreatedAt.String():
+			sortValues = []any{routineTaskRecord.CreatedAt, routineTaskRecord.Id}
+		default:
+			sortValues = []any{routineTaskRecord.Id}
 		}
-		encodedSearchCursor, err := searchCursor.Encode()
+		encodedSearchCursor, err := keyset.Encode(sortValues...)
 		if err != nil {
 			return nil, apiexceptions.NewSearchException().FailedToEncode().WithOrigin(err)
 		}
-		if encodedSearchCursor == nil {
-			return nil, apiexceptions.NewSearchException().FailedToUnmarshalSearchCursor()
-		}
 
 		searchEdges[index] = &gqlmodels.SearchRoutineTaskRecordEdge{
-			EncodedSearchCursor: *encodedSearchCursor,
+			EncodedSearchCursor: encodedSearchCursor,
 			Node:                routineTaskRecord.RoutineTaskRecord.ToPrivateRoutineTaskRecord(),
 		}
 	}
 
 	searchPageInfo := &gqlmodels.SearchPageInfo{
 		HasNextPage:     hasNextPage,
-		HasPreviousPage: gqlInput.After != nil && len(strings.ReplaceAll(*gqlInput.After, " ", "")) > 0,
+		HasPreviousPage: hasPreviousPage,
 	}
 
 	if len(searchEdges) > 0 {
@@ -594,9 +564,6 @@ func (s *RoutineTaskRecordService) SearchPrivateRoutineTaskRecords(
 	}
 
 	searchTime := float64(time.Since(startTime).Nanoseconds()) / 1e6
-	if hasNextPage {
-		searchEdges = searchEdges[:limit]
-	}
 
 	return &gqlmodels.SearchRoutineTaskRecordConnection{
 		SearchEdges:    searchEdges,
@@ -605,3 +572,44 @@ func (s *RoutineTaskRecordService) SearchPrivateRoutineTaskRecords(
 		SearchTime:     searchTime,
 	}, nil
 }
+
+// newRoutineTaskRecordSearchKeyset builds the keyset of the requested sort order
+func newRoutineTaskRecordSearchKeyset(gqlInput gqlmodels.SearchRoutineTaskRecordInput) *searchcursor.Keyset {
+	isDescending := gqlInput.SortOrder != nil && *gqlInput.SortOrder == gqlmodels.SearchSortOrderDesc
+
+	idKey := searchcursor.KeysetKey{Column: `"RoutineTaskRecordTable".id`, Type: "uuid"}
+	purposeKey := searchcursor.KeysetKey{Column: `"RoutineTaskRecordTable".purpose`, Type: `"RoutineTaskPurpose"`}
+	statusKey := searchcursor.KeysetKey{Column: `"RoutineTaskRecordTable".status`, Type: `"RoutineTaskRecordStatus"`}
+	costUnitKey := searchcursor.KeysetKey{Column: `"RoutineTaskRecordTable".cost_unit`, Type: "bigint"}
+	totalAttemptsKey := searchcursor.KeysetKey{Column: `"RoutineTaskRecordTable".total_attempts`, Type: "bigint"}
+	scheduledAtKey := searchcursor.KeysetKey{Column: `"RoutineTaskRecordTable".scheduled_at`, Type: "timestamptz"}
+	actualStartedAtKey := searchcursor.KeysetKey{Column: `"RoutineTaskRecordTable".actual_started_at`, Type: "timestamptz"}
+	actualEndedAtKey := searchcursor.KeysetKey{Column: `"RoutineTaskRecordTable".actual_ended_at`, Type: "timestamptz"}
+	updatedAtKey := searchcursor.KeysetKey{Column: `"RoutineTaskRecordTable".updated_at`, Type: "timestamptz"}
+	createdAtKey := searchcursor.KeysetKey{Column: `"RoutineTaskRecordTable".created_at`, Type: "timestamptz"}
+
+	if gqlInput.SortBy == nil {
+		return searchcursor.NewKeyset("", isDescending, idKey)
+	}
+
+	switch *gqlInput.SortBy {
+	case gqlmodels.SearchRoutineTaskRecordSortByPurpose:
+		return searchcursor.NewKeyset(gqlmodels.SearchRoutineTaskRecordSortByPurpose.String(), isDescending, purposeKey, createdAtKey, idKey)
+	case gqlmodels.SearchRoutineTaskRecordSortByStatus:
+		return searchcursor.NewKeyset(gqlmodels.SearchRoutineTaskRecordSortByStatus.String(), isDescending, statusKey, createdAtKey, idKey)
+	case gqlmodels.SearchRoutineTaskRecordSortByCostUnit:
+		return searchcursor.NewKeyset(gqlmodels.SearchRoutineTaskRecordSortByCostUnit.String(), isDescending, costUnitKey, createdAtKey, idKey)
+	case gqlmodels.SearchRoutineTaskRecordSortByTotalAttempts:
+		return searchcursor.NewKeyset(gqlmodels.SearchRoutineTaskRecordSortByTotalAttempts.String(), isDescending, totalAttemptsKey, createdAtKey, idKey)
+	case gqlmodels.SearchRoutineTaskRecordSortByScheduledAt:
+		return searchcursor.NewKeyset(gqlmodels.SearchRoutineTaskRecordSortByScheduledAt.String(), isDescending, scheduledAtKey, createdAtKey, idKey)
+	case gqlmodels.SearchRoutineTaskRecordSortByActualStartedAt:
+		return searchcursor.NewKeyset(gqlmodels.SearchRoutineTaskRecordSortByActualStartedAt.String(), isDescending, actualStartedAtKey, createdAtKey, idKey)
+	case gqlmodels.SearchRoutineTaskRecordSortByActualEndedAt:
+		return searchcursor.NewKeyset(gqlmodels.SearchRoutineTaskRecordSortByActualEndedAt.String(), isDescending, actualEndedAtKey, createdAtKey, idKey)
+	case gqlmodels.SearchRoutineTaskRecordSortByLastUpdate:
+		return searchcursor.NewKeyset(gqlmodels.SearchRoutineTaskRecordSortByLastUpdate.String(), isDescending, updatedAtKey, createdAtKey, idKey)
+	default:
+		return searchcursor.NewKeyset(gqlmodels.SearchRoutineTaskRecordSortByCreatedAt.String(), isDescending, createdAtKey, idKey)
+	}
+}
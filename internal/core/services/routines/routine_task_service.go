@@ -3,20 +3,24 @@ package routines
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"strings"
 	"time"
 
 	validator "github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
 	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
 	constants "github.com/HiIamJeff67/notegic-backend/shared/constants"
 
+	recurrence "github.com/HiIamJeff67/notegic-backend/shared/lib/recurrence"
 	searchcursor "github.com/HiIamJeff67/notegic-backend/shared/lib/searchcursor"
 	times "github.com/HiIamJeff67/notegic-backend/shared/lib/times"
+	logs "github.com/HiIamJeff67/notegic-backend/shared/platform/observability/logs"
 
 	apicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/routine-tasks"
 	gqlmodels "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/graphql/models"
@@ -202,6 +206,53 @@ func (s *RoutineTaskService) visualizeMyRoutineTaskTimeCount(
 	return data, nil
 }
 
+// newRoutineTaskSearchKeyset builds the keyset of the requested sort order
+func newRoutineTaskSearchKeyset(gqlInput gqlmodels.SearchRoutineTaskInput) *searchcursor.Keyset {
+	isDescending := gqlInput.SortOrder != nil && *gqlInput.SortOrder == gqlmodels.SearchSortOrderDesc
+
+	idKey := searchcursor.KeysetKey{Column: `"RoutineTaskTable".id`, Type: "uuid"}
+	titleKey := searchcursor.KeysetKey{Column: `"RoutineTaskTable".title`, Type: "text"}
+	purposeKey := searchcursor.KeysetKey{Column: `"RoutineTaskTable".purpose`, Type: `"RoutineTaskPurpose"`}
+	priorityKey := searchcursor.KeysetKey{Column: `"RoutineTaskTable".priority`, Type: "integer"}
+	statusKey := searchcursor.KeysetKey{Column: `"RoutineTaskTable".status`, Type: `"RoutineTaskStatus"`}
+	attemptsKey := searchcursor.KeysetKey{Column: `"RoutineTaskTable".attempts`, Type: "integer"}
+	maxAttemptsKey := searchcursor.KeysetKey{Column: `"RoutineTaskTable".max_attempts`, Type: "integer"}
+	scheduledAtKey := searchcursor.KeysetKey{Column: `"RoutineTaskTable".scheduled_at`, Type: "timestamptz"}
+	actualStartedAtKey := searchcursor.KeysetKey{Column: `"RoutineTaskTable".actual_started_at`, Type: "timestamptz"}
+	actualEndedAtKey := searchcursor.KeysetKey{Column: `"RoutineTaskTable".actual_ended_at`, Type: "timestamptz"}
+	updatedAtKey := searchcursor.KeysetKey{Column: `"RoutineTaskTable".updated_at`, Type: "timestamptz"}
+	createdAtKey := searchcursor.KeysetKey{Column: `"RoutineTaskTable".created_at`, Type: "timestamptz"}
+
+	if gqlInput.SortBy == nil {
+		return searchcursor.NewKeyset("", isDescending, idKey)
+	}
+
+	switch *gqlInput.SortBy {
+	case gqlmodels.SearchRoutineTaskSortByTitle:
+		return searchcursor.NewKeyset(gqlmodels.SearchRoutineTaskSortByTitle.String(), isDescending, titleKey, scheduledAtKey, priorityKey, updatedAtKey, createdAtKey, idKey)
+	case gqlmodels.SearchRoutineTaskSortByPurpose:
+		return searchcursor.NewKeyset(gqlmodels.SearchRoutineTaskSortByPurpose.String(), isDescending, purposeKey, scheduledAtKey, priorityKey, updatedAtKey, createdAtKey, idKey)
+	case gqlmodels.SearchRoutineTaskSortByPriority:
+		return searchcursor.NewKeyset(gqlmodels.SearchRoutineTaskSortByPriority.String(), isDescending, priorityKey, scheduledAtKey, updatedAtKey, createdAtKey, idKey)
+	case gqlmodels.SearchRoutineTaskSortByStatus:
+		return searchcursor.NewKeyset(gqlmodels.SearchRoutineTaskSortByStatus.String(), isDescending, statusKey, scheduledAtKey, priorityKey, updatedAtKey, createdAtKey, idKey)
+	case gqlmodels.SearchRoutineTaskSortByAttempts:
+		return searchcursor.NewKeyset(gqlmodels.SearchRoutineTaskSortByAttempts.String(), isDescending, attemptsKey, scheduledAtKey, priorityKey, updatedAtKey, createdAtKey, idKey)
+	case gqlmodels.SearchRoutineTaskSortByMaxAttempts:
+		return searchcursor.NewKeyset(gqlmodels.SearchRoutineTaskSortByMaxAttempts.String(), isDescending, maxAttemptsKey, scheduledAtKey, priorityKey, updatedAtKey, createdAtKey, idKey)
+	case gqlmodels.SearchRoutineTaskSortByActualStartedAt:
+		return searchcursor.NewKeyset(gqlmodels.SearchRoutineTaskSortByActualStartedAt.String(), isDescending, actualStartedAtKey, scheduledAtKey, priorityKey, updatedAtKey, createdAtKey, idKey)
+	case gqlmodels.SearchRoutineTaskSortByActualEndedAt:
+		return searchcursor.NewKeyset(gqlmodels.SearchRoutineTaskSortByActualEndedAt.String(), isDescending, actualEndedAtKey, scheduledAtKey, priorityKey, updatedAtKey, createdAtKey, idKey)
+	case gqlmodels.SearchRoutineTaskSortByLastUpdate:
+		return searchcursor.NewKeyset(gqlmodels.SearchRoutineTaskSortByLastUpdate.String(), isDescending, updatedAtKey, scheduledAtKey, priorityKey, createdAtKey, idKey)
+	case gqlmodels.SearchRoutineTaskSortByCreatedAt:
+		return searchcursor.NewKeyset(gqlmodels.SearchRoutineTaskSortByCreatedAt.String(), isDescending, createdAtKey, scheduledAtKey, priorityKey, updatedAtKey, idKey)
+	default:
+		return searchcursor.NewKeyset(gqlmodels.SearchRoutineTaskSortByScheduledAt.String(), isDescending, scheduledAtKey, priorityKey, updatedAtKey, createdAtKey, idKey)
+	}
+}
+
 /* ============================== Service Methods for RoutineTask ============================== */
 
 /* ============================== Main Methods ============================== */
@@ -249,6 +300,7 @@ func (s *RoutineTaskService) GetMyRoutineTaskById(
 		Attempts:        routineTask.Attempts,
 		MaxAttempts:     routineTask.MaxAttempts,
 		Period:          routineTask.Period.ToContractable(),
+		RecurrenceRule:  routineTask.RecurrenceRule,
 		NextScheduledAt: routineTask.NextScheduledAt,
 		ScheduledAt:     routineTask.ScheduledAt,
 		ActualStartedAt: routineTask.ActualStartedAt,
@@ -303,6 +355,7 @@ func (s *RoutineTaskService) GetAllMyRoutineTasksByRoutineIds(
 			Attempts:        routineTask.Attempts,
 			MaxAttempts:     routineTask.MaxAttempts,
 			Period:          routineTask.Period.ToContractable(),
+			RecurrenceRule:  routineTask.RecurrenceRule,
 			NextScheduledAt: routineTask.NextScheduledAt,
 			ScheduledAt:     routineTask.ScheduledAt,
 			ActualStartedAt: routineTask.ActualStartedAt,
@@ -360,6 +413,7 @@ func (s *RoutineTaskService) GetAllMyRoutineTasks(
 			Attempts:        routineTask.Attempts,
 			MaxAttempts:     routineTask.MaxAttempts,
 			Period:          routineTask.Period.ToContractable(),
+			RecurrenceRule:  routineTask.RecurrenceRule,
 			NextScheduledAt: routineTask.NextScheduledAt,
 			ScheduledAt:     routineTask.ScheduledAt,
 			ActualStartedAt: routineTask.ActualStartedAt,
@@ -406,6 +460,7 @@ func (s *RoutineTaskService) CreateRoutineTaskByRoutineId(
 			Priority:        reqDto.Body.Priority,
 			MaxAttempts:     reqDto.Body.MaxAttempts,
 			Period:          (*enums.RoutinePeriod)(reqDto.Body.Period).ToStorable(),
+			RecurrenceRule:  reqDto.Body.RecurrenceRule,
 			NextScheduledAt: reqDto.Body.NextScheduledAt,
 		},
 		options.WithDB(db),
@@ -479,6 +534,7 @@ func (s *RoutineTaskService) UpdateMyRoutineTaskById(
 				Priority:        reqDto.Body.Values.Priority,
 				MaxAttempts:     reqDto.Body.Values.MaxAttempts,
 				Period:          (*enums.RoutinePeriod)(reqDto.Body.Values.Period).ToStorable(),
+				RecurrenceRule:  reqDto.Body.Values.RecurrenceRule,
 				NextScheduledAt: reqDto.Body.Values.NextScheduledAt,
 			},
 			SetNull: reqDto.Body.SetNull,
@@ -932,98 +988,13 @@ func (s *RoutineTaskService) SearchPrivateRoutineTasks(
 			"%"+gqlInput.Query+"%",
 		)
 	}
-	if gqlInput.After != nil && len(strings.ReplaceAll(*gqlInput.After, " ", "")) > 0 {
-		searchCursor, err := searchcursor.Decode[gqlmodels.SearchRoutineTaskCursorFields](*gqlInput.After)
-		if err != nil {
-			return nil, apiexceptions.NewSearchException().FailedToDecode().WithOrigin(err)
-		}
-
-		query = query.Where("id > ?", searchCursor.Fields.ID)
-	}
-
-	if gqlInput.SortBy != nil && gqlInput.SortOrder != nil {
-		var cending string = gqlmodels.SearchSortOrderAsc.String()
-		if *gqlInput.SortOrder == gqlmodels.SearchSortOrderDesc {
-			cending = gqlmodels.SearchSortOrderDesc.String()
-		}
-
-		switch *gqlInput.SortBy {
-		case gqlmodels.SearchRoutineTaskSortByTitle:
-			query = query.Order("title " + cending).
-				Order("scheduled_at " + cending).
-				Order("priority " + cending).
-				Order("updated_at " + cending).
-				Order("created_at " + cending)
-		case gqlmodels.SearchRoutineTaskSortByPurpose:
-			query = query.Order("purpose " + cending).
-				Order("scheduled_at " + cending).
-				Order("priority " + cending).
-				Order("updated_at " + cending).
-				Order("created_at " + cending)
-		case gqlmodels.SearchRoutineTaskSortByPriority:
-			query = query.Order("priority " + cending).
-				Order("scheduled_at " + cending).
-				Order("updated_at " + cending).
-				Order("created_at " + cending)
-		case gqlmodels.SearchRoutineTaskSortByStatus:
-			query = query.Order("status " + cending).
-				Order("scheduled_at " + cending).
-				Order("priority " + cending).
-				Order("updated_at " + cending).
-				Order("created_at " + cending)
-		case gqlmodels.SearchRoutineTaskSortByAttempts:
-			query = query.Order("attempts " + cending).
-				Order("scheduled_at " + cending).
-				Order("priority " + cending).
-				Order("updated_at " + cending).
-				Order("created_at " + cending)
-		case gqlmodels.SearchRoutineTaskSortByMaxAttempts:
-			query = query.Order("max_attempts " + cending).
-				Order("scheduled_at " + cending).
-				Order("priority " + cending).
-				Order("updated_at " + cending).
-				Order("created_at " + cending)
-		case gqlmodels.SearchRoutineTaskSortByScheduledAt:
-			query = query.Order("scheduled_at " + cending).
-				Order("priority " + cending).
-				Order("updated_at " + cending).
-				Order("created_at " + cending)
-		case gqlmodels.SearchRoutineTaskSortByActualStartedAt:
-			query = query.Order("actual_started_at " + cending).
-				Order("scheduled_at " + cending).
-				Order("priority " + cending).
-				Order("updated_at " + cending).
-				Order("created_at " + cending)
-		case gqlmodels.SearchRoutineTaskSortByActualEndedAt:
-			query = query.Order("actual_ended_at " + cending).
-				Order("scheduled_at " + cending).
-				Order("priority " + cending).
-				Order("updated_at " + cending).
-				Order("created_at " + cending)
-		case gqlmodels.SearchRoutineTaskSortByLastUpdate:
-			query = query.Order("updated_at " + cending).
-				Order("scheduled_at " + cending).
-				Order("priority " + cending).
-				Order("created_at " + cending)
-		case gqlmodels.SearchRoutineTaskSortByCreatedAt:
-			query = query.Order("created_at " + cending).
-				Order("scheduled_at " + cending).
-				Order("priority " + cending).
-				Order("updated_at " + cending)
-		default:
-			query = query.Order("scheduled_at " + cending).
-				Order("priority " + cending).
-				Order("updated_at " + cending).
-				Order("created_at " + cending)
-		}
-	}
-
-	limit := constants.DefaultSearchLimit
-	if gqlInput.First != nil && *gqlInput.First > 0 {
-		limit = int(*gqlInput.First)
+	keyset := newRoutineTaskSearchKeyset(gqlInput)
+	page, err := keyset.NewPage(gqlInput.After, gqlInput.Before, gqlInput.First, gqlInput.Last, constants.DefaultSearchLimit, constants.MaxSearchLimit)
+	if err != nil {
+		return nil, apiexceptions.NewSearchException().FailedToDecode().WithOrigin(err)
 	}
-	limit = min(limit, constants.MaxSearchLimit)
-	query = query.Limit(limit + 1)
+	query = query.Scopes(scopes.PaginateByKeyset(keyset, page.AfterCursor, page.BeforeCursor, page.IsBackward)).
+		Limit(page.Limit + 1)
 
 	var routineTasks []PrivateRoutineTask
 	if err := query.Scopes(s.routineTaskScope.IncludePreloads(
@@ -1032,32 +1003,52 @@ func (s *RoutineTaskService) SearchPrivateRoutineTasks(
 		return nil, apiexceptions.NewRoutineTaskException().NotFound().WithOrigin(err)
 	}
 
-	hasNextPage := len(routineTasks) > limit
+	routineTasks, hasNextPage, hasPreviousPage := searchcursor.Slice(page, routineTasks)
+
 	searchEdges := make([]*gqlmodels.SearchRoutineTaskEdge, len(routineTasks))
 
 	for index, routineTask := range routineTasks {
-		searchCursor := searchcursor.SearchCursor[gqlmodels.SearchRoutineTaskCursorFields]{
-			Fields: gqlmodels.SearchRoutineTaskCursorFields{
-				ID: routineTask.Id,
-			},
+		var sortValues []any
+		switch keyset.SortBy {
+		case gqlmodels.SearchRoutineTaskSortByTitle.String():
+			sortValues = []any{routineTask.Title, routineTask.ScheduledAt, routineTask.Priority, routineTask.UpdatedAt, routineTask.CreatedAt, routineTask.Id}
+		case gqlmodels.SearchRoutineTaskSortByPurpose.String():
+			sortValues = []any{routineTask.Purpose, routineTask.ScheduledAt, routineTask.Priority, routineTask.UpdatedAt, routineTask.CreatedAt, routineTask.Id}
+		case gqlmodels.SearchRoutineTaskSortByPriority.String():
+			sortValues = []any{routineTask.Priority, routineTask.ScheduledAt, routineTask.UpdatedAt, routineTask.CreatedAt, routineTask.Id}
+		case gqlmodels.SearchRoutineTaskSortByStatus.String():
+			sortValues = []any{routineTask.Status, routineTask.ScheduledAt, routineTask.Priority, routineTask.UpdatedAt, routineTask.CreatedAt, routineTask.Id}
+		case gqlmodels.SearchRoutineTaskSortByAttempts.String():
+			sortValues = []any{routineTask.Attempts, routineTask.ScheduledAt, routineTask.Priority, routineTask.UpdatedAt, routineTask.CreatedAt, routineTask.Id}
+		case gqlmodels.SearchRoutineTaskSortByMaxAttempts.String():
+			sortValues = []any{routineTask.MaxAttempts, routineTask.ScheduledAt, routineTask.Priority, routineTask.UpdatedAt, routineTask.CreatedAt, routineTask.Id}
+		case gqlmodels.SearchRoutineTaskSortByActualStartedAt.String():
+			sortValues = []any{routineTask.ActualStartedAt, routineTask.ScheduledAt, routineTask.Priority, routineTask.UpdatedAt, routineTask.CreatedAt, routineTask.Id}
+		case gqlmodels.SearchRoutineTaskSortByActualEndedAt.String():
+			sortValues = []any{routineTask.ActualEndedAt, routineTask.ScheduledAt, routineTask.Priority, routineTask.UpdatedAt, routineTask.CreatedAt, routineTask.Id}
+		case gqlmodels.SearchRoutineTaskSortByLastUpdate.String():
+			sortValues = []any{routineTask.UpdatedAt, routineTask.ScheduledAt, routineTask.Priority, routineTask.CreatedAt, routineTask.Id}
+		case gqlmodels.SearchRoutineTaskSortByCreatedAt.String():
+			sortValues = []any{routineTask.CreatedAt, routineTask.ScheduledAt, routineTask.Priority, routineTask.UpdatedAt, routineTask.Id}
+		case gqlmodels.SearchRoutineTaskSortByScheduledAt.String():
+			sortValues = []any{routineTask.ScheduledAt, routineTask.Priority, routineTask.UpdatedAt, routineTask.CreatedAt, routineTask.Id}
+		default:
+			sortValues = []any{routineTask.Id}
 		}
-		encodedSearchCursor, err := searchCursor.Encode()
+		encodedSearchCursor, err := keyset.Encode(sortValues...)
 		if err != nil {
 			return nil, apiexceptions.NewSearchException().FailedToEncode().WithOrigin(err)
 		}
-		if encodedSearchCursor == nil {
-			return nil, apiexceptions.NewSearchException().FailedToUnmarshalSearchCursor()
-		}
 
 		searchEdges[index] = &gqlmodels.SearchRoutineTaskEdge{
-			EncodedSearchCursor: *encodedSearchCursor,
+			EncodedSearchCursor: encodedSearchCursor,
 			Node:                routineTask.RoutineTask.ToPrivateRoutineTask(),
 		}
 	}
 
 	searchPageInfo := &gqlmodels.SearchPageInfo{
 		HasNextPage:     hasNextPage,
-		HasPreviousPage: gqlInput.After != nil && len(strings.ReplaceAll(*gqlInput.After, " ", "")) > 0,
+		HasPreviousPage: hasPreviousPage,
 	}
 
 	if len(searchEdges) > 0 {
@@ -1066,9 +1057,6 @@ func (s *RoutineTaskService) SearchPrivateRoutineTasks(
 	}
 
 	searchTime := float64(time.Since(startTime).Nanoseconds()) / 1e6
-	if hasNextPage {
-		searchEdges = searchEdges[:limit]
-	}
 
 	return &gqlmodels.SearchRoutineTaskConnection{
 		SearchEdges:    searchEdges,
@@ -1142,13 +1130,19 @@ func (s *RoutineTaskService) ClaimRoutineTasks(
 		CostUnit    int64     `gorm:"column:cost_unit;"`
 		Priority    int32     `gorm:"column:priority;"`
 		ScheduledAt time.Time `gorm:"column:scheduled_at;"`
+		// the fields below are only used to advance the tasks with recurrence rules
+		NextScheduledAt   time.Time  `gorm:"column:next_scheduled_at;"`
+		RecurrenceRule    *string    `gorm:"column:recurrence_rule;"`
+		RecurrenceStartAt *time.Time `gorm:"column:recurrence_start_at;"`
+		Timezone          string     `gorm:"column:timezone;"`
 	}
 
 	now := time.Now().UTC()
 	var claimableRoutineTasks []claimableRoutineTask
 	result = tx.
 		Model(&schemas.RoutineTask{}).
-		Select("id, actor_user_id, cost_unit, priority, scheduled_at").
+		Select(`id, actor_user_id, cost_unit, priority, scheduled_at, next_scheduled_at, recurrence_rule, recurrence_start_at,
+			(SELECT timezone FROM "RoutineTable" WHERE "RoutineTable".id = "RoutineTaskTable".routine_id) AS timezone`).
 		Where("status = ?", enums.RoutineTaskStatus_Idle).
 		Where("scheduled_at <= ?", now).
 		Where("attempts < max_attempts").
@@ -1306,6 +1300,32 @@ func (s *RoutineTaskService) ClaimRoutineTasks(
 		).WithOrigin(result.Error)
 	}
 
+	recurringRoutineTasks := make([]recurringRoutineTask, 0, len(claimableRoutineTasks))
+	for _, routineTask := range claimableRoutineTasks {
+		if routineTask.RecurrenceRule == nil {
+			continue
+		}
+		recurringRoutineTasks = append(recurringRoutineTasks, recurringRoutineTask{
+			Id:                routineTask.Id,
+			ScheduledAt:       routineTask.ScheduledAt,
+			NextScheduledAt:   routineTask.NextScheduledAt,
+			RecurrenceRule:    *routineTask.RecurrenceRule,
+			RecurrenceStartAt: routineTask.RecurrenceStartAt,
+			Timezone:          routineTask.Timezone,
+		})
+	}
+	if err := advanceRecurringRoutineTasks(ctx, tx, recurringRoutineTasks, now); err != nil {
+		tx.Rollback()
+		return nil, exceptions.New(
+			"ClaimFailed",
+			"RoutineTask",
+			"Claim",
+			"Failed to advance the recurrence of claimed routine tasks",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(err)
+	}
+
 	var claimedRoutineTasks []schemas.RoutineTask
 	result = tx.
 		Model(&schemas.RoutineTask{}).
@@ -1502,7 +1522,12 @@ func (s *RoutineTaskService) MarkCompletedRoutineTasks(
 	result = tx.Model(&schemas.RoutineTask{}).
 		Where("id IN ? AND status = ?", taskIds, enums.RoutineTaskStatus_Running).
 		Updates(map[string]any{
-			"status":          enums.RoutineTaskStatus_Idle,
+			// the tasks whose recurrence rules have no occurrence after this run are paused
+			"status": gorm.Expr(
+				"CASE WHEN recurrence_rule IS NOT NULL AND scheduled_at <= actual_started_at THEN ? ELSE ? END",
+				enums.RoutineTaskStatus_Pause,
+				enums.RoutineTaskStatus_Idle,
+			),
 			"attempts":        0,
 			"actual_ended_at": now,
 			"updated_at":      now,
@@ -1644,8 +1669,94 @@ func (s *RoutineTaskService) MarkFailedRoutineTasks(
 		tx.Rollback()
 		return exceptions.New("ResultStateMismatch", "RoutineTaskRecord", "MarkFailedRoutineTasks", "Routine task record failure count does not match the claimed batch", http.StatusConflict, true)
 	}
+	if err := repositories.NewOutboxEventRepository().EnqueueRoutineTasksFailed(
+		tx,
+		eventId.String(),
+		recordIds,
+	); err != nil {
+		tx.Rollback()
+		return exceptions.New("FailedToEnqueueFailureEvent", "RoutineTask", "MarkFailedRoutineTasks", "Failed to enqueue the routine task failure notifications", http.StatusInternalServerError, true).WithOrigin(err)
+	}
 	if err := tx.Commit().Error; err != nil {
 		return apiexceptions.NewRoutineTaskException().FailedToCommitTransaction().WithOrigin(err)
 	}
 	return nil
 }
+
+/* ============================== Recurrence Helpers ============================== */
+
+type recurringRoutineTask struct {
+	Id                uuid.UUID
+	ScheduledAt       time.Time
+	NextScheduledAt   time.Time
+	RecurrenceRule    string
+	RecurrenceStartAt *time.Time
+	Timezone          string
+}
+
+// advanceRecurringRoutineTasks moves the claimed tasks with recurrence rules to their next occurrences
+// in the timezones of their routines, the missed occurrences before now are coalesced into the current run,
+// and the tasks whose rules are exhausted keep their scheduled_at so that they will be paused on completion,
+// so do the tasks whose rules or timezones cannot be parsed, which are logged since their routines are broken
+func advanceRecurringRoutineTasks(ctx context.Context, tx *gorm.DB, routineTasks []recurringRoutineTask, now time.Time) error {
+	placeholders := make([]string, 0, len(routineTasks))
+	args := make([]any, 0, len(routineTasks)*2)
+	for _, routineTask := range routineTasks {
+		rule, err := recurrence.Parse(routineTask.RecurrenceRule)
+		if err != nil {
+			logUnparsableRecurringRoutineTask(ctx, routineTask, "Failed to parse the recurrence rule of RoutineTask", err)
+			continue
+		}
+		location, err := time.LoadLocation(routineTask.Timezone)
+		if err != nil {
+			logUnparsableRecurringRoutineTask(ctx, routineTask, "Failed to load the timezone of RoutineTask", err)
+			continue
+		}
+
+		start := routineTask.NextScheduledAt
+		if routineTask.RecurrenceStartAt != nil {
+			start = *routineTask.RecurrenceStartAt
+		}
+		after := now
+		if routineTask.ScheduledAt.After(after) {
+			after = routineTask.ScheduledAt
+		}
+		if routineTask.NextScheduledAt.After(after) {
+			after = routineTask.NextScheduledAt
+		}
+
+		nextScheduledAt, ok := rule.Next(start, after, location)
+		if !ok {
+			continue
+		}
+		placeholders = append(placeholders, "(?::uuid, ?::timestamptz)")
+		args = append(args, routineTask.Id, nextScheduledAt.UTC())
+	}
+	if len(placeholders) == 0 {
+		return nil
+	}
+
+	sql := fmt.Sprintf(`
+		UPDATE "RoutineTaskTable" AS rt
+		SET
+			scheduled_at = v.next_scheduled_at,
+			next_scheduled_at = v.next_scheduled_at
+		FROM (VALUES %s) AS v(id, next_scheduled_at)
+		WHERE rt.id = v.id
+	`, strings.Join(placeholders, ", "))
+	return tx.Exec(sql, args...).Error
+}
+
+func logUnparsableRecurringRoutineTask(ctx context.Context, routineTask recurringRoutineTask, message string, err error) {
+	if logs.NotegicLogger == nil {
+		return
+	}
+	logs.NotegicLogger.Warn(
+		ctx,
+		message,
+		attribute.String("routine_task.id", routineTask.Id.String()),
+		attribute.String("routine_task.recurrence_rule", routineTask.RecurrenceRule),
+		attribute.String("routine_task.timezone", routineTask.Timezone),
+		attribute.String("error.message", err.Error()),
+	)
+}
@@ -236,6 +236,32 @@ func (s *StationService) saveMyStationPermission(
 	}, nil
 }
 
+// newStationSearchKeyset builds the keyset of the requested sort order
+func newStationSearchKeyset(gqlInput gqlmodels.SearchStationInput) *searchcursor.Keyset {
+	isDescending := gqlInput.SortOrder != nil && *gqlInput.SortOrder == gqlmodels.SearchSortOrderDesc
+
+	idKey := searchcursor.KeysetKey{Column: `"StationTable".id`, Type: "uuid"}
+	nameKey := searchcursor.KeysetKey{Column: `"StationTable".name`, Type: "text"}
+	routineCountKey := searchcursor.KeysetKey{Column: `"StationTable".routine_count`, Type: "bigint"}
+	updatedAtKey := searchcursor.KeysetKey{Column: `"StationTable".updated_at`, Type: "timestamptz"}
+	createdAtKey := searchcursor.KeysetKey{Column: `"StationTable".created_at`, Type: "timestamptz"}
+
+	if gqlInput.SortBy == nil {
+		return searchcursor.NewKeyset("", isDescending, idKey)
+	}
+
+	switch *gqlInput.SortBy {
+	case gqlmodels.SearchStationSortByRoutineCount:
+		return searchcursor.NewKeyset(gqlmodels.SearchStationSortByRoutineCount.String(), isDescending, routineCountKey, nameKey, updatedAtKey, createdAtKey, idKey)
+	case gqlmodels.SearchStationSortByLastUpdate:
+		return searchcursor.NewKeyset(gqlmodels.SearchStationSortByLastUpdate.String(), isDescending, updatedAtKey, nameKey, routineCountKey, createdAtKey, idKey)
+	case gqlmodels.SearchStationSortByCreatedAt:
+		return searchcursor.NewKeyset(gqlmodels.SearchStationSortByCreatedAt.String(), isDescending, createdAtKey, nameKey, routineCountKey, updatedAtKey, idKey)
+	default:
+		return searchcursor.NewKeyset(gqlmodels.SearchStationSortByName.String(), isDescending, nameKey, routineCountKey, updatedAtKey, createdAtKey, idKey)
+	}
+}
+
 /* ============================== Service Methods for Station ============================== */
 
 func (s *StationService) GetMyStationById(
@@ -2148,63 +2174,20 @@ func (s *StationService) SearchPrivateStations(
 			"%"+gqlInput.Query+"%",
 		)
 	}
-	if gqlInput.After != nil && len(strings.ReplaceAll(*gqlInput.After, " ", "")) > 0 {
-		searchCursor, err := searchcursor.Decode[gqlmodels.SearchStationCursorFields](*gqlInput.After)
-		if err != nil {
-			return nil, exceptions.New(
-				"CursorDecodeFailed",
-				"Search",
-				"SearchPrivateStations",
-				"Failed to decode the search cursor",
-				http.StatusInternalServerError,
-				true,
-			).WithOrigin(err)
-		}
-
-		query = query.Where("id > ?", searchCursor.Fields.ID)
-	}
-
-	if gqlInput.SortBy != nil && gqlInput.SortOrder != nil {
-		var cending string = gqlmodels.SearchSortOrderAsc.String()
-		if *gqlInput.SortOrder == gqlmodels.SearchSortOrderDesc {
-			cending = gqlmodels.SearchSortOrderDesc.String()
-		}
-
-		switch *gqlInput.SortBy {
-		case gqlmodels.SearchStationSortByName:
-			query = query.Order("name " + cending).
-				Order("routine_count " + cending).
-				Order("updated_at " + cending).
-				Order("created_at " + cending)
-		case gqlmodels.SearchStationSortByRoutineCount:
-			query = query.Order("routine_count " + cending).
-				Order("name " + cending).
-				Order("updated_at " + cending).
-				Order("created_at " + cending)
-		case gqlmodels.SearchStationSortByLastUpdate:
-			query = query.Order("updated_at " + cending).
-				Order("name " + cending).
-				Order("routine_count " + cending).
-				Order("created_at " + cending)
-		case gqlmodels.SearchStationSortByCreatedAt:
-			query = query.Order("created_at " + cending).
-				Order("name " + cending).
-				Order("routine_count " + cending).
-				Order("updated_at " + cending)
-		default:
-			query = query.Order("name " + cending).
-				Order("routine_count " + cending).
-				Order("updated_at " + cending).
-				Order("created_at " + cending)
-		}
-	}
-
-	limit := constants.DefaultSearchLimit
-	if gqlInput.First != nil && *gqlInput.First > 0 {
-		limit = int(*gqlInput.First)
+	keyset := newStationSearchKeyset(gqlInput)
+	page, err := keyset.NewPage(gqlInput.After, gqlInput.Before, gqlInput.First, gqlInput.Last, constants.DefaultSearchLimit, constants.MaxSearchLimit)
+	if err != nil {
+		return nil, exceptions.New(
+			"CursorDecodeFailed",
+			"Search",
+			"SearchPrivateStations",
+			"Failed to decode the search cursor",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(err)
 	}
-	limit = min(limit, constants.MaxSearchLimit)
-	query = query.Limit(limit + 1)
+	query = query.Scopes(scopes.PaginateByKeyset(keyset, page.AfterCursor, page.BeforeCursor, page.IsBackward)).
+		Limit(page.Limit + 1)
 
 	var stations []PrivateStation
 	if err := query.Find(&stations).Error; err != nil {
@@ -2217,16 +2200,25 @@ func (s *StationService) SearchPrivateStations(
 		).WithOrigin(err)
 	}
 
-	hasNextPage := len(stations) > limit
+	stations, hasNextPage, hasPreviousPage := searchcursor.Slice(page, stations)
+
 	searchEdges := make([]*gqlmodels.SearchStationEdge, len(stations))
 
 	for index, station := range stations {
-		searchCursor := searchcursor.SearchCursor[gqlmodels.SearchStationCursorFields]{
-			Fields: gqlmodels.SearchStationCursorFields{
-				ID: station.Id,
-			},
+		var sortValues []any
+		switch keyset.SortBy {
+		case gqlmodels.SearchStationSortByName.String():
+			sortValues = []any{station.Name, station.RoutineCount, station.UpdatedAt, station.CreatedAt, station.Id}
+		case gqlmodels.SearchStationSortByRoutineCount.String():
+			sortValues = []any{station.RoutineCount, station.Name, station.UpdatedAt, station.CreatedAt, station.Id}
+		case gqlmodels.SearchStationSortByLastUpdate.String():
+			sortValues = []any{station.UpdatedAt, station.Name, station.RoutineCount, station.CreatedAt, station.Id}
+		case gqlmodels.SearchStationSortByCreatedAt.String():
+			sortValues = []any{station.CreatedAt, station.Name, station.RoutineCount, station.UpdatedAt, station.Id}
+		default:
+			sortValues = []any{station.Id}
 		}
-		encodedSearchCursor, err := searchCursor.Encode()
+		encodedSearchCursor, err := keyset.Encode(sortValues...)
 		if err != nil {
 			return nil, exceptions.New(
 				"CursorEncodeFailed",
@@ -2237,26 +2229,16 @@ func (s *StationService) SearchPrivateStations(
 				true,
 			).WithOrigin(err)
 		}
-		if encodedSearchCursor == nil {
-			return nil, exceptions.New(
-				"CursorEncodingFailed",
-				"Search",
-				"SearchPrivateStations",
-				"Failed to encode the search cursor",
-				http.StatusInternalServerError,
-				true,
-			)
-		}
 
 		searchEdges[index] = &gqlmodels.SearchStationEdge{
-			EncodedSearchCursor: *encodedSearchCursor,
+			EncodedSearchCursor: encodedSearchCursor,
 			Node:                station.Station.ToPrivateSearchableStation(station.Permission),
 		}
 	}
 
 	searchPageInfo := &gqlmodels.SearchPageInfo{
 		HasNextPage:     hasNextPage,
-		HasPreviousPage: gqlInput.After != nil && len(strings.ReplaceAll(*gqlInput.After, " ", "")) > 0,
+		HasPreviousPage: hasPreviousPage,
 	}
 
 	if len(searchEdges) > 0 {
@@ -2265,9 +2247,6 @@ func (s *StationService) SearchPrivateStations(
 	}
 
 	searchTime := float64(time.Since(startTime).Nanoseconds()) / 1e6
-	if hasNextPage {
-		searchEdges = searchEdges[:limit]
-	}
 
 	return &gqlmodels.SearchStationConnection{
 		SearchEdges:    searchEdges,
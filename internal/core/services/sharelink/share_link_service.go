@@ -0,0 +1,352 @@
+package sharelink
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	validator "github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+	sharedtokens "github.com/HiIamJeff67/notegic-backend/shared/tokens"
+	types "github.com/HiIamJeff67/notegic-backend/shared/types"
+
+	apicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/share-links"
+
+	contexts "github.com/HiIamJeff67/notegic-backend/internal/core/contexts"
+	inputs "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/inputs"
+	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
+	repositories "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/repositories"
+	schemas "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas"
+	enums "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/enums"
+	apiexceptions "github.com/HiIamJeff67/notegic-backend/internal/core/exceptions"
+)
+
+// only the managers of a root shelf can share it with the people outside of it
+var shareLinkManagerPermissions = []enums.AccessControlPermission{
+	enums.AccessControlPermission_Admin,
+	enums.AccessControlPermission_Owner,
+}
+
+type ShareLinkServiceInterface interface {
+	CreateMyShareLink(ctx context.Context, requestDto *apicontract.CreateMyShareLinkRequestDto) (*apicontract.CreateMyShareLinkResponseDto, *exceptions.Exception)
+	GetMyShareLinksByRootShelfId(ctx context.Context, requestDto *apicontract.GetMyShareLinksByRootShelfIdRequestDto) (*apicontract.GetMyShareLinksByRootShelfIdResponseDto, *exceptions.Exception)
+	RevokeMyShareLinkById(ctx context.Context, requestDto *apicontract.RevokeMyShareLinkByIdRequestDto) (*apicontract.RevokeMyShareLinkByIdResponseDto, *exceptions.Exception)
+	ResolveShareLink(ctx context.Context, requestDto *apicontract.ResolveShareLinkRequestDto) (*apicontract.ResolveShareLinkResponseDto, *exceptions.Exception)
+}
+
+type ShareLinkService struct {
+	validator           *validator.Validate
+	db                  *gorm.DB
+	shareLinkRepository repositories.ShareLinkRepositoryInterface
+	rootShelfRepository repositories.RootShelfRepositoryInterface
+	userRepository      repositories.UserRepositoryInterface
+	outboxRepository    repositories.OutboxEventRepositoryInterface
+}
+
+func NewShareLinkService(
+	validator *validator.Validate,
+	db *gorm.DB,
+	shareLinkRepository repositories.ShareLinkRepositoryInterface,
+	rootShelfRepository repositories.RootShelfRepositoryInterface,
+	userRepository repositories.UserRepositoryInterface,
+	outboxRepository repositories.OutboxEventRepositoryInterface,
+) ShareLinkServiceInterface {
+	return &ShareLinkService{
+		validator:           validator,
+		db:                  db,
+		shareLinkRepository: shareLinkRepository,
+		rootShelfRepository: rootShelfRepository,
+		userRepository:      userRepository,
+		outboxRepository:    outboxRepository,
+	}
+}
+
+/* ============================== Auxiliary Functions ============================== */
+
+func newShareLinkResponseDto(shareLink schemas.ShareLink) apicontract.ShareLinkResponseDto {
+	return apicontract.ShareLinkResponseDto{
+		Id:                 shareLink.Id,
+		RootShelfId:        shareLink.RootShelfId,
+		BlockPackId:        shareLink.BlockPackId,
+		Permission:         string(shareLink.Permission),
+		IsPasswordRequired: shareLink.PasswordHash != nil,
+		MaxUseCount:        shareLink.MaxUseCount,
+		UseCount:           shareLink.UseCount,
+		ExpiresAt:          shareLink.ExpiresAt,
+		RevokedAt:          shareLink.RevokedAt,
+		LastUsedAt:         shareLink.LastUsedAt,
+		CreatorId:          shareLink.CreatorId,
+		CreatedAt:          shareLink.CreatedAt,
+	}
+}
+
+func (s *ShareLinkService) checkManagerPermission(
+	db *gorm.DB,
+	rootShelfId uuid.UUID,
+	userId uuid.UUID,
+) *exceptions.Exception {
+	_, _, exception := s.rootShelfRepository.CheckPermissionAndGetOneById(
+		rootShelfId,
+		userId,
+		nil,
+		shareLinkManagerPermissions,
+		options.WithDB(db),
+		options.WithOnlyDeleted(types.Ternary_Negative),
+	)
+
+	return exception
+}
+
+/* ============================== Service Methods for ShareLink ============================== */
+
+func (s *ShareLinkService) CreateMyShareLink(
+	ctx context.Context,
+	requestDto *apicontract.CreateMyShareLinkRequestDto,
+) (*apicontract.CreateMyShareLinkResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(requestDto); err != nil {
+		return nil, apiexceptions.NewShareLinkException().InvalidDto().WithOrigin(err)
+	}
+	if requestDto.Body.ExpiresAt != nil && !requestDto.Body.ExpiresAt.After(time.Now()) {
+		return nil, apiexceptions.NewShareLinkException().InvalidInput("The expiration of the share link should be in the future")
+	}
+
+	db := s.db.WithContext(ctx)
+
+	actorUserId, exception := contexts.GetActorUserId(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+	if exception := s.checkManagerPermission(db, requestDto.Body.RootShelfId, actorUserId); exception != nil {
+		return nil, exception
+	}
+	if requestDto.Body.BlockPackId != nil {
+		var blockPackCount int64
+		result := db.Model(&schemas.BlockPack{}).
+			Joins(`INNER JOIN "SubShelfTable" ss ON "BlockPackTable".parent_sub_shelf_id = ss.id`).
+			Where(`"BlockPackTable".id = ? AND ss.root_shelf_id = ?`, *requestDto.Body.BlockPackId, requestDto.Body.RootShelfId).
+			Where(`"BlockPackTable".deleted_at IS NULL`).
+			Count(&blockPackCount)
+		if result.Error != nil || blockPackCount == 0 {
+			return nil, apiexceptions.NewBlockPackException().NotFound().WithOrigin(result.Error)
+		}
+	}
+
+	var passwordHash *string
+	if requestDto.Body.Password != nil {
+		bytes, err := bcrypt.GenerateFromPassword([]byte(*requestDto.Body.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, exceptions.New(
+				"FailedToGenerateHashValue",
+				"ShareLink",
+				"Hash",
+				"Failed to generate a hash value",
+				http.StatusInternalServerError,
+				true,
+			).WithOrigin(err)
+		}
+		hash := string(bytes)
+		passwordHash = &hash
+	}
+
+	token, tokenHash, err := sharedtokens.GenerateShareLinkToken()
+	if err != nil {
+		return nil, apiexceptions.NewShareLinkException().FailedToCreate().WithOrigin(err)
+	}
+	shareLink, exception := s.shareLinkRepository.CreateOne(
+		actorUserId,
+		inputs.CreateShareLinkInput{
+			TokenHash:    tokenHash,
+			RootShelfId:  requestDto.Body.RootShelfId,
+			BlockPackId:  requestDto.Body.BlockPackId,
+			Permission:   enums.AccessControlPermission(requestDto.Body.Permission),
+			PasswordHash: passwordHash,
+			MaxUseCount:  requestDto.Body.MaxUseCount,
+			ExpiresAt:    requestDto.Body.ExpiresAt,
+		},
+		options.WithDB(db),
+	)
+	if exception != nil {
+		return nil, exception
+	}
+
+	return &apicontract.CreateMyShareLinkResponseDto{
+		ShareLinkResponseDto: newShareLinkResponseDto(*shareLink),
+		Token:                token,
+	}, nil
+}
+
+func (s *ShareLinkService) GetMyShareLinksByRootShelfId(
+	ctx context.Context,
+	requestDto *apicontract.GetMyShareLinksByRootShelfIdRequestDto,
+) (*apicontract.GetMyShareLinksByRootShelfIdResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(requestDto); err != nil {
+		return nil, apiexceptions.NewShareLinkException().InvalidDto().WithOrigin(err)
+	}
+
+	db := s.db.WithContext(ctx)
+
+	actorUserId, exception := contexts.GetActorUserId(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+	if exception := s.checkManagerPermission(db, requestDto.Param.RootShelfId, actorUserId); exception != nil {
+		return nil, exception
+	}
+
+	shareLinks, exception := s.shareLinkRepository.GetManyByRootShelfId(
+		requestDto.Param.RootShelfId,
+		options.WithDB(db),
+	)
+	if exception != nil {
+		return nil, exception
+	}
+
+	responseDto := make(apicontract.GetMyShareLinksByRootShelfIdResponseDto, 0, len(shareLinks))
+	for _, shareLink := range shareLinks {
+		responseDto = append(responseDto, newShareLinkResponseDto(shareLink))
+	}
+
+	return &responseDto, nil
+}
+
+func (s *ShareLinkService) RevokeMyShareLinkById(
+	ctx context.Context,
+	requestDto *apicontract.RevokeMyShareLinkByIdRequestDto,
+) (*apicontract.RevokeMyShareLinkByIdResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(requestDto); err != nil {
+		return nil, apiexceptions.NewShareLinkException().InvalidDto().WithOrigin(err)
+	}
+
+	db := s.db.WithContext(ctx)
+
+	actorUserId, exception := contexts.GetActorUserId(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+	if exception := s.checkManagerPermission(db, requestDto.Param.RootShelfId, actorUserId); exception != nil {
+		return nil, exception
+	}
+
+	tx := db.Begin()
+	shareLink, exception := s.shareLinkRepository.RevokeOneById(
+		requestDto.Param.ShareLinkId,
+		requestDto.Param.RootShelfId,
+		time.Now(),
+		options.WithTransactionDB(tx),
+	)
+	if exception != nil {
+		tx.Rollback()
+		return nil, exception
+	}
+	// the realtime connections of the guests are closed by the event, while the
+	// share sessions are rejected by ShareLinkMiddleware on their next request
+	if err := s.outboxRepository.EnqueueShareLinkRevoked(
+		tx,
+		shareLink.Id.String(),
+		shareLink.Id,
+		shareLink.RootShelfId,
+	); err != nil {
+		tx.Rollback()
+		return nil, exceptions.New(
+			"FailedToCreate",
+			"Outbox",
+			"RevokeMyShareLinkById",
+			"Failed to create share link revocation event",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
+		return nil, apiexceptions.NewShareLinkException().FailedToCommitTransaction().WithOrigin(err)
+	}
+
+	responseDto := newShareLinkResponseDto(*shareLink)
+	return &responseDto, nil
+}
+
+// ResolveShareLink is called anonymously, so every failure after the lookup of the
+// token is reported as the same inactive exception, except the password failures.
+func (s *ShareLinkService) ResolveShareLink(
+	ctx context.Context,
+	requestDto *apicontract.ResolveShareLinkRequestDto,
+) (*apicontract.ResolveShareLinkResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(requestDto); err != nil {
+		return nil, apiexceptions.NewShareLinkException().InvalidDto().WithOrigin(err)
+	}
+	if err := sharedtokens.ValidateShareLinkTokenFormat(requestDto.Body.Token); err != nil {
+		return nil, apiexceptions.NewShareLinkException().Inactive().WithOrigin(err)
+	}
+
+	db := s.db.WithContext(ctx)
+	now := time.Now()
+
+	shareLink, exception := s.shareLinkRepository.GetOneByTokenHash(
+		sharedtokens.HashShareLinkToken(requestDto.Body.Token),
+		options.WithDB(db),
+	)
+	if exception != nil {
+		return nil, apiexceptions.NewShareLinkException().Inactive().WithOrigin(exception)
+	}
+	if !shareLink.IsActive(now) {
+		return nil, apiexceptions.NewShareLinkException().Inactive()
+	}
+	if shareLink.PasswordHash != nil {
+		if requestDto.Body.Password == nil {
+			return nil, apiexceptions.NewShareLinkException().PasswordRequired()
+		}
+		if bcrypt.CompareHashAndPassword([]byte(*shareLink.PasswordHash), []byte(*requestDto.Body.Password)) != nil {
+			return nil, apiexceptions.NewShareLinkException().PasswordIncorrect()
+		}
+	}
+	// the share link stops working once its creator can no longer manage the root shelf
+	if exception := s.checkManagerPermission(db, shareLink.RootShelfId, shareLink.CreatorId); exception != nil {
+		return nil, apiexceptions.NewShareLinkException().Inactive().WithOrigin(exception)
+	}
+	creator, exception := s.userRepository.GetOneById(shareLink.CreatorId, nil, options.WithDB(db))
+	if exception != nil {
+		return nil, apiexceptions.NewShareLinkException().Inactive().WithOrigin(exception)
+	}
+
+	claims := sharedtokens.ShareSessionTokenClaims{
+		ShareLinkId: shareLink.Id.String(),
+		RootShelfId: shareLink.RootShelfId.String(),
+		Permission:  sharedtokens.ShareLinkPermissionRead,
+		UserAgent:   requestDto.Header.UserAgent,
+	}
+	if shareLink.BlockPackId != nil {
+		claims.BlockPackId = shareLink.BlockPackId.String()
+	}
+	shareSessionToken, expiresAt, err := sharedtokens.GenerateShareSessionToken(
+		creator.PublicId.String(),
+		shareLink.ExpiresAt,
+		claims,
+	)
+	if err != nil {
+		return nil, exceptions.New(
+			"GenerationFailed",
+			"ShareLink",
+			"Resolve",
+			"Failed to generate the share session token",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(err)
+	}
+	// consume the use at last, so a failed resolution never uses up the share link
+	if exception := s.shareLinkRepository.ConsumeOneById(shareLink.Id, now, options.WithDB(db)); exception != nil {
+		return nil, exception
+	}
+
+	return &apicontract.ResolveShareLinkResponseDto{
+		ShareSessionToken: *shareSessionToken,
+		ExpiresAt:         expiresAt,
+		ShareLinkId:       shareLink.Id,
+		RootShelfId:       shareLink.RootShelfId,
+		BlockPackId:       shareLink.BlockPackId,
+		Permission:        string(shareLink.Permission),
+	}, nil
+}
@@ -19,30 +19,41 @@ import (
 
 	contexts "github.com/HiIamJeff67/notegic-backend/internal/core/contexts"
 	data "github.com/HiIamJeff67/notegic-backend/internal/core/data/database"
+	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
+	repositories "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/repositories"
 	schemas "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas"
 	enums "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/enums"
 	scopes "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/scopes"
 )
 
+const (
+	defaultTrashPageSize = 50
+	maxTrashPageSize     = 200
+)
+
 type ItemServiceInterface interface {
 	SearchPrivateItems(ctx context.Context, userId uuid.UUID, gqlInput gqlmodels.SearchItemInput) (*gqlmodels.SearchItemConnection, *exceptions.Exception)
+	GetMyTrash(ctx context.Context, userId uuid.UUID, gqlInput gqlmodels.GetMyTrashInput) (*gqlmodels.MyTrash, *exceptions.Exception)
 }
 
 type ItemService struct {
-	db        *gorm.DB
-	itemScope scopes.ItemScopeInterface
+	db              *gorm.DB
+	itemScope       scopes.ItemScopeInterface
+	trashRepository repositories.TrashRepositoryInterface
 }
 
 func NewItemService(
 	db *gorm.DB,
 	itemScope scopes.ItemScopeInterface,
+	trashRepository repositories.TrashRepositoryInterface,
 ) ItemServiceInterface {
 	if db == nil {
 		db = data.DB
 	}
 	return &ItemService{
-		db:        db,
-		itemScope: itemScope,
+		db:              db,
+		itemScope:       itemScope,
+		trashRepository: trashRepository,
 	}
 }
 
@@ -97,54 +108,20 @@ func (s *ItemService) SearchPrivateItems(
 			"%"+gqlInput.Query+"%",
 		)
 	}
-	if gqlInput.After != nil && len(strings.ReplaceAll(*gqlInput.After, " ", "")) > 0 {
-		searchCursor, err := searchcursor.Decode[gqlmodels.SearchItemCursorFields](*gqlInput.After)
-		if err != nil {
-			return nil, exceptions.New(
-				"CursorDecodeFailed",
-				"Search",
-				"SearchPrivateItems",
-				"Failed to decode the search cursor",
-				http.StatusInternalServerError,
-				true,
-			).WithOrigin(err)
-		}
-
-		query = query.Where("id > ?", searchCursor.Fields.ID)
-	}
-
-	if gqlInput.SortBy != nil && gqlInput.SortOrder != nil {
-		var cending string = gqlmodels.SearchSortOrderAsc.String()
-		if *gqlInput.SortOrder == gqlmodels.SearchSortOrderDesc {
-			cending = gqlmodels.SearchSortOrderDesc.String()
-		}
-
-		switch *gqlInput.SortBy {
-		case gqlmodels.SearchItemSortByType:
-			query = query.Order("type " + cending).
-				Order("updated_at " + cending).
-				Order("created_at " + cending)
-		case gqlmodels.SearchItemSortByLastUpdate:
-			query = query.Order("updated_at " + cending).
-				Order("type " + cending).
-				Order("created_at " + cending)
-		case gqlmodels.SearchItemSortByCreatedAt:
-			query = query.Order("created_at " + cending).
-				Order("type " + cending).
-				Order("updated_at " + cending)
-		default:
-			query = query.Order("type " + cending).
-				Order("updated_at " + cending).
-				Order("created_at " + cending)
-		}
-	}
-
-	limit := constants.DefaultSearchLimit
-	if gqlInput.First != nil && *gqlInput.First > 0 {
-		limit = int(*gqlInput.First)
+	keyset := newItemSearchKeyset(gqlInput)
+	page, err := keyset.NewPage(gqlInput.After, gqlInput.Before, gqlInput.First, gqlInput.Last, constants.DefaultSearchLimit, constants.MaxSearchLimit)
+	if err != nil {
+		return nil, exceptions.New(
+			"CursorDecodeFailed",
+			"Search",
+			"SearchPrivateItems",
+			"Failed to decode the search cursor",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(err)
 	}
-	limit = min(limit, constants.MaxSearchLimit)
-	query = query.Limit(limit + 1)
+	query = query.Scopes(scopes.PaginateByKeyset(keyset, page.AfterCursor, page.BeforeCursor, page.IsBackward)).
+		Limit(page.Limit + 1)
 
 	var items []PrivateItem
 	if err := query.Preload(
@@ -167,16 +144,23 @@ func (s *ItemService) SearchPrivateItems(
 		).WithOrigin(err)
 	}
 
-	hasNextPage := len(items) > limit
+	items, hasNextPage, hasPreviousPage := searchcursor.Slice(page, items)
+
 	searchEdges := make([]*gqlmodels.SearchItemEdge, len(items))
 
 	for index, item := range items {
-		searchCursor := searchcursor.SearchCursor[gqlmodels.SearchItemCursorFields]{
-			Fields: gqlmodels.SearchItemCursorFields{
-				ID: item.Id,
-			},
+		var sortValues []any
+		switch keyset.SortBy {
+		case gqlmodels.SearchItemSortByType.String():
+			sortValues = []any{item.Type, item.UpdatedAt, item.CreatedAt, item.Id}
+		case gqlmodels.SearchItemSortByLastUpdate.String():
+			sortValues = []any{item.UpdatedAt, item.Type, item.CreatedAt, item.Id}
+		case gqlmodels.SearchItemSortByCreatedAt.String():
+			sortValues = []any{item.CreatedAt, item.Type, item.UpdatedAt, item.Id}
+		default:
+			sortValues = []any{item.Id, item.Type}
 		}
-		encodedSearchCursor, err := searchCursor.Encode()
+		encodedSearchCursor, err := keyset.Encode(sortValues...)
 		if err != nil {
 			return nil, exceptions.New(
 				"CursorEncodeFailed",
@@ -187,26 +171,16 @@ func (s *ItemService) SearchPrivateItems(
 				true,
 			).WithOrigin(err)
 		}
-		if encodedSearchCursor == nil {
-			return nil, exceptions.New(
-				"CursorEncodingFailed",
-				"Search",
-				"SearchPrivateItems",
-				"Failed to encode the search cursor",
-				http.StatusInternalServerError,
-				true,
-			)
-		}
 
 		searchEdges[index] = &gqlmodels.SearchItemEdge{
-			EncodedSearchCursor: *encodedSearchCursor,
+			EncodedSearchCursor: encodedSearchCursor,
 			Node:                item.Item.ToPrivateItem(),
 		}
 	}
 
 	searchPageInfo := &gqlmodels.SearchPageInfo{
 		HasNextPage:     hasNextPage,
-		HasPreviousPage: false,
+		HasPreviousPage: hasPreviousPage,
 	}
 
 	if len(searchEdges) > 0 {
@@ -215,9 +189,6 @@ func (s *ItemService) SearchPrivateItems(
 	}
 
 	searchTime := float64(time.Since(startTime).Nanoseconds()) / 1e6
-	if hasNextPage {
-		searchEdges = searchEdges[:limit]
-	}
 
 	return &gqlmodels.SearchItemConnection{
 		SearchEdges:    searchEdges,
@@ -226,3 +197,110 @@ func (s *ItemService) SearchPrivateItems(
 		SearchTime:     searchTime,
 	}, nil
 }
+
+/* ============================== Service Methods for GraphQL Trash ============================== */
+
+// GetMyTrash lists the soft deleted shelves and items owned by the user together with the
+// time each of them is purged, they can be restored by the restore methods of their types
+func (s *ItemService) GetMyTrash(
+	ctx context.Context, userId uuid.UUID, gqlInput gqlmodels.GetMyTrashInput,
+) (*gqlmodels.MyTrash, *exceptions.Exception) {
+	first, offset := int32(defaultTrashPageSize), int32(0)
+	if gqlInput.First != nil {
+		first = *gqlInput.First
+	}
+	if gqlInput.Offset != nil {
+		offset = *gqlInput.Offset
+	}
+	if first < 1 || first > maxTrashPageSize || offset < 0 {
+		return nil, exceptions.New(
+			"InvalidRequest",
+			"Item",
+			"GetMyTrash",
+			"Trash request is invalid",
+			http.StatusBadRequest,
+		)
+	}
+
+	itemTypes := make([]string, 0, len(gqlInput.Types))
+	for _, itemType := range gqlInput.Types {
+		if !itemType.IsValid() {
+			return nil, exceptions.New(
+				"InvalidRequest",
+				"Item",
+				"GetMyTrash",
+				"Trash item type is invalid",
+				http.StatusBadRequest,
+			)
+		}
+		itemTypes = append(itemTypes, itemType.String())
+	}
+
+	db := s.db.WithContext(ctx)
+
+	retentionDays, exception := s.trashRepository.GetRetentionDaysByOwnerId(
+		ctx,
+		userId,
+		options.WithDB(db),
+	)
+	if exception != nil {
+		return nil, exception
+	}
+
+	trashedItems, totalCount, exception := s.trashRepository.GetManyByOwnerId(
+		ctx,
+		userId,
+		itemTypes,
+		int(first),
+		int(offset),
+		options.WithDB(db),
+	)
+	if exception != nil {
+		return nil, exception
+	}
+
+	items := make([]*gqlmodels.TrashItem, 0, len(trashedItems))
+	for _, trashedItem := range trashedItems {
+		items = append(items, &gqlmodels.TrashItem{
+			ID:               trashedItem.Id,
+			Type:             gqlmodels.TrashItemType(trashedItem.Type),
+			Name:             trashedItem.Name,
+			RootShelfID:      trashedItem.RootShelfId,
+			ParentSubShelfID: trashedItem.ParentSubShelfId,
+			Size:             trashedItem.Size,
+			DeletedAt:        trashedItem.DeletedAt,
+			PurgeAt:          trashedItem.PurgeAt,
+		})
+	}
+
+	return &gqlmodels.MyTrash{
+		Items:         items,
+		TotalCount:    int32(totalCount),
+		RetentionDays: retentionDays,
+	}, nil
+}
+
+/* ============================== Auxiliary Functions ============================== */
+
+// newItemSearchKeyset builds the keyset of the requested sort order, where the type is also a tie-breaker since the primary key is (id, type)
+func newItemSearchKeyset(gqlInput gqlmodels.SearchItemInput) *searchcursor.Keyset {
+	isDescending := gqlInput.SortOrder != nil && *gqlInput.SortOrder == gqlmodels.SearchSortOrderDesc
+
+	idKey := searchcursor.KeysetKey{Column: `"ItemTable".id`, Type: "uuid"}
+	typeKey := searchcursor.KeysetKey{Column: `"ItemTable".type`, Type: `"ItemType"`}
+	updatedAtKey := searchcursor.KeysetKey{Column: `"ItemTable".updated_at`, Type: "timestamptz"}
+	createdAtKey := searchcursor.KeysetKey{Column: `"ItemTable".created_at`, Type: "timestamptz"}
+
+	if gqlInput.SortBy == nil {
+		return searchcursor.NewKeyset("", isDescending, idKey, typeKey)
+	}
+
+	switch *gqlInput.SortBy {
+	case gqlmodels.SearchItemSortByLastUpdate:
+		return searchcursor.NewKeyset(gqlmodels.SearchItemSortByLastUpdate.String(), isDescending, updatedAtKey, typeKey, createdAtKey, idKey)
+	case gqlmodels.SearchItemSortByCreatedAt:
+		return searchcursor.NewKeyset(gqlmodels.SearchItemSortByCreatedAt.String(), isDescending, createdAtKey, typeKey, updatedAtKey, idKey)
+	default:
+		return searchcursor.NewKeyset(gqlmodels.SearchItemSortByType.String(), isDescending, typeKey, updatedAtKey, createdAtKey, idKey)
+	}
+}
@@ -0,0 +1,1252 @@
+package shelves
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	validator "github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+	constants "github.com/HiIamJeff67/notegic-backend/shared/constants"
+
+	editableblock "github.com/HiIamJeff67/notegic-backend/shared/util/editableblock"
+	shelfarchive "github.com/HiIamJeff67/notegic-backend/shared/util/shelfarchive"
+
+	blockpackcontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/block-packs"
+	apicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/root-shelves"
+	coreeventscontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/events"
+	notificationtypescontract "github.com/HiIamJeff67/notegic-backend/contracts/notification/v1/types"
+	blocknote "github.com/HiIamJeff67/notegic-backend/contracts/types/blocknote"
+	enumcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
+
+	logs "github.com/HiIamJeff67/notegic-backend/shared/platform/observability/logs"
+
+	contexts "github.com/HiIamJeff67/notegic-backend/internal/core/contexts"
+	data "github.com/HiIamJeff67/notegic-backend/internal/core/data/database"
+	inputs "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/inputs"
+	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
+	repositories "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/repositories"
+	schemas "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas"
+	enums "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/enums"
+	storage "github.com/HiIamJeff67/notegic-backend/internal/core/data/storage"
+	apiexceptions "github.com/HiIamJeff67/notegic-backend/internal/core/exceptions"
+)
+
+const (
+	maxRootShelfArchiveJobAttempts int32 = 3
+	rootShelfArchiveContentType          = "application/zip"
+)
+
+// the progress milestones which are reported through the notification runtime,
+// the completion is always reported separately with the result of the job
+var rootShelfArchiveProgressMilestones = []int32{25, 50, 75}
+
+var rootShelfArchiveLimits = shelfarchive.Limits{
+	MaxEntryCount:       constants.MaxRootShelfArchiveEntryCount,
+	MaxUncompressedSize: constants.MaxRootShelfArchiveUncompressedSize.ToInt64(),
+	MaxSubShelfDepth:    constants.MaxSubShelfPathLength,
+}
+
+type RootShelfArchiveYjsDocumentInitializer interface {
+	InitializeDocuments(
+		context.Context,
+		[]blockpackcontract.InitializeBlockPackYjsDocumentReqDto,
+	) ([]blockpackcontract.InitializeBlockPackYjsDocumentResDto, error)
+}
+
+type RootShelfArchiveServiceInterface interface {
+	ExportMyRootShelfArchiveById(ctx context.Context, requestDto *apicontract.ExportMyRootShelfArchiveByIdRequestDto) (*apicontract.ExportMyRootShelfArchiveByIdResponseDto, *exceptions.Exception)
+	CreateRootShelfFromArchive(ctx context.Context, requestDto *apicontract.CreateRootShelfFromArchiveRequestDto) (*apicontract.CreateRootShelfFromArchiveResponseDto, *exceptions.Exception)
+	GetMyRootShelfArchiveJobById(ctx context.Context, requestDto *apicontract.GetMyRootShelfArchiveJobByIdRequestDto) (*apicontract.GetMyRootShelfArchiveJobByIdResponseDto, *exceptions.Exception)
+
+	ProcessNextArchiveJob(ctx context.Context, claimTimeout time.Duration) (bool, *exceptions.Exception)
+}
+
+type RootShelfArchiveService struct {
+	validator                     *validator.Validate
+	db                            *gorm.DB
+	storage                       storage.StorageInterface
+	yjsDocumentInitializer        RootShelfArchiveYjsDocumentInitializer
+	rootShelfRepository           repositories.RootShelfRepositoryInterface
+	rootShelfArchiveJobRepository repositories.RootShelfArchiveJobRepositoryInterface
+	outboxEventRepository         repositories.OutboxEventRepositoryInterface
+	storageKeySalt                string
+}
+
+func NewRootShelfArchiveService(
+	validator *validator.Validate,
+	db *gorm.DB,
+	storage storage.StorageInterface,
+	yjsDocumentInitializer RootShelfArchiveYjsDocumentInitializer,
+	rootShelfRepository repositories.RootShelfRepositoryInterface,
+	rootShelfArchiveJobRepository repositories.RootShelfArchiveJobRepositoryInterface,
+	outboxEventRepository repositories.OutboxEventRepositoryInterface,
+	storageKeySalt string,
+) RootShelfArchiveServiceInterface {
+	if db == nil {
+		db = data.DB
+	}
+	return &RootShelfArchiveService{
+		validator:                     validator,
+		db:                            db,
+		storage:                       storage,
+		yjsDocumentInitializer:        yjsDocumentInitializer,
+		rootShelfRepository:           rootShelfRepository,
+		rootShelfArchiveJobRepository: rootShelfArchiveJobRepository,
+		outboxEventRepository:         outboxEventRepository,
+		storageKeySalt:                storageKeySalt,
+	}
+}
+
+/* ============================== Auxiliary Types ============================== */
+
+type rootShelfArchiveQuota struct {
+	MaxRootShelfCount            int32 `gorm:"column:max_root_shelf_count"`
+	MaxBlockPackCount            int32 `gorm:"column:max_block_pack_count"`
+	MaxBlockCount                int32 `gorm:"column:max_block_count"`
+	MaxMaterialCount             int32 `gorm:"column:max_material_count"`
+	MaxSubShelfCountPerRootShelf int32 `gorm:"column:max_sub_shelf_count_per_root_shelf"`
+	MaxItemCountPerRootShelf     int32 `gorm:"column:max_item_count_per_root_shelf"`
+	MaxBlockCountPerBlockPack    int32 `gorm:"column:max_block_count_per_block_pack"`
+	MaxMaterialSize              int64 `gorm:"column:max_material_size"`
+	RootShelfCount               int64 `gorm:"column:root_shelf_count"`
+	BlockPackCount               int64 `gorm:"column:block_pack_count"`
+	BlockCount                   int64 `gorm:"column:block_count"`
+	MaterialCount                int64 `gorm:"column:material_count"`
+}
+
+type importedBlockPack struct {
+	blockPack       schemas.BlockPack
+	arborizedBlocks []blocknote.ArborizedEditableBlock
+	flattenedBlocks []blocknote.RawFlattenedEditableBlock
+}
+
+type importedMaterial struct {
+	material schemas.Material
+	object   *storage.Object
+}
+
+// rootShelfArchiveProgress keeps the progress of the running job,
+// so that the progress is only written once its percentage is changed
+type rootShelfArchiveProgress struct {
+	job                  *schemas.RootShelfArchiveJob
+	ownerPublicId        uuid.UUID
+	processedItemCount   int64
+	totalItemCount       int64
+	lastWrittenPercent   int32
+	lastNotifiedProgress int32
+}
+
+/* ============================== Auxiliary Functions ============================== */
+
+func (s *RootShelfArchiveService) getArchiveKey(ownerPublicId uuid.UUID, jobId uuid.UUID) string {
+	return s.storage.GetKey(ownerPublicId.String(), "root-shelf-archive:"+jobId.String(), s.storageKeySalt)
+}
+
+func calculateRootShelfArchiveProgress(processedItemCount int64, totalItemCount int64) int32 {
+	if totalItemCount <= 0 {
+		return 0
+	}
+	return int32(min(processedItemCount*100/totalItemCount, 100))
+}
+
+func (s *RootShelfArchiveService) getOwnerPublicId(ctx context.Context, ownerId uuid.UUID) (uuid.UUID, *exceptions.Exception) {
+	publicIds := []uuid.UUID{}
+	result := s.db.WithContext(ctx).
+		Model(&schemas.User{}).
+		Where("id = ?", ownerId).
+		Limit(1).
+		Pluck("public_id", &publicIds)
+	if result.Error != nil || len(publicIds) == 0 {
+		return uuid.Nil, apiexceptions.NewUserException().NotFound().WithOrigin(result.Error)
+	}
+	return publicIds[0], nil
+}
+
+func (s *RootShelfArchiveService) enqueueArchiveNotification(
+	tx *gorm.DB,
+	ownerPublicId uuid.UUID,
+	notificationType coreeventscontract.NotificationType,
+	priority coreeventscontract.NotificationPriority,
+	templateKey string,
+	payload any,
+	dedupeKey string,
+) error {
+	encodedPayload, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	return s.outboxEventRepository.EnqueueNotificationRequested(
+		tx,
+		uuid.NewString(),
+		coreeventscontract.NotificationRequestedData{
+			RecipientUserPublicId: ownerPublicId,
+			Type:                  notificationType,
+			Priority:              priority,
+			TemplateKey:           templateKey,
+			TemplateVersion:       1,
+			Payload:               encodedPayload,
+			DedupeKey:             dedupeKey,
+		},
+	)
+}
+
+func describeRootShelfArchiveJob(jobType enums.RootShelfArchiveJobType) string {
+	if jobType == enums.RootShelfArchiveJobType_Import {
+		return "import"
+	}
+	return "export"
+}
+
+// reportProgress writes the progress of the job once its percentage is changed,
+// and notifies the owner whenever a milestone is passed for the first time
+func (s *RootShelfArchiveService) reportProgress(
+	ctx context.Context,
+	progress *rootShelfArchiveProgress,
+	processedItemCount int64,
+) *exceptions.Exception {
+	progress.processedItemCount = min(processedItemCount, progress.totalItemCount)
+	percent := calculateRootShelfArchiveProgress(progress.processedItemCount, progress.totalItemCount)
+	if percent == progress.lastWrittenPercent {
+		return nil
+	}
+	progress.lastWrittenPercent = percent
+
+	var reachedMilestone int32
+	for _, milestone := range rootShelfArchiveProgressMilestones {
+		if percent >= milestone && milestone > progress.lastNotifiedProgress {
+			reachedMilestone = milestone
+		}
+	}
+
+	tx := s.db.WithContext(ctx).Begin()
+	progressInput := inputs.UpdateRootShelfArchiveJobProgressInput{
+		ProcessedItemCount: progress.processedItemCount,
+		TotalItemCount:     progress.totalItemCount,
+	}
+	if reachedMilestone > 0 {
+		progressInput.LastNotifiedProgress = &reachedMilestone
+	}
+	if exception := s.rootShelfArchiveJobRepository.UpdateProgressById(
+		progress.job.Id,
+		progressInput,
+		options.WithTransactionDB(tx),
+	); exception != nil {
+		tx.Rollback()
+		return exception
+	}
+
+	if reachedMilestone > 0 {
+		jobDescription := describeRootShelfArchiveJob(progress.job.Type)
+		if err := s.enqueueArchiveNotification(
+			tx,
+			progress.ownerPublicId,
+			coreeventscontract.NotificationType_News,
+			coreeventscontract.NotificationPriority_Low,
+			notificationtypescontract.TemplateKey_News,
+			notificationtypescontract.NewsPayload{
+				Title:   fmt.Sprintf("Root shelf %s in progress", jobDescription),
+				Summary: fmt.Sprintf("Your root shelf %s is %d%% done.", jobDescription, reachedMilestone),
+				Body:    fmt.Sprintf("%d of %d items have been processed.", progress.processedItemCount, progress.totalItemCount),
+			},
+			fmt.Sprintf("root-shelf-archive:%s:%d", progress.job.Id, reachedMilestone),
+		); err != nil {
+			tx.Rollback()
+			return apiexceptions.NewRootShelfArchiveJobException().FailedToUpdate().WithOrigin(err)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
+		return apiexceptions.NewRootShelfArchiveJobException().FailedToCommitTransaction().WithOrigin(err)
+	}
+	if reachedMilestone > 0 {
+		progress.lastNotifiedProgress = reachedMilestone
+	}
+
+	return nil
+}
+
+func (s *RootShelfArchiveService) completeArchiveJob(
+	ctx context.Context,
+	job *schemas.RootShelfArchiveJob,
+	ownerPublicId uuid.UUID,
+) *exceptions.Exception {
+	jobDescription := describeRootShelfArchiveJob(job.Type)
+	body := "The imported root shelf is ready in your library."
+	if job.Type == enums.RootShelfArchiveJobType_Export {
+		body = "The archive is ready to be downloaded from the archive job."
+	}
+
+	tx := s.db.WithContext(ctx).Begin()
+	if exception := s.rootShelfArchiveJobRepository.MarkSucceededById(
+		job.Id,
+		time.Now().UTC(),
+		options.WithTransactionDB(tx),
+	); exception != nil {
+		tx.Rollback()
+		return exception
+	}
+	if err := s.enqueueArchiveNotification(
+		tx,
+		ownerPublicId,
+		coreeventscontract.NotificationType_News,
+		coreeventscontract.NotificationPriority_Normal,
+		notificationtypescontract.TemplateKey_News,
+		notificationtypescontract.NewsPayload{
+			Title:   fmt.Sprintf("Root shelf %s completed", jobDescription),
+			Summary: fmt.Sprintf("Your root shelf %s has completed.", jobDescription),
+			Body:    body,
+		},
+		fmt.Sprintf("root-shelf-archive:%s:completed", job.Id),
+	); err != nil {
+		tx.Rollback()
+		return apiexceptions.NewRootShelfArchiveJobException().FailedToUpdate().WithOrigin(err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
+		return apiexceptions.NewRootShelfArchiveJobException().FailedToCommitTransaction().WithOrigin(err)
+	}
+
+	return nil
+}
+
+func (s *RootShelfArchiveService) failArchiveJob(
+	ctx context.Context,
+	job *schemas.RootShelfArchiveJob,
+	ownerPublicId uuid.UUID,
+	cause *exceptions.Exception,
+) *exceptions.Exception {
+	jobDescription := describeRootShelfArchiveJob(job.Type)
+	// only the public message is exposed, since the job is readable by its owner
+	publicMessage := cause.ToPublic().Message
+
+	tx := s.db.WithContext(ctx).Begin()
+	if exception := s.rootShelfArchiveJobRepository.MarkFailedById(
+		job.Id,
+		publicMessage,
+		time.Now().UTC(),
+		options.WithTransactionDB(tx),
+	); exception != nil {
+		tx.Rollback()
+		return exception
+	}
+	if ownerPublicId != uuid.Nil {
+		if err := s.enqueueArchiveNotification(
+			tx,
+			ownerPublicId,
+			coreeventscontract.NotificationType_Warning,
+			coreeventscontract.NotificationPriority_High,
+			notificationtypescontract.TemplateKey_Warning,
+			notificationtypescontract.WarningPayload{
+				Title:   fmt.Sprintf("Root shelf %s failed", jobDescription),
+				Message: publicMessage,
+				Details: map[string]any{"archiveJobId": job.Id.String()},
+			},
+			fmt.Sprintf("root-shelf-archive:%s:failed", job.Id),
+		); err != nil {
+			tx.Rollback()
+			return apiexceptions.NewRootShelfArchiveJobException().FailedToUpdate().WithOrigin(err)
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
+		return apiexceptions.NewRootShelfArchiveJobException().FailedToCommitTransaction().WithOrigin(err)
+	}
+
+	return nil
+}
+
+func (s *RootShelfArchiveService) exportArchive(
+	ctx context.Context,
+	job *schemas.RootShelfArchiveJob,
+	progress *rootShelfArchiveProgress,
+) *exceptions.Exception {
+	if job.SourceRootShelfId == nil {
+		return apiexceptions.NewShelfException().NotFound()
+	}
+	db := s.db.WithContext(ctx)
+
+	var rootShelf schemas.RootShelf
+	if err := db.Model(&schemas.RootShelf{}).
+		Where("id = ? AND deleted_at IS NULL", *job.SourceRootShelfId).
+		First(&rootShelf).Error; err != nil {
+		return apiexceptions.NewShelfException().NotFound().WithOrigin(err)
+	}
+
+	var subShelves []schemas.SubShelf
+	if err := db.Model(&schemas.SubShelf{}).
+		Where("root_shelf_id = ? AND deleted_at IS NULL", rootShelf.Id).
+		Order("cardinality(path) ASC").
+		Order("created_at ASC").
+		Find(&subShelves).Error; err != nil {
+		return apiexceptions.NewShelfException().NotFound().WithOrigin(err)
+	}
+	subShelfIds := make([]uuid.UUID, len(subShelves))
+	for index, subShelf := range subShelves {
+		subShelfIds[index] = subShelf.Id
+	}
+
+	blockPacks := []schemas.BlockPack{}
+	materials := []schemas.Material{}
+	if len(subShelfIds) > 0 {
+		if err := db.Model(&schemas.BlockPack{}).
+			Where("parent_sub_shelf_id IN ? AND deleted_at IS NULL", subShelfIds).
+			Order("created_at ASC").
+			Find(&blockPacks).Error; err != nil {
+			return apiexceptions.NewBlockPackException().NotFound().WithOrigin(err)
+		}
+		if err := db.Model(&schemas.Material{}).
+			Where("parent_sub_shelf_id IN ? AND deleted_at IS NULL", subShelfIds).
+			Order("created_at ASC").
+			Find(&materials).Error; err != nil {
+			return apiexceptions.NewMaterialException().NotFound().WithOrigin(err)
+		}
+	}
+
+	progress.totalItemCount = int64(len(subShelves) + len(blockPacks) + len(materials))
+	sourceRootShelfId := rootShelf.Id
+	if exception := s.rootShelfArchiveJobRepository.UpdateProgressById(
+		job.Id,
+		inputs.UpdateRootShelfArchiveJobProgressInput{
+			TotalItemCount:    progress.totalItemCount,
+			SourceRootShelfId: &sourceRootShelfId,
+		},
+		options.WithDB(db),
+	); exception != nil {
+		return exception
+	}
+
+	buffer := &bytes.Buffer{}
+	writer := shelfarchive.NewWriter(buffer, shelfarchive.RootShelfEntry{
+		Id:   rootShelf.Id,
+		Name: rootShelf.Name,
+	}, time.Now())
+
+	processedItemCount := int64(0)
+	for _, subShelf := range subShelves {
+		writer.AddSubShelf(shelfarchive.SubShelfEntry{
+			Id:             subShelf.Id,
+			Name:           subShelf.Name,
+			PrevSubShelfId: subShelf.PrevSubShelfId,
+			Path:           subShelf.Path,
+		})
+		processedItemCount++
+	}
+	if exception := s.reportProgress(ctx, progress, processedItemCount); exception != nil {
+		return exception
+	}
+
+	for _, blockPack := range blockPacks {
+		var blocks []schemas.Block
+		if err := db.Model(&schemas.Block{}).
+			Where("block_pack_id = ?", blockPack.Id).
+			Order("created_at ASC").
+			Order("id ASC").
+			Find(&blocks).Error; err != nil {
+			return apiexceptions.NewBlockException().NotFound().WithOrigin(err)
+		}
+
+		flattenedBlocks := make([]blocknote.RawFlattenedEditableBlock, len(blocks))
+		for index, block := range blocks {
+			flattenedBlocks[index] = blocknote.RawFlattenedEditableBlock{
+				Id:            block.Id,
+				ParentBlockId: block.ParentBlockId,
+				PrevBlockId:   block.PrevBlockId,
+				NextBlockId:   block.NextBlockId,
+				Type:          enumcontract.BlockType(block.Type),
+				Props:         json.RawMessage(block.Props),
+				Content:       json.RawMessage(block.Content),
+			}
+		}
+		arborizedBlocks, err := editableblock.ArborizeEditableBlocks(flattenedBlocks)
+		if err != nil {
+			return apiexceptions.NewBlockPackException().InvalidType(blockPack.Id).WithOrigin(err)
+		}
+
+		var icon *string
+		if blockPack.Icon != nil {
+			iconString := blockPack.Icon.String()
+			icon = &iconString
+		}
+		if err := writer.AddBlockPack(shelfarchive.BlockPackEntry{
+			Id:                  blockPack.Id,
+			ParentSubShelfId:    blockPack.ParentSubShelfId,
+			Name:                blockPack.Name,
+			Icon:                icon,
+			HeaderBackgroundURL: blockPack.HeaderBackgroundURL,
+			BlockCount:          int64(len(blocks)),
+		}, arborizedBlocks); err != nil {
+			return apiexceptions.NewRootShelfArchiveJobException().FailedToMarshalData(blockPack.Id).WithOrigin(err)
+		}
+
+		processedItemCount++
+		if exception := s.reportProgress(ctx, progress, processedItemCount); exception != nil {
+			return exception
+		}
+	}
+
+	for _, material := range materials {
+		content := []byte{}
+		// a material without any size has never been saved, so there is no object of it in the storage
+		if material.Size > 0 {
+			reader, _, err := s.storage.GetObjectByKey(ctx, material.ContentKey, &storage.GetOptions{})
+			if err != nil {
+				return apiexceptions.NewStorageException().FailedToReadObjectBytes().WithOrigin(err)
+			}
+			content, err = io.ReadAll(reader)
+			reader.Close()
+			if err != nil {
+				return apiexceptions.NewStorageException().FailedToReadObjectBytes().WithOrigin(err)
+			}
+		}
+
+		if err := writer.AddMaterial(shelfarchive.MaterialEntry{
+			Id:               material.Id,
+			ParentSubShelfId: material.ParentSubShelfId,
+			Name:             material.Name,
+			ContentType:      material.ContentType.String(),
+			ParseMediaType:   material.ParseMediaType,
+		}, content); err != nil {
+			return apiexceptions.NewRootShelfArchiveJobException().FailedToMarshalData(material.Id).WithOrigin(err)
+		}
+
+		processedItemCount++
+		if exception := s.reportProgress(ctx, progress, processedItemCount); exception != nil {
+			return exception
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return apiexceptions.NewRootShelfArchiveJobException().FailedToMarshalData(job.Id).WithOrigin(err)
+	}
+	archiveSize := int64(buffer.Len())
+	if archiveSize > constants.MaxRootShelfArchiveSize.ToInt64() {
+		return apiexceptions.NewRootShelfArchiveJobException().ArchiveTooLarge(archiveSize, constants.MaxRootShelfArchiveSize.ToInt64())
+	}
+
+	object, err := s.storage.NewObject(job.ArchiveKey, buffer, archiveSize)
+	if err != nil {
+		return apiexceptions.NewStorageException().FailedToReadObjectBytes().WithOrigin(err)
+	}
+	if err := s.storage.PutObjectByKey(ctx, job.ArchiveKey, object); err != nil {
+		return apiexceptions.NewStorageException().FailedToPutObject(job.ArchiveKey).WithOrigin(err)
+	}
+
+	if exception := s.rootShelfArchiveJobRepository.UpdateProgressById(
+		job.Id,
+		inputs.UpdateRootShelfArchiveJobProgressInput{
+			ProcessedItemCount: progress.processedItemCount,
+			TotalItemCount:     progress.totalItemCount,
+			ArchiveSize:        &archiveSize,
+		},
+		options.WithDB(db),
+	); exception != nil {
+		return exception
+	}
+
+	return nil
+}
+
+// checkImportQuota checks the plan limitations of the owner of the target root shelf before anything is created,
+// the accounting triggers still enforce the same limitations while the rows are inserted
+func (s *RootShelfArchiveService) checkImportQuota(
+	db *gorm.DB,
+	quotaOwnerId uuid.UUID,
+	targetRootShelf *schemas.RootShelf,
+	manifest *shelfarchive.Manifest,
+	importedBlockCount int64,
+) *exceptions.Exception {
+	var quota rootShelfArchiveQuota
+	result := db.
+		Model(&schemas.User{}).
+		Select(`"PlanLimitationTable".max_root_shelf_count, "PlanLimitationTable".max_block_pack_count, `+
+			`"PlanLimitationTable".max_block_count, "PlanLimitationTable".max_material_count, `+
+			`"PlanLimitationTable".max_sub_shelf_count_per_root_shelf, "PlanLimitationTable".max_item_count_per_root_shelf, `+
+			`"PlanLimitationTable".max_block_count_per_block_pack, "PlanLimitationTable".max_material_size, `+
+			`"UserAccountTable".root_shelf_count, "UserAccountTable".block_pack_count, `+
+			`"UserAccountTable".block_count, "UserAccountTable".material_count`).
+		Joins(`INNER JOIN "PlanLimitationTable" ON "PlanLimitationTable".key = "UserTable".plan`).
+		Joins(`INNER JOIN "UserAccountTable" ON "UserAccountTable".user_id = "UserTable".id`).
+		Where(`"UserTable".id = ?`, quotaOwnerId).
+		Scan(&quota)
+	if result.Error != nil {
+		return apiexceptions.NewRootShelfArchiveJobException().FailedToCreate().WithOrigin(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apiexceptions.NewUserException().NotFound()
+	}
+
+	var existingSubShelfCount, existingItemCount int64
+	newRootShelfCount := int64(1)
+	if targetRootShelf != nil {
+		existingSubShelfCount, existingItemCount = targetRootShelf.SubShelfCount, targetRootShelf.ItemCount
+		newRootShelfCount = 0
+	}
+	blockPackCount := int64(len(manifest.BlockPacks))
+	materialCount := int64(len(manifest.Materials))
+
+	for _, check := range []struct {
+		resource string
+		count    int64
+		maxCount int64
+	}{
+		{"root shelves", quota.RootShelfCount + newRootShelfCount, int64(quota.MaxRootShelfCount)},
+		{"sub shelves in the root shelf", existingSubShelfCount + int64(len(manifest.SubShelves)), int64(quota.MaxSubShelfCountPerRootShelf)},
+		{"items in the root shelf", existingItemCount + blockPackCount + materialCount, int64(quota.MaxItemCountPerRootShelf)},
+		{"block packs", quota.BlockPackCount + blockPackCount, int64(quota.MaxBlockPackCount)},
+		{"blocks", quota.BlockCount + importedBlockCount, int64(quota.MaxBlockCount)},
+		{"materials", quota.MaterialCount + materialCount, int64(quota.MaxMaterialCount)},
+	} {
+		if check.count > check.maxCount {
+			return apiexceptions.NewRootShelfArchiveJobException().QuotaExceeded(check.resource, check.count, check.maxCount)
+		}
+	}
+	for _, blockPack := range manifest.BlockPacks {
+		if blockPack.BlockCount > int64(quota.MaxBlockCountPerBlockPack) {
+			return apiexceptions.NewBlockPackException().MaximumBlockCountExceeded(int(blockPack.BlockCount), quota.MaxBlockCountPerBlockPack)
+		}
+	}
+	for _, material := range manifest.Materials {
+		if material.Size > quota.MaxMaterialSize {
+			return apiexceptions.NewRootShelfArchiveJobException().QuotaExceeded("bytes for the material "+material.Name, material.Size, quota.MaxMaterialSize)
+		}
+	}
+
+	return nil
+}
+
+func (s *RootShelfArchiveService) validateImportedNames(manifest *shelfarchive.Manifest) *exceptions.Exception {
+	for _, subShelf := range manifest.SubShelves {
+		if err := s.validator.Var(subShelf.Name, "required,min=1,max=128,isshelfname"); err != nil {
+			return apiexceptions.NewRootShelfArchiveJobException().InvalidArchive().WithOrigin(err)
+		}
+	}
+	for _, blockPack := range manifest.BlockPacks {
+		if err := s.validator.Var(blockPack.Name, "required,min=1,max=128"); err != nil {
+			return apiexceptions.NewRootShelfArchiveJobException().InvalidArchive().WithOrigin(err)
+		}
+	}
+	for _, material := range manifest.Materials {
+		if err := s.validator.Var(material.Name, "required,min=1,max=128"); err != nil {
+			return apiexceptions.NewRootShelfArchiveJobException().InvalidArchive().WithOrigin(err)
+		}
+	}
+	return nil
+}
+
+func (s *RootShelfArchiveService) importArchive(
+	ctx context.Context,
+	job *schemas.RootShelfArchiveJob,
+	progress *rootShelfArchiveProgress,
+) *exceptions.Exception {
+	db := s.db.WithContext(ctx)
+
+	reader, _, err := s.storage.GetObjectByKey(ctx, job.ArchiveKey, &storage.GetOptions{})
+	if err != nil {
+		return apiexceptions.NewStorageException().FailedToReadObjectBytes().WithOrigin(err)
+	}
+	archiveData, err := io.ReadAll(io.LimitReader(reader, constants.MaxRootShelfArchiveSize.ToInt64()+1))
+	reader.Close()
+	if err != nil {
+		return apiexceptions.NewStorageException().FailedToReadObjectBytes().WithOrigin(err)
+	}
+
+	archive, err := shelfarchive.Read(archiveData, rootShelfArchiveLimits)
+	if err != nil {
+		return apiexceptions.NewRootShelfArchiveJobException().InvalidArchive().WithOrigin(err)
+	}
+	if exception := s.validateImportedNames(&archive.Manifest); exception != nil {
+		return exception
+	}
+	manifest, _ := shelfarchive.RemapManifest(archive.Manifest)
+
+	// resolve the target root shelf and the user whose quota is used by it
+	var targetRootShelf *schemas.RootShelf
+	quotaOwnerId := job.OwnerId
+	if job.TargetRootShelfId != nil {
+		targetRootShelf = &schemas.RootShelf{}
+		result := db.Model(&schemas.RootShelf{}).
+			Joins(`INNER JOIN "UsersToShelvesTable" AS uts ON uts.root_shelf_id = "RootShelfTable".id`).
+			Where(`"RootShelfTable".id = ? AND "RootShelfTable".deleted_at IS NULL`, *job.TargetRootShelfId).
+			Where("uts.user_id = ? AND uts.permission IN ?", job.OwnerId, []enums.AccessControlPermission{
+				enums.AccessControlPermission_Write,
+				enums.AccessControlPermission_Admin,
+				enums.AccessControlPermission_Owner,
+			}).
+			First(targetRootShelf)
+		if result.Error != nil {
+			return apiexceptions.NewShelfException().NoPermission("import into").WithOrigin(result.Error)
+		}
+		quotaOwnerId = targetRootShelf.OwnerId
+	}
+
+	progress.totalItemCount = manifest.ItemCount()
+	sourceRootShelfId := manifest.RootShelf.Id
+	if exception := s.rootShelfArchiveJobRepository.UpdateProgressById(
+		job.Id,
+		inputs.UpdateRootShelfArchiveJobProgressInput{
+			TotalItemCount:    progress.totalItemCount,
+			SourceRootShelfId: &sourceRootShelfId,
+		},
+		options.WithDB(db),
+	); exception != nil {
+		return exception
+	}
+
+	// read and prepare every block pack and material before touching the database,
+	// so that an invalid entry fails the job without leaving any partially imported tree
+	processedItemCount := int64(len(manifest.SubShelves))
+	if exception := s.reportProgress(ctx, progress, processedItemCount); exception != nil {
+		return exception
+	}
+
+	importedBlockCount := int64(0)
+	importedBlockPacks := make([]importedBlockPack, 0, len(manifest.BlockPacks))
+	for _, blockPackEntry := range manifest.BlockPacks {
+		arborizedBlocks, err := archive.ReadBlockPack(blockPackEntry)
+		if err != nil {
+			return apiexceptions.NewRootShelfArchiveJobException().InvalidArchive().WithOrigin(err)
+		}
+		shelfarchive.RemapEditableBlockIds(arborizedBlocks)
+		flattenedBlocks, _, err := editableblock.FlattenEditableBlocks(arborizedBlocks)
+		if err != nil {
+			return apiexceptions.NewRootShelfArchiveJobException().InvalidArchive().WithOrigin(err)
+		}
+		for _, flattenedBlock := range flattenedBlocks {
+			if len(flattenedBlock.Props) > constants.MaxBlockPropsSize ||
+				len(flattenedBlock.Content) > constants.MaxBlockContentSize {
+				return apiexceptions.NewBlockPackException().BlockTooLarge(string(flattenedBlock.Type))
+			}
+		}
+
+		var icon *enums.SupportedIcon
+		if blockPackEntry.Icon != nil {
+			if icon, err = enums.ConvertStringToSupportedIcon(*blockPackEntry.Icon); err != nil {
+				return apiexceptions.NewRootShelfArchiveJobException().InvalidArchive().WithOrigin(err)
+			}
+		}
+
+		importedBlockCount += int64(len(flattenedBlocks))
+		importedBlockPacks = append(importedBlockPacks, importedBlockPack{
+			blockPack: schemas.BlockPack{
+				Id:                  blockPackEntry.Id,
+				ParentSubShelfId:    blockPackEntry.ParentSubShelfId,
+				Name:                blockPackEntry.Name,
+				Icon:                icon,
+				HeaderBackgroundURL: blockPackEntry.HeaderBackgroundURL,
+			},
+			arborizedBlocks: arborizedBlocks,
+			flattenedBlocks: flattenedBlocks,
+		})
+	}
+	// the block count of the manifest is only informative, the quota is checked by the actual blocks
+	for index := range manifest.BlockPacks {
+		manifest.BlockPacks[index].BlockCount = int64(len(importedBlockPacks[index].flattenedBlocks))
+	}
+
+	if exception := s.checkImportQuota(db, quotaOwnerId, targetRootShelf, &manifest, importedBlockCount); exception != nil {
+		return exception
+	}
+
+	if len(importedBlockPacks) > 0 && s.yjsDocumentInitializer == nil {
+		return exceptions.New(
+			"DependencyUnavailable",
+			"RootShelfArchiveJob",
+			"Import",
+			"The Yjs worker document initializer is not configured",
+			http.StatusServiceUnavailable,
+			true,
+		)
+	}
+	documents := make([]schemas.BlockPackYjsDocument, 0, len(importedBlockPacks))
+	for _, importedBlockPack := range importedBlockPacks {
+		initializationResDtos, err := s.yjsDocumentInitializer.InitializeDocuments(
+			ctx,
+			[]blockpackcontract.InitializeBlockPackYjsDocumentReqDto{{Blocks: importedBlockPack.arborizedBlocks}},
+		)
+		if err != nil || len(initializationResDtos) != 1 {
+			return exceptions.New(
+				"FailedToCreate",
+				"BlockPack",
+				"Create",
+				"Failed to initialize block pack documents",
+				http.StatusInternalServerError,
+				true,
+			).WithOrigin(err)
+		}
+		documents = append(documents, schemas.BlockPackYjsDocument{
+			BlockPackId:            importedBlockPack.blockPack.Id,
+			Snapshot:               initializationResDtos[0].Snapshot,
+			StateVector:            initializationResDtos[0].StateVector,
+			ProjectedUntilSequence: 0,
+		})
+
+		processedItemCount++
+		if exception := s.reportProgress(ctx, progress, processedItemCount); exception != nil {
+			return exception
+		}
+	}
+
+	importedMaterials := make([]importedMaterial, 0, len(manifest.Materials))
+	for _, materialEntry := range manifest.Materials {
+		content, err := archive.ReadMaterial(materialEntry)
+		if err != nil {
+			return apiexceptions.NewRootShelfArchiveJobException().InvalidArchive().WithOrigin(err)
+		}
+
+		material := schemas.Material{
+			Id:               materialEntry.Id,
+			ParentSubShelfId: materialEntry.ParentSubShelfId,
+			Name:             materialEntry.Name,
+			ContentKey:       s.storage.GetKey(progress.ownerPublicId.String(), materialEntry.Id.String(), s.storageKeySalt),
+			ContentType:      enums.MaterialContentType_None,
+		}
+		var object *storage.Object
+		if len(content) > 0 {
+			// detect the content type again instead of trusting the one recorded in the manifest
+			object, err = s.storage.NewObject(material.ContentKey, bytes.NewReader(content), int64(len(content)))
+			if err != nil {
+				return apiexceptions.NewStorageException().FailedToReadObjectBytes().WithOrigin(err)
+			}
+			contentType, err := enums.ConvertStringToMaterialContentType(object.ContentType)
+			if err != nil {
+				return apiexceptions.NewMaterialException().InvalidType(object.ContentType).WithOrigin(err)
+			}
+			material.Size = object.Size
+			material.ContentType = *contentType
+			material.ParseMediaType = object.ParseMediaType
+			material.ProcessingStatus = enums.MaterialProcessingStatus_Pending
+		}
+		importedMaterials = append(importedMaterials, importedMaterial{material: material, object: object})
+
+		processedItemCount++
+		if exception := s.reportProgress(ctx, progress, processedItemCount); exception != nil {
+			return exception
+		}
+	}
+
+	tx := db.Begin()
+
+	targetRootShelfId := job.TargetRootShelfId
+	if targetRootShelfId == nil {
+		name := manifest.RootShelf.Name
+		if job.TargetRootShelfName != nil {
+			name = *job.TargetRootShelfName
+		}
+		if err := s.validator.Var(name, "required,min=1,max=128,isshelfname"); err != nil {
+			tx.Rollback()
+			return apiexceptions.NewRootShelfArchiveJobException().InvalidArchive().WithOrigin(err)
+		}
+
+		newRootShelfId, exception := s.rootShelfRepository.CreateOne(
+			job.OwnerId,
+			inputs.CreateRootShelfInput{Name: name},
+			options.WithTransactionDB(tx),
+		)
+		if exception != nil {
+			tx.Rollback()
+			return exception
+		}
+		targetRootShelfId = newRootShelfId
+	}
+
+	// insert the sub shelves level by level, so that every parent exists before its children
+	for depth := 0; depth <= constants.MaxSubShelfPathLength; depth++ {
+		subShelves := []schemas.SubShelf{}
+		for _, subShelfEntry := range manifest.SubShelves {
+			if len(subShelfEntry.Path) == depth {
+				subShelves = append(subShelves, schemas.SubShelf{
+					Id:             subShelfEntry.Id,
+					Name:           subShelfEntry.Name,
+					RootShelfId:    *targetRootShelfId,
+					PrevSubShelfId: subShelfEntry.PrevSubShelfId,
+					Path:           subShelfEntry.Path,
+				})
+			}
+		}
+		if len(subShelves) == 0 {
+			break
+		}
+		if err := tx.CreateInBatches(&subShelves, constants.MaxBatchCreateBlockSize).Error; err != nil {
+			tx.Rollback()
+			return apiexceptions.NewShelfException().FailedToCreate().WithOrigin(err)
+		}
+	}
+
+	if len(importedBlockPacks) > 0 {
+		blockPacks := make([]schemas.BlockPack, len(importedBlockPacks))
+		blockPackIds := make([]uuid.UUID, len(importedBlockPacks))
+		blocks := []schemas.Block{}
+		for index, importedBlockPack := range importedBlockPacks {
+			blockPacks[index] = importedBlockPack.blockPack
+			blockPackIds[index] = importedBlockPack.blockPack.Id
+			for _, flattenedBlock := range importedBlockPack.flattenedBlocks {
+				blocks = append(blocks, schemas.Block{
+					Id:            flattenedBlock.Id,
+					BlockPackId:   importedBlockPack.blockPack.Id,
+					ParentBlockId: flattenedBlock.ParentBlockId,
+					PrevBlockId:   flattenedBlock.PrevBlockId,
+					NextBlockId:   flattenedBlock.NextBlockId,
+					Type:          enums.BlockType(flattenedBlock.Type),
+					Props:         datatypes.JSON(flattenedBlock.Props),
+					Content:       datatypes.JSON(flattenedBlock.Content),
+				})
+			}
+		}
+		if err := tx.CreateInBatches(&blockPacks, constants.MaxBatchCreateBlockSize).Error; err != nil {
+			tx.Rollback()
+			return apiexceptions.NewBlockPackException().FailedToCreate().WithOrigin(err)
+		}
+		if err := tx.CreateInBatches(&documents, constants.MaxBatchCreateBlockSize).Error; err != nil {
+			tx.Rollback()
+			return apiexceptions.NewBlockPackException().FailedToCreate().WithOrigin(err)
+		}
+		if len(blocks) > 0 {
+			if err := tx.CreateInBatches(&blocks, constants.MaxBatchCreateBlockSize).Error; err != nil {
+				tx.Rollback()
+				return apiexceptions.NewBlockException().FailedToCreate().WithOrigin(err)
+			}
+		}
+		if err := s.outboxEventRepository.EnqueueManyYjsMaintenanceHints(
+			tx,
+			uuid.NewString(),
+			blockPackIds,
+			"root_shelf_archive_imported",
+		); err != nil {
+			tx.Rollback()
+			return apiexceptions.NewBlockPackException().FailedToCreate().WithOrigin(err)
+		}
+	}
+
+	putContentKeys := []string{}
+	deletePutObjects := func() {
+		for _, contentKey := range putContentKeys {
+			_ = s.storage.DeleteObjectByKey(context.WithoutCancel(ctx), contentKey)
+		}
+	}
+	if len(importedMaterials) > 0 {
+		materials := make([]schemas.Material, len(importedMaterials))
+		for index, importedMaterial := range importedMaterials {
+			materials[index] = importedMaterial.material
+		}
+		if err := tx.CreateInBatches(&materials, constants.MaxBatchCreateBlockSize).Error; err != nil {
+			tx.Rollback()
+			return apiexceptions.NewMaterialException().FailedToCreate().WithOrigin(err)
+		}
+
+		// put the objects before committing, so that a committed material always owns its content
+		processingMaterialIds := []uuid.UUID{}
+		for _, importedMaterial := range importedMaterials {
+			if importedMaterial.object == nil {
+				continue
+			}
+			if err := s.storage.PutObjectByKey(ctx, importedMaterial.material.ContentKey, importedMaterial.object); err != nil {
+				tx.Rollback()
+				deletePutObjects()
+				return apiexceptions.NewStorageException().FailedToPutObject(importedMaterial.material.ContentKey).WithOrigin(err)
+			}
+			putContentKeys = append(putContentKeys, importedMaterial.material.ContentKey)
+			processingMaterialIds = append(processingMaterialIds, importedMaterial.material.Id)
+		}
+		if err := s.outboxEventRepository.EnqueueManyMaterialProcessingHints(
+			tx,
+			uuid.NewString(),
+			processingMaterialIds,
+			"root_shelf_archive_imported",
+		); err != nil {
+			tx.Rollback()
+			deletePutObjects()
+			return apiexceptions.NewMaterialException().FailedToCreate().WithOrigin(err)
+		}
+	}
+
+	if exception := s.rootShelfArchiveJobRepository.UpdateProgressById(
+		job.Id,
+		inputs.UpdateRootShelfArchiveJobProgressInput{
+			ProcessedItemCount: progress.totalItemCount,
+			TotalItemCount:     progress.totalItemCount,
+			TargetRootShelfId:  targetRootShelfId,
+		},
+		options.WithTransactionDB(tx),
+	); exception != nil {
+		tx.Rollback()
+		deletePutObjects()
+		return exception
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
+		deletePutObjects()
+		return apiexceptions.NewRootShelfArchiveJobException().FailedToCommitTransaction().WithOrigin(err)
+	}
+	job.TargetRootShelfId = targetRootShelfId
+
+	return nil
+}
+
+/* ============================== Service Methods for RootShelfArchive ============================== */
+
+func (s *RootShelfArchiveService) ExportMyRootShelfArchiveById(
+	ctx context.Context, requestDto *apicontract.ExportMyRootShelfArchiveByIdRequestDto,
+) (*apicontract.ExportMyRootShelfArchiveByIdResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(requestDto); err != nil {
+		return nil, apiexceptions.NewRootShelfArchiveJobException().InvalidDto().WithOrigin(err)
+	}
+	actorUserId, exception := contexts.GetActorUserId(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+	actorUserPublicId, exception := contexts.GetActorUserPublicId(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+	allowedPermissions, exception := contexts.GetAllowedPermissions(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+
+	db := s.db.WithContext(ctx)
+
+	rootShelf, _, exception := s.rootShelfRepository.GetOneById(
+		requestDto.Param.RootShelfId,
+		actorUserId,
+		nil,
+		options.WithDB(db),
+		options.WithAllowedPermissions(allowedPermissions),
+	)
+	if exception != nil {
+		return nil, exception
+	}
+
+	jobId := uuid.New()
+	job, exception := s.rootShelfArchiveJobRepository.CreateOne(
+		actorUserId,
+		inputs.CreateRootShelfArchiveJobInput{
+			Id:                &jobId,
+			Type:              enums.RootShelfArchiveJobType_Export,
+			SourceRootShelfId: &rootShelf.Id,
+			ArchiveKey:        s.getArchiveKey(actorUserPublicId, jobId),
+		},
+		options.WithDB(db),
+	)
+	if exception != nil {
+		return nil, exception
+	}
+
+	return &apicontract.ExportMyRootShelfArchiveByIdResponseDto{
+		ArchiveJobId: job.Id,
+		Status:       enumcontract.RootShelfArchiveJobStatus(job.Status),
+		CreatedAt:    job.CreatedAt,
+	}, nil
+}
+
+func (s *RootShelfArchiveService) CreateRootShelfFromArchive(
+	ctx context.Context, requestDto *apicontract.CreateRootShelfFromArchiveRequestDto,
+) (*apicontract.CreateRootShelfFromArchiveResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(requestDto); err != nil {
+		return nil, apiexceptions.NewRootShelfArchiveJobException().InvalidDto().WithOrigin(err)
+	}
+	archiveSize := int64(len(requestDto.Body.ArchiveFile))
+	if archiveSize > constants.MaxRootShelfArchiveSize.ToInt64() {
+		return nil, apiexceptions.NewRootShelfArchiveJobException().ArchiveTooLarge(archiveSize, constants.MaxRootShelfArchiveSize.ToInt64())
+	}
+	actorUserId, exception := contexts.GetActorUserId(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+	actorUserPublicId, exception := contexts.GetActorUserPublicId(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+	allowedPermissions, exception := contexts.GetAllowedPermissions(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+
+	db := s.db.WithContext(ctx)
+
+	if requestDto.Body.TargetRootShelfId != nil {
+		if _, _, exception := s.rootShelfRepository.GetOneById(
+			*requestDto.Body.TargetRootShelfId,
+			actorUserId,
+			nil,
+			options.WithDB(db),
+			options.WithAllowedPermissions(allowedPermissions),
+		); exception != nil {
+			return nil, exception
+		}
+	}
+
+	// reject the archives which can never be imported early, the worker validates the whole archive again
+	if _, err := shelfarchive.Read(requestDto.Body.ArchiveFile, rootShelfArchiveLimits); err != nil {
+		if errors.Is(err, shelfarchive.ErrArchiveTooLarge) {
+			return nil, apiexceptions.NewRootShelfArchiveJobException().ArchiveTooLarge(archiveSize, constants.MaxRootShelfArchiveSize.ToInt64()).WithOrigin(err)
+		}
+		return nil, apiexceptions.NewRootShelfArchiveJobException().InvalidArchive().WithOrigin(err)
+	}
+
+	jobId := uuid.New()
+	archiveKey := s.getArchiveKey(actorUserPublicId, jobId)
+	object, err := s.storage.NewObject(archiveKey, bytes.NewReader(requestDto.Body.ArchiveFile), archiveSize)
+	if err != nil {
+		return nil, apiexceptions.NewStorageException().FailedToReadObjectBytes().WithOrigin(err)
+	}
+	object.ContentType = rootShelfArchiveContentType
+	if err := s.storage.PutObjectByKey(ctx, archiveKey, object); err != nil {
+		return nil, apiexceptions.NewStorageException().FailedToPutObject(archiveKey).WithOrigin(err)
+	}
+
+	job, exception := s.rootShelfArchiveJobRepository.CreateOne(
+		actorUserId,
+		inputs.CreateRootShelfArchiveJobInput{
+			Id:                  &jobId,
+			Type:                enums.RootShelfArchiveJobType_Import,
+			TargetRootShelfId:   requestDto.Body.TargetRootShelfId,
+			TargetRootShelfName: requestDto.Body.Name,
+			ArchiveKey:          archiveKey,
+			ArchiveSize:         archiveSize,
+		},
+		options.WithDB(db),
+	)
+	if exception != nil {
+		_ = s.storage.DeleteObjectByKey(ctx, archiveKey)
+		return nil, exception
+	}
+
+	return &apicontract.CreateRootShelfFromArchiveResponseDto{
+		ArchiveJobId: job.Id,
+		Status:       enumcontract.RootShelfArchiveJobStatus(job.Status),
+		CreatedAt:    job.CreatedAt,
+	}, nil
+}
+
+func (s *RootShelfArchiveService) GetMyRootShelfArchiveJobById(
+	ctx context.Context, requestDto *apicontract.GetMyRootShelfArchiveJobByIdRequestDto,
+) (*apicontract.GetMyRootShelfArchiveJobByIdResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(requestDto); err != nil {
+		return nil, apiexceptions.NewRootShelfArchiveJobException().InvalidDto().WithOrigin(err)
+	}
+	actorUserId, exception := contexts.GetActorUserId(ctx)
+	if exception != nil {
+		return nil, exception
+	}
+
+	job, exception := s.rootShelfArchiveJobRepository.GetOneByIdAndOwnerId(
+		requestDto.Param.ArchiveJobId,
+		actorUserId,
+		options.WithDB(s.db.WithContext(ctx)),
+	)
+	if exception != nil {
+		return nil, exception
+	}
+
+	rootShelfId := job.SourceRootShelfId
+	var downloadURL *string
+	if job.Type == enums.RootShelfArchiveJobType_Import {
+		rootShelfId = job.TargetRootShelfId
+	} else if job.Status == enums.RootShelfArchiveJobStatus_Succeeded {
+		url, err := s.storage.PresignGetObjectByKey(ctx, job.ArchiveKey, &storage.PresignOptions{
+			Expires:     constants.RootShelfArchiveDownloadExpiresIn,
+			ContentType: rootShelfArchiveContentType,
+		})
+		if err != nil {
+			return nil, apiexceptions.NewStorageException().FailedToPresignedGetObject(job.ArchiveKey).WithOrigin(err)
+		}
+		downloadURL = &url
+	}
+
+	progressPercent := calculateRootShelfArchiveProgress(job.ProcessedItemCount, job.TotalItemCount)
+	if job.Status == enums.RootShelfArchiveJobStatus_Succeeded {
+		progressPercent = 100
+	}
+
+	return &apicontract.GetMyRootShelfArchiveJobByIdResponseDto{
+		Id:                 job.Id,
+		Type:               enumcontract.RootShelfArchiveJobType(job.Type),
+		Status:             enumcontract.RootShelfArchiveJobStatus(job.Status),
+		RootShelfId:        rootShelfId,
+		ProcessedItemCount: job.ProcessedItemCount,
+		TotalItemCount:     job.TotalItemCount,
+		ProgressPercent:    progressPercent,
+		DownloadURL:        downloadURL,
+		ErrorMessage:       job.ErrorMessage,
+		StartedAt:          job.StartedAt,
+		CompletedAt:        job.CompletedAt,
+		UpdatedAt:          job.UpdatedAt,
+		CreatedAt:          job.CreatedAt,
+	}, nil
+}
+
+/* ============================== Service Methods for RootShelfArchive Worker ============================== */
+
+// ProcessNextArchiveJob claims and runs a single archive job, and reports whether there was a job to run,
+// the failure of the job itself is recorded on the job instead of being returned
+func (s *RootShelfArchiveService) ProcessNextArchiveJob(
+	ctx context.Context, claimTimeout time.Duration,
+) (bool, *exceptions.Exception) {
+	job, exception := s.rootShelfArchiveJobRepository.ClaimNext(
+		time.Now().UTC(),
+		claimTimeout,
+		options.WithDB(s.db.WithContext(ctx)),
+	)
+	if exception != nil {
+		return false, exception
+	}
+	if job == nil {
+		return false, nil
+	}
+
+	ownerPublicId, exception := s.getOwnerPublicId(ctx, job.OwnerId)
+	if exception != nil {
+		return true, s.failArchiveJob(ctx, job, uuid.Nil, exception)
+	}
+	if job.Attempts > maxRootShelfArchiveJobAttempts {
+		return true, s.failArchiveJob(ctx, job, ownerPublicId, apiexceptions.NewRootShelfArchiveJobException().MaximumAttemptsExceeded(job.Attempts-1))
+	}
+
+	progress := &rootShelfArchiveProgress{
+		job:                  job,
+		ownerPublicId:        ownerPublicId,
+		lastWrittenPercent:   -1,
+		lastNotifiedProgress: job.LastNotifiedProgress,
+	}
+	switch job.Type {
+	case enums.RootShelfArchiveJobType_Export:
+		exception = s.exportArchive(ctx, job, progress)
+	case enums.RootShelfArchiveJobType_Import:
+		exception = s.importArchive(ctx, job, progress)
+	default:
+		exception = apiexceptions.NewRootShelfArchiveJobException().InvalidType(job.Type)
+	}
+	if ctx.Err() != nil {
+		// leave the job claimed, it is retried by another worker once the claim expires
+		return true, nil
+	}
+
+	if exception != nil {
+		if logs.NotegicLogger != nil {
+			logs.NotegicLogger.Error(ctx, exception.Origin(), exception.String())
+		}
+		if job.Type == enums.RootShelfArchiveJobType_Import {
+			_ = s.storage.DeleteObjectByKey(ctx, job.ArchiveKey)
+		}
+		return true, s.failArchiveJob(ctx, job, ownerPublicId, exception)
+	}
+
+	if job.Type == enums.RootShelfArchiveJobType_Import {
+		// the uploaded archive is no longer needed once its tree has been imported
+		_ = s.storage.DeleteObjectByKey(ctx, job.ArchiveKey)
+	}
+	return true, s.completeArchiveJob(ctx, job, ownerPublicId)
+}
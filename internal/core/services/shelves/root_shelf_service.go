@@ -287,6 +287,29 @@ func (s *RootShelfService) saveMyRootShelfPermission(
 	}, nil
 }
 
+// newRootShelfSearchKeyset builds the keyset of the requested sort order
+func newRootShelfSearchKeyset(gqlInput gqlmodels.SearchRootShelfInput) *searchcursor.Keyset {
+	isDescending := gqlInput.SortOrder != nil && *gqlInput.SortOrder == gqlmodels.SearchSortOrderDesc
+
+	idKey := searchcursor.KeysetKey{Column: `"RootShelfTable".id`, Type: "uuid"}
+	nameKey := searchcursor.KeysetKey{Column: `"RootShelfTable".name`, Type: "text"}
+	updatedAtKey := searchcursor.KeysetKey{Column: `"RootShelfTable".updated_at`, Type: "timestamptz"}
+	createdAtKey := searchcursor.KeysetKey{Column: `"RootShelfTable".created_at`, Type: "timestamptz"}
+
+	if gqlInput.SortBy == nil {
+		return searchcursor.NewKeyset("", isDescending, idKey)
+	}
+
+	switch *gqlInput.SortBy {
+	case gqlmodels.SearchRootShelfSortByLastUpdate:
+		return searchcursor.NewKeyset(gqlmodels.SearchRootShelfSortByLastUpdate.String(), isDescending, updatedAtKey, nameKey, createdAtKey, idKey)
+	case gqlmodels.SearchRootShelfSortByCreatedAt:
+		return searchcursor.NewKeyset(gqlmodels.SearchRootShelfSortByCreatedAt.String(), isDescending, createdAtKey, nameKey, updatedAtKey, idKey)
+	default:
+		return searchcursor.NewKeyset(gqlmodels.SearchRootShelfSortByName.String(), isDescending, nameKey, updatedAtKey, createdAtKey, idKey)
+	}
+}
+
 /* ============================== Service Methods for RootShelf ============================== */
 
 func (s *RootShelfService) GetMyRootShelfById(
@@ -2160,54 +2183,20 @@ func (s *RootShelfService) SearchPrivateRootShelves(
 			"%"+gqlInput.Query+"%",
 		)
 	}
-	if gqlInput.After != nil && len(strings.ReplaceAll(*gqlInput.After, " ", "")) > 0 {
-		searchCursor, err := searchcursor.Decode[gqlmodels.SearchRootShelfCursorFields](*gqlInput.After)
-		if err != nil {
-			return nil, exceptions.New(
-				"CursorDecodeFailed",
-				"Search",
-				"SearchPrivateRootShelves",
-				"Failed to decode the search cursor",
-				http.StatusInternalServerError,
-				true,
-			).WithOrigin(err)
-		}
-
-		query = query.Where("id > ?", searchCursor.Fields.ID)
-	}
-
-	if gqlInput.SortBy != nil && gqlInput.SortOrder != nil {
-		var cending string = gqlmodels.SearchSortOrderAsc.String()
-		if *gqlInput.SortOrder == gqlmodels.SearchSortOrderDesc {
-			cending = gqlmodels.SearchSortOrderDesc.String()
-		}
-
-		switch *gqlInput.SortBy {
-		case gqlmodels.SearchRootShelfSortByName:
-			query = query.Order("name " + cending).
-				Order("updated_at " + cending).
-				Order("created_at " + cending)
-		case gqlmodels.SearchRootShelfSortByLastUpdate:
-			query = query.Order("updated_at " + cending).
-				Order("name " + cending).
-				Order("created_at " + cending)
-		case gqlmodels.SearchRootShelfSortByCreatedAt:
-			query = query.Order("created_at " + cending).
-				Order("name " + cending).
-				Order("updated_at " + cending)
-		default:
-			query = query.Order("name " + cending).
-				Order("updated_at " + cending).
-				Order("created_at " + cending)
-		}
-	}
-
-	limit := constants.DefaultSearchLimit
-	if gqlInput.First != nil && *gqlInput.First > 0 {
-		limit = int(*gqlInput.First)
+	keyset := newRootShelfSearchKeyset(gqlInput)
+	page, err := keyset.NewPage(gqlInput.After, gqlInput.Before, gqlInput.First, gqlInput.Last, constants.DefaultSearchLimit, constants.MaxSearchLimit)
+	if err != nil {
+		return nil, exceptions.New(
+			"CursorDecodeFailed",
+			"Search",
+			"SearchPrivateRootShelves",
+			"Failed to decode the search cursor",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(err)
 	}
-	limit = min(limit, constants.MaxSearchLimit)
-	query = query.Limit(limit + 1)
+	query = query.Scopes(scopes.PaginateByKeyset(keyset, page.AfterCursor, page.BeforeCursor, page.IsBackward)).
+		Limit(page.Limit + 1)
 
 	var shelves []PrivateRootShelf
 	if err := query.Scopes(s.rootShelfScope.IncludePreloads(
@@ -2225,6 +2214,8 @@ func (s *RootShelfService) SearchPrivateRootShelves(
 		).WithOrigin(err)
 	}
 
+	shelves, hasNextPage, hasPreviousPage := searchcursor.Slice(page, shelves)
+
 	userIds := make([]uuid.UUID, 0)
 	userIdsSeen := make(map[uuid.UUID]struct{})
 	for _, shelf := range shelves {
@@ -2263,16 +2254,21 @@ func (s *RootShelfService) SearchPrivateRootShelves(
 		publicUsersById[user.Id] = user.ToPublicUser()
 	}
 
-	hasNextPage := len(shelves) > limit
 	searchEdges := make([]*gqlmodels.SearchRootShelfEdge, len(shelves))
 
 	for index, shelf := range shelves {
-		searchCursor := searchcursor.SearchCursor[gqlmodels.SearchRootShelfCursorFields]{
-			Fields: gqlmodels.SearchRootShelfCursorFields{
-				ID: shelf.Id,
-			},
+		var sortValues []any
+		switch keyset.SortBy {
+		case gqlmodels.SearchRootShelfSortByName.String():
+			sortValues = []any{shelf.Name, shelf.UpdatedAt, shelf.CreatedAt, shelf.Id}
+		case gqlmodels.SearchRootShelfSortByLastUpdate.String():
+			sortValues = []any{shelf.UpdatedAt, shelf.Name, shelf.CreatedAt, shelf.Id}
+		case gqlmodels.SearchRootShelfSortByCreatedAt.String():
+			sortValues = []any{shelf.CreatedAt, shelf.Name, shelf.UpdatedAt, shelf.Id}
+		default:
+			sortValues = []any{shelf.Id}
 		}
-		encodedSearchCursor, err := searchCursor.Encode()
+		encodedSearchCursor, err := keyset.Encode(sortValues...)
 		if err != nil {
 			return nil, exceptions.New(
 				"CursorEncodeFailed",
@@ -2283,16 +2279,6 @@ func (s *RootShelfService) SearchPrivateRootShelves(
 				true,
 			).WithOrigin(err)
 		}
-		if encodedSearchCursor == nil {
-			return nil, exceptions.New(
-				"CursorEncodingFailed",
-				"Search",
-				"SearchPrivateRootShelves",
-				"Failed to encode the search cursor",
-				http.StatusInternalServerError,
-				true,
-			)
-		}
 
 		privateRootShelf := shelf.RootShelf.ToPrivateRootShelf(shelf.Permission)
 		owner, exists := publicUsersById[shelf.OwnerId]
@@ -2327,14 +2313,14 @@ func (s *RootShelfService) SearchPrivateRootShelves(
 		}
 
 		searchEdges[index] = &gqlmodels.SearchRootShelfEdge{
-			EncodedSearchCursor: *encodedSearchCursor,
+			EncodedSearchCursor: encodedSearchCursor,
 			Node:                privateRootShelf,
 		}
 	}
 
 	searchPageInfo := &gqlmodels.SearchPageInfo{
 		HasNextPage:     hasNextPage,
-		HasPreviousPage: gqlInput.After != nil && len(strings.ReplaceAll(*gqlInput.After, " ", "")) > 0,
+		HasPreviousPage: hasPreviousPage,
 	}
 
 	if len(searchEdges) > 0 {
@@ -2343,9 +2329,6 @@ func (s *RootShelfService) SearchPrivateRootShelves(
 	}
 
 	searchTime := float64(time.Since(startTime).Nanoseconds()) / 1e6
-	if hasNextPage {
-		searchEdges = searchEdges[:limit]
-	}
 
 	return &gqlmodels.SearchRootShelfConnection{
 		SearchEdges:    searchEdges,
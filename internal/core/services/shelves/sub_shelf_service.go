@@ -105,6 +105,32 @@ func newSubShelfResponseDto(subShelf schemas.SubShelf) subshelvescontract.SubShe
 	}
 }
 
+// newSubShelfSearchKeyset builds the keyset of the requested sort order
+func newSubShelfSearchKeyset(gqlInput gqlmodels.SearchSubShelfInput) *searchcursor.Keyset {
+	isDescending := gqlInput.SortOrder != nil && *gqlInput.SortOrder == gqlmodels.SearchSortOrderDesc
+
+	idKey := searchcursor.KeysetKey{Column: `"SubShelfTable".id`, Type: "uuid"}
+	nameKey := searchcursor.KeysetKey{Column: `"SubShelfTable".name`, Type: "text"}
+	pathLengthKey := searchcursor.KeysetKey{Column: `cardinality("SubShelfTable".path)`, Type: "integer"}
+	updatedAtKey := searchcursor.KeysetKey{Column: `"SubShelfTable".updated_at`, Type: "timestamptz"}
+	createdAtKey := searchcursor.KeysetKey{Column: `"SubShelfTable".created_at`, Type: "timestamptz"}
+
+	if gqlInput.SortBy == nil {
+		return searchcursor.NewKeyset("", isDescending, idKey)
+	}
+
+	switch *gqlInput.SortBy {
+	case gqlmodels.SearchSubShelfSortByPathLength:
+		return searchcursor.NewKeyset(gqlmodels.SearchSubShelfSortByPathLength.String(), isDescending, pathLengthKey, nameKey, updatedAtKey, createdAtKey, idKey)
+	case gqlmodels.SearchSubShelfSortByLastUpdate:
+		return searchcursor.NewKeyset(gqlmodels.SearchSubShelfSortByLastUpdate.String(), isDescending, updatedAtKey, nameKey, pathLengthKey, createdAtKey, idKey)
+	case gqlmodels.SearchSubShelfSortByCreatedAt:
+		return searchcursor.NewKeyset(gqlmodels.SearchSubShelfSortByCreatedAt.String(), isDescending, createdAtKey, nameKey, pathLengthKey, updatedAtKey, idKey)
+	default:
+		return searchcursor.NewKeyset(gqlmodels.SearchSubShelfSortByName.String(), isDescending, nameKey, pathLengthKey, updatedAtKey, createdAtKey, idKey)
+	}
+}
+
 /* ============================== Service Methods for SubShelf ============================== */
 
 func (s *SubShelfService) GetMySubShelfById(
@@ -186,6 +212,7 @@ func (s *SubShelfService) GetMySubShelvesByPrevSubShelfId(
 	result := db.Model(&schemas.SubShelf{}).
 		Where("prev_sub_shelf_id = ? AND EXISTS (?)", requestDto.Param.PrevSubShelfId, subQuery).
 		Scopes(scopes.NewSubShelfScope().FilterOnlyDeleted(onlyDeleted)).
+		Scopes(scopes.RestrictRootShelvesOfShareLink(`"SubShelfTable".root_shelf_id`)).
 		Order(`"SubShelfTable".name ASC`).
 		Limit(int(data.MaxSubShelvesOfSubShelf)).
 		Find(&subShelves)
@@ -235,6 +262,7 @@ func (s *SubShelfService) GetAllMySubShelvesByRootShelfId(
 		Where("root_shelf_id = ? AND EXISTS (?)",
 			requestDto.Param.RootShelfId, subQuery,
 		).Scopes(scopes.NewSubShelfScope().FilterOnlyDeleted(onlyDeleted)).
+		Scopes(scopes.RestrictRootShelvesOfShareLink(`"SubShelfTable".root_shelf_id`)).
 		Order(`"SubShelfTable".name ASC`).
 		Limit(int(data.MaxSubShelvesOfSubShelf)).
 		Find(&subShelves)
@@ -288,6 +316,7 @@ func (s *SubShelfService) GetMySubShelvesAndItemsByPrevSubShelfId(
 		Where("prev_sub_shelf_id = ? AND EXISTS (?)",
 			requestDto.Param.PrevSubShelfId, subQuery,
 		).Scopes(scopes.NewSubShelfScope().FilterOnlyDeleted(onlyDeleted)).
+		Scopes(scopes.RestrictRootShelvesOfShareLink(`"SubShelfTable".root_shelf_id`)).
 		Order(`"SubShelfTable".name ASC`).
 		Limit(int(data.MaxSubShelvesOfSubShelf)).
 		Find(&subShelves)
@@ -307,6 +336,7 @@ func (s *SubShelfService) GetMySubShelvesAndItemsByPrevSubShelfId(
 			actorUserId,
 			allowedPermissions,
 		).Scopes(scopes.NewMaterialScope().FilterOnlyDeleted(onlyDeleted)).
+		Scopes(scopes.RestrictRootShelvesOfShareLink("ss.root_shelf_id")).
 		Order(`"MaterialTable".name ASC`).
 		Limit(int(data.MaxMaterialsOfSubShelf)).
 		Find(&materials)
@@ -342,6 +372,7 @@ func (s *SubShelfService) GetMySubShelvesAndItemsByPrevSubShelfId(
 			actorUserId,
 			allowedPermissions,
 		).Scopes(scopes.NewBlockPackScope().FilterOnlyDeleted(onlyDeleted)).
+		Scopes(scopes.RestrictRootShelvesOfShareLink("ss.root_shelf_id"), scopes.RestrictBlockPacksOfShareLink(`"BlockPackTable".id`)).
 		Order(`"BlockPackTable".name ASC`).
 		Limit(int(data.MaxBlockPackOfSubShelf)).
 		Scan(&blockPacks)
@@ -1337,56 +1368,13 @@ func (s *SubShelfService) SearchPrivateSubShelves(
 			"%"+gqlInput.Query+"%",
 		)
 	}
-	if gqlInput.After != nil && len(strings.ReplaceAll(*gqlInput.After, " ", "")) > 0 {
-		searchCursor, err := searchcursor.Decode[gqlmodels.SearchSubShelfCursorFields](*gqlInput.After)
-		if err != nil {
-			return nil, apiexceptions.NewSearchException().FailedToDecode().WithOrigin(err)
-		}
-
-		query = query.Where(`"SubShelfTable".id > ?`, searchCursor.Fields.ID)
-	}
-
-	if gqlInput.SortBy != nil && gqlInput.SortOrder != nil {
-		cending := gqlmodels.SearchSortOrderAsc.String()
-		if *gqlInput.SortOrder == gqlmodels.SearchSortOrderDesc {
-			cending = gqlmodels.SearchSortOrderDesc.String()
-		}
-
-		switch *gqlInput.SortBy {
-		case gqlmodels.SearchSubShelfSortByName:
-			query = query.Order(`"SubShelfTable".name ` + cending).
-				Order(`cardinality("SubShelfTable".path) ` + cending).
-				Order(`"SubShelfTable".updated_at ` + cending).
-				Order(`"SubShelfTable".created_at ` + cending)
-		case gqlmodels.SearchSubShelfSortByPathLength:
-			query = query.Order(`cardinality("SubShelfTable".path) ` + cending).
-				Order(`"SubShelfTable".name ` + cending).
-				Order(`"SubShelfTable".updated_at ` + cending).
-				Order(`"SubShelfTable".created_at ` + cending)
-		case gqlmodels.SearchSubShelfSortByLastUpdate:
-			query = query.Order(`"SubShelfTable".updated_at ` + cending).
-				Order(`"SubShelfTable".name ` + cending).
-				Order(`cardinality("SubShelfTable".path) ` + cending).
-				Order(`"SubShelfTable".created_at ` + cending)
-		case gqlmodels.SearchSubShelfSortByCreatedAt:
-			query = query.Order(`"SubShelfTable".created_at ` + cending).
-				Order(`"SubShelfTable".name ` + cending).
-				Order(`cardinality("SubShelfTable".path) ` + cending).
-				Order(`"SubShelfTable".updated_at ` + cending)
-		default:
-			query = query.Order(`"SubShelfTable".name ` + cending).
-				Order(`cardinality("SubShelfTable".path) ` + cending).
-				Order(`"SubShelfTable".updated_at ` + cending).
-				Order(`"SubShelfTable".created_at ` + cending)
-		}
-	}
-
-	limit := constants.DefaultSearchLimit
-	if gqlInput.First != nil && *gqlInput.First > 0 {
-		limit = int(*gqlInput.First)
+	keyset := newSubShelfSearchKeyset(gqlInput)
+	page, err := keyset.NewPage(gqlInput.After, gqlInput.Before, gqlInput.First, gqlInput.Last, constants.DefaultSearchLimit, constants.MaxSearchLimit)
+	if err != nil {
+		return nil, apiexceptions.NewSearchException().FailedToDecode().WithOrigin(err)
 	}
-	limit = min(limit, constants.MaxSearchLimit)
-	query = query.Limit(limit + 1)
+	query = query.Scopes(scopes.PaginateByKeyset(keyset, page.AfterCursor, page.BeforeCursor, page.IsBackward)).
+		Limit(page.Limit + 1)
 
 	var subShelves []schemas.SubShelf
 	if err := query.Scopes(s.subShelfScope.IncludePreloads(
@@ -1398,36 +1386,38 @@ func (s *SubShelfService) SearchPrivateSubShelves(
 		return nil, apiexceptions.NewShelfException().NotFound().WithOrigin(err)
 	}
 
-	hasNextPage := len(subShelves) > limit
+	subShelves, hasNextPage, hasPreviousPage := searchcursor.Slice(page, subShelves)
+
 	searchEdges := make([]*gqlmodels.SearchSubShelfEdge, len(subShelves))
 
 	for index, subShelf := range subShelves {
-		searchCursor := searchcursor.SearchCursor[gqlmodels.SearchSubShelfCursorFields]{
-			Fields: gqlmodels.SearchSubShelfCursorFields{
-				ID: subShelf.Id,
-			},
+		var sortValues []any
+		switch keyset.SortBy {
+		case gqlmodels.SearchSubShelfSortByName.String():
+			sortValues = []any{subShelf.Name, len(subShelf.Path), subShelf.UpdatedAt, subShelf.CreatedAt, subShelf.Id}
+		case gqlmodels.SearchSubShelfSortByPathLength.String():
+			sortValues = []any{len(subShelf.Path), subShelf.Name, subShelf.UpdatedAt, subShelf.CreatedAt, subShelf.Id}
+		case gqlmodels.SearchSubShelfSortByLastUpdate.String():
+			sortValues = []any{subShelf.UpdatedAt, subShelf.Name, len(subShelf.Path), subShelf.CreatedAt, subShelf.Id}
+		case gqlmodels.SearchSubShelfSortByCreatedAt.String():
+			sortValues = []any{subShelf.CreatedAt, subShelf.Name, len(subShelf.Path), subShelf.UpdatedAt, subShelf.Id}
+		default:
+			sortValues = []any{subShelf.Id}
 		}
-		encodedSearchCursor, err := searchCursor.Encode()
+		encodedSearchCursor, err := keyset.Encode(sortValues...)
 		if err != nil {
 			return nil, apiexceptions.NewSearchException().FailedToEncode().WithOrigin(err)
 		}
-		if encodedSearchCursor == nil {
-			return nil, apiexceptions.NewSearchException().FailedToUnmarshalSearchCursor()
-		}
 
 		searchEdges[index] = &gqlmodels.SearchSubShelfEdge{
-			EncodedSearchCursor: *encodedSearchCursor,
+			EncodedSearchCursor: encodedSearchCursor,
 			Node:                subShelf.ToPrivateSubShelf(),
 		}
 	}
 
-	if hasNextPage {
-		searchEdges = searchEdges[:limit]
-	}
-
 	searchPageInfo := &gqlmodels.SearchPageInfo{
 		HasNextPage:     hasNextPage,
-		HasPreviousPage: gqlInput.After != nil && len(strings.ReplaceAll(*gqlInput.After, " ", "")) > 0,
+		HasPreviousPage: hasPreviousPage,
 	}
 
 	if len(searchEdges) > 0 {
@@ -2,15 +2,18 @@ package user
 
 import (
 	"context"
+	"math"
 	"net/http"
 	"time"
 
 	validator "github.com/go-playground/validator/v10"
 	"gorm.io/gorm"
 
+	enumcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
 	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
 
 	apicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/user-accounts"
+	gqlmodels "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/graphql/models"
 
 	contexts "github.com/HiIamJeff67/notegic-backend/internal/core/contexts"
 	data "github.com/HiIamJeff67/notegic-backend/internal/core/data/database"
@@ -22,20 +25,36 @@ import (
 	authservices "github.com/HiIamJeff67/notegic-backend/internal/core/services/auth"
 )
 
+const (
+	defaultUsageSnapshotDays = 30
+	maxUsageSnapshotDays     = 365
+)
+
 type UserAccountServiceInterface interface {
 	GetMyAccount(ctx context.Context, requestDto *apicontract.GetMyAccountRequestDto) (*apicontract.GetMyAccountResponseDto, *exceptions.Exception)
 	UpdateMyAccount(ctx context.Context, requestDto *apicontract.UpdateMyAccountRequestDto) (*apicontract.UpdateMyAccountResponseDto, *exceptions.Exception)
 	BindGoogleAccount(ctx context.Context, requestDto *apicontract.BindGoogleAccountRequestDto) (*apicontract.BindGoogleAccountResponseDto, *exceptions.Exception)
 	UnbindGoogleAccount(ctx context.Context, requestDto *apicontract.UnbindGoogleAccountRequestDto) (*apicontract.UnbindGoogleAccountResponseDto, *exceptions.Exception)
+	BindGithubAccount(ctx context.Context, requestDto *apicontract.BindGithubAccountRequestDto) (*apicontract.BindGithubAccountResponseDto, *exceptions.Exception)
+	UnbindGithubAccount(ctx context.Context, requestDto *apicontract.UnbindGithubAccountRequestDto) (*apicontract.UnbindGithubAccountResponseDto, *exceptions.Exception)
+	BindMetaAccount(ctx context.Context, requestDto *apicontract.BindMetaAccountRequestDto) (*apicontract.BindMetaAccountResponseDto, *exceptions.Exception)
+	UnbindMetaAccount(ctx context.Context, requestDto *apicontract.UnbindMetaAccountRequestDto) (*apicontract.UnbindMetaAccountResponseDto, *exceptions.Exception)
+	BindOIDCAccount(ctx context.Context, requestDto *apicontract.BindOIDCAccountRequestDto) (*apicontract.BindOIDCAccountResponseDto, *exceptions.Exception)
+	UnbindOIDCAccount(ctx context.Context, requestDto *apicontract.UnbindOIDCAccountRequestDto) (*apicontract.UnbindOIDCAccountResponseDto, *exceptions.Exception)
+	GetMyUsage(ctx context.Context, requestDto *apicontract.GetMyUsageRequestDto) (*apicontract.GetMyUsageResponseDto, *exceptions.Exception)
+
+	// services for graphql users
+	GetMyUsageForGraphQL(ctx context.Context, requestDto *apicontract.GraphQLGetMyUsageRequestDto) (*apicontract.GraphQLGetMyUsageResponseDto, *exceptions.Exception)
 }
 
 type UserAccountService struct {
-	validator             *validator.Validate
-	db                    *gorm.DB
-	userRepository        repositories.UserRepositoryInterface
-	userAccountRepository repositories.UserAccountRepositoryInterface
-	userQuotaRepository   repositories.UserQuotaRepositoryInterface
-	oauthService          authservices.OAuthServiceInterface
+	validator               *validator.Validate
+	db                      *gorm.DB
+	userRepository          repositories.UserRepositoryInterface
+	userAccountRepository   repositories.UserAccountRepositoryInterface
+	userQuotaRepository     repositories.UserQuotaRepositoryInterface
+	usageSnapshotRepository repositories.UserUsageSnapshotRepositoryInterface
+	oauthService            authservices.OAuthServiceInterface
 }
 
 func NewUserAccountService(
@@ -44,18 +63,20 @@ func NewUserAccountService(
 	userRepository repositories.UserRepositoryInterface,
 	userAccountRepository repositories.UserAccountRepositoryInterface,
 	userQuotaRepository repositories.UserQuotaRepositoryInterface,
+	usageSnapshotRepository repositories.UserUsageSnapshotRepositoryInterface,
 	oauthService authservices.OAuthServiceInterface,
 ) UserAccountServiceInterface {
 	if db == nil {
 		db = data.DB
 	}
 	return &UserAccountService{
-		validator:             validator,
-		db:                    db,
-		userRepository:        userRepository,
-		userAccountRepository: userAccountRepository,
-		userQuotaRepository:   userQuotaRepository,
-		oauthService:          oauthService,
+		validator:               validator,
+		db:                      db,
+		userRepository:          userRepository,
+		userAccountRepository:   userAccountRepository,
+		userQuotaRepository:     userQuotaRepository,
+		usageSnapshotRepository: usageSnapshotRepository,
+		oauthService:            oauthService,
 	}
 }
 
@@ -104,6 +125,9 @@ func (s *UserAccountService) GetMyAccount(
 		PhoneNumber:              userAccount.PhoneNumber,
 		GoogleCredential:         userAccount.GoogleCredential,
 		DiscordCredential:        userAccount.DiscordCredential,
+		GithubCredential:         userAccount.GithubCredential,
+		MetaCredential:           userAccount.MetaCredential,
+		OIDCCredential:           userAccount.OIDCCredential,
 		RootShelfCount:           userAccount.RootShelfCount,
 		BlockPackCount:           userAccount.BlockPackCount,
 		BlockCount:               userAccount.BlockCount,
@@ -179,6 +203,26 @@ func (s *UserAccountService) UpdateMyAccount(
 	}, nil
 }
 
+func (s *UserAccountService) GetMyUsage(
+	ctx context.Context, requestDto *apicontract.GetMyUsageRequestDto,
+) (*apicontract.GetMyUsageResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(requestDto); err != nil {
+		return nil, exceptions.New(
+			"InvalidRequest",
+			"UserAccount",
+			"GetMyUsage",
+			"User usage request is invalid",
+			http.StatusBadRequest,
+		).WithOrigin(err)
+	}
+
+	days := int32(defaultUsageSnapshotDays)
+	if requestDto.Query.Days != nil {
+		days = *requestDto.Query.Days
+	}
+	return s.getMyUsage(ctx, days)
+}
+
 /* ============================== Service Methods for Binding Accounts ============================== */
 
 func (s *UserAccountService) BindGoogleAccount(
@@ -193,17 +237,243 @@ func (s *UserAccountService) BindGoogleAccount(
 			http.StatusBadRequest,
 		).WithOrigin(err)
 	}
+
+	if exception := s.bindOAuthAccount(
+		ctx,
+		enumcontract.OAuthProvider_Google,
+		"BindGoogleAccount",
+		requestDto.Body.AuthorizationCode,
+		requestDto.Body.State,
+		requestDto.Header.OAuthStateToken,
+		requestDto.Header.UserAgent,
+	); exception != nil {
+		return nil, exception
+	}
+
+	return &apicontract.BindGoogleAccountResponseDto{
+		UpdatedAt: time.Now(),
+	}, nil
+}
+
+func (s *UserAccountService) UnbindGoogleAccount(
+	ctx context.Context, requestDto *apicontract.UnbindGoogleAccountRequestDto,
+) (*apicontract.UnbindGoogleAccountResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(requestDto); err != nil {
+		return nil, exceptions.New(
+			"InvalidRequest",
+			"UserAccount",
+			"UnbindGoogleAccount",
+			"Google account unbinding request is invalid",
+			http.StatusBadRequest,
+		).WithOrigin(err)
+	}
+
+	if exception := s.unbindOAuthAccount(
+		ctx,
+		enumcontract.OAuthProvider_Google,
+		"UnbindGoogleAccount",
+		requestDto.Body.AuthCode,
+	); exception != nil {
+		return nil, exception
+	}
+
+	return &apicontract.UnbindGoogleAccountResponseDto{
+		UpdatedAt: time.Now(),
+	}, nil
+}
+
+func (s *UserAccountService) BindGithubAccount(
+	ctx context.Context, requestDto *apicontract.BindGithubAccountRequestDto,
+) (*apicontract.BindGithubAccountResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(requestDto); err != nil {
+		return nil, exceptions.New(
+			"InvalidRequest",
+			"UserAccount",
+			"BindGithubAccount",
+			"GitHub account binding request is invalid",
+			http.StatusBadRequest,
+		).WithOrigin(err)
+	}
+
+	if exception := s.bindOAuthAccount(
+		ctx,
+		enumcontract.OAuthProvider_Github,
+		"BindGithubAccount",
+		requestDto.Body.AuthorizationCode,
+		requestDto.Body.State,
+		requestDto.Header.OAuthStateToken,
+		requestDto.Header.UserAgent,
+	); exception != nil {
+		return nil, exception
+	}
+
+	return &apicontract.BindGithubAccountResponseDto{
+		UpdatedAt: time.Now(),
+	}, nil
+}
+
+func (s *UserAccountService) UnbindGithubAccount(
+	ctx context.Context, requestDto *apicontract.UnbindGithubAccountRequestDto,
+) (*apicontract.UnbindGithubAccountResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(requestDto); err != nil {
+		return nil, exceptions.New(
+			"InvalidRequest",
+			"UserAccount",
+			"UnbindGithubAccount",
+			"GitHub account unbinding request is invalid",
+			http.StatusBadRequest,
+		).WithOrigin(err)
+	}
+
+	if exception := s.unbindOAuthAccount(
+		ctx,
+		enumcontract.OAuthProvider_Github,
+		"UnbindGithubAccount",
+		requestDto.Body.AuthCode,
+	); exception != nil {
+		return nil, exception
+	}
+
+	return &apicontract.UnbindGithubAccountResponseDto{
+		UpdatedAt: time.Now(),
+	}, nil
+}
+
+func (s *UserAccountService) BindMetaAccount(
+	ctx context.Context, requestDto *apicontract.BindMetaAccountRequestDto,
+) (*apicontract.BindMetaAccountResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(requestDto); err != nil {
+		return nil, exceptions.New(
+			"InvalidRequest",
+			"UserAccount",
+			"BindMetaAccount",
+			"Meta account binding request is invalid",
+			http.StatusBadRequest,
+		).WithOrigin(err)
+	}
+
+	if exception := s.bindOAuthAccount(
+		ctx,
+		enumcontract.OAuthProvider_Meta,
+		"BindMetaAccount",
+		requestDto.Body.AuthorizationCode,
+		requestDto.Body.State,
+		requestDto.Header.OAuthStateToken,
+		requestDto.Header.UserAgent,
+	); exception != nil {
+		return nil, exception
+	}
+
+	return &apicontract.BindMetaAccountResponseDto{
+		UpdatedAt: time.Now(),
+	}, nil
+}
+
+func (s *UserAccountService) UnbindMetaAccount(
+	ctx context.Context, requestDto *apicontract.UnbindMetaAccountRequestDto,
+) (*apicontract.UnbindMetaAccountResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(requestDto); err != nil {
+		return nil, exceptions.New(
+			"InvalidRequest",
+			"UserAccount",
+			"UnbindMetaAccount",
+			"Meta account unbinding request is invalid",
+			http.StatusBadRequest,
+		).WithOrigin(err)
+	}
+
+	if exception := s.unbindOAuthAccount(
+		ctx,
+		enumcontract.OAuthProvider_Meta,
+		"UnbindMetaAccount",
+		requestDto.Body.AuthCode,
+	); exception != nil {
+		return nil, exception
+	}
+
+	return &apicontract.UnbindMetaAccountResponseDto{
+		UpdatedAt: time.Now(),
+	}, nil
+}
+
+func (s *UserAccountService) BindOIDCAccount(
+	ctx context.Context, requestDto *apicontract.BindOIDCAccountRequestDto,
+) (*apicontract.BindOIDCAccountResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(requestDto); err != nil {
+		return nil, exceptions.New(
+			"InvalidRequest",
+			"UserAccount",
+			"BindOIDCAccount",
+			"OpenID Connect account binding request is invalid",
+			http.StatusBadRequest,
+		).WithOrigin(err)
+	}
+
+	if exception := s.bindOAuthAccount(
+		ctx,
+		enumcontract.OAuthProvider_OIDC,
+		"BindOIDCAccount",
+		requestDto.Body.AuthorizationCode,
+		requestDto.Body.State,
+		requestDto.Header.OAuthStateToken,
+		requestDto.Header.UserAgent,
+	); exception != nil {
+		return nil, exception
+	}
+
+	return &apicontract.BindOIDCAccountResponseDto{
+		UpdatedAt: time.Now(),
+	}, nil
+}
+
+func (s *UserAccountService) UnbindOIDCAccount(
+	ctx context.Context, requestDto *apicontract.UnbindOIDCAccountRequestDto,
+) (*apicontract.UnbindOIDCAccountResponseDto, *exceptions.Exception) {
+	if err := s.validator.Struct(requestDto); err != nil {
+		return nil, exceptions.New(
+			"InvalidRequest",
+			"UserAccount",
+			"UnbindOIDCAccount",
+			"OpenID Connect account unbinding request is invalid",
+			http.StatusBadRequest,
+		).WithOrigin(err)
+	}
+
+	if exception := s.unbindOAuthAccount(
+		ctx,
+		enumcontract.OAuthProvider_OIDC,
+		"UnbindOIDCAccount",
+		requestDto.Body.AuthCode,
+	); exception != nil {
+		return nil, exception
+	}
+
+	return &apicontract.UnbindOIDCAccountResponseDto{
+		UpdatedAt: time.Now(),
+	}, nil
+}
+
+// bindOAuthAccount binds the identity of the OAuth provider to the actor, the identity
+// can only be bound to one user, and the provider has to be unbound before rebinding.
+func (s *UserAccountService) bindOAuthAccount(
+	ctx context.Context,
+	provider enumcontract.OAuthProvider,
+	method string,
+	authorizationCode string,
+	state string,
+	stateToken string,
+	userAgent string,
+) *exceptions.Exception {
 	actorUserId, exception := contexts.GetActorUserId(ctx)
 	if exception != nil {
-		return nil, exception
+		return exception
 	}
 
-	// Start transaction
 	db := s.db.WithContext(ctx)
 
-	userInfo, exception := s.oauthService.GetGoogleUserInfo(ctx, requestDto.Body.AuthorizationCode)
+	userInfo, exception := s.oauthService.GetUserInfo(ctx, provider, authorizationCode, state, stateToken, userAgent)
 	if exception != nil {
-		return nil, exception
+		return exception
 	}
 
 	user, exception := s.userRepository.GetOneById(
@@ -212,66 +482,70 @@ func (s *UserAccountService) BindGoogleAccount(
 		options.WithDB(db),
 	)
 	if exception != nil {
-		return nil, exception
+		return exception
 	}
 
-	if user.UserAccount.GoogleCredential != nil {
-		return nil, exceptions.New(
-			"GoogleCredentialAlreadyBound",
+	if user.UserAccount.GetOAuthCredential(provider) != nil {
+		return exceptions.New(
+			string(provider)+"CredentialAlreadyBound",
 			"UserAccount",
-			"BindGoogleAccount",
-			"Google credential is already bound",
-			http.StatusInternalServerError,
+			method,
+			string(provider)+" credential is already bound",
+			http.StatusConflict,
 		)
 	}
 
+	var values inputs.UpdateUserAccountInput
+	switch provider {
+	case enumcontract.OAuthProvider_Google:
+		values.GoogleCredential = &userInfo.Id
+	case enumcontract.OAuthProvider_Github:
+		values.GithubCredential = &userInfo.Id
+	case enumcontract.OAuthProvider_Meta:
+		values.MetaCredential = &userInfo.Id
+	case enumcontract.OAuthProvider_OIDC:
+		values.OIDCCredential = &userInfo.Id
+	}
 	_, exception = s.userAccountRepository.UpdateOneByUserId(
 		actorUserId,
 		inputs.PartialUpdateUserAccountInput{
-			Values: inputs.UpdateUserAccountInput{
-				GoogleCredential: &userInfo.Id,
-			},
+			Values:  values,
 			SetNull: nil,
 		},
 		options.WithDB(db),
 	)
-	if exception != nil {
-		return nil, exception
-	}
+	return exception
+}
 
-	return &apicontract.BindGoogleAccountResponseDto{
-		UpdatedAt: time.Now(),
-	}, nil
+// the fields of the credentials in the UpdateUserAccountInput, which are used to set them to null
+var oauthCredentialFields = map[enumcontract.OAuthProvider]string{
+	enumcontract.OAuthProvider_Google: "GoogleCredential",
+	enumcontract.OAuthProvider_Github: "GithubCredential",
+	enumcontract.OAuthProvider_Meta:   "MetaCredential",
+	enumcontract.OAuthProvider_OIDC:   "OIDCCredential",
 }
 
-func (s *UserAccountService) UnbindGoogleAccount(
-	ctx context.Context, requestDto *apicontract.UnbindGoogleAccountRequestDto,
-) (*apicontract.UnbindGoogleAccountResponseDto, *exceptions.Exception) {
-	if err := s.validator.Struct(requestDto); err != nil {
-		return nil, exceptions.New(
-			"InvalidRequest",
-			"UserAccount",
-			"UnbindGoogleAccount",
-			"Google account unbinding request is invalid",
-			http.StatusBadRequest,
-		).WithOrigin(err)
-	}
+func (s *UserAccountService) unbindOAuthAccount(
+	ctx context.Context,
+	provider enumcontract.OAuthProvider,
+	method string,
+	authCode string,
+) *exceptions.Exception {
 	actorUserId, exception := contexts.GetActorUserId(ctx)
 	if exception != nil {
-		return nil, exception
+		return exception
 	}
 
-	// Start transaction
 	db := s.db.WithContext(ctx)
 
 	result := db.Model(&schemas.UserAccount{}).
-		Where("user_id = ? AND auth_code = ?", actorUserId, requestDto.Body.AuthCode).
+		Where("user_id = ? AND auth_code = ?", actorUserId, authCode).
 		First(&schemas.UserAccount{})
 	if err := result.Error; err != nil {
-		return nil, exceptions.New(
+		return exceptions.New(
 			"NotFound",
 			"UserAccount",
-			"UnbindGoogleAccount",
+			method,
 			"User account was not found",
 			http.StatusNotFound,
 		).WithOrigin(err)
@@ -280,20 +554,150 @@ func (s *UserAccountService) UnbindGoogleAccount(
 	_, exception = s.userAccountRepository.UpdateOneByUserId(
 		actorUserId,
 		inputs.PartialUpdateUserAccountInput{
-			Values: inputs.UpdateUserAccountInput{
-				GoogleCredential: nil,
-			},
+			Values: inputs.UpdateUserAccountInput{},
 			SetNull: &map[string]bool{
-				"GoogleCredential": true,
+				oauthCredentialFields[provider]: true,
 			},
 		},
 		options.WithDB(db),
 	)
+	return exception
+}
+
+/* ============================== Service Methods for GraphQL UserAccount ============================== */
+
+func (s *UserAccountService) GetMyUsageForGraphQL(
+	ctx context.Context, requestDto *apicontract.GraphQLGetMyUsageRequestDto,
+) (*apicontract.GraphQLGetMyUsageResponseDto, *exceptions.Exception) {
+	days := int32(defaultUsageSnapshotDays)
+	if requestDto != nil && requestDto.Days != nil {
+		days = *requestDto.Days
+	}
+	if days < 1 || days > maxUsageSnapshotDays {
+		return nil, exceptions.New(
+			"InvalidRequest",
+			"UserAccount",
+			"GetMyUsageForGraphQL",
+			"User usage request is invalid",
+			http.StatusBadRequest,
+		)
+	}
+
+	return s.getMyUsage(ctx, days)
+}
+
+// getMyUsage reports the usage of the actor against the limitations of the plan, and
+// the daily snapshots of the last days (including today) to draw the usage trend
+func (s *UserAccountService) getMyUsage(
+	ctx context.Context, days int32,
+) (*gqlmodels.MyUsage, *exceptions.Exception) {
+	actorUserId, exception := contexts.GetActorUserId(ctx)
 	if exception != nil {
 		return nil, exception
 	}
 
-	return &apicontract.UnbindGoogleAccountResponseDto{
-		UpdatedAt: time.Now(),
+	db := s.db.WithContext(ctx)
+	now := time.Now()
+
+	planLimitation := schemas.PlanLimitation{}
+	result := db.Model(&schemas.User{}).
+		Select(`"PlanLimitationTable".*`).
+		Joins(`INNER JOIN "PlanLimitationTable" ON "PlanLimitationTable".key = "UserTable".plan`).
+		Where(`"UserTable".id = ?`, actorUserId).
+		Scan(&planLimitation)
+	if result.Error != nil {
+		return nil, exceptions.New(
+			"FailedToGet",
+			"UserAccount",
+			"GetMyUsage",
+			"Failed to retrieve the plan limitation",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, exceptions.New(
+			"NotFound",
+			"UserAccount",
+			"GetMyUsage",
+			"Plan limitation of the user was not found",
+			http.StatusNotFound,
+		)
+	}
+
+	userAccount, exception := s.userAccountRepository.GetOneByUserId(actorUserId, options.WithDB(db))
+	if exception != nil {
+		return nil, exception
+	}
+
+	userQuota, exception := s.userQuotaRepository.GetOneByUserId(ctx, actorUserId, options.WithDB(db))
+	if exception != nil {
+		return nil, exception
+	}
+	var routineTaskCostUnitUsed int64
+	var routineTaskCostUnitResetAt *time.Time
+	if userQuota != nil {
+		routineTaskCostUnitUsed = userQuota.RoutineTaskCostUnitUsed
+		routineTaskCostUnitResetAt = &userQuota.NextResetAt
+	}
+
+	snapshots, exception := s.usageSnapshotRepository.GetManyByUserIdSince(
+		ctx,
+		actorUserId,
+		now.AddDate(0, 0, -int(days-1)),
+		options.WithDB(db),
+	)
+	if exception != nil {
+		return nil, exception
+	}
+
+	usageSnapshots := make([]*gqlmodels.UsageSnapshot, len(snapshots))
+	for index, snapshot := range snapshots {
+		usageSnapshots[index] = &gqlmodels.UsageSnapshot{
+			Date:                    snapshot.SnapshotDate,
+			RootShelfCount:          snapshot.RootShelfCount,
+			BlockPackCount:          snapshot.BlockPackCount,
+			BlockCount:              snapshot.BlockCount,
+			MaterialCount:           snapshot.MaterialCount,
+			WorkflowCount:           snapshot.WorkflowCount,
+			AdditionalItemCount:     snapshot.AdditionalItemCount,
+			StationCount:            snapshot.StationCount,
+			RoutineCount:            snapshot.RoutineCount,
+			RoutineTagCount:         snapshot.RoutineTagCount,
+			RoutineTaskCostUnitUsed: snapshot.RoutineTaskCostUnitUsed,
+		}
+	}
+
+	return &gqlmodels.MyUsage{
+		Plan: enumcontract.UserPlan(planLimitation.Key),
+		Limits: []*gqlmodels.UsageLimit{
+			newUsageLimit(gqlmodels.UsageLimitKeyRootShelf, userAccount.RootShelfCount, int64(planLimitation.MaxRootShelfCount), nil),
+			newUsageLimit(gqlmodels.UsageLimitKeyBlockPack, userAccount.BlockPackCount, int64(planLimitation.MaxBlockPackCount), nil),
+			newUsageLimit(gqlmodels.UsageLimitKeyBlock, userAccount.BlockCount, int64(planLimitation.MaxBlockCount), nil),
+			newUsageLimit(gqlmodels.UsageLimitKeyMaterial, userAccount.MaterialCount, int64(planLimitation.MaxMaterialCount), nil),
+			newUsageLimit(gqlmodels.UsageLimitKeyWorkflow, userAccount.WorkflowCount, int64(planLimitation.MaxWorkflowCount), nil),
+			newUsageLimit(gqlmodels.UsageLimitKeyAdditionalItem, userAccount.AdditionalItemCount, int64(planLimitation.MaxAdditionalItemCount), nil),
+			newUsageLimit(gqlmodels.UsageLimitKeyStation, userAccount.StationCount, int64(planLimitation.MaxStationCount), nil),
+			newUsageLimit(gqlmodels.UsageLimitKeyRoutineTag, userAccount.RoutineTagCount, int64(planLimitation.MaxRoutineTagCount), nil),
+			newUsageLimit(gqlmodels.UsageLimitKeyRoutineTaskCostUnit, routineTaskCostUnitUsed, int64(planLimitation.MaxRoutineTaskCostUnitCount), routineTaskCostUnitResetAt),
+		},
+		Snapshots:   usageSnapshots,
+		GeneratedAt: now,
 	}, nil
 }
+
+// newUsageLimit reports a used amount against its limit, the percentage is rounded to two
+// decimal places, and a limit of zero is reported as fully used since nothing can be created
+func newUsageLimit(key gqlmodels.UsageLimitKey, used int64, limit int64, resetAt *time.Time) *gqlmodels.UsageLimit {
+	percentage := float64(100)
+	if limit > 0 {
+		percentage = math.Round(float64(used)/float64(limit)*100*100) / 100
+	}
+	return &gqlmodels.UsageLimit{
+		Key:        key,
+		Limit:      limit,
+		Used:       used,
+		Percentage: percentage,
+		ResetAt:    resetAt,
+	}
+}
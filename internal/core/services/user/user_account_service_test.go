@@ -0,0 +1,240 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	enumcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+
+	apicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/user-accounts"
+	gqlmodels "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/graphql/models"
+
+	contexts "github.com/HiIamJeff67/notegic-backend/internal/core/contexts"
+	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
+	repositories "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/repositories"
+	schemas "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas"
+	validation "github.com/HiIamJeff67/notegic-backend/internal/core/validations"
+)
+
+const testUserAgent = "NotegicTest/1.0"
+
+/* ============================== Test Doubles ============================== */
+
+// planLimitationConnector answers the plan limitation query of getMyUsage,
+// since the repositories are replaced by the fakes below
+type planLimitationConnector struct {
+	values [][]driver.Value
+}
+
+var planLimitationColumns = []string{"key", "max_root_shelf_count", "max_material_count", "max_routine_task_cost_unit_count"}
+
+func (c planLimitationConnector) Connect(context.Context) (driver.Conn, error) { return c, nil }
+func (c planLimitationConnector) Driver() driver.Driver                        { return c }
+func (c planLimitationConnector) Open(string) (driver.Conn, error)             { return c, nil }
+func (planLimitationConnector) Prepare(query string) (driver.Stmt, error) {
+	return nil, driver.ErrSkip
+}
+func (planLimitationConnector) Close() error                { return nil }
+func (c planLimitationConnector) Begin() (driver.Tx, error) { return c, nil }
+func (planLimitationConnector) Commit() error               { return nil }
+func (planLimitationConnector) Rollback() error             { return nil }
+func (c planLimitationConnector) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return &planLimitationRows{values: c.values}, nil
+}
+
+type planLimitationRows struct {
+	values [][]driver.Value
+}
+
+func (r *planLimitationRows) Columns() []string { return planLimitationColumns }
+func (r *planLimitationRows) Close() error      { return nil }
+func (r *planLimitationRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
+
+type fakeUserAccountRepository struct {
+	repositories.UserAccountRepositoryInterface
+	userAccount *schemas.UserAccount
+}
+
+func (r *fakeUserAccountRepository) GetOneByUserId(userId uuid.UUID, opts ...options.RepositoryOptions) (*schemas.UserAccount, *exceptions.Exception) {
+	userAccount := *r.userAccount
+	return &userAccount, nil
+}
+
+type fakeUserQuotaRepository struct {
+	repositories.UserQuotaRepositoryInterface
+	userQuota *schemas.UserQuota
+}
+
+func (r *fakeUserQuotaRepository) GetOneByUserId(ctx context.Context, userId uuid.UUID, opts ...options.RepositoryOptions) (*schemas.UserQuota, *exceptions.Exception) {
+	return r.userQuota, nil
+}
+
+type fakeUserUsageSnapshotRepository struct {
+	repositories.UserUsageSnapshotRepositoryInterface
+	snapshots []schemas.UserUsageSnapshot
+	since     time.Time
+}
+
+func (r *fakeUserUsageSnapshotRepository) GetManyByUserIdSince(ctx context.Context, userId uuid.UUID, since time.Time, opts ...options.RepositoryOptions) ([]schemas.UserUsageSnapshot, *exceptions.Exception) {
+	r.since = since
+	return r.snapshots, nil
+}
+
+/* ============================== Test Helpers ============================== */
+
+type usageTestFixture struct {
+	ctx                     context.Context
+	service                 UserAccountServiceInterface
+	userQuotaRepository     *fakeUserQuotaRepository
+	usageSnapshotRepository *fakeUserUsageSnapshotRepository
+}
+
+func newUsageTestFixture(t *testing.T, planLimitations ...[]driver.Value) *usageTestFixture {
+	t.Helper()
+
+	db, err := gorm.Open(
+		postgres.New(postgres.Config{Conn: sql.OpenDB(planLimitationConnector{values: planLimitations})}),
+		&gorm.Config{DisableAutomaticPing: true, SkipDefaultTransaction: true},
+	)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+
+	fixture := &usageTestFixture{
+		ctx: contexts.WithActorUserId(context.Background(), uuid.New()),
+		userQuotaRepository: &fakeUserQuotaRepository{userQuota: &schemas.UserQuota{
+			RoutineTaskCostUnitUsed: 25,
+			NextResetAt:             time.Now().Add(10 * 24 * time.Hour),
+		}},
+		usageSnapshotRepository: &fakeUserUsageSnapshotRepository{snapshots: []schemas.UserUsageSnapshot{
+			{SnapshotDate: time.Now().AddDate(0, 0, -1).Truncate(24 * time.Hour), RootShelfCount: 2, RoutineTaskCostUnitUsed: 180},
+			{SnapshotDate: time.Now().Truncate(24 * time.Hour), RootShelfCount: 3, RoutineTaskCostUnitUsed: 25},
+		}},
+	}
+	fixture.service = NewUserAccountService(
+		validation.New(),
+		db,
+		nil,
+		&fakeUserAccountRepository{userAccount: &schemas.UserAccount{RootShelfCount: 3, MaterialCount: 4}},
+		fixture.userQuotaRepository,
+		fixture.usageSnapshotRepository,
+		nil,
+	)
+
+	return fixture
+}
+
+func findUsageLimit(usage *gqlmodels.MyUsage, key gqlmodels.UsageLimitKey) *gqlmodels.UsageLimit {
+	for _, usageLimit := range usage.Limits {
+		if usageLimit.Key == key {
+			return usageLimit
+		}
+	}
+	return nil
+}
+
+/* ============================== Tests ============================== */
+
+func TestUserAccountServiceGetMyUsageReportsTheLimitsAndTheSnapshots(t *testing.T) {
+	fixture := newUsageTestFixture(t, []driver.Value{string(enumcontract.UserPlan_Pro), int64(10), int64(0), int64(200)})
+	days := int32(7)
+	requestDto := &apicontract.GetMyUsageRequestDto{}
+	requestDto.Header.UserAgent = testUserAgent
+	requestDto.Query.Days = &days
+
+	usage, exception := fixture.service.GetMyUsage(fixture.ctx, requestDto)
+	if exception != nil {
+		t.Fatalf("GetMyUsage() exception = %v", exception)
+	}
+
+	if usage.Plan != enumcontract.UserPlan_Pro {
+		t.Fatalf("GetMyUsage() plan = %s, want %s", usage.Plan, enumcontract.UserPlan_Pro)
+	}
+	tests := []struct {
+		key            gqlmodels.UsageLimitKey
+		wantUsed       int64
+		wantLimit      int64
+		wantPercentage float64
+		wantResetAt    bool
+	}{
+		{gqlmodels.UsageLimitKeyRootShelf, 3, 10, 30, false},
+		// nothing can be created under a limit of zero, so it is reported as fully used
+		{gqlmodels.UsageLimitKeyMaterial, 4, 0, 100, false},
+		{gqlmodels.UsageLimitKeyRoutineTaskCostUnit, 25, 200, 12.5, true},
+	}
+	for _, test := range tests {
+		usageLimit := findUsageLimit(usage, test.key)
+		if usageLimit == nil {
+			t.Fatalf("GetMyUsage() does not report the limit of %s", test.key)
+		}
+		if usageLimit.Used != test.wantUsed || usageLimit.Limit != test.wantLimit ||
+			usageLimit.Percentage != test.wantPercentage || (usageLimit.ResetAt != nil) != test.wantResetAt {
+			t.Errorf("GetMyUsage() limit of %s = %+v", test.key, usageLimit)
+		}
+	}
+
+	if len(usage.Snapshots) != 2 || usage.Snapshots[0].RoutineTaskCostUnitUsed != 180 || usage.Snapshots[1].RootShelfCount != 3 {
+		t.Fatalf("GetMyUsage() snapshots = %+v, want the recorded ones in order", usage.Snapshots)
+	}
+	// the days include today
+	wantSince := time.Now().AddDate(0, 0, -6)
+	if since := fixture.usageSnapshotRepository.since; since.Sub(wantSince).Abs() > time.Minute {
+		t.Fatalf("GetMyUsage() since = %v, want %v", since, wantSince)
+	}
+}
+
+func TestUserAccountServiceGetMyUsageWithoutAQuota(t *testing.T) {
+	fixture := newUsageTestFixture(t, []driver.Value{string(enumcontract.UserPlan_Free), int64(1), int64(1), int64(10)})
+	fixture.userQuotaRepository.userQuota = nil
+
+	usage, exception := fixture.service.GetMyUsageForGraphQL(fixture.ctx, nil)
+	if exception != nil {
+		t.Fatalf("GetMyUsageForGraphQL() exception = %v", exception)
+	}
+
+	usageLimit := findUsageLimit(usage, gqlmodels.UsageLimitKeyRoutineTaskCostUnit)
+	if usageLimit.Used != 0 || usageLimit.ResetAt != nil {
+		t.Fatalf("GetMyUsageForGraphQL() limit of the cost units = %+v, want nothing used and no reset", usageLimit)
+	}
+	wantSince := time.Now().AddDate(0, 0, -(defaultUsageSnapshotDays - 1))
+	if since := fixture.usageSnapshotRepository.since; since.Sub(wantSince).Abs() > time.Minute {
+		t.Fatalf("GetMyUsageForGraphQL() since = %v, want the default %d days", since, defaultUsageSnapshotDays)
+	}
+}
+
+func TestUserAccountServiceGetMyUsageWithoutAPlanLimitation(t *testing.T) {
+	fixture := newUsageTestFixture(t)
+
+	_, exception := fixture.service.GetMyUsageForGraphQL(fixture.ctx, nil)
+	if exception == nil || exception.HTTPStatusCode() != http.StatusNotFound {
+		t.Fatalf("GetMyUsageForGraphQL() exception = %v, want NotFound", exception)
+	}
+}
+
+func TestUserAccountServiceGetMyUsageForGraphQLRejectsTheDaysOutOfRange(t *testing.T) {
+	fixture := newUsageTestFixture(t, []driver.Value{string(enumcontract.UserPlan_Free), int64(1), int64(1), int64(10)})
+
+	for _, days := range []int32{0, maxUsageSnapshotDays + 1} {
+		_, exception := fixture.service.GetMyUsageForGraphQL(fixture.ctx, &apicontract.GraphQLGetMyUsageRequestDto{Days: &days})
+		if exception == nil || exception.HTTPStatusCode() != http.StatusBadRequest {
+			t.Fatalf("GetMyUsageForGraphQL() of %d days exception = %v, want InvalidRequest", days, exception)
+		}
+	}
+}
@@ -29,6 +29,7 @@ import (
 	repositories "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/repositories"
 	schemas "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas"
 	enums "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas/enums"
+	scopes "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/scopes"
 )
 
 type UserServiceInterface interface {
@@ -358,54 +359,21 @@ func (s *UserService) SearchPublicUsers(
 
 		query = query.Where("EXISTS (?)", usersWithBadge)
 	}
-	if gqlInput.After != nil && len(strings.ReplaceAll(*gqlInput.After, " ", "")) > 0 {
-		searchCursor, err := searchcursor.Decode[gqlmodels.SearchUserCursorFields](*gqlInput.After)
-		if err != nil {
-			return nil, exceptions.New(
-				"CursorDecodeFailed",
-				"Search",
-				"SearchPublicUsers",
-				"Failed to decode the search cursor",
-				http.StatusInternalServerError,
-				true,
-			).WithOrigin(err)
-		}
-
-		query = query.Where("public_id > ?", searchCursor.Fields.PublicID)
-	}
 
-	if gqlInput.SortBy != nil && gqlInput.SortOrder != nil {
-		var cending string = gqlmodels.SearchSortOrderAsc.String()
-		if *gqlInput.SortOrder == gqlmodels.SearchSortOrderDesc {
-			cending = gqlmodels.SearchSortOrderDesc.String()
-		}
-
-		switch *gqlInput.SortBy {
-		case gqlmodels.SearchUserSortByName:
-			query.Order("name " + cending).
-				Order("updated_at " + cending).
-				Order("created_at " + cending)
-		case gqlmodels.SearchUserSortByLastActive:
-			query.Order("updated_at " + cending).
-				Order("name " + cending).
-				Order("created_at " + cending)
-		case gqlmodels.SearchUserSortByCreatedAt:
-			query.Order("created_at " + cending).
-				Order("name " + cending).
-				Order("updated_at " + cending)
-		default:
-			query.Order("updated_at " + cending).
-				Order("name " + cending).
-				Order("created_at " + cending)
-		}
-	}
-
-	limit := constants.DefaultSearchLimit
-	if gqlInput.First != nil && *gqlInput.First > 0 {
-		limit = int(*gqlInput.First)
+	keyset := newUserSearchKeyset(gqlInput)
+	page, err := keyset.NewPage(gqlInput.After, gqlInput.Before, gqlInput.First, gqlInput.Last, constants.DefaultSearchLimit, constants.MaxSearchLimit)
+	if err != nil {
+		return nil, exceptions.New(
+			"CursorDecodeFailed",
+			"Search",
+			"SearchPublicUsers",
+			"Failed to decode the search cursor",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(err)
 	}
-	limit = min(limit, constants.MaxSearchLimit)
-	query = query.Limit(limit + 1)
+	query = query.Scopes(scopes.PaginateByKeyset(keyset, page.AfterCursor, page.BeforeCursor, page.IsBackward)).
+		Limit(page.Limit + 1)
 
 	var users []schemas.User
 	if err := query.Find(&users).Error; err != nil {
@@ -419,16 +387,22 @@ func (s *UserService) SearchPublicUsers(
 		).WithOrigin(err)
 	}
 
-	hasNextPage := len(users) > limit // since we fetch an additional one
+	users, hasNextPage, hasPreviousPage := searchcursor.Slice(page, users)
 	searchEdges := make([]*gqlmodels.SearchUserEdge, len(users))
 
 	for index, user := range users {
-		searchCursor := searchcursor.SearchCursor[gqlmodels.SearchUserCursorFields]{
-			Fields: gqlmodels.SearchUserCursorFields{
-				PublicID: user.PublicId,
-			},
+		var sortValues []any
+		switch keyset.SortBy {
+		case gqlmodels.SearchUserSortByName.String():
+			sortValues = []any{user.Name, user.UpdatedAt, user.CreatedAt, user.PublicId}
+		case gqlmodels.SearchUserSortByCreatedAt.String():
+			sortValues = []any{user.CreatedAt, user.Name, user.UpdatedAt, user.PublicId}
+		case gqlmodels.SearchUserSortByLastActive.String():
+			sortValues = []any{user.UpdatedAt, user.Name, user.CreatedAt, user.PublicId}
+		default:
+			sortValues = []any{user.PublicId}
 		}
-		encodedSearchCursor, err := searchCursor.Encode()
+		encodedSearchCursor, err := keyset.Encode(sortValues...)
 		if err != nil {
 			return nil, exceptions.New(
 				"CursorEncodeFailed",
@@ -439,26 +413,16 @@ func (s *UserService) SearchPublicUsers(
 				true,
 			).WithOrigin(err)
 		}
-		if encodedSearchCursor == nil {
-			return nil, exceptions.New(
-				"CursorEncodingFailed",
-				"Search",
-				"SearchPublicUsers",
-				"Failed to encode the search cursor",
-				http.StatusInternalServerError,
-				true,
-			)
-		}
 
 		searchEdges[index] = &gqlmodels.SearchUserEdge{
-			EncodedSearchCursor: *encodedSearchCursor,
+			EncodedSearchCursor: encodedSearchCursor,
 			Node:                user.ToPublicUser(),
 		}
 	}
 
 	searchPageInfo := &gqlmodels.SearchPageInfo{
 		HasNextPage:     hasNextPage,
-		HasPreviousPage: gqlInput.After != nil && len(strings.ReplaceAll(*gqlInput.After, " ", "")) > 0,
+		HasPreviousPage: hasPreviousPage,
 	}
 
 	if len(searchEdges) > 0 {
@@ -467,9 +431,6 @@ func (s *UserService) SearchPublicUsers(
 	}
 
 	searchTime := float64(time.Since(startTime).Nanoseconds()) / 1e6
-	if hasNextPage {
-		searchEdges = searchEdges[:limit]
-	}
 
 	return &gqlmodels.SearchUserConnection{
 		SearchEdges:    searchEdges,
@@ -478,3 +439,28 @@ func (s *UserService) SearchPublicUsers(
 		SearchTime:     searchTime,
 	}, nil
 }
+
+/* ============================== Auxiliary Functions ============================== */
+
+// newUserSearchKeyset builds the keyset of the requested sort order, where the public id is used as the tie-breaker to avoid exposing the id
+func newUserSearchKeyset(gqlInput gqlmodels.SearchUserInput) *searchcursor.Keyset {
+	isDescending := gqlInput.SortOrder != nil && *gqlInput.SortOrder == gqlmodels.SearchSortOrderDesc
+
+	publicIdKey := searchcursor.KeysetKey{Column: `"UserTable".public_id`, Type: "uuid"}
+	nameKey := searchcursor.KeysetKey{Column: `"UserTable".name`, Type: "text"}
+	updatedAtKey := searchcursor.KeysetKey{Column: `"UserTable".updated_at`, Type: "timestamptz"}
+	createdAtKey := searchcursor.KeysetKey{Column: `"UserTable".created_at`, Type: "timestamptz"}
+
+	if gqlInput.SortBy == nil {
+		return searchcursor.NewKeyset("", isDescending, publicIdKey)
+	}
+
+	switch *gqlInput.SortBy {
+	case gqlmodels.SearchUserSortByName:
+		return searchcursor.NewKeyset(gqlmodels.SearchUserSortByName.String(), isDescending, nameKey, updatedAtKey, createdAtKey, publicIdKey)
+	case gqlmodels.SearchUserSortByCreatedAt:
+		return searchcursor.NewKeyset(gqlmodels.SearchUserSortByCreatedAt.String(), isDescending, createdAtKey, nameKey, updatedAtKey, publicIdKey)
+	default:
+		return searchcursor.NewKeyset(gqlmodels.SearchUserSortByLastActive.String(), isDescending, updatedAtKey, nameKey, createdAtKey, publicIdKey)
+	}
+}
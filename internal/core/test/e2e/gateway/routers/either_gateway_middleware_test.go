@@ -50,10 +50,12 @@ func configureDelegationTestEnvironment(t *testing.T) {
 
 func issueAPIDelegationToken(t *testing.T, operation string) string {
 	t.Helper()
+	apiKeyScope, _ := sharedtokens.GetRequiredAPIKeyScope(operation)
 	token, err := sharedtokens.GenerateDelegationToken(sharedtokens.DelegationTokenClaims{
 		Actor:         "gateway",
 		GatewaySource: sharedtokens.GatewaySourceAPI,
 		AuthMethod:    sharedtokens.AuthMethodAPIKey,
+		APIKeyScope:   apiKeyScope,
 		Operation:     operation,
 		RequestId:     "request-id",
 	})
@@ -0,0 +1,346 @@
+package billing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	coreconfig "github.com/HiIamJeff67/notegic-backend/internal/core/configs"
+)
+
+const (
+	payPalRequestTimeout        = 10 * time.Second
+	payPalMaximumResponseBytes  = 1 << 20
+	payPalAccessTokenExpiryLeap = time.Minute
+)
+
+// PayPalClient talks to the Subscriptions API of PayPal, the access token of the
+// client credentials is cached and renewed shortly before it expires.
+type PayPalClient struct {
+	baseURL      string
+	clientId     string
+	clientSecret string
+	webhookId    string
+	returnURL    string
+	cancelURL    string
+	httpClient   *http.Client
+
+	mutex                sync.Mutex
+	accessToken          string
+	accessTokenExpiresAt time.Time
+}
+
+func NewPayPalClient(config coreconfig.PayPalConfig) *PayPalClient {
+	return &PayPalClient{
+		baseURL:      config.BaseUrl,
+		clientId:     config.ClientId,
+		clientSecret: config.ClientSecret,
+		webhookId:    config.WebhookId,
+		returnURL:    config.ReturnUrl,
+		cancelURL:    config.CancelUrl,
+		httpClient: &http.Client{
+			Timeout: payPalRequestTimeout,
+		},
+	}
+}
+
+type payPalLink struct {
+	Href string `json:"href"`
+	Rel  string `json:"rel"`
+}
+
+type payPalSubscription struct {
+	Id          string       `json:"id"`
+	PlanId      string       `json:"plan_id"`
+	CustomId    string       `json:"custom_id"`
+	Status      string       `json:"status"`
+	Links       []payPalLink `json:"links"`
+	BillingInfo *struct {
+		NextBillingTime *time.Time `json:"next_billing_time"`
+	} `json:"billing_info"`
+}
+
+type payPalApplicationContext struct {
+	ReturnURL          string `json:"return_url"`
+	CancelURL          string `json:"cancel_url"`
+	UserAction         string `json:"user_action"`
+	ShippingPreference string `json:"shipping_preference"`
+}
+
+func (s payPalSubscription) toSubscription() *Subscription {
+	subscription := &Subscription{
+		Id:       s.Id,
+		PlanId:   s.PlanId,
+		CustomId: s.CustomId,
+		Status:   s.Status,
+	}
+	for _, link := range s.Links {
+		if link.Rel == "approve" {
+			subscription.ApprovalURL = link.Href
+			break
+		}
+	}
+	if s.BillingInfo != nil {
+		subscription.NextBillingTime = s.BillingInfo.NextBillingTime
+	}
+	return subscription
+}
+
+func (c *PayPalClient) applicationContext() payPalApplicationContext {
+	return payPalApplicationContext{
+		ReturnURL:          c.returnURL,
+		CancelURL:          c.cancelURL,
+		UserAction:         "SUBSCRIBE_NOW",
+		ShippingPreference: "NO_SHIPPING",
+	}
+}
+
+/* ============================== Provider Methods ============================== */
+
+func (c *PayPalClient) CreateSubscription(
+	ctx context.Context,
+	input CreateSubscriptionInput,
+) (*Subscription, error) {
+	var response payPalSubscription
+	if err := c.do(ctx, http.MethodPost, "/v1/billing/subscriptions", map[string]string{
+		"PayPal-Request-Id": input.RequestId,
+	}, struct {
+		PlanId             string                   `json:"plan_id"`
+		CustomId           string                   `json:"custom_id"`
+		ApplicationContext payPalApplicationContext `json:"application_context"`
+	}{
+		PlanId:             input.PlanId,
+		CustomId:           input.CustomId,
+		ApplicationContext: c.applicationContext(),
+	}, &response); err != nil {
+		return nil, err
+	}
+	if response.Id == "" {
+		return nil, errors.New("PayPal subscription response does not contain a subscription ID")
+	}
+
+	return response.toSubscription(), nil
+}
+
+func (c *PayPalClient) GetSubscription(
+	ctx context.Context,
+	subscriptionId string,
+) (*Subscription, error) {
+	var response payPalSubscription
+	if err := c.do(
+		ctx,
+		http.MethodGet,
+		"/v1/billing/subscriptions/"+url.PathEscape(subscriptionId),
+		nil,
+		nil,
+		&response,
+	); err != nil {
+		return nil, err
+	}
+
+	return response.toSubscription(), nil
+}
+
+// ReviseSubscription changes the plan of the subscription, the change only takes effect
+// after the user approves it through the returned ApprovalURL, and PayPal then notifies
+// the change with a BILLING.SUBSCRIPTION.UPDATED webhook.
+func (c *PayPalClient) ReviseSubscription(
+	ctx context.Context,
+	subscriptionId string,
+	planId string,
+) (*Subscription, error) {
+	var response payPalSubscription
+	if err := c.do(ctx, http.MethodPost, "/v1/billing/subscriptions/"+url.PathEscape(subscriptionId)+"/revise", nil, struct {
+		PlanId             string                   `json:"plan_id"`
+		ApplicationContext payPalApplicationContext `json:"application_context"`
+	}{
+		PlanId:             planId,
+		ApplicationContext: c.applicationContext(),
+	}, &response); err != nil {
+		return nil, err
+	}
+
+	subscription := response.toSubscription()
+	subscription.Id = subscriptionId
+	return subscription, nil
+}
+
+func (c *PayPalClient) CancelSubscription(
+	ctx context.Context,
+	subscriptionId string,
+	reason string,
+) error {
+	return c.do(ctx, http.MethodPost, "/v1/billing/subscriptions/"+url.PathEscape(subscriptionId)+"/cancel", nil, struct {
+		Reason string `json:"reason"`
+	}{
+		Reason: reason,
+	}, nil)
+}
+
+// VerifyWebhookEvent asks PayPal to verify the signature of the delivery against the
+// webhook of this application, so a delivery forged or sent to another webhook is rejected.
+func (c *PayPalClient) VerifyWebhookEvent(
+	ctx context.Context,
+	headers WebhookHeaders,
+	payload []byte,
+) (*WebhookEvent, error) {
+	var event WebhookEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, fmt.Errorf("decode PayPal webhook event: %w", err)
+	}
+	if event.Id == "" || event.EventType == "" {
+		return nil, errors.New("PayPal webhook event does not contain an event ID and type")
+	}
+	if headers.AuthAlgo == "" ||
+		headers.CertURL == "" ||
+		headers.TransmissionId == "" ||
+		headers.TransmissionSig == "" ||
+		headers.TransmissionTime == "" {
+		return nil, ErrInvalidWebhookSignature
+	}
+
+	var response struct {
+		VerificationStatus string `json:"verification_status"`
+	}
+	if err := c.do(ctx, http.MethodPost, "/v1/notifications/verify-webhook-signature", nil, struct {
+		AuthAlgo         string          `json:"auth_algo"`
+		CertURL          string          `json:"cert_url"`
+		TransmissionId   string          `json:"transmission_id"`
+		TransmissionSig  string          `json:"transmission_sig"`
+		TransmissionTime string          `json:"transmission_time"`
+		WebhookId        string          `json:"webhook_id"`
+		WebhookEvent     json.RawMessage `json:"webhook_event"`
+	}{
+		AuthAlgo:         headers.AuthAlgo,
+		CertURL:          headers.CertURL,
+		TransmissionId:   headers.TransmissionId,
+		TransmissionSig:  headers.TransmissionSig,
+		TransmissionTime: headers.TransmissionTime,
+		WebhookId:        c.webhookId,
+		WebhookEvent:     payload,
+	}, &response); err != nil {
+		return nil, err
+	}
+	if response.VerificationStatus != "SUCCESS" {
+		return nil, ErrInvalidWebhookSignature
+	}
+
+	return &event, nil
+}
+
+/* ============================== Private Methods ============================== */
+
+func (c *PayPalClient) getAccessToken(ctx context.Context) (string, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.accessToken != "" && time.Now().Before(c.accessTokenExpiresAt) {
+		return c.accessToken, nil
+	}
+
+	request, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		c.baseURL+"/v1/oauth2/token",
+		strings.NewReader(url.Values{"grant_type": {"client_credentials"}}.Encode()),
+	)
+	if err != nil {
+		return "", fmt.Errorf("create PayPal access token request: %w", err)
+	}
+	request.SetBasicAuth(c.clientId, c.clientSecret)
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var response struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := c.send(request, "access token", &response); err != nil {
+		return "", err
+	}
+	if response.AccessToken == "" {
+		return "", errors.New("PayPal access token response is invalid")
+	}
+
+	c.accessToken = response.AccessToken
+	c.accessTokenExpiresAt = time.Now().Add(time.Duration(response.ExpiresIn)*time.Second - payPalAccessTokenExpiryLeap)
+	return c.accessToken, nil
+}
+
+func (c *PayPalClient) do(
+	ctx context.Context,
+	method string,
+	path string,
+	headers map[string]string,
+	requestBody any,
+	responseBody any,
+) error {
+	accessToken, err := c.getAccessToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	var body io.Reader
+	if requestBody != nil {
+		payload, err := json.Marshal(requestBody)
+		if err != nil {
+			return fmt.Errorf("encode PayPal %s request: %w", path, err)
+		}
+		body = bytes.NewReader(payload)
+	}
+	request, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return fmt.Errorf("create PayPal %s request: %w", path, err)
+	}
+	request.Header.Set("Authorization", "Bearer "+accessToken)
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+	for key, header := range headers {
+		request.Header.Set(key, header)
+	}
+
+	return c.send(request, path, responseBody)
+}
+
+func (c *PayPalClient) send(request *http.Request, operation string, responseBody any) error {
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("send PayPal %s request: %w", operation, err)
+	}
+	defer response.Body.Close()
+
+	responsePayload, err := io.ReadAll(io.LimitReader(response.Body, payPalMaximumResponseBytes+1))
+	if err != nil {
+		return fmt.Errorf("read PayPal %s response: %w", operation, err)
+	}
+	if len(responsePayload) > payPalMaximumResponseBytes {
+		return fmt.Errorf("PayPal %s response exceeds the size limit", operation)
+	}
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		providerError := &ProviderError{StatusCode: response.StatusCode}
+		var errorResponse struct {
+			Name    string `json:"name"`
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(responsePayload, &errorResponse) == nil {
+			providerError.Name = errorResponse.Name
+			providerError.Message = errorResponse.Message
+		}
+		return providerError
+	}
+	if responseBody == nil || len(responsePayload) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(responsePayload, responseBody); err != nil {
+		return fmt.Errorf("decode PayPal %s response: %w", operation, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package billing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	coreconfig "github.com/HiIamJeff67/notegic-backend/internal/core/configs"
+	paypalfake "github.com/HiIamJeff67/notegic-backend/internal/core/transports/billing/paypalfake"
+)
+
+var _ ProviderInterface = (*PayPalClient)(nil)
+
+func newTestPayPalClient(server *paypalfake.Server) *PayPalClient {
+	return NewPayPalClient(coreconfig.PayPalConfig{
+		BaseUrl:      server.URL,
+		ClientId:     paypalfake.ClientId,
+		ClientSecret: paypalfake.ClientSecret,
+		WebhookId:    paypalfake.WebhookId,
+		ReturnUrl:    "http://client/billing/approved",
+		CancelUrl:    "http://client/billing/cancelled",
+	})
+}
+
+func TestPayPalClientSubscriptionLifecycle(t *testing.T) {
+	server := paypalfake.NewServer()
+	defer server.Close()
+	client := newTestPayPalClient(server)
+	ctx := context.Background()
+
+	created, err := client.CreateSubscription(ctx, CreateSubscriptionInput{
+		RequestId: "request-1",
+		PlanId:    "P-MONTHLY-PRO",
+		CustomId:  "user-1",
+	})
+	if err != nil {
+		t.Fatalf("CreateSubscription() error = %v", err)
+	}
+	if created.Status != "APPROVAL_PENDING" || created.ApprovalURL == "" || created.CustomId != "user-1" {
+		t.Fatalf("CreateSubscription() = %#v", created)
+	}
+	retried, err := client.CreateSubscription(ctx, CreateSubscriptionInput{
+		RequestId: "request-1",
+		PlanId:    "P-MONTHLY-PRO",
+		CustomId:  "user-1",
+	})
+	if err != nil || retried.Id != created.Id {
+		t.Fatalf("CreateSubscription() retried = %#v, %v, want the subscription %s", retried, err, created.Id)
+	}
+
+	_, err = client.ReviseSubscription(ctx, created.Id, "P-MONTHLY-PREMIUM")
+	var providerError *ProviderError
+	if !errors.As(err, &providerError) || !providerError.IsClientError() {
+		t.Fatalf("ReviseSubscription() error = %v, want a client error before the subscription is approved", err)
+	}
+
+	server.Approve(created.Id)
+	activated, err := client.GetSubscription(ctx, created.Id)
+	if err != nil {
+		t.Fatalf("GetSubscription() error = %v", err)
+	}
+	if activated.Status != "ACTIVE" || activated.NextBillingTime == nil || activated.ApprovalURL != "" {
+		t.Fatalf("GetSubscription() = %#v", activated)
+	}
+
+	revised, err := client.ReviseSubscription(ctx, created.Id, "P-MONTHLY-PREMIUM")
+	if err != nil {
+		t.Fatalf("ReviseSubscription() error = %v", err)
+	}
+	if revised.Id != created.Id || revised.PlanId != "P-MONTHLY-PREMIUM" || revised.ApprovalURL == "" {
+		t.Fatalf("ReviseSubscription() = %#v", revised)
+	}
+
+	if err := client.CancelSubscription(ctx, created.Id, "Not needed anymore"); err != nil {
+		t.Fatalf("CancelSubscription() error = %v", err)
+	}
+	if subscription, _ := server.Subscription(created.Id); subscription.Status != "CANCELLED" {
+		t.Fatalf("subscription status = %s, want CANCELLED", subscription.Status)
+	}
+}
+
+func TestPayPalClientVerifyWebhookEvent(t *testing.T) {
+	server := paypalfake.NewServer()
+	defer server.Close()
+	client := newTestPayPalClient(server)
+	ctx := context.Background()
+
+	payload := []byte(`{
+		"id": "WH-1",
+		"event_type": "BILLING.SUBSCRIPTION.ACTIVATED",
+		"resource_type": "subscription",
+		"resource": {"id": "I-FAKE00000001", "status": "ACTIVE"}
+	}`)
+	signedHeaders := server.SignWebhookEvent(payload)
+	headers := WebhookHeaders{
+		AuthAlgo:         signedHeaders.Get("PAYPAL-AUTH-ALGO"),
+		CertURL:          signedHeaders.Get("PAYPAL-CERT-URL"),
+		TransmissionId:   signedHeaders.Get("PAYPAL-TRANSMISSION-ID"),
+		TransmissionSig:  signedHeaders.Get("PAYPAL-TRANSMISSION-SIG"),
+		TransmissionTime: signedHeaders.Get("PAYPAL-TRANSMISSION-TIME"),
+	}
+
+	event, err := client.VerifyWebhookEvent(ctx, headers, payload)
+	if err != nil {
+		t.Fatalf("VerifyWebhookEvent() error = %v", err)
+	}
+	if event.Id != "WH-1" || event.EventType != "BILLING.SUBSCRIPTION.ACTIVATED" || len(event.Resource) == 0 {
+		t.Fatalf("VerifyWebhookEvent() = %#v", event)
+	}
+
+	tampered := []byte(`{"id":"WH-1","event_type":"BILLING.SUBSCRIPTION.CANCELLED","resource":{}}`)
+	if _, err := client.VerifyWebhookEvent(ctx, headers, tampered); !errors.Is(err, ErrInvalidWebhookSignature) {
+		t.Fatalf("VerifyWebhookEvent() tampered error = %v, want %v", err, ErrInvalidWebhookSignature)
+	}
+	if _, err := client.VerifyWebhookEvent(ctx, WebhookHeaders{}, payload); !errors.Is(err, ErrInvalidWebhookSignature) {
+		t.Fatalf("VerifyWebhookEvent() unsigned error = %v, want %v", err, ErrInvalidWebhookSignature)
+	}
+}
+
+func TestPayPalClientRejectsInvalidCredentials(t *testing.T) {
+	server := paypalfake.NewServer()
+	defer server.Close()
+	client := NewPayPalClient(coreconfig.PayPalConfig{
+		BaseUrl:      server.URL,
+		ClientId:     paypalfake.ClientId,
+		ClientSecret: "wrong-secret",
+	})
+
+	_, err := client.GetSubscription(context.Background(), "I-FAKE00000001")
+	var providerError *ProviderError
+	if !errors.As(err, &providerError) || providerError.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("GetSubscription() error = %v, want an unauthorized provider error", err)
+	}
+}
@@ -0,0 +1,327 @@
+// Package paypalfake serves the subset of the PayPal REST API used by the billing transport,
+// so the subscription flow and the webhook verification can be exercised without the sandbox.
+package paypalfake
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	ClientId     = "fake-paypal-client-id"
+	ClientSecret = "fake-paypal-client-secret"
+	WebhookId    = "FAKE-WEBHOOK-ID"
+
+	accessToken = "fake-paypal-access-token"
+	signingKey  = "fake-paypal-signing-key"
+)
+
+type Subscription struct {
+	Id              string
+	PlanId          string
+	PendingPlanId   string
+	CustomId        string
+	Status          string
+	NextBillingTime time.Time
+}
+
+type Server struct {
+	*httptest.Server
+
+	mutex         sync.Mutex
+	subscriptions map[string]*Subscription
+	requestIds    map[string]string
+	sequence      int
+}
+
+func NewServer() *Server {
+	server := &Server{
+		subscriptions: make(map[string]*Subscription),
+		requestIds:    make(map[string]string),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/oauth2/token", server.handleAccessToken)
+	mux.HandleFunc("POST /v1/billing/subscriptions", server.authorize(server.handleCreateSubscription))
+	mux.HandleFunc("GET /v1/billing/subscriptions/{id}", server.authorize(server.handleGetSubscription))
+	mux.HandleFunc("POST /v1/billing/subscriptions/{id}/revise", server.authorize(server.handleReviseSubscription))
+	mux.HandleFunc("POST /v1/billing/subscriptions/{id}/cancel", server.authorize(server.handleCancelSubscription))
+	mux.HandleFunc("POST /v1/notifications/verify-webhook-signature", server.authorize(server.handleVerifyWebhookSignature))
+	server.Server = httptest.NewServer(mux)
+
+	return server
+}
+
+/* ============================== Test Helpers ============================== */
+
+// Approve acts as the user approving the subscription or its pending plan change at PayPal.
+func (s *Server) Approve(subscriptionId string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	subscription, ok := s.subscriptions[subscriptionId]
+	if !ok {
+		return false
+	}
+	if subscription.PendingPlanId != "" {
+		subscription.PlanId = subscription.PendingPlanId
+		subscription.PendingPlanId = ""
+	}
+	subscription.Status = "ACTIVE"
+	subscription.NextBillingTime = time.Now().UTC().AddDate(0, 1, 0).Truncate(time.Second)
+	return true
+}
+
+func (s *Server) Subscription(subscriptionId string) (Subscription, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	subscription, ok := s.subscriptions[subscriptionId]
+	if !ok {
+		return Subscription{}, false
+	}
+	return *subscription, true
+}
+
+// SignWebhookEvent returns the transmission headers PayPal would deliver the payload with,
+// following the layout of the signed message of PayPal but with a shared key.
+func (s *Server) SignWebhookEvent(payload []byte) http.Header {
+	transmissionId := fmt.Sprintf("fake-transmission-%d", s.nextSequence())
+	transmissionTime := time.Now().UTC().Format(time.RFC3339)
+
+	headers := http.Header{}
+	headers.Set("PAYPAL-AUTH-ALGO", "SHA256withRSA")
+	headers.Set("PAYPAL-CERT-URL", s.URL+"/v1/notifications/certs/fake")
+	headers.Set("PAYPAL-TRANSMISSION-ID", transmissionId)
+	headers.Set("PAYPAL-TRANSMISSION-SIG", sign(transmissionId, transmissionTime, WebhookId, payload))
+	headers.Set("PAYPAL-TRANSMISSION-TIME", transmissionTime)
+	return headers
+}
+
+/* ============================== Handlers ============================== */
+
+func (s *Server) handleAccessToken(responseWriter http.ResponseWriter, request *http.Request) {
+	clientId, clientSecret, ok := request.BasicAuth()
+	if !ok || clientId != ClientId || clientSecret != ClientSecret {
+		writeError(responseWriter, http.StatusUnauthorized, "invalid_client", "Client Authentication failed")
+		return
+	}
+	if err := request.ParseForm(); err != nil || request.PostForm.Get("grant_type") != "client_credentials" {
+		writeError(responseWriter, http.StatusBadRequest, "unsupported_grant_type", "Grant type is not supported")
+		return
+	}
+
+	writeJSON(responseWriter, http.StatusOK, map[string]any{
+		"access_token": accessToken,
+		"token_type":   "Bearer",
+		"expires_in":   32400,
+	})
+}
+
+func (s *Server) handleCreateSubscription(responseWriter http.ResponseWriter, request *http.Request) {
+	var requestBody struct {
+		PlanId   string `json:"plan_id"`
+		CustomId string `json:"custom_id"`
+	}
+	if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil || !strings.HasPrefix(requestBody.PlanId, "P-") {
+		writeError(responseWriter, http.StatusBadRequest, "INVALID_REQUEST", "The plan ID is invalid")
+		return
+	}
+
+	s.mutex.Lock()
+	requestId := request.Header.Get("PayPal-Request-Id")
+	if subscriptionId, ok := s.requestIds[requestId]; ok && requestId != "" {
+		response := s.toResponse(s.subscriptions[subscriptionId])
+		s.mutex.Unlock()
+		writeJSON(responseWriter, http.StatusOK, response)
+		return
+	}
+	s.sequence++
+	subscription := &Subscription{
+		Id:       fmt.Sprintf("I-FAKE%08d", s.sequence),
+		PlanId:   requestBody.PlanId,
+		CustomId: requestBody.CustomId,
+		Status:   "APPROVAL_PENDING",
+	}
+	s.subscriptions[subscription.Id] = subscription
+	if requestId != "" {
+		s.requestIds[requestId] = subscription.Id
+	}
+	response := s.toResponse(subscription)
+	s.mutex.Unlock()
+
+	writeJSON(responseWriter, http.StatusCreated, response)
+}
+
+func (s *Server) handleGetSubscription(responseWriter http.ResponseWriter, request *http.Request) {
+	s.mutex.Lock()
+	subscription, ok := s.subscriptions[request.PathValue("id")]
+	var response map[string]any
+	if ok {
+		response = s.toResponse(subscription)
+	}
+	s.mutex.Unlock()
+	if !ok {
+		writeError(responseWriter, http.StatusNotFound, "RESOURCE_NOT_FOUND", "The specified resource does not exist")
+		return
+	}
+
+	writeJSON(responseWriter, http.StatusOK, response)
+}
+
+func (s *Server) handleReviseSubscription(responseWriter http.ResponseWriter, request *http.Request) {
+	var requestBody struct {
+		PlanId string `json:"plan_id"`
+	}
+	if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil || !strings.HasPrefix(requestBody.PlanId, "P-") {
+		writeError(responseWriter, http.StatusBadRequest, "INVALID_REQUEST", "The plan ID is invalid")
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	subscription, ok := s.subscriptions[request.PathValue("id")]
+	if !ok {
+		writeError(responseWriter, http.StatusNotFound, "RESOURCE_NOT_FOUND", "The specified resource does not exist")
+		return
+	}
+	if subscription.Status != "ACTIVE" && subscription.Status != "SUSPENDED" {
+		writeError(responseWriter, http.StatusUnprocessableEntity, "SUBSCRIPTION_STATUS_INVALID", "Invalid subscription status for revise action")
+		return
+	}
+	subscription.PendingPlanId = requestBody.PlanId
+
+	writeJSON(responseWriter, http.StatusOK, map[string]any{
+		"plan_id": requestBody.PlanId,
+		"links": []map[string]string{
+			{"href": s.approvalURL(subscription.Id), "rel": "approve"},
+		},
+	})
+}
+
+func (s *Server) handleCancelSubscription(responseWriter http.ResponseWriter, request *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	subscription, ok := s.subscriptions[request.PathValue("id")]
+	if !ok {
+		writeError(responseWriter, http.StatusNotFound, "RESOURCE_NOT_FOUND", "The specified resource does not exist")
+		return
+	}
+	if subscription.Status != "ACTIVE" && subscription.Status != "SUSPENDED" {
+		writeError(responseWriter, http.StatusUnprocessableEntity, "SUBSCRIPTION_STATUS_INVALID", "Invalid subscription status for cancel action")
+		return
+	}
+	subscription.Status = "CANCELLED"
+
+	responseWriter.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleVerifyWebhookSignature(responseWriter http.ResponseWriter, request *http.Request) {
+	var requestBody struct {
+		TransmissionId   string          `json:"transmission_id"`
+		TransmissionSig  string          `json:"transmission_sig"`
+		TransmissionTime string          `json:"transmission_time"`
+		WebhookId        string          `json:"webhook_id"`
+		WebhookEvent     json.RawMessage `json:"webhook_event"`
+	}
+	if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil {
+		writeError(responseWriter, http.StatusBadRequest, "INVALID_REQUEST", "The request is not well-formed")
+		return
+	}
+
+	verificationStatus := "FAILURE"
+	expected := sign(requestBody.TransmissionId, requestBody.TransmissionTime, requestBody.WebhookId, requestBody.WebhookEvent)
+	if requestBody.WebhookId == WebhookId && hmac.Equal([]byte(expected), []byte(requestBody.TransmissionSig)) {
+		verificationStatus = "SUCCESS"
+	}
+	writeJSON(responseWriter, http.StatusOK, map[string]string{
+		"verification_status": verificationStatus,
+	})
+}
+
+/* ============================== Private Methods ============================== */
+
+func (s *Server) authorize(handler http.HandlerFunc) http.HandlerFunc {
+	return func(responseWriter http.ResponseWriter, request *http.Request) {
+		if request.Header.Get("Authorization") != "Bearer "+accessToken {
+			writeError(responseWriter, http.StatusUnauthorized, "AUTHENTICATION_FAILURE", "Authentication failed due to invalid authentication credentials")
+			return
+		}
+		handler(responseWriter, request)
+	}
+}
+
+func (s *Server) nextSequence() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.sequence++
+	return s.sequence
+}
+
+func (s *Server) approvalURL(subscriptionId string) string {
+	return s.URL + "/webapps/billing/subscriptions?ba_token=" + subscriptionId
+}
+
+func (s *Server) toResponse(subscription *Subscription) map[string]any {
+	response := map[string]any{
+		"id":        subscription.Id,
+		"plan_id":   subscription.PlanId,
+		"custom_id": subscription.CustomId,
+		"status":    subscription.Status,
+		"links": []map[string]string{
+			{"href": s.URL + "/v1/billing/subscriptions/" + subscription.Id, "rel": "self"},
+		},
+	}
+	if subscription.Status == "APPROVAL_PENDING" {
+		response["links"] = append(response["links"].([]map[string]string), map[string]string{
+			"href": s.approvalURL(subscription.Id), "rel": "approve",
+		})
+	}
+	if !subscription.NextBillingTime.IsZero() {
+		response["billing_info"] = map[string]any{
+			"next_billing_time": subscription.NextBillingTime.Format(time.RFC3339),
+		}
+	}
+	return response
+}
+
+// sign compacts the event first, since the event is re-encoded on its way to the verification
+func sign(transmissionId string, transmissionTime string, webhookId string, event []byte) string {
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, event); err != nil {
+		compacted.Write(event)
+	}
+	message := fmt.Sprintf(
+		"%s|%s|%s|%d",
+		transmissionId,
+		transmissionTime,
+		webhookId,
+		crc32.ChecksumIEEE(compacted.Bytes()),
+	)
+	mac := hmac.New(sha256.New, []byte(signingKey))
+	mac.Write([]byte(message))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func writeJSON(responseWriter http.ResponseWriter, statusCode int, body any) {
+	responseWriter.Header().Set("Content-Type", "application/json")
+	responseWriter.WriteHeader(statusCode)
+	_ = json.NewEncoder(responseWriter).Encode(body)
+}
+
+func writeError(responseWriter http.ResponseWriter, statusCode int, name string, message string) {
+	writeJSON(responseWriter, statusCode, map[string]string{
+		"name":    name,
+		"message": message,
+	})
+}
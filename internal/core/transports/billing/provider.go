@@ -0,0 +1,75 @@
+package billing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ErrInvalidWebhookSignature is returned when the provider does not recognize the signature
+// of a webhook delivery, the delivery must then be rejected without being processed.
+var ErrInvalidWebhookSignature = errors.New("billing webhook signature is invalid")
+
+// ProviderError is returned when the billing provider rejects a request,
+// the client errors usually mean the subscription or the plan is in an unexpected state.
+type ProviderError struct {
+	StatusCode int
+	Name       string
+	Message    string
+}
+
+func (e *ProviderError) Error() string {
+	return fmt.Sprintf("billing provider returned HTTP status %d: %s %s", e.StatusCode, e.Name, e.Message)
+}
+
+func (e *ProviderError) IsClientError() bool {
+	return e.StatusCode >= http.StatusBadRequest && e.StatusCode < http.StatusInternalServerError
+}
+
+// Subscription is the state of a subscription at the provider, its Status uses the
+// same values as the UsersToBillingPlansStatus, and the ApprovalURL is only set
+// while the user still has to approve the subscription or its plan change.
+type Subscription struct {
+	Id              string
+	PlanId          string
+	CustomId        string
+	Status          string
+	ApprovalURL     string
+	NextBillingTime *time.Time
+}
+
+type CreateSubscriptionInput struct {
+	// RequestId makes the creation idempotent at the provider, a retried request
+	// with the same RequestId returns the subscription created by the first one
+	RequestId string
+	PlanId    string
+	CustomId  string
+}
+
+// WebhookHeaders are the transmission headers the provider signs each webhook delivery with.
+type WebhookHeaders struct {
+	AuthAlgo         string
+	CertURL          string
+	TransmissionId   string
+	TransmissionSig  string
+	TransmissionTime string
+}
+
+type WebhookEvent struct {
+	Id           string          `json:"id"`
+	EventType    string          `json:"event_type"`
+	ResourceType string          `json:"resource_type"`
+	Resource     json.RawMessage `json:"resource"`
+	CreateTime   time.Time       `json:"create_time"`
+}
+
+type ProviderInterface interface {
+	CreateSubscription(ctx context.Context, input CreateSubscriptionInput) (*Subscription, error)
+	GetSubscription(ctx context.Context, subscriptionId string) (*Subscription, error)
+	ReviseSubscription(ctx context.Context, subscriptionId string, planId string) (*Subscription, error)
+	CancelSubscription(ctx context.Context, subscriptionId string, reason string) error
+	VerifyWebhookEvent(ctx context.Context, headers WebhookHeaders, payload []byte) (*WebhookEvent, error)
+}
@@ -1,7 +1,8 @@
 package durablejobtransport
 
 const (
-	RoutineTaskClaimConsumerGroup      = "notegic-core-durablejob-routine-task-v1"
-	RoutineTaskResultConsumerGroup     = "notegic-core-durablejob-routine-task-result-v1"
-	YjsMaintenanceRequestConsumerGroup = "notegic-core-durablejob-yjs-maintenance-request-v1"
+	RoutineTaskClaimConsumerGroup          = "notegic-core-durablejob-routine-task-v1"
+	RoutineTaskResultConsumerGroup         = "notegic-core-durablejob-routine-task-result-v1"
+	YjsMaintenanceRequestConsumerGroup     = "notegic-core-durablejob-yjs-maintenance-request-v1"
+	MaterialProcessingRequestConsumerGroup = "notegic-core-durablejob-material-processing-request-v1"
 )
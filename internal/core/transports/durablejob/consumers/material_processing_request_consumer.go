@@ -0,0 +1,111 @@
+package durablejobconsumers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	durablejobeventscontract "github.com/HiIamJeff67/notegic-backend/contracts/durable-job/v1/events"
+	eventcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/events"
+
+	platformkafka "github.com/HiIamJeff67/notegic-backend/shared/platform/kafka"
+	logs "github.com/HiIamJeff67/notegic-backend/shared/platform/observability/logs"
+
+	materialservices "github.com/HiIamJeff67/notegic-backend/internal/core/services/material"
+	durablejobproducers "github.com/HiIamJeff67/notegic-backend/internal/core/transports/durablejob/producers"
+)
+
+type MaterialProcessingRequestConsumer struct {
+	materialProcessingService materialservices.MaterialProcessingServiceInterface
+	resultProducer            *durablejobproducers.MaterialProcessingResultProducer
+	kafkaConfig               platformkafka.ConsumerConfig
+}
+
+func NewMaterialProcessingRequestConsumer(
+	materialProcessingService materialservices.MaterialProcessingServiceInterface,
+	resultProducer *durablejobproducers.MaterialProcessingResultProducer,
+	kafkaConfig platformkafka.ConsumerConfig,
+) *MaterialProcessingRequestConsumer {
+	return &MaterialProcessingRequestConsumer{
+		materialProcessingService: materialProcessingService,
+		resultProducer:            resultProducer,
+		kafkaConfig:               kafkaConfig,
+	}
+}
+
+func (c *MaterialProcessingRequestConsumer) Start(ctx context.Context) func() {
+	consumer, err := platformkafka.NewConsumer(
+		c.kafkaConfig,
+		durablejobeventscontract.DurableJobCoreMaterialProcessingRequestTopic.String(),
+	)
+	if err != nil {
+		if logs.NotegicLogger != nil {
+			logs.NotegicLogger.Error(ctx, err, "failed to create material processing request consumer")
+		}
+
+		return func() {}
+	}
+
+	workerCtx, cancel := context.WithCancel(ctx)
+	go func() {
+		if err := consumer.Run(workerCtx, c.consume); err != nil && workerCtx.Err() == nil && logs.NotegicLogger != nil {
+			logs.NotegicLogger.Error(workerCtx, err, "material processing request consumer stopped")
+		}
+	}()
+
+	return func() {
+		cancel()
+		consumer.Close()
+	}
+}
+
+// consume processes the material and reports the outcome to the DurableJob, where the transient failures are
+// reported as unsuccessful results instead of being retried here, so the DurableJob owns the retry budget
+func (c *MaterialProcessingRequestConsumer) consume(
+	ctx context.Context,
+	_ platformkafka.ConsumerRecord,
+	event eventcontract.EventEnvelope[json.RawMessage],
+) error {
+	if event.EventType != durablejobeventscontract.EventType_MaterialProcessingRequested ||
+		event.AggregateType != durablejobeventscontract.AggregateType_Material ||
+		event.AggregateId == uuid.Nil ||
+		event.KafkaKey != event.AggregateId.String() {
+		return &platformkafka.ConsumerError{
+			Classification: platformkafka.ErrorClassification_SchemaIncompatible,
+			Origin:         errors.New("invalid material processing request envelope"),
+		}
+	}
+
+	var request durablejobeventscontract.MaterialProcessingRequestData
+	if err := json.Unmarshal(event.Data, &request); err != nil {
+		return &platformkafka.ConsumerError{
+			Classification: platformkafka.ErrorClassification_SchemaIncompatible,
+			Origin:         fmt.Errorf("decode material processing request: %w", err),
+		}
+	}
+	if request.RequestId == uuid.Nil || request.MaterialId != event.AggregateId || request.Attempt < 1 {
+		return &platformkafka.ConsumerError{
+			Classification: platformkafka.ErrorClassification_SchemaIncompatible,
+			Origin:         errors.New("invalid material processing request data"),
+		}
+	}
+
+	status, exception := c.materialProcessingService.ProcessMaterialById(ctx, request.MaterialId)
+	result := durablejobeventscontract.MaterialProcessingResultData{
+		RequestId:  request.RequestId,
+		MaterialId: request.MaterialId,
+		Success:    exception == nil,
+		Status:     *status.ToContractable(),
+	}
+	if exception != nil {
+		result.Error = exception.Message
+	}
+	if err := c.resultProducer.Produce(ctx, event, result); err != nil {
+		return fmt.Errorf("produce material processing result: %w", err)
+	}
+
+	return nil
+}
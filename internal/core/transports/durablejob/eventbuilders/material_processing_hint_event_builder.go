@@ -0,0 +1,34 @@
+package eventbuilders
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	coreeventscontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/events"
+	eventcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/events"
+)
+
+type MaterialProcessingHintEventBuilder struct{}
+
+func NewMaterialProcessingHintEventBuilder() *MaterialProcessingHintEventBuilder {
+	return &MaterialProcessingHintEventBuilder{}
+}
+
+func (b *MaterialProcessingHintEventBuilder) Build(
+	hint coreeventscontract.MaterialProcessingHintData,
+	correlationId string,
+	occurredAt time.Time,
+) eventcontract.EventEnvelope[coreeventscontract.MaterialProcessingHintData] {
+	return eventcontract.EventEnvelope[coreeventscontract.MaterialProcessingHintData]{
+		SchemaVersion: eventcontract.Version,
+		EventId:       uuid.New(),
+		EventType:     coreeventscontract.EventType_MaterialProcessingHint,
+		AggregateType: coreeventscontract.AggregateType_Material,
+		AggregateId:   hint.MaterialId,
+		KafkaKey:      hint.MaterialId.String(),
+		OccurredAt:    occurredAt,
+		CorrelationId: correlationId,
+		Data:          hint,
+	}
+}
@@ -0,0 +1,53 @@
+package durablejobproducers
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	durablejobeventscontract "github.com/HiIamJeff67/notegic-backend/contracts/durable-job/v1/events"
+	eventcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/events"
+
+	platformkafka "github.com/HiIamJeff67/notegic-backend/shared/platform/kafka"
+)
+
+type MaterialProcessingResultProducer struct {
+	producer *platformkafka.Producer
+}
+
+func NewMaterialProcessingResultProducer(producer *platformkafka.Producer) *MaterialProcessingResultProducer {
+	return &MaterialProcessingResultProducer{producer: producer}
+}
+
+func (p *MaterialProcessingResultProducer) Produce(
+	ctx context.Context,
+	source eventcontract.EventEnvelope[json.RawMessage],
+	result durablejobeventscontract.MaterialProcessingResultData,
+) error {
+	event := eventcontract.EventEnvelope[durablejobeventscontract.MaterialProcessingResultData]{
+		SchemaVersion: eventcontract.Version,
+		EventId:       uuid.New(),
+		EventType:     durablejobeventscontract.EventType_MaterialProcessingCompleted,
+		AggregateType: durablejobeventscontract.AggregateType_Material,
+		AggregateId:   result.MaterialId,
+		KafkaKey:      result.MaterialId.String(),
+		OccurredAt:    time.Now().UTC(),
+		CorrelationId: source.CorrelationId,
+		CausationId:   &source.EventId,
+		Trace:         source.Trace,
+		Data:          result,
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return p.producer.Produce(
+		ctx,
+		durablejobeventscontract.DurableJobCoreMaterialProcessingResultTopic.String(),
+		result.MaterialId.String(),
+		payload,
+	)
+}
@@ -21,6 +21,7 @@ type ClientInterface interface {
 	SendWelcomeEmail(ctx context.Context, requestDto emaileventscontract.SendWelcomeEmailRequestDto) *exceptions.Exception
 	SendValidationEmail(ctx context.Context, requestDto emaileventscontract.SendValidationEmailRequestDto) *exceptions.Exception
 	SendSecurityAlertEmail(ctx context.Context, requestDto emaileventscontract.SendSecurityAlertEmailRequestDto) *exceptions.Exception
+	SendQuotaWarningEmail(ctx context.Context, requestDto emaileventscontract.SendQuotaWarningEmailRequestDto) *exceptions.Exception
 }
 
 type Client struct {
@@ -61,6 +62,16 @@ func (c *Client) SendSecurityAlertEmail(
 	return enqueue(c, ctx, requestDto.RequestId, requestDto.OccurredAt, requestDto)
 }
 
+func (c *Client) SendQuotaWarningEmail(
+	ctx context.Context,
+	requestDto emaileventscontract.SendQuotaWarningEmailRequestDto,
+) *exceptions.Exception {
+	requestDto.RequestId = uuid.New()
+	requestDto.Operation = emailcontract.SendQuotaWarningEmailOperation
+	requestDto.OccurredAt = time.Now().UTC()
+	return enqueue(c, ctx, requestDto.RequestId, requestDto.OccurredAt, requestDto)
+}
+
 func enqueue[D any](
 	c *Client,
 	ctx context.Context,
@@ -24,6 +24,22 @@ type AuthEndpointInterface interface {
 	ForgetPassword(ctx *gin.Context)
 	ResetMe(ctx *gin.Context)
 	DeleteMe(ctx *gin.Context)
+	GetMySessions(ctx *gin.Context)
+	RevokeMySession(ctx *gin.Context)
+	RevokeMyOtherSessions(ctx *gin.Context)
+	GetMyTwoFactor(ctx *gin.Context)
+	EnrollMyTwoFactor(ctx *gin.Context)
+	EnableMyTwoFactor(ctx *gin.Context)
+	DisableMyTwoFactor(ctx *gin.Context)
+	RegenerateMyTwoFactorRecoveryCodes(ctx *gin.Context)
+	VerifyLoginTwoFactor(ctx *gin.Context)
+	GetOAuthAuthorizationURL(ctx *gin.Context)
+	RegisterViaGithub(ctx *gin.Context)
+	LoginViaGithub(ctx *gin.Context)
+	RegisterViaMeta(ctx *gin.Context)
+	LoginViaMeta(ctx *gin.Context)
+	RegisterViaOIDC(ctx *gin.Context)
+	LoginViaOIDC(ctx *gin.Context)
 }
 
 type AuthEndpoint struct {
@@ -389,3 +405,515 @@ func (t *AuthEndpoint) DeleteMe(ctx *gin.Context) {
 		Data: *responseDto,
 	})
 }
+
+func (t *AuthEndpoint) GetMySessions(ctx *gin.Context) {
+	request := &gatewaycontract.Request[apicontract.GetMySessionsRequestDto]{}
+	if err := ctx.ShouldBindBodyWithJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	responseDto, exception := t.authService.GetMySessions(ctx.Request.Context(), &request.Dto)
+	if exception != nil {
+		publicException := exception.ToPublic()
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[apicontract.GetMySessionsResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
+
+func (t *AuthEndpoint) RevokeMySession(ctx *gin.Context) {
+	request := &gatewaycontract.Request[apicontract.RevokeMySessionRequestDto]{}
+	if err := ctx.ShouldBindBodyWithJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	responseDto, exception := t.authService.RevokeMySession(ctx.Request.Context(), &request.Dto)
+	if exception != nil {
+		publicException := exception.ToPublic()
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[apicontract.RevokeMySessionResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
+
+func (t *AuthEndpoint) RevokeMyOtherSessions(ctx *gin.Context) {
+	request := &gatewaycontract.Request[apicontract.RevokeMyOtherSessionsRequestDto]{}
+	if err := ctx.ShouldBindBodyWithJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	responseDto, exception := t.authService.RevokeMyOtherSessions(ctx.Request.Context(), &request.Dto)
+	if exception != nil {
+		publicException := exception.ToPublic()
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[apicontract.RevokeMyOtherSessionsResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
+
+func (t *AuthEndpoint) GetMyTwoFactor(ctx *gin.Context) {
+	request := &gatewaycontract.Request[apicontract.GetMyTwoFactorRequestDto]{}
+	if err := ctx.ShouldBindBodyWithJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	responseDto, exception := t.authService.GetMyTwoFactor(ctx.Request.Context(), &request.Dto)
+	if exception != nil {
+		publicException := exception.ToPublic()
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[apicontract.GetMyTwoFactorResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
+
+func (t *AuthEndpoint) EnrollMyTwoFactor(ctx *gin.Context) {
+	request := &gatewaycontract.Request[apicontract.EnrollMyTwoFactorRequestDto]{}
+	if err := ctx.ShouldBindBodyWithJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	responseDto, exception := t.authService.EnrollMyTwoFactor(ctx.Request.Context(), &request.Dto)
+	if exception != nil {
+		publicException := exception.ToPublic()
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[apicontract.EnrollMyTwoFactorResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
+
+func (t *AuthEndpoint) EnableMyTwoFactor(ctx *gin.Context) {
+	request := &gatewaycontract.Request[apicontract.EnableMyTwoFactorRequestDto]{}
+	if err := ctx.ShouldBindBodyWithJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	responseDto, exception := t.authService.EnableMyTwoFactor(ctx.Request.Context(), &request.Dto)
+	if exception != nil {
+		publicException := exception.ToPublic()
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[apicontract.EnableMyTwoFactorResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
+
+func (t *AuthEndpoint) DisableMyTwoFactor(ctx *gin.Context) {
+	request := &gatewaycontract.Request[apicontract.DisableMyTwoFactorRequestDto]{}
+	if err := ctx.ShouldBindBodyWithJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	responseDto, exception := t.authService.DisableMyTwoFactor(ctx.Request.Context(), &request.Dto)
+	if exception != nil {
+		publicException := exception.ToPublic()
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[apicontract.DisableMyTwoFactorResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
+
+func (t *AuthEndpoint) RegenerateMyTwoFactorRecoveryCodes(ctx *gin.Context) {
+	request := &gatewaycontract.Request[apicontract.RegenerateMyTwoFactorRecoveryCodesRequestDto]{}
+	if err := ctx.ShouldBindBodyWithJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	responseDto, exception := t.authService.RegenerateMyTwoFactorRecoveryCodes(ctx.Request.Context(), &request.Dto)
+	if exception != nil {
+		publicException := exception.ToPublic()
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[apicontract.RegenerateMyTwoFactorRecoveryCodesResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
+
+func (t *AuthEndpoint) VerifyLoginTwoFactor(ctx *gin.Context) {
+	request := &gatewaycontract.Request[apicontract.VerifyLoginTwoFactorRequestDto]{}
+	if err := ctx.ShouldBindBodyWithJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	responseDto, exception := t.authService.VerifyLoginTwoFactor(ctx.Request.Context(), &request.Dto)
+	if exception != nil {
+		publicException := exception.ToPublic()
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[apicontract.VerifyLoginTwoFactorResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
+
+func (t *AuthEndpoint) GetOAuthAuthorizationURL(ctx *gin.Context) {
+	request := &gatewaycontract.Request[apicontract.GetOAuthAuthorizationURLRequestDto]{}
+	if err := ctx.ShouldBindBodyWithJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	responseDto, exception := t.authService.GetOAuthAuthorizationURL(ctx.Request.Context(), &request.Dto)
+	if exception != nil {
+		publicException := exception.ToPublic()
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[apicontract.GetOAuthAuthorizationURLResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
+
+func (t *AuthEndpoint) RegisterViaGithub(ctx *gin.Context) {
+	request := &gatewaycontract.Request[apicontract.RegisterViaGithubRequestDto]{}
+	if err := ctx.ShouldBindBodyWithJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	responseDto, exception := t.authService.RegisterViaGithub(ctx.Request.Context(), &request.Dto)
+	if exception != nil {
+		publicException := exception.ToPublic()
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[apicontract.RegisterViaGithubResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
+
+func (t *AuthEndpoint) LoginViaGithub(ctx *gin.Context) {
+	request := &gatewaycontract.Request[apicontract.LoginViaGithubRequestDto]{}
+	if err := ctx.ShouldBindBodyWithJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	responseDto, exception := t.authService.LoginViaGithub(ctx.Request.Context(), &request.Dto)
+	if exception != nil {
+		publicException := exception.ToPublic()
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[apicontract.LoginViaGithubResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
+
+func (t *AuthEndpoint) RegisterViaMeta(ctx *gin.Context) {
+	request := &gatewaycontract.Request[apicontract.RegisterViaMetaRequestDto]{}
+	if err := ctx.ShouldBindBodyWithJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	responseDto, exception := t.authService.RegisterViaMeta(ctx.Request.Context(), &request.Dto)
+	if exception != nil {
+		publicException := exception.ToPublic()
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[apicontract.RegisterViaMetaResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
+
+func (t *AuthEndpoint) LoginViaMeta(ctx *gin.Context) {
+	request := &gatewaycontract.Request[apicontract.LoginViaMetaRequestDto]{}
+	if err := ctx.ShouldBindBodyWithJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	responseDto, exception := t.authService.LoginViaMeta(ctx.Request.Context(), &request.Dto)
+	if exception != nil {
+		publicException := exception.ToPublic()
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[apicontract.LoginViaMetaResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
+
+func (t *AuthEndpoint) RegisterViaOIDC(ctx *gin.Context) {
+	request := &gatewaycontract.Request[apicontract.RegisterViaOIDCRequestDto]{}
+	if err := ctx.ShouldBindBodyWithJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	responseDto, exception := t.authService.RegisterViaOIDC(ctx.Request.Context(), &request.Dto)
+	if exception != nil {
+		publicException := exception.ToPublic()
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[apicontract.RegisterViaOIDCResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
+
+func (t *AuthEndpoint) LoginViaOIDC(ctx *gin.Context) {
+	request := &gatewaycontract.Request[apicontract.LoginViaOIDCRequestDto]{}
+	if err := ctx.ShouldBindBodyWithJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	responseDto, exception := t.authService.LoginViaOIDC(ctx.Request.Context(), &request.Dto)
+	if exception != nil {
+		publicException := exception.ToPublic()
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[apicontract.LoginViaOIDCResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
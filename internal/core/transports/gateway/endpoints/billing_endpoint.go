@@ -0,0 +1,259 @@
+package endpoints
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	apicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/billing"
+	gatewaycontract "github.com/HiIamJeff67/notegic-backend/contracts/gateway/v1"
+
+	billingservices "github.com/HiIamJeff67/notegic-backend/internal/core/services/billing"
+)
+
+type BillingEndpointInterface interface {
+	GetBillingPlans(ctx *gin.Context)
+	GetMySubscription(ctx *gin.Context)
+	CreateMySubscription(ctx *gin.Context)
+	ChangeMySubscriptionPlan(ctx *gin.Context)
+	CancelMySubscription(ctx *gin.Context)
+	GetMyBillingInvoices(ctx *gin.Context)
+	HandlePayPalWebhook(ctx *gin.Context)
+}
+
+type BillingEndpoint struct {
+	billingService billingservices.BillingServiceInterface
+}
+
+func NewBillingEndpoint(service billingservices.BillingServiceInterface) BillingEndpointInterface {
+	return &BillingEndpoint{
+		billingService: service,
+	}
+}
+
+/* ============================== Billing Endpoint Methods ============================== */
+
+func (t *BillingEndpoint) GetBillingPlans(ctx *gin.Context) {
+	request := &gatewaycontract.Request[apicontract.GetBillingPlansRequestDto]{}
+	if err := ctx.ShouldBindBodyWithJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	responseDto, exception := t.billingService.GetBillingPlans(ctx.Request.Context(), &request.Dto)
+	if exception != nil {
+		publicException := exception.ToPublic()
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[apicontract.GetBillingPlansResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
+
+func (t *BillingEndpoint) GetMySubscription(ctx *gin.Context) {
+	request := &gatewaycontract.Request[apicontract.GetMySubscriptionRequestDto]{}
+	if err := ctx.ShouldBindBodyWithJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	responseDto, exception := t.billingService.GetMySubscription(ctx.Request.Context(), &request.Dto)
+	if exception != nil {
+		publicException := exception.ToPublic()
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[apicontract.GetMySubscriptionResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
+
+func (t *BillingEndpoint) CreateMySubscription(ctx *gin.Context) {
+	request := &gatewaycontract.Request[apicontract.CreateMySubscriptionRequestDto]{}
+	if err := ctx.ShouldBindBodyWithJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	responseDto, exception := t.billingService.CreateMySubscription(ctx.Request.Context(), &request.Dto)
+	if exception != nil {
+		publicException := exception.ToPublic()
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gatewaycontract.Response[apicontract.CreateMySubscriptionResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
+
+func (t *BillingEndpoint) ChangeMySubscriptionPlan(ctx *gin.Context) {
+	request := &gatewaycontract.Request[apicontract.ChangeMySubscriptionPlanRequestDto]{}
+	if err := ctx.ShouldBindBodyWithJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	responseDto, exception := t.billingService.ChangeMySubscriptionPlan(ctx.Request.Context(), &request.Dto)
+	if exception != nil {
+		publicException := exception.ToPublic()
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[apicontract.ChangeMySubscriptionPlanResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
+
+func (t *BillingEndpoint) CancelMySubscription(ctx *gin.Context) {
+	request := &gatewaycontract.Request[apicontract.CancelMySubscriptionRequestDto]{}
+	if err := ctx.ShouldBindBodyWithJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	responseDto, exception := t.billingService.CancelMySubscription(ctx.Request.Context(), &request.Dto)
+	if exception != nil {
+		publicException := exception.ToPublic()
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[apicontract.CancelMySubscriptionResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
+
+func (t *BillingEndpoint) GetMyBillingInvoices(ctx *gin.Context) {
+	request := &gatewaycontract.Request[apicontract.GetMyBillingInvoicesRequestDto]{}
+	if err := ctx.ShouldBindBodyWithJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	responseDto, exception := t.billingService.GetMyBillingInvoices(ctx.Request.Context(), &request.Dto)
+	if exception != nil {
+		publicException := exception.ToPublic()
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[apicontract.GetMyBillingInvoicesResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
+
+func (t *BillingEndpoint) HandlePayPalWebhook(ctx *gin.Context) {
+	request := &gatewaycontract.Request[apicontract.HandlePayPalWebhookRequestDto]{}
+	if err := ctx.ShouldBindBodyWithJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	responseDto, exception := t.billingService.HandlePayPalWebhook(ctx.Request.Context(), &request.Dto)
+	if exception != nil {
+		publicException := exception.ToPublic()
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[apicontract.HandlePayPalWebhookResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
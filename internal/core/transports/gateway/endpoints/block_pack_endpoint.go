@@ -17,8 +17,10 @@ type BlockPackEndpointInterface interface {
 	GetMyBlockPackAndItsParentById(ctx *gin.Context)
 	GetMyBlockPacksByParentSubShelfId(ctx *gin.Context)
 	GetAllMyBlockPacksByRootShelfId(ctx *gin.Context)
+	GetMyBlockPackMarkdownById(ctx *gin.Context)
 	CreateBlockPack(ctx *gin.Context)
 	CreateBlockPacks(ctx *gin.Context)
+	CreateBlockPackFromMarkdown(ctx *gin.Context)
 	UpdateMyBlockPackById(ctx *gin.Context)
 	UpdateMyBlockPacksByIds(ctx *gin.Context)
 	MoveMyBlockPackByParentSubShelfId(ctx *gin.Context)
@@ -28,20 +30,27 @@ type BlockPackEndpointInterface interface {
 	RestoreMyBlockPacksByIds(ctx *gin.Context)
 	DeleteMyBlockPackById(ctx *gin.Context)
 	DeleteMyBlockPacksByIds(ctx *gin.Context)
+	CreateMyBlockPackSnapshotById(ctx *gin.Context)
+	GetMyBlockPackSnapshotsById(ctx *gin.Context)
+	GetMyBlockPackSnapshotDiffById(ctx *gin.Context)
+	RestoreMyBlockPackSnapshotById(ctx *gin.Context)
 
 	/* ============================== GraphQL Methods ============================== */
 	SearchBlockPacks(ctx *gin.Context)
 }
 
 type BlockPackEndpoint struct {
-	blockPackService blockservices.BlockPackServiceInterface
+	blockPackService         blockservices.BlockPackServiceInterface
+	blockPackSnapshotService blockservices.BlockPackSnapshotServiceInterface
 }
 
 func NewBlockPackEndpoint(
-	blockPackService blockservices.BlockPackServiceInterface,
+	service blockservices.BlockPackServiceInterface,
+	snapshotService blockservices.BlockPackSnapshotServiceInterface,
 ) BlockPackEndpointInterface {
 	return &BlockPackEndpoint{
-		blockPackService: blockPackService,
+		blockPackService:         service,
+		blockPackSnapshotService: snapshotService,
 	}
 }
 
@@ -173,6 +182,38 @@ func (t *BlockPackEndpoint) GetAllMyBlockPacksByRootShelfId(ctx *gin.Context) {
 	})
 }
 
+func (t *BlockPackEndpoint) GetMyBlockPackMarkdownById(ctx *gin.Context) {
+	request := &gatewaycontract.Request[apicontract.GetMyBlockPackMarkdownByIdRequestDto]{}
+	if err := ctx.ShouldBindBodyWithJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	responseDto, exception := t.blockPackService.GetMyBlockPackMarkdownById(ctx.Request.Context(), &request.Dto)
+	if exception != nil {
+		publicException := exception.ToPublic()
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[apicontract.GetMyBlockPackMarkdownByIdResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
+
 func (t *BlockPackEndpoint) CreateBlockPack(ctx *gin.Context) {
 	request := &gatewaycontract.Request[apicontract.CreateBlockPackRequestDto]{}
 	if err := ctx.ShouldBindBodyWithJSON(request); err != nil {
@@ -237,6 +278,38 @@ func (t *BlockPackEndpoint) CreateBlockPacks(ctx *gin.Context) {
 	})
 }
 
+func (t *BlockPackEndpoint) CreateBlockPackFromMarkdown(ctx *gin.Context) {
+	request := &gatewaycontract.Request[apicontract.CreateBlockPackFromMarkdownRequestDto]{}
+	if err := ctx.ShouldBindBodyWithJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	responseDto, exception := t.blockPackService.CreateBlockPackFromMarkdown(ctx.Request.Context(), &request.Dto)
+	if exception != nil {
+		publicException := exception.ToPublic()
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[apicontract.CreateBlockPackFromMarkdownResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
+
 func (t *BlockPackEndpoint) UpdateMyBlockPackById(ctx *gin.Context) {
 	request := &gatewaycontract.Request[apicontract.UpdateMyBlockPackByIdRequestDto]{}
 	if err := ctx.ShouldBindBodyWithJSON(request); err != nil {
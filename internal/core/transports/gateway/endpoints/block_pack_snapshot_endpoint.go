@@ -0,0 +1,141 @@
+package endpoints
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	apicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/block-packs"
+	gatewaycontract "github.com/HiIamJeff67/notegic-backend/contracts/gateway/v1"
+)
+
+/* ============================== BlockPackSnapshot Endpoint Methods ============================== */
+
+func (t *BlockPackEndpoint) CreateMyBlockPackSnapshotById(ctx *gin.Context) {
+	request := &gatewaycontract.Request[apicontract.CreateMyBlockPackSnapshotByIdRequestDto]{}
+	if err := ctx.ShouldBindBodyWithJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	responseDto, exception := t.blockPackSnapshotService.CreateMyBlockPackSnapshotById(ctx.Request.Context(), &request.Dto)
+	if exception != nil {
+		publicException := exception.ToPublic()
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[apicontract.CreateMyBlockPackSnapshotByIdResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
+
+func (t *BlockPackEndpoint) GetMyBlockPackSnapshotsById(ctx *gin.Context) {
+	request := &gatewaycontract.Request[apicontract.GetMyBlockPackSnapshotsByIdRequestDto]{}
+	if err := ctx.ShouldBindBodyWithJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	responseDto, exception := t.blockPackSnapshotService.GetMyBlockPackSnapshotsById(ctx.Request.Context(), &request.Dto)
+	if exception != nil {
+		publicException := exception.ToPublic()
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[apicontract.GetMyBlockPackSnapshotsByIdResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
+
+func (t *BlockPackEndpoint) GetMyBlockPackSnapshotDiffById(ctx *gin.Context) {
+	request := &gatewaycontract.Request[apicontract.GetMyBlockPackSnapshotDiffByIdRequestDto]{}
+	if err := ctx.ShouldBindBodyWithJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	responseDto, exception := t.blockPackSnapshotService.GetMyBlockPackSnapshotDiffById(ctx.Request.Context(), &request.Dto)
+	if exception != nil {
+		publicException := exception.ToPublic()
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[apicontract.GetMyBlockPackSnapshotDiffByIdResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
+
+func (t *BlockPackEndpoint) RestoreMyBlockPackSnapshotById(ctx *gin.Context) {
+	request := &gatewaycontract.Request[apicontract.RestoreMyBlockPackSnapshotByIdRequestDto]{}
+	if err := ctx.ShouldBindBodyWithJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	responseDto, exception := t.blockPackSnapshotService.RestoreMyBlockPackSnapshotById(ctx.Request.Context(), &request.Dto)
+	if exception != nil {
+		publicException := exception.ToPublic()
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[apicontract.RestoreMyBlockPackSnapshotByIdResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
@@ -15,6 +15,7 @@ import (
 
 type ItemEndpointInterface interface {
 	SearchItems(ctx *gin.Context)
+	GetMyTrash(ctx *gin.Context)
 }
 
 type ItemEndpoint struct {
@@ -75,3 +76,50 @@ func (t *ItemEndpoint) SearchItems(ctx *gin.Context) {
 		Data: *responseDto,
 	})
 }
+
+func (t *ItemEndpoint) GetMyTrash(ctx *gin.Context) {
+	request := &gatewaycontract.Request[apicontract.GetMyTrashRequestDto]{}
+	if err := ctx.ShouldBindBodyWithJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	userId, exception := contexts.GetActorUserId(ctx.Request.Context())
+	if exception != nil {
+		publicException := exception.ToPublic()
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+
+	responseDto, exception := t.itemService.GetMyTrash(ctx.Request.Context(), userId, request.Dto)
+	if exception != nil {
+		publicException := exception.ToPublic()
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[apicontract.GetMyTrashResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
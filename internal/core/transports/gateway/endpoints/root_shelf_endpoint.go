@@ -32,20 +32,26 @@ type RootShelfEndpointInterface interface {
 	DeleteMyRootShelfPermissions(ctx *gin.Context)
 	LeaveMyRootShelf(ctx *gin.Context)
 	LeaveMyRootShelves(ctx *gin.Context)
+	ExportMyRootShelfArchiveById(ctx *gin.Context)
+	CreateRootShelfFromArchive(ctx *gin.Context)
+	GetMyRootShelfArchiveJobById(ctx *gin.Context)
 
 	/* ============================== GraphQL Methods ============================== */
 	SearchRootShelves(ctx *gin.Context)
 }
 
 type RootShelfEndpoint struct {
-	rootShelfService shelfservices.RootShelfServiceInterface
+	rootShelfService        shelfservices.RootShelfServiceInterface
+	rootShelfArchiveService shelfservices.RootShelfArchiveServiceInterface
 }
 
 func NewRootShelfEndpoint(
 	service shelfservices.RootShelfServiceInterface,
+	archiveService shelfservices.RootShelfArchiveServiceInterface,
 ) RootShelfEndpointInterface {
 	return &RootShelfEndpoint{
-		rootShelfService: service,
+		rootShelfService:        service,
+		rootShelfArchiveService: archiveService,
 	}
 }
 
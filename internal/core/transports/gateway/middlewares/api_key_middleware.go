@@ -14,6 +14,7 @@ import (
 	contexts "github.com/HiIamJeff67/notegic-backend/internal/core/contexts"
 	apikeycache "github.com/HiIamJeff67/notegic-backend/internal/core/data/cache/apikey"
 	repositories "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/repositories"
+	schemas "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas"
 	sharedtokens "github.com/HiIamJeff67/notegic-backend/shared/tokens"
 )
 
@@ -54,7 +55,14 @@ func APIKeyMiddleware(
 					abortAPIKey(ctx, "the API key is expired or revoked", http.StatusUnauthorized)
 					return
 				}
-				setAPIKeyContext(ctx, cached.Id, cached.UserId, cached.UserPublicId)
+				if !isAPIKeyScopeGranted(ctx, cached.Scopes) {
+					abortAPIKey(ctx, "the API key is not granted the scope of this operation", http.StatusForbidden)
+					return
+				}
+				setAPIKeyContext(ctx, cached.Id, cached.UserId, cached.UserPublicId, contexts.APIKeyRestriction{
+					RootShelfIds: cached.RootShelfIds,
+					StationIds:   cached.StationIds,
+				})
 				ctx.Next()
 				return
 			}
@@ -70,21 +78,37 @@ func APIKeyMiddleware(
 			return
 		}
 
-		user, exception := userRepository.GetOneById(apiKey.UserId, nil)
+		user, exception := userRepository.GetOneById(apiKey.UserId, []schemas.UserRelation{schemas.UserRelation_PlanLimitation})
 		if exception != nil || user == nil || user.Id == uuid.Nil || user.PublicId == uuid.Nil {
 			abortAPIKey(ctx, "the API key owner is invalid", http.StatusUnauthorized)
 			return
 		}
-		setAPIKeyContext(ctx, apiKey.Id, user.Id, user.PublicId)
+		restriction := contexts.APIKeyRestriction{
+			RootShelfIds: apiKey.GetRootShelfIds(),
+			StationIds:   apiKey.GetStationIds(),
+		}
 		if cacheClient != nil {
 			_ = cacheClient.Set(keyHash, apikeycache.APIKeyCache{
 				Id:           apiKey.Id,
 				UserId:       user.Id,
 				UserPublicId: user.PublicId,
+				Scopes:       []string(apiKey.Scopes),
+				RootShelfIds: restriction.RootShelfIds,
+				StationIds:   restriction.StationIds,
 				ExpiresAt:    apiKey.ExpiresAt,
 				RevokedAt:    apiKey.RevokedAt,
 			})
+			_ = cacheClient.SetRateLimitPolicy(keyHash, sharedtokens.NewAPIKeyRateLimitPolicy(
+				string(user.Plan),
+				user.PlanLimitation.MaxAPIKeyBurstRequestCount,
+				user.PlanLimitation.MaxAPIKeySustainedRequestCount,
+			))
+		}
+		if !isAPIKeyScopeGranted(ctx, apiKey.Scopes) {
+			abortAPIKey(ctx, "the API key is not granted the scope of this operation", http.StatusForbidden)
+			return
 		}
+		setAPIKeyContext(ctx, apiKey.Id, user.Id, user.PublicId, restriction)
 		_ = apiKeyRepository.MarkUsed(apiKey.Id, now)
 		ctx.Next()
 	}
@@ -94,12 +118,32 @@ func isAPIKeyActive(revokedAt, expiresAt *time.Time, now time.Time) bool {
 	return revokedAt == nil && (expiresAt == nil || expiresAt.After(now))
 }
 
-func setAPIKeyContext(ctx *gin.Context, apiKeyId, userId, userPublicId uuid.UUID) {
+// isAPIKeyScopeGranted checks the key's scopes against the scope carried by the
+// delegation token, which DelegationAuthenticatedMiddleware has already matched
+// with the operation. A key without scopes keeps the full rights of its owner.
+func isAPIKeyScopeGranted(ctx *gin.Context, scopes []string) bool {
+	if len(scopes) == 0 {
+		return true
+	}
+	requiredScope, exception := contexts.GetAPIKeyScope(ctx.Request.Context())
+	if exception != nil {
+		return false
+	}
+
+	return sharedtokens.IsAPIKeyScopeGranted(scopes, requiredScope)
+}
+
+func setAPIKeyContext(
+	ctx *gin.Context,
+	apiKeyId, userId, userPublicId uuid.UUID,
+	restriction contexts.APIKeyRestriction,
+) {
 	requestContext := contexts.WithGatewaySource(ctx.Request.Context(), sharedtokens.GatewaySourceAPI)
 	requestContext = contexts.WithAuthMethod(requestContext, sharedtokens.AuthMethodAPIKey)
 	requestContext = contexts.WithAPIKeyId(requestContext, apiKeyId.String())
 	requestContext = contexts.WithActorUserId(requestContext, userId)
 	requestContext = contexts.WithActorUserPublicId(requestContext, userPublicId)
+	requestContext = contexts.WithAPIKeyRestriction(requestContext, restriction)
 	ctx.Request = ctx.Request.WithContext(requestContext)
 }
 
@@ -20,10 +20,20 @@ import (
 	data "github.com/HiIamJeff67/notegic-backend/internal/core/data/database"
 	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
 	repositories "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/repositories"
+	apiexceptions "github.com/HiIamJeff67/notegic-backend/internal/core/exceptions"
 )
 
+// RefreshTokenReuseGracePeriod lets the concurrent requests of one device, which were sent
+// with the same refresh token before the rotated one reached the device, be refreshed
+// without being mistaken for a reused refresh token.
+const RefreshTokenReuseGracePeriod time.Duration = 30 * time.Second
+
+// AuthMiddleware authenticates the user session of the request, an expired access token
+// is refreshed by rotating the refresh token of its session, and a refresh token that
+// has already been rotated revokes the whole session as it may have been stolen.
 func AuthMiddleware(
 	userRepository repositories.UserRepositoryInterface,
+	userSessionRepository repositories.UserSessionRepositoryInterface,
 	userDataCacheClient *userdata.UserDataCacheClient,
 ) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
@@ -59,7 +69,8 @@ func AuthMiddleware(
 		if accessTokenExists {
 			claims, err := sharedtokens.ParseAccessToken(accessToken)
 			if err == nil && claims.Subject == userPublicId.String() && claims.UserAgent == userAgent {
-				if setActorUserId(ctx, userRepository, userPublicId) {
+				if setActorUserId(ctx, userRepository, userPublicId) &&
+					setActorSessionId(ctx, userSessionRepository, claims.SessionId) {
 					ctx.Next()
 				}
 				return
@@ -115,23 +126,62 @@ func AuthMiddleware(
 			nil,
 			options.WithDB(data.DB),
 		)
-		if exception != nil || user.RefreshToken != refreshToken || user.UserAgent != userAgent {
-			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gatewaycontract.Response[struct{}]{
-				Version: gatewaycontract.Version,
-				Metadata: gatewaycontract.ResponseMetadata{
-					RequestId:   ctx.GetHeader("X-Request-Id"),
-					RespondedAt: time.Now(),
+		sessionId, err := uuid.Parse(claims.SessionId)
+		if exception != nil || err != nil {
+			abortUserSession(ctx, apiexceptions.NewUserSessionException().Inactive())
+			return
+		}
+
+		var newRefreshToken *string
+		if userSessionRepository != nil {
+			newRefreshToken, err = sharedtokens.GenerateRefreshToken(
+				user.PublicId.String(),
+				sharedtokens.RefreshTokenClaims{
+					Name:      user.Name,
+					Email:     user.Email,
+					UserAgent: userAgent,
+					SessionId: sessionId.String(),
 				},
-				Data: struct{}{},
-				Exception: exceptions.New(
-					"Unauthorized",
+			)
+			if err != nil {
+				abortUserSession(ctx, exceptions.New(
+					"GenerationFailed",
 					"Core",
 					"AuthenticateRequest",
-					"the forwarded refresh credential could not be authenticated",
-					http.StatusUnauthorized,
-				),
-			})
-			return
+					"failed to generate a new refresh token",
+					http.StatusInternalServerError,
+					true,
+				).WithOrigin(err))
+				return
+			}
+
+			now := time.Now()
+			refreshTokenHash := sharedtokens.HashRefreshToken(refreshToken)
+			if _, exception := userSessionRepository.RotateOneById(
+				sessionId,
+				refreshTokenHash,
+				sharedtokens.HashRefreshToken(*newRefreshToken),
+				ctx.GetHeader("X-Real-IP"),
+				now,
+				now.Add(sharedtokens.RefreshTokenExpiresIn),
+				options.WithDB(data.DB),
+			); exception != nil {
+				// the refresh token is not the current one of its session, so it is either
+				// raced by a concurrent refresh of the same device or replayed after its rotation
+				session, exception := userSessionRepository.GetOneById(sessionId, options.WithDB(data.DB))
+				if exception != nil || session.UserId != user.Id || !session.IsActive(now) {
+					abortUserSession(ctx, apiexceptions.NewUserSessionException().Inactive())
+					return
+				}
+				if session.PrevRefreshTokenHash == nil || *session.PrevRefreshTokenHash != refreshTokenHash ||
+					session.RefreshedAt == nil || now.Sub(*session.RefreshedAt) > RefreshTokenReuseGracePeriod {
+					_, _ = userSessionRepository.RevokeOneById(sessionId, user.Id, now, options.WithDB(data.DB))
+					abortUserSession(ctx, apiexceptions.NewUserSessionException().RefreshTokenReused())
+					return
+				}
+				// the device will receive the rotated refresh token from the winning request
+				newRefreshToken = nil
+			}
 		}
 
 		newAccessToken, err := sharedtokens.GenerateAccessToken(
@@ -139,7 +189,8 @@ func AuthMiddleware(
 			sharedtokens.AccessTokenClaims{
 				Name:      user.Name,
 				Email:     user.Email,
-				UserAgent: user.UserAgent,
+				UserAgent: userAgent,
+				SessionId: sessionId.String(),
 			},
 		)
 		if err != nil {
@@ -210,9 +261,13 @@ func AuthMiddleware(
 		ctx.Set(sharedcontexts.ContextFieldName_IsNewTokens.String(), true)
 		ctx.Set(sharedcontexts.ContextFieldName_AccessToken.String(), *newAccessToken)
 		ctx.Set(sharedcontexts.ContextFieldName_CSRFToken.String(), *newCSRFToken)
+		if newRefreshToken != nil {
+			ctx.Set(sharedcontexts.ContextFieldName_NewRefreshToken.String(), *newRefreshToken)
+		}
 		if !setActorUserId(ctx, userRepository, userPublicId) {
 			return
 		}
+		ctx.Request = ctx.Request.WithContext(contexts.WithActorSessionId(ctx.Request.Context(), sessionId))
 		ctx.Next()
 	}
 }
@@ -258,3 +313,47 @@ func setActorUserId(
 	ctx.Set(sharedcontexts.ContextFieldName_User_Plan.String(), user.Plan)
 	return true
 }
+
+// setActorSessionId makes sure the session of the access token is still active, so a
+// revoked session is signed out before its short-lived access token expires.
+func setActorSessionId(
+	ctx *gin.Context,
+	userSessionRepository repositories.UserSessionRepositoryInterface,
+	rawSessionId string,
+) bool {
+	sessionId, err := uuid.Parse(rawSessionId)
+	if userSessionRepository == nil {
+		if err == nil {
+			ctx.Request = ctx.Request.WithContext(contexts.WithActorSessionId(ctx.Request.Context(), sessionId))
+		}
+		return true
+	}
+
+	now := time.Now()
+	actorUserId, exception := contexts.GetActorUserId(ctx.Request.Context())
+	if err != nil || exception != nil {
+		abortUserSession(ctx, apiexceptions.NewUserSessionException().Inactive())
+		return false
+	}
+	session, exception := userSessionRepository.GetOneById(sessionId, options.WithDB(data.DB))
+	if exception != nil || session.UserId != actorUserId || !session.IsActive(now) {
+		abortUserSession(ctx, apiexceptions.NewUserSessionException().Inactive())
+		return false
+	}
+	_ = userSessionRepository.TouchOneById(sessionId, now, options.WithDB(data.DB))
+
+	ctx.Request = ctx.Request.WithContext(contexts.WithActorSessionId(ctx.Request.Context(), sessionId))
+	return true
+}
+
+func abortUserSession(ctx *gin.Context, exception *exceptions.Exception) {
+	ctx.AbortWithStatusJSON(exception.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   ctx.GetHeader("X-Request-Id"),
+			RespondedAt: time.Now(),
+		},
+		Data:      struct{}{},
+		Exception: exception,
+	})
+}
@@ -0,0 +1,286 @@
+package middlewares
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	sharedtokens "github.com/HiIamJeff67/notegic-backend/shared/tokens"
+
+	sharedcontexts "github.com/HiIamJeff67/notegic-backend/shared/lib/contexts"
+
+	gatewaycontract "github.com/HiIamJeff67/notegic-backend/contracts/gateway/v1"
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+
+	contexts "github.com/HiIamJeff67/notegic-backend/internal/core/contexts"
+	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
+	repositories "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/repositories"
+	schemas "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas"
+	apiexceptions "github.com/HiIamJeff67/notegic-backend/internal/core/exceptions"
+)
+
+const testUserAgent = "NotegicTest/1.0"
+
+/* ============================== Test Doubles ============================== */
+
+type fakeUserRepository struct {
+	repositories.UserRepositoryInterface
+	user schemas.User
+}
+
+func (r *fakeUserRepository) GetOneByPublicId(publicId uuid.UUID, preloads []schemas.UserRelation, opts ...options.RepositoryOptions) (*schemas.User, *exceptions.Exception) {
+	if r.user.PublicId != publicId {
+		return nil, apiexceptions.NewUserException().NotFound()
+	}
+	user := r.user
+	return &user, nil
+}
+
+// fakeUserSessionRepository rotates the session under a lock with the same condition as the
+// conditional update of UserSessionRepository, so only one concurrent rotation can win
+type fakeUserSessionRepository struct {
+	repositories.UserSessionRepositoryInterface
+	mutex   sync.Mutex
+	session schemas.UserSession
+}
+
+func (r *fakeUserSessionRepository) GetOneById(id uuid.UUID, opts ...options.RepositoryOptions) (*schemas.UserSession, *exceptions.Exception) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.session.Id != id {
+		return nil, apiexceptions.NewUserSessionException().NotFound()
+	}
+	session := r.session
+	return &session, nil
+}
+
+func (r *fakeUserSessionRepository) RotateOneById(id uuid.UUID, refreshTokenHash string, newRefreshTokenHash string, ipAddress string, rotatedAt time.Time, expiresAt time.Time, opts ...options.RepositoryOptions) (*schemas.UserSession, *exceptions.Exception) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.session.Id != id || r.session.RefreshTokenHash != refreshTokenHash || !r.session.IsActive(rotatedAt) {
+		return nil, apiexceptions.NewUserSessionException().NotFound()
+	}
+	prevRefreshTokenHash := r.session.RefreshTokenHash
+	r.session.PrevRefreshTokenHash = &prevRefreshTokenHash
+	r.session.RefreshTokenHash = newRefreshTokenHash
+	r.session.RefreshedAt = &rotatedAt
+	r.session.ExpiresAt = expiresAt
+	session := r.session
+	return &session, nil
+}
+
+func (r *fakeUserSessionRepository) RevokeOneById(id uuid.UUID, userId uuid.UUID, revokedAt time.Time, opts ...options.RepositoryOptions) (*schemas.UserSession, *exceptions.Exception) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.session.RevokedAt == nil {
+		r.session.RevokedAt = &revokedAt
+	}
+	session := r.session
+	return &session, nil
+}
+
+/* ============================== Test Helpers ============================== */
+
+type authTestFixture struct {
+	user                  schemas.User
+	refreshToken          string
+	userSessionRepository *fakeUserSessionRepository
+	router                *gin.Engine
+}
+
+type authTestResult struct {
+	statusCode      int
+	newRefreshToken string
+	exception       *exceptions.Exception
+}
+
+func newAuthTestFixture(t *testing.T) *authTestFixture {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	t.Setenv("JWT_ACCESS_TOKEN_SECRET_KEY", "access-token-secret")
+	t.Setenv("JWT_REFRESH_TOKEN_SECRET_KEY", "refresh-token-secret")
+	t.Setenv("CSRF_TOKEN_SECRET_KEY", "csrf-token-secret")
+
+	fixture := &authTestFixture{
+		user: schemas.User{Id: uuid.New(), PublicId: uuid.New(), Name: "tester", Email: "tester@notegic.test"},
+	}
+	sessionId := uuid.New()
+	refreshToken, err := sharedtokens.GenerateRefreshToken(fixture.user.PublicId.String(), sharedtokens.RefreshTokenClaims{
+		Name:      fixture.user.Name,
+		Email:     fixture.user.Email,
+		UserAgent: testUserAgent,
+		SessionId: sessionId.String(),
+	})
+	if err != nil {
+		t.Fatalf("failed to generate the refresh token: %v", err)
+	}
+	fixture.refreshToken = *refreshToken
+	fixture.userSessionRepository = &fakeUserSessionRepository{session: schemas.UserSession{
+		Id:               sessionId,
+		UserId:           fixture.user.Id,
+		RefreshTokenHash: sharedtokens.HashRefreshToken(fixture.refreshToken),
+		ExpiresAt:        time.Now().Add(time.Hour),
+	}}
+
+	fixture.router = gin.New()
+	fixture.router.POST(
+		"/",
+		func(ctx *gin.Context) {
+			ctx.Request = ctx.Request.WithContext(contexts.WithActorUserPublicId(ctx.Request.Context(), fixture.user.PublicId))
+			ctx.Next()
+		},
+		AuthMiddleware(&fakeUserRepository{user: fixture.user}, fixture.userSessionRepository, nil),
+		func(ctx *gin.Context) {
+			newRefreshToken, _ := ctx.Get(sharedcontexts.ContextFieldName_NewRefreshToken.String())
+			ctx.JSON(http.StatusOK, gin.H{"newRefreshToken": newRefreshToken})
+		},
+	)
+
+	return fixture
+}
+
+// rotatedBefore moves the session as if its refresh token had been rotated by another request
+func (f *authTestFixture) rotatedBefore(elapsed time.Duration) {
+	refreshedAt := time.Now().Add(-elapsed)
+	prevRefreshTokenHash := f.userSessionRepository.session.RefreshTokenHash
+	f.userSessionRepository.session.PrevRefreshTokenHash = &prevRefreshTokenHash
+	f.userSessionRepository.session.RefreshTokenHash = sharedtokens.HashRefreshToken("rotated-refresh-token")
+	f.userSessionRepository.session.RefreshedAt = &refreshedAt
+}
+
+func (f *authTestFixture) refresh(t *testing.T) authTestResult {
+	body, err := json.Marshal(gatewaycontract.Request[json.RawMessage]{
+		Tokens: gatewaycontract.Tokens{RefreshToken: f.refreshToken},
+		Dto:    json.RawMessage(`{}`),
+	})
+	if err != nil {
+		t.Errorf("failed to marshal the request: %v", err)
+		return authTestResult{}
+	}
+
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+	request.Header.Set("User-Agent", testUserAgent)
+	f.router.ServeHTTP(response, request)
+
+	result := authTestResult{statusCode: response.Code}
+	if response.Code == http.StatusOK {
+		var responseBody struct {
+			NewRefreshToken *string `json:"newRefreshToken"`
+		}
+		_ = json.Unmarshal(response.Body.Bytes(), &responseBody)
+		if responseBody.NewRefreshToken != nil {
+			result.newRefreshToken = *responseBody.NewRefreshToken
+		}
+	} else {
+		var responseBody gatewaycontract.Response[struct{}]
+		_ = json.Unmarshal(response.Body.Bytes(), &responseBody)
+		result.exception = responseBody.Exception
+	}
+	return result
+}
+
+/* ============================== Tests ============================== */
+
+func TestAuthMiddlewareRotatesTheRefreshToken(t *testing.T) {
+	fixture := newAuthTestFixture(t)
+
+	result := fixture.refresh(t)
+
+	if result.statusCode != http.StatusOK || result.newRefreshToken == "" {
+		t.Fatalf("refresh = %d with the new refresh token %q, want a rotated refresh token", result.statusCode, result.newRefreshToken)
+	}
+	session := fixture.userSessionRepository.session
+	if session.RefreshTokenHash != sharedtokens.HashRefreshToken(result.newRefreshToken) {
+		t.Fatal("refresh did not store the hash of the new refresh token")
+	}
+	if session.PrevRefreshTokenHash == nil || *session.PrevRefreshTokenHash != sharedtokens.HashRefreshToken(fixture.refreshToken) {
+		t.Fatal("refresh did not keep the hash of the rotated refresh token")
+	}
+}
+
+func TestAuthMiddlewareRefreshesTheConcurrentRequestsInsideTheGracePeriod(t *testing.T) {
+	fixture := newAuthTestFixture(t)
+
+	results := make([]authTestResult, 4)
+	var waitGroup sync.WaitGroup
+	for index := range results {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			results[index] = fixture.refresh(t)
+		}()
+	}
+	waitGroup.Wait()
+
+	rotatedCount := 0
+	for _, result := range results {
+		if result.statusCode != http.StatusOK {
+			t.Fatalf("refresh = %d with %v, want every concurrent request refreshed", result.statusCode, result.exception)
+		}
+		if result.newRefreshToken != "" {
+			rotatedCount++
+		}
+	}
+	if rotatedCount != 1 {
+		t.Fatalf("refresh rotated the refresh token %d times, want only the winning request", rotatedCount)
+	}
+	if fixture.userSessionRepository.session.RevokedAt != nil {
+		t.Fatal("refresh revoked the session of the concurrent requests")
+	}
+}
+
+func TestAuthMiddlewareRefreshesARotatedRefreshTokenInsideTheGracePeriod(t *testing.T) {
+	fixture := newAuthTestFixture(t)
+	fixture.rotatedBefore(RefreshTokenReuseGracePeriod / 2)
+
+	result := fixture.refresh(t)
+
+	if result.statusCode != http.StatusOK || result.newRefreshToken != "" {
+		t.Fatalf("refresh = %d with the new refresh token %q, want a refresh without another rotation", result.statusCode, result.newRefreshToken)
+	}
+	if fixture.userSessionRepository.session.RevokedAt != nil {
+		t.Fatal("refresh revoked the session inside the grace period")
+	}
+}
+
+func TestAuthMiddlewareRevokesTheSessionOfARefreshTokenReusedAfterTheGracePeriod(t *testing.T) {
+	fixture := newAuthTestFixture(t)
+	fixture.rotatedBefore(RefreshTokenReuseGracePeriod + time.Second)
+
+	result := fixture.refresh(t)
+
+	if result.statusCode != http.StatusUnauthorized || result.exception == nil || result.exception.Reason != "RefreshTokenReused" {
+		t.Fatalf("refresh = %d with %v, want RefreshTokenReused", result.statusCode, result.exception)
+	}
+	if fixture.userSessionRepository.session.RevokedAt == nil {
+		t.Fatal("refresh kept the session of a reused refresh token")
+	}
+}
+
+func TestAuthMiddlewareRevokesTheSessionOfAnUnknownRefreshToken(t *testing.T) {
+	fixture := newAuthTestFixture(t)
+	// the refresh token has been rotated twice, so it is not even the previous one anymore
+	fixture.rotatedBefore(time.Second)
+	prevRefreshTokenHash := sharedtokens.HashRefreshToken("another-refresh-token")
+	fixture.userSessionRepository.session.PrevRefreshTokenHash = &prevRefreshTokenHash
+
+	result := fixture.refresh(t)
+
+	if result.statusCode != http.StatusUnauthorized || result.exception == nil || result.exception.Reason != "RefreshTokenReused" {
+		t.Fatalf("refresh = %d with %v, want RefreshTokenReused", result.statusCode, result.exception)
+	}
+	if fixture.userSessionRepository.session.RevokedAt == nil {
+		t.Fatal("refresh kept the session of a reused refresh token")
+	}
+}
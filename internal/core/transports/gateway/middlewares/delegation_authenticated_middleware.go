@@ -111,6 +111,33 @@ func DelegationAuthenticatedMiddleware(expectedOperation string) gin.HandlerFunc
 			})
 			return
 		}
+		if isAPIKeyDelegation {
+			// the scope is derived from the operation again, so a token can never
+			// carry a narrower scope than the operation it is used for; operations
+			// unavailable to API keys carry none and are rejected by the router
+			requiredScope, err := sharedtokens.GetRequiredAPIKeyScope(delegationClaims.Operation)
+			if err != nil {
+				requiredScope = ""
+			}
+			if requiredScope != delegationClaims.APIKeyScope {
+				ctx.AbortWithStatusJSON(http.StatusForbidden, gatewaycontract.Response[struct{}]{
+					Version: gatewaycontract.Version,
+					Metadata: gatewaycontract.ResponseMetadata{
+						RequestId:   ctx.GetHeader("X-Request-Id"),
+						RespondedAt: time.Now(),
+					},
+					Data: struct{}{},
+					Exception: exceptions.New(
+						"InvalidDelegation",
+						"Core",
+						"VerifyDelegation",
+						"delegated API key scope does not match the operation",
+						http.StatusForbidden,
+					),
+				})
+				return
+			}
+		}
 		requestContext := contexts.WithDelegationMetadata(ctx.Request.Context(), delegationClaims)
 		if delegationClaims.UserSubject != "" {
 			userSubject, err := uuid.Parse(delegationClaims.UserSubject)
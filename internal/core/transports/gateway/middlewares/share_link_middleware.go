@@ -0,0 +1,100 @@
+package middlewares
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	gatewaycontract "github.com/HiIamJeff67/notegic-backend/contracts/gateway/v1"
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+	sharedtokens "github.com/HiIamJeff67/notegic-backend/shared/tokens"
+
+	contexts "github.com/HiIamJeff67/notegic-backend/internal/core/contexts"
+	data "github.com/HiIamJeff67/notegic-backend/internal/core/data/database"
+	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
+	repositories "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/repositories"
+)
+
+// ShareLinkMiddleware authenticates a share session forwarded by the client
+// gateway. The guest acts as the creator of the share link, while the share link
+// restriction narrows the queries to the shared root shelf or block pack, and the
+// operation is checked against the permission of the share link.
+func ShareLinkMiddleware(
+	shareLinkRepository repositories.ShareLinkRepositoryInterface,
+	userRepository repositories.UserRepositoryInterface,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if shareLinkRepository == nil || userRepository == nil {
+			abortShareLink(ctx, "share link authentication is not configured", http.StatusInternalServerError)
+			return
+		}
+
+		userPublicId, exception := contexts.GetActorUserPublicId(ctx.Request.Context())
+		if exception != nil {
+			abortShareLink(ctx, "a user delegation subject is required", http.StatusUnauthorized)
+			return
+		}
+
+		request := &gatewaycontract.Request[json.RawMessage]{}
+		if ctx.Request.ContentLength != 0 {
+			_ = ctx.ShouldBindBodyWithJSON(request)
+		}
+		claims, err := sharedtokens.ParseShareSessionToken(request.Tokens.ShareSessionToken)
+		if err != nil || claims.Subject != userPublicId.String() || claims.UserAgent != ctx.GetHeader("User-Agent") {
+			abortShareLink(ctx, "the forwarded share session is invalid", http.StatusUnauthorized)
+			return
+		}
+		if !sharedtokens.IsShareLinkOperationAllowed(request.GetOperation(), claims.Permission, claims.BlockPackId != "") {
+			abortShareLink(ctx, "the share link does not allow this operation", http.StatusForbidden)
+			return
+		}
+
+		shareLink, exception := shareLinkRepository.GetOneById(
+			uuid.MustParse(claims.ShareLinkId),
+			options.WithDB(data.DB),
+		)
+		if exception != nil || !shareLink.IsSessionValid(time.Now()) ||
+			shareLink.RootShelfId.String() != claims.RootShelfId ||
+			(shareLink.BlockPackId == nil) != (claims.BlockPackId == "") ||
+			(shareLink.BlockPackId != nil && shareLink.BlockPackId.String() != claims.BlockPackId) {
+			abortShareLink(ctx, "the share link is expired or revoked", http.StatusUnauthorized)
+			return
+		}
+
+		if !setActorUserId(ctx, userRepository, userPublicId) {
+			return
+		}
+		ctx.Request = ctx.Request.WithContext(contexts.WithShareLinkRestriction(
+			ctx.Request.Context(),
+			contexts.ShareLinkRestriction{
+				ShareLinkId: shareLink.Id,
+				RootShelfId: shareLink.RootShelfId,
+				BlockPackId: shareLink.BlockPackId,
+				Permission:  claims.Permission,
+				ExpiresAt:   claims.ExpiresAt.Time,
+			},
+		))
+		ctx.Next()
+	}
+}
+
+func abortShareLink(ctx *gin.Context, message string, status int) {
+	ctx.AbortWithStatusJSON(status, gatewaycontract.Response[struct{}]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   ctx.GetHeader("X-Request-Id"),
+			RespondedAt: time.Now(),
+		},
+		Data: struct{}{},
+		Exception: exceptions.New(
+			"Unauthorized",
+			"Core",
+			"AuthenticateShareLink",
+			message,
+			status,
+		),
+	})
+}
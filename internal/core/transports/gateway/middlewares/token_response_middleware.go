@@ -22,7 +22,10 @@ func TokenResponseMiddleware() gin.HandlerFunc {
 		ctx.Next()
 
 		isNewTokens, exists := ctx.Get(sharedcontexts.ContextFieldName_IsNewTokens.String())
-		if exists && isNewTokens == true && bufferedWriter.Status() < 400 {
+		// a rotated refresh token is forwarded even with a failed response,
+		// since the previous refresh token of the session is no longer valid
+		_, isRefreshTokenRotated := ctx.Get(sharedcontexts.ContextFieldName_NewRefreshToken.String())
+		if exists && isNewTokens == true && (bufferedWriter.Status() < 400 || isRefreshTokenRotated) {
 			accessToken, accessTokenExists := ctx.Get(sharedcontexts.ContextFieldName_AccessToken.String())
 			csrfToken, csrfTokenExists := ctx.Get(sharedcontexts.ContextFieldName_CSRFToken.String())
 			if accessTokenExists && csrfTokenExists {
@@ -35,6 +38,12 @@ func TokenResponseMiddleware() gin.HandlerFunc {
 							AccessToken: accessTokenString,
 							CSRFToken:   csrfTokenString,
 						}
+						// the refresh token is only forwarded after it has been rotated
+						if refreshToken, exists := ctx.Get(sharedcontexts.ContextFieldName_NewRefreshToken.String()); exists {
+							if refreshTokenString, ok := refreshToken.(string); ok {
+								response.Tokens.RefreshToken = refreshTokenString
+							}
+						}
 						if payload, err := json.Marshal(response); err == nil {
 							body.Reset()
 							_, _ = body.Write(payload)
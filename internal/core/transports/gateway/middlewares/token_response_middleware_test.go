@@ -37,4 +37,35 @@ func TestTokenResponseMiddlewareAddsInternalTokenEnvelope(t *testing.T) {
 	if response.Tokens == nil || response.Tokens.AccessToken != "access-token" || response.Tokens.CSRFToken != "csrf-token" {
 		t.Fatalf("expected internal token envelope, got %#v", response.Tokens)
 	}
+	if response.Tokens.RefreshToken != "" {
+		t.Fatalf("expected no refresh token without a rotation, got %q", response.Tokens.RefreshToken)
+	}
+}
+
+func TestTokenResponseMiddlewareForwardsRotatedRefreshToken(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(TokenResponseMiddleware())
+	router.POST("/", func(ctx *gin.Context) {
+		ctx.Set(sharedcontexts.ContextFieldName_IsNewTokens.String(), true)
+		ctx.Set(sharedcontexts.ContextFieldName_AccessToken.String(), "access-token")
+		ctx.Set(sharedcontexts.ContextFieldName_CSRFToken.String(), "csrf-token")
+		ctx.Set(sharedcontexts.ContextFieldName_NewRefreshToken.String(), "rotated-refresh-token")
+		ctx.JSON(http.StatusOK, gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Data:    struct{}{},
+		})
+	})
+
+	request := httptest.NewRequest(http.MethodPost, "/", nil)
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, request)
+
+	response := gatewaycontract.Response[struct{}]{}
+	if err := json.Unmarshal(responseRecorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("decode internal response: %v", err)
+	}
+	if response.Tokens == nil || response.Tokens.RefreshToken != "rotated-refresh-token" {
+		t.Fatalf("expected the rotated refresh token in the internal envelope, got %#v", response.Tokens)
+	}
 }
@@ -53,6 +53,62 @@ func configureAnonymousAuthRoutes(
 			),
 			endpoint.LoginViaGoogle,
 		)
+		authRoutes.POST(
+			"/login/two-factor",
+			middlewares.DelegationMiddleware(
+				apicontract.VerifyLoginTwoFactorOperation,
+			),
+			endpoint.VerifyLoginTwoFactor,
+		)
+		authRoutes.POST(
+			"/oauth/authorization-url",
+			middlewares.DelegationMiddleware(
+				apicontract.GetOAuthAuthorizationURLOperation,
+			),
+			endpoint.GetOAuthAuthorizationURL,
+		)
+		authRoutes.POST(
+			"/register/github",
+			middlewares.DelegationMiddleware(
+				apicontract.RegisterViaGithubOperation,
+			),
+			endpoint.RegisterViaGithub,
+		)
+		authRoutes.POST(
+			"/login/github",
+			middlewares.DelegationMiddleware(
+				apicontract.LoginViaGithubOperation,
+			),
+			endpoint.LoginViaGithub,
+		)
+		authRoutes.POST(
+			"/register/meta",
+			middlewares.DelegationMiddleware(
+				apicontract.RegisterViaMetaOperation,
+			),
+			endpoint.RegisterViaMeta,
+		)
+		authRoutes.POST(
+			"/login/meta",
+			middlewares.DelegationMiddleware(
+				apicontract.LoginViaMetaOperation,
+			),
+			endpoint.LoginViaMeta,
+		)
+		authRoutes.POST(
+			"/register/oidc",
+			middlewares.DelegationMiddleware(
+				apicontract.RegisterViaOIDCOperation,
+			),
+			endpoint.RegisterViaOIDC,
+		)
+		authRoutes.POST(
+			"/login/oidc",
+			middlewares.DelegationMiddleware(
+				apicontract.LoginViaOIDCOperation,
+			),
+			endpoint.LoginViaOIDC,
+		)
 		authRoutes.POST(
 			"/email/code",
 			middlewares.DelegationMiddleware(
@@ -124,5 +180,75 @@ func configureAuthenticatedAuthRoutes(
 			middlewares.CSRFMiddleware(userDataCacheClient),
 			endpoint.DeleteMe,
 		)
+		authRoutes.POST(
+			"/sessions",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.GetMySessionsOperation,
+			),
+			authMiddleware,
+			endpoint.GetMySessions,
+		)
+		authRoutes.POST(
+			"/sessions/revoke",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.RevokeMySessionOperation,
+			),
+			authMiddleware,
+			middlewares.CSRFMiddleware(userDataCacheClient),
+			endpoint.RevokeMySession,
+		)
+		authRoutes.POST(
+			"/sessions/revoke-others",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.RevokeMyOtherSessionsOperation,
+			),
+			authMiddleware,
+			middlewares.CSRFMiddleware(userDataCacheClient),
+			endpoint.RevokeMyOtherSessions,
+		)
+		authRoutes.POST(
+			"/two-factor",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.GetMyTwoFactorOperation,
+			),
+			authMiddleware,
+			endpoint.GetMyTwoFactor,
+		)
+		authRoutes.POST(
+			"/two-factor/enroll",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.EnrollMyTwoFactorOperation,
+			),
+			authMiddleware,
+			middlewares.CSRFMiddleware(userDataCacheClient),
+			endpoint.EnrollMyTwoFactor,
+		)
+		authRoutes.POST(
+			"/two-factor/enable",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.EnableMyTwoFactorOperation,
+			),
+			authMiddleware,
+			middlewares.CSRFMiddleware(userDataCacheClient),
+			endpoint.EnableMyTwoFactor,
+		)
+		authRoutes.POST(
+			"/two-factor/disable",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.DisableMyTwoFactorOperation,
+			),
+			authMiddleware,
+			middlewares.CSRFMiddleware(userDataCacheClient),
+			endpoint.DisableMyTwoFactor,
+		)
+		authRoutes.POST(
+			"/two-factor/recovery-codes",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.RegenerateMyTwoFactorRecoveryCodesOperation,
+			),
+			authMiddleware,
+			middlewares.CSRFMiddleware(userDataCacheClient),
+			endpoint.RegenerateMyTwoFactorRecoveryCodes,
+		)
 	}
 }
@@ -0,0 +1,92 @@
+package routers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	apicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/billing"
+
+	billingservices "github.com/HiIamJeff67/notegic-backend/internal/core/services/billing"
+	endpoints "github.com/HiIamJeff67/notegic-backend/internal/core/transports/gateway/endpoints"
+	middlewares "github.com/HiIamJeff67/notegic-backend/internal/core/transports/gateway/middlewares"
+)
+
+type BillingRouterDependencies struct {
+	Service        billingservices.BillingServiceInterface
+	AuthMiddleware gin.HandlerFunc
+}
+
+// the webhook of PayPal is anonymous, its deliveries are authenticated by their signature instead
+func configureAnonymousBillingRoutes(
+	router *gin.RouterGroup,
+	deps BillingRouterDependencies,
+) {
+	endpoint := endpoints.NewBillingEndpoint(deps.Service)
+	billingRoutes := router.Group("/billing")
+	{
+		billingRoutes.POST(
+			"/get-billing-plans",
+			middlewares.DelegationMiddleware(
+				apicontract.GetBillingPlansOperation,
+			),
+			endpoint.GetBillingPlans,
+		)
+		billingRoutes.POST(
+			"/paypal/webhook",
+			middlewares.DelegationMiddleware(
+				apicontract.HandlePayPalWebhookOperation,
+			),
+			endpoint.HandlePayPalWebhook,
+		)
+	}
+}
+
+func configureBillingRoutes(
+	router *gin.RouterGroup,
+	deps BillingRouterDependencies,
+) {
+	authMiddleware := deps.AuthMiddleware
+	endpoint := endpoints.NewBillingEndpoint(deps.Service)
+	billingRoutes := router.Group("/billing")
+	{
+		billingRoutes.POST(
+			"/get-my-subscription",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.GetMySubscriptionOperation,
+			),
+			authMiddleware,
+			endpoint.GetMySubscription,
+		)
+		billingRoutes.POST(
+			"/create-my-subscription",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.CreateMySubscriptionOperation,
+			),
+			authMiddleware,
+			endpoint.CreateMySubscription,
+		)
+		billingRoutes.POST(
+			"/change-my-subscription-plan",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.ChangeMySubscriptionPlanOperation,
+			),
+			authMiddleware,
+			endpoint.ChangeMySubscriptionPlan,
+		)
+		billingRoutes.POST(
+			"/cancel-my-subscription",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.CancelMySubscriptionOperation,
+			),
+			authMiddleware,
+			endpoint.CancelMySubscription,
+		)
+		billingRoutes.POST(
+			"/get-my-billing-invoices",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.GetMyBillingInvoicesOperation,
+			),
+			authMiddleware,
+			endpoint.GetMyBillingInvoices,
+		)
+	}
+}
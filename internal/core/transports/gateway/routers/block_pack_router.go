@@ -13,6 +13,7 @@ import (
 
 type BlockPackRouterDependencies struct {
 	Service          blockservices.BlockPackServiceInterface
+	SnapshotService  blockservices.BlockPackSnapshotServiceInterface
 	AuthMiddleware   gin.HandlerFunc
 	APIKeyMiddleware gin.HandlerFunc
 }
@@ -23,7 +24,7 @@ func configureBlockPackRoutes(
 ) {
 	authMiddleware := deps.AuthMiddleware
 	apiKeyMiddleware := deps.APIKeyMiddleware
-	endpoint := endpoints.NewBlockPackEndpoint(deps.Service)
+	endpoint := endpoints.NewBlockPackEndpoint(deps.Service, deps.SnapshotService)
 	apiCompatibleAuthMiddleware := middlewares.EitherMiddleware(
 		[]gin.HandlerFunc{authMiddleware},
 		[]gin.HandlerFunc{apiKeyMiddleware},
@@ -64,6 +65,14 @@ func configureBlockPackRoutes(
 			apiCompatibleAuthMiddleware,
 			endpoint.GetAllMyBlockPacksByRootShelfId,
 		)
+		blockPackRoutes.POST(
+			"/get-markdown-by-id",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.GetMyBlockPackMarkdownByIdOperation,
+			),
+			apiCompatibleAuthMiddleware,
+			endpoint.GetMyBlockPackMarkdownById,
+		)
 		blockPackRoutes.POST(
 			"/create",
 			middlewares.DelegationAuthenticatedMiddleware(
@@ -80,6 +89,14 @@ func configureBlockPackRoutes(
 			apiCompatibleAuthMiddleware,
 			endpoint.CreateBlockPacks,
 		)
+		blockPackRoutes.POST(
+			"/create-from-markdown",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.CreateBlockPackFromMarkdownOperation,
+			),
+			apiCompatibleAuthMiddleware,
+			endpoint.CreateBlockPackFromMarkdown,
+		)
 		blockPackRoutes.POST(
 			"/update",
 			middlewares.DelegationAuthenticatedMiddleware(
@@ -152,6 +169,38 @@ func configureBlockPackRoutes(
 			apiCompatibleAuthMiddleware,
 			endpoint.DeleteMyBlockPacksByIds,
 		)
+		blockPackRoutes.POST(
+			"/create-snapshot-by-id",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.CreateMyBlockPackSnapshotByIdOperation,
+			),
+			apiCompatibleAuthMiddleware,
+			endpoint.CreateMyBlockPackSnapshotById,
+		)
+		blockPackRoutes.POST(
+			"/get-snapshots-by-id",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.GetMyBlockPackSnapshotsByIdOperation,
+			),
+			apiCompatibleAuthMiddleware,
+			endpoint.GetMyBlockPackSnapshotsById,
+		)
+		blockPackRoutes.POST(
+			"/get-snapshot-diff-by-id",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.GetMyBlockPackSnapshotDiffByIdOperation,
+			),
+			apiCompatibleAuthMiddleware,
+			endpoint.GetMyBlockPackSnapshotDiffById,
+		)
+		blockPackRoutes.POST(
+			"/restore-snapshot-by-id",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.RestoreMyBlockPackSnapshotByIdOperation,
+			),
+			apiCompatibleAuthMiddleware,
+			endpoint.RestoreMyBlockPackSnapshotById,
+		)
 		blockPackRoutes.POST(
 			"/graphql/search",
 			middlewares.DelegationAuthenticatedMiddleware(
@@ -31,5 +31,13 @@ func configureItemRoutes(
 			authMiddleware,
 			endpoint.SearchItems,
 		)
+		itemRoutes.POST(
+			"/graphql/get-my-trash",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.GetMyTrashOperation,
+			),
+			authMiddleware,
+			endpoint.GetMyTrash,
+		)
 	}
 }
@@ -136,6 +136,38 @@ func configureMaterialRoutes(
 			apiCompatibleAuthMiddleware,
 			endpoint.DeleteMyMaterialsByIds,
 		)
+		materialRoutes.POST(
+			"/initiate-upload",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.InitiateMyMaterialUploadOperation,
+			),
+			apiCompatibleAuthMiddleware,
+			endpoint.InitiateMyMaterialUpload,
+		)
+		materialRoutes.POST(
+			"/upload-part",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.UploadMyMaterialPartOperation,
+			),
+			apiCompatibleAuthMiddleware,
+			endpoint.UploadMyMaterialPart,
+		)
+		materialRoutes.POST(
+			"/complete-upload",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.CompleteMyMaterialUploadOperation,
+			),
+			apiCompatibleAuthMiddleware,
+			endpoint.CompleteMyMaterialUpload,
+		)
+		materialRoutes.POST(
+			"/abort-upload",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.AbortMyMaterialUploadOperation,
+			),
+			apiCompatibleAuthMiddleware,
+			endpoint.AbortMyMaterialUpload,
+		)
 		materialRoutes.POST(
 			"/graphql/search",
 			middlewares.DelegationAuthenticatedMiddleware(
@@ -13,6 +13,7 @@ import (
 
 type RootShelfRouterDependencies struct {
 	Service          shelfservices.RootShelfServiceInterface
+	ArchiveService   shelfservices.RootShelfArchiveServiceInterface
 	AuthMiddleware   gin.HandlerFunc
 	APIKeyMiddleware gin.HandlerFunc
 }
@@ -23,7 +24,7 @@ func configureRootShelfRoutes(
 ) {
 	authMiddleware := deps.AuthMiddleware
 	apiKeyMiddleware := deps.APIKeyMiddleware
-	endpoint := endpoints.NewRootShelfEndpoint(deps.Service)
+	endpoint := endpoints.NewRootShelfEndpoint(deps.Service, deps.ArchiveService)
 	apiCompatibleAuthMiddleware := middlewares.EitherMiddleware(
 		[]gin.HandlerFunc{authMiddleware},
 		[]gin.HandlerFunc{apiKeyMiddleware},
@@ -184,6 +185,30 @@ func configureRootShelfRoutes(
 			apiCompatibleAuthMiddleware,
 			endpoint.LeaveMyRootShelves,
 		)
+		rootShelfRoutes.POST(
+			"/get-archive-by-id",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.ExportMyRootShelfArchiveByIdOperation,
+			),
+			apiCompatibleAuthMiddleware,
+			endpoint.ExportMyRootShelfArchiveById,
+		)
+		rootShelfRoutes.POST(
+			"/create-from-archive",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.CreateRootShelfFromArchiveOperation,
+			),
+			apiCompatibleAuthMiddleware,
+			endpoint.CreateRootShelfFromArchive,
+		)
+		rootShelfRoutes.POST(
+			"/get-archive-job-by-id",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.GetMyRootShelfArchiveJobByIdOperation,
+			),
+			apiCompatibleAuthMiddleware,
+			endpoint.GetMyRootShelfArchiveJobById,
+		)
 		rootShelfRoutes.POST(
 			"/graphql/search",
 			middlewares.DelegationAuthenticatedMiddleware(
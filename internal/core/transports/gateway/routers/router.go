@@ -30,6 +30,8 @@ type RouterDependencies struct {
 	Theme             ThemeRouterDependencies
 	Item              ItemRouterDependencies
 	Badge             BadgeRouterDependencies
+	ShareLink         ShareLinkRouterDependencies
+	Billing           BillingRouterDependencies
 }
 
 func NewRouter(deps RouterDependencies) *gin.Engine {
@@ -61,6 +63,10 @@ func NewRouter(deps RouterDependencies) *gin.Engine {
 	configureThemeRoutes(anonymousCoreRouterGroup, deps.Theme)
 	configureItemRoutes(secureCoreRouterGroup, deps.Item)
 	configureBadgeRoutes(secureCoreRouterGroup, deps.Badge)
+	configureAnonymousShareLinkRoutes(anonymousCoreRouterGroup, deps.ShareLink)
+	configureShareLinkRoutes(secureCoreRouterGroup, deps.ShareLink)
+	configureAnonymousBillingRoutes(anonymousCoreRouterGroup, deps.Billing)
+	configureBillingRoutes(secureCoreRouterGroup, deps.Billing)
 
 	return router
 }
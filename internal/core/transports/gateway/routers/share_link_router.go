@@ -0,0 +1,68 @@
+package routers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	apicontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/share-links"
+
+	sharelinkservices "github.com/HiIamJeff67/notegic-backend/internal/core/services/sharelink"
+	endpoints "github.com/HiIamJeff67/notegic-backend/internal/core/transports/gateway/endpoints"
+	middlewares "github.com/HiIamJeff67/notegic-backend/internal/core/transports/gateway/middlewares"
+)
+
+type ShareLinkRouterDependencies struct {
+	Service        sharelinkservices.ShareLinkServiceInterface
+	AuthMiddleware gin.HandlerFunc
+}
+
+func configureAnonymousShareLinkRoutes(
+	router *gin.RouterGroup,
+	deps ShareLinkRouterDependencies,
+) {
+	endpoint := endpoints.NewShareLinkEndpoint(deps.Service)
+	shareLinkRoutes := router.Group("/share-links")
+	{
+		shareLinkRoutes.POST(
+			"/resolve",
+			middlewares.DelegationMiddleware(
+				apicontract.ResolveShareLinkOperation,
+			),
+			endpoint.ResolveShareLink,
+		)
+	}
+}
+
+func configureShareLinkRoutes(
+	router *gin.RouterGroup,
+	deps ShareLinkRouterDependencies,
+) {
+	authMiddleware := deps.AuthMiddleware
+	endpoint := endpoints.NewShareLinkEndpoint(deps.Service)
+	shareLinkRoutes := router.Group("/share-links")
+	{
+		shareLinkRoutes.POST(
+			"/create",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.CreateMyShareLinkOperation,
+			),
+			authMiddleware,
+			endpoint.CreateMyShareLink,
+		)
+		shareLinkRoutes.POST(
+			"/get-many-by-root-shelf-id",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.GetMyShareLinksByRootShelfIdOperation,
+			),
+			authMiddleware,
+			endpoint.GetMyShareLinksByRootShelfId,
+		)
+		shareLinkRoutes.POST(
+			"/revoke",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.RevokeMyShareLinkByIdOperation,
+			),
+			authMiddleware,
+			endpoint.RevokeMyShareLinkById,
+		)
+	}
+}
@@ -55,5 +55,69 @@ func configureUserAccountRoutes(
 			authMiddleware,
 			endpoint.UnbindGoogleAccount,
 		)
+		userAccountRoutes.POST(
+			"/github/bind",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.BindGithubAccountOperation,
+			),
+			authMiddleware,
+			endpoint.BindGithubAccount,
+		)
+		userAccountRoutes.POST(
+			"/github/unbind",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.UnbindGithubAccountOperation,
+			),
+			authMiddleware,
+			endpoint.UnbindGithubAccount,
+		)
+		userAccountRoutes.POST(
+			"/meta/bind",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.BindMetaAccountOperation,
+			),
+			authMiddleware,
+			endpoint.BindMetaAccount,
+		)
+		userAccountRoutes.POST(
+			"/meta/unbind",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.UnbindMetaAccountOperation,
+			),
+			authMiddleware,
+			endpoint.UnbindMetaAccount,
+		)
+		userAccountRoutes.POST(
+			"/oidc/bind",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.BindOIDCAccountOperation,
+			),
+			authMiddleware,
+			endpoint.BindOIDCAccount,
+		)
+		userAccountRoutes.POST(
+			"/oidc/unbind",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.UnbindOIDCAccountOperation,
+			),
+			authMiddleware,
+			endpoint.UnbindOIDCAccount,
+		)
+		userAccountRoutes.POST(
+			"/usage/get",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.GetMyUsageOperation,
+			),
+			authMiddleware,
+			endpoint.GetMyUsage,
+		)
+		userAccountRoutes.POST(
+			"/graphql/get-my-usage",
+			middlewares.DelegationAuthenticatedMiddleware(
+				apicontract.GraphQLGetMyUsageOperation,
+			),
+			authMiddleware,
+			endpoint.GetMyUsageForGraphQL,
+		)
 	}
 }
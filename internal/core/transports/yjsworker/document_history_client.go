@@ -0,0 +1,133 @@
+package adapterstransport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+
+	blockpackscontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/block-packs"
+	yjsworkercontract "github.com/HiIamJeff67/notegic-backend/contracts/yjs-worker/v1"
+
+	coreconfig "github.com/HiIamJeff67/notegic-backend/internal/core/configs"
+)
+
+type DocumentHistoryClient struct {
+	projectionEndpoint  string
+	restorationEndpoint string
+	httpClient          *http.Client
+}
+
+func NewDocumentHistoryClient(
+	config coreconfig.YjsDocumentHistoryConfig,
+) *DocumentHistoryClient {
+	return &DocumentHistoryClient{
+		projectionEndpoint:  config.ProjectionEndpoint,
+		restorationEndpoint: config.RestorationEndpoint,
+		httpClient: &http.Client{
+			Timeout: config.Timeout,
+		},
+	}
+}
+
+func (c *DocumentHistoryClient) ProjectDocuments(
+	ctx context.Context,
+	requestDtos []blockpackscontract.ProjectBlockPackYjsDocumentReqDto,
+) ([]blockpackscontract.ProjectBlockPackYjsDocumentResDto, error) {
+	if len(requestDtos) == 0 {
+		return []blockpackscontract.ProjectBlockPackYjsDocumentResDto{}, nil
+	}
+
+	var responseDto struct {
+		Documents []blockpackscontract.ProjectBlockPackYjsDocumentResDto `json:"documents"`
+	}
+	if err := c.post(ctx, c.projectionEndpoint, "projection", struct {
+		Documents []blockpackscontract.ProjectBlockPackYjsDocumentReqDto `json:"documents"`
+	}{
+		Documents: requestDtos,
+	}, &responseDto); err != nil {
+		return nil, err
+	}
+	if len(responseDto.Documents) != len(requestDtos) {
+		return nil, errors.New("Yjs document projection response is incomplete")
+	}
+
+	return responseDto.Documents, nil
+}
+
+func (c *DocumentHistoryClient) RestoreDocument(
+	ctx context.Context,
+	requestDto blockpackscontract.RestoreBlockPackYjsDocumentReqDto,
+) (*blockpackscontract.RestoreBlockPackYjsDocumentResDto, error) {
+	var responseDto blockpackscontract.RestoreBlockPackYjsDocumentResDto
+	if err := c.post(ctx, c.restorationEndpoint, "restoration", requestDto, &responseDto); err != nil {
+		return nil, err
+	}
+	if len(responseDto.Update) == 0 {
+		return nil, errors.New("Yjs document restoration response is invalid")
+	}
+
+	return &responseDto, nil
+}
+
+/* ============================== Private Methods ============================== */
+
+func (c *DocumentHistoryClient) post(
+	ctx context.Context,
+	endpoint string,
+	operation string,
+	requestBody any,
+	responseBody any,
+) error {
+	payload, err := json.Marshal(requestBody)
+	if err != nil {
+		return fmt.Errorf("encode Yjs document %s request: %w", operation, err)
+	}
+	if len(payload) > yjsworkercontract.YjsMaintenanceMaximumPayloadBytes {
+		return fmt.Errorf("Yjs document %s request exceeds the worker payload limit", operation)
+	}
+
+	request, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		endpoint,
+		bytes.NewReader(payload),
+	)
+	if err != nil {
+		return fmt.Errorf("create Yjs document %s request: %w", operation, err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(request.Header))
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("send Yjs document %s request: %w", operation, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("Yjs document %s worker returned %s", operation, response.Status)
+	}
+
+	responsePayload, err := io.ReadAll(io.LimitReader(
+		response.Body,
+		int64(yjsworkercontract.YjsMaintenanceMaximumPayloadBytes)+1,
+	))
+	if err != nil {
+		return fmt.Errorf("read Yjs document %s response: %w", operation, err)
+	}
+	if len(responsePayload) > yjsworkercontract.YjsMaintenanceMaximumPayloadBytes {
+		return fmt.Errorf("Yjs document %s response exceeds the worker payload limit", operation)
+	}
+	if err := json.Unmarshal(responsePayload, responseBody); err != nil {
+		return fmt.Errorf("decode Yjs document %s response: %w", operation, err)
+	}
+
+	return nil
+}
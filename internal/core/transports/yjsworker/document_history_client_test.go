@@ -0,0 +1,77 @@
+package adapterstransport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	blockpackscontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/block-packs"
+
+	coreconfig "github.com/HiIamJeff67/notegic-backend/internal/core/configs"
+)
+
+func TestDocumentHistoryClientProjectAndRestoreDocuments(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/projection", func(responseWriter http.ResponseWriter, request *http.Request) {
+		var requestBody struct {
+			Documents []blockpackscontract.ProjectBlockPackYjsDocumentReqDto `json:"documents"`
+		}
+		if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil {
+			t.Fatalf("Decode() error = %v", err)
+		}
+		if len(requestBody.Documents) != 2 {
+			t.Fatalf("documents = %d, want 2", len(requestBody.Documents))
+		}
+
+		responseWriter.Header().Set("Content-Type", "application/json")
+		_, _ = responseWriter.Write([]byte(`{"documents":[{"blocks":[]},{"blocks":[]}]}`))
+	})
+	mux.HandleFunc("/restoration", func(responseWriter http.ResponseWriter, request *http.Request) {
+		var requestBody blockpackscontract.RestoreBlockPackYjsDocumentReqDto
+		if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil {
+			t.Fatalf("Decode() error = %v", err)
+		}
+		if len(requestBody.CurrentState) != 1 || len(requestBody.TargetState) != 1 {
+			t.Fatalf("restoration request = %#v", requestBody)
+		}
+
+		responseWriter.Header().Set("Content-Type", "application/json")
+		_, _ = responseWriter.Write([]byte(`{"update":"Aw==","blockCount":3}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := NewDocumentHistoryClient(coreconfig.YjsDocumentHistoryConfig{
+		ProjectionEndpoint:  server.URL + "/projection",
+		RestorationEndpoint: server.URL + "/restoration",
+		Timeout:             time.Second,
+	})
+
+	projectedDtos, err := client.ProjectDocuments(
+		context.Background(),
+		[]blockpackscontract.ProjectBlockPackYjsDocumentReqDto{{State: []byte{1}}, {State: []byte{2}}},
+	)
+	if err != nil {
+		t.Fatalf("ProjectDocuments() error = %v", err)
+	}
+	if len(projectedDtos) != 2 {
+		t.Fatalf("ProjectDocuments() = %#v", projectedDtos)
+	}
+
+	restoredDto, err := client.RestoreDocument(
+		context.Background(),
+		blockpackscontract.RestoreBlockPackYjsDocumentReqDto{
+			CurrentState: []byte{1},
+			TargetState:  []byte{2},
+		},
+	)
+	if err != nil {
+		t.Fatalf("RestoreDocument() error = %v", err)
+	}
+	if len(restoredDto.Update) != 1 || restoredDto.BlockCount != 3 {
+		t.Fatalf("RestoreDocument() = %#v", restoredDto)
+	}
+}
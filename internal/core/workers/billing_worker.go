@@ -0,0 +1,114 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	logs "github.com/HiIamJeff67/notegic-backend/shared/platform/observability/logs"
+
+	coreconfig "github.com/HiIamJeff67/notegic-backend/internal/core/configs"
+	billingservices "github.com/HiIamJeff67/notegic-backend/internal/core/services/billing"
+)
+
+type BillingWorkerInterface interface {
+	Start(ctx context.Context) func()
+	ExpireLapsed(ctx context.Context) error
+	CancelPendingProviderSubscriptions(ctx context.Context) error
+}
+
+type BillingWorker struct {
+	config         coreconfig.BillingConfig
+	billingService billingservices.BillingServiceInterface
+}
+
+func NewBillingWorker(
+	config coreconfig.BillingConfig,
+	billingService billingservices.BillingServiceInterface,
+) BillingWorkerInterface {
+	return &BillingWorker{
+		config:         config,
+		billingService: billingService,
+	}
+}
+
+/* ============================== Auxiliary Functions ============================== */
+
+func (w *BillingWorker) expireLapsed(ctx context.Context) {
+	if err := w.ExpireLapsed(ctx); err != nil && ctx.Err() == nil && logs.NotegicLogger != nil {
+		logs.NotegicLogger.Error(ctx, err, "Lapsed subscription expiration failed")
+	}
+	if err := w.CancelPendingProviderSubscriptions(ctx); err != nil && ctx.Err() == nil && logs.NotegicLogger != nil {
+		logs.NotegicLogger.Error(ctx, err, "Pending provider subscription cancellation failed")
+	}
+}
+
+/* ============================== Worker Methods ============================== */
+
+func (w *BillingWorker) Start(ctx context.Context) func() {
+	workerCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		w.expireLapsed(workerCtx)
+
+		ticker := time.NewTicker(w.config.WorkerInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-workerCtx.Done():
+				return
+			case <-ticker.C:
+				w.expireLapsed(workerCtx)
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// ExpireLapsed expires the subscriptions whose grace period or paid period has ended
+// one by one until there is none left, downgrading the plans of their users
+func (w *BillingWorker) ExpireLapsed(ctx context.Context) error {
+	if w == nil || w.billingService == nil || w.config.WorkerInterval <= 0 {
+		return errors.New("billing worker dependencies are required")
+	}
+
+	for ctx.Err() == nil {
+		expired, exception := w.billingService.ExpireNextLapsedSubscription(ctx)
+		if exception != nil {
+			return fmt.Errorf("expire lapsed subscription: %w", exception)
+		}
+		if !expired {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// CancelPendingProviderSubscriptions retries the cancellations at the provider which have failed
+// after their subscriptions were cancelled or expired locally, it stops at the first failure
+// since the provider is most likely unavailable until the next tick
+func (w *BillingWorker) CancelPendingProviderSubscriptions(ctx context.Context) error {
+	if w == nil || w.billingService == nil || w.config.WorkerInterval <= 0 {
+		return errors.New("billing worker dependencies are required")
+	}
+
+	for ctx.Err() == nil {
+		cancelled, exception := w.billingService.CancelNextPendingProviderSubscription(ctx)
+		if exception != nil {
+			return fmt.Errorf("cancel pending provider subscription: %w", exception)
+		}
+		if !cancelled {
+			return nil
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	logs "github.com/HiIamJeff67/notegic-backend/shared/platform/observability/logs"
+	metrics "github.com/HiIamJeff67/notegic-backend/shared/platform/observability/metrics"
+
+	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
+	repositories "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/repositories"
+)
+
+type MaterialProcessingReconciliationWorkerInterface interface {
+	Start(ctx context.Context) func()
+	Reconcile(ctx context.Context) error
+}
+
+type MaterialProcessingReconciliationWorker struct {
+	db                    *gorm.DB
+	materialRepository    repositories.MaterialRepositoryInterface
+	outboxEventRepository repositories.OutboxEventRepositoryInterface
+}
+
+func NewMaterialProcessingReconciliationWorker(
+	db *gorm.DB,
+	materialRepository repositories.MaterialRepositoryInterface,
+	outboxEventRepository repositories.OutboxEventRepositoryInterface,
+) MaterialProcessingReconciliationWorkerInterface {
+	return &MaterialProcessingReconciliationWorker{
+		db:                    db,
+		materialRepository:    materialRepository,
+		outboxEventRepository: outboxEventRepository,
+	}
+}
+
+/* ============================== Constants ============================== */
+
+const (
+	materialProcessingReconciliationBatchSize = 256
+	materialProcessingReconciliationInterval  = time.Hour
+	// the materials waiting for or stuck in the processing longer than this are hinted again,
+	// which covers the lost hints and the processing interrupted by a restart of the Core
+	materialProcessingReconciliationStaleAfter = 15 * time.Minute
+)
+
+/* ============================== Auxiliary Functions ============================== */
+
+func (w *MaterialProcessingReconciliationWorker) reconcile(ctx context.Context) {
+	if err := w.Reconcile(ctx); err != nil && ctx.Err() == nil {
+		// like the Yjs maintenance reconciliation, this is only a safety net of the outbox path
+		if logs.NotegicLogger != nil {
+			logs.NotegicLogger.Error(ctx, err, "Material processing reconciliation failed")
+		}
+		return
+	}
+}
+
+/* ============================== Worker Methods ============================== */
+
+func (w *MaterialProcessingReconciliationWorker) Start(ctx context.Context) func() {
+	workerCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		w.reconcile(workerCtx)
+
+		ticker := time.NewTicker(materialProcessingReconciliationInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-workerCtx.Done():
+				return
+			case <-ticker.C:
+				w.reconcile(workerCtx)
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+func (w *MaterialProcessingReconciliationWorker) Reconcile(ctx context.Context) error {
+	if w == nil || w.db == nil || w.materialRepository == nil || w.outboxEventRepository == nil {
+		return errors.New("material processing reconciliation dependencies are required")
+	}
+
+	materialIds, exception := w.materialRepository.GetManyUnprocessedIds(
+		time.Now().Add(-materialProcessingReconciliationStaleAfter),
+		materialProcessingReconciliationBatchSize,
+		options.WithDB(w.db.WithContext(ctx)),
+	)
+	if exception != nil {
+		return fmt.Errorf("load unprocessed materials: %w", exception)
+	}
+	if metrics.NotegicMeter != nil {
+		metrics.NotegicMeter.Value(ctx, "material.processing.reconciliation.stale_materials", int64(len(materialIds)))
+	}
+	if len(materialIds) == 0 {
+		return nil
+	}
+
+	tx := w.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return fmt.Errorf("begin material processing reconciliation transaction: %w", tx.Error)
+	}
+	if err := w.outboxEventRepository.EnqueueManyMaterialProcessingHints(
+		tx,
+		uuid.NewString(),
+		materialIds,
+		"reconciliation",
+	); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("enqueue material processing hints: %w", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("commit material processing reconciliation transaction: %w", err)
+	}
+	if metrics.NotegicMeter != nil {
+		metrics.NotegicMeter.Count(ctx, "material.processing.reconciliation.hints_enqueued", int64(len(materialIds)))
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	logs "github.com/HiIamJeff67/notegic-backend/shared/platform/observability/logs"
+
+	coreconfig "github.com/HiIamJeff67/notegic-backend/internal/core/configs"
+	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
+	repositories "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/repositories"
+	schemas "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas"
+	storage "github.com/HiIamJeff67/notegic-backend/internal/core/data/storage"
+)
+
+// the maximum number of the expired uploads aborted in one transaction
+const materialUploadCleanupBatchSize = 100
+
+type MaterialUploadCleanupWorkerInterface interface {
+	Start(ctx context.Context) func()
+	Cleanup(ctx context.Context) error
+}
+
+type MaterialUploadCleanupWorker struct {
+	db                       *gorm.DB
+	config                   coreconfig.MaterialUploadConfig
+	storage                  storage.StorageInterface
+	materialUploadRepository repositories.MaterialUploadRepositoryInterface
+}
+
+func NewMaterialUploadCleanupWorker(
+	db *gorm.DB,
+	config coreconfig.MaterialUploadConfig,
+	storage storage.StorageInterface,
+	materialUploadRepository repositories.MaterialUploadRepositoryInterface,
+) MaterialUploadCleanupWorkerInterface {
+	return &MaterialUploadCleanupWorker{
+		db:                       db,
+		config:                   config,
+		storage:                  storage,
+		materialUploadRepository: materialUploadRepository,
+	}
+}
+
+/* ============================== Auxiliary Functions ============================== */
+
+func (w *MaterialUploadCleanupWorker) cleanup(ctx context.Context) {
+	if err := w.Cleanup(ctx); err != nil && ctx.Err() == nil && logs.NotegicLogger != nil {
+		logs.NotegicLogger.Error(ctx, err, "Material upload cleanup failed")
+	}
+}
+
+// discardStorageUpload aborts the multipart upload, or deletes its object when the upload has
+// already been assembled by a completion whose material update was never committed
+func (w *MaterialUploadCleanupWorker) discardStorageUpload(ctx context.Context, materialUpload schemas.MaterialUpload) {
+	abortErr := w.storage.AbortMultipartUploadByKey(ctx, materialUpload.ContentKey, materialUpload.StorageUploadId)
+	if abortErr == nil {
+		return
+	}
+	if err := w.storage.DeleteObjectByKey(ctx, materialUpload.ContentKey); err != nil && logs.NotegicLogger != nil {
+		logs.NotegicLogger.Error(ctx, errors.Join(abortErr, err), "Failed to discard an expired material upload")
+	}
+}
+
+/* ============================== Worker Methods ============================== */
+
+func (w *MaterialUploadCleanupWorker) Start(ctx context.Context) func() {
+	workerCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		w.cleanup(workerCtx)
+
+		ticker := time.NewTicker(w.config.CleanupInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-workerCtx.Done():
+				return
+			case <-ticker.C:
+				w.cleanup(workerCtx)
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// Cleanup deletes the expired uploads in batches and discards their parts from the storage once
+// the deletions are committed, the uploads locked by their completions are skipped until the next run
+func (w *MaterialUploadCleanupWorker) Cleanup(ctx context.Context) error {
+	if w == nil || w.db == nil || w.storage == nil || w.materialUploadRepository == nil ||
+		w.config.CleanupInterval <= 0 {
+		return errors.New("material upload cleanup dependencies are required")
+	}
+
+	for ctx.Err() == nil {
+		tx := w.db.WithContext(ctx).Begin()
+		if tx.Error != nil {
+			return fmt.Errorf("begin material upload cleanup transaction: %w", tx.Error)
+		}
+
+		expiredUploads, exception := w.materialUploadRepository.GetManyExpired(
+			time.Now().UTC(),
+			materialUploadCleanupBatchSize,
+			options.WithTransactionDB(tx),
+		)
+		if exception != nil {
+			tx.Rollback()
+			return fmt.Errorf("get expired material uploads: %w", exception)
+		}
+		for _, expiredUpload := range expiredUploads {
+			if exception := w.materialUploadRepository.DeleteOneById(
+				expiredUpload.Id,
+				options.WithTransactionDB(tx),
+			); exception != nil {
+				tx.Rollback()
+				return fmt.Errorf("delete expired material upload: %w", exception)
+			}
+		}
+
+		if err := tx.Commit().Error; err != nil {
+			return fmt.Errorf("commit material upload cleanup transaction: %w", err)
+		}
+
+		for _, expiredUpload := range expiredUploads {
+			w.discardStorageUpload(ctx, expiredUpload)
+		}
+
+		if len(expiredUploads) < materialUploadCleanupBatchSize {
+			return nil
+		}
+	}
+
+	return ctx.Err()
+}
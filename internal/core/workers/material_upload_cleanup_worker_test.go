@@ -0,0 +1,232 @@
+package workers
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+
+	coreconfig "github.com/HiIamJeff67/notegic-backend/internal/core/configs"
+	inputs "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/inputs"
+	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
+	schemas "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/schemas"
+	storage "github.com/HiIamJeff67/notegic-backend/internal/core/data/storage"
+	apiexceptions "github.com/HiIamJeff67/notegic-backend/internal/core/exceptions"
+)
+
+/* ============================== Test Doubles ============================== */
+
+// transactionOnlyConnector only supports the transactions, since the repository is replaced by
+// the fake below and the worker itself never sends a statement to the database
+type transactionOnlyConnector struct {
+	commitErr error
+}
+
+func (c transactionOnlyConnector) Connect(context.Context) (driver.Conn, error) { return c, nil }
+func (c transactionOnlyConnector) Driver() driver.Driver                        { return c }
+func (c transactionOnlyConnector) Open(string) (driver.Conn, error)             { return c, nil }
+func (transactionOnlyConnector) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("unexpected statement " + query)
+}
+func (transactionOnlyConnector) Close() error                { return nil }
+func (c transactionOnlyConnector) Begin() (driver.Tx, error) { return c, nil }
+func (c transactionOnlyConnector) Commit() error             { return c.commitErr }
+func (transactionOnlyConnector) Rollback() error             { return nil }
+
+// fakeMaterialUploadRepository only applies the deletions when the next batch is read or the test commits them,
+// so the uploads of an uncommitted cleanup stay readable like in the database
+type fakeMaterialUploadRepository struct {
+	uploads        map[uuid.UUID]schemas.MaterialUpload
+	expiredCalls   int
+	pendingDeletes []uuid.UUID
+}
+
+func (r *fakeMaterialUploadRepository) GetOneById(id uuid.UUID, materialId uuid.UUID, userId uuid.UUID, opts ...options.RepositoryOptions) (*schemas.MaterialUpload, *exceptions.Exception) {
+	return nil, apiexceptions.NewMaterialUploadException().NotFound()
+}
+
+func (r *fakeMaterialUploadRepository) GetOneByStorageUploadId(storageUploadId string, userId uuid.UUID, opts ...options.RepositoryOptions) (*schemas.MaterialUpload, *exceptions.Exception) {
+	return nil, apiexceptions.NewMaterialUploadException().NotFound()
+}
+
+func (r *fakeMaterialUploadRepository) GetManyExpired(now time.Time, limit int, opts ...options.RepositoryOptions) ([]schemas.MaterialUpload, *exceptions.Exception) {
+	r.expiredCalls++
+	r.commitPendingDeletes()
+
+	expiredUploads := []schemas.MaterialUpload{}
+	for _, materialUpload := range r.uploads {
+		if !materialUpload.ExpiresAt.After(now) {
+			expiredUploads = append(expiredUploads, materialUpload)
+		}
+	}
+	sort.Slice(expiredUploads, func(i, j int) bool {
+		return expiredUploads[i].ExpiresAt.Before(expiredUploads[j].ExpiresAt)
+	})
+	if len(expiredUploads) > limit {
+		expiredUploads = expiredUploads[:limit]
+	}
+	return expiredUploads, nil
+}
+
+func (r *fakeMaterialUploadRepository) GetMaxMaterialSizeByMaterialId(materialId uuid.UUID, opts ...options.RepositoryOptions) (int64, *exceptions.Exception) {
+	return 0, apiexceptions.NewMaterialUploadException().NotFound()
+}
+
+func (r *fakeMaterialUploadRepository) CreateOne(userId uuid.UUID, input inputs.CreateMaterialUploadInput, opts ...options.RepositoryOptions) (*schemas.MaterialUpload, *exceptions.Exception) {
+	return nil, apiexceptions.NewMaterialUploadException().FailedToCreate()
+}
+
+func (r *fakeMaterialUploadRepository) DeleteOneById(id uuid.UUID, opts ...options.RepositoryOptions) *exceptions.Exception {
+	if _, ok := r.uploads[id]; !ok {
+		return apiexceptions.NewMaterialUploadException().NotFound()
+	}
+	r.pendingDeletes = append(r.pendingDeletes, id)
+	return nil
+}
+
+func (r *fakeMaterialUploadRepository) commitPendingDeletes() {
+	for _, id := range r.pendingDeletes {
+		delete(r.uploads, id)
+	}
+	r.pendingDeletes = nil
+}
+
+/* ============================== Test Helpers ============================== */
+
+func newMaterialUploadCleanupTestWorker(
+	t *testing.T,
+	commitErr error,
+	inMemoryStorage storage.StorageInterface,
+	materialUploadRepository *fakeMaterialUploadRepository,
+) MaterialUploadCleanupWorkerInterface {
+	t.Helper()
+
+	db, err := gorm.Open(
+		postgres.New(postgres.Config{Conn: sql.OpenDB(transactionOnlyConnector{commitErr: commitErr})}),
+		&gorm.Config{DisableAutomaticPing: true, SkipDefaultTransaction: true},
+	)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+
+	return NewMaterialUploadCleanupWorker(
+		db,
+		coreconfig.MaterialUploadConfig{Expiration: time.Hour, CleanupInterval: time.Minute},
+		inMemoryStorage,
+		materialUploadRepository,
+	)
+}
+
+func createTestMaterialUpload(t *testing.T, inMemoryStorage storage.StorageInterface, expiresAt time.Time) schemas.MaterialUpload {
+	t.Helper()
+
+	contentKey := uuid.NewString()
+	storageUploadId, err := inMemoryStorage.CreateMultipartUploadByKey(context.Background(), contentKey, nil)
+	if err != nil {
+		t.Fatalf("failed to create the multipart upload: %v", err)
+	}
+	return schemas.MaterialUpload{
+		Id:              uuid.New(),
+		ContentKey:      contentKey,
+		StorageUploadId: storageUploadId,
+		ExpiresAt:       expiresAt,
+	}
+}
+
+func isMultipartUploadOpen(inMemoryStorage storage.StorageInterface, materialUpload schemas.MaterialUpload) bool {
+	_, err := inMemoryStorage.UploadPartByKey(context.Background(), materialUpload.ContentKey, materialUpload.StorageUploadId, 1, strings.NewReader("part"))
+	return err == nil
+}
+
+/* ============================== Tests ============================== */
+
+func TestMaterialUploadCleanupWorkerDiscardsTheExpiredUploads(t *testing.T) {
+	inMemoryStorage := storage.NewInMemoryStorage()
+	now := time.Now()
+	openUpload := createTestMaterialUpload(t, inMemoryStorage, now.Add(-time.Minute))
+	assembledUpload := createTestMaterialUpload(t, inMemoryStorage, now.Add(-time.Second))
+	activeUpload := createTestMaterialUpload(t, inMemoryStorage, now.Add(time.Hour))
+
+	// a completion assembled this upload, but its material update was never committed
+	eTag, err := inMemoryStorage.UploadPartByKey(context.Background(), assembledUpload.ContentKey, assembledUpload.StorageUploadId, 1, strings.NewReader("part"))
+	if err != nil {
+		t.Fatalf("failed to upload the part: %v", err)
+	}
+	if _, err := inMemoryStorage.CompleteMultipartUploadByKey(context.Background(), assembledUpload.ContentKey, assembledUpload.StorageUploadId, []storage.UploadedPart{
+		{PartNumber: 1, ETag: eTag},
+	}); err != nil {
+		t.Fatalf("failed to complete the multipart upload: %v", err)
+	}
+
+	materialUploadRepository := &fakeMaterialUploadRepository{uploads: map[uuid.UUID]schemas.MaterialUpload{
+		openUpload.Id:      openUpload,
+		assembledUpload.Id: assembledUpload,
+		activeUpload.Id:    activeUpload,
+	}}
+	worker := newMaterialUploadCleanupTestWorker(t, nil, inMemoryStorage, materialUploadRepository)
+
+	if err := worker.Cleanup(context.Background()); err != nil {
+		t.Fatalf("Cleanup() error = %v", err)
+	}
+	materialUploadRepository.commitPendingDeletes()
+
+	if _, ok := materialUploadRepository.uploads[activeUpload.Id]; !ok || len(materialUploadRepository.uploads) != 1 {
+		t.Fatalf("Cleanup() left the uploads %v, want only the active upload", materialUploadRepository.uploads)
+	}
+	if isMultipartUploadOpen(inMemoryStorage, openUpload) {
+		t.Error("Cleanup() did not abort the expired multipart upload")
+	}
+	if _, _, err := inMemoryStorage.GetObjectByKey(context.Background(), assembledUpload.ContentKey, nil); err == nil {
+		t.Error("Cleanup() did not delete the object of the expired assembled upload")
+	}
+	if !isMultipartUploadOpen(inMemoryStorage, activeUpload) {
+		t.Error("Cleanup() aborted the active multipart upload")
+	}
+}
+
+func TestMaterialUploadCleanupWorkerCleansUpInBatches(t *testing.T) {
+	inMemoryStorage := storage.NewInMemoryStorage()
+	materialUploadRepository := &fakeMaterialUploadRepository{uploads: map[uuid.UUID]schemas.MaterialUpload{}}
+	for index := 0; index < materialUploadCleanupBatchSize+1; index++ {
+		materialUpload := createTestMaterialUpload(t, inMemoryStorage, time.Now().Add(-time.Duration(index+1)*time.Second))
+		materialUploadRepository.uploads[materialUpload.Id] = materialUpload
+	}
+	worker := newMaterialUploadCleanupTestWorker(t, nil, inMemoryStorage, materialUploadRepository)
+
+	if err := worker.Cleanup(context.Background()); err != nil {
+		t.Fatalf("Cleanup() error = %v", err)
+	}
+	materialUploadRepository.commitPendingDeletes()
+
+	if len(materialUploadRepository.uploads) != 0 || materialUploadRepository.expiredCalls != 2 {
+		t.Fatalf("Cleanup() left %d uploads after %d batches, want none after 2 batches",
+			len(materialUploadRepository.uploads), materialUploadRepository.expiredCalls)
+	}
+}
+
+func TestMaterialUploadCleanupWorkerKeepsTheStorageWhenTheDeletionsAreNotCommitted(t *testing.T) {
+	inMemoryStorage := storage.NewInMemoryStorage()
+	expiredUpload := createTestMaterialUpload(t, inMemoryStorage, time.Now().Add(-time.Minute))
+	materialUploadRepository := &fakeMaterialUploadRepository{uploads: map[uuid.UUID]schemas.MaterialUpload{
+		expiredUpload.Id: expiredUpload,
+	}}
+	worker := newMaterialUploadCleanupTestWorker(t, errors.New("connection lost"), inMemoryStorage, materialUploadRepository)
+
+	if err := worker.Cleanup(context.Background()); err == nil {
+		t.Fatal("Cleanup() error = nil, want the commit error")
+	}
+
+	if !isMultipartUploadOpen(inMemoryStorage, expiredUpload) {
+		t.Fatal("Cleanup() aborted the multipart upload of an upload which is still in the database")
+	}
+}
@@ -21,20 +21,23 @@ type QuotaCycleWorkerInterface interface {
 }
 
 type QuotaCycleWorker struct {
-	db                  *gorm.DB
-	config              coreconfig.QuotaCycleWorkerConfig
-	userQuotaRepository repositories.UserQuotaRepositoryInterface
+	db                          *gorm.DB
+	config                      coreconfig.QuotaCycleWorkerConfig
+	userQuotaRepository         repositories.UserQuotaRepositoryInterface
+	userUsageSnapshotRepository repositories.UserUsageSnapshotRepositoryInterface
 }
 
 func NewQuotaCycleWorker(
 	db *gorm.DB,
 	config coreconfig.QuotaCycleWorkerConfig,
 	userQuotaRepository repositories.UserQuotaRepositoryInterface,
+	userUsageSnapshotRepository repositories.UserUsageSnapshotRepositoryInterface,
 ) QuotaCycleWorkerInterface {
 	return &QuotaCycleWorker{
-		db:                  db,
-		config:              config,
-		userQuotaRepository: userQuotaRepository,
+		db:                          db,
+		config:                      config,
+		userQuotaRepository:         userQuotaRepository,
+		userUsageSnapshotRepository: userUsageSnapshotRepository,
 	}
 }
 
@@ -75,7 +78,8 @@ func (w *QuotaCycleWorker) Start(ctx context.Context) func() {
 }
 
 func (w *QuotaCycleWorker) Reconcile(ctx context.Context) error {
-	if w == nil || w.db == nil || w.userQuotaRepository == nil || w.config.Interval <= 0 {
+	if w == nil || w.db == nil || w.userQuotaRepository == nil || w.userUsageSnapshotRepository == nil ||
+		w.config.Interval <= 0 || w.config.UsageSnapshotRetention <= 0 {
 		return errors.New("user quota cycle reconciliation dependencies are required")
 	}
 
@@ -94,6 +98,17 @@ func (w *QuotaCycleWorker) Reconcile(ctx context.Context) error {
 		return fmt.Errorf("initialize missing user quotas: %w", exception)
 	}
 
+	// the snapshots are recorded before the reset, so the snapshot of the day a quota cycle
+	// ends keeps the usage of that cycle instead of the zero it is reset to
+	if _, exception := w.userUsageSnapshotRepository.RecordDaily(
+		ctx,
+		now,
+		options.WithTransactionDB(tx),
+	); exception != nil {
+		tx.Rollback()
+		return fmt.Errorf("record daily usage snapshots: %w", exception)
+	}
+
 	if _, exception := w.userQuotaRepository.ResetDue(
 		ctx,
 		now,
@@ -103,6 +118,15 @@ func (w *QuotaCycleWorker) Reconcile(ctx context.Context) error {
 		return fmt.Errorf("reset due user quotas: %w", exception)
 	}
 
+	if _, exception := w.userUsageSnapshotRepository.DeleteBefore(
+		ctx,
+		now.Add(-w.config.UsageSnapshotRetention),
+		options.WithTransactionDB(tx),
+	); exception != nil {
+		tx.Rollback()
+		return fmt.Errorf("delete expired usage snapshots: %w", exception)
+	}
+
 	if err := tx.Commit().Error; err != nil {
 		return fmt.Errorf("commit user quota cycle reconciliation transaction: %w", err)
 	}
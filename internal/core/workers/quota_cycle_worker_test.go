@@ -0,0 +1,79 @@
+package workers
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"testing"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+
+	coreconfig "github.com/HiIamJeff67/notegic-backend/internal/core/configs"
+	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
+	repositories "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/repositories"
+)
+
+/* ============================== Test Doubles ============================== */
+
+// fakeUserQuotaRepository and fakeUserUsageSnapshotRepository record their calls in the same steps
+type fakeUserQuotaRepository struct {
+	repositories.UserQuotaRepositoryInterface
+	steps *[]string
+}
+
+func (r *fakeUserQuotaRepository) InitializeMissing(ctx context.Context, now time.Time, opts ...options.RepositoryOptions) *exceptions.Exception {
+	*r.steps = append(*r.steps, "InitializeMissing")
+	return nil
+}
+
+func (r *fakeUserQuotaRepository) ResetDue(ctx context.Context, now time.Time, opts ...options.RepositoryOptions) (int64, *exceptions.Exception) {
+	*r.steps = append(*r.steps, "ResetDue")
+	return 1, nil
+}
+
+type fakeUserUsageSnapshotRepository struct {
+	repositories.UserUsageSnapshotRepositoryInterface
+	steps *[]string
+}
+
+func (r *fakeUserUsageSnapshotRepository) RecordDaily(ctx context.Context, now time.Time, opts ...options.RepositoryOptions) (int64, *exceptions.Exception) {
+	*r.steps = append(*r.steps, "RecordDaily")
+	return 1, nil
+}
+
+func (r *fakeUserUsageSnapshotRepository) DeleteBefore(ctx context.Context, before time.Time, opts ...options.RepositoryOptions) (int64, *exceptions.Exception) {
+	*r.steps = append(*r.steps, "DeleteBefore")
+	return 0, nil
+}
+
+/* ============================== Tests ============================== */
+
+func TestQuotaCycleWorkerRecordsTheSnapshotsBeforeTheReset(t *testing.T) {
+	db, err := gorm.Open(
+		postgres.New(postgres.Config{Conn: sql.OpenDB(transactionOnlyConnector{})}),
+		&gorm.Config{DisableAutomaticPing: true, SkipDefaultTransaction: true},
+	)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	steps := []string{}
+	worker := NewQuotaCycleWorker(
+		db,
+		coreconfig.QuotaCycleWorkerConfig{Interval: time.Minute, UsageSnapshotRetention: 24 * time.Hour},
+		&fakeUserQuotaRepository{steps: &steps},
+		&fakeUserUsageSnapshotRepository{steps: &steps},
+	)
+
+	if err := worker.Reconcile(context.Background()); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	wantSteps := []string{"InitializeMissing", "RecordDaily", "ResetDue", "DeleteBefore"}
+	if !reflect.DeepEqual(steps, wantSteps) {
+		t.Fatalf("Reconcile() steps = %v, want %v", steps, wantSteps)
+	}
+}
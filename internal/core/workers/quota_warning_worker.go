@@ -0,0 +1,278 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	coreeventscontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/events"
+	emaileventscontract "github.com/HiIamJeff67/notegic-backend/contracts/email/v1/events"
+	notificationtypescontract "github.com/HiIamJeff67/notegic-backend/contracts/notification/v1/types"
+	enumcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
+
+	logs "github.com/HiIamJeff67/notegic-backend/shared/platform/observability/logs"
+
+	coreconfig "github.com/HiIamJeff67/notegic-backend/internal/core/configs"
+	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
+	repositories "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/repositories"
+	emailtransport "github.com/HiIamJeff67/notegic-backend/internal/core/transports/email"
+)
+
+// the users are warned once per quota cycle when their usage of a limitation reaches each
+// of these percentages, the last one is the hard limit which rejects any further usage
+var quotaWarningThresholds = []int32{80, 100}
+
+var quotaWarningLimitNames = map[enumcontract.UsageLimitKey]string{
+	enumcontract.UsageLimitKey_RootShelf:           "root shelves",
+	enumcontract.UsageLimitKey_BlockPack:           "block packs",
+	enumcontract.UsageLimitKey_Block:               "blocks",
+	enumcontract.UsageLimitKey_Material:            "materials",
+	enumcontract.UsageLimitKey_Workflow:            "workflows",
+	enumcontract.UsageLimitKey_AdditionalItem:      "additional items",
+	enumcontract.UsageLimitKey_Station:             "stations",
+	enumcontract.UsageLimitKey_RoutineTag:          "routine tags",
+	enumcontract.UsageLimitKey_RoutineTaskCostUnit: "routine task cost units",
+}
+
+type QuotaWarningWorkerInterface interface {
+	Start(ctx context.Context) func()
+	Warn(ctx context.Context) error
+}
+
+type QuotaWarningWorker struct {
+	db                         *gorm.DB
+	config                     coreconfig.QuotaWarningWorkerConfig
+	userQuotaWarningRepository repositories.UserQuotaWarningRepositoryInterface
+	outboxEventRepository      repositories.OutboxEventRepositoryInterface
+	emailClient                emailtransport.ClientInterface
+}
+
+func NewQuotaWarningWorker(
+	db *gorm.DB,
+	config coreconfig.QuotaWarningWorkerConfig,
+	userQuotaWarningRepository repositories.UserQuotaWarningRepositoryInterface,
+	outboxEventRepository repositories.OutboxEventRepositoryInterface,
+	emailClient emailtransport.ClientInterface,
+) QuotaWarningWorkerInterface {
+	return &QuotaWarningWorker{
+		db:                         db,
+		config:                     config,
+		userQuotaWarningRepository: userQuotaWarningRepository,
+		outboxEventRepository:      outboxEventRepository,
+		emailClient:                emailClient,
+	}
+}
+
+/* ============================== Auxiliary Functions ============================== */
+
+func (w *QuotaWarningWorker) warn(ctx context.Context) {
+	if err := w.Warn(ctx); err != nil && ctx.Err() == nil && logs.NotegicLogger != nil {
+		logs.NotegicLogger.Error(ctx, err, "User quota warning failed")
+	}
+}
+
+// highestCrossedWarnings keeps only the highest threshold of each limitation of each user,
+// so a user who jumps over several thresholds at once only receives the most severe warning
+func highestCrossedWarnings(crossedWarnings []repositories.CrossedUserQuotaWarning) []repositories.CrossedUserQuotaWarning {
+	highestWarnings := make([]repositories.CrossedUserQuotaWarning, 0, len(crossedWarnings))
+	for _, crossedWarning := range crossedWarnings {
+		last := len(highestWarnings) - 1
+		if last >= 0 && highestWarnings[last].UserId == crossedWarning.UserId &&
+			highestWarnings[last].LimitKey == crossedWarning.LimitKey {
+			if crossedWarning.Threshold > highestWarnings[last].Threshold {
+				highestWarnings[last] = crossedWarning
+			}
+			continue
+		}
+		highestWarnings = append(highestWarnings, crossedWarning)
+	}
+	return highestWarnings
+}
+
+func quotaWarningLimitName(limitKey enumcontract.UsageLimitKey) string {
+	if limitName, ok := quotaWarningLimitNames[limitKey]; ok {
+		return limitName
+	}
+	return string(limitKey)
+}
+
+func newQuotaWarningNotification(
+	crossedWarning repositories.CrossedUserQuotaWarning,
+) (coreeventscontract.NotificationRequestedData, error) {
+	limitName := quotaWarningLimitName(crossedWarning.LimitKey)
+	isCycleLimit := crossedWarning.LimitKey == enumcontract.UsageLimitKey_RoutineTaskCostUnit
+
+	title := fmt.Sprintf("You have used %d%% of your %s", crossedWarning.Threshold, limitName)
+	message := fmt.Sprintf("You have used %d of %d %s.", crossedWarning.Used, crossedWarning.Maximum, limitName)
+	priority := coreeventscontract.NotificationPriority_Normal
+	if crossedWarning.Used >= crossedWarning.Maximum {
+		title = fmt.Sprintf("You have reached the limit of your %s", limitName)
+		message += " New ones will be rejected until you free some up or upgrade your plan."
+		priority = coreeventscontract.NotificationPriority_High
+	}
+	details := map[string]any{
+		"limitKey":  crossedWarning.LimitKey,
+		"threshold": crossedWarning.Threshold,
+		"used":      crossedWarning.Used,
+		"maximum":   crossedWarning.Maximum,
+	}
+	var expiresAt *time.Time
+	if isCycleLimit {
+		message += fmt.Sprintf(" The usage resets at %s.", crossedWarning.NextResetAt.UTC().Format(time.RFC1123))
+		details["resetAt"] = crossedWarning.NextResetAt
+		// the warning is meaningless once the usage has been reset
+		expiresAt = &crossedWarning.NextResetAt
+	}
+
+	payload, err := json.Marshal(notificationtypescontract.WarningPayload{
+		Title:   title,
+		Message: message,
+		Details: details,
+	})
+	if err != nil {
+		return coreeventscontract.NotificationRequestedData{}, err
+	}
+
+	return coreeventscontract.NotificationRequestedData{
+		RecipientUserPublicId: crossedWarning.UserPublicId,
+		Type:                  coreeventscontract.NotificationType_Warning,
+		Priority:              priority,
+		TemplateKey:           notificationtypescontract.TemplateKey_Warning,
+		TemplateVersion:       1,
+		Payload:               payload,
+		DedupeKey: fmt.Sprintf(
+			"quota-warning:%s:%s:%d:%d",
+			crossedWarning.UserPublicId,
+			crossedWarning.LimitKey,
+			crossedWarning.Threshold,
+			crossedWarning.CycleStartedAt.Unix(),
+		),
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func (w *QuotaWarningWorker) sendQuotaWarningEmail(
+	ctx context.Context,
+	crossedWarning repositories.CrossedUserQuotaWarning,
+) {
+	var resetAt *time.Time
+	if crossedWarning.LimitKey == enumcontract.UsageLimitKey_RoutineTaskCostUnit {
+		resetAt = &crossedWarning.NextResetAt
+	}
+
+	// the notification has already been committed, so a failed email is only logged
+	if exception := w.emailClient.SendQuotaWarningEmail(ctx, emaileventscontract.SendQuotaWarningEmailRequestDto{
+		To:        crossedWarning.UserEmail,
+		UserName:  crossedWarning.UserName,
+		LimitName: quotaWarningLimitName(crossedWarning.LimitKey),
+		Threshold: crossedWarning.Threshold,
+		Used:      crossedWarning.Used,
+		Maximum:   crossedWarning.Maximum,
+		ResetAt:   resetAt,
+	}); exception != nil && logs.NotegicLogger != nil {
+		logs.NotegicLogger.Error(ctx, exception, "Failed to send the quota warning email")
+	}
+}
+
+/* ============================== Worker Methods ============================== */
+
+func (w *QuotaWarningWorker) Start(ctx context.Context) func() {
+	workerCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		w.warn(workerCtx)
+
+		ticker := time.NewTicker(w.config.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-workerCtx.Done():
+				return
+			case <-ticker.C:
+				w.warn(workerCtx)
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// Warn records the thresholds the users have newly reached in their current quota cycles,
+// and requests a warning notification for each of them in the same transaction, so the
+// warnings are neither lost nor repeated when the worker fails in the middle, the warnings
+// of the users who turned off their notifications are still recorded but never sent
+func (w *QuotaWarningWorker) Warn(ctx context.Context) error {
+	if w == nil || w.db == nil || w.userQuotaWarningRepository == nil || w.outboxEventRepository == nil ||
+		(w.config.EmailEnabled && w.emailClient == nil) || w.config.Interval <= 0 {
+		return errors.New("user quota warning dependencies are required")
+	}
+
+	now := time.Now().UTC()
+	tx := w.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return fmt.Errorf("begin user quota warning transaction: %w", tx.Error)
+	}
+
+	if _, exception := w.userQuotaWarningRepository.DeleteBeforeCurrentCycles(
+		ctx,
+		options.WithTransactionDB(tx),
+	); exception != nil {
+		tx.Rollback()
+		return fmt.Errorf("delete user quota warnings of previous cycles: %w", exception)
+	}
+
+	crossedWarnings, exception := w.userQuotaWarningRepository.CreateManyCrossed(
+		ctx,
+		quotaWarningThresholds,
+		now,
+		options.WithTransactionDB(tx),
+	)
+	if exception != nil {
+		tx.Rollback()
+		return fmt.Errorf("record crossed user quota warnings: %w", exception)
+	}
+
+	highestWarnings := []repositories.CrossedUserQuotaWarning{}
+	for _, crossedWarning := range highestCrossedWarnings(crossedWarnings) {
+		if crossedWarning.IsNotificationsEnabled {
+			highestWarnings = append(highestWarnings, crossedWarning)
+		}
+	}
+	for _, crossedWarning := range highestWarnings {
+		notification, err := newQuotaWarningNotification(crossedWarning)
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("encode user quota warning notification: %w", err)
+		}
+		if err := w.outboxEventRepository.EnqueueNotificationRequested(
+			tx,
+			uuid.NewString(),
+			notification,
+		); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("enqueue user quota warning notification: %w", err)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("commit user quota warning transaction: %w", err)
+	}
+
+	if w.config.EmailEnabled {
+		for _, crossedWarning := range highestWarnings {
+			w.sendQuotaWarningEmail(ctx, crossedWarning)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package workers
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	coreeventscontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/events"
+	emaileventscontract "github.com/HiIamJeff67/notegic-backend/contracts/email/v1/events"
+	enumcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+
+	coreconfig "github.com/HiIamJeff67/notegic-backend/internal/core/configs"
+	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
+	repositories "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/repositories"
+	emailtransport "github.com/HiIamJeff67/notegic-backend/internal/core/transports/email"
+)
+
+/* ============================== Test Doubles ============================== */
+
+type fakeUserQuotaWarningRepository struct {
+	crossedWarnings []repositories.CrossedUserQuotaWarning
+}
+
+func (r *fakeUserQuotaWarningRepository) CreateManyCrossed(ctx context.Context, thresholds []int32, now time.Time, opts ...options.RepositoryOptions) ([]repositories.CrossedUserQuotaWarning, *exceptions.Exception) {
+	return r.crossedWarnings, nil
+}
+
+func (r *fakeUserQuotaWarningRepository) DeleteBeforeCurrentCycles(ctx context.Context, opts ...options.RepositoryOptions) (int64, *exceptions.Exception) {
+	return 0, nil
+}
+
+type fakeQuotaWarningOutboxEventRepository struct {
+	repositories.OutboxEventRepositoryInterface
+	notifications []coreeventscontract.NotificationRequestedData
+}
+
+func (r *fakeQuotaWarningOutboxEventRepository) EnqueueNotificationRequested(tx *gorm.DB, correlationId string, data coreeventscontract.NotificationRequestedData) error {
+	r.notifications = append(r.notifications, data)
+	return nil
+}
+
+type fakeQuotaWarningEmailClient struct {
+	emailtransport.ClientInterface
+	recipients []string
+}
+
+func (c *fakeQuotaWarningEmailClient) SendQuotaWarningEmail(ctx context.Context, requestDto emaileventscontract.SendQuotaWarningEmailRequestDto) *exceptions.Exception {
+	c.recipients = append(c.recipients, requestDto.To)
+	return nil
+}
+
+/* ============================== Test Helpers ============================== */
+
+func newQuotaWarningTestWorker(
+	t *testing.T,
+	config coreconfig.QuotaWarningWorkerConfig,
+	userQuotaWarningRepository *fakeUserQuotaWarningRepository,
+	outboxEventRepository *fakeQuotaWarningOutboxEventRepository,
+	emailClient emailtransport.ClientInterface,
+) QuotaWarningWorkerInterface {
+	t.Helper()
+
+	db, err := gorm.Open(
+		postgres.New(postgres.Config{Conn: sql.OpenDB(transactionOnlyConnector{})}),
+		&gorm.Config{DisableAutomaticPing: true, SkipDefaultTransaction: true},
+	)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+
+	return NewQuotaWarningWorker(db, config, userQuotaWarningRepository, outboxEventRepository, emailClient)
+}
+
+func newTestCrossedUserQuotaWarning(email string, isNotificationsEnabled bool) repositories.CrossedUserQuotaWarning {
+	return repositories.CrossedUserQuotaWarning{
+		UserId:                 uuid.New(),
+		UserPublicId:           uuid.New(),
+		UserEmail:              email,
+		IsNotificationsEnabled: isNotificationsEnabled,
+		LimitKey:               enumcontract.UsageLimitKey_BlockPack,
+		Threshold:              80,
+		Used:                   8,
+		Maximum:                10,
+		CycleStartedAt:         time.Now().Add(-time.Hour),
+		NextResetAt:            time.Now().Add(time.Hour),
+	}
+}
+
+/* ============================== Tests ============================== */
+
+func TestQuotaWarningWorkerDoesNotRequireTheEmailClientWhenTheEmailIsDisabled(t *testing.T) {
+	outboxEventRepository := &fakeQuotaWarningOutboxEventRepository{}
+	worker := newQuotaWarningTestWorker(
+		t,
+		coreconfig.QuotaWarningWorkerConfig{Interval: time.Minute, EmailEnabled: false},
+		&fakeUserQuotaWarningRepository{crossedWarnings: []repositories.CrossedUserQuotaWarning{
+			newTestCrossedUserQuotaWarning("user@example.com", true),
+		}},
+		outboxEventRepository,
+		nil,
+	)
+
+	if err := worker.Warn(context.Background()); err != nil {
+		t.Fatalf("Warn() error = %v", err)
+	}
+	if len(outboxEventRepository.notifications) != 1 {
+		t.Fatalf("Warn() requested %d notifications, want 1", len(outboxEventRepository.notifications))
+	}
+}
+
+func TestQuotaWarningWorkerRequiresTheEmailClientWhenTheEmailIsEnabled(t *testing.T) {
+	worker := newQuotaWarningTestWorker(
+		t,
+		coreconfig.QuotaWarningWorkerConfig{Interval: time.Minute, EmailEnabled: true},
+		&fakeUserQuotaWarningRepository{},
+		&fakeQuotaWarningOutboxEventRepository{},
+		nil,
+	)
+
+	if err := worker.Warn(context.Background()); err == nil {
+		t.Fatal("Warn() error = nil, want the missing email client error")
+	}
+}
+
+func TestQuotaWarningWorkerSkipsTheUsersWhoTurnedOffTheirNotifications(t *testing.T) {
+	enabledWarning := newTestCrossedUserQuotaWarning("enabled@example.com", true)
+	disabledWarning := newTestCrossedUserQuotaWarning("disabled@example.com", false)
+	outboxEventRepository := &fakeQuotaWarningOutboxEventRepository{}
+	emailClient := &fakeQuotaWarningEmailClient{}
+	worker := newQuotaWarningTestWorker(
+		t,
+		coreconfig.QuotaWarningWorkerConfig{Interval: time.Minute, EmailEnabled: true},
+		&fakeUserQuotaWarningRepository{crossedWarnings: []repositories.CrossedUserQuotaWarning{
+			enabledWarning,
+			disabledWarning,
+		}},
+		outboxEventRepository,
+		emailClient,
+	)
+
+	if err := worker.Warn(context.Background()); err != nil {
+		t.Fatalf("Warn() error = %v", err)
+	}
+	if len(outboxEventRepository.notifications) != 1 ||
+		outboxEventRepository.notifications[0].RecipientUserPublicId != enabledWarning.UserPublicId {
+		t.Fatalf("Warn() requested the notifications %v, want only the one of the enabled user", outboxEventRepository.notifications)
+	}
+	if len(emailClient.recipients) != 1 || emailClient.recipients[0] != enabledWarning.UserEmail {
+		t.Fatalf("Warn() emailed %v, want only %s", emailClient.recipients, enabledWarning.UserEmail)
+	}
+}
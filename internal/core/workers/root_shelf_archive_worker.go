@@ -0,0 +1,88 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	logs "github.com/HiIamJeff67/notegic-backend/shared/platform/observability/logs"
+
+	coreconfig "github.com/HiIamJeff67/notegic-backend/internal/core/configs"
+	shelfservices "github.com/HiIamJeff67/notegic-backend/internal/core/services/shelves"
+)
+
+type RootShelfArchiveWorkerInterface interface {
+	Start(ctx context.Context) func()
+	ProcessPending(ctx context.Context) error
+}
+
+type RootShelfArchiveWorker struct {
+	config                  coreconfig.RootShelfArchiveWorkerConfig
+	rootShelfArchiveService shelfservices.RootShelfArchiveServiceInterface
+}
+
+func NewRootShelfArchiveWorker(
+	config coreconfig.RootShelfArchiveWorkerConfig,
+	rootShelfArchiveService shelfservices.RootShelfArchiveServiceInterface,
+) RootShelfArchiveWorkerInterface {
+	return &RootShelfArchiveWorker{
+		config:                  config,
+		rootShelfArchiveService: rootShelfArchiveService,
+	}
+}
+
+/* ============================== Auxiliary Functions ============================== */
+
+func (w *RootShelfArchiveWorker) processPending(ctx context.Context) {
+	if err := w.ProcessPending(ctx); err != nil && ctx.Err() == nil && logs.NotegicLogger != nil {
+		logs.NotegicLogger.Error(ctx, err, "Root shelf archive job processing failed")
+	}
+}
+
+/* ============================== Worker Methods ============================== */
+
+func (w *RootShelfArchiveWorker) Start(ctx context.Context) func() {
+	workerCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		w.processPending(workerCtx)
+
+		ticker := time.NewTicker(w.config.PollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-workerCtx.Done():
+				return
+			case <-ticker.C:
+				w.processPending(workerCtx)
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// ProcessPending runs the claimable archive jobs one by one until there is none left
+func (w *RootShelfArchiveWorker) ProcessPending(ctx context.Context) error {
+	if w == nil || w.rootShelfArchiveService == nil || w.config.PollInterval <= 0 || w.config.ClaimTimeout <= 0 {
+		return errors.New("root shelf archive worker dependencies are required")
+	}
+
+	for ctx.Err() == nil {
+		processed, exception := w.rootShelfArchiveService.ProcessNextArchiveJob(ctx, w.config.ClaimTimeout)
+		if exception != nil {
+			return fmt.Errorf("process root shelf archive job: %w", exception)
+		}
+		if !processed {
+			return nil
+		}
+	}
+
+	return nil
+}
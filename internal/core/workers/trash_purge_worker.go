@@ -0,0 +1,127 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	logs "github.com/HiIamJeff67/notegic-backend/shared/platform/observability/logs"
+
+	coreconfig "github.com/HiIamJeff67/notegic-backend/internal/core/configs"
+	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
+	repositories "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/repositories"
+	storage "github.com/HiIamJeff67/notegic-backend/internal/core/data/storage"
+)
+
+type TrashPurgeWorkerInterface interface {
+	Start(ctx context.Context) func()
+	Purge(ctx context.Context) error
+}
+
+type TrashPurgeWorker struct {
+	db              *gorm.DB
+	config          coreconfig.TrashPurgeWorkerConfig
+	storage         storage.StorageInterface
+	trashRepository repositories.TrashRepositoryInterface
+}
+
+func NewTrashPurgeWorker(
+	db *gorm.DB,
+	config coreconfig.TrashPurgeWorkerConfig,
+	storage storage.StorageInterface,
+	trashRepository repositories.TrashRepositoryInterface,
+) TrashPurgeWorkerInterface {
+	return &TrashPurgeWorker{
+		db:              db,
+		config:          config,
+		storage:         storage,
+		trashRepository: trashRepository,
+	}
+}
+
+/* ============================== Auxiliary Functions ============================== */
+
+func (w *TrashPurgeWorker) purge(ctx context.Context) {
+	if err := w.Purge(ctx); err != nil && ctx.Err() == nil && logs.NotegicLogger != nil {
+		logs.NotegicLogger.Error(ctx, err, "Trash purge failed")
+	}
+}
+
+/* ============================== Worker Methods ============================== */
+
+func (w *TrashPurgeWorker) Start(ctx context.Context) func() {
+	workerCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		w.purge(workerCtx)
+
+		ticker := time.NewTicker(w.config.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-workerCtx.Done():
+				return
+			case <-ticker.C:
+				w.purge(workerCtx)
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// Purge hard deletes the trashed items whose retention has passed in batches, and removes
+// the contents of the purged materials from the storage once their rows are committed,
+// so a failed run never leaves a material row pointing to a missing object
+func (w *TrashPurgeWorker) Purge(ctx context.Context) error {
+	if w == nil || w.db == nil || w.storage == nil || w.trashRepository == nil ||
+		w.config.Interval <= 0 || w.config.BatchSize <= 0 {
+		return errors.New("trash purge dependencies are required")
+	}
+
+	for ctx.Err() == nil {
+		now := time.Now().UTC()
+		tx := w.db.WithContext(ctx).Begin()
+		if tx.Error != nil {
+			return fmt.Errorf("begin trash purge transaction: %w", tx.Error)
+		}
+
+		purgedTrash, exception := w.trashRepository.PurgeExpired(
+			ctx,
+			now,
+			w.config.BatchSize,
+			options.WithTransactionDB(tx),
+		)
+		if exception != nil {
+			tx.Rollback()
+			return fmt.Errorf("purge expired trash: %w", exception)
+		}
+
+		if err := tx.Commit().Error; err != nil {
+			return fmt.Errorf("commit trash purge transaction: %w", err)
+		}
+
+		for _, contentKey := range purgedTrash.ContentKeys {
+			// the row is already gone, so a failed deletion only leaves an orphaned object
+			if err := w.storage.DeleteObjectByKey(ctx, contentKey); err != nil && logs.NotegicLogger != nil {
+				logs.NotegicLogger.Error(ctx, err, "Failed to delete the content of a purged material")
+			}
+		}
+
+		purgedCount := purgedTrash.RootShelfCount + purgedTrash.SubShelfCount +
+			purgedTrash.MaterialCount + purgedTrash.BlockPackCount
+		if purgedCount < int64(w.config.BatchSize) {
+			return nil
+		}
+	}
+
+	return ctx.Err()
+}
@@ -0,0 +1,129 @@
+package workers
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
+
+	coreconfig "github.com/HiIamJeff67/notegic-backend/internal/core/configs"
+	options "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/options"
+	repositories "github.com/HiIamJeff67/notegic-backend/internal/core/data/database/repositories"
+	storage "github.com/HiIamJeff67/notegic-backend/internal/core/data/storage"
+)
+
+/* ============================== Test Doubles ============================== */
+
+// fakeTrashRepository returns one scripted purge per batch, like the rows the database actually deleted
+type fakeTrashRepository struct {
+	repositories.TrashRepositoryInterface
+	batches    []repositories.PurgedTrash
+	purgeCalls int
+}
+
+func (r *fakeTrashRepository) PurgeExpired(ctx context.Context, now time.Time, limit int, opts ...options.RepositoryOptions) (*repositories.PurgedTrash, *exceptions.Exception) {
+	r.purgeCalls++
+	if len(r.batches) == 0 {
+		return &repositories.PurgedTrash{ContentKeys: []string{}}, nil
+	}
+	purgedTrash := r.batches[0]
+	r.batches = r.batches[1:]
+	return &purgedTrash, nil
+}
+
+/* ============================== Test Helpers ============================== */
+
+func newTrashPurgeTestWorker(
+	t *testing.T,
+	commitErr error,
+	inMemoryStorage storage.StorageInterface,
+	trashRepository *fakeTrashRepository,
+) TrashPurgeWorkerInterface {
+	t.Helper()
+
+	db, err := gorm.Open(
+		postgres.New(postgres.Config{Conn: sql.OpenDB(transactionOnlyConnector{commitErr: commitErr})}),
+		&gorm.Config{DisableAutomaticPing: true, SkipDefaultTransaction: true},
+	)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+
+	return NewTrashPurgeWorker(
+		db,
+		coreconfig.TrashPurgeWorkerConfig{Interval: time.Minute, BatchSize: 2},
+		inMemoryStorage,
+		trashRepository,
+	)
+}
+
+func putTestObjects(t *testing.T, inMemoryStorage storage.StorageInterface, keys ...string) {
+	t.Helper()
+
+	for _, key := range keys {
+		object, err := inMemoryStorage.NewObject(key, bytes.NewReader([]byte("content")), int64(len("content")))
+		if err != nil {
+			t.Fatalf("failed to create the object: %v", err)
+		}
+		if err := inMemoryStorage.PutObjectByKey(context.Background(), key, object); err != nil {
+			t.Fatalf("failed to put the object: %v", err)
+		}
+	}
+}
+
+func hasTestObject(inMemoryStorage storage.StorageInterface, key string) bool {
+	_, _, err := inMemoryStorage.GetObjectByKey(context.Background(), key, nil)
+	return err == nil
+}
+
+/* ============================== Tests ============================== */
+
+func TestTrashPurgeWorkerDeletesOnlyTheContentsOfThePurgedMaterials(t *testing.T) {
+	inMemoryStorage := storage.NewInMemoryStorage()
+	putTestObjects(t, inMemoryStorage, "purged-content", "purged-preview", "descendant-content", "restored-content")
+	trashRepository := &fakeTrashRepository{batches: []repositories.PurgedTrash{
+		{MaterialCount: 1, RootShelfCount: 1, ContentKeys: []string{"purged-content", "purged-preview"}},
+		{RootShelfCount: 1, ContentKeys: []string{"descendant-content"}},
+	}}
+	worker := newTrashPurgeTestWorker(t, nil, inMemoryStorage, trashRepository)
+
+	if err := worker.Purge(context.Background()); err != nil {
+		t.Fatalf("Purge() error = %v", err)
+	}
+
+	for _, key := range []string{"purged-content", "purged-preview", "descendant-content"} {
+		if hasTestObject(inMemoryStorage, key) {
+			t.Errorf("Purge() kept the object %s of a purged material", key)
+		}
+	}
+	if !hasTestObject(inMemoryStorage, "restored-content") {
+		t.Error("Purge() deleted the object of a material which was not purged")
+	}
+	if trashRepository.purgeCalls != 2 {
+		t.Errorf("Purge() ran %d batches, want 2", trashRepository.purgeCalls)
+	}
+}
+
+func TestTrashPurgeWorkerKeepsTheStorageWhenThePurgeIsNotCommitted(t *testing.T) {
+	inMemoryStorage := storage.NewInMemoryStorage()
+	putTestObjects(t, inMemoryStorage, "purged-content")
+	trashRepository := &fakeTrashRepository{batches: []repositories.PurgedTrash{
+		{MaterialCount: 1, ContentKeys: []string{"purged-content"}},
+	}}
+	worker := newTrashPurgeTestWorker(t, errors.New("connection lost"), inMemoryStorage, trashRepository)
+
+	if err := worker.Purge(context.Background()); err == nil {
+		t.Fatal("Purge() error = nil, want the commit error")
+	}
+
+	if !hasTestObject(inMemoryStorage, "purged-content") {
+		t.Fatal("Purge() deleted the object of a material which is still in the database")
+	}
+}
@@ -164,7 +164,43 @@ func (a *Application) initializeWorkers(
 	)
 	shutdownYjsMaintenanceResultConsumer := yjsMaintenanceResultConsumer.Start(context.Background())
 
+	materialProcessingStrategy := corestrategies.NewMaterialProcessingStrategy(config.MaterialProcessingStrategy)
+	materialProcessingRequestProducer := coreproducers.NewMaterialProcessingRequestProducer(kafkaProducer)
+	materialProcessingHintConsumer := coreconsumers.NewMaterialProcessingHintConsumer(
+		materialProcessingRequestProducer,
+		materialProcessingStrategy,
+		platformkafka.ConsumerConfig{
+			ClientConfig: platformkafka.ClientConfig{
+				ConnectionConfig: kafkaConnection,
+				ClientId:         "notegic-durable-job-material-processing",
+			},
+			ConsumerGroup:       durablejobconfig.MaterialProcessingHintConsumerGroup,
+			MaximumAttempts:     config.KafkaConsumer.MaximumAttempts,
+			InitialRetryBackoff: config.KafkaConsumer.InitialRetryBackoff,
+			MaximumRetryBackoff: config.KafkaConsumer.MaximumRetryBackoff,
+			MaximumPollRecords:  config.KafkaConsumer.MaximumPollRecords,
+		},
+	)
+	shutdownMaterialProcessingHintConsumer := materialProcessingHintConsumer.Start(context.Background())
+	materialProcessingResultConsumer := coreconsumers.NewMaterialProcessingResultConsumer(
+		materialProcessingStrategy,
+		platformkafka.ConsumerConfig{
+			ClientConfig: platformkafka.ClientConfig{
+				ConnectionConfig: kafkaConnection,
+				ClientId:         "notegic-durable-job-material-processing-result",
+			},
+			ConsumerGroup:       durablejobconfig.MaterialProcessingResultConsumerGroup,
+			MaximumAttempts:     config.KafkaConsumer.MaximumAttempts,
+			InitialRetryBackoff: config.KafkaConsumer.InitialRetryBackoff,
+			MaximumRetryBackoff: config.KafkaConsumer.MaximumRetryBackoff,
+			MaximumPollRecords:  config.KafkaConsumer.MaximumPollRecords,
+		},
+	)
+	shutdownMaterialProcessingResultConsumer := materialProcessingResultConsumer.Start(context.Background())
+
 	return func() {
+		shutdownMaterialProcessingResultConsumer()
+		shutdownMaterialProcessingHintConsumer()
 		shutdownYjsMaintenanceResultConsumer()
 		shutdownYjsMaintenanceHintConsumer()
 		shutdownRoutineTaskEngine()
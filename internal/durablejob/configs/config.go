@@ -7,9 +7,10 @@ import (
 )
 
 type Config struct {
-	ListenAddress          string
-	KafkaConsumer          KafkaConsumerConfig
-	YjsMaintenanceStrategy YjsMaintenanceStrategyConfig
+	ListenAddress              string
+	KafkaConsumer              KafkaConsumerConfig
+	YjsMaintenanceStrategy     YjsMaintenanceStrategyConfig
+	MaterialProcessingStrategy MaterialProcessingStrategyConfig
 }
 
 func LoadConfig() (Config, error) {
@@ -29,6 +30,10 @@ func LoadConfig() (Config, error) {
 	if err != nil {
 		return Config{}, err
 	}
+	config.MaterialProcessingStrategy, err = loadMaterialProcessingStrategyConfig()
+	if err != nil {
+		return Config{}, err
+	}
 
 	return config, nil
 }
@@ -14,6 +14,10 @@ func TestLoadConfig(t *testing.T) {
 	t.Setenv("DURABLEJOB_YJS_MAINTENANCE_MAXIMUM_DISPATCH_BATCH", "32")
 	t.Setenv("DURABLEJOB_YJS_MAINTENANCE_MAXIMUM_DISPATCH_WORKERS", "8")
 	t.Setenv("DURABLEJOB_YJS_MAINTENANCE_MAXIMUM_REQUEST_ATTEMPTS", "3")
+	t.Setenv("DURABLEJOB_MATERIAL_PROCESSING_MAXIMUM_PENDING_HINTS", "10000")
+	t.Setenv("DURABLEJOB_MATERIAL_PROCESSING_MAXIMUM_DISPATCH_BATCH", "32")
+	t.Setenv("DURABLEJOB_MATERIAL_PROCESSING_MAXIMUM_DISPATCH_WORKERS", "4")
+	t.Setenv("DURABLEJOB_MATERIAL_PROCESSING_MAXIMUM_REQUEST_ATTEMPTS", "3")
 
 	config, err := LoadConfig()
 	if err != nil {
@@ -1,7 +1,9 @@
 package config
 
 const (
-	RoutineTaskConsumerGroup          = "notegic-durablejob-routine-task-v1"
-	YjsMaintenanceHintConsumerGroup   = "notegic-durablejob-yjs-maintenance-v1"
-	YjsMaintenanceResultConsumerGroup = "notegic-durablejob-yjs-maintenance-result-v1"
+	RoutineTaskConsumerGroup              = "notegic-durablejob-routine-task-v1"
+	YjsMaintenanceHintConsumerGroup       = "notegic-durablejob-yjs-maintenance-v1"
+	YjsMaintenanceResultConsumerGroup     = "notegic-durablejob-yjs-maintenance-result-v1"
+	MaterialProcessingHintConsumerGroup   = "notegic-durablejob-material-processing-v1"
+	MaterialProcessingResultConsumerGroup = "notegic-durablejob-material-processing-result-v1"
 )
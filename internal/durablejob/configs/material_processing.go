@@ -0,0 +1,34 @@
+package config
+
+type MaterialProcessingStrategyConfig struct {
+	MaximumPendingHints    int
+	MaximumDispatchBatch   int
+	MaximumDispatchWorkers int
+	MaximumRequestAttempts int
+}
+
+func loadMaterialProcessingStrategyConfig() (MaterialProcessingStrategyConfig, error) {
+	maximumPendingHints, err := positiveInteger("DURABLEJOB_MATERIAL_PROCESSING_MAXIMUM_PENDING_HINTS")
+	if err != nil {
+		return MaterialProcessingStrategyConfig{}, err
+	}
+	maximumDispatchBatch, err := positiveInteger("DURABLEJOB_MATERIAL_PROCESSING_MAXIMUM_DISPATCH_BATCH")
+	if err != nil {
+		return MaterialProcessingStrategyConfig{}, err
+	}
+	maximumDispatchWorkers, err := positiveInteger("DURABLEJOB_MATERIAL_PROCESSING_MAXIMUM_DISPATCH_WORKERS")
+	if err != nil {
+		return MaterialProcessingStrategyConfig{}, err
+	}
+	maximumRequestAttempts, err := positiveInteger("DURABLEJOB_MATERIAL_PROCESSING_MAXIMUM_REQUEST_ATTEMPTS")
+	if err != nil {
+		return MaterialProcessingStrategyConfig{}, err
+	}
+
+	return MaterialProcessingStrategyConfig{
+		MaximumPendingHints:    maximumPendingHints,
+		MaximumDispatchBatch:   maximumDispatchBatch,
+		MaximumDispatchWorkers: maximumDispatchWorkers,
+		MaximumRequestAttempts: maximumRequestAttempts,
+	}, nil
+}
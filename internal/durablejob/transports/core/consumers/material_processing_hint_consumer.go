@@ -0,0 +1,186 @@
+package coreconsumers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	coreeventscontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/events"
+	eventcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/events"
+
+	platformkafka "github.com/HiIamJeff67/notegic-backend/shared/platform/kafka"
+	logs "github.com/HiIamJeff67/notegic-backend/shared/platform/observability/logs"
+	metrics "github.com/HiIamJeff67/notegic-backend/shared/platform/observability/metrics"
+
+	coreproducers "github.com/HiIamJeff67/notegic-backend/internal/durablejob/transports/core/producers"
+	corestrategies "github.com/HiIamJeff67/notegic-backend/internal/durablejob/transports/core/strategies"
+)
+
+type MaterialProcessingHintConsumer struct {
+	producer    *coreproducers.MaterialProcessingRequestProducer
+	kafkaConfig platformkafka.ConsumerConfig
+	strategy    *corestrategies.MaterialProcessingStrategy
+	slots       chan struct{}
+}
+
+func NewMaterialProcessingHintConsumer(
+	producer *coreproducers.MaterialProcessingRequestProducer,
+	strategy *corestrategies.MaterialProcessingStrategy,
+	kafkaConfig platformkafka.ConsumerConfig,
+) *MaterialProcessingHintConsumer {
+	return &MaterialProcessingHintConsumer{
+		producer:    producer,
+		kafkaConfig: kafkaConfig,
+		strategy:    strategy,
+		slots:       make(chan struct{}, strategy.Config.MaximumDispatchWorkers),
+	}
+}
+
+func (c *MaterialProcessingHintConsumer) Start(ctx context.Context) func() {
+	consumer, err := platformkafka.NewConsumer(
+		c.kafkaConfig,
+		coreeventscontract.CoreDurableJobMaterialProcessingHintTopic.String(),
+	)
+	if err != nil {
+		if logs.NotegicLogger != nil {
+			logs.NotegicLogger.Error(ctx, err, "failed to create material processing hint consumer")
+		}
+		return func() {}
+	}
+
+	workerCtx, cancel := context.WithCancel(ctx)
+	var waitGroup sync.WaitGroup
+	waitGroup.Add(2)
+	go func() {
+		defer waitGroup.Done()
+		if err := consumer.Run(workerCtx, c.consume); err != nil && workerCtx.Err() == nil && logs.NotegicLogger != nil {
+			logs.NotegicLogger.Error(workerCtx, err, "material processing hint consumer stopped")
+		}
+	}()
+	go func() {
+		defer waitGroup.Done()
+		c.dispatch(workerCtx)
+	}()
+
+	return func() {
+		cancel()
+		consumer.Close()
+		waitGroup.Wait()
+	}
+}
+
+func (c *MaterialProcessingHintConsumer) consume(
+	ctx context.Context,
+	_ platformkafka.ConsumerRecord,
+	event eventcontract.EventEnvelope[json.RawMessage],
+) error {
+	if event.EventType != coreeventscontract.EventType_MaterialProcessingHint ||
+		event.AggregateType != coreeventscontract.AggregateType_Material ||
+		event.AggregateId == uuid.Nil || event.KafkaKey != event.AggregateId.String() {
+		return &platformkafka.ConsumerError{
+			Classification: platformkafka.ErrorClassification_SchemaIncompatible,
+			Origin:         errors.New("invalid material processing hint envelope"),
+		}
+	}
+
+	var hint coreeventscontract.MaterialProcessingHintData
+	if err := json.Unmarshal(event.Data, &hint); err != nil {
+		return &platformkafka.ConsumerError{
+			Classification: platformkafka.ErrorClassification_SchemaIncompatible,
+			Origin:         fmt.Errorf("decode material processing hint: %w", err),
+		}
+	}
+	if hint.MaterialId != event.AggregateId || hint.Size < 0 {
+		return &platformkafka.ConsumerError{
+			Classification: platformkafka.ErrorClassification_SchemaIncompatible,
+			Origin:         errors.New("invalid material processing hint data"),
+		}
+	}
+
+	if err := c.strategy.Enqueue(hint); err != nil {
+		return &platformkafka.ConsumerError{
+			Classification: platformkafka.ErrorClassification_Transient,
+			Origin:         err,
+		}
+	}
+	if metrics.NotegicMeter != nil {
+		metrics.NotegicMeter.Value(ctx, "material.processing.queue.size", int64(c.strategy.PendingCount()))
+	}
+
+	return nil
+}
+
+func (c *MaterialProcessingHintConsumer) dispatch(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-c.strategy.Notify():
+			c.dispatchPending(ctx)
+		}
+	}
+}
+
+func (c *MaterialProcessingHintConsumer) dispatchPending(ctx context.Context) {
+	for {
+		hints := c.strategy.DequeueBatch(c.strategy.Config.MaximumDispatchBatch)
+		if len(hints) == 0 {
+			return
+		}
+		var waitGroup sync.WaitGroup
+		for _, hint := range hints {
+			c.slots <- struct{}{}
+			waitGroup.Add(1)
+			go func(hint coreeventscontract.MaterialProcessingHintData) {
+				defer waitGroup.Done()
+				defer func() { <-c.slots }()
+				if err := c.dispatchHint(ctx, hint); err != nil {
+					if logs.NotegicLogger != nil {
+						logs.NotegicLogger.Error(ctx, err, "failed to dispatch material processing request")
+					}
+					c.retryHint(ctx, hint)
+					if metrics.NotegicMeter != nil {
+						metrics.NotegicMeter.Count(ctx, "material.processing.request.failure", 1)
+					}
+				}
+			}(hint)
+		}
+		waitGroup.Wait()
+	}
+}
+
+func (c *MaterialProcessingHintConsumer) retryHint(ctx context.Context, hint coreeventscontract.MaterialProcessingHintData) {
+	go func() {
+		timer := time.NewTimer(time.Second)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			if err := c.strategy.Enqueue(hint); err != nil && logs.NotegicLogger != nil {
+				logs.NotegicLogger.Error(ctx, err, "failed to requeue material processing hint")
+			}
+		}
+	}()
+}
+
+func (c *MaterialProcessingHintConsumer) dispatchHint(ctx context.Context, hint coreeventscontract.MaterialProcessingHintData) error {
+	startedAt := time.Now()
+	requestId := uuid.New()
+	attempt := c.strategy.Track(requestId, hint)
+	if err := c.producer.Produce(ctx, hint, attempt, requestId); err != nil {
+		c.strategy.Complete(requestId)
+		return err
+	}
+	if metrics.NotegicMeter != nil {
+		metrics.NotegicMeter.Duration(ctx, "material.processing.dispatch.duration", time.Since(startedAt))
+		metrics.NotegicMeter.Value(ctx, "material.processing.queue.size", int64(c.strategy.PendingCount()))
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package coreconsumers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	coreeventscontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/events"
+	durablejobeventscontract "github.com/HiIamJeff67/notegic-backend/contracts/durable-job/v1/events"
+	eventcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/events"
+
+	platformkafka "github.com/HiIamJeff67/notegic-backend/shared/platform/kafka"
+	logs "github.com/HiIamJeff67/notegic-backend/shared/platform/observability/logs"
+	metrics "github.com/HiIamJeff67/notegic-backend/shared/platform/observability/metrics"
+
+	corestrategies "github.com/HiIamJeff67/notegic-backend/internal/durablejob/transports/core/strategies"
+)
+
+type MaterialProcessingResultConsumer struct {
+	kafkaConfig platformkafka.ConsumerConfig
+	strategy    *corestrategies.MaterialProcessingStrategy
+}
+
+func NewMaterialProcessingResultConsumer(
+	strategy *corestrategies.MaterialProcessingStrategy,
+	kafkaConfig platformkafka.ConsumerConfig,
+) *MaterialProcessingResultConsumer {
+	return &MaterialProcessingResultConsumer{
+		kafkaConfig: kafkaConfig,
+		strategy:    strategy,
+	}
+}
+
+func (c *MaterialProcessingResultConsumer) Start(ctx context.Context) func() {
+	consumer, err := platformkafka.NewConsumer(
+		c.kafkaConfig,
+		durablejobeventscontract.DurableJobCoreMaterialProcessingResultTopic.String(),
+	)
+	if err != nil {
+		if logs.NotegicLogger != nil {
+			logs.NotegicLogger.Error(ctx, err, "failed to create material processing result consumer")
+		}
+		return func() {}
+	}
+
+	workerCtx, cancel := context.WithCancel(ctx)
+	go func() {
+		if err := consumer.Run(workerCtx, c.consume); err != nil && workerCtx.Err() == nil && logs.NotegicLogger != nil {
+			logs.NotegicLogger.Error(workerCtx, err, "material processing result consumer stopped")
+		}
+	}()
+
+	return func() {
+		cancel()
+		consumer.Close()
+	}
+}
+
+// consume settles the request of the result, where only the unsuccessful results are retried, since a material
+// which failed to be processed with a successful result has a malformed content that never succeeds
+func (c *MaterialProcessingResultConsumer) consume(
+	ctx context.Context,
+	_ platformkafka.ConsumerRecord,
+	event eventcontract.EventEnvelope[json.RawMessage],
+) error {
+	if event.EventType != durablejobeventscontract.EventType_MaterialProcessingCompleted ||
+		event.AggregateType != durablejobeventscontract.AggregateType_Material ||
+		event.AggregateId == uuid.Nil || event.KafkaKey != event.AggregateId.String() {
+		return &platformkafka.ConsumerError{
+			Classification: platformkafka.ErrorClassification_SchemaIncompatible,
+			Origin:         errors.New("invalid material processing result envelope"),
+		}
+	}
+
+	var result durablejobeventscontract.MaterialProcessingResultData
+	if err := json.Unmarshal(event.Data, &result); err != nil {
+		return &platformkafka.ConsumerError{
+			Classification: platformkafka.ErrorClassification_SchemaIncompatible,
+			Origin:         fmt.Errorf("decode material processing result: %w", err),
+		}
+	}
+	if result.RequestId == uuid.Nil || result.MaterialId != event.AggregateId || result.Status == "" {
+		return &platformkafka.ConsumerError{
+			Classification: platformkafka.ErrorClassification_SchemaIncompatible,
+			Origin:         errors.New("invalid material processing result data"),
+		}
+	}
+
+	if result.Success {
+		c.strategy.Complete(result.RequestId)
+		if metrics.NotegicMeter != nil {
+			metrics.NotegicMeter.Count(ctx, "material.processing.result.success", 1,
+				attribute.String("status", string(result.Status)))
+		}
+		return nil
+	}
+
+	if request, exists := c.strategy.Fail(result.RequestId); exists && request.Attempt < c.strategy.Config.MaximumRequestAttempts {
+		c.retryHint(ctx, request.Hint)
+	}
+	if metrics.NotegicMeter != nil {
+		metrics.NotegicMeter.Count(ctx, "material.processing.result.failure", 1)
+	}
+	if logs.NotegicLogger != nil {
+		logs.NotegicLogger.Error(ctx, errors.New(result.Error), "material processing request failed")
+	}
+
+	return nil
+}
+
+func (c *MaterialProcessingResultConsumer) retryHint(ctx context.Context, hint coreeventscontract.MaterialProcessingHintData) {
+	go func() {
+		timer := time.NewTimer(time.Second)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+		case <-timer.C:
+			if err := c.strategy.Enqueue(hint); err != nil && logs.NotegicLogger != nil {
+				logs.NotegicLogger.Error(ctx, err, "failed to requeue material processing hint")
+			}
+		}
+	}()
+}
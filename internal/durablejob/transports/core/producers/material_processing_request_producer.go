@@ -0,0 +1,62 @@
+package coreproducers
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	coreeventscontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/events"
+	durablejobeventscontract "github.com/HiIamJeff67/notegic-backend/contracts/durable-job/v1/events"
+	eventcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/events"
+
+	platformkafka "github.com/HiIamJeff67/notegic-backend/shared/platform/kafka"
+)
+
+type MaterialProcessingRequestProducer struct {
+	producer *platformkafka.Producer
+}
+
+func NewMaterialProcessingRequestProducer(producer *platformkafka.Producer) *MaterialProcessingRequestProducer {
+	return &MaterialProcessingRequestProducer{producer: producer}
+}
+
+func (p *MaterialProcessingRequestProducer) Produce(
+	ctx context.Context,
+	hint coreeventscontract.MaterialProcessingHintData,
+	attempt int,
+	requestId uuid.UUID,
+) error {
+	correlationId := uuid.NewString()
+	request := eventcontract.EventEnvelope[durablejobeventscontract.MaterialProcessingRequestData]{
+		SchemaVersion: eventcontract.Version,
+		EventId:       uuid.New(),
+		EventType:     durablejobeventscontract.EventType_MaterialProcessingRequested,
+		AggregateType: durablejobeventscontract.AggregateType_Material,
+		AggregateId:   hint.MaterialId,
+		KafkaKey:      hint.MaterialId.String(),
+		OccurredAt:    time.Now().UTC(),
+		CorrelationId: correlationId,
+		Data: durablejobeventscontract.MaterialProcessingRequestData{
+			RequestId:     requestId,
+			MaterialId:    hint.MaterialId,
+			Attempt:       attempt,
+			CorrelationId: correlationId,
+		},
+	}
+	payload, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	if err := p.producer.Produce(
+		ctx,
+		durablejobeventscontract.DurableJobCoreMaterialProcessingRequestTopic.String(),
+		hint.MaterialId.String(),
+		payload,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package corestrategies
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/google/uuid"
+
+	coreeventscontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/events"
+	durablejobconfig "github.com/HiIamJeff67/notegic-backend/internal/durablejob/configs"
+)
+
+// MaterialProcessingStrategy dispatches the hints in their arrival order, since every material is equally urgent to
+// its owner, and coalesces the hints of the same material, since the processing always reads the latest content
+type MaterialProcessingStrategy struct {
+	Config   durablejobconfig.MaterialProcessingStrategyConfig
+	mutex    sync.Mutex
+	order    []uuid.UUID
+	pending  map[uuid.UUID]coreeventscontract.MaterialProcessingHintData
+	requests map[uuid.UUID]materialProcessingRequest
+	attempts map[uuid.UUID]int
+	inFlight map[uuid.UUID]struct{}
+	notify   chan struct{}
+}
+
+type materialProcessingRequest struct {
+	Hint    coreeventscontract.MaterialProcessingHintData
+	Attempt int
+}
+
+func NewMaterialProcessingStrategy(config durablejobconfig.MaterialProcessingStrategyConfig) *MaterialProcessingStrategy {
+	return &MaterialProcessingStrategy{
+		Config:   config,
+		pending:  make(map[uuid.UUID]coreeventscontract.MaterialProcessingHintData),
+		requests: make(map[uuid.UUID]materialProcessingRequest),
+		attempts: make(map[uuid.UUID]int),
+		inFlight: make(map[uuid.UUID]struct{}),
+		notify:   make(chan struct{}, 1),
+	}
+}
+
+func (s *MaterialProcessingStrategy) Enqueue(hint coreeventscontract.MaterialProcessingHintData) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, exists := s.pending[hint.MaterialId]; !exists {
+		if len(s.pending) >= s.Config.MaximumPendingHints {
+			return errors.New("material processing strategy queue is full")
+		}
+		s.order = append(s.order, hint.MaterialId)
+	}
+	s.pending[hint.MaterialId] = hint
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+func (s *MaterialProcessingStrategy) DequeueBatch(limit int) []coreeventscontract.MaterialProcessingHintData {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if len(s.pending) == 0 {
+		return nil
+	}
+	result := make([]coreeventscontract.MaterialProcessingHintData, 0, min(limit, len(s.pending)))
+	remaining := s.order[:0]
+	for _, materialId := range s.order {
+		_, active := s.inFlight[materialId]
+		if len(result) >= limit || active {
+			remaining = append(remaining, materialId)
+			continue
+		}
+		result = append(result, s.pending[materialId])
+		delete(s.pending, materialId)
+	}
+	s.order = remaining
+
+	return result
+}
+
+func (s *MaterialProcessingStrategy) PendingCount() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return len(s.pending)
+}
+
+func (s *MaterialProcessingStrategy) Track(requestId uuid.UUID, hint coreeventscontract.MaterialProcessingHintData) int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	attempt := s.attempts[hint.MaterialId] + 1
+	s.attempts[hint.MaterialId] = attempt
+	s.requests[requestId] = materialProcessingRequest{Hint: hint, Attempt: attempt}
+	s.inFlight[hint.MaterialId] = struct{}{}
+
+	return attempt
+}
+
+func (s *MaterialProcessingStrategy) Complete(requestId uuid.UUID) (materialProcessingRequest, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	request, exists := s.requests[requestId]
+	if exists {
+		delete(s.attempts, request.Hint.MaterialId)
+		delete(s.inFlight, request.Hint.MaterialId)
+	}
+	delete(s.requests, requestId)
+	s.signalPending(request.Hint.MaterialId)
+
+	return request, exists
+}
+
+func (s *MaterialProcessingStrategy) Fail(requestId uuid.UUID) (materialProcessingRequest, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	request, exists := s.requests[requestId]
+	if exists {
+		delete(s.requests, requestId)
+		delete(s.inFlight, request.Hint.MaterialId)
+		if request.Attempt >= s.Config.MaximumRequestAttempts {
+			delete(s.attempts, request.Hint.MaterialId)
+		}
+	}
+	s.signalPending(request.Hint.MaterialId)
+
+	return request, exists
+}
+
+func (s *MaterialProcessingStrategy) Notify() <-chan struct{} {
+	return s.notify
+}
+
+func (s *MaterialProcessingStrategy) signalPending(materialId uuid.UUID) {
+	if _, exists := s.pending[materialId]; !exists {
+		return
+	}
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
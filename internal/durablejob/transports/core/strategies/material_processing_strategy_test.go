@@ -0,0 +1,95 @@
+package corestrategies
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+
+	coreeventscontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/events"
+	durablejobconfig "github.com/HiIamJeff67/notegic-backend/internal/durablejob/configs"
+)
+
+func TestMaterialProcessingStrategyDispatchesInArrivalOrder(t *testing.T) {
+	strategy := NewMaterialProcessingStrategy(testMaterialProcessingStrategyConfig())
+	materialIds := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+	for _, materialId := range materialIds {
+		if err := strategy.Enqueue(coreeventscontract.MaterialProcessingHintData{MaterialId: materialId}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	first := strategy.DequeueBatch(2)
+	second := strategy.DequeueBatch(2)
+	if len(first) != 2 || first[0].MaterialId != materialIds[0] || first[1].MaterialId != materialIds[1] {
+		t.Fatalf("expected the first two materials in arrival order, got %#v", first)
+	}
+	if len(second) != 1 || second[0].MaterialId != materialIds[2] {
+		t.Fatalf("expected the last material, got %#v", second)
+	}
+}
+
+func TestMaterialProcessingStrategyCoalescesHintsByMaterial(t *testing.T) {
+	strategy := NewMaterialProcessingStrategy(testMaterialProcessingStrategyConfig())
+	materialId := uuid.New()
+	for _, size := range []int64{10, 20} {
+		if err := strategy.Enqueue(coreeventscontract.MaterialProcessingHintData{MaterialId: materialId, Size: size}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	hints := strategy.DequeueBatch(10)
+	if len(hints) != 1 || hints[0].Size != 20 {
+		t.Fatalf("expected one latest coalesced hint, got %#v", hints)
+	}
+}
+
+func TestMaterialProcessingStrategyHoldsHintsOfActiveMaterial(t *testing.T) {
+	strategy := NewMaterialProcessingStrategy(testMaterialProcessingStrategyConfig())
+	hint := coreeventscontract.MaterialProcessingHintData{MaterialId: uuid.New()}
+	requestId := uuid.New()
+
+	if attempt := strategy.Track(requestId, hint); attempt != 1 {
+		t.Fatalf("expected the first attempt, got %d", attempt)
+	}
+	if err := strategy.Enqueue(hint); err != nil {
+		t.Fatal(err)
+	}
+	if hints := strategy.DequeueBatch(1); len(hints) != 0 {
+		t.Fatalf("expected active material to remain queued, got %d hints", len(hints))
+	}
+
+	strategy.Fail(requestId)
+	hints := strategy.DequeueBatch(1)
+	if len(hints) != 1 {
+		t.Fatalf("expected the queued hint after the failure, got %d hints", len(hints))
+	}
+	if attempt := strategy.Track(uuid.New(), hints[0]); attempt != 2 {
+		t.Fatalf("expected the retry to be the second attempt, got %d", attempt)
+	}
+}
+
+func TestMaterialProcessingStrategyRejectsHintsBeyondCapacity(t *testing.T) {
+	config := testMaterialProcessingStrategyConfig()
+	config.MaximumPendingHints = 1
+	strategy := NewMaterialProcessingStrategy(config)
+	materialId := uuid.New()
+
+	if err := strategy.Enqueue(coreeventscontract.MaterialProcessingHintData{MaterialId: materialId}); err != nil {
+		t.Fatal(err)
+	}
+	if err := strategy.Enqueue(coreeventscontract.MaterialProcessingHintData{MaterialId: materialId}); err != nil {
+		t.Fatalf("expected the hint of a queued material to be coalesced, got %v", err)
+	}
+	if err := strategy.Enqueue(coreeventscontract.MaterialProcessingHintData{MaterialId: uuid.New()}); err == nil {
+		t.Fatal("expected the full queue to reject a new material")
+	}
+}
+
+func testMaterialProcessingStrategyConfig() durablejobconfig.MaterialProcessingStrategyConfig {
+	return durablejobconfig.MaterialProcessingStrategyConfig{
+		MaximumPendingHints:    1_000,
+		MaximumDispatchBatch:   32,
+		MaximumDispatchWorkers: 4,
+		MaximumRequestAttempts: 3,
+	}
+}
@@ -86,10 +86,31 @@ func (a *Application) initializeWorkers(
 		shutdownObservability()
 		panic(err)
 	}
+	quotaWarningRenderer, err := renderers.NewRenderer(config.Renderers.QuotaWarning)
+	if err != nil {
+		emailWorkerManager.Shutdown()
+		shutdownObservability()
+		panic(err)
+	}
+	notificationRenderer, err := renderers.NewRenderer(config.Renderers.Notification)
+	if err != nil {
+		emailWorkerManager.Shutdown()
+		shutdownObservability()
+		panic(err)
+	}
+	notificationDigestRenderer, err := renderers.NewRenderer(config.Renderers.NotificationDigest)
+	if err != nil {
+		emailWorkerManager.Shutdown()
+		shutdownObservability()
+		panic(err)
+	}
 	sender := coretransport.NewSender(
 		emailsenders.NewWelcomeEmailSender(welcomeRenderer, emailWorkerManager.Enqueue),
 		emailsenders.NewValidationEmailSender(validationRenderer, emailWorkerManager.Enqueue),
 		emailsenders.NewSecurityAlertEmailSender(securityAlertRenderer, emailWorkerManager.Enqueue),
+		emailsenders.NewQuotaWarningEmailSender(quotaWarningRenderer, emailWorkerManager.Enqueue),
+		emailsenders.NewNotificationEmailSender(notificationRenderer, emailWorkerManager.Enqueue),
+		emailsenders.NewNotificationDigestEmailSender(notificationDigestRenderer, emailWorkerManager.Enqueue),
 	)
 	validation := validator.New()
 	emailRequestConsumer := coretransport.NewEmailRequestConsumer(sender, validation, config.KafkaConsumer)
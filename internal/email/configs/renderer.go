@@ -8,9 +8,12 @@ type RendererConfig struct {
 }
 
 type RendererConfigs struct {
-	Welcome       RendererConfig
-	Validation    RendererConfig
-	SecurityAlert RendererConfig
+	Welcome            RendererConfig
+	Validation         RendererConfig
+	SecurityAlert      RendererConfig
+	QuotaWarning       RendererConfig
+	Notification       RendererConfig
+	NotificationDigest RendererConfig
 }
 
 func loadRendererConfigs() RendererConfigs {
@@ -27,5 +30,17 @@ func loadRendererConfigs() RendererConfigs {
 			TemplatePath: "templates/security_alert_email_template.html",
 			ContentType:  emailcontract.EmailContentType_HTML,
 		},
+		QuotaWarning: RendererConfig{
+			TemplatePath: "templates/quota_warning_email_template.html",
+			ContentType:  emailcontract.EmailContentType_HTML,
+		},
+		Notification: RendererConfig{
+			TemplatePath: "templates/notification_email_template.html",
+			ContentType:  emailcontract.EmailContentType_HTML,
+		},
+		NotificationDigest: RendererConfig{
+			TemplatePath: "templates/notification_digest_email_template.html",
+			ContentType:  emailcontract.EmailContentType_HTML,
+		},
 	}
 }
@@ -4,9 +4,12 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	emailcontract "github.com/HiIamJeff67/notegic-backend/contracts/email/v1"
+	emaileventscontract "github.com/HiIamJeff67/notegic-backend/contracts/email/v1/events"
 	exceptions "github.com/HiIamJeff67/notegic-backend/contracts/types/exceptions"
 	emailconfig "github.com/HiIamJeff67/notegic-backend/internal/email/configs"
 )
@@ -158,3 +161,38 @@ func TestRendererRejectsMalformedTemplate(t *testing.T) {
 		t.Fatalf("exception.Reason = %q, want %q", emailException.Reason, "TemplateParseFailed")
 	}
 }
+
+func TestRendererRendersNotificationDigestTemplate(t *testing.T) {
+	renderer, exception := NewRenderer(emailconfig.RendererConfig{
+		TemplatePath: filepath.Join("..", "templates", "notification_digest_email_template.html"),
+		ContentType:  emailcontract.EmailContentType_HTML,
+	})
+	if exception != nil {
+		t.Fatalf("NewRenderer() exception = %v", exception)
+	}
+
+	periodEndedAt := time.Date(2026, time.March, 2, 8, 0, 0, 0, time.UTC)
+	body, exception := renderer.Render(map[string]any{
+		"UserName":        "Notegic User",
+		"Frequency":       emaileventscontract.NotificationDigestFrequency_Weekly,
+		"PeriodStartedAt": periodEndedAt.AddDate(0, 0, -7),
+		"PeriodEndedAt":   periodEndedAt,
+		"UnreadCount":     int64(3),
+		"Notifications": []emaileventscontract.NotificationEmailItem{
+			{Type: "news", Priority: "normal", Title: "Release", Message: "A new release is available.", CreatedAt: periodEndedAt},
+		},
+		"FailureCount": int64(1),
+		"RoutineTaskFailures": []emaileventscontract.RoutineTaskFailureEmailItem{
+			{RoutineTitle: "Weekly Review", RoutineTaskTitle: "Summarize", Purpose: "summarize", ErrorCode: "Timeout", FailedAt: periodEndedAt},
+		},
+		"UnsubscribeUrl": "https://notegic.test/api/development/v1/notifications/digest/unsubscribe?token=digest-token",
+	})
+	if exception != nil {
+		t.Fatalf("Render() exception = %v", exception)
+	}
+	for _, want := range []string{"Your Weekly Digest", "3 unread notifications", "And more in your inbox.", "1 failed routine task<", "Timeout", `href="https://notegic.test/api/development/v1/notifications/digest/unsubscribe?token=digest-token"`} {
+		if !strings.Contains(body, want) {
+			t.Fatalf("Render() body does not contain %q", want)
+		}
+	}
+}
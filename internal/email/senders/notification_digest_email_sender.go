@@ -0,0 +1,98 @@
+package senders
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	emaileventscontract "github.com/HiIamJeff67/notegic-backend/contracts/email/v1/events"
+
+	emailrenderers "github.com/HiIamJeff67/notegic-backend/internal/email/renderers"
+	emailtypes "github.com/HiIamJeff67/notegic-backend/internal/email/types"
+)
+
+const (
+	dailyNotificationDigestEmailSubject  = "Notegic - Your Daily Digest"
+	weeklyNotificationDigestEmailSubject = "Notegic - Your Weekly Digest"
+)
+
+type NotificationDigestEmailSenderInterface interface {
+	Send(context.Context, emaileventscontract.SendNotificationDigestEmailRequestDto) error
+	SendAsync(context.Context, emaileventscontract.SendNotificationDigestEmailRequestDto) error
+}
+
+type NotificationDigestEmailSender struct {
+	renderer    emailrenderers.RendererInterface
+	enqueueFunc emailtypes.EnqueueFunc
+}
+
+func NewNotificationDigestEmailSender(renderer emailrenderers.RendererInterface, enqueueFunc emailtypes.EnqueueFunc) NotificationDigestEmailSenderInterface {
+	return &NotificationDigestEmailSender{renderer: renderer, enqueueFunc: enqueueFunc}
+}
+
+func (s *NotificationDigestEmailSender) Send(
+	_ context.Context,
+	request emaileventscontract.SendNotificationDigestEmailRequestDto,
+) error {
+	// the digest is scheduled in the timezone of the recipient, so every time in it is shown in that timezone too
+	location, err := time.LoadLocation(request.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+	notifications := make([]emaileventscontract.NotificationEmailItem, len(request.Notifications))
+	for index, notification := range request.Notifications {
+		notification.CreatedAt = notification.CreatedAt.In(location)
+		notifications[index] = notification
+	}
+	routineTaskFailures := make([]emaileventscontract.RoutineTaskFailureEmailItem, len(request.RoutineTaskFailures))
+	for index, routineTaskFailure := range request.RoutineTaskFailures {
+		routineTaskFailure.FailedAt = routineTaskFailure.FailedAt.In(location)
+		routineTaskFailures[index] = routineTaskFailure
+	}
+
+	body, err := s.renderer.Render(map[string]any{
+		"UserName":            request.UserName,
+		"Frequency":           request.Frequency,
+		"PeriodStartedAt":     request.PeriodStartedAt.In(location),
+		"PeriodEndedAt":       request.PeriodEndedAt.In(location),
+		"UnreadCount":         request.UnreadCount,
+		"Notifications":       notifications,
+		"FailureCount":        request.FailureCount,
+		"RoutineTaskFailures": routineTaskFailures,
+		"UnsubscribeUrl":      request.UnsubscribeUrl,
+	})
+	if err != nil {
+		return err
+	}
+
+	subject := dailyNotificationDigestEmailSubject
+	if request.Frequency == emaileventscontract.NotificationDigestFrequency_Weekly {
+		subject = weeklyNotificationDigestEmailSubject
+	}
+
+	return s.enqueueFunc(
+		emailtypes.EmailObject{
+			To:               request.To,
+			Subject:          subject,
+			Body:             body,
+			EmailContentType: s.renderer.ContentType(),
+			// the one-click unsubscribe of RFC 8058, which lets the mail client unsubscribe without opening a page
+			Headers: map[string]string{
+				"List-Unsubscribe":      fmt.Sprintf("<%s>", request.UnsubscribeUrl),
+				"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+			},
+		},
+		emailtypes.EmailTaskType_NotificationDigest,
+		1,
+		3,
+	)
+}
+
+func (s *NotificationDigestEmailSender) SendAsync(
+	ctx context.Context,
+	request emaileventscontract.SendNotificationDigestEmailRequestDto,
+) error {
+	return s.Send(ctx, request)
+}
+
+var _ NotificationDigestEmailSenderInterface = (*NotificationDigestEmailSender)(nil)
@@ -0,0 +1,76 @@
+package senders
+
+import (
+	"context"
+	"fmt"
+
+	coreeventscontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/events"
+	emaileventscontract "github.com/HiIamJeff67/notegic-backend/contracts/email/v1/events"
+
+	emailrenderers "github.com/HiIamJeff67/notegic-backend/internal/email/renderers"
+	emailtypes "github.com/HiIamJeff67/notegic-backend/internal/email/types"
+)
+
+const (
+	notificationEmailSubjectFormat       = "Notegic - %s"
+	notificationDigestEmailSubjectFormat = "Notegic - You Have %d New Notifications"
+)
+
+type NotificationEmailSenderInterface interface {
+	Send(context.Context, emaileventscontract.SendNotificationEmailRequestDto) error
+	SendAsync(context.Context, emaileventscontract.SendNotificationEmailRequestDto) error
+}
+
+type NotificationEmailSender struct {
+	renderer    emailrenderers.RendererInterface
+	enqueueFunc emailtypes.EnqueueFunc
+}
+
+func NewNotificationEmailSender(renderer emailrenderers.RendererInterface, enqueueFunc emailtypes.EnqueueFunc) NotificationEmailSenderInterface {
+	return &NotificationEmailSender{renderer: renderer, enqueueFunc: enqueueFunc}
+}
+
+func (s *NotificationEmailSender) Send(
+	_ context.Context,
+	request emaileventscontract.SendNotificationEmailRequestDto,
+) error {
+	body, err := s.renderer.Render(map[string]any{
+		"UserName":      request.UserName,
+		"Digest":        request.Digest,
+		"Notifications": request.Notifications,
+	})
+	if err != nil {
+		return err
+	}
+
+	subject := fmt.Sprintf(notificationDigestEmailSubjectFormat, len(request.Notifications))
+	if len(request.Notifications) == 1 {
+		subject = fmt.Sprintf(notificationEmailSubjectFormat, request.Notifications[0].Title)
+	}
+	// a digest is never urgent, while a critical notification skips ahead of the regular notification emails
+	priority := 1
+	if !request.Digest && request.Notifications[0].Priority == string(coreeventscontract.NotificationPriority_Critical) {
+		priority = 3
+	}
+
+	return s.enqueueFunc(
+		emailtypes.EmailObject{
+			To:               request.To,
+			Subject:          subject,
+			Body:             body,
+			EmailContentType: s.renderer.ContentType(),
+		},
+		emailtypes.EmailTaskType_Notification,
+		priority,
+		3,
+	)
+}
+
+func (s *NotificationEmailSender) SendAsync(
+	ctx context.Context,
+	request emaileventscontract.SendNotificationEmailRequestDto,
+) error {
+	return s.Send(ctx, request)
+}
+
+var _ NotificationEmailSenderInterface = (*NotificationEmailSender)(nil)
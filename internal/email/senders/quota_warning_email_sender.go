@@ -0,0 +1,65 @@
+package senders
+
+import (
+	"context"
+	"fmt"
+
+	emaileventscontract "github.com/HiIamJeff67/notegic-backend/contracts/email/v1/events"
+
+	emailrenderers "github.com/HiIamJeff67/notegic-backend/internal/email/renderers"
+	emailtypes "github.com/HiIamJeff67/notegic-backend/internal/email/types"
+)
+
+const quotaWarningEmailSubjectFormat = "Quota Warning - You Have Used %d%% of Your %s"
+
+type QuotaWarningEmailSenderInterface interface {
+	Send(context.Context, emaileventscontract.SendQuotaWarningEmailRequestDto) error
+	SendAsync(context.Context, emaileventscontract.SendQuotaWarningEmailRequestDto) error
+}
+
+type QuotaWarningEmailSender struct {
+	renderer    emailrenderers.RendererInterface
+	enqueueFunc emailtypes.EnqueueFunc
+}
+
+func NewQuotaWarningEmailSender(renderer emailrenderers.RendererInterface, enqueueFunc emailtypes.EnqueueFunc) QuotaWarningEmailSenderInterface {
+	return &QuotaWarningEmailSender{renderer: renderer, enqueueFunc: enqueueFunc}
+}
+
+func (s *QuotaWarningEmailSender) Send(
+	_ context.Context,
+	request emaileventscontract.SendQuotaWarningEmailRequestDto,
+) error {
+	body, err := s.renderer.Render(map[string]any{
+		"UserName":  request.UserName,
+		"LimitName": request.LimitName,
+		"Threshold": request.Threshold,
+		"Used":      request.Used,
+		"Maximum":   request.Maximum,
+		"ResetAt":   request.ResetAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.enqueueFunc(
+		emailtypes.EmailObject{
+			To:               request.To,
+			Subject:          fmt.Sprintf(quotaWarningEmailSubjectFormat, request.Threshold, request.LimitName),
+			Body:             body,
+			EmailContentType: s.renderer.ContentType(),
+		},
+		emailtypes.EmailTaskType_QuotaWarning,
+		2,
+		3,
+	)
+}
+
+func (s *QuotaWarningEmailSender) SendAsync(
+	ctx context.Context,
+	request emaileventscontract.SendQuotaWarningEmailRequestDto,
+) error {
+	return s.Send(ctx, request)
+}
+
+var _ QuotaWarningEmailSenderInterface = (*QuotaWarningEmailSender)(nil)
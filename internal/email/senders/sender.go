@@ -34,6 +34,9 @@ func (s *EmailSender) Send(_ context.Context, emailObject emailtypes.EmailObject
 	message.SetHeader("From", s.config.From)
 	message.SetHeader("To", emailObject.To)
 	message.SetHeader("Subject", emailObject.Subject)
+	for name, value := range emailObject.Headers {
+		message.SetHeader(name, value)
+	}
 	message.SetBody(emailObject.EmailContentType.String(), emailObject.Body)
 
 	dialer := gomail.NewDialer(
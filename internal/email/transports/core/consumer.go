@@ -39,6 +39,7 @@ func (c *EmailRequestConsumer) Start(ctx context.Context) func() {
 	consumer, err := platformkafka.NewConsumer(
 		c.kafkaConfig,
 		emaileventscontract.CoreEmailRequestTopic.String(),
+		emaileventscontract.NotificationEmailRequestTopic.String(),
 	)
 	if err != nil {
 		if logs.NotegicLogger != nil {
@@ -125,6 +126,42 @@ func (c *EmailRequestConsumer) consume(
 			return invalidEmailRequest(err.Error())
 		}
 		err = c.sender.SendSecurityAlertEmail(ctx, request)
+	case emailcontract.SendQuotaWarningEmailOperation:
+		var request emaileventscontract.SendQuotaWarningEmailRequestDto
+		if err := json.Unmarshal(event.Data, &request); err != nil {
+			return invalidEmailRequest(err.Error())
+		}
+		if request.RequestId != event.AggregateId || request.Operation != metadata.Operation {
+			return invalidEmailRequest("quota warning request metadata is invalid")
+		}
+		if err := c.validator.Struct(&request); err != nil {
+			return invalidEmailRequest(err.Error())
+		}
+		err = c.sender.SendQuotaWarningEmail(ctx, request)
+	case emailcontract.SendNotificationEmailOperation:
+		var request emaileventscontract.SendNotificationEmailRequestDto
+		if err := json.Unmarshal(event.Data, &request); err != nil {
+			return invalidEmailRequest(err.Error())
+		}
+		if request.RequestId != event.AggregateId || request.Operation != metadata.Operation {
+			return invalidEmailRequest("notification request metadata is invalid")
+		}
+		if err := c.validator.Struct(&request); err != nil {
+			return invalidEmailRequest(err.Error())
+		}
+		err = c.sender.SendNotificationEmail(ctx, request)
+	case emailcontract.SendNotificationDigestEmailOperation:
+		var request emaileventscontract.SendNotificationDigestEmailRequestDto
+		if err := json.Unmarshal(event.Data, &request); err != nil {
+			return invalidEmailRequest(err.Error())
+		}
+		if request.RequestId != event.AggregateId || request.Operation != metadata.Operation {
+			return invalidEmailRequest("notification digest request metadata is invalid")
+		}
+		if err := c.validator.Struct(&request); err != nil {
+			return invalidEmailRequest(err.Error())
+		}
+		err = c.sender.SendNotificationDigestEmail(ctx, request)
 	default:
 		return invalidEmailRequest("unsupported email operation")
 	}
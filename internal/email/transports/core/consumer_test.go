@@ -33,6 +33,110 @@ func (s senderStub) SendSecurityAlertEmail(context.Context, emaileventscontract.
 	return s.err
 }
 
+func (s senderStub) SendQuotaWarningEmail(context.Context, emaileventscontract.SendQuotaWarningEmailRequestDto) error {
+	return s.err
+}
+
+func (s senderStub) SendNotificationEmail(context.Context, emaileventscontract.SendNotificationEmailRequestDto) error {
+	return s.err
+}
+
+func (s senderStub) SendNotificationDigestEmail(context.Context, emaileventscontract.SendNotificationDigestEmailRequestDto) error {
+	return s.err
+}
+
+func TestEmailRequestConsumerValidatesNotificationRequest(t *testing.T) {
+	requestId := uuid.New()
+	request := emaileventscontract.SendNotificationEmailRequestDto{
+		RequestId:  requestId,
+		Operation:  emailcontract.SendNotificationEmailOperation,
+		OccurredAt: time.Now().UTC(),
+		To:         "user@example.com",
+		UserName:   "Notegic User",
+		Notifications: []emaileventscontract.NotificationEmailItem{
+			{Type: "news", Priority: "normal", Title: "Release", Message: "A new release is available.", CreatedAt: time.Now().UTC()},
+		},
+	}
+	consumer := &EmailRequestConsumer{sender: senderStub{}, validator: validatorpkg.New()}
+	consume := func(request emaileventscontract.SendNotificationEmailRequestDto) error {
+		data, err := json.Marshal(request)
+		if err != nil {
+			t.Fatalf("marshal request: %v", err)
+		}
+		return consumer.consume(
+			context.Background(),
+			platformkafka.ConsumerRecord{},
+			eventcontract.EventEnvelope[json.RawMessage]{
+				SchemaVersion: eventcontract.Version,
+				EventType:     emaileventscontract.EventType_EmailRequested,
+				AggregateType: emaileventscontract.AggregateType_EmailRequest,
+				AggregateId:   requestId,
+				Data:          data,
+			},
+		)
+	}
+
+	if err := consume(request); err != nil {
+		t.Fatalf("consume notification request: %v", err)
+	}
+
+	request.Notifications = nil
+	consumerError, ok := consume(request).(*platformkafka.ConsumerError)
+	if !ok || consumerError.Classification != platformkafka.ErrorClassification_SchemaIncompatible {
+		t.Fatalf("expected an empty notification email to be schema incompatible, got %v", consumerError)
+	}
+}
+
+func TestEmailRequestConsumerValidatesNotificationDigestRequest(t *testing.T) {
+	requestId := uuid.New()
+	periodEndedAt := time.Now().UTC()
+	request := emaileventscontract.SendNotificationDigestEmailRequestDto{
+		RequestId:       requestId,
+		Operation:       emailcontract.SendNotificationDigestEmailOperation,
+		OccurredAt:      periodEndedAt,
+		To:              "user@example.com",
+		UserName:        "Notegic User",
+		Frequency:       emaileventscontract.NotificationDigestFrequency_Daily,
+		PeriodStartedAt: periodEndedAt.Add(-24 * time.Hour),
+		PeriodEndedAt:   periodEndedAt,
+		Timezone:        "Asia/Taipei",
+		FailureCount:    1,
+		RoutineTaskFailures: []emaileventscontract.RoutineTaskFailureEmailItem{
+			{RoutineTitle: "Weekly Review", RoutineTaskTitle: "Summarize", Purpose: "summarize", ErrorCode: "Timeout", FailedAt: periodEndedAt},
+		},
+		UnsubscribeToken: "token",
+		UnsubscribeUrl:   "https://api.notegic.app/api/v1/notifications/digest/unsubscribe?token=token",
+	}
+	consumer := &EmailRequestConsumer{sender: senderStub{}, validator: validatorpkg.New()}
+	consume := func(request emaileventscontract.SendNotificationDigestEmailRequestDto) error {
+		data, err := json.Marshal(request)
+		if err != nil {
+			t.Fatalf("marshal request: %v", err)
+		}
+		return consumer.consume(
+			context.Background(),
+			platformkafka.ConsumerRecord{},
+			eventcontract.EventEnvelope[json.RawMessage]{
+				SchemaVersion: eventcontract.Version,
+				EventType:     emaileventscontract.EventType_EmailRequested,
+				AggregateType: emaileventscontract.AggregateType_EmailRequest,
+				AggregateId:   requestId,
+				Data:          data,
+			},
+		)
+	}
+
+	if err := consume(request); err != nil {
+		t.Fatalf("consume notification digest request: %v", err)
+	}
+
+	request.PeriodStartedAt = periodEndedAt
+	consumerError, ok := consume(request).(*platformkafka.ConsumerError)
+	if !ok || consumerError.Classification != platformkafka.ErrorClassification_SchemaIncompatible {
+		t.Fatalf("expected an empty digest period to be schema incompatible, got %v", consumerError)
+	}
+}
+
 func TestEmailRequestConsumerMapsLocalErrorClassification(t *testing.T) {
 	cases := []struct {
 		name      string
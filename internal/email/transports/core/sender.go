@@ -12,23 +12,35 @@ type SenderInterface interface {
 	SendWelcomeEmail(context.Context, emaileventscontract.SendWelcomeEmailRequestDto) error
 	SendValidationEmail(context.Context, emaileventscontract.SendValidationEmailRequestDto) error
 	SendSecurityAlertEmail(context.Context, emaileventscontract.SendSecurityAlertEmailRequestDto) error
+	SendQuotaWarningEmail(context.Context, emaileventscontract.SendQuotaWarningEmailRequestDto) error
+	SendNotificationEmail(context.Context, emaileventscontract.SendNotificationEmailRequestDto) error
+	SendNotificationDigestEmail(context.Context, emaileventscontract.SendNotificationDigestEmailRequestDto) error
 }
 
 type Sender struct {
 	welcome       emailsenders.WelcomeEmailSenderInterface
 	validation    emailsenders.ValidationEmailSenderInterface
 	securityAlert emailsenders.SecurityAlertEmailSenderInterface
+	quotaWarning  emailsenders.QuotaWarningEmailSenderInterface
+	notification  emailsenders.NotificationEmailSenderInterface
+	digest        emailsenders.NotificationDigestEmailSenderInterface
 }
 
 func NewSender(
 	welcome emailsenders.WelcomeEmailSenderInterface,
 	validation emailsenders.ValidationEmailSenderInterface,
 	securityAlert emailsenders.SecurityAlertEmailSenderInterface,
+	quotaWarning emailsenders.QuotaWarningEmailSenderInterface,
+	notification emailsenders.NotificationEmailSenderInterface,
+	digest emailsenders.NotificationDigestEmailSenderInterface,
 ) SenderInterface {
 	return &Sender{
 		welcome:       welcome,
 		validation:    validation,
 		securityAlert: securityAlert,
+		quotaWarning:  quotaWarning,
+		notification:  notification,
+		digest:        digest,
 	}
 }
 
@@ -53,4 +65,25 @@ func (s *Sender) SendSecurityAlertEmail(
 	return s.securityAlert.Send(ctx, request)
 }
 
+func (s *Sender) SendQuotaWarningEmail(
+	ctx context.Context,
+	request emaileventscontract.SendQuotaWarningEmailRequestDto,
+) error {
+	return s.quotaWarning.Send(ctx, request)
+}
+
+func (s *Sender) SendNotificationEmail(
+	ctx context.Context,
+	request emaileventscontract.SendNotificationEmailRequestDto,
+) error {
+	return s.notification.Send(ctx, request)
+}
+
+func (s *Sender) SendNotificationDigestEmail(
+	ctx context.Context,
+	request emaileventscontract.SendNotificationDigestEmailRequestDto,
+) error {
+	return s.digest.Send(ctx, request)
+}
+
 var _ SenderInterface = (*Sender)(nil)
@@ -9,11 +9,14 @@ import (
 type EmailTaskType string
 
 const (
-	EmailTaskType_Undefined  EmailTaskType = "Undefined"
-	EmailTaskType_Welcome    EmailTaskType = "EmailTaskType_Welcome"
-	EmailTaskType_Validation EmailTaskType = "EmailTaskType_Validation"
-	EmailTaskType_Security   EmailTaskType = "EmailTaskType_Security"
-	EmailTaskType_News       EmailTaskType = "EmailTaskType_News"
+	EmailTaskType_Undefined          EmailTaskType = "Undefined"
+	EmailTaskType_Welcome            EmailTaskType = "EmailTaskType_Welcome"
+	EmailTaskType_Validation         EmailTaskType = "EmailTaskType_Validation"
+	EmailTaskType_Security           EmailTaskType = "EmailTaskType_Security"
+	EmailTaskType_News               EmailTaskType = "EmailTaskType_News"
+	EmailTaskType_QuotaWarning       EmailTaskType = "EmailTaskType_QuotaWarning"
+	EmailTaskType_Notification       EmailTaskType = "EmailTaskType_Notification"
+	EmailTaskType_NotificationDigest EmailTaskType = "EmailTaskType_NotificationDigest"
 )
 
 type EmailObject struct {
@@ -21,6 +24,7 @@ type EmailObject struct {
 	Subject          string `json:"subject"`
 	Body             string `json:"body"`
 	EmailContentType emailcontract.EmailContentType
+	Headers          map[string]string `json:"headers,omitempty"` // the extra headers, like the List-Unsubscribe of a digest
 }
 
 type EmailTask struct {
@@ -27,6 +27,7 @@ import (
 	consumers "github.com/HiIamJeff67/notegic-backend/internal/notification/transports/core/consumers"
 	endpoints "github.com/HiIamJeff67/notegic-backend/internal/notification/transports/gateway/endpoints"
 	routers "github.com/HiIamJeff67/notegic-backend/internal/notification/transports/gateway/routers"
+	webpush "github.com/HiIamJeff67/notegic-backend/internal/notification/transports/webpush"
 	validations "github.com/HiIamJeff67/notegic-backend/internal/notification/validations"
 	workers "github.com/HiIamJeff67/notegic-backend/internal/notification/workers"
 )
@@ -44,7 +45,8 @@ type ApplicationInterface interface {
 	initializeObservability() func()
 	initializeDatabase(platformpostgres.Config, func()) *gorm.DB
 	initializeKafka(platformkafka.ConnectionConfig, *gorm.DB, func()) *platformkafka.Producer
-	initializeService(*gorm.DB) services.NotificationServiceInterface
+	initializePushClient(configs.WebPushConfig) webpush.ClientInterface
+	initializeService(configs.Config, *gorm.DB) services.NotificationServiceInterface
 	initializeWorkers(configs.Config, services.NotificationServiceInterface, *gorm.DB, *platformkafka.Producer) func()
 	buildRouter(services.NotificationServiceInterface) *gin.Engine
 	startHTTP(configs.Config, *gin.Engine, func(), *gorm.DB, *platformkafka.Producer, func()) func()
@@ -103,7 +105,20 @@ func (a *Application) initializeKafka(
 	return producer
 }
 
-func (a *Application) initializeService(db *gorm.DB) services.NotificationServiceInterface {
+// initializePushClient returns a nil client instead of a nil WebPushClient when web push is not configured,
+// so the notification service can tell the missing client apart
+func (a *Application) initializePushClient(config configs.WebPushConfig) webpush.ClientInterface {
+	if !config.IsConfigured() {
+		return nil
+	}
+	client, err := webpush.NewWebPushClient(config)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+func (a *Application) initializeService(config configs.Config, db *gorm.DB) services.NotificationServiceInterface {
 	repository := repositories.NewNotificationRepository(db)
 	notificationValidator := validator.New()
 	sharedvalidations.RegisterStringsValidation(notificationValidator)
@@ -112,7 +127,13 @@ func (a *Application) initializeService(db *gorm.DB) services.NotificationServic
 	validations.RegisterNewsValidation(notificationValidator)
 	validations.RegisterWarningValidation(notificationValidator)
 	validations.RegisterImportantValidation(notificationValidator)
-	return services.NewNotificationService(repository, notificationValidator)
+	return services.NewNotificationService(
+		repository,
+		notificationValidator,
+		config.EmailDigestInterval,
+		a.initializePushClient(config.WebPush),
+		config.DigestUnsubscribeUrl,
+	)
 }
 
 func (a *Application) initializeWorkers(
@@ -139,10 +160,18 @@ func (a *Application) initializeWorkers(
 		config.OutboxCleanupInterval,
 		config.NotificationRetention,
 	)
+	delivery := workers.NewDeliveryWorker(
+		service,
+		config.DeliveryPollInterval,
+		config.DeliveryBatchSize,
+		config.OutboxClaimTimeout,
+	)
 	shutdownConsumer := consumer.Start(context.Background())
 	shutdownRelay := relay.Start(context.Background())
 	shutdownCleanup := cleanup.Start(context.Background())
+	shutdownDelivery := delivery.Start(context.Background())
 	return func() {
+		shutdownDelivery()
 		shutdownCleanup()
 		shutdownRelay()
 		shutdownConsumer()
@@ -218,7 +247,7 @@ func (a *Application) Start() func() {
 	config := a.loadConfig()
 	db := a.initializeDatabase(config.Postgres, shutdownObservability)
 	producer := a.initializeKafka(config.Kafka.Connection, db, shutdownObservability)
-	service := a.initializeService(db)
+	service := a.initializeService(config, db)
 	shutdownWorkers := a.initializeWorkers(config, service, db, producer)
 	router := a.buildRouter(service)
 	return a.startHTTP(config, router, shutdownWorkers, db, producer, shutdownObservability)
@@ -24,6 +24,10 @@ var rootCommand = &cobra.Command{
 	},
 }
 
+func init() {
+	rootCommand.AddCommand(generateVapidKeysCommand)
+}
+
 func Execute() {
 	if err := rootCommand.Execute(); err != nil {
 		panic(err)
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	webpush "github.com/HiIamJeff67/notegic-backend/internal/notification/transports/webpush"
+)
+
+var generateVapidKeysCommand = &cobra.Command{
+	Use:   "generateVapidKeys",
+	Short: "Generate a VAPID key pair for web push.",
+	Long:  "Generate a P-256 VAPID key pair and print it as the environment variables read by the Notification runtime.",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		publicKey, privateKey, err := webpush.GenerateVapidKeys()
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "NOTIFICATION_VAPID_PUBLIC_KEY=%s\n", publicKey)
+		fmt.Fprintf(cmd.OutOrStdout(), "NOTIFICATION_VAPID_PRIVATE_KEY=%s\n", privateKey)
+		return nil
+	},
+}
@@ -2,6 +2,7 @@ package configs
 
 import (
 	"fmt"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
@@ -22,6 +23,11 @@ type Config struct {
 	OutboxCleanupInterval time.Duration
 	OutboxRetention       time.Duration
 	NotificationRetention time.Duration
+	DeliveryPollInterval  time.Duration
+	DeliveryBatchSize     int
+	EmailDigestInterval   time.Duration
+	DigestUnsubscribeUrl  string
+	WebPush               WebPushConfig
 }
 
 func LoadConfig() (Config, error) {
@@ -42,6 +48,10 @@ func LoadConfig() (Config, error) {
 	if err != nil {
 		return Config{}, err
 	}
+	webPush, err := LoadWebPushConfig()
+	if err != nil {
+		return Config{}, err
+	}
 	outboxBatchSize, err := strconv.Atoi(strings.TrimSpace(os.Getenv("NOTIFICATION_OUTBOX_BATCH_SIZE")))
 	if err != nil || outboxBatchSize <= 0 {
 		return Config{}, fmt.Errorf("NOTIFICATION_OUTBOX_BATCH_SIZE must be a positive integer")
@@ -74,6 +84,26 @@ func LoadConfig() (Config, error) {
 	if err != nil || notificationRetention <= 0 {
 		return Config{}, fmt.Errorf("NOTIFICATION_RETENTION must be a positive Go duration")
 	}
+	deliveryPollInterval, err := time.ParseDuration(strings.TrimSpace(os.Getenv("NOTIFICATION_DELIVERY_POLL_INTERVAL")))
+	if err != nil || deliveryPollInterval <= 0 {
+		return Config{}, fmt.Errorf("NOTIFICATION_DELIVERY_POLL_INTERVAL must be a positive Go duration")
+	}
+	deliveryBatchSize, err := strconv.Atoi(strings.TrimSpace(os.Getenv("NOTIFICATION_DELIVERY_BATCH_SIZE")))
+	if err != nil || deliveryBatchSize <= 0 {
+		return Config{}, fmt.Errorf("NOTIFICATION_DELIVERY_BATCH_SIZE must be a positive integer")
+	}
+	emailDigestInterval, err := time.ParseDuration(strings.TrimSpace(os.Getenv("NOTIFICATION_EMAIL_DIGEST_INTERVAL")))
+	if err != nil || emailDigestInterval <= 0 {
+		return Config{}, fmt.Errorf("NOTIFICATION_EMAIL_DIGEST_INTERVAL must be a positive Go duration")
+	}
+	digestUnsubscribeUrl := strings.TrimSpace(os.Getenv("NOTIFICATION_DIGEST_UNSUBSCRIBE_URL"))
+	if parsedUrl, err := url.Parse(digestUnsubscribeUrl); err != nil || parsedUrl.Host == "" ||
+		(parsedUrl.Scheme != "http" && parsedUrl.Scheme != "https") {
+		return Config{}, fmt.Errorf("NOTIFICATION_DIGEST_UNSUBSCRIBE_URL must be an absolute HTTP(S) URL")
+	}
+	if strings.TrimSpace(os.Getenv("JWT_NOTIFICATION_DIGEST_UNSUBSCRIBE_TOKEN_SECRET_KEY")) == "" {
+		return Config{}, fmt.Errorf("JWT_NOTIFICATION_DIGEST_UNSUBSCRIBE_TOKEN_SECRET_KEY is required")
+	}
 
 	return Config{
 		ListenAddress:         listenAddress,
@@ -87,5 +117,10 @@ func LoadConfig() (Config, error) {
 		OutboxCleanupInterval: outboxCleanupInterval,
 		OutboxRetention:       outboxRetention,
 		NotificationRetention: notificationRetention,
+		DeliveryPollInterval:  deliveryPollInterval,
+		DeliveryBatchSize:     deliveryBatchSize,
+		EmailDigestInterval:   emailDigestInterval,
+		DigestUnsubscribeUrl:  digestUnsubscribeUrl,
+		WebPush:               webPush,
 	}, nil
 }
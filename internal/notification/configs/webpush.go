@@ -0,0 +1,51 @@
+package configs
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// WebPushConfig is optional like the other third party providers, it is only enabled when all of its variables
+// are set, and the push subscriptions are rejected and no push delivery is planned otherwise.
+type WebPushConfig struct {
+	VapidPublicKey  string
+	VapidPrivateKey string
+	VapidSubject    string
+}
+
+func LoadWebPushConfig() (WebPushConfig, error) {
+	config := WebPushConfig{
+		VapidPublicKey:  strings.TrimSpace(os.Getenv("NOTIFICATION_VAPID_PUBLIC_KEY")),
+		VapidPrivateKey: strings.TrimSpace(os.Getenv("NOTIFICATION_VAPID_PRIVATE_KEY")),
+		VapidSubject:    strings.TrimSpace(os.Getenv("NOTIFICATION_VAPID_SUBJECT")),
+	}
+	setCount := 0
+	for _, value := range []string{config.VapidPublicKey, config.VapidPrivateKey, config.VapidSubject} {
+		if value != "" {
+			setCount++
+		}
+	}
+	if setCount == 0 {
+		return config, nil
+	}
+	if setCount != 3 {
+		return WebPushConfig{}, fmt.Errorf("NOTIFICATION_VAPID_PUBLIC_KEY, NOTIFICATION_VAPID_PRIVATE_KEY, and NOTIFICATION_VAPID_SUBJECT must be set together")
+	}
+	if publicKey, err := base64.RawURLEncoding.DecodeString(config.VapidPublicKey); err != nil || len(publicKey) != 65 || publicKey[0] != 0x04 {
+		return WebPushConfig{}, fmt.Errorf("NOTIFICATION_VAPID_PUBLIC_KEY must be an uncompressed P-256 public key in unpadded base64url")
+	}
+	if privateKey, err := base64.RawURLEncoding.DecodeString(config.VapidPrivateKey); err != nil || len(privateKey) != 32 {
+		return WebPushConfig{}, fmt.Errorf("NOTIFICATION_VAPID_PRIVATE_KEY must be a P-256 private key in unpadded base64url")
+	}
+	if !strings.HasPrefix(config.VapidSubject, "mailto:") && !strings.HasPrefix(config.VapidSubject, "https://") {
+		return WebPushConfig{}, fmt.Errorf("NOTIFICATION_VAPID_SUBJECT must be a mailto: or https: URL")
+	}
+
+	return config, nil
+}
+
+func (c WebPushConfig) IsConfigured() bool {
+	return c.VapidPrivateKey != ""
+}
@@ -0,0 +1,32 @@
+package configs
+
+import "testing"
+
+func TestLoadWebPushConfig(t *testing.T) {
+	t.Setenv("NOTIFICATION_VAPID_PUBLIC_KEY", "")
+	t.Setenv("NOTIFICATION_VAPID_PRIVATE_KEY", "")
+	t.Setenv("NOTIFICATION_VAPID_SUBJECT", "")
+	if config, err := LoadWebPushConfig(); err != nil || config.IsConfigured() {
+		t.Fatalf("LoadWebPushConfig() = %#v, %v, want an unconfigured provider", config, err)
+	}
+
+	t.Setenv("NOTIFICATION_VAPID_SUBJECT", "mailto:push@notegic.example")
+	if _, err := LoadWebPushConfig(); err == nil {
+		t.Fatal("LoadWebPushConfig() expected an error for a partially configured provider")
+	}
+
+	t.Setenv("NOTIFICATION_VAPID_PUBLIC_KEY", "BP4z9KsN6nGRTbVYI_c7VJSPQTBtkgcy27mlmlMoZIIgDll6e3vCYLocInmYWAmS6TlzAC8wEqKK6PBru3jl7A8")
+	t.Setenv("NOTIFICATION_VAPID_PRIVATE_KEY", "yfWPiYE-n46HLnH0KqZOF1fJJU3MYrct3AELtAQ-oRw")
+	config, err := LoadWebPushConfig()
+	if err != nil {
+		t.Fatalf("LoadWebPushConfig() error = %v", err)
+	}
+	if !config.IsConfigured() {
+		t.Fatalf("LoadWebPushConfig() = %#v", config)
+	}
+
+	t.Setenv("NOTIFICATION_VAPID_PRIVATE_KEY", "not-a-key")
+	if _, err := LoadWebPushConfig(); err == nil {
+		t.Fatal("LoadWebPushConfig() expected an error for a malformed private key")
+	}
+}
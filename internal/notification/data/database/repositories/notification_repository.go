@@ -12,6 +12,7 @@ import (
 	"gorm.io/gorm/clause"
 
 	coreeventscontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/events"
+	emaileventscontract "github.com/HiIamJeff67/notegic-backend/contracts/email/v1/events"
 	notificationeventscontract "github.com/HiIamJeff67/notegic-backend/contracts/notification/v1/events"
 	eventcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/events"
 
@@ -19,7 +20,12 @@ import (
 )
 
 type NotificationRepository interface {
-	CreateFromRequest(ctx context.Context, event eventcontract.EventEnvelope[coreeventscontract.NotificationRequestedData]) error
+	CreateFromRequest(
+		ctx context.Context,
+		event eventcontract.EventEnvelope[coreeventscontract.NotificationRequestedData],
+		inApp bool,
+		deliveries []schemas.NotificationDelivery,
+	) error
 	List(
 		ctx context.Context,
 		userPublicId uuid.UUID,
@@ -36,6 +42,72 @@ type NotificationRepository interface {
 	MarkOutboxPublished(ctx context.Context, workerId string, eventIds []uuid.UUID) error
 	MarkOutboxFailed(ctx context.Context, workerId string, eventIds []uuid.UUID, message string, availableAt time.Time) error
 	DeletePublishedOutbox(ctx context.Context, publishedBefore time.Time) (int64, error)
+	FindPreferences(
+		ctx context.Context,
+		userPublicId uuid.UUID,
+	) ([]schemas.NotificationPreference, *schemas.NotificationSetting, error)
+	ReplacePreferences(
+		ctx context.Context,
+		userPublicId uuid.UUID,
+		setting *schemas.NotificationSetting,
+		preferences []schemas.NotificationPreference,
+	) error
+	ClaimDeliveries(
+		ctx context.Context,
+		workerId string,
+		channel string,
+		batchSize int,
+		claimTimeout time.Duration,
+	) ([]schemas.NotificationDelivery, error)
+	CompleteEmailDeliveries(
+		ctx context.Context,
+		workerId string,
+		deliveryIds []uuid.UUID,
+		request emaileventscontract.SendNotificationEmailRequestDto,
+	) error
+	DiscardDeliveries(ctx context.Context, workerId string, deliveryIds []uuid.UUID) error
+	ReleaseDeliveries(ctx context.Context, workerId string, deliveryIds []uuid.UUID, availableAt time.Time) error
+	UpsertPushSubscription(ctx context.Context, subscription *schemas.NotificationPushSubscription) error
+	DeletePushSubscription(ctx context.Context, userPublicId uuid.UUID, endpoint string) (int64, error)
+	DeletePushSubscriptionsByIds(ctx context.Context, subscriptionIds []uuid.UUID) error
+	HasPushSubscription(ctx context.Context, userPublicId uuid.UUID, now time.Time) (bool, error)
+	FindPushSubscriptions(
+		ctx context.Context,
+		userPublicIds []uuid.UUID,
+		now time.Time,
+	) ([]schemas.NotificationPushSubscription, error)
+	CreateRoutineTaskFailure(
+		ctx context.Context,
+		event eventcontract.EventEnvelope[coreeventscontract.RoutineTaskFailedData],
+	) error
+	UnsubscribeDigest(ctx context.Context, userPublicId uuid.UUID, now time.Time) error
+	ClaimDigests(
+		ctx context.Context,
+		workerId string,
+		batchSize int,
+		claimTimeout time.Duration,
+	) ([]schemas.NotificationSetting, error)
+	FindDigestNotifications(
+		ctx context.Context,
+		userPublicId uuid.UUID,
+		since time.Time,
+		until time.Time,
+		limit int,
+	) ([]schemas.Notification, int64, error)
+	FindRoutineTaskFailures(
+		ctx context.Context,
+		userPublicId uuid.UUID,
+		until time.Time,
+		limit int,
+	) ([]schemas.NotificationRoutineTaskFailure, int64, error)
+	CompleteDigest(
+		ctx context.Context,
+		workerId string,
+		userPublicId uuid.UUID,
+		periodEndedAt time.Time,
+		nextDigestAt *time.Time,
+		request *emaileventscontract.SendNotificationDigestEmailRequestDto,
+	) error
 }
 
 type NotificationRepositoryImpl struct {
@@ -49,6 +121,8 @@ func NewNotificationRepository(db *gorm.DB) NotificationRepository {
 func (r *NotificationRepositoryImpl) CreateFromRequest(
 	ctx context.Context,
 	event eventcontract.EventEnvelope[coreeventscontract.NotificationRequestedData],
+	inApp bool,
+	deliveries []schemas.NotificationDelivery,
 ) error {
 	if r == nil || r.db == nil {
 		return errors.New("notification repository database is required")
@@ -75,6 +149,13 @@ func (r *NotificationRepositoryImpl) CreateFromRequest(
 		if !errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return result.Error
 		}
+		if err := saveNotificationRecipient(tx, event.Data.RecipientUserPublicId, event.Data.Recipient); err != nil {
+			return err
+		}
+
+		if !inApp {
+			return createDeliveries(tx, deliveries)
+		}
 
 		notification := schemas.Notification{
 			Id:                    uuid.New(),
@@ -95,6 +176,9 @@ func (r *NotificationRepositoryImpl) CreateFromRequest(
 		if result.RowsAffected == 0 {
 			return nil
 		}
+		if err := createDeliveries(tx, deliveries); err != nil {
+			return err
+		}
 
 		createdData := notificationeventscontract.NotificationCreatedData{
 			NotificationId:        notification.Id,
@@ -120,35 +204,79 @@ func (r *NotificationRepositoryImpl) CreateFromRequest(
 			Trace:         event.Trace,
 			Data:          createdData,
 		}
-		payload, err := json.Marshal(createdEvent)
-		if err != nil {
-			return err
-		}
-		metadata, err := json.Marshal(map[string]any{
-			"schemaVersion": eventcontract.Version,
-			"correlationId": event.CorrelationId,
-			"causationId":   event.EventId,
-			"occurredAt":    createdEvent.OccurredAt,
-			"trace":         event.Trace,
-		})
+		outboxEvent, err := newOutboxEvent(createdEvent, notificationeventscontract.NotificationTopic)
 		if err != nil {
 			return err
 		}
 
-		return tx.Create(&schemas.OutboxEvent{
-			Id:            createdEvent.EventId,
-			AggregateType: string(createdEvent.AggregateType),
-			AggregateId:   createdEvent.AggregateId,
-			EventType:     string(createdEvent.EventType),
-			Topic:         notificationeventscontract.NotificationTopic.String(),
-			KafkaKey:      createdEvent.KafkaKey,
-			Payload:       datatypes.JSON(payload),
-			Metadata:      datatypes.JSON(metadata),
-			AvailableAt:   time.Now().UTC(),
-		}).Error
+		return tx.Create(outboxEvent).Error
 	})
 }
 
+// saveNotificationRecipient snapshots the email address and the name of the recipient into the setting, so the email
+// digest can reach the recipient without waiting for an event
+func saveNotificationRecipient(
+	tx *gorm.DB,
+	userPublicId uuid.UUID,
+	recipient *coreeventscontract.NotificationRecipient,
+) error {
+	if recipient == nil {
+		return nil
+	}
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&schemas.NotificationSetting{
+		UserPublicId: userPublicId,
+		UpdatedAt:    time.Now().UTC(),
+	}).Error; err != nil {
+		return err
+	}
+
+	return tx.Model(&schemas.NotificationSetting{}).
+		Where("user_public_id = ?", userPublicId).
+		Updates(map[string]any{
+			"recipient_email": recipient.Email,
+			"recipient_name":  recipient.Name,
+		}).Error
+}
+
+func createDeliveries(tx *gorm.DB, deliveries []schemas.NotificationDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	return tx.Create(&deliveries).Error
+}
+
+func newOutboxEvent[D any](event eventcontract.EventEnvelope[D], topic eventcontract.Topic) (*schemas.OutboxEvent, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+	metadata := map[string]any{
+		"schemaVersion": eventcontract.Version,
+		"correlationId": event.CorrelationId,
+		"occurredAt":    event.OccurredAt,
+		"trace":         event.Trace,
+	}
+	if event.CausationId != nil {
+		metadata["causationId"] = *event.CausationId
+	}
+	encodedMetadata, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schemas.OutboxEvent{
+		Id:            event.EventId,
+		AggregateType: string(event.AggregateType),
+		AggregateId:   event.AggregateId,
+		EventType:     string(event.EventType),
+		Topic:         topic.String(),
+		KafkaKey:      event.KafkaKey,
+		Payload:       datatypes.JSON(payload),
+		Metadata:      datatypes.JSON(encodedMetadata),
+		AvailableAt:   time.Now().UTC(),
+	}, nil
+}
+
 func (r *NotificationRepositoryImpl) List(
 	ctx context.Context,
 	userPublicId uuid.UUID,
@@ -246,6 +374,22 @@ func (r *NotificationRepositoryImpl) DeleteForUser(
 			return result.Error
 		}
 
+		if err := tx.Where("user_public_id = ?", userPublicId).Delete(&schemas.NotificationPreference{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_public_id = ?", userPublicId).Delete(&schemas.NotificationSetting{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("recipient_user_public_id = ?", userPublicId).Delete(&schemas.NotificationDelivery{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_public_id = ?", userPublicId).Delete(&schemas.NotificationPushSubscription{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_public_id = ?", userPublicId).Delete(&schemas.NotificationRoutineTaskFailure{}).Error; err != nil {
+			return err
+		}
+
 		result = tx.Where("recipient_user_public_id = ?", userPublicId).
 			Delete(&schemas.Notification{})
 		deletedCount = result.RowsAffected
@@ -366,3 +510,563 @@ func (r *NotificationRepositoryImpl) DeletePublishedOutbox(
 
 	return result.RowsAffected, result.Error
 }
+
+func (r *NotificationRepositoryImpl) FindPreferences(
+	ctx context.Context,
+	userPublicId uuid.UUID,
+) ([]schemas.NotificationPreference, *schemas.NotificationSetting, error) {
+	var preferences []schemas.NotificationPreference
+	if err := r.db.WithContext(ctx).
+		Where("user_public_id = ?", userPublicId).
+		Order("type ASC").
+		Order("template_key ASC").
+		Find(&preferences).Error; err != nil {
+		return nil, nil, err
+	}
+
+	var settings []schemas.NotificationSetting
+	if err := r.db.WithContext(ctx).
+		Where("user_public_id = ?", userPublicId).
+		Limit(1).
+		Find(&settings).Error; err != nil {
+		return nil, nil, err
+	}
+	if len(settings) == 0 {
+		return preferences, nil, nil
+	}
+
+	return preferences, &settings[0], nil
+}
+
+// ReplacePreferences replaces every override of the recipient, a nil setting keeps the current timezone and digest,
+// and the setting is written with a map so a zero digest hour is not replaced by the column default
+func (r *NotificationRepositoryImpl) ReplacePreferences(
+	ctx context.Context,
+	userPublicId uuid.UUID,
+	setting *schemas.NotificationSetting,
+	preferences []schemas.NotificationPreference,
+) error {
+	if userPublicId == uuid.Nil {
+		return errors.New("user public ID is required")
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now().UTC()
+		if setting != nil {
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&schemas.NotificationSetting{
+				UserPublicId: userPublicId,
+				UpdatedAt:    now,
+			}).Error; err != nil {
+				return err
+			}
+			if err := tx.Model(&schemas.NotificationSetting{}).
+				Where("user_public_id = ?", userPublicId).
+				Updates(map[string]any{
+					"timezone":         setting.Timezone,
+					"digest_frequency": setting.DigestFrequency,
+					"digest_hour":      setting.DigestHour,
+					"digest_weekday":   setting.DigestWeekday,
+					"next_digest_at":   setting.NextDigestAt,
+					"updated_at":       now,
+				}).Error; err != nil {
+				return err
+			}
+			if setting.NextDigestAt == nil {
+				// nobody reads the pending failures of a recipient without a digest
+				if err := tx.Where("user_public_id = ?", userPublicId).
+					Delete(&schemas.NotificationRoutineTaskFailure{}).Error; err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := tx.Where("user_public_id = ?", userPublicId).Delete(&schemas.NotificationPreference{}).Error; err != nil {
+			return err
+		}
+		if len(preferences) == 0 {
+			return nil
+		}
+		for index := range preferences {
+			preferences[index].UserPublicId = userPublicId
+			preferences[index].UpdatedAt = now
+		}
+		return tx.Create(&preferences).Error
+	})
+}
+
+func (r *NotificationRepositoryImpl) ClaimDeliveries(
+	ctx context.Context,
+	workerId string,
+	channel string,
+	batchSize int,
+	claimTimeout time.Duration,
+) ([]schemas.NotificationDelivery, error) {
+	if batchSize <= 0 {
+		return nil, nil
+	}
+
+	var deliveries []schemas.NotificationDelivery
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now().UTC()
+		query := tx.Where("channel = ?", channel).
+			Where("available_at <= ?", now).
+			Where("claimed_at IS NULL OR claimed_at < ?", now.Add(-claimTimeout)).
+			Order("available_at ASC").
+			Order("created_at ASC").
+			Limit(batchSize).
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"})
+		if err := query.Find(&deliveries).Error; err != nil {
+			return err
+		}
+		if len(deliveries) == 0 {
+			return nil
+		}
+
+		ids := make([]uuid.UUID, len(deliveries))
+		for index, delivery := range deliveries {
+			ids[index] = delivery.Id
+		}
+		return tx.Model(&schemas.NotificationDelivery{}).
+			Where("id IN ?", ids).
+			Updates(map[string]any{
+				"claimed_by": workerId,
+				"claimed_at": now,
+			}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// CompleteEmailDeliveries hands the deliveries over to the Email runtime, the email request is enqueued in the same
+// transaction that deletes the deliveries, so a delivery is either still pending or already on its way to the outbox
+func (r *NotificationRepositoryImpl) CompleteEmailDeliveries(
+	ctx context.Context,
+	workerId string,
+	deliveryIds []uuid.UUID,
+	request emaileventscontract.SendNotificationEmailRequestDto,
+) error {
+	if len(deliveryIds) == 0 {
+		return nil
+	}
+	if request.RequestId == uuid.Nil {
+		return errors.New("notification email request ID is required")
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id IN ? AND claimed_by = ?", deliveryIds, workerId).
+			Delete(&schemas.NotificationDelivery{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected != int64(len(deliveryIds)) {
+			return errors.New("notification deliveries are no longer claimed by the worker")
+		}
+
+		outboxEvent, err := newOutboxEvent(eventcontract.EventEnvelope[emaileventscontract.SendNotificationEmailRequestDto]{
+			SchemaVersion: eventcontract.Version,
+			EventId:       uuid.New(),
+			EventType:     emaileventscontract.EventType_EmailRequested,
+			AggregateType: emaileventscontract.AggregateType_EmailRequest,
+			AggregateId:   request.RequestId,
+			KafkaKey:      request.RequestId.String(),
+			OccurredAt:    request.OccurredAt,
+			CorrelationId: request.RequestId.String(),
+			Data:          request,
+		}, emaileventscontract.NotificationEmailRequestTopic)
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(outboxEvent).Error
+	})
+}
+
+func (r *NotificationRepositoryImpl) DiscardDeliveries(
+	ctx context.Context,
+	workerId string,
+	deliveryIds []uuid.UUID,
+) error {
+	if len(deliveryIds) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Where("id IN ? AND claimed_by = ?", deliveryIds, workerId).
+		Delete(&schemas.NotificationDelivery{}).Error
+}
+
+// ReleaseDeliveries gives the deliveries back after a transient channel failure, they are claimed again once they
+// are available, and their attempts tell the dispatcher when to give up
+func (r *NotificationRepositoryImpl) ReleaseDeliveries(
+	ctx context.Context,
+	workerId string,
+	deliveryIds []uuid.UUID,
+	availableAt time.Time,
+) error {
+	if len(deliveryIds) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Model(&schemas.NotificationDelivery{}).
+		Where("id IN ? AND claimed_by = ?", deliveryIds, workerId).
+		Updates(map[string]any{
+			"attempts":     gorm.Expr("attempts + 1"),
+			"available_at": availableAt,
+			"claimed_by":   nil,
+			"claimed_at":   nil,
+		}).Error
+}
+
+// UpsertPushSubscription stores the subscription by its endpoint, a browser profile shared by several users keeps
+// one subscription, so the endpoint moves to the user who registered it last
+func (r *NotificationRepositoryImpl) UpsertPushSubscription(
+	ctx context.Context,
+	subscription *schemas.NotificationPushSubscription,
+) error {
+	if subscription == nil || subscription.UserPublicId == uuid.Nil || subscription.Endpoint == "" {
+		return errors.New("push subscription is incomplete")
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var userDeletion schemas.UserDeletion
+		result := tx.Where("user_public_id = ?", subscription.UserPublicId).First(&userDeletion)
+		if result.Error == nil {
+			return errors.New("push subscription belongs to a deleted user")
+		}
+		if !errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return result.Error
+		}
+
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "endpoint"}},
+			DoUpdates: clause.AssignmentColumns([]string{"user_public_id", "p256dh", "auth", "expires_at", "updated_at"}),
+		}).Create(subscription).Error; err != nil {
+			return err
+		}
+
+		var stored schemas.NotificationPushSubscription
+		if err := tx.Where("endpoint = ?", subscription.Endpoint).First(&stored).Error; err != nil {
+			return err
+		}
+		*subscription = stored
+
+		return nil
+	})
+}
+
+func (r *NotificationRepositoryImpl) DeletePushSubscription(
+	ctx context.Context,
+	userPublicId uuid.UUID,
+	endpoint string,
+) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("user_public_id = ? AND endpoint = ?", userPublicId, endpoint).
+		Delete(&schemas.NotificationPushSubscription{})
+
+	return result.RowsAffected, result.Error
+}
+
+func (r *NotificationRepositoryImpl) DeletePushSubscriptionsByIds(
+	ctx context.Context,
+	subscriptionIds []uuid.UUID,
+) error {
+	if len(subscriptionIds) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Where("id IN ?", subscriptionIds).
+		Delete(&schemas.NotificationPushSubscription{}).Error
+}
+
+func (r *NotificationRepositoryImpl) HasPushSubscription(
+	ctx context.Context,
+	userPublicId uuid.UUID,
+	now time.Time,
+) (bool, error) {
+	var subscriptions []schemas.NotificationPushSubscription
+	if err := r.db.WithContext(ctx).
+		Select("id").
+		Where("user_public_id = ?", userPublicId).
+		Where("expires_at IS NULL OR expires_at > ?", now).
+		Limit(1).
+		Find(&subscriptions).Error; err != nil {
+		return false, err
+	}
+
+	return len(subscriptions) > 0, nil
+}
+
+func (r *NotificationRepositoryImpl) FindPushSubscriptions(
+	ctx context.Context,
+	userPublicIds []uuid.UUID,
+	now time.Time,
+) ([]schemas.NotificationPushSubscription, error) {
+	if len(userPublicIds) == 0 {
+		return nil, nil
+	}
+
+	var subscriptions []schemas.NotificationPushSubscription
+	if err := r.db.WithContext(ctx).
+		Where("user_public_id IN ?", userPublicIds).
+		Where("expires_at IS NULL OR expires_at > ?", now).
+		Order("created_at ASC").
+		Find(&subscriptions).Error; err != nil {
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+// CreateRoutineTaskFailure keeps a failed routine task until the next email digest of the recipient, the failure is
+// dropped when the recipient has no digest, and the inbox and the record ID make a redelivered event a no-op
+func (r *NotificationRepositoryImpl) CreateRoutineTaskFailure(
+	ctx context.Context,
+	event eventcontract.EventEnvelope[coreeventscontract.RoutineTaskFailedData],
+) error {
+	if r == nil || r.db == nil {
+		return errors.New("notification repository database is required")
+	}
+	if event.EventId == uuid.Nil || event.Data.RecipientUserPublicId == uuid.Nil || event.Data.RoutineTaskRecordId == uuid.Nil {
+		return errors.New("routine task failure is incomplete")
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&schemas.InboxEvent{EventId: event.EventId})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		var userDeletion schemas.UserDeletion
+		result = tx.Where("user_public_id = ?", event.Data.RecipientUserPublicId).First(&userDeletion)
+		if result.Error == nil {
+			return nil
+		}
+		if !errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return result.Error
+		}
+		if err := saveNotificationRecipient(tx, event.Data.RecipientUserPublicId, event.Data.Recipient); err != nil {
+			return err
+		}
+
+		var settings []schemas.NotificationSetting
+		if err := tx.Where("user_public_id = ?", event.Data.RecipientUserPublicId).
+			Where("next_digest_at IS NOT NULL").
+			Limit(1).
+			Find(&settings).Error; err != nil {
+			return err
+		}
+		if len(settings) == 0 {
+			return nil
+		}
+
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&schemas.NotificationRoutineTaskFailure{
+			RoutineTaskRecordId: event.Data.RoutineTaskRecordId,
+			UserPublicId:        event.Data.RecipientUserPublicId,
+			RoutineId:           event.Data.RoutineId,
+			RoutineTitle:        event.Data.RoutineTitle,
+			RoutineTaskId:       event.Data.RoutineTaskId,
+			RoutineTaskTitle:    event.Data.RoutineTaskTitle,
+			Purpose:             string(event.Data.Purpose),
+			ErrorCode:           string(event.Data.ErrorCode),
+			FailedAt:            event.Data.FailedAt.UTC(),
+			CreatedAt:           time.Now().UTC(),
+		}).Error
+	})
+}
+
+// UnsubscribeDigest turns the digest off without touching the digest hour and weekday, so subscribing again restores
+// the previous schedule
+func (r *NotificationRepositoryImpl) UnsubscribeDigest(ctx context.Context, userPublicId uuid.UUID, now time.Time) error {
+	if userPublicId == uuid.Nil {
+		return errors.New("user public ID is required")
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&schemas.NotificationSetting{}).
+			Where("user_public_id = ?", userPublicId).
+			Updates(map[string]any{
+				"digest_frequency":  "off",
+				"next_digest_at":    nil,
+				"digest_claimed_by": nil,
+				"digest_claimed_at": nil,
+				"updated_at":        now,
+			}).Error; err != nil {
+			return err
+		}
+
+		return tx.Where("user_public_id = ?", userPublicId).Delete(&schemas.NotificationRoutineTaskFailure{}).Error
+	})
+}
+
+func (r *NotificationRepositoryImpl) ClaimDigests(
+	ctx context.Context,
+	workerId string,
+	batchSize int,
+	claimTimeout time.Duration,
+) ([]schemas.NotificationSetting, error) {
+	if batchSize <= 0 {
+		return nil, nil
+	}
+
+	var settings []schemas.NotificationSetting
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now().UTC()
+		query := tx.Where("next_digest_at IS NOT NULL AND next_digest_at <= ?", now).
+			Where("digest_claimed_at IS NULL OR digest_claimed_at < ?", now.Add(-claimTimeout)).
+			Order("next_digest_at ASC").
+			Limit(batchSize).
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"})
+		if err := query.Find(&settings).Error; err != nil {
+			return err
+		}
+		if len(settings) == 0 {
+			return nil
+		}
+
+		userPublicIds := make([]uuid.UUID, len(settings))
+		for index, setting := range settings {
+			userPublicIds[index] = setting.UserPublicId
+		}
+		return tx.Model(&schemas.NotificationSetting{}).
+			Where("user_public_id IN ?", userPublicIds).
+			Updates(map[string]any{
+				"digest_claimed_by": workerId,
+				"digest_claimed_at": now,
+			}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// FindDigestNotifications lists the latest unread notifications created in the period of a digest, and counts all of
+// them, the notifications which are read, deleted or expired by now are left out
+func (r *NotificationRepositoryImpl) FindDigestNotifications(
+	ctx context.Context,
+	userPublicId uuid.UUID,
+	since time.Time,
+	until time.Time,
+	limit int,
+) ([]schemas.Notification, int64, error) {
+	query := func() *gorm.DB {
+		return r.db.WithContext(ctx).
+			Model(&schemas.Notification{}).
+			Where("recipient_user_public_id = ?", userPublicId).
+			Where("read_at IS NULL AND deleted_at IS NULL").
+			Where("expires_at IS NULL OR expires_at > ?", until).
+			Where("created_at > ? AND created_at <= ?", since, until)
+	}
+
+	var count int64
+	if err := query().Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+	if count == 0 || limit <= 0 {
+		return nil, count, nil
+	}
+	var notifications []schemas.Notification
+	if err := query().
+		Order("created_at DESC").
+		Order("id DESC").
+		Limit(limit).
+		Find(&notifications).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return notifications, count, nil
+}
+
+func (r *NotificationRepositoryImpl) FindRoutineTaskFailures(
+	ctx context.Context,
+	userPublicId uuid.UUID,
+	until time.Time,
+	limit int,
+) ([]schemas.NotificationRoutineTaskFailure, int64, error) {
+	query := func() *gorm.DB {
+		return r.db.WithContext(ctx).
+			Model(&schemas.NotificationRoutineTaskFailure{}).
+			Where("user_public_id = ? AND created_at <= ?", userPublicId, until)
+	}
+
+	var count int64
+	if err := query().Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+	if count == 0 || limit <= 0 {
+		return nil, count, nil
+	}
+	var failures []schemas.NotificationRoutineTaskFailure
+	if err := query().
+		Order("failed_at DESC").
+		Order("routine_task_record_id ASC").
+		Limit(limit).
+		Find(&failures).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return failures, count, nil
+}
+
+// CompleteDigest moves the digest of the recipient to its next period, the reported failures are deleted and the
+// digest email is enqueued in the same transaction, so a digest is either still due or already on its way to the
+// outbox, and a nil request only moves the digest forward since there was nothing to report
+func (r *NotificationRepositoryImpl) CompleteDigest(
+	ctx context.Context,
+	workerId string,
+	userPublicId uuid.UUID,
+	periodEndedAt time.Time,
+	nextDigestAt *time.Time,
+	request *emaileventscontract.SendNotificationDigestEmailRequestDto,
+) error {
+	if request != nil && request.RequestId == uuid.Nil {
+		return errors.New("notification digest email request ID is required")
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&schemas.NotificationSetting{}).
+			Where("user_public_id = ? AND digest_claimed_by = ?", userPublicId, workerId).
+			Updates(map[string]any{
+				"next_digest_at":    nextDigestAt,
+				"last_digest_at":    periodEndedAt,
+				"digest_claimed_by": nil,
+				"digest_claimed_at": nil,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected != 1 {
+			return errors.New("notification digest is no longer claimed by the worker")
+		}
+		if err := tx.Where("user_public_id = ? AND created_at <= ?", userPublicId, periodEndedAt).
+			Delete(&schemas.NotificationRoutineTaskFailure{}).Error; err != nil {
+			return err
+		}
+		if request == nil {
+			return nil
+		}
+
+		outboxEvent, err := newOutboxEvent(eventcontract.EventEnvelope[emaileventscontract.SendNotificationDigestEmailRequestDto]{
+			SchemaVersion: eventcontract.Version,
+			EventId:       uuid.New(),
+			EventType:     emaileventscontract.EventType_EmailRequested,
+			AggregateType: emaileventscontract.AggregateType_EmailRequest,
+			AggregateId:   request.RequestId,
+			KafkaKey:      request.RequestId.String(),
+			OccurredAt:    request.OccurredAt,
+			CorrelationId: request.RequestId.String(),
+			Data:          *request,
+		}, emaileventscontract.NotificationEmailRequestTopic)
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(outboxEvent).Error
+	})
+}
@@ -12,6 +12,8 @@ import (
 	"gorm.io/gorm"
 
 	coreeventscontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/events"
+	emaileventscontract "github.com/HiIamJeff67/notegic-backend/contracts/email/v1/events"
+	notificationscontract "github.com/HiIamJeff67/notegic-backend/contracts/notification/v1/api"
 	notificationtypescontract "github.com/HiIamJeff67/notegic-backend/contracts/notification/v1/types"
 	eventcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/events"
 	schemas "github.com/HiIamJeff67/notegic-backend/internal/notification/data/database/schemas"
@@ -29,7 +31,7 @@ func TestNotificationRepositoryDeleteTombstoneSuppressesDelayedRequests(t *testi
 	repository := NewNotificationRepository(db)
 	userPublicId := uuid.New()
 	firstEvent := newNotificationRequestEvent(t, userPublicId, "first")
-	if err := repository.CreateFromRequest(context.Background(), firstEvent); err != nil {
+	if err := repository.CreateFromRequest(context.Background(), firstEvent, true, nil); err != nil {
 		t.Fatalf("persist first notification: %v", err)
 	}
 
@@ -41,7 +43,7 @@ func TestNotificationRepositoryDeleteTombstoneSuppressesDelayedRequests(t *testi
 		t.Fatalf("deleted notification count = %d, want 1", deletedCount)
 	}
 
-	if err := repository.CreateFromRequest(context.Background(), newNotificationRequestEvent(t, userPublicId, "delayed")); err != nil {
+	if err := repository.CreateFromRequest(context.Background(), newNotificationRequestEvent(t, userPublicId, "delayed"), true, nil); err != nil {
 		t.Fatalf("process delayed notification request: %v", err)
 	}
 
@@ -110,6 +112,248 @@ func TestNotificationRepositoryListUsesCompositeCursor(t *testing.T) {
 	}
 }
 
+func TestNotificationRepositoryHandsEmailDeliveriesToOutbox(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open("file:notification-repository-delivery-test?mode=memory&cache=shared"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open test database: %v", err)
+	}
+	if err := createNotificationRepositoryTestTables(db); err != nil {
+		t.Fatalf("create test database tables: %v", err)
+	}
+
+	repository := NewNotificationRepository(db)
+	userPublicId := uuid.New()
+	delivery := schemas.NotificationDelivery{
+		Id:                    uuid.New(),
+		RecipientUserPublicId: userPublicId,
+		Channel:               notificationscontract.NotificationChannel_Email,
+		Type:                  "news",
+		Priority:              "normal",
+		Title:                 "Release update",
+		Message:               "A new release is available.",
+		RecipientEmail:        "user@example.com",
+		RecipientName:         "user",
+		AvailableAt:           time.Now().UTC().Add(-time.Second),
+		CreatedAt:             time.Now().UTC(),
+	}
+	event := newNotificationRequestEvent(t, userPublicId, "email-only")
+	if err := repository.CreateFromRequest(context.Background(), event, false, []schemas.NotificationDelivery{delivery}); err != nil {
+		t.Fatalf("persist email-only notification: %v", err)
+	}
+	notifications, err := repository.List(context.Background(), userPublicId, nil, nil, 100)
+	if err != nil {
+		t.Fatalf("list notifications: %v", err)
+	}
+	if len(notifications) != 0 {
+		t.Fatalf("in-app notifications = %d, want 0", len(notifications))
+	}
+
+	deliveries, err := repository.ClaimDeliveries(context.Background(), "worker", notificationscontract.NotificationChannel_Email, 10, time.Minute)
+	if err != nil {
+		t.Fatalf("claim deliveries: %v", err)
+	}
+	if len(deliveries) != 1 {
+		t.Fatalf("claimed deliveries = %d, want 1", len(deliveries))
+	}
+	if err := repository.CompleteEmailDeliveries(
+		context.Background(),
+		"other-worker",
+		[]uuid.UUID{delivery.Id},
+		emaileventscontract.SendNotificationEmailRequestDto{RequestId: uuid.New()},
+	); err == nil {
+		t.Fatal("expected another worker not to complete the claimed delivery")
+	}
+	if err := repository.CompleteEmailDeliveries(
+		context.Background(),
+		"worker",
+		[]uuid.UUID{delivery.Id},
+		emaileventscontract.SendNotificationEmailRequestDto{RequestId: uuid.New(), OccurredAt: time.Now().UTC()},
+	); err != nil {
+		t.Fatalf("complete email deliveries: %v", err)
+	}
+
+	var remainingCount int64
+	if err := db.Model(&schemas.NotificationDelivery{}).Count(&remainingCount).Error; err != nil {
+		t.Fatalf("count deliveries: %v", err)
+	}
+	if remainingCount != 0 {
+		t.Fatalf("remaining deliveries = %d, want 0", remainingCount)
+	}
+	var outboxCount int64
+	if err := db.Model(&schemas.OutboxEvent{}).
+		Where("topic = ?", emaileventscontract.NotificationEmailRequestTopic.String()).
+		Count(&outboxCount).Error; err != nil {
+		t.Fatalf("count outbox events: %v", err)
+	}
+	if outboxCount != 1 {
+		t.Fatalf("email outbox events = %d, want 1", outboxCount)
+	}
+}
+
+func TestNotificationRepositoryMovesPushSubscriptionEndpointToLatestUser(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open("file:notification-repository-push-test?mode=memory&cache=shared"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open test database: %v", err)
+	}
+	if err := createNotificationRepositoryTestTables(db); err != nil {
+		t.Fatalf("create test database tables: %v", err)
+	}
+
+	repository := NewNotificationRepository(db)
+	firstUserPublicId, secondUserPublicId := uuid.New(), uuid.New()
+	now := time.Now().UTC()
+	first := schemas.NotificationPushSubscription{
+		Id:           uuid.New(),
+		UserPublicId: firstUserPublicId,
+		Endpoint:     "https://push.example.com/send/shared",
+		P256dh:       "first-key",
+		Auth:         "first-auth",
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if err := repository.UpsertPushSubscription(context.Background(), &first); err != nil {
+		t.Fatalf("register first push subscription: %v", err)
+	}
+	second := schemas.NotificationPushSubscription{
+		Id:           uuid.New(),
+		UserPublicId: secondUserPublicId,
+		Endpoint:     first.Endpoint,
+		P256dh:       "second-key",
+		Auth:         "second-auth",
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if err := repository.UpsertPushSubscription(context.Background(), &second); err != nil {
+		t.Fatalf("register second push subscription: %v", err)
+	}
+	if second.Id != first.Id || second.UserPublicId != secondUserPublicId || second.P256dh != "second-key" {
+		t.Fatalf("unexpected stored push subscription: %#v", second)
+	}
+
+	if hasSubscription, err := repository.HasPushSubscription(context.Background(), firstUserPublicId, now); err != nil || hasSubscription {
+		t.Fatalf("first user has push subscription = %v, %v, want false", hasSubscription, err)
+	}
+	subscriptions, err := repository.FindPushSubscriptions(context.Background(), []uuid.UUID{secondUserPublicId}, now)
+	if err != nil {
+		t.Fatalf("find push subscriptions: %v", err)
+	}
+	if len(subscriptions) != 1 {
+		t.Fatalf("push subscriptions = %d, want 1", len(subscriptions))
+	}
+
+	if deletedCount, err := repository.DeletePushSubscription(context.Background(), firstUserPublicId, first.Endpoint); err != nil || deletedCount != 0 {
+		t.Fatalf("deleted push subscriptions of another user = %d, %v, want 0", deletedCount, err)
+	}
+	if _, err := repository.DeleteForUser(context.Background(), secondUserPublicId); err != nil {
+		t.Fatalf("delete notifications for user: %v", err)
+	}
+	if err := repository.UpsertPushSubscription(context.Background(), &second); err == nil {
+		t.Fatal("expected a deleted user not to register a push subscription")
+	}
+}
+
+func TestNotificationRepositoryKeepsRoutineTaskFailuresUntilDigest(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open("file:notification-repository-digest-test?mode=memory&cache=shared"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open test database: %v", err)
+	}
+	if err := createNotificationRepositoryTestTables(db); err != nil {
+		t.Fatalf("create test database tables: %v", err)
+	}
+
+	repository := NewNotificationRepository(db)
+	userPublicId := uuid.New()
+	failureEvent := newRoutineTaskFailedEvent(userPublicId)
+	if err := repository.CreateRoutineTaskFailure(context.Background(), failureEvent); err != nil {
+		t.Fatalf("consume routine task failure without digest: %v", err)
+	}
+	if _, count, err := repository.FindRoutineTaskFailures(context.Background(), userPublicId, time.Now().UTC(), 20); err != nil || count != 0 {
+		t.Fatalf("failures kept without digest = %d, %v, want 0", count, err)
+	}
+
+	nextDigestAt := time.Now().UTC().Add(-time.Minute)
+	if err := repository.ReplacePreferences(context.Background(), userPublicId, &schemas.NotificationSetting{
+		Timezone:        "UTC",
+		DigestFrequency: notificationscontract.NotificationDigestFrequency_Daily,
+		DigestHour:      0,
+		NextDigestAt:    &nextDigestAt,
+	}, nil); err != nil {
+		t.Fatalf("enable digest: %v", err)
+	}
+	for range 2 {
+		if err := repository.CreateRoutineTaskFailure(context.Background(), newRoutineTaskFailedEvent(userPublicId)); err != nil {
+			t.Fatalf("consume routine task failure: %v", err)
+		}
+	}
+	if err := repository.CreateRoutineTaskFailure(context.Background(), failureEvent); err != nil {
+		t.Fatalf("consume redelivered routine task failure: %v", err)
+	}
+
+	settings, err := repository.ClaimDigests(context.Background(), "worker", 10, time.Minute)
+	if err != nil {
+		t.Fatalf("claim digests: %v", err)
+	}
+	if len(settings) != 1 || settings[0].RecipientEmail != "owner@example.com" || settings[0].DigestHour != 0 {
+		t.Fatalf("unexpected claimed digests: %#v", settings)
+	}
+	periodEndedAt := time.Now().UTC()
+	failures, count, err := repository.FindRoutineTaskFailures(context.Background(), userPublicId, periodEndedAt, 1)
+	if err != nil || count != 2 || len(failures) != 1 {
+		t.Fatalf("failures = %d of %d, %v, want 1 of 2", len(failures), count, err)
+	}
+
+	followingDigestAt := periodEndedAt.Add(24 * time.Hour)
+	request := &emaileventscontract.SendNotificationDigestEmailRequestDto{RequestId: uuid.New(), OccurredAt: periodEndedAt}
+	if err := repository.CompleteDigest(context.Background(), "other-worker", userPublicId, periodEndedAt, &followingDigestAt, request); err == nil {
+		t.Fatal("expected another worker not to complete the claimed digest")
+	}
+	if err := repository.CompleteDigest(context.Background(), "worker", userPublicId, periodEndedAt, &followingDigestAt, request); err != nil {
+		t.Fatalf("complete digest: %v", err)
+	}
+	if _, count, err := repository.FindRoutineTaskFailures(context.Background(), userPublicId, time.Now().UTC(), 20); err != nil || count != 0 {
+		t.Fatalf("failures after digest = %d, %v, want 0", count, err)
+	}
+	if settings, err := repository.ClaimDigests(context.Background(), "worker", 10, time.Minute); err != nil || len(settings) != 0 {
+		t.Fatalf("digests claimed before their next period = %d, %v, want 0", len(settings), err)
+	}
+	var outboxCount int64
+	if err := db.Model(&schemas.OutboxEvent{}).
+		Where("topic = ?", emaileventscontract.NotificationEmailRequestTopic.String()).
+		Count(&outboxCount).Error; err != nil {
+		t.Fatalf("count outbox events: %v", err)
+	}
+	if outboxCount != 1 {
+		t.Fatalf("digest outbox events = %d, want 1", outboxCount)
+	}
+}
+
+func newRoutineTaskFailedEvent(userPublicId uuid.UUID) eventcontract.EventEnvelope[coreeventscontract.RoutineTaskFailedData] {
+	routineTaskId := uuid.New()
+
+	return eventcontract.EventEnvelope[coreeventscontract.RoutineTaskFailedData]{
+		SchemaVersion: eventcontract.Version,
+		EventId:       uuid.New(),
+		EventType:     coreeventscontract.EventType_RoutineTaskFailed,
+		AggregateType: coreeventscontract.AggregateType_RoutineTask,
+		AggregateId:   routineTaskId,
+		KafkaKey:      userPublicId.String(),
+		OccurredAt:    time.Now().UTC(),
+		CorrelationId: "notification-repository-test",
+		Data: coreeventscontract.RoutineTaskFailedData{
+			RecipientUserPublicId: userPublicId,
+			RoutineId:             uuid.New(),
+			RoutineTitle:          "Morning routine",
+			RoutineTaskId:         routineTaskId,
+			RoutineTaskTitle:      "Summarize notes",
+			RoutineTaskRecordId:   uuid.New(),
+			Purpose:               "CreateBlockPack",
+			ErrorCode:             "QuotaExceeded",
+			FailedAt:              time.Now().UTC(),
+			Recipient:             &coreeventscontract.NotificationRecipient{Email: "owner@example.com", Name: "owner"},
+		},
+	}
+}
+
 func createNotificationRepositoryTestTables(db *gorm.DB) error {
 	for _, statement := range []string{
 		`CREATE TABLE NotificationTable (id BLOB PRIMARY KEY, recipient_user_public_id BLOB NOT NULL, type TEXT NOT NULL, priority TEXT NOT NULL, template_key TEXT NOT NULL, template_version INTEGER NOT NULL, payload BLOB NOT NULL, dedupe_key TEXT NOT NULL, created_at DATETIME NOT NULL, read_at DATETIME, deleted_at DATETIME, expires_at DATETIME)`,
@@ -117,6 +361,12 @@ func createNotificationRepositoryTestTables(db *gorm.DB) error {
 		`CREATE TABLE InboxEventTable (event_id BLOB PRIMARY KEY, consumed_at DATETIME NOT NULL)`,
 		`CREATE TABLE OutboxEventTable (id BLOB PRIMARY KEY, aggregate_type TEXT NOT NULL, aggregate_id BLOB NOT NULL, event_type TEXT NOT NULL, topic TEXT NOT NULL, kafka_key TEXT NOT NULL, payload BLOB NOT NULL, metadata BLOB NOT NULL, available_at DATETIME NOT NULL, published_at DATETIME, publish_count INTEGER NOT NULL DEFAULT 0, last_error TEXT, claimed_by TEXT, claimed_at DATETIME, created_at DATETIME NOT NULL)`,
 		`CREATE TABLE UserDeletionTable (user_public_id BLOB PRIMARY KEY, deleted_at DATETIME NOT NULL)`,
+		`CREATE TABLE NotificationPreferenceTable (user_public_id BLOB NOT NULL, type TEXT NOT NULL, template_key TEXT NOT NULL, in_app BOOLEAN NOT NULL, email BOOLEAN NOT NULL, web_push BOOLEAN NOT NULL, email_digest BOOLEAN NOT NULL, updated_at DATETIME NOT NULL, PRIMARY KEY (user_public_id, type, template_key))`,
+		`CREATE TABLE NotificationSettingTable (user_public_id BLOB PRIMARY KEY, timezone TEXT NOT NULL DEFAULT 'UTC', recipient_email TEXT NOT NULL DEFAULT '', recipient_name TEXT NOT NULL DEFAULT '', digest_frequency TEXT NOT NULL DEFAULT 'off', digest_hour INTEGER NOT NULL DEFAULT 8, digest_weekday INTEGER NOT NULL DEFAULT 1, next_digest_at DATETIME, last_digest_at DATETIME, digest_claimed_by TEXT, digest_claimed_at DATETIME, updated_at DATETIME NOT NULL)`,
+		`CREATE TABLE NotificationDeliveryTable (id BLOB PRIMARY KEY, recipient_user_public_id BLOB NOT NULL, channel TEXT NOT NULL, type TEXT NOT NULL, priority TEXT NOT NULL, title TEXT NOT NULL, message TEXT NOT NULL, action_url TEXT NOT NULL DEFAULT '', recipient_email TEXT NOT NULL DEFAULT '', recipient_name TEXT NOT NULL DEFAULT '', digest BOOLEAN NOT NULL DEFAULT false, attempts INTEGER NOT NULL DEFAULT 0, available_at DATETIME NOT NULL, claimed_by TEXT, claimed_at DATETIME, created_at DATETIME NOT NULL)`,
+		`CREATE TABLE NotificationPushSubscriptionTable (id BLOB PRIMARY KEY, user_public_id BLOB NOT NULL, endpoint TEXT NOT NULL, p256dh TEXT NOT NULL, auth TEXT NOT NULL, expires_at DATETIME, created_at DATETIME NOT NULL, updated_at DATETIME NOT NULL)`,
+		`CREATE UNIQUE INDEX notification_push_subscription_endpoint_index ON NotificationPushSubscriptionTable (endpoint)`,
+		`CREATE TABLE NotificationRoutineTaskFailureTable (routine_task_record_id BLOB PRIMARY KEY, user_public_id BLOB NOT NULL, routine_id BLOB NOT NULL, routine_title TEXT NOT NULL, routine_task_id BLOB NOT NULL, routine_task_title TEXT NOT NULL, purpose TEXT NOT NULL, error_code TEXT NOT NULL, failed_at DATETIME NOT NULL, created_at DATETIME NOT NULL)`,
 	} {
 		if err := db.Exec(statement).Error; err != nil {
 			return err
@@ -5,4 +5,9 @@ var MigratingTables = []any{
 	&InboxEvent{},
 	&OutboxEvent{},
 	&UserDeletion{},
+	&NotificationPreference{},
+	&NotificationSetting{},
+	&NotificationDelivery{},
+	&NotificationPushSubscription{},
+	&NotificationRoutineTaskFailure{},
 }
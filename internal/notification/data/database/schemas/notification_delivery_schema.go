@@ -0,0 +1,33 @@
+package schemas
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationDelivery is one pending delivery of a notification on a channel other than in-app, it snapshots the
+// rendered content and the recipient so the delivery does not depend on the notification row, and it is deleted
+// once the delivery is handed over to its channel
+type NotificationDelivery struct {
+	Id                    uuid.UUID  `json:"id" gorm:"column:id;type:uuid;primaryKey;default:gen_random_uuid();"`
+	RecipientUserPublicId uuid.UUID  `json:"recipientUserPublicId" gorm:"column:recipient_user_public_id;type:uuid;not null;index;"`
+	Channel               string     `json:"channel" gorm:"column:channel;type:varchar(32);not null;"`
+	Type                  string     `json:"type" gorm:"column:type;type:varchar(64);not null;"`
+	Priority              string     `json:"priority" gorm:"column:priority;type:varchar(32);not null;"`
+	Title                 string     `json:"title" gorm:"column:title;type:text;not null;"`
+	Message               string     `json:"message" gorm:"column:message;type:text;not null;"`
+	ActionUrl             string     `json:"actionUrl" gorm:"column:action_url;type:text;not null;default:'';"`
+	RecipientEmail        string     `json:"recipientEmail" gorm:"column:recipient_email;type:varchar(320);not null;default:'';"`
+	RecipientName         string     `json:"recipientName" gorm:"column:recipient_name;type:varchar(255);not null;default:'';"`
+	Digest                bool       `json:"digest" gorm:"column:digest;type:boolean;not null;default:false;"`
+	Attempts              int        `json:"attempts" gorm:"column:attempts;type:integer;not null;default:0;"`
+	AvailableAt           time.Time  `json:"availableAt" gorm:"column:available_at;type:timestamptz;not null;index;"`
+	ClaimedBy             *string    `json:"claimedBy" gorm:"column:claimed_by;type:varchar(255);"`
+	ClaimedAt             *time.Time `json:"claimedAt" gorm:"column:claimed_at;type:timestamptz;"`
+	CreatedAt             time.Time  `json:"createdAt" gorm:"column:created_at;type:timestamptz;not null;"`
+}
+
+func (NotificationDelivery) TableName() string {
+	return "NotificationDeliveryTable"
+}
@@ -0,0 +1,24 @@
+package schemas
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationPreference overrides the channels of one notification type, where an empty template key applies to the
+// whole type and a non-empty template key only to the notifications of that template
+type NotificationPreference struct {
+	UserPublicId uuid.UUID `json:"userPublicId" gorm:"column:user_public_id;type:uuid;primaryKey;"`
+	Type         string    `json:"type" gorm:"column:type;type:varchar(64);primaryKey;"`
+	TemplateKey  string    `json:"templateKey" gorm:"column:template_key;type:varchar(128);primaryKey;"`
+	InApp        bool      `json:"inApp" gorm:"column:in_app;type:boolean;not null;"`
+	Email        bool      `json:"email" gorm:"column:email;type:boolean;not null;"`
+	WebPush      bool      `json:"webPush" gorm:"column:web_push;type:boolean;not null;"`
+	EmailDigest  bool      `json:"emailDigest" gorm:"column:email_digest;type:boolean;not null;"`
+	UpdatedAt    time.Time `json:"updatedAt" gorm:"column:updated_at;type:timestamptz;not null;"`
+}
+
+func (NotificationPreference) TableName() string {
+	return "NotificationPreferenceTable"
+}
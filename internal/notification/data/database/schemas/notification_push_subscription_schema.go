@@ -0,0 +1,24 @@
+package schemas
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationPushSubscription is the Web Push subscription of one browser, the endpoint is unique since a browser
+// profile only has one subscription per application server key, so a re-registration moves it to the current user
+type NotificationPushSubscription struct {
+	Id           uuid.UUID  `json:"id" gorm:"column:id;type:uuid;primaryKey;default:gen_random_uuid();"`
+	UserPublicId uuid.UUID  `json:"userPublicId" gorm:"column:user_public_id;type:uuid;not null;index;"`
+	Endpoint     string     `json:"endpoint" gorm:"column:endpoint;type:text;not null;uniqueIndex;"`
+	P256dh       string     `json:"p256dh" gorm:"column:p256dh;type:varchar(128);not null;"`
+	Auth         string     `json:"auth" gorm:"column:auth;type:varchar(64);not null;"`
+	ExpiresAt    *time.Time `json:"expiresAt" gorm:"column:expires_at;type:timestamptz;"`
+	CreatedAt    time.Time  `json:"createdAt" gorm:"column:created_at;type:timestamptz;not null;"`
+	UpdatedAt    time.Time  `json:"updatedAt" gorm:"column:updated_at;type:timestamptz;not null;"`
+}
+
+func (NotificationPushSubscription) TableName() string {
+	return "NotificationPushSubscriptionTable"
+}
@@ -0,0 +1,27 @@
+package schemas
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationRoutineTaskFailure is a failed routine task record waiting for the next email digest of the owner of
+// its station, the record ID keeps a redelivered failure event from being reported twice, and the row is deleted
+// once a digest reported it
+type NotificationRoutineTaskFailure struct {
+	RoutineTaskRecordId uuid.UUID `json:"routineTaskRecordId" gorm:"column:routine_task_record_id;type:uuid;primaryKey;"`
+	UserPublicId        uuid.UUID `json:"userPublicId" gorm:"column:user_public_id;type:uuid;not null;index;"`
+	RoutineId           uuid.UUID `json:"routineId" gorm:"column:routine_id;type:uuid;not null;"`
+	RoutineTitle        string    `json:"routineTitle" gorm:"column:routine_title;type:varchar(128);not null;"`
+	RoutineTaskId       uuid.UUID `json:"routineTaskId" gorm:"column:routine_task_id;type:uuid;not null;"`
+	RoutineTaskTitle    string    `json:"routineTaskTitle" gorm:"column:routine_task_title;type:varchar(128);not null;"`
+	Purpose             string    `json:"purpose" gorm:"column:purpose;type:varchar(64);not null;"`
+	ErrorCode           string    `json:"errorCode" gorm:"column:error_code;type:varchar(64);not null;"`
+	FailedAt            time.Time `json:"failedAt" gorm:"column:failed_at;type:timestamptz;not null;"`
+	CreatedAt           time.Time `json:"createdAt" gorm:"column:created_at;type:timestamptz;not null;"`
+}
+
+func (NotificationRoutineTaskFailure) TableName() string {
+	return "NotificationRoutineTaskFailureTable"
+}
@@ -0,0 +1,29 @@
+package schemas
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationSetting keeps the settings of a recipient which are not bound to a notification type, the recipient
+// email and name are snapshotted from the latest event carrying them, since the email digest has no event to read
+// them from, and the digest is claimed by a worker the same way a delivery is
+type NotificationSetting struct {
+	UserPublicId    uuid.UUID  `json:"userPublicId" gorm:"column:user_public_id;type:uuid;primaryKey;"`
+	Timezone        string     `json:"timezone" gorm:"column:timezone;type:varchar(64);not null;default:'UTC';"`
+	RecipientEmail  string     `json:"recipientEmail" gorm:"column:recipient_email;type:varchar(320);not null;default:'';"`
+	RecipientName   string     `json:"recipientName" gorm:"column:recipient_name;type:varchar(255);not null;default:'';"`
+	DigestFrequency string     `json:"digestFrequency" gorm:"column:digest_frequency;type:varchar(16);not null;default:'off';"`
+	DigestHour      int        `json:"digestHour" gorm:"column:digest_hour;type:integer;not null;default:8;"`
+	DigestWeekday   int        `json:"digestWeekday" gorm:"column:digest_weekday;type:integer;not null;default:1;"`
+	NextDigestAt    *time.Time `json:"nextDigestAt" gorm:"column:next_digest_at;type:timestamptz;index;"`
+	LastDigestAt    *time.Time `json:"lastDigestAt" gorm:"column:last_digest_at;type:timestamptz;"`
+	DigestClaimedBy *string    `json:"digestClaimedBy" gorm:"column:digest_claimed_by;type:varchar(255);"`
+	DigestClaimedAt *time.Time `json:"digestClaimedAt" gorm:"column:digest_claimed_at;type:timestamptz;"`
+	UpdatedAt       time.Time  `json:"updatedAt" gorm:"column:updated_at;type:timestamptz;not null;"`
+}
+
+func (NotificationSetting) TableName() string {
+	return "NotificationSettingTable"
+}
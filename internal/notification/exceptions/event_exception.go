@@ -45,3 +45,11 @@ func (e EventException) InvalidImportantTemplateKey() *exceptions.Exception {
 func (e EventException) UnsupportedType(cause error) *exceptions.Exception {
 	return exceptions.New("UnsupportedNotificationType", e.Domain, "ValidateEvent", "The notification type is unsupported", http.StatusBadRequest).WithOrigin(cause)
 }
+
+func (e EventException) AggregateRoutineTaskMismatch() *exceptions.Exception {
+	return exceptions.New("AggregateRoutineTaskMismatch", e.Domain, "ConsumeEvent", "The routine task failure aggregate does not match its routine task", http.StatusBadRequest)
+}
+
+func (e EventException) InvalidRoutineTaskFailure(cause error) *exceptions.Exception {
+	return exceptions.New("InvalidRoutineTaskFailure", e.Domain, "ValidateEvent", "The routine task failure is invalid", http.StatusBadRequest).WithOrigin(cause)
+}
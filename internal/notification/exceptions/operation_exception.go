@@ -55,3 +55,51 @@ func (e OperationException) DeleteAllForUserFailed(cause error) *exceptions.Exce
 	exception.Retryable = true
 	return exception.WithOrigin(cause)
 }
+
+func (e OperationException) GetPreferencesFailed(cause error) *exceptions.Exception {
+	exception := exceptions.New("GetPreferencesFailed", e.Domain, "GetNotificationPreferences", "Failed to get the notification preferences", http.StatusInternalServerError, true)
+	exception.Retryable = true
+	return exception.WithOrigin(cause)
+}
+
+func (e OperationException) UpdatePreferencesFailed(cause error) *exceptions.Exception {
+	exception := exceptions.New("UpdatePreferencesFailed", e.Domain, "UpdateNotificationPreferences", "Failed to update the notification preferences", http.StatusInternalServerError, true)
+	exception.Retryable = true
+	return exception.WithOrigin(cause)
+}
+
+func (e OperationException) DispatchDeliveriesFailed(cause error) *exceptions.Exception {
+	exception := exceptions.New("DispatchDeliveriesFailed", e.Domain, "DispatchNotificationDeliveries", "Failed to dispatch the notification deliveries", http.StatusInternalServerError, true)
+	exception.Retryable = true
+	return exception.WithOrigin(cause)
+}
+
+func (e OperationException) RegisterPushSubscriptionFailed(cause error) *exceptions.Exception {
+	exception := exceptions.New("RegisterPushSubscriptionFailed", e.Domain, "RegisterNotificationPushSubscription", "Failed to register the push subscription", http.StatusInternalServerError, true)
+	exception.Retryable = true
+	return exception.WithOrigin(cause)
+}
+
+func (e OperationException) UnregisterPushSubscriptionFailed(cause error) *exceptions.Exception {
+	exception := exceptions.New("UnregisterPushSubscriptionFailed", e.Domain, "UnregisterNotificationPushSubscription", "Failed to unregister the push subscription", http.StatusInternalServerError, true)
+	exception.Retryable = true
+	return exception.WithOrigin(cause)
+}
+
+func (e OperationException) CreateRoutineTaskFailureFailed(cause error) *exceptions.Exception {
+	exception := exceptions.New("CreateRoutineTaskFailureFailed", e.Domain, "CreateRoutineTaskFailure", "Failed to keep the routine task failure for the digest", http.StatusInternalServerError, true)
+	exception.Retryable = true
+	return exception.WithOrigin(cause)
+}
+
+func (e OperationException) UnsubscribeDigestFailed(cause error) *exceptions.Exception {
+	exception := exceptions.New("UnsubscribeDigestFailed", e.Domain, "UnsubscribeNotificationDigest", "Failed to unsubscribe from the notification digest", http.StatusInternalServerError, true)
+	exception.Retryable = true
+	return exception.WithOrigin(cause)
+}
+
+func (e OperationException) DispatchDigestsFailed(cause error) *exceptions.Exception {
+	exception := exceptions.New("DispatchDigestsFailed", e.Domain, "DispatchNotificationDigests", "Failed to dispatch the notification digests", http.StatusInternalServerError, true)
+	exception.Retryable = true
+	return exception.WithOrigin(cause)
+}
@@ -9,3 +9,19 @@ func TestOperationCreateFailed(t *testing.T) {
 		t.Fatalf("unexpected operation exception: %#v", exception)
 	}
 }
+
+func TestOperationDispatchDeliveriesFailed(t *testing.T) {
+	cause := &testError{message: "database unavailable"}
+	exception := NewOperationException("Notification").DispatchDeliveriesFailed(cause)
+	if exception.Reason != "DispatchDeliveriesFailed" || !exception.Retryable || exception.Origin() != cause {
+		t.Fatalf("unexpected operation exception: %#v", exception)
+	}
+}
+
+func TestOperationRegisterPushSubscriptionFailed(t *testing.T) {
+	cause := &testError{message: "database unavailable"}
+	exception := NewOperationException("Notification").RegisterPushSubscriptionFailed(cause)
+	if exception.Reason != "RegisterPushSubscriptionFailed" || !exception.Retryable || exception.Origin() != cause {
+		t.Fatalf("unexpected operation exception: %#v", exception)
+	}
+}
@@ -37,3 +37,39 @@ func (e RequestException) InvalidDeleteRequest(cause error) *exceptions.Exceptio
 func (e RequestException) UserRequired() *exceptions.Exception {
 	return exceptions.New("UserPublicIdRequired", e.Domain, "DeleteAllNotificationsForUser", "The user public ID is required", http.StatusBadRequest)
 }
+
+func (e RequestException) InvalidGetPreferencesRequest(cause error) *exceptions.Exception {
+	return exceptions.New("InvalidGetPreferencesRequest", e.Domain, "GetNotificationPreferences", "The get notification preferences request is invalid", http.StatusBadRequest).WithOrigin(cause)
+}
+
+func (e RequestException) InvalidUpdatePreferencesRequest(cause error) *exceptions.Exception {
+	return exceptions.New("InvalidUpdatePreferencesRequest", e.Domain, "UpdateNotificationPreferences", "The update notification preferences request is invalid", http.StatusBadRequest).WithOrigin(cause)
+}
+
+func (e RequestException) DuplicatePreference() *exceptions.Exception {
+	return exceptions.New("DuplicateNotificationPreference", e.Domain, "UpdateNotificationPreferences", "Every notification type and template key can only have one preference", http.StatusBadRequest)
+}
+
+func (e RequestException) InvalidGetPushPublicKeyRequest(cause error) *exceptions.Exception {
+	return exceptions.New("InvalidGetPushPublicKeyRequest", e.Domain, "GetNotificationPushPublicKey", "The get push public key request is invalid", http.StatusBadRequest).WithOrigin(cause)
+}
+
+func (e RequestException) InvalidRegisterPushSubscriptionRequest(cause error) *exceptions.Exception {
+	return exceptions.New("InvalidRegisterPushSubscriptionRequest", e.Domain, "RegisterNotificationPushSubscription", "The register push subscription request is invalid", http.StatusBadRequest).WithOrigin(cause)
+}
+
+func (e RequestException) InvalidUnregisterPushSubscriptionRequest(cause error) *exceptions.Exception {
+	return exceptions.New("InvalidUnregisterPushSubscriptionRequest", e.Domain, "UnregisterNotificationPushSubscription", "The unregister push subscription request is invalid", http.StatusBadRequest).WithOrigin(cause)
+}
+
+func (e RequestException) InvalidPushSubscriptionKeys(cause error) *exceptions.Exception {
+	return exceptions.New("InvalidPushSubscriptionKeys", e.Domain, "RegisterNotificationPushSubscription", "The push subscription keys must be a P-256 public key and a 16-byte authentication secret", http.StatusBadRequest).WithOrigin(cause)
+}
+
+func (e RequestException) PushUnavailable() *exceptions.Exception {
+	return exceptions.New("NotificationPushUnavailable", e.Domain, "ValidateRequest", "Web push notifications are not configured", http.StatusServiceUnavailable)
+}
+
+func (e RequestException) InvalidUnsubscribeDigestRequest(cause error) *exceptions.Exception {
+	return exceptions.New("InvalidUnsubscribeDigestRequest", e.Domain, "UnsubscribeNotificationDigest", "The unsubscribe notification digest request is invalid", http.StatusBadRequest).WithOrigin(cause)
+}
@@ -8,3 +8,17 @@ func TestRequestRecipientRequired(t *testing.T) {
 		t.Fatalf("unexpected request exception: %#v", exception)
 	}
 }
+
+func TestRequestDuplicatePreference(t *testing.T) {
+	exception := NewRequestException("Notification").DuplicatePreference()
+	if exception.Reason != "DuplicateNotificationPreference" {
+		t.Fatalf("unexpected request exception: %#v", exception)
+	}
+}
+
+func TestRequestPushUnavailable(t *testing.T) {
+	exception := NewRequestException("Notification").PushUnavailable()
+	if exception.Reason != "NotificationPushUnavailable" || exception.HTTPStatusCode() != 503 {
+		t.Fatalf("unexpected request exception: %#v", exception)
+	}
+}
@@ -0,0 +1,190 @@
+package services
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	coreeventscontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/events"
+	notificationscontract "github.com/HiIamJeff67/notegic-backend/contracts/notification/v1/api"
+
+	schemas "github.com/HiIamJeff67/notegic-backend/internal/notification/data/database/schemas"
+)
+
+// NotificationContent is the channel independent content of a notification, extracted from its typed payload
+type NotificationContent struct {
+	Title     string
+	Message   string
+	ActionUrl string
+}
+
+type NotificationDeliveryPlan struct {
+	InApp      bool
+	Deliveries []schemas.NotificationDelivery
+}
+
+// defaultNotificationPreferences are the channels of a notification type without any override, every notification
+// stays in the inbox, the warnings and the important notifications are pushed to the browsers of the recipient,
+// and only the important notifications reach the email of the recipient
+var defaultNotificationPreferences = []schemas.NotificationPreference{
+	{Type: string(coreeventscontract.NotificationType_News), InApp: true, Email: false, WebPush: false, EmailDigest: false},
+	{Type: string(coreeventscontract.NotificationType_Warning), InApp: true, Email: false, WebPush: true, EmailDigest: false},
+	{Type: string(coreeventscontract.NotificationType_Important), InApp: true, Email: true, WebPush: true, EmailDigest: false},
+}
+
+// resolveNotificationPreference picks the override of the template key first, then the override of the type,
+// and falls back to the default of the type
+func resolveNotificationPreference(
+	preferences []schemas.NotificationPreference,
+	notificationType string,
+	templateKey string,
+) schemas.NotificationPreference {
+	var typePreference *schemas.NotificationPreference
+	for index := range preferences {
+		preference := &preferences[index]
+		if preference.Type != notificationType {
+			continue
+		}
+		if preference.TemplateKey == templateKey && templateKey != "" {
+			return *preference
+		}
+		if preference.TemplateKey == "" {
+			typePreference = preference
+		}
+	}
+	if typePreference != nil {
+		return *typePreference
+	}
+	for _, preference := range defaultNotificationPreferences {
+		if preference.Type == notificationType {
+			return preference
+		}
+	}
+
+	return schemas.NotificationPreference{Type: notificationType, InApp: true}
+}
+
+// planNotificationDelivery decides the channels of one notification request, the email and the web push are
+// deferred to the end of the quiet hours of the recipient unless the notification is critical, and a digest email is
+// deferred to the next digest boundary so the notifications of the same window are sent together, the web push is
+// only planned when the recipient has a push subscription
+func planNotificationDelivery(
+	data coreeventscontract.NotificationRequestedData,
+	content NotificationContent,
+	preferences []schemas.NotificationPreference,
+	setting *schemas.NotificationSetting,
+	occurredAt time.Time,
+	now time.Time,
+	digestInterval time.Duration,
+	webPushAvailable bool,
+) NotificationDeliveryPlan {
+	preference := resolveNotificationPreference(preferences, string(data.Type), data.TemplateKey)
+	plan := NotificationDeliveryPlan{InApp: preference.InApp}
+
+	location := time.UTC
+	if setting != nil && setting.Timezone != "" {
+		if loadedLocation, err := time.LoadLocation(setting.Timezone); err == nil {
+			location = loadedLocation
+		}
+	}
+	recipient := data.Recipient
+
+	if preference.Email && recipient != nil && recipient.Email != "" {
+		availableAt := now.UTC()
+		digest := preference.EmailDigest && data.Priority != coreeventscontract.NotificationPriority_Critical
+		if digest && digestInterval > 0 {
+			availableAt = availableAt.Truncate(digestInterval).Add(digestInterval)
+		}
+		delivery := newNotificationDelivery(
+			data,
+			content,
+			notificationscontract.NotificationChannel_Email,
+			deferForQuietHours(availableAt, data, location),
+			occurredAt,
+		)
+		delivery.RecipientEmail = recipient.Email
+		delivery.RecipientName = recipient.Name
+		delivery.Digest = digest
+		plan.Deliveries = append(plan.Deliveries, delivery)
+	}
+	if preference.WebPush && webPushAvailable {
+		plan.Deliveries = append(plan.Deliveries, newNotificationDelivery(
+			data,
+			content,
+			notificationscontract.NotificationChannel_WebPush,
+			deferForQuietHours(now.UTC(), data, location),
+			occurredAt,
+		))
+	}
+
+	return plan
+}
+
+func newNotificationDelivery(
+	data coreeventscontract.NotificationRequestedData,
+	content NotificationContent,
+	channel string,
+	availableAt time.Time,
+	occurredAt time.Time,
+) schemas.NotificationDelivery {
+	return schemas.NotificationDelivery{
+		Id:                    uuid.New(),
+		RecipientUserPublicId: data.RecipientUserPublicId,
+		Channel:               channel,
+		Type:                  string(data.Type),
+		Priority:              string(data.Priority),
+		Title:                 content.Title,
+		Message:               content.Message,
+		ActionUrl:             content.ActionUrl,
+		AvailableAt:           availableAt,
+		CreatedAt:             occurredAt.UTC(),
+	}
+}
+
+func deferForQuietHours(
+	availableAt time.Time,
+	data coreeventscontract.NotificationRequestedData,
+	location *time.Location,
+) time.Time {
+	recipient := data.Recipient
+	if recipient == nil || !recipient.QuietMode || data.Priority == coreeventscontract.NotificationPriority_Critical {
+		return availableAt
+	}
+	if quietUntil, quiet := quietHoursEnd(
+		availableAt,
+		location,
+		recipient.QuietModeStartMinute,
+		recipient.QuietModeEndMinute,
+	); quiet {
+		return quietUntil.UTC()
+	}
+
+	return availableAt
+}
+
+// quietHoursEnd reports whether the moment falls in the quiet hours of the location, and when they end,
+// a start minute after the end minute wraps over midnight, and an equal start and end minute disables the quiet hours
+func quietHoursEnd(moment time.Time, location *time.Location, startMinute int64, endMinute int64) (time.Time, bool) {
+	if startMinute == endMinute || startMinute < 0 || endMinute < 0 || startMinute >= 24*60 || endMinute >= 24*60 {
+		return time.Time{}, false
+	}
+
+	local := moment.In(location)
+	minute := int64(local.Hour()*60 + local.Minute())
+	var quiet bool
+	if startMinute < endMinute {
+		quiet = minute >= startMinute && minute < endMinute
+	} else {
+		quiet = minute >= startMinute || minute < endMinute
+	}
+	if !quiet {
+		return time.Time{}, false
+	}
+
+	end := time.Date(local.Year(), local.Month(), local.Day(), int(endMinute/60), int(endMinute%60), 0, 0, location)
+	if minute >= endMinute {
+		end = time.Date(local.Year(), local.Month(), local.Day()+1, int(endMinute/60), int(endMinute%60), 0, 0, location)
+	}
+
+	return end, true
+}
@@ -0,0 +1,144 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	coreeventscontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/events"
+	notificationscontract "github.com/HiIamJeff67/notegic-backend/contracts/notification/v1/api"
+
+	schemas "github.com/HiIamJeff67/notegic-backend/internal/notification/data/database/schemas"
+)
+
+func TestResolveNotificationPreferencePrefersTemplateKeyOverride(t *testing.T) {
+	preferences := []schemas.NotificationPreference{
+		{Type: "news", InApp: true, Email: true},
+		{Type: "news", TemplateKey: "news", InApp: false},
+	}
+
+	if preference := resolveNotificationPreference(preferences, "news", "news"); preference.InApp || preference.Email {
+		t.Fatalf("expected the template key override, got %#v", preference)
+	}
+	if preference := resolveNotificationPreference(preferences, "news", "other"); !preference.Email {
+		t.Fatalf("expected the type override, got %#v", preference)
+	}
+	if preference := resolveNotificationPreference(nil, "important", "important"); !preference.InApp || !preference.Email {
+		t.Fatalf("expected the default of the type, got %#v", preference)
+	}
+}
+
+func TestQuietHoursEnd(t *testing.T) {
+	location, err := time.LoadLocation("Asia/Taipei")
+	if err != nil {
+		t.Skipf("time zone database unavailable: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		moment    time.Time
+		start     int64
+		end       int64
+		wantQuiet bool
+		wantEnd   time.Time
+	}{
+		{name: "before midnight", moment: time.Date(2026, 10, 1, 23, 0, 0, 0, location), start: 1320, end: 480, wantQuiet: true, wantEnd: time.Date(2026, 10, 2, 8, 0, 0, 0, location)},
+		{name: "after midnight", moment: time.Date(2026, 10, 2, 3, 0, 0, 0, location), start: 1320, end: 480, wantQuiet: true, wantEnd: time.Date(2026, 10, 2, 8, 0, 0, 0, location)},
+		{name: "daytime", moment: time.Date(2026, 10, 2, 12, 0, 0, 0, location), start: 1320, end: 480, wantQuiet: false},
+		{name: "same day window", moment: time.Date(2026, 10, 2, 13, 30, 0, 0, location), start: 780, end: 840, wantQuiet: true, wantEnd: time.Date(2026, 10, 2, 14, 0, 0, 0, location)},
+		{name: "disabled window", moment: time.Date(2026, 10, 2, 13, 30, 0, 0, location), start: 600, end: 600, wantQuiet: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			end, quiet := quietHoursEnd(test.moment.UTC(), location, test.start, test.end)
+			if quiet != test.wantQuiet {
+				t.Fatalf("quiet = %v, want %v", quiet, test.wantQuiet)
+			}
+			if quiet && !end.Equal(test.wantEnd) {
+				t.Fatalf("end = %s, want %s", end, test.wantEnd)
+			}
+		})
+	}
+}
+
+func TestPlanNotificationDeliveryDefersEmail(t *testing.T) {
+	now := time.Date(2026, 10, 1, 23, 10, 0, 0, time.UTC)
+	data := coreeventscontract.NotificationRequestedData{
+		RecipientUserPublicId: uuid.New(),
+		Type:                  coreeventscontract.NotificationType_Important,
+		Priority:              coreeventscontract.NotificationPriority_High,
+		TemplateKey:           "important",
+		Recipient: &coreeventscontract.NotificationRecipient{
+			Email:                "user@example.com",
+			Name:                 "user",
+			QuietMode:            true,
+			QuietModeStartMinute: 1320,
+			QuietModeEndMinute:   480,
+		},
+	}
+	content := NotificationContent{Title: "Title", Message: "Message"}
+
+	plan := planNotificationDelivery(data, content, nil, nil, now, now, time.Hour, false)
+	if !plan.InApp || len(plan.Deliveries) != 1 {
+		t.Fatalf("unexpected plan: %#v", plan)
+	}
+	if want := time.Date(2026, 10, 2, 8, 0, 0, 0, time.UTC); !plan.Deliveries[0].AvailableAt.Equal(want) {
+		t.Fatalf("available at = %s, want the end of the quiet hours %s", plan.Deliveries[0].AvailableAt, want)
+	}
+
+	data.Priority = coreeventscontract.NotificationPriority_Critical
+	plan = planNotificationDelivery(data, content, nil, nil, now, now, time.Hour, false)
+	if !plan.Deliveries[0].AvailableAt.Equal(now) {
+		t.Fatalf("available at = %s, want critical notifications to bypass the quiet hours", plan.Deliveries[0].AvailableAt)
+	}
+
+	data.Priority = coreeventscontract.NotificationPriority_Normal
+	data.Recipient.QuietMode = false
+	digestPreferences := []schemas.NotificationPreference{{Type: "important", InApp: false, Email: true, EmailDigest: true}}
+	plan = planNotificationDelivery(data, content, digestPreferences, nil, now, now, time.Hour, false)
+	if plan.InApp || !plan.Deliveries[0].Digest {
+		t.Fatalf("unexpected digest plan: %#v", plan)
+	}
+	if want := time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC); !plan.Deliveries[0].AvailableAt.Equal(want) {
+		t.Fatalf("available at = %s, want the next digest boundary %s", plan.Deliveries[0].AvailableAt, want)
+	}
+
+	data.Recipient = nil
+	plan = planNotificationDelivery(data, content, nil, nil, now, now, time.Hour, false)
+	if len(plan.Deliveries) != 0 {
+		t.Fatalf("expected no email without a recipient snapshot, got %#v", plan.Deliveries)
+	}
+}
+
+func TestPlanNotificationDeliveryAddsWebPushForSubscribedRecipient(t *testing.T) {
+	now := time.Date(2026, 10, 1, 23, 10, 0, 0, time.UTC)
+	data := coreeventscontract.NotificationRequestedData{
+		RecipientUserPublicId: uuid.New(),
+		Type:                  coreeventscontract.NotificationType_Warning,
+		Priority:              coreeventscontract.NotificationPriority_High,
+		TemplateKey:           "warning",
+		Recipient: &coreeventscontract.NotificationRecipient{
+			QuietMode:            true,
+			QuietModeStartMinute: 1320,
+			QuietModeEndMinute:   480,
+		},
+	}
+	content := NotificationContent{Title: "Title", Message: "Message"}
+
+	if plan := planNotificationDelivery(data, content, nil, nil, now, now, time.Hour, false); len(plan.Deliveries) != 0 {
+		t.Fatalf("expected no web push without a push subscription, got %#v", plan.Deliveries)
+	}
+	plan := planNotificationDelivery(data, content, nil, nil, now, now, time.Hour, true)
+	if len(plan.Deliveries) != 1 || plan.Deliveries[0].Channel != notificationscontract.NotificationChannel_WebPush {
+		t.Fatalf("unexpected plan: %#v", plan)
+	}
+	if want := time.Date(2026, 10, 2, 8, 0, 0, 0, time.UTC); !plan.Deliveries[0].AvailableAt.Equal(want) {
+		t.Fatalf("available at = %s, want the end of the quiet hours %s", plan.Deliveries[0].AvailableAt, want)
+	}
+
+	mutedPreferences := []schemas.NotificationPreference{{Type: "warning", InApp: true, WebPush: false}}
+	if plan := planNotificationDelivery(data, content, mutedPreferences, nil, now, now, time.Hour, true); len(plan.Deliveries) != 0 {
+		t.Fatalf("expected the preference to disable the web push, got %#v", plan.Deliveries)
+	}
+}
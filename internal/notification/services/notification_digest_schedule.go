@@ -0,0 +1,70 @@
+package services
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	notificationscontract "github.com/HiIamJeff67/notegic-backend/contracts/notification/v1/api"
+
+	schemas "github.com/HiIamJeff67/notegic-backend/internal/notification/data/database/schemas"
+)
+
+// the defaults match the column defaults of the setting, so a recipient without a setting row reads the same
+// digest schedule as a recipient whose row was created by an event
+const (
+	defaultNotificationDigestHour    = 8
+	defaultNotificationDigestWeekday = int(time.Monday)
+)
+
+func newDefaultNotificationSetting(userPublicId uuid.UUID) schemas.NotificationSetting {
+	return schemas.NotificationSetting{
+		UserPublicId:    userPublicId,
+		Timezone:        "UTC",
+		DigestFrequency: notificationscontract.NotificationDigestFrequency_Off,
+		DigestHour:      defaultNotificationDigestHour,
+		DigestWeekday:   defaultNotificationDigestWeekday,
+	}
+}
+
+// nextNotificationDigestAt is the first digest hour strictly after the moment in the timezone of the recipient, it is
+// nil when the digest is off, and the wall clock is kept across the daylight saving time changes since time.Date
+// normalizes an hour skipped by the change to the hour after it
+func nextNotificationDigestAt(after time.Time, setting schemas.NotificationSetting) *time.Time {
+	if setting.DigestHour < 0 || setting.DigestHour > 23 || setting.DigestWeekday < 0 || setting.DigestWeekday > 6 {
+		return nil
+	}
+	location := time.UTC
+	if setting.Timezone != "" {
+		if loadedLocation, err := time.LoadLocation(setting.Timezone); err == nil {
+			location = loadedLocation
+		}
+	}
+
+	local := after.In(location)
+	var dayOffset, dayStep int
+	switch setting.DigestFrequency {
+	case notificationscontract.NotificationDigestFrequency_Daily:
+		dayOffset, dayStep = 0, 1
+	case notificationscontract.NotificationDigestFrequency_Weekly:
+		dayOffset, dayStep = (setting.DigestWeekday-int(local.Weekday())+7)%7, 7
+	default:
+		return nil
+	}
+	next := time.Date(local.Year(), local.Month(), local.Day()+dayOffset, setting.DigestHour, 0, 0, 0, location)
+	if !next.After(after) {
+		next = time.Date(local.Year(), local.Month(), local.Day()+dayOffset+dayStep, setting.DigestHour, 0, 0, 0, location)
+	}
+	next = next.UTC()
+
+	return &next
+}
+
+// notificationDigestPeriod is the period covered by the first digest of a recipient, the later digests cover
+// everything since the previous one
+func notificationDigestPeriod(frequency string) time.Duration {
+	if frequency == notificationscontract.NotificationDigestFrequency_Weekly {
+		return 7 * 24 * time.Hour
+	}
+	return 24 * time.Hour
+}
@@ -0,0 +1,72 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	notificationscontract "github.com/HiIamJeff67/notegic-backend/contracts/notification/v1/api"
+
+	schemas "github.com/HiIamJeff67/notegic-backend/internal/notification/data/database/schemas"
+)
+
+func TestNextNotificationDigestAtFollowsRecipientTimezone(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("load timezone: %v", err)
+	}
+
+	for _, testCase := range []struct {
+		name    string
+		after   time.Time
+		setting schemas.NotificationSetting
+		want    *time.Time
+	}{
+		{
+			name:    "daily before the hour",
+			after:   time.Date(2026, time.March, 2, 7, 30, 0, 0, newYork),
+			setting: schemas.NotificationSetting{Timezone: "America/New_York", DigestFrequency: notificationscontract.NotificationDigestFrequency_Daily, DigestHour: 8},
+			want:    timePointer(time.Date(2026, time.March, 2, 8, 0, 0, 0, newYork)),
+		},
+		{
+			name:    "daily at the hour",
+			after:   time.Date(2026, time.March, 2, 8, 0, 0, 0, newYork),
+			setting: schemas.NotificationSetting{Timezone: "America/New_York", DigestFrequency: notificationscontract.NotificationDigestFrequency_Daily, DigestHour: 8},
+			want:    timePointer(time.Date(2026, time.March, 3, 8, 0, 0, 0, newYork)),
+		},
+		{
+			name:    "daily over the daylight saving time change",
+			after:   time.Date(2026, time.March, 7, 9, 0, 0, 0, newYork),
+			setting: schemas.NotificationSetting{Timezone: "America/New_York", DigestFrequency: notificationscontract.NotificationDigestFrequency_Daily, DigestHour: 8},
+			want:    timePointer(time.Date(2026, time.March, 8, 8, 0, 0, 0, newYork)),
+		},
+		{
+			name:    "weekly later in the week",
+			after:   time.Date(2026, time.March, 2, 9, 0, 0, 0, newYork),
+			setting: schemas.NotificationSetting{Timezone: "America/New_York", DigestFrequency: notificationscontract.NotificationDigestFrequency_Weekly, DigestHour: 8, DigestWeekday: int(time.Friday)},
+			want:    timePointer(time.Date(2026, time.March, 6, 8, 0, 0, 0, newYork)),
+		},
+		{
+			name:    "weekly after the hour of the same weekday",
+			after:   time.Date(2026, time.March, 2, 9, 0, 0, 0, newYork),
+			setting: schemas.NotificationSetting{Timezone: "America/New_York", DigestFrequency: notificationscontract.NotificationDigestFrequency_Weekly, DigestHour: 8, DigestWeekday: int(time.Monday)},
+			want:    timePointer(time.Date(2026, time.March, 9, 8, 0, 0, 0, newYork)),
+		},
+		{
+			name:    "off",
+			after:   time.Date(2026, time.March, 2, 9, 0, 0, 0, newYork),
+			setting: schemas.NotificationSetting{Timezone: "America/New_York", DigestFrequency: notificationscontract.NotificationDigestFrequency_Off, DigestHour: 8},
+			want:    nil,
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := nextNotificationDigestAt(testCase.after.UTC(), testCase.setting)
+			if (got == nil) != (testCase.want == nil) || (got != nil && !got.Equal(*testCase.want)) {
+				t.Fatalf("next digest at = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func timePointer(value time.Time) *time.Time {
+	return &value
+}
@@ -2,22 +2,32 @@ package services
 
 import (
 	"context"
+	"crypto/ecdh"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/url"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 
 	coreeventscontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/events"
+	emailcontract "github.com/HiIamJeff67/notegic-backend/contracts/email/v1"
+	emaileventscontract "github.com/HiIamJeff67/notegic-backend/contracts/email/v1/events"
 	notificationscontract "github.com/HiIamJeff67/notegic-backend/contracts/notification/v1/api"
 	notificationtypescontract "github.com/HiIamJeff67/notegic-backend/contracts/notification/v1/types"
 	eventcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/events"
 	searchcursor "github.com/HiIamJeff67/notegic-backend/shared/lib/searchcursor"
+	sharedtokens "github.com/HiIamJeff67/notegic-backend/shared/tokens"
 
 	repositories "github.com/HiIamJeff67/notegic-backend/internal/notification/data/database/repositories"
+	schemas "github.com/HiIamJeff67/notegic-backend/internal/notification/data/database/schemas"
 	notificationexceptions "github.com/HiIamJeff67/notegic-backend/internal/notification/exceptions"
+	webpush "github.com/HiIamJeff67/notegic-backend/internal/notification/transports/webpush"
 )
 
 type NotificationServiceInterface interface {
@@ -43,20 +53,62 @@ type NotificationServiceInterface interface {
 	) (*notificationscontract.DeleteNotificationsResponseDto, error)
 	HardDeleteExpiredNotifications(ctx context.Context, now time.Time, retention time.Duration) (int64, error)
 	DeleteAllNotificationsForUser(ctx context.Context, userPublicId uuid.UUID) error
+	GetMyNotificationPreferences(
+		ctx context.Context,
+		request *notificationscontract.GetNotificationPreferencesRequestDto,
+	) (*notificationscontract.GetNotificationPreferencesResponseDto, error)
+	UpdateMyNotificationPreferences(
+		ctx context.Context,
+		request *notificationscontract.UpdateNotificationPreferencesRequestDto,
+	) (*notificationscontract.UpdateNotificationPreferencesResponseDto, error)
+	DispatchEmailDeliveries(ctx context.Context, workerId string, batchSize int, claimTimeout time.Duration) (int, error)
+	GetMyPushPublicKey(
+		ctx context.Context,
+		request *notificationscontract.GetPushPublicKeyRequestDto,
+	) (*notificationscontract.GetPushPublicKeyResponseDto, error)
+	RegisterMyPushSubscription(
+		ctx context.Context,
+		request *notificationscontract.RegisterPushSubscriptionRequestDto,
+	) (*notificationscontract.RegisterPushSubscriptionResponseDto, error)
+	UnregisterMyPushSubscription(
+		ctx context.Context,
+		request *notificationscontract.UnregisterPushSubscriptionRequestDto,
+	) (*notificationscontract.UnregisterPushSubscriptionResponseDto, error)
+	DispatchPushDeliveries(ctx context.Context, workerId string, batchSize int, claimTimeout time.Duration) (int, error)
+	ConsumeRoutineTaskFailed(
+		ctx context.Context,
+		event eventcontract.EventEnvelope[coreeventscontract.RoutineTaskFailedData],
+	) error
+	UnsubscribeMyNotificationDigest(
+		ctx context.Context,
+		request *notificationscontract.UnsubscribeNotificationDigestRequestDto,
+	) (*notificationscontract.UnsubscribeNotificationDigestResponseDto, error)
+	DispatchEmailDigests(ctx context.Context, workerId string, batchSize int, claimTimeout time.Duration) (int, error)
 }
 
 type NotificationService struct {
-	repository repositories.NotificationRepository
-	validator  *validator.Validate
+	repository          repositories.NotificationRepository
+	validator           *validator.Validate
+	emailDigestInterval time.Duration
+	// pushClient is nil when web push is not configured
+	pushClient webpush.ClientInterface
+	// digestUnsubscribeUrl receives the one-click unsubscription of a digest email with its token in the query
+	digestUnsubscribeUrl string
 }
 
 func NewNotificationService(
 	repository repositories.NotificationRepository,
 	notificationValidator *validator.Validate,
+	emailDigestInterval time.Duration,
+	pushClient webpush.ClientInterface,
+	digestUnsubscribeUrl string,
 ) NotificationServiceInterface {
 	return &NotificationService{
-		repository: repository,
-		validator:  notificationValidator,
+		repository:           repository,
+		validator:            notificationValidator,
+		emailDigestInterval:  emailDigestInterval,
+		pushClient:           pushClient,
+		digestUnsubscribeUrl: digestUnsubscribeUrl,
 	}
 }
 
@@ -84,50 +136,87 @@ func (s *NotificationService) ConsumeNotificationRequested(
 			fmt.Errorf("version: %d", event.Data.TemplateVersion),
 		)
 	}
-	switch event.Data.Type {
+	content, err := s.decodeNotificationContent(string(event.Data.Type), event.Data.TemplateKey, event.Data.Payload)
+	if err != nil {
+		return err
+	}
+
+	preferences, setting, err := s.repository.FindPreferences(ctx, event.Data.RecipientUserPublicId)
+	if err != nil {
+		return notificationexceptions.NewOperationException("Notification").CreateFailed(err)
+	}
+	now := time.Now().UTC()
+	webPushAvailable := false
+	if s.pushClient != nil {
+		webPushAvailable, err = s.repository.HasPushSubscription(ctx, event.Data.RecipientUserPublicId, now)
+		if err != nil {
+			return notificationexceptions.NewOperationException("Notification").CreateFailed(err)
+		}
+	}
+	plan := planNotificationDelivery(
+		event.Data,
+		content,
+		preferences,
+		setting,
+		event.OccurredAt,
+		now,
+		s.emailDigestInterval,
+		webPushAvailable,
+	)
+	if err := s.repository.CreateFromRequest(ctx, event, plan.InApp, plan.Deliveries); err != nil {
+		return notificationexceptions.NewOperationException("Notification").CreateFailed(err)
+	}
+	return nil
+}
+
+// decodeNotificationContent validates the typed payload of a notification and extracts its channel independent content
+func (s *NotificationService) decodeNotificationContent(
+	notificationType string,
+	templateKey string,
+	encodedPayload []byte,
+) (NotificationContent, error) {
+	switch coreeventscontract.NotificationType(notificationType) {
 	case coreeventscontract.NotificationType_News:
-		if event.Data.TemplateKey != notificationtypescontract.TemplateKey_News {
-			return notificationexceptions.NewEventException("Notification").InvalidNewsTemplateKey()
+		if templateKey != notificationtypescontract.TemplateKey_News {
+			return NotificationContent{}, notificationexceptions.NewEventException("Notification").InvalidNewsTemplateKey()
 		}
 		var payload notificationtypescontract.NewsPayload
-		if err := json.Unmarshal(event.Data.Payload, &payload); err != nil {
-			return notificationexceptions.NewPayloadException("Notification").PayloadDecodeFailed(err)
+		if err := json.Unmarshal(encodedPayload, &payload); err != nil {
+			return NotificationContent{}, notificationexceptions.NewPayloadException("Notification").PayloadDecodeFailed(err)
 		}
 		if err := s.validator.Struct(payload); err != nil {
-			return notificationexceptions.NewPayloadException("Notification").InvalidNewsPayload(err)
+			return NotificationContent{}, notificationexceptions.NewPayloadException("Notification").InvalidNewsPayload(err)
 		}
+		return NotificationContent{Title: payload.Title, Message: payload.Summary, ActionUrl: payload.ActionUrl}, nil
 	case coreeventscontract.NotificationType_Warning:
-		if event.Data.TemplateKey != notificationtypescontract.TemplateKey_Warning {
-			return notificationexceptions.NewEventException("Notification").InvalidWarningTemplateKey()
+		if templateKey != notificationtypescontract.TemplateKey_Warning {
+			return NotificationContent{}, notificationexceptions.NewEventException("Notification").InvalidWarningTemplateKey()
 		}
 		var payload notificationtypescontract.WarningPayload
-		if err := json.Unmarshal(event.Data.Payload, &payload); err != nil {
-			return notificationexceptions.NewPayloadException("Notification").PayloadDecodeFailed(err)
+		if err := json.Unmarshal(encodedPayload, &payload); err != nil {
+			return NotificationContent{}, notificationexceptions.NewPayloadException("Notification").PayloadDecodeFailed(err)
 		}
 		if err := s.validator.Struct(payload); err != nil {
-			return notificationexceptions.NewPayloadException("Notification").InvalidWarningPayload(err)
+			return NotificationContent{}, notificationexceptions.NewPayloadException("Notification").InvalidWarningPayload(err)
 		}
+		return NotificationContent{Title: payload.Title, Message: payload.Message}, nil
 	case coreeventscontract.NotificationType_Important:
-		if event.Data.TemplateKey != notificationtypescontract.TemplateKey_Important {
-			return notificationexceptions.NewEventException("Notification").InvalidImportantTemplateKey()
+		if templateKey != notificationtypescontract.TemplateKey_Important {
+			return NotificationContent{}, notificationexceptions.NewEventException("Notification").InvalidImportantTemplateKey()
 		}
 		var payload notificationtypescontract.ImportantPayload
-		if err := json.Unmarshal(event.Data.Payload, &payload); err != nil {
-			return notificationexceptions.NewPayloadException("Notification").PayloadDecodeFailed(err)
+		if err := json.Unmarshal(encodedPayload, &payload); err != nil {
+			return NotificationContent{}, notificationexceptions.NewPayloadException("Notification").PayloadDecodeFailed(err)
 		}
 		if err := s.validator.Struct(payload); err != nil {
-			return notificationexceptions.NewPayloadException("Notification").InvalidImportantPayload(err)
+			return NotificationContent{}, notificationexceptions.NewPayloadException("Notification").InvalidImportantPayload(err)
 		}
+		return NotificationContent{Title: payload.Title, Message: payload.Message, ActionUrl: payload.ActionUrl}, nil
 	default:
-		return notificationexceptions.NewEventException("Notification").UnsupportedType(
-			fmt.Errorf("type: %q", event.Data.Type),
+		return NotificationContent{}, notificationexceptions.NewEventException("Notification").UnsupportedType(
+			fmt.Errorf("type: %q", notificationType),
 		)
 	}
-
-	if err := s.repository.CreateFromRequest(ctx, event); err != nil {
-		return notificationexceptions.NewOperationException("Notification").CreateFailed(err)
-	}
-	return nil
 }
 
 func (s *NotificationService) SearchPrivateNotifications(
@@ -317,3 +406,721 @@ func (s *NotificationService) DeleteAllNotificationsForUser(
 	}
 	return nil
 }
+
+/* ============================== Service Methods for Notification Preference ============================== */
+
+func (s *NotificationService) GetMyNotificationPreferences(
+	ctx context.Context,
+	request *notificationscontract.GetNotificationPreferencesRequestDto,
+) (*notificationscontract.GetNotificationPreferencesResponseDto, error) {
+	if request == nil || request.RecipientUserPublicId == uuid.Nil {
+		return nil, notificationexceptions.NewRequestException("Notification").RecipientRequired()
+	}
+	if err := s.validator.Struct(request); err != nil {
+		return nil, notificationexceptions.NewRequestException("Notification").InvalidGetPreferencesRequest(err)
+	}
+
+	preferences, setting, err := s.repository.FindPreferences(ctx, request.RecipientUserPublicId)
+	if err != nil {
+		return nil, notificationexceptions.NewOperationException("Notification").GetPreferencesFailed(err)
+	}
+
+	return newNotificationPreferencesResponse(preferences, setting), nil
+}
+
+func (s *NotificationService) UpdateMyNotificationPreferences(
+	ctx context.Context,
+	request *notificationscontract.UpdateNotificationPreferencesRequestDto,
+) (*notificationscontract.UpdateNotificationPreferencesResponseDto, error) {
+	if request == nil || request.RecipientUserPublicId == uuid.Nil {
+		return nil, notificationexceptions.NewRequestException("Notification").RecipientRequired()
+	}
+	if err := s.validator.Struct(request); err != nil {
+		return nil, notificationexceptions.NewRequestException("Notification").InvalidUpdatePreferencesRequest(err)
+	}
+
+	type preferenceKey struct {
+		notificationType string
+		templateKey      string
+	}
+	seen := make(map[preferenceKey]bool, len(request.Preferences))
+	preferences := make([]schemas.NotificationPreference, 0, len(request.Preferences))
+	for _, preference := range request.Preferences {
+		key := preferenceKey{notificationType: preference.Type, templateKey: preference.TemplateKey}
+		if seen[key] {
+			return nil, notificationexceptions.NewRequestException("Notification").DuplicatePreference()
+		}
+		seen[key] = true
+		preferences = append(preferences, schemas.NotificationPreference{
+			Type:        preference.Type,
+			TemplateKey: preference.TemplateKey,
+			InApp:       preference.InApp,
+			Email:       preference.Email,
+			WebPush:     preference.WebPush,
+			EmailDigest: preference.EmailDigest,
+		})
+	}
+
+	var updatedSetting *schemas.NotificationSetting
+	if request.Timezone != nil || request.Digest != nil {
+		_, currentSetting, err := s.repository.FindPreferences(ctx, request.RecipientUserPublicId)
+		if err != nil {
+			return nil, notificationexceptions.NewOperationException("Notification").UpdatePreferencesFailed(err)
+		}
+		setting := newDefaultNotificationSetting(request.RecipientUserPublicId)
+		if currentSetting != nil {
+			setting = *currentSetting
+		}
+		if request.Timezone != nil {
+			setting.Timezone = *request.Timezone
+		}
+		if request.Digest != nil {
+			setting.DigestFrequency = request.Digest.Frequency
+			setting.DigestHour = request.Digest.Hour
+			setting.DigestWeekday = request.Digest.Weekday
+		}
+		setting.NextDigestAt = nextNotificationDigestAt(time.Now().UTC(), setting)
+		updatedSetting = &setting
+	}
+
+	if err := s.repository.ReplacePreferences(
+		ctx,
+		request.RecipientUserPublicId,
+		updatedSetting,
+		preferences,
+	); err != nil {
+		return nil, notificationexceptions.NewOperationException("Notification").UpdatePreferencesFailed(err)
+	}
+	updatedPreferences, setting, err := s.repository.FindPreferences(ctx, request.RecipientUserPublicId)
+	if err != nil {
+		return nil, notificationexceptions.NewOperationException("Notification").GetPreferencesFailed(err)
+	}
+
+	return newNotificationPreferencesResponse(updatedPreferences, setting), nil
+}
+
+func newNotificationPreferencesResponse(
+	preferences []schemas.NotificationPreference,
+	setting *schemas.NotificationSetting,
+) *notificationscontract.GetNotificationPreferencesResponseDto {
+	response := &notificationscontract.GetNotificationPreferencesResponseDto{
+		Timezone:    "UTC",
+		Preferences: make([]notificationscontract.NotificationPreferenceDto, len(preferences)),
+		Defaults:    make([]notificationscontract.NotificationPreferenceDto, len(defaultNotificationPreferences)),
+	}
+	if setting != nil && setting.Timezone != "" {
+		response.Timezone = setting.Timezone
+	}
+	response.Digest = newNotificationDigestSettingDto(setting)
+	if setting != nil {
+		response.NextDigestAt = setting.NextDigestAt
+	}
+	for index, preference := range preferences {
+		response.Preferences[index] = newNotificationPreferenceDto(preference)
+	}
+	for index, preference := range defaultNotificationPreferences {
+		response.Defaults[index] = newNotificationPreferenceDto(preference)
+	}
+
+	return response
+}
+
+func newNotificationPreferenceDto(preference schemas.NotificationPreference) notificationscontract.NotificationPreferenceDto {
+	return notificationscontract.NotificationPreferenceDto{
+		Type:        preference.Type,
+		TemplateKey: preference.TemplateKey,
+		InApp:       preference.InApp,
+		Email:       preference.Email,
+		WebPush:     preference.WebPush,
+		EmailDigest: preference.EmailDigest,
+	}
+}
+
+func newNotificationDigestSettingDto(setting *schemas.NotificationSetting) notificationscontract.NotificationDigestSettingDto {
+	if setting == nil {
+		defaultSetting := newDefaultNotificationSetting(uuid.Nil)
+		setting = &defaultSetting
+	}
+
+	return notificationscontract.NotificationDigestSettingDto{
+		Frequency: setting.DigestFrequency,
+		Hour:      setting.DigestHour,
+		Weekday:   setting.DigestWeekday,
+	}
+}
+
+/* ============================== Service Methods for Notification Delivery ============================== */
+
+const maxNotificationsPerEmail = 50
+
+// DispatchEmailDeliveries claims the due email deliveries and hands them over to the Email runtime, the deliveries of
+// the same recipient are merged into one email, so the notifications deferred by quiet hours or digests arrive together
+func (s *NotificationService) DispatchEmailDeliveries(
+	ctx context.Context,
+	workerId string,
+	batchSize int,
+	claimTimeout time.Duration,
+) (int, error) {
+	deliveries, err := s.repository.ClaimDeliveries(
+		ctx,
+		workerId,
+		notificationscontract.NotificationChannel_Email,
+		batchSize,
+		claimTimeout,
+	)
+	if err != nil {
+		return 0, notificationexceptions.NewOperationException("Notification").DispatchDeliveriesFailed(err)
+	}
+
+	type recipientKey struct {
+		userPublicId uuid.UUID
+		email        string
+	}
+	var recipientKeys []recipientKey
+	groups := make(map[recipientKey][]schemas.NotificationDelivery)
+	for _, delivery := range deliveries {
+		key := recipientKey{userPublicId: delivery.RecipientUserPublicId, email: delivery.RecipientEmail}
+		if _, exists := groups[key]; !exists {
+			recipientKeys = append(recipientKeys, key)
+		}
+		groups[key] = append(groups[key], delivery)
+	}
+
+	dispatchedCount := 0
+	var errs []error
+	for _, key := range recipientKeys {
+		group := groups[key]
+		for start := 0; start < len(group); start += maxNotificationsPerEmail {
+			chunk := group[start:min(start+maxNotificationsPerEmail, len(group))]
+			deliveryIds := make([]uuid.UUID, len(chunk))
+			request := emaileventscontract.SendNotificationEmailRequestDto{
+				RequestId:     uuid.New(),
+				Operation:     emailcontract.SendNotificationEmailOperation,
+				OccurredAt:    time.Now().UTC(),
+				To:            key.email,
+				UserName:      chunk[len(chunk)-1].RecipientName,
+				Digest:        len(chunk) > 1,
+				Notifications: make([]emaileventscontract.NotificationEmailItem, len(chunk)),
+			}
+			for index, delivery := range chunk {
+				deliveryIds[index] = delivery.Id
+				request.Digest = request.Digest || delivery.Digest
+				request.Notifications[index] = emaileventscontract.NotificationEmailItem{
+					Type:      delivery.Type,
+					Priority:  delivery.Priority,
+					Title:     delivery.Title,
+					Message:   delivery.Message,
+					ActionUrl: delivery.ActionUrl,
+					CreatedAt: delivery.CreatedAt,
+				}
+			}
+
+			if err := s.validator.Struct(request); err != nil {
+				// an undeliverable email never becomes valid by retrying, so the deliveries are dropped
+				if discardErr := s.repository.DiscardDeliveries(ctx, workerId, deliveryIds); discardErr != nil {
+					errs = append(errs, discardErr)
+				}
+				errs = append(errs, fmt.Errorf("invalid notification email request: %w", err))
+				continue
+			}
+			if err := s.repository.CompleteEmailDeliveries(ctx, workerId, deliveryIds, request); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			dispatchedCount += len(chunk)
+		}
+	}
+	if len(errs) > 0 {
+		return dispatchedCount, notificationexceptions.NewOperationException("Notification").DispatchDeliveriesFailed(errors.Join(errs...))
+	}
+
+	return dispatchedCount, nil
+}
+
+/* ============================== Service Methods for Notification Push Subscription ============================== */
+
+func (s *NotificationService) GetMyPushPublicKey(
+	ctx context.Context,
+	request *notificationscontract.GetPushPublicKeyRequestDto,
+) (*notificationscontract.GetPushPublicKeyResponseDto, error) {
+	if request == nil || request.RecipientUserPublicId == uuid.Nil {
+		return nil, notificationexceptions.NewRequestException("Notification").RecipientRequired()
+	}
+	if err := s.validator.Struct(request); err != nil {
+		return nil, notificationexceptions.NewRequestException("Notification").InvalidGetPushPublicKeyRequest(err)
+	}
+	if s.pushClient == nil {
+		return nil, notificationexceptions.NewRequestException("Notification").PushUnavailable()
+	}
+
+	return &notificationscontract.GetPushPublicKeyResponseDto{PublicKey: s.pushClient.PublicKey()}, nil
+}
+
+func (s *NotificationService) RegisterMyPushSubscription(
+	ctx context.Context,
+	request *notificationscontract.RegisterPushSubscriptionRequestDto,
+) (*notificationscontract.RegisterPushSubscriptionResponseDto, error) {
+	if request == nil || request.RecipientUserPublicId == uuid.Nil {
+		return nil, notificationexceptions.NewRequestException("Notification").RecipientRequired()
+	}
+	if err := s.validator.Struct(request); err != nil {
+		return nil, notificationexceptions.NewRequestException("Notification").InvalidRegisterPushSubscriptionRequest(err)
+	}
+	if s.pushClient == nil {
+		return nil, notificationexceptions.NewRequestException("Notification").PushUnavailable()
+	}
+	if err := validatePushSubscriptionKeys(request.Keys); err != nil {
+		return nil, notificationexceptions.NewRequestException("Notification").InvalidPushSubscriptionKeys(err)
+	}
+
+	now := time.Now().UTC()
+	var expiresAt *time.Time
+	if request.ExpirationTime != nil {
+		expirationTime := time.UnixMilli(*request.ExpirationTime).UTC()
+		if !expirationTime.After(now) {
+			return nil, notificationexceptions.NewRequestException("Notification").InvalidRegisterPushSubscriptionRequest(
+				fmt.Errorf("push subscription expired at %s", expirationTime.Format(time.RFC3339)),
+			)
+		}
+		expiresAt = &expirationTime
+	}
+
+	subscription := &schemas.NotificationPushSubscription{
+		Id:           uuid.New(),
+		UserPublicId: request.RecipientUserPublicId,
+		Endpoint:     request.Endpoint,
+		P256dh:       request.Keys.P256dh,
+		Auth:         request.Keys.Auth,
+		ExpiresAt:    expiresAt,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if err := s.repository.UpsertPushSubscription(ctx, subscription); err != nil {
+		return nil, notificationexceptions.NewOperationException("Notification").RegisterPushSubscriptionFailed(err)
+	}
+
+	return &notificationscontract.RegisterPushSubscriptionResponseDto{
+		Id:        subscription.Id,
+		Endpoint:  subscription.Endpoint,
+		ExpiresAt: subscription.ExpiresAt,
+		CreatedAt: subscription.CreatedAt,
+		UpdatedAt: subscription.UpdatedAt,
+	}, nil
+}
+
+// UnregisterMyPushSubscription stays available without web push configured, so the browsers can always clean up
+func (s *NotificationService) UnregisterMyPushSubscription(
+	ctx context.Context,
+	request *notificationscontract.UnregisterPushSubscriptionRequestDto,
+) (*notificationscontract.UnregisterPushSubscriptionResponseDto, error) {
+	if request == nil || request.RecipientUserPublicId == uuid.Nil {
+		return nil, notificationexceptions.NewRequestException("Notification").RecipientRequired()
+	}
+	if err := s.validator.Struct(request); err != nil {
+		return nil, notificationexceptions.NewRequestException("Notification").InvalidUnregisterPushSubscriptionRequest(err)
+	}
+
+	count, err := s.repository.DeletePushSubscription(ctx, request.RecipientUserPublicId, request.Endpoint)
+	if err != nil {
+		return nil, notificationexceptions.NewOperationException("Notification").UnregisterPushSubscriptionFailed(err)
+	}
+
+	return &notificationscontract.UnregisterPushSubscriptionResponseDto{DeletedCount: count}, nil
+}
+
+func validatePushSubscriptionKeys(keys notificationscontract.PushSubscriptionKeysDto) error {
+	publicKey, err := base64.RawURLEncoding.DecodeString(keys.P256dh)
+	if err != nil {
+		return err
+	}
+	if _, err := ecdh.P256().NewPublicKey(publicKey); err != nil {
+		return err
+	}
+	authSecret, err := base64.RawURLEncoding.DecodeString(keys.Auth)
+	if err != nil {
+		return err
+	}
+	if len(authSecret) != 16 {
+		return fmt.Errorf("authentication secret is %d bytes", len(authSecret))
+	}
+
+	return nil
+}
+
+const (
+	maxPushDeliveryAttempts  = 5
+	pushDeliveryRetryBackoff = time.Minute
+	// maxPushMessageBodyBytes keeps the encrypted message below the 4096 bytes every push service accepts,
+	// the full message is still in the inbox
+	maxPushMessageBodyBytes = 1024
+)
+
+// DispatchPushDeliveries claims the due web push deliveries and pushes each of them to every browser of the
+// recipient, the subscriptions gone at the push service are deleted, and a delivery failed for a transient reason
+// is released with an exponential backoff until it runs out of attempts
+func (s *NotificationService) DispatchPushDeliveries(
+	ctx context.Context,
+	workerId string,
+	batchSize int,
+	claimTimeout time.Duration,
+) (int, error) {
+	deliveries, err := s.repository.ClaimDeliveries(
+		ctx,
+		workerId,
+		notificationscontract.NotificationChannel_WebPush,
+		batchSize,
+		claimTimeout,
+	)
+	if err != nil {
+		return 0, notificationexceptions.NewOperationException("Notification").DispatchDeliveriesFailed(err)
+	}
+	if len(deliveries) == 0 {
+		return 0, nil
+	}
+	if s.pushClient == nil {
+		// web push was turned off after these deliveries were planned, so they can never be sent
+		deliveryIds := make([]uuid.UUID, len(deliveries))
+		for index, delivery := range deliveries {
+			deliveryIds[index] = delivery.Id
+		}
+		if err := s.repository.DiscardDeliveries(ctx, workerId, deliveryIds); err != nil {
+			return 0, notificationexceptions.NewOperationException("Notification").DispatchDeliveriesFailed(err)
+		}
+		return 0, nil
+	}
+
+	now := time.Now().UTC()
+	var userPublicIds []uuid.UUID
+	seenUsers := make(map[uuid.UUID]bool)
+	for _, delivery := range deliveries {
+		if !seenUsers[delivery.RecipientUserPublicId] {
+			seenUsers[delivery.RecipientUserPublicId] = true
+			userPublicIds = append(userPublicIds, delivery.RecipientUserPublicId)
+		}
+	}
+	subscriptions, err := s.repository.FindPushSubscriptions(ctx, userPublicIds, now)
+	if err != nil {
+		return 0, notificationexceptions.NewOperationException("Notification").DispatchDeliveriesFailed(err)
+	}
+	subscriptionsByUser := make(map[uuid.UUID][]schemas.NotificationPushSubscription)
+	for _, subscription := range subscriptions {
+		subscriptionsByUser[subscription.UserPublicId] = append(subscriptionsByUser[subscription.UserPublicId], subscription)
+	}
+
+	dispatchedCount := 0
+	var finishedDeliveryIds []uuid.UUID
+	var goneSubscriptionIds []uuid.UUID
+	goneSubscriptions := make(map[uuid.UUID]bool)
+	var errs []error
+	for _, delivery := range deliveries {
+		payload, err := json.Marshal(notificationscontract.PushNotificationMessageDto{
+			Tag:       delivery.Id.String(),
+			Type:      delivery.Type,
+			Priority:  delivery.Priority,
+			Title:     delivery.Title,
+			Body:      truncateUTF8(delivery.Message, maxPushMessageBodyBytes),
+			Url:       delivery.ActionUrl,
+			CreatedAt: delivery.CreatedAt,
+		})
+		if err != nil {
+			finishedDeliveryIds = append(finishedDeliveryIds, delivery.Id)
+			errs = append(errs, err)
+			continue
+		}
+
+		sent, retry := false, false
+		for _, subscription := range subscriptionsByUser[delivery.RecipientUserPublicId] {
+			if goneSubscriptions[subscription.Id] {
+				continue
+			}
+			err := s.pushClient.Send(ctx, webpush.Subscription{
+				Endpoint: subscription.Endpoint,
+				P256dh:   subscription.P256dh,
+				Auth:     subscription.Auth,
+			}, webpush.Message{
+				Payload: payload,
+				Urgency: pushUrgency(delivery.Priority),
+				Topic:   strings.ReplaceAll(delivery.Id.String(), "-", ""),
+			})
+			if err == nil {
+				sent = true
+				continue
+			}
+			if errors.Is(err, webpush.ErrSubscriptionGone) {
+				goneSubscriptions[subscription.Id] = true
+				goneSubscriptionIds = append(goneSubscriptionIds, subscription.Id)
+				continue
+			}
+			var serviceError *webpush.PushServiceError
+			if !errors.As(err, &serviceError) || serviceError.IsRetryable() {
+				retry = true
+			}
+			errs = append(errs, err)
+		}
+
+		if retry && delivery.Attempts+1 < maxPushDeliveryAttempts {
+			// the browsers which already received the message replace it by its tag when the retry arrives
+			availableAt := now.Add(pushDeliveryRetryBackoff << delivery.Attempts)
+			if err := s.repository.ReleaseDeliveries(ctx, workerId, []uuid.UUID{delivery.Id}, availableAt); err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		finishedDeliveryIds = append(finishedDeliveryIds, delivery.Id)
+		if sent {
+			dispatchedCount++
+		}
+	}
+
+	if err := s.repository.DiscardDeliveries(ctx, workerId, finishedDeliveryIds); err != nil {
+		errs = append(errs, err)
+	}
+	if err := s.repository.DeletePushSubscriptionsByIds(ctx, goneSubscriptionIds); err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return dispatchedCount, notificationexceptions.NewOperationException("Notification").DispatchDeliveriesFailed(errors.Join(errs...))
+	}
+
+	return dispatchedCount, nil
+}
+
+func pushUrgency(priority string) string {
+	switch coreeventscontract.NotificationPriority(priority) {
+	case coreeventscontract.NotificationPriority_Critical, coreeventscontract.NotificationPriority_High:
+		return webpush.Urgency_High
+	case coreeventscontract.NotificationPriority_Low:
+		return webpush.Urgency_Low
+	default:
+		return webpush.Urgency_Normal
+	}
+}
+
+func truncateUTF8(value string, maxBytes int) string {
+	if len(value) <= maxBytes {
+		return value
+	}
+	cut := maxBytes
+	for cut > 0 && !utf8.RuneStart(value[cut]) {
+		cut--
+	}
+	return value[:cut]
+}
+
+/* ============================== Service Methods for Notification Digest ============================== */
+
+const (
+	maxNotificationsPerDigest       = 20
+	maxRoutineTaskFailuresPerDigest = 20
+)
+
+// ConsumeRoutineTaskFailed keeps a failed routine task of the recipient for the next email digest, it is never shown
+// in the inbox since the routine already shows the failed record
+func (s *NotificationService) ConsumeRoutineTaskFailed(
+	ctx context.Context,
+	event eventcontract.EventEnvelope[coreeventscontract.RoutineTaskFailedData],
+) error {
+	if event.EventType != coreeventscontract.EventType_RoutineTaskFailed {
+		return notificationexceptions.NewEventException("Notification").UnsupportedEventType()
+	}
+	if event.AggregateId != event.Data.RoutineTaskId {
+		return notificationexceptions.NewEventException("Notification").AggregateRoutineTaskMismatch()
+	}
+	if event.Data.RecipientUserPublicId == uuid.Nil || event.Data.RoutineTaskRecordId == uuid.Nil ||
+		event.Data.ErrorCode == "" || event.Data.FailedAt.IsZero() {
+		return notificationexceptions.NewEventException("Notification").InvalidRoutineTaskFailure(
+			fmt.Errorf("routine task record %s is incomplete", event.Data.RoutineTaskRecordId),
+		)
+	}
+
+	if err := s.repository.CreateRoutineTaskFailure(ctx, event); err != nil {
+		return notificationexceptions.NewOperationException("Notification").CreateRoutineTaskFailureFailed(err)
+	}
+	return nil
+}
+
+// UnsubscribeMyNotificationDigest is what the one-click unsubscribe link of a digest email calls, so it succeeds
+// for a recipient whose digest is already off
+func (s *NotificationService) UnsubscribeMyNotificationDigest(
+	ctx context.Context,
+	request *notificationscontract.UnsubscribeNotificationDigestRequestDto,
+) (*notificationscontract.UnsubscribeNotificationDigestResponseDto, error) {
+	if request == nil || request.RecipientUserPublicId == uuid.Nil {
+		return nil, notificationexceptions.NewRequestException("Notification").RecipientRequired()
+	}
+	if err := s.validator.Struct(request); err != nil {
+		return nil, notificationexceptions.NewRequestException("Notification").InvalidUnsubscribeDigestRequest(err)
+	}
+
+	now := time.Now().UTC()
+	if err := s.repository.UnsubscribeDigest(ctx, request.RecipientUserPublicId, now); err != nil {
+		return nil, notificationexceptions.NewOperationException("Notification").UnsubscribeDigestFailed(err)
+	}
+	_, setting, err := s.repository.FindPreferences(ctx, request.RecipientUserPublicId)
+	if err != nil {
+		return nil, notificationexceptions.NewOperationException("Notification").GetPreferencesFailed(err)
+	}
+	digest := newNotificationDigestSettingDto(setting)
+	digest.Frequency = notificationscontract.NotificationDigestFrequency_Off
+
+	return &notificationscontract.UnsubscribeNotificationDigestResponseDto{
+		Digest:         digest,
+		UnsubscribedAt: now,
+	}, nil
+}
+
+// DispatchEmailDigests claims the due digests and hands them over to the Email runtime, a digest covers the unread
+// notifications created since the previous digest and the routine task failures kept since then, a digest with
+// nothing to report or without an email address only moves to its next period, and a digest failed for a transient
+// reason is claimed again once its claim times out
+func (s *NotificationService) DispatchEmailDigests(
+	ctx context.Context,
+	workerId string,
+	batchSize int,
+	claimTimeout time.Duration,
+) (int, error) {
+	settings, err := s.repository.ClaimDigests(ctx, workerId, batchSize, claimTimeout)
+	if err != nil {
+		return 0, notificationexceptions.NewOperationException("Notification").DispatchDigestsFailed(err)
+	}
+
+	dispatchedCount := 0
+	var errs []error
+	for _, setting := range settings {
+		now := time.Now().UTC()
+		periodStartedAt := now.Add(-notificationDigestPeriod(setting.DigestFrequency))
+		if setting.LastDigestAt != nil && setting.LastDigestAt.Before(now) {
+			periodStartedAt = setting.LastDigestAt.UTC()
+		}
+
+		request, err := s.newNotificationDigestEmailRequest(ctx, setting, periodStartedAt, now)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if request != nil {
+			if err := s.validator.Struct(request); err != nil {
+				// an invalid digest never becomes valid by retrying, so its period is skipped
+				errs = append(errs, fmt.Errorf("invalid notification digest email request: %w", err))
+				request = nil
+			}
+		}
+		if err := s.repository.CompleteDigest(
+			ctx,
+			workerId,
+			setting.UserPublicId,
+			now,
+			nextNotificationDigestAt(now, setting),
+			request,
+		); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if request != nil {
+			dispatchedCount++
+		}
+	}
+	if len(errs) > 0 {
+		return dispatchedCount, notificationexceptions.NewOperationException("Notification").DispatchDigestsFailed(errors.Join(errs...))
+	}
+
+	return dispatchedCount, nil
+}
+
+// newNotificationDigestEmailRequest is nil when there is nothing to report or nobody to report it to
+func (s *NotificationService) newNotificationDigestEmailRequest(
+	ctx context.Context,
+	setting schemas.NotificationSetting,
+	periodStartedAt time.Time,
+	periodEndedAt time.Time,
+) (*emaileventscontract.SendNotificationDigestEmailRequestDto, error) {
+	if setting.RecipientEmail == "" {
+		return nil, nil
+	}
+	notifications, unreadCount, err := s.repository.FindDigestNotifications(
+		ctx,
+		setting.UserPublicId,
+		periodStartedAt,
+		periodEndedAt,
+		maxNotificationsPerDigest,
+	)
+	if err != nil {
+		return nil, err
+	}
+	failures, failureCount, err := s.repository.FindRoutineTaskFailures(
+		ctx,
+		setting.UserPublicId,
+		periodEndedAt,
+		maxRoutineTaskFailuresPerDigest,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if unreadCount == 0 && failureCount == 0 {
+		return nil, nil
+	}
+
+	unsubscribeToken, err := sharedtokens.GenerateNotificationDigestUnsubscribeToken(setting.UserPublicId, periodEndedAt)
+	if err != nil {
+		return nil, err
+	}
+	unsubscribeUrl, err := url.Parse(s.digestUnsubscribeUrl)
+	if err != nil {
+		return nil, err
+	}
+	query := unsubscribeUrl.Query()
+	query.Set("token", *unsubscribeToken)
+	unsubscribeUrl.RawQuery = query.Encode()
+
+	userName := setting.RecipientName
+	if userName == "" {
+		userName = setting.RecipientEmail
+	}
+	timezone := setting.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	request := &emaileventscontract.SendNotificationDigestEmailRequestDto{
+		RequestId:           uuid.New(),
+		Operation:           emailcontract.SendNotificationDigestEmailOperation,
+		OccurredAt:          periodEndedAt,
+		To:                  setting.RecipientEmail,
+		UserName:            userName,
+		Frequency:           setting.DigestFrequency,
+		PeriodStartedAt:     periodStartedAt,
+		PeriodEndedAt:       periodEndedAt,
+		Timezone:            timezone,
+		UnreadCount:         unreadCount,
+		Notifications:       make([]emaileventscontract.NotificationEmailItem, 0, len(notifications)),
+		FailureCount:        failureCount,
+		RoutineTaskFailures: make([]emaileventscontract.RoutineTaskFailureEmailItem, len(failures)),
+		UnsubscribeToken:    *unsubscribeToken,
+		UnsubscribeUrl:      unsubscribeUrl.String(),
+	}
+	for _, notification := range notifications {
+		content, err := s.decodeNotificationContent(notification.Type, notification.TemplateKey, notification.Payload)
+		if err != nil {
+			// the notification is still counted, it just cannot be listed
+			continue
+		}
+		request.Notifications = append(request.Notifications, emaileventscontract.NotificationEmailItem{
+			Type:      notification.Type,
+			Priority:  notification.Priority,
+			Title:     content.Title,
+			Message:   content.Message,
+			ActionUrl: content.ActionUrl,
+			CreatedAt: notification.CreatedAt,
+		})
+	}
+	for index, failure := range failures {
+		request.RoutineTaskFailures[index] = emaileventscontract.RoutineTaskFailureEmailItem{
+			RoutineTitle:     failure.RoutineTitle,
+			RoutineTaskTitle: failure.RoutineTaskTitle,
+			Purpose:          failure.Purpose,
+			ErrorCode:        failure.ErrorCode,
+			FailedAt:         failure.FailedAt,
+		}
+	}
+
+	return request, nil
+}
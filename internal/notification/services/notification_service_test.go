@@ -2,7 +2,11 @@ package services
 
 import (
 	"context"
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
 	"encoding/json"
+	"strings"
 	"testing"
 	"time"
 
@@ -10,29 +14,50 @@ import (
 	"github.com/google/uuid"
 
 	coreeventscontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/events"
+	emaileventscontract "github.com/HiIamJeff67/notegic-backend/contracts/email/v1/events"
 	notificationscontract "github.com/HiIamJeff67/notegic-backend/contracts/notification/v1/api"
 	notificationtypescontract "github.com/HiIamJeff67/notegic-backend/contracts/notification/v1/types"
 	eventcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/events"
 
+	sharedtokens "github.com/HiIamJeff67/notegic-backend/shared/tokens"
 	sharedvalidations "github.com/HiIamJeff67/notegic-backend/shared/validations"
 
 	schemas "github.com/HiIamJeff67/notegic-backend/internal/notification/data/database/schemas"
+	webpush "github.com/HiIamJeff67/notegic-backend/internal/notification/transports/webpush"
 	notificationvalidations "github.com/HiIamJeff67/notegic-backend/internal/notification/validations"
 )
 
 type notificationRepositoryStub struct {
-	createCalls        int
-	createErr          error
-	deleteForUserCalls int
-	deleteForUserErr   error
-	notifications      []schemas.Notification
+	createCalls         int
+	createErr           error
+	createdInApp        bool
+	createdDeliveries   []schemas.NotificationDelivery
+	deleteForUserCalls  int
+	deleteForUserErr    error
+	notifications       []schemas.Notification
+	preferences         []schemas.NotificationPreference
+	setting             *schemas.NotificationSetting
+	claimedDeliveries   []schemas.NotificationDelivery
+	emailRequests       []emaileventscontract.SendNotificationEmailRequestDto
+	discardedDeliveries []uuid.UUID
+	releasedDeliveries  []uuid.UUID
+	pushSubscriptions   []schemas.NotificationPushSubscription
+	deletedPushIds      []uuid.UUID
+	routineTaskFailures []schemas.NotificationRoutineTaskFailure
+	claimedDigests      []schemas.NotificationSetting
+	digestRequests      []*emaileventscontract.SendNotificationDigestEmailRequestDto
+	nextDigestAts       map[uuid.UUID]*time.Time
 }
 
 func (r *notificationRepositoryStub) CreateFromRequest(
-	context.Context,
-	eventcontract.EventEnvelope[coreeventscontract.NotificationRequestedData],
+	_ context.Context,
+	_ eventcontract.EventEnvelope[coreeventscontract.NotificationRequestedData],
+	inApp bool,
+	deliveries []schemas.NotificationDelivery,
 ) error {
 	r.createCalls++
+	r.createdInApp = inApp
+	r.createdDeliveries = deliveries
 	return r.createErr
 }
 
@@ -77,7 +102,185 @@ func (r *notificationRepositoryStub) DeletePublishedOutbox(context.Context, time
 	return 0, nil
 }
 
+func (r *notificationRepositoryStub) FindPreferences(
+	context.Context,
+	uuid.UUID,
+) ([]schemas.NotificationPreference, *schemas.NotificationSetting, error) {
+	return r.preferences, r.setting, nil
+}
+
+func (r *notificationRepositoryStub) ReplacePreferences(
+	_ context.Context,
+	_ uuid.UUID,
+	setting *schemas.NotificationSetting,
+	preferences []schemas.NotificationPreference,
+) error {
+	r.preferences = preferences
+	if setting != nil {
+		r.setting = setting
+	}
+	return nil
+}
+
+func (r *notificationRepositoryStub) ClaimDeliveries(
+	context.Context,
+	string,
+	string,
+	int,
+	time.Duration,
+) ([]schemas.NotificationDelivery, error) {
+	return r.claimedDeliveries, nil
+}
+
+func (r *notificationRepositoryStub) CompleteEmailDeliveries(
+	_ context.Context,
+	_ string,
+	_ []uuid.UUID,
+	request emaileventscontract.SendNotificationEmailRequestDto,
+) error {
+	r.emailRequests = append(r.emailRequests, request)
+	return nil
+}
+
+func (r *notificationRepositoryStub) DiscardDeliveries(_ context.Context, _ string, deliveryIds []uuid.UUID) error {
+	r.discardedDeliveries = append(r.discardedDeliveries, deliveryIds...)
+	return nil
+}
+
+func (r *notificationRepositoryStub) ReleaseDeliveries(_ context.Context, _ string, deliveryIds []uuid.UUID, _ time.Time) error {
+	r.releasedDeliveries = append(r.releasedDeliveries, deliveryIds...)
+	return nil
+}
+
+func (r *notificationRepositoryStub) UpsertPushSubscription(_ context.Context, subscription *schemas.NotificationPushSubscription) error {
+	r.pushSubscriptions = append(r.pushSubscriptions, *subscription)
+	return nil
+}
+
+func (r *notificationRepositoryStub) DeletePushSubscription(context.Context, uuid.UUID, string) (int64, error) {
+	return 0, nil
+}
+
+func (r *notificationRepositoryStub) DeletePushSubscriptionsByIds(_ context.Context, subscriptionIds []uuid.UUID) error {
+	r.deletedPushIds = append(r.deletedPushIds, subscriptionIds...)
+	return nil
+}
+
+func (r *notificationRepositoryStub) HasPushSubscription(context.Context, uuid.UUID, time.Time) (bool, error) {
+	return len(r.pushSubscriptions) > 0, nil
+}
+
+func (r *notificationRepositoryStub) FindPushSubscriptions(context.Context, []uuid.UUID, time.Time) ([]schemas.NotificationPushSubscription, error) {
+	return r.pushSubscriptions, nil
+}
+
+func (r *notificationRepositoryStub) CreateRoutineTaskFailure(
+	_ context.Context,
+	event eventcontract.EventEnvelope[coreeventscontract.RoutineTaskFailedData],
+) error {
+	r.routineTaskFailures = append(r.routineTaskFailures, schemas.NotificationRoutineTaskFailure{
+		RoutineTaskRecordId: event.Data.RoutineTaskRecordId,
+		UserPublicId:        event.Data.RecipientUserPublicId,
+		RoutineTitle:        event.Data.RoutineTitle,
+		RoutineTaskTitle:    event.Data.RoutineTaskTitle,
+		Purpose:             string(event.Data.Purpose),
+		ErrorCode:           string(event.Data.ErrorCode),
+		FailedAt:            event.Data.FailedAt,
+	})
+	return nil
+}
+
+func (r *notificationRepositoryStub) UnsubscribeDigest(context.Context, uuid.UUID, time.Time) error {
+	if r.setting != nil {
+		r.setting.DigestFrequency = notificationscontract.NotificationDigestFrequency_Off
+		r.setting.NextDigestAt = nil
+	}
+	return nil
+}
+
+func (r *notificationRepositoryStub) ClaimDigests(context.Context, string, int, time.Duration) ([]schemas.NotificationSetting, error) {
+	return r.claimedDigests, nil
+}
+
+func (r *notificationRepositoryStub) FindDigestNotifications(
+	_ context.Context,
+	userPublicId uuid.UUID,
+	_ time.Time,
+	_ time.Time,
+	limit int,
+) ([]schemas.Notification, int64, error) {
+	var notifications []schemas.Notification
+	for _, notification := range r.notifications {
+		if notification.RecipientUserPublicId == userPublicId {
+			notifications = append(notifications, notification)
+		}
+	}
+	count := int64(len(notifications))
+	return notifications[:min(limit, len(notifications))], count, nil
+}
+
+func (r *notificationRepositoryStub) FindRoutineTaskFailures(
+	_ context.Context,
+	userPublicId uuid.UUID,
+	_ time.Time,
+	limit int,
+) ([]schemas.NotificationRoutineTaskFailure, int64, error) {
+	var failures []schemas.NotificationRoutineTaskFailure
+	for _, failure := range r.routineTaskFailures {
+		if failure.UserPublicId == userPublicId {
+			failures = append(failures, failure)
+		}
+	}
+	count := int64(len(failures))
+	return failures[:min(limit, len(failures))], count, nil
+}
+
+func (r *notificationRepositoryStub) CompleteDigest(
+	_ context.Context,
+	_ string,
+	userPublicId uuid.UUID,
+	_ time.Time,
+	nextDigestAt *time.Time,
+	request *emaileventscontract.SendNotificationDigestEmailRequestDto,
+) error {
+	if r.nextDigestAts == nil {
+		r.nextDigestAts = make(map[uuid.UUID]*time.Time)
+	}
+	r.nextDigestAts[userPublicId] = nextDigestAt
+	if request != nil {
+		r.digestRequests = append(r.digestRequests, request)
+	}
+	return nil
+}
+
+type pushClientStub struct {
+	errs     map[string]error
+	messages map[string][]webpush.Message
+}
+
+func (c *pushClientStub) PublicKey() string {
+	return "public-key"
+}
+
+func (c *pushClientStub) Send(_ context.Context, subscription webpush.Subscription, message webpush.Message) error {
+	if err := c.errs[subscription.Endpoint]; err != nil {
+		return err
+	}
+	if c.messages == nil {
+		c.messages = make(map[string][]webpush.Message)
+	}
+	c.messages[subscription.Endpoint] = append(c.messages[subscription.Endpoint], message)
+	return nil
+}
+
 func newNotificationServiceForTest(repository *notificationRepositoryStub) NotificationServiceInterface {
+	return newNotificationServiceWithPushForTest(repository, nil)
+}
+
+func newNotificationServiceWithPushForTest(
+	repository *notificationRepositoryStub,
+	pushClient webpush.ClientInterface,
+) NotificationServiceInterface {
 	validate := validator.New()
 	sharedvalidations.RegisterStringsValidation(validate)
 	sharedvalidations.RegisterTimesValidation(validate)
@@ -86,7 +289,13 @@ func newNotificationServiceForTest(repository *notificationRepositoryStub) Notif
 	notificationvalidations.RegisterWarningValidation(validate)
 	notificationvalidations.RegisterImportantValidation(validate)
 
-	return NewNotificationService(repository, validate)
+	return NewNotificationService(
+		repository,
+		validate,
+		time.Hour,
+		pushClient,
+		"https://api.notegic.app/api/v1/notifications/digest/unsubscribe",
+	)
 }
 
 func TestConsumeRequestedValidatesPayloadBeforePersisting(t *testing.T) {
@@ -226,3 +435,297 @@ func TestSearchPrivateNotificationsReturnsGraphQLStyleCursorPage(t *testing.T) {
 		t.Fatalf("unexpected notification payload: %#v", response.SearchEdges[0].Node.Payload)
 	}
 }
+
+func TestConsumeRequestedPlansEmailForImportantNotification(t *testing.T) {
+	repository := &notificationRepositoryStub{}
+	service := newNotificationServiceForTest(repository)
+	recipientUserPublicId := uuid.New()
+	payload, err := json.Marshal(notificationtypescontract.ImportantPayload{
+		Title:   "Storage almost full",
+		Message: "You have used most of your storage.",
+	})
+	if err != nil {
+		t.Fatalf("failed to encode payload: %v", err)
+	}
+
+	event := eventcontract.EventEnvelope[coreeventscontract.NotificationRequestedData]{
+		EventId:     uuid.New(),
+		EventType:   coreeventscontract.EventType_NotificationRequested,
+		AggregateId: recipientUserPublicId,
+		OccurredAt:  time.Now().UTC(),
+		Data: coreeventscontract.NotificationRequestedData{
+			RecipientUserPublicId: recipientUserPublicId,
+			Type:                  coreeventscontract.NotificationType_Important,
+			Priority:              coreeventscontract.NotificationPriority_Critical,
+			TemplateKey:           notificationtypescontract.TemplateKey_Important,
+			TemplateVersion:       1,
+			Payload:               payload,
+			DedupeKey:             "important:" + recipientUserPublicId.String(),
+			Recipient: &coreeventscontract.NotificationRecipient{
+				Email:     "user@example.com",
+				Name:      "user",
+				QuietMode: false,
+			},
+		},
+	}
+
+	if err := service.ConsumeNotificationRequested(context.Background(), event); err != nil {
+		t.Fatalf("expected valid notification request, got %v", err)
+	}
+	if !repository.createdInApp {
+		t.Fatal("expected the notification to stay in the inbox")
+	}
+	if len(repository.createdDeliveries) != 1 || repository.createdDeliveries[0].Title != "Storage almost full" {
+		t.Fatalf("unexpected deliveries: %#v", repository.createdDeliveries)
+	}
+}
+
+func TestUpdatePreferencesRejectsDuplicateOverrides(t *testing.T) {
+	repository := &notificationRepositoryStub{}
+	service := newNotificationServiceForTest(repository)
+
+	_, err := service.UpdateMyNotificationPreferences(context.Background(), &notificationscontract.UpdateNotificationPreferencesRequestDto{
+		RecipientUserPublicId: uuid.New(),
+		Preferences: []notificationscontract.NotificationPreferenceDto{
+			{Type: "news", Email: true},
+			{Type: "news", InApp: true},
+		},
+	})
+	if err == nil {
+		t.Fatal("expected duplicate preferences to be rejected")
+	}
+
+	timezone := "Asia/Taipei"
+	response, err := service.UpdateMyNotificationPreferences(context.Background(), &notificationscontract.UpdateNotificationPreferencesRequestDto{
+		RecipientUserPublicId: uuid.New(),
+		Timezone:              &timezone,
+		Preferences: []notificationscontract.NotificationPreferenceDto{
+			{Type: "news", Email: true, EmailDigest: true},
+			{Type: "news", TemplateKey: "news", InApp: true},
+		},
+	})
+	if err != nil {
+		t.Fatalf("update preferences: %v", err)
+	}
+	if response.Timezone != timezone || len(response.Preferences) != 2 || len(response.Defaults) != 3 {
+		t.Fatalf("unexpected preferences response: %#v", response)
+	}
+}
+
+func TestDispatchEmailDeliveriesMergesDeliveriesOfRecipient(t *testing.T) {
+	recipientUserPublicId := uuid.New()
+	createdAt := time.Now().UTC()
+	repository := &notificationRepositoryStub{
+		claimedDeliveries: []schemas.NotificationDelivery{
+			{Id: uuid.New(), RecipientUserPublicId: recipientUserPublicId, Type: "news", Priority: "normal", Title: "First", Message: "First message", RecipientEmail: "user@example.com", RecipientName: "user", CreatedAt: createdAt},
+			{Id: uuid.New(), RecipientUserPublicId: recipientUserPublicId, Type: "warning", Priority: "high", Title: "Second", Message: "Second message", RecipientEmail: "user@example.com", RecipientName: "user", CreatedAt: createdAt},
+			{Id: uuid.New(), RecipientUserPublicId: uuid.New(), Type: "news", Priority: "normal", Title: "Other", Message: "Other message", RecipientEmail: "not-an-email", RecipientName: "other", CreatedAt: createdAt},
+		},
+	}
+	service := newNotificationServiceForTest(repository)
+
+	count, err := service.DispatchEmailDeliveries(context.Background(), "worker", 10, time.Minute)
+	if err == nil {
+		t.Fatal("expected the invalid email request to be reported")
+	}
+	if count != 2 || len(repository.emailRequests) != 1 {
+		t.Fatalf("dispatched %d deliveries in %d emails, want 2 in 1", count, len(repository.emailRequests))
+	}
+	if request := repository.emailRequests[0]; !request.Digest || len(request.Notifications) != 2 || request.To != "user@example.com" {
+		t.Fatalf("unexpected email request: %#v", request)
+	}
+	if len(repository.discardedDeliveries) != 1 {
+		t.Fatalf("discarded deliveries = %d, want 1", len(repository.discardedDeliveries))
+	}
+}
+
+func TestRegisterPushSubscriptionValidatesKeys(t *testing.T) {
+	userAgentKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("generate subscription key: %v", err)
+	}
+	request := &notificationscontract.RegisterPushSubscriptionRequestDto{
+		RecipientUserPublicId: uuid.New(),
+		Endpoint:              "https://push.example.com/send/subscription",
+		Keys: notificationscontract.PushSubscriptionKeysDto{
+			P256dh: base64.RawURLEncoding.EncodeToString(userAgentKey.PublicKey().Bytes()),
+			Auth:   base64.RawURLEncoding.EncodeToString(make([]byte, 16)),
+		},
+	}
+
+	if _, err := newNotificationServiceForTest(&notificationRepositoryStub{}).RegisterMyPushSubscription(context.Background(), request); err == nil {
+		t.Fatal("expected the registration to be rejected without web push configured")
+	}
+
+	repository := &notificationRepositoryStub{}
+	service := newNotificationServiceWithPushForTest(repository, &pushClientStub{})
+	invalidRequest := *request
+	invalidRequest.Keys.Auth = base64.RawURLEncoding.EncodeToString(make([]byte, 8))
+	if _, err := service.RegisterMyPushSubscription(context.Background(), &invalidRequest); err == nil {
+		t.Fatal("expected a short authentication secret to be rejected")
+	}
+	response, err := service.RegisterMyPushSubscription(context.Background(), request)
+	if err != nil {
+		t.Fatalf("register push subscription: %v", err)
+	}
+	if response.Endpoint != request.Endpoint || len(repository.pushSubscriptions) != 1 {
+		t.Fatalf("unexpected registration: %#v", response)
+	}
+}
+
+func TestDispatchPushDeliveriesDropsGoneSubscriptionsAndRetries(t *testing.T) {
+	recipientUserPublicId := uuid.New()
+	gone := schemas.NotificationPushSubscription{Id: uuid.New(), UserPublicId: recipientUserPublicId, Endpoint: "https://push.example.com/gone"}
+	active := schemas.NotificationPushSubscription{Id: uuid.New(), UserPublicId: recipientUserPublicId, Endpoint: "https://push.example.com/active"}
+	delivered := schemas.NotificationDelivery{Id: uuid.New(), RecipientUserPublicId: recipientUserPublicId, Type: "warning", Priority: "critical", Title: "Delivered", Message: "Delivered message"}
+	repository := &notificationRepositoryStub{
+		claimedDeliveries: []schemas.NotificationDelivery{delivered},
+		pushSubscriptions: []schemas.NotificationPushSubscription{gone, active},
+	}
+	pushClient := &pushClientStub{errs: map[string]error{gone.Endpoint: webpush.ErrSubscriptionGone}}
+	service := newNotificationServiceWithPushForTest(repository, pushClient)
+
+	count, err := service.DispatchPushDeliveries(context.Background(), "worker", 10, time.Minute)
+	if err != nil || count != 1 {
+		t.Fatalf("dispatched %d deliveries, %v, want 1", count, err)
+	}
+	messages := pushClient.messages[active.Endpoint]
+	if len(messages) != 1 || messages[0].Urgency != webpush.Urgency_High {
+		t.Fatalf("unexpected push messages: %#v", messages)
+	}
+	var payload notificationscontract.PushNotificationMessageDto
+	if err := json.Unmarshal(messages[0].Payload, &payload); err != nil || payload.Tag != delivered.Id.String() || payload.Title != "Delivered" {
+		t.Fatalf("unexpected push payload: %#v, %v", payload, err)
+	}
+	if len(repository.deletedPushIds) != 1 || repository.deletedPushIds[0] != gone.Id {
+		t.Fatalf("deleted push subscriptions = %v, want the gone subscription", repository.deletedPushIds)
+	}
+	if len(repository.discardedDeliveries) != 1 {
+		t.Fatalf("finished deliveries = %d, want 1", len(repository.discardedDeliveries))
+	}
+
+	retried := schemas.NotificationDelivery{Id: uuid.New(), RecipientUserPublicId: recipientUserPublicId, Type: "warning", Priority: "normal", Title: "Retried"}
+	exhausted := schemas.NotificationDelivery{Id: uuid.New(), RecipientUserPublicId: recipientUserPublicId, Type: "warning", Priority: "normal", Title: "Exhausted", Attempts: maxPushDeliveryAttempts - 1}
+	repository = &notificationRepositoryStub{
+		claimedDeliveries: []schemas.NotificationDelivery{retried, exhausted},
+		pushSubscriptions: []schemas.NotificationPushSubscription{active},
+	}
+	pushClient = &pushClientStub{errs: map[string]error{active.Endpoint: &webpush.PushServiceError{StatusCode: 503}}}
+	service = newNotificationServiceWithPushForTest(repository, pushClient)
+	if _, err := service.DispatchPushDeliveries(context.Background(), "worker", 10, time.Minute); err == nil {
+		t.Fatalf("expected the push service failure to be reported, got %v", err)
+	}
+	if len(repository.releasedDeliveries) != 1 || repository.releasedDeliveries[0] != retried.Id {
+		t.Fatalf("released deliveries = %v, want the delivery with attempts left", repository.releasedDeliveries)
+	}
+	if len(repository.discardedDeliveries) != 1 || repository.discardedDeliveries[0] != exhausted.Id {
+		t.Fatalf("finished deliveries = %v, want the exhausted delivery", repository.discardedDeliveries)
+	}
+}
+
+func TestDispatchEmailDigestsReportsUnreadNotificationsAndFailures(t *testing.T) {
+	t.Setenv("JWT_NOTIFICATION_DIGEST_UNSUBSCRIBE_TOKEN_SECRET_KEY", "notification-digest-test-secret")
+
+	recipientUserPublicId, quietUserPublicId := uuid.New(), uuid.New()
+	lastDigestAt := time.Now().UTC().Add(-24 * time.Hour)
+	payload, err := json.Marshal(notificationtypescontract.NewsPayload{
+		Title:   "Release update",
+		Summary: "A new release is available.",
+		Body:    "Read the release notes.",
+	})
+	if err != nil {
+		t.Fatalf("marshal notification payload: %v", err)
+	}
+	repository := &notificationRepositoryStub{
+		claimedDigests: []schemas.NotificationSetting{
+			{
+				UserPublicId:    recipientUserPublicId,
+				Timezone:        "Asia/Taipei",
+				RecipientEmail:  "user@example.com",
+				RecipientName:   "user",
+				DigestFrequency: notificationscontract.NotificationDigestFrequency_Daily,
+				DigestHour:      8,
+				LastDigestAt:    &lastDigestAt,
+			},
+			{
+				UserPublicId:    quietUserPublicId,
+				Timezone:        "UTC",
+				RecipientEmail:  "quiet@example.com",
+				DigestFrequency: notificationscontract.NotificationDigestFrequency_Weekly,
+				DigestHour:      8,
+				DigestWeekday:   1,
+			},
+		},
+		notifications: []schemas.Notification{
+			{Id: uuid.New(), RecipientUserPublicId: recipientUserPublicId, Type: "news", Priority: "normal", TemplateKey: notificationtypescontract.TemplateKey_News, TemplateVersion: 1, Payload: payload, CreatedAt: time.Now().UTC()},
+			{Id: uuid.New(), RecipientUserPublicId: recipientUserPublicId, Type: "news", Priority: "normal", TemplateKey: "unknown", TemplateVersion: 1, Payload: payload, CreatedAt: time.Now().UTC()},
+		},
+		routineTaskFailures: []schemas.NotificationRoutineTaskFailure{
+			{RoutineTaskRecordId: uuid.New(), UserPublicId: recipientUserPublicId, RoutineTitle: "Morning routine", RoutineTaskTitle: "Summarize notes", Purpose: "CreateBlockPack", ErrorCode: "QuotaExceeded", FailedAt: time.Now().UTC()},
+		},
+	}
+	service := newNotificationServiceForTest(repository)
+
+	count, err := service.DispatchEmailDigests(context.Background(), "worker", 10, time.Minute)
+	if err != nil {
+		t.Fatalf("dispatch email digests: %v", err)
+	}
+	if count != 1 || len(repository.digestRequests) != 1 {
+		t.Fatalf("dispatched %d digests in %d emails, want 1 in 1", count, len(repository.digestRequests))
+	}
+	request := repository.digestRequests[0]
+	if request.To != "user@example.com" || request.UnreadCount != 2 || len(request.Notifications) != 1 ||
+		request.FailureCount != 1 || len(request.RoutineTaskFailures) != 1 || !request.PeriodStartedAt.Equal(lastDigestAt) {
+		t.Fatalf("unexpected digest email request: %#v", request)
+	}
+	if !strings.Contains(request.UnsubscribeUrl, "token="+request.UnsubscribeToken) {
+		t.Fatalf("unsubscribe URL %q does not carry the unsubscribe token", request.UnsubscribeUrl)
+	}
+	if subject, err := sharedtokens.ParseNotificationDigestUnsubscribeToken(request.UnsubscribeToken); err != nil || *subject != recipientUserPublicId {
+		t.Fatalf("unsubscribe token subject = %v, %v, want %s", subject, err, recipientUserPublicId)
+	}
+	for _, userPublicId := range []uuid.UUID{recipientUserPublicId, quietUserPublicId} {
+		if nextDigestAt := repository.nextDigestAts[userPublicId]; nextDigestAt == nil || !nextDigestAt.After(time.Now().UTC()) {
+			t.Fatalf("next digest of %s = %v, want a future time", userPublicId, nextDigestAt)
+		}
+	}
+}
+
+func TestUpdatePreferencesSchedulesDigestInRecipientTimezone(t *testing.T) {
+	repository := &notificationRepositoryStub{}
+	service := newNotificationServiceForTest(repository)
+	timezone := "Asia/Taipei"
+
+	response, err := service.UpdateMyNotificationPreferences(context.Background(), &notificationscontract.UpdateNotificationPreferencesRequestDto{
+		RecipientUserPublicId: uuid.New(),
+		Timezone:              &timezone,
+		Digest: &notificationscontract.NotificationDigestSettingDto{
+			Frequency: notificationscontract.NotificationDigestFrequency_Weekly,
+			Hour:      0,
+			Weekday:   int(time.Friday),
+		},
+	})
+	if err != nil {
+		t.Fatalf("update notification preferences: %v", err)
+	}
+	if response.Digest.Frequency != notificationscontract.NotificationDigestFrequency_Weekly || response.Digest.Hour != 0 || response.NextDigestAt == nil {
+		t.Fatalf("unexpected digest in response: %#v, next digest at %v", response.Digest, response.NextDigestAt)
+	}
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		t.Fatalf("load timezone: %v", err)
+	}
+	if local := response.NextDigestAt.In(location); local.Weekday() != time.Friday || local.Hour() != 0 || local.Minute() != 0 {
+		t.Fatalf("next digest at %s, want Friday 00:00 in %s", local, timezone)
+	}
+
+	unsubscribed, err := service.UnsubscribeMyNotificationDigest(context.Background(), &notificationscontract.UnsubscribeNotificationDigestRequestDto{
+		RecipientUserPublicId: uuid.New(),
+	})
+	if err != nil {
+		t.Fatalf("unsubscribe notification digest: %v", err)
+	}
+	if unsubscribed.Digest.Frequency != notificationscontract.NotificationDigestFrequency_Off || unsubscribed.Digest.Weekday != int(time.Friday) {
+		t.Fatalf("unexpected digest after unsubscribing: %#v", unsubscribed.Digest)
+	}
+}
@@ -106,6 +106,40 @@ func (c *NotificationRequestConsumer) consume(
 		}
 		return nil
 	}
+	if event.EventType == coreeventscontract.EventType_RoutineTaskFailed {
+		var data coreeventscontract.RoutineTaskFailedData
+		if err := json.Unmarshal(event.Data, &data); err != nil {
+			return &platformkafka.ConsumerError{
+				Classification: platformkafka.ErrorClassification_SchemaIncompatible,
+				Origin:         err,
+			}
+		}
+		if event.AggregateType != coreeventscontract.AggregateType_RoutineTask || event.AggregateId != data.RoutineTaskId {
+			return &platformkafka.ConsumerError{
+				Classification: platformkafka.ErrorClassification_SchemaIncompatible,
+				Origin:         errors.New("routine task failure event aggregate is invalid"),
+			}
+		}
+		if err := c.service.ConsumeRoutineTaskFailed(ctx, eventcontract.EventEnvelope[coreeventscontract.RoutineTaskFailedData]{
+			SchemaVersion: event.SchemaVersion,
+			EventId:       event.EventId,
+			EventType:     event.EventType,
+			AggregateType: event.AggregateType,
+			AggregateId:   event.AggregateId,
+			KafkaKey:      event.KafkaKey,
+			OccurredAt:    event.OccurredAt,
+			CorrelationId: event.CorrelationId,
+			CausationId:   event.CausationId,
+			Trace:         event.Trace,
+			Data:          data,
+		}); err != nil {
+			return &platformkafka.ConsumerError{
+				Classification: platformkafka.ErrorClassification_Transient,
+				Origin:         err,
+			}
+		}
+		return nil
+	}
 	if event.EventType != coreeventscontract.EventType_NotificationRequested {
 		return nil
 	}
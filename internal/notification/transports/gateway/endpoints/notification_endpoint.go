@@ -178,3 +178,267 @@ func (e *NotificationEndpoint) Delete(ctx *gin.Context) {
 		Data: *responseDto,
 	})
 }
+
+func (e *NotificationEndpoint) GetPreferences(ctx *gin.Context) {
+	request := &gatewaycontract.Request[notificationscontract.GetNotificationPreferencesRequestDto]{}
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+	request.Dto.RecipientUserPublicId = ctx.MustGet(sharedcontexts.ContextFieldName_User_PublicId.String()).(uuid.UUID)
+	responseDto, err := e.service.GetMyNotificationPreferences(ctx.Request.Context(), &request.Dto)
+	if err != nil {
+		responseException := exceptions.New(
+			"NotificationPreferencesGetFailed",
+			"Notification",
+			"GetNotificationPreferences",
+			"Failed to get the notification preferences",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(err)
+		var serviceException *exceptions.Exception
+		if errors.As(err, &serviceException) {
+			responseException = serviceException
+		}
+		publicException := responseException.ToPublic()
+
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[notificationscontract.GetNotificationPreferencesResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
+
+func (e *NotificationEndpoint) UpdatePreferences(ctx *gin.Context) {
+	request := &gatewaycontract.Request[notificationscontract.UpdateNotificationPreferencesRequestDto]{}
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+	request.Dto.RecipientUserPublicId = ctx.MustGet(sharedcontexts.ContextFieldName_User_PublicId.String()).(uuid.UUID)
+	responseDto, err := e.service.UpdateMyNotificationPreferences(ctx.Request.Context(), &request.Dto)
+	if err != nil {
+		responseException := exceptions.New(
+			"NotificationPreferencesUpdateFailed",
+			"Notification",
+			"UpdateNotificationPreferences",
+			"Failed to update the notification preferences",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(err)
+		var serviceException *exceptions.Exception
+		if errors.As(err, &serviceException) {
+			responseException = serviceException
+		}
+		publicException := responseException.ToPublic()
+
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[notificationscontract.UpdateNotificationPreferencesResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
+
+func (e *NotificationEndpoint) GetPushPublicKey(ctx *gin.Context) {
+	request := &gatewaycontract.Request[notificationscontract.GetPushPublicKeyRequestDto]{}
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+	request.Dto.RecipientUserPublicId = ctx.MustGet(sharedcontexts.ContextFieldName_User_PublicId.String()).(uuid.UUID)
+	responseDto, err := e.service.GetMyPushPublicKey(ctx.Request.Context(), &request.Dto)
+	if err != nil {
+		responseException := exceptions.New(
+			"NotificationPushPublicKeyGetFailed",
+			"Notification",
+			"GetNotificationPushPublicKey",
+			"Failed to get the notification push public key",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(err)
+		var serviceException *exceptions.Exception
+		if errors.As(err, &serviceException) {
+			responseException = serviceException
+		}
+		publicException := responseException.ToPublic()
+
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[notificationscontract.GetPushPublicKeyResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
+
+func (e *NotificationEndpoint) RegisterPushSubscription(ctx *gin.Context) {
+	request := &gatewaycontract.Request[notificationscontract.RegisterPushSubscriptionRequestDto]{}
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+	request.Dto.RecipientUserPublicId = ctx.MustGet(sharedcontexts.ContextFieldName_User_PublicId.String()).(uuid.UUID)
+	responseDto, err := e.service.RegisterMyPushSubscription(ctx.Request.Context(), &request.Dto)
+	if err != nil {
+		responseException := exceptions.New(
+			"NotificationPushSubscriptionRegisterFailed",
+			"Notification",
+			"RegisterNotificationPushSubscription",
+			"Failed to register the notification push subscription",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(err)
+		var serviceException *exceptions.Exception
+		if errors.As(err, &serviceException) {
+			responseException = serviceException
+		}
+		publicException := responseException.ToPublic()
+
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[notificationscontract.RegisterPushSubscriptionResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
+
+func (e *NotificationEndpoint) UnregisterPushSubscription(ctx *gin.Context) {
+	request := &gatewaycontract.Request[notificationscontract.UnregisterPushSubscriptionRequestDto]{}
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+	request.Dto.RecipientUserPublicId = ctx.MustGet(sharedcontexts.ContextFieldName_User_PublicId.String()).(uuid.UUID)
+	responseDto, err := e.service.UnregisterMyPushSubscription(ctx.Request.Context(), &request.Dto)
+	if err != nil {
+		responseException := exceptions.New(
+			"NotificationPushSubscriptionUnregisterFailed",
+			"Notification",
+			"UnregisterNotificationPushSubscription",
+			"Failed to unregister the notification push subscription",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(err)
+		var serviceException *exceptions.Exception
+		if errors.As(err, &serviceException) {
+			responseException = serviceException
+		}
+		publicException := responseException.ToPublic()
+
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[notificationscontract.UnregisterPushSubscriptionResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
+
+func (e *NotificationEndpoint) UnsubscribeDigest(ctx *gin.Context) {
+	request := &gatewaycontract.Request[notificationscontract.UnsubscribeNotificationDigestRequestDto]{}
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		ctx.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+	request.Dto.RecipientUserPublicId = ctx.MustGet(sharedcontexts.ContextFieldName_User_PublicId.String()).(uuid.UUID)
+	responseDto, err := e.service.UnsubscribeMyNotificationDigest(ctx.Request.Context(), &request.Dto)
+	if err != nil {
+		responseException := exceptions.New(
+			"NotificationDigestUnsubscribeFailed",
+			"Notification",
+			"UnsubscribeNotificationDigest",
+			"Failed to unsubscribe from the notification digest",
+			http.StatusInternalServerError,
+			true,
+		).WithOrigin(err)
+		var serviceException *exceptions.Exception
+		if errors.As(err, &serviceException) {
+			responseException = serviceException
+		}
+		publicException := responseException.ToPublic()
+
+		ctx.JSON(publicException.HTTPStatusCode(), gatewaycontract.Response[struct{}]{
+			Version: gatewaycontract.Version,
+			Metadata: gatewaycontract.ResponseMetadata{
+				RequestId:   request.Metadata.RequestId,
+				RespondedAt: time.Now(),
+			},
+			Data:      struct{}{},
+			Exception: publicException,
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, gatewaycontract.Response[notificationscontract.UnsubscribeNotificationDigestResponseDto]{
+		Version: gatewaycontract.Version,
+		Metadata: gatewaycontract.ResponseMetadata{
+			RequestId:   request.Metadata.RequestId,
+			RespondedAt: time.Now(),
+		},
+		Data: *responseDto,
+	})
+}
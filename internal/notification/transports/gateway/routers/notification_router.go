@@ -34,4 +34,34 @@ func ConfigureNotificationRoutes(
 		middlewares.DelegationAuthenticatedMiddleware(notificationscontract.DeleteMyNotificationsOperation),
 		endpoint.Delete,
 	)
+	notificationRoutes.POST(
+		"/preferences/get",
+		middlewares.DelegationAuthenticatedMiddleware(notificationscontract.GetMyNotificationPreferencesOperation),
+		endpoint.GetPreferences,
+	)
+	notificationRoutes.POST(
+		"/preferences/update",
+		middlewares.DelegationAuthenticatedMiddleware(notificationscontract.UpdateMyNotificationPreferencesOperation),
+		endpoint.UpdatePreferences,
+	)
+	notificationRoutes.POST(
+		"/push/public-key",
+		middlewares.DelegationAuthenticatedMiddleware(notificationscontract.GetMyPushPublicKeyOperation),
+		endpoint.GetPushPublicKey,
+	)
+	notificationRoutes.POST(
+		"/push/subscriptions/register",
+		middlewares.DelegationAuthenticatedMiddleware(notificationscontract.RegisterMyPushSubscriptionOperation),
+		endpoint.RegisterPushSubscription,
+	)
+	notificationRoutes.POST(
+		"/push/subscriptions/unregister",
+		middlewares.DelegationAuthenticatedMiddleware(notificationscontract.UnregisterMyPushSubscriptionOperation),
+		endpoint.UnregisterPushSubscription,
+	)
+	notificationRoutes.POST(
+		"/digest/unsubscribe",
+		middlewares.DelegationAuthenticatedMiddleware(notificationscontract.UnsubscribeMyNotificationDigestOperation),
+		endpoint.UnsubscribeDigest,
+	)
 }
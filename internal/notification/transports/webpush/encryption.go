@@ -0,0 +1,129 @@
+package webpush
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+const (
+	// encryptionRecordSize is the record size of the aes128gcm content coding, a push message is always encrypted
+	// into one record, so the record size only has to cover the payload
+	encryptionRecordSize = 4096
+	encryptionSaltLength = 16
+	authSecretLength     = 16
+	// MaximumPayloadBytes leaves room for the content coding header, the padding delimiter, and the authentication
+	// tag within the 4096 bytes that every push service has to accept
+	MaximumPayloadBytes = 4096 - encryptionSaltLength - 4 - 1 - 65 - 1 - 16
+)
+
+var ErrPayloadTooLarge = fmt.Errorf("web push payload must not exceed %d bytes", MaximumPayloadBytes)
+
+// encryptPayload encrypts the payload for one subscription with the aes128gcm content coding of RFC 8188, keyed as
+// described by RFC 8291, the local key pair is ephemeral so each message uses a fresh content encryption key
+func encryptPayload(payload []byte, userAgentPublicKey []byte, authSecret []byte) ([]byte, error) {
+	localPrivateKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	salt := make([]byte, encryptionSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	return encryptPayloadWith(payload, userAgentPublicKey, authSecret, localPrivateKey, salt)
+}
+
+func encryptPayloadWith(
+	payload []byte,
+	userAgentPublicKey []byte,
+	authSecret []byte,
+	localPrivateKey *ecdh.PrivateKey,
+	salt []byte,
+) ([]byte, error) {
+	if len(payload) > MaximumPayloadBytes {
+		return nil, ErrPayloadTooLarge
+	}
+	if len(authSecret) != authSecretLength {
+		return nil, errors.New("web push authentication secret must be 16 bytes")
+	}
+	if len(salt) != encryptionSaltLength {
+		return nil, errors.New("web push salt must be 16 bytes")
+	}
+	remotePublicKey, err := ecdh.P256().NewPublicKey(userAgentPublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("web push subscription key is not a P-256 public key: %w", err)
+	}
+	sharedSecret, err := localPrivateKey.ECDH(remotePublicKey)
+	if err != nil {
+		return nil, err
+	}
+	localPublicKey := localPrivateKey.PublicKey().Bytes()
+
+	contentEncryptionKey, nonce, err := deriveContentKeys(
+		sharedSecret,
+		authSecret,
+		salt,
+		userAgentPublicKey,
+		localPublicKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(contentEncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	// the single record is also the last one, so it ends with the 0x02 delimiter and carries no padding
+	record := make([]byte, 0, len(payload)+1)
+	record = append(record, payload...)
+	record = append(record, 0x02)
+
+	header := make([]byte, 0, encryptionSaltLength+4+1+len(localPublicKey))
+	header = append(header, salt...)
+	header = binary.BigEndian.AppendUint32(header, encryptionRecordSize)
+	header = append(header, byte(len(localPublicKey)))
+	header = append(header, localPublicKey...)
+
+	return gcm.Seal(header, nonce, record, nil), nil
+}
+
+// deriveContentKeys mixes the authentication secret of the subscription into the shared secret first, so only the
+// holder of both the subscription private key and the authentication secret can derive the content keys
+func deriveContentKeys(
+	sharedSecret []byte,
+	authSecret []byte,
+	salt []byte,
+	userAgentPublicKey []byte,
+	applicationServerPublicKey []byte,
+) ([]byte, []byte, error) {
+	keyInfo := make([]byte, 0, 14+len(userAgentPublicKey)+len(applicationServerPublicKey))
+	keyInfo = append(keyInfo, "WebPush: info\x00"...)
+	keyInfo = append(keyInfo, userAgentPublicKey...)
+	keyInfo = append(keyInfo, applicationServerPublicKey...)
+	inputKeyingMaterial, err := hkdf.Key(sha256.New, sharedSecret, authSecret, string(keyInfo), 32)
+	if err != nil {
+		return nil, nil, err
+	}
+	contentEncryptionKey, err := hkdf.Key(sha256.New, inputKeyingMaterial, salt, "Content-Encoding: aes128gcm\x00", 16)
+	if err != nil {
+		return nil, nil, err
+	}
+	nonce, err := hkdf.Key(sha256.New, inputKeyingMaterial, salt, "Content-Encoding: nonce\x00", 12)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return contentEncryptionKey, nonce, nil
+}
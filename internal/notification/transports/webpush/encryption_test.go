@@ -0,0 +1,51 @@
+package webpush
+
+import (
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"testing"
+)
+
+// TestEncryptPayloadMatchesRFC8291 encrypts the example message of RFC 8291 Appendix A with its fixed keys and salt
+func TestEncryptPayloadMatchesRFC8291(t *testing.T) {
+	decode := func(value string) []byte {
+		decoded, err := base64.RawURLEncoding.DecodeString(value)
+		if err != nil {
+			t.Fatalf("decode %q: %v", value, err)
+		}
+		return decoded
+	}
+	localPrivateKey, err := ecdh.P256().NewPrivateKey(decode("yfWPiYE-n46HLnH0KqZOF1fJJU3MYrct3AELtAQ-oRw"))
+	if err != nil {
+		t.Fatalf("load application server private key: %v", err)
+	}
+
+	encrypted, err := encryptPayloadWith(
+		[]byte("When I grow up, I want to be a watermelon"),
+		decode("BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4"),
+		decode("BTBZMqHH6r4Tts7J_aSIgg"),
+		localPrivateKey,
+		decode("DGv6ra1nlYgDCS1FRnbzlw"),
+	)
+	if err != nil {
+		t.Fatalf("encryptPayloadWith() error = %v", err)
+	}
+	want := "DGv6ra1nlYgDCS1FRnbzlwAAEABBBP4z9KsN6nGRTbVYI_c7VJSPQTBtkgcy27mlmlMoZIIgDll6e3vCYLocInmYWAmS6TlzAC8wEqKK6PBru3jl7A_yl95bQpu6cVPTpK4Mqgkf1CXztLVBSt2Ks3oZwbuwXPXLWyouBWLVWGNWQexSgSxsj_Qulcy4a-fN"
+	if got := base64.RawURLEncoding.EncodeToString(encrypted); got != want {
+		t.Fatalf("encryptPayloadWith() = %s, want %s", got, want)
+	}
+}
+
+func TestEncryptPayloadRejectsOversizedPayload(t *testing.T) {
+	subscriptionKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("generate subscription key: %v", err)
+	}
+
+	_, err = encryptPayload(make([]byte, MaximumPayloadBytes+1), subscriptionKey.PublicKey().Bytes(), make([]byte, 16))
+	if !errors.Is(err, ErrPayloadTooLarge) {
+		t.Fatalf("encryptPayload() error = %v, want ErrPayloadTooLarge", err)
+	}
+}
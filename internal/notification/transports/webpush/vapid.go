@@ -0,0 +1,79 @@
+package webpush
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// vapidTokenLifetime stays below the 24 hours RFC 8292 allows, so a cached token is never rejected for its expiry
+const vapidTokenLifetime = 12 * time.Hour
+
+// GenerateVapidKeys returns a new application server key pair in the unpadded base64url form used by the
+// NOTIFICATION_VAPID_* variables and by the applicationServerKey of the browsers
+func GenerateVapidKeys() (publicKey string, privateKey string, err error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", "", err
+	}
+	privateKeyBytes, err := key.Bytes()
+	if err != nil {
+		return "", "", err
+	}
+	publicKeyBytes, err := key.PublicKey.Bytes()
+	if err != nil {
+		return "", "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(publicKeyBytes), base64.RawURLEncoding.EncodeToString(privateKeyBytes), nil
+}
+
+// parseVapidKeys loads the application server key pair and rejects a public key that does not belong to the
+// private key, since the browsers would then reject every message of the subscriptions made with that public key
+func parseVapidKeys(publicKey string, privateKey string) (*ecdsa.PrivateKey, error) {
+	privateKeyBytes, err := base64.RawURLEncoding.DecodeString(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("VAPID private key is not unpadded base64url: %w", err)
+	}
+	key, err := ecdsa.ParseRawPrivateKey(elliptic.P256(), privateKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("VAPID private key is not a P-256 private key: %w", err)
+	}
+	publicKeyBytes, err := key.PublicKey.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	if base64.RawURLEncoding.EncodeToString(publicKeyBytes) != publicKey {
+		return nil, errors.New("VAPID public key does not belong to the VAPID private key")
+	}
+
+	return key, nil
+}
+
+// vapidAudience is the origin of the push service, which is the audience every VAPID token must be bound to
+func vapidAudience(endpoint string) (string, error) {
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+	if endpointURL.Scheme == "" || endpointURL.Host == "" {
+		return "", errors.New("web push endpoint must be an absolute URL")
+	}
+
+	return endpointURL.Scheme + "://" + endpointURL.Host, nil
+}
+
+func signVapidToken(key *ecdsa.PrivateKey, audience string, subject string, expiresAt time.Time) (string, error) {
+	return jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"aud": audience,
+		"exp": expiresAt.Unix(),
+		"sub": subject,
+	}).SignedString(key)
+}
@@ -0,0 +1,239 @@
+package webpush
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	configs "github.com/HiIamJeff67/notegic-backend/internal/notification/configs"
+)
+
+const (
+	webPushRequestTimeout       = 10 * time.Second
+	webPushMaximumResponseBytes = 1 << 16
+	webPushMaximumAttempts      = 3
+	webPushInitialBackoff       = 500 * time.Millisecond
+	webPushMaximumRetryAfter    = 30 * time.Second
+	// webPushTTL is how long the push service keeps a message for an offline browser, a notification older than a
+	// day is still in the inbox and is not worth a system notification anymore
+	webPushTTL = 24 * time.Hour
+)
+
+const (
+	Urgency_VeryLow = "very-low"
+	Urgency_Low     = "low"
+	Urgency_Normal  = "normal"
+	Urgency_High    = "high"
+)
+
+// ErrSubscriptionGone is returned when the push service no longer knows the subscription, the browser unsubscribed
+// or the subscription expired, so the subscription must be deleted instead of being retried.
+var ErrSubscriptionGone = errors.New("web push subscription is gone")
+
+// PushServiceError is returned when the push service rejects a message, the rate limits and the server errors are
+// retried by the client before they are returned.
+type PushServiceError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *PushServiceError) Error() string {
+	return fmt.Sprintf("web push service returned HTTP status %d: %s", e.StatusCode, e.Message)
+}
+
+func (e *PushServiceError) IsRetryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= http.StatusInternalServerError
+}
+
+// Subscription is the browser subscription a message is sent to, both keys are unpadded base64url.
+type Subscription struct {
+	Endpoint string
+	P256dh   string
+	Auth     string
+}
+
+type Message struct {
+	Payload []byte
+	Urgency string
+	// Topic lets the push service replace an undelivered message of the same topic, it must be at most
+	// 32 characters of the base64url alphabet
+	Topic string
+}
+
+type ClientInterface interface {
+	PublicKey() string
+	Send(ctx context.Context, subscription Subscription, message Message) error
+}
+
+// WebPushClient sends encrypted messages to the push services of the browsers, the VAPID token of each push
+// service is cached and renewed shortly before it expires.
+type WebPushClient struct {
+	publicKey  string
+	privateKey *ecdsa.PrivateKey
+	subject    string
+	httpClient *http.Client
+	backoff    time.Duration
+
+	mutex  sync.Mutex
+	tokens map[string]vapidToken
+}
+
+type vapidToken struct {
+	value     string
+	expiresAt time.Time
+}
+
+func NewWebPushClient(config configs.WebPushConfig) (*WebPushClient, error) {
+	privateKey, err := parseVapidKeys(config.VapidPublicKey, config.VapidPrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WebPushClient{
+		publicKey:  config.VapidPublicKey,
+		privateKey: privateKey,
+		subject:    config.VapidSubject,
+		httpClient: &http.Client{
+			Timeout: webPushRequestTimeout,
+		},
+		backoff: webPushInitialBackoff,
+		tokens:  make(map[string]vapidToken),
+	}, nil
+}
+
+func (c *WebPushClient) PublicKey() string {
+	return c.publicKey
+}
+
+// Send encrypts the message for the subscription and delivers it to the push service of the subscription, the rate
+// limits, server errors, and network errors are retried with an exponential backoff, or after the Retry-After of
+// the push service when it is shorter than the maximum retry delay
+func (c *WebPushClient) Send(ctx context.Context, subscription Subscription, message Message) error {
+	userAgentPublicKey, err := base64.RawURLEncoding.DecodeString(subscription.P256dh)
+	if err != nil {
+		return fmt.Errorf("web push subscription key is not unpadded base64url: %w", err)
+	}
+	authSecret, err := base64.RawURLEncoding.DecodeString(subscription.Auth)
+	if err != nil {
+		return fmt.Errorf("web push authentication secret is not unpadded base64url: %w", err)
+	}
+	body, err := encryptPayload(message.Payload, userAgentPublicKey, authSecret)
+	if err != nil {
+		return err
+	}
+	authorization, err := c.authorization(subscription.Endpoint)
+	if err != nil {
+		return err
+	}
+
+	var lastErr error
+	delay := c.backoff
+	for attempt := 1; attempt <= webPushMaximumAttempts; attempt++ {
+		retryAfter, err := c.post(ctx, subscription.Endpoint, authorization, body, message)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+		var serviceError *PushServiceError
+		if errors.Is(err, ErrSubscriptionGone) || (errors.As(err, &serviceError) && !serviceError.IsRetryable()) {
+			return err
+		}
+		if ctx.Err() != nil || attempt == webPushMaximumAttempts {
+			break
+		}
+
+		wait := delay
+		if retryAfter > 0 && retryAfter <= webPushMaximumRetryAfter {
+			wait = retryAfter
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.Join(lastErr, ctx.Err())
+		case <-timer.C:
+		}
+		delay *= 2
+	}
+
+	return lastErr
+}
+
+func (c *WebPushClient) post(
+	ctx context.Context,
+	endpoint string,
+	authorization string,
+	body []byte,
+	message Message,
+) (time.Duration, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	urgency := message.Urgency
+	if urgency == "" {
+		urgency = Urgency_Normal
+	}
+	request.Header.Set("Authorization", authorization)
+	request.Header.Set("Content-Encoding", "aes128gcm")
+	request.Header.Set("Content-Type", "application/octet-stream")
+	request.Header.Set("TTL", strconv.Itoa(int(webPushTTL.Seconds())))
+	request.Header.Set("Urgency", urgency)
+	if message.Topic != "" {
+		request.Header.Set("Topic", message.Topic)
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	responseBody, _ := io.ReadAll(io.LimitReader(response.Body, webPushMaximumResponseBytes))
+
+	switch {
+	case response.StatusCode >= http.StatusOK && response.StatusCode < http.StatusMultipleChoices:
+		return 0, nil
+	case response.StatusCode == http.StatusNotFound || response.StatusCode == http.StatusGone:
+		return 0, ErrSubscriptionGone
+	}
+	var retryAfter time.Duration
+	if seconds, err := strconv.Atoi(response.Header.Get("Retry-After")); err == nil && seconds > 0 {
+		retryAfter = time.Duration(seconds) * time.Second
+	}
+
+	return retryAfter, &PushServiceError{StatusCode: response.StatusCode, Message: string(bytes.TrimSpace(responseBody))}
+}
+
+// authorization returns the VAPID authorization of the push service of the endpoint, the token is bound to the
+// origin of the push service, so the subscriptions of the same browser vendor share one token
+func (c *WebPushClient) authorization(endpoint string) (string, error) {
+	audience, err := vapidAudience(endpoint)
+	if err != nil {
+		return "", err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := time.Now()
+	token, ok := c.tokens[audience]
+	if !ok || now.Add(time.Hour).After(token.expiresAt) {
+		expiresAt := now.Add(vapidTokenLifetime)
+		value, err := signVapidToken(c.privateKey, audience, c.subject, expiresAt)
+		if err != nil {
+			return "", err
+		}
+		token = vapidToken{value: value, expiresAt: expiresAt}
+		c.tokens[audience] = token
+	}
+
+	return "vapid t=" + token.value + ", k=" + c.publicKey, nil
+}
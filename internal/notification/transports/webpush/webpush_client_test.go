@@ -0,0 +1,130 @@
+package webpush
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	configs "github.com/HiIamJeff67/notegic-backend/internal/notification/configs"
+	webpushfake "github.com/HiIamJeff67/notegic-backend/internal/notification/transports/webpush/webpushfake"
+)
+
+var _ ClientInterface = (*WebPushClient)(nil)
+
+func newTestWebPushClient(t *testing.T) *WebPushClient {
+	t.Helper()
+
+	publicKey, privateKey, err := GenerateVapidKeys()
+	if err != nil {
+		t.Fatalf("GenerateVapidKeys() error = %v", err)
+	}
+	client, err := NewWebPushClient(configs.WebPushConfig{
+		VapidPublicKey:  publicKey,
+		VapidPrivateKey: privateKey,
+		VapidSubject:    "mailto:push@notegic.example",
+	})
+	if err != nil {
+		t.Fatalf("NewWebPushClient() error = %v", err)
+	}
+	client.backoff = time.Millisecond
+
+	return client
+}
+
+func TestWebPushClientDeliversEncryptedMessage(t *testing.T) {
+	client := newTestWebPushClient(t)
+	server := webpushfake.NewServer(client.PublicKey())
+	defer server.Close()
+	endpoint, p256dh, auth := server.Subscribe()
+
+	err := client.Send(context.Background(), Subscription{Endpoint: endpoint, P256dh: p256dh, Auth: auth}, Message{
+		Payload: []byte(`{"title":"Storage almost full"}`),
+		Urgency: Urgency_High,
+		Topic:   "storage",
+	})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	messages := server.Messages(endpoint)
+	if len(messages) != 1 {
+		t.Fatalf("delivered messages = %d, want 1", len(messages))
+	}
+	if message := messages[0]; string(message.Payload) != `{"title":"Storage almost full"}` ||
+		message.Urgency != Urgency_High ||
+		message.Topic != "storage" ||
+		message.TTL != int(webPushTTL.Seconds()) {
+		t.Fatalf("delivered message = %#v", message)
+	}
+
+	// the cached VAPID token is reused for the same push service
+	if err := client.Send(context.Background(), Subscription{Endpoint: endpoint, P256dh: p256dh, Auth: auth}, Message{Payload: []byte("second")}); err != nil {
+		t.Fatalf("Send() second message error = %v", err)
+	}
+	if len(client.tokens) != 1 {
+		t.Fatalf("cached VAPID tokens = %d, want 1", len(client.tokens))
+	}
+}
+
+func TestWebPushClientRetriesTransientFailures(t *testing.T) {
+	client := newTestWebPushClient(t)
+	server := webpushfake.NewServer(client.PublicKey())
+	defer server.Close()
+	endpoint, p256dh, auth := server.Subscribe()
+	subscription := Subscription{Endpoint: endpoint, P256dh: p256dh, Auth: auth}
+
+	server.FailNext(endpoint, http.StatusServiceUnavailable, http.StatusTooManyRequests)
+	if err := client.Send(context.Background(), subscription, Message{Payload: []byte("retried")}); err != nil {
+		t.Fatalf("Send() error = %v, want the third attempt to succeed", err)
+	}
+	if messages := server.Messages(endpoint); len(messages) != 1 {
+		t.Fatalf("delivered messages = %d, want 1", len(messages))
+	}
+
+	server.FailNext(endpoint, http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError)
+	var serviceError *PushServiceError
+	if err := client.Send(context.Background(), subscription, Message{Payload: []byte("failed")}); !errors.As(err, &serviceError) || !serviceError.IsRetryable() {
+		t.Fatalf("Send() error = %v, want a retryable push service error after the last attempt", err)
+	}
+
+	server.FailNext(endpoint, http.StatusBadRequest)
+	if err := client.Send(context.Background(), subscription, Message{Payload: []byte("rejected")}); !errors.As(err, &serviceError) || serviceError.IsRetryable() {
+		t.Fatalf("Send() error = %v, want a rejected message not to be retried", err)
+	}
+	if messages := server.Messages(endpoint); len(messages) != 1 {
+		t.Fatalf("delivered messages = %d, want 1", len(messages))
+	}
+}
+
+func TestWebPushClientReportsGoneSubscription(t *testing.T) {
+	client := newTestWebPushClient(t)
+	server := webpushfake.NewServer(client.PublicKey())
+	defer server.Close()
+	endpoint, p256dh, auth := server.Subscribe()
+	server.Unsubscribe(endpoint)
+
+	err := client.Send(context.Background(), Subscription{Endpoint: endpoint, P256dh: p256dh, Auth: auth}, Message{Payload: []byte("gone")})
+	if !errors.Is(err, ErrSubscriptionGone) {
+		t.Fatalf("Send() error = %v, want ErrSubscriptionGone", err)
+	}
+}
+
+func TestNewWebPushClientRejectsMismatchedKeys(t *testing.T) {
+	publicKey, _, err := GenerateVapidKeys()
+	if err != nil {
+		t.Fatalf("GenerateVapidKeys() error = %v", err)
+	}
+	_, privateKey, err := GenerateVapidKeys()
+	if err != nil {
+		t.Fatalf("GenerateVapidKeys() error = %v", err)
+	}
+
+	if _, err := NewWebPushClient(configs.WebPushConfig{
+		VapidPublicKey:  publicKey,
+		VapidPrivateKey: privateKey,
+		VapidSubject:    "mailto:push@notegic.example",
+	}); err == nil {
+		t.Fatal("NewWebPushClient() expected an error for a public key of another key pair")
+	}
+}
@@ -0,0 +1,287 @@
+// Package webpushfake serves a push service and acts as the browsers subscribed to it, so the web push client can be
+// exercised end to end without a browser, including the VAPID verification and the decryption of the payloads.
+package webpushfake
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Message is a push message as the browser of the subscription received it, after the decryption.
+type Message struct {
+	Endpoint string
+	Urgency  string
+	Topic    string
+	TTL      int
+	Payload  []byte
+}
+
+type subscription struct {
+	privateKey *ecdh.PrivateKey
+	authSecret []byte
+	gone       bool
+	failures   []int
+}
+
+type Server struct {
+	*httptest.Server
+
+	applicationServerKey string
+
+	mutex         sync.Mutex
+	subscriptions map[string]*subscription
+	messages      []Message
+	sequence      int
+}
+
+// NewServer accepts the messages signed by the VAPID key pair of the given public key only, like a push service
+// accepts the messages of the subscriptions made with that applicationServerKey only.
+func NewServer(applicationServerKey string) *Server {
+	server := &Server{
+		applicationServerKey: applicationServerKey,
+		subscriptions:        make(map[string]*subscription),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /push/{id}", server.handlePush)
+	server.Server = httptest.NewServer(mux)
+
+	return server
+}
+
+/* ============================== Test Helpers ============================== */
+
+// Subscribe acts as a browser subscribing to the push service, and returns the subscription as the browser would
+// hand it over to the application.
+func (s *Server) Subscribe() (endpoint string, p256dh string, auth string) {
+	privateKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	authSecret := make([]byte, 16)
+	if _, err := rand.Read(authSecret); err != nil {
+		panic(err)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.sequence++
+	id := fmt.Sprintf("subscription-%d", s.sequence)
+	s.subscriptions[id] = &subscription{privateKey: privateKey, authSecret: authSecret}
+
+	return s.URL + "/push/" + id,
+		base64.RawURLEncoding.EncodeToString(privateKey.PublicKey().Bytes()),
+		base64.RawURLEncoding.EncodeToString(authSecret)
+}
+
+// Unsubscribe acts as the browser dropping the subscription, the push service then answers 410 Gone.
+func (s *Server) Unsubscribe(endpoint string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	subscription, ok := s.subscriptions[s.subscriptionId(endpoint)]
+	if ok {
+		subscription.gone = true
+	}
+	return ok
+}
+
+// FailNext answers the next requests of the subscription with the given status codes, in order.
+func (s *Server) FailNext(endpoint string, statusCodes ...int) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	subscription, ok := s.subscriptions[s.subscriptionId(endpoint)]
+	if ok {
+		subscription.failures = append(subscription.failures, statusCodes...)
+	}
+	return ok
+}
+
+func (s *Server) Messages(endpoint string) []Message {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var messages []Message
+	for _, message := range s.messages {
+		if message.Endpoint == endpoint {
+			messages = append(messages, message)
+		}
+	}
+	return messages
+}
+
+/* ============================== Handlers ============================== */
+
+func (s *Server) handlePush(responseWriter http.ResponseWriter, request *http.Request) {
+	body, err := io.ReadAll(io.LimitReader(request.Body, 4097))
+	if err != nil || len(body) > 4096 {
+		http.Error(responseWriter, "payload too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+	if err := s.verifyAuthorization(request.Header.Get("Authorization")); err != nil {
+		http.Error(responseWriter, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	ttl, err := strconv.Atoi(request.Header.Get("TTL"))
+	if err != nil || ttl < 0 {
+		http.Error(responseWriter, "TTL header is required", http.StatusBadRequest)
+		return
+	}
+	if request.Header.Get("Content-Encoding") != "aes128gcm" {
+		http.Error(responseWriter, "unsupported content encoding", http.StatusUnsupportedMediaType)
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	subscription, ok := s.subscriptions[request.PathValue("id")]
+	if !ok {
+		http.Error(responseWriter, "subscription not found", http.StatusNotFound)
+		return
+	}
+	if subscription.gone {
+		http.Error(responseWriter, "subscription expired", http.StatusGone)
+		return
+	}
+	if len(subscription.failures) > 0 {
+		statusCode := subscription.failures[0]
+		subscription.failures = subscription.failures[1:]
+		http.Error(responseWriter, http.StatusText(statusCode), statusCode)
+		return
+	}
+	payload, err := decrypt(body, subscription.privateKey, subscription.authSecret)
+	if err != nil {
+		http.Error(responseWriter, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.messages = append(s.messages, Message{
+		Endpoint: s.URL + request.URL.Path,
+		Urgency:  request.Header.Get("Urgency"),
+		Topic:    request.Header.Get("Topic"),
+		TTL:      ttl,
+		Payload:  payload,
+	})
+	responseWriter.WriteHeader(http.StatusCreated)
+}
+
+// verifyAuthorization checks the VAPID authorization of RFC 8292, the token must be signed by the application server
+// key, bound to the origin of this push service, and expire within a day
+func (s *Server) verifyAuthorization(authorization string) error {
+	var token, key string
+	for _, parameter := range strings.Split(strings.TrimPrefix(authorization, "vapid "), ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(parameter), "=")
+		switch name {
+		case "t":
+			token = value
+		case "k":
+			key = value
+		}
+	}
+	if !strings.HasPrefix(authorization, "vapid ") || token == "" || key != s.applicationServerKey {
+		return errors.New("VAPID authorization is missing or uses another application server key")
+	}
+	keyBytes, err := base64.RawURLEncoding.DecodeString(key)
+	if err != nil {
+		return err
+	}
+	publicKey, err := ecdsa.ParseUncompressedPublicKey(elliptic.P256(), keyBytes)
+	if err != nil {
+		return err
+	}
+
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (any, error) {
+		return publicKey, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodES256.Alg()}), jwt.WithAudience(s.URL), jwt.WithExpirationRequired()); err != nil {
+		return err
+	}
+	expiresAt, err := claims.GetExpirationTime()
+	if err != nil || expiresAt.After(time.Now().Add(24*time.Hour)) {
+		return errors.New("VAPID token must expire within 24 hours")
+	}
+	if subject, _ := claims["sub"].(string); subject == "" {
+		return errors.New("VAPID token must have a subject")
+	}
+
+	return nil
+}
+
+func decrypt(body []byte, privateKey *ecdh.PrivateKey, authSecret []byte) ([]byte, error) {
+	if len(body) < 21 {
+		return nil, errors.New("content coding header is truncated")
+	}
+	salt, keyIdLength := body[:16], int(body[20])
+	if binary.BigEndian.Uint32(body[16:20]) < 18 || len(body) < 21+keyIdLength {
+		return nil, errors.New("content coding header is invalid")
+	}
+	applicationServerPublicKey := body[21 : 21+keyIdLength]
+	remotePublicKey, err := ecdh.P256().NewPublicKey(applicationServerPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	sharedSecret, err := privateKey.ECDH(remotePublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	userAgentPublicKey := privateKey.PublicKey().Bytes()
+	keyInfo := append(append([]byte("WebPush: info\x00"), userAgentPublicKey...), applicationServerPublicKey...)
+	inputKeyingMaterial, err := hkdf.Key(sha256.New, sharedSecret, authSecret, string(keyInfo), 32)
+	if err != nil {
+		return nil, err
+	}
+	contentEncryptionKey, err := hkdf.Key(sha256.New, inputKeyingMaterial, salt, "Content-Encoding: aes128gcm\x00", 16)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := hkdf.Key(sha256.New, inputKeyingMaterial, salt, "Content-Encoding: nonce\x00", 12)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(contentEncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	record, err := gcm.Open(nil, nonce, body[21+keyIdLength:], nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// the padding of the last record is a 0x02 delimiter followed by zeros
+	record = bytes.TrimRight(record, "\x00")
+	if len(record) == 0 || record[len(record)-1] != 0x02 {
+		return nil, errors.New("the last record delimiter is missing")
+	}
+	return record[:len(record)-1], nil
+}
+
+func (s *Server) subscriptionId(endpoint string) string {
+	return strings.TrimPrefix(endpoint, s.URL+"/push/")
+}
@@ -0,0 +1,68 @@
+package workers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	logs "github.com/HiIamJeff67/notegic-backend/shared/platform/observability/logs"
+
+	services "github.com/HiIamJeff67/notegic-backend/internal/notification/services"
+)
+
+// DeliveryWorker hands the due notification deliveries and email digests over to their channels, the deliveries
+// deferred by quiet hours or digests stay in the database until they are due, so a restart never loses them
+type DeliveryWorker struct {
+	service      services.NotificationServiceInterface
+	workerId     string
+	interval     time.Duration
+	batchSize    int
+	claimTimeout time.Duration
+}
+
+func NewDeliveryWorker(
+	service services.NotificationServiceInterface,
+	interval time.Duration,
+	batchSize int,
+	claimTimeout time.Duration,
+) *DeliveryWorker {
+	return &DeliveryWorker{
+		service:      service,
+		workerId:     "notification-delivery-worker",
+		interval:     interval,
+		batchSize:    batchSize,
+		claimTimeout: claimTimeout,
+	}
+}
+
+func (w *DeliveryWorker) Start(ctx context.Context) func() {
+	workerCtx, cancel := context.WithCancel(ctx)
+	var waitGroup sync.WaitGroup
+	waitGroup.Add(1)
+	go func() {
+		defer waitGroup.Done()
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+		for {
+			if _, err := w.service.DispatchEmailDeliveries(workerCtx, w.workerId, w.batchSize, w.claimTimeout); err != nil && logs.NotegicLogger != nil {
+				logs.NotegicLogger.Error(workerCtx, err, "Failed to dispatch Notification email deliveries")
+			}
+			if _, err := w.service.DispatchPushDeliveries(workerCtx, w.workerId, w.batchSize, w.claimTimeout); err != nil && logs.NotegicLogger != nil {
+				logs.NotegicLogger.Error(workerCtx, err, "Failed to dispatch Notification push deliveries")
+			}
+			if _, err := w.service.DispatchEmailDigests(workerCtx, w.workerId, w.batchSize, w.claimTimeout); err != nil && logs.NotegicLogger != nil {
+				logs.NotegicLogger.Error(workerCtx, err, "Failed to dispatch Notification email digests")
+			}
+			select {
+			case <-workerCtx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		waitGroup.Wait()
+	}
+}
@@ -9,14 +9,22 @@ import (
 	sharedstrings "github.com/HiIamJeff67/notegic-backend/shared/lib/strings"
 )
 
+// the static discovery routes to the configured endpoints as they are, while the dns discovery periodically
+// re-resolves their hostnames, so the replicas behind one service name are discovered as they come and go
+const (
+	YjsWorkerDiscovery_Static = "static"
+	YjsWorkerDiscovery_DNS    = "dns"
+)
+
 type Config struct {
-	ListenAddress     string
-	TrustedProxies    []string
-	AllowedDomains    []string
-	RealtimeEnabled   bool
-	BetaUserPublicIds []string
-	YjsWorkerUrls     []string
-	KafkaConsumer     KafkaConsumerConfig
+	ListenAddress      string
+	TrustedProxies     []string
+	AllowedDomains     []string
+	RealtimeEnabled    bool
+	BetaUserPublicIds  []string
+	YjsWorkerUrls      []string
+	YjsWorkerDiscovery string
+	KafkaConsumer      KafkaConsumerConfig
 }
 
 func LoadConfig() (Config, error) {
@@ -25,16 +33,24 @@ func LoadConfig() (Config, error) {
 		return Config{}, fmt.Errorf("REALTIME_ENABLED must be a boolean")
 	}
 	config := Config{
-		ListenAddress:     strings.TrimSpace(os.Getenv("REALTIME_GATEWAY_LISTEN_ADDRESS")),
-		TrustedProxies:    sharedstrings.SplitValues(os.Getenv("GIN_TRUSTED_PROXIES")),
-		AllowedDomains:    sharedstrings.SplitValues(os.Getenv("ALLOWED_DOMAINS")),
-		RealtimeEnabled:   realtimeEnabled,
-		BetaUserPublicIds: sharedstrings.SplitValues(os.Getenv("REALTIME_BETA_USER_PUBLIC_IDS")),
-		YjsWorkerUrls:     sharedstrings.SplitValues(os.Getenv("YJS_WORKER_URLS")),
+		ListenAddress:      strings.TrimSpace(os.Getenv("REALTIME_GATEWAY_LISTEN_ADDRESS")),
+		TrustedProxies:     sharedstrings.SplitValues(os.Getenv("GIN_TRUSTED_PROXIES")),
+		AllowedDomains:     sharedstrings.SplitValues(os.Getenv("ALLOWED_DOMAINS")),
+		RealtimeEnabled:    realtimeEnabled,
+		BetaUserPublicIds:  sharedstrings.SplitValues(os.Getenv("REALTIME_BETA_USER_PUBLIC_IDS")),
+		YjsWorkerUrls:      sharedstrings.SplitValues(os.Getenv("YJS_WORKER_URLS")),
+		YjsWorkerDiscovery: strings.TrimSpace(os.Getenv("YJS_WORKER_DISCOVERY")),
 	}
 	if config.ListenAddress == "" || len(config.YjsWorkerUrls) == 0 {
 		return Config{}, fmt.Errorf("REALTIME_GATEWAY_LISTEN_ADDRESS and YJS_WORKER_URLS are required")
 	}
+	switch config.YjsWorkerDiscovery {
+	case "":
+		config.YjsWorkerDiscovery = YjsWorkerDiscovery_Static
+	case YjsWorkerDiscovery_Static, YjsWorkerDiscovery_DNS:
+	default:
+		return Config{}, fmt.Errorf("YJS_WORKER_DISCOVERY must be either %s or %s", YjsWorkerDiscovery_Static, YjsWorkerDiscovery_DNS)
+	}
 	config.KafkaConsumer, err = loadKafkaConsumerConfig()
 	if err != nil {
 		return Config{}, err
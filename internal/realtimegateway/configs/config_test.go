@@ -17,7 +17,18 @@ func TestLoadConfig(t *testing.T) {
 	if err != nil {
 		t.Fatalf("LoadConfig() error = %v", err)
 	}
-	if config.KafkaConsumer.InitialRetryBackoff != 250*time.Millisecond {
+	if config.KafkaConsumer.InitialRetryBackoff != 250*time.Millisecond || config.YjsWorkerDiscovery != YjsWorkerDiscovery_Static {
 		t.Fatalf("LoadConfig() = %#v", config)
 	}
+
+	t.Setenv("YJS_WORKER_DISCOVERY", "dns")
+	config, err = LoadConfig()
+	if err != nil || config.YjsWorkerDiscovery != YjsWorkerDiscovery_DNS {
+		t.Fatalf("LoadConfig() = %#v, %v", config, err)
+	}
+
+	t.Setenv("YJS_WORKER_DISCOVERY", "consul")
+	if _, err := LoadConfig(); err == nil {
+		t.Fatal("expected an unknown YJS_WORKER_DISCOVERY to be rejected")
+	}
 }
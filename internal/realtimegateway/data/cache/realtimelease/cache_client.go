@@ -51,11 +51,22 @@ type BlockPackChannelRevocation struct {
 	Reason             coreeventscontract.BlockPackAccessRevocationReason `json:"reason"`
 }
 
+type BlockPackDocumentRestoration struct {
+	EventId        uuid.UUID `json:"eventId"`
+	BlockPackId    uuid.UUID `json:"blockPackId"`
+	UpdateSequence int64     `json:"updateSequence"`
+}
+
 type UserSessionRevocation struct {
 	EventId      uuid.UUID `json:"eventId"`
 	UserPublicId uuid.UUID `json:"userPublicId"`
 }
 
+type ShareLinkRevocation struct {
+	EventId     uuid.UUID `json:"eventId"`
+	ShareLinkId uuid.UUID `json:"shareLinkId"`
+}
+
 type ResourceEvent struct {
 	EventId            uuid.UUID  `json:"eventId"`
 	EventType          string     `json:"eventType"`
@@ -193,6 +204,10 @@ func (s *RealtimeLeaseCacheClient) blockPackChannelRevocationKey() string {
 	return "Realtime:blockPack:channel-revocations"
 }
 
+func (s *RealtimeLeaseCacheClient) blockPackDocumentRestorationKey() string {
+	return "Realtime:blockPack:document-restorations"
+}
+
 func (s *RealtimeLeaseCacheClient) blockPackPresenceKey() string {
 	return "Realtime:blockPack:presence-events"
 }
@@ -201,6 +216,10 @@ func (s *RealtimeLeaseCacheClient) userSessionRevocationKey() string {
 	return "Realtime:user:session-revocations"
 }
 
+func (s *RealtimeLeaseCacheClient) shareLinkRevocationKey() string {
+	return "Realtime:shareLink:revocations"
+}
+
 func (s *RealtimeLeaseCacheClient) userNotificationKey() string {
 	return "Realtime:user:notifications"
 }
@@ -283,6 +302,52 @@ func (s *RealtimeLeaseCacheClient) SubscribeBlockPackChannelRevocations(
 	}, nil
 }
 
+func (s *RealtimeLeaseCacheClient) PublishBlockPackDocumentRestoration(
+	restoration BlockPackDocumentRestoration,
+) error {
+	redisClient, err := s.getRedisClient(restoration.BlockPackId.String())
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(restoration)
+	if err != nil {
+		return err
+	}
+
+	return redisClient.Publish(s.blockPackDocumentRestorationKey(), payload).Err()
+}
+
+func (s *RealtimeLeaseCacheClient) SubscribeBlockPackDocumentRestorations(
+	handler func(BlockPackDocumentRestoration),
+) (func(), error) {
+	redisClient, err := s.getRedisClient(s.blockPackDocumentRestorationKey())
+	if err != nil {
+		return nil, err
+	}
+
+	pubsub := redisClient.Subscribe(s.blockPackDocumentRestorationKey())
+	if _, err := pubsub.Receive(); err != nil {
+		_ = pubsub.Close()
+		return nil, err
+	}
+
+	go func() {
+		for message := range pubsub.Channel() {
+			var restoration BlockPackDocumentRestoration
+			if err := json.Unmarshal([]byte(message.Payload), &restoration); err != nil {
+				continue
+			}
+
+			handler(restoration)
+		}
+	}()
+
+	return func() {
+		_ = pubsub.Close()
+	}, nil
+}
+
 func (s *RealtimeLeaseCacheClient) PublishUserSessionRevocation(
 	revocation UserSessionRevocation,
 ) error {
@@ -329,6 +394,52 @@ func (s *RealtimeLeaseCacheClient) SubscribeUserSessionRevocations(
 	}, nil
 }
 
+func (s *RealtimeLeaseCacheClient) PublishShareLinkRevocation(
+	revocation ShareLinkRevocation,
+) error {
+	redisClient, err := s.getRedisClient(s.shareLinkRevocationKey())
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(revocation)
+	if err != nil {
+		return err
+	}
+
+	return redisClient.Publish(s.shareLinkRevocationKey(), payload).Err()
+}
+
+func (s *RealtimeLeaseCacheClient) SubscribeShareLinkRevocations(
+	handler func(ShareLinkRevocation),
+) (func(), error) {
+	redisClient, err := s.getRedisClient(s.shareLinkRevocationKey())
+	if err != nil {
+		return nil, err
+	}
+
+	pubsub := redisClient.Subscribe(s.shareLinkRevocationKey())
+	if _, err := pubsub.Receive(); err != nil {
+		_ = pubsub.Close()
+		return nil, err
+	}
+
+	go func() {
+		for message := range pubsub.Channel() {
+			var revocation ShareLinkRevocation
+			if err := json.Unmarshal([]byte(message.Payload), &revocation); err != nil {
+				continue
+			}
+
+			handler(revocation)
+		}
+	}()
+
+	return func() {
+		_ = pubsub.Close()
+	}, nil
+}
+
 func (s *RealtimeLeaseCacheClient) PublishNotification(event NotificationEvent) error {
 	if event.EventId == uuid.Nil || event.RecipientUserPublicId == uuid.Nil {
 		return errors.New("realtime notification event is incomplete")
@@ -869,3 +980,56 @@ func (s *RealtimeLeaseCacheClient) SetBlockPackParticipant(
 
 	return err
 }
+
+/* ============================== Channel Worker Methods ============================== */
+
+func (s *RealtimeLeaseCacheClient) channelWorkerKey(channelId uuid.UUID) string {
+	return fmt.Sprintf("Realtime:channel:%s:worker", channelId)
+}
+
+// GetChannelWorker returns the YjsWorker endpoint owning the channel, or an empty endpoint when no gateway owns it
+func (s *RealtimeLeaseCacheClient) GetChannelWorker(channelId uuid.UUID) (string, error) {
+	redisClient, err := s.getRedisClient(channelId.String())
+	if err != nil {
+		return "", err
+	}
+
+	endpoint, err := redisClient.Get(s.channelWorkerKey(channelId)).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", nil
+	}
+
+	return endpoint, err
+}
+
+// ClaimChannelWorker replaces the owner of the channel by the endpoint only while it is still the expected one or
+// has expired, and returns the owner after the claim, so the gateways racing on a failover all settle on one endpoint,
+// claiming the current owner again renews it
+func (s *RealtimeLeaseCacheClient) ClaimChannelWorker(
+	channelId uuid.UUID,
+	expectedEndpoint string,
+	endpoint string,
+) (string, error) {
+	redisClient, err := s.getRedisClient(channelId.String())
+	if err != nil {
+		return "", err
+	}
+
+	result, err := redisscripts.ClaimRealtimeChannelWorker.Eval(
+		redisClient,
+		[]string{s.channelWorkerKey(channelId)},
+		expectedEndpoint,
+		endpoint,
+		constants.RealtimeWorkerChannelOwnerTTL.Milliseconds(),
+	).Result()
+	if err != nil {
+		return "", err
+	}
+
+	owner, ok := result.(string)
+	if !ok {
+		return "", errors.New("realtime redis channel worker claim returned an invalid result")
+	}
+
+	return owner, nil
+}
@@ -313,3 +313,58 @@ func TestRealtimeLeaseCacheClientPublishesBlockPackPresenceEvent(t *testing.T) {
 		t.Fatal("timed out waiting for BlockPack presence event")
 	}
 }
+
+func TestRealtimeLeaseCacheClientSettlesConcurrentChannelWorkerClaims(t *testing.T) {
+	server, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("failed to start test redis server: %v", err)
+	}
+	defer server.Close()
+
+	redisClient := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	defer redisClient.Close()
+
+	store := newTestRealtimeLeaseCacheClient(t, redisClient)
+	channelId := uuid.New()
+	if _, err := store.ClaimChannelWorker(channelId, "", "ws://failed"); err != nil {
+		t.Fatalf("failed to claim the realtime channel worker: %v", err)
+	}
+
+	// every gateway replaces the failed worker by the worker of its own ring at the same time
+	endpoints := []string{"ws://a", "ws://b", "ws://c", "ws://d"}
+	owners := make([]string, len(endpoints))
+	var waitGroup sync.WaitGroup
+	for index, endpoint := range endpoints {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+
+			owner, err := store.ClaimChannelWorker(channelId, "ws://failed", endpoint)
+			if err != nil {
+				t.Errorf("failed to claim the realtime channel worker: %v", err)
+
+				return
+			}
+			owners[index] = owner
+		}()
+	}
+	waitGroup.Wait()
+
+	owner, err := store.GetChannelWorker(channelId)
+	if err != nil || owner == "ws://failed" {
+		t.Fatalf("expected the failed worker to be replaced, got %q with %v", owner, err)
+	}
+	for index, claimedOwner := range owners {
+		if claimedOwner != owner {
+			t.Fatalf("claim %d returned %q, want every gateway to settle on %q", index, claimedOwner, owner)
+		}
+	}
+
+	if renewedOwner, err := store.ClaimChannelWorker(channelId, "ws://failed", "ws://late"); err != nil || renewedOwner != owner {
+		t.Fatalf("expected a stale claim to keep %q, got %q with %v", owner, renewedOwner, err)
+	}
+	server.FastForward(constants.RealtimeWorkerChannelOwnerTTL)
+	if expiredOwner, err := store.GetChannelWorker(channelId); err != nil || expiredOwner != "" {
+		t.Fatalf("expected the channel worker to expire, got %q with %v", expiredOwner, err)
+	}
+}
@@ -0,0 +1,14 @@
+-- Atomically claims or renews the YjsWorker owning one realtime channel.
+-- keys[1]: channel worker key
+-- argv[1]: expected current worker, empty when the caller has seen no owner
+-- argv[2]: claimed worker
+-- argv[3]: Redis key TTL in milliseconds
+local current = redis.call('GET', KEYS[1])
+
+if current and current ~= ARGV[1] then
+    return current
+end
+
+redis.call('SET', KEYS[1], ARGV[2], 'PX', tonumber(ARGV[3]))
+
+return ARGV[2]
@@ -16,7 +16,12 @@ var (
 	//go:embed realtime_lease_release.lua
 	releaseRealtimeLeaseContent string
 
+	//go:embed realtime_channel_worker_claim.lua
+	claimRealtimeChannelWorkerContent string
+
 	AcquireRealtimeLease = redis.NewScript(acquireRealtimeLeaseContent)
 	RefreshRealtimeLease = redis.NewScript(refreshRealtimeLeaseContent)
 	ReleaseRealtimeLease = redis.NewScript(releaseRealtimeLeaseContent)
+
+	ClaimRealtimeChannelWorker = redis.NewScript(claimRealtimeChannelWorkerContent)
 )
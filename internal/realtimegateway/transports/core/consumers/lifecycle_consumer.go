@@ -149,11 +149,43 @@ func (c *LifecycleConsumer) process(
 			TargetUserPublicId: data.TargetUserPublicId,
 			Reason:             data.Reason,
 		})
+	case coreeventscontract.EventType_BlockPackDocumentRestored:
+		var data coreeventscontract.BlockPackDocumentRestoredData
+		if err := json.Unmarshal(envelope.Data, &data); err != nil {
+			return &platformkafka.ConsumerError{
+				Classification: platformkafka.ErrorClassification_SchemaIncompatible,
+				Origin:         err,
+			}
+		}
+		if envelope.AggregateId == uuid.Nil || data.UpdateSequence <= 0 {
+			return &platformkafka.ConsumerError{
+				Classification: platformkafka.ErrorClassification_SchemaIncompatible,
+				Origin:         errors.New("Kafka BlockPack document restoration is incomplete"),
+			}
+		}
+
+		return c.leaseStore.PublishBlockPackDocumentRestoration(realtimelease.BlockPackDocumentRestoration{
+			EventId:        envelope.EventId,
+			BlockPackId:    envelope.AggregateId,
+			UpdateSequence: data.UpdateSequence,
+		})
 	case coreeventscontract.EventType_UserSessionsRevoked:
 		return c.leaseStore.PublishUserSessionRevocation(realtimelease.UserSessionRevocation{
 			EventId:      envelope.EventId,
 			UserPublicId: envelope.AggregateId,
 		})
+	case coreeventscontract.EventType_ShareLinkRevoked:
+		if envelope.AggregateId == uuid.Nil {
+			return &platformkafka.ConsumerError{
+				Classification: platformkafka.ErrorClassification_SchemaIncompatible,
+				Origin:         errors.New("Kafka share link revocation is incomplete"),
+			}
+		}
+
+		return c.leaseStore.PublishShareLinkRevocation(realtimelease.ShareLinkRevocation{
+			EventId:     envelope.EventId,
+			ShareLinkId: envelope.AggregateId,
+		})
 	case coreeventscontract.EventType_UserDeleted:
 		return nil
 	case coreeventscontract.EventType_BlockPackRoomPolicyChanged,
@@ -85,3 +85,120 @@ func TestLifecycleConsumerPublishesCompletedRoutineTaskToRealtimeGateway(t *test
 		t.Fatal("expected completed RoutineTask lifecycle event")
 	}
 }
+
+func TestLifecycleConsumerPublishesBlockPackDocumentRestorationToRealtimeGateway(t *testing.T) {
+	server, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("start Redis: %v", err)
+	}
+	defer server.Close()
+
+	redisClient := redis.NewClient(&redis.Options{
+		Addr: server.Addr(),
+	})
+	defer redisClient.Close()
+
+	leaseStore := realtimelease.NewRealtimeLeaseCacheClient(
+		realtimelease.NewRealtimeLeaseCacheStore(
+			platformredis.NewClientSetFromClients(redisClient),
+		),
+	)
+	consumer := NewLifecycleConsumer(leaseStore, platformkafka.ConsumerConfig{})
+	received := make(chan realtimelease.BlockPackDocumentRestoration, 1)
+	shutdown, err := leaseStore.SubscribeBlockPackDocumentRestorations(func(restoration realtimelease.BlockPackDocumentRestoration) {
+		received <- restoration
+	})
+	if err != nil {
+		t.Fatalf("subscribe to document restorations: %v", err)
+	}
+	defer shutdown()
+
+	blockPackId := uuid.New()
+	payload, err := json.Marshal(coreeventscontract.BlockPackDocumentRestoredData{
+		SnapshotId:     uuid.New(),
+		UpdateSequence: 42,
+	})
+	if err != nil {
+		t.Fatalf("marshal restored lifecycle event: %v", err)
+	}
+
+	if err := consumer.process(
+		context.Background(),
+		platformkafka.ConsumerRecord{},
+		eventcontract.EventEnvelope[json.RawMessage]{
+			EventId:     uuid.New(),
+			EventType:   coreeventscontract.EventType_BlockPackDocumentRestored,
+			AggregateId: blockPackId,
+			Data:        payload,
+		},
+	); err != nil {
+		t.Fatalf("process restored lifecycle event: %v", err)
+	}
+
+	select {
+	case restoration := <-received:
+		if restoration.BlockPackId != blockPackId || restoration.UpdateSequence != 42 {
+			t.Fatalf("unexpected realtime document restoration: %#v", restoration)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected BlockPack document restoration")
+	}
+}
+
+func TestLifecycleConsumerPublishesShareLinkRevocationToRealtimeGateway(t *testing.T) {
+	server, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("start Redis: %v", err)
+	}
+	defer server.Close()
+
+	redisClient := redis.NewClient(&redis.Options{
+		Addr: server.Addr(),
+	})
+	defer redisClient.Close()
+
+	leaseStore := realtimelease.NewRealtimeLeaseCacheClient(
+		realtimelease.NewRealtimeLeaseCacheStore(
+			platformredis.NewClientSetFromClients(redisClient),
+		),
+	)
+	consumer := NewLifecycleConsumer(leaseStore, platformkafka.ConsumerConfig{})
+	received := make(chan realtimelease.ShareLinkRevocation, 1)
+	shutdown, err := leaseStore.SubscribeShareLinkRevocations(func(revocation realtimelease.ShareLinkRevocation) {
+		received <- revocation
+	})
+	if err != nil {
+		t.Fatalf("subscribe to share link revocations: %v", err)
+	}
+	defer shutdown()
+
+	shareLinkId := uuid.New()
+	payload, err := json.Marshal(coreeventscontract.ShareLinkRevokedData{
+		RootShelfId: uuid.New(),
+	})
+	if err != nil {
+		t.Fatalf("marshal share link revoked lifecycle event: %v", err)
+	}
+
+	if err := consumer.process(
+		context.Background(),
+		platformkafka.ConsumerRecord{},
+		eventcontract.EventEnvelope[json.RawMessage]{
+			EventId:     uuid.New(),
+			EventType:   coreeventscontract.EventType_ShareLinkRevoked,
+			AggregateId: shareLinkId,
+			Data:        payload,
+		},
+	); err != nil {
+		t.Fatalf("process share link revoked lifecycle event: %v", err)
+	}
+
+	select {
+	case revocation := <-received:
+		if revocation.ShareLinkId != shareLinkId {
+			t.Fatalf("unexpected realtime share link revocation: %#v", revocation)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected share link revocation")
+	}
+}
@@ -11,9 +11,12 @@ import (
 	realtimetypes "github.com/HiIamJeff67/notegic-backend/internal/realtimegateway/types"
 )
 
+// Connector of a share session acts as the creator of the share link, so its
+// UserPublicId is the creator while its ShareLinkId is set, and uuid.Nil otherwise.
 type Connector struct {
 	Id            uuid.UUID
 	UserPublicId  uuid.UUID
+	ShareLinkId   uuid.UUID
 	UserAgent     string
 	connection    *websocket.Conn
 	channels      map[uint32]realtimetypes.Channel
@@ -22,6 +25,17 @@ type Connector struct {
 	outbound      *outboundQueue
 }
 
+func (c *Connector) isShareSession() bool {
+	return c.ShareLinkId != uuid.Nil
+}
+
+func (c *Connector) shareLinkIdString() string {
+	if !c.isShareSession() {
+		return ""
+	}
+	return c.ShareLinkId.String()
+}
+
 func (c *Connector) get(connectorChannelId uint32) (realtimetypes.Channel, bool) {
 	c.channelMutex.RLock()
 	defer c.channelMutex.RUnlock()
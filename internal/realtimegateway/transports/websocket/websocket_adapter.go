@@ -2,6 +2,7 @@ package websocket
 
 import (
 	"context"
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"go.opentelemetry.io/otel/attribute"
@@ -44,8 +45,10 @@ type WebSocketAdapter struct {
 	maximumConnectionsPerUser            int
 	shutdownRevocationListener           func()
 	shutdownSessionListener              func()
+	shutdownShareLinkListener            func()
 	shutdownPresenceListener             func()
 	shutdownResourceListener             func()
+	shutdownRestorationListener          func()
 	shutdownNotificationListener         func()
 	shutdownRoutineTaskLifecycleListener func()
 }
@@ -54,7 +57,11 @@ func NewWebSocketAdapter(
 	config realtimeconfig.Config,
 	leaseStore *realtimeleasecache.RealtimeLeaseCacheClient,
 ) *WebSocketAdapter {
-	workerManager := workers.NewWorkerManager(config.YjsWorkerUrls)
+	workerDiscovery := workers.NewStaticWorkerDiscovery(config.YjsWorkerUrls)
+	if config.YjsWorkerDiscovery == realtimeconfig.YjsWorkerDiscovery_DNS {
+		workerDiscovery = workers.NewDNSWorkerDiscovery(config.YjsWorkerUrls)
+	}
+	workerManager := workers.NewWorkerManager(workerDiscovery, leaseStore)
 	var realtimeBetaUserPublicIdSet map[uuid.UUID]bool
 	if len(config.BetaUserPublicIds) > 0 {
 		realtimeBetaUserPublicIdSet = make(map[uuid.UUID]bool)
@@ -83,8 +90,10 @@ func NewWebSocketAdapter(
 	workerManager.SetFrameHandler(application.handleInternalFrame)
 	application.subscribeBlockPackChannelRevocations()
 	application.subscribeUserSessionRevocations()
+	application.subscribeShareLinkRevocations()
 	application.subscribeBlockPackPresenceEvents()
 	application.subscribeResourceEvents()
+	application.subscribeBlockPackDocumentRestorations()
 	application.subscribeNotifications()
 	application.subscribeRoutineTaskLifecycleEvents()
 
@@ -101,6 +110,16 @@ func (g *WebSocketAdapter) subscribeBlockPackChannelRevocations() {
 	g.shutdownRevocationListener = shutdown
 }
 
+func (g *WebSocketAdapter) subscribeShareLinkRevocations() {
+	shutdown, err := g.leaseStore.SubscribeShareLinkRevocations(g.revokeShareLinkSessions)
+	if err != nil {
+		logs.NotegicLogger.Error(context.Background(), err, "Failed to subscribe to realtime share link revocations")
+		return
+	}
+
+	g.shutdownShareLinkListener = shutdown
+}
+
 func (g *WebSocketAdapter) subscribeBlockPackPresenceEvents() {
 	shutdown, err := g.leaseStore.SubscribeBlockPackPresenceEvents(g.broadcastBlockPackPresenceEvent)
 	if err != nil {
@@ -131,6 +150,16 @@ func (g *WebSocketAdapter) subscribeResourceEvents() {
 	g.shutdownResourceListener = shutdown
 }
 
+func (g *WebSocketAdapter) subscribeBlockPackDocumentRestorations() {
+	shutdown, err := g.leaseStore.SubscribeBlockPackDocumentRestorations(g.forwardBlockPackDocumentRestoration)
+	if err != nil {
+		logs.NotegicLogger.Error(context.Background(), err, "Failed to subscribe to realtime BlockPack document restorations")
+		return
+	}
+
+	g.shutdownRestorationListener = shutdown
+}
+
 func (g *WebSocketAdapter) subscribeNotifications() {
 	shutdown, err := g.leaseStore.SubscribeNotifications(g.broadcastNotification)
 	if err != nil {
@@ -161,7 +190,7 @@ func (g *WebSocketAdapter) broadcastNotification(event realtimeleasecache.Notifi
 	g.connectorMutex.RLock()
 	connectors := make([]*Connector, 0)
 	for _, connector := range g.connectors {
-		if connector.UserPublicId == event.RecipientUserPublicId {
+		if connector.UserPublicId == event.RecipientUserPublicId && !connector.isShareSession() {
 			connectors = append(connectors, connector)
 		}
 	}
@@ -192,7 +221,7 @@ func (g *WebSocketAdapter) broadcastRoutineTaskLifecycleEvent(
 	g.connectorMutex.RLock()
 	connectors := make([]*Connector, 0)
 	for _, connector := range g.connectors {
-		if connector.UserPublicId == event.ActorUserPublicId {
+		if connector.UserPublicId == event.ActorUserPublicId && !connector.isShareSession() {
 			connectors = append(connectors, connector)
 		}
 	}
@@ -224,7 +253,8 @@ func (g *WebSocketAdapter) broadcastResourceEvent(event realtimeleasecache.Resou
 	g.connectorMutex.RLock()
 	connectors := make([]*Connector, 0, len(g.connectors))
 	for _, connector := range g.connectors {
-		if event.TargetUserPublicId != nil && connector.UserPublicId != *event.TargetUserPublicId {
+		if event.TargetUserPublicId != nil &&
+			(connector.UserPublicId != *event.TargetUserPublicId || connector.isShareSession()) {
 			continue
 		}
 		connectors = append(connectors, connector)
@@ -269,12 +299,18 @@ func (g *WebSocketAdapter) Shutdown() {
 	if g.shutdownSessionListener != nil {
 		g.shutdownSessionListener()
 	}
+	if g.shutdownShareLinkListener != nil {
+		g.shutdownShareLinkListener()
+	}
 	if g.shutdownPresenceListener != nil {
 		g.shutdownPresenceListener()
 	}
 	if g.shutdownResourceListener != nil {
 		g.shutdownResourceListener()
 	}
+	if g.shutdownRestorationListener != nil {
+		g.shutdownRestorationListener()
+	}
 	if g.shutdownNotificationListener != nil {
 		g.shutdownNotificationListener()
 	}
@@ -297,11 +333,73 @@ func (g *WebSocketAdapter) Shutdown() {
 	}
 }
 
+// forwardBlockPackDocumentRestoration notifies the YjsWorker through any local channel of the BlockPack,
+// so the room is reloaded from Core with the restoration update. The YjsWorker ignores the duplicates
+// from the other gateways once the room has caught up with the update sequence.
+func (g *WebSocketAdapter) forwardBlockPackDocumentRestoration(restoration realtimeleasecache.BlockPackDocumentRestoration) {
+	g.connectorMutex.RLock()
+	connectors := make([]*Connector, 0, len(g.connectors))
+	for _, connector := range g.connectors {
+		connectors = append(connectors, connector)
+	}
+	g.connectorMutex.RUnlock()
+
+	payload := make([]byte, 8)
+	binary.BigEndian.PutUint64(payload, uint64(restoration.UpdateSequence))
+	for _, connector := range connectors {
+		connector.channelMutex.RLock()
+		connectorChannelId, exists := uint32(0), false
+		for id, channel := range connector.channels {
+			if channel.Type == realtimetypes.ChannelType_BlockPack && channel.Id == restoration.BlockPackId {
+				connectorChannelId, exists = id, true
+				break
+			}
+		}
+		connector.channelMutex.RUnlock()
+		if !exists {
+			continue
+		}
+
+		if !g.workerManager.Forward(realtimetypes.InternalFrame{
+			Version:            byte(constants.RealtimeWorkerProtocolVersion),
+			Type:               realtimetypes.InternalFrameType_YjsDocumentRestored,
+			ChannelType:        realtimetypes.ChannelType_BlockPack,
+			ConnectionId:       connector.Id,
+			ConnectorChannelId: connectorChannelId,
+			ChannelId:          restoration.BlockPackId,
+			Payload:            payload,
+		}) {
+			metrics.NotegicMeter.Count(context.Background(), "realtime.frame.rejected.count", 1,
+				attribute.String("direction", "internal"),
+				attribute.String("reason", "worker_unavailable"),
+				attribute.String("channelType", string(realtimetypes.ChannelType_BlockPack)),
+			)
+		}
+
+		return
+	}
+}
+
 func (g *WebSocketAdapter) revokeUserSessions(revocation realtimeleasecache.UserSessionRevocation) {
 	g.connectorMutex.RLock()
 	connections := make([]*websocket.Conn, 0)
 	for _, connector := range g.connectors {
-		if connector.UserPublicId == revocation.UserPublicId {
+		if connector.UserPublicId == revocation.UserPublicId && !connector.isShareSession() {
+			connections = append(connections, connector.connection)
+		}
+	}
+	g.connectorMutex.RUnlock()
+
+	for _, connection := range connections {
+		_ = connection.Close()
+	}
+}
+
+func (g *WebSocketAdapter) revokeShareLinkSessions(revocation realtimeleasecache.ShareLinkRevocation) {
+	g.connectorMutex.RLock()
+	connections := make([]*websocket.Conn, 0)
+	for _, connector := range g.connectors {
+		if connector.ShareLinkId == revocation.ShareLinkId {
 			connections = append(connections, connector.connection)
 		}
 	}
@@ -585,6 +683,17 @@ func (g *WebSocketAdapter) Handle(ctx *gin.Context) {
 		ctx.AbortWithStatus(http.StatusUnauthorized)
 		return
 	}
+	shareLinkId := uuid.Nil
+	if connectionClaims.ShareLinkId != "" {
+		if shareLinkId, err = uuid.Parse(connectionClaims.ShareLinkId); err != nil || connectionClaims.ShareSessionExpiresAt == nil {
+			metrics.NotegicMeter.Count(ctx.Request.Context(), "realtime.connection.rejected.count", 1,
+				attribute.String("reason", "invalid_share_link"),
+			)
+			logs.NotegicLogger.Warn(ctx.Request.Context(), "Rejected realtime connection: connection ticket share link is invalid")
+			ctx.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+	}
 	if g.realtimeDisabled ||
 		len(g.realtimeBetaUserPublicIdSet) > 0 && !g.realtimeBetaUserPublicIdSet[userPublicId] {
 		metrics.NotegicMeter.Count(ctx.Request.Context(), "realtime.connection.rejected.count", 1,
@@ -632,8 +741,14 @@ func (g *WebSocketAdapter) Handle(ctx *gin.Context) {
 		maximumConnectionsPerUser = constants.RealtimeMaxConnectionsPerUser
 	}
 
+	// the connections of the share sessions are counted against the share link,
+	// so the guests never take up the connections of the creator
+	connectionLeaseOwnerId := userPublicId
+	if shareLinkId != uuid.Nil {
+		connectionLeaseOwnerId = shareLinkId
+	}
 	acquired, _, err := g.leaseStore.AcquireUserConnection(
-		userPublicId,
+		connectionLeaseOwnerId,
 		connectorId,
 		maximumConnectionsPerUser,
 	)
@@ -656,7 +771,7 @@ func (g *WebSocketAdapter) Handle(ctx *gin.Context) {
 		return
 	}
 	defer func() {
-		if err := g.leaseStore.ReleaseUserConnection(userPublicId, connectorId); err != nil {
+		if err := g.leaseStore.ReleaseUserConnection(connectionLeaseOwnerId, connectorId); err != nil {
 			logs.NotegicLogger.Error(ctx.Request.Context(), err, "Failed to release realtime user connection lease")
 		}
 	}()
@@ -705,6 +820,7 @@ func (g *WebSocketAdapter) Handle(ctx *gin.Context) {
 	connector := Connector{
 		Id:           connectorId,
 		UserPublicId: userPublicId,
+		ShareLinkId:  shareLinkId,
 		UserAgent:    ctx.GetHeader("User-Agent"),
 		connection:   websocketConnection,
 		channels:     make(map[uint32]realtimetypes.Channel),
@@ -782,6 +898,14 @@ func (g *WebSocketAdapter) Handle(ctx *gin.Context) {
 	pingDone := make(chan struct{})
 	defer close(pingDone)
 
+	// the share session is not refreshed, so its connection ends together with the session
+	if connector.isShareSession() {
+		shareSessionTimer := time.AfterFunc(time.Until(*connectionClaims.ShareSessionExpiresAt), func() {
+			_ = websocketConnection.Close()
+		})
+		defer shareSessionTimer.Stop()
+	}
+
 	go func() {
 		ticker := time.NewTicker(constants.RealtimePingInterval)
 		defer ticker.Stop()
@@ -791,7 +915,7 @@ func (g *WebSocketAdapter) Handle(ctx *gin.Context) {
 			case <-pingDone:
 				return
 			case <-ticker.C:
-				refreshed, err := g.leaseStore.RefreshUserConnection(connector.UserPublicId, connector.Id)
+				refreshed, err := g.leaseStore.RefreshUserConnection(connectionLeaseOwnerId, connector.Id)
 				if err != nil || !refreshed {
 					if err != nil {
 						logs.NotegicLogger.Error(connectionContext, err, "Failed to refresh realtime user connection lease")
@@ -1100,6 +1224,7 @@ func (g *WebSocketAdapter) handleControlFrame(ctx context.Context, connector *Co
 			connector.UserAgent,
 		)
 		if err != nil || channelClaims.Subject != connector.UserPublicId.String() ||
+			channelClaims.ShareLinkId != connector.shareLinkIdString() ||
 			channelClaims.ChannelType != string(subscribeFrame.ChannelType) ||
 			channelClaims.ChannelId != subscribeFrame.ChannelId.String() {
 			metrics.NotegicMeter.Count(ctx, "realtime.channel.subscription.count", 1,
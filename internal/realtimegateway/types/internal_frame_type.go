@@ -25,4 +25,5 @@ const (
 	InternalFrameType_YjsDocumentCompactionFailed  InternalFrameType = 20
 	InternalFrameType_Attached                     InternalFrameType = 21
 	InternalFrameType_BlockPackQuotaExceeded       InternalFrameType = 22
+	InternalFrameType_YjsDocumentRestored          InternalFrameType = 23
 )
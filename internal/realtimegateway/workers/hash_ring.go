@@ -0,0 +1,80 @@
+package workers
+
+import (
+	"hash/fnv"
+	"sort"
+	"strconv"
+)
+
+// hashRing places every endpoint on the ring with several virtual nodes, so adding or removing one endpoint only
+// remaps the channels between its own virtual nodes and their predecessors, instead of almost every channel
+type hashRing struct {
+	points []hashRingPoint
+}
+
+type hashRingPoint struct {
+	hash     uint64
+	endpoint string
+}
+
+func newHashRing(endpoints []string, virtualNodes int) *hashRing {
+	points := make([]hashRingPoint, 0, len(endpoints)*virtualNodes)
+	for _, endpoint := range endpoints {
+		for index := range virtualNodes {
+			points = append(points, hashRingPoint{
+				hash:     hashRingKey([]byte(endpoint + "#" + strconv.Itoa(index))),
+				endpoint: endpoint,
+			})
+		}
+	}
+	sort.Slice(points, func(i, j int) bool {
+		if points[i].hash == points[j].hash {
+			return points[i].endpoint < points[j].endpoint
+		}
+		return points[i].hash < points[j].hash
+	})
+
+	return &hashRing{points: points}
+}
+
+// lookup walks clockwise from the key and returns the first endpoint accepted by the eligible function,
+// so the channels of an ineligible endpoint spread over the following endpoints rather than a single one
+func (r *hashRing) lookup(key []byte, eligible func(endpoint string) bool) (string, bool) {
+	if len(r.points) == 0 {
+		return "", false
+	}
+
+	hash := hashRingKey(key)
+	start := sort.Search(len(r.points), func(i int) bool {
+		return r.points[i].hash >= hash
+	})
+	visited := make(map[string]bool)
+	for offset := range r.points {
+		point := r.points[(start+offset)%len(r.points)]
+		if visited[point.endpoint] {
+			continue
+		}
+		if eligible == nil || eligible(point.endpoint) {
+			return point.endpoint, true
+		}
+		visited[point.endpoint] = true
+	}
+
+	return "", false
+}
+
+// hashRingKey finalizes the fnv hash with the murmur3 mixer, since the fnv hashes of the similar virtual node names
+// are clustered on the ring without it
+func hashRingKey(key []byte) uint64 {
+	hasher := fnv.New64a()
+	_, _ = hasher.Write(key)
+
+	hash := hasher.Sum64()
+	hash ^= hash >> 33
+	hash *= 0xff51afd7ed558ccd
+	hash ^= hash >> 33
+	hash *= 0xc4ceb9fe1a85ec53
+	hash ^= hash >> 33
+
+	return hash
+}
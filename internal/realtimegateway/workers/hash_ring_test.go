@@ -0,0 +1,87 @@
+package workers
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestHashRingLookupIsStable(t *testing.T) {
+	ring := newHashRing([]string{"ws://a", "ws://b", "ws://c"}, 128)
+	reversedRing := newHashRing([]string{"ws://c", "ws://b", "ws://a"}, 128)
+
+	for index := range 100 {
+		channelId := uuid.New()
+		endpoint, exists := ring.lookup(channelId[:], nil)
+		if !exists {
+			t.Fatal("expected an endpoint")
+		}
+		if reversedEndpoint, _ := reversedRing.lookup(channelId[:], nil); reversedEndpoint != endpoint {
+			t.Fatalf("lookup %d = %q and %q, want the same endpoint regardless of the order", index, endpoint, reversedEndpoint)
+		}
+	}
+
+	if _, exists := newHashRing(nil, 128).lookup([]byte("key"), nil); exists {
+		t.Fatal("expected an empty ring to find no endpoint")
+	}
+}
+
+func TestHashRingRemapsOnlyTheChannelsOfTheNewEndpoint(t *testing.T) {
+	endpoints := []string{"ws://a", "ws://b", "ws://c", "ws://d"}
+	ring := newHashRing(endpoints, 128)
+	grownRing := newHashRing(append(endpoints, "ws://e"), 128)
+
+	const channelCount = 10000
+	counts := make(map[string]int)
+	remapped := 0
+	for range channelCount {
+		channelId := uuid.New()
+		endpoint, _ := ring.lookup(channelId[:], nil)
+		grownEndpoint, _ := grownRing.lookup(channelId[:], nil)
+		counts[endpoint]++
+		if endpoint != grownEndpoint {
+			remapped++
+			if grownEndpoint != "ws://e" {
+				t.Fatalf("channel moved from %q to %q, want only moves to the new endpoint", endpoint, grownEndpoint)
+			}
+		}
+	}
+
+	// a fifth of the channels belongs to the new endpoint, and the modulo routing would have remapped about four fifths
+	if remapped < channelCount/10 || remapped > channelCount*3/10 {
+		t.Fatalf("remapped %d of %d channels, want about a fifth", remapped, channelCount)
+	}
+	for _, endpoint := range endpoints {
+		if counts[endpoint] < channelCount/len(endpoints)*3/4 || counts[endpoint] > channelCount/len(endpoints)*5/4 {
+			t.Fatalf("endpoint %q owns %d of %d channels, want a balanced share", endpoint, counts[endpoint], channelCount)
+		}
+	}
+}
+
+func TestHashRingLookupSkipsIneligibleEndpoints(t *testing.T) {
+	ring := newHashRing([]string{"ws://a", "ws://b", "ws://c"}, 128)
+
+	moved := make(map[string]int)
+	for index := range 1000 {
+		key := []byte(fmt.Sprintf("channel-%d", index))
+		endpoint, _ := ring.lookup(key, nil)
+		eligibleEndpoint, exists := ring.lookup(key, func(endpoint string) bool { return endpoint != "ws://a" })
+		if !exists || eligibleEndpoint == "ws://a" {
+			t.Fatalf("lookup = %q, %v, want an eligible endpoint", eligibleEndpoint, exists)
+		}
+		if endpoint != "ws://a" && eligibleEndpoint != endpoint {
+			t.Fatalf("channel of eligible %q moved to %q", endpoint, eligibleEndpoint)
+		}
+		if endpoint == "ws://a" {
+			moved[eligibleEndpoint]++
+		}
+	}
+	if moved["ws://b"] == 0 || moved["ws://c"] == 0 {
+		t.Fatalf("moved = %v, want the channels of the ineligible endpoint spread over the others", moved)
+	}
+
+	if _, exists := ring.lookup([]byte("key"), func(string) bool { return false }); exists {
+		t.Fatal("expected no endpoint when every endpoint is ineligible")
+	}
+}
@@ -2,16 +2,21 @@ package workers
 
 import (
 	"context"
-	"hash/fnv"
+	"net/http"
 	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
+	"go.opentelemetry.io/otel/attribute"
 
 	constants "github.com/HiIamJeff67/notegic-backend/shared/constants"
 
+	logs "github.com/HiIamJeff67/notegic-backend/shared/platform/observability/logs"
+	metrics "github.com/HiIamJeff67/notegic-backend/shared/platform/observability/metrics"
+
 	realtimetypes "github.com/HiIamJeff67/notegic-backend/internal/realtimegateway/types"
 )
 
@@ -22,70 +27,145 @@ type WorkerManagerInterface interface {
 	SetFrameHandler(handler func(realtimetypes.InternalFrame))
 }
 
-type WorkerManager struct {
-	workers []*realtimeWorker
-	cancel  context.CancelFunc
-	waiter  sync.WaitGroup
+// ChannelWorkerStore shares the YjsWorker owning each channel between the gateways, since their health checks and
+// discoveries disagree during a failover or a deploy, and the ring of each gateway alone could split one channel
+// into a room on two workers
+type ChannelWorkerStore interface {
+	GetChannelWorker(channelId uuid.UUID) (string, error)
+	ClaimChannelWorker(channelId uuid.UUID, expectedEndpoint string, endpoint string) (string, error)
 }
 
-func NewWorkerManager(endpoints []string) *WorkerManager {
-	ctx, cancel := context.WithCancel(context.Background())
-	workers := make([]*realtimeWorker, 0, len(endpoints))
+// WorkerManager routes every BlockPack channel to one YjsWorker by a consistent-hash ring over the discovered workers,
+// and pins the channel to that worker while it has attachments, so all the connections of a channel share one room,
+// the channels are moved away from a failing or draining worker by asking their clients to resubscribe,
+// and every pin and move goes through the owner shared by the gateways, so they all follow the same move
+type WorkerManager struct {
+	discovery          WorkerDiscovery
+	channelWorkerStore ChannelWorkerStore
+	httpClient         *http.Client
 
-	for _, endpoint := range endpoints {
-		worker := &realtimeWorker{
-			endpoint:       endpoint,
-			activeChannels: make(map[string]realtimetypes.InternalFrame),
-			outbound:       make(chan realtimetypes.InternalFrame, constants.RealtimeWorkerQueueSize),
-		}
-		workers = append(workers, worker)
-	}
+	mutex       sync.RWMutex
+	workers     map[string]*realtimeWorker
+	ring        *hashRing
+	routes      map[uuid.UUID]*channelRoute
+	attachments map[string]uuid.UUID
+
+	frameHandler      func(realtimetypes.InternalFrame)
+	frameHandlerMutex sync.RWMutex
 
+	ctx    context.Context
+	cancel context.CancelFunc
+	waiter sync.WaitGroup
+}
+
+type channelRoute struct {
+	worker *realtimeWorker
+	frames map[string]realtimetypes.InternalFrame
+}
+
+func NewWorkerManager(discovery WorkerDiscovery, channelWorkerStore ChannelWorkerStore) *WorkerManager {
+	ctx, cancel := context.WithCancel(context.Background())
 	manager := &WorkerManager{
-		workers: workers,
-		cancel:  cancel,
-	}
-	for _, worker := range manager.workers {
-		manager.waiter.Add(1)
-		go func() {
-			defer manager.waiter.Done()
-			worker.run(ctx)
-		}()
+		discovery:          discovery,
+		channelWorkerStore: channelWorkerStore,
+		httpClient:         &http.Client{Timeout: constants.RealtimeWorkerHealthCheckTimeout},
+		workers:            make(map[string]*realtimeWorker),
+		ring:               newHashRing(nil, constants.RealtimeWorkerVirtualNodes),
+		routes:             make(map[uuid.UUID]*channelRoute),
+		attachments:        make(map[string]uuid.UUID),
+		ctx:                ctx,
+		cancel:             cancel,
 	}
+	manager.discover()
+
+	manager.waiter.Add(1)
+	go func() {
+		defer manager.waiter.Done()
+		manager.maintain()
+	}()
 
 	return manager
 }
 
 func (m *WorkerManager) Shutdown() {
 	m.cancel()
+	m.mutex.RLock()
 	for _, worker := range m.workers {
 		worker.close()
 	}
+	m.mutex.RUnlock()
 	m.waiter.Wait()
 }
 
 func (m *WorkerManager) Attach(frame realtimetypes.InternalFrame) bool {
-	worker := m.getWorker(frame.ChannelId)
-	if worker == nil || !worker.ready.Load() {
-		return false
+	key := channelKey(frame)
+
+	m.mutex.Lock()
+	if channelId, exists := m.attachments[key]; exists {
+		m.removeAttachmentLocked(key, channelId)
 	}
 
-	worker.activeChannelsMutex.Lock()
-	worker.activeChannels[worker.channelKey(frame)] = frame
-	worker.activeChannelsMutex.Unlock()
+	var resyncFrames []realtimetypes.InternalFrame
+	route := m.routes[frame.ChannelId]
+	if route != nil && !m.isEligible(route.worker) {
+		// a worker whose connection is down keeps its channels during the failover grace period,
+		// while a connected but draining worker accepts no new attachment, so its channel moves along with it
+		if !route.worker.ready.Load() {
+			m.mutex.Unlock()
+			return false
+		}
+		resyncFrames = m.releaseRouteLocked(frame.ChannelId, "drain")
+		route = nil
+	}
+	if route == nil {
+		// the shared owner is claimed without holding the mutex, and a route pinned by another attachment in the meantime wins
+		m.mutex.Unlock()
+		worker := m.claimWorker(frame.ChannelId)
+		m.mutex.Lock()
+
+		route = m.routes[frame.ChannelId]
+		if route == nil {
+			if worker == nil {
+				m.mutex.Unlock()
+				m.dispatch(resyncFrames)
+				return false
+			}
+			route = &channelRoute{
+				worker: worker,
+				frames: make(map[string]realtimetypes.InternalFrame),
+			}
+			m.routes[frame.ChannelId] = route
+		}
+	}
+	route.frames[key] = frame
+	m.attachments[key] = frame.ChannelId
+	worker := route.worker
+	m.mutex.Unlock()
+	m.dispatch(resyncFrames)
 
-	return worker.enqueue(frame)
+	if !worker.enqueue(frame) {
+		m.mutex.Lock()
+		m.removeAttachmentLocked(key, frame.ChannelId)
+		m.mutex.Unlock()
+
+		return false
+	}
+
+	return true
 }
 
 func (m *WorkerManager) Detach(frame realtimetypes.InternalFrame) {
-	worker := m.getWorker(frame.ChannelId)
-	if worker == nil {
+	key := channelKey(frame)
+
+	m.mutex.Lock()
+	channelId, exists := m.attachments[key]
+	if !exists {
+		m.mutex.Unlock()
 		return
 	}
-
-	worker.activeChannelsMutex.Lock()
-	delete(worker.activeChannels, worker.channelKey(frame))
-	worker.activeChannelsMutex.Unlock()
+	worker := m.routes[channelId].worker
+	m.removeAttachmentLocked(key, channelId)
+	m.mutex.Unlock()
 
 	if worker.ready.Load() {
 		worker.enqueue(frame)
@@ -93,7 +173,13 @@ func (m *WorkerManager) Detach(frame realtimetypes.InternalFrame) {
 }
 
 func (m *WorkerManager) Forward(frame realtimetypes.InternalFrame) bool {
-	worker := m.getWorker(frame.ChannelId)
+	m.mutex.RLock()
+	var worker *realtimeWorker
+	if channelId, exists := m.attachments[channelKey(frame)]; exists {
+		worker = m.routes[channelId].worker
+	}
+	m.mutex.RUnlock()
+
 	if worker == nil || !worker.ready.Load() {
 		return false
 	}
@@ -102,42 +188,412 @@ func (m *WorkerManager) Forward(frame realtimetypes.InternalFrame) bool {
 }
 
 func (m *WorkerManager) SetFrameHandler(handler func(realtimetypes.InternalFrame)) {
+	m.frameHandlerMutex.Lock()
+	m.frameHandler = handler
+	m.frameHandlerMutex.Unlock()
+}
+
+/* ============================== Routing Methods ============================== */
+
+// isEligible reports whether the worker accepts new attachments, the caller must hold the mutex
+func (m *WorkerManager) isEligible(worker *realtimeWorker) bool {
+	return !worker.removed && worker.ready.Load() && worker.healthy.Load()
+}
+
+func (m *WorkerManager) lookupLocked(channelId uuid.UUID) *realtimeWorker {
+	endpoint, exists := m.ring.lookup(channelId[:], func(endpoint string) bool {
+		worker, exists := m.workers[endpoint]
+		return exists && m.isEligible(worker)
+	})
+	if !exists {
+		return nil
+	}
+
+	return m.workers[endpoint]
+}
+
+// eligibleWorker returns the worker of the endpoint when it accepts new attachments
+func (m *WorkerManager) eligibleWorker(endpoint string) *realtimeWorker {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	worker, exists := m.workers[endpoint]
+	if !exists || !m.isEligible(worker) {
+		return nil
+	}
+
+	return worker
+}
+
+// claimWorker returns the worker owning the channel for all the gateways, the current owner is kept while this gateway
+// can route to it and is otherwise replaced by the worker of the ring, where a claim lost to another gateway follows
+// that gateway, and the ring of this gateway decides alone only while the shared owner is unavailable
+func (m *WorkerManager) claimWorker(channelId uuid.UUID) *realtimeWorker {
+	m.mutex.RLock()
+	candidate := m.lookupLocked(channelId)
+	m.mutex.RUnlock()
+
+	owner, err := m.getChannelWorker(channelId)
+	if err != nil {
+		logs.NotegicLogger.Error(context.Background(), err, "Failed to get the YjsWorker owning the realtime channel")
+		return candidate
+	}
+	if worker := m.eligibleWorker(owner); worker != nil {
+		candidate = worker
+	}
+	if candidate == nil {
+		return nil
+	}
+
+	owner, err = m.claimChannelWorker(channelId, owner, candidate.endpoint)
+	if err != nil {
+		logs.NotegicLogger.Error(context.Background(), err, "Failed to claim the YjsWorker owning the realtime channel")
+		return candidate
+	}
+
+	return m.eligibleWorker(owner)
+}
+
+func (m *WorkerManager) getChannelWorker(channelId uuid.UUID) (string, error) {
+	if m.channelWorkerStore == nil {
+		return "", nil
+	}
+
+	return m.channelWorkerStore.GetChannelWorker(channelId)
+}
+
+func (m *WorkerManager) claimChannelWorker(channelId uuid.UUID, expectedEndpoint string, endpoint string) (string, error) {
+	if m.channelWorkerStore == nil {
+		return endpoint, nil
+	}
+
+	return m.channelWorkerStore.ClaimChannelWorker(channelId, expectedEndpoint, endpoint)
+}
+
+func (m *WorkerManager) removeAttachmentLocked(key string, channelId uuid.UUID) {
+	delete(m.attachments, key)
+	route, exists := m.routes[channelId]
+	if !exists {
+		return
+	}
+	delete(route.frames, key)
+	if len(route.frames) == 0 {
+		delete(m.routes, channelId)
+	}
+}
+
+// releaseRouteLocked unpins the channel and returns the resync frames of all its attachments, the previous worker is
+// still told to detach them when it is connected, so it can flush and evict the room instead of waiting for the idle timeout
+func (m *WorkerManager) releaseRouteLocked(channelId uuid.UUID, reason string) []realtimetypes.InternalFrame {
+	route, exists := m.routes[channelId]
+	if !exists {
+		return nil
+	}
+	delete(m.routes, channelId)
+
+	resyncFrames := make([]realtimetypes.InternalFrame, 0, len(route.frames))
+	for key, frame := range route.frames {
+		delete(m.attachments, key)
+
+		frame.Payload = nil
+		if route.worker.ready.Load() {
+			detachFrame := frame
+			detachFrame.Type = realtimetypes.InternalFrameType_Detach
+			route.worker.enqueue(detachFrame)
+		}
+		frame.Type = realtimetypes.InternalFrameType_ResyncRequired
+		resyncFrames = append(resyncFrames, frame)
+	}
+	metrics.NotegicMeter.Count(context.Background(), "realtime.worker.channel.migration.count", 1,
+		attribute.String("reason", reason),
+	)
+
+	return resyncFrames
+}
+
+func (m *WorkerManager) dispatch(frames []realtimetypes.InternalFrame) {
+	if len(frames) == 0 {
+		return
+	}
+
+	m.frameHandlerMutex.RLock()
+	frameHandler := m.frameHandler
+	m.frameHandlerMutex.RUnlock()
+
+	if frameHandler == nil {
+		return
+	}
+	for _, frame := range frames {
+		frameHandler(frame)
+	}
+}
+
+// attachedFrames returns the attach frames of the channels pinned to the worker, which are replayed after it reconnects
+func (m *WorkerManager) attachedFrames(worker *realtimeWorker) []realtimetypes.InternalFrame {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	frames := make([]realtimetypes.InternalFrame, 0)
+	for _, route := range m.routes {
+		if route.worker != worker {
+			continue
+		}
+		for _, frame := range route.frames {
+			frames = append(frames, frame)
+		}
+	}
+
+	return frames
+}
+
+/* ============================== Maintenance Methods ============================== */
+
+func (m *WorkerManager) maintain() {
+	discoveryTicker := time.NewTicker(constants.RealtimeWorkerDiscoveryInterval)
+	defer discoveryTicker.Stop()
+	healthCheckTicker := time.NewTicker(constants.RealtimeWorkerHealthCheckInterval)
+	defer healthCheckTicker.Stop()
+
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-discoveryTicker.C:
+			m.discover()
+		case <-healthCheckTicker.C:
+			m.checkHealth()
+			m.rebalance(time.Now())
+		}
+	}
+}
+
+// discover applies the discovered endpoints, where a new endpoint starts a worker and a missing one is only marked
+// as removed, so its channels are drained gradually before the worker is stopped
+func (m *WorkerManager) discover() {
+	ctx, cancel := context.WithTimeout(m.ctx, constants.RealtimeWorkerHealthCheckTimeout)
+	defer cancel()
+
+	endpoints, err := m.discovery.Discover(ctx)
+	if err != nil {
+		if m.ctx.Err() == nil {
+			logs.NotegicLogger.Error(context.Background(), err, "Failed to discover YjsWorker endpoints")
+		}
+		return
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	discovered := make(map[string]bool, len(endpoints))
+	for _, endpoint := range endpoints {
+		discovered[endpoint] = true
+		if worker, exists := m.workers[endpoint]; exists {
+			worker.removed = false
+			continue
+		}
+		m.startWorkerLocked(endpoint)
+	}
+	for endpoint, worker := range m.workers {
+		if !discovered[endpoint] && !worker.removed {
+			worker.removed = true
+			logs.NotegicLogger.Warn(context.Background(), "YjsWorker endpoint is draining after leaving the discovery",
+				attribute.String("endpoint", endpoint),
+			)
+		}
+	}
+	m.ring = newHashRing(endpoints, constants.RealtimeWorkerVirtualNodes)
+}
+
+func (m *WorkerManager) startWorkerLocked(endpoint string) {
+	ctx, cancel := context.WithCancel(m.ctx)
+	worker := &realtimeWorker{
+		endpoint: endpoint,
+		manager:  m,
+		outbound: make(chan realtimetypes.InternalFrame, constants.RealtimeWorkerQueueSize),
+		cancel:   cancel,
+	}
+	// a new worker is trusted until its first health check, its connection still has to be established first
+	worker.healthy.Store(true)
+	m.workers[endpoint] = worker
+
+	m.waiter.Add(1)
+	go func() {
+		defer m.waiter.Done()
+		worker.run(ctx)
+	}()
+}
+
+// checkHealth probes the health endpoint of every worker, where a worker answering 503 is shutting down or not yet
+// ready, and the probes are concurrent so one unresponsive worker does not delay the others
+func (m *WorkerManager) checkHealth() {
+	m.mutex.RLock()
+	workers := make([]*realtimeWorker, 0, len(m.workers))
 	for _, worker := range m.workers {
-		worker.frameHandlerMutex.Lock()
-		worker.frameHandler = handler
-		worker.frameHandlerMutex.Unlock()
+		workers = append(workers, worker)
 	}
+	m.mutex.RUnlock()
+
+	var waiter sync.WaitGroup
+	for _, worker := range workers {
+		waiter.Add(1)
+		go func() {
+			defer waiter.Done()
+			healthy := m.probe(worker.endpoint)
+			if worker.healthy.Swap(healthy) != healthy {
+				logs.NotegicLogger.Warn(context.Background(), "YjsWorker health has changed",
+					attribute.String("endpoint", worker.endpoint),
+					attribute.Bool("healthy", healthy),
+				)
+			}
+		}()
+	}
+	waiter.Wait()
+}
+
+func (m *WorkerManager) probe(endpoint string) bool {
+	url, err := healthURL(endpoint)
+	if err != nil {
+		return false
+	}
+	req, err := http.NewRequestWithContext(m.ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return false
+	}
+	res, err := m.httpClient.Do(req)
+	if err != nil {
+		return false
+	}
+	defer res.Body.Close()
+
+	return res.StatusCode == http.StatusOK
+}
+
+// channelClaim is a pinned channel whose shared owner is renewed, or moved to the target by the rebalance
+type channelClaim struct {
+	channelId uuid.UUID
+	worker    *realtimeWorker
+	target    *realtimeWorker
+	reason    string
 }
 
-func (m *WorkerManager) getWorker(channelId [16]byte) *realtimeWorker {
-	if len(m.workers) == 0 {
+// rebalance moves the channels away from the workers which should no longer own them,
+// a disconnected worker past the failover grace period loses all its channels at once since they are already broken,
+// while the channels of a draining worker and the channels remapped by the ring are moved in bounded batches,
+// and only when another worker can take them, so a rolling deploy does not resync every client at the same moment,
+// the other pinned channels renew their shared owner, and the ones moved by another gateway are released to follow it
+func (m *WorkerManager) rebalance(now time.Time) {
+	m.mutex.Lock()
+
+	channelCounts := make(map[*realtimeWorker]int, len(m.workers))
+	for _, route := range m.routes {
+		channelCounts[route.worker]++
+	}
+	for endpoint, worker := range m.workers {
+		if worker.ready.Load() {
+			worker.unavailableSince = time.Time{}
+		} else if worker.unavailableSince.IsZero() {
+			worker.unavailableSince = now
+		}
+		if worker.removed && channelCounts[worker] == 0 {
+			delete(m.workers, endpoint)
+			worker.cancel()
+			worker.close()
+		}
+	}
+
+	var resyncFrames []realtimetypes.InternalFrame
+	claims := make([]channelClaim, 0, len(m.routes))
+	remainingBatch := constants.RealtimeWorkerRebalanceBatchSize
+	for channelId, route := range m.routes {
+		claim := channelClaim{channelId: channelId, worker: route.worker}
+		switch {
+		case !claim.worker.ready.Load():
+			// the next attachment claims a new owner, since a disconnected worker can not be kept by any gateway
+			if now.Sub(claim.worker.unavailableSince) >= constants.RealtimeWorkerFailoverGracePeriod {
+				resyncFrames = append(resyncFrames, m.releaseRouteLocked(channelId, "failover")...)
+				continue
+			}
+		case remainingBatch <= 0:
+		case !m.isEligible(claim.worker):
+			if target := m.lookupLocked(channelId); target != nil {
+				claim.target, claim.reason = target, "drain"
+				remainingBatch--
+			}
+		default:
+			if target := m.lookupLocked(channelId); target != nil && target != claim.worker {
+				claim.target, claim.reason = target, "rebalance"
+				remainingBatch--
+			}
+		}
+		claims = append(claims, claim)
+	}
+	m.mutex.Unlock()
+
+	m.dispatch(resyncFrames)
+	m.dispatch(m.claimChannels(claims))
+}
+
+// claimChannels moves or renews the shared owners of the channels without holding the mutex, and releases the channels
+// whose owner is no longer their worker, where a move is still applied by this gateway alone when the owner is unavailable
+func (m *WorkerManager) claimChannels(claims []channelClaim) []realtimetypes.InternalFrame {
+	releases := make([]channelClaim, 0)
+	for _, claim := range claims {
+		endpoint := claim.worker.endpoint
+		if claim.target != nil {
+			endpoint = claim.target.endpoint
+		}
+
+		owner, err := m.claimChannelWorker(claim.channelId, claim.worker.endpoint, endpoint)
+		switch {
+		case err != nil:
+			logs.NotegicLogger.Error(context.Background(), err, "Failed to claim the YjsWorker owning the realtime channel")
+			if claim.target != nil {
+				releases = append(releases, claim)
+			}
+		case owner != claim.worker.endpoint:
+			if claim.target == nil {
+				claim.reason = "owner"
+			}
+			releases = append(releases, claim)
+		}
+	}
+	if len(releases) == 0 {
 		return nil
 	}
 
-	hasher := fnv.New32a()
-	_, _ = hasher.Write(channelId[:])
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
 
-	return m.workers[int(hasher.Sum32())%len(m.workers)]
+	var resyncFrames []realtimetypes.InternalFrame
+	for _, claim := range releases {
+		// the channel may have been released and pinned again while its owner was claimed
+		if route, exists := m.routes[claim.channelId]; exists && route.worker == claim.worker {
+			resyncFrames = append(resyncFrames, m.releaseRouteLocked(claim.channelId, claim.reason)...)
+		}
+	}
+
+	return resyncFrames
 }
 
 /* ============================== Realtime Worker Methods ============================== */
 
 type realtimeWorker struct {
 	endpoint string
+	manager  *WorkerManager
 
-	activeChannels      map[string]realtimetypes.InternalFrame
-	activeChannelsMutex sync.RWMutex
-
-	frameHandler      func(realtimetypes.InternalFrame)
-	frameHandlerMutex sync.RWMutex
+	// removed and unavailableSince are guarded by the mutex of the manager
+	removed          bool
+	unavailableSince time.Time
+	healthy          atomic.Bool
 
 	outbound        chan realtimetypes.InternalFrame
 	ready           atomic.Bool
+	cancel          context.CancelFunc
 	connectionMutex sync.Mutex
 	connection      *websocket.Conn
 }
 
-func (w *realtimeWorker) channelKey(frame realtimetypes.InternalFrame) string {
+func channelKey(frame realtimetypes.InternalFrame) string {
 	return frame.ConnectionId.String() + ":" + strconv.FormatUint(uint64(frame.ConnectorChannelId), 10)
 }
 
@@ -231,14 +687,7 @@ func wait(ctx context.Context, duration time.Duration) bool {
 }
 
 func (w *realtimeWorker) replayActiveChannels(connection *websocket.Conn) bool {
-	w.activeChannelsMutex.RLock()
-	frames := make([]realtimetypes.InternalFrame, 0, len(w.activeChannels))
-	for _, frame := range w.activeChannels {
-		frames = append(frames, frame)
-	}
-	w.activeChannelsMutex.RUnlock()
-
-	for _, frame := range frames {
+	for _, frame := range w.manager.attachedFrames(w) {
 		payload, err := frame.MarshalBytes()
 		if err != nil || connection.SetWriteDeadline(time.Now().Add(constants.RealtimeControlWriteTimeout)) != nil ||
 			connection.WriteMessage(websocket.BinaryMessage, payload) != nil {
@@ -263,9 +712,9 @@ func (w *realtimeWorker) read(connection *websocket.Conn, readError chan<- struc
 			return
 		}
 
-		w.frameHandlerMutex.RLock()
-		frameHandler := w.frameHandler
-		w.frameHandlerMutex.RUnlock()
+		w.manager.frameHandlerMutex.RLock()
+		frameHandler := w.manager.frameHandler
+		w.manager.frameHandlerMutex.RUnlock()
 
 		if frameHandler != nil {
 			frameHandler(frame)
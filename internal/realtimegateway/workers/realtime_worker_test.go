@@ -0,0 +1,166 @@
+package workers
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+
+	constants "github.com/HiIamJeff67/notegic-backend/shared/constants"
+
+	logs "github.com/HiIamJeff67/notegic-backend/shared/platform/observability/logs"
+	metrics "github.com/HiIamJeff67/notegic-backend/shared/platform/observability/metrics"
+
+	realtimetypes "github.com/HiIamJeff67/notegic-backend/internal/realtimegateway/types"
+)
+
+// fakeChannelWorkerStore claims the owners under a lock with the same condition as the claim script of the lease cache
+type fakeChannelWorkerStore struct {
+	mutex  sync.Mutex
+	owners map[uuid.UUID]string
+}
+
+func (s *fakeChannelWorkerStore) GetChannelWorker(channelId uuid.UUID) (string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.owners[channelId], nil
+}
+
+func (s *fakeChannelWorkerStore) ClaimChannelWorker(channelId uuid.UUID, expectedEndpoint string, endpoint string) (string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if current, exists := s.owners[channelId]; exists && current != expectedEndpoint {
+		return current, nil
+	}
+	s.owners[channelId] = endpoint
+
+	return endpoint, nil
+}
+
+// newTestWorkerManager builds a gateway whose workers are connected and healthy without a connection to a YjsWorker,
+// and records the frames sent back to its clients
+func newTestWorkerManager(store ChannelWorkerStore, endpoints []string, frames *[]realtimetypes.InternalFrame) *WorkerManager {
+	manager := &WorkerManager{
+		channelWorkerStore: store,
+		workers:            make(map[string]*realtimeWorker),
+		ring:               newHashRing(endpoints, constants.RealtimeWorkerVirtualNodes),
+		routes:             make(map[uuid.UUID]*channelRoute),
+		attachments:        make(map[string]uuid.UUID),
+	}
+	for _, endpoint := range endpoints {
+		worker := &realtimeWorker{
+			endpoint: endpoint,
+			manager:  manager,
+			outbound: make(chan realtimetypes.InternalFrame, 16),
+		}
+		worker.ready.Store(true)
+		worker.healthy.Store(true)
+		manager.workers[endpoint] = worker
+	}
+	manager.SetFrameHandler(func(frame realtimetypes.InternalFrame) {
+		*frames = append(*frames, frame)
+	})
+
+	return manager
+}
+
+func (m *WorkerManager) routedEndpoint(channelId uuid.UUID) string {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	route, exists := m.routes[channelId]
+	if !exists {
+		return ""
+	}
+
+	return route.worker.endpoint
+}
+
+func TestWorkerManagerFollowsTheFailoverOfAnotherGateway(t *testing.T) {
+	logs.NotegicLogger = logs.NewLogger(false)
+	metrics.NotegicMeter = metrics.NewMeter(otel.Meter("realtime.test"))
+
+	endpoints := []string{"ws://a", "ws://b", "ws://c"}
+	store := &fakeChannelWorkerStore{owners: make(map[uuid.UUID]string)}
+	var firstFrames, secondFrames []realtimetypes.InternalFrame
+	firstGateway := newTestWorkerManager(store, endpoints, &firstFrames)
+	secondGateway := newTestWorkerManager(store, endpoints, &secondFrames)
+
+	channelId := uuid.New()
+	attachFrame := func() realtimetypes.InternalFrame {
+		return realtimetypes.InternalFrame{
+			Version:            byte(constants.RealtimeWorkerProtocolVersion),
+			Type:               realtimetypes.InternalFrameType_Attach,
+			ConnectionId:       uuid.New(),
+			ConnectorChannelId: 1,
+			ChannelId:          channelId,
+		}
+	}
+	if !firstGateway.Attach(attachFrame()) || !secondGateway.Attach(attachFrame()) {
+		t.Fatal("Attach() = false, want the channel attached on both gateways")
+	}
+	failedEndpoint := firstGateway.routedEndpoint(channelId)
+	if secondGateway.routedEndpoint(channelId) != failedEndpoint || store.owners[channelId] != failedEndpoint {
+		t.Fatalf("the gateways route the channel to %q and %q, want the shared owner %q",
+			failedEndpoint, secondGateway.routedEndpoint(channelId), store.owners[channelId])
+	}
+
+	// only the first gateway has lost the connection to the worker, so the second one still considers it healthy
+	firstGateway.workers[failedEndpoint].ready.Store(false)
+	now := time.Now()
+	firstGateway.rebalance(now)
+	firstGateway.rebalance(now.Add(constants.RealtimeWorkerFailoverGracePeriod))
+	if len(firstFrames) != 1 || firstFrames[0].Type != realtimetypes.InternalFrameType_ResyncRequired {
+		t.Fatalf("the first gateway sent %v, want a resync after the failover", firstFrames)
+	}
+	if !firstGateway.Attach(attachFrame()) {
+		t.Fatal("Attach() = false, want the channel attached to another worker")
+	}
+	targetEndpoint := firstGateway.routedEndpoint(channelId)
+	if targetEndpoint == failedEndpoint || store.owners[channelId] != targetEndpoint {
+		t.Fatalf("the first gateway routes the channel to %q owned by %q, want another worker owning it", targetEndpoint, store.owners[channelId])
+	}
+
+	secondGateway.rebalance(now)
+	if len(secondFrames) != 1 || secondFrames[0].Type != realtimetypes.InternalFrameType_ResyncRequired {
+		t.Fatalf("the second gateway sent %v, want a resync to follow the failover", secondFrames)
+	}
+	if !secondGateway.Attach(attachFrame()) || secondGateway.routedEndpoint(channelId) != targetEndpoint {
+		t.Fatalf("the second gateway routes the channel to %q, want the room on %q", secondGateway.routedEndpoint(channelId), targetEndpoint)
+	}
+}
+
+func TestWorkerManagerRebalancesThroughTheSharedOwner(t *testing.T) {
+	logs.NotegicLogger = logs.NewLogger(false)
+	metrics.NotegicMeter = metrics.NewMeter(otel.Meter("realtime.test"))
+
+	store := &fakeChannelWorkerStore{owners: make(map[uuid.UUID]string)}
+	var frames []realtimetypes.InternalFrame
+	gateway := newTestWorkerManager(store, []string{"ws://a", "ws://b"}, &frames)
+
+	channelId := uuid.New()
+	ringEndpoint, _ := gateway.ring.lookup(channelId[:], nil)
+	otherEndpoint := "ws://a"
+	if ringEndpoint == otherEndpoint {
+		otherEndpoint = "ws://b"
+	}
+	// another gateway has pinned the channel to the worker it owned before this gateway discovered the ring
+	store.owners[channelId] = otherEndpoint
+
+	if !gateway.Attach(realtimetypes.InternalFrame{ConnectionId: uuid.New(), ConnectorChannelId: 1, ChannelId: channelId}) {
+		t.Fatal("Attach() = false, want the channel attached")
+	}
+	if gateway.routedEndpoint(channelId) != otherEndpoint {
+		t.Fatalf("Attach() routes the channel to %q, want the shared owner %q", gateway.routedEndpoint(channelId), otherEndpoint)
+	}
+
+	gateway.rebalance(time.Now())
+	if store.owners[channelId] != ringEndpoint || gateway.routedEndpoint(channelId) != "" || len(frames) != 1 {
+		t.Fatalf("rebalance() moved the owner to %q with %d resyncs, want the owner moved to %q before the resync",
+			store.owners[channelId], len(frames), ringEndpoint)
+	}
+}
@@ -0,0 +1,118 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+)
+
+// WorkerDiscovery returns the current YjsWorker endpoints, where an error keeps the previously discovered endpoints,
+// since dropping every worker on a transient lookup failure would fail over every channel at once
+type WorkerDiscovery interface {
+	Discover(ctx context.Context) ([]string, error)
+}
+
+type staticWorkerDiscovery struct {
+	endpoints []string
+}
+
+func NewStaticWorkerDiscovery(endpoints []string) WorkerDiscovery {
+	return &staticWorkerDiscovery{endpoints: normalizeEndpoints(endpoints)}
+}
+
+func (d *staticWorkerDiscovery) Discover(ctx context.Context) ([]string, error) {
+	return d.endpoints, nil
+}
+
+// dnsWorkerDiscovery re-resolves the hostnames of the endpoints, and every resolved address becomes an endpoint,
+// so the replicas behind one service name are discovered and routed individually
+type dnsWorkerDiscovery struct {
+	endpoints  []string
+	lookupHost func(ctx context.Context, host string) ([]string, error)
+}
+
+func NewDNSWorkerDiscovery(endpoints []string) WorkerDiscovery {
+	return &dnsWorkerDiscovery{
+		endpoints:  normalizeEndpoints(endpoints),
+		lookupHost: net.DefaultResolver.LookupHost,
+	}
+}
+
+func (d *dnsWorkerDiscovery) Discover(ctx context.Context) ([]string, error) {
+	endpoints := make([]string, 0, len(d.endpoints))
+	for _, endpoint := range d.endpoints {
+		endpointURL, err := url.Parse(endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid YjsWorker endpoint %q: %w", endpoint, err)
+		}
+		host := endpointURL.Hostname()
+		if host == "" {
+			return nil, fmt.Errorf("invalid YjsWorker endpoint %q: missing host", endpoint)
+		}
+		if net.ParseIP(host) != nil {
+			endpoints = append(endpoints, endpoint)
+			continue
+		}
+
+		addresses, err := d.lookupHost(ctx, host)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve YjsWorker host %q: %w", host, err)
+		}
+		for _, address := range addresses {
+			resolvedURL := *endpointURL
+			if port := endpointURL.Port(); port != "" {
+				resolvedURL.Host = net.JoinHostPort(address, port)
+			} else if ip := net.ParseIP(address); ip != nil && ip.To4() == nil {
+				resolvedURL.Host = "[" + address + "]"
+			} else {
+				resolvedURL.Host = address
+			}
+			endpoints = append(endpoints, resolvedURL.String())
+		}
+	}
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("no YjsWorker endpoint has been resolved")
+	}
+
+	return normalizeEndpoints(endpoints), nil
+}
+
+func normalizeEndpoints(endpoints []string) []string {
+	seen := make(map[string]bool, len(endpoints))
+	normalized := make([]string, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		if endpoint == "" || seen[endpoint] {
+			continue
+		}
+		seen[endpoint] = true
+		normalized = append(normalized, endpoint)
+	}
+	sort.Strings(normalized)
+
+	return normalized
+}
+
+// healthURL derives the health check of the worker from its realtime endpoint, both are served by the same listener
+func healthURL(endpoint string) (string, error) {
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+	switch endpointURL.Scheme {
+	case "ws":
+		endpointURL.Scheme = "http"
+	case "wss":
+		endpointURL.Scheme = "https"
+	case "http", "https":
+	default:
+		return "", fmt.Errorf("unsupported YjsWorker endpoint scheme %q", endpointURL.Scheme)
+	}
+	endpointURL.Path = "/healthz"
+	endpointURL.RawPath = ""
+	endpointURL.RawQuery = ""
+	endpointURL.Fragment = ""
+
+	return endpointURL.String(), nil
+}
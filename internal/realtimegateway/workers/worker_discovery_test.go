@@ -0,0 +1,61 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestDNSWorkerDiscoveryExpandsHostnames(t *testing.T) {
+	discovery := &dnsWorkerDiscovery{
+		endpoints: normalizeEndpoints([]string{
+			"ws://notegic-yjs-worker:8787/core/realtime/v1",
+			"ws://10.0.0.9:8787/core/realtime/v1",
+		}),
+		lookupHost: func(ctx context.Context, host string) ([]string, error) {
+			if host != "notegic-yjs-worker" {
+				t.Fatalf("lookupHost(%q), want the hostname only", host)
+			}
+			return []string{"10.0.0.2", "10.0.0.1", "fd00::1", "10.0.0.2"}, nil
+		},
+	}
+
+	endpoints, err := discovery.Discover(context.Background())
+	if err != nil {
+		t.Fatalf("Discover() error = %v", err)
+	}
+	want := []string{
+		"ws://10.0.0.1:8787/core/realtime/v1",
+		"ws://10.0.0.2:8787/core/realtime/v1",
+		"ws://10.0.0.9:8787/core/realtime/v1",
+		"ws://[fd00::1]:8787/core/realtime/v1",
+	}
+	if !reflect.DeepEqual(endpoints, want) {
+		t.Fatalf("Discover() = %v, want %v", endpoints, want)
+	}
+
+	discovery.lookupHost = func(ctx context.Context, host string) ([]string, error) {
+		return nil, errors.New("temporary failure")
+	}
+	if _, err := discovery.Discover(context.Background()); err == nil {
+		t.Fatal("expected a failed lookup to keep the previous endpoints by returning an error")
+	}
+}
+
+func TestHealthURL(t *testing.T) {
+	tests := map[string]string{
+		"ws://notegic-yjs-worker:8787/core/realtime/v1": "http://notegic-yjs-worker:8787/healthz",
+		"wss://yjs.internal/core/realtime/v1?region=ap": "https://yjs.internal/healthz",
+		"ws://[fd00::1]:8787/core/realtime/v1":          "http://[fd00::1]:8787/healthz",
+	}
+	for endpoint, want := range tests {
+		got, err := healthURL(endpoint)
+		if err != nil || got != want {
+			t.Fatalf("healthURL(%q) = %q, %v, want %q", endpoint, got, err, want)
+		}
+	}
+	if _, err := healthURL("tcp://yjs:8787"); err == nil {
+		t.Fatal("expected an unsupported scheme to be rejected")
+	}
+}
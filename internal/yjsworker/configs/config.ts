@@ -13,6 +13,23 @@ export const config = {
 
     return port;
   })(),
+  // the time to wait after reporting unready for the realtime gateways to move the subscribed rooms to other workers,
+  // which is only useful when another worker can take them, so it is disabled by default
+  shutdownDrainMilliseconds: (() => {
+    const drainString = process.env.YJS_WORKER_SHUTDOWN_DRAIN_MILLISECONDS;
+    if (drainString === undefined || drainString === "") {
+      return 0;
+    }
+
+    const drain = Number(drainString);
+    if (!Number.isInteger(drain) || drain < 0) {
+      throw new Error(
+        "YJS_WORKER_SHUTDOWN_DRAIN_MILLISECONDS must be a non-negative integer"
+      );
+    }
+
+    return drain;
+  })(),
   telemetry: {
     serviceName: process.env.OTEL_SERVICE_NAME ?? "notegic-yjs-worker",
     serviceVersion: process.env.OTEL_SERVICE_VERSION ?? "0.1.0",
@@ -6,6 +6,7 @@ import { WebSocketServer } from "ws";
 import { config } from "./configs/config.js";
 import { BlockPackProjector } from "./services/block_pack_projector.js";
 import { YjsCompactionService } from "./services/yjs_compaction_service.js";
+import { YjsDocumentHistoryService } from "./services/yjs_document_history_service.js";
 import { YjsDocumentInitializationService } from "./services/yjs_document_initialization_service.js";
 import { YjsProjectionService } from "./services/yjs_projection_service.js";
 import type { Telemetry } from "./telemetry.js";
@@ -34,6 +35,9 @@ export class YjsWorkerServer {
     const yjsCompactionService = new YjsCompactionService(telemetry);
     const yjsDocumentInitializationService =
       new YjsDocumentInitializationService();
+    const yjsDocumentHistoryService = new YjsDocumentHistoryService(
+      blockPackProjector
+    );
     const yjsProjectionService = new YjsProjectionService(
       blockPackProjector,
       telemetry
@@ -78,6 +82,7 @@ export class YjsWorkerServer {
       app,
       yjsCompactionService,
       yjsDocumentInitializationService,
+      yjsDocumentHistoryService,
       yjsProjectionService,
       telemetry
     );
@@ -109,6 +114,7 @@ export class YjsWorkerServer {
 
   async shutdown(): Promise<void> {
     this.ready = false;
+    await this.realtimeGateway.drain(config.shutdownDrainMilliseconds);
     this.healthy = false;
     const closeServer = new Promise<void>(resolve => {
       this.server.close(() => resolve());
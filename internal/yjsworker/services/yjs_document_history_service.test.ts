@@ -0,0 +1,74 @@
+import assert from "node:assert/strict";
+import test from "node:test";
+
+import { blocksToYXmlFragment } from "@blocknote/core/yjs";
+import * as Y from "yjs";
+import {
+  type NotegicBlock,
+  notegicBlockNoteEditor,
+} from "../types/blocknote_schema.js";
+import { BlockPackProjector } from "./block_pack_projector.js";
+import { YjsDocumentHistoryService } from "./yjs_document_history_service.js";
+
+function createParagraph(id: string, text: string): NotegicBlock {
+  return {
+    id,
+    type: "paragraph",
+    props: {
+      backgroundColor: "default",
+      textAlignment: "left",
+      textColor: "default",
+    },
+    content: [{ styles: {}, text, type: "text" }],
+    children: [],
+  } as NotegicBlock;
+}
+
+function createState(document: Y.Doc): Buffer {
+  const snapshot = Buffer.from(Y.encodeStateAsUpdate(document));
+  const stateVector = Buffer.from(Y.encodeStateVector(document));
+  const state = Buffer.alloc(36 + snapshot.length + stateVector.length);
+  state.writeBigInt64BE(0n, 0);
+  state.writeBigInt64BE(0n, 8);
+  state.writeBigInt64BE(-1n, 16);
+  state.writeUInt32BE(snapshot.length, 24);
+  state.writeUInt32BE(stateVector.length, 28);
+  state.writeUInt32BE(0, 32);
+  snapshot.copy(state, 36);
+  stateVector.copy(state, 36 + snapshot.length);
+
+  return state;
+}
+
+test("YjsDocumentHistoryService restores the target blocks on top of the current document", () => {
+  const targetBlocks = [
+    createParagraph("0b6b3f9e-4f0d-4e55-9a38-2f7d0c6c1a01", "before"),
+  ];
+  const document = new Y.Doc();
+  const fragment = document.getXmlFragment("document-store");
+  blocksToYXmlFragment(notegicBlockNoteEditor, targetBlocks, fragment);
+  const targetState = createState(document);
+
+  blocksToYXmlFragment(
+    notegicBlockNoteEditor,
+    [
+      createParagraph("0b6b3f9e-4f0d-4e55-9a38-2f7d0c6c1a01", "after"),
+      createParagraph("0b6b3f9e-4f0d-4e55-9a38-2f7d0c6c1a02", "added"),
+    ],
+    fragment
+  );
+  const currentState = createState(document);
+
+  const service = new YjsDocumentHistoryService(new BlockPackProjector());
+  assert.equal(service.project(currentState).length, 2);
+
+  const result = service.restore(currentState, targetState);
+  Y.applyUpdate(document, result.update);
+
+  assert.equal(result.blockCount, 1);
+  assert.deepEqual(
+    new BlockPackProjector().projectYjsDocument(document),
+    targetBlocks
+  );
+  document.destroy();
+});
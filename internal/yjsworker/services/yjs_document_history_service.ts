@@ -0,0 +1,79 @@
+import { blocksToYXmlFragment } from "@blocknote/core/yjs";
+import * as Y from "yjs";
+import { YjsBlockPackFragmentName } from "../../../contracts/yjs-worker/v1/yjsworker_contract.js";
+import {
+  type NotegicBlock,
+  notegicBlockNoteEditor,
+} from "../types/blocknote_schema.js";
+import { parseYjsDocumentState } from "../types/yjs_document_state.js";
+import type { BlockPackProjector } from "./block_pack_projector.js";
+
+export class YjsDocumentHistoryService {
+  private readonly blockPackProjector: BlockPackProjector;
+
+  constructor(blockPackProjector: BlockPackProjector) {
+    this.blockPackProjector = blockPackProjector;
+  }
+
+  project(statePayload: Buffer): NotegicBlock[] {
+    const document = YjsDocumentHistoryService.loadDocument(statePayload);
+    try {
+      return this.blockPackProjector.projectYjsDocument(document);
+    } finally {
+      document.destroy();
+    }
+  }
+
+  // restore rewrites the fragment of the current document with the blocks of the target
+  // document, so the result is a regular update on top of the current document instead of
+  // a rewind of its history, and the connected editors can apply it like any other change.
+  restore(
+    currentStatePayload: Buffer,
+    targetStatePayload: Buffer
+  ): { update: Buffer; blockCount: number } {
+    const currentDocument =
+      YjsDocumentHistoryService.loadDocument(currentStatePayload);
+    const targetDocument =
+      YjsDocumentHistoryService.loadDocument(targetStatePayload);
+    try {
+      const targetBlocks =
+        this.blockPackProjector.projectYjsDocument(targetDocument);
+      const beforeStateVector = Y.encodeStateVector(currentDocument);
+      currentDocument.transact(() => {
+        blocksToYXmlFragment(
+          notegicBlockNoteEditor,
+          targetBlocks,
+          currentDocument.getXmlFragment(YjsBlockPackFragmentName)
+        );
+      });
+
+      return {
+        update: Buffer.from(
+          Y.encodeStateAsUpdate(currentDocument, beforeStateVector)
+        ),
+        blockCount:
+          this.blockPackProjector.countYjsDocumentBlocks(currentDocument),
+      };
+    } finally {
+      currentDocument.destroy();
+      targetDocument.destroy();
+    }
+  }
+
+  private static loadDocument(statePayload: Buffer): Y.Doc {
+    const state = parseYjsDocumentState(statePayload);
+    if (state === null) {
+      throw new Error("invalid yjs document state");
+    }
+
+    const document = new Y.Doc();
+    if (state.snapshot.length > 0) {
+      Y.applyUpdate(document, state.snapshot);
+    }
+    for (const update of state.updates) {
+      Y.applyUpdate(document, update.payload);
+    }
+
+    return document;
+  }
+}
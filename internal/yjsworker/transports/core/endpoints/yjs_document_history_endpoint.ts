@@ -0,0 +1,167 @@
+import {
+  context as otelContext,
+  propagation,
+  SpanStatusCode,
+} from "@opentelemetry/api";
+import type { Hono } from "hono";
+import { bodyLimit } from "hono/body-limit";
+import { YjsMaintenanceMaximumPayloadBytes } from "../../../../../contracts/yjs-worker/v1/yjsworker_contract.js";
+import type { YjsDocumentHistoryService } from "../../../services/yjs_document_history_service.js";
+import type { Telemetry } from "../../../telemetry.js";
+import type {
+  YjsDocumentProjectionRequest,
+  YjsDocumentRestorationRequest,
+} from "../../../types/yjs_document_history_type.js";
+
+export function configureYjsDocumentProjectionEndpoint(
+  app: Hono,
+  yjsDocumentHistoryService: YjsDocumentHistoryService,
+  telemetry: Telemetry
+): void {
+  app.post(
+    "/core/yjs-document-projection/v1",
+    bodyLimit({
+      maxSize: YjsMaintenanceMaximumPayloadBytes,
+      onError: context => context.body(null, 413),
+    }),
+    async context => {
+      const startedAt = performance.now();
+      const contentLength = Number(context.req.header("content-length") ?? 0);
+      if (
+        !Number.isSafeInteger(contentLength) ||
+        contentLength <= 0 ||
+        contentLength > YjsMaintenanceMaximumPayloadBytes
+      ) {
+        return context.body(null, 413);
+      }
+
+      const parentContext = propagation.extract(
+        otelContext.active(),
+        context.req.raw.headers,
+        {
+          get: (headers, key) => headers.get(key) ?? undefined,
+          keys: headers => [...headers.keys()],
+        }
+      );
+      return otelContext.with(parentContext, async () => {
+        const span = telemetry.startSpan("document.projection_batch");
+        try {
+          const request =
+            await context.req.json<YjsDocumentProjectionRequest>();
+          if (
+            !Array.isArray(request.documents) ||
+            request.documents.length === 0 ||
+            request.documents.some(
+              document => typeof document.state !== "string"
+            )
+          ) {
+            return context.body(null, 422);
+          }
+
+          const documents = request.documents.map(document => ({
+            blocks: yjsDocumentHistoryService.project(
+              Buffer.from(document.state, "base64")
+            ),
+          }));
+          telemetry.recordOperation({
+            operation: "document.projection_batch",
+            outcome: "success",
+            durationMilliseconds: performance.now() - startedAt,
+            payloadBytes: contentLength,
+          });
+
+          return context.json({ documents });
+        } catch (error) {
+          span.recordException(error as Error);
+          span.setStatus({ code: SpanStatusCode.ERROR });
+          telemetry.recordOperation({
+            operation: "document.projection_batch",
+            outcome: "error",
+            durationMilliseconds: performance.now() - startedAt,
+            payloadBytes: contentLength,
+            error,
+          });
+          return context.body(null, 422);
+        } finally {
+          span.end();
+        }
+      });
+    }
+  );
+}
+
+export function configureYjsDocumentRestorationEndpoint(
+  app: Hono,
+  yjsDocumentHistoryService: YjsDocumentHistoryService,
+  telemetry: Telemetry
+): void {
+  app.post(
+    "/core/yjs-document-restoration/v1",
+    bodyLimit({
+      maxSize: YjsMaintenanceMaximumPayloadBytes,
+      onError: context => context.body(null, 413),
+    }),
+    async context => {
+      const startedAt = performance.now();
+      const contentLength = Number(context.req.header("content-length") ?? 0);
+      if (
+        !Number.isSafeInteger(contentLength) ||
+        contentLength <= 0 ||
+        contentLength > YjsMaintenanceMaximumPayloadBytes
+      ) {
+        return context.body(null, 413);
+      }
+
+      const parentContext = propagation.extract(
+        otelContext.active(),
+        context.req.raw.headers,
+        {
+          get: (headers, key) => headers.get(key) ?? undefined,
+          keys: headers => [...headers.keys()],
+        }
+      );
+      return otelContext.with(parentContext, async () => {
+        const span = telemetry.startSpan("document.restoration");
+        try {
+          const request =
+            await context.req.json<YjsDocumentRestorationRequest>();
+          if (
+            typeof request.currentState !== "string" ||
+            typeof request.targetState !== "string"
+          ) {
+            return context.body(null, 422);
+          }
+
+          const result = yjsDocumentHistoryService.restore(
+            Buffer.from(request.currentState, "base64"),
+            Buffer.from(request.targetState, "base64")
+          );
+          telemetry.recordOperation({
+            operation: "document.restoration",
+            outcome: "success",
+            durationMilliseconds: performance.now() - startedAt,
+            payloadBytes: contentLength,
+          });
+
+          return context.json({
+            update: result.update.toString("base64"),
+            blockCount: result.blockCount,
+          });
+        } catch (error) {
+          span.recordException(error as Error);
+          span.setStatus({ code: SpanStatusCode.ERROR });
+          telemetry.recordOperation({
+            operation: "document.restoration",
+            outcome: "error",
+            durationMilliseconds: performance.now() - startedAt,
+            payloadBytes: contentLength,
+            error,
+          });
+          return context.body(null, 422);
+        } finally {
+          span.end();
+        }
+      });
+    }
+  );
+}
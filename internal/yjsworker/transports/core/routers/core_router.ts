@@ -1,9 +1,14 @@
 import type { Hono } from "hono";
 import type { YjsCompactionService } from "../../../services/yjs_compaction_service.js";
+import type { YjsDocumentHistoryService } from "../../../services/yjs_document_history_service.js";
 import type { YjsDocumentInitializationService } from "../../../services/yjs_document_initialization_service.js";
 import type { YjsProjectionService } from "../../../services/yjs_projection_service.js";
 import type { Telemetry } from "../../../telemetry.js";
 import { configureYjsCompactionEndpoint } from "../endpoints/yjs_compaction_endpoint.js";
+import {
+  configureYjsDocumentProjectionEndpoint,
+  configureYjsDocumentRestorationEndpoint,
+} from "../endpoints/yjs_document_history_endpoint.js";
 import { configureYjsDocumentInitializationEndpoint } from "../endpoints/yjs_document_initialization_endpoint.js";
 import { configureYjsProjectionEndpoint } from "../endpoints/yjs_projection_endpoint.js";
 
@@ -11,6 +16,7 @@ export function configureCoreRouter(
   app: Hono,
   yjsCompactionService: YjsCompactionService,
   yjsDocumentInitializationService: YjsDocumentInitializationService,
+  yjsDocumentHistoryService: YjsDocumentHistoryService,
   yjsProjectionService: YjsProjectionService,
   telemetry: Telemetry
 ): void {
@@ -20,5 +26,15 @@ export function configureCoreRouter(
     yjsDocumentInitializationService,
     telemetry
   );
+  configureYjsDocumentProjectionEndpoint(
+    app,
+    yjsDocumentHistoryService,
+    telemetry
+  );
+  configureYjsDocumentRestorationEndpoint(
+    app,
+    yjsDocumentHistoryService,
+    telemetry
+  );
   configureYjsProjectionEndpoint(app, yjsProjectionService, telemetry);
 }
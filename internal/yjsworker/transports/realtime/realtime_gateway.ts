@@ -988,6 +988,22 @@ export class RealtimeGateway {
 
           return;
         }
+        case InternalFrameType.InternalFrameType_YjsDocumentRestored: {
+          const room = this.roomRegistry.get(frame.blockPackId);
+          const updateSequence = parseYjsUpdateSequence(frame.payload);
+          if (
+            room === undefined ||
+            room.document === null ||
+            updateSequence === null ||
+            room.lastUpdateSequence >= updateSequence
+          ) {
+            return;
+          }
+
+          this.resyncRoom(room, frame.blockPackId);
+
+          return;
+        }
         case InternalFrameType.InternalFrameType_YjsPersistenceFailed: {
           const room = this.roomRegistry.get(frame.blockPackId);
           if (room !== undefined) {
@@ -1126,6 +1142,20 @@ export class RealtimeGateway {
     return this.roomRegistry.size;
   }
 
+  // drain waits until the subscribers of every room have been detached, which the realtime gateways do once this worker
+  // reports unready, so the rooms move to other workers before the remaining subscribers are closed by the shutdown
+  async drain(timeoutMilliseconds: number): Promise<void> {
+    const drainDeadline = Date.now() + timeoutMilliseconds;
+    while (
+      Date.now() < drainDeadline &&
+      [...this.roomRegistry.entries()].some(
+        ([, room]) => room.subscribers.size > 0
+      )
+    ) {
+      await new Promise(resolve => setTimeout(resolve, 250));
+    }
+  }
+
   async shutdown(): Promise<void> {
     for (const [blockPackId, room] of this.roomRegistry.entries()) {
       this.yjsDebouncer.flush(room, blockPackId);
@@ -21,4 +21,5 @@ export enum InternalFrameType {
   InternalFrameType_YjsDocumentCompactionFailed = 20,
   InternalFrameType_Attached = 21,
   InternalFrameType_BlockPackQuotaExceeded = 22,
+  InternalFrameType_YjsDocumentRestored = 23,
 }
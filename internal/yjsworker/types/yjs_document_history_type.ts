@@ -0,0 +1,10 @@
+export type YjsDocumentProjectionRequest = {
+  documents: Array<{
+    state: string;
+  }>;
+};
+
+export type YjsDocumentRestorationRequest = {
+  currentState: string;
+  targetState: string;
+};
@@ -22,6 +22,13 @@ const (
 	RealtimeWorkerReconnectDelay        time.Duration = 2 * time.Second
 	RealtimeWorkerAttachTimeout         time.Duration = 10 * time.Second
 	RealtimeWorkerQueueSize             int           = 1024
+	RealtimeWorkerVirtualNodes          int           = 128
+	RealtimeWorkerDiscoveryInterval     time.Duration = 15 * time.Second
+	RealtimeWorkerHealthCheckInterval   time.Duration = 5 * time.Second
+	RealtimeWorkerHealthCheckTimeout    time.Duration = 2 * time.Second
+	RealtimeWorkerFailoverGracePeriod   time.Duration = 10 * time.Second
+	RealtimeWorkerRebalanceBatchSize    int           = 32
+	RealtimeWorkerChannelOwnerTTL       time.Duration = 30 * time.Second
 	RealtimeMaxOutboundControlFrames    int           = 256
 	RealtimeMaxOutboundFramesPerChannel int           = 256
 	RealtimeMaxOutboundBytesPerChannel  int64         = 4 << 20
@@ -55,6 +62,33 @@ const (
 	MaxNonVideoFileSize        types.ByteType = 10 * types.MB
 	MaxInMemoryStorageFileSize types.ByteType = 10 * types.MB
 	MaxS3StorageFileSize       types.ByteType = 10 * types.MB
+
+	// every part of a material upload but the last one has this size, which is above the minimum part
+	// size of the S3 compatible storages, and keeps a single part retryable on slow connections
+	MaterialUploadPartSize types.ByteType = 8 * types.MB
+)
+
+/* ============================== Root Shelf Archive limitations ============================== */
+
+const (
+	// the archive itself is kept in the storage, so it can not be larger than a single stored object
+	MaxRootShelfArchiveSize             types.ByteType = 10 * types.MB
+	MaxRootShelfArchiveUncompressedSize types.ByteType = 64 * types.MB
+	MaxRootShelfArchiveEntryCount       int            = 10_000
+	RootShelfArchiveDownloadExpiresIn   time.Duration  = 24 * time.Hour
+
+	// make sure the below value is as the same as the check constraint of the sub shelf path
+	MaxSubShelfPathLength int = 100
+)
+
+/* ============================== Block Pack Snapshot limitations ============================== */
+
+const (
+	// a periodic snapshot is captured by the compaction if the latest one is older than the interval
+	BlockPackPeriodicSnapshotInterval   time.Duration = 1 * time.Hour
+	MaxBlockPackPeriodicSnapshotCount   int           = 48
+	MaxBlockPackPreRestoreSnapshotCount int           = 20
+	MaxBlockPackNamedSnapshotCount      int           = 100
 )
 
 /* ============================== Variable constraints ============================== */
@@ -68,6 +102,10 @@ const (
 	// make sure the below values are as the same as the constraint in the dto while registering or creating the user
 	MaxNameLength = 32
 	MinNameLength = 6
+
+	// make sure the below values are as the same as the check constraints of the block table
+	MaxBlockPropsSize   int = 4096
+	MaxBlockContentSize int = 16384
 )
 
 /* ============================== Database or orm limitation ============================== */
@@ -3,13 +3,17 @@ package cookies
 type ValidCookieName string
 
 const (
-	ValidCookieName_AccessToken  ValidCookieName = "accessToken"
-	ValidCookieName_RefreshToken ValidCookieName = "refreshToken"
+	ValidCookieName_AccessToken       ValidCookieName = "accessToken"
+	ValidCookieName_RefreshToken      ValidCookieName = "refreshToken"
+	ValidCookieName_ShareSessionToken ValidCookieName = "shareSessionToken"
+	ValidCookieName_OAuthStateToken   ValidCookieName = "oauthStateToken"
 )
 
 var _validCookieNames = map[string]ValidCookieName{
-	"accessToken":  ValidCookieName_AccessToken,
-	"refreshToken": ValidCookieName_RefreshToken,
+	"accessToken":       ValidCookieName_AccessToken,
+	"refreshToken":      ValidCookieName_RefreshToken,
+	"shareSessionToken": ValidCookieName_ShareSessionToken,
+	"oauthStateToken":   ValidCookieName_OAuthStateToken,
 }
 
 func (cn ValidCookieName) String() string {
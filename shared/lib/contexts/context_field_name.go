@@ -11,16 +11,22 @@ const (
 	ContextFieldName_User_Name           ContextFieldName = "User-Name"           // string
 	ContextFieldName_User_DisplayName    ContextFieldName = "User-DisplayName"    // string
 	ContextFieldName_User_Email          ContextFieldName = "User-Email"          // string
+	ContextFieldName_User_SessionId      ContextFieldName = "User-SessionId"      // UUID
 	ContextFieldName_IsNewTokens         ContextFieldName = "IsNewTokens"         // bool
 	ContextFieldName_AccessToken         ContextFieldName = "AccessToken"         // string
 	ContextFieldName_RefreshToken        ContextFieldName = "RefreshToken"        // string
+	ContextFieldName_NewRefreshToken     ContextFieldName = "NewRefreshToken"     // string (only set after a rotation)
 	ContextFieldName_CSRFToken           ContextFieldName = "CSRFToken"           // string
+	ContextFieldName_ShareSessionToken   ContextFieldName = "ShareSessionToken"   // string
 	ContextFieldName_User_Role           ContextFieldName = "User-Role"           // enums.UserRole
 	ContextFieldName_User_Plan           ContextFieldName = "User-Plan"           // enums.UserPlan
 	ContextFieldName_Allowed_Permissions ContextFieldName = "Allowed-Permissions" // []enums.AccessControlPermission
 	ContextFieldName_Gateway_Source      ContextFieldName = "Gateway-Source"      // string: client | api
-	ContextFieldName_Auth_Method         ContextFieldName = "Auth-Method"         // string: jwt | api-key
+	ContextFieldName_Auth_Method         ContextFieldName = "Auth-Method"         // string: jwt | api-key | share-link
 	ContextFieldName_API_Key_Id          ContextFieldName = "API-Key-Id"          // string (never the raw key)
+	ContextFieldName_API_Key_Scope       ContextFieldName = "API-Key-Scope"       // string: <resource>:<action>
+	ContextFieldName_API_Key_Restriction ContextFieldName = "API-Key-Restriction" // contexts.APIKeyRestriction
+	ContextFieldName_Share_Link          ContextFieldName = "Share-Link"          // contexts.ShareLinkRestriction
 
 	ContextFieldName_GinContext          ContextFieldName = "GinContext"          // gin.Context
 	ContextFieldName_FormDataFileHeaders ContextFieldName = "FormDataFileHeaders" // []*multipart.FileHeader
@@ -0,0 +1,453 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/ascii85"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+)
+
+// the maximum size in bytes of a decoded stream, which prevents the decompression bombs
+const MaxDecodedStreamSize = 64 * 1024 * 1024
+
+// the maximum size in bytes of all the streams decoded from one document, since a document may hold any number of
+// streams which are each below the limit of a single stream
+const MaxDecodedDocumentSize = 256 * 1024 * 1024
+
+// the maximum depth of the page tree and the reference chains
+const maxResolvingDepth = 32
+
+// the maximum number of color components of a predicted row, which is the limit of the DeviceN color spaces
+const maxPredictorColors = 32
+
+var predictorBitsPerComponents = []float64{1, 2, 4, 8, 16}
+
+var (
+	ErrInvalidDocument   = errors.New("the content is not a PDF document")
+	ErrEncryptedDocument = errors.New("the PDF document is encrypted")
+	ErrUnsupportedFilter = errors.New("the PDF stream uses an unsupported filter")
+	ErrDecodingBudget    = errors.New("the PDF document exceeds its decoding budget")
+)
+
+var objectHeaderPattern = regexp.MustCompile(`(\d+)\s+(\d+)\s+obj\b`)
+
+// Document is a minimal reader of the PDF documents, which scans the objects instead of trusting
+// the cross-reference table, so the incrementally updated and slightly broken files are still readable
+type Document struct {
+	objects map[int]any
+	catalog Dictionary
+	// the bytes decoded so far, which are bounded by MaxDecodedDocumentSize
+	decodedSize int
+}
+
+// Page is a leaf of the page tree with the resources it inherits from its ancestors
+type Page struct {
+	Dictionary Dictionary
+	Resources  Dictionary
+}
+
+func Open(data []byte) (*Document, error) {
+	if !bytes.Contains(data[:min(len(data), 1024)], []byte("%PDF-")) {
+		return nil, ErrInvalidDocument
+	}
+
+	document := &Document{objects: make(map[int]any)}
+	// the later definitions win, which are the ones written by the incremental updates
+	for _, match := range objectHeaderPattern.FindAllSubmatchIndex(data, -1) {
+		if match[0] > 0 && !isWhitespace(data[match[0]-1]) && !isDelimiter(data[match[0]-1]) {
+			continue
+		}
+		number, err := strconv.Atoi(string(data[match[2]:match[3]]))
+		if err != nil {
+			continue
+		}
+		objectLexer := &lexer{data: data, position: match[1]}
+		value, err := objectLexer.parseValue()
+		if err != nil {
+			continue
+		}
+		if dictionary, ok := value.(Dictionary); ok {
+			if stream, ok := objectLexer.parseStream(dictionary); ok {
+				value = stream
+			}
+		}
+		document.objects[number] = value
+	}
+	document.loadObjectStreams()
+
+	trailer := document.findTrailer(data)
+	if _, exists := trailer["Encrypt"]; exists {
+		return nil, ErrEncryptedDocument
+	}
+	if catalog, ok := document.Resolve(trailer["Root"]).(Dictionary); ok {
+		document.catalog = catalog
+	} else {
+		for _, object := range document.objects {
+			if dictionary, ok := object.(Dictionary); ok && dictionary["Type"] == Name("Catalog") {
+				document.catalog = dictionary
+				break
+			}
+		}
+	}
+	if document.catalog == nil {
+		return nil, ErrInvalidDocument
+	}
+
+	return document, nil
+}
+
+/* ============================== Document Methods ============================== */
+
+// Resolve follows the references until a direct object is reached, where a missing object resolves to nil
+func (d *Document) Resolve(value any) any {
+	for depth := 0; depth < maxResolvingDepth; depth++ {
+		reference, ok := value.(Reference)
+		if !ok {
+			return value
+		}
+		value = d.objects[reference.Number]
+	}
+	return nil
+}
+
+func (d *Document) ResolveDictionary(value any) Dictionary {
+	switch resolved := d.Resolve(value).(type) {
+	case Dictionary:
+		return resolved
+	case Stream:
+		return resolved.Dictionary
+	default:
+		return nil
+	}
+}
+
+// Pages returns the leaves of the page tree in order
+func (d *Document) Pages() []Page {
+	var pages []Page
+	visited := make(map[int]bool)
+	var walk func(node any, resources Dictionary, depth int)
+	walk = func(node any, resources Dictionary, depth int) {
+		if depth > maxResolvingDepth {
+			return
+		}
+		if reference, ok := node.(Reference); ok {
+			if visited[reference.Number] {
+				return
+			}
+			visited[reference.Number] = true
+		}
+		dictionary := d.ResolveDictionary(node)
+		if dictionary == nil {
+			return
+		}
+		if ownResources := d.ResolveDictionary(dictionary["Resources"]); ownResources != nil {
+			resources = ownResources
+		}
+		kids, hasKids := d.Resolve(dictionary["Kids"]).(Array)
+		if !hasKids || dictionary["Type"] == Name("Page") {
+			pages = append(pages, Page{Dictionary: dictionary, Resources: resources})
+			return
+		}
+		for _, kid := range kids {
+			walk(kid, resources, depth+1)
+		}
+	}
+	walk(d.catalog["Pages"], nil, 0)
+
+	return pages
+}
+
+// Decode applies the filters of the stream, while the image filters which are left encoded are reported by their names
+func (d *Document) Decode(stream Stream) ([]byte, Name, error) {
+	filters := d.names(stream.Dictionary["Filter"])
+	parameters := d.Resolve(stream.Dictionary["DecodeParms"])
+	data := stream.Raw
+	for index, filter := range filters {
+		var filterParameters Dictionary
+		if parametersArray, ok := parameters.(Array); ok && index < len(parametersArray) {
+			filterParameters = d.ResolveDictionary(parametersArray[index])
+		} else if index == 0 {
+			filterParameters = d.ResolveDictionary(parameters)
+		}
+
+		var err error
+		switch filter {
+		case "FlateDecode", "Fl":
+			remainingSize := MaxDecodedDocumentSize - d.decodedSize
+			if remainingSize <= 0 {
+				return nil, "", ErrDecodingBudget
+			}
+			data, err = inflate(data, min(MaxDecodedStreamSize, remainingSize))
+			if err == nil {
+				data, err = unpredict(data, filterParameters)
+			}
+		case "ASCIIHexDecode", "AHx":
+			data = (&lexer{data: append(append([]byte{'<'}, data...), '>')}).parseHexString()
+		case "ASCII85Decode", "A85":
+			data, err = decodeASCII85(data)
+		case "DCTDecode", "DCT", "JPXDecode":
+			if index != len(filters)-1 {
+				return nil, "", ErrUnsupportedFilter
+			}
+			return data, filter, nil
+		default:
+			return nil, "", fmt.Errorf("%w: %s", ErrUnsupportedFilter, filter)
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		d.decodedSize += len(data)
+	}
+
+	return data, "", nil
+}
+
+/* ============================== Auxiliary Functions ============================== */
+
+// parseStream reads the bytes between the stream and endstream keywords following the dictionary,
+// where the length is only trusted if it really ends at the endstream keyword
+func (l *lexer) parseStream(dictionary Dictionary) (Stream, bool) {
+	l.skipSpaces()
+	if !bytes.HasPrefix(l.data[l.position:], []byte("stream")) {
+		return Stream{}, false
+	}
+	start := l.position + len("stream")
+	if start < len(l.data) && l.data[start] == '\r' {
+		start++
+	}
+	if start < len(l.data) && l.data[start] == '\n' {
+		start++
+	}
+
+	if length, ok := dictionary["Length"].(float64); ok && length >= 0 && start+int(length) <= len(l.data) {
+		end := start + int(length)
+		if bytes.HasPrefix(bytes.TrimLeft(l.data[end:min(len(l.data), end+32)], "\r\n \t"), []byte("endstream")) {
+			l.position = end
+			return Stream{Dictionary: dictionary, Raw: l.data[start:end]}, true
+		}
+	}
+	index := bytes.Index(l.data[start:], []byte("endstream"))
+	if index < 0 {
+		return Stream{}, false
+	}
+	end := start + index
+	l.position = end
+	// the end of line before the endstream keyword is not a part of the data
+	if end > start && l.data[end-1] == '\n' {
+		end--
+	}
+	if end > start && l.data[end-1] == '\r' {
+		end--
+	}
+	return Stream{Dictionary: dictionary, Raw: l.data[start:end]}, true
+}
+
+// loadObjectStreams unpacks the objects compressed into the object streams of PDF 1.5,
+// while the objects defined directly in the file are kept since they are never compressed
+func (d *Document) loadObjectStreams() {
+	compressedObjects := make(map[int]any)
+	for _, object := range d.objects {
+		stream, ok := object.(Stream)
+		if !ok || stream.Dictionary["Type"] != Name("ObjStm") {
+			continue
+		}
+		count, _ := d.Resolve(stream.Dictionary["N"]).(float64)
+		first, _ := d.Resolve(stream.Dictionary["First"]).(float64)
+		data, filter, err := d.Decode(stream)
+		if err != nil || filter != "" || first < 0 || int(first) > len(data) {
+			continue
+		}
+
+		headerLexer := &lexer{data: data[:int(first)]}
+		for index := 0; index < int(count); index++ {
+			number, numberErr := headerLexer.parseValue()
+			offset, offsetErr := headerLexer.parseValue()
+			objectNumber, isNumber := number.(float64)
+			objectOffset, isOffset := offset.(float64)
+			if numberErr != nil || offsetErr != nil || !isNumber || !isOffset {
+				break
+			}
+			objectLexer := &lexer{data: data, position: int(first) + int(objectOffset)}
+			if objectOffset < 0 || objectLexer.position < 0 || objectLexer.position > len(data) {
+				break
+			}
+			if value, err := objectLexer.parseValue(); err == nil {
+				compressedObjects[int(objectNumber)] = value
+			}
+		}
+	}
+	for number, object := range compressedObjects {
+		if _, exists := d.objects[number]; !exists {
+			d.objects[number] = object
+		}
+	}
+}
+
+// findTrailer merges the trailer dictionaries and the dictionaries of the cross-reference streams,
+// where the later trailers win as they belong to the later incremental updates
+func (d *Document) findTrailer(data []byte) Dictionary {
+	trailer := Dictionary{}
+	for _, object := range d.objects {
+		if stream, ok := object.(Stream); ok && stream.Dictionary["Type"] == Name("XRef") {
+			for key, value := range stream.Dictionary {
+				trailer[key] = value
+			}
+		}
+	}
+	for offset := 0; ; {
+		index := bytes.Index(data[offset:], []byte("trailer"))
+		if index < 0 {
+			break
+		}
+		trailerLexer := &lexer{data: data, position: offset + index + len("trailer")}
+		if dictionary, err := trailerLexer.parseValue(); err == nil {
+			if dictionary, ok := dictionary.(Dictionary); ok {
+				for key, value := range dictionary {
+					trailer[key] = value
+				}
+			}
+		}
+		offset += index + len("trailer")
+	}
+	return trailer
+}
+
+func (d *Document) names(value any) []Name {
+	switch resolved := d.Resolve(value).(type) {
+	case Name:
+		return []Name{resolved}
+	case Array:
+		names := make([]Name, 0, len(resolved))
+		for _, item := range resolved {
+			if name, ok := d.Resolve(item).(Name); ok {
+				names = append(names, name)
+			}
+		}
+		return names
+	default:
+		return nil
+	}
+}
+
+/* ============================== Helper Functions ============================== */
+
+func inflate(data []byte, maximumSize int) ([]byte, error) {
+	reader, err := zlib.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	inflated, err := io.ReadAll(io.LimitReader(reader, int64(maximumSize)+1))
+	if len(inflated) > maximumSize {
+		return nil, fmt.Errorf("the decoded stream exceeds %d bytes", maximumSize)
+	}
+	// many writers leave a truncated checksum behind, so the data read so far is kept
+	if err != nil && len(inflated) == 0 {
+		return nil, err
+	}
+	return inflated, nil
+}
+
+// unpredict reverts the PNG predictors of the flate streams, while the TIFF predictor is not supported
+func unpredict(data []byte, parameters Dictionary) ([]byte, error) {
+	predictor, _ := parameters["Predictor"].(float64)
+	if predictor < 10 {
+		if predictor == 2 {
+			return nil, fmt.Errorf("%w: TIFF predictor", ErrUnsupportedFilter)
+		}
+		return data, nil
+	}
+
+	colors, columns, bitsPerComponent := 1.0, 1.0, 8.0
+	if value, ok := parameters["Colors"].(float64); ok {
+		colors = value
+	}
+	if value, ok := parameters["Columns"].(float64); ok {
+		columns = value
+	}
+	if value, ok := parameters["BitsPerComponent"].(float64); ok {
+		bitsPerComponent = value
+	}
+	// the parameters are bounded before any row is allocated, so a row can never be larger than the data itself
+	if colors < 1 || colors > maxPredictorColors || columns < 1 || !slices.Contains(predictorBitsPerComponents, bitsPerComponent) {
+		return nil, ErrInvalidDocument
+	}
+	if math.Ceil(colors*bitsPerComponent*columns/8) > float64(len(data)) {
+		return nil, ErrInvalidDocument
+	}
+	bytesPerPixel := max(1, int(colors*bitsPerComponent+7)/8)
+	rowSize := int(colors*bitsPerComponent*columns+7) / 8
+	if rowSize <= 0 {
+		return nil, ErrInvalidDocument
+	}
+
+	output := make([]byte, 0, len(data)/(rowSize+1)*rowSize)
+	previous := make([]byte, rowSize)
+	for offset := 0; offset+rowSize+1 <= len(data); offset += rowSize + 1 {
+		filterType := data[offset]
+		row := append([]byte(nil), data[offset+1:offset+1+rowSize]...)
+		for index := range row {
+			var left, upperLeft byte
+			if index >= bytesPerPixel {
+				left = row[index-bytesPerPixel]
+				upperLeft = previous[index-bytesPerPixel]
+			}
+			up := previous[index]
+			switch filterType {
+			case 1:
+				row[index] += left
+			case 2:
+				row[index] += up
+			case 3:
+				row[index] += byte((int(left) + int(up)) / 2)
+			case 4:
+				row[index] += paeth(left, up, upperLeft)
+			}
+		}
+		output = append(output, row...)
+		previous = row
+	}
+	return output, nil
+}
+
+func paeth(left byte, up byte, upperLeft byte) byte {
+	estimate := int(left) + int(up) - int(upperLeft)
+	leftDistance := abs(estimate - int(left))
+	upDistance := abs(estimate - int(up))
+	upperLeftDistance := abs(estimate - int(upperLeft))
+	if leftDistance <= upDistance && leftDistance <= upperLeftDistance {
+		return left
+	}
+	if upDistance <= upperLeftDistance {
+		return up
+	}
+	return upperLeft
+}
+
+func abs(value int) int {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
+
+func decodeASCII85(data []byte) ([]byte, error) {
+	data = bytes.TrimSpace(data)
+	data = bytes.TrimPrefix(data, []byte("<~"))
+	if index := bytes.Index(data, []byte("~>")); index >= 0 {
+		data = data[:index]
+	}
+	decoded := make([]byte, len(data))
+	count, _, err := ascii85.Decode(decoded, data, true)
+	if err != nil {
+		return nil, err
+	}
+	return decoded[:count], nil
+}
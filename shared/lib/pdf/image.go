@@ -0,0 +1,133 @@
+package pdf
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+)
+
+// the maximum number of pixels of a decoded image, which prevents the decompression bombs
+const MaxImagePixels = 64 * 1024 * 1024
+
+var (
+	ErrNoImage          = errors.New("the PDF page does not draw any image")
+	ErrUnsupportedImage = errors.New("the PDF image uses an unsupported encoding")
+)
+
+/* ============================== Document Methods ============================== */
+
+// FirstPageImage decodes the largest image drawn by the first page, which is the page itself for the scanned
+// documents, while the pages made of text and vector graphics only have a preview with a full renderer
+func (d *Document) FirstPageImage() (image.Image, error) {
+	pages := d.Pages()
+	if len(pages) == 0 {
+		return nil, ErrNoImage
+	}
+
+	var largestImage *Stream
+	largestPixels := 0.0
+	for _, value := range d.ResolveDictionary(pages[0].Resources["XObject"]) {
+		stream, ok := d.Resolve(value).(Stream)
+		if !ok || stream.Dictionary["Subtype"] != Name("Image") {
+			continue
+		}
+		width, _ := d.Resolve(stream.Dictionary["Width"]).(float64)
+		height, _ := d.Resolve(stream.Dictionary["Height"]).(float64)
+		if pixels := width * height; pixels > largestPixels {
+			largestImage = &stream
+			largestPixels = pixels
+		}
+	}
+	if largestImage == nil {
+		return nil, ErrNoImage
+	}
+
+	return d.decodeImage(*largestImage)
+}
+
+/* ============================== Auxiliary Functions ============================== */
+
+// decodeImage decodes the JPEG images and the 8-bit gray or RGB raw images, which cover most of the scanned pages
+func (d *Document) decodeImage(stream Stream) (image.Image, error) {
+	width, _ := d.Resolve(stream.Dictionary["Width"]).(float64)
+	height, _ := d.Resolve(stream.Dictionary["Height"]).(float64)
+	if width <= 0 || height <= 0 || width*height > MaxImagePixels {
+		return nil, ErrUnsupportedImage
+	}
+
+	data, filter, err := d.Decode(stream)
+	if err != nil {
+		return nil, err
+	}
+	switch filter {
+	case "DCTDecode", "DCT":
+		// the dimensions of the stream dictionary are not binding for the embedded JPEG
+		config, err := jpeg.DecodeConfig(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		if config.Width*config.Height > MaxImagePixels {
+			return nil, ErrUnsupportedImage
+		}
+		return jpeg.Decode(bytes.NewReader(data))
+	case "":
+	default:
+		return nil, ErrUnsupportedImage
+	}
+
+	if bitsPerComponent, _ := d.Resolve(stream.Dictionary["BitsPerComponent"]).(float64); bitsPerComponent != 8 {
+		return nil, ErrUnsupportedImage
+	}
+	components := d.colorComponents(stream.Dictionary["ColorSpace"])
+	pixelCount := int(width) * int(height)
+	if (components != 1 && components != 3) || len(data) < pixelCount*components {
+		return nil, ErrUnsupportedImage
+	}
+
+	bounds := image.Rect(0, 0, int(width), int(height))
+	if components == 1 {
+		grayImage := image.NewGray(bounds)
+		copy(grayImage.Pix, data[:pixelCount])
+		return grayImage, nil
+	}
+	rgbImage := image.NewNRGBA(bounds)
+	for index := 0; index < pixelCount; index++ {
+		rgbImage.SetNRGBA(index%int(width), index/int(width), color.NRGBA{
+			R: data[index*3],
+			G: data[index*3+1],
+			B: data[index*3+2],
+			A: 0xff,
+		})
+	}
+	return rgbImage, nil
+}
+
+func (d *Document) colorComponents(value any) int {
+	switch colorSpace := d.Resolve(value).(type) {
+	case Name:
+		switch colorSpace {
+		case "DeviceGray", "CalGray", "G":
+			return 1
+		case "DeviceRGB", "CalRGB", "RGB":
+			return 3
+		}
+	case Array:
+		if len(colorSpace) < 2 {
+			return 0
+		}
+		switch d.Resolve(colorSpace[0]) {
+		case Name("ICCBased"):
+			if stream, ok := d.Resolve(colorSpace[1]).(Stream); ok {
+				components, _ := d.Resolve(stream.Dictionary["N"]).(float64)
+				return int(components)
+			}
+		case Name("CalGray"):
+			return 1
+		case Name("CalRGB"):
+			return 3
+		}
+	}
+	return 0
+}
@@ -0,0 +1,326 @@
+package pdf
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"io"
+	"strconv"
+)
+
+// Name is a PDF name object without its leading slash
+type Name string
+
+// Keyword is a bare token, which is either an object keyword or an operator of a content stream
+type Keyword string
+
+// Reference is an indirect reference to the object with the number and the generation
+type Reference struct {
+	Number     int
+	Generation int
+}
+
+type Array []any
+
+type Dictionary map[Name]any
+
+// Stream is a dictionary followed by its raw bytes, which are decoded by Document.Decode
+type Stream struct {
+	Dictionary Dictionary
+	Raw        []byte
+}
+
+// the maximum nesting depth of the arrays and dictionaries, which stops the malicious documents early
+const maxNestingDepth = 64
+
+var errUnexpectedToken = errors.New("unexpected token")
+
+type lexer struct {
+	data     []byte
+	position int
+}
+
+/* ============================== Lexer Methods ============================== */
+
+// parseValue reads the next object, and returns the unknown delimiters as keywords so the callers always advance
+func (l *lexer) parseValue() (any, error) {
+	return l.parseNestedValue(0)
+}
+
+func (l *lexer) parseNestedValue(depth int) (any, error) {
+	if depth > maxNestingDepth {
+		return nil, errUnexpectedToken
+	}
+
+	l.skipSpaces()
+	if l.position >= len(l.data) {
+		return nil, io.EOF
+	}
+
+	switch character := l.data[l.position]; {
+	case character == '/':
+		return l.parseName(), nil
+	case character == '(':
+		return l.parseLiteralString(), nil
+	case character == '<':
+		if l.position+1 < len(l.data) && l.data[l.position+1] == '<' {
+			l.position += 2
+			return l.parseDictionary(depth)
+		}
+		return l.parseHexString(), nil
+	case character == '[':
+		l.position++
+		return l.parseArray(depth)
+	case character == '+' || character == '-' || character == '.' || isDigit(character):
+		return l.parseNumberOrReference(), nil
+	case isDelimiter(character):
+		l.position++
+		if character == '>' && l.position < len(l.data) && l.data[l.position] == '>' {
+			l.position++
+			return Keyword(">>"), nil
+		}
+		return Keyword(string(character)), nil
+	default:
+		start := l.position
+		for l.position < len(l.data) && !isWhitespace(l.data[l.position]) && !isDelimiter(l.data[l.position]) {
+			l.position++
+		}
+		switch keyword := string(l.data[start:l.position]); keyword {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "null":
+			return nil, nil
+		default:
+			return Keyword(keyword), nil
+		}
+	}
+}
+
+func (l *lexer) parseDictionary(depth int) (Dictionary, error) {
+	dictionary := Dictionary{}
+	for {
+		key, err := l.parseNestedValue(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		if key == Keyword(">>") {
+			return dictionary, nil
+		}
+		name, ok := key.(Name)
+		if !ok {
+			return nil, errUnexpectedToken
+		}
+		value, err := l.parseNestedValue(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		if value == Keyword(">>") {
+			return dictionary, nil
+		}
+		dictionary[name] = value
+	}
+}
+
+func (l *lexer) parseArray(depth int) (Array, error) {
+	array := Array{}
+	for {
+		value, err := l.parseNestedValue(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		if value == Keyword("]") {
+			return array, nil
+		}
+		array = append(array, value)
+	}
+}
+
+func (l *lexer) parseName() Name {
+	l.position++
+	var name []byte
+	for l.position < len(l.data) && !isWhitespace(l.data[l.position]) && !isDelimiter(l.data[l.position]) {
+		character := l.data[l.position]
+		if character == '#' && l.position+2 < len(l.data) {
+			if decoded, err := hex.DecodeString(string(l.data[l.position+1 : l.position+3])); err == nil {
+				name = append(name, decoded[0])
+				l.position += 3
+				continue
+			}
+		}
+		name = append(name, character)
+		l.position++
+	}
+	return Name(name)
+}
+
+func (l *lexer) parseLiteralString() []byte {
+	l.position++
+	var literal []byte
+	depth := 1
+	for l.position < len(l.data) {
+		character := l.data[l.position]
+		l.position++
+		switch character {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return literal
+			}
+		case '\\':
+			if l.position >= len(l.data) {
+				return literal
+			}
+			escaped := l.data[l.position]
+			l.position++
+			switch escaped {
+			case 'n':
+				literal = append(literal, '\n')
+			case 'r':
+				literal = append(literal, '\r')
+			case 't':
+				literal = append(literal, '\t')
+			case 'b':
+				literal = append(literal, '\b')
+			case 'f':
+				literal = append(literal, '\f')
+			case '\r':
+				// a backslash at the end of the line continues the string on the next line
+				if l.position < len(l.data) && l.data[l.position] == '\n' {
+					l.position++
+				}
+			case '\n':
+			default:
+				if escaped < '0' || escaped > '7' {
+					literal = append(literal, escaped)
+					continue
+				}
+				octal := int(escaped - '0')
+				for count := 1; count < 3 && l.position < len(l.data) && l.data[l.position] >= '0' && l.data[l.position] <= '7'; count++ {
+					octal = octal*8 + int(l.data[l.position]-'0')
+					l.position++
+				}
+				literal = append(literal, byte(octal))
+			}
+			continue
+		}
+		literal = append(literal, character)
+	}
+	return literal
+}
+
+func (l *lexer) parseHexString() []byte {
+	l.position++
+	digits := make([]byte, 0, 16)
+	for l.position < len(l.data) && l.data[l.position] != '>' {
+		if character := l.data[l.position]; isHexDigit(character) {
+			digits = append(digits, character)
+		}
+		l.position++
+	}
+	// an unterminated string ends the data instead of moving past it
+	if l.position < len(l.data) {
+		l.position++
+	}
+	if len(digits)%2 == 1 {
+		digits = append(digits, '0')
+	}
+	decoded, _ := hex.DecodeString(string(digits))
+	return decoded
+}
+
+// parseNumberOrReference reads a number, or a reference if the number is followed by a generation and the R keyword
+func (l *lexer) parseNumberOrReference() any {
+	number, isInteger := l.parseNumber()
+	if !isInteger || number < 0 {
+		return number
+	}
+
+	start := l.position
+	l.skipSpaces()
+	if l.position < len(l.data) && isDigit(l.data[l.position]) {
+		generation, isGenerationInteger := l.parseNumber()
+		l.skipSpaces()
+		if isGenerationInteger && l.position < len(l.data) && l.data[l.position] == 'R' &&
+			(l.position+1 == len(l.data) || isWhitespace(l.data[l.position+1]) || isDelimiter(l.data[l.position+1])) {
+			l.position++
+			return Reference{Number: int(number), Generation: int(generation)}
+		}
+	}
+	l.position = start
+	return number
+}
+
+func (l *lexer) parseNumber() (float64, bool) {
+	start := l.position
+	isInteger := true
+	for l.position < len(l.data) {
+		character := l.data[l.position]
+		if character == '.' {
+			isInteger = false
+		} else if !isDigit(character) && !(l.position == start && (character == '+' || character == '-')) {
+			break
+		}
+		l.position++
+	}
+	number, err := strconv.ParseFloat(string(l.data[start:l.position]), 64)
+	if err != nil {
+		return 0, false
+	}
+	return number, isInteger
+}
+
+func (l *lexer) skipSpaces() {
+	for l.position < len(l.data) {
+		if character := l.data[l.position]; character == '%' {
+			for l.position < len(l.data) && l.data[l.position] != '\n' && l.data[l.position] != '\r' {
+				l.position++
+			}
+			// the end of line is skipped as a whitespace, while a comment may also end the data
+			continue
+		} else if !isWhitespace(character) {
+			return
+		}
+		l.position++
+	}
+}
+
+// skipInlineImage moves past the binary data of an inline image, which starts after the ID operator and ends at the EI operator
+func (l *lexer) skipInlineImage() {
+	l.position++
+	for l.position < len(l.data) {
+		index := bytes.Index(l.data[l.position:], []byte("EI"))
+		if index < 0 {
+			l.position = len(l.data)
+			return
+		}
+		end := l.position + index
+		l.position = end + 2
+		if end > 0 && isWhitespace(l.data[end-1]) &&
+			(l.position == len(l.data) || isWhitespace(l.data[l.position]) || isDelimiter(l.data[l.position])) {
+			return
+		}
+	}
+}
+
+/* ============================== Helper Functions ============================== */
+
+func isWhitespace(character byte) bool {
+	return character == 0 || character == '\t' || character == '\n' || character == '\f' || character == '\r' || character == ' '
+}
+
+func isDelimiter(character byte) bool {
+	return character == '(' || character == ')' || character == '<' || character == '>' || character == '[' ||
+		character == ']' || character == '{' || character == '}' || character == '/' || character == '%'
+}
+
+func isDigit(character byte) bool {
+	return character >= '0' && character <= '9'
+}
+
+func isHexDigit(character byte) bool {
+	return isDigit(character) || (character >= 'a' && character <= 'f') || (character >= 'A' && character <= 'F')
+}
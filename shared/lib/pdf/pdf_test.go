@@ -0,0 +1,227 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"image/color"
+	"strings"
+	"testing"
+)
+
+func TestTextExtractsPagesInOrder(t *testing.T) {
+	document := openTestDocument(t, buildTestDocument([]string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R 4 0 R] /Count 2 /Resources << /Font << /F1 5 0 R >> >> >>",
+		"<< /Type /Page /Parent 2 0 R /Contents 6 0 R >>",
+		"<< /Type /Page /Parent 2 0 R /Contents [7 0 R] >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>",
+		testStream("", "BT /F1 12 Tf 72 712 Td (Hello, \\(PDF\\)) Tj 0 -14 Td [(Note)-300(gic)] TJ ET"),
+		testStream("", "BT /F1 12 Tf (It\\222s the second page) Tj ET"),
+	}, ""))
+
+	got := strings.Join(strings.Fields(document.Text(1024)), " ")
+	if want := "Hello, (PDF) Note gic It’s the second page"; got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+}
+
+func TestTextReadsObjectStreamsAndToUnicodeMaps(t *testing.T) {
+	toUnicode := strings.Join([]string{
+		"begincmap",
+		"1 begincodespacerange <0000> <FFFF> endcodespacerange",
+		"1 beginbfchar <0001> <7B46> endbfchar",
+		"1 beginbfrange <0010> <0012> <0061> endbfrange",
+		"1 beginbfrange <0020> <0021> [<8A18> <004E>] endbfrange",
+		"endcmap",
+	}, "\n")
+	pagesObject := "<< /Type /Pages /Kids [4 0 R] /Count 1 >> "
+	pageObject := "<< /Type /Page /Parent 3 0 R /Contents 6 0 R /Resources << /Font << /F1 5 0 R >> >> >>"
+	objectStreamHeader := fmt.Sprintf("3 0 4 %d ", len(pagesObject))
+	document := openTestDocument(t, buildTestDocument([]string{
+		"<< /Type /Catalog /Pages 3 0 R >>",
+		testStream(fmt.Sprintf("/Type /ObjStm /N 2 /First %d", len(objectStreamHeader)), objectStreamHeader+pagesObject+pageObject),
+		"",
+		"",
+		"<< /Type /Font /Subtype /Type0 /BaseFont /NotoSans /ToUnicode 7 0 R >>",
+		testStream("", "BT /F1 12 Tf <00010020> Tj [<0010>-100<00110012>] TJ <0021> Tj <9999> Tj ET"),
+		testStream("", toUnicode),
+	}, ""))
+
+	got := strings.Join(strings.Fields(document.Text(1024)), " ")
+	if want := "筆記abcN"; got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+}
+
+func TestOpenRejectsInvalidDocuments(t *testing.T) {
+	if _, err := Open([]byte("plain text")); !errors.Is(err, ErrInvalidDocument) {
+		t.Fatalf("expected ErrInvalidDocument, got %v", err)
+	}
+
+	encrypted := buildTestDocument([]string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [] /Count 0 >>",
+		"<< /Filter /Standard /V 2 >>",
+	}, "/Encrypt 3 0 R")
+	if _, err := Open(encrypted); !errors.Is(err, ErrEncryptedDocument) {
+		t.Fatalf("expected ErrEncryptedDocument, got %v", err)
+	}
+}
+
+func TestFirstPageImage(t *testing.T) {
+	pixels := []byte{
+		0xff, 0x00, 0x00, 0x00, 0xff, 0x00,
+		0x00, 0x00, 0xff, 0xff, 0xff, 0xff,
+	}
+	document := openTestDocument(t, buildTestDocument([]string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /Resources << /XObject << /Im1 4 0 R /Im2 5 0 R >> >> >>",
+		testStream("/Type /XObject /Subtype /Image /Width 1 /Height 1 /ColorSpace /DeviceGray /BitsPerComponent 8", "\x80"),
+		compressedTestStream("/Type /XObject /Subtype /Image /Width 2 /Height 2 /ColorSpace /DeviceRGB /BitsPerComponent 8", pixels),
+	}, ""))
+
+	firstPageImage, err := document.FirstPageImage()
+	if err != nil {
+		t.Fatalf("FirstPageImage() error = %v", err)
+	}
+	if bounds := firstPageImage.Bounds(); bounds.Dx() != 2 || bounds.Dy() != 2 {
+		t.Fatalf("expected the largest 2x2 image, got %v", bounds)
+	}
+	if got := color.NRGBAModel.Convert(firstPageImage.At(0, 1)).(color.NRGBA); got != (color.NRGBA{B: 0xff, A: 0xff}) {
+		t.Fatalf("expected a blue pixel, got %v", got)
+	}
+
+	textOnly := openTestDocument(t, buildTestDocument([]string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /Contents 4 0 R >>",
+		testStream("", "BT (text) Tj ET"),
+	}, ""))
+	if _, err := textOnly.FirstPageImage(); !errors.Is(err, ErrNoImage) {
+		t.Fatalf("expected ErrNoImage, got %v", err)
+	}
+}
+
+func openTestDocument(t *testing.T, data []byte) *Document {
+	t.Helper()
+
+	document, err := Open(data)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	return document
+}
+
+// buildTestDocument numbers the objects from one and writes the trailer pointing to the first one as the root,
+// where the empty objects are skipped so their numbers can be taken by the compressed objects
+func buildTestDocument(objects []string, trailerEntries string) []byte {
+	var buffer bytes.Buffer
+	buffer.WriteString("%PDF-1.7\n")
+	for index, object := range objects {
+		if object == "" {
+			continue
+		}
+		fmt.Fprintf(&buffer, "%d 0 obj\n%s\nendobj\n", index+1, object)
+	}
+	fmt.Fprintf(&buffer, "trailer\n<< /Size %d /Root 1 0 R %s >>\n%%%%EOF\n", len(objects)+1, trailerEntries)
+	return buffer.Bytes()
+}
+
+func testStream(entries string, data string) string {
+	return fmt.Sprintf("<< %s /Length %d >>\nstream\n%s\nendstream", entries, len(data), data)
+}
+
+func compressedTestStream(entries string, data []byte) string {
+	var buffer bytes.Buffer
+	writer := zlib.NewWriter(&buffer)
+	writer.Write(data)
+	writer.Close()
+	return testStream(entries+" /Filter /FlateDecode", buffer.String())
+}
+
+func TestOpenRejectsNegativeObjectStreamOffsets(t *testing.T) {
+	objectStreamHeader := "3 -992 "
+	data := buildTestDocument([]string{
+		"<< /Type /Catalog /Pages 3 0 R >>",
+		testStream(fmt.Sprintf("/Type /ObjStm /N 1 /First %d", len(objectStreamHeader)), objectStreamHeader+"<< /Type /Pages /Kids [] /Count 0 >>"),
+	}, "")
+
+	document := openTestDocument(t, data)
+	if pages := document.Pages(); len(pages) != 0 {
+		t.Fatalf("expected the object at a negative offset to be skipped, got %d pages", len(pages))
+	}
+}
+
+func TestDecodeRejectsPredictorRowsLargerThanTheStream(t *testing.T) {
+	for _, parameters := range []string{
+		"/Predictor 12 /Columns 100000000000000",
+		"/Predictor 12 /Columns 4 /Colors 100000000000000",
+		"/Predictor 12 /Columns 4 /BitsPerComponent 100000000000000",
+		"/Predictor 12 /Columns -4",
+		"/Predictor 12 /Columns 4 /BitsPerComponent 3",
+	} {
+		t.Run(parameters, func(t *testing.T) {
+			document := openTestDocument(t, buildTestDocument([]string{
+				"<< /Type /Catalog /Pages 2 0 R >>",
+				"<< /Type /Pages /Kids [] /Count 0 >>",
+				compressedTestStream("/DecodeParms << "+parameters+" >>", []byte{0, 1, 2, 3, 4}),
+			}, ""))
+
+			if _, _, err := document.Decode(document.objects[3].(Stream)); !errors.Is(err, ErrInvalidDocument) {
+				t.Fatalf("expected ErrInvalidDocument, got %v", err)
+			}
+		})
+	}
+}
+
+func TestDecodeStopsAtTheDocumentBudget(t *testing.T) {
+	document := openTestDocument(t, buildTestDocument([]string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [] /Count 0 >>",
+		compressedTestStream("", bytes.Repeat([]byte{0}, 1024)),
+	}, ""))
+	stream := document.objects[3].(Stream)
+
+	document.decodedSize = MaxDecodedDocumentSize - 512
+	if _, _, err := document.Decode(stream); err == nil {
+		t.Fatal("expected the stream beyond the remaining budget to be rejected")
+	}
+	document.decodedSize = MaxDecodedDocumentSize
+	if _, _, err := document.Decode(stream); !errors.Is(err, ErrDecodingBudget) {
+		t.Fatalf("expected ErrDecodingBudget, got %v", err)
+	}
+}
+
+func FuzzOpen(f *testing.F) {
+	objectStreamHeader := "3 0 "
+	f.Add(buildTestDocument([]string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 /Resources << /Font << /F1 4 0 R >> /XObject << /Im1 5 0 R >> >> >>",
+		"<< /Type /Page /Parent 2 0 R /Contents 6 0 R >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>",
+		compressedTestStream("/Type /XObject /Subtype /Image /Width 1 /Height 1 /ColorSpace /DeviceGray /BitsPerComponent 8", []byte{0x80}),
+		compressedTestStream("/DecodeParms << /Predictor 12 /Columns 4 >>", []byte{0, 1, 2, 3, 4}),
+	}, ""))
+	f.Add(buildTestDocument([]string{
+		"<< /Type /Catalog /Pages 3 0 R >>",
+		testStream(fmt.Sprintf("/Type /ObjStm /N 1 /First %d", len(objectStreamHeader)), objectStreamHeader+"<< /Type /Pages /Kids [] /Count 0 >>"),
+	}, ""))
+	f.Add([]byte("%PDF-1.7\n1 0 obj << /Type /Catalog >> endobj"))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		document, err := Open(data)
+		if err != nil {
+			return
+		}
+		document.Text(1024)
+		document.FirstPageImage()
+		for _, object := range document.objects {
+			if stream, ok := object.(Stream); ok {
+				document.Decode(stream)
+			}
+		}
+	})
+}
@@ -0,0 +1,293 @@
+package pdf
+
+import (
+	"strings"
+	"unicode/utf16"
+)
+
+// the characters of the WinAnsiEncoding between 0x80 and 0x9f, where the rest of the bytes match the Latin-1
+var winAnsiCharacters = [32]rune{
+	'€', 0, '‚', 'ƒ', '„', '…', '†', '‡', 'ˆ', '‰', 'Š', '‹', 'Œ', 0, 'Ž', 0,
+	0, '‘', '’', '“', '”', '•', '–', '—', '˜', '™', 'š', '›', 'œ', 0, 'ž', 'Ÿ',
+}
+
+// the negative adjustment of the TJ operator in thousandths of the font size which is regarded as a space
+const textAdjustmentSpaceThreshold = -250
+
+type font struct {
+	isComposite bool
+	toUnicode   *characterMap
+}
+
+type characterMap struct {
+	codeLength int
+	characters map[uint32][]rune
+	ranges     []characterRange
+}
+
+type characterRange struct {
+	low          uint32
+	high         uint32
+	destination  []rune
+	destinations [][]rune
+}
+
+/* ============================== Document Methods ============================== */
+
+// Text extracts the text drawn by the text operators of the pages in order, until the limit in bytes is reached,
+// where the fonts without a ToUnicode map are read as the WinAnsiEncoding, and the composite ones are skipped
+func (d *Document) Text(limit int) string {
+	var builder strings.Builder
+	for _, page := range d.Pages() {
+		if builder.Len() >= limit {
+			break
+		}
+		d.writePageText(&builder, page, limit)
+		builder.WriteByte('\n')
+	}
+
+	return builder.String()
+}
+
+/* ============================== Auxiliary Functions ============================== */
+
+func (d *Document) writePageText(builder *strings.Builder, page Page, limit int) {
+	fontDictionary := d.ResolveDictionary(page.Resources["Font"])
+	fonts := make(map[Name]*font)
+	var currentFont *font
+
+	contentLexer := &lexer{data: d.pageContent(page)}
+	var operands []any
+	for builder.Len() < limit {
+		token, err := contentLexer.parseValue()
+		if err != nil {
+			return
+		}
+		operator, ok := token.(Keyword)
+		if !ok {
+			operands = append(operands, token)
+			continue
+		}
+
+		switch operator {
+		case "Tf":
+			if len(operands) >= 2 {
+				if name, ok := operands[len(operands)-2].(Name); ok {
+					if _, exists := fonts[name]; !exists {
+						fonts[name] = d.loadFont(fontDictionary[name])
+					}
+					currentFont = fonts[name]
+				}
+			}
+		case "Tj", "'", "\"":
+			if operator != "Tj" {
+				builder.WriteByte('\n')
+			}
+			if len(operands) > 0 {
+				if text, ok := operands[len(operands)-1].([]byte); ok {
+					builder.WriteString(currentFont.decode(text))
+				}
+			}
+		case "TJ":
+			if len(operands) > 0 {
+				if array, ok := operands[len(operands)-1].(Array); ok {
+					for _, item := range array {
+						switch value := item.(type) {
+						case []byte:
+							builder.WriteString(currentFont.decode(value))
+						case float64:
+							if value <= textAdjustmentSpaceThreshold {
+								builder.WriteByte(' ')
+							}
+						}
+					}
+				}
+			}
+		case "Td", "TD":
+			if len(operands) >= 2 {
+				if verticalOffset, ok := operands[len(operands)-1].(float64); ok && verticalOffset != 0 {
+					builder.WriteByte('\n')
+				} else {
+					builder.WriteByte(' ')
+				}
+			}
+		case "T*", "Tm", "ET":
+			builder.WriteByte('\n')
+		case "ID":
+			contentLexer.skipInlineImage()
+		}
+		operands = operands[:0]
+	}
+}
+
+func (d *Document) pageContent(page Page) []byte {
+	var contents []any
+	switch value := d.Resolve(page.Dictionary["Contents"]).(type) {
+	case Stream:
+		contents = []any{value}
+	case Array:
+		contents = value
+	}
+
+	var content []byte
+	for _, item := range contents {
+		stream, ok := d.Resolve(item).(Stream)
+		if !ok {
+			continue
+		}
+		data, filter, err := d.Decode(stream)
+		if err != nil || filter != "" {
+			continue
+		}
+		// the content streams of a page are concatenated, and an operator never spans two of them
+		content = append(append(content, data...), '\n')
+	}
+	return content
+}
+
+func (d *Document) loadFont(value any) *font {
+	dictionary := d.ResolveDictionary(value)
+	if dictionary == nil {
+		return nil
+	}
+
+	loadedFont := &font{isComposite: dictionary["Subtype"] == Name("Type0")}
+	if stream, ok := d.Resolve(dictionary["ToUnicode"]).(Stream); ok {
+		if data, filter, err := d.Decode(stream); err == nil && filter == "" {
+			loadedFont.toUnicode = parseCharacterMap(data, loadedFont.isComposite)
+		}
+	}
+	return loadedFont
+}
+
+func (f *font) decode(text []byte) string {
+	if f != nil && f.toUnicode != nil {
+		return f.toUnicode.decode(text)
+	}
+	// the codes of the composite fonts are meaningless without their ToUnicode maps
+	if f != nil && f.isComposite {
+		return ""
+	}
+
+	runes := make([]rune, 0, len(text))
+	for _, code := range text {
+		if code >= 0x80 && code < 0xa0 {
+			if character := winAnsiCharacters[code-0x80]; character != 0 {
+				runes = append(runes, character)
+			}
+			continue
+		}
+		runes = append(runes, rune(code))
+	}
+	return string(runes)
+}
+
+func parseCharacterMap(data []byte, isComposite bool) *characterMap {
+	characterMap := &characterMap{characters: make(map[uint32][]rune)}
+	mapLexer := &lexer{data: data}
+	var operands []any
+	for {
+		token, err := mapLexer.parseValue()
+		if err != nil {
+			break
+		}
+		keyword, ok := token.(Keyword)
+		if !ok {
+			operands = append(operands, token)
+			continue
+		}
+
+		switch keyword {
+		case "endcodespacerange":
+			if len(operands) >= 2 {
+				if low, ok := operands[0].([]byte); ok && len(low) > 0 {
+					characterMap.codeLength = len(low)
+				}
+			}
+		case "endbfchar":
+			for index := 0; index+1 < len(operands); index += 2 {
+				source, isSource := operands[index].([]byte)
+				destination, isDestination := operands[index+1].([]byte)
+				if isSource && isDestination {
+					characterMap.characters[readCode(source)] = decodeUTF16(destination)
+				}
+			}
+		case "endbfrange":
+			for index := 0; index+2 < len(operands); index += 3 {
+				low, isLow := operands[index].([]byte)
+				high, isHigh := operands[index+1].([]byte)
+				if !isLow || !isHigh || readCode(low) > readCode(high) {
+					continue
+				}
+				characterRange := characterRange{low: readCode(low), high: readCode(high)}
+				switch destination := operands[index+2].(type) {
+				case []byte:
+					characterRange.destination = decodeUTF16(destination)
+				case Array:
+					for _, item := range destination {
+						if itemBytes, ok := item.([]byte); ok {
+							characterRange.destinations = append(characterRange.destinations, decodeUTF16(itemBytes))
+						}
+					}
+				}
+				characterMap.ranges = append(characterMap.ranges, characterRange)
+			}
+		}
+		operands = operands[:0]
+	}
+
+	if characterMap.codeLength == 0 {
+		characterMap.codeLength = 1
+		if isComposite {
+			characterMap.codeLength = 2
+		}
+	}
+	return characterMap
+}
+
+func (m *characterMap) decode(text []byte) string {
+	var builder strings.Builder
+	for offset := 0; offset+m.codeLength <= len(text); offset += m.codeLength {
+		code := readCode(text[offset : offset+m.codeLength])
+		if characters, exists := m.characters[code]; exists {
+			builder.WriteString(string(characters))
+			continue
+		}
+		for _, characterRange := range m.ranges {
+			if code < characterRange.low || code > characterRange.high {
+				continue
+			}
+			distance := code - characterRange.low
+			if characterRange.destinations != nil {
+				if int(distance) < len(characterRange.destinations) {
+					builder.WriteString(string(characterRange.destinations[distance]))
+				}
+			} else if len(characterRange.destination) > 0 {
+				// the last character of the destination increases along the range
+				characters := append([]rune(nil), characterRange.destination...)
+				characters[len(characters)-1] += rune(distance)
+				builder.WriteString(string(characters))
+			}
+			break
+		}
+	}
+	return builder.String()
+}
+
+/* ============================== Helper Functions ============================== */
+
+func readCode(data []byte) uint32 {
+	var code uint32
+	for _, value := range data[:min(len(data), 4)] {
+		code = code<<8 | uint32(value)
+	}
+	return code
+}
+
+func decodeUTF16(data []byte) []rune {
+	units := make([]uint16, 0, len(data)/2)
+	for index := 0; index+1 < len(data); index += 2 {
+		units = append(units, uint16(data[index])<<8|uint16(data[index+1]))
+	}
+	return utf16.Decode(units)
+}
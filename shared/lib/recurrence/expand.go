@@ -0,0 +1,388 @@
+package recurrence
+
+import (
+	"slices"
+	"time"
+)
+
+// the maximum number of periods scanned by a single expansion,
+// a rule that doesn't produce any occurrence within this window is treated as exhausted
+var maxPeriods = map[Frequency]int{
+	Frequency_Minutely: 500000,
+	Frequency_Hourly:   200000,
+	Frequency_Daily:    36600,
+	Frequency_Weekly:   5300,
+	Frequency_Monthly:  4800,
+	Frequency_Yearly:   400,
+}
+
+/* ============================== Expansion ============================== */
+
+// Next returns the first occurrence of the rule strictly after `after`,
+// the `start` is the DTSTART of the rule, so it bounds the occurrences and anchors the INTERVAL and COUNT,
+// the rule is expanded in the wall clock of the given location, so "BYHOUR=9" stays at 09:00 across DST changes.
+// It returns false if the rule has been exhausted by its COUNT or UNTIL.
+func (r *Rule) Next(start time.Time, after time.Time, location *time.Location) (time.Time, bool) {
+	var next time.Time
+	var found bool
+	r.iterate(start, after, location, func(occurrence time.Time) bool {
+		if !occurrence.After(after) {
+			return true
+		}
+		next, found = occurrence, true
+		return false
+	})
+	return next, found
+}
+
+// Between returns the occurrences of the rule within [from, to), at most limit of them if the limit is positive.
+func (r *Rule) Between(start time.Time, from time.Time, to time.Time, location *time.Location, limit int) []time.Time {
+	occurrences := make([]time.Time, 0)
+	r.iterate(start, from, location, func(occurrence time.Time) bool {
+		if !occurrence.Before(to) {
+			return false
+		}
+		occurrences = append(occurrences, occurrence)
+		return limit <= 0 || len(occurrences) < limit
+	})
+	return occurrences
+}
+
+// iterate yields the occurrences no earlier than from in ascending order until yield returns false
+func (r *Rule) iterate(start time.Time, from time.Time, location *time.Location, yield func(time.Time) bool) {
+	if location == nil {
+		location = time.UTC
+	}
+	start = start.Truncate(time.Minute).In(location)
+	until, hasUntil := r.untilIn(location)
+
+	firstPeriod := 0
+	if r.Count == 0 && from.After(start) {
+		// without COUNT the occurrences before from never matter, so we can jump to the period of from directly
+		firstPeriod = max(r.periodsBetween(start, from.In(location))/r.Interval-1, 0)
+	}
+
+	emitted := 0
+	for period := firstPeriod; period < firstPeriod+maxPeriods[r.Frequency]; period++ {
+		begin, candidates := r.periodCandidates(start, period, location)
+		if hasUntil && begin.After(until) {
+			return
+		}
+		for _, candidate := range r.applySetPos(candidates) {
+			if candidate.Before(start) {
+				continue
+			}
+			if hasUntil && candidate.After(until) {
+				return
+			}
+			emitted++
+			if r.Count > 0 && emitted > r.Count {
+				return
+			}
+			if candidate.Before(from) {
+				continue
+			}
+			if !yield(candidate) {
+				return
+			}
+		}
+	}
+}
+
+func (r *Rule) untilIn(location *time.Location) (time.Time, bool) {
+	if r.Until == nil {
+		return time.Time{}, false
+	}
+	u := r.Until
+	if u.IsUTC {
+		return time.Date(u.Year, u.Month, u.Day, u.Hour, u.Minute, u.Second, 0, time.UTC), true
+	}
+	if u.IsDateOnly {
+		return LocalTime(u.Year, u.Month, u.Day, 23, 59, location).Add(59 * time.Second), true
+	}
+	return LocalTime(u.Year, u.Month, u.Day, u.Hour, u.Minute, location).Add(time.Duration(u.Second) * time.Second), true
+}
+
+// periodsBetween returns the number of whole FREQ units between the period of start and the given time
+func (r *Rule) periodsBetween(start time.Time, t time.Time) int {
+	switch r.Frequency {
+	case Frequency_Yearly:
+		return t.Year() - start.Year()
+	case Frequency_Monthly:
+		return (t.Year()-start.Year())*12 + int(t.Month()-start.Month())
+	case Frequency_Weekly:
+		return daysBetween(weekBegin(civilDate(start), r.WeekStart), civilDate(t)) / 7
+	case Frequency_Daily:
+		return daysBetween(civilDate(start), civilDate(t))
+	case Frequency_Hourly:
+		return int(t.Sub(hourBegin(start)) / time.Hour)
+	default:
+		return int(t.Sub(start) / time.Minute)
+	}
+}
+
+// periodCandidates returns the beginning of the period and its candidate occurrences in ascending order,
+// before applying BYSETPOS
+func (r *Rule) periodCandidates(start time.Time, period int, location *time.Location) (time.Time, []time.Time) {
+	step := period * r.Interval
+	dates := make([]time.Time, 0)
+
+	switch r.Frequency {
+	case Frequency_Minutely:
+		t := start.Add(time.Duration(step) * time.Minute)
+		if !r.matchesDate(civilDate(t)) || !r.matchesHour(t.Hour()) ||
+			(len(r.ByMinute) > 0 && !slices.Contains(r.ByMinute, t.Minute())) {
+			return t, nil
+		}
+		return t, []time.Time{t}
+	case Frequency_Hourly:
+		begin := hourBegin(start).Add(time.Duration(step) * time.Hour)
+		if !r.matchesDate(civilDate(begin)) || !r.matchesHour(begin.Hour()) {
+			return begin, nil
+		}
+		candidates := make([]time.Time, 0)
+		for _, minute := range r.minutes(start) {
+			candidates = append(candidates, begin.Add(time.Duration(minute)*time.Minute))
+		}
+		return begin, candidates
+	case Frequency_Daily:
+		date := civilDate(start).AddDate(0, 0, step)
+		if r.matchesDate(date) {
+			dates = append(dates, date)
+		}
+	case Frequency_Weekly:
+		weekStart := weekBegin(civilDate(start), r.WeekStart).AddDate(0, 0, 7*step)
+		for offset := 0; offset < 7; offset++ {
+			date := weekStart.AddDate(0, 0, offset)
+			if len(r.ByMonth) > 0 && !slices.Contains(r.ByMonth, int(date.Month())) {
+				continue
+			}
+			if len(r.ByDay) == 0 && date.Weekday() != start.Weekday() {
+				continue
+			}
+			if len(r.ByDay) > 0 && !r.matchesWeekday(date, 0, 0) {
+				continue
+			}
+			dates = append(dates, date)
+		}
+	case Frequency_Monthly:
+		monthStart := time.Date(start.Year(), start.Month()+time.Month(step), 1, 0, 0, 0, 0, time.UTC)
+		if len(r.ByMonth) == 0 || slices.Contains(r.ByMonth, int(monthStart.Month())) {
+			for date := monthStart; date.Month() == monthStart.Month(); date = date.AddDate(0, 0, 1) {
+				if r.matchesMonthlyDay(start, date) {
+					dates = append(dates, date)
+				}
+			}
+		}
+	case Frequency_Yearly:
+		yearStart := time.Date(start.Year()+step, time.January, 1, 0, 0, 0, 0, time.UTC)
+		for date := yearStart; date.Year() == yearStart.Year(); date = date.AddDate(0, 0, 1) {
+			if r.matchesYearlyDay(start, date) {
+				dates = append(dates, date)
+			}
+		}
+	}
+
+	candidates := make([]time.Time, 0, len(dates))
+	for _, date := range dates {
+		for _, hour := range r.hours(start) {
+			for _, minute := range r.minutes(start) {
+				candidates = append(candidates, LocalTime(date.Year(), date.Month(), date.Day(), hour, minute, location))
+			}
+		}
+	}
+	slices.SortFunc(candidates, func(a, b time.Time) int { return a.Compare(b) })
+	candidates = slices.CompactFunc(candidates, func(a, b time.Time) bool { return a.Equal(b) })
+
+	return r.periodBegin(start, step, location), candidates
+}
+
+// periodBegin returns the midnight of the first day in the period, which is used to stop the expansion after UNTIL
+func (r *Rule) periodBegin(start time.Time, step int, location *time.Location) time.Time {
+	var date time.Time
+	switch r.Frequency {
+	case Frequency_Daily:
+		date = civilDate(start).AddDate(0, 0, step)
+	case Frequency_Weekly:
+		date = weekBegin(civilDate(start), r.WeekStart).AddDate(0, 0, 7*step)
+	case Frequency_Monthly:
+		date = time.Date(start.Year(), start.Month()+time.Month(step), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		date = time.Date(start.Year()+step, time.January, 1, 0, 0, 0, 0, time.UTC)
+	}
+	return LocalTime(date.Year(), date.Month(), date.Day(), 0, 0, location)
+}
+
+func (r *Rule) applySetPos(candidates []time.Time) []time.Time {
+	if len(r.BySetPos) == 0 || len(candidates) == 0 {
+		return candidates
+	}
+	selected := make([]time.Time, 0, len(r.BySetPos))
+	for _, position := range r.BySetPos {
+		index := position - 1
+		if position < 0 {
+			index = len(candidates) + position
+		}
+		if index < 0 || index >= len(candidates) {
+			continue
+		}
+		selected = append(selected, candidates[index])
+	}
+	slices.SortFunc(selected, func(a, b time.Time) int { return a.Compare(b) })
+	return slices.CompactFunc(selected, func(a, b time.Time) bool { return a.Equal(b) })
+}
+
+/* ============================== Matchers ============================== */
+
+// matchesDate checks the BYMONTH, BYMONTHDAY and BYDAY rule parts which limit the DAILY, HOURLY and MINUTELY frequencies
+func (r *Rule) matchesDate(date time.Time) bool {
+	if len(r.ByMonth) > 0 && !slices.Contains(r.ByMonth, int(date.Month())) {
+		return false
+	}
+	if len(r.ByMonthDay) > 0 && !r.matchesMonthDay(date) {
+		return false
+	}
+	if len(r.ByDay) > 0 && !r.matchesWeekday(date, 0, 0) {
+		return false
+	}
+	return true
+}
+
+func (r *Rule) matchesHour(hour int) bool {
+	return len(r.ByHour) == 0 || slices.Contains(r.ByHour, hour)
+}
+
+func (r *Rule) matchesMonthlyDay(start time.Time, date time.Time) bool {
+	if len(r.ByMonthDay) == 0 && len(r.ByDay) == 0 {
+		return date.Day() == start.Day()
+	}
+	if len(r.ByMonthDay) > 0 && !r.matchesMonthDay(date) {
+		return false
+	}
+	if len(r.ByDay) > 0 && !r.matchesWeekday(date, date.Day(), daysIn(date.Year(), date.Month())) {
+		return false
+	}
+	return true
+}
+
+func (r *Rule) matchesYearlyDay(start time.Time, date time.Time) bool {
+	if len(r.ByMonth) > 0 && !slices.Contains(r.ByMonth, int(date.Month())) {
+		return false
+	}
+	if len(r.ByMonthDay) == 0 && len(r.ByDay) == 0 {
+		if len(r.ByMonth) == 0 && date.Month() != start.Month() {
+			return false
+		}
+		return date.Day() == start.Day()
+	}
+	if len(r.ByMonthDay) > 0 && !r.matchesMonthDay(date) {
+		return false
+	}
+	if len(r.ByDay) > 0 {
+		// the BYDAY ordinals are relative to the month if BYMONTH is given, otherwise relative to the year
+		if len(r.ByMonth) > 0 {
+			return r.matchesWeekday(date, date.Day(), daysIn(date.Year(), date.Month()))
+		}
+		return r.matchesWeekday(date, date.YearDay(), daysIn(date.Year(), 0))
+	}
+	return true
+}
+
+func (r *Rule) matchesMonthDay(date time.Time) bool {
+	total := daysIn(date.Year(), date.Month())
+	for _, monthDay := range r.ByMonthDay {
+		if monthDay == date.Day() || (monthDay < 0 && total+monthDay+1 == date.Day()) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesWeekday checks the BYDAY rule part, the index and total describe the position of the date
+// within the scope of the ordinals, either the month or the year
+func (r *Rule) matchesWeekday(date time.Time, index int, total int) bool {
+	for _, weekdayNum := range r.ByDay {
+		if weekdayNum.Weekday != date.Weekday() {
+			continue
+		}
+		if weekdayNum.Ordinal == 0 {
+			return true
+		}
+		if weekdayNum.Ordinal > 0 && (index-1)/7+1 == weekdayNum.Ordinal {
+			return true
+		}
+		if weekdayNum.Ordinal < 0 && -((total-index)/7+1) == weekdayNum.Ordinal {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Rule) hours(start time.Time) []int {
+	if len(r.ByHour) > 0 {
+		return r.ByHour
+	}
+	return []int{start.Hour()}
+}
+
+func (r *Rule) minutes(start time.Time) []int {
+	if len(r.ByMinute) > 0 {
+		return r.ByMinute
+	}
+	return []int{start.Minute()}
+}
+
+/* ============================== Calendar Helpers ============================== */
+
+// LocalTime returns the instant of the given wall clock time in the location following RFC 5545,
+// a wall clock time skipped by a DST gap is shifted forward by the length of the gap,
+// and a wall clock time repeated by a DST overlap resolves to its first instance.
+func LocalTime(year int, month time.Month, day int, hour int, minute int, location *time.Location) time.Time {
+	wall := time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+
+	// the offsets around the wall clock time, which are enough to cover any single transition
+	_, offsetBefore := wall.Add(-48 * time.Hour).In(location).Zone()
+	_, offsetAfter := wall.Add(48 * time.Hour).In(location).Zone()
+	_, offsetAt := wall.In(location).Zone()
+
+	var resolved time.Time
+	for _, offset := range []int{offsetBefore, offsetAt, offsetAfter} {
+		candidate := wall.Add(-time.Duration(offset) * time.Second).In(location)
+		if candidate.Year() != year || candidate.Month() != month || candidate.Day() != day ||
+			candidate.Hour() != hour || candidate.Minute() != minute {
+			continue
+		}
+		if resolved.IsZero() || candidate.Before(resolved) {
+			resolved = candidate
+		}
+	}
+	if resolved.IsZero() {
+		// the wall clock time falls into a gap, so we interpret it with the offset before the gap
+		resolved = wall.Add(-time.Duration(offsetBefore) * time.Second).In(location)
+	}
+	return resolved
+}
+
+func civilDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func hourBegin(t time.Time) time.Time {
+	return t.Add(-time.Duration(t.Minute()) * time.Minute)
+}
+
+func weekBegin(date time.Time, weekStart time.Weekday) time.Time {
+	return date.AddDate(0, 0, -((int(date.Weekday()) - int(weekStart) + 7) % 7))
+}
+
+func daysBetween(from time.Time, to time.Time) int {
+	return int(to.Sub(from).Hours() / 24)
+}
+
+// daysIn returns the number of days in the month, or in the year if the month is 0
+func daysIn(year int, month time.Month) int {
+	if month == 0 {
+		return time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+	}
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
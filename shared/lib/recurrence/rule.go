@@ -0,0 +1,372 @@
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Rule is a parsed RFC 5545 RRULE with minute precision.
+// The supported rule parts are FREQ, INTERVAL, COUNT, UNTIL, BYMONTH, BYMONTHDAY,
+// BYDAY, BYHOUR, BYMINUTE, BYSETPOS and WKST. BYSECOND, BYWEEKNO and BYYEARDAY
+// are rejected since the routine scheduler never runs below the minute level.
+type Rule struct {
+	Frequency  Frequency
+	Interval   int
+	Count      int
+	Until      *Until
+	ByMonth    []int
+	ByMonthDay []int
+	ByDay      []WeekdayNum
+	ByHour     []int
+	ByMinute   []int
+	BySetPos   []int
+	WeekStart  time.Weekday
+}
+
+type Frequency string
+
+const (
+	Frequency_Minutely Frequency = "MINUTELY"
+	Frequency_Hourly   Frequency = "HOURLY"
+	Frequency_Daily    Frequency = "DAILY"
+	Frequency_Weekly   Frequency = "WEEKLY"
+	Frequency_Monthly  Frequency = "MONTHLY"
+	Frequency_Yearly   Frequency = "YEARLY"
+)
+
+var AllFrequencies = []Frequency{
+	Frequency_Minutely,
+	Frequency_Hourly,
+	Frequency_Daily,
+	Frequency_Weekly,
+	Frequency_Monthly,
+	Frequency_Yearly,
+}
+
+// WeekdayNum is a single BYDAY entry, ex. "MO", "1MO" or "-1FR",
+// an Ordinal of 0 means every matching weekday inside the period.
+type WeekdayNum struct {
+	Ordinal int
+	Weekday time.Weekday
+}
+
+// Until is the UNTIL rule part, a value without the trailing "Z" is a floating local time
+// which is resolved in the location passed to the expansion methods.
+type Until struct {
+	Year       int
+	Month      time.Month
+	Day        int
+	Hour       int
+	Minute     int
+	Second     int
+	IsUTC      bool
+	IsDateOnly bool
+}
+
+const (
+	MaxRuleLength = 512
+	MaxInterval   = 1000
+	MaxCount      = 10000
+)
+
+var weekdayCodes = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+var weekdayNames = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+/* ============================== Parsing ============================== */
+
+// Parse parses a RRULE value such as "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR;BYHOUR=9;BYMINUTE=0",
+// an optional leading "RRULE:" is accepted.
+func Parse(value string) (*Rule, error) {
+	value = strings.TrimSpace(value)
+	if len(value) == 0 {
+		return nil, errors.New("recurrence rule cannot be empty")
+	}
+	if len(value) > MaxRuleLength {
+		return nil, fmt.Errorf("recurrence rule cannot be longer than %d characters", MaxRuleLength)
+	}
+	if len(value) >= 6 && strings.EqualFold(value[:6], "RRULE:") {
+		value = value[6:]
+	}
+
+	rule := &Rule{
+		Interval:  1,
+		WeekStart: time.Monday,
+	}
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(value, ";") {
+		if len(part) == 0 {
+			continue
+		}
+		name, raw, ok := strings.Cut(part, "=")
+		if !ok || len(raw) == 0 {
+			return nil, fmt.Errorf("invalid rule part %q", part)
+		}
+		name = strings.ToUpper(strings.TrimSpace(name))
+		raw = strings.ToUpper(strings.TrimSpace(raw))
+		if seen[name] {
+			return nil, fmt.Errorf("duplicate rule part %s", name)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			rule.Frequency = Frequency(raw)
+			if !slices.Contains(AllFrequencies, rule.Frequency) {
+				err = fmt.Errorf("unsupported frequency %s", raw)
+			}
+		case "INTERVAL":
+			rule.Interval, err = parseIntInRange(raw, 1, MaxInterval)
+		case "COUNT":
+			rule.Count, err = parseIntInRange(raw, 1, MaxCount)
+		case "UNTIL":
+			rule.Until, err = parseUntil(raw)
+		case "BYMONTH":
+			rule.ByMonth, err = parseIntList(raw, 1, 12, false)
+		case "BYMONTHDAY":
+			rule.ByMonthDay, err = parseIntList(raw, 1, 31, true)
+		case "BYDAY":
+			rule.ByDay, err = parseWeekdayList(raw)
+		case "BYHOUR":
+			rule.ByHour, err = parseIntList(raw, 0, 23, false)
+		case "BYMINUTE":
+			rule.ByMinute, err = parseIntList(raw, 0, 59, false)
+		case "BYSETPOS":
+			rule.BySetPos, err = parseIntList(raw, 1, 366, true)
+		case "WKST":
+			weekday, exists := weekdayCodes[raw]
+			if !exists {
+				err = fmt.Errorf("invalid weekday %s", raw)
+			}
+			rule.WeekStart = weekday
+		case "BYSECOND", "BYWEEKNO", "BYYEARDAY":
+			err = fmt.Errorf("rule part %s is not supported", name)
+		default:
+			err = fmt.Errorf("unknown rule part %s", name)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := rule.validate(); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+func (r *Rule) validate() error {
+	if len(r.Frequency) == 0 {
+		return errors.New("FREQ is required")
+	}
+	if r.Count > 0 && r.Until != nil {
+		return errors.New("COUNT and UNTIL cannot be used together")
+	}
+	if len(r.BySetPos) > 0 &&
+		len(r.ByMonth) == 0 && len(r.ByMonthDay) == 0 && len(r.ByDay) == 0 &&
+		len(r.ByHour) == 0 && len(r.ByMinute) == 0 {
+		return errors.New("BYSETPOS requires another BYxxx rule part")
+	}
+	if r.Frequency == Frequency_Weekly && len(r.ByMonthDay) > 0 {
+		return errors.New("BYMONTHDAY cannot be used with FREQ=WEEKLY")
+	}
+	for _, weekdayNum := range r.ByDay {
+		if weekdayNum.Ordinal == 0 {
+			continue
+		}
+		switch r.Frequency {
+		case Frequency_Monthly:
+			if weekdayNum.Ordinal < -5 || weekdayNum.Ordinal > 5 {
+				return fmt.Errorf("BYDAY ordinal %d is out of range for FREQ=MONTHLY", weekdayNum.Ordinal)
+			}
+		case Frequency_Yearly:
+			if len(r.ByMonth) > 0 && (weekdayNum.Ordinal < -5 || weekdayNum.Ordinal > 5) {
+				return fmt.Errorf("BYDAY ordinal %d is out of range with BYMONTH", weekdayNum.Ordinal)
+			}
+		default:
+			return fmt.Errorf("BYDAY ordinals can only be used with FREQ=MONTHLY or FREQ=YEARLY")
+		}
+	}
+
+	return nil
+}
+
+func parseIntInRange(raw string, min int, max int) (int, error) {
+	value, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q", raw)
+	}
+	if value < min || value > max {
+		return 0, fmt.Errorf("value %d is out of range [%d, %d]", value, min, max)
+	}
+	return value, nil
+}
+
+func parseIntList(raw string, min int, max int, allowNegative bool) ([]int, error) {
+	values := make([]int, 0)
+	for _, item := range strings.Split(raw, ",") {
+		value, err := strconv.Atoi(item)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", item)
+		}
+		absolute := value
+		if allowNegative && value < 0 {
+			absolute = -value
+		}
+		if absolute < min || absolute > max {
+			return nil, fmt.Errorf("value %d is out of range", value)
+		}
+		if !slices.Contains(values, value) {
+			values = append(values, value)
+		}
+	}
+	slices.Sort(values)
+	return values, nil
+}
+
+func parseWeekdayList(raw string) ([]WeekdayNum, error) {
+	values := make([]WeekdayNum, 0)
+	for _, item := range strings.Split(raw, ",") {
+		if len(item) < 2 {
+			return nil, fmt.Errorf("invalid weekday %q", item)
+		}
+		weekday, exists := weekdayCodes[item[len(item)-2:]]
+		if !exists {
+			return nil, fmt.Errorf("invalid weekday %q", item)
+		}
+		ordinal := 0
+		if prefix := item[:len(item)-2]; len(prefix) > 0 {
+			value, err := strconv.Atoi(prefix)
+			if err != nil || value == 0 || value < -53 || value > 53 {
+				return nil, fmt.Errorf("invalid weekday ordinal %q", item)
+			}
+			ordinal = value
+		}
+		weekdayNum := WeekdayNum{Ordinal: ordinal, Weekday: weekday}
+		if !slices.Contains(values, weekdayNum) {
+			values = append(values, weekdayNum)
+		}
+	}
+	return values, nil
+}
+
+func parseUntil(raw string) (*Until, error) {
+	layouts := []struct {
+		layout     string
+		isUTC      bool
+		isDateOnly bool
+	}{
+		{layout: "20060102T150405Z", isUTC: true},
+		{layout: "20060102T150405"},
+		{layout: "20060102", isDateOnly: true},
+	}
+	for _, candidate := range layouts {
+		parsed, err := time.Parse(candidate.layout, raw)
+		if err != nil {
+			continue
+		}
+		return &Until{
+			Year:       parsed.Year(),
+			Month:      parsed.Month(),
+			Day:        parsed.Day(),
+			Hour:       parsed.Hour(),
+			Minute:     parsed.Minute(),
+			Second:     parsed.Second(),
+			IsUTC:      candidate.isUTC,
+			IsDateOnly: candidate.isDateOnly,
+		}, nil
+	}
+	return nil, fmt.Errorf("invalid UNTIL value %q", raw)
+}
+
+/* ============================== Formatting ============================== */
+
+// String returns the canonical form of the rule, the rule parts are always written in the same order
+// so that two equivalent rules are stored as the same string.
+func (r *Rule) String() string {
+	parts := []string{"FREQ=" + string(r.Frequency)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.String())
+	}
+	if len(r.ByMonth) > 0 {
+		parts = append(parts, "BYMONTH="+joinInts(r.ByMonth))
+	}
+	if len(r.ByMonthDay) > 0 {
+		parts = append(parts, "BYMONTHDAY="+joinInts(r.ByMonthDay))
+	}
+	if len(r.ByDay) > 0 {
+		weekdays := make([]string, 0, len(r.ByDay))
+		for _, weekdayNum := range r.ByDay {
+			weekdays = append(weekdays, weekdayNum.String())
+		}
+		parts = append(parts, "BYDAY="+strings.Join(weekdays, ","))
+	}
+	if len(r.ByHour) > 0 {
+		parts = append(parts, "BYHOUR="+joinInts(r.ByHour))
+	}
+	if len(r.ByMinute) > 0 {
+		parts = append(parts, "BYMINUTE="+joinInts(r.ByMinute))
+	}
+	if len(r.BySetPos) > 0 {
+		parts = append(parts, "BYSETPOS="+joinInts(r.BySetPos))
+	}
+	if r.WeekStart != time.Monday {
+		parts = append(parts, "WKST="+weekdayNames[r.WeekStart])
+	}
+	return strings.Join(parts, ";")
+}
+
+func (w WeekdayNum) String() string {
+	if w.Ordinal == 0 {
+		return weekdayNames[w.Weekday]
+	}
+	return strconv.Itoa(w.Ordinal) + weekdayNames[w.Weekday]
+}
+
+func (u Until) String() string {
+	if u.IsDateOnly {
+		return fmt.Sprintf("%04d%02d%02d", u.Year, u.Month, u.Day)
+	}
+	value := fmt.Sprintf("%04d%02d%02dT%02d%02d%02d", u.Year, u.Month, u.Day, u.Hour, u.Minute, u.Second)
+	if u.IsUTC {
+		value += "Z"
+	}
+	return value
+}
+
+// Normalize parses the given rule and returns its canonical form.
+func Normalize(value string) (string, error) {
+	rule, err := Parse(value)
+	if err != nil {
+		return "", err
+	}
+	return rule.String(), nil
+}
+
+func joinInts(values []int) string {
+	items := make([]string, 0, len(values))
+	for _, value := range values {
+		items = append(items, strconv.Itoa(value))
+	}
+	return strings.Join(items, ",")
+}
@@ -0,0 +1,126 @@
+package recurrence
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	cases := loadRuleCases[parseCase](t, "testdata/rule/parse_testdata.json")
+	for index, testCase := range cases {
+		t.Run(string(rune('A'+index)), func(t *testing.T) {
+			rule, err := Parse(testCase.Args.Value)
+			if (err == nil) != testCase.Returns.IsValid {
+				t.Fatalf("expected valid %t, got error %v", testCase.Returns.IsValid, err)
+			}
+			if err == nil && rule.String() != testCase.Returns.Normalized {
+				t.Fatalf("expected %q, got %q", testCase.Returns.Normalized, rule.String())
+			}
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	cases := loadRuleCases[nextCase](t, "testdata/rule/next_testdata.json")
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			rule, err := Parse(testCase.Args.Rule)
+			if err != nil {
+				t.Fatalf("parse rule: %v", err)
+			}
+			location, err := time.LoadLocation(testCase.Args.Timezone)
+			if err != nil {
+				t.Fatalf("load location: %v", err)
+			}
+
+			got := make([]time.Time, 0, testCase.Args.Count)
+			after := testCase.Args.After
+			for len(got) < testCase.Args.Count {
+				next, ok := rule.Next(testCase.Args.Start, after, location)
+				if !ok {
+					break
+				}
+				got = append(got, next)
+				after = next
+			}
+
+			if len(got) != len(testCase.Returns) {
+				t.Fatalf("expected %d occurrences, got %v", len(testCase.Returns), got)
+			}
+			for index := range got {
+				if !got[index].Equal(testCase.Returns[index]) {
+					t.Fatalf("expected occurrence %d to be %s, got %s", index, testCase.Returns[index], got[index].UTC())
+				}
+			}
+		})
+	}
+}
+
+func TestBetween(t *testing.T) {
+	rule, err := Parse("FREQ=DAILY;BYHOUR=9,18;BYMINUTE=0")
+	if err != nil {
+		t.Fatalf("parse rule: %v", err)
+	}
+	start := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	from := time.Date(2026, time.January, 2, 12, 0, 0, 0, time.UTC)
+	to := time.Date(2026, time.January, 4, 9, 0, 0, 0, time.UTC)
+
+	got := rule.Between(start, from, to, time.UTC, 0)
+	want := []time.Time{
+		time.Date(2026, time.January, 2, 18, 0, 0, 0, time.UTC),
+		time.Date(2026, time.January, 3, 9, 0, 0, 0, time.UTC),
+		time.Date(2026, time.January, 3, 18, 0, 0, 0, time.UTC),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for index := range want {
+		if !got[index].Equal(want[index]) {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+	}
+
+	if limited := rule.Between(start, from, to, time.UTC, 1); len(limited) != 1 {
+		t.Fatalf("expected 1 occurrence with limit, got %d", len(limited))
+	}
+}
+
+type parseCase struct {
+	Args struct {
+		Value string `json:"value"`
+	} `json:"args"`
+	Returns struct {
+		Normalized string `json:"normalized"`
+		IsValid    bool   `json:"isValid"`
+	} `json:"returns"`
+}
+
+type nextCase struct {
+	Name string `json:"name"`
+	Args struct {
+		Rule     string    `json:"rule"`
+		Timezone string    `json:"timezone"`
+		Start    time.Time `json:"start"`
+		After    time.Time `json:"after"`
+		Count    int       `json:"count"`
+	} `json:"args"`
+	Returns []time.Time `json:"returns"`
+}
+
+func loadRuleCases[CaseType any](t *testing.T, path string) []CaseType {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read testdata: %v", err)
+	}
+
+	var cases []CaseType
+	if err := json.Unmarshal(data, &cases); err != nil {
+		t.Fatalf("decode testdata: %v", err)
+	}
+
+	return cases
+}
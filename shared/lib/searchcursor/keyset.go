@@ -0,0 +1,277 @@
+package searchcursor
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The `Keyset` is the search cursor used by the keyset pagination, which captures the values of all the sort keys
+// of the boundary row (where the last sort key should always be the unique tie-breaker such as the id),
+// so that the pages never skip or repeat the rows whatever the sort keys and the sort order are.
+
+type KeysetKey struct {
+	Column string // the sql expression of the sort key
+	Type   string // the sql type used to cast the encoded values back
+	Vars   []any  // the arguments of the placeholders in the sql expression (if any)
+}
+
+type Keyset struct {
+	SortBy       string
+	IsDescending bool
+	Keys         []KeysetKey
+}
+
+type KeysetCursor struct {
+	SortBy       string    `json:"sortBy"`
+	IsDescending bool      `json:"isDescending"`
+	Values       []*string `json:"values"` // the text representations of the values of the sort keys, where nil stands for NULL
+}
+
+type KeysetOrder struct {
+	SQL  string
+	Vars []any
+}
+
+type KeysetPage struct {
+	AfterCursor  *KeysetCursor
+	BeforeCursor *KeysetCursor
+	IsBackward   bool // the rows are fetched from the before cursor in the reversed order if it is true
+	Limit        int
+}
+
+func NewKeyset(sortBy string, isDescending bool, keys ...KeysetKey) *Keyset {
+	return &Keyset{
+		SortBy:       sortBy,
+		IsDescending: isDescending,
+		Keys:         keys,
+	}
+}
+
+func (k *Keyset) Encode(values ...any) (string, error) {
+	if len(values) != len(k.Keys) {
+		return "", fmt.Errorf("expected %d values of the sort keys, got %d", len(k.Keys), len(values))
+	}
+
+	cursor := KeysetCursor{
+		SortBy:       k.SortBy,
+		IsDescending: k.IsDescending,
+		Values:       make([]*string, len(values)),
+	}
+	for index, value := range values {
+		text, err := formatValue(value)
+		if err != nil {
+			return "", err
+		}
+		cursor.Values[index] = text
+	}
+
+	jsonData, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(jsonData), nil
+}
+
+// Decode decodes the cursor and rejects the one encoded by another sort key or sort order
+func (k *Keyset) Decode(encoded string) (*KeysetCursor, error) {
+	if len(strings.ReplaceAll(encoded, " ", "")) == 0 {
+		return nil, errors.New("encoded string cannot be empty")
+	}
+
+	jsonData, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	var cursor KeysetCursor
+	if err := json.Unmarshal(jsonData, &cursor); err != nil {
+		return nil, err
+	}
+	if cursor.SortBy != k.SortBy || cursor.IsDescending != k.IsDescending {
+		return nil, errors.New("the cursor is encoded by another sort key or sort order")
+	}
+	if len(cursor.Values) != len(k.Keys) {
+		return nil, fmt.Errorf("expected %d values of the sort keys, got %d", len(k.Keys), len(cursor.Values))
+	}
+
+	return &cursor, nil
+}
+
+// NewPage decodes the cursors and resolves the size and the direction of the page,
+// where the page is fetched backward if last is given or only the before cursor is given
+func (k *Keyset) NewPage(after *string, before *string, first *int32, last *int32, defaultLimit int, maxLimit int) (*KeysetPage, error) {
+	page := &KeysetPage{Limit: defaultLimit}
+
+	if after != nil && len(strings.ReplaceAll(*after, " ", "")) > 0 {
+		cursor, err := k.Decode(*after)
+		if err != nil {
+			return nil, err
+		}
+		page.AfterCursor = cursor
+	}
+	if before != nil && len(strings.ReplaceAll(*before, " ", "")) > 0 {
+		cursor, err := k.Decode(*before)
+		if err != nil {
+			return nil, err
+		}
+		page.BeforeCursor = cursor
+	}
+
+	page.IsBackward = (last != nil && *last > 0) || (page.BeforeCursor != nil && (first == nil || *first <= 0))
+	if page.IsBackward && last != nil && *last > 0 {
+		page.Limit = int(*last)
+	} else if !page.IsBackward && first != nil && *first > 0 {
+		page.Limit = int(*first)
+	}
+	page.Limit = min(page.Limit, maxLimit)
+
+	return page, nil
+}
+
+// Where builds the condition of the rows strictly after the cursor in the sort order,
+// or strictly before it if isBefore is true, the NULLs are placed as the default of PostgreSQL
+// (which is NULLS LAST for ASC and NULLS FIRST for DESC)
+func (k *Keyset) Where(cursor *KeysetCursor, isBefore bool) (string, []any) {
+	isDescending := k.IsDescending != isBefore
+
+	var disjunctions []string
+	var args []any
+	for index, key := range k.Keys {
+		var conjunctions []string
+		var conjunctionArgs []any
+		for equalIndex := 0; equalIndex < index; equalIndex++ {
+			condition, conditionArgs := equalCondition(k.Keys[equalIndex], cursor.Values[equalIndex])
+			conjunctions = append(conjunctions, condition)
+			conjunctionArgs = append(conjunctionArgs, conditionArgs...)
+		}
+
+		condition, conditionArgs, ok := beyondCondition(key, cursor.Values[index], isDescending)
+		if !ok {
+			continue
+		}
+		conjunctions = append(conjunctions, condition)
+		conjunctionArgs = append(conjunctionArgs, conditionArgs...)
+
+		disjunctions = append(disjunctions, "("+strings.Join(conjunctions, " AND ")+")")
+		args = append(args, conjunctionArgs...)
+	}
+	if len(disjunctions) == 0 {
+		return "FALSE", nil
+	}
+
+	return "(" + strings.Join(disjunctions, " OR ") + ")", args
+}
+
+// OrderBy builds the order clauses of the sort keys, which are reversed if isBackward is true
+func (k *Keyset) OrderBy(isBackward bool) []KeysetOrder {
+	isDescending := k.IsDescending != isBackward
+
+	orders := make([]KeysetOrder, len(k.Keys))
+	for index, key := range k.Keys {
+		if isDescending {
+			orders[index] = KeysetOrder{SQL: key.Column + " DESC NULLS FIRST", Vars: key.Vars}
+		} else {
+			orders[index] = KeysetOrder{SQL: key.Column + " ASC NULLS LAST", Vars: key.Vars}
+		}
+	}
+
+	return orders
+}
+
+// Slice trims the additional row fetched to detect the next (or the previous) page and restores the sort order of the backward page
+func Slice[T any](page *KeysetPage, rows []T) ([]T, bool, bool) {
+	hasMoreRows := len(rows) > page.Limit
+	if hasMoreRows {
+		rows = rows[:page.Limit]
+	}
+
+	if !page.IsBackward {
+		return rows, hasMoreRows, page.AfterCursor != nil
+	}
+
+	for left, right := 0, len(rows)-1; left < right; left, right = left+1, right-1 {
+		rows[left], rows[right] = rows[right], rows[left]
+	}
+	return rows, page.BeforeCursor != nil, hasMoreRows
+}
+
+/* ============================== Helper Functions ============================== */
+
+func equalCondition(key KeysetKey, value *string) (string, []any) {
+	if value == nil {
+		return key.Column + " IS NULL", key.Vars
+	}
+
+	return fmt.Sprintf("%s = CAST(? AS %s)", key.Column, key.Type), append(append([]any{}, key.Vars...), *value)
+}
+
+// beyondCondition builds the condition of the values of the key strictly beyond the value in the order,
+// and returns false if there is no such value
+func beyondCondition(key KeysetKey, value *string, isDescending bool) (string, []any, bool) {
+	switch {
+	case value == nil && isDescending:
+		return key.Column + " IS NOT NULL", key.Vars, true
+	case value == nil:
+		return "", nil, false
+	case isDescending:
+		return fmt.Sprintf("%s < CAST(? AS %s)", key.Column, key.Type), append(append([]any{}, key.Vars...), *value), true
+	default:
+		args := append(append([]any{}, key.Vars...), *value)
+		args = append(args, key.Vars...)
+		return fmt.Sprintf("(%s > CAST(? AS %s) OR %s IS NULL)", key.Column, key.Type, key.Column), args, true
+	}
+}
+
+func formatValue(value any) (*string, error) {
+	if value == nil {
+		return nil, nil
+	}
+	if reflected := reflect.ValueOf(value); reflected.Kind() == reflect.Pointer {
+		if reflected.IsNil() {
+			return nil, nil
+		}
+		return formatValue(reflected.Elem().Interface())
+	}
+
+	var text string
+	switch typed := value.(type) {
+	case time.Time:
+		text = typed.UTC().Format(time.RFC3339Nano)
+	case string:
+		text = typed
+	case bool:
+		text = strconv.FormatBool(typed)
+	case int:
+		text = strconv.FormatInt(int64(typed), 10)
+	case int32:
+		text = strconv.FormatInt(int64(typed), 10)
+	case int64:
+		text = strconv.FormatInt(typed, 10)
+	case float32:
+		text = strconv.FormatFloat(float64(typed), 'g', -1, 32)
+	case float64:
+		text = strconv.FormatFloat(typed, 'g', -1, 64)
+	case fmt.Stringer:
+		text = typed.String()
+	default:
+		// the named types such as the enums
+		reflected := reflect.ValueOf(value)
+		switch reflected.Kind() {
+		case reflect.String:
+			text = reflected.String()
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			text = strconv.FormatInt(reflected.Int(), 10)
+		default:
+			return nil, fmt.Errorf("unsupported value of the sort key: %T", value)
+		}
+	}
+
+	return &text, nil
+}
@@ -0,0 +1,142 @@
+package searchcursor
+
+import (
+	"encoding/json"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestKeysetWhere(t *testing.T) {
+	data, err := os.ReadFile("testdata/keyset/where_testdata.json")
+	if err != nil {
+		t.Fatalf("read testdata: %v", err)
+	}
+
+	var cases []struct {
+		Name string `json:"name"`
+		Args struct {
+			IsDescending bool `json:"isDescending"`
+			IsBefore     bool `json:"isBefore"`
+			Keys         []struct {
+				Column string `json:"column"`
+				Type   string `json:"type"`
+			} `json:"keys"`
+			Values []*string `json:"values"`
+		} `json:"args"`
+		Returns struct {
+			SQL  string `json:"sql"`
+			Args []any  `json:"args"`
+		} `json:"returns"`
+	}
+	if err := json.Unmarshal(data, &cases); err != nil {
+		t.Fatalf("decode testdata: %v", err)
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var keys []KeysetKey
+			for _, key := range testCase.Args.Keys {
+				keys = append(keys, KeysetKey{Column: key.Column, Type: key.Type})
+			}
+			keyset := NewKeyset("KEY", testCase.Args.IsDescending, keys...)
+
+			sql, args := keyset.Where(&KeysetCursor{Values: testCase.Args.Values}, testCase.Args.IsBefore)
+			if sql != testCase.Returns.SQL {
+				t.Fatalf("expected sql %q, got %q", testCase.Returns.SQL, sql)
+			}
+			if !reflect.DeepEqual(args, testCase.Returns.Args) {
+				t.Fatalf("expected args %v, got %v", testCase.Returns.Args, args)
+			}
+		})
+	}
+}
+
+func TestKeysetEncodeDecode(t *testing.T) {
+	keyset := NewKeyset("UPDATED_AT", true,
+		KeysetKey{Column: "t.updated_at", Type: "timestamptz"},
+		KeysetKey{Column: "t.id", Type: "uuid"},
+	)
+
+	updatedAt := time.Date(2026, 1, 2, 3, 4, 5, 6000, time.FixedZone("UTC+8", 8*60*60))
+	encoded, err := keyset.Encode(updatedAt, "a")
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+
+	cursor, err := keyset.Decode(encoded)
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if *cursor.Values[0] != "2026-01-01T19:04:05.000006Z" || *cursor.Values[1] != "a" {
+		t.Fatalf("unexpected values %q, %q", *cursor.Values[0], *cursor.Values[1])
+	}
+
+	if _, err := NewKeyset("UPDATED_AT", false, keyset.Keys...).Decode(encoded); err == nil {
+		t.Fatalf("expected the cursor of another sort order to be rejected")
+	}
+	if _, err := NewKeyset("CREATED_AT", true, keyset.Keys...).Decode(encoded); err == nil {
+		t.Fatalf("expected the cursor of another sort key to be rejected")
+	}
+	if _, err := NewKeyset("UPDATED_AT", true, keyset.Keys[1]).Decode(encoded); err == nil {
+		t.Fatalf("expected the cursor of another number of sort keys to be rejected")
+	}
+}
+
+func TestKeysetOrderBy(t *testing.T) {
+	keyset := NewKeyset("UPDATED_AT", true,
+		KeysetKey{Column: "t.updated_at", Type: "timestamptz"},
+		KeysetKey{Column: "t.id", Type: "uuid"},
+	)
+
+	var forward, backward []string
+	for _, order := range keyset.OrderBy(false) {
+		forward = append(forward, order.SQL)
+	}
+	for _, order := range keyset.OrderBy(true) {
+		backward = append(backward, order.SQL)
+	}
+
+	if expected := []string{"t.updated_at DESC NULLS FIRST", "t.id DESC NULLS FIRST"}; !reflect.DeepEqual(forward, expected) {
+		t.Fatalf("expected %v, got %v", expected, forward)
+	}
+	if expected := []string{"t.updated_at ASC NULLS LAST", "t.id ASC NULLS LAST"}; !reflect.DeepEqual(backward, expected) {
+		t.Fatalf("expected %v, got %v", expected, backward)
+	}
+}
+
+func TestKeysetPage(t *testing.T) {
+	keyset := NewKeyset("", false, KeysetKey{Column: "t.id", Type: "bigint"})
+	after, _ := keyset.Encode(3)
+	before, _ := keyset.Encode(9)
+	two, four := int32(2), int32(4)
+
+	forward, err := keyset.NewPage(&after, nil, &two, nil, 10, 100)
+	if err != nil {
+		t.Fatalf("new page: %v", err)
+	}
+	rows, hasNextPage, hasPreviousPage := Slice(forward, []int{4, 5, 6})
+	if !reflect.DeepEqual(rows, []int{4, 5}) || !hasNextPage || !hasPreviousPage {
+		t.Fatalf("unexpected forward page %v, %v, %v", rows, hasNextPage, hasPreviousPage)
+	}
+
+	backward, err := keyset.NewPage(nil, &before, nil, &four, 10, 3)
+	if err != nil {
+		t.Fatalf("new page: %v", err)
+	}
+	if !backward.IsBackward || backward.Limit != 3 {
+		t.Fatalf("expected a backward page of 3 rows, got %v of %d rows", backward.IsBackward, backward.Limit)
+	}
+	rows, hasNextPage, hasPreviousPage = Slice(backward, []int{8, 7, 6, 5})
+	if !reflect.DeepEqual(rows, []int{6, 7, 8}) || !hasNextPage || !hasPreviousPage {
+		t.Fatalf("unexpected backward page %v, %v, %v", rows, hasNextPage, hasPreviousPage)
+	}
+
+	if _, err := keyset.NewPage(nil, nil, nil, nil, 10, 100); err != nil {
+		t.Fatalf("new page: %v", err)
+	}
+	if _, err := NewKeyset("", true, keyset.Keys...).NewPage(&after, nil, nil, nil, 10, 100); err == nil {
+		t.Fatalf("expected the cursor of another sort order to be rejected")
+	}
+}
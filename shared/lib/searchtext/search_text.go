@@ -0,0 +1,134 @@
+package searchtext
+
+import (
+	"bytes"
+	"encoding/json"
+	"html"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	nethtml "golang.org/x/net/html"
+)
+
+// the maximum length in bytes of the extracted text,
+// which keeps the projected tsvector far below the 1MB limit of PostgreSQL
+const MaxLength = 65536
+
+// the selectors wrapped around the matched lexemes by ts_headline,
+// the control characters are used so that they can never collide with the escaped text
+const (
+	HighlightStartSelector = "\x02"
+	HighlightStopSelector  = "\x03"
+)
+
+// the options passed to ts_headline to build the highlighted snippets
+const HighlightOptions = `StartSel="` + HighlightStartSelector + `", StopSel="` + HighlightStopSelector + `", MaxWords=35, MinWords=15, ShortWord=3, MaxFragments=2, FragmentDelimiter=" ... "`
+
+// FromPlainText normalizes the whitespaces of the plain text (and markdown) content
+func FromPlainText(content []byte) string {
+	return normalize(string(content))
+}
+
+// FromHTML extracts the text nodes of the html content, while the scripts and styles are skipped
+func FromHTML(content []byte) string {
+	var builder strings.Builder
+	tokenizer := nethtml.NewTokenizer(bytes.NewReader(content))
+	skippingDepth := 0
+	for builder.Len() < MaxLength {
+		switch tokenizer.Next() {
+		case nethtml.ErrorToken:
+			return normalize(builder.String())
+		case nethtml.StartTagToken:
+			if name, _ := tokenizer.TagName(); isSkippedTag(string(name)) {
+				skippingDepth++
+			}
+		case nethtml.EndTagToken:
+			if name, _ := tokenizer.TagName(); isSkippedTag(string(name)) && skippingDepth > 0 {
+				skippingDepth--
+			}
+		case nethtml.TextToken:
+			if skippingDepth == 0 {
+				builder.Write(tokenizer.Text())
+				builder.WriteByte(' ')
+			}
+		}
+	}
+
+	return normalize(builder.String())
+}
+
+// FromJSON extracts the string values of the json content, while the object keys are skipped
+func FromJSON(content []byte) string {
+	var builder strings.Builder
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	// each frame records whether it is an object and whether the next string token of the object is a key
+	type frame struct {
+		isObject   bool
+		expectsKey bool
+	}
+	var frames []frame
+	for builder.Len() < MaxLength {
+		token, err := decoder.Token()
+		if err != nil {
+			break
+		}
+
+		if delimiter, ok := token.(json.Delim); ok {
+			switch delimiter {
+			case '{', '[':
+				if len(frames) > 0 && frames[len(frames)-1].isObject {
+					frames[len(frames)-1].expectsKey = true
+				}
+				frames = append(frames, frame{isObject: delimiter == '{', expectsKey: delimiter == '{'})
+			default:
+				frames = frames[:len(frames)-1]
+			}
+			continue
+		}
+
+		if len(frames) > 0 && frames[len(frames)-1].isObject {
+			isKey := frames[len(frames)-1].expectsKey
+			frames[len(frames)-1].expectsKey = !isKey
+			if isKey {
+				continue
+			}
+		}
+		if value, ok := token.(string); ok {
+			builder.WriteString(value)
+			builder.WriteByte(' ')
+		}
+	}
+
+	return normalize(builder.String())
+}
+
+// FormatHighlight escapes the snippet built by ts_headline with the HighlightOptions,
+// and wraps the matched lexemes with the <mark> tags
+func FormatHighlight(snippet string) string {
+	escaped := html.EscapeString(snippet)
+	escaped = strings.ReplaceAll(escaped, HighlightStartSelector, "<mark>")
+	return strings.ReplaceAll(escaped, HighlightStopSelector, "</mark>")
+}
+
+/* ============================== Helper Functions ============================== */
+
+func isSkippedTag(name string) bool {
+	return name == "script" || name == "style" || name == "noscript" || name == "template"
+}
+
+func normalize(text string) string {
+	text = strings.Join(strings.FieldsFunc(text, func(character rune) bool {
+		return unicode.IsSpace(character) || unicode.IsControl(character)
+	}), " ")
+	if len(text) <= MaxLength {
+		return text
+	}
+
+	// truncate at the boundary of the utf-8 characters
+	end := MaxLength
+	for end > 0 && !utf8.RuneStart(text[end]) {
+		end--
+	}
+	return text[:end]
+}
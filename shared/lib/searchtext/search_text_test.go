@@ -0,0 +1,75 @@
+package searchtext
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestExtract(t *testing.T) {
+	extractors := map[string]func([]byte) string{
+		"plain": FromPlainText,
+		"html":  FromHTML,
+		"json":  FromJSON,
+	}
+
+	cases := loadSearchTextCases(t, "testdata/search_text/extract_testdata.json")
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			extract, ok := extractors[testCase.Args.Format]
+			if !ok {
+				t.Fatalf("unknown format %q", testCase.Args.Format)
+			}
+			if got := extract([]byte(testCase.Args.Input)); got != testCase.Returns {
+				t.Fatalf("expected %q, got %q", testCase.Returns, got)
+			}
+		})
+	}
+}
+
+func TestExtractTruncatesAtCharacterBoundary(t *testing.T) {
+	got := FromPlainText([]byte(strings.Repeat("筆記", MaxLength)))
+	if len(got) > MaxLength {
+		t.Fatalf("expected at most %d bytes, got %d", MaxLength, len(got))
+	}
+	if !strings.HasSuffix(got, "記") && !strings.HasSuffix(got, "筆") {
+		t.Fatalf("expected the text to end with a complete character, got %q", got[len(got)-4:])
+	}
+}
+
+func TestFormatHighlight(t *testing.T) {
+	cases := loadSearchTextCases(t, "testdata/search_text/format_highlight_testdata.json")
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := FormatHighlight(testCase.Args.Input); got != testCase.Returns {
+				t.Fatalf("expected %q, got %q", testCase.Returns, got)
+			}
+		})
+	}
+}
+
+type searchTextCase struct {
+	Name string `json:"name"`
+	Args struct {
+		Format string `json:"format"`
+		Input  string `json:"input"`
+	} `json:"args"`
+	Returns string `json:"returns"`
+}
+
+func loadSearchTextCases(t *testing.T, path string) []searchTextCase {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read testdata: %v", err)
+	}
+
+	var cases []searchTextCase
+	if err := json.Unmarshal(data, &cases); err != nil {
+		t.Fatalf("decode testdata: %v", err)
+	}
+
+	return cases
+}
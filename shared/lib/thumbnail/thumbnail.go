@@ -0,0 +1,89 @@
+package thumbnail
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/png"
+
+	// register the decoders of the image formats which can be thumbnailed
+	_ "image/gif"
+	_ "image/jpeg"
+)
+
+// the maximum number of pixels of a source image, which prevents the decompression bombs
+const MaxSourcePixels = 64 * 1024 * 1024
+
+// the content type of every generated thumbnail
+const ContentType = "image/png"
+
+var ErrImageTooLarge = errors.New("the image is too large to generate a thumbnail")
+
+// FromImageBytes decodes a PNG, JPEG or GIF image and generates its thumbnail, where only the first frame of a GIF is used
+func FromImageBytes(content []byte, maxDimension int) ([]byte, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	if config.Width*config.Height > MaxSourcePixels {
+		return nil, ErrImageTooLarge
+	}
+
+	source, _, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	return FromImage(source, maxDimension)
+}
+
+// FromImage scales the image to fit in a square of the max dimension and encodes it as a PNG
+func FromImage(source image.Image, maxDimension int) ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := png.Encode(&buffer, Scale(source, maxDimension)); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// Scale shrinks the image by averaging the source pixels covered by each target pixel while keeping its aspect ratio,
+// and the images which already fit are copied as they are
+func Scale(source image.Image, maxDimension int) image.Image {
+	bounds := source.Bounds()
+	sourceWidth, sourceHeight := bounds.Dx(), bounds.Dy()
+	targetWidth, targetHeight := sourceWidth, sourceHeight
+	if sourceWidth > maxDimension || sourceHeight > maxDimension {
+		if sourceWidth >= sourceHeight {
+			targetWidth = maxDimension
+			targetHeight = max(1, sourceHeight*maxDimension/sourceWidth)
+		} else {
+			targetHeight = maxDimension
+			targetWidth = max(1, sourceWidth*maxDimension/sourceHeight)
+		}
+	}
+
+	target := image.NewRGBA(image.Rect(0, 0, targetWidth, targetHeight))
+	for targetY := 0; targetY < targetHeight; targetY++ {
+		startY := bounds.Min.Y + targetY*sourceHeight/targetHeight
+		endY := max(startY+1, bounds.Min.Y+(targetY+1)*sourceHeight/targetHeight)
+		for targetX := 0; targetX < targetWidth; targetX++ {
+			startX := bounds.Min.X + targetX*sourceWidth/targetWidth
+			endX := max(startX+1, bounds.Min.X+(targetX+1)*sourceWidth/targetWidth)
+
+			// the channels are premultiplied by the alpha, so the transparent pixels do not darken the average
+			var red, green, blue, alpha, count uint64
+			for y := startY; y < endY; y++ {
+				for x := startX; x < endX; x++ {
+					r, g, b, a := source.At(x, y).RGBA()
+					red, green, blue, alpha = red+uint64(r), green+uint64(g), blue+uint64(b), alpha+uint64(a)
+					count++
+				}
+			}
+			offset := target.PixOffset(targetX, targetY)
+			target.Pix[offset] = uint8(red / count >> 8)
+			target.Pix[offset+1] = uint8(green / count >> 8)
+			target.Pix[offset+2] = uint8(blue / count >> 8)
+			target.Pix[offset+3] = uint8(alpha / count >> 8)
+		}
+	}
+	return target
+}
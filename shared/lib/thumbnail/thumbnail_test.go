@@ -0,0 +1,69 @@
+package thumbnail
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+)
+
+func TestScaleKeepsAspectRatio(t *testing.T) {
+	cases := []struct {
+		name          string
+		width, height int
+		wantBounds    image.Rectangle
+	}{
+		{name: "landscape", width: 800, height: 400, wantBounds: image.Rect(0, 0, 320, 160)},
+		{name: "portrait", width: 300, height: 900, wantBounds: image.Rect(0, 0, 106, 320)},
+		{name: "already fits", width: 40, height: 30, wantBounds: image.Rect(0, 0, 40, 30)},
+		{name: "thin strip", width: 10000, height: 1, wantBounds: image.Rect(0, 0, 320, 1)},
+	}
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := Scale(image.NewRGBA(image.Rect(0, 0, testCase.width, testCase.height)), 320).Bounds()
+			if got != testCase.wantBounds {
+				t.Fatalf("expected %v, got %v", testCase.wantBounds, got)
+			}
+		})
+	}
+}
+
+func TestScaleAveragesCoveredPixels(t *testing.T) {
+	source := image.NewNRGBA(image.Rect(10, 10, 14, 12))
+	for x := 10; x < 14; x++ {
+		source.SetNRGBA(x, 10, color.NRGBA{R: 0xff, A: 0xff})
+		source.SetNRGBA(x, 11, color.NRGBA{B: 0xff, A: 0xff})
+	}
+
+	scaled := Scale(source, 2)
+	want := color.RGBA{R: 0x7f, B: 0x7f, A: 0xff}
+	for x := 0; x < 2; x++ {
+		if got := scaled.At(x, 0); got != want {
+			t.Fatalf("expected %v at (%d, 0), got %v", want, x, got)
+		}
+	}
+}
+
+func TestFromImageBytes(t *testing.T) {
+	var source bytes.Buffer
+	if err := png.Encode(&source, image.NewGray(image.Rect(0, 0, 640, 480))); err != nil {
+		t.Fatalf("encode source: %v", err)
+	}
+
+	thumbnail, err := FromImageBytes(source.Bytes(), 320)
+	if err != nil {
+		t.Fatalf("FromImageBytes() error = %v", err)
+	}
+	config, err := png.DecodeConfig(bytes.NewReader(thumbnail))
+	if err != nil {
+		t.Fatalf("decode thumbnail: %v", err)
+	}
+	if config.Width != 320 || config.Height != 240 {
+		t.Fatalf("expected 320x240, got %dx%d", config.Width, config.Height)
+	}
+
+	if _, err := FromImageBytes([]byte("not an image"), 320); err == nil {
+		t.Fatal("expected an error for the content which is not an image")
+	}
+}
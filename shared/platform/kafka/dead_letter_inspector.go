@@ -0,0 +1,538 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/twmb/franz-go/pkg/kerr"
+	franzkgo "github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/kmsg"
+
+	eventcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/events"
+)
+
+// the scan of a dead-letter topic stops once a poll returns nothing within this timeout,
+// which only happens when the remaining offsets have been deleted or belong to no record
+const deadLetterPollTimeout = 5 * time.Second
+
+type DeadLetterRecord struct {
+	Partition  int32
+	Offset     int64
+	DeadLetter DeadLetter
+	Envelope   *eventcontract.EventEnvelope[json.RawMessage]
+	DecodeErr  error
+}
+
+// EventType returns the event type of the original envelope, which is empty when the envelope cannot be decoded
+func (r DeadLetterRecord) EventType() eventcontract.EventType {
+	if r.Envelope == nil {
+		return ""
+	}
+
+	return r.Envelope.EventType
+}
+
+type DeadLetterFilter struct {
+	EventType      eventcontract.EventType
+	Classification ErrorClassification
+	FailedFrom     time.Time
+	FailedUntil    time.Time
+}
+
+func (f DeadLetterFilter) Matches(record DeadLetterRecord) bool {
+	if f.EventType != "" && record.EventType() != f.EventType {
+		return false
+	}
+	if f.Classification != "" && record.DeadLetter.Classification != f.Classification {
+		return false
+	}
+	if !f.FailedFrom.IsZero() && record.DeadLetter.FailedAt.Before(f.FailedFrom) {
+		return false
+	}
+	if !f.FailedUntil.IsZero() && !record.DeadLetter.FailedAt.Before(f.FailedUntil) {
+		return false
+	}
+
+	return true
+}
+
+// DeadLetterCursor holds the next offset to read of each partition of a dead-letter topic,
+// and it is encoded as comma-separated partition:offset pairs, such as "0:12,1:4"
+type DeadLetterCursor map[int32]int64
+
+func ParseDeadLetterCursor(value string) (DeadLetterCursor, error) {
+	cursor := make(DeadLetterCursor)
+	if strings.TrimSpace(value) == "" {
+		return cursor, nil
+	}
+
+	for _, position := range strings.Split(value, ",") {
+		partition, offset, err := ParseDeadLetterPosition(position)
+		if err != nil {
+			return nil, err
+		}
+		cursor[partition] = offset
+	}
+
+	return cursor, nil
+}
+
+func (c DeadLetterCursor) String() string {
+	partitions := make([]int32, 0, len(c))
+	for partition := range c {
+		partitions = append(partitions, partition)
+	}
+	slices.Sort(partitions)
+
+	positions := make([]string, 0, len(partitions))
+	for _, partition := range partitions {
+		positions = append(positions, fmt.Sprintf("%d:%d", partition, c[partition]))
+	}
+
+	return strings.Join(positions, ",")
+}
+
+// ParseDeadLetterPosition parses the partition:offset position of a record in a dead-letter topic
+func ParseDeadLetterPosition(value string) (int32, int64, error) {
+	partitionValue, offsetValue, found := strings.Cut(strings.TrimSpace(value), ":")
+	if !found {
+		return 0, 0, fmt.Errorf("dead-letter position %q must be partition:offset", value)
+	}
+	partition, err := strconv.ParseInt(partitionValue, 10, 32)
+	if err != nil || partition < 0 {
+		return 0, 0, fmt.Errorf("dead-letter position %q has an invalid partition", value)
+	}
+	offset, err := strconv.ParseInt(offsetValue, 10, 64)
+	if err != nil || offset < 0 {
+		return 0, 0, fmt.Errorf("dead-letter position %q has an invalid offset", value)
+	}
+
+	return int32(partition), offset, nil
+}
+
+type DeadLetterPage struct {
+	Records []DeadLetterRecord
+	Next    DeadLetterCursor
+	Done    bool
+}
+
+// DeadLetterPurge is the range of offsets of a partition deleted by a purge, where From is inclusive and To is exclusive
+type DeadLetterPurge struct {
+	From int64
+	To   int64
+}
+
+type DeadLetterInspector struct {
+	config ClientConfig
+	client *franzkgo.Client
+}
+
+type deadLetterOffsets struct {
+	earliest int64
+	end      int64
+}
+
+func NewDeadLetterInspector(kafkaConfig ClientConfig) (*DeadLetterInspector, error) {
+	options, err := newConnectionOptions(kafkaConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := franzkgo.NewClient(options...)
+	if err != nil {
+		return nil, fmt.Errorf("create Kafka dead-letter client: %w", err)
+	}
+
+	return &DeadLetterInspector{
+		config: kafkaConfig,
+		client: client,
+	}, nil
+}
+
+/* ============================== Inspector Methods ============================== */
+
+// List pages through the records of the dead-letter topic from the cursor, where the records rejected by the filter
+// still advance the cursor, so the next page continues after them
+func (i *DeadLetterInspector) List(
+	ctx context.Context,
+	topic string,
+	cursor DeadLetterCursor,
+	filter DeadLetterFilter,
+	limit int,
+) (DeadLetterPage, error) {
+	if i == nil || i.client == nil {
+		return DeadLetterPage{}, errors.New("Kafka dead-letter inspector is unavailable")
+	}
+
+	offsets, err := i.listOffsets(ctx, topic)
+	if err != nil {
+		return DeadLetterPage{}, err
+	}
+	page := DeadLetterPage{Next: make(DeadLetterCursor, len(offsets))}
+	for partition, bounds := range offsets {
+		page.Next[partition] = max(bounds.earliest, cursor[partition])
+	}
+
+	start := make(DeadLetterCursor, len(page.Next))
+	for partition, offset := range page.Next {
+		start[partition] = offset
+	}
+	if err := i.scan(ctx, topic, start, offsets, func(record DeadLetterRecord) bool {
+		if limit > 0 && len(page.Records) >= limit {
+			return false
+		}
+		page.Next[record.Partition] = record.Offset + 1
+		if filter.Matches(record) {
+			page.Records = append(page.Records, record)
+		}
+		return true
+	}); err != nil {
+		return DeadLetterPage{}, err
+	}
+
+	page.Done = true
+	for partition, bounds := range offsets {
+		if page.Next[partition] < bounds.end {
+			page.Done = false
+		}
+	}
+
+	return page, nil
+}
+
+func (i *DeadLetterInspector) Get(
+	ctx context.Context,
+	topic string,
+	partition int32,
+	offset int64,
+) (DeadLetterRecord, error) {
+	if i == nil || i.client == nil {
+		return DeadLetterRecord{}, errors.New("Kafka dead-letter inspector is unavailable")
+	}
+
+	offsets, err := i.listOffsets(ctx, topic)
+	if err != nil {
+		return DeadLetterRecord{}, err
+	}
+	bounds, exists := offsets[partition]
+	if !exists || offset < bounds.earliest || offset >= bounds.end {
+		return DeadLetterRecord{}, fmt.Errorf("Kafka dead-letter record %d:%d does not exist in %q", partition, offset, topic)
+	}
+
+	var found *DeadLetterRecord
+	if err := i.scan(
+		ctx,
+		topic,
+		DeadLetterCursor{partition: offset},
+		map[int32]deadLetterOffsets{partition: {earliest: offset, end: offset + 1}},
+		func(record DeadLetterRecord) bool {
+			found = &record
+			return false
+		},
+	); err != nil {
+		return DeadLetterRecord{}, err
+	}
+	if found == nil || found.Offset != offset {
+		return DeadLetterRecord{}, fmt.Errorf("Kafka dead-letter record %d:%d does not exist in %q", partition, offset, topic)
+	}
+
+	return *found, nil
+}
+
+// Replay republishes the original event of the record to its source topic with the replay ID as its causation ID
+func (i *DeadLetterInspector) Replay(ctx context.Context, record DeadLetterRecord, replayId uuid.UUID) error {
+	if i == nil || i.client == nil {
+		return errors.New("Kafka dead-letter inspector is unavailable")
+	}
+	if record.DecodeErr != nil {
+		return record.DecodeErr
+	}
+	if record.DeadLetter.SourceTopic == "" {
+		return errors.New("Kafka dead-letter record has no source topic")
+	}
+
+	value, err := ReplayValue(record.DeadLetter.Value, replayId)
+	if err != nil {
+		return err
+	}
+
+	startedAt := time.Now()
+	result := i.client.ProduceSync(ctx, &franzkgo.Record{
+		Topic: record.DeadLetter.SourceTopic,
+		Key:   []byte(record.DeadLetter.Key),
+		Value: value,
+	})
+	err = result.FirstErr()
+	RecordPublish(ctx, record.DeadLetter.SourceTopic, time.Since(startedAt), err)
+
+	return err
+}
+
+// PlanPurge returns the offsets of each partition deleted by purging the records failed before the time,
+// and a zero time purges every record, since Kafka only deletes the records of a partition from its beginning,
+// a partition stops being purged at its first record failed at or after the time
+func (i *DeadLetterInspector) PlanPurge(
+	ctx context.Context,
+	topic string,
+	failedBefore time.Time,
+) (map[int32]DeadLetterPurge, error) {
+	if i == nil || i.client == nil {
+		return nil, errors.New("Kafka dead-letter inspector is unavailable")
+	}
+
+	offsets, err := i.listOffsets(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+	plan := make(map[int32]DeadLetterPurge, len(offsets))
+	if failedBefore.IsZero() {
+		for partition, bounds := range offsets {
+			plan[partition] = DeadLetterPurge{From: bounds.earliest, To: bounds.end}
+		}
+		return plan, nil
+	}
+
+	start := make(DeadLetterCursor, len(offsets))
+	for partition, bounds := range offsets {
+		plan[partition] = DeadLetterPurge{From: bounds.earliest, To: bounds.earliest}
+		start[partition] = bounds.earliest
+	}
+	kept := make(map[int32]struct{}, len(offsets))
+	if err := i.scan(ctx, topic, start, offsets, func(record DeadLetterRecord) bool {
+		if _, exists := kept[record.Partition]; exists {
+			return true
+		}
+		if !record.DeadLetter.FailedAt.Before(failedBefore) {
+			kept[record.Partition] = struct{}{}
+			return true
+		}
+		purge := plan[record.Partition]
+		purge.To = record.Offset + 1
+		plan[record.Partition] = purge
+		return true
+	}); err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+func (i *DeadLetterInspector) Purge(ctx context.Context, topic string, plan map[int32]DeadLetterPurge) error {
+	if i == nil || i.client == nil {
+		return errors.New("Kafka dead-letter inspector is unavailable")
+	}
+
+	requestTopic := kmsg.NewDeleteRecordsRequestTopic()
+	requestTopic.Topic = topic
+	for partition, purge := range plan {
+		if purge.To <= purge.From {
+			continue
+		}
+		requestPartition := kmsg.NewDeleteRecordsRequestTopicPartition()
+		requestPartition.Partition = partition
+		requestPartition.Offset = purge.To
+		requestTopic.Partitions = append(requestTopic.Partitions, requestPartition)
+	}
+	if len(requestTopic.Partitions) == 0 {
+		return nil
+	}
+
+	request := kmsg.NewPtrDeleteRecordsRequest()
+	request.TimeoutMillis = 30_000
+	request.Topics = append(request.Topics, requestTopic)
+	response, err := request.RequestWith(ctx, i.client)
+	if err != nil {
+		return fmt.Errorf("delete Kafka dead-letter records: %w", err)
+	}
+	for _, responseTopic := range response.Topics {
+		for _, responsePartition := range responseTopic.Partitions {
+			if err := kerr.ErrorForCode(responsePartition.ErrorCode); err != nil {
+				return fmt.Errorf("delete Kafka dead-letter records of %q partition %d: %w", topic, responsePartition.Partition, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (i *DeadLetterInspector) Close() {
+	if i == nil || i.client == nil {
+		return
+	}
+
+	i.client.Close()
+}
+
+/* ============================== Auxiliary Methods ============================== */
+
+func (i *DeadLetterInspector) listOffsets(ctx context.Context, topic string) (map[int32]deadLetterOffsets, error) {
+	metadataRequest := kmsg.NewPtrMetadataRequest()
+	metadataTopic := kmsg.NewMetadataRequestTopic()
+	metadataTopic.Topic = kmsg.StringPtr(topic)
+	metadataRequest.Topics = append(metadataRequest.Topics, metadataTopic)
+	metadataResponse, err := metadataRequest.RequestWith(ctx, i.client)
+	if err != nil {
+		return nil, fmt.Errorf("describe Kafka topic %q: %w", topic, err)
+	}
+	if len(metadataResponse.Topics) != 1 {
+		return nil, fmt.Errorf("describe Kafka topic %q: unexpected metadata response", topic)
+	}
+	if err := kerr.ErrorForCode(metadataResponse.Topics[0].ErrorCode); err != nil {
+		return nil, fmt.Errorf("describe Kafka topic %q: %w", topic, err)
+	}
+
+	offsets := make(map[int32]deadLetterOffsets, len(metadataResponse.Topics[0].Partitions))
+	for _, timestamp := range []int64{-2, -1} {
+		requestTopic := kmsg.NewListOffsetsRequestTopic()
+		requestTopic.Topic = topic
+		for _, partition := range metadataResponse.Topics[0].Partitions {
+			requestPartition := kmsg.NewListOffsetsRequestTopicPartition()
+			requestPartition.Partition = partition.Partition
+			requestPartition.Timestamp = timestamp
+			requestTopic.Partitions = append(requestTopic.Partitions, requestPartition)
+		}
+		request := kmsg.NewPtrListOffsetsRequest()
+		request.Topics = append(request.Topics, requestTopic)
+		response, err := request.RequestWith(ctx, i.client)
+		if err != nil {
+			return nil, fmt.Errorf("list Kafka topic %q offsets: %w", topic, err)
+		}
+		for _, responseTopic := range response.Topics {
+			for _, responsePartition := range responseTopic.Partitions {
+				if err := kerr.ErrorForCode(responsePartition.ErrorCode); err != nil {
+					return nil, fmt.Errorf("list Kafka topic %q partition %d offsets: %w", topic, responsePartition.Partition, err)
+				}
+				bounds := offsets[responsePartition.Partition]
+				if timestamp == -2 {
+					bounds.earliest = responsePartition.Offset
+				} else {
+					bounds.end = responsePartition.Offset
+				}
+				offsets[responsePartition.Partition] = bounds
+			}
+		}
+	}
+
+	return offsets, nil
+}
+
+// scan visits the records of the partitions from their start offsets until their end offsets,
+// where a visit returning false stops the whole scan
+func (i *DeadLetterInspector) scan(
+	ctx context.Context,
+	topic string,
+	start DeadLetterCursor,
+	offsets map[int32]deadLetterOffsets,
+	visit func(record DeadLetterRecord) bool,
+) error {
+	partitions := make(map[int32]franzkgo.Offset, len(start))
+	for partition, offset := range start {
+		if offset < offsets[partition].end {
+			partitions[partition] = franzkgo.NewOffset().At(offset)
+		}
+	}
+	if len(partitions) == 0 {
+		return nil
+	}
+
+	options, err := newConnectionOptions(i.config)
+	if err != nil {
+		return err
+	}
+	options = append(options, franzkgo.ConsumePartitions(map[string]map[int32]franzkgo.Offset{topic: partitions}))
+	reader, err := franzkgo.NewClient(options...)
+	if err != nil {
+		return fmt.Errorf("create Kafka dead-letter reader: %w", err)
+	}
+	defer reader.Close()
+
+	for len(partitions) > 0 {
+		pollCtx, cancel := context.WithTimeout(ctx, deadLetterPollTimeout)
+		fetches := reader.PollFetches(pollCtx)
+		cancel()
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		var fetchErr error
+		fetches.EachError(func(_ string, _ int32, err error) {
+			if fetchErr == nil && !errors.Is(err, context.DeadlineExceeded) {
+				fetchErr = err
+			}
+		})
+		if fetchErr != nil {
+			return fmt.Errorf("fetch Kafka dead-letter records of %q: %w", topic, fetchErr)
+		}
+		if fetches.NumRecords() == 0 {
+			return nil
+		}
+
+		for iterator := fetches.RecordIter(); !iterator.Done(); {
+			record := iterator.Next()
+			if _, exists := partitions[record.Partition]; !exists {
+				continue
+			}
+			end := offsets[record.Partition].end
+			if record.Offset >= end {
+				delete(partitions, record.Partition)
+				continue
+			}
+			if !visit(decodeDeadLetterRecord(record)) {
+				return nil
+			}
+			if record.Offset+1 >= end {
+				delete(partitions, record.Partition)
+			}
+		}
+	}
+
+	return nil
+}
+
+/* ============================== Auxiliary Functions ============================== */
+
+// ReplayValue sets the replay ID as the causation ID of the event and keeps its event ID,
+// so the inbox of the consumers still deduplicates a replayed event which has been processed before
+func ReplayValue(value []byte, replayId uuid.UUID) ([]byte, error) {
+	var envelope map[string]json.RawMessage
+	if err := json.Unmarshal(value, &envelope); err != nil {
+		return nil, fmt.Errorf("decode Kafka event envelope: %w", err)
+	}
+	if _, exists := envelope["eventId"]; !exists {
+		return nil, errors.New("Kafka event envelope has no event ID")
+	}
+
+	causationId, err := json.Marshal(replayId)
+	if err != nil {
+		return nil, err
+	}
+	envelope["causationId"] = causationId
+
+	return json.Marshal(envelope)
+}
+
+func decodeDeadLetterRecord(record *franzkgo.Record) DeadLetterRecord {
+	result := DeadLetterRecord{
+		Partition: record.Partition,
+		Offset:    record.Offset,
+	}
+	if err := json.Unmarshal(record.Value, &result.DeadLetter); err != nil {
+		result.DeadLetter = DeadLetter{Key: string(record.Key), Value: record.Value}
+		result.DecodeErr = fmt.Errorf("decode Kafka dead-letter record: %w", err)
+		return result
+	}
+
+	var envelope eventcontract.EventEnvelope[json.RawMessage]
+	if err := json.Unmarshal(result.DeadLetter.Value, &envelope); err == nil {
+		result.Envelope = &envelope
+	}
+
+	return result
+}
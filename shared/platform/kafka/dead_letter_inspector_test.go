@@ -0,0 +1,104 @@
+package kafka
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	eventcontract "github.com/HiIamJeff67/notegic-backend/contracts/types/events"
+)
+
+func TestDeadLetterCursorRoundTrip(t *testing.T) {
+	cursor, err := ParseDeadLetterCursor("2:7, 0:12,1:0")
+	if err != nil {
+		t.Fatalf("ParseDeadLetterCursor() error = %v", err)
+	}
+	if cursor.String() != "0:12,1:0,2:7" {
+		t.Fatalf("cursor = %q, want sorted partitions", cursor.String())
+	}
+
+	empty, err := ParseDeadLetterCursor("")
+	if err != nil || len(empty) != 0 {
+		t.Fatalf("ParseDeadLetterCursor(\"\") = %v, %v", empty, err)
+	}
+	for _, value := range []string{"0", "a:1", "0:b", "-1:3", "0:-3"} {
+		if _, err := ParseDeadLetterCursor(value); err == nil {
+			t.Fatalf("ParseDeadLetterCursor(%q) returned nil error", value)
+		}
+	}
+}
+
+func TestDeadLetterFilterMatches(t *testing.T) {
+	failedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	record := DeadLetterRecord{
+		DeadLetter: DeadLetter{Classification: ErrorClassification_Transient, FailedAt: failedAt},
+		Envelope:   &eventcontract.EventEnvelope[json.RawMessage]{EventType: "MaterialProcessingHint"},
+	}
+
+	tests := []struct {
+		name   string
+		filter DeadLetterFilter
+		want   bool
+	}{
+		{name: "empty", filter: DeadLetterFilter{}, want: true},
+		{name: "event type", filter: DeadLetterFilter{EventType: "MaterialProcessingHint"}, want: true},
+		{name: "other event type", filter: DeadLetterFilter{EventType: "YjsMaintenanceHint"}, want: false},
+		{name: "other classification", filter: DeadLetterFilter{Classification: ErrorClassification_PoisonMessage}, want: false},
+		{name: "inclusive from", filter: DeadLetterFilter{FailedFrom: failedAt}, want: true},
+		{name: "exclusive until", filter: DeadLetterFilter{FailedUntil: failedAt}, want: false},
+		{name: "within range", filter: DeadLetterFilter{FailedFrom: failedAt.Add(-time.Hour), FailedUntil: failedAt.Add(time.Hour)}, want: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.filter.Matches(record); got != test.want {
+				t.Fatalf("Matches() = %v, want %v", got, test.want)
+			}
+		})
+	}
+
+	undecodable := DeadLetterRecord{DeadLetter: DeadLetter{Classification: ErrorClassification_SchemaIncompatible}}
+	if (DeadLetterFilter{EventType: "MaterialProcessingHint"}).Matches(undecodable) {
+		t.Fatal("expected a record without an envelope not to match an event type")
+	}
+}
+
+func TestReplayValueKeepsEventIdAndSetsCausationId(t *testing.T) {
+	eventId := uuid.New()
+	replayId := uuid.New()
+	value, err := json.Marshal(eventcontract.EventEnvelope[json.RawMessage]{
+		SchemaVersion: eventcontract.Version,
+		EventId:       eventId,
+		EventType:     "MaterialProcessingHint",
+		Data:          json.RawMessage(`{"materialId":"x","extra":[1,2]}`),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	replayed, err := ReplayValue(value, replayId)
+	if err != nil {
+		t.Fatalf("ReplayValue() error = %v", err)
+	}
+	var envelope eventcontract.EventEnvelope[json.RawMessage]
+	if err := json.Unmarshal(replayed, &envelope); err != nil {
+		t.Fatal(err)
+	}
+	if envelope.EventId != eventId {
+		t.Fatalf("event ID = %s, want %s", envelope.EventId, eventId)
+	}
+	if envelope.CausationId == nil || *envelope.CausationId != replayId {
+		t.Fatalf("causation ID = %v, want %s", envelope.CausationId, replayId)
+	}
+	if string(envelope.Data) != `{"materialId":"x","extra":[1,2]}` {
+		t.Fatalf("data = %s, want the original data", envelope.Data)
+	}
+
+	if _, err := ReplayValue([]byte("not json"), replayId); err == nil {
+		t.Fatal("expected an undecodable value to be rejected")
+	}
+	if _, err := ReplayValue([]byte(`{"eventType":"MaterialProcessingHint"}`), replayId); err == nil {
+		t.Fatal("expected a value without an event ID to be rejected")
+	}
+}
@@ -0,0 +1,20 @@
+package topics
+
+import (
+	"time"
+
+	durablejobeventscontract "github.com/HiIamJeff67/notegic-backend/contracts/durable-job/v1/events"
+)
+
+func DurableJobCoreMaterialProcessingResultTopicSpec() TopicSpec {
+	return TopicSpec{
+		Name:                durablejobeventscontract.DurableJobCoreMaterialProcessingResultTopic.String(),
+		Partitions:          3,
+		ReplicationFactor:   1,
+		Retention:           7 * 24 * time.Hour,
+		CleanupPolicy:       "delete",
+		MinInSyncReplicas:   1,
+		CreateDeadLetter:    true,
+		DeadLetterRetention: 30 * 24 * time.Hour,
+	}
+}
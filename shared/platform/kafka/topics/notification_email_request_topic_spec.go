@@ -0,0 +1,20 @@
+package topics
+
+import (
+	"time"
+
+	emaileventscontract "github.com/HiIamJeff67/notegic-backend/contracts/email/v1/events"
+)
+
+func NotificationEmailRequestTopicSpec() TopicSpec {
+	return TopicSpec{
+		Name:                emaileventscontract.NotificationEmailRequestTopic.String(),
+		Partitions:          3,
+		ReplicationFactor:   1,
+		Retention:           7 * 24 * time.Hour,
+		CleanupPolicy:       "delete",
+		MinInSyncReplicas:   1,
+		CreateDeadLetter:    true,
+		DeadLetterRetention: 30 * 24 * time.Hour,
+	}
+}
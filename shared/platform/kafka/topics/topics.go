@@ -6,13 +6,17 @@ func All() []TopicSpec {
 	return []TopicSpec{
 		CoreLifecycleTopicSpec(),
 		CoreDurableJobYjsMaintenanceHintTopicSpec(),
+		CoreDurableJobMaterialProcessingHintTopicSpec(),
 		CoreNotificationTopicSpec(),
 		CoreDurableJobRoutineTaskTopicSpec(),
 		DurableJobRealtimeGatewayRoutineTaskLifecycleTopicSpec(),
 		DurableJobCoreYjsMaintenanceRequestTopicSpec(),
 		DurableJobCoreYjsMaintenanceResultTopicSpec(),
+		DurableJobCoreMaterialProcessingRequestTopicSpec(),
+		DurableJobCoreMaterialProcessingResultTopicSpec(),
 		CoreEmailRequestTopicSpec(),
 		NotificationTopicSpec(),
+		NotificationEmailRequestTopicSpec(),
 		YjsWorkerCoreCommandTopicSpec(),
 		CoreYjsWorkerReplyTopicSpec(),
 		YjsWorkerCoreMaintenanceCommandTopicSpec(),
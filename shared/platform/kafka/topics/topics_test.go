@@ -4,8 +4,8 @@ import "testing"
 
 func TestAllContainsExplicitUniqueTopicSpecs(t *testing.T) {
 	specifications := All()
-	if len(specifications) != 13 {
-		t.Fatalf("topic spec count = %d, want 13", len(specifications))
+	if len(specifications) != 17 {
+		t.Fatalf("topic spec count = %d, want 17", len(specifications))
 	}
 
 	seen := make(map[string]struct{}, len(specifications))
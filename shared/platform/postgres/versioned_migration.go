@@ -0,0 +1,372 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	logs "github.com/HiIamJeff67/notegic-backend/shared/platform/observability/logs"
+)
+
+const SchemaMigrationsTableName = "schema_migrations"
+
+type VersionedMigrationDirection string
+
+const (
+	VersionedMigrationDirection_Up   VersionedMigrationDirection = "up"
+	VersionedMigrationDirection_Down VersionedMigrationDirection = "down"
+)
+
+// VersionedMigration is a numbered schema change written in frozen SQL, so it
+// never follows the schema structs, and a dry run prints exactly what it runs.
+// A migration without DownSQL is irreversible.
+type VersionedMigration struct {
+	Version int64
+	Name    string
+	UpSQL   string
+	DownSQL string
+	// IsAdopted reports whether the database already has the change of UpSQL, in
+	// which case the migration is only recorded as applied without running it
+	IsAdopted func(tx *gorm.DB) (bool, error)
+	// the values added by ALTER TYPE ... ADD VALUE can not be used in the same
+	// transaction, so the migrations which add enum values run without one
+	DisableTransaction bool
+}
+
+type VersionedMigrationStatus struct {
+	Version   int64
+	Name      string
+	AppliedAt *time.Time
+}
+
+type VersionedMigrationStep struct {
+	Direction VersionedMigrationDirection
+	Migration VersionedMigration
+}
+
+type VersionedMigrator struct {
+	db         *gorm.DB
+	lockName   string
+	migrations []VersionedMigration
+}
+
+type appliedVersionedMigration struct {
+	Version   int64     `gorm:"column:version;"`
+	Name      string    `gorm:"column:name;"`
+	AppliedAt time.Time `gorm:"column:applied_at;"`
+}
+
+func NewVersionedMigrator(db *gorm.DB, lockName string, migrations []VersionedMigration) (*VersionedMigrator, error) {
+	if db == nil {
+		return nil, errors.New("database is required")
+	}
+	if strings.TrimSpace(lockName) == "" {
+		return nil, errors.New("migration lock name is required")
+	}
+	if err := validateVersionedMigrations(migrations); err != nil {
+		return nil, err
+	}
+
+	sortedMigrations := append([]VersionedMigration(nil), migrations...)
+	sort.Slice(sortedMigrations, func(i, j int) bool {
+		return sortedMigrations[i].Version < sortedMigrations[j].Version
+	})
+
+	return &VersionedMigrator{
+		db:         db,
+		lockName:   lockName,
+		migrations: sortedMigrations,
+	}, nil
+}
+
+/* ============================== Public Methods ============================== */
+
+func (m *VersionedMigrator) LatestVersion() int64 {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Status lists every known migration and whether it has been applied
+func (m *VersionedMigrator) Status(ctx context.Context) ([]VersionedMigrationStatus, error) {
+	applied, err := m.appliedMigrations(m.db.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if err := m.checkUnknownAppliedMigrations(applied); err != nil {
+		return nil, err
+	}
+
+	statuses := make([]VersionedMigrationStatus, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := VersionedMigrationStatus{Version: migration.Version, Name: migration.Name}
+		if appliedMigration, exists := applied[migration.Version]; exists {
+			appliedAt := appliedMigration.AppliedAt
+			status.AppliedAt = &appliedAt
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// DownTarget returns the version left after reverting the given number of the
+// most recently applied migrations
+func (m *VersionedMigrator) DownTarget(ctx context.Context, steps int) (int64, error) {
+	if steps <= 0 {
+		return 0, errors.New("the number of steps to migrate down must be positive")
+	}
+
+	applied, err := m.appliedMigrations(m.db.WithContext(ctx))
+	if err != nil {
+		return 0, err
+	}
+
+	versions := make([]int64, 0, len(applied))
+	for version := range applied {
+		versions = append(versions, version)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+	if steps >= len(versions) {
+		return 0, nil
+	}
+	return versions[len(versions)-steps-1], nil
+}
+
+// Plan returns the steps to migrate the database to the target version without
+// changing anything, the target version 0 reverts every migration
+func (m *VersionedMigrator) Plan(ctx context.Context, target int64) ([]VersionedMigrationStep, error) {
+	applied, err := m.appliedMigrations(m.db.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return m.plan(applied, target)
+}
+
+// Apply migrates the database to the target version while holding a session
+// level advisory lock, so only one replica migrates at a time and the others
+// find nothing left to apply once they acquire the lock
+func (m *VersionedMigrator) Apply(ctx context.Context, target int64) ([]VersionedMigrationStep, error) {
+	if logs.NotegicLogger == nil {
+		return nil, errors.New("observability logger is not initialized")
+	}
+
+	var steps []VersionedMigrationStep
+	err := m.db.WithContext(ctx).Connection(func(conn *gorm.DB) (err error) {
+		if err := conn.Exec("SELECT pg_advisory_lock(hashtext(?));", m.lockName).Error; err != nil {
+			return fmt.Errorf("acquire migration lock: %w", err)
+		}
+		defer func() {
+			if unlockErr := conn.Exec("SELECT pg_advisory_unlock(hashtext(?));", m.lockName).Error; unlockErr != nil && err == nil {
+				err = fmt.Errorf("release migration lock: %w", unlockErr)
+			}
+		}()
+
+		if err := conn.Exec(fmt.Sprintf(`
+			CREATE TABLE IF NOT EXISTS %q (
+				version BIGINT PRIMARY KEY,
+				name TEXT NOT NULL,
+				applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+			);
+		`, SchemaMigrationsTableName)).Error; err != nil {
+			return fmt.Errorf("create %s table: %w", SchemaMigrationsTableName, err)
+		}
+
+		applied, err := m.appliedMigrations(conn)
+		if err != nil {
+			return err
+		}
+		steps, err = m.plan(applied, target)
+		if err != nil {
+			return err
+		}
+
+		for _, step := range steps {
+			apply := func(tx *gorm.DB) error { return applyVersionedMigrationStep(tx, step) }
+			if !step.Migration.DisableTransaction {
+				apply = func(db *gorm.DB) error {
+					return db.Transaction(func(tx *gorm.DB) error { return applyVersionedMigrationStep(tx, step) })
+				}
+			}
+			if err := apply(conn); err != nil {
+				return fmt.Errorf("migrate %s %s: %w", step.Direction, versionedMigrationLabel(step.Migration), err)
+			}
+			logs.NotegicLogger.Info(ctx, fmt.Sprintf("Migrated %s %s", step.Direction, versionedMigrationLabel(step.Migration)))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return steps, nil
+}
+
+// WriteVersionedMigrationSQL prints the SQL of the given steps for a dry run
+func WriteVersionedMigrationSQL(writer io.Writer, steps []VersionedMigrationStep) error {
+	for _, step := range steps {
+		if _, err := fmt.Fprintf(writer, "-- %s %s\n", step.Direction, versionedMigrationLabel(step.Migration)); err != nil {
+			return err
+		}
+
+		sql := versionedMigrationSQL(step)
+		if step.Direction == VersionedMigrationDirection_Up && step.Migration.IsAdopted != nil {
+			sql = "-- only recorded when the database already has this change\n" + sql
+		}
+		if _, err := fmt.Fprintf(writer, "%s\n\n", strings.TrimSpace(sql)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecVersionedMigrationSQL runs the statements of the SQL one by one, which are
+// split by the SQL separator
+func ExecVersionedMigrationSQL(tx *gorm.DB, sql string) error {
+	for _, statement := range strings.Split(sql, SQLSeparator) {
+		statement = strings.TrimSpace(statement)
+		if statement == "" {
+			continue
+		}
+		if err := tx.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/* ============================== Auxiliary Functions ============================== */
+
+func validateVersionedMigrations(migrations []VersionedMigration) error {
+	versions := make(map[int64]struct{}, len(migrations))
+	for _, migration := range migrations {
+		if migration.Version <= 0 {
+			return fmt.Errorf("migration %q must have a positive version", migration.Name)
+		}
+		if strings.TrimSpace(migration.Name) == "" {
+			return fmt.Errorf("migration %d must have a name", migration.Version)
+		}
+		if strings.TrimSpace(migration.UpSQL) == "" {
+			return fmt.Errorf("migration %s must have an up path", versionedMigrationLabel(migration))
+		}
+		if _, exists := versions[migration.Version]; exists {
+			return fmt.Errorf("migration version %d is declared more than once", migration.Version)
+		}
+		versions[migration.Version] = struct{}{}
+	}
+	return nil
+}
+
+func (m *VersionedMigrator) appliedMigrations(db *gorm.DB) (map[int64]appliedVersionedMigration, error) {
+	var exists bool
+	if err := db.Raw("SELECT to_regclass(?) IS NOT NULL;", SchemaMigrationsTableName).Scan(&exists).Error; err != nil {
+		return nil, fmt.Errorf("check %s table: %w", SchemaMigrationsTableName, err)
+	}
+
+	applied := make(map[int64]appliedVersionedMigration)
+	if !exists {
+		return applied, nil
+	}
+
+	var rows []appliedVersionedMigration
+	if err := db.Raw(fmt.Sprintf("SELECT version, name, applied_at FROM %q ORDER BY version;", SchemaMigrationsTableName)).
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("list applied migrations: %w", err)
+	}
+	for _, row := range rows {
+		applied[row.Version] = row
+	}
+	return applied, nil
+}
+
+func (m *VersionedMigrator) checkUnknownAppliedMigrations(applied map[int64]appliedVersionedMigration) error {
+	known := make(map[int64]struct{}, len(m.migrations))
+	for _, migration := range m.migrations {
+		known[migration.Version] = struct{}{}
+	}
+	for version, appliedMigration := range applied {
+		if _, exists := known[version]; !exists {
+			return fmt.Errorf("applied migration %04d_%s is unknown to this build", version, appliedMigration.Name)
+		}
+	}
+	return nil
+}
+
+func (m *VersionedMigrator) plan(applied map[int64]appliedVersionedMigration, target int64) ([]VersionedMigrationStep, error) {
+	if err := m.checkUnknownAppliedMigrations(applied); err != nil {
+		return nil, err
+	}
+	if target != 0 {
+		found := false
+		for _, migration := range m.migrations {
+			if migration.Version == target {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("migration version %d does not exist", target)
+		}
+	}
+
+	steps := make([]VersionedMigrationStep, 0)
+	for index := len(m.migrations) - 1; index >= 0; index-- {
+		migration := m.migrations[index]
+		if _, exists := applied[migration.Version]; !exists || migration.Version <= target {
+			continue
+		}
+		if strings.TrimSpace(migration.DownSQL) == "" {
+			return nil, fmt.Errorf("migration %s is irreversible", versionedMigrationLabel(migration))
+		}
+		steps = append(steps, VersionedMigrationStep{Direction: VersionedMigrationDirection_Down, Migration: migration})
+	}
+	for _, migration := range m.migrations {
+		if _, exists := applied[migration.Version]; exists || migration.Version > target {
+			continue
+		}
+		steps = append(steps, VersionedMigrationStep{Direction: VersionedMigrationDirection_Up, Migration: migration})
+	}
+	return steps, nil
+}
+
+func applyVersionedMigrationStep(tx *gorm.DB, step VersionedMigrationStep) error {
+	migration := step.Migration
+
+	isAdopted := false
+	if step.Direction == VersionedMigrationDirection_Up && migration.IsAdopted != nil {
+		var err error
+		if isAdopted, err = migration.IsAdopted(tx); err != nil {
+			return fmt.Errorf("check the existing change: %w", err)
+		}
+	}
+	if !isAdopted {
+		if err := ExecVersionedMigrationSQL(tx, versionedMigrationSQL(step)); err != nil {
+			return err
+		}
+	}
+
+	if step.Direction == VersionedMigrationDirection_Down {
+		return tx.Exec(fmt.Sprintf("DELETE FROM %q WHERE version = ?;", SchemaMigrationsTableName), migration.Version).Error
+	}
+	return tx.Exec(
+		fmt.Sprintf("INSERT INTO %q (version, name) VALUES (?, ?);", SchemaMigrationsTableName),
+		migration.Version, migration.Name,
+	).Error
+}
+
+func versionedMigrationSQL(step VersionedMigrationStep) string {
+	if step.Direction == VersionedMigrationDirection_Down {
+		return step.Migration.DownSQL
+	}
+	return step.Migration.UpSQL
+}
+
+func versionedMigrationLabel(migration VersionedMigration) string {
+	return fmt.Sprintf("%04d_%s", migration.Version, migration.Name)
+}
@@ -0,0 +1,147 @@
+package postgres
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"gorm.io/gorm"
+)
+
+func testVersionedMigrator(t *testing.T) *VersionedMigrator {
+	t.Helper()
+
+	migrator, err := NewVersionedMigrator(&gorm.DB{}, "test", []VersionedMigration{
+		{Version: 3, Name: "drop_legacy_column", UpSQL: "ALTER TABLE t DROP COLUMN c;"},
+		{
+			Version:   1,
+			Name:      "baseline",
+			UpSQL:     "CREATE TABLE t (c INT);",
+			DownSQL:   "DROP TABLE t;",
+			IsAdopted: func(*gorm.DB) (bool, error) { return false, nil },
+		},
+		{Version: 2, Name: "add_index", UpSQL: "CREATE INDEX i ON t (c);", DownSQL: "DROP INDEX i;"},
+	})
+	if err != nil {
+		t.Fatalf("NewVersionedMigrator() error = %v", err)
+	}
+	return migrator
+}
+
+func stepLabels(steps []VersionedMigrationStep) string {
+	labels := make([]string, 0, len(steps))
+	for _, step := range steps {
+		labels = append(labels, string(step.Direction)+" "+versionedMigrationLabel(step.Migration))
+	}
+	return strings.Join(labels, ", ")
+}
+
+func TestNewVersionedMigratorRejectsInvalidMigrations(t *testing.T) {
+	for name, migrations := range map[string][]VersionedMigration{
+		"duplicate version": {
+			{Version: 1, Name: "a", UpSQL: "SELECT 1;"},
+			{Version: 1, Name: "b", UpSQL: "SELECT 1;"},
+		},
+		"non positive version": {{Version: 0, Name: "a", UpSQL: "SELECT 1;"}},
+		"missing name":         {{Version: 1, UpSQL: "SELECT 1;"}},
+		"missing up path":      {{Version: 1, Name: "a", DownSQL: "SELECT 1;"}},
+		"adopted without up path": {{
+			Version:   1,
+			Name:      "a",
+			IsAdopted: func(*gorm.DB) (bool, error) { return true, nil },
+		}},
+	} {
+		if _, err := NewVersionedMigrator(&gorm.DB{}, "test", migrations); err == nil {
+			t.Errorf("%s: NewVersionedMigrator() error = nil", name)
+		}
+	}
+}
+
+func TestVersionedMigratorPlan(t *testing.T) {
+	migrator := testVersionedMigrator(t)
+	if got := migrator.LatestVersion(); got != 3 {
+		t.Fatalf("LatestVersion() = %d, want 3", got)
+	}
+
+	tests := []struct {
+		name    string
+		applied []int64
+		target  int64
+		want    string
+	}{
+		{name: "up from empty", target: 3, want: "up 0001_baseline, up 0002_add_index, up 0003_drop_legacy_column"},
+		{name: "up to middle", applied: []int64{1}, target: 2, want: "up 0002_add_index"},
+		{name: "down to baseline", applied: []int64{1, 2}, target: 1, want: "down 0002_add_index"},
+		{name: "down to empty", applied: []int64{1, 2}, target: 0, want: "down 0002_add_index, down 0001_baseline"},
+		{name: "up fills gaps", applied: []int64{1, 3}, target: 3, want: "up 0002_add_index"},
+		{name: "nothing to do", applied: []int64{1, 2, 3}, target: 3, want: ""},
+	}
+	for _, test := range tests {
+		applied := make(map[int64]appliedVersionedMigration, len(test.applied))
+		for _, version := range test.applied {
+			applied[version] = appliedVersionedMigration{Version: version}
+		}
+
+		steps, err := migrator.plan(applied, test.target)
+		if err != nil {
+			t.Fatalf("%s: plan() error = %v", test.name, err)
+		}
+		if got := stepLabels(steps); got != test.want {
+			t.Errorf("%s: plan() = %q, want %q", test.name, got, test.want)
+		}
+	}
+}
+
+func TestVersionedMigratorPlanRejectsUnsafeTargets(t *testing.T) {
+	migrator := testVersionedMigrator(t)
+
+	if _, err := migrator.plan(map[int64]appliedVersionedMigration{1: {Version: 1}, 2: {Version: 2}, 3: {Version: 3}}, 2); err == nil ||
+		!strings.Contains(err.Error(), "irreversible") {
+		t.Errorf("plan() past an irreversible migration error = %v", err)
+	}
+	if _, err := migrator.plan(map[int64]appliedVersionedMigration{}, 4); err == nil {
+		t.Error("plan() to an unknown version error = nil")
+	}
+	if _, err := migrator.plan(map[int64]appliedVersionedMigration{9: {Version: 9, Name: "future"}}, 3); err == nil ||
+		!strings.Contains(err.Error(), "0009_future") {
+		t.Errorf("plan() with an unknown applied migration error = %v", err)
+	}
+}
+
+func TestWriteVersionedMigrationSQL(t *testing.T) {
+	migrator := testVersionedMigrator(t)
+	steps, err := migrator.plan(map[int64]appliedVersionedMigration{}, 2)
+	if err != nil {
+		t.Fatalf("plan() error = %v", err)
+	}
+
+	var buffer bytes.Buffer
+	if err := WriteVersionedMigrationSQL(&buffer, steps); err != nil {
+		t.Fatalf("WriteVersionedMigrationSQL() error = %v", err)
+	}
+
+	want := "-- up 0001_baseline\n-- only recorded when the database already has this change\nCREATE TABLE t (c INT);\n\n" +
+		"-- up 0002_add_index\nCREATE INDEX i ON t (c);\n\n"
+	if got := buffer.String(); got != want {
+		t.Fatalf("WriteVersionedMigrationSQL() = %q, want %q", got, want)
+	}
+}
+
+func TestWriteVersionedMigrationSQLPrintsTheDownSQL(t *testing.T) {
+	migrator := testVersionedMigrator(t)
+	steps, err := migrator.plan(map[int64]appliedVersionedMigration{1: {Version: 1}, 2: {Version: 2}}, 0)
+	if err != nil {
+		t.Fatalf("plan() error = %v", err)
+	}
+
+	var buffer bytes.Buffer
+	if err := WriteVersionedMigrationSQL(&buffer, steps); err != nil {
+		t.Fatalf("WriteVersionedMigrationSQL() error = %v", err)
+	}
+
+	want := "-- down 0002_add_index\nDROP INDEX i;\n\n" +
+		"-- down 0001_baseline\nDROP TABLE t;\n\n"
+	if got := buffer.String(); got != want {
+		t.Fatalf("WriteVersionedMigrationSQL() = %q, want %q", got, want)
+	}
+}
@@ -15,6 +15,7 @@ type AccessTokenClaims struct {
 	Name      string `json:"name" validate:"required,min=6,max=16,alphaandnum"`
 	Email     string `json:"email" validate:"required,email"`
 	UserAgent string `json:"userAgent" validate:"required"`
+	SessionId string `json:"sessionId" validate:"required,uuid4"`
 	jwt.RegisteredClaims
 }
 
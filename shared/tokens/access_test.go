@@ -11,6 +11,7 @@ func TestAccessTokenRoundTrip(t *testing.T) {
 			Name:      "notegic",
 			Email:     "notegic@example.com",
 			UserAgent: "test-agent",
+			SessionId: "2f1c4f6e-8f0a-4d6b-9c1e-5a7d3b9e2c10",
 		},
 	)
 	if err != nil {
@@ -21,7 +22,7 @@ func TestAccessTokenRoundTrip(t *testing.T) {
 	if err != nil {
 		t.Fatalf("parse access token: %v", err)
 	}
-	if claims.Name != "notegic" || claims.Email != "notegic@example.com" || claims.UserAgent != "test-agent" {
+	if claims.Name != "notegic" || claims.Email != "notegic@example.com" || claims.UserAgent != "test-agent" || claims.SessionId != "2f1c4f6e-8f0a-4d6b-9c1e-5a7d3b9e2c10" {
 		t.Fatalf("unexpected access token claims: %#v", claims)
 	}
 	if claims.Subject != "83bdeac1-02de-42fe-a7a8-4e1a83174866" {
@@ -0,0 +1,39 @@
+package tokens
+
+import "time"
+
+const (
+	APIKeyRateLimitBurstWindow     = 10 * time.Second
+	APIKeyRateLimitSustainedWindow = time.Hour
+)
+
+// APIKeyRateLimitPolicy is the request budget of one API key. Core derives it
+// from the PlanLimitation of the key owner when the key is authenticated and
+// publishes it by key hash, so APIGateway can enforce it before calling Core.
+type APIKeyRateLimitPolicy struct {
+	Plan            string        `json:"plan"`
+	BurstLimit      int32         `json:"burstLimit"`
+	BurstWindow     time.Duration `json:"burstWindow"`
+	SustainedLimit  int32         `json:"sustainedLimit"`
+	SustainedWindow time.Duration `json:"sustainedWindow"`
+}
+
+// DefaultAPIKeyRateLimitPolicy is applied to keys whose policy has not been
+// published yet, and matches the seeded Free plan.
+func DefaultAPIKeyRateLimitPolicy() APIKeyRateLimitPolicy {
+	return NewAPIKeyRateLimitPolicy("Free", 20, 1000)
+}
+
+func NewAPIKeyRateLimitPolicy(plan string, burstLimit int32, sustainedLimit int32) APIKeyRateLimitPolicy {
+	return APIKeyRateLimitPolicy{
+		Plan:            plan,
+		BurstLimit:      burstLimit,
+		BurstWindow:     APIKeyRateLimitBurstWindow,
+		SustainedLimit:  sustainedLimit,
+		SustainedWindow: APIKeyRateLimitSustainedWindow,
+	}
+}
+
+func (p APIKeyRateLimitPolicy) IsValid() bool {
+	return p.BurstLimit > 0 && p.BurstWindow > 0 && p.SustainedLimit > 0 && p.SustainedWindow > 0
+}
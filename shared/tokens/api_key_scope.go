@@ -0,0 +1,75 @@
+package tokens
+
+import (
+	"errors"
+	"slices"
+	"strings"
+)
+
+// An API key scope is "<resource>:<action>", e.g. "block-pack:read". A key
+// without scopes keeps the full rights of its owner.
+const (
+	APIKeyScopeActionRead   = "read"
+	APIKeyScopeActionCreate = "create"
+	APIKeyScopeActionUpdate = "update"
+	APIKeyScopeActionDelete = "delete"
+	APIKeyScopeActionManage = "manage"
+)
+
+var APIKeyScopeResources = []string{
+	"root-shelf",
+	"sub-shelf",
+	"block-pack",
+	"block",
+	"material",
+	"station",
+	"routine",
+	"routine-tag",
+	"routine-task",
+	"routine-task-record",
+}
+
+var APIKeyScopeActions = []string{
+	APIKeyScopeActionRead,
+	APIKeyScopeActionCreate,
+	APIKeyScopeActionUpdate,
+	APIKeyScopeActionDelete,
+	APIKeyScopeActionManage,
+}
+
+func ValidateAPIKeyScope(scope string) error {
+	resource, action, found := strings.Cut(scope, ":")
+	if !found || !slices.Contains(APIKeyScopeResources, resource) || !slices.Contains(APIKeyScopeActions, action) {
+		return errors.New("invalid API key scope")
+	}
+	return nil
+}
+
+// GetRequiredAPIKeyScope derives the scope an operation such as
+// "block-pack.get-by-id" requires from its resource and verb.
+func GetRequiredAPIKeyScope(operation string) (string, error) {
+	resource, verb, found := strings.Cut(operation, ".")
+	if !found || verb == "" || !slices.Contains(APIKeyScopeResources, resource) {
+		return "", errors.New("operation is not available to API keys")
+	}
+
+	action := APIKeyScopeActionUpdate
+	switch {
+	case strings.HasPrefix(verb, "permission."),
+		strings.HasPrefix(verb, "ownership."),
+		strings.HasPrefix(verb, "membership."):
+		action = APIKeyScopeActionManage
+	case strings.HasPrefix(verb, "get"), strings.HasPrefix(verb, "visualize"):
+		action = APIKeyScopeActionRead
+	case strings.HasPrefix(verb, "create"):
+		action = APIKeyScopeActionCreate
+	case strings.HasPrefix(verb, "delete"), strings.HasPrefix(verb, "hard-delete"):
+		action = APIKeyScopeActionDelete
+	}
+
+	return resource + ":" + action, nil
+}
+
+func IsAPIKeyScopeGranted(scopes []string, requiredScope string) bool {
+	return len(scopes) == 0 || slices.Contains(scopes, requiredScope)
+}
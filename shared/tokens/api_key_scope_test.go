@@ -0,0 +1,67 @@
+package tokens
+
+import (
+	"testing"
+
+	blockpackcontract "github.com/HiIamJeff67/notegic-backend/contracts/core/v1/api/block-packs"
+)
+
+func TestGetRequiredAPIKeyScope(t *testing.T) {
+	cases := map[string]string{
+		"block-pack.get-by-id":                           "block-pack:read",
+		"routine.visualize-status-count":                 "routine:read",
+		"routine-task.create-by-routine-id":              "routine-task:create",
+		"station.hard-delete-many":                       "station:delete",
+		"sub-shelf.delete":                               "sub-shelf:delete",
+		"material.move-many":                             "material:update",
+		"routine-task.pause":                             "routine-task:update",
+		"root-shelf.permission.get":                      "root-shelf:manage",
+		"station.membership.leave":                       "station:manage",
+		"root-shelf.ownership.transfer":                  "root-shelf:manage",
+		"routine-task-record.get-all-by-routine-task-id": "routine-task-record:read",
+
+		// the snapshot operations lead with their verbs, so the verb prefixes derive their actions
+		blockpackcontract.CreateMyBlockPackSnapshotByIdOperation:  "block-pack:create",
+		blockpackcontract.GetMyBlockPackSnapshotsByIdOperation:    "block-pack:read",
+		blockpackcontract.GetMyBlockPackSnapshotDiffByIdOperation: "block-pack:read",
+		blockpackcontract.RestoreMyBlockPackSnapshotByIdOperation: "block-pack:update",
+	}
+	for operation, expected := range cases {
+		scope, err := GetRequiredAPIKeyScope(operation)
+		if err != nil {
+			t.Fatalf("get required scope of %s: %v", operation, err)
+		}
+		if scope != expected {
+			t.Fatalf("expected %s to require %s, got %s", operation, expected, scope)
+		}
+		if err := ValidateAPIKeyScope(scope); err != nil {
+			t.Fatalf("derived scope %s is invalid: %v", scope, err)
+		}
+	}
+
+	for _, operation := range []string{"auth.login", "api-key.create", "block-pack", ""} {
+		if _, err := GetRequiredAPIKeyScope(operation); err == nil {
+			t.Fatalf("expected %q to be unavailable to API keys", operation)
+		}
+	}
+}
+
+func TestValidateAPIKeyScope(t *testing.T) {
+	for _, scope := range []string{"block-pack:write", "user:read", "block-pack", ":read", "block-pack:read:extra"} {
+		if err := ValidateAPIKeyScope(scope); err == nil {
+			t.Fatalf("expected %q to be invalid", scope)
+		}
+	}
+}
+
+func TestIsAPIKeyScopeGranted(t *testing.T) {
+	if !IsAPIKeyScopeGranted(nil, "block-pack:read") {
+		t.Fatal("expected an unscoped key to keep the full rights of its owner")
+	}
+	if !IsAPIKeyScopeGranted([]string{"block-pack:read", "routine-task:create"}, "routine-task:create") {
+		t.Fatal("expected a granted scope to pass")
+	}
+	if IsAPIKeyScopeGranted([]string{"block-pack:read"}, "block-pack:update") {
+		t.Fatal("expected a read-only key to reject updates")
+	}
+}
@@ -14,6 +14,7 @@ type DelegationTokenClaims struct {
 	GatewaySource      string   `json:"gatewaySource,omitempty"`
 	AuthMethod         string   `json:"authMethod,omitempty"`
 	ApiKeyId           string   `json:"apiKeyId,omitempty"`
+	APIKeyScope        string   `json:"apiKeyScope,omitempty"`
 	UserSubject        string   `json:"userSubject,omitempty"`
 	AllowedPermissions []string `json:"allowedPermissions"`
 	Operation          string   `json:"operation"`
@@ -25,8 +26,9 @@ const (
 	GatewaySourceClient = "client"
 	GatewaySourceAPI    = "api"
 
-	AuthMethodJWT    = "jwt"
-	AuthMethodAPIKey = "api-key"
+	AuthMethodJWT       = "jwt"
+	AuthMethodAPIKey    = "api-key"
+	AuthMethodShareLink = "share-link"
 )
 
 func GenerateDelegationToken(claims DelegationTokenClaims) (*string, error) {
@@ -36,7 +38,7 @@ func GenerateDelegationToken(claims DelegationTokenClaims) (*string, error) {
 	if claims.GatewaySource != "" && claims.GatewaySource != GatewaySourceClient && claims.GatewaySource != GatewaySourceAPI {
 		return nil, errors.New("delegation gateway source is invalid")
 	}
-	if claims.AuthMethod != "" && claims.AuthMethod != AuthMethodJWT && claims.AuthMethod != AuthMethodAPIKey {
+	if claims.AuthMethod != "" && claims.AuthMethod != AuthMethodJWT && claims.AuthMethod != AuthMethodAPIKey && claims.AuthMethod != AuthMethodShareLink {
 		return nil, errors.New("delegation auth method is invalid")
 	}
 	if claims.GatewaySource == GatewaySourceAPI && claims.AuthMethod != AuthMethodAPIKey {
@@ -45,6 +47,12 @@ func GenerateDelegationToken(claims DelegationTokenClaims) (*string, error) {
 	if claims.GatewaySource == GatewaySourceClient && claims.AuthMethod == AuthMethodAPIKey {
 		return nil, errors.New("client gateway delegation cannot use api key authentication")
 	}
+	if claims.AuthMethod == AuthMethodShareLink && (claims.GatewaySource != GatewaySourceClient || claims.UserSubject == "") {
+		return nil, errors.New("share link delegation requires the client gateway and a user subject")
+	}
+	if claims.APIKeyScope != "" && (claims.AuthMethod != AuthMethodAPIKey || ValidateAPIKeyScope(claims.APIKeyScope) != nil) {
+		return nil, errors.New("delegation api key scope is invalid")
+	}
 	if claims.UserSubject != "" {
 		if _, err := uuid.Parse(claims.UserSubject); err != nil {
 			return nil, errors.New("delegation user subject is invalid")
@@ -91,7 +99,7 @@ func ParseDelegationToken(tokenString string) (*DelegationTokenClaims, error) {
 	if claims.GatewaySource != "" && claims.GatewaySource != GatewaySourceClient && claims.GatewaySource != GatewaySourceAPI {
 		return nil, errors.New("delegation gateway source is invalid")
 	}
-	if claims.AuthMethod != "" && claims.AuthMethod != AuthMethodJWT && claims.AuthMethod != AuthMethodAPIKey {
+	if claims.AuthMethod != "" && claims.AuthMethod != AuthMethodJWT && claims.AuthMethod != AuthMethodAPIKey && claims.AuthMethod != AuthMethodShareLink {
 		return nil, errors.New("delegation auth method is invalid")
 	}
 	if claims.GatewaySource == GatewaySourceAPI && claims.AuthMethod != AuthMethodAPIKey {
@@ -100,6 +108,12 @@ func ParseDelegationToken(tokenString string) (*DelegationTokenClaims, error) {
 	if claims.GatewaySource == GatewaySourceClient && claims.AuthMethod == AuthMethodAPIKey {
 		return nil, errors.New("client gateway delegation cannot use api key authentication")
 	}
+	if claims.AuthMethod == AuthMethodShareLink && (claims.GatewaySource != GatewaySourceClient || claims.UserSubject == "") {
+		return nil, errors.New("share link delegation requires the client gateway and a user subject")
+	}
+	if claims.APIKeyScope != "" && (claims.AuthMethod != AuthMethodAPIKey || ValidateAPIKeyScope(claims.APIKeyScope) != nil) {
+		return nil, errors.New("delegation api key scope is invalid")
+	}
 	if claims.Subject != claims.UserSubject {
 		return nil, errors.New("delegation token claims are invalid")
 	}
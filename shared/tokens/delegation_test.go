@@ -76,3 +76,71 @@ func TestDelegationTokenCarriesGatewaySourceAndAPIKeyMetadata(t *testing.T) {
 		t.Fatalf("unexpected API delegation metadata: %+v", claims)
 	}
 }
+
+func TestDelegationTokenCarriesAPIKeyScope(t *testing.T) {
+	t.Setenv("CORE_DELEGATION_SECRET", "delegation-secret")
+	t.Setenv("CORE_DELEGATION_AUDIENCE", "core")
+	t.Setenv("CORE_DELEGATION_ISSUER", "gateway")
+	token, err := GenerateDelegationToken(DelegationTokenClaims{
+		Actor:         "gateway",
+		GatewaySource: GatewaySourceAPI,
+		AuthMethod:    AuthMethodAPIKey,
+		APIKeyScope:   "block-pack:read",
+		Operation:     "block-pack.get-by-id",
+		RequestId:     "request-id",
+	})
+	if err != nil {
+		t.Fatalf("generate scoped API delegation token: %v", err)
+	}
+	claims, err := ParseDelegationToken(*token)
+	if err != nil {
+		t.Fatalf("parse scoped API delegation token: %v", err)
+	}
+	if claims.APIKeyScope != "block-pack:read" {
+		t.Fatalf("unexpected API key scope: %+v", claims)
+	}
+
+	if _, err := GenerateDelegationToken(DelegationTokenClaims{
+		Actor:       "gateway",
+		AuthMethod:  AuthMethodJWT,
+		APIKeyScope: "block-pack:read",
+		Operation:   "block-pack.get-by-id",
+		RequestId:   "request-id",
+	}); err == nil {
+		t.Fatal("expected a JWT delegation with an API key scope to be rejected")
+	}
+}
+
+func TestDelegationTokenShareLinkRequiresClientGatewayAndUserSubject(t *testing.T) {
+	t.Setenv("CORE_DELEGATION_SECRET", "delegation-secret")
+	t.Setenv("CORE_DELEGATION_AUDIENCE", "core")
+	t.Setenv("CORE_DELEGATION_ISSUER", "gateway")
+	token, err := GenerateDelegationToken(DelegationTokenClaims{
+		Actor:         "gateway",
+		GatewaySource: GatewaySourceClient,
+		AuthMethod:    AuthMethodShareLink,
+		UserSubject:   "83bdeac1-02de-42fe-a7a8-4e1a83174866",
+		Operation:     "block-pack.get-by-id",
+		RequestId:     "request-id",
+	})
+	if err != nil {
+		t.Fatalf("generate share link delegation token: %v", err)
+	}
+	claims, err := ParseDelegationToken(*token)
+	if err != nil {
+		t.Fatalf("parse share link delegation token: %v", err)
+	}
+	if claims.AuthMethod != AuthMethodShareLink {
+		t.Fatalf("unexpected share link delegation metadata: %+v", claims)
+	}
+
+	if _, err := GenerateDelegationToken(DelegationTokenClaims{
+		Actor:         "gateway",
+		GatewaySource: GatewaySourceClient,
+		AuthMethod:    AuthMethodShareLink,
+		Operation:     "block-pack.get-by-id",
+		RequestId:     "request-id",
+	}); err == nil {
+		t.Fatal("expected a share link delegation without a user subject to be rejected")
+	}
+}
@@ -0,0 +1,64 @@
+package tokens
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// The unsubscribe link of a digest email can be opened long after the email is sent, so it outlives the weekly
+// digest by far, and it can only turn the digest of its subject off.
+const NotificationDigestUnsubscribeTokenExpiresIn time.Duration = 60 * 24 * time.Hour
+
+type NotificationDigestUnsubscribeTokenClaims struct {
+	jwt.RegisteredClaims
+}
+
+// GenerateNotificationDigestUnsubscribeToken is issued by Notification for every digest email, and redeemed by the
+// client gateway, which delegates the unsubscription on behalf of the subject without a session.
+func GenerateNotificationDigestUnsubscribeToken(userPublicId uuid.UUID, issuedAt time.Time) (*string, error) {
+	if userPublicId == uuid.Nil {
+		return nil, errors.New("notification digest unsubscribe token subject is required")
+	}
+
+	secret := os.Getenv("JWT_NOTIFICATION_DIGEST_UNSUBSCRIBE_TOKEN_SECRET_KEY")
+	if secret == "" {
+		return nil, errors.New("notification digest unsubscribe token secret is required")
+	}
+
+	claims := NotificationDigestUnsubscribeTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(issuedAt.Add(NotificationDigestUnsubscribeTokenExpiresIn)),
+			ID:        uuid.NewString(),
+			IssuedAt:  jwt.NewNumericDate(issuedAt),
+			Subject:   userPublicId.String(),
+		},
+	}
+	token, err := SignJWT(secret, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func ParseNotificationDigestUnsubscribeToken(tokenString string) (*uuid.UUID, error) {
+	secret := os.Getenv("JWT_NOTIFICATION_DIGEST_UNSUBSCRIBE_TOKEN_SECRET_KEY")
+	if secret == "" {
+		return nil, errors.New("notification digest unsubscribe token secret is required")
+	}
+
+	claims := &NotificationDigestUnsubscribeTokenClaims{}
+	if err := ParseJWT(secret, tokenString, claims, jwt.WithExpirationRequired()); err != nil {
+		return nil, err
+	}
+	userPublicId, err := uuid.Parse(claims.Subject)
+	if err != nil || userPublicId == uuid.Nil {
+		return nil, errors.New("notification digest unsubscribe token subject is invalid")
+	}
+
+	return &userPublicId, nil
+}
@@ -0,0 +1,41 @@
+package tokens
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNotificationDigestUnsubscribeTokenRoundTrip(t *testing.T) {
+	t.Setenv("JWT_NOTIFICATION_DIGEST_UNSUBSCRIBE_TOKEN_SECRET_KEY", "test-digest-unsubscribe-secret")
+
+	userPublicId := uuid.New()
+	token, err := GenerateNotificationDigestUnsubscribeToken(userPublicId, time.Now())
+	if err != nil {
+		t.Fatalf("generate notification digest unsubscribe token: %v", err)
+	}
+	subject, err := ParseNotificationDigestUnsubscribeToken(*token)
+	if err != nil {
+		t.Fatalf("parse notification digest unsubscribe token: %v", err)
+	}
+	if *subject != userPublicId {
+		t.Fatalf("subject = %s, want %s", subject, userPublicId)
+	}
+
+	expiredToken, err := GenerateNotificationDigestUnsubscribeToken(
+		userPublicId,
+		time.Now().Add(-NotificationDigestUnsubscribeTokenExpiresIn-time.Minute),
+	)
+	if err != nil {
+		t.Fatalf("generate expired notification digest unsubscribe token: %v", err)
+	}
+	if _, err := ParseNotificationDigestUnsubscribeToken(*expiredToken); err == nil {
+		t.Fatal("expected an expired unsubscribe token to be rejected")
+	}
+
+	t.Setenv("JWT_NOTIFICATION_DIGEST_UNSUBSCRIBE_TOKEN_SECRET_KEY", "another-digest-unsubscribe-secret")
+	if _, err := ParseNotificationDigestUnsubscribeToken(*token); err == nil {
+		t.Fatal("expected an unsubscribe token signed with another secret to be rejected")
+	}
+}
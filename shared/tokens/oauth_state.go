@@ -0,0 +1,104 @@
+package tokens
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"os"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+const OAuthStateTokenExpiresIn time.Duration = 10 * time.Minute
+
+// The state of an OAuth authorization travels through the browser of the user and the
+// OAuth provider, so it is only a random nonce. The state token carries that nonce and the
+// random PKCE code verifier, and is kept in an HttpOnly cookie of the browser which started
+// the authorization, so a callback is only accepted from that browser, the code verifier
+// never appears in any URL, and the cookie is cleared by the first callback which uses it.
+type OAuthStateTokenClaims struct {
+	Provider     string `json:"provider" validate:"required"`
+	UserAgent    string `json:"userAgent" validate:"required"`
+	State        string `json:"state" validate:"required"`
+	CodeVerifier string `json:"codeVerifier" validate:"required"`
+	jwt.RegisteredClaims
+}
+
+// GenerateOAuthStateToken returns the state token, the state nonce sent to the OAuth provider,
+// and the PKCE code verifier, where the nonce and the verifier are generated here.
+func GenerateOAuthStateToken(claims OAuthStateTokenClaims) (*string, string, string, time.Time, error) {
+	if claims.Provider == "" || claims.UserAgent == "" {
+		return nil, "", "", time.Time{}, errors.New("OAuth state token claims are invalid")
+	}
+
+	secret := os.Getenv("JWT_OAUTH_STATE_TOKEN_SECRET_KEY")
+	if secret == "" {
+		return nil, "", "", time.Time{}, errors.New("OAuth state token secret is required")
+	}
+
+	state, err := generateOAuthRandomString()
+	if err != nil {
+		return nil, "", "", time.Time{}, err
+	}
+	// RFC 7636 requires 43 to 128 unreserved characters, which 32 random bytes encode to
+	codeVerifier, err := generateOAuthRandomString()
+	if err != nil {
+		return nil, "", "", time.Time{}, err
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(OAuthStateTokenExpiresIn)
+	claims.State = state
+	claims.CodeVerifier = codeVerifier
+	claims.RegisteredClaims = jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+		ID:        uuid.NewString(),
+		IssuedAt:  jwt.NewNumericDate(now),
+	}
+
+	token, err := SignJWT(secret, claims)
+	if err != nil {
+		return nil, "", "", time.Time{}, err
+	}
+
+	return &token, state, codeVerifier, expiresAt, nil
+}
+
+// ParseOAuthStateToken parses the state token from the cookie of the browser, and only accepts it
+// together with the state returned by the OAuth provider to the same browser.
+func ParseOAuthStateToken(tokenString string, state string) (*OAuthStateTokenClaims, error) {
+	secret := os.Getenv("JWT_OAUTH_STATE_TOKEN_SECRET_KEY")
+	if secret == "" {
+		return nil, errors.New("OAuth state token secret is required")
+	}
+	if tokenString == "" || state == "" {
+		return nil, errors.New("OAuth state and state token are required")
+	}
+
+	claims := &OAuthStateTokenClaims{}
+	if err := ParseJWT(secret, tokenString, claims, jwt.WithExpirationRequired()); err != nil {
+		return nil, err
+	}
+	if _, err := uuid.Parse(claims.ID); err != nil {
+		return nil, errors.New("OAuth state token ID is invalid")
+	}
+	if claims.Provider == "" || claims.UserAgent == "" || claims.State == "" || claims.CodeVerifier == "" {
+		return nil, errors.New("OAuth state token claims are invalid")
+	}
+	if subtle.ConstantTimeCompare([]byte(claims.State), []byte(state)) != 1 {
+		return nil, errors.New("OAuth state does not match the state token")
+	}
+
+	return claims, nil
+}
+
+func generateOAuthRandomString() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
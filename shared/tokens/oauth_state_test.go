@@ -0,0 +1,65 @@
+package tokens
+
+import "testing"
+
+func TestOAuthStateTokenRoundTrip(t *testing.T) {
+	t.Setenv("JWT_OAUTH_STATE_TOKEN_SECRET_KEY", "test-oauth-state-secret")
+
+	token, state, codeVerifier, expiresAt, err := GenerateOAuthStateToken(OAuthStateTokenClaims{
+		Provider:  "GitHub",
+		UserAgent: "test-agent",
+	})
+	if err != nil {
+		t.Fatalf("generate OAuth state token: %v", err)
+	}
+	if expiresAt.IsZero() {
+		t.Fatal("expected the OAuth state token to expire")
+	}
+	// RFC 7636 requires 43 to 128 unreserved characters
+	if len(codeVerifier) < 43 || len(codeVerifier) > 128 {
+		t.Fatalf("unexpected OAuth code verifier length %d", len(codeVerifier))
+	}
+
+	claims, err := ParseOAuthStateToken(*token, state)
+	if err != nil {
+		t.Fatalf("parse OAuth state token: %v", err)
+	}
+	if claims.Provider != "GitHub" || claims.UserAgent != "test-agent" || claims.CodeVerifier != codeVerifier {
+		t.Fatalf("unexpected OAuth state token claims: %#v", claims)
+	}
+
+	t.Setenv("JWT_OAUTH_STATE_TOKEN_SECRET_KEY", "another-oauth-state-secret")
+	if _, err := ParseOAuthStateToken(*token, state); err == nil {
+		t.Fatal("expected an OAuth state token signed by another secret to be rejected")
+	}
+}
+
+func TestOAuthStateTokenIsBoundToItsState(t *testing.T) {
+	t.Setenv("JWT_OAUTH_STATE_TOKEN_SECRET_KEY", "test-oauth-state-secret")
+
+	first, firstState, firstVerifier, _, err := GenerateOAuthStateToken(OAuthStateTokenClaims{Provider: "Google", UserAgent: "test-agent"})
+	if err != nil {
+		t.Fatalf("generate OAuth state token: %v", err)
+	}
+	second, secondState, secondVerifier, _, err := GenerateOAuthStateToken(OAuthStateTokenClaims{Provider: "Google", UserAgent: "test-agent"})
+	if err != nil {
+		t.Fatalf("generate OAuth state token: %v", err)
+	}
+	if firstState == secondState || firstVerifier == secondVerifier {
+		t.Fatal("expected every authorization to have its own random state and code verifier")
+	}
+
+	// the state of one browser is never accepted with the cookie of another browser
+	if _, err := ParseOAuthStateToken(*first, secondState); err == nil {
+		t.Fatal("expected the state of another authorization to be rejected")
+	}
+	if _, err := ParseOAuthStateToken(*second, firstState); err == nil {
+		t.Fatal("expected the state of another authorization to be rejected")
+	}
+	if _, err := ParseOAuthStateToken("", firstState); err == nil {
+		t.Fatal("expected a missing state token to be rejected")
+	}
+	if _, err := ParseOAuthStateToken(*first, ""); err == nil {
+		t.Fatal("expected a missing state to be rejected")
+	}
+}
@@ -25,9 +25,13 @@ const blockPackRoomAdmissionPolicyVersion int = 1
 const blockPackDocumentQuotaPolicyVersion int = 1
 const roomAdmissionEnforcementStrategyRejectNewSubscriber string = "reject-new-subscriber"
 
+// ShareLinkId and ShareSessionExpiresAt are only set for the guests of a share
+// link, so that the connection can be closed once the share session ends.
 type RealtimeConnectionTicketClaims struct {
-	UserAgentHash           string `json:"userAgentHash" validate:"required"`
-	RealtimeProtocolVersion int    `json:"realtimeProtocolVersion" validate:"required"`
+	UserAgentHash           string     `json:"userAgentHash" validate:"required"`
+	RealtimeProtocolVersion int        `json:"realtimeProtocolVersion" validate:"required"`
+	ShareLinkId             string     `json:"shareLinkId,omitempty" validate:"omitempty,uuid4"`
+	ShareSessionExpiresAt   *time.Time `json:"shareSessionExpiresAt,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -43,6 +47,7 @@ type RealtimeBlockPackTicketClaims struct {
 	MaximumSubscribers               int32  `json:"maximumSubscribers" validate:"required,min=1"`
 	DocumentQuotaPolicyVersion       int    `json:"documentQuotaPolicyVersion" validate:"required"`
 	MaximumBlockCount                int32  `json:"maximumBlockCount" validate:"required,min=1"`
+	ShareLinkId                      string `json:"shareLinkId,omitempty" validate:"omitempty,uuid4"`
 	jwt.RegisteredClaims
 }
 
@@ -57,6 +62,9 @@ func GenerateRealtimeConnectionTicket(claims RealtimeConnectionTicketClaims) (*s
 	if _, err := uuid.Parse(claims.Subject); err != nil {
 		return nil, time.Time{}, fmt.Errorf("invalid realtime connection ticket user public id: %w", err)
 	}
+	if err := validateRealtimeTicketShareLinkId(claims.ShareLinkId); err != nil {
+		return nil, time.Time{}, err
+	}
 
 	now := time.Now()
 	expiresAt := now.Add(realtimeConnectionTicketExpiresIn)
@@ -100,6 +108,9 @@ func GenerateRealtimeBlockPackTicket(claims RealtimeBlockPackTicketClaims) (*str
 	if _, err := uuid.Parse(claims.ChannelId); err != nil {
 		return nil, time.Time{}, fmt.Errorf("invalid realtime block pack ticket channel id: %w", err)
 	}
+	if err := validateRealtimeTicketShareLinkId(claims.ShareLinkId); err != nil {
+		return nil, time.Time{}, err
+	}
 
 	now := time.Now()
 	expiresAt := now.Add(realtimeBlockPackTicketExpiresIn)
@@ -157,6 +168,9 @@ func ParseRealtimeConnectionTicket(ticketString string, userAgent string) (*Real
 	if _, err := uuid.Parse(claims.Subject); err != nil {
 		return nil, fmt.Errorf("invalid realtime connection ticket user public id: %w", err)
 	}
+	if err := validateRealtimeTicketShareLinkId(claims.ShareLinkId); err != nil {
+		return nil, err
+	}
 
 	return &claims, nil
 }
@@ -211,10 +225,24 @@ func ParseRealtimeBlockPackTicket(ticketString string, userAgent string) (*Realt
 	if claims.Permission != "read" && claims.Permission != "write" {
 		return nil, fmt.Errorf("invalid realtime block pack ticket permission")
 	}
+	if err := validateRealtimeTicketShareLinkId(claims.ShareLinkId); err != nil {
+		return nil, err
+	}
 
 	return &claims, nil
 }
 
+func validateRealtimeTicketShareLinkId(shareLinkId string) error {
+	if shareLinkId == "" {
+		return nil
+	}
+	if _, err := uuid.Parse(shareLinkId); err != nil {
+		return fmt.Errorf("invalid realtime ticket share link id: %w", err)
+	}
+
+	return nil
+}
+
 func parseRealtimeTicketPrivateKey(encodedPrivateKey string) (ed25519.PrivateKey, error) {
 	if encodedPrivateKey == "" {
 		return nil, errors.New("realtime ticket private key is required")
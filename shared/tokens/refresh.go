@@ -1,6 +1,8 @@
 package tokens
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"os"
 	"time"
@@ -11,10 +13,13 @@ import (
 
 const RefreshTokenExpiresIn time.Duration = 7 * 24 * time.Hour
 
+// A refresh token belongs to exactly one user session and is rotated on every use,
+// so each generated token carries a unique token id to never collide with its predecessor.
 type RefreshTokenClaims struct {
 	Name      string `json:"name" validate:"required,min=6,max=16,alphaandnum"`
 	Email     string `json:"email" validate:"required,email"`
 	UserAgent string `json:"userAgent" validate:"required"`
+	SessionId string `json:"sessionId" validate:"required,uuid4"`
 	jwt.RegisteredClaims
 }
 
@@ -32,6 +37,7 @@ func GenerateRefreshToken(userPublicId string, claims RefreshTokenClaims) (*stri
 		ExpiresAt: jwt.NewNumericDate(time.Now().Add(RefreshTokenExpiresIn)),
 		IssuedAt:  jwt.NewNumericDate(time.Now()),
 		Subject:   userPublicId,
+		ID:        uuid.NewString(),
 	}
 
 	token, err := SignJWT(secret, claims)
@@ -55,3 +61,10 @@ func ParseRefreshToken(tokenString string) (*RefreshTokenClaims, error) {
 
 	return claims, nil
 }
+
+// HashRefreshToken returns the digest of the refresh token stored in the user session,
+// the clear-text refresh token only lives in the cookie of the device.
+func HashRefreshToken(token string) string {
+	digest := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(digest[:])
+}
@@ -11,6 +11,7 @@ func TestRefreshTokenRoundTrip(t *testing.T) {
 			Name:      "notegic",
 			Email:     "notegic@example.com",
 			UserAgent: "test-agent",
+			SessionId: "2f1c4f6e-8f0a-4d6b-9c1e-5a7d3b9e2c10",
 		},
 	)
 	if err != nil {
@@ -21,7 +22,7 @@ func TestRefreshTokenRoundTrip(t *testing.T) {
 	if err != nil {
 		t.Fatalf("parse refresh token: %v", err)
 	}
-	if claims.Name != "notegic" || claims.Email != "notegic@example.com" || claims.UserAgent != "test-agent" {
+	if claims.Name != "notegic" || claims.Email != "notegic@example.com" || claims.UserAgent != "test-agent" || claims.SessionId != "2f1c4f6e-8f0a-4d6b-9c1e-5a7d3b9e2c10" {
 		t.Fatalf("unexpected refresh token claims: %#v", claims)
 	}
 	if claims.Subject != "83bdeac1-02de-42fe-a7a8-4e1a83174866" {
@@ -31,3 +32,29 @@ func TestRefreshTokenRoundTrip(t *testing.T) {
 		t.Fatal("expected generated refresh token timestamps")
 	}
 }
+
+func TestRotatedRefreshTokensHaveDistinctDigests(t *testing.T) {
+	t.Setenv("JWT_REFRESH_TOKEN_SECRET_KEY", "test-secret")
+
+	claims := RefreshTokenClaims{
+		Name:      "notegic",
+		Email:     "notegic@example.com",
+		UserAgent: "test-agent",
+		SessionId: "2f1c4f6e-8f0a-4d6b-9c1e-5a7d3b9e2c10",
+	}
+	previousToken, err := GenerateRefreshToken("83bdeac1-02de-42fe-a7a8-4e1a83174866", claims)
+	if err != nil {
+		t.Fatalf("generate previous refresh token: %v", err)
+	}
+	rotatedToken, err := GenerateRefreshToken("83bdeac1-02de-42fe-a7a8-4e1a83174866", claims)
+	if err != nil {
+		t.Fatalf("generate rotated refresh token: %v", err)
+	}
+
+	if HashRefreshToken(*previousToken) == HashRefreshToken(*rotatedToken) {
+		t.Fatal("expected the rotated refresh token to have a distinct digest within the same second")
+	}
+	if HashRefreshToken(*rotatedToken) != HashRefreshToken(*rotatedToken) {
+		t.Fatal("expected the refresh token digest to be deterministic")
+	}
+}
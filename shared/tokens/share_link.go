@@ -0,0 +1,167 @@
+package tokens
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+const ShareLinkTokenPrefix = "nzs_"
+
+const ShareSessionTokenExpiresIn time.Duration = 12 * time.Hour
+
+// a share link only grants the read permission, since an anonymous guest acts as the creator of the share link,
+// and there is no narrower permission, such as commenting, that could be granted to such a guest yet
+const ShareLinkPermissionRead = "read"
+
+// A share session acts as the creator of the share link, so its subject is the
+// public id of the creator, and the operations it can delegate are narrowed by
+// the share link it is resolved from.
+type ShareSessionTokenClaims struct {
+	ShareLinkId string `json:"shareLinkId" validate:"required,uuid4"`
+	RootShelfId string `json:"rootShelfId" validate:"required,uuid4"`
+	BlockPackId string `json:"blockPackId,omitempty" validate:"omitempty,uuid4"`
+	Permission  string `json:"permission" validate:"required,oneof=read"`
+	UserAgent   string `json:"userAgent" validate:"required"`
+	jwt.RegisteredClaims
+}
+
+// GenerateShareLinkToken returns the one-time secret of the share link and the
+// digest that should be stored in the ShareLink schema.
+func GenerateShareLinkToken() (secret string, digest string, err error) {
+	bytes := make([]byte, 32)
+	if _, err = rand.Read(bytes); err != nil {
+		return "", "", err
+	}
+	secret = ShareLinkTokenPrefix + base64.RawURLEncoding.EncodeToString(bytes)
+	return secret, HashShareLinkToken(secret), nil
+}
+
+func HashShareLinkToken(secret string) string {
+	digest := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(digest[:])
+}
+
+func ValidateShareLinkTokenFormat(secret string) error {
+	if !strings.HasPrefix(secret, ShareLinkTokenPrefix) || len(secret) < len(ShareLinkTokenPrefix)+32 {
+		return errors.New("invalid share link token format")
+	}
+	return nil
+}
+
+// GenerateShareSessionToken never outlives the share link, the expiration of the
+// session is the earlier one of its own lifetime and the given share link expiration.
+func GenerateShareSessionToken(
+	creatorPublicId string,
+	shareLinkExpiresAt *time.Time,
+	claims ShareSessionTokenClaims,
+) (*string, time.Time, error) {
+	if _, err := uuid.Parse(creatorPublicId); err != nil {
+		return nil, time.Time{}, errors.New("share session token creator public ID is invalid")
+	}
+	if err := validateShareSessionTokenClaims(&claims); err != nil {
+		return nil, time.Time{}, err
+	}
+
+	secret := os.Getenv("JWT_SHARE_SESSION_TOKEN_SECRET_KEY")
+	if secret == "" {
+		return nil, time.Time{}, errors.New("share session token secret is required")
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(ShareSessionTokenExpiresIn)
+	if shareLinkExpiresAt != nil && shareLinkExpiresAt.Before(expiresAt) {
+		expiresAt = *shareLinkExpiresAt
+	}
+	if !expiresAt.After(now) {
+		return nil, time.Time{}, errors.New("share link has already expired")
+	}
+	claims.RegisteredClaims = jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+		ID:        uuid.NewString(),
+		IssuedAt:  jwt.NewNumericDate(now),
+		Subject:   creatorPublicId,
+	}
+
+	token, err := SignJWT(secret, claims)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	return &token, expiresAt, nil
+}
+
+func ParseShareSessionToken(tokenString string) (*ShareSessionTokenClaims, error) {
+	secret := os.Getenv("JWT_SHARE_SESSION_TOKEN_SECRET_KEY")
+	if secret == "" {
+		return nil, errors.New("share session token secret is required")
+	}
+
+	claims := &ShareSessionTokenClaims{}
+	if err := ParseJWT(secret, tokenString, claims, jwt.WithExpirationRequired()); err != nil {
+		return nil, err
+	}
+	if _, err := uuid.Parse(claims.Subject); err != nil {
+		return nil, errors.New("share session token creator public ID is invalid")
+	}
+	if err := validateShareSessionTokenClaims(claims); err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+// IsShareLinkOperationAllowed reuses the resource and action derivation of the
+// API key scopes, a share session can only read the shared resources.
+func IsShareLinkOperationAllowed(operation string, permission string, isBlockPackShareLink bool) bool {
+	switch operation {
+	case "realtime.connection-ticket.create", "realtime.block-pack-channel-ticket.create":
+		return true
+	}
+
+	requiredScope, err := GetRequiredAPIKeyScope(operation)
+	if err != nil {
+		return false
+	}
+	resource, action, _ := strings.Cut(requiredScope, ":")
+	_, verb, _ := strings.Cut(operation, ".")
+
+	sharedResources := []string{"root-shelf", "sub-shelf", "material", "block-pack", "block"}
+	if isBlockPackShareLink {
+		sharedResources = []string{"block-pack", "block"}
+	}
+	// the archives are exported on behalf of the creator instead of the guest
+	if !slices.Contains(sharedResources, resource) || strings.Contains(verb, "archive") {
+		return false
+	}
+
+	return action == APIKeyScopeActionRead && permission == ShareLinkPermissionRead
+}
+
+func validateShareSessionTokenClaims(claims *ShareSessionTokenClaims) error {
+	if claims.UserAgent == "" ||
+		claims.Permission != ShareLinkPermissionRead {
+		return errors.New("share session token claims are invalid")
+	}
+	if _, err := uuid.Parse(claims.ShareLinkId); err != nil {
+		return errors.New("share session token share link ID is invalid")
+	}
+	if _, err := uuid.Parse(claims.RootShelfId); err != nil {
+		return errors.New("share session token root shelf ID is invalid")
+	}
+	if claims.BlockPackId != "" {
+		if _, err := uuid.Parse(claims.BlockPackId); err != nil {
+			return errors.New("share session token block pack ID is invalid")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package tokens
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGenerateShareLinkTokenStoresOnlyDigestMaterial(t *testing.T) {
+	secret, digest, err := GenerateShareLinkToken()
+	if err != nil {
+		t.Fatalf("generate share link token: %v", err)
+	}
+	if ValidateShareLinkTokenFormat(secret) != nil {
+		t.Fatalf("generated share link token has an invalid format")
+	}
+	if digest == secret || digest != HashShareLinkToken(secret) {
+		t.Fatalf("expected SHA-256 digest of the generated secret")
+	}
+}
+
+func TestShareSessionTokenRoundTrip(t *testing.T) {
+	t.Setenv("JWT_SHARE_SESSION_TOKEN_SECRET_KEY", "test-share-session-secret")
+
+	shareLinkExpiresAt := time.Now().Add(time.Hour)
+	token, expiresAt, err := GenerateShareSessionToken(
+		"83bdeac1-02de-42fe-a7a8-4e1a83174866",
+		&shareLinkExpiresAt,
+		ShareSessionTokenClaims{
+			ShareLinkId: "0c8e4ad1-4f5e-4d61-9f1a-7c2d9b3e6a10",
+			RootShelfId: "5f1c2e3d-7a8b-4c9d-8e0f-1a2b3c4d5e6f",
+			Permission:  ShareLinkPermissionRead,
+			UserAgent:   "test-agent",
+		},
+	)
+	if err != nil {
+		t.Fatalf("generate share session token: %v", err)
+	}
+	if expiresAt.After(shareLinkExpiresAt) {
+		t.Fatalf("expected the share session to end with the share link, got %s", expiresAt)
+	}
+
+	claims, err := ParseShareSessionToken(*token)
+	if err != nil {
+		t.Fatalf("parse share session token: %v", err)
+	}
+	if claims.Subject != "83bdeac1-02de-42fe-a7a8-4e1a83174866" ||
+		claims.ShareLinkId != "0c8e4ad1-4f5e-4d61-9f1a-7c2d9b3e6a10" ||
+		claims.BlockPackId != "" ||
+		claims.Permission != ShareLinkPermissionRead {
+		t.Fatalf("unexpected share session token claims: %#v", claims)
+	}
+
+	expiredAt := time.Now().Add(-time.Minute)
+	if _, _, err := GenerateShareSessionToken(
+		"83bdeac1-02de-42fe-a7a8-4e1a83174866",
+		&expiredAt,
+		ShareSessionTokenClaims{
+			ShareLinkId: "0c8e4ad1-4f5e-4d61-9f1a-7c2d9b3e6a10",
+			RootShelfId: "5f1c2e3d-7a8b-4c9d-8e0f-1a2b3c4d5e6f",
+			Permission:  ShareLinkPermissionRead,
+			UserAgent:   "test-agent",
+		},
+	); err == nil {
+		t.Fatal("expected a share session of an expired share link to be rejected")
+	}
+}
+
+func TestIsShareLinkOperationAllowed(t *testing.T) {
+	cases := []struct {
+		operation            string
+		permission           string
+		isBlockPackShareLink bool
+		expected             bool
+	}{
+		{"root-shelf.get-my-root-shelf-by-id", ShareLinkPermissionRead, false, true},
+		{"sub-shelf.get-by-id", ShareLinkPermissionRead, false, true},
+		{"block.update", ShareLinkPermissionRead, false, false},
+		{"block.update", "write", false, false},
+		{"block-pack.create", ShareLinkPermissionRead, false, false},
+		{"block-pack.move", ShareLinkPermissionRead, false, false},
+		{"root-shelf.get-archive-by-id", ShareLinkPermissionRead, false, false},
+		{"block-pack.delete", ShareLinkPermissionRead, false, false},
+		{"root-shelf.permission.upsert", ShareLinkPermissionRead, false, false},
+		{"routine.get-by-id", ShareLinkPermissionRead, false, false},
+		{"root-shelf.get-my-root-shelf-by-id", ShareLinkPermissionRead, true, false},
+		{"block-pack.get-by-id", ShareLinkPermissionRead, true, true},
+		{"block-pack.get-by-id", "write", true, false},
+		{"realtime.block-pack-channel-ticket.create", ShareLinkPermissionRead, true, true},
+		{"share-link.create", ShareLinkPermissionRead, false, false},
+		{"user.get-me", ShareLinkPermissionRead, false, false},
+	}
+	for _, c := range cases {
+		if allowed := IsShareLinkOperationAllowed(c.operation, c.permission, c.isBlockPackShareLink); allowed != c.expected {
+			t.Fatalf("expected %s with %s permission (block pack: %v) to be allowed=%v", c.operation, c.permission, c.isBlockPackShareLink, c.expected)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package tokens
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	TOTPIssuer                  = "Notegic"
+	TOTPDigits                  = 6
+	TOTPPeriod    time.Duration = 30 * time.Second
+	TOTPSkewSteps               = 1
+
+	TOTPRecoveryCodeCount = 10
+)
+
+var totpSecretEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTOTPSecret returns a 160-bit secret in the unpadded base32 form
+// expected by the authenticator apps, as RFC 4226 recommends for HMAC-SHA1.
+func GenerateTOTPSecret() (string, error) {
+	bytes := make([]byte, 20)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return totpSecretEncoding.EncodeToString(bytes), nil
+}
+
+// GetTOTPProvisioningURI returns the otpauth URI rendered as the QR code by the
+// clients, the account name is the label shown in the authenticator apps.
+func GetTOTPProvisioningURI(accountName string, secret string) string {
+	label := url.PathEscape(TOTPIssuer + ":" + accountName)
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", TOTPIssuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(TOTPDigits))
+	query.Set("period", fmt.Sprint(int(TOTPPeriod.Seconds())))
+	return "otpauth://totp/" + label + "?" + query.Encode()
+}
+
+// GetTOTPCode returns the code of the time step that contains the given time.
+func GetTOTPCode(secret string, at time.Time) (string, error) {
+	key, err := totpSecretEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return "", err
+	}
+	return getTOTPCodeByStep(key, getTOTPStep(at)), nil
+}
+
+// ValidateTOTPCode accepts the codes of the adjacent time steps to tolerate clock
+// drifts, and returns the matched time step so that the caller can reject any code
+// of a time step which is not after the last used one, as replays of the same code.
+func ValidateTOTPCode(secret string, code string, at time.Time) (int64, bool) {
+	key, err := totpSecretEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil || len(code) != TOTPDigits {
+		return 0, false
+	}
+
+	step := getTOTPStep(at)
+	for offset := int64(-TOTPSkewSteps); offset <= TOTPSkewSteps; offset++ {
+		expected := getTOTPCodeByStep(key, step+offset)
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step + offset, true
+		}
+	}
+	return 0, false
+}
+
+func getTOTPStep(at time.Time) int64 {
+	return at.Unix() / int64(TOTPPeriod.Seconds())
+}
+
+func getTOTPCodeByStep(key []byte, step int64) string {
+	message := make([]byte, 8)
+	binary.BigEndian.PutUint64(message, uint64(step))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(message)
+	sum := mac.Sum(nil)
+
+	// dynamic truncation of RFC 4226
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", TOTPDigits, value%1000000)
+}
+
+// EncryptTOTPSecret seals the secret with AES-GCM, the secret must be recoverable
+// to validate the codes, so it cannot be stored as a digest like the other tokens.
+func EncryptTOTPSecret(secret string) (string, error) {
+	gcm, err := getTOTPSecretCipher()
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := gcm.Seal(nonce, nonce, []byte(secret), nil)
+	return base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+func DecryptTOTPSecret(encryptedSecret string) (string, error) {
+	gcm, err := getTOTPSecretCipher()
+	if err != nil {
+		return "", err
+	}
+	sealed, err := base64.RawStdEncoding.DecodeString(encryptedSecret)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return "", errors.New("encrypted TOTP secret is malformed")
+	}
+	secret, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", err
+	}
+	return string(secret), nil
+}
+
+func getTOTPSecretCipher() (cipher.AEAD, error) {
+	encryptionKey := os.Getenv("TOTP_SECRET_ENCRYPTION_KEY")
+	if encryptionKey == "" {
+		return nil, errors.New("TOTP secret encryption key is required")
+	}
+	key := sha256.Sum256([]byte(encryptionKey))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// GenerateTOTPRecoveryCodes returns the one-time recovery codes shown to the user
+// once, in the form of "xxxxx-xxxxx", and the digests that should be stored.
+func GenerateTOTPRecoveryCodes() (codes []string, digests []string, err error) {
+	codes = make([]string, TOTPRecoveryCodeCount)
+	digests = make([]string, TOTPRecoveryCodeCount)
+	for index := range codes {
+		bytes := make([]byte, 7)
+		if _, err = rand.Read(bytes); err != nil {
+			return nil, nil, err
+		}
+		encoded := strings.ToLower(totpSecretEncoding.EncodeToString(bytes))[:10]
+		codes[index] = encoded[:5] + "-" + encoded[5:]
+		digests[index] = HashTOTPRecoveryCode(codes[index])
+	}
+	return codes, digests, nil
+}
+
+// HashTOTPRecoveryCode ignores the case and the separators of the recovery code,
+// since the users usually type them back by hand.
+func HashTOTPRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	digest := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(digest[:])
+}
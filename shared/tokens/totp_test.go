@@ -0,0 +1,109 @@
+package tokens
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+)
+
+// the SHA-1 test vectors of RFC 6238, truncated to six digits
+func TestGetTOTPCodeMatchesRFC6238(t *testing.T) {
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+	cases := []struct {
+		unix     int64
+		expected string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+
+	for _, tc := range cases {
+		code, err := GetTOTPCode(secret, time.Unix(tc.unix, 0))
+		if err != nil {
+			t.Fatalf("get TOTP code: %v", err)
+		}
+		if code != tc.expected {
+			t.Fatalf("expected %s at %d, got %s", tc.expected, tc.unix, code)
+		}
+	}
+}
+
+func TestValidateTOTPCodeToleratesAdjacentSteps(t *testing.T) {
+	secret, err := GenerateTOTPSecret()
+	if err != nil {
+		t.Fatalf("generate TOTP secret: %v", err)
+	}
+	now := time.Unix(1700000000, 0)
+	previousCode, _ := GetTOTPCode(secret, now.Add(-TOTPPeriod))
+	staleCode, _ := GetTOTPCode(secret, now.Add(-3*TOTPPeriod))
+
+	step, ok := ValidateTOTPCode(secret, previousCode, now)
+	if !ok || step != getTOTPStep(now)-1 {
+		t.Fatalf("expected the code of the previous step to be accepted, got step %d and %v", step, ok)
+	}
+	if _, ok := ValidateTOTPCode(secret, staleCode, now); ok {
+		t.Fatal("expected a stale code to be rejected")
+	}
+	if _, ok := ValidateTOTPCode(secret, "12345", now); ok {
+		t.Fatal("expected a code of the wrong length to be rejected")
+	}
+}
+
+func TestTOTPSecretEncryptionRoundTrip(t *testing.T) {
+	t.Setenv("TOTP_SECRET_ENCRYPTION_KEY", "test-totp-encryption-key")
+
+	secret, err := GenerateTOTPSecret()
+	if err != nil {
+		t.Fatalf("generate TOTP secret: %v", err)
+	}
+	encrypted, err := EncryptTOTPSecret(secret)
+	if err != nil {
+		t.Fatalf("encrypt TOTP secret: %v", err)
+	}
+	if strings.Contains(encrypted, secret) {
+		t.Fatal("expected the encrypted TOTP secret not to contain the secret")
+	}
+	decrypted, err := DecryptTOTPSecret(encrypted)
+	if err != nil {
+		t.Fatalf("decrypt TOTP secret: %v", err)
+	}
+	if decrypted != secret {
+		t.Fatalf("expected %s, got %s", secret, decrypted)
+	}
+
+	t.Setenv("TOTP_SECRET_ENCRYPTION_KEY", "another-totp-encryption-key")
+	if _, err := DecryptTOTPSecret(encrypted); err == nil {
+		t.Fatal("expected the secret sealed by another key to be rejected")
+	}
+}
+
+func TestGenerateTOTPRecoveryCodesStoresOnlyDigests(t *testing.T) {
+	codes, digests, err := GenerateTOTPRecoveryCodes()
+	if err != nil {
+		t.Fatalf("generate TOTP recovery codes: %v", err)
+	}
+	if len(codes) != TOTPRecoveryCodeCount || len(digests) != TOTPRecoveryCodeCount {
+		t.Fatalf("expected %d recovery codes, got %d", TOTPRecoveryCodeCount, len(codes))
+	}
+	for index, code := range codes {
+		if len(code) != 11 || code[5] != '-' {
+			t.Fatalf("unexpected recovery code format %q", code)
+		}
+		if digests[index] != HashTOTPRecoveryCode(strings.ToUpper(strings.ReplaceAll(code, "-", ""))) {
+			t.Fatalf("expected the digest to ignore the case and the separator of %q", code)
+		}
+	}
+}
+
+func TestGetTOTPProvisioningURI(t *testing.T) {
+	uri := GetTOTPProvisioningURI("dev@example.com", "JBSWY3DPEHPK3PXP")
+	if !strings.HasPrefix(uri, "otpauth://totp/Notegic:dev@example.com?") ||
+		!strings.Contains(uri, "secret=JBSWY3DPEHPK3PXP") ||
+		!strings.Contains(uri, "issuer=Notegic") {
+		t.Fatalf("unexpected provisioning URI %s", uri)
+	}
+}
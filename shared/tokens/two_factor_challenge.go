@@ -0,0 +1,71 @@
+package tokens
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+const TwoFactorChallengeTokenExpiresIn time.Duration = 5 * time.Minute
+
+// A two-factor challenge is issued by the login once the password or the OAuth
+// authorization has been verified, and it is exchanged for a session together with
+// a TOTP or recovery code, so the login options of the device travel along with it.
+type TwoFactorChallengeTokenClaims struct {
+	UserAgent   string `json:"userAgent" validate:"required"`
+	DeviceLabel string `json:"deviceLabel,omitempty"`
+	jwt.RegisteredClaims
+}
+
+func GenerateTwoFactorChallengeToken(userPublicId string, claims TwoFactorChallengeTokenClaims) (*string, time.Time, error) {
+	if _, err := uuid.Parse(userPublicId); err != nil {
+		return nil, time.Time{}, errors.New("two-factor challenge token user public ID is invalid")
+	}
+	if claims.UserAgent == "" {
+		return nil, time.Time{}, errors.New("two-factor challenge token claims are invalid")
+	}
+
+	secret := os.Getenv("JWT_TWO_FACTOR_CHALLENGE_TOKEN_SECRET_KEY")
+	if secret == "" {
+		return nil, time.Time{}, errors.New("two-factor challenge token secret is required")
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(TwoFactorChallengeTokenExpiresIn)
+	claims.RegisteredClaims = jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+		ID:        uuid.NewString(),
+		IssuedAt:  jwt.NewNumericDate(now),
+		Subject:   userPublicId,
+	}
+
+	token, err := SignJWT(secret, claims)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	return &token, expiresAt, nil
+}
+
+func ParseTwoFactorChallengeToken(tokenString string) (*TwoFactorChallengeTokenClaims, error) {
+	secret := os.Getenv("JWT_TWO_FACTOR_CHALLENGE_TOKEN_SECRET_KEY")
+	if secret == "" {
+		return nil, errors.New("two-factor challenge token secret is required")
+	}
+
+	claims := &TwoFactorChallengeTokenClaims{}
+	if err := ParseJWT(secret, tokenString, claims, jwt.WithExpirationRequired()); err != nil {
+		return nil, err
+	}
+	if _, err := uuid.Parse(claims.Subject); err != nil {
+		return nil, errors.New("two-factor challenge token user public ID is invalid")
+	}
+	if claims.UserAgent == "" {
+		return nil, errors.New("two-factor challenge token claims are invalid")
+	}
+
+	return claims, nil
+}
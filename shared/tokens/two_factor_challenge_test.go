@@ -0,0 +1,36 @@
+package tokens
+
+import "testing"
+
+func TestTwoFactorChallengeTokenRoundTrip(t *testing.T) {
+	t.Setenv("JWT_TWO_FACTOR_CHALLENGE_TOKEN_SECRET_KEY", "test-two-factor-challenge-secret")
+
+	token, expiresAt, err := GenerateTwoFactorChallengeToken(
+		"83bdeac1-02de-42fe-a7a8-4e1a83174866",
+		TwoFactorChallengeTokenClaims{
+			UserAgent:   "test-agent",
+			DeviceLabel: "Work laptop",
+		},
+	)
+	if err != nil {
+		t.Fatalf("generate two-factor challenge token: %v", err)
+	}
+	if expiresAt.IsZero() {
+		t.Fatal("expected the two-factor challenge token to expire")
+	}
+
+	claims, err := ParseTwoFactorChallengeToken(*token)
+	if err != nil {
+		t.Fatalf("parse two-factor challenge token: %v", err)
+	}
+	if claims.Subject != "83bdeac1-02de-42fe-a7a8-4e1a83174866" ||
+		claims.UserAgent != "test-agent" ||
+		claims.DeviceLabel != "Work laptop" {
+		t.Fatalf("unexpected two-factor challenge token claims: %#v", claims)
+	}
+
+	t.Setenv("JWT_TWO_FACTOR_CHALLENGE_TOKEN_SECRET_KEY", "another-two-factor-challenge-secret")
+	if _, err := ParseTwoFactorChallengeToken(*token); err == nil {
+		t.Fatal("expected a two-factor challenge token signed by another secret to be rejected")
+	}
+}
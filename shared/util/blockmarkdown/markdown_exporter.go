@@ -0,0 +1,411 @@
+package blockmarkdown
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	blocknote "github.com/HiIamJeff67/notegic-backend/contracts/types/blocknote"
+	enums "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
+)
+
+const markdownEscapedCharacters = "\\`*_[]<>|~$"
+
+var (
+	markdownLineStartPattern    = regexp.MustCompile(`^(\s*)([#>+=-])`)
+	markdownOrderedStartPattern = regexp.MustCompile(`^(\s*\d+)([.)])`)
+)
+
+type markdownExporter struct {
+	builder strings.Builder
+}
+
+/* ============================== Auxiliary Functions ============================== */
+
+func isListItemBlock(blockType enums.BlockType) bool {
+	switch blockType {
+	case enums.BlockType_BulletListItem,
+		enums.BlockType_NumberedListItem,
+		enums.BlockType_CheckListItem,
+		enums.BlockType_ToggleListItem:
+		return true
+	}
+	return false
+}
+
+// escapeMarkdownText escapes the characters that could open inline syntax and
+// turns line breaks into hard line breaks
+func escapeMarkdownText(value string) string {
+	var builder strings.Builder
+	for index := 0; index < len(value); index++ {
+		character := value[index]
+		switch {
+		case character == '\n':
+			builder.WriteString("\\\n")
+			continue
+		case strings.IndexByte(markdownEscapedCharacters, character) >= 0:
+			builder.WriteByte('\\')
+		case character == '&' && index+1 < len(value) &&
+			(value[index+1] == '#' || ('a' <= value[index+1] && value[index+1] <= 'z') || ('A' <= value[index+1] && value[index+1] <= 'Z')):
+			builder.WriteByte('\\')
+		}
+		builder.WriteByte(character)
+	}
+	return builder.String()
+}
+
+// escapeMarkdownLineStarts escapes the characters that would open a block when
+// they start a line of rendered inline content
+func escapeMarkdownLineStarts(lines []string) []string {
+	for index, line := range lines {
+		line = markdownLineStartPattern.ReplaceAllString(line, `$1\$2`)
+		lines[index] = markdownOrderedStartPattern.ReplaceAllString(line, `$1\$2`)
+	}
+	return lines
+}
+
+func escapeMarkdownDestination(destination string) string {
+	if strings.ContainsAny(destination, " ()<>") {
+		return "<" + strings.NewReplacer("<", "%3C", ">", "%3E").Replace(destination) + ">"
+	}
+	return destination
+}
+
+// longestRun returns the length of the longest run of the character in value
+func longestRun(value string, character rune) int {
+	longest, current := 0, 0
+	for _, each := range value {
+		if each == character {
+			current++
+			longest = max(longest, current)
+		} else {
+			current = 0
+		}
+	}
+	return longest
+}
+
+func renderCodeSpan(value string) string {
+	fence := strings.Repeat("`", longestRun(value, '`')+1)
+	if strings.HasPrefix(value, "`") || strings.HasSuffix(value, "`") {
+		value = " " + value + " "
+	}
+	return fence + value + fence
+}
+
+func renderStyledText(styledText blocknote.StyledText) string {
+	if styledText.Text == "" {
+		return ""
+	}
+	if styledText.Styles.Code {
+		return renderCodeSpan(strings.ReplaceAll(styledText.Text, "\n", " "))
+	}
+
+	// emphasis delimiters must touch non-whitespace, so surrounding spaces stay outside of them
+	trimmed := strings.TrimSpace(styledText.Text)
+	if trimmed == "" {
+		return escapeMarkdownText(styledText.Text)
+	}
+	leading := styledText.Text[:strings.Index(styledText.Text, trimmed)]
+	trailing := styledText.Text[len(leading)+len(trimmed):]
+
+	rendered := escapeMarkdownText(trimmed)
+	if styledText.Styles.Strike {
+		rendered = "~~" + rendered + "~~"
+	}
+	if styledText.Styles.Italic {
+		rendered = "*" + rendered + "*"
+	}
+	if styledText.Styles.Bold {
+		rendered = "**" + rendered + "**"
+	}
+	return escapeMarkdownText(leading) + rendered + escapeMarkdownText(trailing)
+}
+
+func renderInlineContent(contents blocknote.InlineContentList) string {
+	var builder strings.Builder
+	for _, content := range contents {
+		switch value := content.InlineContentUnion.(type) {
+		case *blocknote.StyledText:
+			builder.WriteString(renderStyledText(*value))
+		case *blocknote.Link:
+			builder.WriteString("[")
+			for _, styledText := range value.Content {
+				builder.WriteString(renderStyledText(styledText))
+			}
+			builder.WriteString("](" + escapeMarkdownDestination(value.Href) + ")")
+		case *blocknote.Math:
+			builder.WriteString("$" + strings.ReplaceAll(value.Content, "$", `\$`) + "$")
+		}
+	}
+	return builder.String()
+}
+
+func renderBlockContent(content blocknote.BlockContent) string {
+	switch value := content.(type) {
+	case blocknote.InlineContentList:
+		return renderInlineContent(value)
+	case blocknote.PlainContent:
+		return escapeMarkdownText(string(value))
+	}
+	return ""
+}
+
+func rawBlockContent(content blocknote.BlockContent) string {
+	switch value := content.(type) {
+	case blocknote.InlineContentList:
+		var builder strings.Builder
+		for _, inlineContent := range value {
+			switch each := inlineContent.InlineContentUnion.(type) {
+			case *blocknote.StyledText:
+				builder.WriteString(each.Text)
+			case *blocknote.Link:
+				for _, styledText := range each.Content {
+					builder.WriteString(styledText.Text)
+				}
+			case *blocknote.Math:
+				builder.WriteString(each.Content)
+			}
+		}
+		return builder.String()
+	case blocknote.PlainContent:
+		return string(value)
+	}
+	return ""
+}
+
+func renderFence(info string, value string) []string {
+	fence := strings.Repeat("`", max(3, longestRun(value, '`')+1))
+	lines := []string{fence + info}
+	if value != "" {
+		lines = append(lines, strings.Split(value, "\n")...)
+	}
+	return append(lines, fence)
+}
+
+func renderTableCell(cell blocknote.TableCell) string {
+	rendered := strings.ReplaceAll(renderInlineContent(cell.Content), "\\\n", " ")
+	return strings.TrimSpace(rendered)
+}
+
+func renderTable(content blocknote.BlockContent) []string {
+	tableContent, ok := content.(*blocknote.TableContent)
+	if !ok || len(tableContent.Rows) == 0 {
+		return nil
+	}
+
+	columnCount := 0
+	for _, row := range tableContent.Rows {
+		columnCount = max(columnCount, len(row.Cells))
+	}
+	if columnCount == 0 {
+		return nil
+	}
+
+	renderRow := func(row blocknote.TableRow) string {
+		cells := make([]string, columnCount)
+		for index := range cells {
+			if index < len(row.Cells) {
+				cells[index] = renderTableCell(row.Cells[index])
+			}
+		}
+		return "| " + strings.Join(cells, " | ") + " |"
+	}
+
+	// GFM requires a header row, the first row of the table is used as it
+	delimiters := make([]string, columnCount)
+	for index := range delimiters {
+		delimiters[index] = "---"
+		if index < len(tableContent.Rows[0].Cells) {
+			switch tableContent.Rows[0].Cells[index].Props.TextAlignment {
+			case "left":
+				delimiters[index] = ":---"
+			case "center":
+				delimiters[index] = ":---:"
+			case "right":
+				delimiters[index] = "---:"
+			}
+		}
+	}
+
+	lines := []string{renderRow(tableContent.Rows[0]), "| " + strings.Join(delimiters, " | ") + " |"}
+	for _, row := range tableContent.Rows[1:] {
+		lines = append(lines, renderRow(row))
+	}
+	return lines
+}
+
+func renderFileBlock(block blocknote.ArborizedEditableBlock) []string {
+	var fileProps blocknote.FileBlockProps
+	switch props := block.Props.(type) {
+	case *blocknote.ImageBlockProps:
+		fileProps = props.FileBlockProps
+	case *blocknote.VideoBlockProps:
+		fileProps = props.FileBlockProps
+	case *blocknote.AudioBlockProps:
+		fileProps = props.FileBlockProps
+	case *blocknote.FileBlockProps:
+		fileProps = *props
+	}
+	if fileProps.Url == "" {
+		return nil
+	}
+
+	label := fileProps.Caption
+	if label == "" {
+		label = fileProps.Name
+	}
+	if block.Type == enums.BlockType_Image {
+		return []string{"![" + escapeMarkdownText(label) + "](" + escapeMarkdownDestination(fileProps.Url) + ")"}
+	}
+	if label == "" {
+		label = fileProps.Url
+	}
+	return []string{"[" + escapeMarkdownText(label) + "](" + escapeMarkdownDestination(fileProps.Url) + ")"}
+}
+
+// renderBlockLines renders the block itself without its children
+func renderBlockLines(block blocknote.ArborizedEditableBlock) []string {
+	switch block.Type {
+	case enums.BlockType_Heading:
+		level := 1
+		if props, ok := block.Props.(*blocknote.HeadingProps); ok {
+			level = min(max(props.Level, 1), blocknote.MaxHeadingLevel)
+		}
+		return []string{strings.Repeat("#", level) + " " + strings.ReplaceAll(renderBlockContent(block.Content), "\\\n", " ")}
+	case enums.BlockType_Quote:
+		lines := escapeMarkdownLineStarts(strings.Split(renderBlockContent(block.Content), "\n"))
+		for index, line := range lines {
+			lines[index] = "> " + line
+		}
+		return lines
+	case enums.BlockType_CodeBlock:
+		language := ""
+		if props, ok := block.Props.(*blocknote.CodeBlockProps); ok {
+			language = props.Language
+		}
+		return renderFence(language, rawBlockContent(block.Content))
+	case enums.BlockType_MathBlock:
+		return renderFence("math", rawBlockContent(block.Content))
+	case enums.BlockType_Diagram:
+		return renderFence("mermaid", rawBlockContent(block.Content))
+	case enums.BlockType_Table:
+		return renderTable(block.Content)
+	case enums.BlockType_Image, enums.BlockType_Video, enums.BlockType_Audio, enums.BlockType_File:
+		return renderFileBlock(block)
+	case enums.BlockType_Calendar:
+		// a calendar is a live view of the routines and has no Markdown form
+		return nil
+	default:
+		content := renderBlockContent(block.Content)
+		if content == "" && !isListItemBlock(block.Type) {
+			return nil
+		}
+		return escapeMarkdownLineStarts(strings.Split(content, "\n"))
+	}
+}
+
+func listItemMarker(block blocknote.ArborizedEditableBlock, number int) string {
+	switch block.Type {
+	case enums.BlockType_NumberedListItem:
+		return strconv.Itoa(number) + ". "
+	case enums.BlockType_CheckListItem:
+		if props, ok := block.Props.(*blocknote.CheckListItemProps); ok && props.Checked {
+			return "- [x] "
+		}
+		return "- [ ] "
+	}
+	return "- "
+}
+
+/* ============================== Writer ============================== */
+
+func (e *markdownExporter) writeLines(lines []string, firstPrefix string, restPrefix string) {
+	for index, line := range lines {
+		prefix := restPrefix
+		if index == 0 {
+			prefix = firstPrefix
+		}
+		if strings.TrimSpace(line) == "" && index != 0 {
+			e.builder.WriteString(strings.TrimRight(prefix, " ") + "\n")
+			continue
+		}
+		e.builder.WriteString(prefix + line + "\n")
+	}
+}
+
+// writeBlocks separates blocks by a blank line except between items of the same
+// list, so that lists stay tight
+func (e *markdownExporter) writeBlocks(blocks []blocknote.ArborizedEditableBlock, indent string, isFirst bool) bool {
+	number := 0
+	var previousType enums.BlockType
+	for _, block := range blocks {
+		if block.Type == enums.BlockType_NumberedListItem {
+			if previousType == enums.BlockType_NumberedListItem {
+				number++
+			} else {
+				number = 1
+			}
+		}
+
+		lines := renderBlockLines(block)
+		if lines != nil {
+			isSameList := isListItemBlock(block.Type) && isListItemBlock(previousType) &&
+				(block.Type == enums.BlockType_NumberedListItem) == (previousType == enums.BlockType_NumberedListItem)
+			if !isFirst && !isSameList {
+				e.builder.WriteString("\n")
+			}
+
+			if isListItemBlock(block.Type) {
+				marker := listItemMarker(block, number)
+				childIndent := indent + strings.Repeat(" ", len(marker))
+				e.writeLines(lines, indent+marker, childIndent)
+				isFirst = false
+				previousType = block.Type
+				e.writeListItemChildren(block.Children, childIndent)
+				continue
+			}
+
+			e.writeLines(lines, indent, indent)
+			isFirst = false
+			previousType = block.Type
+		}
+
+		// children of blocks that Markdown cannot nest are written as the following siblings
+		if len(block.Children) > 0 && e.writeBlocks(block.Children, indent, isFirst) {
+			isFirst = false
+			previousType = ""
+		}
+	}
+
+	return !isFirst
+}
+
+func (e *markdownExporter) writeListItemChildren(children []blocknote.ArborizedEditableBlock, indent string) {
+	if len(children) == 0 {
+		return
+	}
+	if isListItemBlock(children[0].Type) {
+		// a nested list directly follows its item, anything else would become a lazy continuation line
+		e.writeBlocks(children, indent, true)
+		return
+	}
+	e.writeBlocks(children, indent, false)
+}
+
+/* ============================== Main Methods ============================== */
+
+// ExportEditableBlocks renders editor blocks as CommonMark with the GFM table,
+// task list and strikethrough extensions. Editor features without a Markdown
+// form are mapped as follows:
+//   - underline and colors are dropped, alignment is kept only for table cells
+//   - toggle list items become bullet list items
+//   - math blocks and diagrams become fenced code blocks tagged math and mermaid
+//   - video, audio and file blocks become links, images keep their caption as alt text
+//   - calendar blocks are omitted
+//   - children of blocks other than list items follow their parent unindented
+func ExportEditableBlocks(blocks []blocknote.ArborizedEditableBlock) string {
+	exporter := &markdownExporter{}
+	exporter.writeBlocks(blocks, "", true)
+	return exporter.builder.String()
+}
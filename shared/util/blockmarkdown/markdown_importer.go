@@ -0,0 +1,369 @@
+package blockmarkdown
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	extast "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+
+	blocknote "github.com/HiIamJeff67/notegic-backend/contracts/types/blocknote"
+	enums "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
+)
+
+var markdownParser = goldmark.New(
+	goldmark.WithExtensions(extension.GFM, mathExtension{}),
+).Parser()
+
+var programmingLanguageAliases = map[string]enums.SupportedProgrammingLanguage{
+	"js":     enums.SupportedProgrammingLanguage_JavaScript,
+	"ts":     enums.SupportedProgrammingLanguage_TypeScript,
+	"py":     enums.SupportedProgrammingLanguage_Python,
+	"sh":     enums.SupportedProgrammingLanguage_Bash,
+	"shell":  enums.SupportedProgrammingLanguage_Bash,
+	"zsh":    enums.SupportedProgrammingLanguage_Bash,
+	"golang": enums.SupportedProgrammingLanguage_Go,
+	"c++":    enums.SupportedProgrammingLanguage_CPP,
+	"c#":     enums.SupportedProgrammingLanguage_CSharp,
+	"cs":     enums.SupportedProgrammingLanguage_CSharp,
+	"f#":     enums.SupportedProgrammingLanguage_FSharp,
+}
+
+type markdownImporter struct {
+	source []byte
+}
+
+/* ============================== Auxiliary Functions ============================== */
+
+func newImportedBlock(blockType enums.BlockType, props blocknote.BlockProps, content blocknote.BlockContent) blocknote.ArborizedEditableBlock {
+	if props == nil {
+		props = &blocknote.BaseProps{}
+	}
+	return blocknote.ArborizedEditableBlock{
+		Id:       uuid.New(),
+		Type:     blockType,
+		Props:    props,
+		Content:  content,
+		Children: []blocknote.ArborizedEditableBlock{},
+	}
+}
+
+func newPlainInlineContent(value string) blocknote.InlineContentList {
+	if value == "" {
+		return blocknote.InlineContentList{}
+	}
+	return blocknote.InlineContentList{{InlineContentUnion: blocknote.NewStyledText(value, blocknote.Styles{})}}
+}
+
+// normalizeProgrammingLanguage keeps the languages the editor highlights,
+// maps the common aliases and falls back to plain text for everything else
+func normalizeProgrammingLanguage(language string) string {
+	language = strings.ToLower(strings.TrimSpace(language))
+	if language == "" {
+		return ""
+	}
+	if alias, exists := programmingLanguageAliases[language]; exists {
+		language = string(alias)
+	}
+	if len(language) > blocknote.MaxProgrammingLanguageLength ||
+		!slices.Contains(enums.AllSupportedProgrammingLanguageStrings, language) {
+		return string(enums.SupportedProgrammingLanguage_Plaintext)
+	}
+	return language
+}
+
+func (i *markdownImporter) linesValue(lines *text.Segments) string {
+	var buffer bytes.Buffer
+	for index := 0; index < lines.Len(); index++ {
+		segment := lines.At(index)
+		buffer.Write(segment.Value(i.source))
+	}
+	return strings.TrimRight(buffer.String(), "\n")
+}
+
+func (i *markdownImporter) textValue(node *ast.Text) string {
+	value := node.Segment.Value(i.source)
+	if !node.IsRaw() {
+		value = util.UnescapePunctuations(util.ResolveNumericReferences(util.ResolveEntityNames(value)))
+	}
+	return string(value)
+}
+
+/* ============================== Inline Content ============================== */
+
+func appendStyledText(contents blocknote.InlineContentList, value string, styles blocknote.Styles) blocknote.InlineContentList {
+	if value == "" {
+		return contents
+	}
+	if len(contents) > 0 {
+		if previous, ok := contents[len(contents)-1].InlineContentUnion.(*blocknote.StyledText); ok && previous.Styles == styles {
+			previous.Text += value
+			return contents
+		}
+	}
+	return append(contents, blocknote.InlineContent{InlineContentUnion: blocknote.NewStyledText(value, styles)})
+}
+
+func (i *markdownImporter) convertInlines(parent ast.Node, styles blocknote.Styles, contents blocknote.InlineContentList) blocknote.InlineContentList {
+	for child := parent.FirstChild(); child != nil; child = child.NextSibling() {
+		switch node := child.(type) {
+		case *ast.Text:
+			contents = appendStyledText(contents, i.textValue(node), styles)
+			if node.HardLineBreak() {
+				contents = appendStyledText(contents, "\n", styles)
+			} else if node.SoftLineBreak() {
+				contents = appendStyledText(contents, " ", styles)
+			}
+		case *ast.String:
+			contents = appendStyledText(contents, string(node.Value), styles)
+		case *ast.CodeSpan:
+			codeStyles := styles
+			codeStyles.Code = true
+			contents = i.convertInlines(node, codeStyles, contents)
+		case *ast.Emphasis:
+			emphasisStyles := styles
+			if node.Level >= 2 {
+				emphasisStyles.Bold = true
+			} else {
+				emphasisStyles.Italic = true
+			}
+			contents = i.convertInlines(node, emphasisStyles, contents)
+		case *extast.Strikethrough:
+			strikeStyles := styles
+			strikeStyles.Strike = true
+			contents = i.convertInlines(node, strikeStyles, contents)
+		case *ast.Link:
+			contents = i.appendLink(contents, string(node.Destination), i.convertInlines(node, styles, nil))
+		case *ast.Image:
+			// an image inside running text cannot be a block, so it is kept as a link to the image
+			contents = i.appendLink(contents, string(node.Destination), i.convertInlines(node, styles, nil))
+		case *ast.AutoLink:
+			url := string(node.URL(i.source))
+			if node.AutoLinkType == ast.AutoLinkEmail && !strings.HasPrefix(strings.ToLower(url), "mailto:") {
+				url = "mailto:" + url
+			}
+			contents = i.appendLink(contents, url, newPlainInlineContent(string(node.Label(i.source))))
+		case *ast.RawHTML:
+			contents = appendStyledText(contents, i.linesValue(node.Segments), styles)
+		case *mathInlineNode:
+			contents = append(contents, blocknote.InlineContent{InlineContentUnion: blocknote.NewMath(node.Content)})
+		case *extast.TaskCheckBox:
+			// consumed by the list item conversion
+		default:
+			contents = i.convertInlines(node, styles, contents)
+		}
+	}
+
+	return contents
+}
+
+// appendLink keeps only the styled text of the label since links cannot nest
+// other inline content in the editor
+func (i *markdownImporter) appendLink(contents blocknote.InlineContentList, href string, label blocknote.InlineContentList) blocknote.InlineContentList {
+	styledTexts := make([]blocknote.StyledText, 0, len(label))
+	for _, content := range label {
+		switch value := content.InlineContentUnion.(type) {
+		case *blocknote.StyledText:
+			styledTexts = append(styledTexts, *value)
+		case *blocknote.Link:
+			styledTexts = append(styledTexts, value.Content...)
+		case *blocknote.Math:
+			styledTexts = append(styledTexts, *blocknote.NewStyledText(value.Content, blocknote.Styles{Code: true}))
+		}
+	}
+	if len(styledTexts) == 0 {
+		styledTexts = append(styledTexts, *blocknote.NewStyledText(href, blocknote.Styles{}))
+	}
+	return append(contents, blocknote.InlineContent{InlineContentUnion: blocknote.NewLink(href, styledTexts)})
+}
+
+/* ============================== Blocks ============================== */
+
+func (i *markdownImporter) convertChildren(parent ast.Node) []blocknote.ArborizedEditableBlock {
+	blocks := make([]blocknote.ArborizedEditableBlock, 0)
+	for child := parent.FirstChild(); child != nil; child = child.NextSibling() {
+		blocks = append(blocks, i.convertBlock(child)...)
+	}
+	return blocks
+}
+
+func (i *markdownImporter) convertParagraph(node ast.Node, blockType enums.BlockType) blocknote.ArborizedEditableBlock {
+	if blockType == enums.BlockType_Paragraph && node.ChildCount() == 1 {
+		switch child := node.FirstChild().(type) {
+		case *ast.Image:
+			props := &blocknote.ImageBlockProps{}
+			props.Url = string(child.Destination)
+			props.Caption = strings.TrimSpace(string(child.Text(i.source)))
+			return newImportedBlock(enums.BlockType_Image, props, nil)
+		case *mathInlineNode:
+			if child.IsDisplay {
+				return newImportedBlock(enums.BlockType_MathBlock, nil, blocknote.PlainContent(child.Content))
+			}
+		}
+	}
+
+	return newImportedBlock(blockType, nil, i.convertInlines(node, blocknote.Styles{}, blocknote.InlineContentList{}))
+}
+
+func (i *markdownImporter) convertListItem(item *ast.ListItem, isOrdered bool) blocknote.ArborizedEditableBlock {
+	blockType := enums.BlockType_BulletListItem
+	if isOrdered {
+		blockType = enums.BlockType_NumberedListItem
+	}
+	var props blocknote.BlockProps = &blocknote.BaseProps{}
+
+	content := blocknote.InlineContentList{}
+	firstChild := item.FirstChild()
+	switch firstChild.(type) {
+	case *ast.Paragraph, *ast.TextBlock:
+		if checkBox, ok := firstChild.FirstChild().(*extast.TaskCheckBox); ok {
+			blockType = enums.BlockType_CheckListItem
+			props = &blocknote.CheckListItemProps{Checked: checkBox.IsChecked}
+		}
+		content = i.convertInlines(firstChild, blocknote.Styles{}, content)
+		if len(content) > 0 {
+			if styledText, ok := content[0].InlineContentUnion.(*blocknote.StyledText); ok && blockType == enums.BlockType_CheckListItem {
+				styledText.Text = strings.TrimLeft(styledText.Text, " ")
+			}
+		}
+		firstChild = firstChild.NextSibling()
+	}
+
+	block := newImportedBlock(blockType, props, content)
+	for child := firstChild; child != nil; child = child.NextSibling() {
+		block.Children = append(block.Children, i.convertBlock(child)...)
+	}
+	return block
+}
+
+func (i *markdownImporter) convertTable(table *extast.Table) blocknote.ArborizedEditableBlock {
+	tableContent := &blocknote.TableContent{
+		Type: blocknote.TableContentType_TableContent,
+		Rows: []blocknote.TableRow{},
+	}
+	for row := table.FirstChild(); row != nil; row = row.NextSibling() {
+		tableRow := blocknote.TableRow{Cells: []blocknote.TableCell{}}
+		for cell := row.FirstChild(); cell != nil; cell = cell.NextSibling() {
+			tableCell := blocknote.TableCell{
+				Type:    blocknote.TableCellType_TableCell,
+				Content: i.convertInlines(cell, blocknote.Styles{}, blocknote.InlineContentList{}),
+				Props:   blocknote.TableCellProps{RowSpan: 1, ColSpan: 1},
+			}
+			if tableCellNode, ok := cell.(*extast.TableCell); ok {
+				switch tableCellNode.Alignment {
+				case extast.AlignLeft:
+					tableCell.Props.TextAlignment = "left"
+				case extast.AlignCenter:
+					tableCell.Props.TextAlignment = "center"
+				case extast.AlignRight:
+					tableCell.Props.TextAlignment = "right"
+				}
+			}
+			tableRow.Cells = append(tableRow.Cells, tableCell)
+		}
+		tableContent.Rows = append(tableContent.Rows, tableRow)
+	}
+	return newImportedBlock(enums.BlockType_Table, &blocknote.TableProps{}, tableContent)
+}
+
+func (i *markdownImporter) convertCodeBlock(language string, value string) blocknote.ArborizedEditableBlock {
+	switch strings.ToLower(strings.TrimSpace(language)) {
+	case "math", "latex", "tex", "katex":
+		return newImportedBlock(enums.BlockType_MathBlock, nil, blocknote.PlainContent(value))
+	case "mermaid":
+		return newImportedBlock(enums.BlockType_Diagram, nil, blocknote.PlainContent(value))
+	}
+	return newImportedBlock(
+		enums.BlockType_CodeBlock,
+		&blocknote.CodeBlockProps{Language: normalizeProgrammingLanguage(language)},
+		newPlainInlineContent(value),
+	)
+}
+
+func (i *markdownImporter) convertBlock(node ast.Node) []blocknote.ArborizedEditableBlock {
+	switch node := node.(type) {
+	case *ast.Paragraph, *ast.TextBlock:
+		return []blocknote.ArborizedEditableBlock{i.convertParagraph(node, enums.BlockType_Paragraph)}
+	case *ast.Heading:
+		level := min(max(node.Level, 1), blocknote.MaxHeadingLevel)
+		block := i.convertParagraph(node, enums.BlockType_Heading)
+		block.Props = &blocknote.HeadingProps{Level: level}
+		return []blocknote.ArborizedEditableBlock{block}
+	case *ast.Blockquote:
+		blocks := make([]blocknote.ArborizedEditableBlock, 0)
+		for child := node.FirstChild(); child != nil; child = child.NextSibling() {
+			if _, ok := child.(*ast.Paragraph); ok {
+				blocks = append(blocks, i.convertParagraph(child, enums.BlockType_Quote))
+				continue
+			}
+			blocks = append(blocks, i.convertBlock(child)...)
+		}
+		return blocks
+	case *ast.List:
+		blocks := make([]blocknote.ArborizedEditableBlock, 0, node.ChildCount())
+		for child := node.FirstChild(); child != nil; child = child.NextSibling() {
+			if item, ok := child.(*ast.ListItem); ok {
+				blocks = append(blocks, i.convertListItem(item, node.IsOrdered()))
+			}
+		}
+		return blocks
+	case *ast.FencedCodeBlock:
+		return []blocknote.ArborizedEditableBlock{i.convertCodeBlock(string(node.Language(i.source)), i.linesValue(node.Lines()))}
+	case *ast.CodeBlock:
+		return []blocknote.ArborizedEditableBlock{i.convertCodeBlock("", i.linesValue(node.Lines()))}
+	case *mathBlockNode:
+		return []blocknote.ArborizedEditableBlock{
+			newImportedBlock(enums.BlockType_MathBlock, nil, blocknote.PlainContent(i.linesValue(node.Lines()))),
+		}
+	case *extast.Table:
+		return []blocknote.ArborizedEditableBlock{i.convertTable(node)}
+	case *ast.HTMLBlock:
+		// raw HTML is not rendered by the editor, its source is kept as text instead of being dropped
+		value := i.linesValue(node.Lines())
+		if node.HasClosure() {
+			value += string(node.ClosureLine.Value(i.source))
+		}
+		value = strings.TrimSpace(value)
+		if value == "" {
+			return nil
+		}
+		return []blocknote.ArborizedEditableBlock{newImportedBlock(enums.BlockType_Paragraph, nil, newPlainInlineContent(value))}
+	case *ast.ThematicBreak:
+		// the editor has no divider block
+		return nil
+	default:
+		return i.convertChildren(node)
+	}
+}
+
+/* ============================== Main Methods ============================== */
+
+// ImportEditableBlocks parses CommonMark with the GFM extensions into editor
+// blocks. Constructs the editor cannot represent are mapped as follows:
+//   - thematic breaks are dropped
+//   - raw HTML is kept as plain text
+//   - images inside running text become links to the image
+//   - fenced code blocks tagged math, latex or tex become math blocks and
+//     mermaid becomes a diagram
+//   - unknown code block languages fall back to plaintext
+//   - paragraphs in a block quote become consecutive quote blocks
+func ImportEditableBlocks(source []byte) []blocknote.ArborizedEditableBlock {
+	importer := &markdownImporter{source: source}
+	document := markdownParser.Parse(text.NewReader(source))
+	return importer.convertChildren(document)
+}
+
+// CountEditableBlocks counts the blocks including all their nested children
+func CountEditableBlocks(blocks []blocknote.ArborizedEditableBlock) int {
+	count := len(blocks)
+	for _, block := range blocks {
+		count += CountEditableBlocks(block.Children)
+	}
+	return count
+}
@@ -0,0 +1,154 @@
+package blockmarkdown
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// Neither CommonMark nor GFM define math, so "$...$" spans and "$$" fenced
+// blocks are parsed by this small extension the same way most editors do.
+
+var (
+	kindMathInline = ast.NewNodeKind("MathInline")
+	kindMathBlock  = ast.NewNodeKind("MathBlock")
+)
+
+/* ============================== Nodes ============================== */
+
+type mathInlineNode struct {
+	ast.BaseInline
+	Content   string
+	IsDisplay bool
+}
+
+func (n *mathInlineNode) Kind() ast.NodeKind { return kindMathInline }
+
+func (n *mathInlineNode) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{"Content": n.Content}, nil)
+}
+
+type mathBlockNode struct {
+	ast.BaseBlock
+}
+
+func (n *mathBlockNode) Kind() ast.NodeKind { return kindMathBlock }
+
+func (n *mathBlockNode) IsRaw() bool { return true }
+
+func (n *mathBlockNode) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, nil, nil)
+}
+
+/* ============================== Inline Parser ============================== */
+
+type mathInlineParser struct{}
+
+func (mathInlineParser) Trigger() []byte {
+	return []byte{'$'}
+}
+
+func (mathInlineParser) Parse(parent ast.Node, block text.Reader, pc parser.Context) ast.Node {
+	line, _ := block.PeekLine()
+
+	delimiter := []byte("$")
+	if bytes.HasPrefix(line, []byte("$$")) {
+		delimiter = []byte("$$")
+	}
+	start := len(delimiter)
+	if start >= len(line) || util.IsSpace(line[start]) || line[start] == '$' {
+		return nil
+	}
+
+	for index := start + 1; index+len(delimiter) <= len(line); index++ {
+		if line[index-1] == '\\' || !bytes.HasPrefix(line[index:], delimiter) {
+			continue
+		}
+		if util.IsSpace(line[index-1]) {
+			continue
+		}
+		end := index + len(delimiter)
+		if len(delimiter) == 1 && end < len(line) && (line[end] == '$' || util.IsNumeric(line[end])) {
+			continue
+		}
+
+		content := bytes.ReplaceAll(line[start:index], []byte(`\$`), []byte("$"))
+		block.Advance(end)
+		return &mathInlineNode{
+			Content:   string(content),
+			IsDisplay: len(delimiter) == 2,
+		}
+	}
+
+	return nil
+}
+
+/* ============================== Block Parser ============================== */
+
+type mathBlockParser struct{}
+
+// lineLength excludes the trailing newline so that the reader stays on the line
+func lineLength(line []byte, segment text.Segment) int {
+	if len(line) > 0 && line[len(line)-1] == '\n' {
+		return segment.Len() - 1
+	}
+	return segment.Len()
+}
+
+func isMathBlockFence(line []byte) bool {
+	trimmed := util.TrimLeftSpace(line)
+	return bytes.HasPrefix(trimmed, []byte("$$")) && util.IsBlank(trimmed[2:])
+}
+
+func (mathBlockParser) Trigger() []byte {
+	return []byte{'$'}
+}
+
+func (mathBlockParser) Open(parent ast.Node, reader text.Reader, pc parser.Context) (ast.Node, parser.State) {
+	line, segment := reader.PeekLine()
+	pos := pc.BlockOffset()
+	if pos < 0 || !isMathBlockFence(line[pos:]) {
+		return nil, parser.NoChildren
+	}
+
+	reader.Advance(lineLength(line, segment))
+	return &mathBlockNode{}, parser.NoChildren
+}
+
+func (mathBlockParser) Continue(node ast.Node, reader text.Reader, pc parser.Context) parser.State {
+	line, segment := reader.PeekLine()
+	if isMathBlockFence(line) {
+		reader.Advance(lineLength(line, segment))
+		return parser.Close
+	}
+
+	segment.ForceNewline = true
+	node.Lines().Append(segment)
+	reader.Advance(lineLength(line, segment))
+	return parser.Continue | parser.NoChildren
+}
+
+func (mathBlockParser) Close(node ast.Node, reader text.Reader, pc parser.Context) {}
+
+func (mathBlockParser) CanInterruptParagraph() bool {
+	return true
+}
+
+func (mathBlockParser) CanAcceptIndentedLine() bool {
+	return false
+}
+
+/* ============================== Extension ============================== */
+
+type mathExtension struct{}
+
+func (mathExtension) Extend(markdown goldmark.Markdown) {
+	markdown.Parser().AddOptions(
+		parser.WithBlockParsers(util.Prioritized(mathBlockParser{}, 750)),
+		parser.WithInlineParsers(util.Prioritized(mathInlineParser{}, 500)),
+	)
+}
@@ -0,0 +1,157 @@
+package blockmarkdown
+
+import (
+	"strings"
+	"testing"
+
+	blocknote "github.com/HiIamJeff67/notegic-backend/contracts/types/blocknote"
+	enums "github.com/HiIamJeff67/notegic-backend/contracts/types/enums"
+)
+
+const sampleMarkdown = "# Title\n" +
+	"\n" +
+	"Some **bold**, *italic*, ~~gone~~ and `code` with [a link](https://example.com) and $x^2$.\n" +
+	"\n" +
+	"- first\n" +
+	"  - nested\n" +
+	"- second\n" +
+	"\n" +
+	"1. one\n" +
+	"2. two\n" +
+	"\n" +
+	"- [x] done\n" +
+	"- [ ] todo\n" +
+	"\n" +
+	"> quoted\n" +
+	"\n" +
+	"```go\n" +
+	"fmt.Println(\"hi\")\n" +
+	"```\n" +
+	"\n" +
+	"$$\n" +
+	"\\int_0^1 x\\,dx\n" +
+	"$$\n" +
+	"\n" +
+	"| a | b |\n" +
+	"| --- | ---: |\n" +
+	"| 1 | 2 |\n" +
+	"\n" +
+	"---\n" +
+	"\n" +
+	"![cat](https://example.com/cat.png)\n"
+
+func TestImportEditableBlocksMapsGFMConstructs(t *testing.T) {
+	blocks := ImportEditableBlocks([]byte(sampleMarkdown))
+
+	expectedTypes := []enums.BlockType{
+		enums.BlockType_Heading,
+		enums.BlockType_Paragraph,
+		enums.BlockType_BulletListItem,
+		enums.BlockType_BulletListItem,
+		enums.BlockType_NumberedListItem,
+		enums.BlockType_NumberedListItem,
+		enums.BlockType_CheckListItem,
+		enums.BlockType_CheckListItem,
+		enums.BlockType_Quote,
+		enums.BlockType_CodeBlock,
+		enums.BlockType_MathBlock,
+		enums.BlockType_Table,
+		enums.BlockType_Image,
+	}
+	if len(blocks) != len(expectedTypes) {
+		t.Fatalf("expected %d root blocks, got %d", len(expectedTypes), len(blocks))
+	}
+	for index, expectedType := range expectedTypes {
+		if blocks[index].Type != expectedType {
+			t.Fatalf("block %d: expected %s, got %s", index, expectedType, blocks[index].Type)
+		}
+	}
+
+	if CountEditableBlocks(blocks) != len(expectedTypes)+1 {
+		t.Fatalf("expected the nested list item to be counted, got %d", CountEditableBlocks(blocks))
+	}
+	if len(blocks[2].Children) != 1 || blocks[2].Children[0].Type != enums.BlockType_BulletListItem {
+		t.Fatalf("expected one nested bullet list item, got %#v", blocks[2].Children)
+	}
+	if props := blocks[6].Props.(*blocknote.CheckListItemProps); !props.Checked {
+		t.Fatalf("expected the first check list item to be checked")
+	}
+	if props := blocks[9].Props.(*blocknote.CodeBlockProps); props.Language != "go" {
+		t.Fatalf("expected go code block, got %q", props.Language)
+	}
+	if content := blocks[10].Content.(blocknote.PlainContent); content != `\int_0^1 x\,dx` {
+		t.Fatalf("unexpected math block content %q", content)
+	}
+
+	paragraph := blocks[1].Content.(blocknote.InlineContentList)
+	var sawBold, sawStrike, sawCode, sawLink, sawMath bool
+	for _, content := range paragraph {
+		switch value := content.InlineContentUnion.(type) {
+		case *blocknote.StyledText:
+			sawBold = sawBold || (value.Styles.Bold && value.Text == "bold")
+			sawStrike = sawStrike || (value.Styles.Strike && value.Text == "gone")
+			sawCode = sawCode || (value.Styles.Code && value.Text == "code")
+		case *blocknote.Link:
+			sawLink = value.Href == "https://example.com"
+		case *blocknote.Math:
+			sawMath = value.Content == "x^2"
+		}
+	}
+	if !sawBold || !sawStrike || !sawCode || !sawLink || !sawMath {
+		t.Fatalf("missing inline content: bold=%v strike=%v code=%v link=%v math=%v", sawBold, sawStrike, sawCode, sawLink, sawMath)
+	}
+
+	table := blocks[11].Content.(*blocknote.TableContent)
+	if len(table.Rows) != 2 || table.Rows[0].Cells[1].Props.TextAlignment != "right" {
+		t.Fatalf("unexpected table content %#v", table)
+	}
+}
+
+func TestExportEditableBlocksRoundTrips(t *testing.T) {
+	exported := ExportEditableBlocks(ImportEditableBlocks([]byte(sampleMarkdown)))
+
+	for _, expected := range []string{
+		"# Title\n",
+		"Some **bold**, *italic*, ~~gone~~ and `code` with [a link](https://example.com) and $x^2$.\n",
+		"- first\n  - nested\n- second\n",
+		"1. one\n2. two\n",
+		"- [x] done\n- [ ] todo\n",
+		"> quoted\n",
+		"```go\nfmt.Println(\"hi\")\n```\n",
+		"```math\n\\int_0^1 x\\,dx\n```\n",
+		"| a | b |\n| --- | ---: |\n| 1 | 2 |\n",
+		"![cat](https://example.com/cat.png)\n",
+	} {
+		if !strings.Contains(exported, expected) {
+			t.Fatalf("expected exported markdown to contain %q, got:\n%s", expected, exported)
+		}
+	}
+
+	reimported := ImportEditableBlocks([]byte(exported))
+	if CountEditableBlocks(reimported) != CountEditableBlocks(ImportEditableBlocks([]byte(sampleMarkdown))) {
+		t.Fatalf("expected the exported markdown to re-import to the same block count, got:\n%s", exported)
+	}
+}
+
+func TestExportEditableBlocksEscapesLiteralMarkdown(t *testing.T) {
+	blocks := []blocknote.ArborizedEditableBlock{{
+		Type:  enums.BlockType_Paragraph,
+		Props: &blocknote.BaseProps{},
+		Content: blocknote.InlineContentList{
+			{InlineContentUnion: blocknote.NewStyledText("- costs $5 *not* bold\n# not a heading", blocknote.Styles{})},
+		},
+	}}
+
+	exported := ExportEditableBlocks(blocks)
+	reimported := ImportEditableBlocks([]byte(exported))
+	if len(reimported) != 1 || reimported[0].Type != enums.BlockType_Paragraph {
+		t.Fatalf("expected a single paragraph, got %#v from:\n%s", reimported, exported)
+	}
+	content := reimported[0].Content.(blocknote.InlineContentList)
+	if len(content) != 1 {
+		t.Fatalf("expected plain text only, got %#v from:\n%s", content, exported)
+	}
+	if text := content[0].InlineContentUnion.(*blocknote.StyledText).Text; text != "- costs $5 *not* bold\n# not a heading" {
+		t.Fatalf("unexpected round-tripped text %q from:\n%s", text, exported)
+	}
+}
@@ -0,0 +1,151 @@
+package editableblock
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	blocknote "github.com/HiIamJeff67/notegic-backend/contracts/types/blocknote"
+)
+
+/* ============================== Auxiliary Functions ============================== */
+
+// orderEditableBlockSiblings follows the prev/next pointers of one sibling group,
+// blocks that are not reachable from the head keep their input order at the end
+func orderEditableBlockSiblings(siblings []blocknote.RawFlattenedEditableBlock) []blocknote.RawFlattenedEditableBlock {
+	if len(siblings) <= 1 {
+		return siblings
+	}
+
+	siblingsById := make(map[uuid.UUID]blocknote.RawFlattenedEditableBlock, len(siblings))
+	for _, sibling := range siblings {
+		siblingsById[sibling.Id] = sibling
+	}
+
+	ordered := make([]blocknote.RawFlattenedEditableBlock, 0, len(siblings))
+	visited := make(map[uuid.UUID]bool, len(siblings))
+	for _, sibling := range siblings {
+		if sibling.PrevBlockId != nil {
+			if _, exists := siblingsById[*sibling.PrevBlockId]; exists {
+				continue
+			}
+		}
+		for current, exists := sibling, true; exists && !visited[current.Id]; {
+			visited[current.Id] = true
+			ordered = append(ordered, current)
+			if current.NextBlockId == nil {
+				break
+			}
+			current, exists = siblingsById[*current.NextBlockId]
+		}
+	}
+	for _, sibling := range siblings {
+		if !visited[sibling.Id] {
+			ordered = append(ordered, sibling)
+		}
+	}
+
+	return ordered
+}
+
+func arborizeEditableBlock(block blocknote.RawFlattenedEditableBlock) (blocknote.ArborizedEditableBlock, error) {
+	props := block.Props
+	if len(props) == 0 {
+		props = json.RawMessage("{}")
+	}
+	content := block.Content
+	if len(content) == 0 {
+		content = json.RawMessage("null")
+	}
+
+	payload, err := json.Marshal(struct {
+		Id       uuid.UUID       `json:"id"`
+		Type     string          `json:"type"`
+		Props    json.RawMessage `json:"props"`
+		Content  json.RawMessage `json:"content"`
+		Children []struct{}      `json:"children"`
+	}{
+		Id:       block.Id,
+		Type:     string(block.Type),
+		Props:    props,
+		Content:  content,
+		Children: []struct{}{},
+	})
+	if err != nil {
+		return blocknote.ArborizedEditableBlock{}, fmt.Errorf("marshal flattened editable block: %w", err)
+	}
+
+	var arborizedBlock blocknote.ArborizedEditableBlock
+	if err := json.Unmarshal(payload, &arborizedBlock); err != nil {
+		return blocknote.ArborizedEditableBlock{}, fmt.Errorf("unmarshal flattened editable block %s: %w", block.Id, err)
+	}
+
+	return arborizedBlock, nil
+}
+
+/* ============================== Main Methods ============================== */
+
+// ArborizeEditableBlocks is the inverse of FlattenEditableBlocks, blocks whose
+// parent is missing from the input are treated as roots
+func ArborizeEditableBlocks(blocks []blocknote.RawFlattenedEditableBlock) ([]blocknote.ArborizedEditableBlock, error) {
+	if len(blocks) == 0 {
+		return []blocknote.ArborizedEditableBlock{}, nil
+	}
+
+	blockIdSet := make(map[uuid.UUID]bool, len(blocks))
+	for _, block := range blocks {
+		if block.Id == uuid.Nil {
+			return nil, fmt.Errorf("editable block id is required")
+		}
+		if blockIdSet[block.Id] {
+			return nil, fmt.Errorf("duplicate editable block id: %s", block.Id)
+		}
+		blockIdSet[block.Id] = true
+	}
+
+	roots := make([]blocknote.RawFlattenedEditableBlock, 0)
+	childrenByParentId := make(map[uuid.UUID][]blocknote.RawFlattenedEditableBlock)
+	for _, block := range blocks {
+		if block.ParentBlockId == nil || !blockIdSet[*block.ParentBlockId] {
+			roots = append(roots, block)
+			continue
+		}
+		childrenByParentId[*block.ParentBlockId] = append(childrenByParentId[*block.ParentBlockId], block)
+	}
+
+	visitedBlockIds := make(map[uuid.UUID]bool, len(blocks))
+	var build func(siblings []blocknote.RawFlattenedEditableBlock) ([]blocknote.ArborizedEditableBlock, error)
+	build = func(siblings []blocknote.RawFlattenedEditableBlock) ([]blocknote.ArborizedEditableBlock, error) {
+		ordered := orderEditableBlockSiblings(siblings)
+		arborizedBlocks := make([]blocknote.ArborizedEditableBlock, 0, len(ordered))
+		for _, block := range ordered {
+			if visitedBlockIds[block.Id] {
+				return nil, fmt.Errorf("cyclic editable block parent: %s", block.Id)
+			}
+			visitedBlockIds[block.Id] = true
+
+			arborizedBlock, err := arborizeEditableBlock(block)
+			if err != nil {
+				return nil, err
+			}
+			children, err := build(childrenByParentId[block.Id])
+			if err != nil {
+				return nil, err
+			}
+			arborizedBlock.Children = children
+			arborizedBlocks = append(arborizedBlocks, arborizedBlock)
+		}
+		return arborizedBlocks, nil
+	}
+
+	arborizedBlocks, err := build(roots)
+	if err != nil {
+		return nil, err
+	}
+	if len(visitedBlockIds) != len(blocks) {
+		return nil, fmt.Errorf("cyclic editable block parent chain")
+	}
+
+	return arborizedBlocks, nil
+}
@@ -0,0 +1,63 @@
+package editableblock
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+
+	blocknote "github.com/HiIamJeff67/notegic-backend/contracts/types/blocknote"
+)
+
+func TestArborizeEditableBlocksRestoresFlattenedTree(t *testing.T) {
+	firstRootId := uuid.New()
+	secondRootId := uuid.New()
+	firstChildId := uuid.New()
+	secondChildId := uuid.New()
+
+	payload := []byte(`[
+		{
+			"id": "` + firstRootId.String() + `",
+			"type": "bulletListItem",
+			"props": {},
+			"content": [{"type":"text","text":"root","styles":{}}],
+			"children": [
+				{"id": "` + firstChildId.String() + `", "type": "checkListItem", "props": {"checked":true}, "content": [], "children": []},
+				{"id": "` + secondChildId.String() + `", "type": "codeBlock", "props": {"language":"go"}, "content": [], "children": []}
+			]
+		},
+		{"id": "` + secondRootId.String() + `", "type": "mathBlock", "props": {}, "content": "x^2", "children": []}
+	]`)
+
+	var roots []blocknote.ArborizedEditableBlock
+	if err := json.Unmarshal(payload, &roots); err != nil {
+		t.Fatalf("unmarshal arborized blocks: %v", err)
+	}
+	flattenedBlocks, _, err := FlattenEditableBlocks(roots)
+	if err != nil {
+		t.Fatalf("flatten arborized blocks: %v", err)
+	}
+
+	// reverse the rows to make sure the order comes from the sibling pointers
+	for left, right := 0, len(flattenedBlocks)-1; left < right; left, right = left+1, right-1 {
+		flattenedBlocks[left], flattenedBlocks[right] = flattenedBlocks[right], flattenedBlocks[left]
+	}
+
+	arborizedBlocks, err := ArborizeEditableBlocks(flattenedBlocks)
+	if err != nil {
+		t.Fatalf("arborize flattened blocks: %v", err)
+	}
+	if len(arborizedBlocks) != 2 || arborizedBlocks[0].Id != firstRootId || arborizedBlocks[1].Id != secondRootId {
+		t.Fatalf("unexpected roots: %#v", arborizedBlocks)
+	}
+	children := arborizedBlocks[0].Children
+	if len(children) != 2 || children[0].Id != firstChildId || children[1].Id != secondChildId {
+		t.Fatalf("unexpected children: %#v", children)
+	}
+	if props, ok := children[0].Props.(*blocknote.CheckListItemProps); !ok || !props.Checked {
+		t.Fatalf("expected checked check list item props, got %#v", children[0].Props)
+	}
+	if content, ok := arborizedBlocks[1].Content.(blocknote.PlainContent); !ok || content != "x^2" {
+		t.Fatalf("expected math block plain content, got %#v", arborizedBlocks[1].Content)
+	}
+}
@@ -0,0 +1,64 @@
+package shelfarchive
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	FormatVersion = 1
+
+	ManifestPath        = "manifest.json"
+	BlockPackContentDir = "block-packs"
+	MaterialContentDir  = "materials"
+)
+
+type Manifest struct {
+	FormatVersion int              `json:"formatVersion"`
+	ExportedAt    time.Time        `json:"exportedAt"`
+	RootShelf     RootShelfEntry   `json:"rootShelf"`
+	SubShelves    []SubShelfEntry  `json:"subShelves"`
+	BlockPacks    []BlockPackEntry `json:"blockPacks"`
+	Materials     []MaterialEntry  `json:"materials"`
+}
+
+type RootShelfEntry struct {
+	Id   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
+
+type SubShelfEntry struct {
+	Id             uuid.UUID   `json:"id"`
+	Name           string      `json:"name"`
+	PrevSubShelfId *uuid.UUID  `json:"prevSubShelfId"`
+	Path           []uuid.UUID `json:"path"`
+}
+
+type BlockPackEntry struct {
+	Id                  uuid.UUID `json:"id"`
+	ParentSubShelfId    uuid.UUID `json:"parentSubShelfId"`
+	Name                string    `json:"name"`
+	Icon                *string   `json:"icon"`
+	HeaderBackgroundURL *string   `json:"headerBackgroundURL"`
+	BlockCount          int64     `json:"blockCount"`
+	Sha256              string    `json:"sha256"`
+	ContentPath         string    `json:"contentPath"`
+}
+
+type MaterialEntry struct {
+	Id               uuid.UUID `json:"id"`
+	ParentSubShelfId uuid.UUID `json:"parentSubShelfId"`
+	Name             string    `json:"name"`
+	ContentType      string    `json:"contentType"`
+	ParseMediaType   string    `json:"parseMediaType"`
+	Size             int64     `json:"size"`
+	Sha256           string    `json:"sha256"`
+	ContentPath      string    `json:"contentPath"`
+}
+
+// ItemCount is the number of the sub shelves, block packs and materials in the manifest,
+// which is used as the total amount of work while exporting or importing the archive
+func (m *Manifest) ItemCount() int64 {
+	return int64(len(m.SubShelves) + len(m.BlockPacks) + len(m.Materials))
+}
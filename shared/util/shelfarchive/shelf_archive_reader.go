@@ -0,0 +1,211 @@
+package shelfarchive
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/google/uuid"
+
+	blocknote "github.com/HiIamJeff67/notegic-backend/contracts/types/blocknote"
+)
+
+var (
+	ErrArchiveTooLarge           = errors.New("root shelf archive is too large")
+	ErrUnsupportedFormatVersion  = errors.New("root shelf archive format version is not supported")
+	ErrInvalidManifest           = errors.New("root shelf archive manifest is invalid")
+	ErrArchiveEntryNotFound      = errors.New("root shelf archive entry is not found")
+	ErrArchiveChecksumMismatched = errors.New("root shelf archive entry checksum is mismatched")
+)
+
+type Limits struct {
+	MaxEntryCount       int
+	MaxUncompressedSize int64
+	MaxSubShelfDepth    int
+}
+
+type Archive struct {
+	Manifest Manifest
+	files    map[string]*zip.File
+	limits   Limits
+}
+
+// Read opens the archive and validates its manifest before any of its contents are used,
+// the sub shelves of the returned manifest are ordered so that every parent comes before its children
+func Read(data []byte, limits Limits) (*Archive, error) {
+	zipReader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("open root shelf archive: %w", err)
+	}
+	if len(zipReader.File) > limits.MaxEntryCount {
+		return nil, fmt.Errorf("%w: %d entries exceed limit %d", ErrArchiveTooLarge, len(zipReader.File), limits.MaxEntryCount)
+	}
+
+	archive := &Archive{
+		files:  make(map[string]*zip.File, len(zipReader.File)),
+		limits: limits,
+	}
+	var uncompressedSize uint64
+	for _, file := range zipReader.File {
+		uncompressedSize += file.UncompressedSize64
+		archive.files[file.Name] = file
+	}
+	if uncompressedSize > uint64(limits.MaxUncompressedSize) {
+		return nil, fmt.Errorf("%w: %d bytes exceed limit %d", ErrArchiveTooLarge, uncompressedSize, limits.MaxUncompressedSize)
+	}
+
+	manifestContent, err := archive.readEntry(ManifestPath)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(manifestContent, &archive.Manifest); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidManifest, err)
+	}
+	if err := archive.validateManifest(); err != nil {
+		return nil, err
+	}
+
+	return archive, nil
+}
+
+/* ============================== Auxiliary Functions ============================== */
+
+// readEntry reads the whole entry while never trusting the declared size of it,
+// since the declared sizes of a crafted archive can be much smaller than the inflated contents
+func (a *Archive) readEntry(name string) ([]byte, error) {
+	file, exist := a.files[name]
+	if !exist {
+		return nil, fmt.Errorf("%w: %s", ErrArchiveEntryNotFound, name)
+	}
+
+	reader, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("open archive entry %q: %w", name, err)
+	}
+	defer reader.Close()
+
+	content, err := io.ReadAll(io.LimitReader(reader, int64(file.UncompressedSize64)+1))
+	if err != nil {
+		return nil, fmt.Errorf("read archive entry %q: %w", name, err)
+	}
+	if uint64(len(content)) > file.UncompressedSize64 {
+		return nil, fmt.Errorf("%w: entry %q is larger than declared", ErrArchiveTooLarge, name)
+	}
+
+	return content, nil
+}
+
+func (a *Archive) readVerifiedEntry(name string, expectedSha256 string) ([]byte, error) {
+	content, err := a.readEntry(name)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(content)
+	if hex.EncodeToString(hash[:]) != expectedSha256 {
+		return nil, fmt.Errorf("%w: %s", ErrArchiveChecksumMismatched, name)
+	}
+
+	return content, nil
+}
+
+func (a *Archive) validateManifest() error {
+	manifest := &a.Manifest
+	if manifest.FormatVersion != FormatVersion {
+		return fmt.Errorf("%w: %d", ErrUnsupportedFormatVersion, manifest.FormatVersion)
+	}
+	if manifest.RootShelf.Id == uuid.Nil {
+		return fmt.Errorf("%w: root shelf id is required", ErrInvalidManifest)
+	}
+
+	seenIds := map[uuid.UUID]bool{manifest.RootShelf.Id: true}
+	markSeen := func(id uuid.UUID) error {
+		if id == uuid.Nil || seenIds[id] {
+			return fmt.Errorf("%w: id %s is empty or duplicated", ErrInvalidManifest, id)
+		}
+		seenIds[id] = true
+		return nil
+	}
+
+	// a parent always has a shorter path than its children, so the stable sort places the parents first
+	slices.SortStableFunc(manifest.SubShelves, func(a, b SubShelfEntry) int {
+		return len(a.Path) - len(b.Path)
+	})
+	subShelfById := make(map[uuid.UUID]SubShelfEntry, len(manifest.SubShelves))
+	for _, subShelf := range manifest.SubShelves {
+		if err := markSeen(subShelf.Id); err != nil {
+			return err
+		}
+		if len(subShelf.Path) > a.limits.MaxSubShelfDepth {
+			return fmt.Errorf("%w: sub shelf %s is nested too deep", ErrInvalidManifest, subShelf.Id)
+		}
+
+		if subShelf.PrevSubShelfId == nil {
+			if len(subShelf.Path) != 0 {
+				return fmt.Errorf("%w: path of top level sub shelf %s must be empty", ErrInvalidManifest, subShelf.Id)
+			}
+		} else {
+			parent, exist := subShelfById[*subShelf.PrevSubShelfId]
+			if !exist || !slices.Equal(subShelf.Path, append(slices.Clone(parent.Path), parent.Id)) {
+				return fmt.Errorf("%w: parent of sub shelf %s is inconsistent", ErrInvalidManifest, subShelf.Id)
+			}
+		}
+		subShelfById[subShelf.Id] = subShelf
+	}
+
+	for _, blockPack := range manifest.BlockPacks {
+		if err := markSeen(blockPack.Id); err != nil {
+			return err
+		}
+		if _, exist := subShelfById[blockPack.ParentSubShelfId]; !exist {
+			return fmt.Errorf("%w: parent of block pack %s is not found", ErrInvalidManifest, blockPack.Id)
+		}
+		if _, exist := a.files[blockPack.ContentPath]; !exist {
+			return fmt.Errorf("%w: %s", ErrArchiveEntryNotFound, blockPack.ContentPath)
+		}
+	}
+
+	for _, material := range manifest.Materials {
+		if err := markSeen(material.Id); err != nil {
+			return err
+		}
+		if _, exist := subShelfById[material.ParentSubShelfId]; !exist {
+			return fmt.Errorf("%w: parent of material %s is not found", ErrInvalidManifest, material.Id)
+		}
+		file, exist := a.files[material.ContentPath]
+		if !exist {
+			return fmt.Errorf("%w: %s", ErrArchiveEntryNotFound, material.ContentPath)
+		}
+		if file.UncompressedSize64 != uint64(material.Size) {
+			return fmt.Errorf("%w: size of material %s is inconsistent", ErrInvalidManifest, material.Id)
+		}
+	}
+
+	return nil
+}
+
+/* ============================== Archive Methods ============================== */
+
+func (a *Archive) ReadBlockPack(entry BlockPackEntry) ([]blocknote.ArborizedEditableBlock, error) {
+	content, err := a.readVerifiedEntry(entry.ContentPath, entry.Sha256)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := []blocknote.ArborizedEditableBlock{}
+	if err := json.Unmarshal(content, &blocks); err != nil {
+		return nil, fmt.Errorf("%w: blocks of block pack %s: %v", ErrInvalidManifest, entry.Id, err)
+	}
+
+	return blocks, nil
+}
+
+func (a *Archive) ReadMaterial(entry MaterialEntry) ([]byte, error) {
+	return a.readVerifiedEntry(entry.ContentPath, entry.Sha256)
+}
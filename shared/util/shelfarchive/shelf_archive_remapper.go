@@ -0,0 +1,67 @@
+package shelfarchive
+
+import (
+	"github.com/google/uuid"
+
+	blocknote "github.com/HiIamJeff67/notegic-backend/contracts/types/blocknote"
+)
+
+/* ============================== Auxiliary Functions ============================== */
+
+func remapId(idMapping map[uuid.UUID]uuid.UUID, id uuid.UUID) uuid.UUID {
+	if remappedId, exist := idMapping[id]; exist {
+		return remappedId
+	}
+	remappedId := uuid.New()
+	idMapping[id] = remappedId
+	return remappedId
+}
+
+/* ============================== Remapper Functions ============================== */
+
+// RemapManifest returns a copy of the manifest whose sub shelves, block packs and materials own new ids,
+// so that importing the same archive more than once never collides with the existing rows,
+// the content paths are kept since they still refer to the entries of the original archive
+func RemapManifest(manifest Manifest) (Manifest, map[uuid.UUID]uuid.UUID) {
+	idMapping := make(map[uuid.UUID]uuid.UUID, manifest.ItemCount())
+
+	remapped := manifest
+	remapped.SubShelves = make([]SubShelfEntry, len(manifest.SubShelves))
+	for index, subShelf := range manifest.SubShelves {
+		subShelf.Id = remapId(idMapping, subShelf.Id)
+		if subShelf.PrevSubShelfId != nil {
+			prevSubShelfId := remapId(idMapping, *subShelf.PrevSubShelfId)
+			subShelf.PrevSubShelfId = &prevSubShelfId
+		}
+		path := make([]uuid.UUID, len(subShelf.Path))
+		for pathIndex, ancestorId := range subShelf.Path {
+			path[pathIndex] = remapId(idMapping, ancestorId)
+		}
+		subShelf.Path = path
+		remapped.SubShelves[index] = subShelf
+	}
+
+	remapped.BlockPacks = make([]BlockPackEntry, len(manifest.BlockPacks))
+	for index, blockPack := range manifest.BlockPacks {
+		blockPack.Id = remapId(idMapping, blockPack.Id)
+		blockPack.ParentSubShelfId = remapId(idMapping, blockPack.ParentSubShelfId)
+		remapped.BlockPacks[index] = blockPack
+	}
+
+	remapped.Materials = make([]MaterialEntry, len(manifest.Materials))
+	for index, material := range manifest.Materials {
+		material.Id = remapId(idMapping, material.Id)
+		material.ParentSubShelfId = remapId(idMapping, material.ParentSubShelfId)
+		remapped.Materials[index] = material
+	}
+
+	return remapped, idMapping
+}
+
+// RemapEditableBlockIds gives every block of the trees a new id in place
+func RemapEditableBlockIds(blocks []blocknote.ArborizedEditableBlock) {
+	for index := range blocks {
+		blocks[index].Id = uuid.New()
+		RemapEditableBlockIds(blocks[index].Children)
+	}
+}
@@ -0,0 +1,205 @@
+package shelfarchive
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	blocknote "github.com/HiIamJeff67/notegic-backend/contracts/types/blocknote"
+)
+
+var testLimits = Limits{
+	MaxEntryCount:       16,
+	MaxUncompressedSize: 1 << 20,
+	MaxSubShelfDepth:    100,
+}
+
+type testTree struct {
+	rootShelfId   uuid.UUID
+	topSubShelfId uuid.UUID
+	subSubShelfId uuid.UUID
+	blockPackId   uuid.UUID
+	materialId    uuid.UUID
+}
+
+func writeTestArchive(t *testing.T) ([]byte, testTree) {
+	t.Helper()
+
+	tree := testTree{
+		rootShelfId:   uuid.New(),
+		topSubShelfId: uuid.New(),
+		subSubShelfId: uuid.New(),
+		blockPackId:   uuid.New(),
+		materialId:    uuid.New(),
+	}
+
+	var blocks []blocknote.ArborizedEditableBlock
+	payload := []byte(`[{"id":"` + uuid.NewString() + `","type":"paragraph","props":{},"content":[{"type":"text","text":"hello","styles":{}}],"children":[
+		{"id":"` + uuid.NewString() + `","type":"paragraph","props":{},"content":[],"children":[]}
+	]}]`)
+	if err := json.Unmarshal(payload, &blocks); err != nil {
+		t.Fatalf("unmarshal blocks: %v", err)
+	}
+
+	buffer := &bytes.Buffer{}
+	writer := NewWriter(buffer, RootShelfEntry{Id: tree.rootShelfId, Name: "Root"}, time.Now())
+	// add the child before its parent to make sure the reader orders them
+	writer.AddSubShelf(SubShelfEntry{
+		Id:             tree.subSubShelfId,
+		Name:           "Child",
+		PrevSubShelfId: &tree.topSubShelfId,
+		Path:           []uuid.UUID{tree.topSubShelfId},
+	})
+	writer.AddSubShelf(SubShelfEntry{Id: tree.topSubShelfId, Name: "Top"})
+	if err := writer.AddBlockPack(BlockPackEntry{
+		Id:               tree.blockPackId,
+		ParentSubShelfId: tree.subSubShelfId,
+		Name:             "Notes",
+		BlockCount:       2,
+	}, blocks); err != nil {
+		t.Fatalf("add block pack: %v", err)
+	}
+	if err := writer.AddMaterial(MaterialEntry{
+		Id:               tree.materialId,
+		ParentSubShelfId: tree.topSubShelfId,
+		Name:             "readme.txt",
+		ContentType:      "text/plain",
+	}, []byte("plain text")); err != nil {
+		t.Fatalf("add material: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("close writer: %v", err)
+	}
+
+	return buffer.Bytes(), tree
+}
+
+func TestReadRestoresWrittenArchive(t *testing.T) {
+	data, tree := writeTestArchive(t)
+
+	archive, err := Read(data, testLimits)
+	if err != nil {
+		t.Fatalf("read archive: %v", err)
+	}
+	if archive.Manifest.RootShelf.Id != tree.rootShelfId || archive.Manifest.ItemCount() != 4 {
+		t.Fatalf("unexpected manifest: %+v", archive.Manifest)
+	}
+	if archive.Manifest.SubShelves[0].Id != tree.topSubShelfId {
+		t.Fatalf("expected the parent sub shelf first, got %+v", archive.Manifest.SubShelves)
+	}
+
+	blocks, err := archive.ReadBlockPack(archive.Manifest.BlockPacks[0])
+	if err != nil {
+		t.Fatalf("read block pack: %v", err)
+	}
+	if len(blocks) != 1 || len(blocks[0].Children) != 1 {
+		t.Fatalf("unexpected blocks: %+v", blocks)
+	}
+
+	content, err := archive.ReadMaterial(archive.Manifest.Materials[0])
+	if err != nil {
+		t.Fatalf("read material: %v", err)
+	}
+	if string(content) != "plain text" || archive.Manifest.Materials[0].Size != int64(len(content)) {
+		t.Fatalf("unexpected material content %q", content)
+	}
+}
+
+func TestReadRejectsInvalidArchives(t *testing.T) {
+	data, _ := writeTestArchive(t)
+
+	if _, err := Read(data, Limits{MaxEntryCount: 2, MaxUncompressedSize: 1 << 20, MaxSubShelfDepth: 100}); !errors.Is(err, ErrArchiveTooLarge) {
+		t.Fatalf("expected too many entries to be rejected, got %v", err)
+	}
+	if _, err := Read(data, Limits{MaxEntryCount: 16, MaxUncompressedSize: 16, MaxSubShelfDepth: 100}); !errors.Is(err, ErrArchiveTooLarge) {
+		t.Fatalf("expected a large archive to be rejected, got %v", err)
+	}
+	if _, err := Read(data, Limits{MaxEntryCount: 16, MaxUncompressedSize: 1 << 20, MaxSubShelfDepth: 0}); !errors.Is(err, ErrInvalidManifest) {
+		t.Fatalf("expected a deep sub shelf to be rejected, got %v", err)
+	}
+
+	writeManifest := func(manifest Manifest) []byte {
+		buffer := &bytes.Buffer{}
+		zipWriter := zip.NewWriter(buffer)
+		entryWriter, err := zipWriter.Create(ManifestPath)
+		if err != nil {
+			t.Fatalf("create manifest: %v", err)
+		}
+		if err := json.NewEncoder(entryWriter).Encode(manifest); err != nil {
+			t.Fatalf("encode manifest: %v", err)
+		}
+		if err := zipWriter.Close(); err != nil {
+			t.Fatalf("close archive: %v", err)
+		}
+		return buffer.Bytes()
+	}
+
+	rootShelf := RootShelfEntry{Id: uuid.New(), Name: "Root"}
+	if _, err := Read(writeManifest(Manifest{FormatVersion: FormatVersion + 1, RootShelf: rootShelf}), testLimits); !errors.Is(err, ErrUnsupportedFormatVersion) {
+		t.Fatalf("expected an unknown version to be rejected, got %v", err)
+	}
+
+	orphanParentId := uuid.New()
+	orphan := Manifest{
+		FormatVersion: FormatVersion,
+		RootShelf:     rootShelf,
+		SubShelves:    []SubShelfEntry{{Id: uuid.New(), Name: "Orphan", PrevSubShelfId: &orphanParentId, Path: []uuid.UUID{orphanParentId}}},
+	}
+	if _, err := Read(writeManifest(orphan), testLimits); !errors.Is(err, ErrInvalidManifest) {
+		t.Fatalf("expected an orphan sub shelf to be rejected, got %v", err)
+	}
+
+	subShelfId := uuid.New()
+	missingContent := Manifest{
+		FormatVersion: FormatVersion,
+		RootShelf:     rootShelf,
+		SubShelves:    []SubShelfEntry{{Id: subShelfId, Name: "Top", Path: []uuid.UUID{}}},
+		Materials:     []MaterialEntry{{Id: uuid.New(), ParentSubShelfId: subShelfId, Name: "x", ContentPath: "materials/x"}},
+	}
+	if _, err := Read(writeManifest(missingContent), testLimits); !errors.Is(err, ErrArchiveEntryNotFound) {
+		t.Fatalf("expected a missing entry to be rejected, got %v", err)
+	}
+}
+
+func TestRemapManifestKeepsTopology(t *testing.T) {
+	data, tree := writeTestArchive(t)
+	archive, err := Read(data, testLimits)
+	if err != nil {
+		t.Fatalf("read archive: %v", err)
+	}
+
+	remapped, idMapping := RemapManifest(archive.Manifest)
+	if len(idMapping) != 4 {
+		t.Fatalf("expected 4 remapped ids, got %d", len(idMapping))
+	}
+
+	top, child := remapped.SubShelves[0], remapped.SubShelves[1]
+	if top.Id == tree.topSubShelfId || top.Id != idMapping[tree.topSubShelfId] {
+		t.Fatalf("top sub shelf is not remapped: %+v", top)
+	}
+	if child.PrevSubShelfId == nil || *child.PrevSubShelfId != top.Id || len(child.Path) != 1 || child.Path[0] != top.Id {
+		t.Fatalf("child sub shelf does not point to the remapped parent: %+v", child)
+	}
+	if remapped.BlockPacks[0].ParentSubShelfId != child.Id || remapped.Materials[0].ParentSubShelfId != top.Id {
+		t.Fatalf("items do not point to the remapped parents")
+	}
+	if archive.Manifest.SubShelves[0].Id != tree.topSubShelfId {
+		t.Fatalf("the original manifest must be kept")
+	}
+
+	blocks, err := archive.ReadBlockPack(remapped.BlockPacks[0])
+	if err != nil {
+		t.Fatalf("read block pack through the remapped entry: %v", err)
+	}
+	originalBlockId := blocks[0].Id
+	originalChildBlockId := blocks[0].Children[0].Id
+	RemapEditableBlockIds(blocks)
+	if blocks[0].Id == originalBlockId || blocks[0].Children[0].Id == originalChildBlockId {
+		t.Fatalf("blocks are not remapped")
+	}
+}
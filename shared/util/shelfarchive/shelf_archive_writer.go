@@ -0,0 +1,107 @@
+package shelfarchive
+
+import (
+	"archive/zip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"github.com/google/uuid"
+
+	blocknote "github.com/HiIamJeff67/notegic-backend/contracts/types/blocknote"
+)
+
+type Writer struct {
+	zipWriter *zip.Writer
+	manifest  Manifest
+}
+
+func NewWriter(writer io.Writer, rootShelf RootShelfEntry, exportedAt time.Time) *Writer {
+	return &Writer{
+		zipWriter: zip.NewWriter(writer),
+		manifest: Manifest{
+			FormatVersion: FormatVersion,
+			ExportedAt:    exportedAt.UTC(),
+			RootShelf:     rootShelf,
+			SubShelves:    []SubShelfEntry{},
+			BlockPacks:    []BlockPackEntry{},
+			Materials:     []MaterialEntry{},
+		},
+	}
+}
+
+/* ============================== Auxiliary Functions ============================== */
+
+func (w *Writer) writeEntry(name string, content []byte) (string, error) {
+	entryWriter, err := w.zipWriter.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: w.manifest.ExportedAt,
+	})
+	if err != nil {
+		return "", fmt.Errorf("create archive entry %q: %w", name, err)
+	}
+	if _, err := entryWriter.Write(content); err != nil {
+		return "", fmt.Errorf("write archive entry %q: %w", name, err)
+	}
+
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+/* ============================== Writer Methods ============================== */
+
+func (w *Writer) AddSubShelf(entry SubShelfEntry) {
+	if entry.Path == nil {
+		entry.Path = []uuid.UUID{}
+	}
+	w.manifest.SubShelves = append(w.manifest.SubShelves, entry)
+}
+
+func (w *Writer) AddBlockPack(entry BlockPackEntry, blocks []blocknote.ArborizedEditableBlock) error {
+	if blocks == nil {
+		blocks = []blocknote.ArborizedEditableBlock{}
+	}
+	content, err := json.Marshal(blocks)
+	if err != nil {
+		return fmt.Errorf("marshal blocks of block pack %s: %w", entry.Id, err)
+	}
+
+	entry.ContentPath = path.Join(BlockPackContentDir, entry.Id.String()+".json")
+	if entry.Sha256, err = w.writeEntry(entry.ContentPath, content); err != nil {
+		return err
+	}
+	w.manifest.BlockPacks = append(w.manifest.BlockPacks, entry)
+
+	return nil
+}
+
+func (w *Writer) AddMaterial(entry MaterialEntry, content []byte) error {
+	var err error
+	entry.Size = int64(len(content))
+	entry.ContentPath = path.Join(MaterialContentDir, entry.Id.String())
+	if entry.Sha256, err = w.writeEntry(entry.ContentPath, content); err != nil {
+		return err
+	}
+	w.manifest.Materials = append(w.manifest.Materials, entry)
+
+	return nil
+}
+
+// Close writes the manifest as the last entry and flushes the archive,
+// the manifest is written at the end since the checksums are only known after adding the contents
+func (w *Writer) Close() error {
+	manifest, err := json.MarshalIndent(w.manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal archive manifest: %w", err)
+	}
+	if _, err := w.writeEntry(ManifestPath, manifest); err != nil {
+		return err
+	}
+
+	return w.zipWriter.Close()
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/go-playground/validator/v10" // make sure we use the version 10
 
 	constants "github.com/HiIamJeff67/notegic-backend/shared/constants"
+	recurrence "github.com/HiIamJeff67/notegic-backend/shared/lib/recurrence"
 	stringutil "github.com/HiIamJeff67/notegic-backend/shared/lib/strings"
 )
 
@@ -110,4 +111,8 @@ func RegisterStringsValidation(validate *validator.Validate) {
 		_, err := time.LoadLocation(tzStr)
 		return err == nil
 	})
+	validate.RegisterValidation("isrecurrencerule", func(fl validator.FieldLevel) bool {
+		_, err := recurrence.Parse(fl.Field().String())
+		return err == nil
+	})
 }